@@ -0,0 +1,56 @@
+// cmd/debug/checks.go
+package main
+
+import (
+	"fmt"
+	"time"
+
+	appConfig "barber-booking-system/internal/config"
+	"barber-booking-system/internal/opscheck"
+)
+
+// printCheck prints a check result and reports whether it passed (skips count as passing)
+func printCheck(r opscheck.Result) bool {
+	switch {
+	case r.Skipped:
+		fmt.Printf("⚪ %-8s skipped: %s\n", r.Name, r.Detail)
+	case r.OK:
+		fmt.Printf("✅ %-8s ok (%s) %s\n", r.Name, r.Duration.Round(time.Millisecond), r.Detail)
+	default:
+		fmt.Printf("❌ %-8s FAILED (%s): %s\n", r.Name, r.Duration.Round(time.Millisecond), r.Detail)
+	}
+	return r.Passed()
+}
+
+// runHealthChecks runs all connectivity checks and reports overall success
+func runHealthChecks(cfg *appConfig.Config) bool {
+	fmt.Println("🩺 Running connectivity checks...")
+
+	allOK := true
+	for _, check := range opscheck.All {
+		if !printCheck(check(cfg)) {
+			allOK = false
+		}
+	}
+	return allOK
+}
+
+// validateConfiguration prints the problems and warnings opscheck.Validate
+// finds and reports whether the configuration is valid
+func validateConfiguration(cfg *appConfig.Config) bool {
+	fmt.Println("🧪 Validating configuration...")
+
+	problems, warnings := opscheck.Validate(cfg)
+	for _, w := range warnings {
+		fmt.Printf("⚠️  %s\n", w)
+	}
+	for _, p := range problems {
+		fmt.Printf("❌ %s\n", p)
+	}
+
+	if len(problems) == 0 {
+		fmt.Printf("✅ Configuration valid (%d warnings)\n", len(warnings))
+		return true
+	}
+	return false
+}
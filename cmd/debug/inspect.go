@@ -0,0 +1,119 @@
+// cmd/debug/inspect.go
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"barber-booking-system/config"
+	"barber-booking-system/internal/clock"
+	appConfig "barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+)
+
+// openDatabase connects to the database or exits
+func openDatabase(cfg *appConfig.Config) *config.DatabaseManager {
+	dbManager, err := config.NewDatabaseManager(cfg.Database)
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+	return dbManager
+}
+
+// listPendingNotifications prints notifications waiting to be sent
+func listPendingNotifications(cfg *appConfig.Config, clk clock.Clock, limit int) {
+	dbManager := openDatabase(cfg)
+	defer dbManager.Close()
+
+	repo := repository.NewNotificationRepository(dbManager.DB)
+	notifications, err := repo.GetPendingNotifications(context.Background(), limit)
+	if err != nil {
+		log.Fatalf("❌ Failed to load pending notifications: %v", err)
+	}
+
+	fmt.Printf("📬 %d pending notification(s)\n", len(notifications))
+	for _, n := range notifications {
+		scheduled := "now"
+		if n.ScheduledFor != nil {
+			scheduled = n.ScheduledFor.Format(time.RFC3339)
+		}
+		fmt.Printf("   #%-6d user=%-6d %-22s %-7s channels=%s scheduled=%s age=%s\n",
+			n.ID, n.UserID, n.Type, n.Priority,
+			strings.Join(n.Channels, ","), scheduled,
+			clk.Now().Sub(n.CreatedAt).Round(time.Second))
+	}
+}
+
+// retryFailedNotification re-queues a failed notification for delivery
+func retryFailedNotification(cfg *appConfig.Config, clk clock.Clock, id int) {
+	dbManager := openDatabase(cfg)
+	defer dbManager.Close()
+
+	notificationService := services.NewNotificationService(
+		repository.NewNotificationRepository(dbManager.DB),
+		repository.NewUserRepository(dbManager.DB),
+		repository.NewBookingRepository(dbManager.DB),
+		nil,
+	)
+	notificationService.SetClock(clk)
+
+	if err := notificationService.RetryNotification(context.Background(), id); err != nil {
+		log.Fatalf("❌ Retry failed: %v", err)
+	}
+	fmt.Printf("✅ Notification #%d re-queued as pending\n", id)
+}
+
+// showBookingStateMachine prints a booking's current state, allowed transitions and history
+func showBookingStateMachine(cfg *appConfig.Config, clk clock.Clock, id int) {
+	dbManager := openDatabase(cfg)
+	defer dbManager.Close()
+
+	ctx := context.Background()
+	repo := repository.NewBookingRepository(dbManager.DB)
+
+	booking, err := repo.FindByID(ctx, id)
+	if err != nil {
+		log.Fatalf("❌ Failed to load booking: %v", err)
+	}
+
+	sm := models.NewBookingStateMachine()
+	transitions, err := sm.GetAllowedTransitions(booking.Status)
+	if err != nil {
+		fmt.Printf("⚠️  %v\n", err)
+	}
+
+	fmt.Printf("📅 Booking #%d (%s)\n", booking.ID, booking.BookingNumber)
+	fmt.Printf("   Scheduled: %s → %s\n",
+		booking.ScheduledStartTime.Format(time.RFC3339),
+		booking.ScheduledEndTime.Format(time.RFC3339))
+	fmt.Printf("   Status:    %s (terminal=%t)\n", booking.Status, sm.IsTerminalState(booking.Status))
+	fmt.Printf("   Payment:   %s\n", booking.PaymentStatus)
+	fmt.Printf("   Upcoming:  %t (as of %s)\n", booking.IsUpcomingAt(clk.Now()), clk.Now().Format(time.RFC3339))
+	if len(transitions) > 0 {
+		fmt.Printf("   Next:      %s\n", strings.Join(transitions, ", "))
+	} else {
+		fmt.Println("   Next:      (none)")
+	}
+
+	history, err := repo.GetHistory(ctx, id)
+	if err != nil {
+		log.Fatalf("❌ Failed to load history: %v", err)
+	}
+
+	fmt.Printf("   History (%d):\n", len(history))
+	for i := len(history) - 1; i >= 0; i-- {
+		h := history[i]
+		by := "system"
+		if h.ChangedBy != nil {
+			by = fmt.Sprintf("user %d", *h.ChangedBy)
+		}
+		fmt.Printf("     %s  %-15s by %-10s %v → %v\n",
+			h.CreatedAt.Format(time.RFC3339), h.ChangeType, by,
+			h.OldValues["status"], h.NewValues["status"])
+	}
+}
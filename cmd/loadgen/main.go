@@ -0,0 +1,119 @@
+// cmd/loadgen/main.go
+//
+// loadgen generates synthetic booking traffic to benchmark conflict checking
+// and listing before a release. It can drive a running instance over HTTP or
+// call the service layer directly against a database.
+//
+//	go run ./cmd/loadgen -mode http -base-url http://localhost:8080 -token $JWT \
+//	    -barbers 1,2,3 -service 4 -bookings 500 -checks 2000 -lists 500 -concurrency 16
+//	go run ./cmd/loadgen -mode db -barbers 1,2,3 -service 4 -bookings 5000
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/loadgen"
+)
+
+func main() {
+	mode := flag.String("mode", "http", "Target: http (running instance) or db (service layer against DATABASE_URL)")
+	baseURL := flag.String("base-url", "http://localhost:8080", "Base URL of the running instance (http mode)")
+	token := flag.String("token", "", "JWT used for authenticated requests (http mode)")
+	email := flag.String("email", "", "Log in with this email when -token is not set (http mode)")
+	password := flag.String("password", "", "Password for -email (http mode)")
+
+	barberList := flag.String("barbers", "", "Comma-separated barber IDs to spread load across (required)")
+	serviceID := flag.Int("service", 0, "Barber service ID to book (required for -bookings)")
+	duration := flag.Int("duration", 30, "Booking duration in minutes")
+	days := flag.Int("days", 14, "Spread start times across this many upcoming days")
+
+	bookings := flag.Int("bookings", 0, "Number of booking creations")
+	checks := flag.Int("checks", 0, "Number of availability checks")
+	lists := flag.Int("lists", 0, "Number of booking list requests")
+	concurrency := flag.Int("concurrency", 8, "Concurrent workers per scenario")
+	seed := flag.Int64("seed", time.Now().UnixNano(), "Random seed for reproducible runs")
+
+	flag.Parse()
+
+	barberIDs, err := parseIDs(*barberList)
+	if err != nil || len(barberIDs) == 0 {
+		fmt.Println("Usage: -barbers is required (e.g. -barbers 1,2,3)")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	if *bookings > 0 && *serviceID == 0 {
+		log.Fatal("-service is required when -bookings > 0")
+	}
+
+	ctx := context.Background()
+
+	var target loadgen.Target
+	switch *mode {
+	case "http":
+		target, err = loadgen.NewHTTPTarget(ctx, *baseURL, *token, *email, *password)
+	case "db":
+		target, err = loadgen.NewDBTarget()
+	default:
+		err = fmt.Errorf("unknown mode %q", *mode)
+	}
+	if err != nil {
+		log.Fatalf("❌ Failed to set up target: %v", err)
+	}
+	defer target.Close()
+
+	gen := loadgen.NewGenerator(*seed, time.Now(), barberIDs, *serviceID, *duration, *days)
+
+	fmt.Printf("🚀 loadgen mode=%s barbers=%v seed=%d concurrency=%d\n", *mode, barberIDs, *seed, *concurrency)
+
+	scenarios := []loadgen.Scenario{
+		{Name: "create_booking", Count: *bookings, Op: func(ctx context.Context) loadgen.Outcome {
+			return target.CreateBooking(ctx, gen.Booking())
+		}},
+		{Name: "check_availability", Count: *checks, Op: func(ctx context.Context) loadgen.Outcome {
+			return target.CheckAvailability(ctx, gen.Slot())
+		}},
+		{Name: "list_bookings", Count: *lists, Op: func(ctx context.Context) loadgen.Outcome {
+			return target.ListBookings(ctx, gen.BarberID())
+		}},
+	}
+
+	failed := false
+	for _, s := range scenarios {
+		if s.Count <= 0 {
+			continue
+		}
+		report := loadgen.Run(ctx, s, *concurrency)
+		report.Print()
+		if report.Errors > 0 {
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// parseIDs parses a comma-separated list of positive integers
+func parseIDs(s string) ([]int, error) {
+	var ids []int
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := strconv.Atoi(part)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid id %q", part)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
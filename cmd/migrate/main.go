@@ -0,0 +1,153 @@
+// cmd/migrate/main.go
+//
+// migrate lints and applies the schema migrations with zero-downtime
+// guardrails (see internal/migrate):
+//
+//	go run ./cmd/migrate lint         # CI: fail on unsafe migrations, no database needed
+//	go run ./cmd/migrate status       # applied version, pending migrations and backfills
+//	go run ./cmd/migrate up           # lint, then apply the pending migrations
+//	go run ./cmd/migrate retry NAME   # queue a failed backfill again
+//
+// up stops before a contract migration whose backfills the background
+// worker has not finished; run it again once they have.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"barber-booking-system/config"
+	appConfig "barber-booking-system/internal/config"
+	"barber-booking-system/internal/migrate"
+	"barber-booking-system/internal/repository"
+)
+
+func main() {
+	dir := flag.String("dir", "migrations", "Migrations directory")
+	all := flag.Bool("all", false, "lint: also lint the migrations up to the baseline")
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: migrate [-dir migrations] lint|status|up|retry NAME")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	opts := migrate.DefaultLintOptions()
+	if *all {
+		opts.Since = 0
+	}
+
+	switch flag.Arg(0) {
+	case "lint":
+		lint(*dir, opts)
+		return
+	case "status", "up", "retry":
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cfg, err := appConfig.Load()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	dbManager, err := config.NewDatabaseManager(cfg.Database)
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+	defer dbManager.Close()
+
+	ctx := context.Background()
+	runner := migrate.NewRunner(dbManager.DB, *dir, opts)
+
+	switch flag.Arg(0) {
+	case "status":
+		status(ctx, runner, repository.NewSchemaBackfillRepository(dbManager.DB))
+	case "up":
+		up(ctx, runner)
+	case "retry":
+		if flag.NArg() != 2 {
+			flag.Usage()
+			os.Exit(2)
+		}
+		if err := repository.NewSchemaBackfillRepository(dbManager.DB).Retry(ctx, flag.Arg(1)); err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		fmt.Printf("🔁 Backfill %s queued again\n", flag.Arg(1))
+	}
+}
+
+// lint checks every migration and exits non-zero on findings
+func lint(dir string, opts migrate.LintOptions) {
+	migrations, findings, err := migrate.Check(dir, opts)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	for _, f := range findings {
+		fmt.Println(f)
+	}
+	if len(findings) > 0 {
+		fmt.Printf("❌ %d unsafe statement(s); fix them or add \"-- migrate:allow RULE REASON\"\n", len(findings))
+		os.Exit(1)
+	}
+	fmt.Printf("✅ %d migrations checked\n", len(migrations))
+}
+
+// status prints the applied version, pending migrations and backfills
+func status(ctx context.Context, runner *migrate.Runner, backfills *repository.SchemaBackfillRepository) {
+	version, dirty, err := runner.Version(ctx)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	state := "clean"
+	if dirty {
+		state = "DIRTY"
+	}
+	fmt.Printf("📦 Schema version %d (%s)\n", version, state)
+
+	if !dirty {
+		pending, err := runner.Pending(ctx)
+		if err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		for _, m := range pending {
+			fmt.Printf("   pending  %s %s\n", m.File(), m.Phase)
+		}
+	}
+
+	list, err := backfills.FindAll(ctx)
+	if err != nil {
+		// The table arrives with migration 000045
+		return
+	}
+	for _, b := range list {
+		fmt.Printf("   backfill %-30s %-9s %5.1f%%  %d rows\n", b.Name, b.Status, b.Progress()*100, b.RowsUpdated)
+		if b.LastError != nil {
+			fmt.Printf("            %s\n", *b.LastError)
+		}
+	}
+}
+
+// up applies the pending migrations
+func up(ctx context.Context, runner *migrate.Runner) {
+	applied, err := runner.Up(ctx)
+	for _, m := range applied {
+		fmt.Printf("✅ Applied %s\n", m.File())
+		for _, name := range m.Backfills {
+			fmt.Printf("   🔄 queued backfill %s\n", name)
+		}
+	}
+
+	var pending *migrate.BackfillPendingError
+	switch {
+	case errors.As(err, &pending):
+		fmt.Printf("⏸️  Stopped: %v\n", err)
+	case err != nil:
+		log.Fatalf("❌ %v", err)
+	case len(applied) == 0:
+		fmt.Println("✅ Already up to date")
+	}
+}
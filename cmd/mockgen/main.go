@@ -0,0 +1,101 @@
+// cmd/mockgen/main.go
+//
+// mockgen generates testify mocks for the exported interfaces of a Go file
+// (see internal/mockgen). Run via `go generate ./internal/repository/...`;
+// use -check in CI to fail when the committed mocks have drifted from the
+// interfaces.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"barber-booking-system/internal/mockgen"
+)
+
+func main() {
+	out := flag.String("o", "", "Output file (\"-\" for stdout)")
+	pkg := flag.String("pkg", "", "Package name of the output file (default: its directory name)")
+	check := flag.Bool("check", false, "Verify the output file is up to date instead of writing it")
+	flag.Parse()
+
+	if flag.NArg() != 1 || *out == "" {
+		log.Fatalf("❌ usage: mockgen -o <output> <source.go>")
+	}
+	source := flag.Arg(0)
+
+	src, err := os.ReadFile(source)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	importPath, err := packageImportPath(filepath.Dir(source))
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	if *pkg == "" {
+		abs, err := filepath.Abs(*out)
+		if err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		*pkg = filepath.Base(filepath.Dir(abs))
+	}
+
+	data, err := mockgen.Generate(filepath.Base(source), src, importPath, *pkg)
+	if err != nil {
+		log.Fatalf("❌ %s: %v", source, err)
+	}
+
+	if *out == "-" {
+		os.Stdout.Write(data)
+		return
+	}
+
+	if *check {
+		existing, err := os.ReadFile(*out)
+		if err != nil || !bytes.Equal(existing, data) {
+			fmt.Printf("❌ %s is out of date; run `go generate ./...`\n", *out)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ %s is up to date\n", *out)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*out), 0o755); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	fmt.Printf("✅ Wrote %s\n", *out)
+}
+
+// packageImportPath derives the import path of dir from the nearest go.mod
+func packageImportPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for root := dir; ; root = filepath.Dir(root) {
+		data, err := os.ReadFile(filepath.Join(root, "go.mod"))
+		if err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if module, ok := strings.CutPrefix(strings.TrimSpace(line), "module "); ok {
+					rel, err := filepath.Rel(root, dir)
+					if err != nil {
+						return "", err
+					}
+					return strings.TrimSuffix(strings.TrimSpace(module)+"/"+filepath.ToSlash(rel), "/."), nil
+				}
+			}
+			return "", fmt.Errorf("no module line in %s", filepath.Join(root, "go.mod"))
+		}
+		if filepath.Dir(root) == root {
+			return "", fmt.Errorf("no go.mod above %s", dir)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// cmd/sdkgen/main.go
+//
+// sdkgen generates the Go and TypeScript client SDKs from docs/swagger.json.
+// Run after `swag init` (or via `go generate ./...`); use -check in CI to fail
+// when the committed SDKs have drifted from the spec.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"barber-booking-system/internal/apispec"
+)
+
+func main() {
+	specPath := flag.String("spec", "docs/swagger.json", "Path to swagger.json")
+	goOut := flag.String("go-out", "sdk/go/barbershop/api.gen.go", "Output file for the Go SDK")
+	goPkg := flag.String("go-package", "barbershop", "Package name for the Go SDK")
+	tsOut := flag.String("ts-out", "sdk/typescript/src/client.gen.ts", "Output file for the TypeScript SDK")
+	check := flag.Bool("check", false, "Verify generated files are up to date instead of writing them")
+	flag.Parse()
+
+	spec, err := apispec.Load(*specPath)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	goSrc, err := spec.GenerateGo(*goPkg)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+
+	outputs := []struct {
+		path string
+		data []byte
+	}{
+		{*goOut, goSrc},
+		{*tsOut, spec.GenerateTypeScript()},
+	}
+
+	stale := false
+	for _, out := range outputs {
+		if *check {
+			existing, err := os.ReadFile(out.path)
+			if err != nil || !bytes.Equal(existing, out.data) {
+				fmt.Printf("❌ %s is out of date; run `go generate ./sdk/...`\n", out.path)
+				stale = true
+				continue
+			}
+			fmt.Printf("✅ %s is up to date\n", out.path)
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(out.path), 0o755); err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		if err := os.WriteFile(out.path, out.data, 0o644); err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		fmt.Printf("✅ Wrote %s\n", out.path)
+	}
+
+	if stale {
+		os.Exit(1)
+	}
+}
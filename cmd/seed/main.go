@@ -0,0 +1,96 @@
+// cmd/seed/main.go
+//
+// seed populates the configured database (DATABASE_URL etc.) with barbers,
+// services, categories, customers, bookings across every status, and
+// reviews for local development and integration tests. The data is
+// generated from -seed, so runs with the same flags match. Seeded users
+// share the password printed at the end; -reset removes them first.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"barber-booking-system/config"
+	"barber-booking-system/internal/clock"
+	appConfig "barber-booking-system/internal/config"
+	"barber-booking-system/internal/seed"
+)
+
+func main() {
+	defaults := seed.DefaultOptions()
+	barbers := flag.Int("barbers", defaults.Barbers, "Number of barbers")
+	customers := flag.Int("customers", defaults.Customers, "Number of customers")
+	bookings := flag.Int("bookings", defaults.BookingsPerBarber, "Bookings per barber")
+	pastDays := flag.Int("past-days", defaults.PastDays, "Spread bookings over this many past days")
+	futureDays := flag.Int("future-days", defaults.FutureDays, "... and this many upcoming days")
+	randomSeed := flag.Int64("seed", defaults.Seed, "Random seed; the same seed generates the same data")
+	reset := flag.Bool("reset", false, "Delete previously seeded users, bookings and reviews first")
+	frozenNow := flag.String("now", "", "Generate bookings around this time (RFC3339 or YYYY-MM-DD) instead of now")
+	flag.Parse()
+
+	cfg, err := appConfig.Load()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+	if cfg.IsProductionLike() {
+		log.Fatalf("❌ Refusing to seed a %s database", cfg.App.Environment)
+	}
+
+	clk, err := clock.ParseFrozen(*frozenNow)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	now := clk.Now()
+
+	dbManager, err := config.NewDatabaseManager(cfg.Database)
+	if err != nil {
+		log.Fatalf("❌ Failed to connect to database: %v", err)
+	}
+	defer dbManager.Close()
+
+	ctx := context.Background()
+	if *reset {
+		deleted, err := seed.Reset(ctx, dbManager.DB)
+		if err != nil {
+			log.Fatalf("❌ Reset failed: %v", err)
+		}
+		fmt.Printf("🧹 Removed %d seeded user(s)\n", deleted)
+	} else if exists, err := seed.Exists(ctx, dbManager.DB); err != nil {
+		log.Fatalf("❌ %v", err)
+	} else if exists {
+		log.Fatalf("❌ Database is already seeded (run with -reset to replace the seeded data)")
+	}
+
+	plan := seed.NewPlan(seed.Options{
+		Barbers:           *barbers,
+		Customers:         *customers,
+		BookingsPerBarber: *bookings,
+		PastDays:          *pastDays,
+		FutureDays:        *futureDays,
+		Seed:              *randomSeed,
+	}, now)
+
+	started := time.Now()
+	summary, err := seed.Run(ctx, dbManager.DB, plan, now)
+	if err != nil {
+		log.Fatalf("❌ Seeding failed: %v", err)
+	}
+
+	fmt.Printf("✅ Seeded in %s\n", time.Since(started).Round(time.Millisecond))
+	fmt.Printf("   %d categories, %d services\n", summary.Categories, summary.Services)
+	fmt.Printf("   %d barbers, %d customers\n", summary.Barbers, summary.Customers)
+	fmt.Printf("   %d bookings, %d reviews\n", summary.Bookings, summary.Reviews)
+	fmt.Println()
+	fmt.Printf("🔑 Every seeded user's password is %q\n", seed.Password)
+	fmt.Printf("   admin:    %s\n", plan.Admin.Email)
+	if len(plan.Barbers) > 0 {
+		fmt.Printf("   barber:   %s\n", plan.Barbers[0].Email)
+	}
+	if len(plan.Customers) > 0 {
+		fmt.Printf("   customer: %s\n", plan.Customers[0].Email)
+	}
+}
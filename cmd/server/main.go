@@ -5,9 +5,16 @@ import (
 	"barber-booking-system/config"
 	_ "barber-booking-system/docs"
 	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/chaos"
 	appConfig "barber-booking-system/internal/config"
+	"barber-booking-system/internal/cron"
 	"barber-booking-system/internal/logger"
 	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/querybudget"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/sqlhook"
+	"barber-booking-system/internal/worker"
 	"context"
 	"fmt"
 	"log"
@@ -79,6 +86,25 @@ func main() {
 	logConfigSummary(cfg)
 	initLogger(cfg)
 
+	// Database hooks: per-request query budget and fault injection (never
+	// in production; see internal/chaos)
+	var dbHooks []sqlhook.Hook
+	if cfg.QueryBudget.Budget > 0 {
+		dbHooks = append(dbHooks, querybudget.Hook)
+	}
+	var chaosFaults *chaos.Faults
+	if cfg.Chaos.Enabled {
+		if cfg.Chaos.Faults != "" {
+			if chaosFaults, err = chaos.Parse(cfg.Chaos.Faults); err != nil {
+				log.Fatalf("❌ Invalid CHAOS_FAULTS: %v", err)
+			}
+		}
+		dbHooks = append(dbHooks, chaos.DatabaseHook)
+	}
+	if len(dbHooks) > 0 {
+		cfg.Database.Driver = sqlhook.RegisterPostgres(dbHooks...)
+	}
+
 	// Initialize database connection
 	dbManager, err := config.NewDatabaseManager(cfg.Database)
 	if err != nil {
@@ -111,6 +137,9 @@ func main() {
 	} else {
 		defer redisClient.Close()
 		log.Println("✅ Redis connection established")
+		if cfg.Chaos.Enabled {
+			redisClient.InjectFaults()
+		}
 		cacheService = cache.NewCacheService(redisClient)
 	}
 
@@ -120,11 +149,27 @@ func main() {
 	// Setup request limits BEFORE other middleware
 	setupRequestLimits(router, cfg)
 
-	// Setup all middleware (including Redis rate limiting if available)
-	setupMiddlewareWithRedis(router, cfg, redisClient)
+	// API usage tracking; its per-consumer quotas feed the rate limiters
+	apiUsageService := services.NewAPIUsageService(
+		repository.NewAPIUsageRepository(dbManager.DB),
+		repository.NewUserRepository(dbManager.DB),
+		cfg.API.RateLimit,
+	)
 
-	// Setup routes (pass cache service)
-	SetupRoutes(router, dbManager.DB, cfg, cacheService)
+	// Setup all middleware (including Redis rate limiting if available)
+	setupMiddlewareWithRedis(router, cfg, redisClient, apiUsageService, chaosFaults)
+
+	// Setup routes (pass cache service); background jobs register on the
+	// worker and the cron scheduler. With Redis, cron ticks are locked so
+	// each runs on one instance only.
+	backgroundWorker := worker.New()
+	var cronLocker cron.Locker
+	if redisClient != nil {
+		cronLocker = cron.NewRedisLocker(redisClient.GetClient())
+	}
+	cronLocation, _ := time.LoadLocation(cfg.Cron.Timezone) // Validated by Load
+	scheduler := cron.New(cronLocker, cronLocation)
+	SetupRoutes(router, dbManager.DB, cfg, cacheService, backgroundWorker, scheduler, apiUsageService)
 
 	// Setup Swagger
 	setupSwagger(router)
@@ -148,6 +193,27 @@ func main() {
 		}
 	}()
 
+	// Start background jobs (notification delivery, win-back campaigns)
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	if cfg.Worker.Enabled {
+		go backgroundWorker.Run(workerCtx)
+		log.Printf("⚙️  Background worker: %v", backgroundWorker.Jobs())
+	} else {
+		log.Printf("⚪ Background worker: Disabled")
+	}
+
+	// Start cron jobs (reminders, cleanup, stats, booking expiry)
+	if cfg.Cron.Enabled {
+		go scheduler.Run(workerCtx)
+		if cronLocker == nil {
+			log.Printf("⏰ Cron jobs (no Redis, not locked across instances): %v", scheduler.Jobs())
+		} else {
+			log.Printf("⏰ Cron jobs: %v", scheduler.Jobs())
+		}
+	} else {
+		log.Printf("⚪ Cron jobs: Disabled")
+	}
+
 	// Graceful shutdown
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -162,9 +228,36 @@ func main() {
 		log.Fatal("❌ Server forced to shutdown:", err)
 	}
 
+	// Stop starting background jobs, then let in-flight runs finish within
+	// the same deadline and flush the outbox
+	log.Printf("⏳ Draining background jobs: %v", append(backgroundWorker.Running(), scheduler.Running()...))
+	stopWorker()
+	reportDrain("Background worker", backgroundWorker.Shutdown(ctx))
+	reportDrain("Cron jobs", scheduler.Shutdown(ctx))
+
+	// Write API usage counted since the last flush
+	if err := apiUsageService.Flush(ctx); err != nil {
+		log.Printf("⚠️  Failed to flush API usage: %v", err)
+	}
+
 	log.Println("✅ Server exited gracefully")
 }
 
+// reportDrain logs how a background component shut down
+func reportDrain(component string, status worker.DrainStatus) {
+	if status.Drained {
+		log.Printf("✅ %s drained in %s", component, status.Duration.Round(time.Millisecond))
+	} else {
+		log.Printf("⚠️  %s did not drain in time; aborted: %v", component, status.Interrupted)
+	}
+	if len(status.Flushed) > 0 {
+		log.Printf("📤 %s flushed: %v", component, status.Flushed)
+	}
+	if len(status.Unflushed) > 0 {
+		log.Printf("⚠️  %s could not flush before the deadline: %v", component, status.Unflushed)
+	}
+}
+
 // setupSwagger configures Swagger documentation route
 func setupSwagger(router *gin.Engine) {
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
@@ -175,6 +268,10 @@ func setupSwagger(router *gin.Engine) {
 func setupRouter(cfg *appConfig.Config, dbManager *config.DatabaseManager) *gin.Engine {
 	gin.SetMode(cfg.Server.GinMode)
 	router := gin.New()
+	// Forwarded client addresses are only believed from configured proxies
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		log.Fatalf("❌ Invalid TRUSTED_PROXIES: %v", err)
+	}
 	router.GET("/health", config.CreateHealthCheckHandler(dbManager))
 	return router
 }
@@ -188,11 +285,13 @@ func setupRequestLimits(router *gin.Engine, cfg *appConfig.Config) {
 }
 
 // setupMiddlewareWithRedis configures all middleware with optional Redis support
-func setupMiddlewareWithRedis(router *gin.Engine, cfg *appConfig.Config, redisClient *cache.RedisClient) {
+func setupMiddlewareWithRedis(router *gin.Engine, cfg *appConfig.Config, redisClient *cache.RedisClient, quotas middleware.QuotaLookup, chaosFaults *chaos.Faults) {
 	middleware.SetupRequestLimits(router, cfg.Upload.MaxFileSize)
 	middleware.SetupAll(router, middleware.SetupConfig{
 		Config:      cfg,
 		RedisClient: redisClient,
+		Quotas:      quotas,
+		ChaosFaults: chaosFaults,
 	})
 }
 
@@ -220,6 +319,7 @@ func logConfigSummary(cfg *appConfig.Config) {
 	log.Printf("   JWT Expiration: %v", cfg.JWT.Expiration)
 	log.Printf("   Rate Limit: %d req/min", cfg.API.RateLimit)
 	log.Printf("   Upload Max Size: %.2f MB", float64(cfg.Upload.MaxFileSize)/(1024*1024))
+	log.Printf("   API Body Limit: %.2f MB", float64(cfg.API.MaxBodySize)/(1024*1024))
 	log.Printf("   CORS Origins: %v", cfg.CORS.AllowedOrigins)
 
 	if cfg.IsDevelopment() {
@@ -2,18 +2,137 @@
 package main
 
 import (
+	"errors"
+	"log"
+
+	"barber-booking-system/internal/breaker"
 	"barber-booking-system/internal/cache"
 	appConfig "barber-booking-system/internal/config"
+	"barber-booking-system/internal/cron"
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/oauth"
+	"barber-booking-system/internal/payments"
+	"barber-booking-system/internal/push"
 	"barber-booking-system/internal/routes"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/sms"
+	"barber-booking-system/internal/storage"
+	"barber-booking-system/internal/worker"
 
 	"github.com/gin-gonic/gin"
 	"github.com/jmoiron/sqlx"
 )
 
-// SetupRoutes configures all application routes
-func SetupRoutes(router *gin.Engine, db *sqlx.DB, cfg *appConfig.Config, cacheService *cache.CacheService) {
+// SetupRoutes configures all application routes and registers background jobs on w
+func SetupRoutes(router *gin.Engine, db *sqlx.DB, cfg *appConfig.Config, cacheService *cache.CacheService, w *worker.Worker, scheduler *cron.Scheduler, apiUsageService *services.APIUsageService) {
+	// Admin network restrictions (IP allow/deny lists, country blocking)
+	adminIPFilter, err := middleware.AdminIPFilter(cfg.AdminSecurity, cfg.Server.TrustedProxies)
+	if err != nil {
+		log.Fatalf("❌ Invalid admin security configuration: %v", err)
+	}
+
+	// External providers are guarded by circuit breakers, whose state the
+	// status page reports
+	newBreaker := func(name string, isFailure func(error) bool) *breaker.Breaker {
+		return breaker.New(name, breaker.Settings{
+			FailureThreshold: cfg.Status.BreakerFailureThreshold,
+			OpenTimeout:      cfg.Status.BreakerOpenTimeout,
+			IsFailure:        isFailure,
+		})
+	}
+	var statusOptions []routes.Option
+
+	// Paid checkout is only available when Stripe is configured
+	var paymentGateway payments.Gateway
+	if stripe, err := payments.NewStripeGateway(cfg.Stripe); err == nil {
+		b := newBreaker(stripe.Name(), payments.IsProviderFailure)
+		paymentGateway = payments.WithBreaker(stripe, b)
+		statusOptions = append(statusOptions, routes.WithCircuitBreaker(appConfig.StatusComponentPayments, b))
+	}
+
+	// SMS confirmations and reminders are only sent when Twilio is configured
+	var smsSender sms.Sender
+	if twilio, err := sms.NewTwilioSender(cfg.Twilio); err == nil {
+		b := newBreaker(twilio.Name(), sms.IsProviderFailure)
+		smsSender = sms.WithBreaker(twilio, b)
+		statusOptions = append(statusOptions, routes.WithCircuitBreaker(appConfig.StatusComponentNotifications, b))
+	}
+
+	// Push notifications go through FCM (Android, web) and APNs (iOS) when configured
+	pushDispatcher := push.NewDispatcher(cfg.Push.MaxAttempts)
+	fcm, err := push.NewFCMSender(cfg.Push.FCM)
+	if err != nil && !errors.Is(err, push.ErrNotConfigured) {
+		log.Fatalf("❌ Invalid FCM configuration: %v", err)
+	}
+	if fcm != nil {
+		b := newBreaker(fcm.Name(), push.IsProviderFailure)
+		guarded := push.WithBreaker(fcm, b)
+		pushDispatcher.Register(appConfig.DevicePlatformAndroid, guarded)
+		pushDispatcher.Register(appConfig.DevicePlatformWeb, guarded)
+		statusOptions = append(statusOptions, routes.WithCircuitBreaker(appConfig.StatusComponentNotifications, b))
+	}
+	apns, err := push.NewAPNsSender(cfg.Push.APNs)
+	if err != nil && !errors.Is(err, push.ErrNotConfigured) {
+		log.Fatalf("❌ Invalid APNs configuration: %v", err)
+	}
+	if apns != nil {
+		b := newBreaker(apns.Name(), push.IsProviderFailure)
+		pushDispatcher.Register(appConfig.DevicePlatformIOS, push.WithBreaker(apns, b))
+		statusOptions = append(statusOptions, routes.WithCircuitBreaker(appConfig.StatusComponentNotifications, b))
+	}
+
+	// Uploaded files go to local disk or S3, as configured
+	uploadStorage, err := storage.New(cfg.Upload)
+	if err != nil {
+		log.Fatalf("❌ Invalid upload storage configuration: %v", err)
+	}
+
+	// Social sign-in is offered for each provider with client IDs
+	var oauthVerifiers []oauth.Verifier
+	if google, err := oauth.NewGoogleVerifier(cfg.OAuth.Google); err == nil {
+		oauthVerifiers = append(oauthVerifiers, google)
+	}
+	if apple, err := oauth.NewAppleVerifier(cfg.OAuth.Apple); err == nil {
+		oauthVerifiers = append(oauthVerifiers, apple)
+	}
+
 	// Pass cache service to routes setup
-	routes.Setup(router, db, cfg.JWT.Secret, cfg.JWT.Expiration, cacheService)
+	options := []routes.Option{
+		routes.WithAdminMiddleware(adminIPFilter),
+		routes.WithBodyLimits(cfg.API.MaxBodySize, cfg.Upload.MaxFileSize, cfg.Upload.MaxMultipartParts),
+		routes.WithStatusHooks(cfg.BookingHooks.StatusHooks),
+		routes.WithPaymentGateway(paymentGateway),
+		routes.WithUploadStorage(uploadStorage),
+		routes.WithCancellationPolicy(cfg.Cancellation),
+		routes.WithPriceAdjustment(cfg.PriceAdjustment),
+		routes.WithAssignment(cfg.Assignment),
+		routes.WithSMSSender(smsSender, cfg.Twilio.StatusCallbackBaseURL),
+		routes.WithPushDispatcher(pushDispatcher),
+		routes.WithNPS(cfg.NPS),
+		routes.WithReviewRequests(cfg.ReviewRequests),
+		routes.WithWinBack(cfg.WinBack),
+		routes.WithNoShowRisk(cfg.NoShowRisk),
+		routes.WithActionLinks(cfg.ActionLinks),
+		routes.WithPendingExpiry(cfg.PendingExpiry),
+		routes.WithOffboarding(cfg.Offboarding),
+		routes.WithAutoNoShow(cfg.AutoNoShow),
+		routes.WithClientImport(cfg.ClientImport),
+		routes.WithWebhooks(cfg.Webhooks),
+		routes.WithFeatured(cfg.Featured),
+		routes.WithRanking(cfg.Ranking),
+		routes.WithReviewScreening(cfg.ReviewScreening),
+		routes.WithPagination(cfg.Pagination),
+		routes.WithLocalization(cfg.Localization),
+		routes.WithQuietHours(cfg.QuietHours),
+		routes.WithSandbox(cfg.API.SandboxEnabled),
+		routes.WithRefreshTokenExpiration(cfg.JWT.RefreshExpiration),
+		routes.WithOAuthProviders(oauthVerifiers...),
+		routes.WithAPIUsage(apiUsageService),
+		routes.WithWorker(w, cfg.Worker),
+		routes.WithScheduler(scheduler, cfg.Cron),
+		routes.WithStatus(cfg.Status),
+	}
+	routes.Setup(router, db, cfg.JWT.Secret, cfg.JWT.Expiration, cacheService, append(options, statusOptions...)...)
 }
 
 // NOTE: Keep all other existing functions (setupMiddlewareWithRedis, getLogFormat, etc.) unchanged
@@ -0,0 +1,42 @@
+// cmd/specgen/main.go
+//
+// specgen regenerates docs/ (docs.go, swagger.json, swagger.yaml) from the
+// handler annotations, exactly as `swag init -g cmd/server/main.go` would,
+// without needing the swag CLI installed. Use -check in CI to fail when a
+// handler's annotations changed without regenerating the spec.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"barber-booking-system/internal/apispec/docgen"
+)
+
+func main() {
+	root := flag.String("root", ".", "Project root")
+	check := flag.Bool("check", false, "Verify docs/ is up to date instead of writing it")
+	flag.Parse()
+
+	if !*check {
+		if err := docgen.Generate(*root, *root+"/docs"); err != nil {
+			log.Fatalf("❌ %v", err)
+		}
+		fmt.Println("✅ Wrote docs/; run `go generate ./sdk/...` to regenerate the SDKs")
+		return
+	}
+
+	stale, err := docgen.Stale(*root)
+	if err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	for _, path := range stale {
+		fmt.Printf("❌ %s is out of date; run `go run ./cmd/specgen`\n", path)
+	}
+	if len(stale) > 0 {
+		os.Exit(1)
+	}
+	fmt.Println("✅ docs/ is up to date")
+}
@@ -0,0 +1,88 @@
+// cmd/statediagram/main.go
+//
+// statediagram renders the booking status machine (internal/statemachine) as
+// Mermaid and Graphviz diagrams. Run via `go generate ./internal/statemachine/...`;
+// use -check in CI to fail when the committed diagram has drifted from the code.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"barber-booking-system/internal/statemachine"
+)
+
+func main() {
+	out := flag.String("o", "docs/booking_state_machine.md", "Output markdown file (\"-\" for stdout)")
+	format := flag.String("format", "markdown", "Output format: markdown, mermaid, or dot")
+	check := flag.Bool("check", false, "Verify the output file is up to date instead of writing it")
+	flag.Parse()
+
+	machine := statemachine.NewBooking[any]()
+
+	var data []byte
+	switch *format {
+	case "markdown":
+		data = []byte(render(machine))
+	case "mermaid":
+		data = []byte(machine.Mermaid())
+	case "dot":
+		data = []byte(machine.DOT())
+	default:
+		log.Fatalf("❌ unknown format %q (use markdown, mermaid, or dot)", *format)
+	}
+
+	if *out == "-" {
+		os.Stdout.Write(data)
+		return
+	}
+
+	if *check {
+		existing, err := os.ReadFile(*out)
+		if err != nil || !bytes.Equal(existing, data) {
+			fmt.Printf("❌ %s is out of date; run `go generate ./internal/statemachine/...`\n", *out)
+			os.Exit(1)
+		}
+		fmt.Printf("✅ %s is up to date\n", *out)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(*out), 0o755); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		log.Fatalf("❌ %v", err)
+	}
+	fmt.Printf("✅ Wrote %s\n", *out)
+}
+
+// render builds the markdown document with both diagram formats
+func render(machine *statemachine.Machine[any]) string {
+	var b bytes.Buffer
+	b.WriteString("<!-- Code generated by cmd/statediagram. DO NOT EDIT. -->\n\n")
+	b.WriteString("# Booking Status Machine\n\n")
+	b.WriteString("Generated from `internal/statemachine/booking.go`. Terminal states allow no further transitions.\n\n")
+	b.WriteString("```mermaid\n")
+	b.WriteString(machine.Mermaid())
+	b.WriteString("```\n\n")
+	b.WriteString("## Transitions\n\n")
+	b.WriteString("| From | Allowed next states |\n|------|---------------------|\n")
+	for _, state := range machine.States() {
+		next, _ := machine.Allowed(state)
+		cell := "_terminal_"
+		if len(next) > 0 {
+			cell = "`" + strings.Join(next, "`, `") + "`"
+		}
+		fmt.Fprintf(&b, "| `%s` | %s |\n", state, cell)
+	}
+	b.WriteString("\n## Graphviz\n\n")
+	b.WriteString("```dot\n")
+	b.WriteString(machine.DOT())
+	b.WriteString("```\n")
+	return b.String()
+}
@@ -24,8 +24,13 @@ func NewDatabaseManager(config appConfig.DatabaseConfig) (*DatabaseManager, erro
 		return nil, fmt.Errorf("database URL is required")
 	}
 
+	driverName := config.Driver
+	if driverName == "" {
+		driverName = "postgres"
+	}
+
 	// Connect to database
-	db, err := sqlx.Connect("postgres", config.URL)
+	db, err := sqlx.Connect(driverName, config.URL)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
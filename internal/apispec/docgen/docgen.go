@@ -0,0 +1,88 @@
+// internal/apispec/docgen/docgen.go
+package docgen
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/swaggo/swag"
+	"github.com/swaggo/swag/gen"
+)
+
+// ========================================================================
+// DOC GENERATOR - docs/ from the handler annotations
+// ========================================================================
+// Generate is `swag init -g cmd/server/main.go` run in-process, so the spec
+// can be regenerated and checked without installing the swag CLI. It lives
+// apart from apispec so the server does not link swag's parser.
+// ========================================================================
+
+// MainAPIFile holds the general API annotations, relative to the project root
+const MainAPIFile = "cmd/server/main.go"
+
+// Files are the files Generate writes, relative to its output directory
+var Files = []string{"docs.go", "swagger.json", "swagger.yaml"}
+
+// Generate parses the annotations under root and writes Files to outDir
+func Generate(root, outDir string) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	outDir, err = filepath.Abs(outDir)
+	if err != nil {
+		return err
+	}
+	// swag resolves the search dir and go list against the working directory
+	if err := os.Chdir(root); err != nil {
+		return err
+	}
+	defer os.Chdir(wd)
+
+	return gen.New().Build(&gen.Config{
+		SearchDir:          "./",
+		MainAPIFile:        MainAPIFile,
+		PropNamingStrategy: swag.CamelCase,
+		OutputDir:          outDir,
+		OutputTypes:        []string{"go", "json", "yaml"},
+		ParseDepth:         100,
+		OverridesFile:      gen.DefaultOverridesFile,
+		ParseGoList:        true,
+		LeftTemplateDelim:  "{{",
+		RightTemplateDelim: "}}",
+		CollectionFormat:   "csv",
+		PackageName:        "docs",
+		Debugger:           log.New(io.Discard, "", 0),
+	})
+}
+
+// Stale regenerates the docs into a temporary directory and returns the
+// committed files under root/docs that differ from the annotations
+func Stale(root string) ([]string, error) {
+	tmp, err := os.MkdirTemp("", "docgen")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := Generate(root, tmp); err != nil {
+		return nil, fmt.Errorf("failed to generate docs: %w", err)
+	}
+
+	var stale []string
+	for _, name := range Files {
+		fresh, err := os.ReadFile(filepath.Join(tmp, name))
+		if err != nil {
+			return nil, err
+		}
+		committed, err := os.ReadFile(filepath.Join(root, "docs", name))
+		if err != nil || !bytes.Equal(committed, fresh) {
+			stale = append(stale, filepath.Join("docs", name))
+		}
+	}
+	return stale, nil
+}
@@ -0,0 +1,251 @@
+// internal/apispec/gogen.go
+package apispec
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+)
+
+// ========================================================================
+// GO SDK GENERATOR
+// ========================================================================
+
+// GenerateGo renders typed Go models and client methods for every operation.
+// The output relies on the hand-written runtime (Client, do, expandPath) in the
+// same package.
+func (s *Spec) GenerateGo(pkg string) ([]byte, error) {
+	g := &goGenerator{spec: s, names: s.typeNames()}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by cmd/sdkgen from docs/swagger.json. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	b.WriteString("import (\n\t\"context\"\n\t\"encoding/json\"\n\t\"fmt\"\n\t\"net/url\"\n)\n\n")
+	b.WriteString("var (\n\t_ = json.RawMessage{}\n\t_ = fmt.Sprint\n\t_ url.Values\n)\n\n")
+
+	b.WriteString("// ============================================\n// Models\n// ============================================\n\n")
+	for _, key := range s.sortedDefinitions() {
+		g.writeModel(&b, key, s.Definitions[key])
+	}
+
+	b.WriteString("// ============================================\n// Operations\n// ============================================\n\n")
+	for _, op := range s.sdkOperations() {
+		g.writeOperation(&b, op)
+	}
+
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated Go SDK does not compile: %w", err)
+	}
+	return out, nil
+}
+
+type goGenerator struct {
+	spec  *Spec
+	names map[string]string
+}
+
+// goType maps a schema to a Go type; optional scalars and refs become pointers
+func (g *goGenerator) goType(schema *Schema, required bool) string {
+	if schema == nil {
+		return "json.RawMessage"
+	}
+	ptr := ""
+	if !required {
+		ptr = "*"
+	}
+
+	if schema.Ref != "" {
+		name, ok := g.names[schema.RefName()]
+		if !ok {
+			return "json.RawMessage"
+		}
+		if def := g.spec.Definitions[schema.RefName()]; def != nil && def.Type == "object" && len(def.Properties) == 0 {
+			return name // map-backed types are already nil-able
+		}
+		return ptr + name
+	}
+
+	switch schema.Type {
+	case "string":
+		return ptr + "string"
+	case "integer":
+		if schema.Format == "int64" {
+			return ptr + "int64"
+		}
+		return ptr + "int"
+	case "number":
+		return ptr + "float64"
+	case "boolean":
+		return ptr + "bool"
+	case "array":
+		return "[]" + g.goType(schema.Items, true)
+	case "object":
+		return "map[string]interface{}"
+	default:
+		return "json.RawMessage"
+	}
+}
+
+// writeModel renders a definition as a struct or named map type
+func (g *goGenerator) writeModel(b *bytes.Buffer, key string, schema *Schema) {
+	name := g.names[key]
+	if schema.Description != "" {
+		b.WriteString(commentLines("// ", name+" "+schema.Description))
+	} else {
+		fmt.Fprintf(b, "// %s mirrors %s\n", name, key)
+	}
+
+	if schema.Type == "object" && len(schema.Properties) == 0 {
+		fmt.Fprintf(b, "type %s map[string]interface{}\n\n", name)
+		return
+	}
+
+	fmt.Fprintf(b, "type %s struct {\n", name)
+	for _, prop := range sortedProperties(schema) {
+		p := schema.Properties[prop]
+		required := schema.IsRequired(prop)
+		tag := prop
+		if !required {
+			tag += ",omitempty"
+		}
+		if p.Description != "" {
+			b.WriteString(commentLines("\t// ", p.Description))
+		}
+		fmt.Fprintf(b, "\t%s %s `json:\"%s\"`\n", pascalCase(prop), g.goType(p, required), tag)
+	}
+	b.WriteString("}\n\n")
+}
+
+// paramType maps a path/query parameter to a Go type
+func (g *goGenerator) paramType(p Parameter) string {
+	if p.Type == "array" {
+		return "[]" + g.goType(p.Items, true)
+	}
+	return g.goType(&Schema{Type: p.Type, Format: p.Format}, true)
+}
+
+// writeOperation renders a params struct (if needed) and the client method
+func (g *goGenerator) writeOperation(b *bytes.Buffer, op sdkOperation) {
+	paramsType := ""
+	if len(op.QueryParams) > 0 {
+		paramsType = op.Name + "Params"
+		g.writeParams(b, paramsType, op.QueryParams)
+	}
+
+	args := []string{"ctx context.Context"}
+	for _, p := range op.PathParams {
+		args = append(args, fmt.Sprintf("%s %s", goParamName(p.Name), g.paramType(p)))
+	}
+	if op.Body != nil {
+		args = append(args, "body "+strings.TrimPrefix(g.goType(op.Body.Schema, true), "*"))
+	}
+	if paramsType != "" {
+		args = append(args, "params *"+paramsType)
+	}
+
+	resultType := ""
+	switch op.Result {
+	case resultEnvelope, resultDirect:
+		resultType = strings.TrimPrefix(g.goType(op.Schema, true), "*")
+	}
+
+	summary := op.Summary
+	if summary == "" {
+		summary = op.Method + " " + op.Path
+	}
+	b.WriteString(commentLines("// ", op.Name+" - "+summary))
+	b.WriteString("//\n")
+	fmt.Fprintf(b, "// %s %s\n", op.Method, op.Path)
+	if op.Auth {
+		b.WriteString("// Requires authentication.\n")
+	}
+
+	// Untyped results are returned as raw JSON rather than a pointer to it
+	resultRef, outRef := "*"+resultType, "&out"
+	if strings.HasPrefix(resultType, "json.RawMessage") || strings.HasPrefix(resultType, "[]") {
+		resultRef, outRef = resultType, "out"
+	}
+
+	if resultType != "" {
+		fmt.Fprintf(b, "func (c *Client) %s(%s) (%s, error) {\n", op.Name, strings.Join(args, ", "), resultRef)
+	} else {
+		fmt.Fprintf(b, "func (c *Client) %s(%s) error {\n", op.Name, strings.Join(args, ", "))
+	}
+
+	pathExpr := fmt.Sprintf("%q", op.Path)
+	if len(op.PathParams) > 0 {
+		kv := []string{pathExpr}
+		for _, p := range op.PathParams {
+			kv = append(kv, fmt.Sprintf("%q, %s", p.Name, goParamName(p.Name)))
+		}
+		pathExpr = "expandPath(" + strings.Join(kv, ", ") + ")"
+	}
+
+	query := "nil"
+	if paramsType != "" {
+		query = "params.values()"
+	}
+	body := "nil"
+	if op.Body != nil {
+		body = "body"
+	}
+	enveloped := op.Result == resultEnvelope
+
+	if resultType != "" {
+		fmt.Fprintf(b, "\tvar out %s\n", resultType)
+		fmt.Fprintf(b, "\tif err := c.do(ctx, %q, %s, %s, %s, %t, &out); err != nil {\n\t\treturn nil, err\n\t}\n", op.Method, pathExpr, query, body, enveloped)
+		fmt.Fprintf(b, "\treturn %s, nil\n}\n\n", outRef)
+	} else {
+		fmt.Fprintf(b, "\treturn c.do(ctx, %q, %s, %s, %s, false, nil)\n}\n\n", op.Method, pathExpr, query, body)
+	}
+}
+
+// writeParams renders a query parameter struct and its encoder
+func (g *goGenerator) writeParams(b *bytes.Buffer, name string, params []Parameter) {
+	fmt.Fprintf(b, "// %s holds the query parameters for %s\n", name, strings.TrimSuffix(name, "Params"))
+	fmt.Fprintf(b, "type %s struct {\n", name)
+	for _, p := range params {
+		if p.Description != "" {
+			b.WriteString(commentLines("\t// ", p.Description))
+		}
+		typ := g.paramType(p)
+		if !strings.HasPrefix(typ, "[]") && !p.Required {
+			typ = "*" + typ
+		}
+		fmt.Fprintf(b, "\t%s %s\n", pascalCase(p.Name), typ)
+	}
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(b, "func (p *%s) values() url.Values {\n\tq := url.Values{}\n\tif p == nil {\n\t\treturn q\n\t}\n", name)
+	for _, p := range params {
+		field := "p." + pascalCase(p.Name)
+		switch {
+		case p.Type == "array":
+			fmt.Fprintf(b, "\tfor _, v := range %s {\n\t\tq.Add(%q, fmt.Sprint(v))\n\t}\n", field, p.Name)
+		case p.Required:
+			fmt.Fprintf(b, "\tq.Set(%q, fmt.Sprint(%s))\n", p.Name, field)
+		default:
+			fmt.Fprintf(b, "\tif %s != nil {\n\t\tq.Set(%q, fmt.Sprint(*%s))\n\t}\n", field, p.Name, field)
+		}
+	}
+	b.WriteString("\treturn q\n}\n\n")
+}
+
+// goParamName converts a parameter name to an unexported Go identifier
+func goParamName(name string) string {
+	ws := words(name)
+	if len(ws) == 0 {
+		return "param"
+	}
+	id := ws[0]
+	for _, w := range ws[1:] {
+		id += pascalCase(w)
+	}
+	switch id {
+	case "type", "func", "range", "map", "var", "default", "select", "go", "chan":
+		return id + "Param"
+	}
+	return id
+}
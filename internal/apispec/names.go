@@ -0,0 +1,172 @@
+// internal/apispec/names.go
+package apispec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// initialisms are upper-cased when they form a whole word of a Go identifier
+var initialisms = map[string]string{
+	"id": "ID", "uuid": "UUID", "url": "URL", "api": "API", "ip": "IP",
+	"json": "JSON", "http": "HTTP", "sms": "SMS", "utm": "UTM", "jwt": "JWT",
+}
+
+// words splits an identifier, summary or snake_case name into lower-case words
+func words(s string) []string {
+	s = strings.ReplaceAll(s, "'", "")
+	var out []string
+	var current []rune
+	flush := func() {
+		if len(current) > 0 {
+			out = append(out, strings.ToLower(string(current)))
+			current = current[:0]
+		}
+	}
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case !unicode.IsLetter(r) && !unicode.IsDigit(r):
+			flush()
+		case unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) ||
+			(unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1]))):
+			flush()
+			current = append(current, r)
+		default:
+			current = append(current, r)
+		}
+	}
+	flush()
+	return out
+}
+
+// pascalCase converts a name to an exported Go identifier
+func pascalCase(s string) string {
+	var b strings.Builder
+	for _, w := range words(s) {
+		if up, ok := initialisms[w]; ok {
+			b.WriteString(up)
+			continue
+		}
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	name := b.String()
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		name = "X" + name
+	}
+	return name
+}
+
+// camelCase converts a name to a TypeScript-style identifier
+func camelCase(s string) string {
+	ws := words(s)
+	if len(ws) == 0 {
+		return "x"
+	}
+	var b strings.Builder
+	b.WriteString(ws[0])
+	for _, w := range ws[1:] {
+		b.WriteString(strings.ToUpper(w[:1]) + w[1:])
+	}
+	return b.String()
+}
+
+// typeNames maps definition keys (e.g. services.AuthResponse) to SDK type names,
+// dropping the Go package prefix unless two definitions would collide
+func (s *Spec) typeNames() map[string]string {
+	byShort := map[string][]string{}
+	for key := range s.Definitions {
+		short := key[strings.LastIndex(key, ".")+1:]
+		byShort[short] = append(byShort[short], key)
+	}
+
+	names := make(map[string]string, len(s.Definitions))
+	for short, keys := range byShort {
+		for _, key := range keys {
+			if len(keys) == 1 {
+				names[key] = pascalCase(short)
+			} else {
+				names[key] = pascalCase(strings.ReplaceAll(key, ".", "_"))
+			}
+		}
+	}
+	return names
+}
+
+// sortedDefinitions returns definition keys in stable order
+func (s *Spec) sortedDefinitions() []string {
+	keys := make([]string, 0, len(s.Definitions))
+	for key := range s.Definitions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// operationNames assigns each operation a unique method name, preferring
+// operationId (swag @ID) and falling back to the summary
+func (s *Spec) operationNames(ops []OperationRef) map[*Operation]string {
+	names := make(map[*Operation]string, len(ops))
+	used := map[string]int{}
+	for _, op := range ops {
+		base := op.OperationID
+		if base == "" {
+			base = op.Summary
+		}
+		if base == "" {
+			base = op.Method + " " + op.Path
+		}
+		name := pascalCase(stripArticles(base))
+		used[name]++
+		if used[name] > 1 {
+			name = fmt.Sprintf("%s%d", name, used[name])
+		}
+		names[op.Operation] = name
+	}
+	return names
+}
+
+// sortedProperties returns property names in stable order
+func sortedProperties(schema *Schema) []string {
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// pathParams returns the {param} names in a path template, in order
+func pathParams(template string) []string {
+	var params []string
+	for _, seg := range strings.Split(template, "/") {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			params = append(params, seg[1:len(seg)-1])
+		}
+	}
+	return params
+}
+
+// commentLines prefixes each line of text for a Go or TS comment
+func commentLines(prefix, text string) string {
+	var b strings.Builder
+	for _, line := range strings.Split(strings.TrimSpace(text), "\n") {
+		b.WriteString(strings.TrimRight(prefix+line, " ") + "\n")
+	}
+	return b.String()
+}
+
+// stripArticles drops "a", "an" and "the" so summaries make concise method names
+func stripArticles(s string) string {
+	var kept []string
+	for _, w := range strings.Fields(s) {
+		switch strings.ToLower(w) {
+		case "a", "an", "the":
+			continue
+		}
+		kept = append(kept, w)
+	}
+	return strings.Join(kept, " ")
+}
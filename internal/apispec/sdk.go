@@ -0,0 +1,104 @@
+// internal/apispec/sdk.go
+package apispec
+
+// ========================================================================
+// SDK MODEL - Language-neutral view of operations shared by the generators
+// ========================================================================
+
+// resultKind describes how a successful response body is decoded
+type resultKind int
+
+const (
+	resultNone     resultKind = iota // No documented body
+	resultEnvelope                   // {"success", "data", ...} wrapper; Schema describes data
+	resultDirect                     // Body is Schema itself
+)
+
+// sdkOperation is an operation prepared for code generation
+type sdkOperation struct {
+	Name        string
+	Method      string
+	Path        string
+	Summary     string
+	Description string
+	Auth        bool
+	PathParams  []Parameter
+	QueryParams []Parameter
+	Body        *Parameter
+	Result      resultKind
+	Schema      *Schema // Data schema for envelope results, body schema for direct results
+}
+
+// sdkOperations prepares all operations for code generation
+func (s *Spec) sdkOperations() []sdkOperation {
+	ops := s.Operations()
+	names := s.operationNames(ops)
+
+	out := make([]sdkOperation, 0, len(ops))
+	for _, op := range ops {
+		sop := sdkOperation{
+			Name:        names[op.Operation],
+			Method:      op.Method,
+			Path:        op.Path,
+			Summary:     op.Summary,
+			Description: op.Description,
+			Auth:        len(op.Security) > 0,
+		}
+
+		params := map[string]Parameter{}
+		for i, p := range op.Parameters {
+			switch p.In {
+			case "path":
+				params[p.Name] = p
+			case "query":
+				sop.QueryParams = append(sop.QueryParams, p)
+			case "body":
+				sop.Body = &op.Parameters[i]
+			}
+		}
+		// Order path params as they appear in the template; undocumented ones default to string
+		for _, name := range pathParams(op.Path) {
+			p, ok := params[name]
+			if !ok {
+				p = Parameter{Name: name, In: "path", Type: "string", Required: true}
+			}
+			sop.PathParams = append(sop.PathParams, p)
+		}
+
+		sop.Result, sop.Schema = s.resultShape(op.Operation)
+		out = append(out, sop)
+	}
+	return out
+}
+
+// resultShape classifies the success response of an operation
+func (s *Spec) resultShape(op *Operation) (resultKind, *Schema) {
+	_, resp := op.SuccessResponse()
+	if resp == nil || resp.Schema == nil {
+		return resultNone, nil
+	}
+	schema := resp.Schema
+
+	// swag renders `SuccessResponse{data=T}` as allOf[envelope, {data: T}]
+	if len(schema.AllOf) > 0 {
+		var data *Schema
+		for _, part := range schema.AllOf {
+			if d, ok := part.Properties["data"]; ok {
+				data = d
+			}
+		}
+		return resultEnvelope, data
+	}
+
+	if resolved, err := s.Resolve(schema); err == nil && s.isEnvelope(resolved) {
+		return resultEnvelope, nil
+	}
+	return resultDirect, schema
+}
+
+// isEnvelope reports whether a schema is the standard success wrapper
+func (s *Spec) isEnvelope(schema *Schema) bool {
+	_, hasSuccess := schema.Properties["success"]
+	_, hasData := schema.Properties["data"]
+	return hasSuccess && hasData
+}
@@ -0,0 +1,232 @@
+// internal/apispec/spec.go
+package apispec
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// ========================================================================
+// SWAGGER 2.0 MODEL - The subset of the spec emitted by swag that the SDK
+// generators and contract validator rely on
+// ========================================================================
+
+// Spec is a parsed Swagger 2.0 document
+type Spec struct {
+	Swagger             string                    `json:"swagger"`
+	Info                Info                      `json:"info"`
+	Host                string                    `json:"host"`
+	BasePath            string                    `json:"basePath"`
+	Paths               map[string]PathItem       `json:"paths"`
+	Definitions         map[string]*Schema        `json:"definitions"`
+	SecurityDefinitions map[string]SecurityScheme `json:"securityDefinitions"`
+}
+
+// Info holds API metadata
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+// SecurityScheme describes an authentication scheme
+type SecurityScheme struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	In   string `json:"in"`
+}
+
+// PathItem maps lower-case HTTP methods to operations
+type PathItem map[string]*Operation
+
+// Operation is a single documented endpoint
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary"`
+	Description string                `json:"description"`
+	Tags        []string              `json:"tags"`
+	Security    []map[string][]string `json:"security"`
+	Parameters  []Parameter           `json:"parameters"`
+	Responses   map[string]Response   `json:"responses"`
+}
+
+// Parameter is an operation parameter (path, query, header or body)
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description"`
+	Required    bool    `json:"required"`
+	Type        string  `json:"type"`
+	Format      string  `json:"format"`
+	Items       *Schema `json:"items"`
+	Schema      *Schema `json:"schema"`
+}
+
+// Response is a documented response for one status code
+type Response struct {
+	Description string  `json:"description"`
+	Schema      *Schema `json:"schema"`
+}
+
+// Schema is a JSON schema as used by Swagger 2.0
+type Schema struct {
+	Ref                  string             `json:"$ref"`
+	Type                 string             `json:"type"`
+	Format               string             `json:"format"`
+	Description          string             `json:"description"`
+	Properties           map[string]*Schema `json:"properties"`
+	Required             []string           `json:"required"`
+	Items                *Schema            `json:"items"`
+	AllOf                []*Schema          `json:"allOf"`
+	AdditionalProperties interface{}        `json:"additionalProperties"`
+	Enum                 []interface{}      `json:"enum"`
+}
+
+// RefName returns the definition name a $ref points to
+func (s *Schema) RefName() string {
+	return strings.TrimPrefix(s.Ref, "#/definitions/")
+}
+
+// IsRequired reports whether the named property is required
+func (s *Schema) IsRequired(name string) bool {
+	for _, r := range s.Required {
+		if r == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ========================================================================
+// LOADING
+// ========================================================================
+
+// Load reads and parses a swagger.json file
+func Load(path string) (*Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spec: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses a swagger.json document
+func Parse(data []byte) (*Spec, error) {
+	var spec Spec
+	if err := json.Unmarshal(data, &spec); err != nil {
+		return nil, fmt.Errorf("failed to parse spec: %w", err)
+	}
+	if !strings.HasPrefix(spec.Swagger, "2.") {
+		return nil, fmt.Errorf("unsupported spec version %q (want swagger 2.x)", spec.Swagger)
+	}
+	return &spec, nil
+}
+
+// Resolve follows a $ref to its definition; schemas without a ref are returned as-is
+func (s *Spec) Resolve(schema *Schema) (*Schema, error) {
+	for depth := 0; schema != nil && schema.Ref != ""; depth++ {
+		if depth > 32 {
+			return nil, fmt.Errorf("reference cycle at %s", schema.Ref)
+		}
+		def, ok := s.Definitions[schema.RefName()]
+		if !ok {
+			return nil, fmt.Errorf("unknown definition %s", schema.Ref)
+		}
+		schema = def
+	}
+	return schema, nil
+}
+
+// ========================================================================
+// OPERATIONS
+// ========================================================================
+
+// OperationRef is an operation together with its method and path
+type OperationRef struct {
+	Method string // Upper-case HTTP method
+	Path   string // Path template, e.g. /api/v1/bookings/{id}
+	*Operation
+}
+
+// Operations returns all operations sorted by path then method for stable output
+func (s *Spec) Operations() []OperationRef {
+	var ops []OperationRef
+	for path, item := range s.Paths {
+		for method, op := range item {
+			ops = append(ops, OperationRef{Method: strings.ToUpper(method), Path: path, Operation: op})
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		if ops[i].Path != ops[j].Path {
+			return ops[i].Path < ops[j].Path
+		}
+		return ops[i].Method < ops[j].Method
+	})
+	return ops
+}
+
+// FindOperation matches a concrete request path against the path templates.
+// Literal segments win over parameters, so /bookings/me matches before /bookings/{id}.
+func (s *Spec) FindOperation(method, requestPath string) (*OperationRef, bool) {
+	if i := strings.IndexAny(requestPath, "?#"); i >= 0 {
+		requestPath = requestPath[:i]
+	}
+	segments := strings.Split(strings.Trim(requestPath, "/"), "/")
+
+	var best *OperationRef
+	bestParams := -1
+	for template, item := range s.Paths {
+		params, ok := matchTemplate(strings.Split(strings.Trim(template, "/"), "/"), segments)
+		if !ok {
+			continue
+		}
+		op, ok := item[strings.ToLower(method)]
+		if !ok {
+			continue
+		}
+		if best == nil || params < bestParams {
+			best = &OperationRef{Method: strings.ToUpper(method), Path: template, Operation: op}
+			bestParams = params
+		}
+	}
+	return best, best != nil
+}
+
+// matchTemplate reports whether segments match the template and how many parameters were used
+func matchTemplate(template, segments []string) (int, bool) {
+	if len(template) != len(segments) {
+		return 0, false
+	}
+	params := 0
+	for i, t := range template {
+		if strings.HasPrefix(t, "{") && strings.HasSuffix(t, "}") {
+			params++
+			continue
+		}
+		if t != segments[i] {
+			return 0, false
+		}
+	}
+	return params, true
+}
+
+// SuccessResponse returns the lowest documented 2xx response
+func (op *Operation) SuccessResponse() (int, *Response) {
+	codes := make([]string, 0, len(op.Responses))
+	for code := range op.Responses {
+		if strings.HasPrefix(code, "2") {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) == 0 {
+		return 0, nil
+	}
+	sort.Strings(codes)
+	resp := op.Responses[codes[0]]
+	var status int
+	fmt.Sscanf(codes[0], "%d", &status)
+	return status, &resp
+}
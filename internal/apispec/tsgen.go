@@ -0,0 +1,236 @@
+// internal/apispec/tsgen.go
+package apispec
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// ========================================================================
+// TYPESCRIPT SDK GENERATOR
+// ========================================================================
+
+// GenerateTypeScript renders TypeScript interfaces and a fetch-based client.
+// The output is self-contained and has no runtime dependencies.
+func (s *Spec) GenerateTypeScript() []byte {
+	g := &tsGenerator{spec: s, names: s.typeNames()}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by cmd/sdkgen from docs/swagger.json. DO NOT EDIT.\n")
+	b.WriteString("/* eslint-disable */\n\n")
+	b.WriteString(tsRuntime)
+
+	b.WriteString("// ============================================\n// Models\n// ============================================\n\n")
+	for _, key := range s.sortedDefinitions() {
+		g.writeModel(&b, key, s.Definitions[key])
+	}
+
+	ops := s.sdkOperations()
+	b.WriteString("// ============================================\n// Query Parameters\n// ============================================\n\n")
+	for _, op := range ops {
+		if len(op.QueryParams) > 0 {
+			g.writeParams(&b, op)
+		}
+	}
+
+	b.WriteString("// ============================================\n// Client\n// ============================================\n\n")
+	b.WriteString("export class BarbershopClient extends BaseClient {\n")
+	for _, op := range ops {
+		g.writeOperation(&b, op)
+	}
+	b.WriteString("}\n")
+
+	return b.Bytes()
+}
+
+type tsGenerator struct {
+	spec  *Spec
+	names map[string]string
+}
+
+// tsType maps a schema to a TypeScript type
+func (g *tsGenerator) tsType(schema *Schema) string {
+	if schema == nil {
+		return "unknown"
+	}
+	if schema.Ref != "" {
+		if name, ok := g.names[schema.RefName()]; ok {
+			return name
+		}
+		return "unknown"
+	}
+	switch schema.Type {
+	case "string":
+		return "string"
+	case "integer", "number":
+		return "number"
+	case "boolean":
+		return "boolean"
+	case "array":
+		return g.tsType(schema.Items) + "[]"
+	case "object":
+		return "Record<string, unknown>"
+	default:
+		return "unknown"
+	}
+}
+
+// writeModel renders a definition as an interface or record alias
+func (g *tsGenerator) writeModel(b *bytes.Buffer, key string, schema *Schema) {
+	name := g.names[key]
+	if schema.Description != "" {
+		b.WriteString(commentLines("/** ", schema.Description+" */"))
+	}
+	if schema.Type == "object" && len(schema.Properties) == 0 {
+		fmt.Fprintf(b, "export type %s = Record<string, unknown>;\n\n", name)
+		return
+	}
+
+	fmt.Fprintf(b, "export interface %s {\n", name)
+	for _, prop := range sortedProperties(schema) {
+		p := schema.Properties[prop]
+		if p.Description != "" {
+			fmt.Fprintf(b, "  /** %s */\n", strings.ReplaceAll(p.Description, "\n", " "))
+		}
+		optional := "?"
+		if schema.IsRequired(prop) {
+			optional = ""
+		}
+		fmt.Fprintf(b, "  %s%s: %s;\n", prop, optional, g.tsType(p))
+	}
+	b.WriteString("}\n\n")
+}
+
+// paramType maps a path/query parameter to a TypeScript type
+func (g *tsGenerator) paramType(p Parameter) string {
+	if p.Type == "array" {
+		return g.tsType(p.Items) + "[]"
+	}
+	return g.tsType(&Schema{Type: p.Type})
+}
+
+// writeParams renders the query parameter interface for an operation
+func (g *tsGenerator) writeParams(b *bytes.Buffer, op sdkOperation) {
+	fmt.Fprintf(b, "export interface %sParams {\n", op.Name)
+	for _, p := range op.QueryParams {
+		optional := "?"
+		if p.Required {
+			optional = ""
+		}
+		fmt.Fprintf(b, "  %s%s: %s;\n", p.Name, optional, g.paramType(p))
+	}
+	b.WriteString("}\n\n")
+}
+
+// writeOperation renders a client method
+func (g *tsGenerator) writeOperation(b *bytes.Buffer, op sdkOperation) {
+	var args []string
+	for _, p := range op.PathParams {
+		args = append(args, fmt.Sprintf("%s: %s", camelCase(p.Name), g.paramType(p)))
+	}
+	if op.Body != nil {
+		args = append(args, "body: "+g.tsType(op.Body.Schema))
+	}
+	if len(op.QueryParams) > 0 {
+		args = append(args, fmt.Sprintf("params?: %sParams", op.Name))
+	}
+	args = append(args, "init?: RequestInit")
+
+	result := "void"
+	if op.Result != resultNone {
+		result = g.tsType(op.Schema)
+	}
+
+	path := "`" + op.Path + "`"
+	for _, p := range op.PathParams {
+		path = strings.ReplaceAll(path, "{"+p.Name+"}", "${encodeURIComponent(String("+camelCase(p.Name)+"))}")
+	}
+
+	query := "undefined"
+	if len(op.QueryParams) > 0 {
+		query = "params as QueryParams | undefined"
+	}
+	body := "undefined"
+	if op.Body != nil {
+		body = "body"
+	}
+
+	summary := op.Summary
+	if summary == "" {
+		summary = op.Method + " " + op.Path
+	}
+	fmt.Fprintf(b, "  /** %s (%s %s) */\n", summary, op.Method, op.Path)
+	fmt.Fprintf(b, "  %s(%s): Promise<%s> {\n", camelCase(op.Name), strings.Join(args, ", "), result)
+	fmt.Fprintf(b, "    return this.request<%s>(%q, %s, %s, %s, %t, init);\n", result, op.Method, path, query, body, op.Result == resultEnvelope)
+	b.WriteString("  }\n\n")
+}
+
+// tsRuntime is the fixed request/response plumbing emitted before the generated code
+const tsRuntime = `export type QueryParams = Record<string, string | number | boolean | Array<string | number | boolean> | undefined>;
+
+export interface ClientOptions {
+  baseUrl: string;
+  token?: string;
+  fetch?: typeof fetch;
+}
+
+export class APIError extends Error {
+  constructor(
+    public readonly status: number,
+    public readonly code: string | undefined,
+    message: string,
+    public readonly body: unknown,
+  ) {
+    super(message);
+    this.name = "APIError";
+  }
+}
+
+export class BaseClient {
+  protected readonly baseUrl: string;
+  protected readonly fetchImpl: typeof fetch;
+  token?: string;
+
+  constructor(options: ClientOptions) {
+    this.baseUrl = options.baseUrl.replace(/\/+$/, "");
+    this.token = options.token;
+    this.fetchImpl = options.fetch ?? fetch;
+  }
+
+  protected async request<T>(
+    method: string,
+    path: string,
+    query: QueryParams | undefined,
+    body: unknown,
+    enveloped: boolean,
+    init?: RequestInit,
+  ): Promise<T> {
+    const url = new URL(this.baseUrl + path);
+    for (const [key, value] of Object.entries(query ?? {})) {
+      if (value === undefined) continue;
+      for (const v of Array.isArray(value) ? value : [value]) url.searchParams.append(key, String(v));
+    }
+
+    const headers = new Headers(init?.headers);
+    headers.set("Accept", "application/json");
+    if (body !== undefined) headers.set("Content-Type", "application/json");
+    if (this.token) headers.set("Authorization", "Bearer " + this.token);
+
+    const res = await this.fetchImpl(url.toString(), {
+      ...init,
+      method,
+      headers,
+      body: body === undefined ? undefined : JSON.stringify(body),
+    });
+
+    const text = await res.text();
+    const payload = text ? JSON.parse(text) : undefined;
+    if (!res.ok) {
+      throw new APIError(res.status, payload?.code, payload?.message ?? payload?.error ?? res.statusText, payload);
+    }
+    return (enveloped ? payload?.data : payload) as T;
+  }
+}
+
+`
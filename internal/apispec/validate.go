@@ -0,0 +1,209 @@
+// internal/apispec/validate.go
+package apispec
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ========================================================================
+// CONTRACT VALIDATION - Checks real handler responses against the spec
+// ========================================================================
+
+// DriftError lists every mismatch between a response and its documented schema
+type DriftError struct {
+	Operation string
+	Status    int
+	Problems  []string
+}
+
+func (e *DriftError) Error() string {
+	return fmt.Sprintf("%s (status %d) drifted from spec:\n  - %s",
+		e.Operation, e.Status, strings.Join(e.Problems, "\n  - "))
+}
+
+// Validator validates responses against a spec
+type Validator struct {
+	spec *Spec
+	// Strict reports properties present in responses but missing from the schema.
+	// Off by default because many schemas only document the envelope.
+	Strict bool
+}
+
+// NewValidator creates a validator for the given spec
+func NewValidator(spec *Spec) *Validator {
+	return &Validator{spec: spec}
+}
+
+// ValidateResponse checks that the status is documented for the operation
+// and that the JSON body matches the documented schema
+func (v *Validator) ValidateResponse(method, requestPath string, status int, body []byte) error {
+	op, ok := v.spec.FindOperation(method, requestPath)
+	if !ok {
+		return &DriftError{
+			Operation: method + " " + requestPath,
+			Status:    status,
+			Problems:  []string{"operation is not documented"},
+		}
+	}
+
+	drift := &DriftError{Operation: op.Method + " " + op.Path, Status: status}
+
+	resp, ok := op.Responses[strconv.Itoa(status)]
+	if !ok {
+		resp, ok = op.Responses["default"]
+	}
+	if !ok {
+		drift.Problems = append(drift.Problems, fmt.Sprintf("status %d is not documented", status))
+		return drift
+	}
+	if resp.Schema == nil || len(body) == 0 {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		drift.Problems = append(drift.Problems, "body is not valid JSON: "+err.Error())
+		return drift
+	}
+
+	v.validate("$", value, resp.Schema, false, drift)
+	if len(drift.Problems) > 0 {
+		sort.Strings(drift.Problems)
+		return drift
+	}
+	return nil
+}
+
+// validate checks value against schema, recording problems under path
+func (v *Validator) validate(path string, value interface{}, schema *Schema, nullable bool, drift *DriftError) {
+	schema, err := v.spec.Resolve(schema)
+	if err != nil {
+		drift.Problems = append(drift.Problems, path+": "+err.Error())
+		return
+	}
+	if schema == nil {
+		return
+	}
+
+	if value == nil {
+		// Optional Go pointer fields serialize as null
+		if !nullable && schema.Type != "" {
+			drift.Problems = append(drift.Problems, fmt.Sprintf("%s: null where %s is required", path, schema.Type))
+		}
+		return
+	}
+
+	if len(schema.AllOf) > 0 {
+		v.validateObject(path, value, v.mergeAllOf(schema), drift)
+		return
+	}
+
+	switch schema.Type {
+	case "":
+		// Untyped schema accepts anything
+		if len(schema.Properties) > 0 {
+			v.validateObject(path, value, schema, drift)
+		}
+	case "object":
+		v.validateObject(path, value, schema, drift)
+	case "array":
+		items, ok := value.([]interface{})
+		if !ok {
+			drift.Problems = append(drift.Problems, fmt.Sprintf("%s: expected array, got %s", path, jsonType(value)))
+			return
+		}
+		for i, item := range items {
+			v.validate(fmt.Sprintf("%s[%d]", path, i), item, schema.Items, true, drift)
+		}
+	case "string":
+		if _, ok := value.(string); !ok {
+			drift.Problems = append(drift.Problems, fmt.Sprintf("%s: expected string, got %s", path, jsonType(value)))
+		}
+	case "integer":
+		n, ok := value.(float64)
+		if !ok || n != math.Trunc(n) {
+			drift.Problems = append(drift.Problems, fmt.Sprintf("%s: expected integer, got %s", path, jsonType(value)))
+		}
+	case "number":
+		if _, ok := value.(float64); !ok {
+			drift.Problems = append(drift.Problems, fmt.Sprintf("%s: expected number, got %s", path, jsonType(value)))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			drift.Problems = append(drift.Problems, fmt.Sprintf("%s: expected boolean, got %s", path, jsonType(value)))
+		}
+	}
+}
+
+// validateObject checks required and documented properties of an object
+func (v *Validator) validateObject(path string, value interface{}, schema *Schema, drift *DriftError) {
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		drift.Problems = append(drift.Problems, fmt.Sprintf("%s: expected object, got %s", path, jsonType(value)))
+		return
+	}
+
+	for _, name := range schema.Required {
+		if _, ok := obj[name]; !ok {
+			drift.Problems = append(drift.Problems, fmt.Sprintf("%s.%s: required property missing", path, name))
+		}
+	}
+
+	for name, propValue := range obj {
+		prop, ok := schema.Properties[name]
+		if !ok {
+			if v.Strict && len(schema.Properties) > 0 && schema.AdditionalProperties == nil {
+				drift.Problems = append(drift.Problems, fmt.Sprintf("%s.%s: undocumented property", path, name))
+			}
+			continue
+		}
+		v.validate(path+"."+name, propValue, prop, !schema.IsRequired(name), drift)
+	}
+}
+
+// mergeAllOf flattens allOf into one object schema; later parts override earlier properties
+func (v *Validator) mergeAllOf(schema *Schema) *Schema {
+	merged := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for _, part := range schema.AllOf {
+		resolved, err := v.spec.Resolve(part)
+		if err != nil || resolved == nil {
+			continue
+		}
+		if len(resolved.AllOf) > 0 {
+			resolved = v.mergeAllOf(resolved)
+		}
+		for name, prop := range resolved.Properties {
+			merged.Properties[name] = prop
+		}
+		merged.Required = append(merged.Required, resolved.Required...)
+		if resolved.AdditionalProperties != nil {
+			merged.AdditionalProperties = resolved.AdditionalProperties
+		}
+	}
+	return merged
+}
+
+// jsonType names the JSON type of a decoded value
+func jsonType(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
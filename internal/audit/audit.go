@@ -0,0 +1,35 @@
+// internal/audit/audit.go
+package audit
+
+import "context"
+
+// ========================================================================
+// AUDIT CONTEXT - Who is behind a request, for the audit trail
+// ========================================================================
+//
+// The auth middleware tags each authenticated request's context with its
+// actor, so services recording audit entries deep in a call chain know who
+// acted and from where without threading it through every signature.
+// ========================================================================
+
+// Actor is the authenticated user making a request and the client they
+// made it from
+type Actor struct {
+	UserID    int
+	UserType  string
+	IPAddress string
+	UserAgent string
+}
+
+type contextKey struct{}
+
+// WithActor tags ctx with the request's actor
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, contextKey{}, actor)
+}
+
+// ActorFromContext returns the request's actor, if ctx has one
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(contextKey{}).(Actor)
+	return actor, ok
+}
@@ -0,0 +1,193 @@
+// internal/breaker/breaker.go
+package breaker
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"barber-booking-system/internal/clock"
+)
+
+// ========================================================================
+// CIRCUIT BREAKER - Fail fast while an external provider is down
+// ========================================================================
+//
+// A breaker wraps calls to one provider (payments, SMS, push). After
+// FailureThreshold consecutive failures it opens and rejects calls with
+// ErrOpen instead of waiting on a provider that is down. Once OpenTimeout
+// has passed it lets a single trial call through (half-open): success
+// closes it again, failure reopens it for another OpenTimeout.
+//
+// Only provider failures count. Callers classify errors with IsFailure so
+// that, say, a declined card does not trip the payments breaker.
+// ========================================================================
+
+// ErrOpen is returned instead of calling a provider whose breaker is open
+var ErrOpen = errors.New("circuit breaker is open")
+
+// State is a breaker's current state
+type State string
+
+// Breaker states
+const (
+	StateClosed   State = "closed"    // Calls go through
+	StateOpen     State = "open"      // Calls are rejected
+	StateHalfOpen State = "half_open" // One trial call is allowed
+)
+
+// Default settings
+const (
+	DefaultFailureThreshold = 5
+	DefaultOpenTimeout      = 30 * time.Second
+)
+
+// Settings configures a breaker
+type Settings struct {
+	// FailureThreshold is how many consecutive failures open the breaker
+	FailureThreshold int
+
+	// OpenTimeout is how long the breaker stays open before a trial call
+	OpenTimeout time.Duration
+
+	// IsFailure reports whether an error counts against the provider
+	// (nil = every error does)
+	IsFailure func(err error) bool
+}
+
+// Breaker guards calls to one provider. It is safe for concurrent use.
+type Breaker struct {
+	name     string
+	settings Settings
+	clock    clock.Clock
+
+	mu        sync.Mutex
+	state     State
+	failures  int
+	openedAt  time.Time
+	trial     bool // A half-open trial call is in flight
+	lastError string
+}
+
+// New creates a closed breaker; zero settings take the defaults
+func New(name string, settings Settings) *Breaker {
+	if settings.FailureThreshold <= 0 {
+		settings.FailureThreshold = DefaultFailureThreshold
+	}
+	if settings.OpenTimeout <= 0 {
+		settings.OpenTimeout = DefaultOpenTimeout
+	}
+	return &Breaker{
+		name:     name,
+		settings: settings,
+		clock:    clock.System,
+		state:    StateClosed,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (b *Breaker) SetClock(c clock.Clock) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.clock = clock.OrSystem(c)
+}
+
+// Name identifies the provider the breaker guards
+func (b *Breaker) Name() string {
+	return b.name
+}
+
+// Snapshot is a breaker's state at one moment
+type Snapshot struct {
+	Name      string     `json:"name"`
+	State     State      `json:"state"`
+	Failures  int        `json:"failures"` // Consecutive failures
+	OpenedAt  *time.Time `json:"opened_at,omitempty"`
+	LastError string     `json:"last_error,omitempty"`
+}
+
+// State returns the breaker's current state
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.currentState()
+}
+
+// Snapshot returns the breaker's current state and failure details
+func (b *Breaker) Snapshot() Snapshot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	snap := Snapshot{
+		Name:      b.name,
+		State:     b.currentState(),
+		Failures:  b.failures,
+		LastError: b.lastError,
+	}
+	if snap.State != StateClosed {
+		openedAt := b.openedAt
+		snap.OpenedAt = &openedAt
+	}
+	return snap
+}
+
+// currentState moves an open breaker to half-open once its timeout has
+// passed. Callers hold mu.
+func (b *Breaker) currentState() State {
+	if b.state == StateOpen && b.clock.Now().Sub(b.openedAt) >= b.settings.OpenTimeout {
+		b.state = StateHalfOpen
+		b.trial = false
+	}
+	return b.state
+}
+
+// Do calls fn unless the breaker is open, and records the outcome
+func (b *Breaker) Do(fn func() error) error {
+	if err := b.allow(); err != nil {
+		return err
+	}
+	err := fn()
+	b.record(err)
+	return err
+}
+
+// allow reserves a call, or returns ErrOpen
+func (b *Breaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.currentState() {
+	case StateOpen:
+		return ErrOpen
+	case StateHalfOpen:
+		if b.trial {
+			return ErrOpen
+		}
+		b.trial = true
+	}
+	return nil
+}
+
+// record updates the breaker with a call's outcome
+func (b *Breaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	failed := err != nil && (b.settings.IsFailure == nil || b.settings.IsFailure(err))
+	if !failed {
+		// Errors that are not the provider's fault still prove it answered
+		b.state = StateClosed
+		b.failures = 0
+		b.trial = false
+		b.lastError = ""
+		return
+	}
+
+	b.failures++
+	b.lastError = err.Error()
+	if b.state == StateHalfOpen || b.failures >= b.settings.FailureThreshold {
+		b.state = StateOpen
+		b.openedAt = b.clock.Now()
+		b.trial = false
+	}
+}
@@ -3,8 +3,12 @@ package cache
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
+
+	"github.com/redis/go-redis/v9"
 )
 
 // CacheService provides high-level caching operations
@@ -17,6 +21,11 @@ func NewCacheService(redis *RedisClient) *CacheService {
 	return &CacheService{redis: redis}
 }
 
+// Ping checks that Redis answers
+func (s *CacheService) Ping(ctx context.Context) error {
+	return s.redis.Ping(ctx)
+}
+
 // Cache key prefixes
 const (
 	BarberPrefix    = "barber:"
@@ -36,6 +45,10 @@ const (
 	MediumTTL = 30 * time.Minute
 	LongTTL   = 2 * time.Hour
 	DayTTL    = 24 * time.Hour
+
+	// DashboardTTL keeps the barber dashboard near real time; booking
+	// changes invalidate it sooner through InvalidateBarber
+	DashboardTTL = 30 * time.Second
 )
 
 // CacheBarber caches a barber object
@@ -50,10 +63,25 @@ func (s *CacheService) GetBarber(ctx context.Context, barberID int, dest interfa
 	return s.redis.GetJSON(ctx, key, dest)
 }
 
-// InvalidateBarber removes a barber from cache
+// InvalidateBarber removes a barber and their dashboard from cache
 func (s *CacheService) InvalidateBarber(ctx context.Context, barberID int) error {
 	key := fmt.Sprintf("%s%d", BarberPrefix, barberID)
-	return s.redis.Delete(ctx, key)
+	return s.redis.Delete(ctx, key, barberDashboardKey(barberID))
+}
+
+// barberDashboardKey returns the cache key for a barber's dashboard snapshot
+func barberDashboardKey(barberID int) string {
+	return fmt.Sprintf("%s%d:dashboard", BarberPrefix, barberID)
+}
+
+// CacheBarberDashboard caches the booking snapshot behind a barber's dashboard
+func (s *CacheService) CacheBarberDashboard(ctx context.Context, barberID int, snapshot interface{}) error {
+	return s.redis.SetJSON(ctx, barberDashboardKey(barberID), snapshot, DashboardTTL)
+}
+
+// GetBarberDashboard retrieves a cached dashboard snapshot
+func (s *CacheService) GetBarberDashboard(ctx context.Context, barberID int, dest interface{}) error {
+	return s.redis.GetJSON(ctx, barberDashboardKey(barberID), dest)
 }
 
 // CacheSearchResults caches search results
@@ -111,3 +139,164 @@ func (s *CacheService) Delete(ctx context.Context, key string) error {
 func (s *CacheService) Exists(ctx context.Context, key string) (bool, error) {
 	return s.redis.Exists(ctx, key)
 }
+
+// ========================================================================
+// CHANGE SIGNALS (pub/sub)
+// ========================================================================
+
+// UnreadChannelPrefix is the pub/sub channel prefix for unread-count changes
+const UnreadChannelPrefix = "notifications:unread:"
+
+// unreadChannel returns the change channel for a user's unread count
+func unreadChannel(userID int) string {
+	return fmt.Sprintf("%s%d", UnreadChannelPrefix, userID)
+}
+
+// PublishUnreadChange signals that a user's unread notification count may have changed
+func (s *CacheService) PublishUnreadChange(ctx context.Context, userID int) error {
+	return s.redis.Publish(ctx, unreadChannel(userID), "changed")
+}
+
+// UnreadChangeWaiter waits for unread-count change signals for a single user
+type UnreadChangeWaiter struct {
+	sub *redis.PubSub
+}
+
+// SubscribeUnreadChanges starts listening for unread-count changes for a user.
+// Subscribing before reading the current count avoids missing a change that
+// lands between the read and the wait.
+func (s *CacheService) SubscribeUnreadChanges(ctx context.Context, userID int) (*UnreadChangeWaiter, error) {
+	sub := s.redis.Subscribe(ctx, unreadChannel(userID))
+
+	// Wait for subscription confirmation so no signal is lost
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return nil, fmt.Errorf("failed to subscribe to unread changes: %w", err)
+	}
+
+	return &UnreadChangeWaiter{sub: sub}, nil
+}
+
+// Wait blocks until a change is signalled, the timeout elapses, or ctx is done.
+// It returns true if a change was signalled.
+func (w *UnreadChangeWaiter) Wait(ctx context.Context, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-w.sub.Channel():
+		return true
+	case <-timer.C:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Close releases the underlying subscription
+func (w *UnreadChangeWaiter) Close() error {
+	return w.sub.Close()
+}
+
+// ========================================================================
+// USER AND BARBER EVENTS (pub/sub)
+// ========================================================================
+
+// EventChannelPrefix is the pub/sub channel prefix for real-time events sent to a user
+const EventChannelPrefix = "events:user:"
+
+// eventChannel returns the real-time event channel for a user
+func eventChannel(userID int) string {
+	return fmt.Sprintf("%s%d", EventChannelPrefix, userID)
+}
+
+// BarberEventChannelPrefix is the pub/sub channel prefix for barbers' booking feeds
+const BarberEventChannelPrefix = "events:barber:"
+
+// barberEventChannel returns the booking feed channel for a barber
+func barberEventChannel(barberID int) string {
+	return fmt.Sprintf("%s%d", BarberEventChannelPrefix, barberID)
+}
+
+// PublishUserEvent sends a JSON-encoded event to a user's real-time
+// connections, whichever server instance holds them
+func (s *CacheService) PublishUserEvent(ctx context.Context, userID int, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode user event: %w", err)
+	}
+	return s.redis.Publish(ctx, eventChannel(userID), payload)
+}
+
+// StreamEvent is a message received on an event stream: an event
+// published with PublishUserEvent or PublishBarberEvent, or an unread-count
+// change signal
+type StreamEvent struct {
+	Payload       string // JSON event; empty for unread-count signals
+	UnreadChanged bool
+}
+
+// EventStream receives the events of one user or one barber's feed
+type EventStream struct {
+	sub *redis.PubSub
+}
+
+// SubscribeUserEvents starts listening for a user's events and unread-count changes
+func (s *CacheService) SubscribeUserEvents(ctx context.Context, userID int) (*EventStream, error) {
+	return s.subscribe(ctx, eventChannel(userID), unreadChannel(userID))
+}
+
+// PublishBarberEvent sends a JSON-encoded event to everyone following a
+// barber's booking feed
+func (s *CacheService) PublishBarberEvent(ctx context.Context, barberID int, event interface{}) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode barber event: %w", err)
+	}
+	return s.redis.Publish(ctx, barberEventChannel(barberID), payload)
+}
+
+// SubscribeBarberEvents starts listening to a barber's booking feed
+func (s *CacheService) SubscribeBarberEvents(ctx context.Context, barberID int) (*EventStream, error) {
+	return s.subscribe(ctx, barberEventChannel(barberID))
+}
+
+// subscribe listens on channels, waiting for every subscription to be
+// confirmed so no event published afterwards is lost
+func (s *CacheService) subscribe(ctx context.Context, channels ...string) (*EventStream, error) {
+	sub := s.redis.Subscribe(ctx, channels...)
+	for range channels {
+		if _, err := sub.Receive(ctx); err != nil {
+			_ = sub.Close()
+			return nil, fmt.Errorf("failed to subscribe to events: %w", err)
+		}
+	}
+	return &EventStream{sub: sub}, nil
+}
+
+// Next blocks until a message arrives, the timeout elapses, or ctx is done.
+// It returns nil on timeout and an error once ctx is done or the stream is closed.
+func (st *EventStream) Next(ctx context.Context, timeout time.Duration) (*StreamEvent, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case msg, ok := <-st.sub.Channel():
+		if !ok {
+			return nil, fmt.Errorf("event stream closed")
+		}
+		if strings.HasPrefix(msg.Channel, UnreadChannelPrefix) {
+			return &StreamEvent{UnreadChanged: true}, nil
+		}
+		return &StreamEvent{Payload: msg.Payload}, nil
+	case <-timer.C:
+		return nil, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Close releases the underlying subscription
+func (st *EventStream) Close() error {
+	return st.sub.Close()
+}
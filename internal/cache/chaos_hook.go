@@ -0,0 +1,51 @@
+// internal/cache/chaos_hook.go
+package cache
+
+import (
+	"context"
+	"net"
+
+	"barber-booking-system/internal/chaos"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// InjectFaults makes the client honor the Redis faults of the request a
+// command runs for (see internal/chaos)
+func (r *RedisClient) InjectFaults() {
+	r.client.AddHook(chaosHook{})
+}
+
+// chaosHook fails or delays Redis commands as the request's faults ask
+type chaosHook struct{}
+
+func (chaosHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		if err := chaos.Check(ctx, chaos.DependencyRedis); err != nil {
+			return nil, err
+		}
+		return next(ctx, network, addr)
+	}
+}
+
+func (chaosHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := chaos.Check(ctx, chaos.DependencyRedis); err != nil {
+			cmd.SetErr(err)
+			return err
+		}
+		return next(ctx, cmd)
+	}
+}
+
+func (chaosHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		if err := chaos.Check(ctx, chaos.DependencyRedis); err != nil {
+			for _, cmd := range cmds {
+				cmd.SetErr(err)
+			}
+			return err
+		}
+		return next(ctx, cmds)
+	}
+}
@@ -57,6 +57,11 @@ func (r *RedisClient) Close() error {
 	return r.client.Close()
 }
 
+// Ping checks that Redis answers
+func (r *RedisClient) Ping(ctx context.Context) error {
+	return r.client.Ping(ctx).Err()
+}
+
 // Get retrieves a value from cache
 func (r *RedisClient) Get(ctx context.Context, key string) (string, error) {
 	val, err := r.client.Get(ctx, key).Result()
@@ -137,6 +142,17 @@ func (r *RedisClient) DeletePattern(ctx context.Context, pattern string) error {
 	return iter.Err()
 }
 
+// Publish sends a message to a pub/sub channel
+func (r *RedisClient) Publish(ctx context.Context, channel string, message interface{}) error {
+	return r.client.Publish(ctx, channel, message).Err()
+}
+
+// Subscribe subscribes to one or more pub/sub channels
+// Callers must Close the returned subscription when done
+func (r *RedisClient) Subscribe(ctx context.Context, channels ...string) *redis.PubSub {
+	return r.client.Subscribe(ctx, channels...)
+}
+
 // GetClient returns the underlying Redis client (for advanced operations)
 func (r *RedisClient) GetClient() *redis.Client {
 	return r.client
@@ -0,0 +1,220 @@
+// internal/chaos/chaos.go
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// CHAOS - Fault injection for resilience testing
+// ========================================================================
+//
+// Outside production, a request can ask for faults in the X-Chaos header
+// (or the server can apply CHAOS_FAULTS to every request) to see how the
+// API copes: slow responses, errors, and Redis or the database being slow
+// or down. The faults travel in the request context; the Redis client and
+// the database driver (see internal/sqlhook) check it before every command,
+// so circuit breakers, retries and fallbacks see the same failures a real
+// outage would cause.
+//
+// A spec is a comma-separated list of faults:
+//
+//	latency=300ms        Delay the request before it is handled
+//	error=503            Answer with this status instead of handling it
+//	redis=down           Fail every Redis command
+//	db=slow:2s           Delay every database query
+//	rate=0.25            Inject the faults into a quarter of the requests
+//
+// Background jobs run outside requests and are never affected.
+// ========================================================================
+
+// Header carries a request's fault spec
+const Header = "X-Chaos"
+
+// Dependencies that can be made slow or unavailable
+const (
+	DependencyDB    = "db"
+	DependencyRedis = "redis"
+)
+
+var dependencies = []string{DependencyDB, DependencyRedis}
+
+// ErrInjected is the error injected into a dependency that is down
+var ErrInjected = errors.New("chaos: injected failure")
+
+// DependencyFault is how a dependency misbehaves
+type DependencyFault struct {
+	Down    bool
+	Latency time.Duration
+}
+
+// Faults are the faults injected into a request
+type Faults struct {
+	Latency      time.Duration
+	Status       int                        // 0 = handle the request
+	Dependencies map[string]DependencyFault // By dependency name
+	Rate         float64                    // Share of requests affected, in (0, 1]
+}
+
+// Parse reads a fault spec
+func Parse(spec string) (*Faults, error) {
+	faults := &Faults{Dependencies: map[string]DependencyFault{}, Rate: 1}
+	for _, part := range strings.Split(spec, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, fmt.Errorf("fault %q must be key=value", part)
+		}
+		key, value = strings.ToLower(strings.TrimSpace(key)), strings.TrimSpace(value)
+
+		switch {
+		case key == "latency":
+			latency, err := parseLatency(value)
+			if err != nil {
+				return nil, err
+			}
+			faults.Latency = latency
+		case key == "error":
+			status, err := strconv.Atoi(value)
+			if err != nil || status < 400 || status > 599 {
+				return nil, fmt.Errorf("error must be an HTTP status from 400 to 599")
+			}
+			faults.Status = status
+		case key == "rate":
+			rate, err := strconv.ParseFloat(value, 64)
+			if err != nil || rate <= 0 || rate > 1 {
+				return nil, fmt.Errorf("rate must be above 0 and at most 1")
+			}
+			faults.Rate = rate
+		case slices.Contains(dependencies, key):
+			fault, err := parseDependencyFault(key, value)
+			if err != nil {
+				return nil, err
+			}
+			faults.Dependencies[key] = fault
+		default:
+			return nil, fmt.Errorf("unknown fault %q", key)
+		}
+	}
+	return faults, nil
+}
+
+// parseDependencyFault reads "down" or "slow:DURATION"
+func parseDependencyFault(name, value string) (DependencyFault, error) {
+	if value == "down" {
+		return DependencyFault{Down: true}, nil
+	}
+	if latency, ok := strings.CutPrefix(value, "slow:"); ok {
+		d, err := parseLatency(latency)
+		if err != nil {
+			return DependencyFault{}, err
+		}
+		return DependencyFault{Latency: d}, nil
+	}
+	return DependencyFault{}, fmt.Errorf("%s must be down or slow:DURATION", name)
+}
+
+// parseLatency reads a delay of at most MaxChaosLatency
+func parseLatency(value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 || d > config.MaxChaosLatency {
+		return 0, fmt.Errorf("latency must be a duration up to %s", config.MaxChaosLatency)
+	}
+	return d, nil
+}
+
+// Applies reports whether a request whose roll (in [0, 1)) is drawn gets
+// the faults
+func (f *Faults) Applies(roll float64) bool {
+	return roll < f.Rate
+}
+
+// String formats the faults as a spec, in a stable order
+func (f *Faults) String() string {
+	var parts []string
+	if f.Latency > 0 {
+		parts = append(parts, "latency="+f.Latency.String())
+	}
+	if f.Status != 0 {
+		parts = append(parts, "error="+strconv.Itoa(f.Status))
+	}
+	names := make([]string, 0, len(f.Dependencies))
+	for name := range f.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if fault := f.Dependencies[name]; fault.Down {
+			parts = append(parts, name+"=down")
+		} else {
+			parts = append(parts, name+"=slow:"+fault.Latency.String())
+		}
+	}
+	if f.Rate < 1 {
+		parts = append(parts, "rate="+strconv.FormatFloat(f.Rate, 'f', -1, 64))
+	}
+	return strings.Join(parts, ",")
+}
+
+type contextKey struct{}
+
+// WithFaults injects faults into ctx
+func WithFaults(ctx context.Context, faults *Faults) context.Context {
+	return context.WithValue(ctx, contextKey{}, faults)
+}
+
+// FromContext returns the faults injected into ctx, if any
+func FromContext(ctx context.Context) *Faults {
+	faults, _ := ctx.Value(contextKey{}).(*Faults)
+	return faults
+}
+
+// Check applies ctx's faults for a dependency about to be called: it waits
+// out the dependency's latency, then returns an error wrapping ErrInjected
+// if the dependency is down
+func Check(ctx context.Context, dependency string) error {
+	faults := FromContext(ctx)
+	if faults == nil {
+		return nil
+	}
+	fault, ok := faults.Dependencies[dependency]
+	if !ok {
+		return nil
+	}
+	if err := Sleep(ctx, fault.Latency); err != nil {
+		return err
+	}
+	if fault.Down {
+		return fmt.Errorf("%w: %s unavailable", ErrInjected, dependency)
+	}
+	return nil
+}
+
+// DatabaseHook applies the database faults before each database call (see
+// internal/sqlhook)
+func DatabaseHook(ctx context.Context, op string) error {
+	return Check(ctx, DependencyDB)
+}
+
+// Sleep waits for d, or until ctx is done
+func Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
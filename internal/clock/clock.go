@@ -0,0 +1,94 @@
+// internal/clock/clock.go
+package clock
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ========================================================================
+// CLOCK - Injectable time source for time-dependent business rules
+// ========================================================================
+
+// Clock abstracts the current time so booking windows, reminders and
+// background jobs can be tested deterministically
+type Clock interface {
+	Now() time.Time
+}
+
+// systemClock reads the wall clock
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// System is the real wall clock used in production
+var System Clock = systemClock{}
+
+// OrSystem returns c, or System when c is nil
+func OrSystem(c Clock) Clock {
+	if c == nil {
+		return System
+	}
+	return c
+}
+
+// ========================================================================
+// FAKE CLOCK
+// ========================================================================
+
+// Fake is a manually controlled clock for tests. It only moves when Set or
+// Advance is called, so it also serves as a frozen clock.
+type Fake struct {
+	mu  sync.RWMutex
+	now time.Time
+}
+
+// NewFake creates a fake clock starting at now
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the fake current time
+func (f *Fake) Now() time.Time {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.now
+}
+
+// Set moves the clock to t
+func (f *Fake) Set(t time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = t
+}
+
+// Advance moves the clock forward by d
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+// ========================================================================
+// FROZEN TIME (CLI)
+// ========================================================================
+
+// Frozen returns a clock fixed at t
+func Frozen(t time.Time) Clock {
+	return NewFake(t)
+}
+
+// ParseFrozen parses an RFC3339 timestamp (or "2006-01-02" date) into a frozen
+// clock. An empty value returns the system clock.
+func ParseFrozen(value string) (Clock, error) {
+	if value == "" {
+		return System, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return Frozen(t), nil
+		}
+	}
+	return nil, fmt.Errorf("invalid time %q: expected RFC3339 or YYYY-MM-DD", value)
+}
@@ -24,6 +24,36 @@ type Config struct {
 	API      APIConfig      `json:"api"`
 	CORS     CORSConfig     `json:"cors"`
 	Logging LoggingConfig `yaml:"logging"`
+	AdminSecurity AdminSecurityConfig `json:"admin_security"`
+	Stripe   StripeConfig   `json:"stripe"`
+	BookingHooks BookingHooksConfig `json:"booking_hooks"`
+	Cancellation CancellationPolicyConfig `json:"cancellation"`
+	PriceAdjustment PriceAdjustmentConfig `json:"price_adjustment"`
+	Assignment AssignmentConfig `json:"assignment"`
+	Twilio   TwilioConfig   `json:"twilio"`
+	NPS      NPSConfig      `json:"nps"`
+	ReviewRequests ReviewRequestConfig `json:"review_requests"`
+	Push     PushConfig     `json:"push"`
+	WinBack  WinBackConfig  `json:"win_back"`
+	NoShowRisk NoShowRiskConfig `json:"no_show_risk"`
+	ActionLinks ActionLinkConfig `json:"action_links"`
+	PendingExpiry PendingExpiryConfig `json:"pending_expiry"`
+	Offboarding OffboardingConfig `json:"offboarding"`
+	AutoNoShow AutoNoShowConfig `json:"auto_no_show"`
+	ClientImport ClientImportConfig `json:"client_import"`
+	Webhooks WebhookConfig `json:"webhooks"`
+	Status   StatusConfig   `json:"status"`
+	Worker   WorkerConfig   `json:"worker"`
+	Cron     CronConfig     `json:"cron"`
+	Featured FeaturedConfig `json:"featured"`
+	OAuth    OAuthConfig    `json:"oauth"`
+	Ranking  RankingConfig  `json:"ranking"`
+	Chaos    ChaosConfig    `json:"chaos"`
+	QueryBudget QueryBudgetConfig `json:"query_budget"`
+	ReviewScreening ReviewScreeningConfig `json:"review_screening"`
+	Pagination PaginationConfig `json:"pagination"`
+	Localization LocalizationConfig `json:"localization"`
+	QuietHours QuietHoursConfig `json:"quiet_hours"`
 }
 
 // AppConfig represents application-level configuration
@@ -40,10 +70,14 @@ type ServerConfig struct {
 	GinMode      string        `json:"gin_mode"`
 	ReadTimeout  time.Duration `json:"read_timeout"`
 	WriteTimeout time.Duration `json:"write_timeout"`
+	// TrustedProxies are the proxy IPs/CIDRs whose X-Forwarded-For header is
+	// believed; empty trusts none, so clients are identified by connection
+	TrustedProxies []string `json:"trusted_proxies"`
 }
 
 // DatabaseConfig represents database configuration
 type DatabaseConfig struct {
+	Driver          string        `json:"driver"` // SQL driver name (empty = postgres)
 	URL             string        `json:"url"`
 	MaxOpenConns    int           `json:"max_open_conns"`
 	MaxIdleConns    int           `json:"max_idle_conns"`
@@ -52,8 +86,9 @@ type DatabaseConfig struct {
 
 // JWTConfig represents JWT configuration
 type JWTConfig struct {
-	Secret     string        `json:"-"` // Don't include secret in JSON output
-	Expiration time.Duration `json:"expiration"`
+	Secret            string        `json:"-"` // Don't include secret in JSON output
+	Expiration        time.Duration `json:"expiration"`
+	RefreshExpiration time.Duration `json:"refresh_expiration"`
 }
 
 // RedisConfig represents Redis configuration
@@ -65,8 +100,33 @@ type RedisConfig struct {
 
 // UploadConfig represents file upload configuration
 type UploadConfig struct {
-	Directory   string `json:"directory"`
-	MaxFileSize int64  `json:"max_file_size"`
+	Directory         string `json:"directory"`
+	MaxFileSize       int64  `json:"max_file_size"`
+	MaxMultipartParts int    `json:"max_multipart_parts"`
+
+	// Where uploaded files are stored: "local" (Directory) or "s3"
+	Driver string `json:"driver"`
+
+	// PublicURL is the base URL files are served from: a path served by
+	// this API for local storage (e.g. /uploads), or a CDN or bucket URL.
+	// Empty = the S3 object URL.
+	PublicURL string   `json:"public_url"`
+	S3        S3Config `json:"s3"`
+}
+
+// S3Config represents S3 (or S3-compatible) object storage configuration
+type S3Config struct {
+	Bucket          string `json:"bucket"`
+	Region          string `json:"region"`
+	Endpoint        string `json:"endpoint"` // Empty = AWS; set for S3-compatible stores such as MinIO
+	AccessKeyID     string `json:"access_key_id"`
+	SecretAccessKey string `json:"-"` // Don't include secret in JSON output
+	PathStyle       bool   `json:"path_style"` // Address the bucket in the path instead of the host name
+}
+
+// IsConfigured returns true if a bucket, region and credentials are present
+func (s S3Config) IsConfigured() bool {
+	return s.Bucket != "" && s.Region != "" && s.AccessKeyID != "" && s.SecretAccessKey != ""
 }
 
 // SMTPConfig represents email configuration
@@ -78,10 +138,322 @@ type SMTPConfig struct {
 	From     string `json:"from"`
 }
 
+// StripeConfig represents payment provider configuration
+type StripeConfig struct {
+	SecretKey     string `json:"-"` // Don't include secret in JSON output
+	WebhookSecret string `json:"-"`
+	APIBaseURL    string `json:"api_base_url"`
+}
+
+// IsConfigured returns true if Stripe credentials are present
+func (s StripeConfig) IsConfigured() bool {
+	return s.SecretKey != ""
+}
+
+// TwilioConfig represents SMS provider configuration
+type TwilioConfig struct {
+	AccountSID          string `json:"account_sid"`
+	AuthToken           string `json:"-"` // Don't include secret in JSON output
+	FromNumber          string `json:"from_number"`           // E.164 sender number
+	MessagingServiceSID string `json:"messaging_service_sid"` // Used instead of FromNumber when set
+	APIBaseURL          string `json:"api_base_url"`
+
+	// StatusCallbackBaseURL is the public URL of this API (e.g. https://api.example.com);
+	// delivery status callbacks are only requested when it is set
+	StatusCallbackBaseURL string `json:"status_callback_base_url"`
+}
+
+// IsConfigured returns true if Twilio credentials and a sender are present
+func (t TwilioConfig) IsConfigured() bool {
+	return t.AccountSID != "" && t.AuthToken != "" && (t.FromNumber != "" || t.MessagingServiceSID != "")
+}
+
+// PushConfig represents push notification provider configuration
+type PushConfig struct {
+	FCM         FCMConfig  `json:"fcm"`
+	APNs        APNsConfig `json:"apns"`
+	MaxAttempts int        `json:"max_attempts"` // Tries per device, including retries of transient failures
+}
+
+// FCMConfig represents Firebase Cloud Messaging (HTTP v1) configuration
+type FCMConfig struct {
+	ProjectID   string `json:"project_id"`
+	ClientEmail string `json:"client_email"` // Service account
+	PrivateKey  string `json:"-"`            // Service account PEM key; don't include in JSON output
+	TokenURL    string `json:"token_url"`
+	APIBaseURL  string `json:"api_base_url"`
+}
+
+// IsConfigured returns true if FCM service account credentials are present
+func (f FCMConfig) IsConfigured() bool {
+	return f.ProjectID != "" && f.ClientEmail != "" && f.PrivateKey != ""
+}
+
+// APNsConfig represents Apple Push Notification service (token auth) configuration
+type APNsConfig struct {
+	KeyID      string `json:"key_id"`
+	TeamID     string `json:"team_id"`
+	PrivateKey string `json:"-"`     // .p8 signing key (PEM); don't include in JSON output
+	Topic      string `json:"topic"` // App bundle ID
+	APIBaseURL string `json:"api_base_url"`
+}
+
+// IsConfigured returns true if APNs signing credentials and a topic are present
+func (a APNsConfig) IsConfigured() bool {
+	return a.KeyID != "" && a.TeamID != "" && a.PrivateKey != "" && a.Topic != ""
+}
+
+// WinBackConfig controls automated win-back campaigns for lapsed customers
+type WinBackConfig struct {
+	InactiveWeeks    int `json:"inactive_weeks"`     // Weeks past the customer's usual cadence before messaging
+	MinVisits        int `json:"min_visits"`         // Completed bookings needed to establish a cadence
+	CooldownDays     int `json:"cooldown_days"`      // Minimum gap between messages to one customer
+	CouponPercentOff int `json:"coupon_percent_off"` // 0 sends no coupon
+	CouponValidDays  int `json:"coupon_valid_days"`
+	AttributionDays  int `json:"attribution_days"` // Bookings within this many days of a message count as conversions
+	BatchSize        int `json:"batch_size"`       // Most customers messaged per run
+}
+
+// NoShowRiskConfig controls the extra confirmation request sent to
+// customers likely to miss their appointment
+type NoShowRiskConfig struct {
+	Threshold        float64 `json:"threshold"`         // No-show rate (0-1) at or above which a customer is high risk
+	MinBookings      int     `json:"min_bookings"`      // Past appointments needed before the rate counts
+	LookbackBookings int     `json:"lookback_bookings"` // Most recent past appointments the rate is taken over
+	HoursBefore      int     `json:"hours_before"`      // How long before the appointment the request is sent
+}
+
+// ActionLinkConfig controls the signed confirm/cancel links in reminders
+type ActionLinkConfig struct {
+	Secret  string        `json:"-"`        // Signs the links (default: the JWT secret)
+	BaseURL string        `json:"base_url"` // Public URL of this API (e.g. https://api.example.com); empty = relative links
+	TTL     time.Duration `json:"ttl"`      // Links expire after this, or when the appointment starts if sooner
+}
+
+// PendingExpiryConfig controls when bookings the barber never confirmed expire
+type PendingExpiryConfig struct {
+	TTL time.Duration `json:"ttl"` // How long a booking may stay pending before it is cancelled
+}
+
+// OffboardingConfig controls the deletion of shops leaving the platform
+type OffboardingConfig struct {
+	Retention time.Duration `json:"retention"` // How long after offboarding is requested the shop's data is deleted
+}
+
+// QueryBudgetConfig controls the per-request database query budget (see
+// internal/querybudget)
+type QueryBudgetConfig struct {
+	Budget int  `json:"budget"` // Queries a request may run (0 = not counted)
+	Strict bool `json:"strict"` // Fail the queries over the budget instead of logging
+}
+
+// ChaosConfig controls fault injection for resilience testing (see
+// internal/chaos). It cannot be enabled in production.
+type ChaosConfig struct {
+	Enabled bool   `json:"enabled"` // Honor the X-Chaos header
+	Faults  string `json:"faults"`  // Spec applied to requests without the header (empty = none)
+}
+
+// AutoNoShowConfig controls when confirmed bookings nobody started are
+// marked no-show
+type AutoNoShowConfig struct {
+	GraceMinutes int `json:"grace_minutes"` // Minutes after the start time, unless the barber set their own
+}
+
+// ClientImportConfig controls the invitations sent to imported clients
+type ClientImportConfig struct {
+	InvitationTTL time.Duration `json:"invitation_ttl"` // How long an invitation to claim an account stays valid
+	ClaimURL      string        `json:"claim_url"`      // Page where clients set their password; the token is appended as ?token=
+}
+
+// WebhookConfig controls outbound webhook deliveries
+type WebhookConfig struct {
+	Timeout time.Duration `json:"timeout"` // Per delivery request
+
+	// AllowInsecure permits http:// URLs and internal addresses, for local
+	// development against a receiver on the same machine
+	AllowInsecure bool `json:"allow_insecure"`
+}
+
+// StatusConfig controls the public status page and the circuit breakers
+// guarding external providers, whose state it reports
+type StatusConfig struct {
+	BreakerFailureThreshold int           `json:"breaker_failure_threshold"` // Consecutive provider failures that open a breaker
+	BreakerOpenTimeout      time.Duration `json:"breaker_open_timeout"`      // How long an open breaker rejects calls before a trial call
+	HistoryDays             int           `json:"history_days"`              // Days of component history on the status page
+}
+
+// WorkerConfig controls the in-process background worker
+type WorkerConfig struct {
+	Enabled bool `json:"enabled"`
+
+	// Notification delivery
+	NotificationPollInterval time.Duration `json:"notification_poll_interval"`
+	NotificationBatchSize    int           `json:"notification_batch_size"`
+	NotificationMaxAttempts  int           `json:"notification_max_attempts"` // Then the notification is marked failed
+	NotificationRetryBase    time.Duration `json:"notification_retry_base"`   // Delay before the first retry; doubles per attempt
+	NotificationRetryMax     time.Duration `json:"notification_retry_max"`
+	NotificationLease        time.Duration `json:"notification_lease"` // How long a claimed notification is hidden from other workers
+
+	// Scheduled jobs (0 disables)
+	WinBackInterval             time.Duration `json:"win_back_interval"`
+	RefreshTokenCleanupInterval time.Duration `json:"refresh_token_cleanup_interval"`
+	ConfirmationRequestInterval time.Duration `json:"confirmation_request_interval"` // Confirmation requests to high no-show risk bookings
+	ReviewRequestInterval       time.Duration `json:"review_request_interval"`       // Review requests whose delay after completion has passed
+	AutoNoShowInterval          time.Duration `json:"auto_no_show_interval"`         // Marking never-started bookings no-show
+	StatusCheckInterval         time.Duration `json:"status_check_interval"`         // Component health checks for the status page
+	SupportSLAInterval          time.Duration `json:"support_sla_interval"`          // Flagging support tickets past their SLA targets
+
+	// Webhook delivery
+	WebhookPollInterval time.Duration `json:"webhook_poll_interval"`
+	WebhookBatchSize    int           `json:"webhook_batch_size"`
+	WebhookMaxAttempts  int           `json:"webhook_max_attempts"` // Then the delivery is marked failed
+	WebhookRetryBase    time.Duration `json:"webhook_retry_base"`   // Delay before the first retry; doubles per attempt
+	WebhookRetryMax     time.Duration `json:"webhook_retry_max"`
+
+	// Outbox dispatch
+	OutboxPollInterval time.Duration `json:"outbox_poll_interval"`
+	OutboxBatchSize    int           `json:"outbox_batch_size"`
+	OutboxMaxAttempts  int           `json:"outbox_max_attempts"` // Then the event is marked failed
+	OutboxRetryBase    time.Duration `json:"outbox_retry_base"`   // Delay before the first retry; doubles per attempt
+	OutboxRetryMax     time.Duration `json:"outbox_retry_max"`
+
+	// API usage counters are buffered in memory and written this often
+	APIUsageFlushInterval time.Duration `json:"api_usage_flush_interval"`
+
+	// Migration backfills queued by expand migrations
+	BackfillInterval  time.Duration `json:"backfill_interval"`
+	BackfillBatchSize int           `json:"backfill_batch_size"` // Keys updated per statement
+}
+
+// CronConfig controls the jobs run on cron schedules. An empty schedule
+// (env value "off") disables its job. With Redis, each tick runs on only
+// one instance.
+type CronConfig struct {
+	Enabled  bool   `json:"enabled"`
+	Timezone string `json:"timezone"` // IANA zone the schedules are read in
+
+	Reminders           string `json:"reminders"`            // Booking reminders
+	NotificationCleanup string `json:"notification_cleanup"` // Old and expired notifications
+	StatsAggregation    string `json:"stats_aggregation"`    // Barber and service counters
+	BookingExpiry       string `json:"booking_expiry"`       // Bookings left pending too long
+	OutboxCleanup       string `json:"outbox_cleanup"`       // Dispatched outbox events
+	BookingArchive      string `json:"booking_archive"`      // Old bookings moved to the archive
+	Partitions          string `json:"partitions"`           // Monthly partitions created ahead and dropped past retention
+	ShopOffboarding     string `json:"shop_offboarding"`     // Data of shops that left the platform, once retention passes
+
+	ReminderHoursBefore   int           `json:"reminder_hours_before"`
+	NotificationRetention time.Duration `json:"notification_retention"` // Read notifications older than this are deleted
+	OutboxRetention       time.Duration `json:"outbox_retention"`       // Dispatched outbox events older than this are deleted
+	BookingArchiveYears   int           `json:"booking_archive_years"`  // Finished bookings older than this are archived (0 = never)
+	NotificationMonths    int           `json:"notification_months"`    // Notification partitions older than this many months are dropped (0 = never)
+}
+
+// FeaturedConfig controls paid featured placement in barber search results
+type FeaturedConfig struct {
+	DailyPriceCents  int64  `json:"daily_price_cents"`  // Price of one day of placement, in minor units
+	Currency         string `json:"currency"`           // ISO 4217, lower case
+	DefaultSlotLimit int    `json:"default_slot_limit"` // Concurrent placements per city/category without an explicit limit
+	MaxDays          int    `json:"max_days"`           // Longest placement that can be bought at once
+	MaxLeadDays      int    `json:"max_lead_days"`      // How far ahead a placement can start
+}
+
+// RankingConfig controls the order of barber listings. Each factor is
+// scored from 0 to 1 and multiplied by its weight; weights are relative.
+type RankingConfig struct {
+	WeightRating       float64 `json:"weight_rating"`
+	WeightDistance     float64 `json:"weight_distance"` // Only applies when the searcher sends a location
+	WeightResponseTime float64 `json:"weight_response_time"`
+	WeightPrice        float64 `json:"weight_price"` // Cheaper scores higher
+	WeightFeatured     float64 `json:"weight_featured"`
+
+	DistanceScaleKm      float64 `json:"distance_scale_km"`      // Distance at which the distance score halves
+	ResponseScaleMinutes float64 `json:"response_scale_minutes"` // Response time at which the response score halves
+	PriceScale           float64 `json:"price_scale"`            // Average service price at which the price score halves
+}
+
+// PaginationConfig controls the page sizes list endpoints accept
+type PaginationConfig struct {
+	DefaultLimit     int            `json:"default_limit"`      // Page size when limit is not sent (list types may default higher)
+	MaxLimit         int            `json:"max_limit"`          // Largest page for public and user consumers
+	ElevatedMaxLimit int            `json:"elevated_max_limit"` // Largest page for admins and consumers with an API quota
+	EndpointLimits   map[string]int `json:"endpoint_limits"`    // Route (e.g. /api/v1/barbers) to its own MaxLimit
+}
+
+// LocalizationConfig sets how notifications are written for users who have
+// not chosen a timezone, language or clock format. DefaultLocale is also
+// the language of API messages for requests without Accept-Language.
+type LocalizationConfig struct {
+	DefaultTimezone string `json:"default_timezone"` // IANA zone
+	DefaultLocale   string `json:"default_locale"`   // Language code, e.g. "en"
+}
+
+// QuietHoursConfig holds back non-urgent notifications overnight, in each
+// recipient's own timezone; they go out when the quiet hours end
+type QuietHoursConfig struct {
+	Enabled bool   `json:"enabled"`
+	Start   string `json:"start"` // HH:MM local time
+	End     string `json:"end"`   // HH:MM; before Start when the quiet hours span midnight
+}
+
+// ReviewScreeningConfig controls the automatic screening of reviews: new
+// ones (see internal/screening) and published ones users report.
+// Suspicious reviews are flagged for moderators.
+type ReviewScreeningConfig struct {
+	Enabled      bool     `json:"enabled"`
+	AutoPublish  bool     `json:"auto_publish"` // Publish reviews that pass; otherwise they wait in the pending queue
+	BlockedWords []string `json:"-"`            // Profanity; matched as whole words, ignoring case and common letter swaps
+
+	MaxLinks       int     `json:"max_links"`        // Links a review may contain
+	MaxLinkDensity float64 `json:"max_link_density"` // Share of a review's words that may be links
+
+	DuplicateWindow    time.Duration `json:"duplicate_window"`     // How far back identical comments count as duplicates
+	DuplicateMinLength int           `json:"duplicate_min_length"` // Shorter comments ("Great cut!") are never duplicates
+
+	RatingOnlyLimit  int           `json:"rating_only_limit"`  // Reviews without text a barber may get within the window
+	RatingOnlyWindow time.Duration `json:"rating_only_window"` // Window for RatingOnlyLimit
+
+	ReportThreshold int `json:"report_threshold"` // User reports that flag a published review
+}
+
+// OAuthConfig represents social sign-in provider configuration
+type OAuthConfig struct {
+	Google OAuthProviderConfig `json:"google"`
+	Apple  OAuthProviderConfig `json:"apple"`
+}
+
+// OAuthProviderConfig represents one sign-in provider
+type OAuthProviderConfig struct {
+	ClientIDs []string `json:"client_ids"` // ID tokens must be issued for one of these (web, iOS, Android apps)
+	JWKSURL   string   `json:"jwks_url"`   // Provider signing keys
+}
+
+// IsConfigured returns true if the provider has client IDs
+func (o OAuthProviderConfig) IsConfigured() bool {
+	return len(o.ClientIDs) > 0
+}
+
+// NPSConfig controls post-appointment NPS micro-surveys
+type NPSConfig struct {
+	EveryNthBooking    int `json:"every_nth_booking"`    // Survey after every Nth completed booking per customer; 0 disables
+	ResponseWindowDays int `json:"response_window_days"` // How long the survey link accepts answers
+}
+
+// ReviewRequestConfig controls the review requests sent after completed
+// bookings
+type ReviewRequestConfig struct {
+	Delay              time.Duration `json:"delay"`                // Wait after completion before asking (0 = next worker run)
+	ResponseWindowDays int           `json:"response_window_days"` // How long the review link accepts a review
+}
+
 // APIConfig represents API configuration
 type APIConfig struct {
-	RateLimit int           `json:"rate_limit"`
-	Timeout   time.Duration `json:"timeout"`
+	RateLimit   int           `json:"rate_limit"`
+	Timeout     time.Duration `json:"timeout"`
+	MaxBodySize int64         `json:"max_body_size"` // Limit for JSON API requests (uploads use Upload.MaxFileSize)
+
+	// SandboxEnabled honors the X-Sandbox header (test bookings, fake payments)
+	SandboxEnabled bool `json:"sandbox_enabled"`
 }
 
 // LoggingConfig represents logging configuration
@@ -97,6 +469,42 @@ type CORSConfig struct {
 	AllowedHeaders []string `json:"allowed_headers"`
 }
 
+// AdminSecurityConfig represents network restrictions for /api/v1/admin routes
+type AdminSecurityConfig struct {
+	IPAllowlist        []string `json:"ip_allowlist"`         // CIDRs; empty allows all
+	IPDenylist         []string `json:"ip_denylist"`          // CIDRs always rejected
+	BlockedCountries   []string `json:"blocked_countries"`    // ISO 3166-1 alpha-2 codes
+	GeoIPDatabasePath  string   `json:"geoip_database_path"`  // CSV of network,country_iso_code
+	GeoIPCountryHeader string   `json:"geoip_country_header"` // CDN header used when no database is configured
+	BypassTokenHashes  []string `json:"-"`                    // SHA-256 hex digests of emergency tokens
+}
+
+// BookingHooksConfig binds booking statuses to the actions run on entering them
+type BookingHooksConfig struct {
+	StatusHooks map[string][]string `json:"status_hooks"` // status -> action names
+}
+
+// CancellationPolicyConfig decides how much of a prepaid booking is refunded
+// when the customer cancels. Barber cancellations are always refunded in full.
+type CancellationPolicyConfig struct {
+	FullRefundHours      int `json:"full_refund_hours"`      // Cancelled at least this long before the start: full refund
+	PartialRefundHours   int `json:"partial_refund_hours"`   // At least this long: PartialRefundPercent; later: no refund
+	PartialRefundPercent int `json:"partial_refund_percent"` // 0-100
+}
+
+// PriceAdjustmentConfig bounds how far a barber may move a booking's service
+// price when completing it (e.g. for extra work done)
+type PriceAdjustmentConfig struct {
+	MaxIncreasePercent float64 `json:"max_increase_percent"` // Above the booked service price
+	MaxDecreasePercent float64 `json:"max_decrease_percent"` // Below the booked service price (0-100)
+}
+
+// AssignmentConfig decides which barber gets a booking made with a shop
+// instead of a particular barber
+type AssignmentConfig struct {
+	Strategy string `json:"strategy"` // least_loaded, first_available or random
+}
+
 // Load loads configuration from environment variables and .env files
 func Load() (*Config, error) {
 	// Load environment-specific .env file first
@@ -129,6 +537,48 @@ func Load() (*Config, error) {
 		API:      loadAPIConfig(),
 		Logging:  loadLoggingConfig(),
 		CORS:     loadCORSConfig(),
+		AdminSecurity: loadAdminSecurityConfig(),
+		Stripe:   loadStripeConfig(),
+		BookingHooks: loadBookingHooksConfig(),
+		Cancellation: loadCancellationPolicyConfig(),
+		PriceAdjustment: loadPriceAdjustmentConfig(),
+		Assignment: loadAssignmentConfig(),
+		Twilio:   loadTwilioConfig(),
+		NPS:      loadNPSConfig(),
+		ReviewRequests: loadReviewRequestConfig(),
+		Push:     loadPushConfig(),
+		WinBack:  loadWinBackConfig(),
+		NoShowRisk: loadNoShowRiskConfig(),
+		ActionLinks: loadActionLinkConfig(),
+		PendingExpiry: loadPendingExpiryConfig(),
+		Offboarding: loadOffboardingConfig(),
+		AutoNoShow: loadAutoNoShowConfig(),
+		ClientImport: loadClientImportConfig(),
+		Webhooks: loadWebhookConfig(),
+		Status:   loadStatusConfig(),
+		Worker:   loadWorkerConfig(),
+		Cron:     loadCronConfig(),
+		Featured: loadFeaturedConfig(),
+		OAuth:    loadOAuthConfig(),
+		Ranking:  loadRankingConfig(),
+		Chaos:    loadChaosConfig(),
+		ReviewScreening: loadReviewScreeningConfig(),
+		Pagination: loadPaginationConfig(),
+		Localization: LocalizationConfig{
+			DefaultTimezone: getEnv("DEFAULT_TIMEZONE", DefaultNotificationTimezone),
+			DefaultLocale:   getEnv("DEFAULT_LOCALE", DefaultNotificationLocale),
+		},
+		QuietHours: QuietHoursConfig{
+			Enabled: getEnv("QUIET_HOURS_ENABLED", "true") == "true",
+			Start:   getEnv("QUIET_HOURS_START", DefaultQuietHoursStart),
+			End:     getEnv("QUIET_HOURS_END", DefaultQuietHoursEnd),
+		},
+	}
+	config.QueryBudget = loadQueryBudgetConfig(config.App.Environment)
+
+	// Action links are signed with the JWT secret unless given their own
+	if config.ActionLinks.Secret == "" {
+		config.ActionLinks.Secret = config.JWT.Secret
 	}
 
 	// Validate required configuration
@@ -156,6 +606,7 @@ func loadServerConfig() ServerConfig {
 		GinMode:      getEnv("GIN_MODE", "debug"),
 		ReadTimeout:  getDurationEnv("SERVER_READ_TIMEOUT", 15*time.Second),
 		WriteTimeout: getDurationEnv("SERVER_WRITE_TIMEOUT", 15*time.Second),
+		TrustedProxies: getSliceEnv("TRUSTED_PROXIES", nil),
 	}
 }
 
@@ -172,8 +623,9 @@ func loadDatabaseConfig() DatabaseConfig {
 // loadJWTConfig loads JWT configuration
 func loadJWTConfig() JWTConfig {
 	return JWTConfig{
-		Secret:     getEnv("JWT_SECRET", ""),
-		Expiration: getDurationEnv("JWT_EXPIRATION", 24*time.Hour),
+		Secret:            getEnv("JWT_SECRET", ""),
+		Expiration:        getDurationEnv("JWT_EXPIRATION", 24*time.Hour),
+		RefreshExpiration: getDurationEnv("JWT_REFRESH_EXPIRATION", DefaultRefreshTokenExpiration),
 	}
 }
 
@@ -189,8 +641,19 @@ func loadRedisConfig() RedisConfig {
 // loadUploadConfig loads upload configuration
 func loadUploadConfig() UploadConfig {
 	return UploadConfig{
-		Directory:   getEnv("UPLOAD_DIR", "./uploads"),
-		MaxFileSize: getInt64Env("MAX_UPLOAD_SIZE", 10485760), // 10MB
+		Directory:         getEnv("UPLOAD_DIR", "./uploads"),
+		MaxFileSize:       getInt64Env("MAX_UPLOAD_SIZE", 10485760), // 10MB
+		MaxMultipartParts: getIntEnv("MAX_MULTIPART_PARTS", DefaultMaxMultipartParts),
+		Driver:            getEnv("UPLOAD_DRIVER", UploadDriverLocal),
+		PublicURL:         getEnv("UPLOAD_PUBLIC_URL", DefaultUploadPublicPath),
+		S3: S3Config{
+			Bucket:          getEnv("S3_BUCKET", ""),
+			Region:          getEnv("S3_REGION", ""),
+			Endpoint:        getEnv("S3_ENDPOINT", ""),
+			AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+			SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+			PathStyle:       getEnv("S3_PATH_STYLE", "false") == "true",
+		},
 	}
 }
 
@@ -208,8 +671,11 @@ func loadSMTPConfig() SMTPConfig {
 // loadAPIConfig loads API configuration
 func loadAPIConfig() APIConfig {
 	return APIConfig{
-		RateLimit: getIntEnv("API_RATE_LIMIT", 100),
-		Timeout:   getDurationEnv("API_TIMEOUT", 30*time.Second),
+		RateLimit:   getIntEnv("API_RATE_LIMIT", DefaultAPIRateLimit),
+		Timeout:     getDurationEnv("API_TIMEOUT", 30*time.Second),
+		MaxBodySize: getInt64Env("API_MAX_BODY_SIZE", DefaultJSONBodyLimitBytes),
+
+		SandboxEnabled: getEnv("API_SANDBOX_ENABLED", "true") == "true",
 	}
 }
 
@@ -230,6 +696,375 @@ func loadCORSConfig() CORSConfig {
 	}
 }
 
+// loadStripeConfig loads payment provider configuration
+func loadStripeConfig() StripeConfig {
+	return StripeConfig{
+		SecretKey:     getEnv("STRIPE_SECRET_KEY", ""),
+		WebhookSecret: getEnv("STRIPE_WEBHOOK_SECRET", ""),
+		APIBaseURL:    getEnv("STRIPE_API_BASE_URL", "https://api.stripe.com"),
+	}
+}
+
+// loadAdminSecurityConfig loads admin route network restrictions
+func loadAdminSecurityConfig() AdminSecurityConfig {
+	return AdminSecurityConfig{
+		IPAllowlist:        getSliceEnv("ADMIN_IP_ALLOWLIST", nil),
+		IPDenylist:         getSliceEnv("ADMIN_IP_DENYLIST", nil),
+		BlockedCountries:   getSliceEnv("ADMIN_BLOCKED_COUNTRIES", nil),
+		GeoIPDatabasePath:  getEnv("GEOIP_DB_PATH", ""),
+		GeoIPCountryHeader: getEnv("GEOIP_COUNTRY_HEADER", ""),
+		BypassTokenHashes:  getSliceEnv("ADMIN_BYPASS_TOKEN_HASHES", nil),
+	}
+}
+
+// loadBookingHooksConfig loads status → action bindings for booking hooks
+func loadBookingHooksConfig() BookingHooksConfig {
+	value := getEnv("BOOKING_STATUS_HOOKS", DefaultBookingStatusHooks)
+	hooks, err := ParseStatusHooks(value)
+	if err != nil {
+		log.Printf("Warning: Invalid BOOKING_STATUS_HOOKS %q: %v, using defaults", value, err)
+		hooks, _ = ParseStatusHooks(DefaultBookingStatusHooks)
+	}
+	return BookingHooksConfig{StatusHooks: hooks}
+}
+
+// loadCancellationPolicyConfig loads the refund windows for cancellations
+func loadCancellationPolicyConfig() CancellationPolicyConfig {
+	return CancellationPolicyConfig{
+		FullRefundHours:      getIntEnv("CANCELLATION_FULL_REFUND_HOURS", DefaultFullRefundHours),
+		PartialRefundHours:   getIntEnv("CANCELLATION_PARTIAL_REFUND_HOURS", DefaultPartialRefundHours),
+		PartialRefundPercent: getIntEnv("CANCELLATION_PARTIAL_REFUND_PERCENT", DefaultPartialRefundPercent),
+	}
+}
+
+// loadPriceAdjustmentConfig loads the bounds on completion price adjustments
+func loadPriceAdjustmentConfig() PriceAdjustmentConfig {
+	return PriceAdjustmentConfig{
+		MaxIncreasePercent: getFloatEnv("PRICE_ADJUSTMENT_MAX_INCREASE_PERCENT", DefaultPriceAdjustmentMaxIncreasePercent),
+		MaxDecreasePercent: getFloatEnv("PRICE_ADJUSTMENT_MAX_DECREASE_PERCENT", DefaultPriceAdjustmentMaxDecreasePercent),
+	}
+}
+
+// loadAssignmentConfig loads the strategy for "any available barber" bookings
+func loadAssignmentConfig() AssignmentConfig {
+	strategy := getEnv("BARBER_ASSIGNMENT_STRATEGY", DefaultAssignmentStrategy)
+	switch strategy {
+	case AssignmentStrategyLeastLoaded, AssignmentStrategyFirstAvailable, AssignmentStrategyRandom:
+	default:
+		log.Printf("Warning: Invalid BARBER_ASSIGNMENT_STRATEGY %q, using %s", strategy, DefaultAssignmentStrategy)
+		strategy = DefaultAssignmentStrategy
+	}
+	return AssignmentConfig{Strategy: strategy}
+}
+
+// loadTwilioConfig loads SMS provider configuration
+func loadTwilioConfig() TwilioConfig {
+	return TwilioConfig{
+		AccountSID:            getEnv("TWILIO_ACCOUNT_SID", ""),
+		AuthToken:             getEnv("TWILIO_AUTH_TOKEN", ""),
+		FromNumber:            getEnv("TWILIO_FROM_NUMBER", ""),
+		MessagingServiceSID:   getEnv("TWILIO_MESSAGING_SERVICE_SID", ""),
+		APIBaseURL:            getEnv("TWILIO_API_BASE_URL", "https://api.twilio.com"),
+		StatusCallbackBaseURL: strings.TrimSuffix(getEnv("TWILIO_STATUS_CALLBACK_BASE_URL", ""), "/"),
+	}
+}
+
+// loadWorkerConfig loads background worker settings
+func loadWorkerConfig() WorkerConfig {
+	return WorkerConfig{
+		Enabled:                     getEnv("WORKER_ENABLED", "true") == "true",
+		NotificationPollInterval:    getDurationEnv("NOTIFICATION_POLL_INTERVAL", DefaultNotificationPollInterval),
+		NotificationBatchSize:       getIntEnv("NOTIFICATION_BATCH_SIZE", DefaultNotificationBatchSize),
+		NotificationMaxAttempts:     getIntEnv("NOTIFICATION_MAX_ATTEMPTS", DefaultNotificationMaxAttempts),
+		NotificationRetryBase:       getDurationEnv("NOTIFICATION_RETRY_BASE", DefaultNotificationRetryBase),
+		NotificationRetryMax:        getDurationEnv("NOTIFICATION_RETRY_MAX", DefaultNotificationRetryMax),
+		NotificationLease:           getDurationEnv("NOTIFICATION_LEASE", DefaultNotificationLease),
+		WinBackInterval:             getDurationEnv("WIN_BACK_INTERVAL", DefaultWinBackInterval),
+		RefreshTokenCleanupInterval: getDurationEnv("REFRESH_TOKEN_CLEANUP_INTERVAL", DefaultRefreshTokenCleanupInterval),
+		ConfirmationRequestInterval: getDurationEnv("CONFIRMATION_REQUEST_INTERVAL", DefaultConfirmationRequestInterval),
+		ReviewRequestInterval:       getDurationEnv("REVIEW_REQUEST_INTERVAL", DefaultReviewRequestInterval),
+		AutoNoShowInterval:          getDurationEnv("AUTO_NO_SHOW_INTERVAL", DefaultAutoNoShowInterval),
+		StatusCheckInterval:         getDurationEnv("STATUS_CHECK_INTERVAL", DefaultStatusCheckInterval),
+		SupportSLAInterval:          getDurationEnv("SUPPORT_SLA_INTERVAL", DefaultSupportSLAInterval),
+		WebhookPollInterval:         getDurationEnv("WEBHOOK_POLL_INTERVAL", DefaultWebhookPollInterval),
+		WebhookBatchSize:            getIntEnv("WEBHOOK_BATCH_SIZE", DefaultWebhookBatchSize),
+		WebhookMaxAttempts:          getIntEnv("WEBHOOK_MAX_ATTEMPTS", DefaultWebhookMaxAttempts),
+		WebhookRetryBase:            getDurationEnv("WEBHOOK_RETRY_BASE", DefaultWebhookRetryBase),
+		WebhookRetryMax:             getDurationEnv("WEBHOOK_RETRY_MAX", DefaultWebhookRetryMax),
+		OutboxPollInterval:          getDurationEnv("OUTBOX_POLL_INTERVAL", DefaultOutboxPollInterval),
+		OutboxBatchSize:             getIntEnv("OUTBOX_BATCH_SIZE", DefaultOutboxBatchSize),
+		OutboxMaxAttempts:           getIntEnv("OUTBOX_MAX_ATTEMPTS", DefaultOutboxMaxAttempts),
+		OutboxRetryBase:             getDurationEnv("OUTBOX_RETRY_BASE", DefaultOutboxRetryBase),
+		OutboxRetryMax:              getDurationEnv("OUTBOX_RETRY_MAX", DefaultOutboxRetryMax),
+		APIUsageFlushInterval:       getDurationEnv("API_USAGE_FLUSH_INTERVAL", DefaultAPIUsageFlushInterval),
+		BackfillInterval:            getDurationEnv("BACKFILL_INTERVAL", DefaultBackfillInterval),
+		BackfillBatchSize:           getIntEnv("BACKFILL_BATCH_SIZE", DefaultBackfillBatchSize),
+	}
+}
+
+// loadCronConfig loads cron job schedules
+func loadCronConfig() CronConfig {
+	return CronConfig{
+		Enabled:               getEnv("CRON_ENABLED", "true") == "true",
+		Timezone:              getEnv("CRON_TIMEZONE", "UTC"),
+		Reminders:             getScheduleEnv("CRON_REMINDERS", DefaultCronReminders),
+		NotificationCleanup:   getScheduleEnv("CRON_NOTIFICATION_CLEANUP", DefaultCronNotificationCleanup),
+		StatsAggregation:      getScheduleEnv("CRON_STATS_AGGREGATION", DefaultCronStatsAggregation),
+		BookingExpiry:         getScheduleEnv("CRON_BOOKING_EXPIRY", DefaultCronBookingExpiry),
+		OutboxCleanup:         getScheduleEnv("CRON_OUTBOX_CLEANUP", DefaultCronOutboxCleanup),
+		BookingArchive:        getScheduleEnv("CRON_BOOKING_ARCHIVE", DefaultCronBookingArchive),
+		Partitions:            getScheduleEnv("CRON_PARTITIONS", DefaultCronPartitions),
+		ShopOffboarding:       getScheduleEnv("CRON_SHOP_OFFBOARDING", DefaultCronShopOffboarding),
+		ReminderHoursBefore:   getIntEnv("REMINDER_HOURS_BEFORE", DefaultReminderHoursBefore),
+		NotificationRetention: getDurationEnv("NOTIFICATION_RETENTION", DefaultNotificationRetention),
+		OutboxRetention:       getDurationEnv("OUTBOX_RETENTION", DefaultOutboxRetention),
+		BookingArchiveYears:   getIntEnv("BOOKING_ARCHIVE_AFTER_YEARS", DefaultBookingArchiveAfterYears),
+		NotificationMonths:    getIntEnv("NOTIFICATION_PARTITION_MONTHS", DefaultNotificationPartitionMonths),
+	}
+}
+
+// loadWinBackConfig loads win-back campaign settings
+func loadWinBackConfig() WinBackConfig {
+	return WinBackConfig{
+		InactiveWeeks:    getIntEnv("WIN_BACK_INACTIVE_WEEKS", DefaultWinBackInactiveWeeks),
+		MinVisits:        getIntEnv("WIN_BACK_MIN_VISITS", DefaultWinBackMinVisits),
+		CooldownDays:     getIntEnv("WIN_BACK_COOLDOWN_DAYS", DefaultWinBackCooldownDays),
+		CouponPercentOff: getIntEnv("WIN_BACK_COUPON_PERCENT_OFF", 0),
+		CouponValidDays:  getIntEnv("WIN_BACK_COUPON_VALID_DAYS", DefaultWinBackCouponValidDays),
+		AttributionDays:  getIntEnv("WIN_BACK_ATTRIBUTION_DAYS", DefaultWinBackAttributionDays),
+		BatchSize:        getIntEnv("WIN_BACK_BATCH_SIZE", DefaultWinBackBatchSize),
+	}
+}
+
+// loadNoShowRiskConfig loads no-show risk confirmation request settings
+func loadNoShowRiskConfig() NoShowRiskConfig {
+	return NoShowRiskConfig{
+		Threshold:        getFloatEnv("NO_SHOW_RISK_THRESHOLD", DefaultNoShowRiskThreshold),
+		MinBookings:      getIntEnv("NO_SHOW_RISK_MIN_BOOKINGS", DefaultNoShowRiskMinBookings),
+		LookbackBookings: getIntEnv("NO_SHOW_RISK_LOOKBACK_BOOKINGS", DefaultNoShowRiskLookbackBookings),
+		HoursBefore:      getIntEnv("CONFIRMATION_REQUEST_HOURS_BEFORE", DefaultConfirmationRequestHoursBefore),
+	}
+}
+
+// loadActionLinkConfig loads reminder action link settings
+func loadActionLinkConfig() ActionLinkConfig {
+	return ActionLinkConfig{
+		Secret:  getEnv("ACTION_LINK_SECRET", ""),
+		BaseURL: strings.TrimSuffix(getEnv("ACTION_LINK_BASE_URL", ""), "/"),
+		TTL:     getDurationEnv("ACTION_LINK_TTL", DefaultActionLinkTTL),
+	}
+}
+
+// loadPendingExpiryConfig loads pending booking expiry settings
+func loadPendingExpiryConfig() PendingExpiryConfig {
+	return PendingExpiryConfig{
+		TTL: getDurationEnv("PENDING_BOOKING_TTL", DefaultPendingBookingTTL),
+	}
+}
+
+// loadOffboardingConfig loads shop offboarding settings
+func loadOffboardingConfig() OffboardingConfig {
+	return OffboardingConfig{
+		Retention: getDurationEnv("SHOP_DATA_RETENTION", DefaultShopDataRetention),
+	}
+}
+
+// loadQueryBudgetConfig loads the query budget; it is strict in
+// development unless QUERY_BUDGET_STRICT says otherwise
+func loadQueryBudgetConfig(environment string) QueryBudgetConfig {
+	strict := "false"
+	if environment == "development" {
+		strict = "true"
+	}
+	return QueryBudgetConfig{
+		Budget: getIntEnv("QUERY_BUDGET", DefaultQueryBudget),
+		Strict: getEnv("QUERY_BUDGET_STRICT", strict) == "true",
+	}
+}
+
+// loadChaosConfig loads fault injection settings
+func loadChaosConfig() ChaosConfig {
+	return ChaosConfig{
+		Enabled: getEnv("CHAOS_ENABLED", "false") == "true",
+		Faults:  getEnv("CHAOS_FAULTS", ""),
+	}
+}
+
+// loadAutoNoShowConfig loads automatic no-show marking settings
+func loadAutoNoShowConfig() AutoNoShowConfig {
+	return AutoNoShowConfig{
+		GraceMinutes: getIntEnv("NO_SHOW_GRACE_MINUTES", DefaultNoShowGraceMinutes),
+	}
+}
+
+// loadClientImportConfig loads client import invitation settings
+func loadClientImportConfig() ClientImportConfig {
+	return ClientImportConfig{
+		InvitationTTL: getDurationEnv("CUSTOMER_INVITATION_TTL", DefaultCustomerInvitationTTL),
+		ClaimURL:      getEnv("CUSTOMER_CLAIM_URL", DefaultCustomerClaimURL),
+	}
+}
+
+// loadWebhookConfig loads outbound webhook delivery settings
+func loadWebhookConfig() WebhookConfig {
+	return WebhookConfig{
+		Timeout:       getDurationEnv("WEBHOOK_TIMEOUT", DefaultWebhookTimeout),
+		AllowInsecure: getEnv("WEBHOOK_ALLOW_INSECURE", "false") == "true",
+	}
+}
+
+// loadStatusConfig loads status page and circuit breaker settings
+func loadStatusConfig() StatusConfig {
+	return StatusConfig{
+		BreakerFailureThreshold: getIntEnv("BREAKER_FAILURE_THRESHOLD", DefaultBreakerFailureThreshold),
+		BreakerOpenTimeout:      getDurationEnv("BREAKER_OPEN_TIMEOUT", DefaultBreakerOpenTimeout),
+		HistoryDays:             getIntEnv("STATUS_HISTORY_DAYS", DefaultStatusHistoryDays),
+	}
+}
+
+// loadFeaturedConfig loads featured placement pricing and inventory settings
+func loadFeaturedConfig() FeaturedConfig {
+	return FeaturedConfig{
+		DailyPriceCents:  getInt64Env("FEATURED_DAILY_PRICE_CENTS", DefaultFeaturedDailyPriceCents),
+		Currency:         strings.ToLower(getEnv("FEATURED_CURRENCY", DefaultCurrency)),
+		DefaultSlotLimit: getIntEnv("FEATURED_SLOT_LIMIT", DefaultFeaturedSlotLimit),
+		MaxDays:          getIntEnv("FEATURED_MAX_DAYS", DefaultFeaturedMaxDays),
+		MaxLeadDays:      getIntEnv("FEATURED_MAX_LEAD_DAYS", DefaultFeaturedMaxLeadDays),
+	}
+}
+
+// loadRankingConfig loads barber listing ranking weights
+func loadRankingConfig() RankingConfig {
+	return RankingConfig{
+		WeightRating:         getFloatEnv("RANKING_WEIGHT_RATING", DefaultRankingWeightRating),
+		WeightDistance:       getFloatEnv("RANKING_WEIGHT_DISTANCE", DefaultRankingWeightDistance),
+		WeightResponseTime:   getFloatEnv("RANKING_WEIGHT_RESPONSE_TIME", DefaultRankingWeightResponseTime),
+		WeightPrice:          getFloatEnv("RANKING_WEIGHT_PRICE", DefaultRankingWeightPrice),
+		WeightFeatured:       getFloatEnv("RANKING_WEIGHT_FEATURED", DefaultRankingWeightFeatured),
+		DistanceScaleKm:      getFloatEnv("RANKING_DISTANCE_SCALE_KM", DefaultRankingDistanceScaleKm),
+		ResponseScaleMinutes: getFloatEnv("RANKING_RESPONSE_SCALE_MINUTES", DefaultRankingResponseScaleMinutes),
+		PriceScale:           getFloatEnv("RANKING_PRICE_SCALE", DefaultRankingPriceScale),
+	}
+}
+
+// loadPaginationConfig loads list page size limits. PAGINATION_ENDPOINT_LIMITS
+// lists route=limit pairs, e.g. "/api/v1/barbers=50,/api/v1/services=50".
+func loadPaginationConfig() PaginationConfig {
+	endpoints := make(map[string]int)
+	for _, pair := range getSliceEnv("PAGINATION_ENDPOINT_LIMITS", DefaultPaginationEndpointLimits) {
+		route, value, ok := strings.Cut(pair, "=")
+		limit, err := strconv.Atoi(strings.TrimSpace(value))
+		if !ok || err != nil || limit <= 0 {
+			log.Printf("Warning: Invalid endpoint limit in PAGINATION_ENDPOINT_LIMITS: %s, ignoring", pair)
+			continue
+		}
+		endpoints[strings.TrimSpace(route)] = limit
+	}
+	return PaginationConfig{
+		DefaultLimit:     getIntEnv("PAGINATION_DEFAULT_LIMIT", DefaultPageLimit),
+		MaxLimit:         getIntEnv("PAGINATION_MAX_LIMIT", MaxPageLimit),
+		ElevatedMaxLimit: getIntEnv("PAGINATION_ELEVATED_MAX_LIMIT", MaxElevatedPageLimit),
+		EndpointLimits:   endpoints,
+	}
+}
+
+// loadReviewScreeningConfig loads review screening settings
+func loadReviewScreeningConfig() ReviewScreeningConfig {
+	return ReviewScreeningConfig{
+		Enabled:            getEnv("REVIEW_SCREENING_ENABLED", "true") == "true",
+		AutoPublish:        getEnv("REVIEW_AUTO_PUBLISH", "true") == "true",
+		BlockedWords:       getSliceEnv("REVIEW_BLOCKED_WORDS", DefaultReviewBlockedWords),
+		MaxLinks:           getIntEnv("REVIEW_MAX_LINKS", DefaultReviewMaxLinks),
+		MaxLinkDensity:     getFloatEnv("REVIEW_MAX_LINK_DENSITY", DefaultReviewMaxLinkDensity),
+		DuplicateWindow:    getDurationEnv("REVIEW_DUPLICATE_WINDOW", DefaultReviewDuplicateWindow),
+		DuplicateMinLength: getIntEnv("REVIEW_DUPLICATE_MIN_LENGTH", DefaultReviewDuplicateMinLength),
+		RatingOnlyLimit:    getIntEnv("REVIEW_RATING_ONLY_LIMIT", DefaultReviewRatingOnlyLimit),
+		RatingOnlyWindow:   getDurationEnv("REVIEW_RATING_ONLY_WINDOW", DefaultReviewRatingOnlyWindow),
+		ReportThreshold:    getIntEnv("REVIEW_REPORT_THRESHOLD", DefaultReviewReportThreshold),
+	}
+}
+
+// loadOAuthConfig loads social sign-in provider configuration
+func loadOAuthConfig() OAuthConfig {
+	return OAuthConfig{
+		Google: OAuthProviderConfig{
+			ClientIDs: getSliceEnv("GOOGLE_CLIENT_IDS", nil),
+			JWKSURL:   getEnv("GOOGLE_JWKS_URL", "https://www.googleapis.com/oauth2/v3/certs"),
+		},
+		Apple: OAuthProviderConfig{
+			ClientIDs: getSliceEnv("APPLE_CLIENT_IDS", nil),
+			JWKSURL:   getEnv("APPLE_JWKS_URL", "https://appleid.apple.com/auth/keys"),
+		},
+	}
+}
+
+// loadNPSConfig loads NPS survey settings
+func loadNPSConfig() NPSConfig {
+	return NPSConfig{
+		EveryNthBooking:    getIntEnv("NPS_SURVEY_EVERY_N", DefaultNPSSurveyEveryN),
+		ResponseWindowDays: getIntEnv("NPS_RESPONSE_WINDOW_DAYS", DefaultNPSResponseWindowDays),
+	}
+}
+
+// loadReviewRequestConfig loads review request settings
+func loadReviewRequestConfig() ReviewRequestConfig {
+	return ReviewRequestConfig{
+		Delay:              getDurationEnv("REVIEW_REQUEST_DELAY", DefaultReviewRequestDelay),
+		ResponseWindowDays: getIntEnv("REVIEW_REQUEST_WINDOW_DAYS", DefaultReviewRequestWindowDays),
+	}
+}
+
+// loadPushConfig loads push notification provider configuration.
+// Private keys may be given with literal "\n" sequences for line breaks.
+func loadPushConfig() PushConfig {
+	apnsBaseURL := "https://api.sandbox.push.apple.com"
+	if getEnv("APNS_PRODUCTION", "false") == "true" {
+		apnsBaseURL = "https://api.push.apple.com"
+	}
+
+	return PushConfig{
+		FCM: FCMConfig{
+			ProjectID:   getEnv("FCM_PROJECT_ID", ""),
+			ClientEmail: getEnv("FCM_CLIENT_EMAIL", ""),
+			PrivateKey:  strings.ReplaceAll(getEnv("FCM_PRIVATE_KEY", ""), `\n`, "\n"),
+			TokenURL:    getEnv("FCM_TOKEN_URL", "https://oauth2.googleapis.com/token"),
+			APIBaseURL:  getEnv("FCM_API_BASE_URL", "https://fcm.googleapis.com"),
+		},
+		APNs: APNsConfig{
+			KeyID:      getEnv("APNS_KEY_ID", ""),
+			TeamID:     getEnv("APNS_TEAM_ID", ""),
+			PrivateKey: strings.ReplaceAll(getEnv("APNS_PRIVATE_KEY", ""), `\n`, "\n"),
+			Topic:      getEnv("APNS_TOPIC", ""),
+			APIBaseURL: getEnv("APNS_API_BASE_URL", apnsBaseURL),
+		},
+		MaxAttempts: getIntEnv("PUSH_MAX_ATTEMPTS", DefaultPushMaxAttempts),
+	}
+}
+
+// ParseStatusHooks parses "status=action,action;status=action" bindings.
+// An empty value binds nothing; "status=" clears a status.
+func ParseStatusHooks(value string) (map[string][]string, error) {
+	hooks := make(map[string][]string)
+	for _, binding := range strings.Split(value, ";") {
+		binding = strings.TrimSpace(binding)
+		if binding == "" {
+			continue
+		}
+		status, actions, ok := strings.Cut(binding, "=")
+		status = strings.TrimSpace(status)
+		if !ok || status == "" {
+			return nil, fmt.Errorf("binding %q must be in status=action form", binding)
+		}
+		hooks[status] = []string{}
+		for _, action := range strings.Split(actions, ",") {
+			if action = strings.TrimSpace(action); action != "" {
+				hooks[status] = append(hooks[status], action)
+			}
+		}
+	}
+	return hooks, nil
+}
+
 // validateConfig validates required configuration fields
 func validateConfig(config *Config) error {
 	var errors []string
@@ -243,6 +1078,35 @@ func validateConfig(config *Config) error {
 		errors = append(errors, "JWT_SECRET is required")
 	}
 
+	if config.Chaos.Enabled && config.App.Environment == "production" {
+		errors = append(errors, "CHAOS_ENABLED must not be set in production")
+	}
+
+	switch config.Upload.Driver {
+	case UploadDriverLocal:
+	case UploadDriverS3:
+		if !config.Upload.S3.IsConfigured() {
+			errors = append(errors, "UPLOAD_DRIVER=s3 requires S3_BUCKET, S3_REGION, S3_ACCESS_KEY_ID and S3_SECRET_ACCESS_KEY")
+		}
+	default:
+		errors = append(errors, fmt.Sprintf("UPLOAD_DRIVER must be %s or %s", UploadDriverLocal, UploadDriverS3))
+	}
+
+	if _, err := time.LoadLocation(config.Cron.Timezone); err != nil {
+		errors = append(errors, fmt.Sprintf("CRON_TIMEZONE is invalid: %v", err))
+	}
+	if _, err := time.LoadLocation(config.Localization.DefaultTimezone); err != nil {
+		errors = append(errors, fmt.Sprintf("DEFAULT_TIMEZONE is invalid: %v", err))
+	}
+	if config.QuietHours.Enabled {
+		if _, err := time.Parse("15:04", config.QuietHours.Start); err != nil {
+			errors = append(errors, "QUIET_HOURS_START must be in HH:MM format")
+		}
+		if _, err := time.Parse("15:04", config.QuietHours.End); err != nil {
+			errors = append(errors, "QUIET_HOURS_END must be in HH:MM format")
+		}
+	}
+
 	if len(errors) > 0 {
 		return fmt.Errorf("validation errors: %s", strings.Join(errors, ", "))
 	}
@@ -260,6 +1124,15 @@ func getEnv(key, fallback string) string {
 	return fallback
 }
 
+// getScheduleEnv gets a cron schedule environment variable; "off" disables
+// the job
+func getScheduleEnv(key, fallback string) string {
+	if value := getEnv(key, fallback); value != "off" {
+		return value
+	}
+	return ""
+}
+
 // getIntEnv gets an integer environment variable with a fallback value
 func getIntEnv(key string, fallback int) int {
 	if value := os.Getenv(key); value != "" {
@@ -282,6 +1155,17 @@ func getInt64Env(key string, fallback int64) int64 {
 	return fallback
 }
 
+// getFloatEnv gets a float environment variable with a fallback value
+func getFloatEnv(key string, fallback float64) float64 {
+	if value := os.Getenv(key); value != "" {
+		if floatValue, err := strconv.ParseFloat(value, 64); err == nil {
+			return floatValue
+		}
+		log.Printf("Warning: Invalid float value for %s: %s, using fallback: %g", key, value, fallback)
+	}
+	return fallback
+}
+
 // getDurationEnv gets a duration environment variable with a fallback value
 func getDurationEnv(key string, fallback time.Duration) time.Duration {
 	if value := os.Getenv(key); value != "" {
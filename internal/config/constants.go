@@ -14,6 +14,10 @@ const (
 	// MaxPageLimit is the maximum number of items per page
 	MaxPageLimit = 100
 
+	// MaxElevatedPageLimit is the maximum number of items per page for
+	// admins and consumers with an API quota
+	MaxElevatedPageLimit = 1000
+
 	// MinPageLimit is the minimum number of items per page
 	MinPageLimit = 1
 
@@ -21,6 +25,23 @@ const (
 	BarberServicesPageLimit = 50
 )
 
+// Notification times for users without time preferences
+const (
+	DefaultNotificationTimezone = "UTC"
+	DefaultNotificationLocale   = "en"
+)
+
+// Quiet hours, in each recipient's local time, when non-urgent
+// notifications are held back
+const (
+	DefaultQuietHoursStart = "22:00"
+	DefaultQuietHoursEnd   = "08:00"
+)
+
+// DefaultPaginationEndpointLimits caps the pages of the costliest public
+// lists below MaxPageLimit: barber listings are ranked per request
+var DefaultPaginationEndpointLimits = []string{"/api/v1/barbers=50"}
+
 // ========================================================================
 // CACHE TTL CONSTANTS
 // ========================================================================
@@ -70,6 +91,54 @@ const (
 
 	// BookingBufferMinutes is the buffer time between bookings
 	BookingBufferMinutes = 15
+
+	// DefaultFullRefundHours is the notice needed for a full refund on cancellation
+	DefaultFullRefundHours = 24
+
+	// DefaultPartialRefundHours is the notice needed for a partial refund on cancellation
+	DefaultPartialRefundHours = 2
+
+	// DefaultPartialRefundPercent is the share refunded inside the partial window
+	DefaultPartialRefundPercent = 50
+
+	// DefaultPriceAdjustmentMaxIncreasePercent is how far above the booked
+	// service price a barber may charge on completion
+	DefaultPriceAdjustmentMaxIncreasePercent = 50.0
+
+	// DefaultPriceAdjustmentMaxDecreasePercent is how far below it
+	DefaultPriceAdjustmentMaxDecreasePercent = 50.0
+
+	// Barber assignment strategies for bookings made with a shop rather
+	// than a barber ("any available barber")
+	AssignmentStrategyLeastLoaded    = "least_loaded"    // Fewest active bookings that day
+	AssignmentStrategyFirstAvailable = "first_available" // First in the shop's barber list
+	AssignmentStrategyRandom         = "random"
+
+	// DefaultAssignmentStrategy spreads bookings across a shop's barbers
+	DefaultAssignmentStrategy = AssignmentStrategyLeastLoaded
+
+	// DashboardRunningLateGraceMinutes is how far behind schedule a barber can
+	// be before the dashboard flags them as running late
+	DashboardRunningLateGraceMinutes = 5
+
+	// DashboardPendingLookaheadDays bounds how far ahead the dashboard lists
+	// unconfirmed bookings
+	DashboardPendingLookaheadDays = 14
+
+	// MaxDurationSegments caps the active/passive segments of a service
+	MaxDurationSegments = 10
+
+	// MaxBookingServices caps the services booked in one appointment
+	MaxBookingServices = 5
+
+	// MaxBookingServiceNameLength is the longest service name kept on a booking
+	MaxBookingServiceNameLength = 150
+
+	// MaxServiceAddOns caps the add-ons one barber service may offer
+	MaxServiceAddOns = 20
+
+	// MaxBookingAddOns caps the add-ons chosen in one appointment
+	MaxBookingAddOns = 10
 )
 
 // ========================================================================
@@ -175,6 +244,42 @@ const (
 
 	// ThumbnailHeight is the height of thumbnail images in pixels
 	ThumbnailHeight = 300
+
+	// DefaultJSONBodyLimitBytes is the default body limit for JSON API routes (1 MB)
+	DefaultJSONBodyLimitBytes = 1 * 1024 * 1024
+
+	// DefaultMaxMultipartParts is the default maximum number of parts in a multipart upload
+	DefaultMaxMultipartParts = 20
+
+	// MaxImagePixels is the largest image (width x height) decoded, so a small
+	// file cannot expand into a huge bitmap
+	MaxImagePixels = 40_000_000
+
+	// MaxReviewImages is the maximum number of photos on a review
+	MaxReviewImages = 5
+
+	// UploadImageMaxDimension is the longest side, in pixels, uploaded photos
+	// are stored at; larger uploads are scaled down
+	UploadImageMaxDimension = 2048
+
+	// MaxPortfolioCaptionLength is the longest caption on a portfolio photo
+	MaxPortfolioCaptionLength = 300
+
+	// DefaultUploadPublicPath is where this API serves locally stored files
+	DefaultUploadPublicPath = "/uploads"
+)
+
+// Portfolio media kinds (barber_services.portfolio_images and
+// before_after_images)
+const (
+	PortfolioKindWork        = "portfolio"
+	PortfolioKindBeforeAfter = "before_after"
+)
+
+// Upload storage drivers
+const (
+	UploadDriverLocal = "local"
+	UploadDriverS3    = "s3"
 )
 
 // ========================================================================
@@ -206,14 +311,45 @@ const (
 	// BookingReminderHoursBefore is hours before booking to send reminder
 	BookingReminderHoursBefore = 24
 
-	// ReviewRequestHoursAfter is hours after completed booking to request review
-	ReviewRequestHoursAfter = 24
+	// DefaultReviewRequestDelay is how long after completion a review is requested
+	DefaultReviewRequestDelay = 2 * time.Hour
+
+	// DefaultReviewRequestWindowDays is how long a review link accepts a review
+	DefaultReviewRequestWindowDays = 7
 
 	// MaxNotificationRetries is maximum retry attempts for failed notifications
 	MaxNotificationRetries = 3
 
 	// NotificationRetryDelay is delay between notification retry attempts
 	NotificationRetryDelay = 5 * time.Minute
+
+	// MaxUnreadCountWaitSeconds caps how long an unread-count long-poll may block
+	MaxUnreadCountWaitSeconds = 30
+)
+
+// ========================================================================
+// REAL-TIME CONSTANTS
+// ========================================================================
+
+const (
+	// RealtimeHeartbeatInterval is how often an idle WebSocket or event stream is sent a
+	// heartbeat, so proxies keep it open and dead connections are noticed
+	RealtimeHeartbeatInterval = 30 * time.Second
+
+	// RealtimeWriteTimeout bounds a single WebSocket or event stream write to a slow client
+	RealtimeWriteTimeout = 10 * time.Second
+
+	// Real-time event types
+	RealtimeEventNotification  = "notification"
+	RealtimeEventBookingStatus = "booking_status"
+	RealtimeEventUnreadCount   = "unread_count"
+	RealtimeEventHeartbeat     = "heartbeat"
+
+	// Barber booking feed event types (Server-Sent Events)
+	RealtimeEventReady            = "ready"
+	RealtimeEventBookingCreated   = "booking.created"
+	RealtimeEventBookingUpdated   = "booking.updated"
+	RealtimeEventBookingCancelled = "booking.cancelled"
 )
 
 // ========================================================================
@@ -267,11 +403,537 @@ const (
 
 	// Payment statuses
 	PaymentStatusPending       = "pending"
+	PaymentStatusAuthorized    = "authorized" // Funds held, not yet captured
 	PaymentStatusPaid          = "paid"
 	PaymentStatusPartiallyPaid = "partially_paid"
 	PaymentStatusFailed        = "failed"
 	PaymentStatusRefunded      = "refunded"
 	PaymentStatusCancelled     = "cancelled"
+
+	// Booking series statuses
+	BookingSeriesStatusActive    = "active"
+	BookingSeriesStatusCancelled = "cancelled"
+
+	// Cancellation reason codes, picked from a list when cancelling
+	CancellationReasonSick               = "sick"
+	CancellationReasonScheduleConflict   = "schedule_conflict"
+	CancellationReasonFoundAnotherBarber = "found_another_barber"
+	CancellationReasonPrice              = "price"
+	CancellationReasonOther              = "other"
+)
+
+// CancellationReasonCodes are the cancellation reasons, in the order the
+// list shows them
+var CancellationReasonCodes = []string{
+	CancellationReasonSick,
+	CancellationReasonScheduleConflict,
+	CancellationReasonFoundAnotherBarber,
+	CancellationReasonPrice,
+	CancellationReasonOther,
+}
+
+// ========================================================================
+// BARBER SCHEDULE CONSTANTS
+// ========================================================================
+
+const (
+	// Weekly schedule period types
+	SchedulePeriodWork  = "work"
+	SchedulePeriodBreak = "break"
+
+	// Schedule exception types
+	ScheduleExceptionVacation    = "vacation"
+	ScheduleExceptionHoliday     = "holiday"
+	ScheduleExceptionClosed      = "closed"
+	ScheduleExceptionCustomHours = "custom_hours"
+
+	// DefaultScheduleTimezone is used when a schedule has no timezone
+	DefaultScheduleTimezone = "UTC"
+
+	// MaxTimeOffDays caps the length of one time-off block
+	MaxTimeOffDays = 90
+
+	// TimeOffRescheduleSuggestions is how many open slots a reschedule
+	// offer suggests, searched over TimeOffRescheduleSearchDays after the
+	// time off ends
+	TimeOffRescheduleSuggestions = 3
+	TimeOffRescheduleSearchDays  = 14
+)
+
+// ========================================================================
+// AUTO-REPLY CONSTANTS
+// ========================================================================
+
+const (
+	// DefaultAutoReplyMessage is sent by barbers who enable auto-replies
+	// without writing their own message
+	DefaultAutoReplyMessage = "Thanks for your booking request! {{barber_name}} is away right now and will get back to you when they're back on {{next_open_time}}."
+
+	// MaxAutoReplyLength is the longest auto-reply message (before rendering)
+	MaxAutoReplyLength = 1000
+
+	// AutoReplyLookaheadDays is how far ahead the next opening is searched;
+	// beyond it the reply says the barber will respond when they are back
+	AutoReplyLookaheadDays = 30
+
+	// AutoReplyTimeFormat renders {{next_open_time}} in the barber's timezone
+	AutoReplyTimeFormat = "Monday, January 2 at 3:04 PM MST"
+)
+
+// AutoReplyVariables are the placeholders an auto-reply message may use,
+// written as {{name}}
+var AutoReplyVariables = []string{
+	"barber_name",
+	"customer_name",
+	"service_name",
+	"booking_number",
+	"next_open_time",
+}
+
+// ========================================================================
+// BOOKING STATUS HOOK CONSTANTS
+// ========================================================================
+
+const (
+	// Actions that can run when a booking enters a status
+	StatusHookReviewRequest = "review_request" // Ask the customer for a review
+	StatusHookBarberStats   = "barber_stats"   // Refresh the barber's booking counters
+	StatusHookCalendarSync  = "calendar_sync"  // Push the booking to an external calendar
+	StatusHookNoShowFee     = "no_show_fee"    // Charge the no-show fee
+	StatusHookNPSSurvey     = "nps_survey"     // Send an NPS micro-survey (every Nth completed booking)
+	StatusHookInventory     = "inventory"      // Take the service's products out of stock
+
+	// DefaultBookingStatusHooks binds statuses to actions
+	// (format: status=action,action;status=action). calendar_sync is left
+	// out: nothing provides it until a calendar integration registers one.
+	DefaultBookingStatusHooks = "completed=review_request,barber_stats,nps_survey,inventory;no_show=no_show_fee,barber_stats"
+)
+
+// ========================================================================
+// RECURRING BOOKING CONSTANTS
+// ========================================================================
+
+const (
+	// Recurrence frequencies
+	RecurrenceWeekly   = "weekly"
+	RecurrenceBiweekly = "biweekly"
+	RecurrenceMonthly  = "monthly"
+
+	// MinRecurringOccurrences is the smallest series (a single booking is not a series)
+	MinRecurringOccurrences = 2
+
+	// MaxRecurringOccurrences caps how many bookings one series may create
+	MaxRecurringOccurrences = 52
+
+	// MaxRecurringHorizon is how far ahead the last occurrence may be scheduled
+	MaxRecurringHorizon = 365 * 24 * time.Hour
+)
+
+// ========================================================================
+// BARBER LOCATION CONSTANTS
+// ========================================================================
+
+const (
+	// MaxBarberLocations caps the locations one barber may work at
+	MaxBarberLocations = 20
+
+	// MaxTravelMinutes caps a travel time between two locations
+	MaxTravelMinutes = 240
+)
+
+// ========================================================================
+// INVENTORY CONSTANTS
+// ========================================================================
+
+const (
+	// MaxInventoryItems caps the products one barber may track
+	MaxInventoryItems = 200
+
+	// DefaultInventoryUnit is the unit stock is counted in when none is given
+	DefaultInventoryUnit = "unit"
+
+	// Inventory usage reports cover UTC days, inclusive
+	DefaultInventoryUsageRangeDays = 30
+	MaxInventoryUsageRangeDays     = 366
+)
+
+// ========================================================================
+// CLIENT IMPORT CONSTANTS
+// ========================================================================
+
+const (
+	// MaxClientImportRows caps the data rows of one client import file
+	MaxClientImportRows = 5000
+
+	// DefaultCustomerInvitationTTL is how long an imported client's
+	// invitation to claim their account stays valid
+	DefaultCustomerInvitationTTL = 14 * 24 * time.Hour
+
+	// DefaultCustomerClaimURL is the page invitations link to; the token is
+	// appended as ?token=
+	DefaultCustomerClaimURL = "/claim-account"
+
+	// Where a barber's client list entry came from
+	ClientSourceImport = "import"
+
+	// What an import did with each row
+	ClientImportCreated   = "created"   // New client entry and customer account
+	ClientImportLinked    = "linked"    // New client entry for an existing account
+	ClientImportDuplicate = "duplicate" // Already on the client list, or repeated in the file
+	ClientImportFailed    = "failed"    // Invalid row
+)
+
+// ========================================================================
+// BOOKING IMPORT CONSTANTS
+// ========================================================================
+
+const (
+	// MaxBookingImportRows caps the data rows of one booking history file
+	MaxBookingImportRows = 20000
+
+	// BookingSourceImport marks bookings imported from another tool
+	BookingSourceImport = "import"
+
+	// What a booking import did with each row
+	BookingImportCreated   = "created"   // New historical booking
+	BookingImportDuplicate = "duplicate" // Imported before, or repeated in the file
+	BookingImportFailed    = "failed"    // Invalid row
+)
+
+// ========================================================================
+// WEBHOOK CONSTANTS
+// ========================================================================
+
+const (
+	// DefaultWebhookTimeout bounds a single delivery request
+	DefaultWebhookTimeout = 10 * time.Second
+
+	// MaxWebhookSubscriptionsPerBarber caps a barber's registered URLs
+	MaxWebhookSubscriptionsPerBarber = 10
+
+	// MaxWebhookErrorLength caps the response excerpt kept for a failed delivery
+	MaxWebhookErrorLength = 500
+
+	// Webhook events
+	WebhookEventBookingCreated   = "booking.created"   // A booking was made
+	WebhookEventBookingCancelled = "booking.cancelled" // The customer or barber cancelled a booking
+	WebhookEventBookingCompleted = "booking.completed" // A booking was marked completed
+	WebhookEventReviewCreated    = "review.created"    // A customer left a review (pending moderation)
+	WebhookEventReviewApproved   = "review.approved"   // A review was approved and published
+	WebhookEventReviewResponded  = "review.responded"  // The barber replied to a review
+
+	// Delivery statuses
+	WebhookDeliveryPending   = "pending"
+	WebhookDeliveryDelivered = "delivered"
+	WebhookDeliveryFailed    = "failed"
+)
+
+// ValidWebhookEvents are the events subscriptions can choose from
+var ValidWebhookEvents = []string{
+	WebhookEventBookingCreated,
+	WebhookEventBookingCancelled,
+	WebhookEventBookingCompleted,
+	WebhookEventReviewCreated,
+	WebhookEventReviewApproved,
+	WebhookEventReviewResponded,
+}
+
+// ========================================================================
+// STATUS PAGE CONSTANTS
+// ========================================================================
+
+const (
+	// DefaultStatusHistoryDays is how many days of component history the status page shows
+	DefaultStatusHistoryDays = 90
+
+	// MaxStatusHistoryDays caps the configured history
+	MaxStatusHistoryDays = 365
+
+	// Circuit breakers guarding external providers
+	DefaultBreakerFailureThreshold = 5
+	DefaultBreakerOpenTimeout      = 30 * time.Second
+
+	// Status page components
+	StatusComponentAPI           = "api"
+	StatusComponentPayments      = "payments"
+	StatusComponentNotifications = "notifications"
+
+	// Component statuses, from best to worst
+	ComponentOperational = "operational"
+	ComponentDegraded    = "degraded"
+	ComponentMajorOutage = "major_outage"
+)
+
+// StatusComponents are the components reported on the status page, in display order
+var StatusComponents = []string{
+	StatusComponentAPI,
+	StatusComponentPayments,
+	StatusComponentNotifications,
+}
+
+// ========================================================================
+// BOOKING FORM CONSTANTS
+// ========================================================================
+
+const (
+	// MaxBookingFormFields caps the custom fields on a barber's booking form
+	MaxBookingFormFields = 20
+
+	// MaxBookingFormOptions caps the choices of a select field
+	MaxBookingFormOptions = 50
+
+	// DefaultBookingFormMaxLength caps text answers of fields without their
+	// own max_length; MaxBookingFormMaxLength caps any field's
+	DefaultBookingFormMaxLength = 500
+	MaxBookingFormMaxLength     = 2000
+
+	// Custom field types
+	BookingFormFieldText     = "text"     // Free text, optionally matching a pattern
+	BookingFormFieldNumber   = "number"   // A number, optionally within min_value..max_value
+	BookingFormFieldSelect   = "select"   // One of the field's options
+	BookingFormFieldCheckbox = "checkbox" // true or false
+)
+
+// ValidBookingFormFieldTypes are the types a custom field can have
+var ValidBookingFormFieldTypes = []string{
+	BookingFormFieldText,
+	BookingFormFieldNumber,
+	BookingFormFieldSelect,
+	BookingFormFieldCheckbox,
+}
+
+// Consultation request statuses: answers to a service's booking questions
+// asked for a consultation instead of a booking, or the customer asked
+// for one
+const (
+	ConsultationRequestOpen     = "open"     // Waiting for the barber
+	ConsultationRequestQuoted   = "quoted"   // Waiting for the customer to accept the barber's quote
+	ConsultationRequestBooked   = "booked"   // The customer is booked in
+	ConsultationRequestDeclined = "declined" // The barber turned the request down
+)
+
+// ValidConsultationRequestStatuses are the statuses of consultation requests
+var ValidConsultationRequestStatuses = []string{
+	ConsultationRequestOpen,
+	ConsultationRequestQuoted,
+	ConsultationRequestBooked,
+	ConsultationRequestDeclined,
+}
+
+const (
+	// MaxConsultationPreferredTimes is the most times a customer may
+	// suggest for a consultation
+	MaxConsultationPreferredTimes = 5
+
+	// MaxConsultationPhotos is the most photos on a consultation request
+	MaxConsultationPhotos = 5
+)
+
+// ========================================================================
+// OPEN SLOT CONSTANTS
+// ========================================================================
+
+const (
+	// Open slot statuses
+	OpenSlotStatusOpen      = "open"      // Broadcast and waiting for a taker
+	OpenSlotStatusClaimed   = "claimed"   // Booked by the first customer to claim it
+	OpenSlotStatusCancelled = "cancelled" // Withdrawn by the barber
+
+	// Who an open slot is broadcast to
+	OpenSlotAudienceFavorites = "favorites" // Customers who favorited the barber
+	OpenSlotAudienceNearby    = "nearby"    // Customers whose location is within the radius
+
+	// OpenSlotMaxLeadHours keeps broadcasts last-minute: open slots start
+	// within this many hours
+	OpenSlotMaxLeadHours = 48
+
+	// OpenSlotClaimNotice is when claims close before the start, matching
+	// the notice every booking needs
+	OpenSlotClaimNotice = time.Hour
+
+	// DefaultOpenSlotRadiusKm and MaxOpenSlotRadiusKm bound "nearby"
+	DefaultOpenSlotRadiusKm = 5.0
+	MaxOpenSlotRadiusKm     = 50.0
+
+	// MaxOpenSlotDiscountPercent caps an open slot's flash discount
+	MaxOpenSlotDiscountPercent = 50
+
+	// MaxOpenSlotRecipients caps the customers one broadcast reaches
+	MaxOpenSlotRecipients = 500
+
+	// MaxOpenSlotsPerDay caps a barber's broadcasts per (UTC) day
+	MaxOpenSlotsPerDay = 5
+
+	// MaxOpenSlotsListed caps the slots returned to the barber
+	MaxOpenSlotsListed = 50
+
+	// BookingSourceOpenSlot marks bookings made by claiming an open slot
+	BookingSourceOpenSlot = "open_slot"
+)
+
+// ValidOpenSlotAudiences are the audiences an open slot can be sent to
+var ValidOpenSlotAudiences = []string{
+	OpenSlotAudienceFavorites,
+	OpenSlotAudienceNearby,
+}
+
+// ========================================================================
+// SUPPORT TICKET CONSTANTS
+// ========================================================================
+
+const (
+	// What a support ticket is about
+	SupportCategoryBooking = "booking"
+	SupportCategoryPayment = "payment"
+	SupportCategoryAccount = "account"
+	SupportCategoryOther   = "other"
+
+	// Support ticket statuses
+	SupportStatusOpen              = "open"                // Waiting for support
+	SupportStatusInProgress        = "in_progress"         // Being worked on
+	SupportStatusWaitingOnCustomer = "waiting_on_customer" // Waiting for the requester; the resolution timer is paused
+	SupportStatusResolved          = "resolved"            // Answered; a reply from the requester reopens it
+	SupportStatusClosed            = "closed"              // Final
+
+	// Support ticket priorities
+	SupportPriorityLow    = "low"
+	SupportPriorityNormal = "normal"
+	SupportPriorityHigh   = "high"
+	SupportPriorityUrgent = "urgent"
+
+	// MaxOpenSupportTickets caps the unresolved tickets one user may have
+	MaxOpenSupportTickets = 10
+)
+
+// ValidSupportCategories lists the accepted ticket categories
+var ValidSupportCategories = []string{
+	SupportCategoryBooking,
+	SupportCategoryPayment,
+	SupportCategoryAccount,
+	SupportCategoryOther,
+}
+
+// ValidSupportPriorities lists the ticket priorities, lowest first
+var ValidSupportPriorities = []string{
+	SupportPriorityLow,
+	SupportPriorityNormal,
+	SupportPriorityHigh,
+	SupportPriorityUrgent,
+}
+
+// SupportFirstResponseTargets is how soon after opening a ticket of each
+// priority support must first reply
+var SupportFirstResponseTargets = map[string]time.Duration{
+	SupportPriorityLow:    24 * time.Hour,
+	SupportPriorityNormal: 8 * time.Hour,
+	SupportPriorityHigh:   4 * time.Hour,
+	SupportPriorityUrgent: time.Hour,
+}
+
+// SupportResolutionTargets is how soon after opening a ticket of each
+// priority it must be resolved, not counting time waiting on the requester
+var SupportResolutionTargets = map[string]time.Duration{
+	SupportPriorityLow:    5 * 24 * time.Hour,
+	SupportPriorityNormal: 3 * 24 * time.Hour,
+	SupportPriorityHigh:   24 * time.Hour,
+	SupportPriorityUrgent: 8 * time.Hour,
+}
+
+// ========================================================================
+// OUTBOX CONSTANTS
+// ========================================================================
+
+const (
+	// Domain events recorded in the outbox with the change that caused them
+	DomainEventBookingCreated       = "booking.created"        // A booking (or series occurrence) was made
+	DomainEventBookingStatusChanged = "booking.status_changed" // A booking moved to a status other than cancelled
+	DomainEventBookingCancelled     = "booking.cancelled"      // A booking was cancelled by anyone
+
+	// Aggregate types of outbox events
+	OutboxAggregateBooking = "booking"
+
+	// Outbox event statuses
+	OutboxEventPending    = "pending"
+	OutboxEventDispatched = "dispatched" // Every handler succeeded
+	OutboxEventFailed     = "failed"     // Gave up after the maximum attempts
+
+	// Outbox handlers, recorded on an event as each succeeds
+	OutboxHandlerNotifications = "notifications"
+	OutboxHandlerWebhooks      = "webhooks"
+	OutboxHandlerCache         = "cache"
+)
+
+// ========================================================================
+// COMMISSION SPLIT CONSTANTS
+// ========================================================================
+
+const (
+	// Roles of the staff who worked on a booking
+	StaffRoleLead      = "lead"      // The booking's barber; keeps what assistants don't get
+	StaffRoleAssistant = "assistant" // Performed part of the work for a share
+
+	// MaxBookingAssistants caps the assistants on one booking
+	MaxBookingAssistants = 5
+
+	// Earnings reports cover UTC days, inclusive
+	DefaultEarningsRangeDays = 30
+	MaxEarningsRangeDays     = 366
+)
+
+// ========================================================================
+// FINANCIAL LEDGER CONSTANTS
+// ========================================================================
+
+const (
+	// Financial event types
+	FinancialEventPaymentAuthorized = "payment.authorized" // Funds held on a card
+	FinancialEventPaymentCaptured   = "payment.captured"   // Held funds collected
+	FinancialEventPaymentVoided     = "payment.voided"     // Hold released
+	FinancialEventRefundIssued      = "refund.issued"      // Collected funds returned
+	FinancialEventFeeCharged        = "fee.charged"        // Platform commission on a completed booking
+	FinancialEventPayoutAccrued     = "payout.accrued"     // Owed to a barber for a completed booking
+
+	// FinancialChainGenesis is the previous hash of the first ledger event
+	FinancialChainGenesis = "0000000000000000000000000000000000000000000000000000000000000000"
+
+	// Ledger exports cover UTC days, inclusive
+	DefaultLedgerExportDays = 31
+	MaxLedgerExportDays     = 366
+
+	// LedgerVerifyBatchSize is how many events are read at a time when
+	// verifying the whole chain
+	LedgerVerifyBatchSize = 1000
+)
+
+// ========================================================================
+// TAX CONSTANTS
+// ========================================================================
+
+const (
+	// MaxTaxRatePercent caps a single tax rule's rate
+	MaxTaxRatePercent = 100
+
+	// Tax reports cover UTC days, inclusive
+	DefaultTaxReportRangeDays = 30
+	MaxTaxReportRangeDays     = 366
+)
+
+// ========================================================================
+// CALENDAR EXPORT CONSTANTS
+// ========================================================================
+
+const (
+	// CalendarFeedMaxEvents caps the bookings listed in a calendar feed
+	CalendarFeedMaxEvents = 500
+
+	// CalendarFeedRefreshInterval is how often calendar apps are asked to refetch a feed
+	CalendarFeedRefreshInterval = time.Hour
+
+	// CalendarFeedName is the name calendar apps show for a subscribed feed
+	CalendarFeedName = "Barber appointments"
+
+	// CalendarEventDomain makes booking event UIDs globally unique
+	CalendarEventDomain = "barber-booking-system"
 )
 
 // ========================================================================
@@ -294,8 +956,59 @@ const (
 	ReviewModerationApproved = "approved"
 	ReviewModerationRejected = "rejected"
 	ReviewModerationFlagged  = "flagged"
+
+	// ReviewResponseRedacted is a barber response taken down after it went
+	// live; responses otherwise use the review moderation statuses
+	ReviewResponseRedacted = "redacted"
+
+	// Moderation queue actions and the statuses they set
+	ModerationActionApprove = "approve"
+	ModerationActionReject  = "reject"
+	ModerationActionFlag    = "flag"
+	ModerationActionRedact  = "redact" // Barber responses only
+
+	// MaxBulkModeration caps the reviews one bulk moderation request acts on
+	MaxBulkModeration = 100
+
+	// Reasons users report reviews for
+	ReviewReportSpam                = "spam"
+	ReviewReportOffensive           = "offensive"
+	ReviewReportFake                = "fake"
+	ReviewReportPersonalInformation = "personal_information"
+	ReviewReportOffTopic            = "off_topic"
+	ReviewReportOther               = "other" // Requires details
+
+	// DefaultReviewReportThreshold is the open reports that flag a
+	// published review for moderators
+	DefaultReviewReportThreshold = 3
+
+	// Review screening defaults: at most one link, and links no more than a
+	// fifth of the words
+	DefaultReviewMaxLinks       = 1
+	DefaultReviewMaxLinkDensity = 0.2
+
+	// DefaultReviewDuplicateWindow is how far back an identical comment
+	// makes a new one a duplicate
+	DefaultReviewDuplicateWindow = 30 * 24 * time.Hour
+
+	// DefaultReviewDuplicateMinLength keeps short stock phrases from
+	// counting as duplicates
+	DefaultReviewDuplicateMinLength = 20
+
+	// A barber getting more reviews without text than this within the
+	// window looks like a rating flood
+	DefaultReviewRatingOnlyLimit  = 5
+	DefaultReviewRatingOnlyWindow = time.Hour
 )
 
+// DefaultReviewBlockedWords is the profanity screened out of reviews unless
+// REVIEW_BLOCKED_WORDS replaces it
+var DefaultReviewBlockedWords = []string{
+	"fuck", "fucker", "motherfucker", "shit", "bullshit", "bitch", "cunt",
+	"asshole", "bastard", "dick", "dickhead", "prick", "piss", "twat",
+	"wanker", "whore", "slut", "douche", "douchebag",
+}
+
 // ========================================================================
 // NOTIFICATION STATUS VALUES
 // ========================================================================
@@ -329,24 +1042,542 @@ const (
 	NotificationTypePasswordReset       = "password_reset"
 	NotificationTypePromotion           = "promotion"
 	NotificationTypeSystemAlert         = "system_alert"
+	NotificationTypeNPSSurvey           = "nps_survey"
+	NotificationTypeWinBack             = "win_back"
+	NotificationTypeAutoReply           = "auto_reply"
+	NotificationTypeLowStock            = "low_stock"
+	NotificationTypeConfirmationRequest = "confirmation_request"
+	NotificationTypeBookingNoShow       = "booking_no_show"
+	NotificationTypeCustomerInvitation  = "customer_invitation"
+	NotificationTypeOpenSlot            = "open_slot"
+	NotificationTypeSupportTicket       = "support_ticket"
+	NotificationTypeSupportSLABreach    = "support_sla_breach"
+	NotificationTypeRescheduleOffer     = "reschedule_offer"
+	NotificationTypeConsultationRequest = "consultation_request"
 
 	// Notification channels
 	NotificationChannelApp   = "app"
 	NotificationChannelEmail = "email"
 	NotificationChannelSMS   = "sms"
 	NotificationChannelPush  = "push"
+
+	// Push device platforms
+	DevicePlatformIOS     = "ios"
+	DevicePlatformAndroid = "android"
+	DevicePlatformWeb     = "web"
+
+	// DefaultPushMaxAttempts is how many times a push is tried per device
+	DefaultPushMaxAttempts = 3
+
+	// Suppression reasons - why an email address or phone number is no
+	// longer sent to
+	SuppressionReasonHardBounce    = "hard_bounce"    // Address does not exist or cannot receive messages
+	SuppressionReasonSpamComplaint = "spam_complaint" // Recipient reported a message as spam
+	SuppressionReasonOptOut        = "opt_out"        // Recipient unsubscribed (e.g. replied STOP)
+	SuppressionReasonManual        = "manual"         // Added by an admin
+
+	// Suppression sources other than providers
+	SuppressionSourceAdmin = "admin"
 )
 
+// SuppressionChannels are the channels that have a suppression list
+var SuppressionChannels = []string{NotificationChannelEmail, NotificationChannelSMS}
+
+// SuppressionReasons lists every suppression reason
+var SuppressionReasons = []string{
+	SuppressionReasonHardBounce,
+	SuppressionReasonSpamComplaint,
+	SuppressionReasonOptOut,
+	SuppressionReasonManual,
+}
+
+// RequiredNotificationTypes always go out on their default channels: users
+// cannot turn them off in their notification preferences (account security)
+var RequiredNotificationTypes = []string{
+	NotificationTypeAccountVerification,
+	NotificationTypePasswordReset,
+}
+
 // ========================================================================
 // RELATED ENTITY TYPES
 // ========================================================================
 
 const (
-	EntityTypeBooking = "booking"
-	EntityTypePayment = "payment"
-	EntityTypeReview  = "review"
+	EntityTypeBooking       = "booking"
+	EntityTypePayment       = "payment"
+	EntityTypeReview        = "review"
+	EntityTypeInventoryItem = "inventory_item"
+	EntityTypeOpenSlot      = "open_slot"
+	EntityTypeSupportTicket = "support_ticket"
+	EntityTypeConsultation  = "consultation_request"
+	EntityTypeService       = "service"
+	EntityTypeCategory      = "service_category"
+	EntityTypeRole          = "role"
+	EntityTypeUser          = "user"
+	EntityTypeFeatured      = "featured_placement"
+	EntityTypeDataset       = "analytics_dataset"
+	EntityTypeFeaturedLimit = "featured_slot_limit"
+	EntityTypeExperiment    = "experiment"
+	EntityTypeSuppression   = "suppression"
+	EntityTypeTaxRule       = "tax_rule"
+	EntityTypeIncident      = "status_incident"
+)
+
+// ========================================================================
+// AUDIT LOG ACTIONS
+// ========================================================================
+
+const (
+	// AuditActorSystem is the actor type of entries recorded outside a request
+	AuditActorSystem = "system"
+
+	AuditActionServiceApproved   = "service.approved"
+	AuditActionReviewModerated   = "review.moderated"
+	AuditActionResponseModerated = "review.response_moderated"
+	AuditActionCategoryCreated   = "category.created"
+	AuditActionCategoryUpdated   = "category.updated"
+	AuditActionCategoryDeleted   = "category.deleted"
+	AuditActionRoleCreated       = "role.created"
+	AuditActionRoleUpdated       = "role.updated"
+	AuditActionRoleDeleted       = "role.deleted"
+	AuditActionUserRoleAssigned  = "user.role_assigned"
+	AuditActionUserRoleRevoked   = "user.role_revoked"
+	AuditActionDatasetReleased   = "analytics.dataset_released"
+	AuditActionAPIQuotaSet       = "user.api_quota_set"
+	AuditActionAPIQuotaDeleted   = "user.api_quota_deleted"
+	AuditActionFeaturedLimitSet  = "featured.slot_limit_set"
+	AuditActionExperimentCreated = "experiment.created"
+	AuditActionExperimentUpdated = "experiment.updated"
+	AuditActionExperimentStatus  = "experiment.status_changed"
+	AuditActionAddressSuppressed = "suppression.created"
+	AuditActionSuppressionLifted = "suppression.deleted"
+	AuditActionTaxRuleCreated    = "tax_rule.created"
+	AuditActionTaxRuleUpdated    = "tax_rule.updated"
+	AuditActionTaxRuleDeleted    = "tax_rule.deleted"
+	AuditActionIncidentCreated   = "status_incident.created"
+	AuditActionIncidentUpdated   = "status_incident.updated"
+)
+
+// ========================================================================
+// CUSTOMER TIMELINE EVENT TYPES
+// ========================================================================
+
+const (
+	TimelineEventBookingCreated     = "booking_created"
+	TimelineEventBookingConfirmed   = "booking_confirmed"
+	TimelineEventBookingStarted     = "booking_started"
+	TimelineEventBookingCompleted   = "booking_completed"
+	TimelineEventBookingCancelled   = "booking_cancelled"
+	TimelineEventBookingNoShow      = "booking_no_show"
+	TimelineEventBookingRescheduled = "booking_rescheduled"
+	TimelineEventPaymentAuthorized  = "payment_authorized"
+	TimelineEventPaymentReceived    = "payment_received"
+	TimelineEventPaymentRefunded    = "payment_refunded"
+	TimelineEventPaymentFailed      = "payment_failed"
+	TimelineEventReviewPosted       = "review_posted"
+
+	// Sources the timeline is projected from
+	TimelineSourceBookingHistory = "booking_history"
+	TimelineSourceReview         = "review"
+	TimelineSourcePayment        = "payment"
+)
+
+// ========================================================================
+// NPS SURVEY CONSTANTS
+// ========================================================================
+
+const (
+	// DefaultNPSSurveyEveryN sends a survey after every Nth completed booking per customer
+	DefaultNPSSurveyEveryN = 3
+
+	// DefaultNPSResponseWindowDays is how long a survey link accepts answers
+	DefaultNPSResponseWindowDays = 14
+
+	// NPS score bands: 0-6 detractor, 7-8 passive, 9-10 promoter
+	NPSPromoterMinScore = 9
+	NPSPassiveMinScore  = 7
+	NPSMaxScore         = 10
+
+	NPSCategoryPromoter  = "promoter"
+	NPSCategoryPassive   = "passive"
+	NPSCategoryDetractor = "detractor"
+
+	// Trend buckets
+	NPSIntervalWeek  = "week"
+	NPSIntervalMonth = "month"
+)
+
+// ========================================================================
+// BACKGROUND WORKER CONSTANTS
+// ========================================================================
+
+const (
+	// Notification delivery
+	DefaultNotificationPollInterval = 5 * time.Second
+	DefaultNotificationBatchSize    = 50
+	DefaultNotificationMaxAttempts  = 5
+	DefaultNotificationRetryBase    = 30 * time.Second
+	DefaultNotificationRetryMax     = time.Hour
+	DefaultNotificationLease        = 2 * time.Minute
+
+	// DefaultWinBackInterval runs the win-back campaign daily
+	DefaultWinBackInterval = 24 * time.Hour
+
+	// DefaultConfirmationRequestInterval is how often high-risk bookings
+	// entering the confirmation window are looked for
+	DefaultConfirmationRequestInterval = 15 * time.Minute
+
+	// DefaultReviewRequestInterval is how often review requests whose delay
+	// has passed are sent
+	DefaultReviewRequestInterval = 5 * time.Minute
+
+	// DefaultAutoNoShowInterval is how often confirmed bookings past their
+	// grace period are marked no-show
+	DefaultAutoNoShowInterval = 5 * time.Minute
+
+	// DefaultStatusCheckInterval is how often component health is checked
+	// for the status page
+	DefaultStatusCheckInterval = time.Minute
+
+	// DefaultSupportSLAInterval is how often support tickets are checked
+	// for missed SLA targets
+	DefaultSupportSLAInterval = 5 * time.Minute
+
+	// Webhook delivery
+	DefaultWebhookPollInterval = 10 * time.Second
+	DefaultWebhookBatchSize    = 50
+	DefaultWebhookMaxAttempts  = 8
+	DefaultWebhookRetryBase    = time.Minute
+	DefaultWebhookRetryMax     = 6 * time.Hour
+	DefaultWebhookLease        = 2 * time.Minute
+
+	// Outbox dispatch
+	DefaultOutboxPollInterval = 2 * time.Second
+	DefaultOutboxBatchSize    = 100
+	DefaultOutboxMaxAttempts  = 10
+	DefaultOutboxRetryBase    = 10 * time.Second
+	DefaultOutboxRetryMax     = time.Hour
+	DefaultOutboxLease        = 2 * time.Minute
+)
+
+// ========================================================================
+// AUTH CONSTANTS
+// ========================================================================
+
+const (
+	// DefaultRefreshTokenExpiration is how long a refresh token stays valid;
+	// each refresh issues a new one, so active users stay signed in
+	DefaultRefreshTokenExpiration = 30 * 24 * time.Hour
+
+	// DefaultRefreshTokenCleanupInterval is how often expired refresh tokens are deleted
+	DefaultRefreshTokenCleanupInterval = 24 * time.Hour
+
+	// Social sign-in providers
+	OAuthProviderGoogle = "google"
+	OAuthProviderApple  = "apple"
+)
+
+// ========================================================================
+// FEATURED PLACEMENT CONSTANTS
+// ========================================================================
+
+const (
+	// Featured placement statuses
+	FeaturedStatusPendingPayment = "pending_payment" // Slot held while the card is charged
+	FeaturedStatusActive         = "active"
+	FeaturedStatusCancelled      = "cancelled"
+
+	// DefaultFeaturedDailyPriceCents is the price of one day of featured placement
+	DefaultFeaturedDailyPriceCents = 1000
+
+	// DefaultFeaturedSlotLimit is how many barbers can be featured at once
+	// in one city/category unless an admin sets a different limit
+	DefaultFeaturedSlotLimit = 3
+
+	// DefaultFeaturedMaxDays is the longest placement that can be bought at once
+	DefaultFeaturedMaxDays = 90
+
+	// DefaultFeaturedMaxLeadDays is how far ahead a placement can start
+	DefaultFeaturedMaxLeadDays = 180
+)
+
+// ========================================================================
+// WIN-BACK CAMPAIGN CONSTANTS
+// ========================================================================
+
+const (
+	// DefaultWinBackInactiveWeeks is how far past their usual cadence a
+	// customer must be before a win-back message is sent
+	DefaultWinBackInactiveWeeks = 4
+
+	// DefaultWinBackMinVisits is the completed bookings needed to establish a cadence
+	DefaultWinBackMinVisits = 2
+
+	// DefaultWinBackCooldownDays is the minimum gap between win-back messages to one customer
+	DefaultWinBackCooldownDays = 90
+
+	// DefaultWinBackCouponValidDays is how long a win-back coupon can be redeemed
+	DefaultWinBackCouponValidDays = 30
+
+	// DefaultWinBackAttributionDays is how long after a message a new booking counts as a conversion
+	DefaultWinBackAttributionDays = 30
+
+	// DefaultWinBackBatchSize caps the customers messaged per run
+	DefaultWinBackBatchSize = 500
+
+	// WinBackCouponPrefix starts every win-back coupon code
+	WinBackCouponPrefix = "WB-"
+)
+
+// ========================================================================
+// NO-SHOW RISK CONSTANTS
+// ========================================================================
+
+const (
+	// DefaultNoShowRiskThreshold is the no-show rate at which a customer is
+	// asked to confirm their appointment
+	DefaultNoShowRiskThreshold = 0.2
+
+	// DefaultNoShowRiskMinBookings is the past appointments needed before a
+	// customer's no-show rate counts
+	DefaultNoShowRiskMinBookings = 2
+
+	// DefaultNoShowRiskLookbackBookings limits the rate to recent appointments
+	DefaultNoShowRiskLookbackBookings = 20
+
+	// DefaultConfirmationRequestHoursBefore sends the confirmation request
+	// alongside the regular reminder
+	DefaultConfirmationRequestHoursBefore = BookingReminderHoursBefore
+
+	// Answers to a confirmation request
+	ConfirmationResponseConfirmed = "confirmed"
+	ConfirmationResponseCancelled = "cancelled"
+)
+
+// ========================================================================
+// ACTION LINK CONSTANTS
+// ========================================================================
+
+const (
+	// DefaultActionLinkTTL is how long reminder links stay valid (they also
+	// expire when the appointment starts)
+	DefaultActionLinkTTL = 48 * time.Hour
+
+	// Actions a reminder link can take
+	BookingActionConfirm = "confirm"
+	BookingActionCancel  = "cancel"
+
+	// ActionLinkPath is where reminder links are answered; the token follows
+	ActionLinkPath = "/api/v1/bookings/actions/"
+)
+
+// ========================================================================
+// CRON CONSTANTS
+// ========================================================================
+
+const (
+	// Default schedules of the cron jobs (five-field cron expressions)
+	DefaultCronReminders           = "*/15 * * * *"
+	DefaultCronNotificationCleanup = "30 3 * * *"
+	DefaultCronStatsAggregation    = "0 4 * * *"
+	DefaultCronBookingExpiry       = "*/10 * * * *"
+	DefaultCronOutboxCleanup       = "45 3 * * *"
+	DefaultCronBookingArchive      = "15 2 * * *"
+	DefaultCronPartitions          = "30 1 * * *"
+	DefaultCronShopOffboarding     = "0 5 * * *"
+
+	// DefaultReminderHoursBefore is how long before the appointment the
+	// reminder goes out
+	DefaultReminderHoursBefore = 24
+
+	// DefaultNotificationRetention is how long read notifications are kept
+	DefaultNotificationRetention = 90 * 24 * time.Hour
+
+	// DefaultOutboxRetention is how long dispatched outbox events are kept
+	DefaultOutboxRetention = 7 * 24 * time.Hour
+
+	// StatsWindowDays is the window of the rolling "last 30 days" counters
+	StatsWindowDays = 30
+)
+
+// ========================================================================
+// BOOKING ARCHIVE CONSTANTS
+// ========================================================================
+
+const (
+	// DefaultBookingArchiveAfterYears is how long after its appointment a
+	// finished booking is moved to the archive (0 disables archival)
+	DefaultBookingArchiveAfterYears = 3
+
+	// BookingArchiveBatchSize is how many bookings are moved per transaction
+	BookingArchiveBatchSize = 500
+
+	// BookingArchiveMaxBatches bounds one archival run; the rest wait for
+	// the next run
+	BookingArchiveMaxBatches = 20
+)
+
+// ========================================================================
+// PARTITION CONSTANTS
+// ========================================================================
+
+const (
+	// PartitionMonthsAhead is how many months past the current one have
+	// their partition created in advance
+	PartitionMonthsAhead = 3
+
+	// DefaultNotificationPartitionMonths is how many months of
+	// notifications are kept before their partition is dropped (0 keeps
+	// them all). Read notifications are deleted sooner, see
+	// DefaultNotificationRetention.
+	DefaultNotificationPartitionMonths = 12
+
+	// NotificationDeliveryWindow bounds the delivery queries to recent
+	// partitions: pending notifications created longer ago are not sent,
+	// and the notification cleanup marks them failed
+	NotificationDeliveryWindow = 30 * 24 * time.Hour
+)
+
+// ========================================================================
+// MIGRATION CONSTANTS
+// ========================================================================
+
+const (
+	// MigrationLintBaseline is the last migration written before the
+	// migration linter; it and earlier ones are not linted
+	MigrationLintBaseline = 44
+
+	// Backfill statuses
+	BackfillStatusPending   = "pending"
+	BackfillStatusRunning   = "running"
+	BackfillStatusCompleted = "completed"
+	BackfillStatusFailed    = "failed" // Needs fixing, then a retry
+
+	// Backfill job defaults
+	DefaultBackfillInterval  = 30 * time.Second
+	DefaultBackfillBatchSize = 1000 // Keys per UPDATE
+	DefaultBackfillLease     = 5 * time.Minute
+)
+
+// ========================================================================
+// SHOP OFFBOARDING CONSTANTS
+// ========================================================================
+
+const (
+	// Shop offboarding statuses
+	ShopOffboardingStatusScheduled = "scheduled"
+	ShopOffboardingStatusCancelled = "cancelled"
+	ShopOffboardingStatusCompleted = "completed"
+
+	// DefaultShopDataRetention is how long a leaving shop's data is kept
+	// (and can be exported) before it is deleted
+	DefaultShopDataRetention = 30 * 24 * time.Hour
+
+	// ShopExportBatchSize is how many rows an export reads per query
+	ShopExportBatchSize = 1000
+
+	// ShopOffboardingBatchSize is how many due offboardings a run handles
+	ShopOffboardingBatchSize = 20
+)
+
+// ========================================================================
+// QUERY BUDGET CONSTANTS
+// ========================================================================
+
+// DefaultQueryBudget is how many database queries a request may run before
+// it is reported as a likely N+1 (0 disables counting)
+const DefaultQueryBudget = 50
+
+// ========================================================================
+// CHAOS CONSTANTS
+// ========================================================================
+
+// MaxChaosLatency bounds the delays a fault spec may inject
+const MaxChaosLatency = 30 * time.Second
+
+// ========================================================================
+// ANALYTICS DATASET CONSTANTS
+// ========================================================================
+
+const (
+	// Dataset period granularities
+	AnalyticsGranularityWeek  = "week"
+	AnalyticsGranularityMonth = "month"
+
+	// DefaultAnalyticsK is the smallest number of bookings a released row
+	// may stand for; rows describing fewer are suppressed. Requests may raise
+	// it but not lower it below MinAnalyticsK.
+	DefaultAnalyticsK = 10
+	MinAnalyticsK     = 5
+
+	// DefaultAnalyticsPriceStep is the width of a price band, in major units
+	DefaultAnalyticsPriceStep = 10.0
+
+	// MaxAnalyticsSuppression is the share of bookings that may be
+	// suppressed before a dataset is refused as too sparse to be useful
+	MaxAnalyticsSuppression = 0.2
+
+	// Datasets cover UTC days of scheduled start, inclusive
+	DefaultAnalyticsDatasetDays = 90
+	MaxAnalyticsDatasetDays     = 731
+
+	// AnalyticsBatchSize is how many bookings a dataset reads per query
+	AnalyticsBatchSize = 5000
+)
+
+// MigrationLargeTables are the tables migrations may not rewrite or scan
+// under a lock (see internal/migrate)
+var MigrationLargeTables = []string{
+	"bookings",
+	"booking_history",
+	"booking_tax_lines",
+	"notifications",
+	"users",
+	"barbers",
+	"reviews",
+	"audit_logs",
+	"financial_events",
+	"outbox_events",
+	"api_usage",
+}
+
+// ========================================================================
+// PENDING EXPIRY CONSTANTS
+// ========================================================================
+
+const (
+	// DefaultPendingBookingTTL is how long a booking may wait for the barber
+	// to confirm it before it is cancelled and its slot released
+	DefaultPendingBookingTTL = 24 * time.Hour
+)
+
+// ========================================================================
+// AUTO NO-SHOW CONSTANTS
+// ========================================================================
+
+const (
+	// DefaultNoShowGraceMinutes is how long after its start a confirmed
+	// booking that was never started is marked no-show, for barbers
+	// without their own setting
+	DefaultNoShowGraceMinutes = 15
+
+	// MaxNoShowGraceMinutes caps a barber's grace period
+	MaxNoShowGraceMinutes = 240
+)
+
+// ========================================================================
+// DEPOSIT CONSTANTS
+// ========================================================================
+
+const (
+	// How a barber service's deposit is worked out
+	DepositTypePercentage = "percentage" // Percent of the service price
+	DepositTypeFixed      = "fixed"      // A fixed amount, capped at the price
 )
 
+// ValidDepositTypes lists the accepted deposit types
+var ValidDepositTypes = []string{DepositTypePercentage, DepositTypeFixed}
+
 // ========================================================================
 // MIDDLEWARE CONSTANTS
 // ========================================================================
@@ -362,3 +1593,153 @@ var DefaultAuthSkipPaths = []string{
 	"/api/v1/auth/login",
 	"/api/v1/auth/register",
 }
+
+const (
+	// RequestIDHeader carries the request's correlation ID, both ways
+	RequestIDHeader = "X-Request-ID"
+
+	// MaxRequestIDLength caps a client-supplied request ID; longer ones
+	// (or ones with other characters than letters, digits and -_.:) are
+	// replaced with a generated ID
+	MaxRequestIDLength = 128
+)
+
+// ========================================================================
+// RANKING CONSTANTS
+// ========================================================================
+
+const (
+	// Default barber listing weights (relative to each other)
+	DefaultRankingWeightRating       = 0.35
+	DefaultRankingWeightDistance     = 0.25
+	DefaultRankingWeightResponseTime = 0.10
+	DefaultRankingWeightPrice        = 0.10
+	DefaultRankingWeightFeatured     = 0.20
+
+	// Scales at which a factor's score halves
+	DefaultRankingDistanceScaleKm      = 5.0
+	DefaultRankingResponseScaleMinutes = 60.0
+	DefaultRankingPriceScale           = 40.0
+
+	// Mean Earth radius for distance calculations
+	EarthRadiusKm = 6371.0
+
+	// Nearby search radius and result bounds
+	DefaultNearbyRadiusKm = 10.0
+	MaxNearbyRadiusKm     = 100.0
+	DefaultNearbyLimit    = 20
+	MaxNearbyLimit        = 50
+
+	// NearbyTopServices is how many of each barber's most booked services a
+	// nearby search returns
+	NearbyTopServices = 3
+)
+
+// ========================================================================
+// EXPERIMENT CONSTANTS
+// ========================================================================
+
+const (
+	// Experiment statuses
+	ExperimentStatusDraft   = "draft"   // Editable; everyone gets the control
+	ExperimentStatusRunning = "running" // Users are assigned and exposures logged
+	ExperimentStatusStopped = "stopped" // Everyone gets the winner, or the control
+
+	// Built-in conversion metrics; any other name counts recorded events
+	ExperimentMetricBooking          = "booking"           // Booked after exposure
+	ExperimentMetricCompletedBooking = "completed_booking" // Completed a booking made after exposure
+)
+
+// ValidExperimentStatuses lists every experiment status
+var ValidExperimentStatuses = []string{
+	ExperimentStatusDraft,
+	ExperimentStatusRunning,
+	ExperimentStatusStopped,
+}
+
+// ========================================================================
+// API USAGE CONSTANTS
+// ========================================================================
+
+const (
+	// DefaultAPIRateLimit is the requests per minute allowed per client without a quota
+	DefaultAPIRateLimit = 100
+
+	// DefaultAPIUsageFlushInterval is how often buffered usage counters are written
+	DefaultAPIUsageFlushInterval = time.Minute
+
+	// APIQuotaCacheTTL is how long rate limiters use loaded quotas before reloading
+	APIQuotaCacheTTL = time.Minute
+
+	// Usage report ranges
+	DefaultAPIUsageRangeDays = 7
+	MaxAPIUsageRangeDays     = 90
+
+	// MaxAPIQuotaPerMinute caps per-consumer quotas
+	MaxAPIQuotaPerMinute = 10000
+)
+
+// ========================================================================
+// RBAC CONSTANTS
+// ========================================================================
+
+const (
+	// PermissionAll grants every permission (the admin role)
+	PermissionAll = "*"
+
+	// Customer-facing permissions
+	PermissionBookingsRead  = "bookings:read"
+	PermissionBookingsWrite = "bookings:write"
+	PermissionReviewsWrite  = "reviews:write"
+
+	// Staff permissions
+	PermissionBookingsManage     = "bookings:manage" // Any booking, not just one's own
+	PermissionReviewsModerate    = "reviews:moderate"
+	PermissionServicesManage     = "services:manage"
+	PermissionNotificationsSend  = "notifications:send"
+	PermissionActivityRead       = "activity:read"
+	PermissionActivityManage     = "activity:manage"
+	PermissionNPSRead            = "nps:read"
+	PermissionCampaignsManage    = "campaigns:manage"
+	PermissionFeaturedManage     = "featured:manage"
+	PermissionExperimentsManage  = "experiments:manage"
+	PermissionUsageRead          = "usage:read"
+	PermissionQuotasManage       = "quotas:manage"
+	PermissionRolesManage        = "roles:manage"
+	PermissionSuppressionsManage = "suppressions:manage"
+	PermissionTaxManage          = "tax:manage"
+	PermissionStatusManage       = "status:manage"
+	PermissionSupportManage      = "support:manage"
+	PermissionFinanceExport      = "finance:export"
+	PermissionAnalyticsExport    = "analytics:export"
+
+	// RBACCacheTTL is how long role permissions and user role assignments are
+	// cached before being reloaded
+	RBACCacheTTL = time.Minute
+)
+
+// ValidPermissions lists every permission that can be granted to a role
+var ValidPermissions = []string{
+	PermissionBookingsRead,
+	PermissionBookingsWrite,
+	PermissionReviewsWrite,
+	PermissionBookingsManage,
+	PermissionReviewsModerate,
+	PermissionServicesManage,
+	PermissionNotificationsSend,
+	PermissionActivityRead,
+	PermissionActivityManage,
+	PermissionNPSRead,
+	PermissionCampaignsManage,
+	PermissionFeaturedManage,
+	PermissionExperimentsManage,
+	PermissionUsageRead,
+	PermissionQuotasManage,
+	PermissionRolesManage,
+	PermissionSuppressionsManage,
+	PermissionTaxManage,
+	PermissionStatusManage,
+	PermissionSupportManage,
+	PermissionFinanceExport,
+	PermissionAnalyticsExport,
+}
@@ -242,7 +242,7 @@ func ValidateProductionConfig(cfg *Config) error {
 	}
 
 	// Upload directory should be absolute path in production
-	if cfg.App.Environment == "production" {
+	if cfg.App.Environment == "production" && cfg.Upload.Driver == UploadDriverLocal {
 		if !strings.HasPrefix(cfg.Upload.Directory, "/") {
 			errors = append(errors, "Upload: directory should be an absolute path in production")
 		}
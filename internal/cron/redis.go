@@ -0,0 +1,33 @@
+// internal/cron/redis.go
+package cron
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RedisLocker shares cron locks between instances through Redis
+type RedisLocker struct {
+	client *redis.Client
+	owner  string
+}
+
+// NewRedisLocker creates a locker on client. Locks record the host and
+// process that took them, for debugging.
+func NewRedisLocker(client *redis.Client) *RedisLocker {
+	host, _ := os.Hostname()
+	return &RedisLocker{client: client, owner: fmt.Sprintf("%s:%d", host, os.Getpid())}
+}
+
+// TryLock takes key for ttl unless another instance holds it
+func (l *RedisLocker) TryLock(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	ok, err := l.client.SetNX(ctx, key, l.owner, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to take lock %s: %w", key, err)
+	}
+	return ok, nil
+}
@@ -0,0 +1,192 @@
+// internal/cron/scheduler.go
+package cron
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/worker"
+)
+
+// ========================================================================
+// SCHEDULER - Cron-scheduled jobs, once per tick across instances
+// ========================================================================
+//
+// Each job runs in its own goroutine and sleeps until its next matching
+// minute. When several server instances run the scheduler, a Locker makes
+// sure only one of them runs each tick: the instance that takes the lock
+// for "job + tick time" runs it and the others skip it. Locks are never
+// released, they expire, so an instance whose clock lags a little cannot
+// run a tick another instance already finished. Without a Locker every
+// instance runs every tick.
+//
+// Runs are logged and recovered from panics like worker jobs, and drain on
+// shutdown the same way: cancelling Run's context stops new ticks, and
+// Shutdown waits for in-flight runs until its deadline.
+// ========================================================================
+
+// Job is a unit of background work run on a cron schedule
+type Job struct {
+	Name     string
+	Schedule string // Cron expression; empty disables the job
+	Run      func(ctx context.Context) error
+}
+
+// Locker takes a lock that expires after ttl. It reports false when
+// another holder has it.
+type Locker interface {
+	TryLock(ctx context.Context, key string, ttl time.Duration) (bool, error)
+}
+
+// Minimum and maximum time a tick's lock is held. It is held until the
+// job's next tick, within these bounds.
+const (
+	minLockTTL = time.Minute
+	maxLockTTL = 24 * time.Hour
+)
+
+// entry is a registered job with its parsed schedule
+type entry struct {
+	job      Job
+	schedule *Schedule
+}
+
+// Scheduler runs registered jobs on their cron schedules
+type Scheduler struct {
+	mu       sync.Mutex
+	entries  []entry
+	locker   Locker
+	location *time.Location
+	runs     worker.Runs
+}
+
+// New creates an empty scheduler. Schedules are read in loc (nil = UTC).
+// locker may be nil when only one instance runs.
+func New(locker Locker, loc *time.Location) *Scheduler {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return &Scheduler{locker: locker, location: loc}
+}
+
+// Add registers a job. Jobs with an empty schedule are ignored, which lets
+// configuration disable a job; invalid schedules are an error.
+func (s *Scheduler) Add(job Job) error {
+	if job.Schedule == "" || job.Run == nil {
+		return nil
+	}
+	schedule, err := Parse(job.Schedule)
+	if err != nil {
+		return fmt.Errorf("job %s: %w", job.Name, err)
+	}
+
+	s.mu.Lock()
+	s.entries = append(s.entries, entry{job: job, schedule: schedule})
+	s.mu.Unlock()
+	return nil
+}
+
+// Jobs returns the registered jobs as "name (schedule)"
+func (s *Scheduler) Jobs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	names := make([]string, len(s.entries))
+	for i, e := range s.entries {
+		names[i] = fmt.Sprintf("%s (%s)", e.job.Name, e.schedule)
+	}
+	return names
+}
+
+// Run starts every job and blocks until ctx is cancelled and all runs finish
+func (s *Scheduler) Run(ctx context.Context) {
+	s.mu.Lock()
+	entries := append([]entry(nil), s.entries...)
+	s.mu.Unlock()
+
+	ctx = s.runs.Start(ctx)
+	defer s.runs.Finish()
+
+	var wg sync.WaitGroup
+	for _, e := range entries {
+		wg.Add(1)
+		go func(e entry) {
+			defer wg.Done()
+			s.loop(ctx, e)
+		}(e)
+	}
+	wg.Wait()
+}
+
+// loop runs one job at each of its ticks until ctx is cancelled
+func (s *Scheduler) loop(ctx context.Context, e entry) {
+	for {
+		tick := e.schedule.Next(time.Now().In(s.location))
+		if tick.IsZero() {
+			logger.FromContext(ctx).Warn("Cron job never runs").
+				Str("job", e.job.Name).
+				Str("schedule", e.schedule.String()).
+				Send()
+			return
+		}
+
+		timer := time.NewTimer(time.Until(tick))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		if ctx.Err() == nil && s.claim(ctx, e, tick) {
+			s.runs.Once(worker.Job{Name: e.job.Name, Run: e.job.Run})
+		}
+	}
+}
+
+// Running returns the names of the jobs in flight
+func (s *Scheduler) Running() []string {
+	return s.runs.Running()
+}
+
+// Shutdown stops new ticks and waits for in-flight runs until ctx ends,
+// then aborts them
+func (s *Scheduler) Shutdown(ctx context.Context) worker.DrainStatus {
+	return s.runs.Shutdown(ctx)
+}
+
+// claim takes the lock for one tick of a job. It reports whether this
+// instance should run it.
+func (s *Scheduler) claim(ctx context.Context, e entry, tick time.Time) bool {
+	if s.locker == nil {
+		return true
+	}
+
+	ttl := e.schedule.Next(tick).Sub(tick)
+	if ttl < minLockTTL {
+		ttl = minLockTTL
+	}
+	if ttl > maxLockTTL || ttl < 0 {
+		ttl = maxLockTTL
+	}
+
+	key := "cron:" + e.job.Name + ":" + strconv.FormatInt(tick.Unix(), 10)
+	ok, err := s.locker.TryLock(ctx, key, ttl)
+	if err != nil {
+		// Skipping is safer than running a tick another instance may run too
+		logger.FromContext(ctx).Error(err).
+			Str("job", e.job.Name).
+			Msg("Failed to take cron lock; skipping run")
+		return false
+	}
+	if !ok {
+		logger.FromContext(ctx).Debug("Cron tick taken by another instance").
+			Str("job", e.job.Name).
+			Send()
+	}
+	return ok
+}
@@ -0,0 +1,198 @@
+// internal/csvimport/csvimport.go
+package csvimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ========================================================================
+// CSV IMPORT - Reading uploaded spreadsheets by column name
+// ========================================================================
+//
+// Exports from other booking tools rarely agree on column order, so rows
+// are read by header name. Headers are matched case-insensitively, with
+// spaces and hyphens read as underscores ("Email Consent" = email_consent),
+// and a byte order mark left by spreadsheet software is ignored. Rows may
+// be shorter than the header; missing trailing fields read as empty. Blank
+// lines and rows with only empty fields are skipped.
+// ========================================================================
+
+// ErrNoHeader is returned for input without a header row
+var ErrNoHeader = errors.New("csv file is empty; the first row must name the columns")
+
+// Reader reads CSV rows by column name
+type Reader struct {
+	csv     *csv.Reader
+	columns map[string]int
+}
+
+// Row is one data row of a CSV file
+type Row struct {
+	Line    int // Line number in the file, for error messages
+	fields  []string
+	columns map[string]int
+}
+
+// NewReader reads the header row. It fails if any of the required columns
+// is missing.
+func NewReader(r io.Reader, required ...string) (*Reader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, ErrNoHeader
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		name = NormalizeColumn(name)
+		if _, dup := columns[name]; name != "" && !dup {
+			columns[name] = i
+		}
+	}
+
+	var missing []string
+	for _, name := range required {
+		if _, ok := columns[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("csv file is missing required columns: %s", strings.Join(missing, ", "))
+	}
+
+	return &Reader{csv: cr, columns: columns}, nil
+}
+
+// NormalizeColumn returns the name a header is matched by
+func NormalizeColumn(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	return strings.NewReplacer(" ", "_", "-", "_").Replace(name)
+}
+
+// Has reports whether the file has a column
+func (r *Reader) Has(column string) bool {
+	_, ok := r.columns[column]
+	return ok
+}
+
+// Next returns the next non-empty row, or io.EOF after the last one.
+// Malformed CSV (e.g. an unterminated quote) is an error; the rows after
+// it cannot be read reliably.
+func (r *Reader) Next() (*Row, error) {
+	for {
+		fields, err := r.csv.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, io.EOF
+			}
+			return nil, fmt.Errorf("invalid csv: %w", err)
+		}
+		if isBlank(fields) {
+			continue
+		}
+		line, _ := r.csv.FieldPos(0)
+		return &Row{Line: line, fields: fields, columns: r.columns}, nil
+	}
+}
+
+// isBlank reports whether every field of a record is empty
+func isBlank(fields []string) bool {
+	for _, f := range fields {
+		if strings.TrimSpace(f) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Get returns a column's trimmed value ("" when the file has no such column)
+func (row *Row) Get(column string) string {
+	i, ok := row.columns[column]
+	if !ok || i >= len(row.fields) {
+		return ""
+	}
+	return strings.TrimSpace(row.fields[i])
+}
+
+// Bool reads a yes/no column. Empty values are false; anything other than
+// yes/no, y/n, true/false, t/f, 1/0, on/off or x is an error.
+func (row *Row) Bool(column string) (bool, error) {
+	switch v := strings.ToLower(row.Get(column)); v {
+	case "", "n", "no", "false", "f", "0", "off":
+		return false, nil
+	case "y", "yes", "true", "t", "1", "on", "x":
+		return true, nil
+	default:
+		return false, fmt.Errorf("%s must be yes or no, got %q", column, v)
+	}
+}
+
+// Float reads a non-negative amount such as a price. A leading currency
+// symbol and thousands separators are ignored ("$1,250.00" = 1250). ok is
+// false for empty values.
+func (row *Row) Float(column string) (v float64, ok bool, err error) {
+	raw := row.Get(column)
+	s := strings.TrimLeft(raw, "$€£¥ ")
+	s = strings.ReplaceAll(s, ",", "")
+	if s == "" {
+		return 0, false, nil
+	}
+	v, err = strconv.ParseFloat(s, 64)
+	if err != nil || v < 0 {
+		return 0, false, fmt.Errorf("%s must be a non-negative number, got %q", column, raw)
+	}
+	return v, true, nil
+}
+
+// Int reads a non-negative whole number. ok is false for empty values.
+func (row *Row) Int(column string) (v int, ok bool, err error) {
+	raw := row.Get(column)
+	if raw == "" {
+		return 0, false, nil
+	}
+	v, err = strconv.Atoi(raw)
+	if err != nil || v < 0 {
+		return 0, false, fmt.Errorf("%s must be a whole number, got %q", column, raw)
+	}
+	return v, true, nil
+}
+
+// timeLayouts are the date-time formats ParseTime accepts, most specific
+// first. Layouts without an offset are read in the caller's location.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02 3:04 PM",
+	"2006-01-02 3:04PM",
+	"2006-01-02 3:04 pm",
+	"2006-01-02 3:04pm",
+}
+
+// ParseTime parses an ISO date and time ("2024-05-03 14:30", "2024-05-03
+// 2:30 PM" or RFC 3339). Values without a UTC offset are read in loc.
+func ParseTime(value string, loc *time.Location) (time.Time, error) {
+	value = strings.Join(strings.Fields(value), " ")
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%q is not a date and time like 2024-05-03 14:30", value)
+}
@@ -0,0 +1,163 @@
+// internal/experiments/experiments.go
+package experiments
+
+import (
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// ========================================================================
+// EXPERIMENTS - Deterministic A/B variant assignment
+// ========================================================================
+//
+// A user's variant is derived from a hash of the experiment key and the
+// user ID, so the same user always lands in the same bucket without any
+// lookup, and different experiments split users independently. Variants
+// are weighted: weights 1/1 split evenly, 9/1 sends a tenth of users to the
+// second variant. The first variant is the control.
+// ========================================================================
+
+// Validation errors
+var (
+	ErrInvalidKey      = errors.New("experiment key must be 2-50 lowercase letters, digits, '-' or '_'")
+	ErrTooFewVariants  = errors.New("experiment must have at least 2 variants")
+	ErrTooManyVariants = errors.New("experiment cannot have more than 10 variants")
+	ErrInvalidVariant  = errors.New("variant names must be 1-30 lowercase letters, digits, '-' or '_'")
+	ErrDuplicateName   = errors.New("variant names must be unique")
+	ErrInvalidWeight   = errors.New("variant weights must be between 1 and 1000")
+	ErrInvalidEvent    = errors.New("event name must be 2-50 lowercase letters, digits, '-' or '_'")
+)
+
+// MaxVariants caps the number of variants per experiment
+const MaxVariants = 10
+
+var (
+	keyPattern     = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,49}$`)
+	variantPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,29}$`)
+)
+
+// Variant is one arm of an experiment
+type Variant struct {
+	Name   string `json:"name" binding:"required" example:"control"`
+	Weight int    `json:"weight" binding:"required,min=1,max=1000" example:"50"`
+}
+
+// Variants is an experiment's arms, stored as JSONB
+type Variants []Variant
+
+// Value implements driver.Valuer for database storage
+func (v Variants) Value() (driver.Value, error) {
+	if v == nil {
+		return "[]", nil
+	}
+	return json.Marshal(v)
+}
+
+// Scan implements sql.Scanner for database retrieval
+func (v *Variants) Scan(value interface{}) error {
+	if value == nil {
+		*v = Variants{}
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return fmt.Errorf("failed to scan Variants: expected []byte, got %T", value)
+	}
+	return json.Unmarshal(bytes, v)
+}
+
+// Control returns the first variant's name ("" when there are none)
+func (v Variants) Control() string {
+	if len(v) == 0 {
+		return ""
+	}
+	return v[0].Name
+}
+
+// Has reports whether name is one of the variants
+func (v Variants) Has(name string) bool {
+	for _, variant := range v {
+		if variant.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateKey checks an experiment key
+func ValidateKey(key string) error {
+	if !keyPattern.MatchString(key) {
+		return ErrInvalidKey
+	}
+	return nil
+}
+
+// ValidateEvent checks a conversion event name
+func ValidateEvent(event string) error {
+	if !keyPattern.MatchString(event) {
+		return ErrInvalidEvent
+	}
+	return nil
+}
+
+// Validate checks that the variants can be assigned
+func (v Variants) Validate() error {
+	if len(v) < 2 {
+		return ErrTooFewVariants
+	}
+	if len(v) > MaxVariants {
+		return ErrTooManyVariants
+	}
+	seen := make(map[string]bool, len(v))
+	for _, variant := range v {
+		if !variantPattern.MatchString(variant.Name) {
+			return ErrInvalidVariant
+		}
+		if seen[variant.Name] {
+			return ErrDuplicateName
+		}
+		if variant.Weight < 1 || variant.Weight > 1000 {
+			return ErrInvalidWeight
+		}
+		seen[variant.Name] = true
+	}
+	return nil
+}
+
+// Assign returns the variant of experiment key for userID. The result only
+// changes if the variants or their weights change.
+func Assign(key string, userID int, variants Variants) string {
+	total := 0
+	for _, variant := range variants {
+		if variant.Weight > 0 {
+			total += variant.Weight
+		}
+	}
+	if total == 0 {
+		return variants.Control()
+	}
+
+	bucket := int(Bucket(key, userID) % uint64(total))
+	for _, variant := range variants {
+		if variant.Weight <= 0 {
+			continue
+		}
+		if bucket < variant.Weight {
+			return variant.Name
+		}
+		bucket -= variant.Weight
+	}
+	return variants.Control()
+}
+
+// Bucket returns the stable hash that places userID within experiment key
+func Bucket(key string, userID int) uint64 {
+	sum := sha256.Sum256([]byte(key + ":" + strconv.Itoa(userID)))
+	return binary.BigEndian.Uint64(sum[:8])
+}
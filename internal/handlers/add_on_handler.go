@@ -0,0 +1,218 @@
+// internal/handlers/add_on_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// ADD-ON HANDLER - Add-on catalogs of barber services
+// ========================================================================
+
+// AddOnHandler handles service add-on requests
+type AddOnHandler struct {
+	addOnService *services.AddOnService
+}
+
+// NewAddOnHandler creates a new add-on handler
+func NewAddOnHandler(addOnService *services.AddOnService) *AddOnHandler {
+	return &AddOnHandler{
+		addOnService: addOnService,
+	}
+}
+
+// respondAddOnError maps add-on errors to HTTP responses
+func respondAddOnError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage add-ons of your own services",
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case errors.Is(err, repository.ErrBarberServiceNotFound):
+		RespondNotFound(c, "Service")
+	case errors.Is(err, repository.ErrAddOnNotFound):
+		RespondNotFound(c, "Add-on")
+	case errors.Is(err, repository.ErrDuplicateAddOn):
+		RespondBadRequest(c, "Duplicate entry", err.Error())
+	case utils.ContainsAny(err.Error(), []string{"cannot", "does not allow"}):
+		RespondBadRequest(c, "Invalid add-on request", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// requireAddOnPath reads the barber and barber service IDs from the path
+func requireAddOnPath(c *gin.Context) (barberID, serviceID int, ok bool) {
+	if barberID, ok = RequireIntParam(c, "id", "barber"); !ok {
+		return 0, 0, false
+	}
+	if serviceID, ok = RequireIntParam(c, "serviceId", "service"); !ok {
+		return 0, 0, false
+	}
+	return barberID, serviceID, true
+}
+
+// ListAddOns godoc
+// @Summary List a service's add-ons
+// @Description The active add-ons a barber offers on one of their services. Pass their IDs as add_on_ids when booking the service; each adds its duration and price.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param serviceId path int true "Barber service ID"
+// @Success 200 {object} SuccessResponse{data=[]models.ServiceAddOn}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/barbers/{id}/services/{serviceId}/add-ons [get]
+func (h *AddOnHandler) ListAddOns(c *gin.Context) {
+	barberID, serviceID, ok := requireAddOnPath(c)
+	if !ok {
+		return
+	}
+
+	addOns, err := h.addOnService.ListAddOns(c.Request.Context(), barberID, serviceID)
+	if err != nil {
+		respondAddOnError(c, err, "fetch add-ons")
+		return
+	}
+
+	RespondSuccessWithMeta(c, addOns, map[string]interface{}{
+		"barber_id":  barberID,
+		"service_id": serviceID,
+		"count":      len(addOns),
+	})
+}
+
+// CreateAddOn godoc
+// @Summary Add an add-on to a service
+// @Description Offer an optional extra on one of the barber's services. The service must allow add-ons. Barbers may only manage their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param serviceId path int true "Barber service ID"
+// @Param addOn body services.AddOnRequest true "Add-on"
+// @Success 201 {object} SuccessResponse{data=models.ServiceAddOn}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/services/{serviceId}/add-ons [post]
+func (h *AddOnHandler) CreateAddOn(c *gin.Context) {
+	barberID, serviceID, ok := requireAddOnPath(c)
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "add an add-on")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.AddOnRequest](c)
+	if !ok {
+		return
+	}
+
+	addOn, err := h.addOnService.CreateAddOn(c.Request.Context(), barberID, serviceID, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondAddOnError(c, err, "add add-on")
+		return
+	}
+
+	RespondCreated(c, addOn, "Add-on added")
+}
+
+// UpdateAddOn godoc
+// @Summary Update a service add-on
+// @Description Replace an add-on; set is_active to false to stop offering it. Existing bookings keep the price and duration they were made with. Barbers may only manage their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param serviceId path int true "Barber service ID"
+// @Param addOnId path int true "Add-on ID"
+// @Param addOn body services.AddOnRequest true "Add-on"
+// @Success 200 {object} SuccessResponse{data=models.ServiceAddOn}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/services/{serviceId}/add-ons/{addOnId} [put]
+func (h *AddOnHandler) UpdateAddOn(c *gin.Context) {
+	barberID, serviceID, ok := requireAddOnPath(c)
+	if !ok {
+		return
+	}
+	addOnID, ok := RequireIntParam(c, "addOnId", "add-on")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "update an add-on")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.AddOnRequest](c)
+	if !ok {
+		return
+	}
+
+	addOn, err := h.addOnService.UpdateAddOn(c.Request.Context(), barberID, serviceID, addOnID, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondAddOnError(c, err, "update add-on")
+		return
+	}
+
+	RespondSuccessWithData(c, addOn, "Add-on updated")
+}
+
+// DeleteAddOn godoc
+// @Summary Remove a service add-on
+// @Description Remove an add-on from a service. Bookings that included it keep their line items. Barbers may only manage their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param serviceId path int true "Barber service ID"
+// @Param addOnId path int true "Add-on ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/services/{serviceId}/add-ons/{addOnId} [delete]
+func (h *AddOnHandler) DeleteAddOn(c *gin.Context) {
+	barberID, serviceID, ok := requireAddOnPath(c)
+	if !ok {
+		return
+	}
+	addOnID, ok := RequireIntParam(c, "addOnId", "add-on")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "remove an add-on")
+	if !ok {
+		return
+	}
+
+	if err := h.addOnService.DeleteAddOn(c.Request.Context(), barberID, serviceID, addOnID, userID, middleware.IsAdmin(c)); err != nil {
+		respondAddOnError(c, err, "remove add-on")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Add-on removed")
+}
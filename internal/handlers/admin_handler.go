@@ -0,0 +1,108 @@
+// internal/handlers/admin_handler.go
+package handlers
+
+import (
+	"errors"
+
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// ADMIN HANDLER - Platform administration endpoints
+// ========================================================================
+
+// AdminHandler handles admin-only HTTP requests
+type AdminHandler struct {
+	auditService *services.AuditService
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(auditService *services.AuditService) *AdminHandler {
+	return &AdminHandler{
+		auditService: auditService,
+	}
+}
+
+// ========================================================================
+// ACTIVITY FEED
+// ========================================================================
+
+// GetActivityFeed godoc
+// @Summary Get platform activity feed
+// @Description Recent audit-log entries across the platform, newest first. Admin changes carry the fields they changed in old_values and new_values. Filtering by actor_id also returns actions the actor performed while impersonating another user. Page with before_id using next_before_id from the previous response.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param actor_id query int false "Filter by actor (direct or impersonating)"
+// @Param actor_type query string false "Filter by actor type"
+// @Param impersonator_id query int false "Filter by impersonating admin"
+// @Param impersonated query bool false "Only impersonated (true) or direct (false) actions"
+// @Param entity_type query string false "Filter by entity type"
+// @Param entity_id query int false "Filter by entity ID"
+// @Param action query string false "Filter by action"
+// @Param created_from query string false "Created at or after (RFC3339)"
+// @Param created_to query string false "Created at or before (RFC3339)"
+// @Param before_id query int false "Return entries older than this ID"
+// @Param limit query int false "Limit results" default(50)
+// @Success 200 {object} SuccessResponse{data=services.ActivityFeedResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/activity [get]
+func (h *AdminHandler) GetActivityFeed(c *gin.Context) {
+	filters, ok := BindFilters[repository.AuditLogFilters](c)
+	if !ok {
+		return
+	}
+
+	feed, err := h.auditService.GetActivityFeed(c.Request.Context(), *filters)
+	if err != nil {
+		RespondInternalError(c, "fetch activity feed", err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	RespondSuccessWithMeta(c, feed, map[string]interface{}{
+		"count": len(feed.Entries),
+		"limit": filters.Limit,
+	})
+}
+
+// GetActivityEntry godoc
+// @Summary Get an audit log entry
+// @Description A single audit-log entry with who acted, from which IP address and user agent, and the fields the action changed before (old_values) and after (new_values). Sensitive values are redacted.
+// @Tags admin
+// @Produce json
+// @Param id path int true "Audit log entry ID"
+// @Success 200 {object} SuccessResponse{data=models.AuditLog}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/activity/{id} [get]
+func (h *AdminHandler) GetActivityEntry(c *gin.Context) {
+	entryID, ok := RequireIntParam(c, "id", "audit log entry")
+	if !ok {
+		return
+	}
+
+	entry, err := h.auditService.GetEntry(c.Request.Context(), entryID)
+	if err != nil {
+		if errors.Is(err, repository.ErrAuditLogNotFound) {
+			RespondNotFound(c, "Audit log entry")
+			return
+		}
+		RespondInternalError(c, "fetch audit log entry", err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	RespondSuccess(c, entry)
+}
@@ -0,0 +1,91 @@
+// internal/handlers/analytics_handler.go
+package handlers
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// ANALYTICS HANDLER - Anonymized datasets for data science
+// ========================================================================
+
+// AnalyticsHandler handles analytics dataset requests
+type AnalyticsHandler struct {
+	analyticsService *services.AnalyticsService
+}
+
+// NewAnalyticsHandler creates a new analytics handler
+func NewAnalyticsHandler(analyticsService *services.AnalyticsService) *AnalyticsHandler {
+	return &AnalyticsHandler{
+		analyticsService: analyticsService,
+	}
+}
+
+// ExportDataset godoc
+// @Summary Export an anonymized booking dataset
+// @Description CSV of the bookings scheduled in a period of UTC days, for demand modeling. Bookings carry no ids, names, contact details or exact times: each is reduced to its week or month, day of week, two-hour band of the barber's local time, lead time, duration, price band, currency, status, source, service category and city, and bookings sharing all of these are released as one row with their count. Rows standing for fewer than k bookings are suppressed; the X-Dataset-K, X-Dataset-Bookings and X-Dataset-Suppressed-Bookings headers report how many. If more than 20% of the bookings would be suppressed the dataset is refused. Every release is recorded in the audit log.
+// @Tags admin
+// @Produce text/csv
+// @Param from query string false "First day (YYYY-MM-DD, default 89 days before to)"
+// @Param to query string false "Last day (YYYY-MM-DD, default today)"
+// @Param k query int false "Minimum bookings per row (default 10, at least 5)"
+// @Param price_step query number false "Price band width in major units (default 10)"
+// @Param granularity query string false "Period of a row: week (default) or month"
+// @Success 200 {file} file
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 422 {object} middleware.ErrorResponse "Too many bookings would be suppressed"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/analytics/dataset [get]
+func (h *AnalyticsHandler) ExportDataset(c *gin.Context) {
+	query, ok := BindQuery[services.AnalyticsDatasetQuery](c)
+	if !ok {
+		return
+	}
+
+	release, err := h.analyticsService.BuildDataset(c.Request.Context(), *query)
+	if err != nil {
+		respondAnalyticsError(c, err, "export analytics dataset")
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := services.WriteAnalyticsCSV(&buf, release.AnalyticsDataset); err != nil {
+		RespondInternalError(c, "export analytics dataset", err)
+		return
+	}
+
+	filename := fmt.Sprintf("bookings-dataset-%s-%s.csv", release.From.Format("2006-01-02"), release.To.Format("2006-01-02"))
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Header("X-Dataset-K", strconv.Itoa(release.K))
+	c.Header("X-Dataset-Bookings", strconv.Itoa(release.Bookings))
+	c.Header("X-Dataset-Suppressed-Bookings", strconv.Itoa(release.SuppressedBookings))
+	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
+}
+
+// respondAnalyticsError maps analytics errors to HTTP responses
+func respondAnalyticsError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, services.ErrDatasetTooSparse):
+		middleware.WriteError(c, http.StatusUnprocessableEntity, middleware.ErrorResponse{
+			Error:   "Dataset too sparse",
+			Message: err.Error(),
+		})
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		RespondBadRequest(c, "Invalid dataset request", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
@@ -0,0 +1,238 @@
+// internal/handlers/api_usage_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// API USAGE HANDLER - Per-consumer usage reports and quotas
+// ========================================================================
+
+// APIUsageHandler handles API usage and quota requests
+type APIUsageHandler struct {
+	usageService *services.APIUsageService
+}
+
+// NewAPIUsageHandler creates a new API usage handler
+func NewAPIUsageHandler(usageService *services.APIUsageService) *APIUsageHandler {
+	return &APIUsageHandler{
+		usageService: usageService,
+	}
+}
+
+// respondAPIUsageError maps API usage errors to HTTP responses
+func respondAPIUsageError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrUserNotFound):
+		RespondNotFound(c, "User")
+	case errors.Is(err, repository.ErrAPIQuotaNotFound):
+		RespondNotFound(c, "API quota")
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		middleware.WriteError(c, http.StatusBadRequest, middleware.ErrorResponse{
+			Error:   "Invalid usage request",
+			Message: err.Error(),
+		})
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ========================================================================
+// SELF-SERVICE
+// ========================================================================
+
+// GetMyUsage godoc
+// @Summary Get my API usage
+// @Description Request counts, error rates and latency of the current user's API calls over a date range (UTC days, inclusive; default the last 7 days), in total, per endpoint and per day, plus the rate limit that applies to them. Usage is recorded with up to a minute's delay.
+// @Tags usage
+// @Produce json
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Success 200 {object} SuccessResponse{data=models.APIUsageReport}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/usage [get]
+func (h *APIUsageHandler) GetMyUsage(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "view API usage")
+	if !ok {
+		return
+	}
+	query, ok := BindQuery[services.APIUsageQuery](c)
+	if !ok {
+		return
+	}
+
+	report, err := h.usageService.GetUsage(c.Request.Context(), userID, *query)
+	if err != nil {
+		respondAPIUsageError(c, err, "fetch API usage")
+		return
+	}
+
+	RespondSuccess(c, report)
+}
+
+// ========================================================================
+// ADMIN
+// ========================================================================
+
+// GetTopConsumers godoc
+// @Summary List top API consumers
+// @Description The busiest API consumers over a date range (UTC days, inclusive; default the last 7 days)
+// @Tags admin
+// @Produce json
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param limit query int false "Number of consumers" default(20)
+// @Success 200 {object} SuccessResponse{data=[]models.APIUsageStats}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/usage [get]
+func (h *APIUsageHandler) GetTopConsumers(c *gin.Context) {
+	query, ok := BindQuery[services.APIUsageQuery](c)
+	if !ok {
+		return
+	}
+	limit := ParseLimitQuery(c, 20)
+
+	consumers, err := h.usageService.TopConsumers(c.Request.Context(), *query, limit)
+	if err != nil {
+		respondAPIUsageError(c, err, "fetch top API consumers")
+		return
+	}
+
+	RespondSuccessWithMeta(c, consumers, map[string]interface{}{
+		"count": len(consumers),
+	})
+}
+
+// GetConsumerUsage godoc
+// @Summary Get a consumer's API usage
+// @Description A user's API usage report, as they see it at /api/v1/usage
+// @Tags admin
+// @Produce json
+// @Param userId path int true "User ID"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Success 200 {object} SuccessResponse{data=models.APIUsageReport}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/usage/{userId} [get]
+func (h *APIUsageHandler) GetConsumerUsage(c *gin.Context) {
+	userID, ok := RequireIntParam(c, "userId", "User")
+	if !ok {
+		return
+	}
+	query, ok := BindQuery[services.APIUsageQuery](c)
+	if !ok {
+		return
+	}
+
+	report, err := h.usageService.GetUsage(c.Request.Context(), userID, *query)
+	if err != nil {
+		respondAPIUsageError(c, err, "fetch API usage")
+		return
+	}
+
+	RespondSuccess(c, report)
+}
+
+// ListQuotas godoc
+// @Summary List API quotas
+// @Description Consumers with their own rate limit; everyone else gets API_RATE_LIMIT requests per minute
+// @Tags admin
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=[]models.APIQuota}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/quotas [get]
+func (h *APIUsageHandler) ListQuotas(c *gin.Context) {
+	quotas, err := h.usageService.ListQuotas(c.Request.Context())
+	if err != nil {
+		RespondInternalError(c, "fetch API quotas", err)
+		return
+	}
+
+	RespondSuccess(c, quotas)
+}
+
+// SetQuota godoc
+// @Summary Set an API quota
+// @Description Set a consumer's rate limit in requests per minute. Rate limiters pick it up within a minute.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param userId path int true "User ID"
+// @Param request body services.SetAPIQuotaRequest true "Quota"
+// @Success 200 {object} SuccessResponse{data=models.APIQuota}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/quotas/{userId} [put]
+func (h *APIUsageHandler) SetQuota(c *gin.Context) {
+	userID, ok := RequireIntParam(c, "userId", "User")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.SetAPIQuotaRequest](c)
+	if !ok {
+		return
+	}
+
+	quota, err := h.usageService.SetQuota(c.Request.Context(), userID, *req)
+	if err != nil {
+		respondAPIUsageError(c, err, "set API quota")
+		return
+	}
+
+	RespondSuccessWithData(c, quota, "API quota saved")
+}
+
+// DeleteQuota godoc
+// @Summary Remove an API quota
+// @Description Remove a consumer's rate limit so API_RATE_LIMIT applies again
+// @Tags admin
+// @Produce json
+// @Param userId path int true "User ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/quotas/{userId} [delete]
+func (h *APIUsageHandler) DeleteQuota(c *gin.Context) {
+	userID, ok := RequireIntParam(c, "userId", "User")
+	if !ok {
+		return
+	}
+
+	if err := h.usageService.DeleteQuota(c.Request.Context(), userID); err != nil {
+		respondAPIUsageError(c, err, "delete API quota")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "API quota removed")
+}
@@ -2,11 +2,15 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
 	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/oauth"
+	"barber-booking-system/internal/repository"
 	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/timefmt"
 
 	"github.com/gin-gonic/gin"
 )
@@ -88,14 +92,14 @@ func (h *AuthHandler) Login(c *gin.Context) {
 			return
 		}
 		if strings.Contains(err.Error(), "account is temporarily locked") {
-			c.JSON(http.StatusForbidden, middleware.ErrorResponse{
+			middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
 				Error:   "Account locked",
 				Message: err.Error(),
 			})
 			return
 		}
 		if strings.Contains(err.Error(), "account is") {
-			c.JSON(http.StatusForbidden, middleware.ErrorResponse{
+			middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
 				Error:   "Account inactive",
 				Message: err.Error(),
 			})
@@ -109,43 +113,84 @@ func (h *AuthHandler) Login(c *gin.Context) {
 }
 
 // RefreshToken godoc
-// @Summary Refresh JWT token
-// @Description Generate a new JWT token from an existing valid token
+// @Summary Refresh tokens
+// @Description Exchange a refresh token for a new access token and refresh token. Each refresh token can be used once; reusing one ends the session.
 // @Tags auth
 // @Accept json
 // @Produce json
-// @Security BearerAuth
+// @Param request body services.RefreshTokenRequest true "Refresh token"
 // @Success 200 {object} SuccessResponse{data=services.AuthResponse}
+// @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /api/v1/auth/refresh [post]
 func (h *AuthHandler) RefreshToken(c *gin.Context) {
-	// Extract token from header
-	authHeader := c.GetHeader("Authorization")
-	if authHeader == "" {
-		RespondUnauthorized(c, "Authorization header is required")
+	req, ok := BindJSON[services.RefreshTokenRequest](c)
+	if !ok {
 		return
 	}
 
-	// Remove "Bearer " prefix
-	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-	if tokenString == authHeader {
-		RespondUnauthorized(c, "Token must be in Bearer format")
+	authResponse, err := h.userService.RefreshToken(c.Request.Context(), req.RefreshToken)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRefreshTokenNotFound),
+			errors.Is(err, repository.ErrRefreshTokenExpired),
+			errors.Is(err, repository.ErrRefreshTokenRevoked),
+			errors.Is(err, repository.ErrRefreshTokenReused):
+			RespondUnauthorized(c, "Refresh token is invalid or expired")
+		case strings.Contains(err.Error(), "account is"):
+			middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+				Error:   "Account inactive",
+				Message: err.Error(),
+			})
+		default:
+			RespondInternalError(c, "refresh token", err)
+		}
+		return
+	}
+
+	RespondSuccessWithData(c, authResponse, "Token refreshed successfully")
+}
+
+// ClaimAccount godoc
+// @Summary Claim an imported account
+// @Description Set the password of an account a barber's client import created, using the token from the emailed invitation. The email address is marked verified and the client is signed in. Invitations work once and expire.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body services.ClaimAccountRequest true "Invitation token and new password"
+// @Success 200 {object} SuccessResponse{data=services.AuthResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 410 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/auth/claim [post]
+func (h *AuthHandler) ClaimAccount(c *gin.Context) {
+	req, ok := BindJSON[services.ClaimAccountRequest](c)
+	if !ok {
 		return
 	}
 
-	// Refresh token
-	authResponse, err := h.userService.RefreshToken(c.Request.Context(), tokenString)
+	authResponse, err := h.userService.ClaimAccount(c.Request.Context(), *req)
 	if err != nil {
-		if strings.Contains(err.Error(), "invalid token") || strings.Contains(err.Error(), "expired") {
-			RespondUnauthorized(c, "Token is invalid or expired")
-			return
+		switch {
+		case errors.Is(err, repository.ErrInvitationNotFound):
+			RespondNotFound(c, "Invitation")
+		case errors.Is(err, repository.ErrInvitationClosed):
+			middleware.WriteError(c, http.StatusGone, middleware.ErrorResponse{
+				Error:   "Invitation closed",
+				Message: "This invitation has already been used or has expired",
+			})
+		case strings.Contains(err.Error(), "password must"):
+			RespondBadRequest(c, "Invalid password", err.Error())
+		default:
+			RespondInternalError(c, "claim account", err)
 		}
-		RespondInternalError(c, "refresh token", err)
 		return
 	}
 
-	RespondSuccessWithData(c, authResponse, "Token refreshed successfully")
+	RespondSuccessWithData(c, authResponse, "Account claimed successfully")
 }
 
 // GetMe godoc
@@ -171,7 +216,7 @@ func (h *AuthHandler) GetMe(c *gin.Context) {
 	profile, err := h.userService.GetProfile(c.Request.Context(), userID)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
-			c.JSON(http.StatusNotFound, middleware.ErrorResponse{
+			middleware.WriteError(c, http.StatusNotFound, middleware.ErrorResponse{
 				Error:   "User not found",
 				Message: "User account no longer exists",
 			})
@@ -186,7 +231,7 @@ func (h *AuthHandler) GetMe(c *gin.Context) {
 
 // UpdateProfile godoc
 // @Summary Update user profile
-// @Description Update the profile of the currently authenticated user
+// @Description Update the profile of the currently authenticated user. Notification times are written from the timezone (IANA zone), locale (de, en, es, fr, it, pt) and time_format (12h or 24h) preferences.
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -213,12 +258,20 @@ func (h *AuthHandler) UpdateProfile(c *gin.Context) {
 	profile, err := h.userService.UpdateProfile(c.Request.Context(), userID, *req)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
-			c.JSON(http.StatusNotFound, middleware.ErrorResponse{
+			middleware.WriteError(c, http.StatusNotFound, middleware.ErrorResponse{
 				Error:   "User not found",
 				Message: "User account no longer exists",
 			})
 			return
 		}
+		if strings.Contains(err.Error(), "invalid notification channel") {
+			RespondBadRequest(c, "Invalid notification settings", err.Error())
+			return
+		}
+		if errors.Is(err, timefmt.ErrInvalidPreference) {
+			RespondBadRequest(c, "Invalid preferences", err.Error())
+			return
+		}
 		RespondInternalError(c, "update profile", err)
 		return
 	}
@@ -263,7 +316,7 @@ func (h *AuthHandler) ChangePassword(c *gin.Context) {
 			return
 		}
 		if strings.Contains(err.Error(), "not found") {
-			c.JSON(http.StatusNotFound, middleware.ErrorResponse{
+			middleware.WriteError(c, http.StatusNotFound, middleware.ErrorResponse{
 				Error:   "User not found",
 				Message: "User account no longer exists",
 			})
@@ -278,17 +331,196 @@ func (h *AuthHandler) ChangePassword(c *gin.Context) {
 
 // Logout godoc
 // @Summary User logout
-// @Description Logout the current user (client should delete the token)
+// @Description Revoke the session of the given refresh token, or every session with all_devices. The client should delete its access token.
 // @Tags auth
 // @Accept json
 // @Produce json
 // @Security BearerAuth
+// @Param request body services.LogoutRequest false "Sessions to end"
 // @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
 // @Router /api/v1/auth/logout [post]
 func (h *AuthHandler) Logout(c *gin.Context) {
-	// Note: Since we're using JWT, logout is handled client-side by deleting the token
-	// In a more complex system, you might want to blacklist tokens in Redis
-	// For now, we just acknowledge the logout request
+	userID, ok := GetAuthUserID(c, "log out")
+	if !ok {
+		return
+	}
+
+	req := &services.LogoutRequest{}
+	if c.Request.ContentLength != 0 {
+		if req, ok = BindJSON[services.LogoutRequest](c); !ok {
+			return
+		}
+	}
+
+	if err := h.userService.Logout(c.Request.Context(), userID, *req); err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+			// Already gone; logging out is idempotent
+			RespondSuccessWithMessage(c, "Logged out successfully")
+			return
+		}
+		RespondInternalError(c, "logout", err)
+		return
+	}
+
 	RespondSuccessWithMessage(c, "Logged out successfully")
 }
+
+// ========================================================================
+// SOCIAL SIGN-IN
+// ========================================================================
+
+// respondOAuthError maps social sign-in errors to HTTP responses
+func respondOAuthError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, oauth.ErrNotConfigured):
+		RespondNotFound(c, "Sign-in provider")
+	case errors.Is(err, oauth.ErrInvalidToken):
+		RespondUnauthorized(c, "ID token is invalid or expired")
+	case errors.Is(err, repository.ErrUserIdentityNotFound):
+		RespondNotFound(c, "Linked account")
+	case errors.Is(err, repository.ErrIdentityAlreadyLinked),
+		errors.Is(err, repository.ErrLastSignInMethod):
+		middleware.WriteError(c, http.StatusConflict, middleware.ErrorResponse{
+			Error:   "Cannot change linked accounts",
+			Message: err.Error(),
+		})
+	case errors.Is(err, repository.ErrIdentityEmailUnverified):
+		middleware.WriteError(c, http.StatusUnprocessableEntity, middleware.ErrorResponse{
+			Error:   "Email not verified",
+			Message: "The provider has not verified this email address. Sign in with your password and link the account instead.",
+		})
+	case errors.Is(err, repository.ErrAccountEmailUnverified):
+		middleware.WriteError(c, http.StatusConflict, middleware.ErrorResponse{
+			Error:   "Account not linked",
+			Message: "An account with this email exists but has not verified it. Sign in with your password and link the account instead.",
+		})
+	case strings.Contains(err.Error(), "account is"):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Account inactive",
+			Message: err.Error(),
+		})
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// SocialLogin godoc
+// @Summary Sign in with Google or Apple
+// @Description Exchange a Google or Apple ID token for API tokens. A provider account seen for the first time is linked to the account with its verified email, or a new customer account is created. An existing account whose email is unverified is not linked (409): sign in with its password and link the provider account instead.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param provider path string true "Sign-in provider" Enums(google, apple)
+// @Param request body services.SocialLoginRequest true "ID token"
+// @Success 200 {object} SuccessResponse{data=services.AuthResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 422 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/auth/oauth/{provider} [post]
+func (h *AuthHandler) SocialLogin(c *gin.Context) {
+	req, ok := BindJSON[services.SocialLoginRequest](c)
+	if !ok {
+		return
+	}
+
+	authResponse, err := h.userService.SocialLogin(c.Request.Context(), c.Param("provider"), *req)
+	if err != nil {
+		respondOAuthError(c, err, "social login")
+		return
+	}
+
+	RespondSuccessWithData(c, authResponse, "Login successful")
+}
+
+// ListIdentities godoc
+// @Summary List linked sign-in accounts
+// @Description Google and Apple accounts the current user can sign in with
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} SuccessResponse{data=[]models.UserIdentity}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/auth/identities [get]
+func (h *AuthHandler) ListIdentities(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "view linked accounts")
+	if !ok {
+		return
+	}
+
+	identities, err := h.userService.ListIdentities(c.Request.Context(), userID)
+	if err != nil {
+		RespondInternalError(c, "fetch linked accounts", err)
+		return
+	}
+
+	RespondSuccess(c, identities)
+}
+
+// LinkIdentity godoc
+// @Summary Link a Google or Apple account
+// @Description Link the account an ID token belongs to, so the current user can also sign in with it
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param provider path string true "Sign-in provider" Enums(google, apple)
+// @Param request body services.LinkIdentityRequest true "ID token"
+// @Success 201 {object} SuccessResponse{data=models.UserIdentity}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/auth/identities/{provider} [post]
+func (h *AuthHandler) LinkIdentity(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "link accounts")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.LinkIdentityRequest](c)
+	if !ok {
+		return
+	}
+
+	identity, err := h.userService.LinkIdentity(c.Request.Context(), userID, c.Param("provider"), *req)
+	if err != nil {
+		respondOAuthError(c, err, "link account")
+		return
+	}
+
+	RespondCreated(c, identity, "Account linked")
+}
+
+// UnlinkIdentity godoc
+// @Summary Unlink a Google or Apple account
+// @Description Accounts without a password must keep at least one linked account
+// @Tags auth
+// @Produce json
+// @Security BearerAuth
+// @Param provider path string true "Sign-in provider" Enums(google, apple)
+// @Success 200 {object} SuccessResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/auth/identities/{provider} [delete]
+func (h *AuthHandler) UnlinkIdentity(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "unlink accounts")
+	if !ok {
+		return
+	}
+
+	if err := h.userService.UnlinkIdentity(c.Request.Context(), userID, c.Param("provider")); err != nil {
+		respondOAuthError(c, err, "unlink account")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Account unlinked")
+}
@@ -0,0 +1,119 @@
+// internal/handlers/auto_reply_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// AUTO-REPLY HANDLER - Barbers' out-of-hours replies
+// ========================================================================
+
+// AutoReplyHandler handles barber auto-reply requests
+type AutoReplyHandler struct {
+	autoReplyService *services.AutoReplyService
+}
+
+// NewAutoReplyHandler creates a new auto-reply handler
+func NewAutoReplyHandler(autoReplyService *services.AutoReplyService) *AutoReplyHandler {
+	return &AutoReplyHandler{
+		autoReplyService: autoReplyService,
+	}
+}
+
+// respondAutoReplyError maps auto-reply errors to HTTP responses
+func respondAutoReplyError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage your own auto-reply",
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		RespondBadRequest(c, "Invalid auto-reply", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// GetAutoReply godoc
+// @Summary Get a barber's auto-reply
+// @Description The message customers receive when they request a booking outside the barber's working hours, with the placeholders it may use and a preview for a booking requested now. Barbers may only view their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Success 200 {object} SuccessResponse{data=services.AutoReplyResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/auto-reply [get]
+func (h *AutoReplyHandler) GetAutoReply(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "view an auto-reply")
+	if !ok {
+		return
+	}
+
+	reply, err := h.autoReplyService.GetAutoReply(c.Request.Context(), id, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondAutoReplyError(c, err, "fetch auto-reply")
+		return
+	}
+
+	RespondSuccess(c, reply)
+}
+
+// UpdateAutoReply godoc
+// @Summary Set a barber's auto-reply
+// @Description Enable or disable the out-of-hours auto-reply and set its message. The message may use {{barber_name}}, {{customer_name}}, {{service_name}}, {{booking_number}} and {{next_open_time}} (when the barber is next working, in their timezone). Replies are only sent to customers booking for themselves while the barber is not working, and only for barbers with a schedule. Barbers may only manage their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param request body services.UpdateAutoReplyRequest true "Auto-reply"
+// @Success 200 {object} SuccessResponse{data=services.AutoReplyResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/auto-reply [put]
+func (h *AutoReplyHandler) UpdateAutoReply(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.UpdateAutoReplyRequest](c)
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "update an auto-reply")
+	if !ok {
+		return
+	}
+
+	reply, err := h.autoReplyService.UpdateAutoReply(c.Request.Context(), id, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondAutoReplyError(c, err, "update auto-reply")
+		return
+	}
+
+	RespondSuccessWithData(c, reply, "Auto-reply updated")
+}
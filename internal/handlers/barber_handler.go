@@ -3,11 +3,13 @@ package handlers
 
 import (
 	"fmt"
+	"strconv"
 
 	"barber-booking-system/internal/config"
 	"barber-booking-system/internal/middleware"
 	"barber-booking-system/internal/repository"
 	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -26,40 +28,39 @@ func NewBarberHandler(barberService *services.BarberService) *BarberHandler {
 
 // GetAllBarbers godoc
 // @Summary Get all barbers
-// @Description Get list of all barbers with optional filters
+// @Description Get list of all barbers with optional filters. By default barbers are ranked by a weighted score of rating, distance (when lat/lng are sent), response time, price and featured status; with explain=true each result carries its per-factor contributions. Report clicks on featured results (is_featured) to /api/v1/featured/{featured_placement_id}/click.
 // @Tags barbers
 // @Accept json
 // @Produce json
 // @Param status query string false "Filter by status"
 // @Param city query string false "Filter by city"
 // @Param state query string false "Filter by state"
+// @Param is_verified query bool false "Filter by verification"
 // @Param min_rating query number false "Minimum rating"
+// @Param category_id query int false "Only barbers offering a service in this category"
 // @Param search query string false "Search term"
-// @Param sort_by query string false "Sort by field (rating, total_bookings, shop_name)"
+// @Param sort_by query string false "Sort by field (rank, rating, total_bookings, shop_name, user_name, newest)" default(rank)
+// @Param lat query number false "Searcher latitude (with lng) for distance ranking"
+// @Param lng query number false "Searcher longitude (with lat) for distance ranking"
+// @Param explain query bool false "Include per-factor ranking contributions"
 // @Param limit query int false "Number of results" default(20)
 // @Param offset query int false "Offset for pagination" default(0)
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
 // @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /api/v1/barbers [get]
 func (h *BarberHandler) GetAllBarbers(c *gin.Context) {
-	filters := repository.BarberFilters{
-		Status:    c.Query("status"),
-		City:      c.Query("city"),
-		State:     c.Query("state"),
-		Search:    c.Query("search"),
-		SortBy:    c.Query("sort_by"),
-		Limit:     ParseIntQuery(c, "limit", 20),
-		Offset:    ParseIntQuery(c, "offset", 0),
-		MinRating: ParseFloatQuery(c, "min_rating", 0),
+	filters, ok := BindFilters[repository.BarberFilters](c)
+	if !ok {
+		return
 	}
-
-	if verifiedStr := c.Query("is_verified"); verifiedStr != "" {
-		verified := verifiedStr == "true"
-		filters.IsVerified = &verified
+	if !bindListingRanking(c, filters) {
+		return
 	}
 
 	// Get barbers
-	barbers, err := h.barberService.GetAllBarbers(c.Request.Context(), filters)
+	barbers, err := h.barberService.GetAllBarbers(c.Request.Context(), *filters)
 	if err != nil {
 		RespondInternalError(c, "fetch barbers", err)
 		return
@@ -79,6 +80,7 @@ func (h *BarberHandler) GetAllBarbers(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "Barber ID"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
@@ -131,6 +133,7 @@ func (h *BarberHandler) CreateBarber(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param uuid path string true "Barber UUID"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
 // @Success 200 {object} SuccessResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
@@ -218,14 +221,6 @@ func (h *BarberHandler) DeleteBarber(c *gin.Context) {
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /api/v1/barbers/{id}/status [patch]
-// ========================================================================
-// EXACT CODE TO ADD TO: internal/handlers/barber_handler.go
-// ========================================================================
-//
-// Find the UpdateBarberStatus function (around line 223)
-// Replace the ENTIRE function with this version:
-// ========================================================================
-
 func (h *BarberHandler) UpdateBarberStatus(c *gin.Context) {
 	id, ok := RequireIntParam(c, "id", "barber")
 	if !ok {
@@ -255,7 +250,7 @@ func (h *BarberHandler) UpdateBarberStatus(c *gin.Context) {
 	}
 
 	if !isValid {
-		c.JSON(400, middleware.ErrorResponse{
+		middleware.WriteError(c, 400, middleware.ErrorResponse{
 			Error:   "Invalid status",
 			Message: fmt.Sprintf("Status must be one of: %v. Got: %s", validStatuses, req.Status),
 		})
@@ -307,17 +302,28 @@ func (h *BarberHandler) GetBarberStatistics(c *gin.Context) {
 // @Param q query string true "Search query"
 // @Param city query string false "Filter by city"
 // @Param state query string false "Filter by state"
+// @Param category_id query int false "Only barbers offering a service in this category"
+// @Param lat query number false "Searcher latitude (with lng) for distance ranking"
+// @Param lng query number false "Searcher longitude (with lat) for distance ranking"
+// @Param explain query bool false "Include per-factor ranking contributions"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
 // @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /api/v1/barbers/search [get]
 func (h *BarberHandler) SearchBarbers(c *gin.Context) {
 	query := c.Query("q")
 	filters := repository.BarberFilters{
-		City:   c.Query("city"),
-		Name:   c.Query("user_name"),
-		State:  c.Query("state"),
-		Status: config.BarberStatusActive,
-		Limit:  50,
+		City:       c.Query("city"),
+		Name:       c.Query("user_name"),
+		State:      c.Query("state"),
+		Status:     config.BarberStatusActive,
+		CategoryID: ParseIntQuery(c, "category_id", 0),
+		Limit:      50,
+	}
+
+	if !bindListingRanking(c, &filters) {
+		return
 	}
 
 	barbers, err := h.barberService.SearchBarbers(c.Request.Context(), query, filters)
@@ -332,6 +338,64 @@ func (h *BarberHandler) SearchBarbers(c *gin.Context) {
 	})
 }
 
+// GetNearbyBarbers godoc
+// @Summary Find barbers nearby
+// @Description Active barbers within a radius of a point, nearest first, each with its distance (distance_km), rating and up to three most booked services (top_services). Barbers without a location are not listed.
+// @Tags barbers
+// @Produce json
+// @Param lat query number true "Latitude"
+// @Param lng query number true "Longitude"
+// @Param radius query number false "Radius in km (default 10, at most 100)"
+// @Param limit query int false "Maximum barbers (default 20, at most 50)"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
+// @Success 200 {object} SuccessResponse{data=[]models.Barber}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/barbers/nearby [get]
+func (h *BarberHandler) GetNearbyBarbers(c *gin.Context) {
+	query, ok := BindQuery[services.NearbyBarbersQuery](c)
+	if !ok {
+		return
+	}
+
+	barbers, err := h.barberService.FindNearby(c.Request.Context(), *query)
+	if err != nil {
+		if utils.ContainsAny(err.Error(), []string{"cannot"}) {
+			RespondBadRequest(c, "Invalid nearby search", err.Error())
+			return
+		}
+		RespondInternalError(c, "find nearby barbers", err)
+		return
+	}
+
+	RespondSuccessWithMeta(c, barbers, map[string]interface{}{
+		"count": len(barbers),
+	})
+}
+
+// bindListingRanking reads the searcher location (lat and lng, both or
+// neither) and the explain flag into filters. Responds 400 and returns
+// false on an invalid location.
+func bindListingRanking(c *gin.Context, filters *repository.BarberFilters) bool {
+	filters.Explain = c.Query("explain") == "true"
+
+	latStr, lngStr := c.Query("lat"), c.Query("lng")
+	if latStr == "" && lngStr == "" {
+		return true
+	}
+
+	lat, latErr := strconv.ParseFloat(latStr, 64)
+	lng, lngErr := strconv.ParseFloat(lngStr, 64)
+	if latErr != nil || lngErr != nil || lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		RespondBadRequest(c, "Invalid location", "lat and lng must be given together as valid coordinates")
+		return false
+	}
+
+	filters.Latitude = &lat
+	filters.Longitude = &lng
+	return true
+}
+
 // Request types (handler-specific)
 type StatusUpdateRequest struct {
 	Status string `json:"status" binding:"required"`
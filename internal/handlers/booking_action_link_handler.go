@@ -0,0 +1,111 @@
+// internal/handlers/booking_action_link_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/actionlink"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// BOOKING ACTION LINK HANDLER - One-tap confirm/cancel from reminders
+// ========================================================================
+
+// BookingActionLinkHandler handles the signed confirm/cancel links in reminders
+type BookingActionLinkHandler struct {
+	actionLinkService *services.BookingActionLinkService
+}
+
+// NewBookingActionLinkHandler creates a new booking action link handler
+func NewBookingActionLinkHandler(actionLinkService *services.BookingActionLinkService) *BookingActionLinkHandler {
+	return &BookingActionLinkHandler{
+		actionLinkService: actionLinkService,
+	}
+}
+
+// respondActionLinkError maps action link errors to HTTP responses.
+// Returns true if an error response was sent.
+func respondActionLinkError(c *gin.Context, err error, operation string) bool {
+	if err == nil {
+		return false
+	}
+
+	switch {
+	case errors.Is(err, actionlink.ErrInvalidToken):
+		RespondBadRequest(c, "Invalid link", "This link is not valid")
+	case errors.Is(err, repository.ErrActionLinkNotFound):
+		RespondNotFound(c, "Action link")
+	case errors.Is(err, actionlink.ErrExpired), errors.Is(err, repository.ErrActionLinkUsed):
+		middleware.WriteError(c, http.StatusGone, middleware.ErrorResponse{
+			Error:   "Link closed",
+			Message: "This link has already been used or has expired",
+		})
+	case utils.ContainsAny(err.Error(), []string{"must be", "cannot be cancelled", "terminal state"}):
+		middleware.WriteError(c, http.StatusConflict, middleware.ErrorResponse{
+			Error:   "Booking unavailable",
+			Message: err.Error(),
+		})
+	default:
+		HandleServiceError(c, err, "Booking", operation)
+	}
+	return true
+}
+
+// PreviewAction godoc
+// @Summary Describe a reminder link
+// @Description Describes the booking and action (confirm or cancel) of a signed link from a booking reminder without taking it, so link scanners that prefetch URLs cannot act. Post to the same URL to take the action.
+// @Tags bookings
+// @Produce json
+// @Param token path string true "Signed action link token"
+// @Success 200 {object} SuccessResponse{data=services.ActionLinkPreview}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 410 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/bookings/actions/{token} [get]
+func (h *BookingActionLinkHandler) PreviewAction(c *gin.Context) {
+	preview, err := h.actionLinkService.Preview(c.Request.Context(), c.Param("token"))
+	if respondActionLinkError(c, err, "preview booking action") {
+		return
+	}
+
+	RespondSuccessWithData(c, preview, "Booking action retrieved successfully")
+}
+
+// PerformAction godoc
+// @Summary Confirm or cancel a booking from a reminder link
+// @Description One-tap confirm or cancel from a booking reminder. The signed token authorizes the request, so no login is needed. A reminder's confirm and cancel links work once between them, until they expire or the appointment starts. Cancelling releases the slot; prepaid bookings are refunded per the cancellation policy. Each use is recorded in the booking history and the audit log.
+// @Tags bookings
+// @Produce json
+// @Param token path string true "Signed action link token"
+// @Success 200 {object} SuccessResponse{data=services.ActionLinkResult}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 410 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/bookings/actions/{token} [post]
+func (h *BookingActionLinkHandler) PerformAction(c *gin.Context) {
+	result, err := h.actionLinkService.Perform(c.Request.Context(), c.Param("token"), services.ActionLinkClient{
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		RequestID: middleware.GetRequestID(c),
+	})
+	if respondActionLinkError(c, err, "perform booking action") {
+		return
+	}
+
+	message := "Thanks, see you soon"
+	if result.Action == config.BookingActionCancel {
+		message = "Your booking has been cancelled"
+	}
+	RespondSuccessWithData(c, result, message)
+}
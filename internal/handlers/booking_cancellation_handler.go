@@ -0,0 +1,78 @@
+// internal/handlers/booking_cancellation_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// CANCELLATION REASONS
+// ========================================================================
+
+// GetCancellationReasons godoc
+// @Summary List cancellation reasons
+// @Description The reasons to pick from when cancelling a booking (reason_code), with labels in the requested language (by default the Accept-Language one). Unsupported languages get English.
+// @Tags bookings
+// @Produce json
+// @Param locale query string false "Language of the labels, e.g. es or pt-BR"
+// @Success 200 {object} SuccessResponse{data=[]services.CancellationReason}
+// @Router /api/v1/bookings/cancellation-reasons [get]
+func (h *BookingHandler) GetCancellationReasons(c *gin.Context) {
+	RespondSuccess(c, h.bookingService.CancellationReasons(c.DefaultQuery("locale", middleware.GetLocale(c))))
+}
+
+// GetBarberCancellationReasons godoc
+// @Summary Get a barber's cancellations by reason
+// @Description Counts the barber's cancelled bookings in a date range by reason, split by who cancelled, with each reason's share. Cancellations without a reason code count as unspecified. Barbers see their own, admins any
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param from query string false "Start date (YYYY-MM-DD)"
+// @Param to query string false "End date, inclusive (YYYY-MM-DD)"
+// @Param locale query string false "Language of the labels (default: the Accept-Language one)"
+// @Success 200 {object} SuccessResponse{data=models.CancellationReasonStats}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/cancellation-reasons [get]
+func (h *BookingHandler) GetBarberCancellationReasons(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	req, ok := BindQuery[services.CancellationReasonStatsRequest](c)
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "view cancellation reasons")
+	if !ok {
+		return
+	}
+
+	stats, err := h.bookingService.GetCancellationReasonStats(c.Request.Context(), barberID, userID, middleware.IsAdmin(c), *req)
+	switch {
+	case err == nil:
+		RespondSuccess(c, stats)
+	case err == repository.ErrNotOwner:
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only view your own cancellations",
+		})
+	case utils.ContainsAny(err.Error(), []string{"must be"}):
+		RespondBadRequest(c, "Invalid date range", err.Error())
+	default:
+		HandleServiceError(c, err, "Barber", "load cancellation reasons")
+	}
+}
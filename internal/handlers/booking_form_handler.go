@@ -0,0 +1,233 @@
+// internal/handlers/booking_form_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// BOOKING FORM HANDLER - Barbers' custom booking-form fields
+// ========================================================================
+
+// BookingFormHandler handles booking form requests
+type BookingFormHandler struct {
+	bookingFormService *services.BookingFormService
+}
+
+// NewBookingFormHandler creates a new booking form handler
+func NewBookingFormHandler(bookingFormService *services.BookingFormService) *BookingFormHandler {
+	return &BookingFormHandler{
+		bookingFormService: bookingFormService,
+	}
+}
+
+// respondBookingFormError maps booking form errors to HTTP responses
+func respondBookingFormError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage your own booking form",
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case errors.Is(err, repository.ErrFormFieldNotFound):
+		RespondNotFound(c, "Booking form field")
+	case errors.Is(err, repository.ErrDuplicateFormField):
+		RespondBadRequest(c, "Duplicate entry", err.Error())
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		RespondBadRequest(c, "Invalid booking form field", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// GetBookingForm godoc
+// @Summary Get a barber's booking form
+// @Description The custom fields a barber asks for when booking, in display order. Answer them with custom_fields (field key -> value) when creating a booking: text and select answers are strings (select answers one of the options), number answers numbers and checkbox answers true or false. Required fields must be answered, and a required checkbox must be checked.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Success 200 {object} SuccessResponse{data=[]models.BookingFormField}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/barbers/{id}/booking-form [get]
+func (h *BookingFormHandler) GetBookingForm(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	fields, err := h.bookingFormService.GetForm(c.Request.Context(), barberID)
+	if err != nil {
+		respondBookingFormError(c, err, "fetch booking form")
+		return
+	}
+
+	RespondSuccessWithMeta(c, fields, map[string]interface{}{
+		"barber_id": barberID,
+		"count":     len(fields),
+	})
+}
+
+// ListBookingFormFields godoc
+// @Summary List booking form fields
+// @Description All of a barber's booking form fields, including inactive ones. Barbers may only manage their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Success 200 {object} SuccessResponse{data=[]models.BookingFormField}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/booking-form/fields [get]
+func (h *BookingFormHandler) ListBookingFormFields(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "list booking form fields")
+	if !ok {
+		return
+	}
+
+	fields, err := h.bookingFormService.ListFields(c.Request.Context(), barberID, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondBookingFormError(c, err, "fetch booking form fields")
+		return
+	}
+
+	RespondSuccess(c, fields)
+}
+
+// CreateBookingFormField godoc
+// @Summary Add a booking form field
+// @Description Add a question to the barber's booking form. field_type is text (optionally with max_length and a pattern the whole answer must match), number (optionally with min_value and max_value), select (with options) or checkbox. The key names the answer in custom_fields and must be unique on the form. With barber_service_id the question is only asked, and always required, when that service is booked; the service must require a consultation. consultation_answers on such select or checkbox questions are answers that turn the booking into a consultation request to the barber. Barbers may only manage their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param field body services.BookingFormFieldRequest true "Field"
+// @Success 201 {object} SuccessResponse{data=models.BookingFormField}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/booking-form/fields [post]
+func (h *BookingFormHandler) CreateBookingFormField(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "add a booking form field")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.BookingFormFieldRequest](c)
+	if !ok {
+		return
+	}
+
+	field, err := h.bookingFormService.CreateField(c.Request.Context(), barberID, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondBookingFormError(c, err, "add booking form field")
+		return
+	}
+
+	RespondCreated(c, field, "Booking form field added")
+}
+
+// UpdateBookingFormField godoc
+// @Summary Update a booking form field
+// @Description Replace a booking form field; set is_active to false to stop asking it. Existing bookings keep their answers as they were asked. Barbers may only manage their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param fieldId path int true "Field ID"
+// @Param field body services.BookingFormFieldRequest true "Field"
+// @Success 200 {object} SuccessResponse{data=models.BookingFormField}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/booking-form/fields/{fieldId} [put]
+func (h *BookingFormHandler) UpdateBookingFormField(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	fieldID, ok := RequireIntParam(c, "fieldId", "booking form field")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "update a booking form field")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.BookingFormFieldRequest](c)
+	if !ok {
+		return
+	}
+
+	field, err := h.bookingFormService.UpdateField(c.Request.Context(), barberID, fieldID, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondBookingFormError(c, err, "update booking form field")
+		return
+	}
+
+	RespondSuccessWithData(c, field, "Booking form field updated")
+}
+
+// DeleteBookingFormField godoc
+// @Summary Remove a booking form field
+// @Description Remove a question from the barber's booking form. Bookings keep their answers to it. Barbers may only manage their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param fieldId path int true "Field ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/booking-form/fields/{fieldId} [delete]
+func (h *BookingFormHandler) DeleteBookingFormField(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	fieldID, ok := RequireIntParam(c, "fieldId", "booking form field")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "remove a booking form field")
+	if !ok {
+		return
+	}
+
+	if err := h.bookingFormService.DeleteField(c.Request.Context(), barberID, fieldID, userID, middleware.IsAdmin(c)); err != nil {
+		respondBookingFormError(c, err, "remove booking form field")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Booking form field removed")
+}
@@ -2,12 +2,19 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/ical"
 	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/payments"
+	"barber-booking-system/internal/querybudget"
 	"barber-booking-system/internal/repository"
 	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/statemachine"
 	"barber-booking-system/internal/utils"
 
 	"github.com/gin-gonic/gin"
@@ -33,22 +40,52 @@ func NewBookingHandler(bookingService *services.BookingService) *BookingHandler
 // HELPER FUNCTIONS
 // ========================================================================
 
+// bookingActor returns the authenticated user as a booking actor. Admins and
+// holders of bookings:manage may act on any booking.
+func bookingActor(c *gin.Context, action string) (services.BookingActor, bool) {
+	userID, ok := GetAuthUserID(c, action)
+	if !ok {
+		return services.BookingActor{}, false
+	}
+	return services.BookingActor{
+		UserID:   userID,
+		Override: middleware.IsAdmin(c) || middleware.HasPermission(c, config.PermissionBookingsManage),
+	}, true
+}
+
+// authorizeBooking checks the authenticated user may access booking id and
+// returns their user ID, responding with an error if not
+func (h *BookingHandler) authorizeBooking(c *gin.Context, id int, action string) (int, bool) {
+	actor, ok := bookingActor(c, action)
+	if !ok {
+		return 0, false
+	}
+	if _, err := h.bookingService.AuthorizeBooking(c.Request.Context(), id, actor); err != nil {
+		HandleServiceError(c, err, "Booking", action)
+		return 0, false
+	}
+	return actor.UserID, true
+}
+
 // ========================================================================
 // CREATE BOOKING
 // ========================================================================
 
 // CreateBooking godoc
 // @Summary Create a new booking
-// @Description Create a new appointment booking
+// @Description Create a new appointment booking. When recurrence is set, a booking series is created instead and every occurrence is checked for conflicts up front. Services that require a deposit need deposit_payment_method_id; the deposit is held on that card, and a declined card cancels the booking. Answers to the barber's booking-form fields (GET /barbers/{id}/booking-form) go in custom_fields, keyed by field key; required fields must be answered. To book any available barber of a shop, send shop_id instead of barber_id with catalog service ids: an active barber who offers the services and is free is picked by the configured assignment strategy (least_loaded by default), and the assignment is recorded in the booking's history. When answers to a service's booking questions need a consultation, no booking is made: the barber gets a consultation request instead, returned with 202.
 // @Tags bookings
 // @Accept json
 // @Produce json
 // @Param booking body services.CreateBookingRequest true "Booking data"
 // @Success 201 {object} SuccessResponse
+// @Success 202 {object} SuccessResponse{data=models.ConsultationRequest} "Consultation requested instead"
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 401 {object} middleware.ErrorResponse
-// @Failure 409 {object} middleware.ErrorResponse "Time slot conflict"
+// @Failure 402 {object} middleware.ErrorResponse "Deposit declined"
+// @Failure 409 {object} middleware.ErrorResponse "Time slot conflict, or no shop barber available"
 // @Failure 500 {object} middleware.ErrorResponse
+// @Failure 503 {object} middleware.ErrorResponse "Payments not configured"
 // @Security BearerAuth
 // @Router /api/v1/bookings [post]
 func (h *BookingHandler) CreateBooking(c *gin.Context) {
@@ -67,18 +104,41 @@ func (h *BookingHandler) CreateBooking(c *gin.Context) {
 		}
 	}
 
+	// Recurring requests create a whole series, with queries for each
+	// occurrence
+	if req.Recurrence != nil {
+		querybudget.Unlimited(c.Request.Context())
+		series, err := h.bookingService.CreateBookingSeries(c.Request.Context(), *req, createdByUserID)
+		if respondBookingSeriesError(c, err, "create booking series") {
+			return
+		}
+		RespondCreated(c, series, "Booking series created successfully")
+		return
+	}
+
 	// Create booking
 	booking, err := h.bookingService.CreateBooking(c.Request.Context(), *req, createdByUserID)
+	var consultation *services.ConsultationRequiredError
+	if errors.As(err, &consultation) {
+		RespondAccepted(c, consultation.Request, consultation.Error()+"; the barber will be in touch")
+		return
+	}
 	if err != nil {
 		// Check for specific error types
 		statusCode := http.StatusInternalServerError
-		if err.Error() == "time slot is not available, please choose another time" {
+		if isCouponError(err) {
+			statusCode = http.StatusBadRequest
+		} else if errors.Is(err, payments.ErrPaymentDeclined) {
+			statusCode = http.StatusPaymentRequired
+		} else if errors.Is(err, payments.ErrNotConfigured) {
+			statusCode = http.StatusServiceUnavailable
+		} else if err.Error() == "time slot is not available, please choose another time" || errors.Is(err, services.ErrNoBarberAvailable) {
 			statusCode = http.StatusConflict
 		} else if utils.ContainsAny(err.Error(), []string{"not found", "required", "must be", "cannot"}) {
 			statusCode = http.StatusBadRequest
 		}
 
-		c.JSON(statusCode, middleware.ErrorResponse{
+		middleware.WriteError(c, statusCode, middleware.ErrorResponse{
 			Error:   "Failed to create booking",
 			Message: err.Error(),
 		})
@@ -94,15 +154,19 @@ func (h *BookingHandler) CreateBooking(c *gin.Context) {
 
 // GetBooking godoc
 // @Summary Get booking by ID
-// @Description Get detailed information about a specific booking
+// @Description Get detailed information about a specific booking. Customers can view their own bookings and barbers the bookings assigned to them.
 // @Tags bookings
 // @Accept json
 // @Produce json
 // @Param id path int true "Booking ID"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
 // @Router /api/v1/bookings/{id} [get]
 func (h *BookingHandler) GetBooking(c *gin.Context) {
 	id, ok := RequireIntParam(c, "id", "booking")
@@ -110,7 +174,12 @@ func (h *BookingHandler) GetBooking(c *gin.Context) {
 		return
 	}
 
-	booking, err := h.bookingService.GetBookingByID(c.Request.Context(), id)
+	actor, ok := bookingActor(c, "view a booking")
+	if !ok {
+		return
+	}
+
+	booking, err := h.bookingService.GetBookingForActor(c.Request.Context(), id, actor)
 	if HandleServiceError(c, err, "Booking", "fetch booking") {
 		return
 	}
@@ -125,6 +194,7 @@ func (h *BookingHandler) GetBooking(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param uuid path string true "Booking UUID"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
 // @Success 200 {object} SuccessResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
@@ -142,11 +212,12 @@ func (h *BookingHandler) GetBookingByUUID(c *gin.Context) {
 
 // GetBookingByNumber godoc
 // @Summary Get booking by booking number
-// @Description Get detailed information about a specific booking by its human-readable booking number
+// @Description Get detailed information about a specific booking by its human-readable booking number. Archived bookings are found too (archived_at is set).
 // @Tags bookings
 // @Accept json
 // @Produce json
 // @Param number path string true "Booking Number (e.g., BK202411281234)"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
 // @Success 200 {object} SuccessResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
@@ -176,11 +247,15 @@ func (h *BookingHandler) GetBookingByNumber(c *gin.Context) {
 // @Param payment_status query string false "Filter by payment status"
 // @Param start_date_from query string false "Filter by start date from (RFC3339)"
 // @Param start_date_to query string false "Filter by start date to (RFC3339)"
-// @Param sort_by query string false "Sort by field" default(created_at)
+// @Param sort_by query string false "Sort by field (scheduled_start_time, total_price, created_at, status, payment_status, customer_name, booking_number)" default(created_at)
 // @Param order query string false "Sort order (ASC/DESC)" default(DESC)
 // @Param limit query int false "Limit results" default(50)
 // @Param offset query int false "Offset for pagination" default(0)
-// @Success 200 {object} SuccessResponse
+// @Param include_customer query bool false "Attach each customer's name and picture"
+// @Param include_barber query bool false "Attach each barber's shop summary"
+// @Param include_service query bool false "Attach each booked service's name, price and duration"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
+// @Success 200 {object} PaginatedResponse{data=[]services.BookingResponse}
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Security BearerAuth
@@ -193,23 +268,19 @@ func (h *BookingHandler) GetMyBookings(c *gin.Context) {
 	}
 
 	// Build filters from query params
-	filters, ok := BindQuery[repository.BookingFilters](c)
+	filters, ok := BindFilters[repository.BookingFilters](c)
 	if !ok {
 		return
 	}
 
 	// Get bookings
-	bookings, err := h.bookingService.GetCustomerBookings(c.Request.Context(), userID, *filters)
+	bookings, total, err := h.bookingService.GetCustomerBookings(c.Request.Context(), userID, *filters)
 	if err != nil {
 		RespondInternalError(c, "fetch bookings", err)
 		return
 	}
 
-	RespondSuccessWithMeta(c, bookings, map[string]interface{}{
-		"count":  len(bookings),
-		"limit":  filters.Limit,
-		"offset": filters.Offset,
-	})
+	RespondSuccessWithMeta(c, bookings, PageMeta(len(bookings), total, filters.Limit, filters.Offset))
 }
 
 // ========================================================================
@@ -227,11 +298,15 @@ func (h *BookingHandler) GetMyBookings(c *gin.Context) {
 // @Param payment_status query string false "Filter by payment status"
 // @Param start_date_from query string false "Filter by start date from (RFC3339)"
 // @Param start_date_to query string false "Filter by start date to (RFC3339)"
-// @Param sort_by query string false "Sort by field" default(scheduled_start_time)
+// @Param sort_by query string false "Sort by field (scheduled_start_time, total_price, created_at, status, payment_status, customer_name, booking_number)" default(scheduled_start_time)
 // @Param order query string false "Sort order (ASC/DESC)" default(ASC)
 // @Param limit query int false "Limit results" default(50)
 // @Param offset query int false "Offset for pagination" default(0)
-// @Success 200 {object} SuccessResponse
+// @Param include_customer query bool false "Attach each customer's name and picture"
+// @Param include_barber query bool false "Attach each barber's shop summary"
+// @Param include_service query bool false "Attach each booked service's name, price and duration"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
+// @Success 200 {object} PaginatedResponse{data=[]services.BookingResponse}
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /api/v1/barbers/{id}/bookings [get]
@@ -242,7 +317,7 @@ func (h *BookingHandler) GetBarberBookings(c *gin.Context) {
 	}
 
 	// Build filters from query params
-	filters, ok := BindQuery[repository.BookingFilters](c)
+	filters, ok := BindFilters[repository.BookingFilters](c)
 	if !ok {
 		return
 	}
@@ -253,18 +328,15 @@ func (h *BookingHandler) GetBarberBookings(c *gin.Context) {
 	}
 
 	// Get bookings
-	bookings, err := h.bookingService.GetBarberBookings(c.Request.Context(), barberID, *filters)
+	bookings, total, err := h.bookingService.GetBarberBookings(c.Request.Context(), barberID, *filters)
 	if err != nil {
 		RespondInternalError(c, "fetch bookings", err)
 		return
 	}
 
-	RespondSuccessWithMeta(c, bookings, map[string]interface{}{
-		"barber_id": barberID,
-		"count":     len(bookings),
-		"limit":     filters.Limit,
-		"offset":    filters.Offset,
-	})
+	meta := PageMeta(len(bookings), total, filters.Limit, filters.Offset)
+	meta["barber_id"] = barberID
+	RespondSuccessWithMeta(c, bookings, meta)
 }
 
 // GetTodayBookings godoc
@@ -298,13 +370,56 @@ func (h *BookingHandler) GetTodayBookings(c *gin.Context) {
 	})
 }
 
+// GetBarberDashboard godoc
+// @Summary Get the barber's live dashboard for today
+// @Description Consolidated "today" view for a barber: the live queue, the appointment in the chair, a countdown to the next appointment, whether the barber is running late, unconfirmed pending bookings, and earnings so far. Times are computed on every request; the underlying bookings may be cached for up to 30 seconds (see data_as_of).
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Success 200 {object} SuccessResponse{data=models.BarberDashboard}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/dashboard/today [get]
+func (h *BookingHandler) GetBarberDashboard(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "view the dashboard")
+	if !ok {
+		return
+	}
+
+	dashboard, err := h.bookingService.GetBarberDashboard(c.Request.Context(), barberID, userID, middleware.IsAdmin(c))
+	if err != nil {
+		if err == repository.ErrNotOwner {
+			middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+				Error:   "Forbidden",
+				Message: "You can only view your own dashboard",
+			})
+			return
+		}
+		HandleServiceError(c, err, "Barber", "load barber dashboard")
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	RespondSuccess(c, dashboard)
+}
+
 // ========================================================================
 // UPDATE BOOKING
 // ========================================================================
 
 // UpdateBooking godoc
 // @Summary Update booking details
-// @Description Update booking information (not status)
+// @Description Update booking information (not status). custom_fields replaces the answers to the barber's booking-form fields and is validated like at booking time.
 // @Tags bookings
 // @Accept json
 // @Produce json
@@ -312,6 +427,8 @@ func (h *BookingHandler) GetTodayBookings(c *gin.Context) {
 // @Param booking body services.UpdateBookingRequest true "Updated booking data"
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Security BearerAuth
@@ -327,14 +444,17 @@ func (h *BookingHandler) UpdateBooking(c *gin.Context) {
 		return
 	}
 
-	// Get user ID from auth context
-	var updatedByUserID *int
-	if userID, exists := middleware.GetUserID(c); exists {
-		updatedByUserID = &userID
+	userID, ok := h.authorizeBooking(c, id, "update booking")
+	if !ok {
+		return
 	}
 
 	// Update booking
-	booking, err := h.bookingService.UpdateBooking(c.Request.Context(), id, *req, updatedByUserID)
+	booking, err := h.bookingService.UpdateBooking(c.Request.Context(), id, *req, &userID)
+	if err != nil && utils.ContainsAny(err.Error(), []string{"custom field", "custom_fields"}) {
+		RespondBadRequest(c, "Invalid custom fields", err.Error())
+		return
+	}
 	if HandleServiceError(c, err, "booking", "update booking") {
 		return
 	}
@@ -348,7 +468,7 @@ func (h *BookingHandler) UpdateBooking(c *gin.Context) {
 
 // UpdateBookingStatus godoc
 // @Summary Update booking status
-// @Description Update the status of a booking (pending → confirmed → in_progress → completed)
+// @Description Update the status of a booking (pending → confirmed → in_progress → completed). Customers may only cancel their bookings; the other statuses are set by the booking's barber (or admins), and completing goes through the same checks as POST /bookings/{id}/complete.
 // @Tags bookings
 // @Accept json
 // @Produce json
@@ -356,6 +476,8 @@ func (h *BookingHandler) UpdateBooking(c *gin.Context) {
 // @Param status body services.UpdateStatusRequest true "New status"
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 422 {object} middleware.ErrorResponse "Invalid status transition"
 // @Failure 500 {object} middleware.ErrorResponse
@@ -372,21 +494,83 @@ func (h *BookingHandler) UpdateBookingStatus(c *gin.Context) {
 		return
 	}
 
-	// Get user ID from auth context
-	var updatedByUserID *int
-	if userID, exists := middleware.GetUserID(c); exists {
-		updatedByUserID = &userID
+	actor, ok := bookingActor(c, "update booking status")
+	if !ok {
+		return
 	}
 
-	// Update status
-	booking, err := h.bookingService.UpdateStatus(c.Request.Context(), id, req.Status, updatedByUserID)
-	if HandleServiceError(c, err, "Booking", "operation name") {
+	// Update status (the service checks who may set it)
+	booking, err := h.bookingService.UpdateStatusForActor(c.Request.Context(), id, req.Status, actor)
+	if errors.Is(err, statemachine.ErrTransitionNotAllowed) {
+		middleware.WriteError(c, http.StatusUnprocessableEntity, middleware.ErrorResponse{
+			Error:   "Invalid status transition",
+			Message: err.Error(),
+		})
+		return
+	}
+	if HandleServiceError(c, err, "Booking", "update booking status") {
 		return
 	}
 
 	RespondSuccessWithData(c, booking, "Booking status updated successfully")
 }
 
+// CompleteBooking godoc
+// @Summary Complete a booking
+// @Description Mark a booking completed. The barber may charge a different service_price (before discount and tax) for extra or less work done, within the configured percentage of the booked price and with a reason; tax and total are recalculated with the taxes the booking was booked with, and the old and new prices are recorded in the booking's history. Only the booking's barber and admins may complete it.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Param completion body services.CompleteBookingRequest false "Price adjustment"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 422 {object} middleware.ErrorResponse "Invalid status transition"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/bookings/{id}/complete [post]
+func (h *BookingHandler) CompleteBooking(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "booking")
+	if !ok {
+		return
+	}
+
+	// The body is optional: without one the booking completes at its price
+	var req services.CompleteBookingRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			RespondValidationError(c, err)
+			return
+		}
+	}
+
+	actor, ok := bookingActor(c, "complete booking")
+	if !ok {
+		return
+	}
+
+	booking, err := h.bookingService.CompleteBooking(c.Request.Context(), id, req, actor)
+	if errors.Is(err, statemachine.ErrTransitionNotAllowed) {
+		middleware.WriteError(c, http.StatusUnprocessableEntity, middleware.ErrorResponse{
+			Error:   "Invalid status transition",
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil && utils.ContainsAny(err.Error(), []string{"required", "cannot"}) {
+		RespondBadRequest(c, "Invalid price adjustment", err.Error())
+		return
+	}
+	if HandleServiceError(c, err, "Booking", "complete booking") {
+		return
+	}
+
+	RespondSuccessWithData(c, booking, "Booking completed successfully")
+}
+
 // ========================================================================
 // RESCHEDULE BOOKING
 // ========================================================================
@@ -401,6 +585,8 @@ func (h *BookingHandler) UpdateBookingStatus(c *gin.Context) {
 // @Param reschedule body services.RescheduleBookingRequest true "New schedule"
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 409 {object} middleware.ErrorResponse "Time slot conflict"
 // @Failure 500 {object} middleware.ErrorResponse
@@ -417,17 +603,16 @@ func (h *BookingHandler) RescheduleBooking(c *gin.Context) {
 		return
 	}
 
-	// Get user ID from auth context
-	var rescheduledByUserID *int
-	if userID, exists := middleware.GetUserID(c); exists {
-		rescheduledByUserID = &userID
+	userID, ok := h.authorizeBooking(c, id, "reschedule booking")
+	if !ok {
+		return
 	}
 
 	// Reschedule booking
-	booking, err := h.bookingService.RescheduleBooking(c.Request.Context(), id, *req, rescheduledByUserID)
+	booking, err := h.bookingService.RescheduleBooking(c.Request.Context(), id, *req, &userID)
 	if err != nil {
 		if utils.ContainsAny(err.Error(), []string{"not available", "conflict"}) {
-			c.JSON(http.StatusConflict, middleware.ErrorResponse{
+			middleware.WriteError(c, http.StatusConflict, middleware.ErrorResponse{
 				Error:   "Time slot not available",
 				Message: err.Error(),
 			})
@@ -447,7 +632,7 @@ func (h *BookingHandler) RescheduleBooking(c *gin.Context) {
 
 // CancelBooking godoc
 // @Summary Cancel a booking
-// @Description Cancel an existing booking
+// @Description Cancel an existing booking, optionally with a reason_code from GET /bookings/cancellation-reasons and free text. Prepaid bookings are refunded per the cancellation policy (full, partial or none depending on notice; barber cancellations are always refunded in full) and the outcome is returned in data.refund.
 // @Tags bookings
 // @Accept json
 // @Produce json
@@ -455,6 +640,8 @@ func (h *BookingHandler) RescheduleBooking(c *gin.Context) {
 // @Param cancel body services.CancelBookingRequest false "Cancellation details"
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 422 {object} middleware.ErrorResponse "Cannot cancel booking"
 // @Failure 500 {object} middleware.ErrorResponse
@@ -471,14 +658,17 @@ func (h *BookingHandler) CancelBooking(c *gin.Context) {
 	// Ignore error if body is empty - cancellation reason is optional
 	_ = c.ShouldBindJSON(&req)
 
-	// Get user ID from auth context
-	userID, ok := GetAuthUserID(c, "cancel a booking")
+	userID, ok := h.authorizeBooking(c, id, "cancel booking")
 	if !ok {
 		return
 	}
 
 	// Cancel booking
 	_, err := h.bookingService.CancelBooking(c.Request.Context(), id, req, &userID)
+	if errors.Is(err, services.ErrInvalidCancellationReason) {
+		RespondBadRequest(c, "Invalid cancellation reason", err.Error())
+		return
+	}
 	if HandleServiceError(c, err, "Booking", "cancel booking") {
 		return
 	}
@@ -538,6 +728,47 @@ func (h *BookingHandler) CheckAvailability(c *gin.Context) {
 	})
 }
 
+// GetBarberAvailability godoc
+// @Summary List open time slots for a barber
+// @Description List every open slot on a day, combining the barber's working hours (default business hours if no schedule is set), existing bookings, the appointment duration, and the service's buffer time
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param date query string true "Day in the barber's timezone (YYYY-MM-DD)"
+// @Param service_id query int false "Barber service ID (sets duration and buffer)"
+// @Param duration query int false "Duration in minutes (overrides the service duration)"
+// @Param interval query int false "Minutes between candidate start times" default(30)
+// @Param location_id query int false "Barber location (keeps travel time to bookings at the barber's other locations)"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/barbers/{id}/availability [get]
+func (h *BookingHandler) GetBarberAvailability(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	req, ok := BindQuery[services.AvailabilityRequest](c)
+	if !ok {
+		return
+	}
+
+	availability, err := h.bookingService.GetAvailableSlots(c.Request.Context(), barberID, *req)
+	if err != nil {
+		if utils.ContainsAny(err.Error(), []string{"must be", "not accepting", "not available"}) {
+			RespondBadRequest(c, "Invalid availability request", err.Error())
+			return
+		}
+		HandleServiceError(c, err, "Barber", "list available slots")
+		return
+	}
+
+	RespondSuccess(c, availability)
+}
+
 // ========================================================================
 // GET BOOKING STATISTICS
 // ========================================================================
@@ -599,6 +830,8 @@ func (h *BookingHandler) GetBarberBookingStats(c *gin.Context) {
 // @Param id path int true "Booking ID"
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Security BearerAuth
@@ -609,14 +842,8 @@ func (h *BookingHandler) GetBookingHistory(c *gin.Context) {
 		return
 	}
 
-	// First verify the booking exists
-	_, err := h.bookingService.GetBookingByID(c.Request.Context(), id)
-	if err != nil {
-		if err == repository.ErrBookingNotFound {
-			RespondNotFound(c, "Booking")
-			return
-		}
-		RespondInternalError(c, "verify booking", err)
+	// First verify the booking exists and is the user's
+	if _, ok := h.authorizeBooking(c, id, "fetch booking history"); !ok {
 		return
 	}
 
@@ -632,3 +859,41 @@ func (h *BookingHandler) GetBookingHistory(c *gin.Context) {
 		"count":      len(history),
 	})
 }
+
+// ========================================================================
+// CALENDAR EXPORT
+// ========================================================================
+
+// GetBookingCalendar godoc
+// @Summary Download a booking as a calendar file
+// @Description An iCalendar (.ics) file with the booking as an event, to add it to Apple, Google or Outlook calendars. Importing it again updates the event. Customers can export their own bookings and barbers the bookings assigned to them.
+// @Tags bookings
+// @Produce text/calendar
+// @Param id path int true "Booking ID"
+// @Success 200 {file} file
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/bookings/{id}/ics [get]
+func (h *BookingHandler) GetBookingCalendar(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "booking")
+	if !ok {
+		return
+	}
+
+	actor, ok := bookingActor(c, "export a booking")
+	if !ok {
+		return
+	}
+
+	calendar, err := h.bookingService.GetBookingCalendar(c.Request.Context(), id, actor)
+	if HandleServiceError(c, err, "Booking", "export booking") {
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="booking-%d.ics"`, id))
+	c.Data(http.StatusOK, ical.ContentType, calendar)
+}
@@ -0,0 +1,107 @@
+// internal/handlers/booking_import_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// BOOKING IMPORT HANDLER - Appointment history uploads
+// ========================================================================
+
+// BookingImportHandler handles imports of barbers' appointment history
+type BookingImportHandler struct {
+	bookingImportService *services.BookingImportService
+}
+
+// NewBookingImportHandler creates a new booking import handler
+func NewBookingImportHandler(bookingImportService *services.BookingImportService) *BookingImportHandler {
+	return &BookingImportHandler{
+		bookingImportService: bookingImportService,
+	}
+}
+
+// respondBookingImportError maps booking import errors to HTTP responses
+func respondBookingImportError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only import your own bookings",
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case utils.ContainsAny(err.Error(), []string{"csv", "must", "cannot"}):
+		RespondBadRequest(c, "Invalid booking file", err.Error())
+	default:
+		RespondInternalError(c, "import bookings", err)
+	}
+}
+
+// ImportBookings godoc
+// @Summary Import appointment history from CSV
+// @Description Add a shop's past appointments to the barber's bookings from a CSV file with a header row, so visit history and analytics work from day one. Columns: start (or date and time), service (required; matched to the barber's services by name), duration in minutes or end, price, tip, status (completed, no_show or cancelled; default completed), customer_name, customer_email, customer_phone, payment_method, notes and reference. Times without an offset are read in the timezone field. Only appointments that have ended can be imported; rules for upcoming bookings such as working hours and overlaps do not apply, and no notifications are sent. Customers are linked to existing accounts by email, then phone. Rows imported before (by reference, or by time, service and customer) are skipped, so re-uploading is safe. A malformed file imports nothing; otherwise each row's outcome is reported. Barbers may only import their own.
+// @Tags barbers
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param file formData file true "CSV file"
+// @Param timezone formData string false "IANA timezone of the file's times" default(UTC)
+// @Success 200 {object} SuccessResponse{data=services.BookingImportResult}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 413 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/bookings/import [post]
+func (h *BookingImportHandler) ImportBookings(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "import bookings")
+	if !ok {
+		return
+	}
+
+	var opts services.BookingImportOptions
+	if tz := c.PostForm("timezone"); tz != "" {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			RespondBadRequest(c, "Invalid timezone", "timezone must be an IANA timezone such as Europe/London")
+			return
+		}
+		opts.Location = loc
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		RespondBadRequest(c, "Missing file", "Upload the appointment history as a CSV file in the file field")
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		RespondInternalError(c, "read booking file", err)
+		return
+	}
+	defer file.Close()
+
+	result, err := h.bookingImportService.Import(c.Request.Context(), id, file, opts, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondBookingImportError(c, err)
+		return
+	}
+
+	RespondSuccessWithData(c, result, "Bookings imported")
+}
@@ -0,0 +1,163 @@
+// internal/handlers/booking_series_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// BOOKING SERIES HANDLERS - Recurring appointments
+// ========================================================================
+
+// respondBookingSeriesError maps series errors to HTTP responses.
+// Returns true if an error response was sent.
+func respondBookingSeriesError(c *gin.Context, err error, operation string) bool {
+	if err == nil {
+		return false
+	}
+
+	switch {
+	case err == repository.ErrBookingSeriesNotFound:
+		RespondNotFound(c, "Booking series")
+	case utils.ContainsAny(err.Error(), []string{"not available"}):
+		middleware.WriteError(c, http.StatusConflict, middleware.ErrorResponse{
+			Error:   "Time slot not available",
+			Message: err.Error(),
+		})
+	case utils.ContainsAny(err.Error(), []string{"required", "must be", "cannot", "no upcoming"}):
+		RespondBadRequest(c, "Invalid booking series request", err.Error())
+	default:
+		HandleServiceError(c, err, "Booking", operation)
+	}
+	return true
+}
+
+// authorizeBookingSeries checks the authenticated user may access series
+// id (its customer, its barber, or an override) and returns their user ID,
+// responding with an error if not
+func (h *BookingHandler) authorizeBookingSeries(c *gin.Context, id int, action string) (int, bool) {
+	actor, ok := bookingActor(c, action)
+	if !ok {
+		return 0, false
+	}
+	if _, err := h.bookingService.AuthorizeBookingSeries(c.Request.Context(), id, actor); err != nil {
+		respondBookingSeriesError(c, err, action)
+		return 0, false
+	}
+	return actor.UserID, true
+}
+
+// GetBookingSeries godoc
+// @Summary Get a booking series
+// @Description Get a recurring booking series with all of its occurrences. Only the series' customer and barber (or admins) may see it.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Series ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/bookings/series/{id} [get]
+func (h *BookingHandler) GetBookingSeries(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "booking series")
+	if !ok {
+		return
+	}
+	if _, ok := h.authorizeBookingSeries(c, id, "view booking series"); !ok {
+		return
+	}
+
+	series, err := h.bookingService.GetBookingSeries(c.Request.Context(), id)
+	if respondBookingSeriesError(c, err, "fetch booking series") {
+		return
+	}
+
+	RespondSuccess(c, series)
+}
+
+// RescheduleBookingSeries godoc
+// @Summary Reschedule a booking series
+// @Description Move every upcoming occurrence of a series. new_start_time is the new time of the next occurrence; later occurrences shift by the same amount. Use PUT /bookings/{id}/reschedule to move a single occurrence. Only the series' customer and barber (or admins) may reschedule it.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Series ID"
+// @Param reschedule body services.RescheduleSeriesRequest true "New schedule"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse "Time slot conflict"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/bookings/series/{id}/reschedule [put]
+func (h *BookingHandler) RescheduleBookingSeries(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "booking series")
+	if !ok {
+		return
+	}
+
+	req, ok := BindJSON[services.RescheduleSeriesRequest](c)
+	if !ok {
+		return
+	}
+	userID, ok := h.authorizeBookingSeries(c, id, "reschedule booking series")
+	if !ok {
+		return
+	}
+
+	series, err := h.bookingService.RescheduleBookingSeries(c.Request.Context(), id, *req, &userID)
+	if respondBookingSeriesError(c, err, "reschedule booking series") {
+		return
+	}
+
+	RespondSuccessWithData(c, series, "Booking series rescheduled successfully")
+}
+
+// CancelBookingSeries godoc
+// @Summary Cancel a booking series
+// @Description Cancel every upcoming occurrence of a series. Use DELETE /bookings/{id} to cancel a single occurrence. Only the series' customer and barber (or admins) may cancel it.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Series ID"
+// @Param cancel body services.CancelBookingRequest false "Cancellation details"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/bookings/series/{id} [delete]
+func (h *BookingHandler) CancelBookingSeries(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "booking series")
+	if !ok {
+		return
+	}
+
+	// Cancellation reason is optional
+	var req services.CancelBookingRequest
+	_ = c.ShouldBindJSON(&req)
+
+	userID, ok := h.authorizeBookingSeries(c, id, "cancel booking series")
+	if !ok {
+		return
+	}
+
+	series, err := h.bookingService.CancelBookingSeries(c.Request.Context(), id, req, &userID)
+	if respondBookingSeriesError(c, err, "cancel booking series") {
+		return
+	}
+
+	RespondSuccessWithData(c, series, "Booking series cancelled successfully")
+}
@@ -0,0 +1,121 @@
+// internal/handlers/calendar_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"barber-booking-system/internal/ical"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// CALENDAR HANDLER - Subscribable booking calendars (webcal feeds)
+// ========================================================================
+
+// CalendarHandler handles calendar feed requests
+type CalendarHandler struct {
+	feedService *services.CalendarFeedService
+}
+
+// NewCalendarHandler creates a new calendar handler
+func NewCalendarHandler(feedService *services.CalendarFeedService) *CalendarHandler {
+	return &CalendarHandler{
+		feedService: feedService,
+	}
+}
+
+// requestBaseURL returns the public URL of this API as seen by the client,
+// honoring the headers set by TLS-terminating proxies
+func requestBaseURL(c *gin.Context) string {
+	scheme := "http"
+	if c.Request.TLS != nil || strings.EqualFold(c.GetHeader("X-Forwarded-Proto"), "https") {
+		scheme = "https"
+	}
+	host := c.Request.Host
+	if forwarded := c.GetHeader("X-Forwarded-Host"); forwarded != "" {
+		host = forwarded
+	}
+	return scheme + "://" + host
+}
+
+// GetMyFeed godoc
+// @Summary Get my calendar feed
+// @Description The URL of the current user's calendar feed, listing their upcoming bookings (as a customer and, for barbers, with their customers). Subscribe to it from Apple Calendar (webcal_url) or Google Calendar ("From URL", url). The feed is created on first request.
+// @Tags calendar
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=services.CalendarFeedResponse}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/calendar/feed [get]
+func (h *CalendarHandler) GetMyFeed(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "view calendar feed")
+	if !ok {
+		return
+	}
+
+	feed, err := h.feedService.GetFeed(c.Request.Context(), userID, requestBaseURL(c))
+	if err != nil {
+		RespondInternalError(c, "fetch calendar feed", err)
+		return
+	}
+
+	RespondSuccess(c, feed)
+}
+
+// ResetMyFeed godoc
+// @Summary Reset my calendar feed
+// @Description Replace the current user's feed URL, e.g. after sharing it by mistake. Calendars subscribed to the old URL stop updating.
+// @Tags calendar
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=services.CalendarFeedResponse}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/calendar/feed/reset [post]
+func (h *CalendarHandler) ResetMyFeed(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "reset calendar feed")
+	if !ok {
+		return
+	}
+
+	feed, err := h.feedService.ResetFeed(c.Request.Context(), userID, requestBaseURL(c))
+	if err != nil {
+		RespondInternalError(c, "reset calendar feed", err)
+		return
+	}
+
+	RespondSuccessWithData(c, feed, "Calendar feed reset")
+}
+
+// GetFeed godoc
+// @Summary Get a calendar feed
+// @Description The iCalendar feed polled by subscribed calendar apps. The token in the URL authorizes the request, so no login is needed.
+// @Tags calendar
+// @Produce text/calendar
+// @Param token path string true "Feed token, optionally with an .ics extension"
+// @Success 200 {file} file
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/calendar/feeds/{token} [get]
+func (h *CalendarHandler) GetFeed(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	calendar, err := h.feedService.RenderFeed(c.Request.Context(), token)
+	if errors.Is(err, repository.ErrCalendarFeedNotFound) {
+		RespondNotFound(c, "Calendar feed")
+		return
+	}
+	if err != nil {
+		RespondInternalError(c, "render calendar feed", err)
+		return
+	}
+
+	c.Header("Cache-Control", "private, max-age=300")
+	c.Data(http.StatusOK, ical.ContentType, calendar)
+}
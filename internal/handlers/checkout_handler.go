@@ -0,0 +1,83 @@
+// internal/handlers/checkout_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/payments"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// CHECKOUT HANDLER - Book and pay in one request
+// ========================================================================
+
+// CheckoutHandler handles paid booking creation
+type CheckoutHandler struct {
+	checkoutService *services.CheckoutService
+}
+
+// NewCheckoutHandler creates a new checkout handler
+func NewCheckoutHandler(checkoutService *services.CheckoutService) *CheckoutHandler {
+	return &CheckoutHandler{
+		checkoutService: checkoutService,
+	}
+}
+
+// Checkout godoc
+// @Summary Book and pay
+// @Description Reserve a slot, authorize the payment, confirm the booking and notify the customer. If any step fails the completed steps are undone: the hold on the slot is released and the card authorization is voided.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param checkout body services.CheckoutRequest true "Booking and payment data"
+// @Success 201 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 402 {object} middleware.ErrorResponse "Payment declined"
+// @Failure 409 {object} middleware.ErrorResponse "Time slot conflict"
+// @Failure 503 {object} middleware.ErrorResponse "Payments not configured"
+// @Security BearerAuth
+// @Router /api/v1/bookings/checkout [post]
+func (h *CheckoutHandler) Checkout(c *gin.Context) {
+	req, ok := BindJSON[services.CheckoutRequest](c)
+	if !ok {
+		return
+	}
+
+	var createdByUserID *int
+	if userID, exists := middleware.GetUserID(c); exists {
+		createdByUserID = &userID
+		if req.CustomerID == nil {
+			req.CustomerID = &userID
+		}
+	}
+
+	booking, err := h.checkoutService.CreateBookingWithPayment(c.Request.Context(), *req, createdByUserID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, payments.ErrNotConfigured):
+			statusCode = http.StatusServiceUnavailable
+		case errors.Is(err, payments.ErrPaymentDeclined):
+			statusCode = http.StatusPaymentRequired
+		case err.Error() == "time slot is not available, please choose another time":
+			statusCode = http.StatusConflict
+		case utils.ContainsAny(err.Error(), []string{"not found", "required", "must be", "cannot"}):
+			statusCode = http.StatusBadRequest
+		}
+
+		middleware.WriteError(c, statusCode, middleware.ErrorResponse{
+			Error:   "Checkout failed",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	RespondCreated(c, booking, "Booking confirmed and payment authorized")
+}
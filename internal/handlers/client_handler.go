@@ -0,0 +1,144 @@
+// internal/handlers/client_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// CLIENT HANDLER - Barber client lists and imports
+// ========================================================================
+
+// ClientHandler handles barbers' client lists
+type ClientHandler struct {
+	clientImportService *services.ClientImportService
+}
+
+// NewClientHandler creates a new client handler
+func NewClientHandler(clientImportService *services.ClientImportService) *ClientHandler {
+	return &ClientHandler{
+		clientImportService: clientImportService,
+	}
+}
+
+// respondClientError maps client list errors to HTTP responses
+func respondClientError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage your own clients",
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case utils.ContainsAny(err.Error(), []string{"csv", "must", "cannot"}):
+		RespondBadRequest(c, "Invalid client file", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ListClients godoc
+// @Summary List a barber's clients
+// @Description The barber's client list by name, with consent flags and the linked customer account. Barbers may only view their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param limit query int false "Page size" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} SuccessResponse{data=[]models.BarberClient}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/clients [get]
+func (h *ClientHandler) ListClients(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "view clients")
+	if !ok {
+		return
+	}
+	limit := ParseLimitQuery(c, 0)
+	offset := ParseIntQuery(c, "offset", 0)
+
+	clients, total, err := h.clientImportService.ListClients(c.Request.Context(), id, limit, offset, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondClientError(c, err, "fetch clients")
+		return
+	}
+
+	RespondSuccessWithMeta(c, clients, PageMeta(len(clients), total, limit, offset))
+}
+
+// ImportClients godoc
+// @Summary Import clients from CSV
+// @Description Add a shop's existing clients to the barber's client list from a CSV file with a header row. Columns: name (required), email, phone, notes, email_consent and sms_consent (yes/no); each row needs an email or phone. Clients are matched to existing accounts by email, then phone; clients already listed or repeated in the file are skipped, so re-uploading is safe. New clients with an email get a customer account, and those who consented to email are invited to claim it unless send_invitations is false. A malformed file imports nothing; otherwise each row's outcome is reported. Barbers may only import their own.
+// @Tags barbers
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param file formData file true "CSV file"
+// @Param send_invitations formData bool false "Email invitations to new clients who consented" default(true)
+// @Success 200 {object} SuccessResponse{data=services.ClientImportResult}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 413 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/clients/import [post]
+func (h *ClientHandler) ImportClients(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "import clients")
+	if !ok {
+		return
+	}
+
+	opts := services.ClientImportOptions{SendInvitations: true}
+	if v := c.PostForm("send_invitations"); v != "" {
+		send, err := strconv.ParseBool(v)
+		if err != nil {
+			RespondBadRequest(c, "Invalid send_invitations", "send_invitations must be true or false")
+			return
+		}
+		opts.SendInvitations = send
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		RespondBadRequest(c, "Missing file", "Upload the client list as a CSV file in the file field")
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		RespondInternalError(c, "read client file", err)
+		return
+	}
+	defer file.Close()
+
+	result, err := h.clientImportService.Import(c.Request.Context(), id, file, opts, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondClientError(c, err, "import clients")
+		return
+	}
+
+	RespondSuccessWithData(c, result, "Clients imported")
+}
@@ -0,0 +1,168 @@
+// internal/handlers/commission_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// COMMISSION HANDLER - Booking staff splits and barber earnings
+// ========================================================================
+
+// CommissionHandler handles commission split and earnings requests
+type CommissionHandler struct {
+	commissionService *services.CommissionService
+}
+
+// NewCommissionHandler creates a new commission handler
+func NewCommissionHandler(commissionService *services.CommissionService) *CommissionHandler {
+	return &CommissionHandler{
+		commissionService: commissionService,
+	}
+}
+
+// respondCommissionError maps commission errors to HTTP responses
+func respondCommissionError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "Only the booking's barber can manage its earnings",
+		})
+	case errors.Is(err, repository.ErrBookingNotFound):
+		RespondNotFound(c, "Booking")
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		RespondBadRequest(c, "Invalid commission request", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ========================================================================
+// BOOKING STAFF
+// ========================================================================
+
+// GetBookingStaff godoc
+// @Summary List who worked on a booking
+// @Description The booking's barber (lead) and any assistants, with each one's percentage of the booking's earnings
+// @Tags bookings
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Success 200 {object} SuccessResponse{data=services.BookingStaffResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/bookings/{id}/staff [get]
+func (h *CommissionHandler) GetBookingStaff(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "booking")
+	if !ok {
+		return
+	}
+	actor, ok := bookingActor(c, "view booking staff")
+	if !ok {
+		return
+	}
+
+	staff, err := h.commissionService.GetBookingStaff(c.Request.Context(), id, actor)
+	if err != nil {
+		respondCommissionError(c, err, "fetch booking staff")
+		return
+	}
+
+	RespondSuccess(c, staff)
+}
+
+// SetBookingStaff godoc
+// @Summary Split a booking with assistants
+// @Description Replace the assistants who performed part of a booking, each with a percentage of its earnings (total before tax, and the tip). The booking's barber keeps the rest. Only the booking's barber and admins can split a booking.
+// @Tags bookings
+// @Accept json
+// @Produce json
+// @Param id path int true "Booking ID"
+// @Param staff body services.BookingStaffRequest true "Assistants"
+// @Success 200 {object} SuccessResponse{data=services.BookingStaffResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/bookings/{id}/staff [put]
+func (h *CommissionHandler) SetBookingStaff(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "booking")
+	if !ok {
+		return
+	}
+	actor, ok := bookingActor(c, "split a booking")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.BookingStaffRequest](c)
+	if !ok {
+		return
+	}
+
+	staff, err := h.commissionService.SetBookingStaff(c.Request.Context(), id, *req, actor)
+	if err != nil {
+		respondCommissionError(c, err, "update booking staff")
+		return
+	}
+
+	RespondSuccessWithData(c, staff, "Booking staff updated")
+}
+
+// ========================================================================
+// EARNINGS
+// ========================================================================
+
+// GetBarberEarnings godoc
+// @Summary Get a barber's earnings
+// @Description The barber's share of the completed bookings they worked on, as lead or assistant, over a date range (UTC days, inclusive; default the last 30 days), with the platform commission and the resulting payout. Barbers may only view their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Success 200 {object} SuccessResponse{data=models.EarningsReport}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/earnings [get]
+func (h *CommissionHandler) GetBarberEarnings(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "view earnings")
+	if !ok {
+		return
+	}
+	query, ok := BindQuery[services.EarningsQuery](c)
+	if !ok {
+		return
+	}
+
+	report, err := h.commissionService.GetEarnings(c.Request.Context(), id, *query, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondCommissionError(c, err, "fetch earnings")
+		return
+	}
+
+	RespondSuccess(c, report)
+}
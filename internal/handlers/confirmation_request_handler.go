@@ -0,0 +1,97 @@
+// internal/handlers/confirmation_request_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// CONFIRMATION REQUEST HANDLER - One-tap answers for likely no-shows
+// ========================================================================
+
+// ConfirmationRequestHandler handles answers to no-show risk confirmation requests
+type ConfirmationRequestHandler struct {
+	confirmationService *services.ConfirmationRequestService
+}
+
+// NewConfirmationRequestHandler creates a new confirmation request handler
+func NewConfirmationRequestHandler(confirmationService *services.ConfirmationRequestService) *ConfirmationRequestHandler {
+	return &ConfirmationRequestHandler{
+		confirmationService: confirmationService,
+	}
+}
+
+// respondConfirmationError maps confirmation request errors to HTTP responses.
+// Returns true if an error response was sent.
+func respondConfirmationError(c *gin.Context, err error, operation string) bool {
+	if err == nil {
+		return false
+	}
+
+	switch {
+	case err == repository.ErrConfirmationRequestNotFound:
+		RespondNotFound(c, "Confirmation request")
+	case err == repository.ErrConfirmationRequestClosed:
+		middleware.WriteError(c, http.StatusGone, middleware.ErrorResponse{
+			Error:   "Confirmation request closed",
+			Message: "This confirmation request has already been answered or has expired",
+		})
+	case utils.ContainsAny(err.Error(), []string{"must be", "cannot be cancelled", "terminal state"}):
+		middleware.WriteError(c, http.StatusConflict, middleware.ErrorResponse{
+			Error:   "Booking unavailable",
+			Message: err.Error(),
+		})
+	default:
+		HandleServiceError(c, err, "Booking", operation)
+	}
+	return true
+}
+
+// ConfirmAttendance godoc
+// @Summary Confirm an appointment from a confirmation request
+// @Description One-tap answer to the extra confirmation request sent before appointments of customers with a high no-show rate. The token from the notification authorizes the request, so no login is needed. Each request can be answered once, until the appointment starts.
+// @Tags bookings
+// @Produce json
+// @Param token path string true "Confirmation request token"
+// @Success 200 {object} SuccessResponse{data=services.ConfirmationResponseResult}
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 410 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/bookings/confirmations/{token}/confirm [post]
+func (h *ConfirmationRequestHandler) ConfirmAttendance(c *gin.Context) {
+	result, err := h.confirmationService.Confirm(c.Request.Context(), c.Param("token"))
+	if respondConfirmationError(c, err, "confirm booking") {
+		return
+	}
+
+	RespondSuccessWithData(c, result, "Thanks, see you soon")
+}
+
+// CancelAttendance godoc
+// @Summary Cancel an appointment from a confirmation request
+// @Description One-tap cancellation from the extra confirmation request sent before appointments of customers with a high no-show rate. The booking is cancelled by the customer right away, releasing the slot; prepaid bookings are refunded per the cancellation policy. The token authorizes the request, so no login is needed.
+// @Tags bookings
+// @Produce json
+// @Param token path string true "Confirmation request token"
+// @Success 200 {object} SuccessResponse{data=services.ConfirmationResponseResult}
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 410 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/bookings/confirmations/{token}/cancel [post]
+func (h *ConfirmationRequestHandler) CancelAttendance(c *gin.Context) {
+	result, err := h.confirmationService.Cancel(c.Request.Context(), c.Param("token"))
+	if respondConfirmationError(c, err, "cancel booking") {
+		return
+	}
+
+	RespondSuccessWithData(c, result, "Your booking has been cancelled")
+}
@@ -0,0 +1,451 @@
+// internal/handlers/consultation_handler.go
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/imaging"
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/payments"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// CONSULTATION HANDLER - Bookings that need a consultation first
+// ========================================================================
+
+// ConsultationHandler handles consultation request requests
+type ConsultationHandler struct {
+	consultationService *services.ConsultationService
+}
+
+// NewConsultationHandler creates a new consultation handler
+func NewConsultationHandler(consultationService *services.ConsultationService) *ConsultationHandler {
+	return &ConsultationHandler{
+		consultationService: consultationService,
+	}
+}
+
+// respondConsultationError maps consultation errors to HTTP responses
+func respondConsultationError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage your own consultation requests",
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case errors.Is(err, repository.ErrBarberServiceNotFound):
+		RespondNotFound(c, "Service")
+	case errors.Is(err, repository.ErrConsultationRequestNotFound):
+		RespondNotFound(c, "Consultation request")
+	case errors.Is(err, repository.ErrConsultationQuoteChanged):
+		middleware.WriteError(c, http.StatusConflict, middleware.ErrorResponse{
+			Error:   "Quote changed",
+			Message: err.Error(),
+		})
+	case errors.Is(err, repository.ErrConsultationPhotoLimit):
+		middleware.WriteError(c, http.StatusUnprocessableEntity, middleware.ErrorResponse{
+			Error:   "Too many photos",
+			Message: err.Error(),
+		})
+	case errors.Is(err, services.ErrImageTooLarge), errors.Is(err, imaging.ErrTooManyPixels):
+		middleware.WriteError(c, http.StatusRequestEntityTooLarge, middleware.ErrorResponse{
+			Error:   "Image too large",
+			Message: err.Error(),
+		})
+	case errors.Is(err, imaging.ErrUnsupportedFormat):
+		middleware.WriteError(c, http.StatusUnsupportedMediaType, middleware.ErrorResponse{
+			Error:   "Unsupported image",
+			Message: err.Error(),
+		})
+	case errors.Is(err, payments.ErrPaymentDeclined):
+		middleware.WriteError(c, http.StatusPaymentRequired, middleware.ErrorResponse{
+			Error:   "Failed to create booking",
+			Message: err.Error(),
+		})
+	case err.Error() == "time slot is not available, please choose another time":
+		middleware.WriteError(c, http.StatusConflict, middleware.ErrorResponse{
+			Error:   "Failed to create booking",
+			Message: err.Error() + "; ask the barber for a new quote",
+		})
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot", "required", "not currently"}):
+		RespondBadRequest(c, "Invalid consultation request", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ListConsultationRequests godoc
+// @Summary List a barber's consultation requests
+// @Description Consultations customers asked for, and bookings whose answers to a service's booking questions needed one, newest first. Follow up with the customer, then quote a price and time, or mark the request booked or declined. Barbers may only see their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param status query string false "Filter by status (open, quoted, booked, declined)"
+// @Param limit query int false "Limit results" default(20)
+// @Param offset query int false "Offset for pagination" default(0)
+// @Success 200 {object} SuccessResponse{data=[]models.ConsultationRequest}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/consultation-requests [get]
+func (h *ConsultationHandler) ListConsultationRequests(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "list consultation requests")
+	if !ok {
+		return
+	}
+	req, ok := BindQuery[services.ConsultationRequestListRequest](c)
+	if !ok {
+		return
+	}
+
+	requests, err := h.consultationService.List(c.Request.Context(), barberID, userID, middleware.IsAdmin(c), req)
+	if err != nil {
+		respondConsultationError(c, err, "fetch consultation requests")
+		return
+	}
+
+	RespondSuccessWithMeta(c, requests, PaginationMeta(len(requests), req.Limit, req.Offset))
+}
+
+// RespondToConsultationRequest godoc
+// @Summary Answer a consultation request
+// @Description Mark a pending consultation request booked (after booking the customer in) or declined, with optional notes for the customer. Signed-in customers are notified. Barbers may only answer their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param requestId path int true "Consultation request ID"
+// @Param response body services.ConsultationResponseRequest true "Answer"
+// @Success 200 {object} SuccessResponse{data=models.ConsultationRequest}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/consultation-requests/{requestId} [put]
+func (h *ConsultationHandler) RespondToConsultationRequest(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	requestID, ok := RequireIntParam(c, "requestId", "consultation request")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "answer a consultation request")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.ConsultationResponseRequest](c)
+	if !ok {
+		return
+	}
+
+	request, err := h.consultationService.Respond(c.Request.Context(), barberID, requestID, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondConsultationError(c, err, "answer consultation request")
+		return
+	}
+
+	RespondSuccessWithData(c, request, "Consultation request answered")
+}
+
+// GetConsultationRequest godoc
+// @Summary Get a barber's consultation request
+// @Description One consultation request with the photos the customer attached. Barbers may only see their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param requestId path int true "Consultation request ID"
+// @Success 200 {object} SuccessResponse{data=models.ConsultationRequest}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/consultation-requests/{requestId} [get]
+func (h *ConsultationHandler) GetConsultationRequest(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	requestID, ok := RequireIntParam(c, "requestId", "consultation request")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "view a consultation request")
+	if !ok {
+		return
+	}
+
+	request, err := h.consultationService.Find(c.Request.Context(), barberID, requestID, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondConsultationError(c, err, "fetch consultation request")
+		return
+	}
+
+	RespondSuccess(c, request)
+}
+
+// QuoteConsultationRequest godoc
+// @Summary Quote a consultation request
+// @Description Answer a pending consultation request with a price and a proposed time, which the customer can accept as a booking. Quoting again replaces the earlier quote until it is accepted. Signed-in customers are notified. Barbers may only quote their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param requestId path int true "Consultation request ID"
+// @Param quote body services.ConsultationQuoteRequest true "Quote"
+// @Success 200 {object} SuccessResponse{data=models.ConsultationRequest}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/consultation-requests/{requestId}/quote [put]
+func (h *ConsultationHandler) QuoteConsultationRequest(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	requestID, ok := RequireIntParam(c, "requestId", "consultation request")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "quote a consultation request")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.ConsultationQuoteRequest](c)
+	if !ok {
+		return
+	}
+
+	request, err := h.consultationService.Quote(c.Request.Context(), barberID, requestID, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondConsultationError(c, err, "quote consultation request")
+		return
+	}
+
+	RespondSuccessWithData(c, request, "Quote sent")
+}
+
+// SubmitConsultationRequest godoc
+// @Summary Ask for a consultation
+// @Description Ask the barber of a service that requires a consultation for one, suggesting up to 5 future times. Photos can be attached afterwards. The barber answers with a quote and a proposed time, or declines.
+// @Tags consultations
+// @Accept json
+// @Produce json
+// @Param request body services.SubmitConsultationRequest true "Consultation request"
+// @Success 201 {object} SuccessResponse{data=models.ConsultationRequest}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/consultation-requests [post]
+func (h *ConsultationHandler) SubmitConsultationRequest(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "ask for a consultation")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.SubmitConsultationRequest](c)
+	if !ok {
+		return
+	}
+
+	request, err := h.consultationService.Submit(c.Request.Context(), userID, *req)
+	if err != nil {
+		respondConsultationError(c, err, "create consultation request")
+		return
+	}
+
+	RespondCreated(c, request, "Consultation requested")
+}
+
+// ListMyConsultationRequests godoc
+// @Summary List my consultation requests
+// @Description The signed-in customer's consultation requests, newest first.
+// @Tags consultations
+// @Produce json
+// @Param status query string false "Filter by status (open, quoted, booked, declined)"
+// @Param limit query int false "Limit results" default(20)
+// @Param offset query int false "Offset for pagination" default(0)
+// @Success 200 {object} SuccessResponse{data=[]models.ConsultationRequest}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/consultation-requests [get]
+func (h *ConsultationHandler) ListMyConsultationRequests(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "list consultation requests")
+	if !ok {
+		return
+	}
+	req, ok := BindQuery[services.ConsultationRequestListRequest](c)
+	if !ok {
+		return
+	}
+
+	requests, err := h.consultationService.ListMine(c.Request.Context(), userID, req)
+	if err != nil {
+		respondConsultationError(c, err, "fetch consultation requests")
+		return
+	}
+
+	RespondSuccessWithMeta(c, requests, PaginationMeta(len(requests), req.Limit, req.Offset))
+}
+
+// GetMyConsultationRequest godoc
+// @Summary Get my consultation request
+// @Description One of the signed-in customer's consultation requests, with its photos and the barber's quote.
+// @Tags consultations
+// @Produce json
+// @Param id path int true "Consultation request ID"
+// @Success 200 {object} SuccessResponse{data=models.ConsultationRequest}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/consultation-requests/{id} [get]
+func (h *ConsultationHandler) GetMyConsultationRequest(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "consultation request")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "view a consultation request")
+	if !ok {
+		return
+	}
+
+	request, err := h.consultationService.Get(c.Request.Context(), userID, id)
+	if err != nil {
+		respondConsultationError(c, err, "fetch consultation request")
+		return
+	}
+
+	RespondSuccess(c, request)
+}
+
+// UploadConsultationPhoto godoc
+// @Summary Attach a photo to my consultation request
+// @Description Upload a JPEG or PNG photo for the barber (up to 5 per request) while the request is open or quoted. A thumbnail is made alongside it.
+// @Tags consultations
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Consultation request ID"
+// @Param image formData file true "JPEG or PNG photo"
+// @Success 201 {object} SuccessResponse{data=models.ConsultationPhoto}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 413 {object} middleware.ErrorResponse
+// @Failure 415 {object} middleware.ErrorResponse
+// @Failure 422 {object} middleware.ErrorResponse "The request has the maximum number of photos"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/consultation-requests/{id}/photos [post]
+func (h *ConsultationHandler) UploadConsultationPhoto(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "consultation request")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "upload a consultation photo")
+	if !ok {
+		return
+	}
+
+	header, err := c.FormFile("image")
+	if err != nil {
+		RespondBadRequest(c, "Missing image", "Upload the photo in the image field")
+		return
+	}
+	if header.Size > config.MaxImageSizeBytes {
+		respondConsultationError(c, services.ErrImageTooLarge, "attach consultation photo")
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		RespondInternalError(c, "read consultation photo", err)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, config.MaxImageSizeBytes+1))
+	if err != nil {
+		RespondInternalError(c, "read consultation photo", err)
+		return
+	}
+
+	photo, err := h.consultationService.AttachPhoto(c.Request.Context(), userID, id, data)
+	if err != nil {
+		respondConsultationError(c, err, "attach consultation photo")
+		return
+	}
+	RespondCreated(c, photo, "Photo attached")
+}
+
+// AcceptConsultationQuote godoc
+// @Summary Accept a consultation quote
+// @Description Book the barber's quote: a booking is made at the quoted price and proposed time. If the time has been taken meanwhile the quote stays open and the barber can send a new one.
+// @Tags consultations
+// @Accept json
+// @Produce json
+// @Param id path int true "Consultation request ID"
+// @Param request body services.AcceptConsultationQuoteRequest false "Deposit card, when the service requires a deposit"
+// @Success 201 {object} SuccessResponse{data=services.BookingResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 402 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse "The quote changed, was already accepted, or its time was taken"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/consultation-requests/{id}/accept [post]
+func (h *ConsultationHandler) AcceptConsultationQuote(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "consultation request")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "accept a consultation quote")
+	if !ok {
+		return
+	}
+
+	var req services.AcceptConsultationQuoteRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			RespondValidationError(c, err)
+			return
+		}
+	}
+
+	booking, err := h.consultationService.Accept(c.Request.Context(), userID, id, req)
+	if err != nil {
+		respondConsultationError(c, err, "accept consultation quote")
+		return
+	}
+
+	RespondCreated(c, booking, "Quote accepted and booking created")
+}
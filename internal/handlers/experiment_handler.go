@@ -0,0 +1,317 @@
+// internal/handlers/experiment_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/experiments"
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// EXPERIMENT HANDLER - A/B variant lookup and experiment administration
+// ========================================================================
+
+// ExperimentHandler handles experiment requests
+type ExperimentHandler struct {
+	experimentService *services.ExperimentService
+}
+
+// NewExperimentHandler creates a new experiment handler
+func NewExperimentHandler(experimentService *services.ExperimentService) *ExperimentHandler {
+	return &ExperimentHandler{
+		experimentService: experimentService,
+	}
+}
+
+// respondExperimentError maps experiment errors to HTTP responses
+func respondExperimentError(c *gin.Context, err error, operation string) {
+	statusCode := 0
+	switch {
+	case errors.Is(err, repository.ErrExperimentNotFound):
+		RespondNotFound(c, "Experiment")
+		return
+	case errors.Is(err, repository.ErrDuplicateExperiment),
+		errors.Is(err, repository.ErrExperimentNotEditable),
+		errors.Is(err, repository.ErrInvalidStatusTransition):
+		statusCode = http.StatusConflict
+	case errors.Is(err, repository.ErrExperimentNotExposed):
+		statusCode = http.StatusUnprocessableEntity
+	case errors.Is(err, experiments.ErrInvalidKey),
+		errors.Is(err, experiments.ErrTooFewVariants),
+		errors.Is(err, experiments.ErrTooManyVariants),
+		errors.Is(err, experiments.ErrInvalidVariant),
+		errors.Is(err, experiments.ErrDuplicateName),
+		errors.Is(err, experiments.ErrInvalidWeight),
+		errors.Is(err, experiments.ErrInvalidEvent),
+		utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		statusCode = http.StatusBadRequest
+	}
+
+	if statusCode == 0 {
+		RespondInternalError(c, operation, err)
+		return
+	}
+	middleware.WriteError(c, statusCode, middleware.ErrorResponse{
+		Error:   "Experiment request failed",
+		Message: err.Error(),
+	})
+}
+
+// ========================================================================
+// VARIANT LOOKUP
+// ========================================================================
+
+// GetVariant godoc
+// @Summary Get my experiment variant
+// @Description The current user's variant of an experiment. While the experiment runs, the first lookup is logged as an exposure and the user keeps that variant; otherwise everyone gets the control (or the winner once stopped).
+// @Tags experiments
+// @Produce json
+// @Param key path string true "Experiment key"
+// @Success 200 {object} SuccessResponse{data=services.VariantAssignment}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/experiments/{key}/variant [get]
+func (h *ExperimentHandler) GetVariant(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "view experiments")
+	if !ok {
+		return
+	}
+
+	assignment, err := h.experimentService.Variant(c.Request.Context(), c.Param("key"), userID)
+	if err != nil {
+		respondExperimentError(c, err, "fetch experiment variant")
+		return
+	}
+
+	RespondSuccess(c, assignment)
+}
+
+// RecordConversion godoc
+// @Summary Record an experiment conversion
+// @Description Record a conversion event (e.g. suggestion_accepted) for the current user, attributed to the variant they were exposed to. Report on it with metric=<event>.
+// @Tags experiments
+// @Accept json
+// @Produce json
+// @Param key path string true "Experiment key"
+// @Param request body services.RecordConversionRequest true "Event"
+// @Success 201 {object} SuccessResponse{data=models.ExperimentConversion}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 422 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/experiments/{key}/conversions [post]
+func (h *ExperimentHandler) RecordConversion(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "record conversions")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.RecordConversionRequest](c)
+	if !ok {
+		return
+	}
+
+	conversion, err := h.experimentService.RecordConversion(c.Request.Context(), c.Param("key"), userID, req)
+	if err != nil {
+		respondExperimentError(c, err, "record experiment conversion")
+		return
+	}
+
+	RespondCreated(c, conversion, "Conversion recorded")
+}
+
+// ========================================================================
+// ADMIN
+// ========================================================================
+
+// CreateExperiment godoc
+// @Summary Create an experiment
+// @Description Define an A/B experiment as a draft. The first variant is the control; weights set each variant's share of users.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body services.CreateExperimentRequest true "Experiment"
+// @Success 201 {object} SuccessResponse{data=models.Experiment}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/experiments [post]
+func (h *ExperimentHandler) CreateExperiment(c *gin.Context) {
+	req, ok := BindJSON[services.CreateExperimentRequest](c)
+	if !ok {
+		return
+	}
+
+	var createdBy *int
+	if userID, exists := middleware.GetUserID(c); exists {
+		createdBy = &userID
+	}
+
+	experiment, err := h.experimentService.Create(c.Request.Context(), req, createdBy)
+	if err != nil {
+		respondExperimentError(c, err, "create experiment")
+		return
+	}
+
+	RespondCreated(c, experiment, "Experiment created")
+}
+
+// ListExperiments godoc
+// @Summary List experiments
+// @Description Experiments, newest first
+// @Tags admin
+// @Produce json
+// @Param status query string false "Filter by status" Enums(draft, running, stopped)
+// @Param limit query int false "Limit results" default(20)
+// @Param offset query int false "Offset for pagination" default(0)
+// @Success 200 {object} SuccessResponse{data=[]models.Experiment}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/experiments [get]
+func (h *ExperimentHandler) ListExperiments(c *gin.Context) {
+	req, ok := BindQuery[services.ExperimentListRequest](c)
+	if !ok {
+		return
+	}
+
+	list, err := h.experimentService.List(c.Request.Context(), req)
+	if err != nil {
+		RespondInternalError(c, "fetch experiments", err)
+		return
+	}
+
+	RespondSuccessWithMeta(c, list, PaginationMeta(len(list), req.Limit, req.Offset))
+}
+
+// GetExperiment godoc
+// @Summary Get an experiment
+// @Tags admin
+// @Produce json
+// @Param key path string true "Experiment key"
+// @Success 200 {object} SuccessResponse{data=models.Experiment}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/experiments/{key} [get]
+func (h *ExperimentHandler) GetExperiment(c *gin.Context) {
+	experiment, err := h.experimentService.Get(c.Request.Context(), c.Param("key"))
+	if err != nil {
+		respondExperimentError(c, err, "fetch experiment")
+		return
+	}
+
+	RespondSuccess(c, experiment)
+}
+
+// UpdateExperiment godoc
+// @Summary Update an experiment
+// @Description Edit a draft experiment's name, description or variants. Experiments that have started cannot be edited.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param key path string true "Experiment key"
+// @Param request body services.UpdateExperimentRequest true "Changes"
+// @Success 200 {object} SuccessResponse{data=models.Experiment}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/experiments/{key} [put]
+func (h *ExperimentHandler) UpdateExperiment(c *gin.Context) {
+	req, ok := BindJSON[services.UpdateExperimentRequest](c)
+	if !ok {
+		return
+	}
+
+	experiment, err := h.experimentService.Update(c.Request.Context(), c.Param("key"), req)
+	if err != nil {
+		respondExperimentError(c, err, "update experiment")
+		return
+	}
+
+	RespondSuccessWithData(c, experiment, "Experiment updated")
+}
+
+// UpdateExperimentStatus godoc
+// @Summary Start or stop an experiment
+// @Description running starts (or resumes) assignment; stopped ends it and serves everyone the winner, or the control when none is given
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param key path string true "Experiment key"
+// @Param request body services.UpdateExperimentStatusRequest true "New status"
+// @Success 200 {object} SuccessResponse{data=models.Experiment}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/experiments/{key}/status [patch]
+func (h *ExperimentHandler) UpdateExperimentStatus(c *gin.Context) {
+	req, ok := BindJSON[services.UpdateExperimentStatusRequest](c)
+	if !ok {
+		return
+	}
+
+	experiment, err := h.experimentService.UpdateStatus(c.Request.Context(), c.Param("key"), req)
+	if err != nil {
+		respondExperimentError(c, err, "update experiment status")
+		return
+	}
+
+	RespondSuccessWithData(c, experiment, "Experiment "+experiment.Status)
+}
+
+// GetExperimentReport godoc
+// @Summary Experiment conversion report
+// @Description Exposures, conversions, conversion rate and lift over the control for each variant. metric=booking counts exposed users who booked afterwards (value is their booking revenue), completed_booking those whose booking was completed, and any other metric counts users with that recorded event.
+// @Tags admin
+// @Produce json
+// @Param key path string true "Experiment key"
+// @Param metric query string false "Conversion metric" default(booking)
+// @Param window_days query int false "Only count conversions this many days after exposure"
+// @Success 200 {object} SuccessResponse{data=models.ExperimentReport}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/experiments/{key}/report [get]
+func (h *ExperimentHandler) GetExperimentReport(c *gin.Context) {
+	req, ok := BindQuery[services.ExperimentReportRequest](c)
+	if !ok {
+		return
+	}
+
+	report, err := h.experimentService.Report(c.Request.Context(), c.Param("key"), req)
+	if err != nil {
+		respondExperimentError(c, err, "build experiment report")
+		return
+	}
+
+	RespondSuccess(c, report)
+}
@@ -0,0 +1,330 @@
+// internal/handlers/featured_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/payments"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// FEATURED HANDLER - Paid placement in barber search results
+// ========================================================================
+
+// FeaturedHandler handles featured placement requests
+type FeaturedHandler struct {
+	featuredService *services.FeaturedService
+}
+
+// NewFeaturedHandler creates a new featured placement handler
+func NewFeaturedHandler(featuredService *services.FeaturedService) *FeaturedHandler {
+	return &FeaturedHandler{
+		featuredService: featuredService,
+	}
+}
+
+// respondFeaturedError maps featured placement errors to HTTP responses
+func respondFeaturedError(c *gin.Context, err error, operation string) {
+	statusCode := 0
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		statusCode = http.StatusForbidden
+	case errors.Is(err, payments.ErrNotConfigured):
+		statusCode = http.StatusServiceUnavailable
+	case errors.Is(err, payments.ErrPaymentDeclined):
+		statusCode = http.StatusPaymentRequired
+	case errors.Is(err, repository.ErrFeaturedSoldOut),
+		errors.Is(err, repository.ErrFeaturedOverlap):
+		statusCode = http.StatusConflict
+	case errors.Is(err, repository.ErrFeaturedAlreadyStarted):
+		statusCode = http.StatusUnprocessableEntity
+	case errors.Is(err, repository.ErrFeaturedPlacementNotFound):
+		RespondNotFound(c, "Featured placement")
+		return
+	case errors.Is(err, repository.ErrCategoryNotFound):
+		RespondNotFound(c, "Category")
+		return
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot", "only"}):
+		statusCode = http.StatusBadRequest
+	}
+
+	if statusCode == 0 {
+		HandleServiceError(c, err, "Barber", operation)
+		return
+	}
+	middleware.WriteError(c, statusCode, middleware.ErrorResponse{
+		Error:   "Featured placement failed",
+		Message: err.Error(),
+	})
+}
+
+// ========================================================================
+// PUBLIC
+// ========================================================================
+
+// GetAvailability godoc
+// @Summary Featured slot availability
+// @Description Per-day featured slots left in a city (optionally one category) and the price for the range
+// @Tags featured
+// @Produce json
+// @Param city query string true "City"
+// @Param category_id query int false "Service category (omit for city-wide placement)"
+// @Param starts_on query string true "First day (YYYY-MM-DD)"
+// @Param ends_on query string true "Last day, inclusive (YYYY-MM-DD)"
+// @Success 200 {object} SuccessResponse{data=services.FeaturedAvailability}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/featured/availability [get]
+func (h *FeaturedHandler) GetAvailability(c *gin.Context) {
+	req, ok := BindQuery[services.FeaturedAvailabilityRequest](c)
+	if !ok {
+		return
+	}
+
+	availability, err := h.featuredService.GetAvailability(c.Request.Context(), req)
+	if err != nil {
+		respondFeaturedError(c, err, "check featured availability")
+		return
+	}
+
+	RespondSuccess(c, availability)
+}
+
+// RecordClick godoc
+// @Summary Record a click on a featured result
+// @Description Counts a click on a featured barber in search results (featured_placement_id from the listing)
+// @Tags featured
+// @Produce json
+// @Param id path int true "Featured placement ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/featured/{id}/click [post]
+func (h *FeaturedHandler) RecordClick(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "featured placement")
+	if !ok {
+		return
+	}
+
+	if err := h.featuredService.RecordClick(c.Request.Context(), id); err != nil {
+		respondFeaturedError(c, err, "record featured click")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Click recorded")
+}
+
+// ========================================================================
+// BARBER
+// ========================================================================
+
+// PurchasePlacement godoc
+// @Summary Buy featured placement
+// @Description Buy placement at the top of search results in the barber's city, city-wide or within one service category, for a range of days. The card is charged immediately.
+// @Tags featured
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param request body services.PurchaseFeaturedRequest true "Placement"
+// @Success 201 {object} SuccessResponse{data=models.FeaturedPlacement}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 402 {object} middleware.ErrorResponse "Payment declined"
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse "Sold out or already featured"
+// @Failure 503 {object} middleware.ErrorResponse "Payments not configured"
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/featured [post]
+func (h *FeaturedHandler) PurchasePlacement(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "buy featured placement")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.PurchaseFeaturedRequest](c)
+	if !ok {
+		return
+	}
+
+	placement, err := h.featuredService.Purchase(c.Request.Context(), barberID, req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondFeaturedError(c, err, "buy featured placement")
+		return
+	}
+
+	RespondCreated(c, placement, "Featured placement purchased")
+}
+
+// ListBarberPlacements godoc
+// @Summary List a barber's featured placements
+// @Description Placements with impressions, clicks and click-through rate
+// @Tags featured
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param status query string false "Filter by status (pending_payment, active, cancelled)"
+// @Param limit query int false "Limit results" default(20)
+// @Param offset query int false "Offset for pagination" default(0)
+// @Success 200 {object} SuccessResponse{data=[]models.FeaturedPlacement}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/featured [get]
+func (h *FeaturedHandler) ListBarberPlacements(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "view featured placements")
+	if !ok {
+		return
+	}
+	req, ok := BindQuery[services.FeaturedPlacementListRequest](c)
+	if !ok {
+		return
+	}
+
+	placements, err := h.featuredService.ListForBarber(c.Request.Context(), barberID, userID, middleware.IsAdmin(c), req)
+	if err != nil {
+		respondFeaturedError(c, err, "fetch featured placements")
+		return
+	}
+
+	RespondSuccessWithMeta(c, placements, PaginationMeta(len(placements), req.Limit, req.Offset))
+}
+
+// CancelPlacement godoc
+// @Summary Cancel a featured placement
+// @Description Barbers can cancel before the placement starts for a full refund. Admins can cancel at any time; the days not yet run are refunded.
+// @Tags featured
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param placementId path int true "Featured placement ID"
+// @Success 200 {object} SuccessResponse{data=models.FeaturedPlacement}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 422 {object} middleware.ErrorResponse "Already started"
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/featured/{placementId} [delete]
+func (h *FeaturedHandler) CancelPlacement(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	placementID, ok := RequireIntParam(c, "placementId", "featured placement")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "cancel featured placement")
+	if !ok {
+		return
+	}
+
+	placement, err := h.featuredService.Cancel(c.Request.Context(), barberID, placementID, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondFeaturedError(c, err, "cancel featured placement")
+		return
+	}
+
+	RespondSuccessWithData(c, placement, "Featured placement cancelled")
+}
+
+// ========================================================================
+// ADMIN
+// ========================================================================
+
+// ListPlacements godoc
+// @Summary List featured placements
+// @Description All placements, latest start first, with impressions, clicks and click-through rate
+// @Tags admin
+// @Produce json
+// @Param city query string false "Filter by city"
+// @Param status query string false "Filter by status (pending_payment, active, cancelled)"
+// @Param limit query int false "Limit results" default(20)
+// @Param offset query int false "Offset for pagination" default(0)
+// @Success 200 {object} SuccessResponse{data=[]models.FeaturedPlacement}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/featured/placements [get]
+func (h *FeaturedHandler) ListPlacements(c *gin.Context) {
+	req, ok := BindQuery[services.FeaturedPlacementListRequest](c)
+	if !ok {
+		return
+	}
+
+	placements, err := h.featuredService.ListAll(c.Request.Context(), req)
+	if err != nil {
+		RespondInternalError(c, "fetch featured placements", err)
+		return
+	}
+
+	RespondSuccessWithMeta(c, placements, PaginationMeta(len(placements), req.Limit, req.Offset))
+}
+
+// ListSlotLimits godoc
+// @Summary List featured slot limits
+// @Description Markets (city, optional category) with an explicit limit on concurrent placements; others use the configured default
+// @Tags admin
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=[]models.FeaturedSlotLimit}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/featured/limits [get]
+func (h *FeaturedHandler) ListSlotLimits(c *gin.Context) {
+	limits, err := h.featuredService.ListSlotLimits(c.Request.Context())
+	if err != nil {
+		RespondInternalError(c, "fetch featured slot limits", err)
+		return
+	}
+
+	RespondSuccess(c, limits)
+}
+
+// SetSlotLimit godoc
+// @Summary Set a featured slot limit
+// @Description Set how many barbers can be featured on the same day in a city (optionally one category). Placements already sold are kept.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body services.SetFeaturedSlotLimitRequest true "Limit"
+// @Success 200 {object} SuccessResponse{data=models.FeaturedSlotLimit}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/featured/limits [put]
+func (h *FeaturedHandler) SetSlotLimit(c *gin.Context) {
+	req, ok := BindJSON[services.SetFeaturedSlotLimitRequest](c)
+	if !ok {
+		return
+	}
+
+	limit, err := h.featuredService.SetSlotLimit(c.Request.Context(), req)
+	if err != nil {
+		respondFeaturedError(c, err, "set featured slot limit")
+		return
+	}
+
+	RespondSuccessWithData(c, limit, "Featured slot limit saved")
+}
@@ -26,6 +26,22 @@ func ParseIntQuery(c *gin.Context, key string, defaultValue int) int {
 	return defaultValue
 }
 
+// ParseLimitQuery parses the limit query parameter for lists not bound
+// with BindFilters: missing, invalid or non-positive limits become
+// defaultValue (the request's default page size when 0), and limits are
+// capped at the request's largest page
+func ParseLimitQuery(c *gin.Context, defaultValue int) int {
+	pageLimit := middleware.GetPageLimit(c)
+	if defaultValue <= 0 {
+		defaultValue = pageLimit.Default
+	}
+	limit := ParseIntQuery(c, "limit", defaultValue)
+	if limit <= 0 {
+		limit = defaultValue
+	}
+	return min(limit, pageLimit.Max)
+}
+
 // ParseFloatQuery parses a float from query string with default value
 func ParseFloatQuery(c *gin.Context, key string, defaultValue float64) float64 {
 	if value := c.Query(key); value != "" {
@@ -78,7 +94,7 @@ func ParseIntParam(c *gin.Context, paramName string) (int, error) {
 func RequireIntParam(c *gin.Context, paramName string, entityName string) (int, bool) {
 	id, err := strconv.Atoi(c.Param(paramName))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
+		middleware.WriteError(c, http.StatusBadRequest, middleware.ErrorResponse{
 			Error:   fmt.Sprintf("Invalid %s ID", entityName),
 			Message: fmt.Sprintf("%s ID must be a number", entityName),
 		})
@@ -115,7 +131,7 @@ func GetAuthUserID(c *gin.Context, action string) (int, bool) {
 
 // RespondNotFound sends a standardized 404 response
 func RespondNotFound(c *gin.Context, entityName string) {
-	c.JSON(http.StatusNotFound, middleware.ErrorResponse{
+	middleware.WriteError(c, http.StatusNotFound, middleware.ErrorResponse{
 		Error:   fmt.Sprintf("%s not found", entityName),
 		Message: fmt.Sprintf("No %s found with the given ID", strings.ToLower(entityName)),
 	})
@@ -150,7 +166,9 @@ func HandleServiceError(c *gin.Context, err error, entityName, operation string)
 		repository.ErrBookingNotFound,
 		repository.ErrTimeSlotNotFound,
 		repository.ErrReviewNotFound,
-		repository.ErrNotificationNotFound:
+		repository.ErrReviewImageNotFound,
+		repository.ErrNotificationNotFound,
+		repository.ErrDeviceTokenNotFound:
 		RespondNotFound(c, entityName)
 		return true
 	}
@@ -184,8 +202,14 @@ func HandleServiceError(c *gin.Context, err error, entityName, operation string)
 
 	// Check for forbidden errors (403 Forbidden)
 	switch err {
+	case repository.ErrNotOwner:
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: fmt.Sprintf("You do not have access to this %s", strings.ToLower(entityName)),
+		})
+		return true
 	case repository.ErrCannotModifyReview:
-		c.JSON(http.StatusForbidden, middleware.ErrorResponse{
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
 			Error:   "Cannot modify review",
 			Message: err.Error(),
 		})
@@ -195,7 +219,7 @@ func HandleServiceError(c *gin.Context, err error, entityName, operation string)
 	// Check for unprocessable errors (422 Unprocessable Entity)
 	switch err {
 	case repository.ErrCancellationNotAllowed:
-		c.JSON(http.StatusUnprocessableEntity, middleware.ErrorResponse{
+		middleware.WriteError(c, http.StatusUnprocessableEntity, middleware.ErrorResponse{
 			Error:   "Cannot cancel",
 			Message: err.Error(),
 		})
@@ -225,7 +249,7 @@ func HandleServiceError(c *gin.Context, err error, entityName, operation string)
 
 // RespondInternalError sends a standardized 500 response
 func RespondInternalError(c *gin.Context, operation string, err error) {
-	c.JSON(http.StatusInternalServerError, middleware.ErrorResponse{
+	middleware.WriteError(c, http.StatusInternalServerError, middleware.ErrorResponse{
 		Error:   fmt.Sprintf("Failed to %s", operation),
 		Message: err.Error(),
 	})
@@ -233,7 +257,7 @@ func RespondInternalError(c *gin.Context, operation string, err error) {
 
 // RespondBadRequest sends a standardized 400 response
 func RespondBadRequest(c *gin.Context, errorMsg string, message string) {
-	c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
+	middleware.WriteError(c, http.StatusBadRequest, middleware.ErrorResponse{
 		Error:   errorMsg,
 		Message: message,
 	})
@@ -241,7 +265,7 @@ func RespondBadRequest(c *gin.Context, errorMsg string, message string) {
 
 // RespondUnauthorized sends a standardized 401 response
 func RespondUnauthorized(c *gin.Context, message string) {
-	c.JSON(http.StatusUnauthorized, middleware.ErrorResponse{
+	middleware.WriteError(c, http.StatusUnauthorized, middleware.ErrorResponse{
 		Error:   "Unauthorized",
 		Message: message,
 	})
@@ -288,6 +312,31 @@ func BindQuery[T any](c *gin.Context) (*T, bool) {
 	return &req, true
 }
 
+// BindFilters binds a list endpoint's query like BindQuery, then checks
+// its sorting, paging and date ranges with repository.ValidateListFilters,
+// capping limit at the request's middleware.PageLimit.
+//
+// Usage:
+//
+//	filters, ok := BindFilters[repository.BookingFilters](c)
+//	if !ok {
+//	    return
+//	}
+func BindFilters[T any, P interface {
+	*T
+	repository.ListFilters
+}](c *gin.Context) (*T, bool) {
+	filters, ok := BindQuery[T](c)
+	if !ok {
+		return nil, false
+	}
+	if err := repository.ValidateListFilters(P(filters), middleware.GetPageLimit(c).Max); err != nil {
+		RespondBadRequest(c, "Invalid filters", err.Error())
+		return nil, false
+	}
+	return filters, true
+}
+
 // BindURI is a generic helper that binds and validates URI parameters.
 // Returns the parsed struct and true on success, or sends error response and returns nil, false.
 //
@@ -309,7 +358,7 @@ func BindURI[T any](c *gin.Context) (*T, bool) {
 // RespondValidationError sends a 400 response for validation errors
 // This should already exist in your code, but adding here for completeness
 func RespondValidationError(c *gin.Context, err error) {
-	c.JSON(http.StatusBadRequest, middleware.ErrorResponse{
+	middleware.WriteError(c, http.StatusBadRequest, middleware.ErrorResponse{
 		Error:   "Invalid request body",
 		Message: err.Error(),
 	})
@@ -323,24 +372,42 @@ func RespondValidationError(c *gin.Context, err error) {
 func RespondSuccess(c *gin.Context, data interface{}) {
 	c.JSON(http.StatusOK, SuccessResponse{
 		Success: true,
-		Data:    data,
+		Data:    selectFields(c, data),
 	})
 }
 
 // RespondSuccessWithMeta sends a success response with data and metadata
 func RespondSuccessWithMeta(c *gin.Context, data interface{}, meta map[string]interface{}) {
+	// Paged lists also tell clients the largest page they may ask for
+	if _, paged := meta["limit"]; paged {
+		meta["max_limit"] = middleware.GetPageLimit(c).Max
+	}
 	c.JSON(http.StatusOK, SuccessResponse{
 		Success: true,
-		Data:    data,
+		Data:    selectFields(c, data),
 		Meta:    meta,
 	})
 }
 
+// selectFields trims data to the fields the request picked with ?fields=
+// on routes using middleware.SparseFields; data is sent whole otherwise
+func selectFields(c *gin.Context, data interface{}) interface{} {
+	fields := middleware.GetFields(c)
+	if fields == nil {
+		return data
+	}
+	selected, err := middleware.SelectFields(data, fields)
+	if err != nil {
+		return data
+	}
+	return selected
+}
+
 // RespondSuccessWithMessage sends a success response with a message
 func RespondSuccessWithMessage(c *gin.Context, message string) {
 	c.JSON(http.StatusOK, SuccessResponse{
 		Success: true,
-		Message: message,
+		Message: middleware.Localize(c, message),
 	})
 }
 
@@ -349,7 +416,7 @@ func RespondSuccessWithData(c *gin.Context, data interface{}, message string) {
 	c.JSON(http.StatusOK, SuccessResponse{
 		Success: true,
 		Data:    data,
-		Message: message,
+		Message: middleware.Localize(c, message),
 	})
 }
 
@@ -358,7 +425,17 @@ func RespondCreated(c *gin.Context, data interface{}, message string) {
 	c.JSON(http.StatusCreated, SuccessResponse{
 		Success: true,
 		Data:    data,
-		Message: message,
+		Message: middleware.Localize(c, message),
+	})
+}
+
+// RespondAccepted sends a 202 response for requests taken on but not yet
+// carried out
+func RespondAccepted(c *gin.Context, data interface{}, message string) {
+	c.JSON(http.StatusAccepted, SuccessResponse{
+		Success: true,
+		Data:    data,
+		Message: middleware.Localize(c, message),
 	})
 }
 
@@ -376,4 +453,16 @@ func PaginationMeta(count, limit, offset int) map[string]interface{} {
 	}
 }
 
+// PageMeta creates pagination metadata for list endpoints that know the
+// total number of matching rows, so clients can render paging controls
+func PageMeta(count, total, limit, offset int) map[string]interface{} {
+	return map[string]interface{}{
+		"count":    count,
+		"total":    total,
+		"limit":    limit,
+		"offset":   offset,
+		"has_more": offset+count < total,
+	}
+}
+
 // Note: ContainsAny moved to internal/utils/strings.go as utils.ContainsAny
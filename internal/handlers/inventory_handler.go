@@ -0,0 +1,256 @@
+// internal/handlers/inventory_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// INVENTORY HANDLER - Barber product stock and usage
+// ========================================================================
+
+// InventoryHandler handles barber inventory requests
+type InventoryHandler struct {
+	inventoryService *services.InventoryService
+}
+
+// NewInventoryHandler creates a new inventory handler
+func NewInventoryHandler(inventoryService *services.InventoryService) *InventoryHandler {
+	return &InventoryHandler{
+		inventoryService: inventoryService,
+	}
+}
+
+// respondInventoryError maps inventory errors to HTTP responses
+func respondInventoryError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage your own inventory",
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case errors.Is(err, repository.ErrInventoryItemNotFound):
+		RespondNotFound(c, "Inventory item")
+	case errors.Is(err, repository.ErrDuplicateInventoryItem):
+		RespondBadRequest(c, "Duplicate entry", err.Error())
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		RespondBadRequest(c, "Invalid inventory request", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ========================================================================
+// ITEMS
+// ========================================================================
+
+// ListInventory godoc
+// @Summary List a barber's inventory
+// @Description Products the barber tracks, with stock on hand. Barbers may only view their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param low_stock query bool false "Only items at or below their low-stock threshold"
+// @Success 200 {object} SuccessResponse{data=[]models.InventoryItem}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/inventory [get]
+func (h *InventoryHandler) ListInventory(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "view inventory")
+	if !ok {
+		return
+	}
+	lowStock := ParseBoolQuery(c, "low_stock")
+
+	items, err := h.inventoryService.ListItems(c.Request.Context(), id, lowStock != nil && *lowStock, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondInventoryError(c, err, "fetch inventory")
+		return
+	}
+
+	RespondSuccessWithMeta(c, items, map[string]interface{}{
+		"barber_id": id,
+		"count":     len(items),
+	})
+}
+
+// CreateInventoryItem godoc
+// @Summary Track a product
+// @Description Start tracking a product's stock. Completed bookings whose service lists the product (by name) in required_products take usage_per_service out of stock. Barbers may only manage their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param item body services.InventoryItemRequest true "Inventory item"
+// @Success 201 {object} SuccessResponse{data=models.InventoryItem}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/inventory [post]
+func (h *InventoryHandler) CreateInventoryItem(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "add an inventory item")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.InventoryItemRequest](c)
+	if !ok {
+		return
+	}
+
+	item, err := h.inventoryService.CreateItem(c.Request.Context(), id, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondInventoryError(c, err, "add inventory item")
+		return
+	}
+
+	RespondCreated(c, item, "Inventory item added")
+}
+
+// UpdateInventoryItem godoc
+// @Summary Update a product
+// @Description Replace a tracked product, including its stock on hand (e.g. after a delivery). Barbers may only manage their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param itemId path int true "Inventory item ID"
+// @Param item body services.InventoryItemRequest true "Inventory item"
+// @Success 200 {object} SuccessResponse{data=models.InventoryItem}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/inventory/{itemId} [put]
+func (h *InventoryHandler) UpdateInventoryItem(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	itemID, ok := RequireIntParam(c, "itemId", "inventory item")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "update an inventory item")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.InventoryItemRequest](c)
+	if !ok {
+		return
+	}
+
+	item, err := h.inventoryService.UpdateItem(c.Request.Context(), id, itemID, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondInventoryError(c, err, "update inventory item")
+		return
+	}
+
+	RespondSuccessWithData(c, item, "Inventory item updated")
+}
+
+// DeleteInventoryItem godoc
+// @Summary Stop tracking a product
+// @Description Remove a tracked product and its usage history. Barbers may only manage their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param itemId path int true "Inventory item ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/inventory/{itemId} [delete]
+func (h *InventoryHandler) DeleteInventoryItem(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	itemID, ok := RequireIntParam(c, "itemId", "inventory item")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "remove an inventory item")
+	if !ok {
+		return
+	}
+
+	if err := h.inventoryService.DeleteItem(c.Request.Context(), id, itemID, userID, middleware.IsAdmin(c)); err != nil {
+		respondInventoryError(c, err, "remove inventory item")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Inventory item removed")
+}
+
+// ========================================================================
+// USAGE
+// ========================================================================
+
+// GetInventoryUsage godoc
+// @Summary Get a barber's product usage
+// @Description How much of each tracked product completed bookings used over a date range (UTC days, inclusive; default the last 30 days). Barbers may only view their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Success 200 {object} SuccessResponse{data=models.InventoryUsageReport}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/inventory/usage [get]
+func (h *InventoryHandler) GetInventoryUsage(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "view inventory usage")
+	if !ok {
+		return
+	}
+	query, ok := BindQuery[services.InventoryUsageQuery](c)
+	if !ok {
+		return
+	}
+
+	report, err := h.inventoryService.GetUsageReport(c.Request.Context(), id, *query, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondInventoryError(c, err, "fetch inventory usage")
+		return
+	}
+
+	RespondSuccess(c, report)
+}
@@ -0,0 +1,280 @@
+// internal/handlers/location_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// LOCATION HANDLER - Barber locations and travel times
+// ========================================================================
+
+// LocationHandler handles barber location requests
+type LocationHandler struct {
+	locationService *services.LocationService
+}
+
+// NewLocationHandler creates a new location handler
+func NewLocationHandler(locationService *services.LocationService) *LocationHandler {
+	return &LocationHandler{
+		locationService: locationService,
+	}
+}
+
+// respondLocationError maps location errors to HTTP responses
+func respondLocationError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage your own locations",
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case errors.Is(err, repository.ErrLocationNotFound):
+		RespondNotFound(c, "Location")
+	case errors.Is(err, repository.ErrDuplicateLocation):
+		RespondBadRequest(c, "Duplicate entry", err.Error())
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		RespondBadRequest(c, "Invalid location", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ========================================================================
+// LOCATIONS
+// ========================================================================
+
+// ListLocations godoc
+// @Summary List a barber's locations
+// @Description The locations a barber takes bookings at. Pass one as location_id when booking or listing availability.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Success 200 {object} SuccessResponse{data=[]models.BarberLocation}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/barbers/{id}/locations [get]
+func (h *LocationHandler) ListLocations(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	locations, err := h.locationService.ListLocations(c.Request.Context(), id)
+	if err != nil {
+		respondLocationError(c, err, "fetch locations")
+		return
+	}
+
+	RespondSuccessWithMeta(c, locations, map[string]interface{}{
+		"barber_id": id,
+		"count":     len(locations),
+	})
+}
+
+// CreateLocation godoc
+// @Summary Add a location
+// @Description Add a location the barber takes bookings at. Barbers may only manage their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param location body services.LocationRequest true "Location"
+// @Success 201 {object} SuccessResponse{data=models.BarberLocation}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/locations [post]
+func (h *LocationHandler) CreateLocation(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "add a location")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.LocationRequest](c)
+	if !ok {
+		return
+	}
+
+	location, err := h.locationService.CreateLocation(c.Request.Context(), id, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondLocationError(c, err, "add location")
+		return
+	}
+
+	RespondCreated(c, location, "Location added")
+}
+
+// UpdateLocation godoc
+// @Summary Update a location
+// @Description Replace a location's name and address. Barbers may only manage their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param locationId path int true "Location ID"
+// @Param location body services.LocationRequest true "Location"
+// @Success 200 {object} SuccessResponse{data=models.BarberLocation}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/locations/{locationId} [put]
+func (h *LocationHandler) UpdateLocation(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	locationID, ok := RequireIntParam(c, "locationId", "location")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "update a location")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.LocationRequest](c)
+	if !ok {
+		return
+	}
+
+	location, err := h.locationService.UpdateLocation(c.Request.Context(), id, locationID, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondLocationError(c, err, "update location")
+		return
+	}
+
+	RespondSuccessWithData(c, location, "Location updated")
+}
+
+// DeleteLocation godoc
+// @Summary Remove a location
+// @Description Remove a location and its travel times. Bookings at the location are kept without one. Barbers may only manage their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param locationId path int true "Location ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/locations/{locationId} [delete]
+func (h *LocationHandler) DeleteLocation(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	locationID, ok := RequireIntParam(c, "locationId", "location")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "remove a location")
+	if !ok {
+		return
+	}
+
+	if err := h.locationService.DeleteLocation(c.Request.Context(), id, locationID, userID, middleware.IsAdmin(c)); err != nil {
+		respondLocationError(c, err, "remove location")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Location removed")
+}
+
+// ========================================================================
+// TRAVEL TIMES
+// ========================================================================
+
+// GetTravelTimes godoc
+// @Summary Get a barber's travel times
+// @Description Minutes the barber needs between each pair of locations. Barbers may only view their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Success 200 {object} SuccessResponse{data=[]models.TravelTime}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/travel-times [get]
+func (h *LocationHandler) GetTravelTimes(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "view travel times")
+	if !ok {
+		return
+	}
+
+	times, err := h.locationService.GetTravelTimes(c.Request.Context(), id, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondLocationError(c, err, "fetch travel times")
+		return
+	}
+
+	RespondSuccess(c, times)
+}
+
+// SetTravelTimes godoc
+// @Summary Set a barber's travel times
+// @Description Replace the travel-time matrix. Bookings at different locations are kept apart by the travel time between them; a time given for one direction also applies to the other unless both are given. Existing bookings are not re-checked. Barbers may only manage their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param travel_times body services.TravelTimesRequest true "Travel times"
+// @Success 200 {object} SuccessResponse{data=[]models.TravelTime}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/travel-times [put]
+func (h *LocationHandler) SetTravelTimes(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "set travel times")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.TravelTimesRequest](c)
+	if !ok {
+		return
+	}
+
+	times, err := h.locationService.SetTravelTimes(c.Request.Context(), id, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondLocationError(c, err, "set travel times")
+		return
+	}
+
+	RespondSuccessWithData(c, times, "Travel times saved")
+}
@@ -2,11 +2,16 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
+	"time"
 
 	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/middleware"
 	"barber-booking-system/internal/repository"
 	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/sms"
 	"barber-booking-system/internal/utils"
 
 	"github.com/gin-gonic/gin"
@@ -49,11 +54,12 @@ func NewNotificationHandler(notificationService *services.NotificationService) *
 // @Param priority query string false "Filter by priority"
 // @Param is_read query bool false "Filter by read status"
 // @Param is_unread query bool false "Filter by unread status"
-// @Param sort_by query string false "Sort by field" default(created_at)
+// @Param sort_by query string false "Sort by field (priority, scheduled_for, created_at)" default(created_at)
 // @Param order query string false "Sort order (ASC/DESC)" default(DESC)
 // @Param limit query int false "Limit results" default(50)
 // @Param offset query int false "Offset for pagination" default(0)
-// @Success 200 {object} SuccessResponse
+// @Param include_barber query bool false "Attach the barber each booking notification is about"
+// @Success 200 {object} PaginatedResponse{data=[]services.NotificationResponse}
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Security BearerAuth
@@ -64,18 +70,18 @@ func (h *NotificationHandler) GetMyNotifications(c *gin.Context) {
 		return
 	}
 
-	filters, ok := BindQuery[repository.NotificationFilters](c)
+	filters, ok := BindFilters[repository.NotificationFilters](c)
 	if !ok {
 		return
 	}
 
-	notifications, err := h.notificationService.GetUserNotifications(c.Request.Context(), userID, *filters)
+	notifications, total, err := h.notificationService.GetUserNotifications(c.Request.Context(), userID, *filters)
 	if err != nil {
 		RespondInternalError(c, "fetch notifications", err)
 		return
 	}
 
-	RespondSuccessWithMeta(c, notifications, PaginationMeta(len(notifications), filters.Limit, filters.Offset))
+	RespondSuccessWithMeta(c, notifications, PageMeta(len(notifications), total, filters.Limit, filters.Offset))
 }
 
 // GetUnreadNotifications godoc
@@ -96,7 +102,7 @@ func (h *NotificationHandler) GetUnreadNotifications(c *gin.Context) {
 		return
 	}
 
-	limit := ParseIntQuery(c, "limit", 20)
+	limit := ParseLimitQuery(c, 20)
 
 	notifications, err := h.notificationService.GetUnreadNotifications(c.Request.Context(), userID, limit)
 	if err != nil {
@@ -204,6 +210,44 @@ func (h *NotificationHandler) GetUnreadCount(c *gin.Context) {
 	})
 }
 
+// PollUnreadCount godoc
+// @Summary Long-poll unread notification count
+// @Description Lightweight badge endpoint. When since is the client's last known count and wait > 0, the request blocks up to wait seconds (max 30) until the count changes. Intended for clients that cannot hold a WebSocket.
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param since query int false "Last unread count seen by the client" default(-1)
+// @Param wait query int false "Seconds to wait for a change (0-30)" default(0)
+// @Success 200 {object} SuccessResponse{data=services.UnreadCountResponse}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/notifications/unread-count [get]
+func (h *NotificationHandler) PollUnreadCount(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "view notification count")
+	if !ok {
+		return
+	}
+
+	since := ParseIntQuery(c, "since", -1)
+	wait := ParseIntQuery(c, "wait", 0)
+	if wait < 0 {
+		wait = 0
+	}
+	if wait > config.MaxUnreadCountWaitSeconds {
+		wait = config.MaxUnreadCountWaitSeconds
+	}
+
+	result, err := h.notificationService.WaitForUnreadCount(c.Request.Context(), userID, since, time.Duration(wait)*time.Second)
+	if err != nil {
+		RespondInternalError(c, "fetch unread count", err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	RespondSuccess(c, result)
+}
+
 // ========================================================================
 // MARK AS READ
 // ========================================================================
@@ -305,13 +349,73 @@ func (h *NotificationHandler) DeleteNotification(c *gin.Context) {
 	RespondSuccessWithMessage(c, "Notification deleted successfully")
 }
 
+// ========================================================================
+// PUSH DEVICES
+// ========================================================================
+
+// RegisterDevice godoc
+// @Summary Register a push device
+// @Description Register the current app install for push notifications. Registering a token that belongs to another account moves it to the caller.
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param request body services.RegisterDeviceRequest true "Device token"
+// @Success 200 {object} SuccessResponse{data=models.DeviceToken}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/notifications/devices [post]
+func (h *NotificationHandler) RegisterDevice(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "register devices")
+	if !ok {
+		return
+	}
+
+	req, ok := BindJSON[services.RegisterDeviceRequest](c)
+	if !ok {
+		return
+	}
+
+	device, err := h.notificationService.RegisterDevice(c.Request.Context(), userID, *req)
+	if HandleServiceError(c, err, "Device", "register device") {
+		return
+	}
+	RespondSuccessWithData(c, device, "Device registered successfully")
+}
+
+// UnregisterDevice godoc
+// @Summary Unregister a push device
+// @Description Stop sending push notifications to one of the caller's devices (e.g. on sign-out)
+// @Tags notifications
+// @Produce json
+// @Param token path string true "Device token"
+// @Success 200 {object} SuccessResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/notifications/devices/{token} [delete]
+func (h *NotificationHandler) UnregisterDevice(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "unregister devices")
+	if !ok {
+		return
+	}
+
+	err := h.notificationService.UnregisterDevice(c.Request.Context(), userID, c.Param("token"))
+	if HandleServiceError(c, err, "Device", "unregister device") {
+		return
+	}
+	RespondSuccessWithMessage(c, "Device unregistered successfully")
+}
+
 // ========================================================================
 // CREATE NOTIFICATION (Admin only)
 // ========================================================================
 
 // CreateNotification godoc
 // @Summary Create a notification
-// @Description Create a new notification for a user (admin only)
+// @Description Create a new notification for a user (admin only). scheduled_local schedules it for a time in the recipient's timezone; non-urgent notifications due during the recipient's quiet hours are held back until they end.
 // @Tags notifications
 // @Accept json
 // @Produce json
@@ -334,9 +438,17 @@ func (h *NotificationHandler) CreateNotification(c *gin.Context) {
 	// if userType != "admin" { ... }
 
 	notification, err := h.notificationService.CreateNotification(c.Request.Context(), *req)
+	if errors.Is(err, services.ErrInvalidSchedule) {
+		RespondBadRequest(c, "Invalid schedule", err.Error())
+		return
+	}
 	if HandleServiceError(c, err, "Notification", "create notification") {
 		return
 	}
+	if notification == nil {
+		RespondSuccessWithMessage(c, "The recipient has turned this notification type off")
+		return
+	}
 
 	RespondCreated(c, notification, "Notification created successfully")
 }
@@ -399,6 +511,59 @@ func (h *NotificationHandler) SendBookingNotification(c *gin.Context) {
 	RespondSuccessWithMessage(c, "Notification sent successfully")
 }
 
+// ========================================================================
+// TEMPLATE VARIABLES (Admin only)
+// ========================================================================
+
+// GetTemplateCatalog godoc
+// @Summary List notification templates and their variables
+// @Description Lists the booking notification templates with the {variable} placeholders each accepts, what each variable holds and an example value. Any other placeholder is rejected when the template is rendered.
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=[]services.NotificationTemplateInfo}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/notifications/templates [get]
+func (h *NotificationHandler) GetTemplateCatalog(c *gin.Context) {
+	RespondSuccess(c, h.notificationService.TemplateCatalog())
+}
+
+// ValidateTemplate godoc
+// @Summary Check template text against a template's variables
+// @Description Checks that a title and message for a booking notification template only use the {variable} placeholders that template accepts, and lists the ones used.
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param request body services.ValidateTemplateRequest true "Template key and text"
+// @Success 200 {object} SuccessResponse{data=services.ValidateTemplateResult}
+// @Failure 400 {object} middleware.ErrorResponse "Unknown template or variable"
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/notifications/templates/validate [post]
+func (h *NotificationHandler) ValidateTemplate(c *gin.Context) {
+	req, ok := BindJSON[services.ValidateTemplateRequest](c)
+	if !ok {
+		return
+	}
+
+	result, err := h.notificationService.ValidateTemplate(*req)
+	switch {
+	case errors.Is(err, services.ErrUnknownTemplate):
+		RespondBadRequest(c, "Unknown template", err.Error())
+		return
+	case errors.Is(err, services.ErrUnknownTemplateVariable):
+		RespondBadRequest(c, "Invalid template", err.Error())
+		return
+	case err != nil:
+		RespondInternalError(c, "validate template", err)
+		return
+	}
+
+	RespondSuccess(c, result)
+}
+
 // ========================================================================
 // WEBHOOK CALLBACK (for push notification services)
 // ========================================================================
@@ -448,3 +613,86 @@ func (h *NotificationHandler) DeliveryWebhook(c *gin.Context) {
 
 	RespondSuccessWithMessage(c, "Webhook processed successfully")
 }
+
+// SMSStatusWebhook godoc
+// @Summary SMS delivery status callback
+// @Description Delivery status callback from the SMS provider (Twilio). The request must carry a valid X-Twilio-Signature. "delivered" marks the notification delivered; "undelivered" and "failed" mark it failed; other statuses are acknowledged without changes.
+// @Tags notifications
+// @Accept x-www-form-urlencoded
+// @Produce json
+// @Param id path int true "Notification ID"
+// @Param MessageStatus formData string true "Message status"
+// @Param ErrorCode formData string false "Provider error code"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 503 {object} middleware.ErrorResponse
+// @Router /api/v1/notifications/{id}/sms-status [post]
+func (h *NotificationHandler) SMSStatusWebhook(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "notification")
+	if !ok {
+		return
+	}
+
+	if err := c.Request.ParseForm(); err != nil {
+		RespondBadRequest(c, "Invalid callback", err.Error())
+		return
+	}
+
+	err := h.notificationService.HandleSMSStatus(c.Request.Context(), id, c.Request.PostForm, c.GetHeader("X-Twilio-Signature"))
+	switch {
+	case err == nil:
+		RespondSuccessWithMessage(c, "Webhook processed successfully")
+	case errors.Is(err, sms.ErrNotConfigured):
+		middleware.WriteError(c, http.StatusServiceUnavailable, middleware.ErrorResponse{
+			Error:   "SMS unavailable",
+			Message: err.Error(),
+		})
+	case errors.Is(err, sms.ErrInvalidSignature):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: err.Error(),
+		})
+	default:
+		HandleServiceError(c, err, "Notification", "sms status webhook")
+	}
+}
+
+// SMSInboundWebhook godoc
+// @Summary Inbound SMS webhook
+// @Description Replies to our texts, forwarded by the SMS provider (Twilio). The request must carry a valid X-Twilio-Signature. STOP (or UNSUBSCRIBE, CANCEL, END, QUIT, STOPALL) adds the sender to the suppression list; START (or YES, UNSTOP) lifts their opt-out. Responds with empty TwiML so no reply is sent.
+// @Tags notifications
+// @Accept x-www-form-urlencoded
+// @Produce xml
+// @Param From formData string true "Sender phone number"
+// @Param Body formData string true "Message text"
+// @Success 200 {string} string "<Response></Response>"
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 503 {object} middleware.ErrorResponse
+// @Router /api/v1/notifications/sms-inbound [post]
+func (h *NotificationHandler) SMSInboundWebhook(c *gin.Context) {
+	if err := c.Request.ParseForm(); err != nil {
+		RespondBadRequest(c, "Invalid callback", err.Error())
+		return
+	}
+
+	err := h.notificationService.HandleSMSInbound(c.Request.Context(), c.Request.PostForm, c.GetHeader("X-Twilio-Signature"))
+	switch {
+	case err == nil:
+		c.Data(http.StatusOK, "application/xml", []byte("<Response></Response>"))
+	case errors.Is(err, sms.ErrNotConfigured):
+		middleware.WriteError(c, http.StatusServiceUnavailable, middleware.ErrorResponse{
+			Error:   "SMS unavailable",
+			Message: err.Error(),
+		})
+	case errors.Is(err, sms.ErrInvalidSignature):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: err.Error(),
+		})
+	default:
+		RespondInternalError(c, "process inbound sms", err)
+	}
+}
@@ -0,0 +1,72 @@
+// internal/handlers/notification_preference_handler.go
+package handlers
+
+import (
+	"errors"
+
+	"barber-booking-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// NOTIFICATION PREFERENCES - Channels per notification type
+// ========================================================================
+
+// GetMyNotificationPreferences godoc
+// @Summary Get my notification preferences
+// @Description Lists each notification type you can configure with the channels it goes out on, its default channels and whether you chose them. Account security messages (verification, password reset) always go out and are not listed.
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=[]services.NotificationPreferenceResponse}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/me/notification-preferences [get]
+func (h *NotificationHandler) GetMyNotificationPreferences(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "view notification preferences")
+	if !ok {
+		return
+	}
+
+	prefs, err := h.notificationService.GetPreferences(c.Request.Context(), userID)
+	if HandleServiceError(c, err, "Notification preferences", "get notification preferences") {
+		return
+	}
+
+	RespondSuccess(c, prefs)
+}
+
+// UpdateMyNotificationPreferences godoc
+// @Summary Update my notification preferences
+// @Description Chooses the channels (app, email, sms, push) each notification type goes out on, e.g. {"preferences": {"booking_reminder": ["push"], "promotion": []}}. An empty list turns a type off, null restores its default channels, and types not listed are unchanged. SMS is only sent to users who opted in to it (notification_settings.sms).
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param request body services.UpdateNotificationPreferencesRequest true "Channels per notification type"
+// @Success 200 {object} SuccessResponse{data=[]services.NotificationPreferenceResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/users/me/notification-preferences [put]
+func (h *NotificationHandler) UpdateMyNotificationPreferences(c *gin.Context) {
+	req, ok := BindJSON[services.UpdateNotificationPreferencesRequest](c)
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "update notification preferences")
+	if !ok {
+		return
+	}
+
+	prefs, err := h.notificationService.UpdatePreferences(c.Request.Context(), userID, *req)
+	if errors.Is(err, services.ErrInvalidNotificationPreference) {
+		RespondBadRequest(c, "Invalid notification preferences", err.Error())
+		return
+	}
+	if HandleServiceError(c, err, "Notification preferences", "update notification preferences") {
+		return
+	}
+
+	RespondSuccessWithData(c, prefs, "Notification preferences updated")
+}
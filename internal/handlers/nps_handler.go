@@ -0,0 +1,157 @@
+// internal/handlers/nps_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// NPS HANDLER - One-tap satisfaction surveys and NPS trends
+// ========================================================================
+
+// NPSHandler handles NPS survey requests
+type NPSHandler struct {
+	npsService *services.NPSService
+}
+
+// NewNPSHandler creates a new NPS handler
+func NewNPSHandler(npsService *services.NPSService) *NPSHandler {
+	return &NPSHandler{
+		npsService: npsService,
+	}
+}
+
+// respondNPSError maps NPS errors to HTTP responses.
+// Returns true if an error response was sent.
+func respondNPSError(c *gin.Context, err error, operation string) bool {
+	if err == nil {
+		return false
+	}
+
+	switch {
+	case err == repository.ErrNotOwner:
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only view your own NPS",
+		})
+	case err == repository.ErrNPSSurveyNotFound:
+		RespondNotFound(c, "Survey")
+	case err == repository.ErrNPSSurveyExpired:
+		middleware.WriteError(c, http.StatusGone, middleware.ErrorResponse{
+			Error:   "Survey expired",
+			Message: "This survey is no longer accepting responses",
+		})
+	case utils.ContainsAny(err.Error(), []string{"must be"}):
+		RespondBadRequest(c, "Invalid request", err.Error())
+	default:
+		HandleServiceError(c, err, "Barber", operation)
+	}
+	return true
+}
+
+// RespondToSurvey godoc
+// @Summary Answer an NPS survey
+// @Description One-tap answer to the NPS survey sent after a completed booking. The token from the survey notification authorizes the request, so no login is needed. The answer can be changed until the survey expires.
+// @Tags surveys
+// @Accept json
+// @Produce json
+// @Param token path string true "Survey token"
+// @Param response body services.NPSResponseRequest true "Score (0-10) and optional comment"
+// @Success 200 {object} SuccessResponse{data=services.NPSResponseResult}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 410 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/surveys/nps/{token} [post]
+func (h *NPSHandler) RespondToSurvey(c *gin.Context) {
+	req, ok := BindJSON[services.NPSResponseRequest](c)
+	if !ok {
+		return
+	}
+
+	result, err := h.npsService.Respond(c.Request.Context(), c.Param("token"), *req)
+	if respondNPSError(c, err, "record survey response") {
+		return
+	}
+
+	RespondSuccessWithData(c, result, "Thanks for your feedback")
+}
+
+// GetBarberNPS godoc
+// @Summary Get a barber's NPS trend
+// @Description Net Promoter Score per week or month for one barber, plus the overall score for the range. Barbers can view their own; admins any.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param from query string false "Start date (YYYY-MM-DD)"
+// @Param to query string false "End date, inclusive (YYYY-MM-DD)"
+// @Param interval query string false "Bucket size" Enums(week, month) default(week)
+// @Success 200 {object} SuccessResponse{data=models.NPSTrend}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/nps [get]
+func (h *NPSHandler) GetBarberNPS(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	req, ok := BindQuery[services.NPSTrendRequest](c)
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "view NPS")
+	if !ok {
+		return
+	}
+
+	trend, err := h.npsService.GetBarberTrend(c.Request.Context(), barberID, userID, middleware.IsAdmin(c), *req)
+	if respondNPSError(c, err, "load barber NPS") {
+		return
+	}
+
+	RespondSuccess(c, trend)
+}
+
+// GetPlatformNPS godoc
+// @Summary Get the platform-wide NPS trend (admin)
+// @Description Net Promoter Score per week or month across all barbers, plus the overall score for the range
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param from query string false "Start date (YYYY-MM-DD)"
+// @Param to query string false "End date, inclusive (YYYY-MM-DD)"
+// @Param interval query string false "Bucket size" Enums(week, month) default(week)
+// @Success 200 {object} SuccessResponse{data=models.NPSTrend}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/nps [get]
+func (h *NPSHandler) GetPlatformNPS(c *gin.Context) {
+	req, ok := BindQuery[services.NPSTrendRequest](c)
+	if !ok {
+		return
+	}
+
+	trend, err := h.npsService.GetPlatformTrend(c.Request.Context(), *req)
+	if respondNPSError(c, err, "load platform NPS") {
+		return
+	}
+
+	RespondSuccess(c, trend)
+}
@@ -0,0 +1,333 @@
+// internal/handlers/open_slot_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/payments"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// OPEN SLOT HANDLER - Last-minute openings and favorite barbers
+// ========================================================================
+
+// OpenSlotHandler handles open slot and favorite barber requests
+type OpenSlotHandler struct {
+	openSlotService *services.OpenSlotService
+}
+
+// NewOpenSlotHandler creates a new open slot handler
+func NewOpenSlotHandler(openSlotService *services.OpenSlotService) *OpenSlotHandler {
+	return &OpenSlotHandler{
+		openSlotService: openSlotService,
+	}
+}
+
+// respondOpenSlotError maps open slot errors to HTTP responses
+func respondOpenSlotError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage your own open slots",
+		})
+	case errors.Is(err, repository.ErrOpenSlotTaken),
+		utils.ContainsAny(err.Error(), []string{"not available", "conflict"}):
+		middleware.WriteError(c, http.StatusConflict, middleware.ErrorResponse{
+			Error:   "Slot not available",
+			Message: err.Error(),
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case errors.Is(err, repository.ErrOpenSlotNotFound):
+		RespondNotFound(c, "Open slot")
+	case errors.Is(err, payments.ErrPaymentDeclined):
+		middleware.WriteError(c, http.StatusPaymentRequired, middleware.ErrorResponse{
+			Error:   "Payment declined",
+			Message: err.Error(),
+		})
+	case errors.Is(err, payments.ErrNotConfigured):
+		middleware.WriteError(c, http.StatusServiceUnavailable, middleware.ErrorResponse{
+			Error:   "Payments unavailable",
+			Message: err.Error(),
+		})
+	case utils.ContainsAny(err.Error(), []string{"not found", "required", "must", "cannot"}):
+		RespondBadRequest(c, "Invalid open slot", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// BroadcastOpenSlot godoc
+// @Summary Broadcast a last-minute open slot
+// @Description Push a free slot starting within 48 hours to customers who favorited the barber and customers within radius_km of the shop (audiences favorites and nearby, default both), optionally with a flash discount of up to 50%. The first customer to claim it books it; claims close an hour before the start. At most 5 broadcasts a day. Barbers may only broadcast their own slots.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param slot body services.BroadcastOpenSlotRequest true "Open slot"
+// @Success 201 {object} SuccessResponse{data=models.OpenSlot}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/open-slots [post]
+func (h *OpenSlotHandler) BroadcastOpenSlot(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "broadcast an open slot")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.BroadcastOpenSlotRequest](c)
+	if !ok {
+		return
+	}
+
+	slot, err := h.openSlotService.Broadcast(c.Request.Context(), barberID, userID, middleware.IsAdmin(c), *req)
+	if err != nil {
+		respondOpenSlotError(c, err, "broadcast open slot")
+		return
+	}
+
+	RespondCreated(c, slot, "Open slot broadcast")
+}
+
+// ListOpenSlots godoc
+// @Summary List a barber's open slots
+// @Description The barber's 50 most recent broadcasts with how many customers each reached and who claimed it. Barbers may only list their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Success 200 {object} SuccessResponse{data=[]models.OpenSlot}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/open-slots [get]
+func (h *OpenSlotHandler) ListOpenSlots(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "list open slots")
+	if !ok {
+		return
+	}
+
+	slots, err := h.openSlotService.List(c.Request.Context(), barberID, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondOpenSlotError(c, err, "fetch open slots")
+		return
+	}
+
+	RespondSuccess(c, slots)
+}
+
+// CancelOpenSlot godoc
+// @Summary Withdraw an open slot
+// @Description Withdraw a broadcast nobody has claimed yet. Barbers may only withdraw their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param slotId path int true "Open slot ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/open-slots/{slotId} [delete]
+func (h *OpenSlotHandler) CancelOpenSlot(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	slotID, ok := RequireIntParam(c, "slotId", "open slot")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "withdraw an open slot")
+	if !ok {
+		return
+	}
+
+	if err := h.openSlotService.Cancel(c.Request.Context(), barberID, slotID, userID, middleware.IsAdmin(c)); err != nil {
+		respondOpenSlotError(c, err, "withdraw open slot")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Open slot withdrawn")
+}
+
+// GetOpenSlot godoc
+// @Summary Get an open slot
+// @Description A broadcast slot with its price after the flash discount and whether it can still be claimed.
+// @Tags open-slots
+// @Produce json
+// @Param id path int true "Open slot ID"
+// @Success 200 {object} SuccessResponse{data=services.OpenSlotResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/open-slots/{id} [get]
+func (h *OpenSlotHandler) GetOpenSlot(c *gin.Context) {
+	slotID, ok := RequireIntParam(c, "id", "open slot")
+	if !ok {
+		return
+	}
+
+	slot, err := h.openSlotService.Get(c.Request.Context(), slotID)
+	if err != nil {
+		respondOpenSlotError(c, err, "fetch open slot")
+		return
+	}
+
+	RespondSuccess(c, slot)
+}
+
+// ClaimOpenSlot godoc
+// @Summary Claim an open slot
+// @Description Book a broadcast slot for yourself at its discounted price. First come, first served: once someone has claimed it, later claims get 409. The body is optional and takes the notes, custom_fields and deposit card a booking would.
+// @Tags open-slots
+// @Accept json
+// @Produce json
+// @Param id path int true "Open slot ID"
+// @Param claim body services.ClaimOpenSlotRequest false "Booking details"
+// @Success 201 {object} SuccessResponse{data=services.BookingResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 402 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/open-slots/{id}/claim [post]
+func (h *OpenSlotHandler) ClaimOpenSlot(c *gin.Context) {
+	slotID, ok := RequireIntParam(c, "id", "open slot")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "claim an open slot")
+	if !ok {
+		return
+	}
+
+	var req services.ClaimOpenSlotRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			RespondValidationError(c, err)
+			return
+		}
+	}
+
+	booking, err := h.openSlotService.Claim(c.Request.Context(), slotID, userID, req)
+	if err != nil {
+		respondOpenSlotError(c, err, "claim open slot")
+		return
+	}
+
+	RespondCreated(c, booking, "Open slot claimed")
+}
+
+// ListFavoriteBarbers godoc
+// @Summary List favorite barbers
+// @Description The barbers you favorited, newest first. Favorited barbers' last-minute openings are pushed to you.
+// @Tags barbers
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=[]models.FavoriteBarber}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/favorites [get]
+func (h *OpenSlotHandler) ListFavoriteBarbers(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "list favorite barbers")
+	if !ok {
+		return
+	}
+
+	favorites, err := h.openSlotService.ListFavorites(c.Request.Context(), userID)
+	if err != nil {
+		respondOpenSlotError(c, err, "fetch favorite barbers")
+		return
+	}
+
+	RespondSuccess(c, favorites)
+}
+
+// FavoriteBarber godoc
+// @Summary Favorite a barber
+// @Description Add a barber to your favorites to be told about their last-minute openings. Favoriting again does nothing.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/favorite [post]
+func (h *OpenSlotHandler) FavoriteBarber(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "favorite a barber")
+	if !ok {
+		return
+	}
+
+	if err := h.openSlotService.AddFavorite(c.Request.Context(), userID, barberID); err != nil {
+		respondOpenSlotError(c, err, "favorite barber")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Barber added to favorites")
+}
+
+// UnfavoriteBarber godoc
+// @Summary Unfavorite a barber
+// @Description Remove a barber from your favorites.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/favorite [delete]
+func (h *OpenSlotHandler) UnfavoriteBarber(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "unfavorite a barber")
+	if !ok {
+		return
+	}
+
+	if err := h.openSlotService.RemoveFavorite(c.Request.Context(), userID, barberID); err != nil {
+		respondOpenSlotError(c, err, "unfavorite barber")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Barber removed from favorites")
+}
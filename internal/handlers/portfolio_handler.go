@@ -0,0 +1,287 @@
+// internal/handlers/portfolio_handler.go
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/imaging"
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// PORTFOLIO HANDLER - Photos barbers show on their services
+// ========================================================================
+
+// PortfolioHandler handles portfolio media requests
+type PortfolioHandler struct {
+	portfolioService *services.PortfolioService
+}
+
+// NewPortfolioHandler creates a new portfolio handler
+func NewPortfolioHandler(portfolioService *services.PortfolioService) *PortfolioHandler {
+	return &PortfolioHandler{portfolioService: portfolioService}
+}
+
+// respondPortfolioError maps portfolio errors to HTTP responses
+func respondPortfolioError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, services.ErrImageTooLarge), errors.Is(err, imaging.ErrTooManyPixels):
+		middleware.WriteError(c, http.StatusRequestEntityTooLarge, middleware.ErrorResponse{
+			Error:   "Image too large",
+			Message: err.Error(),
+		})
+	case errors.Is(err, imaging.ErrUnsupportedFormat):
+		middleware.WriteError(c, http.StatusUnsupportedMediaType, middleware.ErrorResponse{
+			Error:   "Unsupported image",
+			Message: err.Error(),
+		})
+	case errors.Is(err, repository.ErrPortfolioLimit):
+		middleware.WriteError(c, http.StatusUnprocessableEntity, middleware.ErrorResponse{
+			Error:   "Too many photos",
+			Message: err.Error(),
+		})
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage photos of your own services",
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case errors.Is(err, repository.ErrBarberServiceNotFound):
+		RespondNotFound(c, "Service")
+	case errors.Is(err, repository.ErrPortfolioMediaNotFound):
+		RespondNotFound(c, "Photo")
+	case errors.Is(err, repository.ErrPortfolioOrderInvalid),
+		utils.ContainsAny(err.Error(), []string{"must be", "cannot"}):
+		RespondBadRequest(c, "Invalid portfolio request", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ListPortfolio godoc
+// @Summary List a service's portfolio
+// @Description Photos a barber shows on one of their services, in the barber's order: examples of their work, then before/after shots. Filter with kind.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param serviceId path int true "Barber service ID"
+// @Param kind query string false "Gallery" Enums(portfolio, before_after)
+// @Success 200 {object} SuccessResponse{data=[]models.PortfolioMedia}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/barbers/{id}/services/{serviceId}/portfolio [get]
+func (h *PortfolioHandler) ListPortfolio(c *gin.Context) {
+	barberID, serviceID, ok := requireAddOnPath(c)
+	if !ok {
+		return
+	}
+	query, ok := BindQuery[services.PortfolioQuery](c)
+	if !ok {
+		return
+	}
+
+	media, err := h.portfolioService.ListMedia(c.Request.Context(), barberID, serviceID, query.Kind)
+	if err != nil {
+		respondPortfolioError(c, err, "list portfolio")
+		return
+	}
+	RespondSuccess(c, media)
+}
+
+// UploadPortfolioMedia godoc
+// @Summary Add a photo to a service's portfolio
+// @Description Upload a JPEG or PNG photo (up to 10 MB) to the end of one of a service's galleries, which hold up to 20 photos each. The photo is scaled down to at most 2048 pixels a side, stripped of camera metadata such as location, and given a thumbnail. Barbers may only manage their own.
+// @Tags barbers
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param serviceId path int true "Barber service ID"
+// @Param image formData file true "JPEG or PNG photo"
+// @Param kind formData string false "Gallery (default portfolio)" Enums(portfolio, before_after)
+// @Param caption formData string false "Caption, up to 300 characters"
+// @Success 201 {object} SuccessResponse{data=models.PortfolioMedia}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 413 {object} middleware.ErrorResponse
+// @Failure 415 {object} middleware.ErrorResponse
+// @Failure 422 {object} middleware.ErrorResponse "The gallery has the maximum number of photos"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/services/{serviceId}/portfolio [post]
+func (h *PortfolioHandler) UploadPortfolioMedia(c *gin.Context) {
+	barberID, serviceID, ok := requireAddOnPath(c)
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "upload a portfolio photo")
+	if !ok {
+		return
+	}
+
+	header, err := c.FormFile("image")
+	if err != nil {
+		RespondBadRequest(c, "Missing image", "Upload the photo in the image field")
+		return
+	}
+	if header.Size > config.MaxImageSizeBytes {
+		respondPortfolioError(c, services.ErrImageTooLarge, "upload portfolio photo")
+		return
+	}
+	kind := c.DefaultPostForm("kind", config.PortfolioKindWork)
+	var caption *string
+	if value, ok := c.GetPostForm("caption"); ok {
+		caption = &value
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		RespondInternalError(c, "read portfolio photo", err)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, config.MaxImageSizeBytes+1))
+	if err != nil {
+		RespondInternalError(c, "read portfolio photo", err)
+		return
+	}
+
+	media, err := h.portfolioService.UploadMedia(c.Request.Context(), barberID, serviceID, kind, caption, data, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondPortfolioError(c, err, "upload portfolio photo")
+		return
+	}
+	RespondCreated(c, media, "Photo added")
+}
+
+// UpdatePortfolioCaption godoc
+// @Summary Caption a portfolio photo
+// @Description Set a photo's caption, up to 300 characters; null or empty clears it. Barbers may only manage their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param serviceId path int true "Barber service ID"
+// @Param mediaId path int true "Photo ID"
+// @Param caption body services.PortfolioCaptionRequest true "Caption"
+// @Success 200 {object} SuccessResponse{data=models.PortfolioMedia}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/services/{serviceId}/portfolio/{mediaId} [patch]
+func (h *PortfolioHandler) UpdatePortfolioCaption(c *gin.Context) {
+	barberID, serviceID, ok := requireAddOnPath(c)
+	if !ok {
+		return
+	}
+	mediaID, ok := RequireIntParam(c, "mediaId", "photo")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "caption a portfolio photo")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.PortfolioCaptionRequest](c)
+	if !ok {
+		return
+	}
+
+	media, err := h.portfolioService.UpdateCaption(c.Request.Context(), barberID, serviceID, mediaID, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondPortfolioError(c, err, "update portfolio caption")
+		return
+	}
+	RespondSuccessWithData(c, media, "Caption updated")
+}
+
+// ReorderPortfolio godoc
+// @Summary Reorder a service's portfolio
+// @Description Order one of a service's galleries; media_ids must list each of its photos once. Barbers may only manage their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param serviceId path int true "Barber service ID"
+// @Param order body services.PortfolioOrderRequest true "New order"
+// @Success 200 {object} SuccessResponse{data=[]models.PortfolioMedia}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/services/{serviceId}/portfolio/order [put]
+func (h *PortfolioHandler) ReorderPortfolio(c *gin.Context) {
+	barberID, serviceID, ok := requireAddOnPath(c)
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "reorder a portfolio")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.PortfolioOrderRequest](c)
+	if !ok {
+		return
+	}
+
+	media, err := h.portfolioService.ReorderMedia(c.Request.Context(), barberID, serviceID, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondPortfolioError(c, err, "reorder portfolio")
+		return
+	}
+	RespondSuccessWithData(c, media, "Portfolio reordered")
+}
+
+// DeletePortfolioMedia godoc
+// @Summary Remove a portfolio photo
+// @Description Delete a photo and its thumbnail from a service's portfolio. Barbers may only manage their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param serviceId path int true "Barber service ID"
+// @Param mediaId path int true "Photo ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/services/{serviceId}/portfolio/{mediaId} [delete]
+func (h *PortfolioHandler) DeletePortfolioMedia(c *gin.Context) {
+	barberID, serviceID, ok := requireAddOnPath(c)
+	if !ok {
+		return
+	}
+	mediaID, ok := RequireIntParam(c, "mediaId", "photo")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "remove a portfolio photo")
+	if !ok {
+		return
+	}
+
+	if err := h.portfolioService.DeleteMedia(c.Request.Context(), barberID, serviceID, mediaID, userID, middleware.IsAdmin(c)); err != nil {
+		respondPortfolioError(c, err, "remove portfolio photo")
+		return
+	}
+	RespondSuccessWithMessage(c, "Photo deleted")
+}
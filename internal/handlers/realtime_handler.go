@@ -0,0 +1,185 @@
+// internal/handlers/realtime_handler.go
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"golang.org/x/net/websocket"
+)
+
+// ========================================================================
+// REALTIME HANDLER - Live updates over WebSockets and Server-Sent Events
+// ========================================================================
+
+// maxClientMessageBytes caps messages read from clients, which send
+// nothing the server acts on
+const maxClientMessageBytes = 4096
+
+// RealtimeHandler serves users' live update connections
+type RealtimeHandler struct {
+	realtimeService *services.RealtimeService
+}
+
+// NewRealtimeHandler creates a new realtime handler
+func NewRealtimeHandler(realtimeService *services.RealtimeService) *RealtimeHandler {
+	return &RealtimeHandler{
+		realtimeService: realtimeService,
+	}
+}
+
+// Connect godoc
+// @Summary Live updates (WebSocket)
+// @Description Upgrades to a WebSocket that pushes the user's events as JSON text messages: {"type", "data", "sent_at"}. Types: notification (a new in-app notification), booking_status (one of the user's bookings changed status), unread_count (sent on connect and whenever the count changes) and heartbeat (after 30 seconds without other events). Authenticate with the Authorization header or, for browsers, which cannot set headers on WebSockets, the token query parameter. Messages from the client are ignored. Returns 503 when the server runs without Redis; poll /notifications/unread-count instead.
+// @Tags notifications
+// @Param token query string false "Access token, when the Authorization header cannot be set"
+// @Success 101 {string} string "Switching Protocols"
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 503 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/ws [get]
+func (h *RealtimeHandler) Connect(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "connect to live updates")
+	if !ok {
+		return
+	}
+	if !h.realtimeService.Enabled() {
+		middleware.WriteError(c, http.StatusServiceUnavailable, middleware.ErrorResponse{
+			Error:   "Live updates unavailable",
+			Message: "Live updates are not enabled on this server; poll for unread notifications instead",
+		})
+		return
+	}
+
+	server := websocket.Server{
+		// Connections are authenticated by token rather than cookies, so
+		// cross-site pages cannot ride on a user's session: any origin may connect
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			h.serve(c.Request.Context(), ws, userID)
+		},
+	}
+	server.ServeHTTP(c.Writer, c.Request)
+}
+
+// serve streams events to one connection until either side closes it
+func (h *RealtimeHandler) serve(ctx context.Context, ws *websocket.Conn, userID int) {
+	defer ws.Close()
+	ws.MaxPayloadBytes = maxClientMessageBytes
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Reading is how a closed connection is noticed
+	go func() {
+		defer cancel()
+		var message string
+		for {
+			if err := websocket.Message.Receive(ws, &message); err != nil {
+				return
+			}
+		}
+	}()
+
+	err := h.realtimeService.Serve(ctx, userID, func(payload []byte) error {
+		if err := ws.SetWriteDeadline(time.Now().Add(config.RealtimeWriteTimeout)); err != nil {
+			return err
+		}
+		return websocket.Message.Send(ws, string(payload))
+	})
+	if err != nil && ctx.Err() == nil {
+		logger.FromContext(ctx).Warn("Live update connection ended").
+			Int("user_id", userID).
+			Err(err).
+			Send()
+	}
+}
+
+// BookingFeed godoc
+// @Summary Live booking feed (Server-Sent Events)
+// @Description Streams a barber's booking changes as Server-Sent Events so dashboards need not poll /barbers/{id}/bookings/today. Each event's name is its type and its data is JSON: {"type", "data", "sent_at"}. Types: ready (sent once subscribed; load today's bookings then, so nothing changed while disconnected is missed), booking.created, booking.updated (details edited, rescheduled or a status change) and booking.cancelled, whose data is {"booking", "previous_status"}, and heartbeat (after 30 seconds without other events). Authenticate with the Authorization header or, for EventSource, which cannot set headers, the token query parameter. Barbers may only follow their own feed. Returns 503 when the server runs without Redis; poll instead.
+// @Tags bookings
+// @Produce text/event-stream
+// @Param id path int true "Barber ID"
+// @Param token query string false "Access token, when the Authorization header cannot be set"
+// @Success 200 {string} string "Event stream"
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 503 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/bookings/stream [get]
+func (h *RealtimeHandler) BookingFeed(c *gin.Context) {
+	barberID, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "follow bookings")
+	if !ok {
+		return
+	}
+
+	ctx := c.Request.Context()
+	rc := http.NewResponseController(c.Writer)
+	started := false
+
+	err := h.realtimeService.ServeBarberFeed(ctx, barberID, userID, middleware.IsAdmin(c), func(eventType string, payload []byte) error {
+		if !started {
+			c.Header("Content-Type", "text/event-stream")
+			c.Header("Cache-Control", "no-cache")
+			c.Header("Connection", "keep-alive")
+			c.Header("X-Accel-Buffering", "no") // Stop proxies buffering the stream
+			c.Status(http.StatusOK)
+			started = true
+		}
+
+		// The server's write timeout would otherwise end the stream
+		if err := rc.SetWriteDeadline(time.Now().Add(config.RealtimeWriteTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		if _, err := fmt.Fprintf(c.Writer, "event: %s\ndata: %s\n\n", eventType, payload); err != nil {
+			return err
+		}
+		return rc.Flush()
+	})
+	if err == nil {
+		return
+	}
+	if started {
+		if ctx.Err() == nil {
+			logger.FromContext(ctx).Warn("Booking feed ended").
+				Int("barber_id", barberID).
+				Err(err).
+				Send()
+		}
+		return
+	}
+
+	switch {
+	case errors.Is(err, services.ErrRealtimeUnavailable):
+		middleware.WriteError(c, http.StatusServiceUnavailable, middleware.ErrorResponse{
+			Error:   "Live updates unavailable",
+			Message: "Live updates are not enabled on this server; poll for bookings instead",
+		})
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only follow your own bookings",
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	default:
+		RespondInternalError(c, "open booking feed", err)
+	}
+}
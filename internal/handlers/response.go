@@ -21,12 +21,14 @@ type PaginatedResponse struct {
 // Pagination contains pagination metadata
 // @Description Pagination metadata for list responses
 type Pagination struct {
-	Total       int  `json:"total" example:"100"`
-	Count       int  `json:"count" example:"20"`
-	PerPage     int  `json:"per_page" example:"20"`
-	CurrentPage int  `json:"current_page" example:"1"`
-	TotalPages  int  `json:"total_pages" example:"5"`
-	HasMore     bool `json:"has_more" example:"true"`
+	Count   int  `json:"count" example:"20"`
+	Total   int  `json:"total" example:"100"`
+	Limit   int  `json:"limit" example:"20"`
+	Offset  int  `json:"offset" example:"0"`
+	HasMore bool `json:"has_more" example:"true"`
+
+	// Largest limit the consumer may ask for; larger limits are capped
+	MaxLimit int `json:"max_limit" example:"100"`
 }
 
 // Note: Helper functions for creating responses are in helpers.go
@@ -2,6 +2,7 @@
 package handlers
 
 import (
+	"barber-booking-system/internal/config"
 	"barber-booking-system/internal/middleware"
 	"barber-booking-system/internal/repository"
 	"barber-booking-system/internal/services"
@@ -35,7 +36,7 @@ func NewReviewHandler(reviewService *services.ReviewService) *ReviewHandler {
 
 // CreateReview godoc
 // @Summary Create a new review
-// @Description Create a review for a completed booking
+// @Description Create a review for a completed booking. New reviews are screened for profanity and spam (links, duplicate text, bursts of reviews without text): suspicious ones are flagged for moderators, the rest are published.
 // @Tags reviews
 // @Accept json
 // @Produce json
@@ -150,11 +151,13 @@ func (h *ReviewHandler) GetReviewByBooking(c *gin.Context) {
 // @Param max_rating query int false "Filter by maximum rating"
 // @Param has_comment query bool false "Filter reviews with comments"
 // @Param has_images query bool false "Filter reviews with images"
-// @Param sort_by query string false "Sort by field" default(created_at)
+// @Param sort_by query string false "Sort by field (created_at, overall_rating, helpful_votes, report_count)" default(created_at)
 // @Param order query string false "Sort order (ASC/DESC)" default(DESC)
 // @Param limit query int false "Limit results" default(50)
 // @Param offset query int false "Offset for pagination" default(0)
-// @Success 200 {object} SuccessResponse
+// @Param include_customer query bool false "Attach each customer's name and picture"
+// @Param include_barber query bool false "Attach each barber's shop summary"
+// @Success 200 {object} PaginatedResponse{data=[]services.ReviewResponse}
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /api/v1/barbers/{id}/reviews [get]
@@ -164,23 +167,20 @@ func (h *ReviewHandler) GetBarberReviews(c *gin.Context) {
 		return
 	}
 
-	filters, ok := BindQuery[repository.ReviewFilters](c)
+	filters, ok := BindFilters[repository.ReviewFilters](c)
 	if !ok {
 		return
 	}
 
-	reviews, err := h.reviewService.GetBarberReviews(c.Request.Context(), barberID, *filters)
+	reviews, total, err := h.reviewService.GetBarberReviews(c.Request.Context(), barberID, *filters)
 	if err != nil {
 		RespondInternalError(c, "fetch reviews", err)
 		return
 	}
 
-	RespondSuccessWithMeta(c, reviews, map[string]interface{}{
-		"barber_id": barberID,
-		"count":     len(reviews),
-		"limit":     filters.Limit,
-		"offset":    filters.Offset,
-	})
+	meta := PageMeta(len(reviews), total, filters.Limit, filters.Offset)
+	meta["barber_id"] = barberID
+	RespondSuccessWithMeta(c, reviews, meta)
 }
 
 // GetBarberReviewStats godoc
@@ -222,11 +222,13 @@ func (h *ReviewHandler) GetBarberReviewStats(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param moderation_status query string false "Filter by moderation status"
-// @Param sort_by query string false "Sort by field" default(created_at)
+// @Param sort_by query string false "Sort by field (created_at, overall_rating, helpful_votes, report_count)" default(created_at)
 // @Param order query string false "Sort order (ASC/DESC)" default(DESC)
 // @Param limit query int false "Limit results" default(50)
 // @Param offset query int false "Offset for pagination" default(0)
-// @Success 200 {object} SuccessResponse
+// @Param include_customer query bool false "Attach each customer's name and picture"
+// @Param include_barber query bool false "Attach each barber's shop summary"
+// @Success 200 {object} PaginatedResponse{data=[]services.ReviewResponse}
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Security BearerAuth
@@ -237,18 +239,18 @@ func (h *ReviewHandler) GetMyReviews(c *gin.Context) {
 		return
 	}
 
-	filters, ok := BindQuery[repository.ReviewFilters](c)
+	filters, ok := BindFilters[repository.ReviewFilters](c)
 	if !ok {
 		return
 	}
 
-	reviews, err := h.reviewService.GetCustomerReviews(c.Request.Context(), userID, *filters)
+	reviews, total, err := h.reviewService.GetCustomerReviews(c.Request.Context(), userID, *filters)
 	if err != nil {
 		RespondInternalError(c, "fetch reviews", err)
 		return
 	}
 
-	RespondSuccessWithMeta(c, reviews, PaginationMeta(len(reviews), filters.Limit, filters.Offset))
+	RespondSuccessWithMeta(c, reviews, PageMeta(len(reviews), total, filters.Limit, filters.Offset))
 }
 
 // ========================================================================
@@ -301,7 +303,7 @@ func (h *ReviewHandler) UpdateReview(c *gin.Context) {
 
 // ModerateReview godoc
 // @Summary Moderate a review
-// @Description Update the moderation status of a review (admin only)
+// @Description Update the moderation status of a review (requires reviews:moderate)
 // @Tags reviews
 // @Accept json
 // @Produce json
@@ -331,10 +333,6 @@ func (h *ReviewHandler) ModerateReview(c *gin.Context) {
 		return
 	}
 
-	// Note: In a real app, you'd check if user is admin here
-	// userType, _ := middleware.GetUserType(c)
-	// if userType != "admin" { ... }
-
 	review, err := h.reviewService.ModerateReview(c.Request.Context(), id, *req, userID)
 	if HandleServiceError(c, err, "Review", "moderate review") {
 		return
@@ -345,31 +343,33 @@ func (h *ReviewHandler) ModerateReview(c *gin.Context) {
 
 // GetPendingReviews godoc
 // @Summary Get pending reviews
-// @Description Get all reviews pending moderation (admin only)
+// @Description Get all reviews pending moderation (requires reviews:moderate)
 // @Tags reviews
 // @Accept json
 // @Produce json
 // @Param limit query int false "Limit results" default(50)
 // @Param offset query int false "Offset for pagination" default(0)
-// @Success 200 {object} SuccessResponse
+// @Param include_customer query bool false "Attach each customer's name and picture"
+// @Param include_barber query bool false "Attach each barber's shop summary"
+// @Success 200 {object} PaginatedResponse{data=[]services.ReviewResponse}
 // @Failure 401 {object} middleware.ErrorResponse
 // @Failure 403 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Security BearerAuth
 // @Router /api/v1/reviews/pending [get]
 func (h *ReviewHandler) GetPendingReviews(c *gin.Context) {
-	filters, ok := BindQuery[repository.ReviewFilters](c)
+	filters, ok := BindFilters[repository.ReviewFilters](c)
 	if !ok {
 		return
 	}
 
-	reviews, err := h.reviewService.GetPendingReviews(c.Request.Context(), *filters)
+	reviews, total, err := h.reviewService.GetPendingReviews(c.Request.Context(), *filters)
 	if err != nil {
 		RespondInternalError(c, "fetch pending reviews", err)
 		return
 	}
 
-	RespondSuccessWithMeta(c, reviews, PaginationMeta(len(reviews), filters.Limit, filters.Offset))
+	RespondSuccessWithMeta(c, reviews, PageMeta(len(reviews), total, filters.Limit, filters.Offset))
 }
 
 // ========================================================================
@@ -378,7 +378,7 @@ func (h *ReviewHandler) GetPendingReviews(c *gin.Context) {
 
 // AddBarberResponse godoc
 // @Summary Add barber response to a review
-// @Description Allow a barber to respond to a review on their profile
+// @Description Allow a barber to respond to a review on their profile. The response is screened like a review (profanity, personal information, links): it shows once it passes, or once a moderator approves it. A rejected or removed response can be replaced
 // @Tags reviews
 // @Accept json
 // @Produce json
@@ -413,7 +413,11 @@ func (h *ReviewHandler) AddBarberResponse(c *gin.Context) {
 		return
 	}
 
-	RespondSuccessWithData(c, review, "Response added successfully")
+	message := "Response added successfully"
+	if review.BarberResponse == nil {
+		message = "Response submitted for moderation"
+	}
+	RespondSuccessWithData(c, review, message)
 }
 
 // ========================================================================
@@ -481,10 +485,10 @@ func (h *ReviewHandler) DeleteReview(c *gin.Context) {
 		return
 	}
 
-	// Note: Check if admin in a real app
-	isAdmin := false // middleware.IsAdmin(c)
+	// Moderators can delete any review
+	canModerate := middleware.HasPermission(c, config.PermissionReviewsModerate)
 
-	err := h.reviewService.DeleteReview(c.Request.Context(), id, userID, isAdmin)
+	err := h.reviewService.DeleteReview(c.Request.Context(), id, userID, canModerate)
 	if HandleServiceError(c, err, "Review", "Delete Review") {
 		return
 	}
@@ -0,0 +1,176 @@
+// internal/handlers/review_image_handler.go
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/imaging"
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// REVIEW IMAGE HANDLER - Photos attached to reviews
+// ========================================================================
+
+// ReviewImageHandler handles review photo uploads
+type ReviewImageHandler struct {
+	reviewImageService *services.ReviewImageService
+}
+
+// NewReviewImageHandler creates a new review image handler
+func NewReviewImageHandler(reviewImageService *services.ReviewImageService) *ReviewImageHandler {
+	return &ReviewImageHandler{reviewImageService: reviewImageService}
+}
+
+// ListReviewImages godoc
+// @Summary List a review's photos
+// @Description Photos attached to a review, in upload order, with their thumbnails
+// @Tags reviews
+// @Produce json
+// @Param id path int true "Review ID"
+// @Success 200 {object} SuccessResponse{data=[]models.ReviewImage}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/reviews/{id}/images [get]
+func (h *ReviewImageHandler) ListReviewImages(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "review")
+	if !ok {
+		return
+	}
+
+	images, err := h.reviewImageService.ListImages(c.Request.Context(), id)
+	if HandleServiceError(c, err, "Review", "list review images") {
+		return
+	}
+	RespondSuccess(c, images)
+}
+
+// UploadReviewImage godoc
+// @Summary Attach a photo to a review
+// @Description Upload a JPEG or PNG photo (up to 10 MB) to your own review while it awaits moderation; a review holds up to 5 photos. The photo is scaled down to at most 2048 pixels a side, stripped of camera metadata such as location, and given a thumbnail.
+// @Tags reviews
+// @Accept multipart/form-data
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param image formData file true "JPEG or PNG photo"
+// @Success 201 {object} SuccessResponse{data=models.ReviewImage}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 413 {object} middleware.ErrorResponse
+// @Failure 415 {object} middleware.ErrorResponse
+// @Failure 422 {object} middleware.ErrorResponse "The review has the maximum number of photos"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/reviews/{id}/images [post]
+func (h *ReviewImageHandler) UploadReviewImage(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "review")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "upload a review photo")
+	if !ok {
+		return
+	}
+
+	header, err := c.FormFile("image")
+	if err != nil {
+		RespondBadRequest(c, "Missing image", "Upload the photo in the image field")
+		return
+	}
+	if header.Size > config.MaxImageSizeBytes {
+		respondReviewImageError(c, services.ErrImageTooLarge)
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		RespondInternalError(c, "read review photo", err)
+		return
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, config.MaxImageSizeBytes+1))
+	if err != nil {
+		RespondInternalError(c, "read review photo", err)
+		return
+	}
+
+	image, err := h.reviewImageService.AttachImage(c.Request.Context(), id, userID, data)
+	if err != nil {
+		respondReviewImageError(c, err)
+		return
+	}
+	RespondCreated(c, image, "Photo attached")
+}
+
+// DeleteReviewImage godoc
+// @Summary Remove a photo from a review
+// @Description Delete a photo and its thumbnail. Authors may remove photos from their own reviews at any time; moderators (reviews:moderate) from any review.
+// @Tags reviews
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param image_id path int true "Image ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/reviews/{id}/images/{image_id} [delete]
+func (h *ReviewImageHandler) DeleteReviewImage(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "review")
+	if !ok {
+		return
+	}
+	imageID, ok := RequireIntParam(c, "image_id", "image")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "delete a review photo")
+	if !ok {
+		return
+	}
+
+	canModerate := middleware.HasPermission(c, config.PermissionReviewsModerate)
+	err := h.reviewImageService.DeleteImage(c.Request.Context(), id, imageID, userID, canModerate)
+	if err != nil {
+		respondReviewImageError(c, err)
+		return
+	}
+	RespondSuccessWithMessage(c, "Photo deleted")
+}
+
+// respondReviewImageError maps review photo errors to responses
+func respondReviewImageError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, services.ErrImageTooLarge), errors.Is(err, imaging.ErrTooManyPixels):
+		middleware.WriteError(c, http.StatusRequestEntityTooLarge, middleware.ErrorResponse{
+			Error:   "Image too large",
+			Message: err.Error(),
+		})
+	case errors.Is(err, imaging.ErrUnsupportedFormat):
+		middleware.WriteError(c, http.StatusUnsupportedMediaType, middleware.ErrorResponse{
+			Error:   "Unsupported image",
+			Message: err.Error(),
+		})
+	case errors.Is(err, repository.ErrReviewImageLimit):
+		middleware.WriteError(c, http.StatusUnprocessableEntity, middleware.ErrorResponse{
+			Error:   "Too many photos",
+			Message: err.Error(),
+		})
+	case errors.Is(err, repository.ErrReviewImageNotFound):
+		RespondNotFound(c, "Image")
+	default:
+		HandleServiceError(c, err, "Review", "update review photos")
+	}
+}
@@ -0,0 +1,345 @@
+// internal/handlers/review_moderation_handler.go
+package handlers
+
+import (
+	"errors"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// REVIEW MODERATION QUEUE (Admin)
+// ========================================================================
+
+// respondModerationError maps moderation queue errors to HTTP responses
+func respondModerationError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, services.ErrModerationReasonRequired),
+		errors.Is(err, services.ErrUnknownModerationReason),
+		utils.ContainsAny(err.Error(), []string{"must be", "cannot"}):
+		RespondBadRequest(c, "Invalid moderation", err.Error())
+	default:
+		HandleServiceError(c, err, "Review", operation)
+	}
+}
+
+// GetModerationQueue godoc
+// @Summary Review moderation queue
+// @Description Reviews by moderation status, oldest first unless sorted otherwise. Reviews flagged by user reports are in the flagged queue; sort_by=report_count puts the most reported first (requires reviews:moderate)
+// @Tags admin
+// @Produce json
+// @Param moderation_status query string false "Moderation status" Enums(pending, approved, rejected, flagged) default(pending)
+// @Param barber_id query int false "Filter by barber"
+// @Param min_reports query int false "Only reviews with at least this many open user reports"
+// @Param sort_by query string false "Sort by field (created_at, overall_rating, helpful_votes, report_count)"
+// @Param order query string false "Sort order (ASC/DESC)"
+// @Param limit query int false "Limit results" default(50)
+// @Param offset query int false "Offset for pagination" default(0)
+// @Param include_customer query bool false "Attach each customer's name and picture"
+// @Param include_barber query bool false "Attach each barber's shop summary"
+// @Success 200 {object} PaginatedResponse{data=[]services.ReviewResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/reviews [get]
+func (h *ReviewHandler) GetModerationQueue(c *gin.Context) {
+	filters, ok := BindFilters[repository.ReviewFilters](c)
+	if !ok {
+		return
+	}
+
+	reviews, total, err := h.reviewService.GetModerationQueue(c.Request.Context(), *filters)
+	if err != nil {
+		respondModerationError(c, err, "fetch moderation queue")
+		return
+	}
+
+	RespondSuccessWithMeta(c, reviews, PageMeta(len(reviews), total, filters.Limit, filters.Offset))
+}
+
+// GetModerationReasons godoc
+// @Summary Moderation reason templates
+// @Description Reasons to pick when rejecting or flagging reviews, with the actions each applies to (requires reviews:moderate)
+// @Tags admin
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=[]services.ModerationReason}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/reviews/moderation-reasons [get]
+func (h *ReviewHandler) GetModerationReasons(c *gin.Context) {
+	RespondSuccess(c, h.reviewService.ModerationReasons())
+}
+
+// ApproveReview godoc
+// @Summary Approve a review
+// @Description Approve and publish a review, with optional notes (requires reviews:moderate)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param moderation body services.ModerationActionRequest false "Notes"
+// @Success 200 {object} SuccessResponse{data=services.ReviewResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/reviews/{id}/approve [post]
+func (h *ReviewHandler) ApproveReview(c *gin.Context) {
+	h.applyModeration(c, config.ModerationActionApprove, "Review approved")
+}
+
+// RejectReview godoc
+// @Summary Reject a review
+// @Description Reject a review for one of the moderation reasons, with optional notes; it stays unpublished (requires reviews:moderate)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param moderation body services.ModerationActionRequest true "Reason and notes"
+// @Success 200 {object} SuccessResponse{data=services.ReviewResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/reviews/{id}/reject [post]
+func (h *ReviewHandler) RejectReview(c *gin.Context) {
+	h.applyModeration(c, config.ModerationActionReject, "Review rejected")
+}
+
+// FlagReview godoc
+// @Summary Flag a review
+// @Description Flag a review for follow-up for one of the moderation reasons, with optional notes; it is unpublished until approved (requires reviews:moderate)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param moderation body services.ModerationActionRequest true "Reason and notes"
+// @Success 200 {object} SuccessResponse{data=services.ReviewResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/reviews/{id}/flag [post]
+func (h *ReviewHandler) FlagReview(c *gin.Context) {
+	h.applyModeration(c, config.ModerationActionFlag, "Review flagged")
+}
+
+// BulkModerateReviews godoc
+// @Summary Moderate reviews in bulk
+// @Description Approve, reject or flag up to 100 reviews at once. Each review is moderated on its own; the result lists the outcome of each (requires reviews:moderate)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param moderation body services.BulkModerationRequest true "Reviews and action"
+// @Success 200 {object} SuccessResponse{data=services.BulkModerationResult}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/reviews/moderate [post]
+func (h *ReviewHandler) BulkModerateReviews(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "moderate reviews")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.BulkModerationRequest](c)
+	if !ok {
+		return
+	}
+
+	result, err := h.reviewService.BulkModerate(c.Request.Context(), *req, userID)
+	if err != nil {
+		respondModerationError(c, err, "moderate reviews")
+		return
+	}
+
+	RespondSuccessWithData(c, result, "Reviews moderated")
+}
+
+// applyModeration applies a queue action to the review in the path; the
+// body is optional
+func (h *ReviewHandler) applyModeration(c *gin.Context, action, message string) {
+	id, ok := RequireIntParam(c, "id", "review")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "moderate reviews")
+	if !ok {
+		return
+	}
+
+	var req services.ModerationActionRequest
+	if c.Request.ContentLength != 0 {
+		body, ok := BindJSON[services.ModerationActionRequest](c)
+		if !ok {
+			return
+		}
+		req = *body
+	}
+
+	review, err := h.reviewService.ApplyModeration(c.Request.Context(), id, action, req, userID)
+	if err != nil {
+		respondModerationError(c, err, "moderate review")
+		return
+	}
+
+	RespondSuccessWithData(c, review, message)
+}
+
+// ========================================================================
+// BARBER RESPONSE MODERATION (Admin)
+// ========================================================================
+
+// GetResponseModerationQueue godoc
+// @Summary Review response moderation queue
+// @Description Reviews by the moderation status of their barber response, oldest first unless sorted otherwise. Responses flagged by screening (profanity, personal information, links) are in the flagged queue; pending_response holds the text awaiting moderation (requires reviews:moderate)
+// @Tags admin
+// @Produce json
+// @Param response_status query string false "Response moderation status" Enums(pending, approved, rejected, flagged, redacted) default(pending)
+// @Param barber_id query int false "Filter by barber"
+// @Param sort_by query string false "Sort by field (created_at, overall_rating, helpful_votes, report_count)"
+// @Param order query string false "Sort order (ASC/DESC)"
+// @Param limit query int false "Limit results" default(50)
+// @Param offset query int false "Offset for pagination" default(0)
+// @Param include_customer query bool false "Attach each customer's name and picture"
+// @Param include_barber query bool false "Attach each barber's shop summary"
+// @Success 200 {object} PaginatedResponse{data=[]services.ReviewResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/reviews/responses [get]
+func (h *ReviewHandler) GetResponseModerationQueue(c *gin.Context) {
+	filters, ok := BindFilters[repository.ReviewFilters](c)
+	if !ok {
+		return
+	}
+
+	reviews, total, err := h.reviewService.GetResponseModerationQueue(c.Request.Context(), *filters)
+	if err != nil {
+		respondModerationError(c, err, "fetch response moderation queue")
+		return
+	}
+
+	RespondSuccessWithMeta(c, reviews, PageMeta(len(reviews), total, filters.Limit, filters.Offset))
+}
+
+// GetResponseModerationReasons godoc
+// @Summary Response moderation reason templates
+// @Description Reasons to pick when rejecting or redacting barber responses, with the actions each applies to (requires reviews:moderate)
+// @Tags admin
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=[]services.ModerationReason}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/reviews/response-moderation-reasons [get]
+func (h *ReviewHandler) GetResponseModerationReasons(c *gin.Context) {
+	RespondSuccess(c, h.reviewService.ResponseModerationReasons())
+}
+
+// ApproveResponse godoc
+// @Summary Approve a barber response
+// @Description Approve a pending or flagged barber response so it shows under the review, with optional notes (requires reviews:moderate)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param moderation body services.ModerationActionRequest false "Notes"
+// @Success 200 {object} SuccessResponse{data=services.ReviewResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/reviews/{id}/response/approve [post]
+func (h *ReviewHandler) ApproveResponse(c *gin.Context) {
+	h.applyResponseModeration(c, config.ModerationActionApprove, "Response approved")
+}
+
+// RejectResponse godoc
+// @Summary Reject a barber response
+// @Description Reject a pending or flagged barber response for one of the response reasons, with optional notes. The barber is notified and may respond again (requires reviews:moderate)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param moderation body services.ModerationActionRequest true "Reason and notes"
+// @Success 200 {object} SuccessResponse{data=services.ReviewResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/reviews/{id}/response/reject [post]
+func (h *ReviewHandler) RejectResponse(c *gin.Context) {
+	h.applyResponseModeration(c, config.ModerationActionReject, "Response rejected")
+}
+
+// RedactResponse godoc
+// @Summary Redact a barber response
+// @Description Take down a live barber response for one of the response reasons, with optional notes. Its text is kept for the record; the barber is notified and may respond again (requires reviews:moderate)
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param moderation body services.ModerationActionRequest true "Reason and notes"
+// @Success 200 {object} SuccessResponse{data=services.ReviewResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/reviews/{id}/response/redact [post]
+func (h *ReviewHandler) RedactResponse(c *gin.Context) {
+	h.applyResponseModeration(c, config.ModerationActionRedact, "Response redacted")
+}
+
+// applyResponseModeration applies a response moderation action to the
+// review in the path; the body is optional
+func (h *ReviewHandler) applyResponseModeration(c *gin.Context, action, message string) {
+	id, ok := RequireIntParam(c, "id", "review")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "moderate review responses")
+	if !ok {
+		return
+	}
+
+	var req services.ModerationActionRequest
+	if c.Request.ContentLength != 0 {
+		body, ok := BindJSON[services.ModerationActionRequest](c)
+		if !ok {
+			return
+		}
+		req = *body
+	}
+
+	review, err := h.reviewService.ModerateResponse(c.Request.Context(), id, action, req, userID)
+	if err != nil {
+		respondModerationError(c, err, "moderate review response")
+		return
+	}
+
+	RespondSuccessWithData(c, review, message)
+}
@@ -0,0 +1,104 @@
+// internal/handlers/review_report_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// REVIEW REPORT HANDLER - Users reporting reviews
+// ========================================================================
+
+// ReviewReportHandler handles review reports
+type ReviewReportHandler struct {
+	reviewReportService *services.ReviewReportService
+}
+
+// NewReviewReportHandler creates a new review report handler
+func NewReviewReportHandler(reviewReportService *services.ReviewReportService) *ReviewReportHandler {
+	return &ReviewReportHandler{reviewReportService: reviewReportService}
+}
+
+// ReportReview godoc
+// @Summary Report a review
+// @Description Report a published review to moderators, once per review. Details are required when the reason is other. A review with enough open reports is unpublished until a moderator looks at it.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param id path int true "Review ID"
+// @Param report body services.ReportReviewRequest true "Reason and details"
+// @Success 201 {object} SuccessResponse{data=models.ReviewReport}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse "Already reported"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/reviews/{id}/report [post]
+func (h *ReviewReportHandler) ReportReview(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "review")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "report a review")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.ReportReviewRequest](c)
+	if !ok {
+		return
+	}
+
+	report, err := h.reviewReportService.ReportReview(c.Request.Context(), id, *req, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrAlreadyReported):
+			middleware.WriteError(c, http.StatusConflict, middleware.ErrorResponse{
+				Error:   "Already reported",
+				Message: err.Error(),
+			})
+		case errors.Is(err, services.ErrReportDetailsRequired),
+			utils.ContainsAny(err.Error(), []string{"cannot"}):
+			RespondBadRequest(c, "Invalid report", err.Error())
+		default:
+			HandleServiceError(c, err, "Review", "report review")
+		}
+		return
+	}
+	RespondCreated(c, report, "Review reported")
+}
+
+// ListReviewReports godoc
+// @Summary List a review's reports
+// @Description Users' reports of a review, newest first, with the reporters' names (requires reviews:moderate)
+// @Tags admin
+// @Produce json
+// @Param id path int true "Review ID"
+// @Success 200 {object} SuccessResponse{data=[]models.ReviewReport}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/reviews/{id}/reports [get]
+func (h *ReviewReportHandler) ListReviewReports(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "review")
+	if !ok {
+		return
+	}
+
+	reports, err := h.reviewReportService.ListReports(c.Request.Context(), id)
+	if HandleServiceError(c, err, "Review", "list review reports") {
+		return
+	}
+	RespondSuccess(c, reports)
+}
@@ -0,0 +1,101 @@
+// internal/handlers/review_request_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// REVIEW REQUEST HANDLER - Reviews written from review request links
+// ========================================================================
+
+// ReviewRequestHandler handles the review links sent after completed bookings
+type ReviewRequestHandler struct {
+	reviewRequestService *services.ReviewRequestService
+}
+
+// NewReviewRequestHandler creates a new review request handler
+func NewReviewRequestHandler(reviewRequestService *services.ReviewRequestService) *ReviewRequestHandler {
+	return &ReviewRequestHandler{
+		reviewRequestService: reviewRequestService,
+	}
+}
+
+// respondReviewRequestError maps review link errors to HTTP responses.
+// Returns true if an error response was sent.
+func respondReviewRequestError(c *gin.Context, err error, operation string) bool {
+	if err == nil {
+		return false
+	}
+
+	switch {
+	case err == repository.ErrReviewRequestNotFound:
+		RespondNotFound(c, "Review request")
+	case err == repository.ErrReviewRequestClosed:
+		middleware.WriteError(c, http.StatusGone, middleware.ErrorResponse{
+			Error:   "Review link closed",
+			Message: "This review link has already been used or has expired",
+		})
+	case utils.ContainsAny(err.Error(), []string{"must be"}):
+		RespondBadRequest(c, "Invalid request", err.Error())
+	default:
+		HandleServiceError(c, err, "Review", operation)
+	}
+	return true
+}
+
+// GetReviewLink godoc
+// @Summary Describe a review link
+// @Description Describes the booking a review link (sent some time after a completed booking) is for, so the review form can be shown. The token from the notification authorizes the request, so no login is needed. Opening the link does not use it up.
+// @Tags reviews
+// @Produce json
+// @Param token path string true "Review link token"
+// @Success 200 {object} SuccessResponse{data=services.ReviewLinkInfo}
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 410 {object} middleware.ErrorResponse "Link used or expired"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/reviews/requests/{token} [get]
+func (h *ReviewRequestHandler) GetReviewLink(c *gin.Context) {
+	info, err := h.reviewRequestService.GetLink(c.Request.Context(), c.Param("token"))
+	if respondReviewRequestError(c, err, "get review link") {
+		return
+	}
+
+	RespondSuccess(c, info)
+}
+
+// SubmitLinkReview godoc
+// @Summary Review a booking from a review link
+// @Description Writes the review for the booking a review link is for, as the booking's customer, without logging in. Each link can be used for one review, until it expires. booking_id must be the link's booking. The review is screened like any other.
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Param token path string true "Review link token"
+// @Param review body services.CreateReviewRequest true "Review data"
+// @Success 201 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse "Review already exists"
+// @Failure 410 {object} middleware.ErrorResponse "Link used or expired"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/reviews/requests/{token} [post]
+func (h *ReviewRequestHandler) SubmitLinkReview(c *gin.Context) {
+	req, ok := BindJSON[services.CreateReviewRequest](c)
+	if !ok {
+		return
+	}
+
+	review, err := h.reviewRequestService.SubmitReview(c.Request.Context(), c.Param("token"), *req)
+	if respondReviewRequestError(c, err, "create review") {
+		return
+	}
+
+	RespondCreated(c, review, "Thanks for your review")
+}
@@ -0,0 +1,286 @@
+// internal/handlers/role_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// ROLE HANDLER - Roles, permissions and role assignments
+// ========================================================================
+
+// RoleHandler handles role administration requests
+type RoleHandler struct {
+	roleService *services.RoleService
+}
+
+// NewRoleHandler creates a new role handler
+func NewRoleHandler(roleService *services.RoleService) *RoleHandler {
+	return &RoleHandler{
+		roleService: roleService,
+	}
+}
+
+// respondRoleError maps role errors to HTTP responses
+func respondRoleError(c *gin.Context, err error, operation string) {
+	statusCode := 0
+	switch {
+	case errors.Is(err, repository.ErrRoleNotFound):
+		RespondNotFound(c, "Role")
+		return
+	case errors.Is(err, repository.ErrUserRoleNotFound):
+		RespondNotFound(c, "Role assignment")
+		return
+	case errors.Is(err, repository.ErrUserNotFound):
+		RespondNotFound(c, "User")
+		return
+	case errors.Is(err, repository.ErrDuplicateRole),
+		errors.Is(err, repository.ErrRoleAlreadyAssigned),
+		errors.Is(err, repository.ErrSystemRole):
+		statusCode = http.StatusConflict
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		statusCode = http.StatusBadRequest
+	}
+
+	if statusCode == 0 {
+		RespondInternalError(c, operation, err)
+		return
+	}
+	middleware.WriteError(c, statusCode, middleware.ErrorResponse{
+		Error:   "Role request failed",
+		Message: err.Error(),
+	})
+}
+
+// ========================================================================
+// PERMISSIONS AND ROLES
+// ========================================================================
+
+// ListPermissions godoc
+// @Summary List permissions
+// @Description Every permission a role can grant
+// @Tags admin
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=[]string}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/permissions [get]
+func (h *RoleHandler) ListPermissions(c *gin.Context) {
+	RespondSuccess(c, h.roleService.ListPermissions())
+}
+
+// ListRoles godoc
+// @Summary List roles
+// @Description System roles (customer, barber, admin), which every account of that type holds, and custom roles, which are assigned to individual users
+// @Tags admin
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=[]models.Role}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/roles [get]
+func (h *RoleHandler) ListRoles(c *gin.Context) {
+	roles, err := h.roleService.ListRoles(c.Request.Context())
+	if err != nil {
+		RespondInternalError(c, "fetch roles", err)
+		return
+	}
+
+	RespondSuccess(c, roles)
+}
+
+// CreateRole godoc
+// @Summary Create a role
+// @Description Define a custom role from the permissions listed at /api/v1/admin/permissions
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body services.CreateRoleRequest true "Role"
+// @Success 201 {object} SuccessResponse{data=models.Role}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/roles [post]
+func (h *RoleHandler) CreateRole(c *gin.Context) {
+	req, ok := BindJSON[services.CreateRoleRequest](c)
+	if !ok {
+		return
+	}
+
+	role, err := h.roleService.CreateRole(c.Request.Context(), *req)
+	if err != nil {
+		respondRoleError(c, err, "create role")
+		return
+	}
+
+	RespondCreated(c, role, "Role created")
+}
+
+// UpdateRole godoc
+// @Summary Update a role
+// @Description Replace a role's description and permissions. The customer and barber system roles can be changed; the admin role cannot.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param name path string true "Role name"
+// @Param request body services.UpdateRoleRequest true "Role"
+// @Success 200 {object} SuccessResponse{data=models.Role}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/roles/{name} [put]
+func (h *RoleHandler) UpdateRole(c *gin.Context) {
+	req, ok := BindJSON[services.UpdateRoleRequest](c)
+	if !ok {
+		return
+	}
+
+	role, err := h.roleService.UpdateRole(c.Request.Context(), c.Param("name"), *req)
+	if err != nil {
+		respondRoleError(c, err, "update role")
+		return
+	}
+
+	RespondSuccessWithData(c, role, "Role updated")
+}
+
+// DeleteRole godoc
+// @Summary Delete a role
+// @Description Delete a custom role and remove it from every user who holds it
+// @Tags admin
+// @Produce json
+// @Param name path string true "Role name"
+// @Success 200 {object} SuccessResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/roles/{name} [delete]
+func (h *RoleHandler) DeleteRole(c *gin.Context) {
+	if err := h.roleService.DeleteRole(c.Request.Context(), c.Param("name")); err != nil {
+		respondRoleError(c, err, "delete role")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Role deleted")
+}
+
+// ========================================================================
+// ROLE ASSIGNMENTS
+// ========================================================================
+
+// GetUserRoles godoc
+// @Summary Get a user's roles
+// @Description A user's assigned roles and the permissions they hold in total
+// @Tags admin
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} SuccessResponse{data=models.UserAccess}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/roles [get]
+func (h *RoleHandler) GetUserRoles(c *gin.Context) {
+	userID, ok := RequireIntParam(c, "id", "User")
+	if !ok {
+		return
+	}
+
+	access, err := h.roleService.GetUserAccess(c.Request.Context(), userID)
+	if err != nil {
+		respondRoleError(c, err, "fetch user roles")
+		return
+	}
+
+	RespondSuccess(c, access)
+}
+
+// AssignRole godoc
+// @Summary Assign a role
+// @Description Grant a custom role to a user. It takes effect on every instance within a minute.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Param request body services.AssignRoleRequest true "Role"
+// @Success 201 {object} SuccessResponse{data=models.UserRole}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/roles [post]
+func (h *RoleHandler) AssignRole(c *gin.Context) {
+	userID, ok := RequireIntParam(c, "id", "User")
+	if !ok {
+		return
+	}
+	adminID, ok := GetAuthUserID(c, "assign roles")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.AssignRoleRequest](c)
+	if !ok {
+		return
+	}
+
+	assignment, err := h.roleService.AssignRole(c.Request.Context(), userID, *req, adminID)
+	if err != nil {
+		respondRoleError(c, err, "assign role")
+		return
+	}
+
+	RespondCreated(c, assignment, "Role assigned")
+}
+
+// RevokeRole godoc
+// @Summary Revoke a role
+// @Description Remove a custom role from a user
+// @Tags admin
+// @Produce json
+// @Param id path int true "User ID"
+// @Param role path string true "Role name"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/users/{id}/roles/{role} [delete]
+func (h *RoleHandler) RevokeRole(c *gin.Context) {
+	userID, ok := RequireIntParam(c, "id", "User")
+	if !ok {
+		return
+	}
+
+	if err := h.roleService.RevokeRole(c.Request.Context(), userID, c.Param("role")); err != nil {
+		respondRoleError(c, err, "revoke role")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Role revoked")
+}
@@ -0,0 +1,202 @@
+// internal/handlers/schedule_handler.go
+package handlers
+
+import (
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// SCHEDULE HANDLERS - Barber working hours, breaks, and days off
+// ========================================================================
+
+// ScheduleHandler handles HTTP requests for barber schedules
+type ScheduleHandler struct {
+	scheduleService *services.ScheduleService
+}
+
+// NewScheduleHandler creates a new schedule handler
+func NewScheduleHandler(scheduleService *services.ScheduleService) *ScheduleHandler {
+	return &ScheduleHandler{
+		scheduleService: scheduleService,
+	}
+}
+
+// respondScheduleError maps schedule errors to HTTP responses.
+// Returns true if an error response was sent.
+func respondScheduleError(c *gin.Context, err error, operation string) bool {
+	if err == nil {
+		return false
+	}
+
+	switch {
+	case err == repository.ErrNotOwner:
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage your own schedule",
+		})
+	case err == repository.ErrBarberNotFound:
+		RespondNotFound(c, "Barber")
+	case err == repository.ErrBarberScheduleNotFound:
+		RespondNotFound(c, "Barber schedule")
+	case err == repository.ErrScheduleExceptionNotFound:
+		RespondNotFound(c, "Schedule exception")
+	case utils.ContainsAny(err.Error(), []string{"required", "must be", "cannot"}):
+		RespondBadRequest(c, "Invalid schedule", err.Error())
+	default:
+		HandleServiceError(c, err, "Barber schedule", operation)
+	}
+	return true
+}
+
+// GetSchedule godoc
+// @Summary Get a barber's schedule
+// @Description Get the weekly working hours, breaks, and exceptions for a barber. Barbers without a schedule accept bookings at any time.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/barbers/{id}/schedule [get]
+func (h *ScheduleHandler) GetSchedule(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	schedule, err := h.scheduleService.GetSchedule(c.Request.Context(), id)
+	if respondScheduleError(c, err, "fetch barber schedule") {
+		return
+	}
+
+	RespondSuccess(c, schedule)
+}
+
+// UpdateSchedule godoc
+// @Summary Replace a barber's weekly schedule
+// @Description Set the timezone and weekly work periods and breaks for a barber. Replaces all existing periods; exceptions are kept. Barbers may only manage their own schedule.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param schedule body services.UpdateScheduleRequest true "Weekly schedule"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/schedule [put]
+func (h *ScheduleHandler) UpdateSchedule(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	req, ok := BindJSON[services.UpdateScheduleRequest](c)
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "update a schedule")
+	if !ok {
+		return
+	}
+
+	schedule, err := h.scheduleService.UpdateSchedule(c.Request.Context(), id, *req, userID, middleware.IsAdmin(c))
+	if respondScheduleError(c, err, "update barber schedule") {
+		return
+	}
+
+	RespondSuccessWithData(c, schedule, "Schedule updated successfully")
+}
+
+// CreateScheduleException godoc
+// @Summary Add a schedule exception
+// @Description Add a vacation, holiday, closure, or custom-hours date range to a barber's schedule. The barber must already have a weekly schedule.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param exception body services.CreateScheduleExceptionRequest true "Exception details"
+// @Success 201 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/schedule/exceptions [post]
+func (h *ScheduleHandler) CreateScheduleException(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	req, ok := BindJSON[services.CreateScheduleExceptionRequest](c)
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "add a schedule exception")
+	if !ok {
+		return
+	}
+
+	exception, err := h.scheduleService.AddException(c.Request.Context(), id, *req, userID, middleware.IsAdmin(c))
+	if respondScheduleError(c, err, "create schedule exception") {
+		return
+	}
+
+	RespondCreated(c, exception, "Schedule exception created successfully")
+}
+
+// DeleteScheduleException godoc
+// @Summary Remove a schedule exception
+// @Description Delete a vacation, holiday, closure, or custom-hours range from a barber's schedule
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param exceptionId path int true "Exception ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/schedule/exceptions/{exceptionId} [delete]
+func (h *ScheduleHandler) DeleteScheduleException(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	exceptionID, ok := RequireIntParam(c, "exceptionId", "schedule exception")
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "remove a schedule exception")
+	if !ok {
+		return
+	}
+
+	err := h.scheduleService.RemoveException(c.Request.Context(), id, exceptionID, userID, middleware.IsAdmin(c))
+	if respondScheduleError(c, err, "delete schedule exception") {
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Schedule exception deleted successfully")
+}
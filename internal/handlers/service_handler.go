@@ -2,9 +2,13 @@
 package handlers
 
 import (
+	"errors"
+	"net/http"
+
 	"barber-booking-system/internal/middleware"
 	"barber-booking-system/internal/repository"
 	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
 
 	"github.com/gin-gonic/gin"
 )
@@ -21,6 +25,21 @@ func NewServiceHandler(serviceService *services.ServiceService) *ServiceHandler
 	}
 }
 
+// respondBarberServiceError maps barber service errors to HTTP responses
+func respondBarberServiceError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrBarberServiceNotFound):
+		RespondNotFound(c, "Barber service")
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		middleware.WriteError(c, http.StatusBadRequest, middleware.ErrorResponse{
+			Error:   "Invalid barber service",
+			Message: err.Error(),
+		})
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
 // ==================== Service Endpoints ====================
 
 // GetAllServices godoc
@@ -39,43 +58,24 @@ func NewServiceHandler(serviceService *services.ServiceService) *ServiceHandler
 // @Param sort_by query string false "Sort by field (name, popularity, rating, duration, complexity)"
 // @Param limit query int false "Number of results" default(20)
 // @Param offset query int false "Offset for pagination" default(0)
-// @Success 200 {object} SuccessResponse
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
+// @Success 200 {object} PaginatedResponse{data=[]models.Service}
+// @Failure 400 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /api/v1/services [get]
 func (h *ServiceHandler) GetAllServices(c *gin.Context) {
-	filters := repository.ServiceFilters{
-		CategoryID:   ParseIntQuery(c, "category_id", 0),
-		ServiceType:  c.Query("service_type"),
-		MinRating:    ParseFloatQuery(c, "min_rating", 0),
-		Complexity:   ParseIntQuery(c, "complexity", 0),
-		TargetGender: c.Query("target_gender"),
-		Search:       c.Query("search"),
-		SortBy:       c.Query("sort_by"),
-		Limit:        ParseIntQuery(c, "limit", 20),
-		Offset:       ParseIntQuery(c, "offset", 0),
-	}
-
-	if activeStr := c.Query("is_active"); activeStr != "" {
-		active := activeStr == "true"
-		filters.IsActive = &active
-	}
-
-	if approvedStr := c.Query("is_approved"); approvedStr != "" {
-		approved := approvedStr == "true"
-		filters.IsApproved = &approved
+	filters, ok := BindFilters[repository.ServiceFilters](c)
+	if !ok {
+		return
 	}
 
-	servicesList, err := h.serviceService.GetAllServices(c.Request.Context(), filters)
+	servicesList, total, err := h.serviceService.GetAllServices(c.Request.Context(), *filters)
 	if err != nil {
 		RespondInternalError(c, "fetch services", err)
 		return
 	}
 
-	RespondSuccessWithMeta(c, servicesList, map[string]interface{}{
-		"count":  len(servicesList),
-		"limit":  filters.Limit,
-		"offset": filters.Offset,
-	})
+	RespondSuccessWithMeta(c, servicesList, PageMeta(len(servicesList), total, filters.Limit, filters.Offset))
 }
 
 // GetService godoc
@@ -85,6 +85,7 @@ func (h *ServiceHandler) GetAllServices(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param id path int true "Service ID"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
 // @Success 200 {object} SuccessResponse
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 404 {object} middleware.ErrorResponse
@@ -110,6 +111,7 @@ func (h *ServiceHandler) GetService(c *gin.Context) {
 // @Accept json
 // @Produce json
 // @Param slug path string true "Service slug"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
 // @Success 200 {object} SuccessResponse
 // @Failure 404 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
@@ -227,6 +229,7 @@ func (h *ServiceHandler) DeleteService(c *gin.Context) {
 // @Produce json
 // @Param q query string true "Search query"
 // @Param category_id query int false "Filter by category"
+// @Param fields query string false "Comma-separated fields to return, e.g. id,name (id is always included)"
 // @Success 200 {object} SuccessResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /api/v1/services/search [get]
@@ -314,14 +317,6 @@ func (h *ServiceHandler) GetCategory(c *gin.Context) {
 // @Failure 400 {object} middleware.ErrorResponse
 // @Failure 500 {object} middleware.ErrorResponse
 // @Router /api/v1/services/categories [post]
-// ========================================================================
-// EXACT CODE TO ADD TO: internal/handlers/service_handler.go
-// ========================================================================
-//
-// Find the CreateCategory function (around line 326)
-// Replace the ENTIRE function with this version:
-// ========================================================================
-
 func (h *ServiceHandler) CreateCategory(c *gin.Context) {
 	req, ok := BindJSON[services.CreateCategoryRequest](c)
 	if !ok {
@@ -483,7 +478,7 @@ func (h *ServiceHandler) AddServiceToBarber(c *gin.Context) {
 
 	barberService, err := h.serviceService.AddServiceToBarber(c.Request.Context(), *req)
 	if err != nil {
-		RespondInternalError(c, "add service to barber", err)
+		respondBarberServiceError(c, err, "add service to barber")
 		return
 	}
 
@@ -515,7 +510,8 @@ func (h *ServiceHandler) UpdateBarberService(c *gin.Context) {
 	}
 
 	barberService, err := h.serviceService.UpdateBarberService(c.Request.Context(), id, *req)
-	if HandleServiceError(c, err, "Barber service", "update barber service") {
+	if err != nil {
+		respondBarberServiceError(c, err, "update barber service")
 		return
 	}
 
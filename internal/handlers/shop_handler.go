@@ -0,0 +1,582 @@
+// internal/handlers/shop_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// SHOP HANDLER - Shops, their barbers, admins, tax rules and bookings
+// ========================================================================
+
+// ShopHandler handles shop requests
+type ShopHandler struct {
+	shopService *services.ShopService
+}
+
+// NewShopHandler creates a new shop handler
+func NewShopHandler(shopService *services.ShopService) *ShopHandler {
+	return &ShopHandler{
+		shopService: shopService,
+	}
+}
+
+// respondShopError maps shop errors to HTTP responses
+func respondShopError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage shops you are an admin of",
+		})
+	case errors.Is(err, repository.ErrShopNotFound):
+		RespondNotFound(c, "Shop")
+	case errors.Is(err, repository.ErrBarberNotFound), errors.Is(err, repository.ErrShopBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case errors.Is(err, repository.ErrUserNotFound):
+		RespondNotFound(c, "User")
+	case errors.Is(err, repository.ErrShopAdminNotFound):
+		RespondNotFound(c, "Shop admin")
+	case errors.Is(err, repository.ErrTaxRuleNotFound):
+		RespondNotFound(c, "Tax rule")
+	case errors.Is(err, repository.ErrShopOffboardingNotFound):
+		RespondNotFound(c, "Shop offboarding")
+	case errors.Is(err, repository.ErrBarberInOtherShop), errors.Is(err, repository.ErrDuplicateShopAdmin),
+		errors.Is(err, repository.ErrOffboardingScheduled):
+		middleware.WriteError(c, http.StatusConflict, middleware.ErrorResponse{
+			Error:   "Conflict",
+			Message: err.Error(),
+		})
+	case utils.ContainsAny(err.Error(), []string{"required", "must", "cannot", "invalid"}):
+		RespondBadRequest(c, "Invalid shop request", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ========================================================================
+// SHOPS
+// ========================================================================
+
+// CreateShop godoc
+// @Summary Create a shop
+// @Description Create a shop several barbers can work at. The creator becomes its owner and can add barbers and admins. Opening hours are the working hours of the shop's barbers who have no schedule of their own.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param shop body services.ShopRequest true "Shop"
+// @Success 201 {object} SuccessResponse{data=models.Shop}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops [post]
+func (h *ShopHandler) CreateShop(c *gin.Context) {
+	req, ok := BindJSON[services.ShopRequest](c)
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "create a shop")
+	if !ok {
+		return
+	}
+
+	shop, err := h.shopService.Create(c.Request.Context(), userID, *req)
+	if err != nil {
+		respondShopError(c, err, "create shop")
+		return
+	}
+
+	RespondCreated(c, shop, "Shop created successfully")
+}
+
+// GetShop godoc
+// @Summary Get a shop
+// @Description Get a shop's details, opening hours and number of barbers
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Success 200 {object} SuccessResponse{data=models.Shop}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/shops/{id} [get]
+func (h *ShopHandler) GetShop(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	shop, err := h.shopService.Get(c.Request.Context(), id)
+	if err != nil {
+		respondShopError(c, err, "fetch shop")
+		return
+	}
+
+	RespondSuccess(c, shop)
+}
+
+// UpdateShop godoc
+// @Summary Update a shop
+// @Description Replace a shop's details and settings. Shop admins only.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Param shop body services.ShopRequest true "Shop"
+// @Success 200 {object} SuccessResponse{data=models.Shop}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id} [put]
+func (h *ShopHandler) UpdateShop(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	req, ok := BindJSON[services.ShopRequest](c)
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "update a shop")
+	if !ok {
+		return
+	}
+
+	shop, err := h.shopService.Update(c.Request.Context(), id, userID, middleware.IsAdmin(c), *req)
+	if err != nil {
+		respondShopError(c, err, "update shop")
+		return
+	}
+
+	RespondSuccess(c, shop)
+}
+
+// ========================================================================
+// BARBERS
+// ========================================================================
+
+// ListShopBarbers godoc
+// @Summary List a shop's barbers
+// @Description List the barbers working at a shop
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Success 200 {object} SuccessResponse{data=[]models.Barber}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/shops/{id}/barbers [get]
+func (h *ShopHandler) ListShopBarbers(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	barbers, err := h.shopService.ListBarbers(c.Request.Context(), id)
+	if err != nil {
+		respondShopError(c, err, "list shop barbers")
+		return
+	}
+
+	RespondSuccess(c, barbers)
+}
+
+// AddShopBarber godoc
+// @Summary Add a barber to a shop
+// @Description Add a barber who does not work at another shop. Shop admins only.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Param barber body services.AddShopBarberRequest true "Barber"
+// @Success 201 {object} SuccessResponse{data=models.Barber}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/barbers [post]
+func (h *ShopHandler) AddShopBarber(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	req, ok := BindJSON[services.AddShopBarberRequest](c)
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "add a barber")
+	if !ok {
+		return
+	}
+
+	barber, err := h.shopService.AddBarber(c.Request.Context(), id, userID, middleware.IsAdmin(c), *req)
+	if err != nil {
+		respondShopError(c, err, "add shop barber")
+		return
+	}
+
+	RespondCreated(c, barber, "Barber added to shop successfully")
+}
+
+// RemoveShopBarber godoc
+// @Summary Remove a barber from a shop
+// @Description Make one of the shop's barbers standalone again. Shop admins may remove any barber; barbers may leave.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Param barberId path int true "Barber ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/barbers/{barberId} [delete]
+func (h *ShopHandler) RemoveShopBarber(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	barberID, ok := RequireIntParam(c, "barberId", "barber")
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "remove a barber")
+	if !ok {
+		return
+	}
+
+	if err := h.shopService.RemoveBarber(c.Request.Context(), id, barberID, userID, middleware.IsAdmin(c)); err != nil {
+		respondShopError(c, err, "remove shop barber")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Barber removed from shop successfully")
+}
+
+// ========================================================================
+// ADMINS
+// ========================================================================
+
+// ListShopAdmins godoc
+// @Summary List a shop's admins
+// @Description List the users who manage a shop, owner first. Shop admins only.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Success 200 {object} SuccessResponse{data=[]models.ShopAdmin}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/admins [get]
+func (h *ShopHandler) ListShopAdmins(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "view shop admins")
+	if !ok {
+		return
+	}
+
+	admins, err := h.shopService.ListAdmins(c.Request.Context(), id, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondShopError(c, err, "list shop admins")
+		return
+	}
+
+	RespondSuccess(c, admins)
+}
+
+// AddShopAdmin godoc
+// @Summary Add a shop admin
+// @Description Let a user manage the shop's details, barbers, tax rules and bookings. Shop owner only.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Param admin body services.AddShopAdminRequest true "Admin"
+// @Success 201 {object} SuccessResponse{data=models.ShopAdmin}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/admins [post]
+func (h *ShopHandler) AddShopAdmin(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	req, ok := BindJSON[services.AddShopAdminRequest](c)
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "add a shop admin")
+	if !ok {
+		return
+	}
+
+	admin, err := h.shopService.AddAdmin(c.Request.Context(), id, userID, middleware.IsAdmin(c), *req)
+	if err != nil {
+		respondShopError(c, err, "add shop admin")
+		return
+	}
+
+	RespondCreated(c, admin, "Shop admin added successfully")
+}
+
+// RemoveShopAdmin godoc
+// @Summary Remove a shop admin
+// @Description Stop a user managing the shop. The owner cannot be removed. Shop owner only.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Param userId path int true "User ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/admins/{userId} [delete]
+func (h *ShopHandler) RemoveShopAdmin(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	adminUserID, ok := RequireIntParam(c, "userId", "user")
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "remove a shop admin")
+	if !ok {
+		return
+	}
+
+	if err := h.shopService.RemoveAdmin(c.Request.Context(), id, adminUserID, userID, middleware.IsAdmin(c)); err != nil {
+		respondShopError(c, err, "remove shop admin")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Shop admin removed successfully")
+}
+
+// ========================================================================
+// TAX RULES
+// ========================================================================
+
+// ListShopTaxRules godoc
+// @Summary List a shop's tax rules
+// @Description List the tax rules for the shop's barbers. Shop admins only.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Success 200 {object} SuccessResponse{data=[]models.TaxRule}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/tax-rules [get]
+func (h *ShopHandler) ListShopTaxRules(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "view shop tax rules")
+	if !ok {
+		return
+	}
+
+	rules, err := h.shopService.ListTaxRules(c.Request.Context(), id, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondShopError(c, err, "list shop tax rules")
+		return
+	}
+
+	RespondSuccess(c, rules)
+}
+
+// CreateShopTaxRule godoc
+// @Summary Add a shop tax rule
+// @Description Add a tax charged on bookings with the shop's barbers. Shop rules apply ahead of regional and global ones; a barber's own rules still come first. barber_id, country and state must be left empty. Shop admins only.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Param rule body services.TaxRuleRequest true "Tax rule"
+// @Success 201 {object} SuccessResponse{data=models.TaxRule}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/tax-rules [post]
+func (h *ShopHandler) CreateShopTaxRule(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	req, ok := BindJSON[services.TaxRuleRequest](c)
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "add a shop tax rule")
+	if !ok {
+		return
+	}
+
+	rule, err := h.shopService.CreateTaxRule(c.Request.Context(), id, userID, middleware.IsAdmin(c), *req)
+	if err != nil {
+		respondShopError(c, err, "create shop tax rule")
+		return
+	}
+
+	RespondCreated(c, rule, "Tax rule created successfully")
+}
+
+// DeleteShopTaxRule godoc
+// @Summary Delete a shop tax rule
+// @Description Remove one of the shop's tax rules. Bookings already priced keep their taxes. Shop admins only.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Param ruleId path int true "Tax rule ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/tax-rules/{ruleId} [delete]
+func (h *ShopHandler) DeleteShopTaxRule(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	ruleID, ok := RequireIntParam(c, "ruleId", "tax rule")
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "delete a shop tax rule")
+	if !ok {
+		return
+	}
+
+	if err := h.shopService.DeleteTaxRule(c.Request.Context(), id, ruleID, userID, middleware.IsAdmin(c)); err != nil {
+		respondShopError(c, err, "delete shop tax rule")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Tax rule deleted successfully")
+}
+
+// ========================================================================
+// BOOKINGS
+// ========================================================================
+
+// GetShopBookings godoc
+// @Summary Get a shop's bookings
+// @Description Get bookings with any of the shop's barbers, soonest first by default. Shop admins only.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Param barber_id query int false "Filter by barber"
+// @Param status query string false "Filter by status"
+// @Param payment_status query string false "Filter by payment status"
+// @Param start_date_from query string false "Filter by start date from (YYYY-MM-DD)"
+// @Param start_date_to query string false "Filter by start date to (YYYY-MM-DD)"
+// @Param sort_by query string false "Sort by field (scheduled_start_time, total_price, created_at, status, payment_status, customer_name, booking_number)" default(scheduled_start_time)
+// @Param order query string false "Sort order (ASC/DESC)" default(ASC)
+// @Param limit query int false "Limit results" default(50)
+// @Param offset query int false "Offset for pagination" default(0)
+// @Success 200 {object} PaginatedResponse{data=[]services.BookingResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/bookings [get]
+func (h *ShopHandler) GetShopBookings(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	filters, ok := BindFilters[repository.BookingFilters](c)
+	if !ok {
+		return
+	}
+	if c.Query("sort_by") == "" {
+		filters.SortBy = "scheduled_start_time"
+		filters.Order = "ASC"
+	}
+
+	userID, ok := GetAuthUserID(c, "view shop bookings")
+	if !ok {
+		return
+	}
+
+	bookings, total, err := h.shopService.GetBookings(c.Request.Context(), id, userID, middleware.IsAdmin(c), *filters)
+	if err != nil {
+		respondShopError(c, err, "fetch shop bookings")
+		return
+	}
+
+	meta := PageMeta(len(bookings), total, filters.Limit, filters.Offset)
+	meta["shop_id"] = id
+	RespondSuccessWithMeta(c, bookings, meta)
+}
@@ -0,0 +1,178 @@
+// internal/handlers/shop_offboarding_handler.go
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// SHOP OFFBOARDING HANDLER - Shop data exports and leaving the platform
+// ========================================================================
+
+// ShopOffboardingHandler handles shop export and offboarding requests
+type ShopOffboardingHandler struct {
+	offboardingService *services.ShopOffboardingService
+}
+
+// NewShopOffboardingHandler creates a new shop offboarding handler
+func NewShopOffboardingHandler(offboardingService *services.ShopOffboardingService) *ShopOffboardingHandler {
+	return &ShopOffboardingHandler{
+		offboardingService: offboardingService,
+	}
+}
+
+// ExportShop godoc
+// @Summary Export a shop's data
+// @Description Zip archive of the shop's data in portable formats: shop.json (details, barbers, tax rules), bookings.csv (live and archived, without customer contact details), customers.csv (the barbers' client lists; an email or phone only where the client consented to that channel), reviews.csv, financial_events.csv (ledger export format) and manifest.json. Shop owner only.
+// @Tags shops
+// @Produce application/zip
+// @Param id path int true "Shop ID"
+// @Success 200 {file} file
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/export [get]
+func (h *ShopOffboardingHandler) ExportShop(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "export a shop")
+	if !ok {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := h.offboardingService.Export(c.Request.Context(), id, userID, middleware.IsAdmin(c), &buf); err != nil {
+		respondShopError(c, err, "export shop")
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="shop-%d-export.zip"`, id))
+	c.Data(http.StatusOK, "application/zip", buf.Bytes())
+}
+
+// ScheduleShopOffboarding godoc
+// @Summary Leave the platform
+// @Description Schedule the deletion of the shop's data once the retention period (SHOP_DATA_RETENTION, 30 days by default) has passed. Until then the shop keeps working and can export its data or cancel. Deletion waits for upcoming bookings to finish; it then erases customers' personal data from the shop's bookings, deletes its client list, reviews and survey answers, deactivates its barbers and removes the shop. Anonymised bookings and financial events are kept. Shop owner only.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Param request body services.ShopOffboardingRequest false "Reason"
+// @Success 201 {object} SuccessResponse{data=models.ShopOffboarding}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse "Already scheduled"
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/offboarding [post]
+func (h *ShopOffboardingHandler) ScheduleShopOffboarding(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	var req services.ShopOffboardingRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			RespondValidationError(c, err)
+			return
+		}
+	}
+
+	userID, ok := GetAuthUserID(c, "schedule a shop offboarding")
+	if !ok {
+		return
+	}
+
+	offboarding, err := h.offboardingService.Schedule(c.Request.Context(), id, userID, middleware.IsAdmin(c), req)
+	if err != nil {
+		respondShopError(c, err, "schedule shop offboarding")
+		return
+	}
+
+	RespondCreated(c, offboarding, "Shop offboarding scheduled successfully")
+}
+
+// GetShopOffboarding godoc
+// @Summary Get a shop's offboarding
+// @Description Get the shop's most recent offboarding: its status, deletion date and, once completed, how many rows were deleted or anonymised per table. Shop admins only.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Success 200 {object} SuccessResponse{data=models.ShopOffboarding}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/offboarding [get]
+func (h *ShopOffboardingHandler) GetShopOffboarding(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "view a shop offboarding")
+	if !ok {
+		return
+	}
+
+	offboarding, err := h.offboardingService.Get(c.Request.Context(), id, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondShopError(c, err, "fetch shop offboarding")
+		return
+	}
+
+	RespondSuccess(c, offboarding)
+}
+
+// CancelShopOffboarding godoc
+// @Summary Cancel leaving the platform
+// @Description Keep the shop on the platform. Only before the deletion date. Shop owner only.
+// @Tags shops
+// @Accept json
+// @Produce json
+// @Param id path int true "Shop ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/shops/{id}/offboarding [delete]
+func (h *ShopOffboardingHandler) CancelShopOffboarding(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "shop")
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "cancel a shop offboarding")
+	if !ok {
+		return
+	}
+
+	if err := h.offboardingService.Cancel(c.Request.Context(), id, userID, middleware.IsAdmin(c)); err != nil {
+		respondShopError(c, err, "cancel shop offboarding")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Shop offboarding cancelled successfully")
+}
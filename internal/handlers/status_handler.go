@@ -0,0 +1,130 @@
+// internal/handlers/status_handler.go
+package handlers
+
+import (
+	"errors"
+
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// STATUS HANDLER - Public status page feed and incidents
+// ========================================================================
+
+// statusCacheControl lets status page clients and CDNs reuse a response briefly
+const statusCacheControl = "public, max-age=30"
+
+// StatusHandler serves the status page
+type StatusHandler struct {
+	statusService *services.StatusService
+}
+
+// NewStatusHandler creates a new status handler
+func NewStatusHandler(statusService *services.StatusService) *StatusHandler {
+	return &StatusHandler{
+		statusService: statusService,
+	}
+}
+
+// respondStatusError maps status page errors to HTTP responses
+func respondStatusError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrIncidentNotFound):
+		RespondNotFound(c, "Incident")
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		RespondBadRequest(c, "Invalid incident", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// GetStatus godoc
+// @Summary Platform status
+// @Description Machine-readable feed for a public status page: the overall status, each component's (api, payments when enabled, notifications) current status and daily history with uptime, and incidents ongoing or resolved within the history window. Statuses are operational, degraded or major_outage. Components are checked every minute from health checks and the payment and notification providers' circuit breakers, which open incidents automatically; staff can post incidents too. Responses may be cached for 30 seconds.
+// @Tags status
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=services.StatusPage}
+// @Failure 500 {object} middleware.ErrorResponse
+// @Router /api/v1/status [get]
+func (h *StatusHandler) GetStatus(c *gin.Context) {
+	page, err := h.statusService.GetStatusPage(c.Request.Context())
+	if err != nil {
+		RespondInternalError(c, "fetch status", err)
+		return
+	}
+
+	c.Header("Cache-Control", statusCacheControl)
+	RespondSuccess(c, page)
+}
+
+// CreateIncident godoc
+// @Summary Post an incident
+// @Description Post an incident to the status page for a component (api, payments or notifications) with impact degraded or major_outage. It counts against the component's uptime until resolved.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body services.CreateIncidentRequest true "Incident"
+// @Success 201 {object} SuccessResponse{data=models.StatusIncident}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/status/incidents [post]
+func (h *StatusHandler) CreateIncident(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "post incident")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.CreateIncidentRequest](c)
+	if !ok {
+		return
+	}
+
+	incident, err := h.statusService.CreateIncident(c.Request.Context(), *req, userID)
+	if err != nil {
+		respondStatusError(c, err, "post incident")
+		return
+	}
+
+	RespondCreated(c, incident, "Incident posted")
+}
+
+// UpdateIncident godoc
+// @Summary Update an incident
+// @Description Change an incident's impact, title or message, or resolve it (resolved=true) or reopen it (resolved=false). Automatic incidents can be edited too; the next health check still resolves them when the component recovers.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Incident ID"
+// @Param request body services.UpdateIncidentRequest true "Fields to change"
+// @Success 200 {object} SuccessResponse{data=models.StatusIncident}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/status/incidents/{id} [patch]
+func (h *StatusHandler) UpdateIncident(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "incident")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.UpdateIncidentRequest](c)
+	if !ok {
+		return
+	}
+
+	incident, err := h.statusService.UpdateIncident(c.Request.Context(), id, *req)
+	if err != nil {
+		respondStatusError(c, err, "update incident")
+		return
+	}
+
+	RespondSuccessWithData(c, incident, "Incident updated")
+}
@@ -0,0 +1,368 @@
+// internal/handlers/support_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// SUPPORT HANDLER - Support tickets for requesters and staff
+// ========================================================================
+
+// SupportHandler handles support ticket requests
+type SupportHandler struct {
+	supportService *services.SupportService
+}
+
+// NewSupportHandler creates a new support handler
+func NewSupportHandler(supportService *services.SupportService) *SupportHandler {
+	return &SupportHandler{
+		supportService: supportService,
+	}
+}
+
+// respondSupportError maps support ticket errors to HTTP responses
+func respondSupportError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only access your own tickets and bookings",
+		})
+	case errors.Is(err, repository.ErrSupportTicketNotFound):
+		RespondNotFound(c, "Support ticket")
+	case errors.Is(err, repository.ErrBookingNotFound):
+		RespondNotFound(c, "Booking")
+	case utils.ContainsAny(err.Error(), []string{"not found", "must", "cannot"}):
+		RespondBadRequest(c, "Invalid support ticket", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ========================================================================
+// REQUESTER ENDPOINTS
+// ========================================================================
+
+// OpenTicket godoc
+// @Summary Open a support ticket
+// @Description Ask support for help, optionally about one of your bookings (booking_id) or its payment. Payment tickets start at high priority, everything else at normal; the priority sets when support must first reply and resolve the ticket. At most 10 unresolved tickets at a time.
+// @Tags support
+// @Accept json
+// @Produce json
+// @Param ticket body services.CreateSupportTicketRequest true "Ticket"
+// @Success 201 {object} SuccessResponse{data=services.SupportTicketResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/support/tickets [post]
+func (h *SupportHandler) OpenTicket(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "open a support ticket")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.CreateSupportTicketRequest](c)
+	if !ok {
+		return
+	}
+
+	ticket, err := h.supportService.OpenTicket(c.Request.Context(), userID, *req)
+	if err != nil {
+		respondSupportError(c, err, "open support ticket")
+		return
+	}
+
+	RespondCreated(c, ticket, "Support ticket opened")
+}
+
+// ListMyTickets godoc
+// @Summary List my support tickets
+// @Description The tickets you opened, newest first.
+// @Tags support
+// @Produce json
+// @Param status query string false "open, in_progress, waiting_on_customer, resolved or closed"
+// @Param priority query string false "low, normal, high or urgent"
+// @Param category query string false "booking, payment, account or other"
+// @Param limit query int false "Page size" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} SuccessResponse{data=[]models.SupportTicket}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/support/tickets [get]
+func (h *SupportHandler) ListMyTickets(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "list support tickets")
+	if !ok {
+		return
+	}
+	req, ok := BindQuery[services.SupportTicketListRequest](c)
+	if !ok {
+		return
+	}
+
+	tickets, err := h.supportService.ListMine(c.Request.Context(), userID, req)
+	if err != nil {
+		respondSupportError(c, err, "fetch support tickets")
+		return
+	}
+
+	RespondSuccessWithMeta(c, tickets, PaginationMeta(len(tickets), req.Limit, req.Offset))
+}
+
+// GetMyTicket godoc
+// @Summary Get one of my support tickets
+// @Description A ticket you opened with its conversation and where it stands against its SLA.
+// @Tags support
+// @Produce json
+// @Param id path int true "Ticket ID"
+// @Success 200 {object} SuccessResponse{data=services.SupportTicketResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/support/tickets/{id} [get]
+func (h *SupportHandler) GetMyTicket(c *gin.Context) {
+	ticketID, ok := RequireIntParam(c, "id", "support ticket")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "view a support ticket")
+	if !ok {
+		return
+	}
+
+	ticket, err := h.supportService.GetMine(c.Request.Context(), ticketID, userID)
+	if err != nil {
+		respondSupportError(c, err, "fetch support ticket")
+		return
+	}
+
+	RespondSuccess(c, ticket)
+}
+
+// ReplyToMyTicket godoc
+// @Summary Reply to one of my support tickets
+// @Description Add a message to a ticket you opened. Replying to a ticket waiting on you hands it back to support; replying to a resolved ticket reopens it. Closed tickets cannot be replied to. is_internal and status are ignored.
+// @Tags support
+// @Accept json
+// @Produce json
+// @Param id path int true "Ticket ID"
+// @Param reply body services.SupportReplyRequest true "Reply"
+// @Success 201 {object} SuccessResponse{data=models.SupportTicketMessage}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/support/tickets/{id}/messages [post]
+func (h *SupportHandler) ReplyToMyTicket(c *gin.Context) {
+	ticketID, ok := RequireIntParam(c, "id", "support ticket")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "reply to a support ticket")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.SupportReplyRequest](c)
+	if !ok {
+		return
+	}
+
+	message, err := h.supportService.Reply(c.Request.Context(), ticketID, userID, *req)
+	if err != nil {
+		respondSupportError(c, err, "reply to support ticket")
+		return
+	}
+
+	RespondCreated(c, message, "Reply added")
+}
+
+// CloseMyTicket godoc
+// @Summary Close one of my support tickets
+// @Description Close a ticket you opened once you no longer need help.
+// @Tags support
+// @Produce json
+// @Param id path int true "Ticket ID"
+// @Success 200 {object} SuccessResponse{data=services.SupportTicketResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/support/tickets/{id}/close [post]
+func (h *SupportHandler) CloseMyTicket(c *gin.Context) {
+	ticketID, ok := RequireIntParam(c, "id", "support ticket")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "close a support ticket")
+	if !ok {
+		return
+	}
+
+	ticket, err := h.supportService.Close(c.Request.Context(), ticketID, userID)
+	if err != nil {
+		respondSupportError(c, err, "close support ticket")
+		return
+	}
+
+	RespondSuccess(c, ticket)
+}
+
+// ========================================================================
+// STAFF ENDPOINTS
+// ========================================================================
+
+// ListTickets godoc
+// @Summary List support tickets
+// @Description Every ticket, soonest resolution due first. breached=true lists tickets that missed their first response or resolution target. Requires support:manage.
+// @Tags admin
+// @Produce json
+// @Param status query string false "open, in_progress, waiting_on_customer, resolved or closed"
+// @Param priority query string false "low, normal, high or urgent"
+// @Param category query string false "booking, payment, account or other"
+// @Param assigned_to query int false "Assignee user ID"
+// @Param breached query bool false "Only tickets that missed an SLA target"
+// @Param limit query int false "Page size" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} SuccessResponse{data=[]models.SupportTicket}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/support/tickets [get]
+func (h *SupportHandler) ListTickets(c *gin.Context) {
+	req, ok := BindQuery[services.SupportTicketListRequest](c)
+	if !ok {
+		return
+	}
+
+	tickets, err := h.supportService.List(c.Request.Context(), req)
+	if err != nil {
+		respondSupportError(c, err, "fetch support tickets")
+		return
+	}
+
+	RespondSuccessWithMeta(c, tickets, PaginationMeta(len(tickets), req.Limit, req.Offset))
+}
+
+// GetTicket godoc
+// @Summary Get a support ticket
+// @Description A ticket with its whole conversation, including internal notes. Requires support:manage.
+// @Tags admin
+// @Produce json
+// @Param id path int true "Ticket ID"
+// @Success 200 {object} SuccessResponse{data=services.SupportTicketResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/support/tickets/{id} [get]
+func (h *SupportHandler) GetTicket(c *gin.Context) {
+	ticketID, ok := RequireIntParam(c, "id", "support ticket")
+	if !ok {
+		return
+	}
+
+	ticket, err := h.supportService.Get(c.Request.Context(), ticketID)
+	if err != nil {
+		respondSupportError(c, err, "fetch support ticket")
+		return
+	}
+
+	RespondSuccess(c, ticket)
+}
+
+// UpdateTicket godoc
+// @Summary Update a support ticket
+// @Description Change a ticket's status, priority or assignee. Changing the priority recalculates its SLA due dates; waiting_on_customer pauses the resolution timer. The assignee must be an active user holding support:manage (0 unassigns) and is notified. Requires support:manage.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Ticket ID"
+// @Param ticket body services.UpdateSupportTicketRequest true "Changes"
+// @Success 200 {object} SuccessResponse{data=services.SupportTicketResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/support/tickets/{id} [patch]
+func (h *SupportHandler) UpdateTicket(c *gin.Context) {
+	ticketID, ok := RequireIntParam(c, "id", "support ticket")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.UpdateSupportTicketRequest](c)
+	if !ok {
+		return
+	}
+
+	ticket, err := h.supportService.Update(c.Request.Context(), ticketID, *req)
+	if err != nil {
+		respondSupportError(c, err, "update support ticket")
+		return
+	}
+
+	RespondSuccess(c, ticket)
+}
+
+// ReplyToTicket godoc
+// @Summary Reply to a support ticket
+// @Description Answer the requester or, with is_internal, leave a note only staff see. The first answer stops the first response timer and moves an open ticket to in_progress unless status says otherwise. The requester is notified of answers. Requires support:manage.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Ticket ID"
+// @Param reply body services.SupportReplyRequest true "Reply"
+// @Success 201 {object} SuccessResponse{data=models.SupportTicketMessage}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/support/tickets/{id}/messages [post]
+func (h *SupportHandler) ReplyToTicket(c *gin.Context) {
+	ticketID, ok := RequireIntParam(c, "id", "support ticket")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "reply to a support ticket")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.SupportReplyRequest](c)
+	if !ok {
+		return
+	}
+
+	message, err := h.supportService.StaffReply(c.Request.Context(), ticketID, userID, *req)
+	if err != nil {
+		respondSupportError(c, err, "reply to support ticket")
+		return
+	}
+
+	RespondCreated(c, message, "Reply added")
+}
@@ -0,0 +1,166 @@
+// internal/handlers/suppression_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// SUPPRESSION HANDLER - Email/SMS suppression list administration
+// ========================================================================
+
+// SuppressionHandler handles suppression list requests
+type SuppressionHandler struct {
+	suppressionService *services.SuppressionService
+}
+
+// NewSuppressionHandler creates a new suppression handler
+func NewSuppressionHandler(suppressionService *services.SuppressionService) *SuppressionHandler {
+	return &SuppressionHandler{
+		suppressionService: suppressionService,
+	}
+}
+
+// respondSuppressionError maps suppression errors to HTTP responses
+func respondSuppressionError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrSuppressionNotFound):
+		RespondNotFound(c, "Suppression")
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		middleware.WriteError(c, http.StatusBadRequest, middleware.ErrorResponse{
+			Error:   "Invalid suppression",
+			Message: err.Error(),
+		})
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ListSuppressions godoc
+// @Summary List suppressed addresses
+// @Description Email addresses and phone numbers that notifications are not sent to, newest first. Entries come from hard bounces, spam complaints and opt-outs reported by providers, or are added by admins.
+// @Tags admin
+// @Produce json
+// @Param channel query string false "Filter by channel (email, sms)"
+// @Param reason query string false "Filter by reason (hard_bounce, spam_complaint, opt_out, manual)"
+// @Param address query string false "Filter by part of the address"
+// @Param limit query int false "Limit results" default(20)
+// @Param offset query int false "Offset for pagination" default(0)
+// @Success 200 {object} SuccessResponse{data=[]models.Suppression}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/suppressions [get]
+func (h *SuppressionHandler) ListSuppressions(c *gin.Context) {
+	req, ok := BindQuery[services.SuppressionListRequest](c)
+	if !ok {
+		return
+	}
+
+	suppressions, err := h.suppressionService.List(c.Request.Context(), req)
+	if err != nil {
+		RespondInternalError(c, "fetch suppressions", err)
+		return
+	}
+
+	RespondSuccessWithMeta(c, suppressions, PaginationMeta(len(suppressions), req.Limit, req.Offset))
+}
+
+// GetSuppression godoc
+// @Summary Get a suppressed address
+// @Tags admin
+// @Produce json
+// @Param id path int true "Suppression ID"
+// @Success 200 {object} SuccessResponse{data=models.Suppression}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/suppressions/{id} [get]
+func (h *SuppressionHandler) GetSuppression(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "Suppression")
+	if !ok {
+		return
+	}
+
+	suppression, err := h.suppressionService.Get(c.Request.Context(), id)
+	if err != nil {
+		respondSuppressionError(c, err, "fetch suppression")
+		return
+	}
+
+	RespondSuccess(c, suppression)
+}
+
+// CreateSuppression godoc
+// @Summary Suppress an address
+// @Description Stop sending email or SMS to an address. An address that is already suppressed takes the new reason.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body services.CreateSuppressionRequest true "Address to suppress"
+// @Success 201 {object} SuccessResponse{data=models.Suppression}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/suppressions [post]
+func (h *SuppressionHandler) CreateSuppression(c *gin.Context) {
+	adminID, ok := GetAuthUserID(c, "suppress an address")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.CreateSuppressionRequest](c)
+	if !ok {
+		return
+	}
+
+	suppression, err := h.suppressionService.Create(c.Request.Context(), adminID, req)
+	if err != nil {
+		respondSuppressionError(c, err, "suppress address")
+		return
+	}
+
+	RespondCreated(c, suppression, "Address suppressed")
+}
+
+// DeleteSuppression godoc
+// @Summary Lift a suppression
+// @Description Remove an address from the suppression list so it is sent to again. A later bounce, complaint or opt-out suppresses it again.
+// @Tags admin
+// @Produce json
+// @Param id path int true "Suppression ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/suppressions/{id} [delete]
+func (h *SuppressionHandler) DeleteSuppression(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "Suppression")
+	if !ok {
+		return
+	}
+
+	if err := h.suppressionService.Delete(c.Request.Context(), id); err != nil {
+		respondSuppressionError(c, err, "lift suppression")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Suppression lifted")
+}
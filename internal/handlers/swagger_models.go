@@ -149,6 +149,7 @@ type NotificationCreateRequest struct {
 	RelatedEntityID   *int                   `json:"related_entity_id,omitempty" example:"456"`
 	Data              map[string]interface{} `json:"data,omitempty"`
 	ScheduledFor      *time.Time             `json:"scheduled_for,omitempty"`
+	ScheduledLocal    string                 `json:"scheduled_local,omitempty" example:"2025-03-10T09:00"`
 	ExpiresAt         *time.Time             `json:"expires_at,omitempty"`
 }
 
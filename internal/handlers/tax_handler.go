@@ -0,0 +1,197 @@
+// internal/handlers/tax_handler.go
+package handlers
+
+import (
+	"errors"
+
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// TAX HANDLER - Tax rules and tax reports
+// ========================================================================
+
+// TaxHandler handles tax rule and tax report requests
+type TaxHandler struct {
+	taxService *services.TaxService
+}
+
+// NewTaxHandler creates a new tax handler
+func NewTaxHandler(taxService *services.TaxService) *TaxHandler {
+	return &TaxHandler{
+		taxService: taxService,
+	}
+}
+
+// respondTaxError maps tax errors to HTTP responses
+func respondTaxError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrTaxRuleNotFound):
+		RespondNotFound(c, "Tax rule")
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		RespondBadRequest(c, "Invalid tax request", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ========================================================================
+// TAX RULES
+// ========================================================================
+
+// ListTaxRules godoc
+// @Summary List tax rules
+// @Description Every tax rule, most specific first. A booking is taxed by the active rules at the most specific scope matching its barber: the barber's own, else their state's, else their country's, else the global ones.
+// @Tags admin
+// @Produce json
+// @Success 200 {object} SuccessResponse{data=[]models.TaxRule}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/tax-rules [get]
+func (h *TaxHandler) ListTaxRules(c *gin.Context) {
+	rules, err := h.taxService.ListRules(c.Request.Context())
+	if err != nil {
+		RespondInternalError(c, "fetch tax rules", err)
+		return
+	}
+
+	RespondSuccessWithMeta(c, rules, map[string]interface{}{
+		"count": len(rules),
+	})
+}
+
+// CreateTaxRule godoc
+// @Summary Create a tax rule
+// @Description Add a tax for one barber (barber_id), a region (country, optionally state) or everywhere. Inclusive taxes are already in service prices; exclusive ones are added on top. Applies to bookings made from now on.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body services.TaxRuleRequest true "Tax rule"
+// @Success 201 {object} SuccessResponse{data=models.TaxRule}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/tax-rules [post]
+func (h *TaxHandler) CreateTaxRule(c *gin.Context) {
+	req, ok := BindJSON[services.TaxRuleRequest](c)
+	if !ok {
+		return
+	}
+
+	rule, err := h.taxService.CreateRule(c.Request.Context(), *req)
+	if err != nil {
+		respondTaxError(c, err, "create tax rule")
+		return
+	}
+
+	RespondCreated(c, rule, "Tax rule created")
+}
+
+// UpdateTaxRule godoc
+// @Summary Update a tax rule
+// @Description Replace a tax rule. Bookings already made keep the taxes they were charged.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Tax rule ID"
+// @Param request body services.TaxRuleRequest true "Tax rule"
+// @Success 200 {object} SuccessResponse{data=models.TaxRule}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/tax-rules/{id} [put]
+func (h *TaxHandler) UpdateTaxRule(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "Tax rule")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.TaxRuleRequest](c)
+	if !ok {
+		return
+	}
+
+	rule, err := h.taxService.UpdateRule(c.Request.Context(), id, *req)
+	if err != nil {
+		respondTaxError(c, err, "update tax rule")
+		return
+	}
+
+	RespondSuccessWithData(c, rule, "Tax rule updated")
+}
+
+// DeleteTaxRule godoc
+// @Summary Delete a tax rule
+// @Description Remove a tax rule. Bookings already made keep the taxes they were charged.
+// @Tags admin
+// @Produce json
+// @Param id path int true "Tax rule ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/tax-rules/{id} [delete]
+func (h *TaxHandler) DeleteTaxRule(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "Tax rule")
+	if !ok {
+		return
+	}
+
+	if err := h.taxService.DeleteRule(c.Request.Context(), id); err != nil {
+		respondTaxError(c, err, "delete tax rule")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Tax rule deleted")
+}
+
+// ========================================================================
+// REPORTS
+// ========================================================================
+
+// GetTaxReport godoc
+// @Summary Get a tax report
+// @Description Taxes charged on completed bookings over a date range (UTC days, inclusive; default the last 30 days), by tax, for every barber or one
+// @Tags admin
+// @Produce json
+// @Param from query string false "First day (YYYY-MM-DD)"
+// @Param to query string false "Last day (YYYY-MM-DD)"
+// @Param barber_id query int false "Barber ID"
+// @Success 200 {object} SuccessResponse{data=models.TaxReport}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/tax-report [get]
+func (h *TaxHandler) GetTaxReport(c *gin.Context) {
+	query, ok := BindQuery[services.TaxReportQuery](c)
+	if !ok {
+		return
+	}
+
+	report, err := h.taxService.GetReport(c.Request.Context(), *query)
+	if err != nil {
+		respondTaxError(c, err, "fetch tax report")
+		return
+	}
+
+	RespondSuccess(c, report)
+}
@@ -0,0 +1,165 @@
+// internal/handlers/time_off_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// TIME OFF HANDLER - Vacations and other periods barbers are away
+// ========================================================================
+
+// TimeOffHandler handles barber time off requests
+type TimeOffHandler struct {
+	timeOffService *services.TimeOffService
+}
+
+// NewTimeOffHandler creates a new time off handler
+func NewTimeOffHandler(timeOffService *services.TimeOffService) *TimeOffHandler {
+	return &TimeOffHandler{
+		timeOffService: timeOffService,
+	}
+}
+
+// respondTimeOffError maps time off errors to HTTP responses
+func respondTimeOffError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage your own time off",
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case errors.Is(err, repository.ErrTimeOffNotFound):
+		RespondNotFound(c, "Time off")
+	case utils.ContainsAny(err.Error(), []string{"required", "must", "cannot", "either"}):
+		RespondBadRequest(c, "Invalid time off", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ListTimeOff godoc
+// @Summary List a barber's time off
+// @Description List the barber's time off that has not ended yet, in start order. Barbers may only see their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Success 200 {object} SuccessResponse{data=[]models.TimeOff}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/time-off [get]
+func (h *TimeOffHandler) ListTimeOff(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "view time off")
+	if !ok {
+		return
+	}
+
+	timeOff, err := h.timeOffService.List(c.Request.Context(), id, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondTimeOffError(c, err, "list time off")
+		return
+	}
+
+	RespondSuccess(c, timeOff)
+}
+
+// CreateTimeOff godoc
+// @Summary Block out time off
+// @Description Block out whole dates in the barber's timezone (start_date, end_date inclusive) or an exact range (start_at, end_at), up to 90 days. Slot listing and new bookings skip the blocked time. Active bookings inside it are kept and returned; with offer_reschedule their customers are notified with the barber's next open slots. Barbers may only manage their own time off.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param timeOff body services.CreateTimeOffRequest true "Time off"
+// @Success 201 {object} SuccessResponse{data=services.TimeOffResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/time-off [post]
+func (h *TimeOffHandler) CreateTimeOff(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	req, ok := BindJSON[services.CreateTimeOffRequest](c)
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "add time off")
+	if !ok {
+		return
+	}
+
+	timeOff, err := h.timeOffService.Create(c.Request.Context(), id, userID, middleware.IsAdmin(c), *req)
+	if err != nil {
+		respondTimeOffError(c, err, "create time off")
+		return
+	}
+
+	RespondCreated(c, timeOff, "Time off added successfully")
+}
+
+// DeleteTimeOff godoc
+// @Summary Remove time off
+// @Description Delete one of the barber's time off blocks, opening the time for bookings again
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param timeOffId path int true "Time off ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/time-off/{timeOffId} [delete]
+func (h *TimeOffHandler) DeleteTimeOff(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+
+	timeOffID, ok := RequireIntParam(c, "timeOffId", "time off")
+	if !ok {
+		return
+	}
+
+	userID, ok := GetAuthUserID(c, "remove time off")
+	if !ok {
+		return
+	}
+
+	if err := h.timeOffService.Delete(c.Request.Context(), id, timeOffID, userID, middleware.IsAdmin(c)); err != nil {
+		respondTimeOffError(c, err, "delete time off")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Time off deleted successfully")
+}
@@ -0,0 +1,122 @@
+// internal/handlers/timeline_handler.go
+package handlers
+
+import (
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// TIMELINE HANDLER - Customer activity timeline
+// ========================================================================
+
+// TimelineHandler handles customer activity timeline requests
+type TimelineHandler struct {
+	timelineService *services.TimelineService
+}
+
+// NewTimelineHandler creates a new timeline handler
+func NewTimelineHandler(timelineService *services.TimelineService) *TimelineHandler {
+	return &TimelineHandler{
+		timelineService: timelineService,
+	}
+}
+
+// GetMyActivity godoc
+// @Summary Get my activity timeline
+// @Description Bookings, reschedules, reviews and payments for the authenticated customer, newest first. Served from a projection that is rebuilt automatically when new activity is recorded.
+// @Tags activity
+// @Accept json
+// @Produce json
+// @Param event_types query []string false "Filter by event type (e.g. booking_created, review_posted)"
+// @Param limit query int false "Limit results" default(50)
+// @Param offset query int false "Offset for pagination" default(0)
+// @Success 200 {object} SuccessResponse{data=services.TimelineResponse}
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/activity/me [get]
+func (h *TimelineHandler) GetMyActivity(c *gin.Context) {
+	userID, ok := GetAuthUserID(c, "view your activity")
+	if !ok {
+		return
+	}
+	h.respondTimeline(c, userID)
+}
+
+// GetCustomerActivity godoc
+// @Summary Get a customer's activity timeline (admin)
+// @Description The activity timeline for any customer, newest first
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Customer user ID"
+// @Param event_types query []string false "Filter by event type"
+// @Param limit query int false "Limit results" default(50)
+// @Param offset query int false "Offset for pagination" default(0)
+// @Success 200 {object} SuccessResponse{data=services.TimelineResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/customers/{id}/activity [get]
+func (h *TimelineHandler) GetCustomerActivity(c *gin.Context) {
+	customerID, ok := RequireIntParam(c, "id", "Customer")
+	if !ok {
+		return
+	}
+	h.respondTimeline(c, customerID)
+}
+
+// RebuildCustomerActivity godoc
+// @Summary Rebuild a customer's activity timeline (admin)
+// @Description Replays the customer's bookings, history, reviews and payments into the timeline projection
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param id path int true "Customer user ID"
+// @Success 200 {object} SuccessResponse{data=models.TimelineState}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/customers/{id}/activity/rebuild [post]
+func (h *TimelineHandler) RebuildCustomerActivity(c *gin.Context) {
+	customerID, ok := RequireIntParam(c, "id", "Customer")
+	if !ok {
+		return
+	}
+
+	state, err := h.timelineService.Rebuild(c.Request.Context(), customerID)
+	if err != nil {
+		RespondInternalError(c, "rebuild activity timeline", err)
+		return
+	}
+
+	RespondSuccessWithData(c, state, "Activity timeline rebuilt")
+}
+
+// respondTimeline binds timeline filters and writes a page of the timeline
+func (h *TimelineHandler) respondTimeline(c *gin.Context, customerID int) {
+	filters, ok := BindFilters[repository.TimelineFilters](c)
+	if !ok {
+		return
+	}
+
+	timeline, err := h.timelineService.GetTimeline(c.Request.Context(), customerID, *filters)
+	if err != nil {
+		RespondInternalError(c, "fetch activity timeline", err)
+		return
+	}
+
+	c.Header("Cache-Control", "no-store")
+	RespondSuccessWithMeta(c, timeline, map[string]interface{}{
+		"count":  len(timeline.Entries),
+		"limit":  filters.Limit,
+		"offset": filters.Offset,
+	})
+}
@@ -0,0 +1,299 @@
+// internal/handlers/webhook_handler.go
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+	"barber-booking-system/internal/webhook"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// WEBHOOK HANDLER - Barbers' webhook subscriptions
+// ========================================================================
+
+// WebhookHandler handles barbers' webhook subscriptions
+type WebhookHandler struct {
+	webhookService *services.WebhookService
+}
+
+// NewWebhookHandler creates a new webhook handler
+func NewWebhookHandler(webhookService *services.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+// respondWebhookError maps webhook errors to HTTP responses
+func respondWebhookError(c *gin.Context, err error, operation string) {
+	switch {
+	case errors.Is(err, repository.ErrNotOwner):
+		middleware.WriteError(c, http.StatusForbidden, middleware.ErrorResponse{
+			Error:   "Forbidden",
+			Message: "You can only manage your own webhooks",
+		})
+	case errors.Is(err, repository.ErrBarberNotFound):
+		RespondNotFound(c, "Barber")
+	case errors.Is(err, repository.ErrWebhookNotFound):
+		RespondNotFound(c, "Webhook")
+	case errors.Is(err, repository.ErrWebhookDeliveryNotFound):
+		RespondNotFound(c, "Webhook delivery")
+	case errors.Is(err, repository.ErrTooManyWebhooks):
+		middleware.WriteError(c, http.StatusConflict, middleware.ErrorResponse{
+			Error:   "Too many webhooks",
+			Message: err.Error(),
+		})
+	case errors.Is(err, webhook.ErrBlockedAddress):
+		RespondBadRequest(c, "Invalid webhook URL", "url must point to a public address")
+	case utils.ContainsAny(err.Error(), []string{"must", "cannot"}):
+		RespondBadRequest(c, "Invalid webhook", err.Error())
+	default:
+		RespondInternalError(c, operation, err)
+	}
+}
+
+// ListWebhooks godoc
+// @Summary List a barber's webhooks
+// @Description The URLs the barber registered for events, without their secrets. Barbers may only view their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Success 200 {object} SuccessResponse{data=[]models.WebhookSubscription}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/webhooks [get]
+func (h *WebhookHandler) ListWebhooks(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "view webhooks")
+	if !ok {
+		return
+	}
+
+	webhooks, err := h.webhookService.ListWebhooks(c.Request.Context(), id, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondWebhookError(c, err, "fetch webhooks")
+		return
+	}
+
+	RespondSuccess(c, webhooks)
+}
+
+// CreateWebhook godoc
+// @Summary Register a webhook
+// @Description Register an https URL on the barber's own site to receive events as signed JSON POSTs: booking.created, booking.cancelled, booking.completed, review.created (a customer left a review, pending moderation), review.approved (a review was published) and review.responded (the barber replied). Each request carries X-Webhook-Event, X-Webhook-ID (the event ID; the same across retries) and X-Webhook-Signature: t=<unix time>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed with the secret>. Answer with any 2xx; other responses are retried with backoff, and every attempt is listed in the delivery log. The secret is returned only in this response. Barbers may only register their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param request body services.CreateWebhookRequest true "URL and events"
+// @Success 201 {object} SuccessResponse{data=services.CreatedWebhookResponse}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 409 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "register webhook")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.CreateWebhookRequest](c)
+	if !ok {
+		return
+	}
+
+	created, err := h.webhookService.CreateWebhook(c.Request.Context(), id, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondWebhookError(c, err, "register webhook")
+		return
+	}
+
+	RespondCreated(c, created, "Webhook registered; store the secret now, it will not be shown again")
+}
+
+// UpdateWebhook godoc
+// @Summary Update a webhook
+// @Description Change a webhook's URL, events or description, or pause it with is_active=false (paused webhooks receive no new events). Omitted fields are left as they are. Barbers may only update their own.
+// @Tags barbers
+// @Accept json
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param webhookId path int true "Webhook ID"
+// @Param request body services.UpdateWebhookRequest true "Changes"
+// @Success 200 {object} SuccessResponse{data=models.WebhookSubscription}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/webhooks/{webhookId} [patch]
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	webhookID, ok := RequireIntParam(c, "webhookId", "webhook")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "update webhook")
+	if !ok {
+		return
+	}
+	req, ok := BindJSON[services.UpdateWebhookRequest](c)
+	if !ok {
+		return
+	}
+
+	updated, err := h.webhookService.UpdateWebhook(c.Request.Context(), id, webhookID, *req, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondWebhookError(c, err, "update webhook")
+		return
+	}
+
+	RespondSuccessWithData(c, updated, "Webhook updated")
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook
+// @Description Remove a webhook; events not yet delivered to it are dropped. Barbers may only delete their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param webhookId path int true "Webhook ID"
+// @Success 200 {object} SuccessResponse
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/webhooks/{webhookId} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	webhookID, ok := RequireIntParam(c, "webhookId", "webhook")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "delete webhook")
+	if !ok {
+		return
+	}
+
+	if err := h.webhookService.DeleteWebhook(c.Request.Context(), id, webhookID, userID, middleware.IsAdmin(c)); err != nil {
+		respondWebhookError(c, err, "delete webhook")
+		return
+	}
+
+	RespondSuccessWithMessage(c, "Webhook deleted")
+}
+
+// ListWebhookDeliveries godoc
+// @Summary List a webhook's deliveries
+// @Description The webhook's delivery log, newest first: each event sent or queued, its attempts, the last response status and error, and the payload. Barbers may only view their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param webhookId path int true "Webhook ID"
+// @Param status query string false "Only deliveries with this status" Enums(pending, delivered, failed)
+// @Param limit query int false "Page size" default(20)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} SuccessResponse{data=[]models.WebhookDelivery}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/webhooks/{webhookId}/deliveries [get]
+func (h *WebhookHandler) ListWebhookDeliveries(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	webhookID, ok := RequireIntParam(c, "webhookId", "webhook")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "view webhook deliveries")
+	if !ok {
+		return
+	}
+	limit := ParseLimitQuery(c, 0)
+	offset := ParseIntQuery(c, "offset", 0)
+
+	deliveries, total, err := h.webhookService.ListDeliveries(c.Request.Context(), id, webhookID, c.Query("status"), limit, offset, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondWebhookError(c, err, "fetch webhook deliveries")
+		return
+	}
+
+	RespondSuccessWithMeta(c, deliveries, PageMeta(len(deliveries), total, limit, offset))
+}
+
+// RedeliverWebhook godoc
+// @Summary Redeliver a webhook event
+// @Description Queue a delivered or failed delivery to be sent again with the same event ID, so receivers that already processed it can ignore it. It gets a fresh set of retries. Barbers may only redeliver their own.
+// @Tags barbers
+// @Produce json
+// @Param id path int true "Barber ID"
+// @Param webhookId path int true "Webhook ID"
+// @Param deliveryId path int true "Delivery ID"
+// @Success 200 {object} SuccessResponse{data=models.WebhookDelivery}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/barbers/{id}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver [post]
+func (h *WebhookHandler) RedeliverWebhook(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "barber")
+	if !ok {
+		return
+	}
+	webhookID, ok := RequireIntParam(c, "webhookId", "webhook")
+	if !ok {
+		return
+	}
+	deliveryID, ok := RequireIntParam(c, "deliveryId", "delivery")
+	if !ok {
+		return
+	}
+	userID, ok := GetAuthUserID(c, "redeliver webhook")
+	if !ok {
+		return
+	}
+
+	delivery, err := h.webhookService.Redeliver(c.Request.Context(), id, webhookID, deliveryID, userID, middleware.IsAdmin(c))
+	if err != nil {
+		respondWebhookError(c, err, "redeliver webhook")
+		return
+	}
+
+	RespondSuccessWithData(c, delivery, "Delivery queued")
+}
@@ -0,0 +1,139 @@
+// internal/handlers/win_back_handler.go
+package handlers
+
+import (
+	"errors"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// WIN-BACK HANDLER - Lapsed-customer campaigns (admin)
+// ========================================================================
+
+// WinBackHandler handles win-back campaign requests
+type WinBackHandler struct {
+	winBackService *services.WinBackService
+}
+
+// NewWinBackHandler creates a new win-back handler
+func NewWinBackHandler(winBackService *services.WinBackService) *WinBackHandler {
+	return &WinBackHandler{
+		winBackService: winBackService,
+	}
+}
+
+// isCouponError reports whether err rejects a coupon code
+func isCouponError(err error) bool {
+	return errors.Is(err, repository.ErrCouponNotFound) ||
+		errors.Is(err, repository.ErrCouponExpired) ||
+		errors.Is(err, repository.ErrCouponRedeemed)
+}
+
+// RunCampaign godoc
+// @Summary Run a win-back campaign
+// @Description Message customers who are past their usual booking cadence by the configured number of weeks, optionally with a single-use coupon. Settings can be overridden for this run; dry_run lists the customers without sending.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param request body services.RunWinBackRequest false "Overrides"
+// @Success 200 {object} SuccessResponse{data=services.WinBackRunResult}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/win-back/run [post]
+func (h *WinBackHandler) RunCampaign(c *gin.Context) {
+	var req services.RunWinBackRequest
+	if c.Request.ContentLength != 0 {
+		bound, ok := BindJSON[services.RunWinBackRequest](c)
+		if !ok {
+			return
+		}
+		req = *bound
+	}
+
+	var triggeredBy *int
+	if userID, exists := middleware.GetUserID(c); exists {
+		triggeredBy = &userID
+	}
+
+	result, err := h.winBackService.RunCampaign(c.Request.Context(), req, triggeredBy)
+	if err != nil {
+		RespondInternalError(c, "run win-back campaign", err)
+		return
+	}
+
+	message := "Win-back campaign sent"
+	if result.DryRun {
+		message = "Win-back dry run complete"
+	}
+	RespondSuccessWithData(c, result, message)
+}
+
+// ListCampaigns godoc
+// @Summary List win-back campaigns
+// @Description Campaign runs, newest first, with opens, conversions (bookings within the attribution window), coupon redemptions and revenue
+// @Tags admin
+// @Produce json
+// @Param limit query int false "Limit results" default(20)
+// @Param offset query int false "Offset for pagination" default(0)
+// @Success 200 {object} SuccessResponse{data=[]models.WinBackCampaignStats}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/win-back/campaigns [get]
+func (h *WinBackHandler) ListCampaigns(c *gin.Context) {
+	req, ok := BindQuery[services.WinBackCampaignListRequest](c)
+	if !ok {
+		return
+	}
+
+	campaigns, err := h.winBackService.ListCampaigns(c.Request.Context(), req)
+	if err != nil {
+		RespondInternalError(c, "fetch win-back campaigns", err)
+		return
+	}
+
+	RespondSuccessWithMeta(c, campaigns, PaginationMeta(len(campaigns), req.Limit, req.Offset))
+}
+
+// GetCampaign godoc
+// @Summary Get a win-back campaign
+// @Description One campaign run with its performance
+// @Tags admin
+// @Produce json
+// @Param id path int true "Campaign ID"
+// @Success 200 {object} SuccessResponse{data=models.WinBackCampaignStats}
+// @Failure 400 {object} middleware.ErrorResponse
+// @Failure 401 {object} middleware.ErrorResponse
+// @Failure 403 {object} middleware.ErrorResponse
+// @Failure 404 {object} middleware.ErrorResponse
+// @Failure 500 {object} middleware.ErrorResponse
+// @Security BearerAuth
+// @Router /api/v1/admin/win-back/campaigns/{id} [get]
+func (h *WinBackHandler) GetCampaign(c *gin.Context) {
+	id, ok := RequireIntParam(c, "id", "campaign")
+	if !ok {
+		return
+	}
+
+	campaign, err := h.winBackService.GetCampaign(c.Request.Context(), id)
+	if err == repository.ErrWinBackCampaignNotFound {
+		RespondNotFound(c, "Campaign")
+		return
+	}
+	if err != nil {
+		RespondInternalError(c, "fetch win-back campaign", err)
+		return
+	}
+
+	RespondSuccess(c, campaign)
+}
@@ -0,0 +1,166 @@
+// internal/i18n/i18n.go
+package i18n
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ========================================================================
+// I18N - User-facing text in the reader's language
+// ========================================================================
+//
+// Text is written in English in the code and translated by looking the
+// English up in the reader's catalog, so untranslated text still reads
+// (in English) and adding a language is only a new file. The catalogs are
+// JSON files in locales/, one per language named by its ISO 639-1 code,
+// embedded in the binary. Translations must keep the {placeholders} of
+// the English; the catalogs are checked when the package loads.
+// ========================================================================
+
+// DefaultLocale is the language of the source text
+const DefaultLocale = "en"
+
+//go:embed locales/*.json
+var files embed.FS
+
+// catalogs are the translations by language, then English text
+var catalogs = mustLoad()
+
+// placeholder matches a {variable} placeholder
+var placeholder = regexp.MustCompile(`\{([A-Za-z0-9_]+)\}`)
+
+// mustLoad reads the embedded catalogs; a broken one is a build mistake
+func mustLoad() map[string]map[string]string {
+	entries, err := files.ReadDir("locales")
+	if err != nil {
+		panic(fmt.Sprintf("i18n: %v", err))
+	}
+
+	loaded := make(map[string]map[string]string, len(entries))
+	for _, entry := range entries {
+		locale := strings.TrimSuffix(entry.Name(), ".json")
+		data, err := files.ReadFile(path.Join("locales", entry.Name()))
+		if err != nil {
+			panic(fmt.Sprintf("i18n: %v", err))
+		}
+		var catalog map[string]string
+		if err := json.Unmarshal(data, &catalog); err != nil {
+			panic(fmt.Sprintf("i18n: %s: %v", entry.Name(), err))
+		}
+		for source, translation := range catalog {
+			if !slices.Equal(placeholders(source), placeholders(translation)) {
+				panic(fmt.Sprintf("i18n: %s: %q must keep the placeholders of %q", entry.Name(), translation, source))
+			}
+		}
+		loaded[locale] = catalog
+	}
+	return loaded
+}
+
+// placeholders returns the sorted variable names text uses
+func placeholders(text string) []string {
+	var names []string
+	for _, match := range placeholder.FindAllStringSubmatch(text, -1) {
+		if !slices.Contains(names, match[1]) {
+			names = append(names, match[1])
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Locales returns the supported languages, sorted
+func Locales() []string {
+	locales := []string{DefaultLocale}
+	for locale := range catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Match returns the supported language of a locale name ("pt-BR" and
+// "pt_PT" are "pt"), and false if it is not supported
+func Match(name string) (string, bool) {
+	code := strings.ToLower(strings.TrimSpace(name))
+	if i := strings.IndexAny(code, "-_"); i >= 0 {
+		code = code[:i]
+	}
+	if _, ok := catalogs[code]; ok || code == DefaultLocale {
+		return code, true
+	}
+	return "", false
+}
+
+// Translate returns text in a supported language, or text itself when
+// the language has no translation of it
+func Translate(locale, text string) string {
+	if translation, ok := catalogs[locale][text]; ok {
+		return translation
+	}
+	return text
+}
+
+// Has returns true if a supported language has a translation of text
+// (English has all of it)
+func Has(locale, text string) bool {
+	if locale == DefaultLocale {
+		return true
+	}
+	_, ok := catalogs[locale][text]
+	return ok
+}
+
+// FromAcceptLanguage returns the supported language an Accept-Language
+// header prefers most, and false if it names none
+func FromAcceptLanguage(header string) (string, bool) {
+	type choice struct {
+		locale string
+		weight float64
+	}
+	var choices []choice
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		weight := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		if locale, ok := Match(name); ok && weight > 0 {
+			choices = append(choices, choice{locale: locale, weight: weight})
+		}
+	}
+	if len(choices) == 0 {
+		return "", false
+	}
+	sort.SliceStable(choices, func(i, j int) bool { return choices[i].weight > choices[j].weight })
+	return choices[0].locale, true
+}
+
+// localeKey is where the request's language is kept in a context
+type localeKey struct{}
+
+// WithLocale returns ctx carrying the language of the reader
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeKey{}, locale)
+}
+
+// FromContext returns the reader's language, DefaultLocale if unknown
+func FromContext(ctx context.Context) string {
+	if locale, ok := ctx.Value(localeKey{}).(string); ok && locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
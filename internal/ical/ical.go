@@ -0,0 +1,165 @@
+// internal/ical/ical.go
+package ical
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ========================================================================
+// ICAL - iCalendar (RFC 5545) files and feeds
+// ========================================================================
+//
+// Only what booking exports need: a VCALENDAR of VEVENTs with times in
+// UTC. Text values are escaped and long lines folded at 75 octets, as
+// Apple and Google Calendar expect.
+// ========================================================================
+
+// ContentType is the media type of iCalendar files
+const ContentType = "text/calendar; charset=utf-8"
+
+// productID identifies this application in every calendar
+const productID = "-//Barber Booking System//Bookings//EN"
+
+// maxLineOctets is the longest content line before folding
+const maxLineOctets = 75
+
+// Event statuses
+const (
+	StatusTentative = "TENTATIVE"
+	StatusConfirmed = "CONFIRMED"
+	StatusCancelled = "CANCELLED"
+)
+
+// Calendar is a set of events, published as a file or a subscribed feed
+type Calendar struct {
+	Name            string        // Shown by calendar apps for subscribed feeds
+	RefreshInterval time.Duration // How often subscribers should refetch (0 = client default)
+	Events          []Event
+}
+
+// Event is one appointment
+type Event struct {
+	UID         string // Stable across exports, so re-imports update the event
+	Start       time.Time
+	End         time.Time
+	Summary     string
+	Description string
+	Location    string
+	Status      string // See Status* constants (empty = not set)
+	Created     time.Time
+	Modified    time.Time
+}
+
+// Marshal renders the calendar, stamped at now
+func (c Calendar) Marshal(now time.Time) []byte {
+	w := &writer{}
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", productID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		w.line("X-WR-CALNAME", escape(c.Name))
+	}
+	if c.RefreshInterval > 0 {
+		interval := duration(c.RefreshInterval)
+		w.line("REFRESH-INTERVAL;VALUE=DURATION", interval)
+		w.line("X-PUBLISHED-TTL", interval)
+	}
+
+	for _, e := range c.Events {
+		w.line("BEGIN", "VEVENT")
+		w.line("UID", escape(e.UID))
+		w.line("DTSTAMP", formatTime(now))
+		w.line("DTSTART", formatTime(e.Start))
+		w.line("DTEND", formatTime(e.End))
+		w.line("SUMMARY", escape(e.Summary))
+		if e.Description != "" {
+			w.line("DESCRIPTION", escape(e.Description))
+		}
+		if e.Location != "" {
+			w.line("LOCATION", escape(e.Location))
+		}
+		if e.Status != "" {
+			w.line("STATUS", e.Status)
+		}
+		if !e.Created.IsZero() {
+			w.line("CREATED", formatTime(e.Created))
+		}
+		if !e.Modified.IsZero() {
+			w.line("LAST-MODIFIED", formatTime(e.Modified))
+		}
+		w.line("END", "VEVENT")
+	}
+
+	w.line("END", "VCALENDAR")
+	return w.buf.Bytes()
+}
+
+// writer builds CRLF-terminated, folded content lines
+type writer struct {
+	buf bytes.Buffer
+}
+
+// line writes "name:value", folding it into continuation lines that start
+// with a space. Folds never split a UTF-8 character.
+func (w *writer) line(name, value string) {
+	content := name + ":" + value
+	limit := maxLineOctets
+	for len(content) > limit {
+		cut := limit
+		for cut > 0 && !isCharStart(content[cut]) {
+			cut--
+		}
+		w.buf.WriteString(content[:cut])
+		w.buf.WriteString("\r\n ")
+		content = content[cut:]
+		limit = maxLineOctets - 1 // The leading space counts
+	}
+	w.buf.WriteString(content)
+	w.buf.WriteString("\r\n")
+}
+
+// isCharStart reports whether b begins a UTF-8 character
+func isCharStart(b byte) bool {
+	return b&0xC0 != 0x80
+}
+
+// textEscaper escapes TEXT values
+var textEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	";", `\;`,
+	",", `\,`,
+	"\r\n", `\n`,
+	"\n", `\n`,
+	"\r", "",
+)
+
+// escape returns s as an iCalendar TEXT value
+func escape(s string) string {
+	return textEscaper.Replace(s)
+}
+
+// formatTime returns t as a UTC DATE-TIME
+func formatTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// duration returns d as a DURATION, to the minute (e.g. PT1H30M)
+func duration(d time.Duration) string {
+	minutes := int(d.Round(time.Minute) / time.Minute)
+	if minutes < 1 {
+		minutes = 1
+	}
+	value := "PT"
+	if h := minutes / 60; h > 0 {
+		value += fmt.Sprintf("%dH", h)
+	}
+	if m := minutes % 60; m > 0 {
+		value += fmt.Sprintf("%dM", m)
+	}
+	return value
+}
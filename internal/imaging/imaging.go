@@ -0,0 +1,235 @@
+// internal/imaging/imaging.go
+package imaging
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"image/png"
+	"io"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// IMAGING - Validating, resizing and re-encoding uploaded photos
+// ========================================================================
+//
+// Uploads are identified by their content, not their name or declared
+// type, and decoded only when their dimensions are within bounds. Every
+// photo is re-encoded before it is stored: that drops the metadata a
+// camera writes (including GPS location), so the EXIF orientation is
+// applied to the pixels first.
+// ========================================================================
+
+// Supported formats, as reported by image.DecodeConfig
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+)
+
+var (
+	// ErrUnsupportedFormat is returned for files that are not JPEG or PNG
+	ErrUnsupportedFormat = errors.New("image must be a JPEG or PNG")
+
+	// ErrTooManyPixels is returned for images too large to decode
+	ErrTooManyPixels = errors.New("image dimensions are too large")
+)
+
+// Image is a decoded photo, upright
+type Image struct {
+	*image.RGBA
+	Format string
+}
+
+// Decode reads a JPEG or PNG of at most maxPixels pixels
+func Decode(data []byte, maxPixels int) (*Image, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || (format != FormatJPEG && format != FormatPNG) {
+		return nil, ErrUnsupportedFormat
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedFormat
+	}
+	rgba := image.NewRGBA(image.Rect(0, 0, cfg.Width, cfg.Height))
+	draw.Draw(rgba, rgba.Bounds(), src, src.Bounds().Min, draw.Src)
+
+	if format == FormatJPEG {
+		rgba = orient(rgba, jpegOrientation(data))
+	}
+	return &Image{RGBA: rgba, Format: format}, nil
+}
+
+// ContentType returns the image's MIME type
+func (img *Image) ContentType() string {
+	if img.Format == FormatPNG {
+		return "image/png"
+	}
+	return "image/jpeg"
+}
+
+// Extension returns the file extension for the image's format
+func (img *Image) Extension() string {
+	if img.Format == FormatPNG {
+		return ".png"
+	}
+	return ".jpg"
+}
+
+// Encode writes the image in its format
+func (img *Image) Encode(w io.Writer) error {
+	if img.Format == FormatPNG {
+		return png.Encode(w, img.RGBA)
+	}
+	return jpeg.Encode(w, img.RGBA, &jpeg.Options{Quality: config.ImageQuality})
+}
+
+// Fit returns the image scaled down, keeping its aspect ratio, to fit
+// within maxWidth x maxHeight. Images that already fit are returned as is.
+func (img *Image) Fit(maxWidth, maxHeight int) *Image {
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+	if w <= maxWidth && h <= maxHeight {
+		return img
+	}
+	dw, dh := maxWidth, h*maxWidth/w
+	if dh > maxHeight {
+		dw, dh = w*maxHeight/h, maxHeight
+	}
+	return &Image{RGBA: downscale(img.RGBA, max(dw, 1), max(dh, 1)), Format: img.Format}
+}
+
+// downscale resizes src to dw x dh by averaging the source pixels each
+// destination pixel covers
+func downscale(src *image.RGBA, dw, dh int) *image.RGBA {
+	sw, sh := src.Bounds().Dx(), src.Bounds().Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for dy := 0; dy < dh; dy++ {
+		y0, y1 := dy*sh/dh, max((dy+1)*sh/dh, dy*sh/dh+1)
+		for dx := 0; dx < dw; dx++ {
+			x0, x1 := dx*sw/dw, max((dx+1)*sw/dw, dx*sw/dw+1)
+
+			var sum [4]int
+			for y := y0; y < y1; y++ {
+				row := src.Pix[y*src.Stride+x0*4 : y*src.Stride+x1*4]
+				for i := 0; i < len(row); i += 4 {
+					sum[0] += int(row[i])
+					sum[1] += int(row[i+1])
+					sum[2] += int(row[i+2])
+					sum[3] += int(row[i+3])
+				}
+			}
+			n := (x1 - x0) * (y1 - y0)
+			offset := dy*dst.Stride + dx*4
+			for c := 0; c < 4; c++ {
+				dst.Pix[offset+c] = uint8(sum[c] / n)
+			}
+		}
+	}
+	return dst
+}
+
+// orient turns an image with the given EXIF orientation (1-8) upright
+func orient(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation < 2 || orientation > 8 {
+		return src
+	}
+	w, h := src.Bounds().Dx(), src.Bounds().Dy()
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w // Orientations 5-8 swap the axes
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2: // Mirrored
+				sx, sy = w-1-x, y
+			case 3: // Rotated 180°
+				sx, sy = w-1-x, h-1-y
+			case 4: // Mirrored vertically
+				sx, sy = x, h-1-y
+			case 5: // Transposed
+				sx, sy = y, x
+			case 6: // Needs a 90° clockwise turn
+				sx, sy = y, h-1-x
+			case 7: // Transversed
+				sx, sy = w-1-y, h-1-x
+			case 8: // Needs a 90° counter-clockwise turn
+				sx, sy = w-1-y, x
+			}
+			copy(dst.Pix[y*dst.Stride+x*4:y*dst.Stride+x*4+4], src.Pix[sy*src.Stride+sx*4:sy*src.Stride+sx*4+4])
+		}
+	}
+	return dst
+}
+
+// jpegOrientation reads the EXIF orientation tag of a JPEG, or returns 1
+// (upright) when there is none
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+	for pos := 2; pos+4 <= len(data); {
+		if data[pos] != 0xFF {
+			return 1
+		}
+		marker := data[pos+1]
+		if marker == 0xDA || marker == 0xD9 { // Image data starts: no EXIF
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 || pos+2+length > len(data) {
+			return 1
+		}
+		segment := data[pos+4 : pos+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return exifOrientation(segment[6:])
+		}
+		pos += 2 + length
+	}
+	return 1
+}
+
+// exifOrientation reads the orientation tag (0x0112) from the first IFD of
+// an EXIF TIFF structure
+func exifOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	entries := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+	return 1
+}
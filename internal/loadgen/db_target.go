@@ -0,0 +1,99 @@
+// internal/loadgen/db_target.go
+package loadgen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/config"
+	appConfig "barber-booking-system/internal/config"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/utils"
+)
+
+// DBTarget calls the booking service directly, bypassing HTTP, so conflict
+// checking and listing queries can be benchmarked in isolation
+type DBTarget struct {
+	dbManager *config.DatabaseManager
+	service   *services.BookingService
+}
+
+// NewDBTarget connects using the standard configuration (DATABASE_URL etc.)
+func NewDBTarget() (*DBTarget, error) {
+	cfg, err := appConfig.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+
+	dbManager, err := config.NewDatabaseManager(cfg.Database)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to database: %w", err)
+	}
+
+	db := dbManager.DB
+	service := services.NewBookingService(
+		repository.NewBookingRepository(db),
+		repository.NewBookingSeriesRepository(db),
+		repository.NewBarberScheduleRepository(db),
+		repository.NewBarberRepository(db),
+		repository.NewServiceRepository(db),
+		nil, // No cache so every call reaches the database
+	)
+
+	return &DBTarget{dbManager: dbManager, service: service}, nil
+}
+
+// CreateBooking creates a guest booking through the service layer
+func (t *DBTarget) CreateBooking(ctx context.Context, slot Slot) Outcome {
+	name := "Load Test"
+	email := fmt.Sprintf("loadgen+%d@example.com", slot.StartTime.UnixNano())
+	notes := "loadgen"
+
+	_, err := t.service.CreateBooking(ctx, services.CreateBookingRequest{
+		BarberID:        slot.BarberID,
+		ServiceID:       slot.ServiceID,
+		StartTime:       slot.StartTime,
+		DurationMinutes: slot.DurationMinutes,
+		CustomerName:    &name,
+		CustomerEmail:   &email,
+		Notes:           &notes,
+		BookingSource:   "web_app",
+	}, nil)
+	return ClassifyError(err)
+}
+
+// CheckAvailability runs the conflict check for a slot
+func (t *DBTarget) CheckAvailability(ctx context.Context, slot Slot) Outcome {
+	_, err := t.service.CheckAvailabilityEnhanced(ctx, slot.BarberID, slot.StartTime, slot.DurationMinutes)
+	return ClassifyError(err)
+}
+
+// ListBookings lists a barber's bookings
+func (t *DBTarget) ListBookings(ctx context.Context, barberID int) Outcome {
+	_, _, err := t.service.GetBarberBookings(ctx, barberID, repository.BookingFilters{Limit: 50})
+	return ClassifyError(err)
+}
+
+// Close closes the database connection
+func (t *DBTarget) Close() {
+	t.dbManager.Close()
+}
+
+// ClassifyError maps a service error to an outcome, mirroring how the
+// booking handler maps the same errors to HTTP statuses
+func ClassifyError(err error) Outcome {
+	switch {
+	case err == nil:
+		return OutcomeOK
+	case errors.Is(err, repository.ErrBookingConflict),
+		strings.Contains(err.Error(), "time slot is not available"):
+		return OutcomeConflict
+	case utils.ContainsAny(err.Error(), []string{"not found", "required", "must be", "cannot"}):
+		return OutcomeRejected
+	default:
+		return OutcomeError
+	}
+}
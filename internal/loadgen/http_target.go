@@ -0,0 +1,151 @@
+// internal/loadgen/http_target.go
+package loadgen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HTTPTarget drives a running instance through the public API
+type HTTPTarget struct {
+	baseURL string
+	token   string
+	client  *http.Client
+}
+
+// NewHTTPTarget creates an HTTP target, logging in when no token is given
+func NewHTTPTarget(ctx context.Context, baseURL, token, email, password string) (*HTTPTarget, error) {
+	t := &HTTPTarget{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		token:   token,
+		client: &http.Client{
+			Timeout: 30 * time.Second,
+			Transport: &http.Transport{
+				MaxIdleConns:        256,
+				MaxIdleConnsPerHost: 256,
+			},
+		},
+	}
+
+	if t.token == "" && email != "" {
+		if err := t.login(ctx, email, password); err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+// login exchanges credentials for a JWT
+func (t *HTTPTarget) login(ctx context.Context, email, password string) error {
+	body, _ := json.Marshal(map[string]string{"email": email, "password": password})
+	resp, err := t.do(ctx, http.MethodPost, "/api/v1/auth/login", body)
+	if err != nil {
+		return fmt.Errorf("login failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("login failed: %s", resp.Status)
+	}
+
+	var envelope struct {
+		Data struct {
+			Token string `json:"token"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		return fmt.Errorf("failed to decode login response: %w", err)
+	}
+	t.token = envelope.Data.Token
+	return nil
+}
+
+// CreateBooking posts a guest booking
+func (t *HTTPTarget) CreateBooking(ctx context.Context, slot Slot) Outcome {
+	name := "Load Test"
+	email := fmt.Sprintf("loadgen+%d@example.com", slot.StartTime.UnixNano())
+	body, _ := json.Marshal(map[string]interface{}{
+		"barber_id":        slot.BarberID,
+		"service_id":       slot.ServiceID,
+		"start_time":       slot.StartTime.Format(time.RFC3339),
+		"duration_minutes": slot.DurationMinutes,
+		"customer_name":    name,
+		"customer_email":   email,
+		"booking_source":   "web_app",
+		"notes":            "loadgen",
+	})
+	return t.send(ctx, http.MethodPost, "/api/v1/bookings", body)
+}
+
+// CheckAvailability queries the availability endpoint
+func (t *HTTPTarget) CheckAvailability(ctx context.Context, slot Slot) Outcome {
+	q := url.Values{}
+	q.Set("barber_id", strconv.Itoa(slot.BarberID))
+	q.Set("start_time", slot.StartTime.Format(time.RFC3339))
+	q.Set("duration", strconv.Itoa(slot.DurationMinutes))
+	return t.send(ctx, http.MethodGet, "/api/v1/bookings/availability?"+q.Encode(), nil)
+}
+
+// ListBookings lists a barber's bookings
+func (t *HTTPTarget) ListBookings(ctx context.Context, barberID int) Outcome {
+	return t.send(ctx, http.MethodGet, fmt.Sprintf("/api/v1/barbers/%d/bookings?limit=50", barberID), nil)
+}
+
+// Close releases idle connections
+func (t *HTTPTarget) Close() {
+	t.client.CloseIdleConnections()
+}
+
+// send performs a request and classifies the response
+func (t *HTTPTarget) send(ctx context.Context, method, path string, body []byte) Outcome {
+	resp, err := t.do(ctx, method, path, body)
+	if err != nil {
+		return OutcomeError
+	}
+	// Drain so the connection is reused
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	return classifyStatus(resp.StatusCode)
+}
+
+func (t *HTTPTarget) do(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, t.baseURL+path, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+	return t.client.Do(req)
+}
+
+// classifyStatus maps an HTTP status to an outcome
+func classifyStatus(status int) Outcome {
+	switch {
+	case status < 300:
+		return OutcomeOK
+	case status == http.StatusConflict:
+		return OutcomeConflict
+	case status < 500:
+		return OutcomeRejected
+	default:
+		return OutcomeError
+	}
+}
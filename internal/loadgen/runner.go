@@ -0,0 +1,235 @@
+// internal/loadgen/runner.go
+package loadgen
+
+// ========================================================================
+// LOAD GENERATOR - Synthetic booking traffic behind cmd/loadgen
+// ========================================================================
+// A Scenario repeats one operation against a Target (a running instance
+// over HTTP, or the service layer against a database) from concurrent
+// workers; Run tallies the outcomes and latencies into a Report.
+// ========================================================================
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ============================================
+// Targets & Outcomes
+// ============================================
+
+// Outcome classifies a single operation
+type Outcome int
+
+const (
+	OutcomeOK       Outcome = iota // Succeeded
+	OutcomeConflict                // Rejected because the slot was taken (expected under load)
+	OutcomeRejected                // Rejected by validation (4xx other than conflict)
+	OutcomeError                   // Transport failure or 5xx
+)
+
+// Slot is a barber/time pair used for bookings and availability checks
+type Slot struct {
+	BarberID        int
+	ServiceID       int
+	StartTime       time.Time
+	DurationMinutes int
+}
+
+// Target executes operations against the system under test
+type Target interface {
+	CreateBooking(ctx context.Context, slot Slot) Outcome
+	CheckAvailability(ctx context.Context, slot Slot) Outcome
+	ListBookings(ctx context.Context, barberID int) Outcome
+	Close()
+}
+
+// ============================================
+// Synthetic Data
+// ============================================
+
+// Generator produces synthetic slots. Start times fall on 15-minute boundaries
+// within business hours so a share of bookings collide and exercise conflict checks.
+type Generator struct {
+	mu        sync.Mutex
+	rng       *rand.Rand
+	barberIDs []int
+	serviceID int
+	duration  int
+	days      int
+	base      time.Time
+}
+
+// NewGenerator creates a generator of slots in the days after now; days is
+// capped to the 30-day booking window
+func NewGenerator(seed int64, now time.Time, barberIDs []int, serviceID, duration, days int) *Generator {
+	if days < 1 {
+		days = 1
+	}
+	if days > 29 {
+		days = 29
+	}
+	// Start tomorrow so the one-hour minimum advance rule never rejects a slot
+	tomorrow := now.Add(24 * time.Hour)
+	base := time.Date(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), 0, 0, 0, 0, time.UTC)
+
+	return &Generator{
+		rng:       rand.New(rand.NewSource(seed)),
+		barberIDs: barberIDs,
+		serviceID: serviceID,
+		duration:  duration,
+		days:      days,
+		base:      base,
+	}
+}
+
+// BarberID returns a random barber from the configured set
+func (g *Generator) BarberID() int {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.barberIDs[g.rng.Intn(len(g.barberIDs))]
+}
+
+// Slot returns a random slot between 09:00 and 18:00 UTC
+func (g *Generator) Slot() Slot {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	day := g.rng.Intn(g.days)
+	quarter := g.rng.Intn(9 * 4) // 36 quarter-hours between 09:00 and 18:00
+	start := g.base.AddDate(0, 0, day).Add(9*time.Hour + time.Duration(quarter)*15*time.Minute)
+
+	return Slot{
+		BarberID:        g.barberIDs[g.rng.Intn(len(g.barberIDs))],
+		ServiceID:       g.serviceID,
+		StartTime:       start,
+		DurationMinutes: g.duration,
+	}
+}
+
+// Booking returns a slot for a booking creation
+func (g *Generator) Booking() Slot {
+	return g.Slot()
+}
+
+// ============================================
+// Runner
+// ============================================
+
+// Scenario is a named operation repeated Count times
+type Scenario struct {
+	Name  string
+	Count int
+	Op    func(ctx context.Context) Outcome
+}
+
+// Report summarizes a scenario run
+type Report struct {
+	Name      string
+	Total     int
+	OK        int
+	Conflicts int
+	Rejected  int
+	Errors    int
+	Elapsed   time.Duration
+	Latencies []time.Duration
+}
+
+// Run executes a scenario with the given number of concurrent workers
+func Run(ctx context.Context, s Scenario, concurrency int) *Report {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	jobs := make(chan struct{})
+	type result struct {
+		outcome Outcome
+		latency time.Duration
+	}
+	results := make(chan result, concurrency)
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range jobs {
+				start := time.Now()
+				outcome := s.Op(ctx)
+				results <- result{outcome: outcome, latency: time.Since(start)}
+			}
+		}()
+	}
+
+	start := time.Now()
+	go func() {
+		for i := 0; i < s.Count; i++ {
+			jobs <- struct{}{}
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	report := &Report{Name: s.Name, Latencies: make([]time.Duration, 0, s.Count)}
+	for r := range results {
+		report.Total++
+		report.Latencies = append(report.Latencies, r.latency)
+		switch r.outcome {
+		case OutcomeOK:
+			report.OK++
+		case OutcomeConflict:
+			report.Conflicts++
+		case OutcomeRejected:
+			report.Rejected++
+		default:
+			report.Errors++
+		}
+	}
+	report.Elapsed = time.Since(start)
+
+	return report
+}
+
+// Percentile returns the p-th percentile latency (0 < p <= 100)
+func (r *Report) Percentile(p float64) time.Duration {
+	if len(r.Latencies) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration(nil), r.Latencies...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	idx := int(float64(len(sorted))*p/100+0.5) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
+
+// Print writes a one-scenario summary
+func (r *Report) Print() {
+	rps := 0.0
+	if r.Elapsed > 0 {
+		rps = float64(r.Total) / r.Elapsed.Seconds()
+	}
+
+	icon := "✅"
+	if r.Errors > 0 {
+		icon = "❌"
+	}
+
+	fmt.Printf("%s %-20s n=%d ok=%d conflict=%d rejected=%d error=%d  %.1f req/s\n",
+		icon, r.Name, r.Total, r.OK, r.Conflicts, r.Rejected, r.Errors, rps)
+	fmt.Printf("   latency p50=%s p95=%s p99=%s max=%s\n",
+		r.Percentile(50).Round(time.Microsecond),
+		r.Percentile(95).Round(time.Microsecond),
+		r.Percentile(99).Round(time.Microsecond),
+		r.Percentile(100).Round(time.Microsecond))
+}
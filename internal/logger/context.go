@@ -13,7 +13,10 @@ import (
 
 type contextKey string
 
-const loggerKey contextKey = "logger"
+const (
+	loggerKey    contextKey = "logger"
+	requestIDKey contextKey = "request_id"
+)
 
 // ToContext adds logger to context
 func ToContext(ctx context.Context, l *Logger) context.Context {
@@ -29,6 +32,22 @@ func FromContext(ctx context.Context) *Logger {
 	return Global()
 }
 
+// WithRequestID tags the context with a request ID: every line logged
+// through FromContext carries it, and RequestIDFromContext reads it back
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	ctx = context.WithValue(ctx, requestIDKey, requestID)
+	return ToContext(ctx, FromContext(ctx).WithRequestID(requestID))
+}
+
+// RequestIDFromContext retrieves the request ID from context
+// Returns "" if not found
+func RequestIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDKey).(string); ok {
+		return id
+	}
+	return ""
+}
+
 // FromGinContext retrieves logger from gin.Context
 func FromGinContext(c *gin.Context) *Logger {
 	if l, exists := c.Get(string(loggerKey)); exists {
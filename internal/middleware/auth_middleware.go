@@ -7,6 +7,7 @@ import (
 	"strings"
 	"time"
 
+	"barber-booking-system/internal/audit"
 	"barber-booking-system/internal/config"
 	"barber-booking-system/internal/utils"
 
@@ -94,10 +95,7 @@ func AuthMiddleware(cfg AuthConfig) gin.HandlerFunc {
 		}
 
 		// Store claims in context
-		c.Set("user_id", claims.UserID)
-		c.Set("email", claims.Email)
-		c.Set("user_type", claims.UserType)
-		c.Set("claims", claims)
+		setClaims(c, claims)
 
 		c.Next()
 	}
@@ -108,7 +106,7 @@ func RequireAuth(jwtSecret string) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
-			c.JSON(http.StatusUnauthorized, ErrorResponse{
+			WriteError(c, http.StatusUnauthorized, ErrorResponse{
 				Error:   "Unauthorized",
 				Message: "Authorization header is required",
 				Code:    "UNAUTHORIZED",
@@ -128,7 +126,7 @@ func RequireAuth(jwtSecret string) gin.HandlerFunc {
 		// Parse and validate token
 		claims, err := parseToken(tokenString, jwtSecret)
 		if err != nil {
-			c.JSON(http.StatusUnauthorized, ErrorResponse{
+			WriteError(c, http.StatusUnauthorized, ErrorResponse{
 				Error:   "Unauthorized",
 				Message: "Invalid or expired token",
 				Code:    "UNAUTHORIZED",
@@ -138,10 +136,7 @@ func RequireAuth(jwtSecret string) gin.HandlerFunc {
 		}
 
 		// Store claims in context
-		c.Set("user_id", claims.UserID)
-		c.Set("email", claims.Email)
-		c.Set("user_type", claims.UserType)
-		c.Set("claims", claims)
+		setClaims(c, claims)
 
 		c.Next()
 	}
@@ -181,15 +176,28 @@ func OptionalAuth(secretKey string) gin.HandlerFunc {
 		}
 
 		// Store claims in context
-		c.Set("user_id", claims.UserID)
-		c.Set("email", claims.Email)
-		c.Set("user_type", claims.UserType)
-		c.Set("claims", claims)
+		setClaims(c, claims)
 
 		c.Next()
 	}
 }
 
+// setClaims stores the authenticated user on the gin context and tags the
+// request context with them as the audit actor
+func setClaims(c *gin.Context, claims *Claims) {
+	c.Set("user_id", claims.UserID)
+	c.Set("email", claims.Email)
+	c.Set("user_type", claims.UserType)
+	c.Set("claims", claims)
+
+	c.Request = c.Request.WithContext(audit.WithActor(c.Request.Context(), audit.Actor{
+		UserID:    claims.UserID,
+		UserType:  claims.UserType,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}))
+}
+
 // extractToken extracts JWT token from request
 func extractToken(c *gin.Context, tokenLookup string, tokenHeadName string) (string, error) {
 	// Parse token lookup string (e.g., "header:Authorization,cookie:token")
@@ -0,0 +1,57 @@
+// internal/middleware/chaos_middleware.go
+package middleware
+
+import (
+	"math/rand"
+	"net/http"
+
+	"barber-booking-system/internal/chaos"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Chaos injects the faults a request asks for in the X-Chaos header, or
+// defaults (nil = none) when it sends none, and echoes the injected spec
+// on the response (see internal/chaos). Only installed outside production.
+func Chaos(defaults *chaos.Faults) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		faults := defaults
+		if spec := c.GetHeader(chaos.Header); spec != "" {
+			parsed, err := chaos.Parse(spec)
+			if err != nil {
+				RespondWithError(c, &AppError{
+					Code:       "INVALID_CHAOS_SPEC",
+					Message:    err.Error(),
+					StatusCode: http.StatusBadRequest,
+				})
+				c.Abort()
+				return
+			}
+			faults = parsed
+		}
+
+		if faults == nil || !faults.Applies(rand.Float64()) {
+			c.Next()
+			return
+		}
+
+		c.Header(chaos.Header, faults.String())
+		ctx := chaos.WithFaults(c.Request.Context(), faults)
+		if err := chaos.Sleep(ctx, faults.Latency); err != nil {
+			c.Abort()
+			return
+		}
+		if faults.Status != 0 {
+			RespondWithError(c, &AppError{
+				Code:       "CHAOS_INJECTED",
+				Message:    "Failure injected for resilience testing",
+				StatusCode: faults.Status,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -13,6 +13,18 @@ type ErrorResponse struct {
 	Message string                 `json:"message"`
 	Code    string                 `json:"code,omitempty"`
 	Details map[string]interface{} `json:"details,omitempty"`
+
+	// RequestID is the request's X-Request-ID, for quoting to support
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// WriteError sends an error response tagged with the request's ID, in the
+// request's language where it has a translation
+func WriteError(c *gin.Context, statusCode int, response ErrorResponse) {
+	response.RequestID = GetRequestID(c)
+	response.Error = Localize(c, response.Error)
+	response.Message = Localize(c, response.Message)
+	c.JSON(statusCode, response)
 }
 
 // AppError represents a custom application error
@@ -92,7 +104,7 @@ func ErrorHandler() gin.HandlerFunc {
 					c.Set("error", appErr.Err)
 				}
 
-				c.JSON(appErr.StatusCode, response)
+				WriteError(c, appErr.StatusCode, response)
 				return
 			}
 
@@ -111,7 +123,7 @@ func ErrorHandler() gin.HandlerFunc {
 			}
 
 			c.Set("error", err.Err)
-			c.JSON(http.StatusInternalServerError, response)
+			WriteError(c, http.StatusInternalServerError, response)
 			return
 		}
 	}
@@ -138,7 +150,8 @@ func RecoveryHandler() gin.HandlerFunc {
 					}
 				}
 
-				c.AbortWithStatusJSON(http.StatusInternalServerError, response)
+				c.Abort()
+				WriteError(c, http.StatusInternalServerError, response)
 			}
 		}()
 
@@ -159,7 +172,8 @@ func AbortWithError(c *gin.Context, err *AppError) {
 		c.Set("error", err.Err)
 	}
 
-	c.AbortWithStatusJSON(err.StatusCode, response)
+	c.Abort()
+	WriteError(c, err.StatusCode, response)
 }
 
 // RespondWithError is a helper to respond with an AppError directly
@@ -175,5 +189,5 @@ func RespondWithError(c *gin.Context, err *AppError) {
 		c.Set("error", err.Err)
 	}
 
-	c.JSON(err.StatusCode, response)
+	WriteError(c, err.StatusCode, response)
 }
@@ -0,0 +1,171 @@
+// internal/middleware/fields_middleware.go
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// fieldsKey holds the request's selected response fields in the gin context
+const fieldsKey = "sparse_fields"
+
+// SparseFields lets clients ask for only some fields of a resource with
+// ?fields=id,name,... (JSON:API sparse fieldsets). The fields a client may
+// pick are the JSON fields of model; asking for any other is a 400. The
+// response helpers trim each object in data down to the selection, always
+// keeping "id".
+func SparseFields(model interface{}) gin.HandlerFunc {
+	allowed := JSONFieldNames(model)
+	known := make(map[string]bool, len(allowed))
+	for _, name := range allowed {
+		known[name] = true
+	}
+
+	return func(c *gin.Context) {
+		raw, ok := c.GetQuery("fields")
+		if !ok {
+			c.Next()
+			return
+		}
+
+		var fields, unknown []string
+		seen := make(map[string]bool)
+		for _, name := range strings.Split(raw, ",") {
+			name = strings.TrimSpace(name)
+			if name == "" || seen[name] {
+				continue
+			}
+			seen[name] = true
+			if !known[name] {
+				unknown = append(unknown, name)
+				continue
+			}
+			fields = append(fields, name)
+		}
+
+		if len(unknown) > 0 || len(fields) == 0 {
+			message := "fields must list at least one field"
+			if len(unknown) > 0 {
+				message = "Unknown fields: " + strings.Join(unknown, ", ")
+			}
+			WriteError(c, http.StatusBadRequest, ErrorResponse{
+				Error:   "Invalid fields",
+				Message: message,
+				Details: map[string]interface{}{"allowed": allowed},
+			})
+			c.Abort()
+			return
+		}
+
+		c.Set(fieldsKey, fields)
+		c.Next()
+	}
+}
+
+// GetFields returns the fields the request selected, or nil when it wants
+// whole objects
+func GetFields(c *gin.Context) []string {
+	if value, ok := c.Get(fieldsKey); ok {
+		if fields, ok := value.([]string); ok {
+			return fields
+		}
+	}
+	return nil
+}
+
+// SelectFields trims data - an object or a list of objects - to the given
+// JSON fields plus "id". Values that are not objects pass through.
+func SelectFields(data interface{}, fields []string) (interface{}, error) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(encoded))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return nil, err
+	}
+
+	keep := make(map[string]bool, len(fields)+1)
+	keep["id"] = true
+	for _, field := range fields {
+		keep[field] = true
+	}
+
+	switch value := decoded.(type) {
+	case map[string]interface{}:
+		return selectKeys(value, keep), nil
+	case []interface{}:
+		for i, item := range value {
+			if object, ok := item.(map[string]interface{}); ok {
+				value[i] = selectKeys(object, keep)
+			}
+		}
+		return value, nil
+	default:
+		return decoded, nil
+	}
+}
+
+// selectKeys drops the keys of object not in keep
+func selectKeys(object map[string]interface{}, keep map[string]bool) map[string]interface{} {
+	for key := range object {
+		if !keep[key] {
+			delete(object, key)
+		}
+	}
+	return object
+}
+
+// JSONFieldNames lists the JSON field names of a struct (or pointer to
+// one), including those of embedded structs, sorted
+func JSONFieldNames(model interface{}) []string {
+	seen := make(map[string]bool)
+	collectJSONFields(reflect.TypeOf(model), seen)
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// collectJSONFields adds the JSON field names of t to seen
+func collectJSONFields(t reflect.Type, seen map[string]bool) {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			collectJSONFields(field.Type, seen)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		seen[name] = true
+	}
+}
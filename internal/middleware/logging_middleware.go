@@ -87,8 +87,7 @@ func Logger(cfg LoggerConfig) gin.HandlerFunc {
 		requestID := GetRequestID(c)
 		if requestID == "" {
 			requestID = uuid.New().String()
-			c.Set("request_id", requestID)
-			c.Header("X-Request-ID", requestID)
+			setRequestID(c, requestID)
 		}
 
 		// Start timer
@@ -225,26 +224,3 @@ func logText(entry LogEntry) {
 
 	fmt.Println(output)
 }
-
-// RequestIDMiddleware adds a request ID to the context
-func RequestIDMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		requestID := c.GetHeader("X-Request-ID")
-		if requestID == "" {
-			requestID = uuid.New().String()
-		}
-		c.Set("request_id", requestID)
-		c.Header("X-Request-ID", requestID)
-		c.Next()
-	}
-}
-
-// GetRequestID retrieves the request ID from the context
-func GetRequestID(c *gin.Context) string {
-	if requestID, exists := c.Get("request_id"); exists {
-		if id, ok := requestID.(string); ok {
-			return id
-		}
-	}
-	return ""
-}
@@ -0,0 +1,74 @@
+// internal/middleware/pagination_middleware.go
+package middleware
+
+import (
+	"barber-booking-system/internal/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+// pageLimitKey holds the request's PageLimit in the gin context
+const pageLimitKey = "page_limit"
+
+// PageLimit is the page size a request's list endpoint defaults to and
+// the largest it accepts
+type PageLimit struct {
+	Default  int
+	Max      int
+	Elevated bool // Admin or consumer with an API quota
+}
+
+// PageLimits decides each request's PageLimit: the endpoint's own limit
+// from cfg.EndpointLimits or cfg.MaxLimit, or cfg.ElevatedMaxLimit for
+// admins and consumers with an API quota. Like ConsumerLimitFunc it reads
+// the bearer token itself, so it may run before authentication.
+func PageLimits(cfg config.PaginationConfig, jwtSecret string, quotas QuotaLookup) gin.HandlerFunc {
+	cfg = withPaginationDefaults(cfg)
+	return func(c *gin.Context) {
+		limit := PageLimit{Default: cfg.DefaultLimit, Max: cfg.MaxLimit}
+		if endpoint, ok := cfg.EndpointLimits[c.FullPath()]; ok {
+			limit.Max = endpoint
+		}
+
+		if claims, ok := bearerClaims(c, jwtSecret); ok {
+			if claims.UserType == "admin" || consumerQuota(c, quotas, claims.UserID, 0) > 0 {
+				limit.Max = max(limit.Max, cfg.ElevatedMaxLimit)
+				limit.Elevated = true
+			}
+		}
+		if limit.Default > limit.Max {
+			limit.Default = limit.Max
+		}
+
+		c.Set(pageLimitKey, limit)
+		c.Next()
+	}
+}
+
+// GetPageLimit returns the request's PageLimit, or the configured defaults
+// on routes PageLimits does not cover
+func GetPageLimit(c *gin.Context) PageLimit {
+	if value, ok := c.Get(pageLimitKey); ok {
+		if limit, ok := value.(PageLimit); ok {
+			return limit
+		}
+	}
+	return PageLimit{Default: config.DefaultPageLimit, Max: config.MaxPageLimit}
+}
+
+// withPaginationDefaults fills in unset limits
+func withPaginationDefaults(cfg config.PaginationConfig) config.PaginationConfig {
+	if cfg.DefaultLimit <= 0 {
+		cfg.DefaultLimit = config.DefaultPageLimit
+	}
+	if cfg.MaxLimit <= 0 {
+		cfg.MaxLimit = config.MaxPageLimit
+	}
+	if cfg.ElevatedMaxLimit <= 0 {
+		cfg.ElevatedMaxLimit = config.MaxElevatedPageLimit
+	}
+	if cfg.ElevatedMaxLimit < cfg.MaxLimit {
+		cfg.ElevatedMaxLimit = cfg.MaxLimit
+	}
+	return cfg
+}
@@ -0,0 +1,89 @@
+// internal/middleware/permission_middleware.go
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// PERMISSION MIDDLEWARE - Role-based access control
+// ========================================================================
+
+// permissionsKey is the context key of the authenticated user's permissions
+const permissionsKey = "permissions"
+
+// PermissionResolver returns the permissions a user holds through their
+// user type (system role) and assigned roles
+type PermissionResolver interface {
+	Permissions(ctx context.Context, userID int, userType string) (map[string]bool, error)
+}
+
+// RequirePermission allows the request only if the authenticated user holds
+// perm. It must run after RequireAuth (or AuthMiddleware).
+func RequirePermission(resolver PermissionResolver, perm string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		perms, ok := resolvePermissions(c, resolver)
+		if !ok {
+			return
+		}
+
+		if !perms[perm] && !perms[config.PermissionAll] {
+			RespondWithError(c, NewForbiddenError("Insufficient permissions"))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// resolvePermissions loads the user's permissions into the context once per
+// request, responding with an error if that isn't possible
+func resolvePermissions(c *gin.Context, resolver PermissionResolver) (map[string]bool, bool) {
+	if perms, exists := c.Get(permissionsKey); exists {
+		return perms.(map[string]bool), true
+	}
+
+	userID, ok := GetUserID(c)
+	if !ok {
+		RespondWithError(c, NewUnauthorizedError("Authentication required"))
+		c.Abort()
+		return nil, false
+	}
+	userType, _ := GetUserType(c)
+
+	perms, err := resolver.Permissions(c.Request.Context(), userID, userType)
+	if err != nil {
+		logger.FromContext(c.Request.Context()).Warn("Failed to resolve permissions").
+			Int("user_id", userID).
+			Err(err).
+			Send()
+		RespondWithError(c, &AppError{
+			Code:       "PERMISSIONS_UNAVAILABLE",
+			Message:    "Permissions could not be checked. Please try again later.",
+			StatusCode: http.StatusServiceUnavailable,
+		})
+		c.Abort()
+		return nil, false
+	}
+
+	c.Set(permissionsKey, perms)
+	return perms, true
+}
+
+// HasPermission reports whether the request's user holds perm. Only routes
+// behind RequirePermission have permissions loaded; elsewhere it is false.
+func HasPermission(c *gin.Context, perm string) bool {
+	value, exists := c.Get(permissionsKey)
+	if !exists {
+		return false
+	}
+	perms := value.(map[string]bool)
+	return perms[perm] || perms[config.PermissionAll]
+}
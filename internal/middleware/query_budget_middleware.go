@@ -0,0 +1,39 @@
+// internal/middleware/query_budget_middleware.go
+package middleware
+
+import (
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/querybudget"
+
+	"github.com/gin-gonic/gin"
+)
+
+// QueryBudget counts each request's database queries (see
+// internal/querybudget) and logs the requests that run more than budget.
+// In strict mode the queries over the budget fail.
+func QueryBudget(budget int, strict bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, counter := querybudget.WithCounter(c.Request.Context(), budget, strict)
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		if counter.Exceeded() {
+			logger.FromContext(ctx).Warn("Query budget exceeded").
+				Str("method", c.Request.Method).
+				Str("route", c.FullPath()).
+				Int("queries", counter.Queries()).
+				Int("budget", counter.Budget()).
+				Int("status", c.Writer.Status()).
+				Send()
+		}
+	}
+}
+
+// UnlimitedQueries lifts the query budget for bulk routes, such as imports
+// and exports, that run a query per row or page by design
+func UnlimitedQueries() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		querybudget.Unlimited(c.Request.Context())
+		c.Next()
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -24,6 +25,10 @@ type RateLimiter struct {
 	redis       *cache.RedisClient
 	maxRequests int
 	window      time.Duration
+
+	// Per-consumer limits (optional, see WithConsumerQuotas)
+	jwtSecret string
+	quotas    QuotaLookup
 }
 
 // NewRateLimiter creates a new Redis-based rate limiter
@@ -35,14 +40,29 @@ func NewRateLimiter(redis *cache.RedisClient, maxRequests int, window time.Durat
 	}
 }
 
+// WithConsumerQuotas limits authenticated consumers per user instead of per
+// IP, at their quota when one is set. Rate limiting runs before route
+// authentication, so consumers are identified from the bearer token.
+func (rl *RateLimiter) WithConsumerQuotas(jwtSecret string, quotas QuotaLookup) *RateLimiter {
+	rl.jwtSecret = jwtSecret
+	rl.quotas = quotas
+	return rl
+}
+
 // Middleware creates rate limiting middleware using Redis
 func (rl *RateLimiter) Middleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// Get client identifier (IP or user ID)
-		identifier := c.ClientIP()
+		// Get client identifier (IP or user ID) and its limit
+		identifier, maxRequests := c.ClientIP(), rl.maxRequests
 		if userID, exists := c.Get("user_id"); exists {
 			identifier = fmt.Sprintf("user:%v", userID)
 		}
+		if rl.jwtSecret != "" {
+			if userID, ok := bearerUserID(c, rl.jwtSecret); ok {
+				identifier = fmt.Sprintf("user:%d", userID)
+				maxRequests = consumerQuota(c, rl.quotas, userID, maxRequests)
+			}
+		}
 
 		key := fmt.Sprintf("ratelimit:%s", identifier)
 		ctx := context.Background()
@@ -61,8 +81,8 @@ func (rl *RateLimiter) Middleware() gin.HandlerFunc {
 		}
 
 		// Check if rate limit exceeded
-		if count > int64(rl.maxRequests) {
-			c.Header("X-RateLimit-Limit", fmt.Sprintf("%d", rl.maxRequests))
+		if count > int64(maxRequests) {
+			c.Header("X-RateLimit-Limit", fmt.Sprintf("%d", maxRequests))
 			c.Header("X-RateLimit-Remaining", "0")
 			c.Header("X-RateLimit-Reset", fmt.Sprintf("%d", time.Now().Add(rl.window).Unix()))
 
@@ -76,8 +96,8 @@ func (rl *RateLimiter) Middleware() gin.HandlerFunc {
 		}
 
 		// Set rate limit headers
-		remaining := rl.maxRequests - int(count)
-		c.Header("X-RateLimit-Limit", fmt.Sprintf("%d", rl.maxRequests))
+		remaining := maxRequests - int(count)
+		c.Header("X-RateLimit-Limit", fmt.Sprintf("%d", maxRequests))
 		c.Header("X-RateLimit-Remaining", fmt.Sprintf("%d", remaining))
 
 		c.Next()
@@ -93,6 +113,7 @@ type RateLimitConfig struct {
 	Limit      int           // Maximum number of requests
 	Window     time.Duration // Time window
 	KeyFunc    KeyFunc       // Function to generate rate limit key
+	LimitFunc  LimitFunc     // Optional per-request limit (0 = Limit)
 	SkipPaths  []string      // Paths to skip
 	Message    string        // Custom message for rate limit exceeded
 	StatusCode int           // Custom status code (default: 429)
@@ -101,6 +122,9 @@ type RateLimitConfig struct {
 // KeyFunc defines the function to generate rate limit key
 type KeyFunc func(*gin.Context) string
 
+// LimitFunc returns the request limit for a request (0 = the configured Limit)
+type LimitFunc func(*gin.Context) int
+
 // DefaultRateLimitConfig returns default rate limit configuration
 func DefaultRateLimitConfig() RateLimitConfig {
 	return RateLimitConfig{
@@ -167,8 +191,8 @@ func newInMemoryRateLimiter(config RateLimitConfig) *inMemoryRateLimiter {
 	return rl
 }
 
-// allow checks if a request is allowed
-func (rl *inMemoryRateLimiter) allow(key string) (bool, int, time.Duration) {
+// allow checks if a request is allowed under limit
+func (rl *inMemoryRateLimiter) allow(key string, limit int) (bool, int, time.Duration) {
 	rl.mu.Lock()
 	defer rl.mu.Unlock()
 
@@ -182,15 +206,15 @@ func (rl *inMemoryRateLimiter) allow(key string) (bool, int, time.Duration) {
 			resetTime:  now.Add(rl.config.Window),
 			lastAccess: now,
 		}
-		return true, rl.config.Limit - 1, rl.config.Window
+		return true, limit - 1, rl.config.Window
 	}
 
 	// Update last access
 	c.lastAccess = now
 
-	if c.count < rl.config.Limit {
+	if c.count < limit {
 		c.count++
-		remaining := rl.config.Limit - c.count
+		remaining := limit - c.count
 		resetIn := c.resetTime.Sub(now)
 		return true, remaining, resetIn
 	}
@@ -250,14 +274,20 @@ func RateLimitMiddleware(cfg RateLimitConfig) gin.HandlerFunc {
 			return
 		}
 
-		// Get rate limit key
+		// Get rate limit key and limit
 		key := cfg.KeyFunc(c)
+		limit := cfg.Limit
+		if cfg.LimitFunc != nil {
+			if l := cfg.LimitFunc(c); l > 0 {
+				limit = l
+			}
+		}
 
 		// Check if request is allowed
-		allowed, remaining, resetIn := limiter.allow(key)
+		allowed, remaining, resetIn := limiter.allow(key, limit)
 
 		// Set rate limit headers
-		c.Header("X-RateLimit-Limit", fmt.Sprintf("%d", cfg.Limit))
+		c.Header("X-RateLimit-Limit", fmt.Sprintf("%d", limit))
 		c.Header("X-RateLimit-Remaining", fmt.Sprintf("%d", remaining))
 		c.Header("X-RateLimit-Reset", fmt.Sprintf("%d", time.Now().Add(resetIn).Unix()))
 
@@ -287,3 +317,69 @@ func UserKeyFunc(c *gin.Context) string {
 	}
 	return c.ClientIP()
 }
+
+// ============================================
+// Per-Consumer Quotas
+// ============================================
+
+// QuotaLookup supplies per-consumer request limits that replace the default
+type QuotaLookup interface {
+	QuotaFor(ctx context.Context, userID int) (int, bool)
+}
+
+// ConsumerKeyFunc generates rate limit keys per consumer (from the bearer
+// token, for limiters that run before authentication), falling back to the client IP
+func ConsumerKeyFunc(jwtSecret string) KeyFunc {
+	return func(c *gin.Context) string {
+		if userID, ok := bearerUserID(c, jwtSecret); ok {
+			return fmt.Sprintf("user:%d", userID)
+		}
+		return c.ClientIP()
+	}
+}
+
+// ConsumerLimitFunc applies each consumer's quota, if one is set
+func ConsumerLimitFunc(jwtSecret string, quotas QuotaLookup) LimitFunc {
+	return func(c *gin.Context) int {
+		if userID, ok := bearerUserID(c, jwtSecret); ok {
+			return consumerQuota(c, quotas, userID, 0)
+		}
+		return 0
+	}
+}
+
+// consumerQuota returns the consumer's quota, or fallback without one
+func consumerQuota(c *gin.Context, quotas QuotaLookup, userID, fallback int) int {
+	if quotas == nil {
+		return fallback
+	}
+	if limit, ok := quotas.QuotaFor(c.Request.Context(), userID); ok {
+		return limit
+	}
+	return fallback
+}
+
+// bearerUserID returns the user of a valid bearer token without requiring one
+func bearerUserID(c *gin.Context, jwtSecret string) (int, bool) {
+	claims, ok := bearerClaims(c, jwtSecret)
+	if !ok {
+		return 0, false
+	}
+	return claims.UserID, true
+}
+
+// bearerClaims returns the claims of a valid bearer token without requiring one
+func bearerClaims(c *gin.Context, jwtSecret string) (*Claims, bool) {
+	header := c.GetHeader("Authorization")
+	if header == "" {
+		return nil, false
+	}
+
+	// Same token formats as RequireAuth
+	tokenString := strings.TrimPrefix(strings.TrimPrefix(header, "Bearer "), "bearer ")
+	claims, err := parseToken(tokenString, jwtSecret)
+	if err != nil {
+		return nil, false
+	}
+	return claims, true
+}
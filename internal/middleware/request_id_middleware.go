@@ -0,0 +1,72 @@
+// internal/middleware/request_id_middleware.go
+package middleware
+
+import (
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// ========================================================================
+// REQUEST ID MIDDLEWARE - Correlating logs, errors and support requests
+// ========================================================================
+//
+// Every request gets an ID: the caller's X-Request-ID when it is usable,
+// otherwise a generated one. The ID is returned in the X-Request-ID
+// response header and in error responses, and every line logged through
+// logger.FromContext(ctx) while serving the request carries it, so a
+// client can quote it and support can find the matching log lines.
+// ========================================================================
+
+// requestIDKey is where the request ID is kept in the gin context
+const requestIDKey = "request_id"
+
+// RequestIDMiddleware accepts or generates the request's ID and propagates
+// it to the context, the request-scoped logger and the response headers
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(config.RequestIDHeader)
+		if !isValidRequestID(requestID) {
+			requestID = uuid.New().String()
+		}
+		setRequestID(c, requestID)
+		c.Next()
+	}
+}
+
+// GetRequestID retrieves the request ID from the context
+func GetRequestID(c *gin.Context) string {
+	if requestID, exists := c.Get(requestIDKey); exists {
+		if id, ok := requestID.(string); ok {
+			return id
+		}
+	}
+	return ""
+}
+
+// setRequestID stores the request ID for handlers, services and the client
+func setRequestID(c *gin.Context, requestID string) {
+	c.Set(requestIDKey, requestID)
+	c.Request = c.Request.WithContext(logger.WithRequestID(c.Request.Context(), requestID))
+	c.Header(config.RequestIDHeader, requestID)
+}
+
+// isValidRequestID reports whether a client-supplied ID is safe to log and
+// echo back: non-empty, at most MaxRequestIDLength characters, and only
+// letters, digits and -_.:
+func isValidRequestID(id string) bool {
+	if id == "" || len(id) > config.MaxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		case r == '-', r == '_', r == '.', r == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,36 @@
+// internal/middleware/sandbox_middleware.go
+package middleware
+
+import (
+	"net/http"
+
+	"barber-booking-system/internal/sandbox"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Sandbox switches requests that send the X-Sandbox header to sandbox mode
+// (see internal/sandbox) and echoes the header on the response. When
+// sandbox mode is disabled such requests are refused rather than run live.
+func Sandbox(enabled bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !sandbox.IsRequested(c.GetHeader(sandbox.Header)) {
+			c.Next()
+			return
+		}
+
+		if !enabled {
+			RespondWithError(c, &AppError{
+				Code:       "SANDBOX_DISABLED",
+				Message:    "Sandbox mode is not available on this server",
+				StatusCode: http.StatusForbidden,
+			})
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(sandbox.WithSandbox(c.Request.Context()))
+		c.Header(sandbox.Header, "true")
+		c.Next()
+	}
+}
@@ -3,6 +3,7 @@ package middleware
 
 import (
 	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/chaos"
 	appConfig "barber-booking-system/internal/config"
 	"log"
 	"time"
@@ -18,6 +19,8 @@ import (
 type SetupConfig struct {
 	Config      *appConfig.Config
 	RedisClient *cache.RedisClient
+	Quotas      QuotaLookup   // Per-consumer rate limits (nil = limit by IP only)
+	ChaosFaults *chaos.Faults // Faults for requests without X-Chaos when chaos is enabled (nil = none)
 }
 
 // SetupAll configures all middleware in the correct order
@@ -34,6 +37,22 @@ func SetupAll(router *gin.Engine, cfg SetupConfig) {
 	router.Use(RequestIDMiddleware())
 	log.Println("   ✓ Request ID tracking")
 
+	// 2a. Language - before anything that can respond with an error
+	router.Use(Localization(cfg.Config.Localization.DefaultLocale))
+	log.Println("   ✓ Response language (Accept-Language)")
+
+	// 2b. Query budget - counts the database queries of everything after it
+	if cfg.Config.QueryBudget.Budget > 0 {
+		router.Use(QueryBudget(cfg.Config.QueryBudget.Budget, cfg.Config.QueryBudget.Strict))
+		log.Printf("   ✓ Query budget (%d per request, strict: %v)", cfg.Config.QueryBudget.Budget, cfg.Config.QueryBudget.Strict)
+	}
+
+	// 2c. Fault injection - before anything that calls Redis or the database
+	if cfg.Config.Chaos.Enabled {
+		router.Use(Chaos(cfg.ChaosFaults))
+		log.Println("   ⚠️  Fault injection enabled (X-Chaos)")
+	}
+
 	// 3. CORS - handle cross-origin requests
 	setupCORS(router, cfg.Config)
 	log.Println("   ✓ CORS configured")
@@ -47,7 +66,7 @@ func SetupAll(router *gin.Engine, cfg SetupConfig) {
 	log.Println("   ✓ Request logging")
 
 	// 6. Rate Limiting
-	setupRateLimiting(router, cfg.Config, cfg.RedisClient)
+	setupRateLimiting(router, cfg.Config, cfg.RedisClient, cfg.Quotas)
 
 	// 7. Error Handler - must be last
 	router.Use(ErrorHandler())
@@ -80,7 +99,9 @@ func setupLogging(router *gin.Engine, cfg *appConfig.Config) {
 }
 
 // setupRateLimiting configures rate limiting with Redis or in-memory fallback
-func setupRateLimiting(router *gin.Engine, cfg *appConfig.Config, redisClient *cache.RedisClient) {
+// With quotas, authenticated consumers are limited per user at their quota
+// (or API_RATE_LIMIT) instead of per IP.
+func setupRateLimiting(router *gin.Engine, cfg *appConfig.Config, redisClient *cache.RedisClient, quotas QuotaLookup) {
 	if redisClient != nil {
 		// Redis-based distributed rate limiting
 		log.Println("   ✓ Rate limiting (Redis-based)")
@@ -89,12 +110,19 @@ func setupRateLimiting(router *gin.Engine, cfg *appConfig.Config, redisClient *c
 			cfg.API.RateLimit,
 			time.Minute,
 		)
+		if quotas != nil {
+			rateLimiter.WithConsumerQuotas(cfg.JWT.Secret, quotas)
+		}
 		router.Use(rateLimiter.Middleware())
 	} else {
 		// Fallback to in-memory rate limiting
 		log.Println("   ✓ Rate limiting (in-memory)")
 		rateLimitConfig := DefaultRateLimitConfig()
 		rateLimitConfig.Limit = cfg.API.RateLimit
+		if quotas != nil {
+			rateLimitConfig.KeyFunc = ConsumerKeyFunc(cfg.JWT.Secret)
+			rateLimitConfig.LimitFunc = ConsumerLimitFunc(cfg.JWT.Secret, quotas)
+		}
 		router.Use(RateLimitMiddleware(rateLimitConfig))
 	}
 }
@@ -107,6 +135,39 @@ func getLogFormat(cfg *appConfig.Config) LogFormat {
 	return TextFormat
 }
 
+// AdminIPFilter builds the admin route IP filter from configuration.
+// Returns nil (no filter) when no restrictions are configured. Forwarded
+// client addresses are only checked when trustedProxies are configured.
+func AdminIPFilter(cfg appConfig.AdminSecurityConfig, trustedProxies []string) (gin.HandlerFunc, error) {
+	if len(cfg.IPAllowlist) == 0 && len(cfg.IPDenylist) == 0 && len(cfg.BlockedCountries) == 0 {
+		return nil, nil
+	}
+
+	var resolver CountryResolver
+	switch {
+	case cfg.GeoIPDatabasePath != "":
+		db, err := LoadGeoIPDatabase(cfg.GeoIPDatabasePath)
+		if err != nil {
+			return nil, err
+		}
+		log.Printf("   ✓ GeoIP database loaded (%d networks)", db.Len())
+		resolver = db
+	case cfg.GeoIPCountryHeader != "":
+		resolver = HeaderCountryResolver{Header: cfg.GeoIPCountryHeader}
+	case len(cfg.BlockedCountries) > 0:
+		log.Println("   ⚠️  ADMIN_BLOCKED_COUNTRIES set without GEOIP_DB_PATH or GEOIP_COUNTRY_HEADER; country blocking disabled")
+	}
+
+	return IPFilter(IPFilterConfig{
+		AllowCIDRs:        cfg.IPAllowlist,
+		DenyCIDRs:         cfg.IPDenylist,
+		BlockedCountries:  cfg.BlockedCountries,
+		CountryResolver:   resolver,
+		BypassTokenHashes: cfg.BypassTokenHashes,
+		TrustForwardedFor: len(trustedProxies) > 0,
+	})
+}
+
 // SetupRequestLimits configures request body size limits
 func SetupRequestLimits(router *gin.Engine, maxSize int64) {
 	router.MaxMultipartMemory = maxSize
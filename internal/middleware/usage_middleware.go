@@ -0,0 +1,34 @@
+// internal/middleware/usage_middleware.go
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// UsageRecorder counts completed requests per consumer
+type UsageRecorder interface {
+	Record(userID int, method, route string, status int, latency time.Duration)
+}
+
+// TrackUsage records every request made by an authenticated consumer once it
+// completes. It must run before the authentication middleware of the routes
+// it covers (e.g. on the API group): the user is read from the context after
+// the handlers have run. Requests without a user or a matched route are ignored.
+func TrackUsage(recorder UsageRecorder) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		userID, ok := GetUserID(c)
+		if !ok {
+			return
+		}
+		route := c.FullPath()
+		if route == "" {
+			return
+		}
+		recorder.Record(userID, c.Request.Method, route, c.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,78 @@
+// internal/migrate/backfill.go
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Backfill is a batched UPDATE queued by an expand migration. Its file,
+// backfills/NAME.sql next to the migrations, names the table (and key
+// column, default id) and holds one UPDATE for the keys in [$1, $2):
+//
+//	-- migrate:table bookings
+//	UPDATE bookings SET total_cents = ROUND(total_price * 100)
+//	WHERE id >= $1 AND id < $2 AND total_cents IS NULL;
+//
+// The UPDATE must be safe to run twice on the same keys.
+type Backfill struct {
+	Name      string
+	Table     string
+	KeyColumn string
+	SQL       string
+}
+
+// LoadBackfill reads backfills/NAME.sql in dir
+func LoadBackfill(dir, name string) (*Backfill, error) {
+	path := filepath.Join(dir, "backfills", name+".sql")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backfill %s: %w", name, err)
+	}
+
+	b := &Backfill{Name: name, KeyColumn: "id"}
+	for _, match := range directive.FindAllStringSubmatch(string(content), -1) {
+		args := strings.Fields(match[2])
+		if len(args) != 1 {
+			return nil, fmt.Errorf("backfill %s: migrate:%s takes one name", name, match[1])
+		}
+		switch match[1] {
+		case "table":
+			b.Table = args[0]
+		case "key":
+			b.KeyColumn = args[0]
+		default:
+			return nil, fmt.Errorf("backfill %s: unknown directive migrate:%s", name, match[1])
+		}
+	}
+	if b.Table == "" {
+		return nil, fmt.Errorf("backfill %s: migrate:table is required", name)
+	}
+
+	statements := SplitStatements(string(content))
+	if len(statements) != 1 || !strings.HasPrefix(statements[0].Normalized(), "UPDATE ") {
+		return nil, fmt.Errorf("backfill %s must be a single UPDATE", name)
+	}
+	b.SQL = statements[0].SQL
+	if !strings.Contains(b.SQL, "$1") || !strings.Contains(b.SQL, "$2") {
+		return nil, fmt.Errorf("backfill %s must limit its UPDATE to keys in [$1, $2)", name)
+	}
+	return b, nil
+}
+
+// Backfills loads every backfill the migrations queue
+func Backfills(dir string, migrations []Migration) ([]Backfill, error) {
+	var backfills []Backfill
+	for _, m := range migrations {
+		for _, name := range m.Backfills {
+			b, err := LoadBackfill(dir, name)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", m.File(), err)
+			}
+			backfills = append(backfills, *b)
+		}
+	}
+	return backfills, nil
+}
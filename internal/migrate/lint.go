@@ -0,0 +1,189 @@
+// internal/migrate/lint.go
+package migrate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ========================================================================
+// LINT - Rejecting migrations that lock or break a live database
+// ========================================================================
+//
+// Migrations run while the previous release is still serving traffic, so
+// none may hold long locks on busy tables or remove what that release
+// still uses. Tables created in the same migration are exempt: nobody uses
+// them yet. Migrations up to config.MigrationLintBaseline predate the
+// linter and are skipped.
+// ========================================================================
+
+// Lint rules
+const (
+	RuleIndexNotConcurrent = "index-not-concurrent"
+	RuleColumnTypeChange   = "column-type-change"
+	RuleSetNotNull         = "set-not-null"
+	RuleConstraintNotValid = "constraint-not-valid"
+	RuleDestructive        = "destructive-outside-contract"
+)
+
+// Rules describes each lint rule and the safe alternative
+var Rules = map[string]string{
+	RuleIndexNotConcurrent: "CREATE/DROP INDEX blocks writes to the table while it runs; use CONCURRENTLY",
+	RuleColumnTypeChange:   "changing a column's type rewrites a large table under an exclusive lock; add a new column, backfill it and switch over",
+	RuleSetNotNull:         "SET NOT NULL scans a large table under an exclusive lock; add a CHECK (col IS NOT NULL) NOT VALID constraint and VALIDATE it in a later migration",
+	RuleConstraintNotValid: "adding a CHECK or FOREIGN KEY constraint scans a large table under lock; add it NOT VALID and VALIDATE it in a later migration",
+	RuleDestructive:        "dropping or renaming a table or column breaks the release still running; do it in a contract migration",
+}
+
+// Finding is a lint rule a migration breaks
+type Finding struct {
+	Version int
+	File    string
+	Line    int
+	Rule    string
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s", f.File, f.Line, f.Rule, f.Message)
+}
+
+// LintOptions configures the linter
+type LintOptions struct {
+	Since       int      // Only lint migrations after this version
+	LargeTables []string // Tables too big to rewrite or scan under lock
+}
+
+var (
+	createTable = regexp.MustCompile(`^CREATE (?:UNLOGGED )?TABLE (?:IF NOT EXISTS )?([\w."]+)`)
+	createIndex = regexp.MustCompile(`^CREATE (?:UNIQUE )?INDEX (CONCURRENTLY )?(?:IF NOT EXISTS )?(?:[\w."]+ )?ON (?:ONLY )?([\w."]+)`)
+	dropIndex   = regexp.MustCompile(`^DROP INDEX (CONCURRENTLY )?`)
+	dropTable   = regexp.MustCompile(`^DROP TABLE (?:IF EXISTS )?([\w."]+)`)
+	alterTable  = regexp.MustCompile(`^ALTER TABLE (?:IF EXISTS )?(?:ONLY )?([\w."]+) (.*)$`)
+
+	typeChange = regexp.MustCompile(`^ALTER (?:COLUMN )?\S+ (?:SET DATA )?TYPE\b`)
+	setNotNull = regexp.MustCompile(`^ALTER (?:COLUMN )?\S+ SET NOT NULL\b`)
+	addCheck   = regexp.MustCompile(`^ADD (?:CONSTRAINT \S+ )?(?:CHECK|FOREIGN KEY)\b`)
+	dropColumn = regexp.MustCompile(`^DROP (?:COLUMN )?(?:IF EXISTS )?\S+`)
+	rename     = regexp.MustCompile(`^RENAME\b`)
+)
+
+// Lint checks a migration against every rule it does not allow
+func Lint(m *Migration, opts LintOptions) []Finding {
+	if m.Version <= opts.Since {
+		return nil
+	}
+
+	large := make(map[string]bool, len(opts.LargeTables))
+	for _, table := range opts.LargeTables {
+		large[strings.ToUpper(table)] = true
+	}
+	created := make(map[string]bool)
+
+	var findings []Finding
+	report := func(stmt Statement, rule, detail string) {
+		if _, ok := m.Allow[rule]; ok {
+			return
+		}
+		findings = append(findings, Finding{
+			Version: m.Version,
+			File:    m.File(),
+			Line:    stmt.Line,
+			Rule:    rule,
+			Message: detail + ": " + Rules[rule],
+		})
+	}
+
+	for _, stmt := range m.Statements() {
+		sql := stmt.Normalized()
+
+		if match := createTable.FindStringSubmatch(sql); match != nil {
+			created[tableName(match[1])] = true
+			continue
+		}
+		if match := createIndex.FindStringSubmatch(sql); match != nil {
+			if match[1] == "" && !created[tableName(match[2])] {
+				report(stmt, RuleIndexNotConcurrent, "index on "+strings.ToLower(tableName(match[2])))
+			}
+			continue
+		}
+		if match := dropIndex.FindStringSubmatch(sql); match != nil {
+			if match[1] == "" {
+				report(stmt, RuleIndexNotConcurrent, "index dropped")
+			}
+			continue
+		}
+		if match := dropTable.FindStringSubmatch(sql); match != nil {
+			if m.Phase != PhaseContract && !created[tableName(match[1])] {
+				report(stmt, RuleDestructive, "table "+strings.ToLower(tableName(match[1]))+" dropped")
+			}
+			continue
+		}
+
+		match := alterTable.FindStringSubmatch(sql)
+		if match == nil {
+			continue
+		}
+		table := tableName(match[1])
+		if created[table] {
+			continue
+		}
+		name := strings.ToLower(table)
+		for _, action := range splitActions(match[2]) {
+			switch {
+			case typeChange.MatchString(action) && large[table]:
+				report(stmt, RuleColumnTypeChange, "column type changed on "+name)
+			case setNotNull.MatchString(action) && large[table]:
+				report(stmt, RuleSetNotNull, "NOT NULL set on "+name)
+			case addCheck.MatchString(action) && !strings.HasSuffix(action, " NOT VALID") && large[table]:
+				report(stmt, RuleConstraintNotValid, "constraint added to "+name)
+			case (dropColumn.MatchString(action) && !strings.HasPrefix(action, "DROP CONSTRAINT")) || rename.MatchString(action):
+				if m.Phase != PhaseContract {
+					report(stmt, RuleDestructive, "column dropped or renamed on "+name)
+				}
+			}
+		}
+	}
+	return findings
+}
+
+// LintAll lints every migration
+func LintAll(migrations []Migration, opts LintOptions) []Finding {
+	var findings []Finding
+	for i := range migrations {
+		findings = append(findings, Lint(&migrations[i], opts)...)
+	}
+	return findings
+}
+
+// tableName drops the schema and quotes from a normalized table name
+func tableName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.Trim(name, `"`)
+}
+
+// splitActions splits the actions of an ALTER TABLE on commas outside
+// parentheses and quotes
+func splitActions(actions string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(actions); i++ {
+		switch actions[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case '\'':
+			i = closingQuote(actions, i) - 1
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(actions[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, strings.TrimSpace(actions[start:]))
+}
@@ -0,0 +1,152 @@
+// internal/migrate/migration.go
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ========================================================================
+// MIGRATIONS - Versioned schema changes and their directives
+// ========================================================================
+//
+// Migrations are NNNNNN_name.up.sql / .down.sql pairs applied in version
+// order. Comments starting "-- migrate:" steer the linter and the runner:
+//
+//	-- migrate:phase expand|contract  Expand/contract step (default: neither)
+//	-- migrate:backfill NAME          Queue backfills/NAME.sql once applied
+//	-- migrate:requires NAME          Wait until backfill NAME has completed
+//	-- migrate:allow RULE REASON      Accept one lint rule, with the reason
+//
+// A change that old and new code must both survive is split in two: an
+// expand migration adds the new shape (nullable column, new table) and
+// queues a backfill, the application writes both shapes, and a later
+// contract migration that requires the backfill removes the old shape.
+// ========================================================================
+
+// Migration phases
+const (
+	PhaseExpand   = "expand"
+	PhaseContract = "contract"
+)
+
+// Migration is one versioned schema change
+type Migration struct {
+	Version int
+	Name    string
+	Path    string // The .up.sql file
+	SQL     string
+
+	Phase     string            // PhaseExpand, PhaseContract or ""
+	Backfills []string          // Backfills queued once applied
+	Requires  []string          // Backfills that must have completed first
+	Allow     map[string]string // Lint rule -> reason
+}
+
+// File returns the migration's file name
+func (m *Migration) File() string {
+	return filepath.Base(m.Path)
+}
+
+// Statements splits the migration into statements
+func (m *Migration) Statements() []Statement {
+	return SplitStatements(m.SQL)
+}
+
+// Concurrent reports whether the migration builds or drops indexes
+// concurrently, which cannot run inside a transaction
+func (m *Migration) Concurrent() bool {
+	for _, stmt := range m.Statements() {
+		if strings.Contains(stmt.Normalized(), " CONCURRENTLY ") {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	migrationFile = regexp.MustCompile(`^(\d+)_(.+)\.up\.sql$`)
+	directive     = regexp.MustCompile(`(?m)^\s*--\s*migrate:(\w+)\s*(.*?)\s*$`)
+	backfillName  = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// Load reads every up migration in dir, in version order
+func Load(dir string) ([]Migration, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.up.sql"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	migrations := make([]Migration, 0, len(paths))
+	seen := make(map[int]string)
+	for _, path := range paths {
+		match := migrationFile.FindStringSubmatch(filepath.Base(path))
+		if match == nil {
+			return nil, fmt.Errorf("%s: migration file names must look like 000001_name.up.sql", filepath.Base(path))
+		}
+		version, _ := strconv.Atoi(match[1])
+		if other, ok := seen[version]; ok {
+			return nil, fmt.Errorf("%s: version %d is also used by %s", filepath.Base(path), version, other)
+		}
+		seen[version] = filepath.Base(path)
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration: %w", err)
+		}
+		m, err := Parse(version, match[2], string(content))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+		m.Path = path
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Parse builds a migration from its SQL, reading its directives
+func Parse(version int, name, sql string) (*Migration, error) {
+	m := &Migration{Version: version, Name: name, SQL: sql, Allow: make(map[string]string)}
+
+	for _, match := range directive.FindAllStringSubmatch(sql, -1) {
+		key, args := match[1], strings.Fields(match[2])
+		switch key {
+		case "phase":
+			if len(args) != 1 || (args[0] != PhaseExpand && args[0] != PhaseContract) {
+				return nil, fmt.Errorf("migrate:phase must be %q or %q", PhaseExpand, PhaseContract)
+			}
+			m.Phase = args[0]
+		case "backfill", "requires":
+			if len(args) != 1 || !backfillName.MatchString(args[0]) {
+				return nil, fmt.Errorf("migrate:%s must name one backfill (lowercase letters, digits and _)", key)
+			}
+			if key == "backfill" {
+				m.Backfills = append(m.Backfills, args[0])
+			} else {
+				m.Requires = append(m.Requires, args[0])
+			}
+		case "allow":
+			if len(args) < 2 {
+				return nil, fmt.Errorf("migrate:allow must name a rule and give a reason")
+			}
+			if _, ok := Rules[args[0]]; !ok {
+				return nil, fmt.Errorf("migrate:allow names unknown rule %q", args[0])
+			}
+			m.Allow[args[0]] = strings.Join(args[1:], " ")
+		default:
+			return nil, fmt.Errorf("unknown directive migrate:%s", key)
+		}
+	}
+
+	if len(m.Backfills) > 0 && m.Phase != PhaseExpand {
+		return nil, fmt.Errorf("only expand migrations may queue backfills")
+	}
+	return m, nil
+}
@@ -0,0 +1,281 @@
+// internal/migrate/runner.go
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/config"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// RUNNER - Applying pending migrations with the guardrails
+// ========================================================================
+//
+// The applied version is kept in schema_migrations (version, dirty), the
+// same table golang-migrate uses, so either tool can take over. Before
+// applying anything the runner lints every pending migration and stops on
+// a finding. Each migration runs in a transaction with its version bump
+// and backfill queueing, except those building indexes CONCURRENTLY: their
+// statements run one at a time with the version marked dirty until the
+// last succeeds. A contract migration waits until the backfills it
+// requires have completed.
+// ========================================================================
+
+// ErrDirty is returned when an earlier run failed partway through a
+// migration that could not run in a transaction
+var ErrDirty = errors.New("database is dirty")
+
+// LintError lists the findings that stopped a run
+type LintError struct {
+	Findings []Finding
+}
+
+func (e *LintError) Error() string {
+	lines := make([]string, len(e.Findings))
+	for i, f := range e.Findings {
+		lines[i] = f.String()
+	}
+	return fmt.Sprintf("%d unsafe migration statement(s):\n%s", len(e.Findings), strings.Join(lines, "\n"))
+}
+
+// BackfillPendingError is returned when a contract migration requires a
+// backfill that has not completed
+type BackfillPendingError struct {
+	Migration string
+	Backfill  string
+	Status    string // Empty when the backfill was never queued
+}
+
+func (e *BackfillPendingError) Error() string {
+	status := e.Status
+	if status == "" {
+		status = "not queued"
+	}
+	return fmt.Sprintf("%s requires backfill %s, which is %s", e.Migration, e.Backfill, status)
+}
+
+// Runner applies the migrations in a directory
+type Runner struct {
+	db   *sqlx.DB
+	dir  string
+	lint LintOptions
+}
+
+// NewRunner creates a runner for the migrations in dir
+func NewRunner(db *sqlx.DB, dir string, lint LintOptions) *Runner {
+	return &Runner{db: db, dir: dir, lint: lint}
+}
+
+// DefaultLintOptions lints the migrations after the baseline against the
+// configured large tables
+func DefaultLintOptions() LintOptions {
+	return LintOptions{Since: config.MigrationLintBaseline, LargeTables: config.MigrationLargeTables}
+}
+
+// Check loads the migrations, verifies their backfills and requirements,
+// and lints them
+func Check(dir string, opts LintOptions) ([]Migration, []Finding, error) {
+	migrations, err := Load(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err := Backfills(dir, migrations); err != nil {
+		return nil, nil, err
+	}
+
+	queued := make(map[string]bool)
+	for _, m := range migrations {
+		for _, name := range m.Requires {
+			if !queued[name] {
+				return nil, nil, fmt.Errorf("%s requires backfill %s, which no earlier migration queues", m.File(), name)
+			}
+		}
+		for _, name := range m.Backfills {
+			if queued[name] {
+				return nil, nil, fmt.Errorf("%s queues backfill %s again", m.File(), name)
+			}
+			queued[name] = true
+		}
+	}
+	return migrations, LintAll(migrations, opts), nil
+}
+
+// Version returns the applied version (0 if none) and whether the last
+// migration failed partway
+func (r *Runner) Version(ctx context.Context) (int, bool, error) {
+	if _, err := r.db.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (version BIGINT NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL)
+	`); err != nil {
+		return 0, false, fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+
+	var row struct {
+		Version int  `db:"version"`
+		Dirty   bool `db:"dirty"`
+	}
+	err := r.db.GetContext(ctx, &row, `SELECT version, dirty FROM schema_migrations LIMIT 1`)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return row.Version, row.Dirty, nil
+}
+
+// Pending returns the migrations not applied yet, in order
+func (r *Runner) Pending(ctx context.Context) ([]Migration, error) {
+	migrations, _, err := Check(r.dir, r.lint)
+	if err != nil {
+		return nil, err
+	}
+	version, dirty, err := r.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if dirty {
+		return nil, fmt.Errorf("%w at version %d: finish or undo that migration by hand, then fix the version", ErrDirty, version)
+	}
+
+	var pending []Migration
+	for _, m := range migrations {
+		if m.Version > version {
+			pending = append(pending, m)
+		}
+	}
+	return pending, nil
+}
+
+// Up lints the pending migrations and applies them in order, stopping at
+// the first contract migration whose backfills have not completed. Returns
+// the migrations applied.
+func (r *Runner) Up(ctx context.Context) ([]Migration, error) {
+	pending, err := r.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if findings := LintAll(pending, r.lint); len(findings) > 0 {
+		return nil, &LintError{Findings: findings}
+	}
+
+	var applied []Migration
+	for i := range pending {
+		m := &pending[i]
+		if err := r.checkRequires(ctx, m); err != nil {
+			return applied, err
+		}
+		if err := r.apply(ctx, m); err != nil {
+			return applied, err
+		}
+		applied = append(applied, *m)
+	}
+	return applied, nil
+}
+
+// checkRequires ensures the backfills a migration requires have completed
+func (r *Runner) checkRequires(ctx context.Context, m *Migration) error {
+	for _, name := range m.Requires {
+		var status string
+		err := r.db.GetContext(ctx, &status, `SELECT status FROM schema_backfills WHERE name = $1`, name)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to check backfill %s: %w", name, err)
+		}
+		if status != config.BackfillStatusCompleted {
+			return &BackfillPendingError{Migration: m.File(), Backfill: name, Status: status}
+		}
+	}
+	return nil
+}
+
+// apply runs one migration and records its version
+func (r *Runner) apply(ctx context.Context, m *Migration) error {
+	if m.Concurrent() {
+		return r.applyConcurrent(ctx, m)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, m.SQL); err != nil {
+		return fmt.Errorf("%s failed: %w", m.File(), err)
+	}
+	if err := r.queueBackfills(ctx, tx, m); err != nil {
+		return err
+	}
+	if err := setVersion(ctx, tx, m.Version, false); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit %s: %w", m.File(), err)
+	}
+	return nil
+}
+
+// applyConcurrent runs a migration's statements one at a time outside a
+// transaction, leaving the version dirty if one fails
+func (r *Runner) applyConcurrent(ctx context.Context, m *Migration) error {
+	if err := setVersion(ctx, r.db, m.Version, true); err != nil {
+		return err
+	}
+	for _, stmt := range m.Statements() {
+		if _, err := r.db.ExecContext(ctx, stmt.SQL); err != nil {
+			return fmt.Errorf("%s:%d failed (the version is left dirty): %w", m.File(), stmt.Line, err)
+		}
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := r.queueBackfills(ctx, tx, m); err != nil {
+		return err
+	}
+	if err := setVersion(ctx, tx, m.Version, false); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit %s: %w", m.File(), err)
+	}
+	return nil
+}
+
+// queueBackfills queues the backfills an expand migration names
+func (r *Runner) queueBackfills(ctx context.Context, tx *sqlx.Tx, m *Migration) error {
+	for _, name := range m.Backfills {
+		b, err := LoadBackfill(r.dir, name)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO schema_backfills (name, migration_version, table_name, key_column, batch_sql)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (name) DO NOTHING
+		`, b.Name, m.Version, b.Table, b.KeyColumn, b.SQL)
+		if err != nil {
+			return fmt.Errorf("failed to queue backfill %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// setVersion records the applied version
+func setVersion(ctx context.Context, db sqlx.ExecerContext, version int, dirty bool) error {
+	if _, err := db.ExecContext(ctx, `DELETE FROM schema_migrations`); err != nil {
+		return fmt.Errorf("failed to clear schema version: %w", err)
+	}
+	if _, err := db.ExecContext(ctx, `INSERT INTO schema_migrations (version, dirty) VALUES ($1, $2)`, version, dirty); err != nil {
+		return fmt.Errorf("failed to record schema version: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+// internal/migrate/statements.go
+package migrate
+
+import (
+	"strings"
+)
+
+// Statement is one SQL statement of a migration
+type Statement struct {
+	SQL  string // As written, without the trailing semicolon
+	Line int    // Line the statement starts on (1-based)
+}
+
+// Normalized returns the statement with comments removed, whitespace
+// collapsed and keywords and identifiers upper-cased, for matching
+func (s Statement) Normalized() string {
+	return strings.ToUpper(strings.Join(strings.Fields(stripComments(s.SQL)), " "))
+}
+
+// SplitStatements splits a migration into statements on semicolons outside
+// quotes, comments and dollar-quoted bodies (e.g. function definitions)
+func SplitStatements(sql string) []Statement {
+	var statements []Statement
+	var current strings.Builder
+	line, startLine := 1, 0
+
+	flush := func() {
+		text := strings.TrimSpace(current.String())
+		if stripComments(text) != "" {
+			statements = append(statements, Statement{SQL: text, Line: startLine})
+		}
+		current.Reset()
+		startLine = 0
+	}
+
+	for i := 0; i < len(sql); i++ {
+		c := sql[i]
+		end := i + 1
+
+		switch {
+		case c == '-' && strings.HasPrefix(sql[i:], "--"):
+			end = indexFrom(sql, "\n", i)
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			end = indexFrom(sql, "*/", i+2) + 2
+		case c == '\'' || c == '"':
+			end = closingQuote(sql, i)
+		case c == '$':
+			if tag := dollarTag(sql[i:]); tag != "" {
+				end = indexFrom(sql, tag, i+len(tag)) + len(tag)
+			}
+		case c == ';':
+			flush()
+			continue
+		}
+		if end > len(sql) {
+			end = len(sql)
+		}
+
+		chunk := sql[i:end]
+		if startLine == 0 && strings.TrimSpace(chunk) != "" && !isComment(chunk) {
+			startLine = line
+		}
+		current.WriteString(chunk)
+		line += strings.Count(chunk, "\n")
+		i = end - 1
+	}
+	flush()
+	return statements
+}
+
+// indexFrom returns the index of substr in s at or after from, or len(s)
+func indexFrom(s, substr string, from int) int {
+	if from > len(s) {
+		return len(s)
+	}
+	if i := strings.Index(s[from:], substr); i >= 0 {
+		return from + i
+	}
+	return len(s)
+}
+
+// closingQuote returns the index just past the quote closing the one at
+// start, skipping doubled quotes
+func closingQuote(s string, start int) int {
+	quote := s[start]
+	for i := start + 1; i < len(s); i++ {
+		if s[i] == quote {
+			if i+1 < len(s) && s[i+1] == quote {
+				i++
+				continue
+			}
+			return i + 1
+		}
+	}
+	return len(s)
+}
+
+// dollarTag returns the dollar-quote opening s ($$ or $tag$), if any
+func dollarTag(s string) string {
+	for i := 1; i < len(s); i++ {
+		c := s[i]
+		if c == '$' {
+			return s[:i+1]
+		}
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || i > 1 && c >= '0' && c <= '9') {
+			return ""
+		}
+	}
+	return ""
+}
+
+// isComment reports whether a chunk is a comment
+func isComment(chunk string) bool {
+	return strings.HasPrefix(chunk, "--") || strings.HasPrefix(chunk, "/*")
+}
+
+// stripComments removes comments outside quotes
+func stripComments(sql string) string {
+	var out strings.Builder
+	for i := 0; i < len(sql); i++ {
+		switch c := sql[i]; {
+		case c == '-' && strings.HasPrefix(sql[i:], "--"):
+			i = indexFrom(sql, "\n", i) - 1
+			out.WriteByte(' ')
+		case c == '/' && strings.HasPrefix(sql[i:], "/*"):
+			i = indexFrom(sql, "*/", i+2) + 1
+			out.WriteByte(' ')
+		case c == '\'' || c == '"':
+			end := closingQuote(sql, i)
+			out.WriteString(sql[i:end])
+			i = end - 1
+		default:
+			out.WriteByte(c)
+		}
+	}
+	return strings.TrimSpace(out.String())
+}
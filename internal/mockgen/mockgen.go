@@ -0,0 +1,250 @@
+// internal/mockgen/mockgen.go
+package mockgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ========================================================================
+// MOCK GENERATOR - testify mocks for the interfaces of a Go file
+// ========================================================================
+// Every exported interface in the source file gets a Mock<Name> type that
+// embeds mock.Mock, records each call with its arguments and returns what
+// the test set up with On(...).Return(...). Only plain method lists are
+// supported; embedded interfaces and type parameters are rejected.
+// ========================================================================
+
+const mockImport = "github.com/stretchr/testify/mock"
+
+// Generate renders mocks for the exported interfaces declared in src.
+// importPath is the import path of the source file's package and pkg the
+// package name of the generated file.
+func Generate(filename string, src []byte, importPath, pkg string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		srcPkg:  file.Name.Name,
+		imports: make(map[string]string),
+		used:    map[string]string{file.Name.Name: importPath, "mock": mockImport},
+	}
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		g.imports[name] = path
+	}
+
+	var body bytes.Buffer
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			iface, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || !ts.Name.IsExported() {
+				continue
+			}
+			if ts.TypeParams != nil {
+				return nil, fmt.Errorf("%s: generic interfaces are not supported", ts.Name.Name)
+			}
+			if err := g.writeMock(&body, ts.Name.Name, iface); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by cmd/mockgen from %s. DO NOT EDIT.\n\n", filename)
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	b.WriteString("import (\n")
+	paths := make([]string, 0, len(g.used))
+	for _, path := range g.used {
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		if std := isStdlib(paths[i]); std != isStdlib(paths[j]) {
+			return std
+		}
+		return paths[i] < paths[j]
+	})
+	for i, path := range paths {
+		if i > 0 && isStdlib(path) != isStdlib(paths[i-1]) {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "\t%q\n", path)
+	}
+	b.WriteString(")\n\n")
+	b.Write(body.Bytes())
+
+	out, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated mocks do not compile: %w", err)
+	}
+	return out, nil
+}
+
+type generator struct {
+	srcPkg  string
+	imports map[string]string // Package name -> import path, as imported by the source file
+	used    map[string]string // ... the subset the generated file needs
+}
+
+// writeMock renders the mock type and methods for one interface
+func (g *generator) writeMock(b *bytes.Buffer, name string, iface *ast.InterfaceType) error {
+	mockName := "Mock" + name
+	fmt.Fprintf(b, "// %s is a mock %s.%s\n", mockName, g.srcPkg, name)
+	fmt.Fprintf(b, "type %s struct {\n\tmock.Mock\n}\n\n", mockName)
+	fmt.Fprintf(b, "var _ %s.%s = (*%s)(nil)\n\n", g.srcPkg, name, mockName)
+
+	for _, field := range iface.Methods.List {
+		fn, ok := field.Type.(*ast.FuncType)
+		if !ok || len(field.Names) == 0 {
+			return fmt.Errorf("%s: embedded interfaces are not supported", name)
+		}
+		if err := g.writeMethod(b, mockName, field.Names[0].Name, fn); err != nil {
+			return fmt.Errorf("%s.%s: %w", name, field.Names[0].Name, err)
+		}
+	}
+	return nil
+}
+
+// writeMethod renders one mocked method
+func (g *generator) writeMethod(b *bytes.Buffer, mockName, method string, fn *ast.FuncType) error {
+	var params, names []string
+	for i, field := range fn.Params.List {
+		typ, err := g.typeString(field.Type)
+		if err != nil {
+			return err
+		}
+		fieldNames := field.Names
+		if len(fieldNames) == 0 {
+			fieldNames = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+		}
+		for _, ident := range fieldNames {
+			params = append(params, ident.Name+" "+typ)
+			names = append(names, ident.Name)
+		}
+	}
+
+	var results []string
+	if fn.Results != nil {
+		for _, field := range fn.Results.List {
+			typ, err := g.typeString(field.Type)
+			if err != nil {
+				return err
+			}
+			for n := max(len(field.Names), 1); n > 0; n-- {
+				results = append(results, typ)
+			}
+		}
+	}
+
+	signature := fmt.Sprintf("%s(%s)", method, strings.Join(params, ", "))
+	switch len(results) {
+	case 0:
+	case 1:
+		signature += " " + results[0]
+	default:
+		signature += " (" + strings.Join(results, ", ") + ")"
+	}
+
+	fmt.Fprintf(b, "func (m *%s) %s {\n", mockName, signature)
+	if len(results) == 0 {
+		fmt.Fprintf(b, "\tm.Called(%s)\n}\n\n", strings.Join(names, ", "))
+		return nil
+	}
+
+	fmt.Fprintf(b, "\targs := m.Called(%s)\n", strings.Join(names, ", "))
+	returns := make([]string, len(results))
+	for i, typ := range results {
+		switch typ {
+		case "error":
+			returns[i] = fmt.Sprintf("args.Error(%d)", i)
+		case "bool":
+			returns[i] = fmt.Sprintf("args.Bool(%d)", i)
+		case "int":
+			returns[i] = fmt.Sprintf("args.Int(%d)", i)
+		case "string":
+			returns[i] = fmt.Sprintf("args.String(%d)", i)
+		default:
+			// A nil Return value leaves the zero value
+			fmt.Fprintf(b, "\tr%d, _ := args.Get(%d).(%s)\n", i, i, typ)
+			returns[i] = fmt.Sprintf("r%d", i)
+		}
+	}
+	fmt.Fprintf(b, "\treturn %s\n}\n\n", strings.Join(returns, ", "))
+	return nil
+}
+
+// typeString renders a type expression as seen from the generated package:
+// types declared in the source package are qualified with its name
+func (g *generator) typeString(expr ast.Expr) (string, error) {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if types.Universe.Lookup(t.Name) != nil {
+			return t.Name, nil
+		}
+		return g.srcPkg + "." + t.Name, nil
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		if !ok {
+			break
+		}
+		path, ok := g.imports[pkg.Name]
+		if !ok {
+			return "", fmt.Errorf("unknown package %s", pkg.Name)
+		}
+		g.used[pkg.Name] = path
+		return pkg.Name + "." + t.Sel.Name, nil
+	case *ast.StarExpr:
+		elem, err := g.typeString(t.X)
+		return "*" + elem, err
+	case *ast.Ellipsis:
+		elem, err := g.typeString(t.Elt)
+		return "..." + elem, err
+	case *ast.ArrayType:
+		elem, err := g.typeString(t.Elt)
+		if err != nil || t.Len == nil {
+			return "[]" + elem, err
+		}
+		lit, ok := t.Len.(*ast.BasicLit)
+		if !ok {
+			break
+		}
+		return "[" + lit.Value + "]" + elem, nil
+	case *ast.MapType:
+		key, err := g.typeString(t.Key)
+		if err != nil {
+			return "", err
+		}
+		value, err := g.typeString(t.Value)
+		return "map[" + key + "]" + value, err
+	case *ast.InterfaceType:
+		if len(t.Methods.List) == 0 {
+			return "interface{}", nil
+		}
+	}
+	return "", fmt.Errorf("unsupported type %T", expr)
+}
+
+// isStdlib reports whether path is a standard library package
+func isStdlib(path string) bool {
+	return !strings.Contains(strings.SplitN(path, "/", 2)[0], ".")
+}
@@ -0,0 +1,205 @@
+// internal/models/analytics_dataset.go
+package models
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// ANALYTICS DATASET - Anonymized bookings for demand modeling
+// ========================================================================
+//
+// A dataset describes bookings only through coarse attributes: the week or
+// month they fell in, the day of the week and a two-hour band of the
+// barber's local time, how far ahead they were made, how long they were
+// and a price band, with status, source, service category and city. No
+// ids, names, contact details or exact times are read out.
+//
+// Bookings that share every attribute are released as one row with their
+// count. A row standing for fewer than k bookings could single someone out,
+// so it is suppressed (k-anonymity); the dataset reports how many rows and
+// bookings were suppressed so analysts know what is missing.
+// ========================================================================
+
+// AnalyticsBooking is the part of a booking a dataset is built from
+type AnalyticsBooking struct {
+	ID                 int       `db:"id"`
+	ScheduledStartTime time.Time `db:"scheduled_start_time"`
+	ScheduledEndTime   time.Time `db:"scheduled_end_time"`
+	CreatedAt          time.Time `db:"created_at"`
+	TotalPrice         float64   `db:"total_price"`
+	Currency           string    `db:"currency"`
+	Status             string    `db:"status"`
+	BookingSource      string    `db:"booking_source"`
+	ServiceCategory    *string   `db:"service_category"`
+	City               string    `db:"city"`
+	Timezone           string    `db:"timezone"` // The barber's schedule timezone
+}
+
+// AnalyticsRecord is a booking reduced to the attributes a dataset releases
+type AnalyticsRecord struct {
+	Period    string // First day of the week (Monday) or month
+	DayOfWeek string
+	TimeBand  string // Two-hour band of the local start time, e.g. 08:00-10:00
+	LeadTime  string // Time from booking to start, e.g. 1-3d
+	Duration  string // Scheduled length, e.g. 30-60m
+	PriceBand string // Total price band, e.g. 20-30
+	Currency  string
+	Status    string
+	Source    string
+	Category  string
+	City      string
+}
+
+// AnalyticsRow is a released dataset row: the bookings sharing a record
+type AnalyticsRow struct {
+	AnalyticsRecord
+	Bookings int
+}
+
+// AnalyticsDataset is a k-anonymous dataset ready for release
+type AnalyticsDataset struct {
+	K                  int
+	Rows               []AnalyticsRow
+	Bookings           int // Bookings read, before suppression
+	SuppressedRows     int
+	SuppressedBookings int
+}
+
+// SuppressionRate is the share of bookings left out of the dataset
+func (d *AnalyticsDataset) SuppressionRate() float64 {
+	if d.Bookings == 0 {
+		return 0
+	}
+	return float64(d.SuppressedBookings) / float64(d.Bookings)
+}
+
+// Bucket reduces a booking to its released attributes. Times are bucketed
+// in loc, the barber's timezone; priceStep is the width of a price band.
+func (b *AnalyticsBooking) Bucket(loc *time.Location, granularity string, priceStep float64) AnalyticsRecord {
+	start := b.ScheduledStartTime.In(loc)
+
+	category := "other"
+	if b.ServiceCategory != nil && *b.ServiceCategory != "" {
+		category = strings.ToLower(*b.ServiceCategory)
+	}
+	city := "unknown"
+	if b.City != "" {
+		city = strings.ToLower(strings.TrimSpace(b.City))
+	}
+
+	band := start.Hour() / 2 * 2
+	return AnalyticsRecord{
+		Period:    analyticsPeriod(start, granularity).Format("2006-01-02"),
+		DayOfWeek: strings.ToLower(start.Weekday().String()),
+		TimeBand:  fmt.Sprintf("%02d:00-%02d:00", band, band+2),
+		LeadTime:  leadTimeBucket(b.ScheduledStartTime.Sub(b.CreatedAt)),
+		Duration:  durationBucket(b.ScheduledEndTime.Sub(b.ScheduledStartTime)),
+		PriceBand: priceBand(b.TotalPrice, priceStep),
+		Currency:  b.Currency,
+		Status:    b.Status,
+		Source:    b.BookingSource,
+		Category:  category,
+		City:      city,
+	}
+}
+
+// analyticsPeriod returns the first day of t's week (Monday) or month
+func analyticsPeriod(t time.Time, granularity string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	if granularity == config.AnalyticsGranularityMonth {
+		return day.AddDate(0, 0, 1-t.Day())
+	}
+	return day.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+}
+
+// leadTimeBucket labels how long before its start a booking was made
+func leadTimeBucket(lead time.Duration) string {
+	day := 24 * time.Hour
+	switch {
+	case lead < day:
+		return "0-1d"
+	case lead < 3*day:
+		return "1-3d"
+	case lead < 7*day:
+		return "3-7d"
+	case lead < 14*day:
+		return "7-14d"
+	default:
+		return "14d+"
+	}
+}
+
+// durationBucket labels a booking's scheduled length
+func durationBucket(d time.Duration) string {
+	switch minutes := d.Minutes(); {
+	case minutes <= 30:
+		return "0-30m"
+	case minutes <= 60:
+		return "30-60m"
+	case minutes <= 90:
+		return "60-90m"
+	case minutes <= 120:
+		return "90-120m"
+	default:
+		return "120m+"
+	}
+}
+
+// priceBand labels the band of width step a price falls in
+func priceBand(price, step float64) string {
+	low := math.Floor(price/step) * step
+	return fmt.Sprintf("%g-%g", low, low+step)
+}
+
+// BuildAnalyticsDataset groups records into rows and suppresses the rows
+// standing for fewer than k bookings. Rows are sorted by their attributes so
+// the same bookings always give the same dataset.
+func BuildAnalyticsDataset(records []AnalyticsRecord, k int) *AnalyticsDataset {
+	counts := make(map[AnalyticsRecord]int)
+	for _, record := range records {
+		counts[record]++
+	}
+
+	dataset := &AnalyticsDataset{K: k, Rows: []AnalyticsRow{}, Bookings: len(records)}
+	for record, count := range counts {
+		if count < k {
+			dataset.SuppressedRows++
+			dataset.SuppressedBookings += count
+			continue
+		}
+		dataset.Rows = append(dataset.Rows, AnalyticsRow{AnalyticsRecord: record, Bookings: count})
+	}
+
+	sort.Slice(dataset.Rows, func(i, j int) bool {
+		a, b := dataset.Rows[i].Fields(), dataset.Rows[j].Fields()
+		for n := range a {
+			if a[n] != b[n] {
+				return a[n] < b[n]
+			}
+		}
+		return false
+	})
+	return dataset
+}
+
+// AnalyticsFields names a record's attributes in the order Fields returns
+// them
+var AnalyticsFields = []string{
+	"period", "day_of_week", "time_band", "lead_time", "duration", "price_band",
+	"currency", "status", "source", "category", "city",
+}
+
+// Fields returns the record's attributes in AnalyticsFields order
+func (r AnalyticsRecord) Fields() []string {
+	return []string{
+		r.Period, r.DayOfWeek, r.TimeBand, r.LeadTime, r.Duration, r.PriceBand,
+		r.Currency, r.Status, r.Source, r.Category, r.City,
+	}
+}
@@ -0,0 +1,102 @@
+// internal/models/api_usage.go
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// APIUsage is one consumer's traffic to one endpoint during one hour
+type APIUsage struct {
+	UserID           int       `json:"user_id" db:"user_id"`
+	Hour             time.Time `json:"hour" db:"hour"`
+	Method           string    `json:"method" db:"method"`
+	Route            string    `json:"route" db:"route"` // Route template, e.g. /api/v1/bookings/:id
+	RequestCount     int64     `json:"request_count" db:"request_count"`
+	ClientErrorCount int64     `json:"client_error_count" db:"client_error_count"` // 4xx responses
+	ServerErrorCount int64     `json:"server_error_count" db:"server_error_count"` // 5xx responses
+	TotalLatencyMs   int64     `json:"total_latency_ms" db:"total_latency_ms"`
+	MaxLatencyMs     int64     `json:"max_latency_ms" db:"max_latency_ms"`
+}
+
+// Add counts one request
+func (u *APIUsage) Add(status int, latency time.Duration) {
+	ms := latency.Milliseconds()
+	u.RequestCount++
+	u.TotalLatencyMs += ms
+	if ms > u.MaxLatencyMs {
+		u.MaxLatencyMs = ms
+	}
+	switch {
+	case status >= 500:
+		u.ServerErrorCount++
+	case status >= 400:
+		u.ClientErrorCount++
+	}
+}
+
+// Merge adds other's counts into u
+func (u *APIUsage) Merge(other APIUsage) {
+	u.RequestCount += other.RequestCount
+	u.ClientErrorCount += other.ClientErrorCount
+	u.ServerErrorCount += other.ServerErrorCount
+	u.TotalLatencyMs += other.TotalLatencyMs
+	if other.MaxLatencyMs > u.MaxLatencyMs {
+		u.MaxLatencyMs = other.MaxLatencyMs
+	}
+}
+
+// APIUsageStats summarizes traffic overall, per endpoint, per day or per consumer
+type APIUsageStats struct {
+	UserID           *int       `json:"user_id,omitempty" db:"user_id"`
+	UserEmail        *string    `json:"user_email,omitempty" db:"user_email"`
+	Method           string     `json:"method,omitempty" db:"method"`
+	Route            string     `json:"route,omitempty" db:"route"`
+	Day              *time.Time `json:"day,omitempty" db:"day"`
+	RequestCount     int64      `json:"request_count" db:"request_count"`
+	ClientErrorCount int64      `json:"client_error_count" db:"client_error_count"`
+	ServerErrorCount int64      `json:"server_error_count" db:"server_error_count"`
+	ErrorRate        float64    `json:"error_rate" db:"-"` // Percent of requests answered 4xx or 5xx
+	AvgLatencyMs     float64    `json:"avg_latency_ms" db:"avg_latency_ms"`
+	MaxLatencyMs     int64      `json:"max_latency_ms" db:"max_latency_ms"`
+}
+
+// Finalize computes the error rate and rounds the average latency
+func (s *APIUsageStats) Finalize() {
+	s.AvgLatencyMs = math.Round(s.AvgLatencyMs*10) / 10
+	if s.RequestCount > 0 {
+		s.ErrorRate = math.Round(float64(s.ClientErrorCount+s.ServerErrorCount)/float64(s.RequestCount)*1000) / 10
+	}
+}
+
+// APIQuota overrides the default rate limit for one consumer
+type APIQuota struct {
+	UserID            int       `json:"user_id" db:"user_id"`
+	UserEmail         *string   `json:"user_email,omitempty" db:"user_email"`
+	RequestsPerMinute int       `json:"requests_per_minute" db:"requests_per_minute"`
+	Note              *string   `json:"note" db:"note"`
+	UpdatedAt         time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// APIUsageReport is a consumer's traffic over a date range
+type APIUsageReport struct {
+	UserID            int             `json:"user_id"`
+	From              time.Time       `json:"from"`
+	To                time.Time       `json:"to"`
+	RequestsPerMinute int             `json:"requests_per_minute"` // Current rate limit
+	CustomQuota       bool            `json:"custom_quota"`        // Whether the limit is a per-consumer override
+	Totals            APIUsageStats   `json:"totals"`
+	Endpoints         []APIUsageStats `json:"endpoints"`
+	Daily             []APIUsageStats `json:"daily"`
+}
+
+// Finalize computes the derived fields of every summary
+func (r *APIUsageReport) Finalize() {
+	r.Totals.Finalize()
+	for i := range r.Endpoints {
+		r.Endpoints[i].Finalize()
+	}
+	for i := range r.Daily {
+		r.Daily[i].Finalize()
+	}
+}
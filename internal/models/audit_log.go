@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// AuditLog represents a single platform-wide audit trail entry
+type AuditLog struct {
+	ID        int    `json:"id" db:"id"`
+	ActorID   *int   `json:"actor_id" db:"actor_id"`
+	ActorType string `json:"actor_type" db:"actor_type"` // customer, barber, admin, system
+
+	// ImpersonatorID is the real operator when an admin acted on ActorID's behalf
+	ImpersonatorID *int `json:"impersonator_id" db:"impersonator_id"`
+
+	Action     string  `json:"action" db:"action"`           // e.g. booking.cancelled, review.moderated
+	EntityType string  `json:"entity_type" db:"entity_type"` // booking, review, user, service, ...
+	EntityID   *int    `json:"entity_id" db:"entity_id"`
+	Metadata   JSONMap `json:"metadata" db:"metadata"`
+
+	// The changed fields before and after the action (nil when not recorded)
+	OldValues JSONMap `json:"old_values,omitempty" db:"old_values"`
+	NewValues JSONMap `json:"new_values,omitempty" db:"new_values"`
+
+	// Request context
+	IPAddress *string `json:"ip_address" db:"ip_address"`
+	UserAgent *string `json:"user_agent" db:"user_agent"`
+	RequestID *string `json:"request_id" db:"request_id"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// IsImpersonated returns true if the action was taken by an admin on behalf of the actor
+func (a *AuditLog) IsImpersonated() bool {
+	return a.ImpersonatorID != nil
+}
@@ -0,0 +1,52 @@
+// internal/models/auto_reply.go
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// BarberAutoReply is the message a barber's customers receive when they
+// request a booking while the barber is not working
+type BarberAutoReply struct {
+	BarberID  int       `json:"barber_id" db:"barber_id"`
+	Enabled   bool      `json:"enabled" db:"enabled"`
+	Message   string    `json:"message" db:"message"` // May use {{placeholders}}, see config.AutoReplyVariables
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// templateVariable matches a {{name}} placeholder, allowing inner spaces
+var templateVariable = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_]+)\s*\}\}`)
+
+// Validate checks the message and the placeholders it uses
+func (r *BarberAutoReply) Validate() error {
+	message := strings.TrimSpace(r.Message)
+	if message == "" {
+		return fmt.Errorf("message must not be empty")
+	}
+	if len(message) > config.MaxAutoReplyLength {
+		return fmt.Errorf("message must be at most %d characters", config.MaxAutoReplyLength)
+	}
+	for _, match := range templateVariable.FindAllStringSubmatch(message, -1) {
+		if !slices.Contains(config.AutoReplyVariables, match[1]) {
+			return fmt.Errorf("message must only use the placeholders {{%s}}, got {{%s}}",
+				strings.Join(config.AutoReplyVariables, "}}, {{"), match[1])
+		}
+	}
+	return nil
+}
+
+// Render replaces the message placeholders with values. Placeholders
+// without a value are rendered empty.
+func (r *BarberAutoReply) Render(values map[string]string) string {
+	return templateVariable.ReplaceAllStringFunc(r.Message, func(placeholder string) string {
+		name := templateVariable.FindStringSubmatch(placeholder)[1]
+		return values[name]
+	})
+}
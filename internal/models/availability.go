@@ -0,0 +1,88 @@
+// internal/models/availability.go
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// ========================================================================
+// TIME RANGES & SLOT GENERATION
+// ========================================================================
+
+// TimeRange is a half-open [Start, End) interval
+type TimeRange struct {
+	Start time.Time `json:"start_time"`
+	End   time.Time `json:"end_time"`
+}
+
+// Overlaps reports whether two ranges share any time (touching is not overlapping)
+func (r TimeRange) Overlaps(other TimeRange) bool {
+	return r.Start.Before(other.End) && r.End.After(other.Start)
+}
+
+// subtractRanges removes every cut from the windows, splitting them as needed
+func subtractRanges(windows, cuts []TimeRange) []TimeRange {
+	result := windows
+	for _, cut := range cuts {
+		var next []TimeRange
+		for _, w := range result {
+			if !w.Overlaps(cut) {
+				next = append(next, w)
+				continue
+			}
+			if w.Start.Before(cut.Start) {
+				next = append(next, TimeRange{Start: w.Start, End: cut.Start})
+			}
+			if cut.End.Before(w.End) {
+				next = append(next, TimeRange{Start: cut.End, End: w.End})
+			}
+		}
+		result = next
+	}
+	return result
+}
+
+// SlotOptions controls AvailableSlots
+type SlotOptions struct {
+	Duration time.Duration    // Length of the appointment
+	Buffer   time.Duration    // Gap required between the slot and existing bookings
+	Interval time.Duration    // Spacing between candidate start times
+	Earliest time.Time        // Slots starting before this are skipped (zero = no limit)
+	Segments DurationSegments // Active/passive split of the appointment (nil = fully active)
+}
+
+// AvailableSlots lists every slot of opts.Duration that fits inside a working
+// window without overlapping a busy range (padded by opts.Buffer). Candidate
+// start times step by opts.Interval from the start of each window. With
+// opts.Segments, only the slot's active segments must avoid busy ranges.
+func AvailableSlots(windows, busy []TimeRange, opts SlotOptions) []TimeRange {
+	if opts.Duration <= 0 {
+		return []TimeRange{}
+	}
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = opts.Duration
+	}
+
+	padded := make([]TimeRange, len(busy))
+	for i, b := range busy {
+		padded[i] = TimeRange{Start: b.Start.Add(-opts.Buffer), End: b.End.Add(opts.Buffer)}
+	}
+
+	slots := []TimeRange{}
+	for _, w := range windows {
+		for start := w.Start; !start.Add(opts.Duration).After(w.End); start = start.Add(interval) {
+			if !opts.Earliest.IsZero() && start.Before(opts.Earliest) {
+				continue
+			}
+			slot := TimeRange{Start: start, End: start.Add(opts.Duration)}
+			if !RangesOverlap(opts.Segments.ActiveRanges(slot.Start, slot.End), padded) {
+				slots = append(slots, slot)
+			}
+		}
+	}
+
+	sort.Slice(slots, func(i, j int) bool { return slots[i].Start.Before(slots[j].Start) })
+	return slots
+}
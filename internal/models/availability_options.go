@@ -24,6 +24,8 @@ type TimeSlotCheckOptions struct {
 	ExcludeBookingID int
 	CheckBufferTime  bool
 	BufferMinutes    int
+	DurationSegments DurationSegments // Active/passive split of the booking (nil = fully active)
+	Travel           Travel           // Location of the booking and the barber's travel times (zero = no travel)
 }
 
 // TimeSlotCheckOption is a function that modifies TimeSlotCheckOptions
@@ -63,6 +65,22 @@ func WithBufferTime(minutes int) TimeSlotCheckOption {
 	}
 }
 
+// WithDurationSegments lets the booking interleave with others during its
+// passive segments
+func WithDurationSegments(segments DurationSegments) TimeSlotCheckOption {
+	return func(o *TimeSlotCheckOptions) {
+		o.DurationSegments = segments
+	}
+}
+
+// WithTravel keeps the barber's travel time free between the booking and
+// bookings at the barber's other locations
+func WithTravel(travel Travel) TimeSlotCheckOption {
+	return func(o *TimeSlotCheckOptions) {
+		o.Travel = travel
+	}
+}
+
 // GetEffectiveStartTime returns start time minus buffer
 func (o *TimeSlotCheckOptions) GetEffectiveStartTime() time.Time {
 	if o.CheckBufferTime && o.BufferMinutes > 0 {
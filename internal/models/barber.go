@@ -1,6 +1,10 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"barber-booking-system/internal/ranking"
+)
 
 // Barber represents a barber's business profile
 type Barber struct {
@@ -14,6 +18,7 @@ type Barber struct {
 	BusinessName               *string `json:"business_name" db:"business_name"`
 	BusinessRegistrationNumber *string `json:"business_registration_number" db:"business_registration_number"`
 	TaxID                      *string `json:"tax_id" db:"tax_id"`
+	ShopID                     *int    `json:"shop_id" db:"shop_id"` // Shop the barber works at (nil = standalone)
 
 	// Location and contact
 	Address      string   `json:"address" db:"address"`
@@ -66,6 +71,10 @@ type Barber struct {
 	AutoAcceptBookings    bool `json:"auto_accept_bookings" db:"auto_accept_bookings"`
 	InstantBookingEnabled bool `json:"instant_booking_enabled" db:"instant_booking_enabled"`
 
+	// NoShowGraceMinutes is how long after the start time a confirmed
+	// booking nobody started is marked no-show (nil = platform default)
+	NoShowGraceMinutes *int `json:"no_show_grace_minutes" db:"no_show_grace_minutes"`
+
 	// Financial information
 	CommissionRate float64 `json:"commission_rate" db:"commission_rate"`
 	PayoutMethod   string  `json:"payout_method" db:"payout_method"`
@@ -77,9 +86,24 @@ type Barber struct {
 	LastActiveAt time.Time  `json:"last_active_at" db:"last_active_at"`
 	DeletedAt    *time.Time `json:"deleted_at" db:"deleted_at"`
 
+	// Featured placement running today (search listings only). Clients
+	// report clicks on featured results against FeaturedPlacementID.
+	IsFeatured          bool `json:"is_featured" db:"is_featured"`
+	FeaturedPlacementID *int `json:"featured_placement_id,omitempty" db:"featured_placement_id"`
+
+	// Ranking (search listings only). DistanceKm is set when the searcher
+	// sent a location; Ranking only when the listing asked for explain=true.
+	DistanceKm  *float64             `json:"distance_km,omitempty" db:"distance_km"`
+	RankScore   *float64             `json:"-" db:"rank_score"`
+	RankFactors ranking.Values       `json:"-" db:"rank_factors"`
+	Ranking     *ranking.Explanation `json:"ranking,omitempty" db:"-"`
+
 	// Relations (populated when needed)
 	User     *User           `json:"user,omitempty"`
 	Services []BarberService `json:"services,omitempty"`
+
+	// A nearby search's pick of the barber's most booked services
+	TopServices []BarberService `json:"top_services,omitempty"`
 }
 
 // BarberAvailability represents detailed availability patterns
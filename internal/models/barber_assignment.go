@@ -0,0 +1,45 @@
+// internal/models/barber_assignment.go
+package models
+
+import (
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// BARBER ASSIGNMENT - Picking a shop barber for "any available barber"
+// ========================================================================
+
+// BarberCandidate is a shop barber free for the requested slot
+type BarberCandidate struct {
+	BarberID int
+	Load     int // Active bookings the barber has that day
+
+	// The barber's own offerings of the requested services, in order
+	ServiceIDs []int
+}
+
+// ChooseBarber picks the barber for a booking from the candidates, which
+// are in the shop's barber order. least_loaded takes the barber with the
+// fewest bookings that day (the earliest in order on a tie), first_available
+// the first candidate, and random a candidate chosen with intn (e.g.
+// rand.Intn). Returns false when there are no candidates.
+func ChooseBarber(strategy string, candidates []BarberCandidate, intn func(int) int) (BarberCandidate, bool) {
+	if len(candidates) == 0 {
+		return BarberCandidate{}, false
+	}
+
+	switch strategy {
+	case config.AssignmentStrategyFirstAvailable:
+		return candidates[0], true
+	case config.AssignmentStrategyRandom:
+		return candidates[intn(len(candidates))], true
+	default:
+		chosen := candidates[0]
+		for _, c := range candidates[1:] {
+			if c.Load < chosen.Load {
+				chosen = c
+			}
+		}
+		return chosen, true
+	}
+}
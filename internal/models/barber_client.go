@@ -0,0 +1,50 @@
+// internal/models/barber_client.go
+package models
+
+import "time"
+
+// ========================================================================
+// BARBER CLIENTS - Client lists brought over from other tools
+// ========================================================================
+//
+// A barber's client list holds the clients a shop brought with it. Each
+// entry is linked to the client's customer account when there is one, and
+// records whether the client agreed to be contacted by email and SMS.
+// Imports create accounts for new clients with an email address; those
+// accounts have no password until the client claims them from an
+// invitation.
+// ========================================================================
+
+// BarberClient is an entry on a barber's client list
+type BarberClient struct {
+	ID           int       `json:"id" db:"id"`
+	BarberID     int       `json:"barber_id" db:"barber_id"`
+	UserID       *int      `json:"user_id" db:"user_id"` // The client's account; nil for clients without email
+	Name         string    `json:"name" db:"name"`
+	Email        *string   `json:"email" db:"email"` // Lower-case
+	Phone        *string   `json:"phone" db:"phone"` // Digits, with a leading + if given
+	Notes        *string   `json:"notes" db:"notes"`
+	EmailConsent bool      `json:"email_consent" db:"email_consent"`
+	SMSConsent   bool      `json:"sms_consent" db:"sms_consent"`
+	Source       string    `json:"source" db:"source"` // import
+	CreatedBy    *int      `json:"created_by" db:"created_by"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CustomerInvitation invites an imported client to claim the account
+// created for them. Only the hash of the token is stored.
+type CustomerInvitation struct {
+	ID        int        `json:"id" db:"id"`
+	UserID    int        `json:"user_id" db:"user_id"`
+	BarberID  *int       `json:"barber_id" db:"barber_id"` // The barber whose import created the account
+	TokenHash string     `json:"-" db:"token_hash"`
+	ExpiresAt time.Time  `json:"expires_at" db:"expires_at"`
+	ClaimedAt *time.Time `json:"claimed_at" db:"claimed_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// IsOpen reports whether the invitation can still be claimed at now
+func (i *CustomerInvitation) IsOpen(now time.Time) bool {
+	return i.ClaimedAt == nil && now.Before(i.ExpiresAt)
+}
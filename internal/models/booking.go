@@ -16,12 +16,21 @@ type Booking struct {
 	CustomerID *int `json:"customer_id" db:"customer_id"` // Nullable for guest bookings
 	BarberID   int  `json:"barber_id" db:"barber_id"`
 	TimeSlotID *int `json:"time_slot_id" db:"time_slot_id"`
+	SeriesID   *int `json:"series_id,omitempty" db:"series_id"`     // Set for occurrences of a recurring booking
+	LocationID *int `json:"location_id,omitempty" db:"location_id"` // One of the barber's locations (nil = the barber's address)
 
 	// Service information
+	BarberServiceID          *int    `json:"barber_service_id,omitempty" db:"barber_service_id"` // The first service performed
 	ServiceName              string  `json:"service_name" db:"service_name"`
 	ServiceCategory          *string `json:"service_category" db:"service_category"`
 	EstimatedDurationMinutes int     `json:"estimated_duration_minutes" db:"estimated_duration_minutes"`
 
+	// The services performed, in order (populated when needed)
+	LineItems []BookingLineItem `json:"line_items,omitempty" db:"-"`
+
+	// Active/passive split copied from the service (nil = fully active)
+	DurationSegments DurationSegments `json:"duration_segments,omitempty" db:"duration_segments"`
+
 	// Customer information (for guest bookings)
 	CustomerName  *string `json:"customer_name" db:"customer_name"`
 	CustomerEmail *string `json:"customer_email" db:"customer_email"`
@@ -38,17 +47,27 @@ type Booking struct {
 	TipAmount      float64 `json:"tip_amount" db:"tip_amount"`
 	Currency       string  `json:"currency" db:"currency"`
 
+	// Tax line items behind TaxAmount (populated when needed)
+	TaxLines []BookingTaxLine `json:"tax_lines,omitempty" db:"-"`
+
 	// Payment information
 	PaymentStatus    string     `json:"payment_status" db:"payment_status"` // pending, paid, partially_paid, refunded, failed
 	PaymentMethod    *string    `json:"payment_method" db:"payment_method"`
 	PaymentReference *string    `json:"payment_reference" db:"payment_reference"`
 	PaidAt           *time.Time `json:"paid_at" db:"paid_at"`
 
+	// Deposit held when booking and the fee kept from it on a no-show
+	DepositAmount float64 `json:"deposit_amount" db:"deposit_amount"`
+	NoShowFee     float64 `json:"no_show_fee" db:"no_show_fee"`
+
 	// Booking details
 	Notes           *string `json:"notes" db:"notes"`
 	SpecialRequests *string `json:"special_requests" db:"special_requests"`
 	InternalNotes   *string `json:"internal_notes" db:"internal_notes"` // For barber use only
 
+	// Answers to the barber's custom booking-form fields
+	CustomFields BookingFormAnswers `json:"custom_fields,omitempty" db:"custom_fields"`
+
 	// Communication tracking
 	ConfirmationMethod *string    `json:"confirmation_method" db:"confirmation_method"` // email, sms, phone, app
 	ConfirmationSentAt *time.Time `json:"confirmation_sent_at" db:"confirmation_sent_at"`
@@ -61,15 +80,18 @@ type Booking struct {
 	ActualEndTime      *time.Time `json:"actual_end_time" db:"actual_end_time"`
 
 	// Cancellation information
-	CancelledAt        *time.Time `json:"cancelled_at" db:"cancelled_at"`
-	CancelledBy        *int       `json:"cancelled_by" db:"cancelled_by"`
-	CancellationReason *string    `json:"cancellation_reason" db:"cancellation_reason"`
-	CancellationFee    float64    `json:"cancellation_fee" db:"cancellation_fee"`
+	CancelledAt            *time.Time `json:"cancelled_at" db:"cancelled_at"`
+	CancelledBy            *int       `json:"cancelled_by" db:"cancelled_by"`
+	CancellationReason     *string    `json:"cancellation_reason" db:"cancellation_reason"` // Free text
+	CancellationReasonCode *string    `json:"cancellation_reason_code" db:"cancellation_reason_code"`
+	CancellationFee        float64    `json:"cancellation_fee" db:"cancellation_fee"`
 
 	// Source and attribution
-	BookingSource  string  `json:"booking_source" db:"booking_source"` // mobile_app, web_app, phone, walk_in, admin
+	BookingSource  string  `json:"booking_source" db:"booking_source"` // mobile_app, web_app, phone, walk_in, admin, import
 	ReferralSource *string `json:"referral_source" db:"referral_source"`
 	UTMCampaign    *string `json:"utm_campaign" db:"utm_campaign"`
+	IsTest         bool    `json:"is_test" db:"is_test"`                 // Created in sandbox mode
+	ImportRef      *string `json:"import_ref,omitempty" db:"import_ref"` // Row an imported booking came from
 
 	// Audit fields
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
@@ -100,6 +122,11 @@ type BookingHistory struct {
 // BOOKING HELPER METHODS
 // ========================================================================
 
+// ActiveRanges returns the times during the booking when the barber is busy
+func (b *Booking) ActiveRanges() []TimeRange {
+	return b.DurationSegments.ActiveRanges(b.ScheduledStartTime, b.ScheduledEndTime)
+}
+
 // IsPending returns true if the booking is pending
 func (b *Booking) IsPending() bool {
 	return b.Status == config.BookingStatusPending
@@ -151,7 +178,21 @@ func (b *Booking) GetDuration() time.Duration {
 
 // IsUpcoming returns true if the booking is scheduled for the future
 func (b *Booking) IsUpcoming() bool {
-	return b.ScheduledStartTime.After(time.Now()) && (b.Status == config.BookingStatusPending || b.Status == config.BookingStatusConfirmed)
+	return b.IsUpcomingAt(time.Now())
+}
+
+// IsUpcomingAt returns true if the booking is scheduled after now and still active
+func (b *Booking) IsUpcomingAt(now time.Time) bool {
+	return b.ScheduledStartTime.After(now) && (b.Status == config.BookingStatusPending || b.Status == config.BookingStatusConfirmed)
+}
+
+// IsAccessibleBy returns true if userID is the booking's customer or the
+// barber it is assigned to (barberUserID is that barber's user account)
+func (b *Booking) IsAccessibleBy(userID, barberUserID int) bool {
+	if b.CustomerID != nil && *b.CustomerID == userID {
+		return true
+	}
+	return barberUserID > 0 && barberUserID == userID
 }
 
 // Validate validates booking fields
@@ -0,0 +1,39 @@
+// internal/models/booking_action_link.go
+package models
+
+import "time"
+
+// ========================================================================
+// BOOKING ACTION LINKS - One-tap confirm/cancel from reminders
+// ========================================================================
+//
+// Reminders carry signed links that confirm or cancel the booking without
+// logging in. The confirm and cancel links of one reminder share a link
+// ID, so answering once closes both.
+// ========================================================================
+
+// BookingActionLink is the record behind a reminder's confirm and cancel
+// links
+type BookingActionLink struct {
+	ID            string     `json:"id" db:"id"` // Signed into the tokens
+	BookingID     int        `json:"booking_id" db:"booking_id"`
+	CustomerID    *int       `json:"customer_id" db:"customer_id"`
+	ExpiresAt     time.Time  `json:"expires_at" db:"expires_at"`
+	UsedAt        *time.Time `json:"used_at" db:"used_at"`
+	UsedAction    *string    `json:"used_action" db:"used_action"` // confirm, cancel; nil until used
+	UsedIP        *string    `json:"used_ip" db:"used_ip"`
+	UsedUserAgent *string    `json:"used_user_agent" db:"used_user_agent"`
+	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
+}
+
+// IsUsed reports whether one of the link's actions was taken
+func (l *BookingActionLink) IsUsed() bool {
+	return l.UsedAt != nil
+}
+
+// BookingActionURLs are the links put in a reminder
+type BookingActionURLs struct {
+	ConfirmURL string    `json:"confirm_url"`
+	CancelURL  string    `json:"cancel_url"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
@@ -0,0 +1,59 @@
+// internal/models/booking_archive.go
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ========================================================================
+// ARCHIVED BOOKINGS - Old bookings moved to cold storage
+// ========================================================================
+
+// ArchivedBooking is a booking moved to bookings_archive, with the rows
+// that belonged to it
+type ArchivedBooking struct {
+	Booking
+	Related    ArchivedBookingRelated `json:"-" db:"related"`
+	ArchivedAt time.Time              `json:"archived_at" db:"archived_at"`
+}
+
+// ArchivedBookingRelated holds the rows deleted along with an archived
+// booking, as they were in their tables
+type ArchivedBookingRelated struct {
+	History   []json.RawMessage `json:"history,omitempty"`
+	LineItems []BookingLineItem `json:"line_items,omitempty"`
+	TaxLines  []BookingTaxLine  `json:"tax_lines,omitempty"`
+	Staff     []json.RawMessage `json:"staff,omitempty"`
+}
+
+// Value implements driver.Valuer
+func (r ArchivedBookingRelated) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+// Scan implements sql.Scanner
+func (r *ArchivedBookingRelated) Scan(value interface{}) error {
+	if value == nil {
+		*r = ArchivedBookingRelated{}
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(bytes, r)
+}
+
+// Restore returns the booking with its line items and tax lines attached,
+// as a live lookup would return it
+func (a *ArchivedBooking) Restore() *Booking {
+	booking := a.Booking
+	booking.LineItems = a.Related.LineItems
+	booking.TaxLines = a.Related.TaxLines
+	return &booking
+}
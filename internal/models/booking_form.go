@@ -0,0 +1,259 @@
+// internal/models/booking_form.go
+package models
+
+import (
+	"barber-booking-system/internal/config"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ========================================================================
+// BOOKING FORM - Barbers' custom booking-form fields and their answers
+// ========================================================================
+//
+// A barber can add their own questions to the booking form (e.g. "Parking
+// spot number", "Preferred clipper guard"). Customers answer them with
+// custom_fields when booking; answers are validated against the active
+// fields and stored on the booking, each with the field's label and type,
+// so the barber sees them as asked even after the field changes.
+//
+// On services that require a consultation, barbers can also ask questions
+// only when that service is booked. Answering one of such a question's
+// consultation answers (e.g. "yes" to "Have you had a chemical treatment
+// in the last 6 months?") asks for a consultation instead of booking.
+// ========================================================================
+
+// BookingFormField is a custom question on a barber's booking form
+type BookingFormField struct {
+	ID           int         `json:"id" db:"id"`
+	BarberID     int         `json:"barber_id" db:"barber_id"`
+	Key          string      `json:"key" db:"field_key"` // Key of the answer in custom_fields
+	Label        string      `json:"label" db:"label"`
+	FieldType    string      `json:"field_type" db:"field_type"` // text, number, select, checkbox
+	HelpText     *string     `json:"help_text,omitempty" db:"help_text"`
+	IsRequired   bool        `json:"is_required" db:"is_required"`
+	Options      StringArray `json:"options,omitempty" db:"options"`       // Choices of a select field
+	MaxLength    *int        `json:"max_length,omitempty" db:"max_length"` // Text fields
+	MinValue     *float64    `json:"min_value,omitempty" db:"min_value"`   // Number fields
+	MaxValue     *float64    `json:"max_value,omitempty" db:"max_value"`   // Number fields
+	Pattern      *string     `json:"pattern,omitempty" db:"pattern"`       // Text fields; the whole answer must match
+	IsActive     bool        `json:"is_active" db:"is_active"`
+	DisplayOrder int         `json:"display_order" db:"display_order"`
+	CreatedAt    time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time   `json:"updated_at" db:"updated_at"`
+
+	// Questions on one service are only asked when it is booked (nil =
+	// asked for every booking); the answers that need a consultation
+	// first (select options, or "true"/"false" for checkboxes)
+	BarberServiceID     *int        `json:"barber_service_id,omitempty" db:"barber_service_id"`
+	ConsultationAnswers StringArray `json:"consultation_answers,omitempty" db:"consultation_answers"`
+}
+
+// BookingFormAnswer is a customer's answer to a custom field, stored with
+// the field as it was asked
+type BookingFormAnswer struct {
+	Key   string      `json:"key"`
+	Label string      `json:"label"`
+	Type  string      `json:"type"`
+	Value interface{} `json:"value"` // string, number or bool by type
+}
+
+// BookingFormAnswers are a booking's custom field answers in form order,
+// stored as JSONB
+type BookingFormAnswers []BookingFormAnswer
+
+func (a BookingFormAnswers) Value() (driver.Value, error) {
+	if len(a) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(a)
+}
+
+func (a *BookingFormAnswers) Scan(value interface{}) error {
+	if value == nil {
+		*a = nil
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(bytes, a)
+}
+
+// Answer validates a response to the field and returns its normalized
+// value: a trimmed string for text and select fields, a float64 for
+// number fields and a bool for checkboxes. A blank response to an optional
+// field returns nil; a required checkbox must be checked.
+func (f *BookingFormField) Answer(value interface{}) (interface{}, error) {
+	if s, ok := value.(string); ok {
+		value = strings.TrimSpace(s)
+		if value == "" {
+			value = nil
+		}
+	}
+	if value == nil {
+		if f.IsRequired {
+			return nil, fmt.Errorf("custom field %q is required", f.Key)
+		}
+		return nil, nil
+	}
+
+	switch f.FieldType {
+	case config.BookingFormFieldText:
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("custom field %q must be text", f.Key)
+		}
+		maxLength := config.DefaultBookingFormMaxLength
+		if f.MaxLength != nil {
+			maxLength = *f.MaxLength
+		}
+		if utf8.RuneCountInString(s) > maxLength {
+			return nil, fmt.Errorf("custom field %q must be at most %d characters", f.Key, maxLength)
+		}
+		if f.Pattern != nil && *f.Pattern != "" {
+			re, err := regexp.Compile(`^(?:` + *f.Pattern + `)$`)
+			if err != nil {
+				return nil, fmt.Errorf("custom field %q has an invalid pattern: %w", f.Key, err)
+			}
+			if !re.MatchString(s) {
+				return nil, fmt.Errorf("custom field %q must match the format %s", f.Key, *f.Pattern)
+			}
+		}
+		return s, nil
+
+	case config.BookingFormFieldNumber:
+		var n float64
+		switch v := value.(type) {
+		case float64:
+			n = v
+		case string:
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return nil, fmt.Errorf("custom field %q must be a number", f.Key)
+			}
+			n = parsed
+		default:
+			return nil, fmt.Errorf("custom field %q must be a number", f.Key)
+		}
+		if f.MinValue != nil && n < *f.MinValue {
+			return nil, fmt.Errorf("custom field %q must be at least %g", f.Key, *f.MinValue)
+		}
+		if f.MaxValue != nil && n > *f.MaxValue {
+			return nil, fmt.Errorf("custom field %q must be at most %g", f.Key, *f.MaxValue)
+		}
+		return n, nil
+
+	case config.BookingFormFieldSelect:
+		s, ok := value.(string)
+		if !ok || !slices.Contains(f.Options, s) {
+			return nil, fmt.Errorf("custom field %q must be one of: %s", f.Key, strings.Join(f.Options, ", "))
+		}
+		return s, nil
+
+	case config.BookingFormFieldCheckbox:
+		var b bool
+		switch v := value.(type) {
+		case bool:
+			b = v
+		case string:
+			parsed, err := strconv.ParseBool(v)
+			if err != nil {
+				return nil, fmt.Errorf("custom field %q must be true or false", f.Key)
+			}
+			b = parsed
+		default:
+			return nil, fmt.Errorf("custom field %q must be true or false", f.Key)
+		}
+		if f.IsRequired && !b {
+			return nil, fmt.Errorf("custom field %q is required", f.Key)
+		}
+		return b, nil
+	}
+
+	return nil, fmt.Errorf("custom field %q has unknown type %q", f.Key, f.FieldType)
+}
+
+// AppliesTo returns true if the field is asked when booking the barber
+// services: fields of the whole form always, service questions when their
+// service is booked
+func (f *BookingFormField) AppliesTo(barberServiceIDs []int) bool {
+	return f.BarberServiceID == nil || slices.Contains(barberServiceIDs, *f.BarberServiceID)
+}
+
+// NeedsConsultation returns true if an answer to the field is one of its
+// consultation answers
+func (f *BookingFormField) NeedsConsultation(value interface{}) bool {
+	if len(f.ConsultationAnswers) == 0 || value == nil {
+		return false
+	}
+	return slices.Contains(f.ConsultationAnswers, fmt.Sprint(value))
+}
+
+// ConsultationTriggers returns the keys of the answered fields whose
+// answers need a consultation, in form order
+func ConsultationTriggers(fields []BookingFormField, answers BookingFormAnswers) []string {
+	values := make(map[string]interface{}, len(answers))
+	for _, answer := range answers {
+		values[answer.Key] = answer.Value
+	}
+
+	var triggers []string
+	for i := range fields {
+		if value, ok := values[fields[i].Key]; ok && fields[i].NeedsConsultation(value) {
+			triggers = append(triggers, fields[i].Key)
+		}
+	}
+	return triggers
+}
+
+// AnswerBookingForm validates responses (key -> value) against a barber's
+// form and returns the answers in form order. Inactive fields are ignored;
+// responses to fields the form does not have are rejected.
+func AnswerBookingForm(fields []BookingFormField, responses map[string]interface{}) (BookingFormAnswers, error) {
+	active := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if f.IsActive {
+			active[f.Key] = true
+		}
+	}
+
+	keys := make([]string, 0, len(responses))
+	for key := range responses {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !active[key] {
+			return nil, fmt.Errorf("custom field %q cannot be answered: the booking form has no such field", key)
+		}
+	}
+
+	var answers BookingFormAnswers
+	for i := range fields {
+		f := &fields[i]
+		if !f.IsActive {
+			continue
+		}
+		value, err := f.Answer(responses[f.Key])
+		if err != nil {
+			return nil, err
+		}
+		if value != nil {
+			answers = append(answers, BookingFormAnswer{Key: f.Key, Label: f.Label, Type: f.FieldType, Value: value})
+		}
+	}
+	return answers, nil
+}
@@ -0,0 +1,128 @@
+// internal/models/booking_line_item.go
+package models
+
+import "time"
+
+// ========================================================================
+// BOOKING LINE ITEMS - The services performed in one appointment
+// ========================================================================
+//
+// A booking can cover several of a barber's services back to back (e.g. a
+// haircut and a beard trim). The appointment lasts as long as all of them
+// and costs their combined price; each service is kept on the booking as a
+// line item, followed by any add-ons booked with it.
+// ========================================================================
+
+// BookingLineItem is one service or add-on performed in a booking
+type BookingLineItem struct {
+	ID              int       `json:"id" db:"id"`
+	BookingID       int       `json:"booking_id" db:"booking_id"`
+	BarberServiceID *int      `json:"barber_service_id,omitempty" db:"barber_service_id"` // nil once the service is deleted
+	AddOnID         *int      `json:"add_on_id,omitempty" db:"add_on_id"`                 // Set for add-ons; nil once the add-on is deleted
+	ServiceName     string    `json:"service_name" db:"service_name"`
+	DurationMinutes int       `json:"duration_minutes" db:"duration_minutes"`
+	Price           float64   `json:"price" db:"price"`
+	Position        int       `json:"position" db:"position"` // Order the services are performed in
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+}
+
+// SelectedService is a barber service booked in an appointment, with the
+// add-ons chosen for it
+type SelectedService struct {
+	*BarberService
+	AddOns []*ServiceAddOn
+}
+
+// TotalMinutes returns how long the service and its add-ons take
+func (s SelectedService) TotalMinutes() int {
+	total := s.EstimatedDurationMin
+	for _, addOn := range s.AddOns {
+		total += addOn.DurationMinutes
+	}
+	return total
+}
+
+// TotalPrice returns the price of the service and its add-ons
+func (s SelectedService) TotalPrice() float64 {
+	total := s.Price
+	for _, addOn := range s.AddOns {
+		total += addOn.Price
+	}
+	return total
+}
+
+// ServiceSelection is the barber services booked in one appointment, in the
+// order they are performed
+type ServiceSelection []SelectedService
+
+// SelectServices wraps barber services, without add-ons, as a selection
+func SelectServices(services ...*BarberService) ServiceSelection {
+	selection := make(ServiceSelection, len(services))
+	for i, service := range services {
+		selection[i] = SelectedService{BarberService: service}
+	}
+	return selection
+}
+
+// HasAddOns reports whether any add-ons were chosen
+func (s ServiceSelection) HasAddOns() bool {
+	for _, service := range s {
+		if len(service.AddOns) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// TotalMinutes returns how long all the services and add-ons take
+func (s ServiceSelection) TotalMinutes() int {
+	total := 0
+	for _, service := range s {
+		total += service.TotalMinutes()
+	}
+	return total
+}
+
+// TotalPrice returns the combined price of the services and add-ons
+func (s ServiceSelection) TotalPrice() float64 {
+	total := 0.0
+	for _, service := range s {
+		total += service.TotalPrice()
+	}
+	return roundCents(total)
+}
+
+// BufferMinutes returns the break needed after the appointment: the last
+// service's buffer
+func (s ServiceSelection) BufferMinutes() int {
+	if len(s) == 0 {
+		return 0
+	}
+	return s[len(s)-1].BufferTimeMinutes
+}
+
+// DurationSegments returns the active and passive time of an appointment
+// of the given length. One service's segments apply as they are; several
+// services are chained, each taking its own duration, and a service without
+// segments is active throughout. Add-ons are active time right after their
+// service.
+func (s ServiceSelection) DurationSegments(durationMinutes int) DurationSegments {
+	if len(s) == 1 && len(s[0].AddOns) == 0 {
+		return s[0].DurationSegments.ForDuration(durationMinutes)
+	}
+
+	var combined DurationSegments
+	for _, service := range s {
+		if segments := service.DurationSegments.ForDuration(service.EstimatedDurationMin); segments != nil {
+			combined = append(combined, segments...)
+		} else {
+			combined = append(combined, DurationSegment{Minutes: service.EstimatedDurationMin})
+		}
+		for _, addOn := range service.AddOns {
+			if addOn.DurationMinutes > 0 {
+				combined = append(combined, DurationSegment{Minutes: addOn.DurationMinutes})
+			}
+		}
+	}
+	return combined.ForDuration(durationMinutes)
+}
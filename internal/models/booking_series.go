@@ -0,0 +1,79 @@
+package models
+
+import (
+	"barber-booking-system/internal/config"
+	"fmt"
+	"time"
+)
+
+// BookingSeries is a standing appointment: a recurrence rule whose
+// occurrences are individual bookings linked by Booking.SeriesID
+type BookingSeries struct {
+	ID              int    `json:"id" db:"id"`
+	UUID            string `json:"uuid" db:"uuid"`
+	CustomerID      *int   `json:"customer_id" db:"customer_id"`
+	BarberID        int    `json:"barber_id" db:"barber_id"`
+	BarberServiceID *int   `json:"barber_service_id" db:"barber_service_id"`
+
+	// Recurrence rule
+	Frequency       string    `json:"frequency" db:"frequency"` // weekly, biweekly, monthly
+	Occurrences     int       `json:"occurrences" db:"occurrences"`
+	StartTime       time.Time `json:"start_time" db:"start_time"`
+	DurationMinutes int       `json:"duration_minutes" db:"duration_minutes"`
+
+	Status    string    `json:"status" db:"status"` // active, cancelled
+	CreatedBy *int      `json:"created_by" db:"created_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// IsActive returns true if the series has not been cancelled
+func (s *BookingSeries) IsActive() bool {
+	return s.Status == config.BookingSeriesStatusActive
+}
+
+// IsValidRecurrenceFrequency checks whether frequency is supported
+func IsValidRecurrenceFrequency(frequency string) bool {
+	switch frequency {
+	case config.RecurrenceWeekly, config.RecurrenceBiweekly, config.RecurrenceMonthly:
+		return true
+	}
+	return false
+}
+
+// RecurrenceTimes returns the start time of each occurrence, beginning with start.
+// Monthly series keep the day of month, clamped to the last day of shorter
+// months (a series on the 31st falls on Feb 28/29).
+func RecurrenceTimes(start time.Time, frequency string, count int) ([]time.Time, error) {
+	if !IsValidRecurrenceFrequency(frequency) {
+		return nil, fmt.Errorf("recurrence frequency must be one of: %s, %s, %s",
+			config.RecurrenceWeekly, config.RecurrenceBiweekly, config.RecurrenceMonthly)
+	}
+	if count < 1 {
+		return nil, fmt.Errorf("recurrence count must be at least 1")
+	}
+
+	times := make([]time.Time, count)
+	for i := 0; i < count; i++ {
+		switch frequency {
+		case config.RecurrenceWeekly:
+			times[i] = start.AddDate(0, 0, 7*i)
+		case config.RecurrenceBiweekly:
+			times[i] = start.AddDate(0, 0, 14*i)
+		case config.RecurrenceMonthly:
+			times[i] = addMonthsClamped(start, i)
+		}
+	}
+	return times, nil
+}
+
+// addMonthsClamped adds months to t without overflowing into the following month
+func addMonthsClamped(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	lastDay := time.Date(year, month+time.Month(months)+1, 0, 0, 0, 0, 0, t.Location()).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(year, month+time.Month(months), day,
+		t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
@@ -0,0 +1,16 @@
+// internal/models/calendar_feed.go
+package models
+
+import "time"
+
+// CalendarFeed is a user's subscribable calendar of upcoming bookings. The
+// token in the feed URL is its only authentication, since calendar apps
+// can't log in; resetting the feed replaces the token.
+type CalendarFeed struct {
+	ID             int        `json:"id" db:"id"`
+	UserID         int        `json:"user_id" db:"user_id"`
+	Token          string     `json:"-" db:"token"`
+	LastAccessedAt *time.Time `json:"last_accessed_at" db:"last_accessed_at"` // Last fetched by a calendar app
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at" db:"updated_at"`
+}
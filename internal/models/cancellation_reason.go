@@ -0,0 +1,80 @@
+// internal/models/cancellation_reason.go
+package models
+
+import (
+	"math"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// CancellationReasonUnspecified stands for cancellations without a reason
+// code in the statistics
+const CancellationReasonUnspecified = "unspecified"
+
+// CancellationReasonCount is how many of a barber's bookings were
+// cancelled with one reason code (empty for none) into one status
+type CancellationReasonCount struct {
+	Code   string `db:"code"`
+	Status string `db:"status"`
+	Count  int    `db:"count"`
+}
+
+// CancellationReasonStat is the cancellations with one reason
+type CancellationReasonStat struct {
+	Code       string  `json:"code" example:"schedule_conflict"`
+	Label      string  `json:"label" example:"Schedule conflict"`
+	Count      int     `json:"count"`
+	ByCustomer int     `json:"by_customer"`
+	ByBarber   int     `json:"by_barber"`
+	Percent    float64 `json:"percent"` // Share of all cancellations in the range
+}
+
+// CancellationReasonStats breaks a barber's cancellations in a date range
+// down by reason
+type CancellationReasonStats struct {
+	BarberID int                      `json:"barber_id"`
+	From     time.Time                `json:"from"`
+	To       time.Time                `json:"to"` // Exclusive
+	Total    int                      `json:"total"`
+	Reasons  []CancellationReasonStat `json:"reasons"`
+}
+
+// BuildCancellationReasonStats totals the counts by reason. Every reason
+// is listed, in the order of config.CancellationReasonCodes, followed by
+// the cancellations without one; unknown codes count as unspecified.
+func BuildCancellationReasonStats(barberID int, from, to time.Time, counts []CancellationReasonCount) *CancellationReasonStats {
+	stats := &CancellationReasonStats{BarberID: barberID, From: from, To: to}
+
+	codes := append(append([]string{}, config.CancellationReasonCodes...), CancellationReasonUnspecified)
+	index := make(map[string]int, len(codes))
+	stats.Reasons = make([]CancellationReasonStat, len(codes))
+	for i, code := range codes {
+		index[code] = i
+		stats.Reasons[i].Code = code
+	}
+
+	for _, count := range counts {
+		i, ok := index[count.Code]
+		if !ok {
+			i = index[CancellationReasonUnspecified]
+		}
+		reason := &stats.Reasons[i]
+		reason.Count += count.Count
+		switch count.Status {
+		case config.BookingStatusCancelledByCustomer:
+			reason.ByCustomer += count.Count
+		case config.BookingStatusCancelledByBarber:
+			reason.ByBarber += count.Count
+		}
+		stats.Total += count.Count
+	}
+
+	if stats.Total > 0 {
+		for i := range stats.Reasons {
+			share := float64(stats.Reasons[i].Count) * 100 / float64(stats.Total)
+			stats.Reasons[i].Percent = math.Round(share*10) / 10
+		}
+	}
+	return stats
+}
@@ -0,0 +1,114 @@
+// internal/models/commission.go
+package models
+
+import (
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// COMMISSION SPLITS - Staff sharing a booking's earnings
+// ========================================================================
+//
+// An assistant who performed part of a booking gets a percentage of it.
+// The booking's barber (the lead) keeps the rest. Earnings are the booking
+// total before tax and the tip, both split by the same percentages; the
+// platform commission is taken from each barber's share of the total at
+// their own commission rate.
+// ========================================================================
+
+// BookingStaff is a barber who worked on a booking and their share of it
+type BookingStaff struct {
+	BookingID    int       `json:"booking_id" db:"booking_id"`
+	BarberID     int       `json:"barber_id" db:"barber_id"`
+	BarberName   *string   `json:"barber_name,omitempty" db:"barber_name"`
+	Role         string    `json:"role" db:"role"`
+	SharePercent float64   `json:"share_percent" db:"share_percent"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+// LeadSharePercent returns what the lead keeps after the assistants' shares
+func LeadSharePercent(assistants []BookingStaff) float64 {
+	share := 100.0
+	for _, a := range assistants {
+		share -= a.SharePercent
+	}
+	return roundCents(share)
+}
+
+// EarningsLine is one completed booking a barber worked on
+type EarningsLine struct {
+	BookingID     int       `json:"booking_id" db:"booking_id"`
+	BookingNumber string    `json:"booking_number" db:"booking_number"`
+	ServiceName   string    `json:"service_name" db:"service_name"`
+	StartTime     time.Time `json:"start_time" db:"scheduled_start_time"`
+	Role          string    `json:"role" db:"role"`
+	SharePercent  float64   `json:"share_percent" db:"share_percent"`
+	Currency      string    `json:"currency" db:"currency"`
+
+	// The whole booking, before tax
+	BookingAmount float64 `json:"booking_amount" db:"booking_amount"`
+	BookingTip    float64 `json:"booking_tip" db:"booking_tip"`
+
+	// This barber's part
+	Amount     float64 `json:"amount" db:"-"`
+	Tip        float64 `json:"tip" db:"-"`
+	Commission float64 `json:"commission" db:"-"`
+	Payout     float64 `json:"payout" db:"-"`
+}
+
+// EarningsReport totals a barber's earnings and payout over a date range
+// (UTC days, inclusive)
+type EarningsReport struct {
+	BarberID       int            `json:"barber_id"`
+	From           time.Time      `json:"from"`
+	To             time.Time      `json:"to"`
+	CommissionRate float64        `json:"commission_rate"` // Platform commission, percent
+	Currency       string         `json:"currency"`
+	Bookings       int            `json:"bookings"`
+	Earnings       float64        `json:"earnings"`   // Share of booking totals, before tax
+	Tips           float64        `json:"tips"`       // Share of tips
+	Commission     float64        `json:"commission"` // Platform commission on earnings
+	Payout         float64        `json:"payout"`     // Earnings - commission + tips
+	Lines          []EarningsLine `json:"lines"`
+}
+
+// NewEarningsReport applies each line's share and the barber's commission
+// rate and totals the lines
+func NewEarningsReport(barberID int, from, to time.Time, commissionRate float64, lines []EarningsLine) *EarningsReport {
+	report := &EarningsReport{
+		BarberID:       barberID,
+		From:           from,
+		To:             to,
+		CommissionRate: commissionRate,
+		Currency:       config.DefaultCurrency,
+		Lines:          lines,
+	}
+	if report.Lines == nil {
+		report.Lines = []EarningsLine{}
+	}
+
+	for i := range report.Lines {
+		line := &report.Lines[i]
+		line.Amount = roundCents(line.BookingAmount * line.SharePercent / 100)
+		line.Tip = roundCents(line.BookingTip * line.SharePercent / 100)
+		line.Commission = roundCents(line.Amount * commissionRate / 100)
+		line.Payout = roundCents(line.Amount - line.Commission + line.Tip)
+
+		if line.Currency != "" {
+			report.Currency = line.Currency
+		}
+		report.Bookings++
+		report.Earnings += line.Amount
+		report.Tips += line.Tip
+		report.Commission += line.Commission
+		report.Payout += line.Payout
+	}
+
+	report.Earnings = roundCents(report.Earnings)
+	report.Tips = roundCents(report.Tips)
+	report.Commission = roundCents(report.Commission)
+	report.Payout = roundCents(report.Payout)
+	return report
+}
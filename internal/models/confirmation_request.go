@@ -0,0 +1,67 @@
+// internal/models/confirmation_request.go
+package models
+
+import "time"
+
+// ========================================================================
+// CONFIRMATION REQUESTS - Extra check-in for likely no-shows
+// ========================================================================
+//
+// Customers whose past appointments often ended as no-shows get an extra
+// request to confirm or cancel shortly before their next one. Cancelling
+// frees the slot for someone else instead of leaving the barber waiting.
+// ========================================================================
+
+// NoShowHistory counts a customer's past appointments
+type NoShowHistory struct {
+	Finished int `json:"finished" db:"finished"` // Completed or no-show
+	NoShows  int `json:"no_shows" db:"no_shows"`
+}
+
+// Risk returns the share of past appointments the customer missed (0-1)
+func (h NoShowHistory) Risk() float64 {
+	if h.Finished <= 0 {
+		return 0
+	}
+	return float64(h.NoShows) / float64(h.Finished)
+}
+
+// IsHighRisk reports whether the customer has enough history and a no-show
+// rate at or above threshold
+func (h NoShowHistory) IsHighRisk(threshold float64, minBookings int) bool {
+	return h.Finished > 0 && h.Finished >= minBookings && h.Risk() >= threshold
+}
+
+// ConfirmationCandidate is an upcoming booking that may need a
+// confirmation request, with its customer's recent history
+type ConfirmationCandidate struct {
+	BookingID          int       `json:"booking_id" db:"booking_id"`
+	CustomerID         int       `json:"customer_id" db:"customer_id"`
+	ScheduledStartTime time.Time `json:"scheduled_start_time" db:"scheduled_start_time"`
+	NoShowHistory
+}
+
+// ConfirmationRequest asks the customer of a high-risk booking to confirm
+// they are still coming
+type ConfirmationRequest struct {
+	ID          int        `json:"id" db:"id"`
+	Token       string     `json:"-" db:"token"` // Secret in the request; answers need no login
+	BookingID   int        `json:"booking_id" db:"booking_id"`
+	CustomerID  int        `json:"customer_id" db:"customer_id"`
+	NoShowRisk  float64    `json:"no_show_risk" db:"no_show_risk"`
+	SentAt      time.Time  `json:"sent_at" db:"sent_at"`
+	ExpiresAt   time.Time  `json:"expires_at" db:"expires_at"` // The booking's start
+	Response    *string    `json:"response" db:"response"`     // confirmed, cancelled; nil until answered
+	RespondedAt *time.Time `json:"responded_at" db:"responded_at"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+}
+
+// IsAnswered reports whether the customer has responded
+func (r *ConfirmationRequest) IsAnswered() bool {
+	return r.RespondedAt != nil
+}
+
+// IsExpired reports whether the request no longer accepts answers
+func (r *ConfirmationRequest) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
@@ -0,0 +1,117 @@
+// internal/models/consultation_request.go
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// ConsultationRequest is a request for a consultation about a service:
+// a booking whose answers to the service's questions called for one, or
+// one a customer submitted with preferred times and photos. The barber
+// follows up with the customer and books them in, declines, or quotes a
+// price and slot that the customer can accept as a booking.
+type ConsultationRequest struct {
+	ID              int    `json:"id" db:"id"`
+	BarberID        int    `json:"barber_id" db:"barber_id"`
+	BarberServiceID *int   `json:"barber_service_id" db:"barber_service_id"` // nil once the service is removed
+	ServiceName     string `json:"service_name" db:"service_name"`
+
+	// Who asked (customer for signed-in users, the contact details for
+	// guests)
+	CustomerID    *int    `json:"customer_id" db:"customer_id"`
+	CustomerName  *string `json:"customer_name" db:"customer_name"`
+	CustomerEmail *string `json:"customer_email" db:"customer_email"`
+	CustomerPhone *string `json:"customer_phone" db:"customer_phone"`
+
+	// The booking that was asked for
+	RequestedStartTime time.Time          `json:"requested_start_time" db:"requested_start_time"`
+	Answers            BookingFormAnswers `json:"answers" db:"answers"`
+	TriggeredBy        StringArray        `json:"triggered_by" db:"triggered_by"` // Keys of the questions that asked for the consultation
+	Notes              *string            `json:"notes" db:"notes"`
+
+	// From customers who ask for a consultation themselves
+	PreferredTimes PreferredTimes      `json:"preferred_times" db:"preferred_times"` // Earliest first
+	Photos         []ConsultationPhoto `json:"photos,omitempty" db:"-"`
+
+	// The barber's quote, which the customer may accept as a booking
+	QuotedPrice       *float64   `json:"quoted_price" db:"quoted_price"`
+	ProposedStartTime *time.Time `json:"proposed_start_time" db:"proposed_start_time"`
+	QuotedAt          *time.Time `json:"quoted_at" db:"quoted_at"`
+	BookingID         *int       `json:"booking_id" db:"booking_id"` // The booking made from the accepted quote
+
+	// The barber's answer
+	Status        string     `json:"status" db:"status"` // open, quoted, booked, declined
+	ResponseNotes *string    `json:"response_notes" db:"response_notes"`
+	RespondedBy   *int       `json:"responded_by" db:"responded_by"`
+	RespondedAt   *time.Time `json:"responded_at" db:"responded_at"`
+
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// IsPending returns true if the request still awaits an outcome: the
+// barber has not answered it, or the customer has not accepted the quote
+func (r *ConsultationRequest) IsPending() bool {
+	return r.Status == config.ConsultationRequestOpen || r.Status == config.ConsultationRequestQuoted
+}
+
+// CanAccept returns nil if the customer can accept the quote as a booking
+// at now
+func (r *ConsultationRequest) CanAccept(now time.Time) error {
+	if r.Status != config.ConsultationRequestQuoted || r.QuotedPrice == nil || r.ProposedStartTime == nil {
+		return fmt.Errorf("consultation request cannot be accepted: it has no quote to accept (status %s)", r.Status)
+	}
+	if !r.ProposedStartTime.After(now) {
+		return fmt.Errorf("consultation request cannot be accepted: the proposed time has passed, ask the barber for a new quote")
+	}
+	if r.BarberServiceID == nil {
+		return fmt.Errorf("consultation request cannot be accepted: the barber no longer offers %s", r.ServiceName)
+	}
+	return nil
+}
+
+// PreferredTimes are the times a customer suggests for a consultation,
+// stored as JSONB
+type PreferredTimes []time.Time
+
+func (p PreferredTimes) Value() (driver.Value, error) {
+	if p == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal([]time.Time(p))
+}
+
+func (p *PreferredTimes) Scan(value interface{}) error {
+	if value == nil {
+		*p = nil
+		return nil
+	}
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(bytes, (*[]time.Time)(p))
+}
+
+// ConsultationPhoto is a photo a customer attached to their consultation
+// request, with its thumbnail
+type ConsultationPhoto struct {
+	ID                    int       `json:"id" db:"id"`
+	ConsultationRequestID int       `json:"consultation_request_id" db:"consultation_request_id"`
+	ImageKey              string    `json:"-" db:"image_key"`     // Storage key of the photo
+	ThumbnailKey          string    `json:"-" db:"thumbnail_key"` // Storage key of the thumbnail
+	URL                   string    `json:"url" db:"url"`
+	ThumbnailURL          string    `json:"thumbnail_url" db:"thumbnail_url"`
+	ContentType           string    `json:"content_type" db:"content_type"`
+	SizeBytes             int       `json:"size_bytes" db:"size_bytes"`
+	Width                 int       `json:"width" db:"width"`
+	Height                int       `json:"height" db:"height"`
+	UploadedBy            *int      `json:"uploaded_by,omitempty" db:"uploaded_by"`
+	CreatedAt             time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,195 @@
+// internal/models/dashboard.go
+package models
+
+import (
+	"sort"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// BARBER DASHBOARD - The barber's "today" view
+// ========================================================================
+//
+// The dashboard is built from a small snapshot of bookings that is cheap
+// to cache; everything time-sensitive (queue position, countdown, running
+// late) is derived from the snapshot on every request so a cached payload
+// never shows a stale countdown.
+// ========================================================================
+
+// DashboardBooking is the slice of a booking the dashboard needs
+type DashboardBooking struct {
+	ID                 int        `json:"id" db:"id"`
+	BookingNumber      string     `json:"booking_number" db:"booking_number"`
+	CustomerID         *int       `json:"customer_id" db:"customer_id"`
+	CustomerName       *string    `json:"customer_name" db:"customer_name"`
+	CustomerPhone      *string    `json:"customer_phone" db:"customer_phone"`
+	ServiceName        string     `json:"service_name" db:"service_name"`
+	Status             string     `json:"status" db:"status"`
+	ScheduledStartTime time.Time  `json:"scheduled_start_time" db:"scheduled_start_time"`
+	ScheduledEndTime   time.Time  `json:"scheduled_end_time" db:"scheduled_end_time"`
+	ActualStartTime    *time.Time `json:"actual_start_time" db:"actual_start_time"`
+	TotalPrice         float64    `json:"total_price" db:"total_price"`
+	TipAmount          float64    `json:"tip_amount" db:"tip_amount"`
+	Currency           string     `json:"currency" db:"currency"`
+	CreatedAt          time.Time  `json:"created_at" db:"created_at"`
+}
+
+// DashboardSnapshot is the cached input for a barber's dashboard
+type DashboardSnapshot struct {
+	BarberID int                `json:"barber_id"`
+	Date     string             `json:"date"` // YYYY-MM-DD in the barber's timezone
+	Timezone string             `json:"timezone"`
+	Bookings []DashboardBooking `json:"bookings"` // Today's bookings plus upcoming unconfirmed ones
+	LoadedAt time.Time          `json:"loaded_at"`
+}
+
+// DashboardAppointment is a booking as shown on the dashboard
+type DashboardAppointment struct {
+	DashboardBooking
+	LateByMinutes int `json:"late_by_minutes,omitempty"`
+}
+
+// DashboardEarnings totals completed work for the day
+type DashboardEarnings struct {
+	CompletedCount int     `json:"completed_count"`
+	Revenue        float64 `json:"revenue"`
+	Tips           float64 `json:"tips"`
+	Total          float64 `json:"total"`
+	Projected      float64 `json:"projected"` // Total plus the price of today's remaining appointments
+	Currency       string  `json:"currency"`
+}
+
+// DashboardCounts summarizes today's bookings by outcome
+type DashboardCounts struct {
+	Total     int `json:"total"`
+	Remaining int `json:"remaining"`
+	Completed int `json:"completed"`
+	Cancelled int `json:"cancelled"`
+	NoShow    int `json:"no_show"`
+}
+
+// BarberDashboard is the consolidated "today" payload
+type BarberDashboard struct {
+	BarberID    int       `json:"barber_id"`
+	Date        string    `json:"date"`
+	Timezone    string    `json:"timezone"`
+	GeneratedAt time.Time `json:"generated_at"`
+	DataAsOf    time.Time `json:"data_as_of"` // When the bookings were read; may trail GeneratedAt when cached
+
+	Current             *DashboardAppointment  `json:"current"`
+	Next                *DashboardAppointment  `json:"next"`
+	NextStartsInSeconds *int64                 `json:"next_starts_in_seconds"`
+	Queue               []DashboardAppointment `json:"queue"`
+
+	RunningLate   bool `json:"running_late"`
+	LateByMinutes int  `json:"late_by_minutes"`
+
+	PendingConfirmations []DashboardAppointment `json:"pending_confirmations"`
+
+	Earnings DashboardEarnings `json:"earnings"`
+	Counts   DashboardCounts   `json:"counts"`
+}
+
+// BuildBarberDashboard derives the dashboard from a snapshot at the given time.
+// A barber is running late when the appointment in the chair has overrun its
+// slot, or when the next appointment should have started but has not, by at
+// least config.DashboardRunningLateGraceMinutes.
+func BuildBarberDashboard(snapshot DashboardSnapshot, now time.Time) *BarberDashboard {
+	dashboard := &BarberDashboard{
+		BarberID:             snapshot.BarberID,
+		Date:                 snapshot.Date,
+		Timezone:             snapshot.Timezone,
+		GeneratedAt:          now,
+		DataAsOf:             snapshot.LoadedAt,
+		Queue:                []DashboardAppointment{},
+		PendingConfirmations: []DashboardAppointment{},
+		Earnings:             DashboardEarnings{Currency: config.DefaultCurrency},
+	}
+
+	bookings := make([]DashboardBooking, len(snapshot.Bookings))
+	copy(bookings, snapshot.Bookings)
+	sort.SliceStable(bookings, func(i, j int) bool {
+		return bookings[i].ScheduledStartTime.Before(bookings[j].ScheduledStartTime)
+	})
+
+	loc := time.UTC
+	if snapshot.Timezone != "" {
+		if l, err := time.LoadLocation(snapshot.Timezone); err == nil {
+			loc = l
+		}
+	}
+
+	for _, b := range bookings {
+		if b.Currency != "" {
+			dashboard.Earnings.Currency = b.Currency
+		}
+
+		// Unconfirmed requests need attention whichever day they are for
+		if b.Status == config.BookingStatusPending && !b.ScheduledEndTime.Before(now) {
+			dashboard.PendingConfirmations = append(dashboard.PendingConfirmations, DashboardAppointment{DashboardBooking: b})
+		}
+
+		if b.ScheduledStartTime.In(loc).Format("2006-01-02") != snapshot.Date {
+			continue
+		}
+		dashboard.Counts.Total++
+
+		switch b.Status {
+		case config.BookingStatusCompleted:
+			dashboard.Counts.Completed++
+			dashboard.Earnings.CompletedCount++
+			dashboard.Earnings.Revenue += b.TotalPrice
+			dashboard.Earnings.Tips += b.TipAmount
+		case config.BookingStatusNoShow:
+			dashboard.Counts.NoShow++
+		case config.BookingStatusInProgress, config.BookingStatusPending, config.BookingStatusConfirmed:
+			dashboard.Queue = append(dashboard.Queue, DashboardAppointment{DashboardBooking: b})
+			dashboard.Counts.Remaining++
+			dashboard.Earnings.Projected += b.TotalPrice
+		case config.BookingStatusCancelled, config.BookingStatusCancelledByCustomer, config.BookingStatusCancelledByBarber:
+			dashboard.Counts.Cancelled++
+		}
+	}
+
+	dashboard.Earnings.Revenue = roundCents(dashboard.Earnings.Revenue)
+	dashboard.Earnings.Tips = roundCents(dashboard.Earnings.Tips)
+	dashboard.Earnings.Total = roundCents(dashboard.Earnings.Revenue + dashboard.Earnings.Tips)
+	dashboard.Earnings.Projected = roundCents(dashboard.Earnings.Total + dashboard.Earnings.Projected)
+
+	// Current is the appointment in the chair (late once it overruns its slot);
+	// Next is the first one that has not started (late once its start passes)
+	for i := range dashboard.Queue {
+		appt := &dashboard.Queue[i]
+		if appt.Status == config.BookingStatusInProgress {
+			if now.After(appt.ScheduledEndTime) {
+				appt.LateByMinutes = int(now.Sub(appt.ScheduledEndTime) / time.Minute)
+			}
+			if dashboard.Current == nil {
+				current := *appt
+				dashboard.Current = &current
+				dashboard.LateByMinutes = max(dashboard.LateByMinutes, appt.LateByMinutes)
+			}
+			continue
+		}
+
+		if now.After(appt.ScheduledStartTime) {
+			appt.LateByMinutes = int(now.Sub(appt.ScheduledStartTime) / time.Minute)
+		}
+		if dashboard.Next == nil {
+			next := *appt
+			dashboard.Next = &next
+			dashboard.LateByMinutes = max(dashboard.LateByMinutes, appt.LateByMinutes)
+
+			seconds := int64(0)
+			if appt.ScheduledStartTime.After(now) {
+				seconds = int64(appt.ScheduledStartTime.Sub(now) / time.Second)
+			}
+			dashboard.NextStartsInSeconds = &seconds
+		}
+	}
+	dashboard.RunningLate = dashboard.LateByMinutes >= config.DashboardRunningLateGraceMinutes
+
+	return dashboard
+}
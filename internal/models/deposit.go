@@ -0,0 +1,98 @@
+// internal/models/deposit.go
+package models
+
+import (
+	"fmt"
+	"slices"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// DEPOSITS - Money held when booking, kept on a no-show
+// ========================================================================
+//
+// A barber service may require a deposit: a percentage of its price or a
+// fixed amount. The deposit is held on the customer's card when the
+// booking is made. If the customer does not show up, the no-show fee is
+// captured from the hold (by default the whole deposit) and the rest is
+// released; cancellations follow the cancellation policy on the deposit.
+// ========================================================================
+
+// ValidateDeposit checks a barber service's deposit settings. A deposit
+// needs a type and a positive value; percentages cannot exceed 100.
+func ValidateDeposit(depositType *string, value, noShowFee *float64) error {
+	if depositType == nil || *depositType == "" {
+		if value != nil || noShowFee != nil {
+			return fmt.Errorf("deposit_type is required to set a deposit or no-show fee")
+		}
+		return nil
+	}
+	if !slices.Contains(config.ValidDepositTypes, *depositType) {
+		return fmt.Errorf("deposit_type must be one of %v", config.ValidDepositTypes)
+	}
+	if value == nil || *value <= 0 {
+		return fmt.Errorf("deposit_value must be positive")
+	}
+	if *depositType == config.DepositTypePercentage && *value > 100 {
+		return fmt.Errorf("deposit_value cannot be more than 100 percent")
+	}
+	if noShowFee != nil && *noShowFee < 0 {
+		return fmt.Errorf("no_show_fee cannot be negative")
+	}
+	return nil
+}
+
+// RequiresDeposit reports whether booking the service takes a deposit
+func (bs *BarberService) RequiresDeposit() bool {
+	return bs.DepositType != nil && *bs.DepositType != "" && bs.DepositValue != nil && *bs.DepositValue > 0
+}
+
+// DepositFor returns the deposit due when the service is booked at price
+func (bs *BarberService) DepositFor(price float64) float64 {
+	if !bs.RequiresDeposit() || price <= 0 {
+		return 0
+	}
+	if *bs.DepositType == config.DepositTypePercentage {
+		return roundCents(price * *bs.DepositValue / 100)
+	}
+	return roundCents(min(*bs.DepositValue, price))
+}
+
+// NoShowFeeFor returns the part of a deposit kept when the customer does
+// not show up: the service's no-show fee, or the whole deposit
+func (bs *BarberService) NoShowFeeFor(deposit float64) float64 {
+	if bs.NoShowFee == nil {
+		return deposit
+	}
+	return roundCents(min(*bs.NoShowFee, deposit))
+}
+
+// Deposit returns the deposit due for the services, each on its own price
+// including its add-ons
+func (s ServiceSelection) Deposit() float64 {
+	total := 0.0
+	for _, service := range s {
+		total += service.DepositFor(service.TotalPrice())
+	}
+	return roundCents(total)
+}
+
+// NoShowFee returns the fee kept from the deposit if the customer does not
+// show up
+func (s ServiceSelection) NoShowFee() float64 {
+	total := 0.0
+	for _, service := range s {
+		total += service.NoShowFeeFor(service.DepositFor(service.TotalPrice()))
+	}
+	return roundCents(total)
+}
+
+// PrepaidAmount returns how much was taken or held for the booking: its
+// deposit, or the full price when it was paid up front
+func (b *Booking) PrepaidAmount() float64 {
+	if b.DepositAmount > 0 {
+		return b.DepositAmount
+	}
+	return b.TotalPrice
+}
@@ -0,0 +1,16 @@
+// internal/models/device_token.go
+package models
+
+import "time"
+
+// DeviceToken is an app install registered for push notifications
+type DeviceToken struct {
+	ID         int        `json:"id" db:"id"`
+	UserID     int        `json:"user_id" db:"user_id"`
+	Platform   string     `json:"platform" db:"platform"` // ios, android or web
+	Token      string     `json:"token" db:"token"`
+	AppVersion *string    `json:"app_version" db:"app_version"`
+	LastUsedAt *time.Time `json:"last_used_at" db:"last_used_at"` // Last successful push
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at" db:"updated_at"`
+}
@@ -0,0 +1,145 @@
+// internal/models/duration_segments.go
+package models
+
+import (
+	"barber-booking-system/internal/config"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ========================================================================
+// DURATION SEGMENTS - Active and passive time within a service
+// ========================================================================
+//
+// Some services have waiting time (e.g. color processing) during which the
+// barber is free to serve someone else. A service's duration is split into
+// consecutive segments; only active segments keep the barber busy, so other
+// bookings may be placed in the passive ones.
+// ========================================================================
+
+// DurationSegment is one consecutive part of a service
+type DurationSegment struct {
+	Minutes int  `json:"minutes" example:"30"`
+	Passive bool `json:"passive" example:"false"` // The barber is free during this segment
+}
+
+// DurationSegments splits a service's duration, stored as JSONB
+type DurationSegments []DurationSegment
+
+func (s DurationSegments) Value() (driver.Value, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(s)
+}
+
+func (s *DurationSegments) Scan(value interface{}) error {
+	if value == nil {
+		*s = nil
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(bytes, s)
+}
+
+// TotalMinutes returns the combined length of all segments
+func (s DurationSegments) TotalMinutes() int {
+	total := 0
+	for _, segment := range s {
+		total += segment.Minutes
+	}
+	return total
+}
+
+// HasPassive reports whether any segment leaves the barber free
+func (s DurationSegments) HasPassive() bool {
+	for _, segment := range s {
+		if segment.Passive {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the segments add up to the service duration. No segments
+// means the whole service is active.
+func (s DurationSegments) Validate(durationMinutes int) error {
+	if len(s) == 0 {
+		return nil
+	}
+	if len(s) > config.MaxDurationSegments {
+		return fmt.Errorf("duration_segments cannot have more than %d segments", config.MaxDurationSegments)
+	}
+
+	active := false
+	for _, segment := range s {
+		if segment.Minutes <= 0 {
+			return fmt.Errorf("duration_segments minutes must be positive")
+		}
+		if !segment.Passive {
+			active = true
+		}
+	}
+	if !active {
+		return fmt.Errorf("duration_segments must have at least one active segment")
+	}
+	if total := s.TotalMinutes(); total != durationMinutes {
+		return fmt.Errorf("duration_segments must add up to the duration (%d minutes, got %d)", durationMinutes, total)
+	}
+	return nil
+}
+
+// ForDuration returns the segments to record on a booking of the given
+// length: nil unless they cover exactly that duration and include passive
+// time, so bookings of a different length are treated as fully active
+func (s DurationSegments) ForDuration(durationMinutes int) DurationSegments {
+	if !s.HasPassive() || s.TotalMinutes() != durationMinutes {
+		return nil
+	}
+	return s
+}
+
+// ActiveRanges returns the times within [start, end) that keep the barber
+// busy. Without passive segments covering exactly that range, the whole
+// range is active.
+func (s DurationSegments) ActiveRanges(start, end time.Time) []TimeRange {
+	if !s.HasPassive() || !start.Add(time.Duration(s.TotalMinutes())*time.Minute).Equal(end) {
+		return []TimeRange{{Start: start, End: end}}
+	}
+
+	var ranges []TimeRange
+	cursor := start
+	for _, segment := range s {
+		next := cursor.Add(time.Duration(segment.Minutes) * time.Minute)
+		if !segment.Passive {
+			// Consecutive active segments form one range
+			if n := len(ranges); n > 0 && ranges[n-1].End.Equal(cursor) {
+				ranges[n-1].End = next
+			} else {
+				ranges = append(ranges, TimeRange{Start: cursor, End: next})
+			}
+		}
+		cursor = next
+	}
+	return ranges
+}
+
+// RangesOverlap reports whether any range in a overlaps any range in b
+func RangesOverlap(a, b []TimeRange) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x.Overlaps(y) {
+				return true
+			}
+		}
+	}
+	return false
+}
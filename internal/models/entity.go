@@ -46,4 +46,12 @@ func (ts TimeSlot) GetID() int        { return ts.ID }
 
 // BookingHistory entity methods
 func (bh BookingHistory) TableName() string { return "booking_history" }
-func (bh BookingHistory) GetID() int        { return bh.ID }
\ No newline at end of file
+func (bh BookingHistory) GetID() int        { return bh.ID }
+
+// AuditLog entity methods
+func (a AuditLog) TableName() string { return "audit_logs" }
+func (a AuditLog) GetID() int        { return a.ID }
+
+// BookingSeries entity methods
+func (bs BookingSeries) TableName() string { return "booking_series" }
+func (bs BookingSeries) GetID() int        { return bs.ID }
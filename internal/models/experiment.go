@@ -0,0 +1,115 @@
+// internal/models/experiment.go
+package models
+
+import (
+	"math"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/experiments"
+)
+
+// ========================================================================
+// EXPERIMENTS - A/B tests of product features
+// ========================================================================
+
+// Experiment is an A/B test. The first variant is the control.
+type Experiment struct {
+	ID          int                  `json:"id" db:"id"`
+	Key         string               `json:"key" db:"key"` // Referenced by the code under test
+	Name        string               `json:"name" db:"name"`
+	Description *string              `json:"description" db:"description"`
+	Status      string               `json:"status" db:"status"`
+	Variants    experiments.Variants `json:"variants" db:"variants"`
+	Winner      *string              `json:"winner" db:"winner"` // Served to everyone once stopped
+	StartedAt   *time.Time           `json:"started_at" db:"started_at"`
+	StoppedAt   *time.Time           `json:"stopped_at" db:"stopped_at"`
+	CreatedBy   *int                 `json:"created_by" db:"created_by"`
+	CreatedAt   time.Time            `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at" db:"updated_at"`
+}
+
+// IsRunning reports whether users are being assigned
+func (e *Experiment) IsRunning() bool {
+	return e.Status == config.ExperimentStatusRunning
+}
+
+// DefaultVariant is what users get outside a running experiment: the
+// winner once stopped with one, otherwise the control
+func (e *Experiment) DefaultVariant() string {
+	if e.Status == config.ExperimentStatusStopped && e.Winner != nil {
+		return *e.Winner
+	}
+	return e.Variants.Control()
+}
+
+// ExperimentExposure records the first time a user was shown an experiment
+type ExperimentExposure struct {
+	ExperimentID int       `json:"experiment_id" db:"experiment_id"`
+	UserID       int       `json:"user_id" db:"user_id"`
+	Variant      string    `json:"variant" db:"variant"`
+	ExposedAt    time.Time `json:"exposed_at" db:"exposed_at"`
+}
+
+// ExperimentConversion is an event recorded for an exposed user
+type ExperimentConversion struct {
+	ID           int       `json:"id" db:"id"`
+	ExperimentID int       `json:"experiment_id" db:"experiment_id"`
+	UserID       int       `json:"user_id" db:"user_id"`
+	Variant      string    `json:"variant" db:"variant"`
+	Event        string    `json:"event" db:"event"`
+	Value        *float64  `json:"value" db:"value"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
+
+// ExperimentVariantResult is one variant's performance
+type ExperimentVariantResult struct {
+	Variant        string   `json:"variant" db:"variant"`
+	Exposures      int      `json:"exposures" db:"exposures"`
+	Conversions    int      `json:"conversions" db:"conversions"` // Exposed users who converted
+	Value          float64  `json:"value" db:"value"`             // Booking revenue or summed event values
+	ConversionRate *float64 `json:"conversion_rate" db:"-"`       // Percentage of exposures; nil without exposures
+	Lift           *float64 `json:"lift" db:"-"`                  // Relative change over the control's rate, in percent
+}
+
+// ExperimentReport is an experiment's conversion by variant
+type ExperimentReport struct {
+	Experiment *Experiment               `json:"experiment"`
+	Metric     string                    `json:"metric"`
+	WindowDays int                       `json:"window_days,omitempty"` // Conversions counted this long after exposure (0 = any time)
+	Variants   []ExperimentVariantResult `json:"variants"`
+}
+
+// Finalize orders the results like the experiment's variants (including
+// variants nobody was exposed to) and computes rates and lift
+func (r *ExperimentReport) Finalize() {
+	byName := make(map[string]ExperimentVariantResult, len(r.Variants))
+	for _, result := range r.Variants {
+		byName[result.Variant] = result
+	}
+
+	results := make([]ExperimentVariantResult, 0, len(r.Experiment.Variants))
+	for _, variant := range r.Experiment.Variants {
+		result := byName[variant.Name]
+		result.Variant = variant.Name
+		result.Value = roundCents(result.Value)
+		if result.Exposures > 0 {
+			rate := math.Round(float64(result.Conversions)/float64(result.Exposures)*1000) / 10
+			result.ConversionRate = &rate
+		}
+		results = append(results, result)
+	}
+
+	if len(results) > 0 && results[0].Conversions > 0 {
+		control := float64(results[0].Conversions) / float64(results[0].Exposures)
+		for i := 1; i < len(results); i++ {
+			if results[i].Exposures == 0 {
+				continue
+			}
+			rate := float64(results[i].Conversions) / float64(results[i].Exposures)
+			lift := math.Round((rate-control)/control*1000) / 10
+			results[i].Lift = &lift
+		}
+	}
+	r.Variants = results
+}
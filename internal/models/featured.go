@@ -0,0 +1,91 @@
+// internal/models/featured.go
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// ========================================================================
+// FEATURED PLACEMENT - Paid promotion in barber search results
+// ========================================================================
+
+// FeaturedPlacement is a barber's paid placement at the top of search
+// results for their city, optionally only within one service category
+type FeaturedPlacement struct {
+	ID               int        `json:"id" db:"id"`
+	BarberID         int        `json:"barber_id" db:"barber_id"`
+	City             string     `json:"city" db:"city"`
+	CategoryID       *int       `json:"category_id" db:"category_id"` // nil = all categories
+	StartsOn         time.Time  `json:"starts_on" db:"starts_on"`
+	EndsOn           time.Time  `json:"ends_on" db:"ends_on"` // Inclusive
+	Status           string     `json:"status" db:"status"`   // pending_payment, active, cancelled
+	Amount           float64    `json:"amount" db:"amount"`
+	Currency         string     `json:"currency" db:"currency"`
+	PaymentProvider  *string    `json:"payment_provider" db:"payment_provider"`
+	PaymentReference *string    `json:"payment_reference" db:"payment_reference"`
+	RefundReference  *string    `json:"refund_reference" db:"refund_reference"`
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+	CancelledAt      *time.Time `json:"cancelled_at" db:"cancelled_at"`
+
+	// Performance over the whole placement
+	Impressions    int      `json:"impressions" db:"impressions"`
+	Clicks         int      `json:"clicks" db:"clicks"`
+	ClickRate      *float64 `json:"click_through_rate" db:"-"` // Percentage of impressions; nil before any impression
+	BarberShopName *string  `json:"barber_shop_name,omitempty" db:"barber_shop_name"`
+}
+
+// Days returns the number of days the placement covers
+func (p *FeaturedPlacement) Days() int {
+	return FeaturedDays(p.StartsOn, p.EndsOn)
+}
+
+// HasStarted reports whether the placement's first day is on or before day
+func (p *FeaturedPlacement) HasStarted(day time.Time) bool {
+	return !p.StartsOn.After(truncateDay(day))
+}
+
+// Finalize computes the click-through rate from the counts
+func (p *FeaturedPlacement) Finalize() {
+	if p.Impressions == 0 {
+		p.ClickRate = nil
+		return
+	}
+	rate := math.Round(float64(p.Clicks)/float64(p.Impressions)*1000) / 10
+	p.ClickRate = &rate
+}
+
+// FeaturedSlotLimit overrides the default number of concurrent placements
+// in one city (and optionally one category)
+type FeaturedSlotLimit struct {
+	ID         int       `json:"id" db:"id"`
+	City       string    `json:"city" db:"city"`
+	CategoryID *int      `json:"category_id" db:"category_id"`
+	MaxSlots   int       `json:"max_slots" db:"max_slots"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// FeaturedSlotDay is the placement inventory for one day in one market
+type FeaturedSlotDay struct {
+	Day       time.Time `json:"day" db:"day"`
+	Booked    int       `json:"booked" db:"booked"`
+	Limit     int       `json:"limit" db:"-"`
+	Remaining int       `json:"remaining" db:"-"`
+}
+
+// FeaturedDays returns the number of calendar days from start to end inclusive
+func FeaturedDays(start, end time.Time) int {
+	days := int(truncateDay(end).Sub(truncateDay(start)).Hours()/24) + 1
+	if days < 0 {
+		return 0
+	}
+	return days
+}
+
+// truncateDay returns t's calendar date as midnight UTC, matching how DATE
+// columns are scanned
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,126 @@
+// internal/models/financial_event.go
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ========================================================================
+// FINANCIAL EVENTS - Hash-chained ledger of money movements
+// ========================================================================
+//
+// Every event carries the hash of the event before it and its own hash
+// over its fields and that previous hash. Changing, removing or reordering
+// an event breaks the chain from that point on, which VerifyFinancialChain
+// detects from the events alone.
+// ========================================================================
+
+// FinancialEvent is one money movement in the ledger
+type FinancialEvent struct {
+	ID         int64     `json:"id" db:"id"`
+	EventType  string    `json:"event_type" db:"event_type"`             // payment.authorized, payment.captured, ...
+	EntityType *string   `json:"entity_type,omitempty" db:"entity_type"` // booking, featured_placement
+	EntityID   *int      `json:"entity_id,omitempty" db:"entity_id"`
+	BarberID   *int      `json:"barber_id,omitempty" db:"barber_id"`
+	Amount     int64     `json:"amount" db:"amount"` // Minor units (cents)
+	Currency   string    `json:"currency" db:"currency"`
+	Provider   *string   `json:"provider,omitempty" db:"provider"`
+	Reference  *string   `json:"reference,omitempty" db:"reference"` // Provider authorization the event belongs to
+	Details    string    `json:"details" db:"details"`               // JSON, hashed exactly as stored
+	OccurredAt time.Time `json:"occurred_at" db:"occurred_at"`
+	PrevHash   string    `json:"prev_hash" db:"prev_hash"`
+	Hash       string    `json:"hash" db:"hash"`
+}
+
+// ComputeHash returns the SHA-256 (hex) of the event's fields and
+// PrevHash. OccurredAt is hashed at microsecond precision in UTC, as the
+// database stores it.
+func (e *FinancialEvent) ComputeHash() string {
+	occurredAt := e.OccurredAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano)
+	fields := []*string{
+		stringField(strconv.FormatInt(e.ID, 10)),
+		&e.EventType,
+		e.EntityType,
+		intField(e.EntityID),
+		intField(e.BarberID),
+		stringField(strconv.FormatInt(e.Amount, 10)),
+		&e.Currency,
+		e.Provider,
+		e.Reference,
+		&e.Details,
+		&occurredAt,
+		&e.PrevHash,
+	}
+	// Length-prefix each field (-1 for NULL) so no two events hash the
+	// same input
+	var b strings.Builder
+	for _, f := range fields {
+		if f == nil {
+			b.WriteString("-1:|")
+			continue
+		}
+		fmt.Fprintf(&b, "%d:%s|", len(*f), *f)
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
+
+// FormattedAmount is the amount in major units, e.g. "12.50"
+func (e *FinancialEvent) FormattedAmount() string {
+	sign := ""
+	amount := e.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, amount/100, amount%100)
+}
+
+// ChainError is where a financial event chain stops verifying
+type ChainError struct {
+	EventID int64
+	Reason  string
+}
+
+func (e *ChainError) Error() string {
+	return fmt.Sprintf("financial event %d: %s", e.EventID, e.Reason)
+}
+
+// VerifyFinancialChain checks that events, in ledger order, link up from
+// prevHash and that each hash matches the event's fields. It returns the
+// hash of the last event (prevHash when there are none) for verifying the
+// next batch, or a *ChainError for the first event that does not verify.
+func VerifyFinancialChain(prevHash string, events []FinancialEvent) (string, error) {
+	var lastID int64
+	for i := range events {
+		event := &events[i]
+		if event.ID <= lastID {
+			return "", &ChainError{EventID: event.ID, Reason: fmt.Sprintf("out of order after event %d", lastID)}
+		}
+		if event.PrevHash != prevHash {
+			return "", &ChainError{EventID: event.ID, Reason: "previous hash does not match the event before it"}
+		}
+		if event.ComputeHash() != event.Hash {
+			return "", &ChainError{EventID: event.ID, Reason: "hash does not match the event's contents"}
+		}
+		prevHash, lastID = event.Hash, event.ID
+	}
+	return prevHash, nil
+}
+
+// stringField is a field as hashed
+func stringField(value string) *string {
+	return &value
+}
+
+// intField is a nullable number as hashed
+func intField(value *int) *string {
+	if value == nil {
+		return nil
+	}
+	return stringField(strconv.Itoa(*value))
+}
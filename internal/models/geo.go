@@ -0,0 +1,46 @@
+// internal/models/geo.go
+package models
+
+import (
+	"math"
+
+	"barber-booking-system/internal/config"
+)
+
+// GeoBounds is a latitude/longitude box, in degrees
+type GeoBounds struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+// BoundingBox returns a box holding every point within radiusKm of
+// (lat, lng), so a distance search can skip rows outside it. Near a pole or
+// across the antimeridian the box spans every longitude.
+func BoundingBox(lat, lng, radiusKm float64) GeoBounds {
+	angular := radiusKm / config.EarthRadiusKm
+	deltaLat := angular * 180 / math.Pi
+
+	bounds := GeoBounds{
+		MinLatitude:  math.Max(lat-deltaLat, -90),
+		MaxLatitude:  math.Min(lat+deltaLat, 90),
+		MinLongitude: -180,
+		MaxLongitude: 180,
+	}
+	if bounds.MinLatitude == -90 || bounds.MaxLatitude == 90 {
+		return bounds
+	}
+
+	ratio := math.Sin(angular) / math.Cos(lat*math.Pi/180)
+	if ratio >= 1 {
+		return bounds
+	}
+	deltaLng := math.Asin(ratio) * 180 / math.Pi
+	if lng-deltaLng < -180 || lng+deltaLng > 180 {
+		return bounds
+	}
+	bounds.MinLongitude = lng - deltaLng
+	bounds.MaxLongitude = lng + deltaLng
+	return bounds
+}
@@ -0,0 +1,66 @@
+// internal/models/inventory.go
+package models
+
+import (
+	"time"
+)
+
+// ========================================================================
+// INVENTORY - Products barbers use in their services
+// ========================================================================
+//
+// Barbers track the stock of products their services need (dye, razors).
+// When a booking is completed, each product its service requires is taken
+// out of stock, and the barber is alerted once stock drops to the low-stock
+// threshold.
+// ========================================================================
+
+// InventoryItem is a product a barber keeps in stock
+type InventoryItem struct {
+	ID                int       `json:"id" db:"id"`
+	BarberID          int       `json:"barber_id" db:"barber_id"`
+	Name              string    `json:"name" db:"name"` // Matched against services' required_products
+	Unit              string    `json:"unit" db:"unit"`
+	Quantity          int       `json:"quantity" db:"quantity"`
+	UsagePerService   int       `json:"usage_per_service" db:"usage_per_service"`     // Taken out of stock per completed booking
+	LowStockThreshold int       `json:"low_stock_threshold" db:"low_stock_threshold"` // Alert at or below this quantity (0 = when out of stock)
+	CreatedAt         time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// IsLowStock reports whether the item is at or below its low-stock threshold
+func (i *InventoryItem) IsLowStock() bool {
+	return i.Quantity <= i.LowStockThreshold
+}
+
+// InventoryStockChange is an item after a booking took some of it out of
+// stock, with the quantity it had before
+type InventoryStockChange struct {
+	InventoryItem
+	PreviousQuantity int `db:"previous_quantity"`
+}
+
+// BecameLowStock reports whether this change took the item down to its
+// low-stock threshold, so each drop is alerted once
+func (c *InventoryStockChange) BecameLowStock() bool {
+	return c.PreviousQuantity > c.LowStockThreshold && c.IsLowStock()
+}
+
+// InventoryItemUsage is how much of an item completed bookings used
+type InventoryItemUsage struct {
+	ItemID   int    `json:"item_id" db:"item_id"`
+	Name     string `json:"name" db:"name"`
+	Unit     string `json:"unit" db:"unit"`
+	Quantity int    `json:"quantity" db:"quantity"`
+	Bookings int    `json:"bookings" db:"bookings"`
+	InStock  int    `json:"in_stock" db:"in_stock"`
+}
+
+// InventoryUsageReport summarizes a barber's product usage over a date range
+// (UTC days, inclusive)
+type InventoryUsageReport struct {
+	BarberID int                  `json:"barber_id"`
+	From     time.Time            `json:"from"`
+	To       time.Time            `json:"to"`
+	Items    []InventoryItemUsage `json:"items"`
+}
@@ -0,0 +1,95 @@
+// internal/models/location.go
+package models
+
+import (
+	"time"
+)
+
+// ========================================================================
+// LOCATIONS & TRAVEL - Barbers working across several locations
+// ========================================================================
+//
+// A booking may take place at one of the barber's locations. Between
+// bookings at different locations the barber needs the configured travel
+// time, so the travel buffer is kept free around the other booking as a
+// whole (its passive segments can't be used from another location).
+// Bookings without a location, and pairs of locations without a configured
+// travel time, need no travel buffer.
+// ========================================================================
+
+// BarberLocation is a place where a barber takes bookings
+type BarberLocation struct {
+	ID        int       `json:"id" db:"id"`
+	BarberID  int       `json:"barber_id" db:"barber_id"`
+	Name      string    `json:"name" db:"name"`
+	Address   *string   `json:"address" db:"address"`
+	City      *string   `json:"city" db:"city"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// TravelTime is how long a barber needs to get from one location to another
+type TravelTime struct {
+	FromLocationID int `json:"from_location_id" db:"from_location_id"`
+	ToLocationID   int `json:"to_location_id" db:"to_location_id"`
+	Minutes        int `json:"minutes" db:"minutes"`
+}
+
+// TravelTimes is a travel-time matrix keyed by (from, to) location IDs
+type TravelTimes map[[2]int]int
+
+// NewTravelTimes builds a matrix from travel time entries
+func NewTravelTimes(entries []TravelTime) TravelTimes {
+	times := make(TravelTimes, len(entries))
+	for _, e := range entries {
+		times[[2]int{e.FromLocationID, e.ToLocationID}] = e.Minutes
+	}
+	return times
+}
+
+// Between returns the travel time from one location to another. Without an
+// entry for that direction the reverse direction is used; without either,
+// or when a location is unknown (nil) or the same, no travel is needed.
+func (t TravelTimes) Between(from, to *int) time.Duration {
+	if from == nil || to == nil || *from == *to {
+		return 0
+	}
+	minutes, ok := t[[2]int{*from, *to}]
+	if !ok {
+		minutes = t[[2]int{*to, *from}]
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// Longest returns the longest travel time in the matrix
+func (t TravelTimes) Longest() time.Duration {
+	longest := 0
+	for _, minutes := range t {
+		if minutes > longest {
+			longest = minutes
+		}
+	}
+	return time.Duration(longest) * time.Minute
+}
+
+// Travel is where a new booking takes place and the barber's travel times
+// (the zero value needs no travel buffers)
+type Travel struct {
+	LocationID *int
+	Times      TravelTimes
+}
+
+// Busy returns the times other keeps the barber from a booking at this
+// location: its active segments at the same location, or all of it plus
+// the travel time each way from a different one
+func (t Travel) Busy(other *Booking) []TimeRange {
+	toOther := t.Times.Between(t.LocationID, other.LocationID)
+	fromOther := t.Times.Between(other.LocationID, t.LocationID)
+	if toOther == 0 && fromOther == 0 {
+		return other.ActiveRanges()
+	}
+	return []TimeRange{{
+		Start: other.ScheduledStartTime.Add(-toOther),
+		End:   other.ScheduledEndTime.Add(fromOther),
+	}}
+}
@@ -0,0 +1,19 @@
+// internal/models/no_show.go
+package models
+
+import "time"
+
+// NoShowCandidate is a confirmed booking nobody started whose grace period
+// after the start time has passed
+type NoShowCandidate struct {
+	BookingID          int       `json:"booking_id" db:"booking_id"`
+	BarberID           int       `json:"barber_id" db:"barber_id"`
+	BarberUserID       int       `json:"barber_user_id" db:"barber_user_id"`
+	ScheduledStartTime time.Time `json:"scheduled_start_time" db:"scheduled_start_time"`
+	GraceMinutes       int       `json:"grace_minutes" db:"grace_minutes"` // The barber's, or the platform default
+}
+
+// DueAt returns when the booking becomes a no-show
+func (c *NoShowCandidate) DueAt() time.Time {
+	return c.ScheduledStartTime.Add(time.Duration(c.GraceMinutes) * time.Minute)
+}
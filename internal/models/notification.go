@@ -19,6 +19,11 @@ type Notification struct {
 	DeliveredAt *time.Time `json:"delivered_at" db:"delivered_at"`
 	ReadAt      *time.Time `json:"read_at" db:"read_at"`
 
+	// Background delivery
+	Attempts      int        `json:"attempts" db:"attempts"`
+	NextAttemptAt *time.Time `json:"next_attempt_at" db:"next_attempt_at"`
+	LastError     *string    `json:"last_error" db:"last_error"`
+
 	// Related entities
 	RelatedEntityType *string `json:"related_entity_type" db:"related_entity_type"` // booking, payment, review
 	RelatedEntityID   *int    `json:"related_entity_id" db:"related_entity_id"`
@@ -0,0 +1,38 @@
+// internal/models/notification_preference.go
+package models
+
+import (
+	"slices"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// NotificationPreference is the channels a user wants one notification type
+// delivered on. No channels turns the type off.
+type NotificationPreference struct {
+	UserID           int         `json:"-" db:"user_id"`
+	NotificationType string      `json:"type" db:"notification_type"`
+	Channels         StringArray `json:"channels" db:"channels"`
+	UpdatedAt        time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// IsOff reports whether the user turned the type off
+func (p *NotificationPreference) IsOff() bool {
+	return len(p.Channels) == 0
+}
+
+// ChannelsFor returns the channels to deliver a notification on when the
+// sender picked channels for it: the preferred ones, except that SMS is
+// only kept when the sender picked it too (texts go only to numbers the
+// sender has checked are opted in)
+func (p *NotificationPreference) ChannelsFor(picked []string) []string {
+	channels := make([]string, 0, len(p.Channels))
+	for _, channel := range p.Channels {
+		if channel == config.NotificationChannelSMS && !slices.Contains(picked, channel) {
+			continue
+		}
+		channels = append(channels, channel)
+	}
+	return channels
+}
@@ -0,0 +1,101 @@
+// internal/models/nps.go
+package models
+
+import (
+	"math"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// NPSSurvey is a one-tap Net Promoter Score survey for a completed booking
+type NPSSurvey struct {
+	ID          int        `json:"id" db:"id"`
+	Token       string     `json:"-" db:"token"` // Secret in the survey link; answers need no login
+	BookingID   int        `json:"booking_id" db:"booking_id"`
+	CustomerID  int        `json:"customer_id" db:"customer_id"`
+	BarberID    int        `json:"barber_id" db:"barber_id"`
+	Score       *int       `json:"score" db:"score"` // 0-10, nil until answered
+	Comment     *string    `json:"comment" db:"comment"`
+	SentAt      time.Time  `json:"sent_at" db:"sent_at"`
+	ExpiresAt   time.Time  `json:"expires_at" db:"expires_at"`
+	RespondedAt *time.Time `json:"responded_at" db:"responded_at"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+}
+
+// IsAnswered reports whether the customer has responded
+func (s *NPSSurvey) IsAnswered() bool {
+	return s.RespondedAt != nil
+}
+
+// IsExpired reports whether the survey no longer accepts answers
+func (s *NPSSurvey) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
+
+// NPSCategory classifies a 0-10 score as promoter, passive or detractor
+func NPSCategory(score int) string {
+	switch {
+	case score >= config.NPSPromoterMinScore:
+		return config.NPSCategoryPromoter
+	case score >= config.NPSPassiveMinScore:
+		return config.NPSCategoryPassive
+	default:
+		return config.NPSCategoryDetractor
+	}
+}
+
+// NPSSummary aggregates survey responses. Score is the percentage of
+// promoters minus the percentage of detractors (-100 to 100).
+type NPSSummary struct {
+	Responses  int      `json:"responses" db:"-"`
+	Promoters  int      `json:"promoters" db:"promoters"`
+	Passives   int      `json:"passives" db:"passives"`
+	Detractors int      `json:"detractors" db:"detractors"`
+	Score      *float64 `json:"score" db:"-"` // nil without responses
+}
+
+// Finalize computes the score from the counts
+func (s *NPSSummary) Finalize() {
+	s.Responses = s.Promoters + s.Passives + s.Detractors
+	if s.Responses == 0 {
+		s.Score = nil
+		return
+	}
+	score := math.Round(float64(s.Promoters-s.Detractors)/float64(s.Responses)*1000) / 10
+	s.Score = &score
+}
+
+// NPSTrendPoint is the NPS for one week or month
+type NPSTrendPoint struct {
+	PeriodStart time.Time `json:"period_start" db:"period_start"`
+	NPSSummary
+}
+
+// NPSTrend is a series of NPS points plus the total over the whole range
+type NPSTrend struct {
+	BarberID *int            `json:"barber_id,omitempty"` // nil for platform-wide
+	Interval string          `json:"interval"`
+	From     time.Time       `json:"from"`
+	To       time.Time       `json:"to"`
+	Overall  NPSSummary      `json:"overall"`
+	Points   []NPSTrendPoint `json:"points"`
+}
+
+// BuildNPSTrend finalizes per-period counts and totals them
+func BuildNPSTrend(points []NPSTrendPoint) (NPSSummary, []NPSTrendPoint) {
+	var overall NPSSummary
+	for i := range points {
+		p := &points[i]
+		p.Finalize()
+
+		overall.Promoters += p.Promoters
+		overall.Passives += p.Passives
+		overall.Detractors += p.Detractors
+	}
+	overall.Finalize()
+	if points == nil {
+		points = []NPSTrendPoint{}
+	}
+	return overall, points
+}
@@ -0,0 +1,76 @@
+// internal/models/open_slot.go
+package models
+
+import (
+	"barber-booking-system/internal/config"
+	"time"
+)
+
+// ========================================================================
+// OPEN SLOTS - Last-minute openings broadcast to customers
+// ========================================================================
+//
+// When a slot frees up at short notice (a cancellation just happened), the
+// barber can broadcast it as a push notification to customers who
+// favorited them and customers nearby, optionally with a flash discount.
+// It is first come, first served: the first customer to claim the slot
+// books it, and later claims are refused.
+// ========================================================================
+
+// FavoriteBarber is a barber a customer favorited
+type FavoriteBarber struct {
+	UserID    int       `json:"user_id" db:"user_id"`
+	BarberID  int       `json:"barber_id" db:"barber_id"`
+	ShopName  string    `json:"shop_name" db:"shop_name"`
+	City      string    `json:"city" db:"city"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// OpenSlot is a last-minute opening a barber broadcast
+type OpenSlot struct {
+	ID              int         `json:"id" db:"id"`
+	BarberID        int         `json:"barber_id" db:"barber_id"`
+	BarberServiceID int         `json:"barber_service_id" db:"barber_service_id"`
+	ServiceName     string      `json:"service_name" db:"service_name"`
+	StartTime       time.Time   `json:"start_time" db:"start_time"`
+	DurationMinutes int         `json:"duration_minutes" db:"duration_minutes"`
+	ServicePrice    float64     `json:"service_price" db:"service_price"`       // Before the flash discount
+	DiscountPercent int         `json:"discount_percent" db:"discount_percent"` // Flash discount, 0 for none
+	Message         *string     `json:"message,omitempty" db:"message"`
+	Audiences       StringArray `json:"audiences" db:"audiences"`             // favorites, nearby
+	RadiusKm        *float64    `json:"radius_km,omitempty" db:"radius_km"`   // Nearby audience only
+	RecipientCount  int         `json:"recipient_count" db:"recipient_count"` // Customers notified
+	Status          string      `json:"status" db:"status"`                   // open, claimed, cancelled
+	ClaimsCloseAt   time.Time   `json:"claims_close_at" db:"claims_close_at"`
+	ClaimedBy       *int        `json:"claimed_by,omitempty" db:"claimed_by"`
+	ClaimedAt       *time.Time  `json:"claimed_at,omitempty" db:"claimed_at"`
+	BookingID       *int        `json:"booking_id,omitempty" db:"booking_id"`
+	CreatedBy       *int        `json:"created_by,omitempty" db:"created_by"`
+	CreatedAt       time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// EndTime returns when the slot ends
+func (s *OpenSlot) EndTime() time.Time {
+	return s.StartTime.Add(time.Duration(s.DurationMinutes) * time.Minute)
+}
+
+// DiscountAmount returns the flash discount off the service price
+func (s *OpenSlot) DiscountAmount() float64 {
+	return roundCents(s.ServicePrice * float64(s.DiscountPercent) / 100)
+}
+
+// Price returns the service price after the flash discount, before tax
+func (s *OpenSlot) Price() float64 {
+	return roundCents(s.ServicePrice - s.DiscountAmount())
+}
+
+// IsClaimable reports whether the slot can still be claimed at now
+func (s *OpenSlot) IsClaimable(now time.Time) bool {
+	return s.Status == config.OpenSlotStatusOpen && now.Before(s.ClaimsCloseAt)
+}
+
+// ClaimsCloseFor returns when claims on a slot starting at start close
+func ClaimsCloseFor(start time.Time) time.Time {
+	return start.Add(-config.OpenSlotClaimNotice)
+}
@@ -0,0 +1,44 @@
+// internal/models/outbox.go
+package models
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// ========================================================================
+// OUTBOX - Domain events recorded with the change that caused them
+// ========================================================================
+
+// OutboxEvent is a domain event waiting to be (or already) relayed to its
+// handlers
+type OutboxEvent struct {
+	ID                int64           `json:"id" db:"id"`
+	EventID           string          `json:"event_id" db:"event_id"` // Stable across retries; consumers dedupe on it
+	EventType         string          `json:"event_type" db:"event_type"`
+	AggregateType     string          `json:"aggregate_type" db:"aggregate_type"` // e.g. booking
+	AggregateID       int             `json:"aggregate_id" db:"aggregate_id"`
+	Payload           json.RawMessage `json:"payload" db:"payload"`
+	Status            string          `json:"status" db:"status"` // pending, dispatched, failed
+	Attempts          int             `json:"attempts" db:"attempts"`
+	NextAttemptAt     *time.Time      `json:"next_attempt_at" db:"next_attempt_at"`
+	CompletedHandlers StringArray     `json:"completed_handlers" db:"completed_handlers"` // Handlers that have succeeded
+	LastError         *string         `json:"last_error" db:"last_error"`
+	OccurredAt        time.Time       `json:"occurred_at" db:"occurred_at"`
+	DispatchedAt      *time.Time      `json:"dispatched_at" db:"dispatched_at"`
+	CreatedAt         time.Time       `json:"created_at" db:"created_at"`
+}
+
+// HandledBy reports whether a handler has already processed the event
+func (e *OutboxEvent) HandledBy(handler string) bool {
+	return slices.Contains(e.CompletedHandlers, handler)
+}
+
+// BookingEvent is the payload of booking domain events
+type BookingEvent struct {
+	Booking        *Booking `json:"booking"`                   // The booking as of the change
+	PreviousStatus string   `json:"previous_status,omitempty"` // Set for status changes and cancellations
+	ChangedBy      *int     `json:"changed_by,omitempty"`      // Nil for guests and the system
+	Reason         string   `json:"reason,omitempty"`          // Cancellation reason, when given
+}
@@ -0,0 +1,55 @@
+// internal/models/partition.go
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ========================================================================
+// TABLE PARTITIONS - Monthly range partitions
+// ========================================================================
+// Partitioned tables are split into one partition per calendar month (UTC)
+// named <table>_yYYYYmMM, matching the migrations that created them.
+// ========================================================================
+
+// MonthlyPartition is one month's partition of a range-partitioned table
+type MonthlyPartition struct {
+	Name  string
+	Month time.Time // First instant of the month, UTC
+}
+
+// End returns the exclusive upper bound of the partition
+func (p MonthlyPartition) End() time.Time {
+	return p.Month.AddDate(0, 1, 0)
+}
+
+// MonthStart returns the first instant of t's month in UTC
+func MonthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// NewMonthlyPartition returns the partition of table holding t
+func NewMonthlyPartition(table string, t time.Time) MonthlyPartition {
+	month := MonthStart(t)
+	return MonthlyPartition{
+		Name:  fmt.Sprintf("%s_y%04dm%02d", table, month.Year(), int(month.Month())),
+		Month: month,
+	}
+}
+
+// ParseMonthlyPartition parses a partition name of table; ok is false for
+// other names, such as the default partition
+func ParseMonthlyPartition(table, name string) (MonthlyPartition, bool) {
+	suffix, found := strings.CutPrefix(name, table+"_")
+	if !found {
+		return MonthlyPartition{}, false
+	}
+	month, err := time.Parse("y2006m01", suffix)
+	if err != nil {
+		return MonthlyPartition{}, false
+	}
+	return MonthlyPartition{Name: name, Month: month}, true
+}
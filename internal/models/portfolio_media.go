@@ -0,0 +1,25 @@
+// internal/models/portfolio_media.go
+package models
+
+import "time"
+
+// PortfolioMedia is a photo a barber shows on one of their services: an
+// example of their work or a before/after shot
+type PortfolioMedia struct {
+	ID              int       `json:"id" db:"id"`
+	BarberServiceID int       `json:"barber_service_id" db:"barber_service_id"`
+	Kind            string    `json:"kind" db:"kind"`         // portfolio, before_after
+	Position        int       `json:"position" db:"position"` // Order among the service's photos of this kind
+	Caption         *string   `json:"caption" db:"caption"`
+	ImageKey        string    `json:"-" db:"image_key"`     // Storage key of the photo
+	ThumbnailKey    string    `json:"-" db:"thumbnail_key"` // Storage key of the thumbnail
+	URL             string    `json:"url" db:"url"`
+	ThumbnailURL    string    `json:"thumbnail_url" db:"thumbnail_url"`
+	ContentType     string    `json:"content_type" db:"content_type"`
+	SizeBytes       int       `json:"size_bytes" db:"size_bytes"`
+	Width           int       `json:"width" db:"width"`
+	Height          int       `json:"height" db:"height"`
+	UploadedBy      *int      `json:"uploaded_by,omitempty" db:"uploaded_by"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
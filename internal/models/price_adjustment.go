@@ -0,0 +1,71 @@
+// internal/models/price_adjustment.go
+package models
+
+import "fmt"
+
+// ========================================================================
+// PRICE ADJUSTMENT - Changing a booking's price when it is completed
+// ========================================================================
+//
+// When the work turns out bigger (or smaller) than booked, the barber can
+// charge a different service price on completion, within bounds set as
+// percentages of the booked price. The booking is re-priced with the taxes
+// charged when it was booked; the discount stays as it was.
+// ========================================================================
+
+// CheckPriceAdjustment validates an adjusted service price against the
+// booked one. maxIncreasePercent and maxDecreasePercent bound the change.
+func CheckPriceAdjustment(booked, adjusted, maxIncreasePercent, maxDecreasePercent float64) error {
+	if adjusted < 0 {
+		return fmt.Errorf("service price cannot be negative")
+	}
+
+	highest := roundCents(booked * (1 + maxIncreasePercent/100))
+	if adjusted > highest {
+		return fmt.Errorf("service price cannot be raised more than %g%% (to at most %.2f)", maxIncreasePercent, highest)
+	}
+	lowest := roundCents(booked * (1 - maxDecreasePercent/100))
+	if lowest < 0 {
+		lowest = 0
+	}
+	if adjusted < lowest {
+		return fmt.Errorf("service price cannot be lowered more than %g%% (to at least %.2f)", maxDecreasePercent, lowest)
+	}
+	return nil
+}
+
+// bookedTaxRules rebuilds the tax rules behind a booking's tax lines
+func (b *Booking) bookedTaxRules() []TaxRule {
+	rules := make([]TaxRule, 0, len(b.TaxLines))
+	for _, line := range b.TaxLines {
+		rule := TaxRule{Name: line.Name, RatePercent: line.RatePercent, Inclusive: line.Inclusive}
+		if line.TaxRuleID != nil {
+			rule.ID = *line.TaxRuleID
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// Reprice sets a new service price and recalculates the tax and total with
+// the taxes charged on the booking (TaxLines must be loaded). The discount
+// is kept and must not exceed the new price.
+func (b *Booking) Reprice(servicePrice float64) error {
+	if b.DiscountAmount > servicePrice {
+		return fmt.Errorf("service price cannot be less than the discount (%.2f)", b.DiscountAmount)
+	}
+
+	original := b.TaxLines
+	pricing := NewTaxedPricing(servicePrice, b.DiscountAmount, b.bookedTaxRules(), b.Currency)
+
+	b.ServicePrice = pricing.ServicePrice
+	b.TaxAmount = pricing.TaxAmount
+	b.TotalPrice = pricing.TotalPrice
+	b.TaxLines = make([]BookingTaxLine, len(pricing.TaxLines))
+	for i, line := range pricing.TaxLines {
+		// Keep the rule a line came from, or none if the rule is gone
+		line.TaxRuleID = original[i].TaxRuleID
+		b.TaxLines[i] = BookingTaxLine{BookingID: b.ID, TaxLine: line}
+	}
+	return nil
+}
@@ -24,8 +24,11 @@ type PricingBreakdown struct {
 	TaxAmount      float64 `json:"tax_amount"`
 	TaxRate        float64 `json:"tax_rate"`
 	SubTotal       float64 `json:"sub_total"`   // ServicePrice - DiscountAmount
-	TotalPrice     float64 `json:"total_price"` // SubTotal + TaxAmount
+	TotalPrice     float64 `json:"total_price"` // SubTotal + TaxAmount (exclusive taxes only)
 	Currency       string  `json:"currency"`
+
+	// Taxes from tax rules (see NewTaxedPricing)
+	TaxLines []TaxLine `json:"tax_lines,omitempty"`
 }
 
 // NewPricingBreakdown creates a new pricing breakdown with calculations
@@ -45,6 +48,30 @@ func NewPricingBreakdown(servicePrice, discountAmount float64, taxRate float64,
 	}
 }
 
+// NewTaxedPricing creates a pricing breakdown with the taxes of the given
+// rules. TaxAmount is all the tax charged; only exclusive taxes are added
+// to the total, and TaxRate is the combined rate on the pre-tax amount.
+func NewTaxedPricing(servicePrice, discountAmount float64, rules []TaxRule, currency string) *PricingBreakdown {
+	subTotal := servicePrice - discountAmount
+	lines, added := ApplyTaxes(subTotal, rules)
+
+	taxRate := 0.0
+	for _, rule := range rules {
+		taxRate += rule.RatePercent / 100
+	}
+
+	return &PricingBreakdown{
+		ServicePrice:   servicePrice,
+		DiscountAmount: discountAmount,
+		TaxAmount:      TotalTax(lines),
+		TaxRate:        taxRate,
+		SubTotal:       subTotal,
+		TotalPrice:     roundCents(subTotal + added),
+		Currency:       currency,
+		TaxLines:       lines,
+	}
+}
+
 // CalculatePricing is a convenience function for calculating pricing
 func CalculatePricing(servicePrice, discountAmount, taxRate float64) *PricingBreakdown {
 	return NewPricingBreakdown(servicePrice, discountAmount, taxRate, "USD")
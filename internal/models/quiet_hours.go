@@ -0,0 +1,62 @@
+// internal/models/quiet_hours.go
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// QuietHours is a daily window of local time when non-urgent notifications
+// are held back. The window may span midnight (22:00 to 08:00); the zero
+// value, or equal start and end, has no quiet hours.
+type QuietHours struct {
+	Start time.Duration // Since local midnight
+	End   time.Duration
+}
+
+// ParseQuietHours parses a window from HH:MM start and end times
+func ParseQuietHours(start, end string) (QuietHours, error) {
+	from, err := time.Parse("15:04", start)
+	if err != nil {
+		return QuietHours{}, fmt.Errorf("quiet hours start must be in HH:MM format")
+	}
+	to, err := time.Parse("15:04", end)
+	if err != nil {
+		return QuietHours{}, fmt.Errorf("quiet hours end must be in HH:MM format")
+	}
+	return QuietHours{
+		Start: time.Duration(from.Hour())*time.Hour + time.Duration(from.Minute())*time.Minute,
+		End:   time.Duration(to.Hour())*time.Hour + time.Duration(to.Minute())*time.Minute,
+	}, nil
+}
+
+// IsZero returns true if there are no quiet hours
+func (q QuietHours) IsZero() bool {
+	return q.Start == q.End
+}
+
+// Defer returns when something due at t may go out for a reader in loc:
+// t itself outside the quiet hours, otherwise the local time they end
+func (q QuietHours) Defer(t time.Time, loc *time.Location) time.Time {
+	if q.IsZero() {
+		return t
+	}
+	local := t.In(loc)
+	sinceMidnight := time.Duration(local.Hour())*time.Hour +
+		time.Duration(local.Minute())*time.Minute +
+		time.Duration(local.Second())*time.Second
+
+	days := 0
+	switch {
+	case q.Start < q.End && sinceMidnight >= q.Start && sinceMidnight < q.End:
+	case q.Start > q.End && sinceMidnight < q.End:
+	case q.Start > q.End && sinceMidnight >= q.Start:
+		days = 1 // Ends tomorrow morning
+	default:
+		return t
+	}
+
+	// Built from the wall clock so the end stays put across DST changes
+	end := int(q.End / time.Minute)
+	return time.Date(local.Year(), local.Month(), local.Day()+days, end/60, end%60, 0, 0, loc)
+}
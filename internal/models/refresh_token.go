@@ -0,0 +1,23 @@
+// internal/models/refresh_token.go
+package models
+
+import "time"
+
+// RefreshToken is a long-lived credential exchanged for new access tokens.
+// Only the hash of the token is stored.
+type RefreshToken struct {
+	ID         int        `json:"id" db:"id"`
+	UserID     int        `json:"user_id" db:"user_id"`
+	FamilyID   string     `json:"family_id" db:"family_id"` // Shared by every token rotated from one login
+	TokenHash  string     `json:"-" db:"token_hash"`
+	ExpiresAt  time.Time  `json:"expires_at" db:"expires_at"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	UsedAt     *time.Time `json:"used_at" db:"used_at"` // Exchanged for a successor
+	RevokedAt  *time.Time `json:"revoked_at" db:"revoked_at"`
+	ReplacedBy *int       `json:"replaced_by" db:"replaced_by"`
+}
+
+// IsExpired reports whether the token has expired at now
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
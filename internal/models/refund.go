@@ -0,0 +1,64 @@
+package models
+
+import (
+	"barber-booking-system/internal/config"
+	"math"
+	"time"
+)
+
+// RefundQuote is how much of a prepaid booking is returned on cancellation
+type RefundQuote struct {
+	Percent         int     `json:"percent"`          // 0-100
+	Amount          float64 `json:"amount"`           // Refunded to the customer
+	CancellationFee float64 `json:"cancellation_fee"` // Kept by the shop
+	Reason          string  `json:"reason"`
+}
+
+// IsFull reports whether the whole amount is refunded
+func (q RefundQuote) IsFull() bool {
+	return q.Percent >= 100
+}
+
+// QuoteRefund applies the cancellation policy to a booking cancelled at now.
+// Barber cancellations are always refunded in full; customer cancellations
+// depend on how much notice was given. The policy applies to what was
+// prepaid: the deposit, or the full price.
+func QuoteRefund(policy config.CancellationPolicyConfig, booking *Booking, now time.Time, byCustomer bool) RefundQuote {
+	notice := booking.ScheduledStartTime.Sub(now)
+
+	var percent int
+	var reason string
+	switch {
+	case !byCustomer:
+		percent, reason = 100, "cancelled by barber"
+	case notice >= time.Duration(policy.FullRefundHours)*time.Hour:
+		percent, reason = 100, "cancelled within the full refund window"
+	case notice >= time.Duration(policy.PartialRefundHours)*time.Hour:
+		percent, reason = policy.PartialRefundPercent, "cancelled within the partial refund window"
+	default:
+		percent, reason = 0, "cancelled too late for a refund"
+	}
+	percent = max(0, min(100, percent))
+
+	prepaid := booking.PrepaidAmount()
+	amount := roundCents(prepaid * float64(percent) / 100)
+	return RefundQuote{
+		Percent:         percent,
+		Amount:          amount,
+		CancellationFee: roundCents(prepaid - amount),
+		Reason:          reason,
+	}
+}
+
+// IsPrepaid reports whether money was taken or held for the booking
+func (b *Booking) IsPrepaid() bool {
+	if b.PaymentReference == nil || *b.PaymentReference == "" {
+		return false
+	}
+	return b.PaymentStatus == config.PaymentStatusAuthorized || b.PaymentStatus == config.PaymentStatusPaid
+}
+
+// roundCents rounds an amount to two decimal places
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
@@ -0,0 +1,36 @@
+// internal/models/relations.go
+package models
+
+// ========================================================================
+// RELATION SUMMARIES - Related records attached to list responses
+// ========================================================================
+
+// CustomerSummary is the part of a customer's account shown on their
+// bookings and reviews
+type CustomerSummary struct {
+	ID                int     `json:"id"`
+	Name              string  `json:"name"`
+	ProfilePictureURL *string `json:"profile_picture_url"`
+}
+
+// BarberSummary is the part of a barber's profile shown on bookings,
+// reviews and notifications about them
+type BarberSummary struct {
+	ID              int     `json:"id"`
+	UUID            string  `json:"uuid"`
+	ShopName        string  `json:"shop_name"`
+	City            string  `json:"city"`
+	ProfileImageURL *string `json:"profile_image_url"`
+	Rating          float64 `json:"rating"`
+}
+
+// ServiceSummary is a barber service as shown on bookings: the barber's
+// name for it (custom or the catalog's), price and duration
+type ServiceSummary struct {
+	ID              int     `json:"id"`
+	ServiceID       int     `json:"service_id"`
+	Name            string  `json:"name"`
+	Price           float64 `json:"price"`
+	Currency        string  `json:"currency"`
+	DurationMinutes int     `json:"duration_minutes"`
+}
@@ -3,6 +3,8 @@ package models
 import (
 	"errors"
 	"time"
+
+	"barber-booking-system/internal/config"
 )
 
 // Review represents customer reviews and ratings
@@ -46,10 +48,17 @@ type Review struct {
 	// Community interaction
 	HelpfulVotes int `json:"helpful_votes" db:"helpful_votes"`
 	TotalVotes   int `json:"total_votes" db:"total_votes"`
-
-	// Barber response
-	BarberResponse   *string    `json:"barber_response" db:"barber_response"`
-	BarberResponseAt *time.Time `json:"barber_response_at" db:"barber_response_at"`
+	ReportCount  int `json:"report_count" db:"report_count"` // User reports since the review was last moderated
+
+	// Barber response. BarberResponse is the live response; a response
+	// awaiting moderation, rejected or redacted is PendingBarberResponse.
+	BarberResponse            *string    `json:"barber_response" db:"barber_response"`
+	BarberResponseAt          *time.Time `json:"barber_response_at" db:"barber_response_at"`
+	BarberResponseStatus      *string    `json:"barber_response_status,omitempty" db:"barber_response_status"` // pending, approved, rejected, flagged, redacted
+	PendingBarberResponse     *string    `json:"-" db:"pending_barber_response"`
+	BarberResponseNotes       *string    `json:"-" db:"barber_response_notes"`
+	BarberResponseModeratedBy *int       `json:"-" db:"barber_response_moderated_by"`
+	BarberResponseModeratedAt *time.Time `json:"-" db:"barber_response_moderated_at"`
 
 	CreatedAt time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
@@ -103,6 +112,28 @@ func (r *Review) IsPositive() bool {
 	return r.OverallRating >= 4
 }
 
+// ResponseStatus returns the moderation status of the barber's response,
+// or "" when there is none
+func (r *Review) ResponseStatus() string {
+	if r.BarberResponseStatus != nil {
+		return *r.BarberResponseStatus
+	}
+	if r.BarberResponse != nil && *r.BarberResponse != "" {
+		return config.ReviewModerationApproved
+	}
+	return ""
+}
+
+// CanAddResponse reports whether the barber may respond: there is no
+// response yet, or the last one was rejected or redacted
+func (r *Review) CanAddResponse() bool {
+	switch r.ResponseStatus() {
+	case "", config.ReviewModerationRejected, config.ReviewResponseRedacted:
+		return true
+	}
+	return false
+}
+
 // GetHelpfulnessRatio returns the ratio of helpful votes to total votes
 func (r *Review) GetHelpfulnessRatio() float64 {
 	if r.TotalVotes == 0 {
@@ -0,0 +1,20 @@
+// internal/models/review_image.go
+package models
+
+import "time"
+
+// ReviewImage is a photo attached to a review, with its thumbnail
+type ReviewImage struct {
+	ID           int       `json:"id" db:"id"`
+	ReviewID     int       `json:"review_id" db:"review_id"`
+	ImageKey     string    `json:"-" db:"image_key"`     // Storage key of the photo
+	ThumbnailKey string    `json:"-" db:"thumbnail_key"` // Storage key of the thumbnail
+	URL          string    `json:"url" db:"url"`
+	ThumbnailURL string    `json:"thumbnail_url" db:"thumbnail_url"`
+	ContentType  string    `json:"content_type" db:"content_type"`
+	SizeBytes    int       `json:"size_bytes" db:"size_bytes"`
+	Width        int       `json:"width" db:"width"`
+	Height       int       `json:"height" db:"height"`
+	UploadedBy   *int      `json:"uploaded_by,omitempty" db:"uploaded_by"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
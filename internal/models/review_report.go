@@ -0,0 +1,15 @@
+// internal/models/review_report.go
+package models
+
+import "time"
+
+// ReviewReport is a user's report of a published review
+type ReviewReport struct {
+	ID           int       `json:"id" db:"id"`
+	ReviewID     int       `json:"review_id" db:"review_id"`
+	ReporterID   *int      `json:"reporter_id" db:"reporter_id"` // Nil once the reporter's account is deleted
+	ReporterName *string   `json:"reporter_name,omitempty" db:"reporter_name"`
+	Reason       string    `json:"reason" db:"reason"` // spam, offensive, fake, personal_information, off_topic, other
+	Details      *string   `json:"details" db:"details"`
+	CreatedAt    time.Time `json:"created_at" db:"created_at"`
+}
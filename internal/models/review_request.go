@@ -0,0 +1,37 @@
+// internal/models/review_request.go
+package models
+
+import "time"
+
+// ReviewRequest asks the customer of a completed booking for a review. It
+// goes out once its send time passes, unless the booking has been reviewed
+// by then; the token in its link lets the customer write the review once
+// without logging in.
+type ReviewRequest struct {
+	ID         int        `json:"id" db:"id"`
+	Token      string     `json:"-" db:"token"` // Secret in the review link
+	BookingID  int        `json:"booking_id" db:"booking_id"`
+	CustomerID int        `json:"customer_id" db:"customer_id"`
+	SendAt     time.Time  `json:"send_at" db:"send_at"`
+	SentAt     *time.Time `json:"sent_at" db:"sent_at"`
+	SkippedAt  *time.Time `json:"skipped_at" db:"skipped_at"` // Already reviewed when due
+	ExpiresAt  time.Time  `json:"expires_at" db:"expires_at"`
+	UsedAt     *time.Time `json:"used_at" db:"used_at"`
+	ReviewID   *int       `json:"review_id" db:"review_id"` // The review written from the link
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
+
+// IsUsed reports whether a review has been written from the link
+func (r *ReviewRequest) IsUsed() bool {
+	return r.UsedAt != nil
+}
+
+// IsExpired reports whether the link no longer accepts a review
+func (r *ReviewRequest) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// IsOpen reports whether the link can still be used to write a review
+func (r *ReviewRequest) IsOpen(now time.Time) bool {
+	return r.SentAt != nil && !r.IsUsed() && !r.IsExpired(now)
+}
@@ -0,0 +1,46 @@
+// internal/models/role.go
+package models
+
+import (
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// Role is a named set of permissions. System roles (customer, barber,
+// admin) apply to every account of that user type and cannot be assigned.
+type Role struct {
+	Name        string      `json:"name" db:"name"`
+	Description *string     `json:"description" db:"description"`
+	Permissions StringArray `json:"permissions" db:"permissions"`
+	IsSystem    bool        `json:"is_system" db:"is_system"`
+	CreatedAt   time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// Grants reports whether the role grants perm
+func (r *Role) Grants(perm string) bool {
+	for _, p := range r.Permissions {
+		if p == perm || p == config.PermissionAll {
+			return true
+		}
+	}
+	return false
+}
+
+// UserRole assigns a role to a user
+type UserRole struct {
+	UserID    int       `json:"user_id" db:"user_id"`
+	Role      string    `json:"role" db:"role"`
+	GrantedBy *int      `json:"granted_by" db:"granted_by"`
+	GrantedAt time.Time `json:"granted_at" db:"granted_at"`
+}
+
+// UserAccess is what a user can do: their system role (user type), the
+// roles assigned to them and the resulting permissions
+type UserAccess struct {
+	UserID      int        `json:"user_id"`
+	UserType    string     `json:"user_type"`
+	Roles       []UserRole `json:"roles"`
+	Permissions []string   `json:"permissions"`
+}
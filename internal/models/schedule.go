@@ -0,0 +1,298 @@
+package models
+
+import (
+	"barber-booking-system/internal/config"
+	"fmt"
+	"time"
+)
+
+// BarberSchedule defines when a barber works: weekly periods (work and break)
+// in the schedule's timezone plus date-range exceptions
+type BarberSchedule struct {
+	BarberID  int       `json:"barber_id" db:"barber_id"`
+	Timezone  string    `json:"timezone" db:"timezone"` // IANA name, e.g. America/New_York
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+
+	// Relations (populated by the repository)
+	Periods    []SchedulePeriod    `json:"periods"`
+	Exceptions []ScheduleException `json:"exceptions"`
+}
+
+// SchedulePeriod is a weekly recurring work period or break
+type SchedulePeriod struct {
+	ID         int     `json:"id" db:"id"`
+	BarberID   int     `json:"barber_id" db:"barber_id"`
+	DayOfWeek  int     `json:"day_of_week" db:"day_of_week"` // 0=Sunday, 6=Saturday
+	StartTime  string  `json:"start_time" db:"start_time"`   // HH:MM wall-clock time
+	EndTime    string  `json:"end_time" db:"end_time"`
+	PeriodType string  `json:"period_type" db:"period_type"` // work, break
+	Label      *string `json:"label" db:"label"`
+}
+
+// ScheduleException overrides the weekly schedule for a date range
+type ScheduleException struct {
+	ID            int       `json:"id" db:"id"`
+	BarberID      int       `json:"barber_id" db:"barber_id"`
+	StartDate     time.Time `json:"start_date" db:"start_date"`
+	EndDate       time.Time `json:"end_date" db:"end_date"`             // Inclusive
+	ExceptionType string    `json:"exception_type" db:"exception_type"` // vacation, holiday, closed, custom_hours
+
+	// Only for custom_hours: the single work window for each day in the range
+	StartTime *string `json:"start_time" db:"start_time"`
+	EndTime   *string `json:"end_time" db:"end_time"`
+
+	Reason    *string   `json:"reason" db:"reason"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// ========================================================================
+// CLOCK TIME HELPERS
+// ========================================================================
+
+// ParseClockTime converts "HH:MM" or "HH:MM:SS" into minutes after midnight
+func ParseClockTime(value string) (int, error) {
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Hour()*60 + t.Minute(), nil
+		}
+	}
+	return 0, fmt.Errorf("time must be in HH:MM format, got %q", value)
+}
+
+// clockRange is a [start, end) window in minutes after midnight
+type clockRange struct {
+	start, end int
+}
+
+func parseClockRange(start, end string) (clockRange, error) {
+	s, err := ParseClockTime(start)
+	if err != nil {
+		return clockRange{}, err
+	}
+	e, err := ParseClockTime(end)
+	if err != nil {
+		return clockRange{}, err
+	}
+	if s >= e {
+		return clockRange{}, fmt.Errorf("start time must be before end time (%s-%s)", start, end)
+	}
+	return clockRange{start: s, end: e}, nil
+}
+
+// ========================================================================
+// VALIDATION
+// ========================================================================
+
+// Validate checks the period fields
+func (p *SchedulePeriod) Validate() error {
+	if p.DayOfWeek < 0 || p.DayOfWeek > 6 {
+		return fmt.Errorf("day_of_week must be between 0 (Sunday) and 6 (Saturday)")
+	}
+	if p.PeriodType != config.SchedulePeriodWork && p.PeriodType != config.SchedulePeriodBreak {
+		return fmt.Errorf("period_type must be %s or %s", config.SchedulePeriodWork, config.SchedulePeriodBreak)
+	}
+	_, err := parseClockRange(p.StartTime, p.EndTime)
+	return err
+}
+
+// Validate checks the exception fields
+func (e *ScheduleException) Validate() error {
+	switch e.ExceptionType {
+	case config.ScheduleExceptionVacation, config.ScheduleExceptionHoliday, config.ScheduleExceptionClosed:
+	case config.ScheduleExceptionCustomHours:
+		if e.StartTime == nil || e.EndTime == nil {
+			return fmt.Errorf("start_time and end_time are required for custom_hours exceptions")
+		}
+		if _, err := parseClockRange(*e.StartTime, *e.EndTime); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("exception_type must be one of: vacation, holiday, closed, custom_hours")
+	}
+	if e.EndDate.Before(e.StartDate) {
+		return fmt.Errorf("end_date must be on or after start_date")
+	}
+	return nil
+}
+
+// Location returns the schedule timezone, falling back to UTC
+func (s *BarberSchedule) Location() *time.Location {
+	if s.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// ========================================================================
+// AVAILABILITY
+// ========================================================================
+
+// ExceptionOn returns the exception covering the given local date, if any
+func (s *BarberSchedule) ExceptionOn(date time.Time) *ScheduleException {
+	day := date.Format("2006-01-02")
+	for i := range s.Exceptions {
+		e := &s.Exceptions[i]
+		if day >= e.StartDate.Format("2006-01-02") && day <= e.EndDate.Format("2006-01-02") {
+			return e
+		}
+	}
+	return nil
+}
+
+// CheckAvailability returns an error if [start, end) falls outside the
+// barber's working hours, overlaps a break, or lands on a day off
+func (s *BarberSchedule) CheckAvailability(start, end time.Time) error {
+	loc := s.Location()
+	localStart := start.In(loc)
+	localEnd := end.In(loc)
+
+	startDay := localStart.Format("2006-01-02")
+	// A booking ending exactly at midnight still belongs to the start day
+	endDay := localEnd.Add(-time.Nanosecond).Format("2006-01-02")
+	if startDay != endDay {
+		return fmt.Errorf("booking must start and end on the same day in the barber's timezone")
+	}
+
+	booking := clockRange{
+		start: localStart.Hour()*60 + localStart.Minute(),
+		end:   localEnd.Hour()*60 + localEnd.Minute(),
+	}
+	if booking.end == 0 {
+		booking.end = 24 * 60
+	}
+
+	weekday := int(localStart.Weekday())
+	var work []clockRange
+
+	if exception := s.ExceptionOn(localStart); exception != nil {
+		if exception.ExceptionType != config.ScheduleExceptionCustomHours {
+			return fmt.Errorf("booking cannot be made on %s: barber is unavailable (%s)", startDay, exception.ExceptionType)
+		}
+		window, err := parseClockRange(*exception.StartTime, *exception.EndTime)
+		if err != nil {
+			return err
+		}
+		work = append(work, window)
+	} else {
+		for _, p := range s.Periods {
+			if p.DayOfWeek != weekday || p.PeriodType != config.SchedulePeriodWork {
+				continue
+			}
+			window, err := parseClockRange(p.StartTime, p.EndTime)
+			if err != nil {
+				return err
+			}
+			work = append(work, window)
+		}
+	}
+
+	if len(work) == 0 {
+		return fmt.Errorf("booking cannot be made on %s: barber is not working that day", localStart.Weekday())
+	}
+
+	within := false
+	for _, w := range work {
+		if booking.start >= w.start && booking.end <= w.end {
+			within = true
+			break
+		}
+	}
+	if !within {
+		return fmt.Errorf("booking must be within the barber's working hours")
+	}
+
+	for _, p := range s.Periods {
+		if p.DayOfWeek != weekday || p.PeriodType != config.SchedulePeriodBreak {
+			continue
+		}
+		brk, err := parseClockRange(p.StartTime, p.EndTime)
+		if err != nil {
+			return err
+		}
+		if booking.start < brk.end && booking.end > brk.start {
+			label := "break"
+			if p.Label != nil && *p.Label != "" {
+				label = *p.Label
+			}
+			return fmt.Errorf("booking cannot overlap the barber's %s (%s-%s)", label, p.StartTime, p.EndTime)
+		}
+	}
+
+	return nil
+}
+
+// clockOn returns the instant minutes after midnight on the given local date
+func clockOn(date time.Time, minutes int, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, minutes, 0, 0, loc)
+}
+
+// WorkingWindows returns the bookable windows on a calendar date (year,
+// month and day are read from date as-is) in the schedule's timezone:
+// the day's work periods or custom hours, with weekly breaks removed.
+// Days off return no windows.
+func (s *BarberSchedule) WorkingWindows(date time.Time) []TimeRange {
+	loc := s.Location()
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, loc)
+	weekday := int(day.Weekday())
+
+	var windows []TimeRange
+	if exception := s.ExceptionOn(day); exception != nil {
+		if exception.ExceptionType != config.ScheduleExceptionCustomHours {
+			return nil
+		}
+		if r, err := parseClockRange(*exception.StartTime, *exception.EndTime); err == nil {
+			windows = append(windows, TimeRange{Start: clockOn(day, r.start, loc), End: clockOn(day, r.end, loc)})
+		}
+	} else {
+		for _, p := range s.Periods {
+			if p.DayOfWeek != weekday || p.PeriodType != config.SchedulePeriodWork {
+				continue
+			}
+			if r, err := parseClockRange(p.StartTime, p.EndTime); err == nil {
+				windows = append(windows, TimeRange{Start: clockOn(day, r.start, loc), End: clockOn(day, r.end, loc)})
+			}
+		}
+	}
+
+	var breaks []TimeRange
+	for _, p := range s.Periods {
+		if p.DayOfWeek != weekday || p.PeriodType != config.SchedulePeriodBreak {
+			continue
+		}
+		if r, err := parseClockRange(p.StartTime, p.EndTime); err == nil {
+			breaks = append(breaks, TimeRange{Start: clockOn(day, r.start, loc), End: clockOn(day, r.end, loc)})
+		}
+	}
+
+	return subtractRanges(windows, breaks)
+}
+
+// NextOpening returns when the barber is next working at or after t: t
+// itself during working hours, otherwise the start of the next working
+// window within days. It reports false when there is none.
+func (s *BarberSchedule) NextOpening(t time.Time, days int) (time.Time, bool) {
+	local := t.In(s.Location())
+	for i := 0; i <= days; i++ {
+		var next time.Time
+		for _, window := range s.WorkingWindows(local.AddDate(0, 0, i)) {
+			if !window.End.After(t) {
+				continue
+			}
+			if !window.Start.After(t) {
+				return t, true
+			}
+			if next.IsZero() || window.Start.Before(next) {
+				next = window.Start
+			}
+		}
+		if !next.IsZero() {
+			return next, true
+		}
+	}
+	return time.Time{}, false
+}
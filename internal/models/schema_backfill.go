@@ -0,0 +1,53 @@
+// internal/models/schema_backfill.go
+package models
+
+import (
+	"time"
+)
+
+// SchemaBackfill fills a new column or table in batches after an expand
+// migration. BatchSQL updates the rows whose key is in [$1, $2); keys below
+// NextKey are done.
+type SchemaBackfill struct {
+	ID               int        `json:"id" db:"id"`
+	Name             string     `json:"name" db:"name"`
+	MigrationVersion int64      `json:"migration_version" db:"migration_version"`
+	TableName        string     `json:"table_name" db:"table_name"`
+	KeyColumn        string     `json:"key_column" db:"key_column"`
+	BatchSQL         string     `json:"batch_sql" db:"batch_sql"`
+	Status           string     `json:"status" db:"status"`
+	NextKey          int64      `json:"next_key" db:"next_key"`
+	MaxKey           *int64     `json:"max_key" db:"max_key"` // Set when the backfill starts
+	RowsUpdated      int64      `json:"rows_updated" db:"rows_updated"`
+	LockedUntil      *time.Time `json:"locked_until,omitempty" db:"locked_until"`
+	LastError        *string    `json:"last_error,omitempty" db:"last_error"`
+	StartedAt        *time.Time `json:"started_at" db:"started_at"`
+	CompletedAt      *time.Time `json:"completed_at" db:"completed_at"`
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// NextBatch returns the key range of the next batch of size keys, and
+// whether the backfill has passed its max key
+func (b *SchemaBackfill) NextBatch(size int) (from, to int64, done bool) {
+	if b.MaxKey == nil || b.NextKey > *b.MaxKey {
+		return 0, 0, true
+	}
+	from = b.NextKey
+	to = from + int64(size)
+	if to > *b.MaxKey+1 {
+		to = *b.MaxKey + 1
+	}
+	return from, to, false
+}
+
+// Progress returns the share of keys done, from 0 to 1
+func (b *SchemaBackfill) Progress() float64 {
+	if b.MaxKey == nil || *b.MaxKey < 0 {
+		return 0
+	}
+	if b.NextKey > *b.MaxKey {
+		return 1
+	}
+	return float64(b.NextKey) / float64(*b.MaxKey+1)
+}
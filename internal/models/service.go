@@ -141,12 +141,16 @@ type Service struct {
 
 // BarberService represents the junction table - how a barber offers a specific service
 type BarberService struct {
-	ID          int     `json:"id" db:"id"`
-	BarberID    int     `json:"barber_id" db:"barber_id"`   // FK to barbers table
-	ServiceID   int     `json:"service_id" db:"service_id"` // FK to services table
-	ServiceName *string `json:"service_name,omitempty" db:"service_name"`
-	ServiceType *string `json:"service_type,omitempty" db:"service_type"`
-	CategoryID  *int    `json:"category_id,omitempty" db:"category_id"`
+	ID           int     `json:"id" db:"id"`
+	BarberID     int     `json:"barber_id" db:"barber_id"`   // FK to barbers table
+	ServiceID    int     `json:"service_id" db:"service_id"` // FK to services table
+	ServiceName  *string `json:"service_name,omitempty" db:"service_name"`
+	ServiceType  *string `json:"service_type,omitempty" db:"service_type"`
+	CategoryID   *int    `json:"category_id,omitempty" db:"category_id"`
+	AllowsAddOns bool    `json:"allows_add_ons" db:"allows_add_ons"` // From the service; add-ons can be booked with it
+
+	// From the service, unless the barber's RequiresConsultation overrides it
+	ServiceRequiresConsultation *bool `json:"-" db:"service_requires_consultation"`
 
 	// Barber's customization of the service
 	CustomName        *string `json:"custom_name" db:"custom_name"`               // Override service name
@@ -164,6 +168,15 @@ type BarberService struct {
 	EstimatedDurationMax *int `json:"estimated_duration_max" db:"estimated_duration_max"` // 45 minutes
 	BufferTimeMinutes    int  `json:"buffer_time_minutes" db:"buffer_time_minutes"`       // Break between services
 
+	// Active/passive split of the duration (nil = fully active)
+	DurationSegments DurationSegments `json:"duration_segments,omitempty" db:"duration_segments"`
+
+	// Deposit held when booking (nil = no deposit) and the part of it kept
+	// when the customer does not show up
+	DepositType  *string  `json:"deposit_type" db:"deposit_type"`   // percentage, fixed
+	DepositValue *float64 `json:"deposit_value" db:"deposit_value"` // Percent of the price, or an amount
+	NoShowFee    *float64 `json:"no_show_fee" db:"no_show_fee"`     // nil = the whole deposit
+
 	// Barber's booking rules
 	AdvanceNoticeHours    int         `json:"advance_notice_hours" db:"advance_notice_hours"`         // 2 hours notice
 	MaxAdvanceBookingDays *int        `json:"max_advance_booking_days" db:"max_advance_booking_days"` // 30 days max
@@ -362,6 +375,15 @@ func (bs *BarberService) IsAvailableOn(day string) bool {
 	return false
 }
 
+// NeedsConsultation returns true if the barber's service requires a
+// consultation: the barber's own setting, else the catalog service's
+func (bs *BarberService) NeedsConsultation() bool {
+	if bs.RequiresConsultation != nil {
+		return *bs.RequiresConsultation
+	}
+	return bs.ServiceRequiresConsultation != nil && *bs.ServiceRequiresConsultation
+}
+
 func (bs *BarberService) RequiresAdvanceBooking(requestedTime time.Time) bool {
 	requiredNotice := time.Duration(bs.AdvanceNoticeHours) * time.Hour
 	return time.Now().Add(requiredNotice).After(requestedTime)
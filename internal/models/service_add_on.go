@@ -0,0 +1,28 @@
+// internal/models/service_add_on.go
+package models
+
+import "time"
+
+// ========================================================================
+// SERVICE ADD-ONS - Optional extras booked with a service
+// ========================================================================
+//
+// A barber can offer add-ons on services that allow them (e.g. a hot towel
+// with a shave, a scalp massage with a haircut). Add-ons chosen at booking
+// time are performed right after their service and add their own duration
+// and price to the appointment.
+// ========================================================================
+
+// ServiceAddOn is an optional extra offered on one barber service
+type ServiceAddOn struct {
+	ID              int       `json:"id" db:"id"`
+	BarberServiceID int       `json:"barber_service_id" db:"barber_service_id"`
+	Name            string    `json:"name" db:"name"`
+	Description     *string   `json:"description,omitempty" db:"description"`
+	Price           float64   `json:"price" db:"price"`
+	DurationMinutes int       `json:"duration_minutes" db:"duration_minutes"` // 0 = no extra time
+	IsActive        bool      `json:"is_active" db:"is_active"`
+	DisplayOrder    int       `json:"display_order" db:"display_order"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
@@ -0,0 +1,139 @@
+// internal/models/shop.go
+package models
+
+import (
+	"barber-booking-system/internal/config"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ========================================================================
+// SHOPS - Businesses several barbers work at
+// ========================================================================
+//
+// A barber belongs to at most one shop. The shop's opening hours are the
+// working hours of its barbers who have no schedule of their own, and its
+// tax rules apply to their bookings ahead of regional ones. Shop admins
+// manage the shop's settings, barbers and tax rules and see every booking
+// made with its barbers; the owner also manages the admins.
+// ========================================================================
+
+// Shop admin roles
+const (
+	ShopRoleOwner = "owner"
+	ShopRoleAdmin = "admin"
+)
+
+// Shop is a business several barbers work at
+type Shop struct {
+	ID          int     `json:"id" db:"id"`
+	UUID        string  `json:"uuid" db:"uuid"`
+	Name        string  `json:"name" db:"name"`
+	Description *string `json:"description" db:"description"`
+
+	// Location and contact
+	Address      string   `json:"address" db:"address"`
+	AddressLine2 *string  `json:"address_line_2" db:"address_line_2"`
+	City         string   `json:"city" db:"city"`
+	State        string   `json:"state" db:"state"`
+	Country      string   `json:"country" db:"country"`
+	PostalCode   string   `json:"postal_code" db:"postal_code"`
+	Latitude     *float64 `json:"latitude" db:"latitude"`
+	Longitude    *float64 `json:"longitude" db:"longitude"`
+	Phone        *string  `json:"phone" db:"phone"`
+	Email        *string  `json:"email" db:"email"`
+	TaxID        *string  `json:"tax_id" db:"tax_id"`
+
+	// Settings
+	Timezone     string    `json:"timezone" db:"timezone"` // IANA name
+	OpeningHours ShopHours `json:"opening_hours" db:"opening_hours"`
+
+	CreatedBy *int      `json:"created_by,omitempty" db:"created_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+
+	// BarberCount is set by listings
+	BarberCount int `json:"barber_count" db:"barber_count"`
+}
+
+// ShopAdmin is a user who manages a shop
+type ShopAdmin struct {
+	ShopID    int       `json:"shop_id" db:"shop_id"`
+	UserID    int       `json:"user_id" db:"user_id"`
+	Role      string    `json:"role" db:"role"` // owner, admin
+	UserName  *string   `json:"user_name" db:"user_name"`
+	UserEmail *string   `json:"user_email" db:"user_email"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// ShopHoursPeriod is one weekly opening window
+type ShopHoursPeriod struct {
+	DayOfWeek int    `json:"day_of_week" example:"1"` // 0=Sunday, 6=Saturday
+	StartTime string `json:"start_time" example:"09:00"`
+	EndTime   string `json:"end_time" example:"18:00"`
+}
+
+// ShopHours are a shop's weekly opening hours, stored as JSONB
+type ShopHours []ShopHoursPeriod
+
+func (h ShopHours) Value() (driver.Value, error) {
+	if h == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(h)
+}
+
+func (h *ShopHours) Scan(value interface{}) error {
+	if value == nil {
+		*h = nil
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(bytes, h)
+}
+
+// Validate checks every opening window
+func (h ShopHours) Validate() error {
+	for _, p := range h {
+		period := SchedulePeriod{DayOfWeek: p.DayOfWeek, StartTime: p.StartTime, EndTime: p.EndTime, PeriodType: config.SchedulePeriodWork}
+		if err := period.Validate(); err != nil {
+			return fmt.Errorf("opening_hours: %w", err)
+		}
+	}
+	return nil
+}
+
+// Schedule returns the opening hours as the schedule of a barber at the
+// shop, or nil when the shop has no opening hours
+func (s *Shop) Schedule(barberID int) *BarberSchedule {
+	if len(s.OpeningHours) == 0 {
+		return nil
+	}
+
+	schedule := &BarberSchedule{
+		BarberID:   barberID,
+		Timezone:   s.Timezone,
+		CreatedAt:  s.CreatedAt,
+		UpdatedAt:  s.UpdatedAt,
+		Periods:    make([]SchedulePeriod, len(s.OpeningHours)),
+		Exceptions: []ScheduleException{},
+	}
+	for i, p := range s.OpeningHours {
+		schedule.Periods[i] = SchedulePeriod{
+			BarberID:   barberID,
+			DayOfWeek:  p.DayOfWeek,
+			StartTime:  p.StartTime,
+			EndTime:    p.EndTime,
+			PeriodType: config.SchedulePeriodWork,
+		}
+	}
+	return schedule
+}
@@ -0,0 +1,93 @@
+// internal/models/shop_offboarding.go
+package models
+
+import (
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// SHOP OFFBOARDING - Export and deletion of a shop leaving the platform
+// ========================================================================
+//
+// A shop's owner can export its bookings, clients, reviews and financial
+// events at any time. When the shop leaves, its data is kept for the
+// retention period so it can still be exported, then deleted: the personal
+// data of its customers is erased from its bookings, its client list,
+// reviews and survey answers are deleted, its barbers are deactivated and
+// the shop removed. The anonymised bookings and the financial ledger stay,
+// as the platform's accounts need them.
+// ========================================================================
+
+// ShopOffboarding is a shop's request to leave the platform
+type ShopOffboarding struct {
+	ID          int        `json:"id" db:"id"`
+	ShopID      int        `json:"shop_id" db:"shop_id"`
+	ShopName    string     `json:"shop_name" db:"shop_name"`
+	RequestedBy *int       `json:"requested_by" db:"requested_by"`
+	Reason      *string    `json:"reason" db:"reason"`
+	Status      string     `json:"status" db:"status"`             // scheduled, cancelled, completed
+	DeleteAfter time.Time  `json:"delete_after" db:"delete_after"` // The data is deleted at the first run after this
+	CompletedAt *time.Time `json:"completed_at" db:"completed_at"`
+	LastError   *string    `json:"last_error" db:"last_error"` // Why the last deletion attempt was put off
+	Summary     JSONMap    `json:"summary" db:"summary"`       // Rows deleted or anonymised, by table
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// CanCancel reports whether the shop can still change its mind at now
+func (o *ShopOffboarding) CanCancel(now time.Time) bool {
+	return o.Status == config.ShopOffboardingStatusScheduled && now.Before(o.DeleteAfter)
+}
+
+// ShopExportBooking is a booking row of a shop export, live or archived.
+// Customer contact details are left out; see ConsentedContact.
+type ShopExportBooking struct {
+	ID                 int       `db:"id"`
+	BookingNumber      string    `db:"booking_number"`
+	BarberID           int       `db:"barber_id"`
+	CustomerID         *int      `db:"customer_id"`
+	CustomerName       *string   `db:"customer_name"`
+	ServiceName        string    `db:"service_name"`
+	Status             string    `db:"status"`
+	ScheduledStartTime time.Time `db:"scheduled_start_time"`
+	ScheduledEndTime   time.Time `db:"scheduled_end_time"`
+	ServicePrice       float64   `db:"service_price"`
+	DiscountAmount     float64   `db:"discount_amount"`
+	TaxAmount          float64   `db:"tax_amount"`
+	TipAmount          float64   `db:"tip_amount"`
+	TotalPrice         float64   `db:"total_price"`
+	Currency           string    `db:"currency"`
+	PaymentStatus      string    `db:"payment_status"`
+	BookingSource      string    `db:"booking_source"`
+	CreatedAt          time.Time `db:"created_at"`
+	Archived           bool      `db:"archived"`
+}
+
+// ShopExportReview is a review row of a shop export
+type ShopExportReview struct {
+	ID               int        `db:"id"`
+	BookingID        int        `db:"booking_id"`
+	BarberID         int        `db:"barber_id"`
+	OverallRating    int        `db:"overall_rating"`
+	Title            *string    `db:"title"`
+	Comment          *string    `db:"comment"`
+	ModerationStatus string     `db:"moderation_status"`
+	IsPublished      bool       `db:"is_published"`
+	BarberResponse   *string    `db:"barber_response"`
+	BarberResponseAt *time.Time `db:"barber_response_at"`
+	CreatedAt        time.Time  `db:"created_at"`
+}
+
+// ConsentedContact returns the client's email and phone for an export,
+// each only if the client agreed to be contacted on it
+func (c *BarberClient) ConsentedContact() (email, phone *string) {
+	if c.EmailConsent {
+		email = c.Email
+	}
+	if c.SMSConsent {
+		phone = c.Phone
+	}
+	return email, phone
+}
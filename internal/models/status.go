@@ -0,0 +1,54 @@
+// internal/models/status.go
+package models
+
+import "time"
+
+// ========================================================================
+// STATUS PAGE - Platform component health and incidents
+// ========================================================================
+
+// ComponentStatus is a component's latest health check
+type ComponentStatus struct {
+	Component string    `json:"component" db:"component"`
+	Status    string    `json:"status" db:"status"` // operational, degraded, major_outage
+	Detail    *string   `json:"detail,omitempty" db:"detail"`
+	CheckedAt time.Time `json:"checked_at" db:"checked_at"`
+	ChangedAt time.Time `json:"changed_at" db:"changed_at"` // When the status last changed
+}
+
+// StatusIncident is a period when a component was not fully operational
+type StatusIncident struct {
+	ID          int        `json:"id" db:"id"`
+	Component   string     `json:"component" db:"component"`
+	Impact      string     `json:"impact" db:"impact"` // degraded, major_outage
+	Title       string     `json:"title" db:"title"`
+	Message     *string    `json:"message,omitempty" db:"message"`
+	IsAutomatic bool       `json:"is_automatic" db:"is_automatic"` // Opened by a health check rather than staff
+	CreatedBy   *int       `json:"-" db:"created_by"`
+	StartedAt   time.Time  `json:"started_at" db:"started_at"`
+	ResolvedAt  *time.Time `json:"resolved_at,omitempty" db:"resolved_at"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// IsResolved reports whether the incident is over
+func (i *StatusIncident) IsResolved() bool {
+	return i.ResolvedAt != nil
+}
+
+// Overlap returns how much of [from, to) the incident covers, treating an
+// unresolved incident as lasting until to
+func (i *StatusIncident) Overlap(from, to time.Time) time.Duration {
+	start := i.StartedAt
+	if start.Before(from) {
+		start = from
+	}
+	end := to
+	if i.ResolvedAt != nil && i.ResolvedAt.Before(to) {
+		end = *i.ResolvedAt
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start)
+}
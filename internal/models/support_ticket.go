@@ -0,0 +1,168 @@
+// internal/models/support_ticket.go
+package models
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// SUPPORT TICKETS - Help requests from customers and barbers
+// ========================================================================
+//
+// A ticket's priority sets two SLA targets counted from when it was
+// opened: the first reply from support and the resolution. Time spent
+// waiting on the requester does not count towards resolution, so the
+// resolution due date moves out by however long the ticket was paused.
+// ========================================================================
+
+// supportTransitions lists the statuses each status can move to
+var supportTransitions = map[string][]string{
+	config.SupportStatusOpen: {
+		config.SupportStatusInProgress, config.SupportStatusWaitingOnCustomer,
+		config.SupportStatusResolved, config.SupportStatusClosed,
+	},
+	config.SupportStatusInProgress: {
+		config.SupportStatusWaitingOnCustomer, config.SupportStatusResolved, config.SupportStatusClosed,
+	},
+	config.SupportStatusWaitingOnCustomer: {
+		config.SupportStatusInProgress, config.SupportStatusResolved, config.SupportStatusClosed,
+	},
+	config.SupportStatusResolved: {config.SupportStatusOpen, config.SupportStatusClosed},
+	config.SupportStatusClosed:   {},
+}
+
+// SupportTicket is a help request and its SLA timers
+type SupportTicket struct {
+	ID                      int        `json:"id" db:"id"`
+	RequesterID             int        `json:"requester_id" db:"requester_id"`
+	BookingID               *int       `json:"booking_id,omitempty" db:"booking_id"`
+	PaymentReference        *string    `json:"payment_reference,omitempty" db:"payment_reference"`
+	Category                string     `json:"category" db:"category"` // booking, payment, account, other
+	Priority                string     `json:"priority" db:"priority"` // low, normal, high, urgent
+	Status                  string     `json:"status" db:"status"`     // open, in_progress, waiting_on_customer, resolved, closed
+	Subject                 string     `json:"subject" db:"subject"`
+	AssignedTo              *int       `json:"assigned_to,omitempty" db:"assigned_to"`
+	FirstResponseDueAt      time.Time  `json:"first_response_due_at" db:"first_response_due_at"`
+	ResolutionDueAt         time.Time  `json:"resolution_due_at" db:"resolution_due_at"`
+	FirstRespondedAt        *time.Time `json:"first_responded_at,omitempty" db:"first_responded_at"`
+	SLAPausedAt             *time.Time `json:"sla_paused_at,omitempty" db:"sla_paused_at"`
+	SLAPausedSeconds        int        `json:"-" db:"sla_paused_seconds"`
+	FirstResponseBreachedAt *time.Time `json:"first_response_breached_at,omitempty" db:"first_response_breached_at"`
+	ResolutionBreachedAt    *time.Time `json:"resolution_breached_at,omitempty" db:"resolution_breached_at"`
+	ResolvedAt              *time.Time `json:"resolved_at,omitempty" db:"resolved_at"`
+	ClosedAt                *time.Time `json:"closed_at,omitempty" db:"closed_at"`
+	CreatedAt               time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt               time.Time  `json:"updated_at" db:"updated_at"`
+
+	// Loaded with the ticket when viewing it
+	Messages []SupportTicketMessage `json:"messages,omitempty" db:"-"`
+}
+
+// SupportTicketMessage is one message in a ticket's conversation
+type SupportTicketMessage struct {
+	ID         int       `json:"id" db:"id"`
+	TicketID   int       `json:"ticket_id" db:"ticket_id"`
+	AuthorID   *int      `json:"author_id,omitempty" db:"author_id"`
+	IsStaff    bool      `json:"is_staff" db:"is_staff"`
+	IsInternal bool      `json:"is_internal" db:"is_internal"` // Staff-only note
+	Body       string    `json:"body" db:"body"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+}
+
+// IsActive reports whether the ticket still needs work from support
+func (t *SupportTicket) IsActive() bool {
+	return t.Status != config.SupportStatusResolved && t.Status != config.SupportStatusClosed
+}
+
+// ApplySLA sets the ticket's due dates from its priority, counting from
+// when it was opened and adding back the time it was paused
+func (t *SupportTicket) ApplySLA() {
+	paused := time.Duration(t.SLAPausedSeconds) * time.Second
+	t.FirstResponseDueAt = t.CreatedAt.Add(config.SupportFirstResponseTargets[t.Priority])
+	t.ResolutionDueAt = t.CreatedAt.Add(config.SupportResolutionTargets[t.Priority] + paused)
+}
+
+// SetPriority changes the ticket's priority and its due dates with it
+func (t *SupportTicket) SetPriority(priority string) error {
+	if !slices.Contains(config.ValidSupportPriorities, priority) {
+		return fmt.Errorf("priority must be one of: low, normal, high, urgent")
+	}
+	t.Priority = priority
+	t.ApplySLA()
+	return nil
+}
+
+// SetStatus moves the ticket to a new status at now. Waiting on the
+// customer pauses the resolution timer and leaving it resumes the timer;
+// reopening a resolved ticket clears its resolution time.
+func (t *SupportTicket) SetStatus(status string, now time.Time) error {
+	if status == t.Status {
+		return nil
+	}
+	allowed, known := supportTransitions[t.Status]
+	if !known || !slices.Contains(allowed, status) {
+		return fmt.Errorf("ticket cannot move from %s to %s", t.Status, status)
+	}
+
+	if t.SLAPausedAt != nil {
+		t.SLAPausedSeconds += int(now.Sub(*t.SLAPausedAt).Seconds())
+		t.SLAPausedAt = nil
+		t.ApplySLA()
+	}
+	if status == config.SupportStatusWaitingOnCustomer {
+		t.SLAPausedAt = &now
+	}
+
+	switch status {
+	case config.SupportStatusResolved:
+		t.ResolvedAt = &now
+	case config.SupportStatusClosed:
+		t.ClosedAt = &now
+		if t.ResolvedAt == nil {
+			t.ResolvedAt = &now
+		}
+	case config.SupportStatusOpen:
+		t.ResolvedAt = nil
+	}
+	t.Status = status
+	return nil
+}
+
+// RecordFirstResponse notes support's first reply at now; later replies
+// leave it unchanged
+func (t *SupportTicket) RecordFirstResponse(now time.Time) {
+	if t.FirstRespondedAt == nil {
+		t.FirstRespondedAt = &now
+	}
+}
+
+// FirstResponseOverdue reports whether support has not replied by the
+// first response due date
+func (t *SupportTicket) FirstResponseOverdue(now time.Time) bool {
+	if t.FirstRespondedAt != nil {
+		return t.FirstRespondedAt.After(t.FirstResponseDueAt)
+	}
+	return t.IsActive() && now.After(t.FirstResponseDueAt)
+}
+
+// ResolutionOverdue reports whether the ticket missed its resolution due
+// date. A paused ticket is not overdue while it waits on the requester.
+func (t *SupportTicket) ResolutionOverdue(now time.Time) bool {
+	if t.ResolvedAt != nil {
+		return t.ResolvedAt.After(t.ResolutionDueAt)
+	}
+	return t.SLAPausedAt == nil && now.After(t.ResolutionDueAt)
+}
+
+// DefaultSupportPriority is the priority a new ticket in category gets:
+// payment problems are high, everything else normal
+func DefaultSupportPriority(category string) string {
+	if category == config.SupportCategoryPayment {
+		return config.SupportPriorityHigh
+	}
+	return config.SupportPriorityNormal
+}
@@ -0,0 +1,44 @@
+// internal/models/suppression.go
+package models
+
+import (
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// Suppression is an email address or phone number that messages must not
+// be sent to
+type Suppression struct {
+	ID        int       `json:"id" db:"id"`
+	Channel   string    `json:"channel" db:"channel"` // email or sms
+	Address   string    `json:"address" db:"address"` // Normalized, see NormalizeAddress
+	Reason    string    `json:"reason" db:"reason"`   // hard_bounce, spam_complaint, opt_out or manual
+	Source    string    `json:"source" db:"source"`   // Provider that reported it, or admin
+	Detail    *string   `json:"detail,omitempty" db:"detail"`
+	CreatedBy *int      `json:"created_by,omitempty" db:"created_by"` // Admin who added it
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// NormalizeAddress returns the form addresses are stored and matched in:
+// lower-case email addresses, and phone numbers reduced to their digits
+// (keeping a leading +) so "+1 (555) 010-9999" matches "+15550109999"
+func NormalizeAddress(channel, address string) string {
+	address = strings.TrimSpace(address)
+	if channel != config.NotificationChannelSMS {
+		return strings.ToLower(address)
+	}
+
+	var b strings.Builder
+	for i, r := range address {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == '+' && i == 0:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
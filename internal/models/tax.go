@@ -0,0 +1,184 @@
+// internal/models/tax.go
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// ========================================================================
+// TAX RULES - Configurable taxes on bookings
+// ========================================================================
+//
+// Tax rules apply to a barber, to a shop's barbers, to a region (country,
+// optionally narrowed to a state) or everywhere. Only the most specific
+// rules that match a barber apply: the barber's own, else their shop's,
+// else their state's, else their country's, else the global ones; several rules at that level stack (e.g. state and city
+// sales tax). An inclusive rule's tax is already in the price; an exclusive
+// rule's tax is added to it. The taxes charged are kept on the booking as
+// line items for reporting.
+// ========================================================================
+
+// Tax rule scopes, most specific first
+const (
+	TaxScopeBarber  = "barber"
+	TaxScopeShop    = "shop"
+	TaxScopeState   = "state"
+	TaxScopeCountry = "country"
+	TaxScopeGlobal  = "global"
+)
+
+// TaxRule is a tax charged on bookings in its scope
+type TaxRule struct {
+	ID          int       `json:"id" db:"id"`
+	Name        string    `json:"name" db:"name"`
+	RatePercent float64   `json:"rate_percent" db:"rate_percent"`
+	Inclusive   bool      `json:"inclusive" db:"inclusive"` // Prices already include this tax
+	BarberID    *int      `json:"barber_id,omitempty" db:"barber_id"`
+	ShopID      *int      `json:"shop_id,omitempty" db:"shop_id"`
+	Country     *string   `json:"country,omitempty" db:"country"`
+	State       *string   `json:"state,omitempty" db:"state"`
+	IsActive    bool      `json:"is_active" db:"is_active"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Scope returns how specific the rule is
+func (r *TaxRule) Scope() string {
+	switch {
+	case r.BarberID != nil:
+		return TaxScopeBarber
+	case r.ShopID != nil:
+		return TaxScopeShop
+	case r.State != nil:
+		return TaxScopeState
+	case r.Country != nil:
+		return TaxScopeCountry
+	default:
+		return TaxScopeGlobal
+	}
+}
+
+// Matches reports whether the rule covers a barber
+func (r *TaxRule) Matches(barber *Barber) bool {
+	if r.BarberID != nil {
+		return *r.BarberID == barber.ID
+	}
+	if r.ShopID != nil {
+		return barber.ShopID != nil && *r.ShopID == *barber.ShopID
+	}
+	if r.Country != nil && !strings.EqualFold(*r.Country, barber.Country) {
+		return false
+	}
+	return r.State == nil || strings.EqualFold(*r.State, barber.State)
+}
+
+// ApplicableTaxRules returns the active rules that apply to a barber: those
+// at the most specific scope with any match
+func ApplicableTaxRules(rules []TaxRule, barber *Barber) []TaxRule {
+	for _, scope := range []string{TaxScopeBarber, TaxScopeShop, TaxScopeState, TaxScopeCountry, TaxScopeGlobal} {
+		var applicable []TaxRule
+		for _, rule := range rules {
+			if rule.IsActive && rule.Scope() == scope && rule.Matches(barber) {
+				applicable = append(applicable, rule)
+			}
+		}
+		if len(applicable) > 0 {
+			return applicable
+		}
+	}
+	return nil
+}
+
+// TaxLine is one tax charged on a price
+type TaxLine struct {
+	TaxRuleID     *int    `json:"tax_rule_id,omitempty" db:"tax_rule_id"`
+	Name          string  `json:"name" db:"name"`
+	RatePercent   float64 `json:"rate_percent" db:"rate_percent"`
+	Inclusive     bool    `json:"inclusive" db:"inclusive"`
+	TaxableAmount float64 `json:"taxable_amount" db:"taxable_amount"` // Price before any tax
+	Amount        float64 `json:"amount" db:"amount"`
+}
+
+// BookingTaxLine is a tax line item kept on a booking
+type BookingTaxLine struct {
+	ID        int `json:"id" db:"id"`
+	BookingID int `json:"booking_id" db:"booking_id"`
+	TaxLine
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// ApplyTaxes works out the taxes on a price. Inclusive taxes are taken out
+// of the price first, so every tax is charged on the same pre-tax amount.
+// Returns the tax lines and the tax added on top of the price.
+func ApplyTaxes(price float64, rules []TaxRule) ([]TaxLine, float64) {
+	if len(rules) == 0 {
+		return nil, 0
+	}
+
+	inclusiveRate := 0.0
+	for _, rule := range rules {
+		if rule.Inclusive {
+			inclusiveRate += rule.RatePercent / 100
+		}
+	}
+	taxable := price / (1 + inclusiveRate)
+
+	lines := make([]TaxLine, 0, len(rules))
+	added := 0.0
+	for _, rule := range rules {
+		ruleID := rule.ID
+		line := TaxLine{
+			TaxRuleID:     &ruleID,
+			Name:          rule.Name,
+			RatePercent:   rule.RatePercent,
+			Inclusive:     rule.Inclusive,
+			TaxableAmount: roundCents(taxable),
+			Amount:        roundCents(taxable * rule.RatePercent / 100),
+		}
+		if !line.Inclusive {
+			added += line.Amount
+		}
+		lines = append(lines, line)
+	}
+	return lines, roundCents(added)
+}
+
+// TotalTax sums the tax lines, inclusive and exclusive
+func TotalTax(lines []TaxLine) float64 {
+	total := 0.0
+	for _, line := range lines {
+		total += line.Amount
+	}
+	return roundCents(total)
+}
+
+// TaxReportLine totals one tax charged on completed bookings
+type TaxReportLine struct {
+	Name          string  `json:"name" db:"name"`
+	RatePercent   float64 `json:"rate_percent" db:"rate_percent"`
+	Inclusive     bool    `json:"inclusive" db:"inclusive"`
+	Bookings      int     `json:"bookings" db:"bookings"`
+	TaxableAmount float64 `json:"taxable_amount" db:"taxable_amount"`
+	Amount        float64 `json:"amount" db:"amount"`
+}
+
+// TaxReport totals the taxes charged on completed bookings over a date
+// range (UTC days, inclusive)
+type TaxReport struct {
+	BarberID *int            `json:"barber_id,omitempty"`
+	From     time.Time       `json:"from"`
+	To       time.Time       `json:"to"`
+	Total    float64         `json:"total"`
+	Lines    []TaxReportLine `json:"lines"`
+}
+
+// NewTaxReport totals the report lines
+func NewTaxReport(barberID *int, from, to time.Time, lines []TaxReportLine) *TaxReport {
+	report := &TaxReport{BarberID: barberID, From: from, To: to, Lines: lines}
+	for _, line := range lines {
+		report.Total += line.Amount
+	}
+	report.Total = roundCents(report.Total)
+	return report
+}
@@ -0,0 +1,57 @@
+// internal/models/time_off.go
+package models
+
+import (
+	"barber-booking-system/internal/config"
+	"fmt"
+	"time"
+)
+
+// TimeOff is a block of time a barber is unavailable. Bookings and listed
+// slots may not overlap it.
+type TimeOff struct {
+	ID        int       `json:"id" db:"id"`
+	BarberID  int       `json:"barber_id" db:"barber_id"`
+	StartAt   time.Time `json:"start_at" db:"start_at"`
+	EndAt     time.Time `json:"end_at" db:"end_at"`   // Exclusive
+	AllDay    bool      `json:"all_day" db:"all_day"` // Created from whole dates
+	Reason    *string   `json:"reason" db:"reason"`
+	CreatedBy *int      `json:"created_by,omitempty" db:"created_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// TimeOffDays returns the time off covering whole local dates, from the
+// start of startDate to the end of endDate (inclusive) in loc. Year, month
+// and day are read from the dates as-is.
+func TimeOffDays(startDate, endDate time.Time, loc *time.Location) TimeRange {
+	return TimeRange{
+		Start: time.Date(startDate.Year(), startDate.Month(), startDate.Day(), 0, 0, 0, 0, loc),
+		End:   time.Date(endDate.Year(), endDate.Month(), endDate.Day()+1, 0, 0, 0, 0, loc),
+	}
+}
+
+// Range returns the blocked period
+func (t *TimeOff) Range() TimeRange {
+	return TimeRange{Start: t.StartAt, End: t.EndAt}
+}
+
+// Validate checks the blocked period
+func (t *TimeOff) Validate() error {
+	if !t.EndAt.After(t.StartAt) {
+		return fmt.Errorf("time off must end after it starts")
+	}
+	if t.EndAt.Sub(t.StartAt) > config.MaxTimeOffDays*24*time.Hour {
+		return fmt.Errorf("time off cannot be longer than %d days", config.MaxTimeOffDays)
+	}
+	return nil
+}
+
+// OverlappingTimeOff returns the first time off overlapping r, if any
+func OverlappingTimeOff(timeOff []TimeOff, r TimeRange) *TimeOff {
+	for i := range timeOff {
+		if timeOff[i].Range().Overlaps(r) {
+			return &timeOff[i]
+		}
+	}
+	return nil
+}
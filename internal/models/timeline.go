@@ -0,0 +1,196 @@
+package models
+
+import (
+	"barber-booking-system/internal/config"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TimelineEntry is one row of a customer's denormalized activity timeline
+// (the "my activity" screen). Entries are a projection of booking history,
+// reviews and payments and can be rebuilt from them at any time.
+type TimelineEntry struct {
+	ID         int64     `json:"id" db:"id"`
+	CustomerID int       `json:"customer_id" db:"customer_id"`
+	EventType  string    `json:"event_type" db:"event_type"` // See config.TimelineEvent*
+	Source     string    `json:"source" db:"source"`         // booking_history, review, payment
+	SourceID   int       `json:"source_id" db:"source_id"`
+	BookingID  *int      `json:"booking_id" db:"booking_id"`
+	Title      string    `json:"title" db:"title"`
+	Details    JSONMap   `json:"details" db:"details"`
+	OccurredAt time.Time `json:"occurred_at" db:"occurred_at"`
+}
+
+// TimelineState records when a customer's timeline was last rebuilt and the
+// version of the source events it was built from
+type TimelineState struct {
+	CustomerID int        `json:"customer_id" db:"customer_id"`
+	RebuiltAt  time.Time  `json:"rebuilt_at" db:"rebuilt_at"`
+	Watermark  *time.Time `json:"watermark" db:"watermark"` // Newest source event
+	EventCount int        `json:"event_count" db:"event_count"`
+}
+
+// TimelineVersion identifies the current state of a customer's source events;
+// the projection is stale when it no longer matches the stored state
+type TimelineVersion struct {
+	Watermark  *time.Time `db:"watermark"`
+	EventCount int        `db:"event_count"`
+}
+
+// IsCurrent reports whether the state was built from this version
+func (s *TimelineState) IsCurrent(v TimelineVersion) bool {
+	if s.EventCount != v.EventCount {
+		return false
+	}
+	if s.Watermark == nil || v.Watermark == nil {
+		return s.Watermark == nil && v.Watermark == nil
+	}
+	return s.Watermark.Equal(*v.Watermark)
+}
+
+// TimelineEvent is a raw source event read for projection
+type TimelineEvent struct {
+	Source     string    `db:"source"`
+	SourceID   int       `db:"source_id"`
+	BookingID  int       `db:"booking_id"`
+	Kind       string    `db:"kind"` // booking_history change_type, "review" or "paid"
+	OldValues  JSONMap   `db:"old_values"`
+	NewValues  JSONMap   `db:"new_values"`
+	OccurredAt time.Time `db:"occurred_at"`
+
+	// Booking context
+	BookingNumber string    `db:"booking_number"`
+	ServiceName   string    `db:"service_name"`
+	BarberName    *string   `db:"barber_name"`
+	ScheduledAt   time.Time `db:"scheduled_start_time"`
+	TotalPrice    float64   `db:"total_price"`
+	Currency      string    `db:"currency"`
+
+	// Review context
+	Rating *int `db:"rating"`
+}
+
+// ========================================================================
+// PROJECTION
+// ========================================================================
+
+// ProjectTimeline turns a customer's source events into timeline entries,
+// oldest first. Events with no customer-facing meaning (internal note edits,
+// unknown change types) are dropped.
+func ProjectTimeline(customerID int, events []TimelineEvent) []TimelineEntry {
+	// Bookings whose payment already appears as a history event
+	paidInHistory := make(map[int]bool)
+	for _, ev := range events {
+		if ev.Kind == "payment_status_changed" && stringValue(ev.NewValues, "payment_status") == config.PaymentStatusPaid {
+			paidInHistory[ev.BookingID] = true
+		}
+	}
+
+	entries := make([]TimelineEntry, 0, len(events))
+	for _, ev := range events {
+		if ev.Source == config.TimelineSourcePayment && paidInHistory[ev.BookingID] {
+			continue
+		}
+		eventType, title, ok := describeTimelineEvent(ev)
+		if !ok {
+			continue
+		}
+
+		bookingID := ev.BookingID
+		details := JSONMap{
+			"booking_number": ev.BookingNumber,
+			"service_name":   ev.ServiceName,
+			"scheduled_at":   ev.ScheduledAt,
+		}
+		if ev.BarberName != nil {
+			details["barber_name"] = *ev.BarberName
+		}
+
+		switch {
+		case eventType == config.TimelineEventBookingRescheduled:
+			details["old_start_time"] = ev.OldValues["scheduled_start_time"]
+			details["new_start_time"] = ev.NewValues["scheduled_start_time"]
+		case ev.Source == config.TimelineSourceReview && ev.Rating != nil:
+			details["rating"] = *ev.Rating
+		case ev.Source == config.TimelineSourcePayment || ev.Kind == "payment_status_changed":
+			details["amount"] = ev.TotalPrice
+			details["currency"] = ev.Currency
+			if amount, ok := ev.NewValues["refund_amount"]; ok {
+				details["amount"] = amount
+			}
+		}
+
+		entries = append(entries, TimelineEntry{
+			CustomerID: customerID,
+			EventType:  eventType,
+			Source:     ev.Source,
+			SourceID:   ev.SourceID,
+			BookingID:  &bookingID,
+			Title:      title,
+			Details:    details,
+			OccurredAt: ev.OccurredAt,
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].OccurredAt.Before(entries[j].OccurredAt)
+	})
+	return entries
+}
+
+// describeTimelineEvent maps a source event to its timeline type and title
+func describeTimelineEvent(ev TimelineEvent) (eventType, title string, ok bool) {
+	service := ev.ServiceName
+	if service == "" {
+		service = "appointment"
+	}
+
+	switch ev.Source {
+	case config.TimelineSourceReview:
+		return config.TimelineEventReviewPosted, fmt.Sprintf("You reviewed your %s", service), true
+	case config.TimelineSourcePayment:
+		return config.TimelineEventPaymentReceived, fmt.Sprintf("Payment received for %s", service), true
+	}
+
+	switch ev.Kind {
+	case "created":
+		return config.TimelineEventBookingCreated, fmt.Sprintf("Booked %s", service), true
+	case "rescheduled":
+		return config.TimelineEventBookingRescheduled, fmt.Sprintf("Rescheduled %s", service), true
+	case "status_changed":
+		switch stringValue(ev.NewValues, "status") {
+		case config.BookingStatusConfirmed:
+			return config.TimelineEventBookingConfirmed, fmt.Sprintf("%s confirmed", service), true
+		case config.BookingStatusInProgress:
+			return config.TimelineEventBookingStarted, fmt.Sprintf("%s started", service), true
+		case config.BookingStatusCompleted:
+			return config.TimelineEventBookingCompleted, fmt.Sprintf("%s completed", service), true
+		case config.BookingStatusCancelled, config.BookingStatusCancelledByCustomer, config.BookingStatusCancelledByBarber:
+			return config.TimelineEventBookingCancelled, fmt.Sprintf("%s cancelled", service), true
+		case config.BookingStatusNoShow:
+			return config.TimelineEventBookingNoShow, fmt.Sprintf("Missed %s", service), true
+		}
+	case "payment_status_changed":
+		switch stringValue(ev.NewValues, "payment_status") {
+		case config.PaymentStatusAuthorized:
+			return config.TimelineEventPaymentAuthorized, fmt.Sprintf("Payment authorized for %s", service), true
+		case config.PaymentStatusPaid:
+			return config.TimelineEventPaymentReceived, fmt.Sprintf("Payment received for %s", service), true
+		case config.PaymentStatusRefunded:
+			return config.TimelineEventPaymentRefunded, fmt.Sprintf("Refund issued for %s", service), true
+		case config.PaymentStatusFailed:
+			return config.TimelineEventPaymentFailed, fmt.Sprintf("Payment failed for %s", service), true
+		}
+	}
+	return "", "", false
+}
+
+// stringValue reads a string field from a JSON map
+func stringValue(m JSONMap, key string) string {
+	if m == nil {
+		return ""
+	}
+	s, _ := m[key].(string)
+	return s
+}
@@ -104,6 +104,20 @@ func (u *User) IsActive() bool {
 	return u.Status == config.UserStatusActive
 }
 
+// HasOptedIn returns true if the user enabled a notification channel
+// (notification_settings.<channel> is true). Missing settings count as no.
+func (u *User) HasOptedIn(channel string) bool {
+	enabled, _ := u.NotificationSettings[channel].(bool)
+	return enabled
+}
+
+// HasOptedOut returns true if the user explicitly disabled a notification
+// channel (notification_settings.<channel> is false)
+func (u *User) HasOptedOut(channel string) bool {
+	enabled, ok := u.NotificationSettings[channel].(bool)
+	return ok && !enabled
+}
+
 // GetFullName returns the user's full name
 func (u *User) GetFullName() string {
 	return u.Name
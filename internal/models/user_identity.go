@@ -0,0 +1,15 @@
+// internal/models/user_identity.go
+package models
+
+import "time"
+
+// UserIdentity links a user to an account at a social sign-in provider
+type UserIdentity struct {
+	ID          int        `json:"id" db:"id"`
+	UserID      int        `json:"user_id" db:"user_id"`
+	Provider    string     `json:"provider" db:"provider"` // google, apple
+	Subject     string     `json:"-" db:"subject"`         // Provider account ID
+	Email       *string    `json:"email" db:"email"`       // Email reported by the provider when linked
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	LastLoginAt *time.Time `json:"last_login_at" db:"last_login_at"`
+}
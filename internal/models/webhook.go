@@ -0,0 +1,56 @@
+// internal/models/webhook.go
+package models
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// ========================================================================
+// WEBHOOKS - Barbers' outbound event subscriptions
+// ========================================================================
+
+// WebhookSubscription is a URL a barber registered to receive events
+type WebhookSubscription struct {
+	ID          int         `json:"id" db:"id"`
+	BarberID    int         `json:"barber_id" db:"barber_id"`
+	URL         string      `json:"url" db:"url"`
+	Secret      string      `json:"-" db:"secret"` // Signs deliveries; shown only when the subscription is created
+	Events      StringArray `json:"events" db:"events"`
+	Description *string     `json:"description" db:"description"`
+	IsActive    bool        `json:"is_active" db:"is_active"`
+	CreatedBy   *int        `json:"created_by" db:"created_by"`
+	CreatedAt   time.Time   `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// Subscribes reports whether the subscription receives an event type
+func (w *WebhookSubscription) Subscribes(eventType string) bool {
+	return w.IsActive && slices.Contains(w.Events, eventType)
+}
+
+// WebhookEvent is the JSON body POSTed to subscribers
+type WebhookEvent struct {
+	ID        string      `json:"id"` // Same for every subscription and retry; receivers dedupe on it
+	Type      string      `json:"type"`
+	BarberID  int         `json:"barber_id"`
+	CreatedAt time.Time   `json:"created_at"`
+	Data      interface{} `json:"data"`
+}
+
+// WebhookDelivery is one event queued for one subscription
+type WebhookDelivery struct {
+	ID             int             `json:"id" db:"id"`
+	SubscriptionID int             `json:"subscription_id" db:"subscription_id"`
+	EventID        string          `json:"event_id" db:"event_id"`
+	EventType      string          `json:"event_type" db:"event_type"`
+	Payload        json.RawMessage `json:"payload" db:"payload" swaggertype:"object"`
+	Status         string          `json:"status" db:"status"` // pending, delivered, failed
+	Attempts       int             `json:"attempts" db:"attempts"`
+	NextAttemptAt  *time.Time      `json:"next_attempt_at" db:"next_attempt_at"`
+	ResponseStatus *int            `json:"response_status" db:"response_status"` // HTTP status of the last attempt
+	LastError      *string         `json:"last_error" db:"last_error"`
+	DeliveredAt    *time.Time      `json:"delivered_at" db:"delivered_at"`
+	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,97 @@
+// internal/models/win_back.go
+package models
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// ========================================================================
+// WIN-BACK CAMPAIGNS - Re-engaging lapsed customers
+// ========================================================================
+
+// WinBackCampaign is one run of the win-back job
+type WinBackCampaign struct {
+	ID               int        `json:"id" db:"id"`
+	InactiveWeeks    int        `json:"inactive_weeks" db:"inactive_weeks"`
+	CouponPercentOff int        `json:"coupon_percent_off" db:"coupon_percent_off"`
+	CouponValidDays  int        `json:"coupon_valid_days" db:"coupon_valid_days"`
+	EligibleCount    int        `json:"eligible_count" db:"eligible_count"`
+	SentCount        int        `json:"sent_count" db:"sent_count"`
+	StartedAt        time.Time  `json:"started_at" db:"started_at"`
+	FinishedAt       *time.Time `json:"finished_at" db:"finished_at"`
+	TriggeredBy      *int       `json:"triggered_by" db:"triggered_by"` // nil when run by the scheduler
+}
+
+// WinBackMessage records one customer messaged by a campaign
+type WinBackMessage struct {
+	ID                int        `json:"id" db:"id"`
+	CampaignID        int        `json:"campaign_id" db:"campaign_id"`
+	CustomerID        int        `json:"customer_id" db:"customer_id"`
+	NotificationID    *int       `json:"notification_id" db:"notification_id"`
+	LastVisitAt       time.Time  `json:"last_visit_at" db:"last_visit_at"`
+	CadenceDays       int        `json:"cadence_days" db:"cadence_days"`
+	CouponCode        *string    `json:"coupon_code" db:"coupon_code"`
+	CouponPercentOff  int        `json:"coupon_percent_off" db:"coupon_percent_off"`
+	CouponExpiresAt   *time.Time `json:"coupon_expires_at" db:"coupon_expires_at"`
+	RedeemedBookingID *int       `json:"redeemed_booking_id" db:"redeemed_booking_id"`
+	RedeemedAt        *time.Time `json:"redeemed_at" db:"redeemed_at"`
+	SentAt            time.Time  `json:"sent_at" db:"sent_at"`
+}
+
+// CouponDiscount returns the discount the message's coupon gives on a price
+func (m *WinBackMessage) CouponDiscount(price float64) float64 {
+	return math.Round(price*float64(m.CouponPercentOff)) / 100
+}
+
+// WinBackCandidate is a returning customer who has not booked recently
+type WinBackCandidate struct {
+	CustomerID      int       `json:"customer_id" db:"customer_id"`
+	CustomerName    string    `json:"customer_name" db:"customer_name"`
+	CompletedVisits int       `json:"completed_visits" db:"completed_visits"`
+	FirstVisitAt    time.Time `json:"first_visit_at" db:"first_visit_at"`
+	LastVisitAt     time.Time `json:"last_visit_at" db:"last_visit_at"`
+	LastServiceName string    `json:"last_service_name" db:"last_service_name"`
+	LastBarberName  string    `json:"last_barber_name" db:"last_barber_name"`
+}
+
+// Cadence is the customer's average time between completed visits
+// (zero with fewer than two visits)
+func (c *WinBackCandidate) Cadence() time.Duration {
+	if c.CompletedVisits < 2 {
+		return 0
+	}
+	return c.LastVisitAt.Sub(c.FirstVisitAt) / time.Duration(c.CompletedVisits-1)
+}
+
+// FirstName returns the first word of the customer's name
+func (c *WinBackCandidate) FirstName() string {
+	if fields := strings.Fields(c.CustomerName); len(fields) > 0 {
+		return fields[0]
+	}
+	return ""
+}
+
+// WinBackCampaignStats is a campaign with its performance
+type WinBackCampaignStats struct {
+	WinBackCampaign
+	Opened          int      `json:"opened" db:"opened"`       // Notifications read
+	Converted       int      `json:"converted" db:"converted"` // Customers who booked within the attribution window
+	CouponsRedeemed int      `json:"coupons_redeemed" db:"coupons_redeemed"`
+	Revenue         float64  `json:"revenue" db:"revenue"` // Total price of converting bookings
+	OpenRate        *float64 `json:"open_rate" db:"-"`     // Percentages of SentCount; nil before anything is sent
+	ConversionRate  *float64 `json:"conversion_rate" db:"-"`
+}
+
+// Finalize computes the rates from the counts
+func (s *WinBackCampaignStats) Finalize() {
+	s.Revenue = roundCents(s.Revenue)
+	if s.SentCount == 0 {
+		s.OpenRate, s.ConversionRate = nil, nil
+		return
+	}
+	open := math.Round(float64(s.Opened)/float64(s.SentCount)*1000) / 10
+	conversion := math.Round(float64(s.Converted)/float64(s.SentCount)*1000) / 10
+	s.OpenRate, s.ConversionRate = &open, &conversion
+}
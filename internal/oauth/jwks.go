@@ -0,0 +1,154 @@
+// internal/oauth/jwks.go
+package oauth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	jwksRequestTimeout = 10 * time.Second
+
+	// jwksDefaultTTL applies when the provider sends no max-age
+	jwksDefaultTTL = time.Hour
+
+	// jwksMinRefresh limits refetches triggered by unknown key IDs
+	jwksMinRefresh = time.Minute
+)
+
+// KeySet is a provider's published signing keys, fetched on demand and
+// cached for as long as the provider allows. Providers rotate keys, so an
+// unknown key ID triggers a refetch (at most once a minute).
+type KeySet struct {
+	url    string
+	client *http.Client
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	expiresAt time.Time
+	fetchedAt time.Time
+}
+
+// NewKeySet creates a key set served at url
+func NewKeySet(url string) *KeySet {
+	return &KeySet{
+		url:    url,
+		client: &http.Client{Timeout: jwksRequestTimeout},
+	}
+}
+
+// Key returns the public key with the given ID
+func (k *KeySet) Key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	now := time.Now()
+	key, found := k.keys[kid]
+	stale := now.After(k.expiresAt)
+	if found && !stale {
+		return key, nil
+	}
+	if !stale && now.Sub(k.fetchedAt) < jwksMinRefresh {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	if err := k.refresh(ctx, now); err != nil {
+		if found {
+			// Keep verifying with the cached key while the provider is unreachable
+			return key, nil
+		}
+		return nil, err
+	}
+	if key, found = k.keys[kid]; !found {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// jwk is one JSON Web Key; only RSA keys are used
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// refresh fetches the key set; callers hold k.mu
+func (k *KeySet) refresh(ctx context.Context, now time.Time) error {
+	k.fetchedAt = now
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build JWKS request: %w", err)
+	}
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch signing keys: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch signing keys: %s", resp.Status)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("failed to decode signing keys: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, key := range set.Keys {
+		if key.Kty != "RSA" {
+			continue
+		}
+		public, err := key.rsaKey()
+		if err != nil {
+			return err
+		}
+		keys[key.Kid] = public
+	}
+
+	k.keys = keys
+	k.expiresAt = now.Add(maxAge(resp.Header.Get("Cache-Control")))
+	return nil
+}
+
+// rsaKey decodes the key's modulus and exponent
+func (j jwk) rsaKey() (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(j.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus for key %q: %w", j.Kid, err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(j.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent for key %q: %w", j.Kid, err)
+	}
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
+
+// maxAge reads max-age from a Cache-Control header
+func maxAge(cacheControl string) time.Duration {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, ok := strings.Cut(strings.TrimSpace(directive), "=")
+		if !ok || !strings.EqualFold(name, "max-age") {
+			continue
+		}
+		if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second
+		}
+	}
+	return jwksDefaultTTL
+}
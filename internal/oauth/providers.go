@@ -0,0 +1,30 @@
+// internal/oauth/providers.go
+package oauth
+
+import (
+	"barber-booking-system/internal/config"
+)
+
+// NewGoogleVerifier verifies Google Sign-In ID tokens; it returns
+// ErrNotConfigured when no client IDs are set
+func NewGoogleVerifier(cfg config.OAuthProviderConfig) (*IDTokenVerifier, error) {
+	jwksURL := cfg.JWKSURL
+	if jwksURL == "" {
+		jwksURL = "https://www.googleapis.com/oauth2/v3/certs"
+	}
+	return NewIDTokenVerifier(config.OAuthProviderGoogle,
+		[]string{"https://accounts.google.com", "accounts.google.com"},
+		cfg.ClientIDs, jwksURL)
+}
+
+// NewAppleVerifier verifies Sign in with Apple ID tokens; it returns
+// ErrNotConfigured when no client IDs (bundle or services IDs) are set
+func NewAppleVerifier(cfg config.OAuthProviderConfig) (*IDTokenVerifier, error) {
+	jwksURL := cfg.JWKSURL
+	if jwksURL == "" {
+		jwksURL = "https://appleid.apple.com/auth/keys"
+	}
+	return NewIDTokenVerifier(config.OAuthProviderApple,
+		[]string{"https://appleid.apple.com"},
+		cfg.ClientIDs, jwksURL)
+}
@@ -0,0 +1,161 @@
+// internal/opscheck/opscheck.go
+package opscheck
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strconv"
+	"time"
+
+	dbconfig "barber-booking-system/config"
+	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// OPS CHECKS - Connectivity checks behind `cmd/debug -health`
+// ========================================================================
+// Each check dials one dependency with the loaded configuration and
+// reports what it found; dependencies that are not configured are
+// skipped rather than failed, so the same checks run in every environment.
+// ========================================================================
+
+// Timeout bounds every connectivity check
+const Timeout = 5 * time.Second
+
+// Result is the outcome of a single connectivity check
+type Result struct {
+	Name     string
+	OK       bool
+	Skipped  bool
+	Detail   string
+	Duration time.Duration
+}
+
+// Passed reports whether the check passed; skipped checks count as passing
+func (r Result) Passed() bool {
+	return r.OK || r.Skipped
+}
+
+// Check checks one dependency
+type Check func(cfg *config.Config) Result
+
+// All are the checks `cmd/debug -health` runs, in order
+var All = []Check{Database, Redis, SMTP, Stripe}
+
+// timed runs fn and records its duration in the result
+func timed(name string, fn func() (string, error)) Result {
+	start := time.Now()
+	detail, err := fn()
+	result := Result{Name: name, OK: err == nil, Detail: detail, Duration: time.Since(start)}
+	if err != nil {
+		result.Detail = err.Error()
+	}
+	return result
+}
+
+// Database connects to Postgres and counts its tables
+func Database(cfg *config.Config) Result {
+	return timed("postgres", func() (string, error) {
+		dbManager, err := dbconfig.NewDatabaseManager(cfg.Database)
+		if err != nil {
+			return "", err
+		}
+		defer dbManager.Close()
+
+		info, err := dbManager.GetDatabaseInfo()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s, %d tables", info.DatabaseName, info.TableCount), nil
+	})
+}
+
+// Redis connects to Redis and reads its server info
+func Redis(cfg *config.Config) Result {
+	return timed("redis", func() (string, error) {
+		client, err := cache.NewRedisClient(cfg.Redis)
+		if err != nil {
+			return "", err
+		}
+		defer client.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+		defer cancel()
+
+		info, err := client.GetClient().Info(ctx, "server").Result()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d bytes of server info", len(info)), nil
+	})
+}
+
+// SMTP greets the mail server and reports the extensions it offers
+func SMTP(cfg *config.Config) Result {
+	if cfg.SMTP.Host == "" {
+		return Result{Name: "smtp", Skipped: true, Detail: "SMTP_HOST not set"}
+	}
+
+	return timed("smtp", func() (string, error) {
+		addr := net.JoinHostPort(cfg.SMTP.Host, strconv.Itoa(cfg.SMTP.Port))
+		conn, err := net.DialTimeout("tcp", addr, Timeout)
+		if err != nil {
+			return "", err
+		}
+		_ = conn.SetDeadline(time.Now().Add(Timeout))
+
+		client, err := smtp.NewClient(conn, cfg.SMTP.Host)
+		if err != nil {
+			conn.Close()
+			return "", fmt.Errorf("smtp handshake failed: %w", err)
+		}
+		defer client.Close()
+
+		if err := client.Hello("localhost"); err != nil {
+			return "", fmt.Errorf("EHLO failed: %w", err)
+		}
+		tls, _ := client.Extension("STARTTLS")
+		auth, _ := client.Extension("AUTH")
+		_ = client.Quit()
+
+		return fmt.Sprintf("%s (starttls=%t auth=%t)", addr, tls, auth), nil
+	})
+}
+
+// Stripe reads the account balance to check the secret key is accepted
+func Stripe(cfg *config.Config) Result {
+	if !cfg.Stripe.IsConfigured() {
+		return Result{Name: "stripe", Skipped: true, Detail: "STRIPE_SECRET_KEY not set"}
+	}
+
+	return timed("stripe", func() (string, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), Timeout)
+		defer cancel()
+
+		endpoint, err := url.JoinPath(cfg.Stripe.APIBaseURL, "/v1/balance")
+		if err != nil {
+			return "", err
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return "", err
+		}
+		req.SetBasicAuth(cfg.Stripe.SecretKey, "")
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("unexpected status %s", resp.Status)
+		}
+		return "credentials accepted", nil
+	})
+}
@@ -0,0 +1,53 @@
+// internal/opscheck/validate.go
+package opscheck
+
+import (
+	"net/url"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/middleware"
+)
+
+// Validate checks the loaded configuration for problems that would only
+// surface at runtime (weak secrets, malformed URLs, bad CIDRs). Problems
+// make the configuration invalid; warnings are worth a look.
+func Validate(cfg *config.Config) (problems, warnings []string) {
+	if err := config.ValidateJWTSecret(cfg.JWT.Secret, cfg.App.Environment); err != nil {
+		problems = append(problems, "JWT_SECRET: "+err.Error())
+	}
+
+	if u, err := url.Parse(cfg.Database.URL); err != nil || u.Scheme == "" {
+		problems = append(problems, "DATABASE_URL is not a valid URL")
+	} else if cfg.IsProductionLike() && u.Query().Get("sslmode") == "disable" {
+		warnings = append(warnings, "DATABASE_URL disables SSL in a production-like environment")
+	}
+
+	if _, err := url.Parse(cfg.Redis.URL); err != nil {
+		problems = append(problems, "REDIS_URL is not a valid URL")
+	}
+
+	if cfg.SMTP.Host != "" && (cfg.SMTP.Username == "" || cfg.SMTP.Password == "") {
+		warnings = append(warnings, "SMTP_HOST is set but SMTP_USERNAME/SMTP_PASSWORD are missing")
+	}
+
+	if cfg.IsProductionLike() && !cfg.Stripe.IsConfigured() {
+		warnings = append(warnings, "STRIPE_SECRET_KEY is not set")
+	}
+	if cfg.Stripe.IsConfigured() && cfg.Stripe.WebhookSecret == "" {
+		warnings = append(warnings, "STRIPE_WEBHOOK_SECRET is not set; webhooks cannot be verified")
+	}
+
+	if _, err := middleware.AdminIPFilter(cfg.AdminSecurity, cfg.Server.TrustedProxies); err != nil {
+		problems = append(problems, "admin security: "+err.Error())
+	}
+
+	if cfg.API.MaxBodySize > cfg.Upload.MaxFileSize {
+		warnings = append(warnings, "API_MAX_BODY_SIZE exceeds MAX_UPLOAD_SIZE; the router-wide limit will cap it")
+	}
+
+	if cfg.IsProductionLike() && cfg.Server.GinMode != "release" {
+		warnings = append(warnings, "GIN_MODE should be 'release' in production-like environments")
+	}
+
+	return problems, warnings
+}
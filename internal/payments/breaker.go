@@ -0,0 +1,66 @@
+// internal/payments/breaker.go
+package payments
+
+import (
+	"context"
+	"errors"
+
+	"barber-booking-system/internal/breaker"
+)
+
+// breakerGateway rejects calls while the provider's circuit breaker is open
+type breakerGateway struct {
+	gateway Gateway
+	breaker *breaker.Breaker
+}
+
+// WithBreaker guards g with b. Declines and cancelled requests do not count
+// as provider failures. A nil gateway stays nil.
+func WithBreaker(g Gateway, b *breaker.Breaker) Gateway {
+	if g == nil {
+		return nil
+	}
+	return &breakerGateway{gateway: g, breaker: b}
+}
+
+// IsProviderFailure reports whether err means the provider is failing
+// rather than refusing a particular payment
+func IsProviderFailure(err error) bool {
+	return !errors.Is(err, ErrPaymentDeclined) && !errors.Is(err, context.Canceled)
+}
+
+func (g *breakerGateway) Name() string {
+	return g.gateway.Name()
+}
+
+func (g *breakerGateway) Authorize(ctx context.Context, req AuthorizeRequest) (*Authorization, error) {
+	var auth *Authorization
+	err := g.breaker.Do(func() error {
+		var err error
+		auth, err = g.gateway.Authorize(ctx, req)
+		return err
+	})
+	return auth, err
+}
+
+func (g *breakerGateway) Capture(ctx context.Context, authorizationID string, amount int64) error {
+	return g.breaker.Do(func() error {
+		return g.gateway.Capture(ctx, authorizationID, amount)
+	})
+}
+
+func (g *breakerGateway) Void(ctx context.Context, authorizationID string) error {
+	return g.breaker.Do(func() error {
+		return g.gateway.Void(ctx, authorizationID)
+	})
+}
+
+func (g *breakerGateway) Refund(ctx context.Context, authorizationID string, amount int64) (string, error) {
+	var refundID string
+	err := g.breaker.Do(func() error {
+		var err error
+		refundID, err = g.gateway.Refund(ctx, authorizationID, amount)
+		return err
+	})
+	return refundID, err
+}
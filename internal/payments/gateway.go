@@ -0,0 +1,70 @@
+// internal/payments/gateway.go
+package payments
+
+import (
+	"context"
+	"errors"
+)
+
+// ========================================================================
+// PAYMENT GATEWAY - Provider-agnostic card payments
+// ========================================================================
+//
+// Bookings paid up front use a two-phase flow: the card is authorized
+// (funds held) while the slot is reserved, and the authorization is
+// captured when the barber completes the appointment or voided if the
+// booking falls through.
+// ========================================================================
+
+var (
+	// ErrNotConfigured is returned when no payment provider is set up
+	ErrNotConfigured = errors.New("payments are not configured")
+
+	// ErrPaymentDeclined is returned when the provider refuses the charge
+	ErrPaymentDeclined = errors.New("payment was declined")
+)
+
+// AuthorizeRequest describes funds to hold on a customer's payment method
+type AuthorizeRequest struct {
+	Amount          int64  // Minor units (cents)
+	Currency        string // ISO 4217, lower case (e.g. "usd")
+	PaymentMethodID string // Provider token for the card
+	Description     string
+
+	// IdempotencyKey makes retries of the same authorization safe
+	IdempotencyKey string
+
+	Metadata map[string]string
+}
+
+// Authorization is a successful hold on funds
+type Authorization struct {
+	ID       string // Provider reference (stored as the booking payment_reference)
+	Amount   int64
+	Currency string
+	Status   string // Provider status, e.g. requires_capture
+}
+
+// Gateway is implemented by payment providers
+type Gateway interface {
+	// Name identifies the provider (stored as the booking payment_method)
+	Name() string
+
+	// Authorize places a hold without capturing funds
+	Authorize(ctx context.Context, req AuthorizeRequest) (*Authorization, error)
+
+	// Capture collects a previously authorized amount (0 = the full amount)
+	Capture(ctx context.Context, authorizationID string, amount int64) error
+
+	// Void releases an authorization that has not been captured
+	Void(ctx context.Context, authorizationID string) error
+
+	// Refund returns captured funds (0 = everything captured) and returns
+	// the provider's refund reference
+	Refund(ctx context.Context, authorizationID string, amount int64) (string, error)
+}
+
+// ToMinorUnits converts a decimal price to minor units (cents), rounding half up
+func ToMinorUnits(amount float64) int64 {
+	return int64(amount*100 + 0.5)
+}
@@ -0,0 +1,104 @@
+// internal/payments/recorder.go
+package payments
+
+import "context"
+
+// Operations reported to a Recorder
+const (
+	OperationAuthorize = "authorize"
+	OperationCapture   = "capture"
+	OperationVoid      = "void"
+	OperationRefund    = "refund"
+)
+
+// Operation is a successful call to the provider
+type Operation struct {
+	Kind            string // OperationAuthorize, OperationCapture, ...
+	Provider        string
+	AuthorizationID string
+	Amount          int64  // Minor units; 0 = the whole authorization for captures and refunds
+	Currency        string // Set for authorizations only
+	RefundID        string // Set for refunds only
+	Metadata        map[string]string
+}
+
+// Recorder is told about every successful provider operation
+type Recorder interface {
+	RecordPayment(ctx context.Context, op Operation)
+}
+
+// recordingGateway reports successful operations to a Recorder
+type recordingGateway struct {
+	gateway  Gateway
+	recorder Recorder
+}
+
+// WithRecorder reports g's successful operations to r, after the provider
+// has accepted them. Failed operations are not reported. A nil gateway
+// stays nil.
+func WithRecorder(g Gateway, r Recorder) Gateway {
+	if g == nil {
+		return nil
+	}
+	return &recordingGateway{gateway: g, recorder: r}
+}
+
+func (g *recordingGateway) Name() string {
+	return g.gateway.Name()
+}
+
+func (g *recordingGateway) Authorize(ctx context.Context, req AuthorizeRequest) (*Authorization, error) {
+	auth, err := g.gateway.Authorize(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	g.recorder.RecordPayment(ctx, Operation{
+		Kind:            OperationAuthorize,
+		Provider:        g.gateway.Name(),
+		AuthorizationID: auth.ID,
+		Amount:          auth.Amount,
+		Currency:        auth.Currency,
+		Metadata:        req.Metadata,
+	})
+	return auth, nil
+}
+
+func (g *recordingGateway) Capture(ctx context.Context, authorizationID string, amount int64) error {
+	if err := g.gateway.Capture(ctx, authorizationID, amount); err != nil {
+		return err
+	}
+	g.recorder.RecordPayment(ctx, Operation{
+		Kind:            OperationCapture,
+		Provider:        g.gateway.Name(),
+		AuthorizationID: authorizationID,
+		Amount:          amount,
+	})
+	return nil
+}
+
+func (g *recordingGateway) Void(ctx context.Context, authorizationID string) error {
+	if err := g.gateway.Void(ctx, authorizationID); err != nil {
+		return err
+	}
+	g.recorder.RecordPayment(ctx, Operation{
+		Kind:            OperationVoid,
+		Provider:        g.gateway.Name(),
+		AuthorizationID: authorizationID,
+	})
+	return nil
+}
+
+func (g *recordingGateway) Refund(ctx context.Context, authorizationID string, amount int64) (string, error) {
+	refundID, err := g.gateway.Refund(ctx, authorizationID, amount)
+	if err != nil {
+		return "", err
+	}
+	g.recorder.RecordPayment(ctx, Operation{
+		Kind:            OperationRefund,
+		Provider:        g.gateway.Name(),
+		AuthorizationID: authorizationID,
+		Amount:          amount,
+		RefundID:        refundID,
+	})
+	return refundID, nil
+}
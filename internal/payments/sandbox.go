@@ -0,0 +1,63 @@
+// internal/payments/sandbox.go
+package payments
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
+
+// Test payment methods understood by the sandbox gateway; any other
+// payment method is authorized
+const (
+	SandboxPaymentMethodDeclined = "pm_card_declined"
+	SandboxPaymentMethodError    = "pm_card_error"
+)
+
+// SandboxGateway is a fake provider for sandbox requests. It never moves
+// money: authorizations, captures, voids and refunds always succeed unless
+// a test payment method asks for a failure.
+type SandboxGateway struct {
+	seq atomic.Int64
+}
+
+// NewSandboxGateway creates a sandbox payment gateway
+func NewSandboxGateway() *SandboxGateway {
+	return &SandboxGateway{}
+}
+
+// Name returns the provider name
+func (g *SandboxGateway) Name() string {
+	return "sandbox"
+}
+
+// Authorize returns a fake authorization
+func (g *SandboxGateway) Authorize(_ context.Context, req AuthorizeRequest) (*Authorization, error) {
+	switch req.PaymentMethodID {
+	case SandboxPaymentMethodDeclined:
+		return nil, ErrPaymentDeclined
+	case SandboxPaymentMethodError:
+		return nil, fmt.Errorf("sandbox: simulated provider error")
+	}
+	return &Authorization{
+		ID:       fmt.Sprintf("sandbox_auth_%d", g.seq.Add(1)),
+		Amount:   req.Amount,
+		Currency: req.Currency,
+		Status:   "requires_capture",
+	}, nil
+}
+
+// Capture always succeeds
+func (g *SandboxGateway) Capture(_ context.Context, _ string, _ int64) error {
+	return nil
+}
+
+// Void always succeeds
+func (g *SandboxGateway) Void(_ context.Context, _ string) error {
+	return nil
+}
+
+// Refund returns a fake refund reference
+func (g *SandboxGateway) Refund(_ context.Context, _ string, _ int64) (string, error) {
+	return fmt.Sprintf("sandbox_refund_%d", g.seq.Add(1)), nil
+}
@@ -0,0 +1,187 @@
+// internal/payments/stripe.go
+package payments
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// STRIPE GATEWAY - PaymentIntents with manual capture
+// ========================================================================
+
+const stripeRequestTimeout = 15 * time.Second
+
+// StripeGateway talks to the Stripe REST API
+type StripeGateway struct {
+	secretKey string
+	baseURL   string
+	client    *http.Client
+}
+
+// NewStripeGateway creates a Stripe gateway; it returns ErrNotConfigured
+// when no secret key is set
+func NewStripeGateway(cfg config.StripeConfig) (*StripeGateway, error) {
+	if !cfg.IsConfigured() {
+		return nil, ErrNotConfigured
+	}
+	baseURL := cfg.APIBaseURL
+	if baseURL == "" {
+		baseURL = "https://api.stripe.com"
+	}
+	return &StripeGateway{
+		secretKey: cfg.SecretKey,
+		baseURL:   baseURL,
+		client:    &http.Client{Timeout: stripeRequestTimeout},
+	}, nil
+}
+
+// Name returns the provider name
+func (g *StripeGateway) Name() string {
+	return "stripe"
+}
+
+// stripePaymentIntent is the subset of the PaymentIntent object we use
+type stripePaymentIntent struct {
+	ID       string `json:"id"`
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	Status   string `json:"status"`
+}
+
+// stripeError is Stripe's error envelope
+type stripeError struct {
+	Error struct {
+		Type        string `json:"type"`
+		Code        string `json:"code"`
+		DeclineCode string `json:"decline_code"`
+		Message     string `json:"message"`
+	} `json:"error"`
+}
+
+// Authorize creates and confirms a PaymentIntent with capture_method=manual
+func (g *StripeGateway) Authorize(ctx context.Context, req AuthorizeRequest) (*Authorization, error) {
+	if req.Amount <= 0 {
+		return nil, fmt.Errorf("payment amount must be greater than zero")
+	}
+	if req.PaymentMethodID == "" {
+		return nil, fmt.Errorf("payment method is required")
+	}
+
+	form := url.Values{}
+	form.Set("amount", strconv.FormatInt(req.Amount, 10))
+	form.Set("currency", strings.ToLower(req.Currency))
+	form.Set("payment_method", req.PaymentMethodID)
+	form.Set("capture_method", "manual")
+	form.Set("confirm", "true")
+	// Server-side confirmation: no redirect-based payment methods
+	form.Set("automatic_payment_methods[enabled]", "true")
+	form.Set("automatic_payment_methods[allow_redirects]", "never")
+	if req.Description != "" {
+		form.Set("description", req.Description)
+	}
+	for k, v := range req.Metadata {
+		form.Set("metadata["+k+"]", v)
+	}
+
+	var intent stripePaymentIntent
+	if err := g.post(ctx, "/v1/payment_intents", form, req.IdempotencyKey, &intent); err != nil {
+		return nil, err
+	}
+	if intent.Status != "requires_capture" {
+		// 3-D Secure and similar flows need the customer; release the intent
+		_ = g.Void(context.WithoutCancel(ctx), intent.ID)
+		return nil, fmt.Errorf("%w: payment requires further action (status %s)", ErrPaymentDeclined, intent.Status)
+	}
+
+	return &Authorization{
+		ID:       intent.ID,
+		Amount:   intent.Amount,
+		Currency: intent.Currency,
+		Status:   intent.Status,
+	}, nil
+}
+
+// Capture captures an authorized PaymentIntent
+func (g *StripeGateway) Capture(ctx context.Context, authorizationID string, amount int64) error {
+	form := url.Values{}
+	if amount > 0 {
+		form.Set("amount_to_capture", strconv.FormatInt(amount, 10))
+	}
+	path := "/v1/payment_intents/" + url.PathEscape(authorizationID) + "/capture"
+	return g.post(ctx, path, form, "capture-"+authorizationID, nil)
+}
+
+// Void cancels an uncaptured PaymentIntent, releasing the hold
+func (g *StripeGateway) Void(ctx context.Context, authorizationID string) error {
+	path := "/v1/payment_intents/" + url.PathEscape(authorizationID) + "/cancel"
+	return g.post(ctx, path, url.Values{}, "void-"+authorizationID, nil)
+}
+
+// Refund refunds a captured PaymentIntent, fully or in part
+func (g *StripeGateway) Refund(ctx context.Context, authorizationID string, amount int64) (string, error) {
+	form := url.Values{}
+	form.Set("payment_intent", authorizationID)
+	idempotencyKey := "refund-" + authorizationID
+	if amount > 0 {
+		form.Set("amount", strconv.FormatInt(amount, 10))
+		idempotencyKey += "-" + strconv.FormatInt(amount, 10)
+	}
+
+	var refund struct {
+		ID string `json:"id"`
+	}
+	if err := g.post(ctx, "/v1/refunds", form, idempotencyKey, &refund); err != nil {
+		return "", err
+	}
+	return refund.ID, nil
+}
+
+// post sends a form-encoded request and decodes the JSON response into out
+func (g *StripeGateway) post(ctx context.Context, path string, form url.Values, idempotencyKey string, out interface{}) error {
+	endpoint, err := url.JoinPath(g.baseURL, path)
+	if err != nil {
+		return fmt.Errorf("invalid stripe endpoint: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to build stripe request: %w", err)
+	}
+	req.SetBasicAuth(g.secretKey, "")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if idempotencyKey != "" {
+		req.Header.Set("Idempotency-Key", idempotencyKey)
+	}
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("stripe request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		var apiErr stripeError
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		if apiErr.Error.Type == "card_error" {
+			return fmt.Errorf("%w: %s", ErrPaymentDeclined, apiErr.Error.Message)
+		}
+		return fmt.Errorf("stripe returned %s: %s", resp.Status, apiErr.Error.Message)
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode stripe response: %w", err)
+	}
+	return nil
+}
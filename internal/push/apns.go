@@ -0,0 +1,182 @@
+// internal/push/apns.go
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"barber-booking-system/internal/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ========================================================================
+// APNS SENDER - Apple Push Notification service (token-based auth)
+// ========================================================================
+//
+// Apple accepts a provider token for up to an hour and throttles clients
+// that mint them too often, so one is signed and reused for most of that.
+// ========================================================================
+
+const (
+	apnsRequestTimeout = 15 * time.Second
+	apnsTokenLifetime  = 50 * time.Minute
+)
+
+// APNsSender sends messages through the APNs provider API
+type APNsSender struct {
+	keyID   string
+	teamID  string
+	topic   string
+	key     *ecdsa.PrivateKey
+	baseURL string
+	client  *http.Client
+
+	mu       sync.Mutex
+	jwtToken string
+	issuedAt time.Time
+}
+
+// NewAPNsSender creates an APNs sender; it returns ErrNotConfigured when the
+// signing key or topic is missing
+func NewAPNsSender(cfg config.APNsConfig) (*APNsSender, error) {
+	if !cfg.IsConfigured() {
+		return nil, ErrNotConfigured
+	}
+	key, err := jwt.ParseECPrivateKeyFromPEM([]byte(cfg.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid APNs private key: %w", err)
+	}
+	baseURL := cfg.APIBaseURL
+	if baseURL == "" {
+		baseURL = "https://api.sandbox.push.apple.com"
+	}
+	return &APNsSender{
+		keyID:   cfg.KeyID,
+		teamID:  cfg.TeamID,
+		topic:   cfg.Topic,
+		key:     key,
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: apnsRequestTimeout},
+	}, nil
+}
+
+// Name returns the provider name
+func (a *APNsSender) Name() string {
+	return "apns"
+}
+
+// Send delivers a message to one device
+func (a *APNsSender) Send(ctx context.Context, msg Message) error {
+	if msg.Token == "" {
+		return fmt.Errorf("device token is required")
+	}
+
+	providerToken, err := a.providerToken()
+	if err != nil {
+		return err
+	}
+
+	aps := map[string]any{
+		"alert": map[string]string{"title": msg.Title, "body": msg.Body},
+		"sound": "default",
+	}
+	if msg.Badge != nil {
+		aps["badge"] = *msg.Badge
+	}
+	payload := map[string]any{"aps": aps}
+	for k, v := range msg.Data {
+		if k != "aps" {
+			payload[k] = v
+		}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode APNs payload: %w", err)
+	}
+
+	endpoint, err := url.JoinPath(a.baseURL, "3", "device", msg.Token)
+	if err != nil {
+		return fmt.Errorf("invalid APNs endpoint: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build APNs request: %w", err)
+	}
+	req.Header.Set("Authorization", "bearer "+providerToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("apns-topic", a.topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-priority", "10")
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: APNs request failed: %v", ErrTransient, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 300 {
+		return nil
+	}
+
+	var apiErr struct {
+		Reason string `json:"reason"`
+	}
+	_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+	detail := fmt.Sprintf("APNs returned %s: %s", resp.Status, apiErr.Reason)
+
+	switch {
+	case resp.StatusCode == http.StatusGone,
+		apiErr.Reason == "BadDeviceToken",
+		apiErr.Reason == "DeviceTokenNotForTopic",
+		apiErr.Reason == "Unregistered":
+		return fmt.Errorf("%w: %s", ErrInvalidToken, detail)
+	case apiErr.Reason == "ExpiredProviderToken":
+		a.resetToken()
+		return fmt.Errorf("%w: %s", ErrTransient, detail)
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("%w: %s", ErrTransient, detail)
+	default:
+		return fmt.Errorf("%s", detail)
+	}
+}
+
+// providerToken returns the signed provider JWT, re-signing it when it
+// nears Apple's one-hour limit
+func (a *APNsSender) providerToken() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	if a.jwtToken != "" && now.Sub(a.issuedAt) < apnsTokenLifetime {
+		return a.jwtToken, nil
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": a.teamID,
+		"iat": now.Unix(),
+	})
+	token.Header["kid"] = a.keyID
+	signed, err := token.SignedString(a.key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign APNs provider token: %w", err)
+	}
+
+	a.jwtToken = signed
+	a.issuedAt = now
+	return signed, nil
+}
+
+// resetToken drops the cached provider token
+func (a *APNsSender) resetToken() {
+	a.mu.Lock()
+	a.jwtToken = ""
+	a.mu.Unlock()
+}
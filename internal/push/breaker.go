@@ -0,0 +1,45 @@
+// internal/push/breaker.go
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"barber-booking-system/internal/breaker"
+)
+
+// breakerSender rejects messages while the provider's circuit breaker is open
+type breakerSender struct {
+	sender  Sender
+	breaker *breaker.Breaker
+}
+
+// WithBreaker guards s with b. Rejected messages fail with ErrTransient so
+// they are retried later. A nil sender stays nil.
+func WithBreaker(s Sender, b *breaker.Breaker) Sender {
+	if s == nil {
+		return nil
+	}
+	return &breakerSender{sender: s, breaker: b}
+}
+
+// IsProviderFailure reports whether err means the provider is failing.
+// Invalid device tokens and rejected messages are not.
+func IsProviderFailure(err error) bool {
+	return errors.Is(err, ErrTransient) && !errors.Is(err, context.Canceled)
+}
+
+func (s *breakerSender) Name() string {
+	return s.sender.Name()
+}
+
+func (s *breakerSender) Send(ctx context.Context, msg Message) error {
+	err := s.breaker.Do(func() error {
+		return s.sender.Send(ctx, msg)
+	})
+	if errors.Is(err, breaker.ErrOpen) {
+		return fmt.Errorf("%w: %s: %v", ErrTransient, s.sender.Name(), err)
+	}
+	return err
+}
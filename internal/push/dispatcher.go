@@ -0,0 +1,53 @@
+// internal/push/dispatcher.go
+package push
+
+import (
+	"context"
+	"time"
+)
+
+// Dispatcher routes messages to the provider for each device platform
+type Dispatcher struct {
+	senders     map[string]Sender
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// NewDispatcher creates a dispatcher that tries each device up to maxAttempts times
+func NewDispatcher(maxAttempts int) *Dispatcher {
+	return &Dispatcher{
+		senders:     make(map[string]Sender),
+		maxAttempts: maxAttempts,
+		backoff:     DefaultRetryBackoff,
+	}
+}
+
+// Register sets the provider for a platform. Nil senders are ignored.
+func (d *Dispatcher) Register(platform string, sender Sender) {
+	if sender != nil {
+		d.senders[platform] = sender
+	}
+}
+
+// SetBackoff changes the wait before the first retry
+func (d *Dispatcher) SetBackoff(backoff time.Duration) {
+	d.backoff = backoff
+}
+
+// Enabled reports whether any platform has a provider
+func (d *Dispatcher) Enabled() bool {
+	return d != nil && len(d.senders) > 0
+}
+
+// Supports reports whether a platform has a provider
+func (d *Dispatcher) Supports(platform string) bool {
+	return d != nil && d.senders[platform] != nil
+}
+
+// Send delivers a message to a device on the given platform
+func (d *Dispatcher) Send(ctx context.Context, platform string, msg Message) error {
+	if !d.Supports(platform) {
+		return ErrNotConfigured
+	}
+	return SendWithRetry(ctx, d.senders[platform], msg, d.maxAttempts, d.backoff)
+}
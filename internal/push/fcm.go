@@ -0,0 +1,241 @@
+// internal/push/fcm.go
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"barber-booking-system/internal/config"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ========================================================================
+// FCM SENDER - Firebase Cloud Messaging HTTP v1
+// ========================================================================
+//
+// Requests are authorized with an OAuth access token obtained by trading a
+// service-account JWT at the token endpoint; the token is reused until
+// shortly before it expires.
+// ========================================================================
+
+const (
+	fcmRequestTimeout = 15 * time.Second
+	fcmScope          = "https://www.googleapis.com/auth/firebase.messaging"
+	fcmTokenSlack     = time.Minute
+)
+
+// FCMSender sends messages through the FCM HTTP v1 API
+type FCMSender struct {
+	projectID   string
+	clientEmail string
+	key         *rsa.PrivateKey
+	tokenURL    string
+	baseURL     string
+	client      *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewFCMSender creates an FCM sender; it returns ErrNotConfigured when the
+// service account is missing
+func NewFCMSender(cfg config.FCMConfig) (*FCMSender, error) {
+	if !cfg.IsConfigured() {
+		return nil, ErrNotConfigured
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(cfg.PrivateKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid FCM private key: %w", err)
+	}
+	tokenURL := cfg.TokenURL
+	if tokenURL == "" {
+		tokenURL = "https://oauth2.googleapis.com/token"
+	}
+	baseURL := cfg.APIBaseURL
+	if baseURL == "" {
+		baseURL = "https://fcm.googleapis.com"
+	}
+	return &FCMSender{
+		projectID:   cfg.ProjectID,
+		clientEmail: cfg.ClientEmail,
+		key:         key,
+		tokenURL:    tokenURL,
+		baseURL:     baseURL,
+		client:      &http.Client{Timeout: fcmRequestTimeout},
+	}, nil
+}
+
+// Name returns the provider name
+func (f *FCMSender) Name() string {
+	return "fcm"
+}
+
+// fcmError is Google's API error envelope
+type fcmError struct {
+	Error struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+		Status  string `json:"status"`
+		Details []struct {
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+// errorCode returns the FCM-specific error code, if any
+func (e fcmError) errorCode() string {
+	for _, d := range e.Error.Details {
+		if d.ErrorCode != "" {
+			return d.ErrorCode
+		}
+	}
+	return ""
+}
+
+// Send delivers a message to one device
+func (f *FCMSender) Send(ctx context.Context, msg Message) error {
+	if msg.Token == "" {
+		return fmt.Errorf("device token is required")
+	}
+
+	accessToken, err := f.token(ctx)
+	if err != nil {
+		return err
+	}
+
+	message := map[string]any{
+		"token":        msg.Token,
+		"notification": map[string]string{"title": msg.Title, "body": msg.Body},
+	}
+	if len(msg.Data) > 0 {
+		message["data"] = msg.Data
+	}
+	if msg.Badge != nil {
+		message["android"] = map[string]any{"notification": map[string]any{"notification_count": *msg.Badge}}
+		message["apns"] = map[string]any{"payload": map[string]any{"aps": map[string]any{"badge": *msg.Badge}}}
+	}
+	body, err := json.Marshal(map[string]any{"message": message})
+	if err != nil {
+		return fmt.Errorf("failed to encode FCM message: %w", err)
+	}
+
+	endpoint, err := url.JoinPath(f.baseURL, "v1", "projects", f.projectID, "messages:send")
+	if err != nil {
+		return fmt.Errorf("invalid FCM endpoint: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build FCM request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%w: FCM request failed: %v", ErrTransient, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 300 {
+		return nil
+	}
+
+	var apiErr fcmError
+	_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+	code := apiErr.errorCode()
+	detail := fmt.Sprintf("FCM returned %s: %s (%s)", resp.Status, apiErr.Error.Message, code)
+
+	switch {
+	case code == "UNREGISTERED" || resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("%w: %s", ErrInvalidToken, detail)
+	case code == "INVALID_ARGUMENT" && strings.Contains(strings.ToLower(apiErr.Error.Message), "registration token"):
+		return fmt.Errorf("%w: %s", ErrInvalidToken, detail)
+	case resp.StatusCode == http.StatusUnauthorized:
+		// The cached access token was revoked or expired early
+		f.resetToken()
+		return fmt.Errorf("%w: %s", ErrTransient, detail)
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return fmt.Errorf("%w: %s", ErrTransient, detail)
+	default:
+		return fmt.Errorf("%s", detail)
+	}
+}
+
+// token returns a valid access token, exchanging a fresh service-account
+// assertion when the cached one is about to expire
+func (f *FCMSender) token(ctx context.Context) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	if f.accessToken != "" && now.Add(fcmTokenSlack).Before(f.expiresAt) {
+		return f.accessToken, nil
+	}
+
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   f.clientEmail,
+		"scope": fcmScope,
+		"aud":   f.tokenURL,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(f.key)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign FCM assertion: %w", err)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, f.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to build FCM token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("%w: FCM token request failed: %v", ErrTransient, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		err := fmt.Errorf("FCM token endpoint returned %s", resp.Status)
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+			err = fmt.Errorf("%w: %v", ErrTransient, err)
+		}
+		return "", err
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("failed to decode FCM token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("FCM token response has no access token")
+	}
+
+	f.accessToken = token.AccessToken
+	f.expiresAt = now.Add(time.Duration(token.ExpiresIn) * time.Second)
+	return f.accessToken, nil
+}
+
+// resetToken drops the cached access token
+func (f *FCMSender) resetToken() {
+	f.mu.Lock()
+	f.accessToken = ""
+	f.mu.Unlock()
+}
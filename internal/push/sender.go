@@ -0,0 +1,81 @@
+// internal/push/sender.go
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ========================================================================
+// PUSH SENDER - Provider-agnostic device notifications
+// ========================================================================
+//
+// Each registered device is delivered to through the provider for its
+// platform (APNs for iOS, FCM for Android and web). Transient provider
+// failures are retried with exponential backoff; a token the provider
+// reports as no longer valid comes back as ErrInvalidToken so the caller
+// can forget the device.
+// ========================================================================
+
+var (
+	// ErrNotConfigured is returned when no provider serves a platform
+	ErrNotConfigured = errors.New("push is not configured")
+
+	// ErrInvalidToken is returned when the provider rejects the device token
+	// for good (app uninstalled, token rotated, wrong app)
+	ErrInvalidToken = errors.New("push device token is no longer valid")
+
+	// ErrTransient marks failures worth retrying (rate limits, provider outages)
+	ErrTransient = errors.New("temporary push delivery failure")
+)
+
+// DefaultRetryBackoff is the wait before the first retry; it doubles per attempt
+const DefaultRetryBackoff = 200 * time.Millisecond
+
+// Message is a notification for one device
+type Message struct {
+	Token string // Provider device token
+	Title string
+	Body  string
+	Badge *int // App icon badge (unread count); nil leaves it unchanged
+
+	// Data is delivered to the app alongside the alert
+	Data map[string]string
+}
+
+// Sender is implemented by push providers
+type Sender interface {
+	// Name identifies the provider
+	Name() string
+
+	// Send delivers a message to one device
+	Send(ctx context.Context, msg Message) error
+}
+
+// SendWithRetry sends a message, retrying ErrTransient failures up to
+// attempts times in total with exponential backoff
+func SendWithRetry(ctx context.Context, sender Sender, msg Message, attempts int, backoff time.Duration) error {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		err = sender.Send(ctx, msg)
+		if err == nil || !errors.Is(err, ErrTransient) || attempt == attempts {
+			break
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w (gave up after %d attempts: %v)", err, attempt, ctx.Err())
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+	return err
+}
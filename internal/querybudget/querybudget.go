@@ -0,0 +1,96 @@
+// internal/querybudget/querybudget.go
+package querybudget
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"barber-booking-system/internal/sqlhook"
+)
+
+// ========================================================================
+// QUERY BUDGET - Database queries per request
+// ========================================================================
+//
+// Each request gets a counter of the queries it sends to the database
+// (through the hooked driver, see internal/sqlhook). A request that goes
+// over its budget is logged with its route, which catches N+1 regressions
+// such as a list loading each row's relations one query at a time. In
+// strict mode (development) the query that goes over fails instead, so the
+// regression cannot go unnoticed.
+//
+// Bulk routes (imports, exports) run a query per row or page by design and
+// opt out with an unlimited budget.
+// ========================================================================
+
+// ErrBudgetExceeded is returned in strict mode for queries over the budget
+var ErrBudgetExceeded = errors.New("query budget exceeded")
+
+// Counter counts a request's queries against its budget
+type Counter struct {
+	queries atomic.Int64
+	budget  atomic.Int64 // 0 = unlimited
+	strict  bool
+}
+
+// WithCounter starts counting the queries run with ctx
+func WithCounter(ctx context.Context, budget int, strict bool) (context.Context, *Counter) {
+	counter := &Counter{strict: strict}
+	counter.budget.Store(int64(budget))
+	return context.WithValue(ctx, contextKey{}, counter), counter
+}
+
+type contextKey struct{}
+
+// FromContext returns the counter of ctx's request, if any
+func FromContext(ctx context.Context) *Counter {
+	counter, _ := ctx.Value(contextKey{}).(*Counter)
+	return counter
+}
+
+// SetBudget changes the request's budget (0 = unlimited)
+func (c *Counter) SetBudget(budget int) {
+	c.budget.Store(int64(budget))
+}
+
+// Unlimited lifts the budget of ctx's request, if it has one
+func Unlimited(ctx context.Context) {
+	if counter := FromContext(ctx); counter != nil {
+		counter.SetBudget(0)
+	}
+}
+
+// Budget returns the request's budget (0 = unlimited)
+func (c *Counter) Budget() int {
+	return int(c.budget.Load())
+}
+
+// Queries returns the number of queries run so far
+func (c *Counter) Queries() int {
+	return int(c.queries.Load())
+}
+
+// Exceeded reports whether the request ran more queries than its budget
+func (c *Counter) Exceeded() bool {
+	budget := c.Budget()
+	return budget > 0 && c.Queries() > budget
+}
+
+// Hook counts queries, statements and prepared statements against the
+// request's budget; in strict mode it fails those over it
+func Hook(ctx context.Context, op string) error {
+	if op == sqlhook.OpBegin || op == sqlhook.OpPing {
+		return nil
+	}
+	counter := FromContext(ctx)
+	if counter == nil {
+		return nil
+	}
+	queries := counter.queries.Add(1)
+	if budget := counter.budget.Load(); counter.strict && budget > 0 && queries > budget {
+		return fmt.Errorf("%w: query %d of a budget of %d", ErrBudgetExceeded, queries, budget)
+	}
+	return nil
+}
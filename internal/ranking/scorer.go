@@ -0,0 +1,258 @@
+// internal/ranking/scorer.go
+package ranking
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// RANKING - Weighted scoring for barber listings
+// ========================================================================
+//
+// Every factor is scored from 0 (worst) to 1 (best) and multiplied by its
+// weight; weights are normalized to sum to 1, so a barber's score is also
+// between 0 and 1. Scoring runs in SQL so listings can be ordered and
+// paginated by score; the same factor values come back with each row and
+// Explain turns them into per-factor contributions.
+//
+// Distance, response time and price decay smoothly: a value equal to the
+// configured scale scores 0.5, twice the scale 0.33, and so on. Barbers with
+// no response time or price history get a neutral 0.5; distance scores 0
+// when either the searcher's or the barber's location is unknown.
+// ========================================================================
+
+// Factor names
+const (
+	FactorRating       = "rating"
+	FactorDistance     = "distance"
+	FactorResponseTime = "response_time"
+	FactorPrice        = "price"
+	FactorFeatured     = "featured"
+)
+
+// neutralScore is used for factors a barber has no data for yet
+const neutralScore = "0.5"
+
+// Columns names the SQL expressions a listing query provides for each raw signal
+type Columns struct {
+	Rating          string // Average rating, 0-5
+	DistanceKm      string // Distance from the searcher in km; NULL when unknown
+	ResponseMinutes string // Typical response time in minutes; 0 when unknown
+	AveragePrice    string // Average active service price; NULL when no services
+	Featured        string // Boolean: a featured placement is running
+}
+
+// factor is one weighted component of the score
+type factor struct {
+	name   string
+	weight float64
+}
+
+// Scorer ranks barbers by weighted factors
+type Scorer struct {
+	factors []factor // Fixed order; weights sum to 1
+
+	distanceScale float64
+	responseScale float64
+	priceScale    float64
+}
+
+// New creates a scorer from configuration. Negative weights count as zero;
+// if every weight is zero the defaults are used. Non-positive scales fall
+// back to the defaults.
+func New(cfg config.RankingConfig) *Scorer {
+	weights := []factor{
+		{FactorRating, cfg.WeightRating},
+		{FactorDistance, cfg.WeightDistance},
+		{FactorResponseTime, cfg.WeightResponseTime},
+		{FactorPrice, cfg.WeightPrice},
+		{FactorFeatured, cfg.WeightFeatured},
+	}
+
+	var total float64
+	for i := range weights {
+		if weights[i].weight < 0 {
+			weights[i].weight = 0
+		}
+		total += weights[i].weight
+	}
+	if total == 0 {
+		weights = []factor{
+			{FactorRating, config.DefaultRankingWeightRating},
+			{FactorDistance, config.DefaultRankingWeightDistance},
+			{FactorResponseTime, config.DefaultRankingWeightResponseTime},
+			{FactorPrice, config.DefaultRankingWeightPrice},
+			{FactorFeatured, config.DefaultRankingWeightFeatured},
+		}
+		for _, f := range weights {
+			total += f.weight
+		}
+	}
+	for i := range weights {
+		weights[i].weight /= total
+	}
+
+	return &Scorer{
+		factors:       weights,
+		distanceScale: positiveOr(cfg.DistanceScaleKm, config.DefaultRankingDistanceScaleKm),
+		responseScale: positiveOr(cfg.ResponseScaleMinutes, config.DefaultRankingResponseScaleMinutes),
+		priceScale:    positiveOr(cfg.PriceScale, config.DefaultRankingPriceScale),
+	}
+}
+
+// Default returns a scorer with the default weights
+func Default() *Scorer {
+	return New(config.RankingConfig{})
+}
+
+// Weight returns the normalized weight of a factor (0 for unknown factors)
+func (s *Scorer) Weight(name string) float64 {
+	for _, f := range s.factors {
+		if f.name == name {
+			return f.weight
+		}
+	}
+	return 0
+}
+
+// ========================================================================
+// SQL
+// ========================================================================
+
+// FactorsSQL returns a JSON object expression with each factor's 0-1 value,
+// for selecting alongside the score and scanning into Values
+func (s *Scorer) FactorsSQL(cols Columns) string {
+	parts := make([]string, 0, len(s.factors))
+	for _, f := range s.factors {
+		parts = append(parts, fmt.Sprintf("'%s', %s", f.name, s.factorSQL(f.name, cols)))
+	}
+	return "json_build_object(" + strings.Join(parts, ", ") + ")"
+}
+
+// ScoreSQL returns the weighted score expression. Factors with zero weight
+// are left out.
+func (s *Scorer) ScoreSQL(cols Columns) string {
+	var terms []string
+	for _, f := range s.factors {
+		if f.weight == 0 {
+			continue
+		}
+		terms = append(terms, fmt.Sprintf("%s * %s", formatFloat(f.weight), s.factorSQL(f.name, cols)))
+	}
+	if len(terms) == 0 {
+		return "0"
+	}
+	return "(" + strings.Join(terms, " + ") + ")"
+}
+
+// factorSQL returns the 0-1 expression for one factor
+func (s *Scorer) factorSQL(name string, cols Columns) string {
+	switch name {
+	case FactorRating:
+		return fmt.Sprintf("(LEAST(GREATEST(COALESCE(%s, 0), 0), 5) / 5.0)", cols.Rating)
+	case FactorDistance:
+		return fmt.Sprintf("COALESCE(1.0 / (1.0 + %s / %s), 0)", cols.DistanceKm, formatFloat(s.distanceScale))
+	case FactorResponseTime:
+		return fmt.Sprintf("(CASE WHEN %[1]s > 0 THEN 1.0 / (1.0 + %[1]s::float8 / %[2]s) ELSE %[3]s END)",
+			cols.ResponseMinutes, formatFloat(s.responseScale), neutralScore)
+	case FactorPrice:
+		return fmt.Sprintf("(CASE WHEN %[1]s > 0 THEN 1.0 / (1.0 + %[1]s::float8 / %[2]s) ELSE %[3]s END)",
+			cols.AveragePrice, formatFloat(s.priceScale), neutralScore)
+	case FactorFeatured:
+		return fmt.Sprintf("(CASE WHEN %s THEN 1.0 ELSE 0.0 END)", cols.Featured)
+	}
+	return "0"
+}
+
+// ========================================================================
+// EXPLAIN
+// ========================================================================
+
+// Values holds a row's factor values (0-1) by factor name, as selected by FactorsSQL
+type Values map[string]float64
+
+// Value implements driver.Valuer
+func (v Values) Value() (driver.Value, error) {
+	if v == nil {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+// Scan implements sql.Scanner
+func (v *Values) Scan(value interface{}) error {
+	if value == nil {
+		*v = nil
+		return nil
+	}
+
+	var data []byte
+	switch val := value.(type) {
+	case []byte:
+		data = val
+	case string:
+		data = []byte(val)
+	default:
+		return fmt.Errorf("cannot scan %T into ranking.Values", value)
+	}
+	return json.Unmarshal(data, v)
+}
+
+// Contribution is one factor's share of a score
+type Contribution struct {
+	Factor       string  `json:"factor"`
+	Value        float64 `json:"value"`  // 0-1
+	Weight       float64 `json:"weight"` // Normalized
+	Contribution float64 `json:"contribution"`
+}
+
+// Explanation breaks a score down by factor
+type Explanation struct {
+	Score   float64        `json:"score"`
+	Factors []Contribution `json:"factors"`
+}
+
+// Explain returns each factor's contribution to the score for the given values.
+// Missing factors count as 0.
+func (s *Scorer) Explain(values Values) *Explanation {
+	explanation := &Explanation{Factors: make([]Contribution, 0, len(s.factors))}
+	for _, f := range s.factors {
+		value := values[f.name]
+		contribution := value * f.weight
+		explanation.Factors = append(explanation.Factors, Contribution{
+			Factor:       f.name,
+			Value:        value,
+			Weight:       f.weight,
+			Contribution: contribution,
+		})
+		explanation.Score += contribution
+	}
+	return explanation
+}
+
+// Score returns the weighted score for the given values
+func (s *Scorer) Score(values Values) float64 {
+	return s.Explain(values).Score
+}
+
+// ========================================================================
+// HELPERS
+// ========================================================================
+
+func positiveOr(value, fallback float64) float64 {
+	if value > 0 {
+		return value
+	}
+	return fallback
+}
+
+// formatFloat renders a float for inlining into SQL (never user input)
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
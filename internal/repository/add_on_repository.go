@@ -0,0 +1,134 @@
+// internal/repository/add_on_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ========================================================================
+// ADD-ON REPOSITORY - Optional extras offered on barber services
+// ========================================================================
+
+// AddOnRepository handles the add-on catalog of barber services
+type AddOnRepository struct {
+	db *sqlx.DB
+}
+
+// NewAddOnRepository creates a new add-on repository
+func NewAddOnRepository(db *sqlx.DB) *AddOnRepository {
+	return &AddOnRepository{db: db}
+}
+
+// FindByID retrieves an add-on by its ID
+func (r *AddOnRepository) FindByID(ctx context.Context, id int) (*models.ServiceAddOn, error) {
+	var addOn models.ServiceAddOn
+	err := r.db.GetContext(ctx, &addOn, `SELECT * FROM barber_service_add_ons WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAddOnNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find add-on: %w", err)
+	}
+	return &addOn, nil
+}
+
+// FindByIDs retrieves the add-ons with the given IDs; missing IDs are
+// skipped
+func (r *AddOnRepository) FindByIDs(ctx context.Context, ids []int) ([]models.ServiceAddOn, error) {
+	addOns := []models.ServiceAddOn{}
+	if len(ids) == 0 {
+		return addOns, nil
+	}
+	err := r.db.SelectContext(ctx, &addOns, `SELECT * FROM barber_service_add_ons WHERE id = ANY($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find add-ons: %w", err)
+	}
+	return addOns, nil
+}
+
+// FindByBarberServiceID retrieves a barber service's add-ons in display
+// order, optionally only the active ones
+func (r *AddOnRepository) FindByBarberServiceID(ctx context.Context, barberServiceID int, activeOnly bool) ([]models.ServiceAddOn, error) {
+	query := `SELECT * FROM barber_service_add_ons WHERE barber_service_id = $1`
+	if activeOnly {
+		query += ` AND is_active = TRUE`
+	}
+	query += ` ORDER BY display_order ASC, name ASC`
+
+	addOns := []models.ServiceAddOn{}
+	if err := r.db.SelectContext(ctx, &addOns, query, barberServiceID); err != nil {
+		return nil, fmt.Errorf("failed to find add-ons: %w", err)
+	}
+	return addOns, nil
+}
+
+// CountByBarberServiceID counts the add-ons a barber service offers
+func (r *AddOnRepository) CountByBarberServiceID(ctx context.Context, barberServiceID int) (int, error) {
+	var count int
+	err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM barber_service_add_ons WHERE barber_service_id = $1`, barberServiceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count add-ons: %w", err)
+	}
+	return count, nil
+}
+
+// Create inserts a new add-on
+func (r *AddOnRepository) Create(ctx context.Context, addOn *models.ServiceAddOn) error {
+	query := `
+		INSERT INTO barber_service_add_ons (barber_service_id, name, description, price, duration_minutes, is_active, display_order)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		addOn.BarberServiceID, addOn.Name, addOn.Description, addOn.Price, addOn.DurationMinutes, addOn.IsActive, addOn.DisplayOrder,
+	).Scan(&addOn.ID, &addOn.CreatedAt, &addOn.UpdatedAt)
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrDuplicateAddOn
+		}
+		return fmt.Errorf("failed to create add-on: %w", err)
+	}
+	return nil
+}
+
+// Update saves an add-on
+func (r *AddOnRepository) Update(ctx context.Context, addOn *models.ServiceAddOn) error {
+	query := `
+		UPDATE barber_service_add_ons SET
+			name = $2, description = $3, price = $4, duration_minutes = $5, is_active = $6, display_order = $7,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		addOn.ID, addOn.Name, addOn.Description, addOn.Price, addOn.DurationMinutes, addOn.IsActive, addOn.DisplayOrder,
+	).Scan(&addOn.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrAddOnNotFound
+	}
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrDuplicateAddOn
+		}
+		return fmt.Errorf("failed to update add-on: %w", err)
+	}
+	return nil
+}
+
+// Delete removes an add-on. Bookings keep their line items for it.
+func (r *AddOnRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM barber_service_add_ons WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete add-on: %w", err)
+	}
+	return CheckRowsAffected(result, ErrAddOnNotFound)
+}
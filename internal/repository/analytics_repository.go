@@ -0,0 +1,58 @@
+// internal/repository/analytics_repository.go
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// ANALYTICS REPOSITORY - Booking attributes for anonymized datasets
+// ========================================================================
+
+// AnalyticsRepository reads the booking attributes analytics datasets are
+// built from
+type AnalyticsRepository struct {
+	db *sqlx.DB
+}
+
+// NewAnalyticsRepository creates a new analytics repository
+func NewAnalyticsRepository(db *sqlx.DB) *AnalyticsRepository {
+	return &AnalyticsRepository{db: db}
+}
+
+// FindDatasetBookings retrieves up to limit live and archived bookings
+// scheduled to start in [from, to) with ids above afterID, in id order.
+// Sandbox bookings are left out.
+func (r *AnalyticsRepository) FindDatasetBookings(ctx context.Context, from, to time.Time, afterID, limit int) ([]models.AnalyticsBooking, error) {
+	const columns = `id, barber_id, scheduled_start_time, scheduled_end_time, created_at, total_price,
+		currency, status, booking_source, service_category`
+
+	bookings := []models.AnalyticsBooking{}
+	err := r.db.SelectContext(ctx, &bookings, `
+		SELECT b.id, b.scheduled_start_time, b.scheduled_end_time, b.created_at, b.total_price,
+			b.currency, b.status, b.booking_source, b.service_category,
+			COALESCE(br.city, '') AS city, COALESCE(s.timezone, 'UTC') AS timezone
+		FROM (
+			SELECT `+columns+` FROM bookings
+			WHERE scheduled_start_time >= $1 AND scheduled_start_time < $2 AND NOT is_test
+			UNION ALL
+			SELECT `+columns+` FROM bookings_archive
+			WHERE scheduled_start_time >= $1 AND scheduled_start_time < $2 AND NOT is_test
+		) b
+		LEFT JOIN barbers br ON br.id = b.barber_id
+		LEFT JOIN barber_schedules s ON s.barber_id = b.barber_id
+		WHERE b.id > $3
+		ORDER BY b.id
+		LIMIT $4
+	`, from, to, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find dataset bookings: %w", err)
+	}
+	return bookings, nil
+}
@@ -0,0 +1,184 @@
+// internal/repository/api_usage_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// APIUsageRepository handles per-consumer API usage counters and quotas
+type APIUsageRepository struct {
+	db *sqlx.DB
+}
+
+// NewAPIUsageRepository creates a new API usage repository
+func NewAPIUsageRepository(db *sqlx.DB) *APIUsageRepository {
+	return &APIUsageRepository{db: db}
+}
+
+// apiUsageStatsColumns aggregates api_usage rows into models.APIUsageStats
+const apiUsageStatsColumns = `
+	COALESCE(SUM(request_count), 0) as request_count,
+	COALESCE(SUM(client_error_count), 0) as client_error_count,
+	COALESCE(SUM(server_error_count), 0) as server_error_count,
+	COALESCE(SUM(total_latency_ms)::float8 / NULLIF(SUM(request_count), 0), 0) as avg_latency_ms,
+	COALESCE(MAX(max_latency_ms), 0) as max_latency_ms
+`
+
+// ========================================================================
+// USAGE
+// ========================================================================
+
+// Record adds buffered counters to the stored hourly rows in one transaction
+func (r *APIUsageRepository) Record(ctx context.Context, usage []models.APIUsage) error {
+	if len(usage) == 0 {
+		return nil
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO api_usage (
+			user_id, hour, method, route, request_count, client_error_count,
+			server_error_count, total_latency_ms, max_latency_ms
+		) VALUES (
+			:user_id, :hour, :method, :route, :request_count, :client_error_count,
+			:server_error_count, :total_latency_ms, :max_latency_ms
+		)
+		ON CONFLICT (user_id, hour, method, route) DO UPDATE SET
+			request_count = api_usage.request_count + EXCLUDED.request_count,
+			client_error_count = api_usage.client_error_count + EXCLUDED.client_error_count,
+			server_error_count = api_usage.server_error_count + EXCLUDED.server_error_count,
+			total_latency_ms = api_usage.total_latency_ms + EXCLUDED.total_latency_ms,
+			max_latency_ms = GREATEST(api_usage.max_latency_ms, EXCLUDED.max_latency_ms)
+	`
+	for _, u := range usage {
+		if _, err := tx.NamedExecContext(ctx, query, u); err != nil {
+			return fmt.Errorf("failed to record api usage: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit api usage: %w", err)
+	}
+	return nil
+}
+
+// Totals returns a consumer's traffic in [from, to)
+func (r *APIUsageRepository) Totals(ctx context.Context, userID int, from, to time.Time) (*models.APIUsageStats, error) {
+	var stats models.APIUsageStats
+	err := r.db.GetContext(ctx, &stats, `
+		SELECT `+apiUsageStatsColumns+`
+		FROM api_usage
+		WHERE user_id = $1 AND hour >= $2 AND hour < $3
+	`, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api usage totals: %w", err)
+	}
+	return &stats, nil
+}
+
+// ByEndpoint returns a consumer's traffic in [from, to) per endpoint, busiest first
+func (r *APIUsageRepository) ByEndpoint(ctx context.Context, userID int, from, to time.Time) ([]models.APIUsageStats, error) {
+	stats := []models.APIUsageStats{}
+	err := r.db.SelectContext(ctx, &stats, `
+		SELECT method, route, `+apiUsageStatsColumns+`
+		FROM api_usage
+		WHERE user_id = $1 AND hour >= $2 AND hour < $3
+		GROUP BY method, route
+		ORDER BY request_count DESC, route, method
+	`, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api usage by endpoint: %w", err)
+	}
+	return stats, nil
+}
+
+// ByDay returns a consumer's traffic in [from, to) per UTC day
+func (r *APIUsageRepository) ByDay(ctx context.Context, userID int, from, to time.Time) ([]models.APIUsageStats, error) {
+	stats := []models.APIUsageStats{}
+	err := r.db.SelectContext(ctx, &stats, `
+		SELECT date_trunc('day', hour AT TIME ZONE 'UTC') as day, `+apiUsageStatsColumns+`
+		FROM api_usage
+		WHERE user_id = $1 AND hour >= $2 AND hour < $3
+		GROUP BY 1
+		ORDER BY 1
+	`, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api usage by day: %w", err)
+	}
+	return stats, nil
+}
+
+// TopConsumers returns the busiest consumers in [from, to)
+func (r *APIUsageRepository) TopConsumers(ctx context.Context, from, to time.Time, limit int) ([]models.APIUsageStats, error) {
+	stats := []models.APIUsageStats{}
+	err := r.db.SelectContext(ctx, &stats, `
+		SELECT a.user_id, u.email as user_email, `+apiUsageStatsColumns+`
+		FROM api_usage a
+		JOIN users u ON u.id = a.user_id
+		WHERE a.hour >= $1 AND a.hour < $2
+		GROUP BY a.user_id, u.email
+		ORDER BY request_count DESC, a.user_id
+		LIMIT $3
+	`, from, to, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get top api consumers: %w", err)
+	}
+	return stats, nil
+}
+
+// ========================================================================
+// QUOTAS
+// ========================================================================
+
+// ListQuotas returns every per-consumer quota
+func (r *APIUsageRepository) ListQuotas(ctx context.Context) ([]models.APIQuota, error) {
+	quotas := []models.APIQuota{}
+	err := r.db.SelectContext(ctx, &quotas, `
+		SELECT q.user_id, u.email as user_email, q.requests_per_minute, q.note, q.updated_at
+		FROM api_quotas q
+		JOIN users u ON u.id = q.user_id
+		ORDER BY q.user_id
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list api quotas: %w", err)
+	}
+	return quotas, nil
+}
+
+// SetQuota creates or replaces a consumer's quota
+func (r *APIUsageRepository) SetQuota(ctx context.Context, quota *models.APIQuota) error {
+	query := `
+		INSERT INTO api_quotas (user_id, requests_per_minute, note, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (user_id) DO UPDATE SET
+			requests_per_minute = EXCLUDED.requests_per_minute,
+			note = EXCLUDED.note,
+			updated_at = EXCLUDED.updated_at
+		RETURNING updated_at
+	`
+	err := r.db.QueryRowxContext(ctx, query, quota.UserID, quota.RequestsPerMinute, quota.Note).
+		Scan(&quota.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set api quota: %w", err)
+	}
+	return nil
+}
+
+// DeleteQuota removes a consumer's quota so the default limit applies again
+func (r *APIUsageRepository) DeleteQuota(ctx context.Context, userID int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM api_quotas WHERE user_id = $1`, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete api quota: %w", err)
+	}
+	return CheckRowsAffected(result, ErrAPIQuotaNotFound)
+}
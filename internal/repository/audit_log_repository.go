@@ -0,0 +1,221 @@
+// internal/repository/audit_log_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// AUDIT LOG REPOSITORY - Data Access Layer for Platform Audit Trail
+// ========================================================================
+
+// AuditLogRepository handles audit log data operations
+type AuditLogRepository struct {
+	*BaseRepository[models.AuditLog]
+	db *sqlx.DB
+}
+
+// NewAuditLogRepository creates a new audit log repository
+func NewAuditLogRepository(db *sqlx.DB) *AuditLogRepository {
+	return &AuditLogRepository{
+		BaseRepository: NewBaseRepository[models.AuditLog](db, ErrAuditLogNotFound),
+		db:             db,
+	}
+}
+
+// ========================================================================
+// FILTER STRUCTS
+// ========================================================================
+
+// AuditLogFilters represents filter options for audit log queries
+type AuditLogFilters struct {
+	// Actor filters - ActorID matches entries performed by the user directly
+	// or while impersonating someone else
+	ActorID        int    `form:"actor_id"`
+	ActorType      string `form:"actor_type"`
+	ImpersonatorID int    `form:"impersonator_id"`
+	Impersonated   *bool  `form:"impersonated"`
+
+	// Entity filters
+	EntityType string `form:"entity_type"`
+	EntityID   int    `form:"entity_id"`
+
+	// Action filters
+	Action  string   `form:"action"`
+	Actions []string `form:"actions"`
+
+	// Date range filters
+	CreatedFrom time.Time `form:"created_from" time_format:"2006-01-02T15:04:05Z07:00"`
+	CreatedTo   time.Time `form:"created_to" time_format:"2006-01-02T15:04:05Z07:00"`
+
+	// Keyset pagination - return entries older than this ID
+	BeforeID int `form:"before_id"`
+	Limit    int `form:"limit,default=50"`
+}
+
+// ListParams implements ListFilters; entries are always newest first
+func (f *AuditLogFilters) ListParams() ListParams {
+	return ListParams{
+		Limit:  &f.Limit,
+		Ranges: []DateRange{{Name: "created", From: f.CreatedFrom, To: f.CreatedTo}},
+	}
+}
+
+// ========================================================================
+// CREATE OPERATIONS
+// ========================================================================
+
+// Create inserts a new audit log entry
+func (r *AuditLogRepository) Create(ctx context.Context, entry *models.AuditLog) error {
+	query := `
+		INSERT INTO audit_logs (
+			actor_id, actor_type, impersonator_id,
+			action, entity_type, entity_id, metadata, old_values, new_values,
+			ip_address, user_agent, request_id, created_at
+		) VALUES (
+			:actor_id, :actor_type, :impersonator_id,
+			:action, :entity_type, :entity_id, :metadata, :old_values, :new_values,
+			:ip_address, :user_agent, :request_id, :created_at
+		) RETURNING id
+	`
+
+	entry.CreatedAt = time.Now()
+	if entry.Metadata == nil {
+		entry.Metadata = models.JSONMap{}
+	}
+
+	rows, err := r.db.NamedQueryContext(ctx, query, entry)
+	if err != nil {
+		return fmt.Errorf("failed to create audit log: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.Scan(&entry.ID); err != nil {
+			return fmt.Errorf("failed to scan audit log id: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ========================================================================
+// READ OPERATIONS
+// ========================================================================
+
+// FindByID retrieves an audit log entry by ID. Audit entries are never
+// soft deleted, so this replaces the base lookup.
+func (r *AuditLogRepository) FindByID(ctx context.Context, id int) (*models.AuditLog, error) {
+	var entry models.AuditLog
+	err := r.db.GetContext(ctx, &entry, `SELECT * FROM audit_logs WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAuditLogNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find audit log: %w", err)
+	}
+	return &entry, nil
+}
+
+// FindAll retrieves audit log entries newest-first with optional filters
+func (r *AuditLogRepository) FindAll(ctx context.Context, filters AuditLogFilters) ([]models.AuditLog, error) {
+	query := `SELECT * FROM audit_logs WHERE 1=1`
+	args := []interface{}{}
+	argCount := 1
+
+	// Actor filter (direct or via impersonation)
+	if filters.ActorID > 0 {
+		query += fmt.Sprintf(" AND (actor_id = $%d OR impersonator_id = $%d)", argCount, argCount)
+		args = append(args, filters.ActorID)
+		argCount++
+	}
+
+	if filters.ActorType != "" {
+		query += fmt.Sprintf(" AND actor_type = $%d", argCount)
+		args = append(args, filters.ActorType)
+		argCount++
+	}
+
+	if filters.ImpersonatorID > 0 {
+		query += fmt.Sprintf(" AND impersonator_id = $%d", argCount)
+		args = append(args, filters.ImpersonatorID)
+		argCount++
+	}
+
+	if filters.Impersonated != nil {
+		if *filters.Impersonated {
+			query += " AND impersonator_id IS NOT NULL"
+		} else {
+			query += " AND impersonator_id IS NULL"
+		}
+	}
+
+	// Entity filters
+	if filters.EntityType != "" {
+		query += fmt.Sprintf(" AND entity_type = $%d", argCount)
+		args = append(args, filters.EntityType)
+		argCount++
+	}
+	if filters.EntityID > 0 {
+		query += fmt.Sprintf(" AND entity_id = $%d", argCount)
+		args = append(args, filters.EntityID)
+		argCount++
+	}
+
+	// Action filters
+	if filters.Action != "" {
+		query += fmt.Sprintf(" AND action = $%d", argCount)
+		args = append(args, filters.Action)
+		argCount++
+	}
+	if len(filters.Actions) > 0 {
+		placeholders := make([]string, len(filters.Actions))
+		for i, a := range filters.Actions {
+			placeholders[i] = fmt.Sprintf("$%d", argCount)
+			args = append(args, a)
+			argCount++
+		}
+		query += fmt.Sprintf(" AND action IN (%s)", strings.Join(placeholders, ", "))
+	}
+
+	// Date range filters
+	if !filters.CreatedFrom.IsZero() {
+		query += fmt.Sprintf(" AND created_at >= $%d", argCount)
+		args = append(args, filters.CreatedFrom)
+		argCount++
+	}
+	if !filters.CreatedTo.IsZero() {
+		query += fmt.Sprintf(" AND created_at <= $%d", argCount)
+		args = append(args, filters.CreatedTo)
+		argCount++
+	}
+
+	// Keyset pagination
+	if filters.BeforeID > 0 {
+		query += fmt.Sprintf(" AND id < $%d", argCount)
+		args = append(args, filters.BeforeID)
+		argCount++
+	}
+
+	limit := 50
+	if filters.Limit > 0 {
+		limit = filters.Limit
+	}
+	query += fmt.Sprintf(" ORDER BY id DESC LIMIT $%d", argCount)
+	args = append(args, limit)
+
+	var entries []models.AuditLog
+	if err := r.db.SelectContext(ctx, &entries, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to find audit logs: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,60 @@
+// internal/repository/auto_reply_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// AUTO-REPLY REPOSITORY - Barbers' out-of-hours replies
+// ========================================================================
+
+// AutoReplyRepository handles barber auto-reply settings
+type AutoReplyRepository struct {
+	db *sqlx.DB
+}
+
+// NewAutoReplyRepository creates a new auto-reply repository
+func NewAutoReplyRepository(db *sqlx.DB) *AutoReplyRepository {
+	return &AutoReplyRepository{db: db}
+}
+
+// FindByBarberID returns a barber's auto-reply. Returns
+// ErrAutoReplyNotFound if the barber never set one up.
+func (r *AutoReplyRepository) FindByBarberID(ctx context.Context, barberID int) (*models.BarberAutoReply, error) {
+	var reply models.BarberAutoReply
+	err := r.db.GetContext(ctx, &reply, `SELECT * FROM barber_auto_replies WHERE barber_id = $1`, barberID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAutoReplyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find auto-reply: %w", err)
+	}
+	return &reply, nil
+}
+
+// Upsert saves a barber's auto-reply
+func (r *AutoReplyRepository) Upsert(ctx context.Context, reply *models.BarberAutoReply) error {
+	query := `
+		INSERT INTO barber_auto_replies (barber_id, enabled, message)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (barber_id) DO UPDATE SET
+			enabled = EXCLUDED.enabled,
+			message = EXCLUDED.message,
+			updated_at = NOW()
+		RETURNING created_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query, reply.BarberID, reply.Enabled, reply.Message).
+		Scan(&reply.CreatedAt, &reply.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save auto-reply: %w", err)
+	}
+	return nil
+}
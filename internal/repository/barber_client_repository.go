@@ -0,0 +1,95 @@
+// internal/repository/barber_client_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// BARBER CLIENT REPOSITORY - Barbers' client lists
+// ========================================================================
+
+// BarberClientRepository handles barbers' client lists
+type BarberClientRepository struct {
+	db *sqlx.DB
+}
+
+// NewBarberClientRepository creates a new barber client repository
+func NewBarberClientRepository(db *sqlx.DB) *BarberClientRepository {
+	return &BarberClientRepository{db: db}
+}
+
+// FindByBarberID retrieves a page of a barber's clients by name, with the
+// total number of clients
+func (r *BarberClientRepository) FindByBarberID(ctx context.Context, barberID, limit, offset int) ([]models.BarberClient, int, error) {
+	var total int
+	if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM barber_clients WHERE barber_id = $1`, barberID); err != nil {
+		return nil, 0, fmt.Errorf("failed to count clients: %w", err)
+	}
+
+	query := `
+		SELECT * FROM barber_clients
+		WHERE barber_id = $1
+		ORDER BY name ASC, id ASC
+		LIMIT $2 OFFSET $3
+	`
+	clients := []models.BarberClient{}
+	if err := r.db.SelectContext(ctx, &clients, query, barberID, limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("failed to find clients: %w", err)
+	}
+	return clients, total, nil
+}
+
+// Exists reports whether the barber already lists a client with this
+// account, email or phone (nil values are not compared)
+func (r *BarberClientRepository) Exists(ctx context.Context, barberID int, userID *int, email, phone *string) (bool, error) {
+	query := `
+		SELECT EXISTS(
+			SELECT 1 FROM barber_clients
+			WHERE barber_id = $1 AND (user_id = $2 OR email = $3 OR phone = $4)
+		)
+	`
+
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, query, barberID, userID, email, phone); err != nil {
+		return false, fmt.Errorf("failed to check client: %w", err)
+	}
+	return exists, nil
+}
+
+// Create adds a client to a barber's list. A client already listed by
+// account, email or phone is ErrDuplicateClient.
+func (r *BarberClientRepository) Create(ctx context.Context, client *models.BarberClient) error {
+	query := `
+		INSERT INTO barber_clients (
+			barber_id, user_id, name, email, phone, notes,
+			email_consent, sms_consent, source, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT DO NOTHING
+		RETURNING id, created_at, updated_at
+	`
+
+	rows, err := r.db.QueryxContext(ctx, query,
+		client.BarberID, client.UserID, client.Name, client.Email, client.Phone, client.Notes,
+		client.EmailConsent, client.SMSConsent, client.Source, client.CreatedBy,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("failed to create client: %w", err)
+		}
+		return ErrDuplicateClient
+	}
+	if err := rows.Scan(&client.ID, &client.CreatedAt, &client.UpdatedAt); err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+	return nil
+}
@@ -4,12 +4,14 @@ package repository
 import (
 	"barber-booking-system/internal/config"
 	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/ranking"
 	"context"
 	"database/sql"
 	"fmt"
 	"time"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 // BarberRepository handles barber data operations
@@ -28,21 +30,22 @@ func NewBarberRepository(db *sqlx.DB) *BarberRepository {
 
 // FindAll retrieves all barbers with optional filters
 func (r *BarberRepository) FindAll(ctx context.Context, filters BarberFilters) ([]models.Barber, error) {
-	// Define sort column mappings
-	sortMap := map[string]string{
-		"rating":         "b.rating DESC",
-		"total_bookings": "b.total_bookings DESC",
-		"shop_name":      "b.shop_name ASC",
-		"user_name":      "u.name ASC",
-		"default":        "b.created_at DESC",
+	scorer := filters.Ranking
+	if scorer == nil {
+		scorer = ranking.Default()
 	}
 
 	// Build query using QueryBuilder
-	qb := BuildBarberQuery().
+	qb := BuildRankedBarberQuery(filters.CategoryID, filters.Latitude, filters.Longitude, scorer).
 		WhereIf(filters.Status != "", "b.status = ?", filters.Status).
 		WhereIf(filters.City != "", "LOWER(b.city) = LOWER(?)", filters.City).
 		WhereIf(filters.State != "", "LOWER(b.state) = LOWER(?)", filters.State).
-		WhereIf(filters.MinRating > 0, "b.rating >= ?", filters.MinRating)
+		WhereIf(filters.MinRating > 0, "b.rating >= ?", filters.MinRating).
+		WhereIf(filters.CategoryID > 0, `EXISTS (
+			SELECT 1 FROM barber_services bs
+			JOIN services s ON s.id = bs.service_id
+			WHERE bs.barber_id = b.id AND bs.is_active AND s.category_id = ?
+		)`, filters.CategoryID)
 
 	// Handle pointer fields safely
 	if filters.IsVerified != nil {
@@ -66,7 +69,7 @@ func (r *BarberRepository) FindAll(ctx context.Context, filters BarberFilters) (
 
 	// Add sorting and pagination
 	query, args := qb.
-		OrderByWithDefault(filters.SortBy, "default", sortMap).
+		OrderByColumns(filters.SortBy, "", BarberSortColumns, BarberSortColumns["rank"]).
 		Paginate(filters.Limit, filters.Offset).
 		Build()
 
@@ -80,6 +83,74 @@ func (r *BarberRepository) FindAll(ctx context.Context, filters BarberFilters) (
 	return barbers, nil
 }
 
+// FindNearby retrieves up to limit active barbers within radiusKm of
+// (lat, lng), nearest first, with their distance_km. Barbers without a
+// location are left out.
+func (r *BarberRepository) FindNearby(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]models.Barber, error) {
+	bounds := models.BoundingBox(lat, lng, radiusKm)
+	query := fmt.Sprintf(`
+		SELECT b.*, u.name as user_name, u.email as user_email, d.distance_km
+		FROM barbers b
+		LEFT JOIN users u ON b.user_id = u.id
+		CROSS JOIN LATERAL (
+			SELECT %.1f * 2 * ASIN(LEAST(1, SQRT(
+				POWER(SIN(RADIANS(b.latitude - $1::float8) / 2), 2) +
+				COS(RADIANS($1::float8)) * COS(RADIANS(b.latitude)) *
+				POWER(SIN(RADIANS(b.longitude - $2::float8) / 2), 2)
+			))) as distance_km
+		) d
+		WHERE b.deleted_at IS NULL
+		AND b.latitude IS NOT NULL AND b.longitude IS NOT NULL
+		AND b.latitude BETWEEN $3 AND $4
+		AND b.longitude BETWEEN $5 AND $6
+		AND b.status = $7
+		AND d.distance_km <= $8
+		ORDER BY d.distance_km, b.rating DESC, b.id
+		LIMIT $9
+	`, config.EarthRadiusKm)
+
+	barbers := []models.Barber{}
+	err := r.db.SelectContext(ctx, &barbers, query, lat, lng,
+		bounds.MinLatitude, bounds.MaxLatitude, bounds.MinLongitude, bounds.MaxLongitude,
+		config.BarberStatusActive, radiusKm, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch nearby barbers: %w", err)
+	}
+	return barbers, nil
+}
+
+// FindTopServices retrieves up to perBarber active services of each barber,
+// most booked first, keyed by barber ID
+func (r *BarberRepository) FindTopServices(ctx context.Context, barberIDs []int, perBarber int) (map[int][]models.BarberService, error) {
+	query := `
+		SELECT bs.*, s.name as service_name, s.service_type, s.category_id, s.allows_add_ons
+		FROM barber_services bs
+		LEFT JOIN services s ON bs.service_id = s.id
+		WHERE bs.id IN (
+			SELECT id FROM (
+				SELECT id, ROW_NUMBER() OVER (
+					PARTITION BY barber_id ORDER BY total_bookings DESC, display_order, id
+				) as position
+				FROM barber_services
+				WHERE barber_id = ANY($1) AND is_active
+			) ranked
+			WHERE position <= $2
+		)
+		ORDER BY bs.barber_id, bs.total_bookings DESC, bs.display_order, bs.id
+	`
+
+	var services []models.BarberService
+	if err := r.db.SelectContext(ctx, &services, query, pq.Array(barberIDs), perBarber); err != nil {
+		return nil, fmt.Errorf("failed to fetch top barber services: %w", err)
+	}
+
+	byBarber := make(map[int][]models.BarberService, len(barberIDs))
+	for _, service := range services {
+		byBarber[service.BarberID] = append(byBarber[service.BarberID], service)
+	}
+	return byBarber, nil
+}
+
 // FindByID retrieves a barber by ID
 func (r *BarberRepository) FindByID(ctx context.Context, id int) (*models.Barber, error) {
 	query := `
@@ -229,6 +300,7 @@ func (r *BarberRepository) Update(ctx context.Context, barber *models.Barber) er
 			min_booking_notice_hours = :min_booking_notice_hours,
 			auto_accept_bookings = :auto_accept_bookings,
 			instant_booking_enabled = :instant_booking_enabled,
+			no_show_grace_minutes = :no_show_grace_minutes,
 			payout_method = :payout_method,
 			payout_details = :payout_details,
 			updated_at = :updated_at
@@ -285,21 +357,37 @@ func (r *BarberRepository) UpdateLastActive(ctx context.Context, id int) error {
 	return CheckRowsAffected(result, ErrBarberNotFound)
 }
 
-// GetStatistics retrieves barber statistics
+// GetStatistics retrieves barber statistics, including archived bookings
 func (r *BarberRepository) GetStatistics(ctx context.Context, id int) (*BarberStatistics, error) {
 	query := `
-		SELECT 
-			COALESCE(COUNT(DISTINCT b.id), 0) as total_bookings,
-			COALESCE(COUNT(DISTINCT CASE WHEN b.status = 'completed' THEN b.id END), 0) as completed_bookings,
-			COALESCE(COUNT(DISTINCT CASE WHEN b.status IN ('cancelled_by_customer', 'cancelled_by_barber') THEN b.id END), 0) as cancelled_bookings,
-			COALESCE(COUNT(DISTINCT r.id), 0) as total_reviews,
-			COALESCE(AVG(r.overall_rating), 0) as average_rating,
-			COALESCE(SUM(CASE WHEN b.status = 'completed' THEN b.total_price ELSE 0 END), 0) as total_revenue
+		SELECT
+			live.total + COALESCE(ar.total, 0) as total_bookings,
+			live.completed + COALESCE(ar.completed, 0) as completed_bookings,
+			live.cancelled + COALESCE(ar.cancelled, 0) as cancelled_bookings,
+			rv.total as total_reviews,
+			rv.average as average_rating,
+			live.revenue + COALESCE(ar.revenue, 0) as total_revenue
 		FROM barbers bar
-		LEFT JOIN bookings b ON bar.id = b.barber_id
-		LEFT JOIN reviews r ON bar.id = r.barber_id AND r.is_published = true
+		CROSS JOIN LATERAL (
+			SELECT COUNT(*) AS total,
+				COUNT(*) FILTER (WHERE status = 'completed') AS completed,
+				COUNT(*) FILTER (WHERE status IN ('cancelled_by_customer', 'cancelled_by_barber')) AS cancelled,
+				COALESCE(SUM(total_price) FILTER (WHERE status = 'completed'), 0) AS revenue
+			FROM bookings
+			WHERE barber_id = bar.id AND NOT is_test
+		) live
+		CROSS JOIN LATERAL (
+			SELECT SUM(total) AS total, SUM(completed) AS completed,
+				SUM(cancelled_by_customer + cancelled_by_barber) AS cancelled, SUM(revenue) AS revenue
+			FROM booking_archive_stats
+			WHERE barber_id = bar.id
+		) ar
+		CROSS JOIN LATERAL (
+			SELECT COUNT(*) AS total, COALESCE(AVG(overall_rating), 0) AS average
+			FROM reviews
+			WHERE barber_id = bar.id AND is_published = true
+		) rv
 		WHERE bar.id = $1
-		GROUP BY bar.id
 	`
 
 	var stats BarberStatistics
@@ -313,16 +401,43 @@ func (r *BarberRepository) GetStatistics(ctx context.Context, id int) (*BarberSt
 
 // BarberFilters represents filter options for barbers
 type BarberFilters struct {
-	Status     string
-	Name       string
-	IsVerified *bool
-	City       string
-	State      string
-	MinRating  float64
-	Search     string
-	SortBy     string
-	Limit      int
-	Offset     int
+	Status     string  `form:"status"`
+	Name       string  `form:"-"`
+	IsVerified *bool   `form:"is_verified"`
+	City       string  `form:"city"`
+	State      string  `form:"state"`
+	MinRating  float64 `form:"min_rating"`
+	CategoryID int     `form:"category_id"` // Offers an active service in this category; also selects category featured placements
+	Search     string  `form:"search"`
+	SortBy     string  `form:"sort_by"`
+	Limit      int     `form:"limit,default=20"`
+	Offset     int     `form:"offset,default=0"`
+
+	// Ranking for the default sort; Latitude/Longitude enable the distance factor
+	Latitude  *float64        `form:"-"`
+	Longitude *float64        `form:"-"`
+	Ranking   *ranking.Scorer `form:"-"` // nil = ranking.Default()
+	Explain   bool            `form:"-"` // Attach per-factor score contributions to each result (service only)
+}
+
+// featuredFirst puts barbers with a featured placement running today
+// ahead of the others on explicit sorts
+const featuredFirst = "is_featured DESC"
+
+// BarberSortColumns are what FindAll sorts barbers by. The default ranks
+// by weighted score (featured status is one of the factors).
+var BarberSortColumns = SortColumns{
+	"rank":           {Expr: "rank_score", Then: "b.id ASC"},
+	"rating":         {Expr: "b.rating", Before: featuredFirst},
+	"total_bookings": {Expr: "b.total_bookings", Before: featuredFirst},
+	"shop_name":      {Expr: "b.shop_name", Order: "ASC", Before: featuredFirst},
+	"user_name":      {Expr: "u.name", Order: "ASC", Before: featuredFirst},
+	"newest":         {Expr: "b.created_at", Before: featuredFirst},
+}
+
+// ListParams implements ListFilters; each sort has a fixed direction
+func (f *BarberFilters) ListParams() ListParams {
+	return ListParams{SortBy: &f.SortBy, Limit: &f.Limit, Offset: &f.Offset, Sorts: BarberSortColumns}
 }
 
 // BarberStatistics represents barber statistics
@@ -335,38 +450,191 @@ type BarberStatistics struct {
 	TotalRevenue      float64 `db:"total_revenue" json:"total_revenue"`
 }
 
-// ========================================================================
-// TRANSACTION SUPPORT
-// ========================================================================
-
-// UpdateRatingStatsTx recalculates and updates barber rating stats within a transaction
-// This should be called after creating/updating/deleting a review
-func (r *BarberRepository) UpdateRatingStatsTx(ctx context.Context, tx *sqlx.Tx, barberID int) error {
-	// Calculate new rating stats from all published/approved reviews
+// RefreshBookingStats recalculates the barber's completed booking counter
+// (archived bookings included)
+func (r *BarberRepository) RefreshBookingStats(ctx context.Context, barberID int) error {
 	query := `
 		UPDATE barbers SET
-			rating = COALESCE((
-				SELECT AVG(overall_rating)::NUMERIC(3,2)
-				FROM reviews
-				WHERE barber_id = $1
-				AND is_published = true
-				AND moderation_status = 'approved'
-			), 0),
-			total_reviews = (
+			total_bookings = (
 				SELECT COUNT(*)
-				FROM reviews
+				FROM bookings
+				WHERE barber_id = $1
+				AND status = 'completed'
+				AND NOT is_test
+			) + (
+				SELECT COALESCE(SUM(completed), 0)
+				FROM booking_archive_stats
 				WHERE barber_id = $1
-				AND is_published = true
-				AND moderation_status = 'approved'
 			),
 			updated_at = $2
 		WHERE id = $1
 	`
 
-	result, err := tx.ExecContext(ctx, query, barberID, time.Now())
+	result, err := r.db.ExecContext(ctx, query, barberID, time.Now())
 	if err != nil {
-		return fmt.Errorf("failed to update barber rating stats: %w", err)
+		return fmt.Errorf("failed to refresh barber booking stats: %w", err)
 	}
 
 	return CheckRowsAffected(result, ErrBarberNotFound)
 }
+
+// RefreshAllStats recalculates every barber's completed booking counter and
+// cancellation rate (the percentage of decided bookings the barber
+// cancelled). Archived bookings count through their rolled-up counters.
+// Returns the number of barbers updated.
+func (r *BarberRepository) RefreshAllStats(ctx context.Context, now time.Time) (int, error) {
+	query := `
+		UPDATE barbers bar SET
+			total_bookings = s.completed,
+			cancellation_rate = CASE WHEN s.decided > 0
+				THEN ROUND(100.0 * s.cancelled_by_barber / s.decided, 2) ELSE 0 END,
+			updated_at = $1
+		FROM (
+			SELECT barber_id,
+				SUM(completed) AS completed,
+				SUM(cancelled_by_barber) AS cancelled_by_barber,
+				SUM(decided) AS decided
+			FROM (
+				SELECT barber_id,
+					COUNT(*) FILTER (WHERE status = 'completed') AS completed,
+					COUNT(*) FILTER (WHERE status = 'cancelled_by_barber') AS cancelled_by_barber,
+					COUNT(*) FILTER (WHERE status <> 'pending') AS decided
+				FROM bookings
+				WHERE NOT is_test
+				GROUP BY barber_id
+				UNION ALL
+				SELECT barber_id, completed, cancelled_by_barber, decided
+				FROM booking_archive_stats
+			) parts
+			GROUP BY barber_id
+		) s
+		WHERE bar.id = s.barber_id AND bar.deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to refresh barber stats: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return int(rows), nil
+}
+
+// ========================================================================
+// TRANSACTION SUPPORT
+// ========================================================================
+
+// Rating stats (barbers.rating/total_reviews and barber_services.
+// average_rating/total_reviews) count published, approved reviews. A
+// review counts towards the barber service of its booking.
+const (
+	barberRatingStatsQuery = `
+		UPDATE barbers bar SET
+			rating = s.rating,
+			total_reviews = s.reviews,
+			updated_at = $1
+		FROM (
+			SELECT b.id,
+				COALESCE(AVG(rv.overall_rating)::NUMERIC(3,2), 0) AS rating,
+				COUNT(rv.id) AS reviews
+			FROM barbers b
+			LEFT JOIN reviews rv ON rv.barber_id = b.id
+				AND rv.is_published = true
+				AND rv.moderation_status = 'approved'
+			WHERE %s
+			GROUP BY b.id
+		) s
+		WHERE bar.id = s.id %s
+	`
+	serviceRatingStatsQuery = `
+		UPDATE barber_services bs SET
+			average_rating = s.rating,
+			total_reviews = s.reviews,
+			updated_at = $1
+		FROM (
+			SELECT sv.id,
+				COALESCE(AVG(rv.overall_rating)::NUMERIC(3,2), 0) AS rating,
+				COUNT(rv.id) AS reviews
+			FROM barber_services sv
+			LEFT JOIN bookings bk ON bk.barber_service_id = sv.id
+			LEFT JOIN reviews rv ON rv.booking_id = bk.id
+				AND rv.is_published = true
+				AND rv.moderation_status = 'approved'
+			WHERE %s
+			GROUP BY sv.id
+		) s
+		WHERE bs.id = s.id %s
+	`
+
+	// The drift conditions limit a reconciliation to rows whose stats are off
+	barberRatingDrift  = "AND (bar.rating IS DISTINCT FROM s.rating OR bar.total_reviews IS DISTINCT FROM s.reviews)"
+	serviceRatingDrift = "AND (bs.average_rating IS DISTINCT FROM s.rating OR bs.total_reviews IS DISTINCT FROM s.reviews)"
+)
+
+// UpdateRatingStatsTx recalculates the rating stats of a barber and their
+// services within a transaction
+// This should be called after a review is created, moderated or deleted
+func (r *BarberRepository) UpdateRatingStatsTx(ctx context.Context, tx *sqlx.Tx, barberID int) error {
+	return updateRatingStats(ctx, tx, barberID)
+}
+
+// UpdateRatingStats recalculates the rating stats of a barber and their
+// services in a transaction of its own
+func (r *BarberRepository) UpdateRatingStats(ctx context.Context, barberID int) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := updateRatingStats(ctx, tx, barberID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// updateRatingStats recalculates one barber's rating stats
+func updateRatingStats(ctx context.Context, exec sqlx.ExecerContext, barberID int) error {
+	now := time.Now()
+
+	result, err := exec.ExecContext(ctx, fmt.Sprintf(barberRatingStatsQuery, "b.id = $2", ""), now, barberID)
+	if err != nil {
+		return fmt.Errorf("failed to update barber rating stats: %w", err)
+	}
+	if err := CheckRowsAffected(result, ErrBarberNotFound); err != nil {
+		return err
+	}
+
+	if _, err := exec.ExecContext(ctx, fmt.Sprintf(serviceRatingStatsQuery, "sv.barber_id = $2", ""), now, barberID); err != nil {
+		return fmt.Errorf("failed to update barber service rating stats: %w", err)
+	}
+	return nil
+}
+
+// ReconcileRatingStats recalculates every barber's and barber service's
+// rating stats, returning how many of each were off and got corrected
+func (r *BarberRepository) ReconcileRatingStats(ctx context.Context, now time.Time) (int, int, error) {
+	result, err := r.db.ExecContext(ctx, fmt.Sprintf(barberRatingStatsQuery, "b.deleted_at IS NULL", barberRatingDrift), now)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to reconcile barber rating stats: %w", err)
+	}
+	barbers, err := result.RowsAffected()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	result, err = r.db.ExecContext(ctx, fmt.Sprintf(serviceRatingStatsQuery, "true", serviceRatingDrift), now)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to reconcile barber service rating stats: %w", err)
+	}
+	services, err := result.RowsAffected()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return int(barbers), int(services), nil
+}
@@ -0,0 +1,182 @@
+// internal/repository/barber_schedule_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// BARBER SCHEDULE REPOSITORY - Data Access Layer for Working Hours
+// ========================================================================
+
+// BarberScheduleRepository handles barber schedule data operations
+type BarberScheduleRepository struct {
+	db *sqlx.DB
+}
+
+// NewBarberScheduleRepository creates a new barber schedule repository
+func NewBarberScheduleRepository(db *sqlx.DB) *BarberScheduleRepository {
+	return &BarberScheduleRepository{db: db}
+}
+
+// ========================================================================
+// READ OPERATIONS
+// ========================================================================
+
+// FindByBarberID retrieves a barber's schedule with its weekly periods and
+// exceptions. Returns ErrBarberScheduleNotFound if no schedule is defined.
+func (r *BarberScheduleRepository) FindByBarberID(ctx context.Context, barberID int) (*models.BarberSchedule, error) {
+	var schedule models.BarberSchedule
+	err := r.db.GetContext(ctx, &schedule, `
+		SELECT barber_id, timezone, created_at, updated_at
+		FROM barber_schedules
+		WHERE barber_id = $1
+	`, barberID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrBarberScheduleNotFound
+		}
+		return nil, fmt.Errorf("failed to find barber schedule: %w", err)
+	}
+
+	periods := []models.SchedulePeriod{}
+	err = r.db.SelectContext(ctx, &periods, `
+		SELECT id, barber_id, day_of_week, start_time, end_time, period_type, label
+		FROM barber_schedule_periods
+		WHERE barber_id = $1
+		ORDER BY day_of_week ASC, start_time ASC
+	`, barberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find schedule periods: %w", err)
+	}
+	schedule.Periods = periods
+
+	exceptions, err := r.FindExceptions(ctx, barberID, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	schedule.Exceptions = exceptions
+
+	return &schedule, nil
+}
+
+// FindExceptions retrieves a barber's schedule exceptions, optionally limited
+// to those overlapping the [from, to] date range
+func (r *BarberScheduleRepository) FindExceptions(ctx context.Context, barberID int, from, to *time.Time) ([]models.ScheduleException, error) {
+	query := `
+		SELECT id, barber_id, start_date, end_date, exception_type,
+		       start_time, end_time, reason, created_at
+		FROM barber_schedule_exceptions
+		WHERE barber_id = $1
+	`
+	args := []interface{}{barberID}
+	argCount := 2
+
+	if from != nil {
+		query += fmt.Sprintf(" AND end_date >= $%d", argCount)
+		args = append(args, *from)
+		argCount++
+	}
+	if to != nil {
+		query += fmt.Sprintf(" AND start_date <= $%d", argCount)
+		args = append(args, *to)
+	}
+	query += " ORDER BY start_date ASC"
+
+	exceptions := []models.ScheduleException{}
+	if err := r.db.SelectContext(ctx, &exceptions, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to find schedule exceptions: %w", err)
+	}
+	return exceptions, nil
+}
+
+// ========================================================================
+// WRITE OPERATIONS
+// ========================================================================
+
+// ReplaceWeekly creates or updates the schedule header and replaces all of
+// its weekly periods in a single transaction
+func (r *BarberScheduleRepository) ReplaceWeekly(ctx context.Context, barberID int, timezone string, periods []models.SchedulePeriod) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO barber_schedules (barber_id, timezone, created_at, updated_at)
+		VALUES ($1, $2, $3, $3)
+		ON CONFLICT (barber_id) DO UPDATE SET timezone = EXCLUDED.timezone, updated_at = EXCLUDED.updated_at
+	`, barberID, timezone, now)
+	if err != nil {
+		return fmt.Errorf("failed to save barber schedule: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM barber_schedule_periods WHERE barber_id = $1`, barberID); err != nil {
+		return fmt.Errorf("failed to clear schedule periods: %w", err)
+	}
+
+	for i := range periods {
+		p := &periods[i]
+		p.BarberID = barberID
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO barber_schedule_periods (barber_id, day_of_week, start_time, end_time, period_type, label)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id
+		`, barberID, p.DayOfWeek, p.StartTime, p.EndTime, p.PeriodType, p.Label).Scan(&p.ID)
+		if err != nil {
+			return fmt.Errorf("failed to create schedule period: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit schedule: %w", err)
+	}
+	return nil
+}
+
+// CreateException adds a date-range exception. The barber must already have
+// a schedule; returns ErrBarberScheduleNotFound otherwise.
+func (r *BarberScheduleRepository) CreateException(ctx context.Context, exception *models.ScheduleException) error {
+	query := `
+		INSERT INTO barber_schedule_exceptions (
+			barber_id, start_date, end_date, exception_type,
+			start_time, end_time, reason, created_at
+		)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8
+		WHERE EXISTS (SELECT 1 FROM barber_schedules WHERE barber_id = $1)
+		RETURNING id
+	`
+
+	exception.CreatedAt = time.Now()
+	err := r.db.QueryRowContext(ctx, query,
+		exception.BarberID, exception.StartDate, exception.EndDate, exception.ExceptionType,
+		exception.StartTime, exception.EndTime, exception.Reason, exception.CreatedAt,
+	).Scan(&exception.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrBarberScheduleNotFound
+		}
+		return fmt.Errorf("failed to create schedule exception: %w", err)
+	}
+	return nil
+}
+
+// DeleteException removes one of a barber's schedule exceptions
+func (r *BarberScheduleRepository) DeleteException(ctx context.Context, barberID, exceptionID int) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM barber_schedule_exceptions WHERE id = $1 AND barber_id = $2`,
+		exceptionID, barberID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule exception: %w", err)
+	}
+	return CheckRowsAffected(result, ErrScheduleExceptionNotFound)
+}
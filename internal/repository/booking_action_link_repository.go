@@ -0,0 +1,70 @@
+// internal/repository/booking_action_link_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// BOOKING ACTION LINK REPOSITORY - Single-use reminder links
+// ========================================================================
+
+// BookingActionLinkRepository handles the records behind reminder links
+type BookingActionLinkRepository struct {
+	db *sqlx.DB
+}
+
+// NewBookingActionLinkRepository creates a new booking action link repository
+func NewBookingActionLinkRepository(db *sqlx.DB) *BookingActionLinkRepository {
+	return &BookingActionLinkRepository{db: db}
+}
+
+// Create inserts a new link
+func (r *BookingActionLinkRepository) Create(ctx context.Context, link *models.BookingActionLink) error {
+	query := `
+		INSERT INTO booking_action_links (id, booking_id, customer_id, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING created_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query, link.ID, link.BookingID, link.CustomerID, link.ExpiresAt).Scan(&link.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create action link: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a link by its ID
+func (r *BookingActionLinkRepository) FindByID(ctx context.Context, id string) (*models.BookingActionLink, error) {
+	var link models.BookingActionLink
+	err := r.db.GetContext(ctx, &link, `SELECT * FROM booking_action_links WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrActionLinkNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find action link: %w", err)
+	}
+	return &link, nil
+}
+
+// MarkUsed records that an unused, unexpired link was used. Only the first
+// use succeeds; later ones get ErrActionLinkUsed.
+func (r *BookingActionLinkRepository) MarkUsed(ctx context.Context, id, action string, ip, userAgent *string, now time.Time) error {
+	query := `
+		UPDATE booking_action_links SET used_at = $2, used_action = $3, used_ip = $4, used_user_agent = $5
+		WHERE id = $1 AND used_at IS NULL AND expires_at > $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, now, action, ip, userAgent)
+	if err != nil {
+		return fmt.Errorf("failed to record action link use: %w", err)
+	}
+	return CheckRowsAffected(result, ErrActionLinkUsed)
+}
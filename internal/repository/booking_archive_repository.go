@@ -0,0 +1,131 @@
+// internal/repository/booking_archive_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ========================================================================
+// BOOKING ARCHIVE REPOSITORY - Cold storage for old bookings
+// ========================================================================
+
+// BookingArchiveRepository moves old bookings to bookings_archive and reads
+// them back
+type BookingArchiveRepository struct {
+	db *sqlx.DB
+}
+
+// NewBookingArchiveRepository creates a new booking archive repository
+func NewBookingArchiveRepository(db *sqlx.DB) *BookingArchiveRepository {
+	return &BookingArchiveRepository{db: db}
+}
+
+// ArchiveBatch moves up to limit bookings in one of statuses that ended
+// before cutoff, and have no payment still held, to the archive. Their
+// history, line items, tax lines and staff go with them, and their counts
+// are added to booking_archive_stats. Bookings locked by another
+// transaction are skipped. Returns the number of bookings moved.
+func (r *BookingArchiveRepository) ArchiveBatch(ctx context.Context, statuses []string, cutoff time.Time, limit int, now time.Time) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var ids []int64
+	err = tx.SelectContext(ctx, &ids, `
+		SELECT id FROM bookings
+		WHERE status = ANY($1) AND scheduled_end_time < $2 AND payment_status <> 'authorized'
+		ORDER BY scheduled_end_time
+		LIMIT $3
+		FOR UPDATE SKIP LOCKED
+	`, pq.Array(statuses), cutoff, limit)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find bookings to archive: %w", err)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO booking_archive_stats (
+			barber_id, barber_service_id, total, completed, cancelled,
+			cancelled_by_customer, cancelled_by_barber, decided, revenue
+		)
+		SELECT barber_id, COALESCE(barber_service_id, 0),
+			COUNT(*),
+			COUNT(*) FILTER (WHERE status = 'completed'),
+			COUNT(*) FILTER (WHERE status = 'cancelled'),
+			COUNT(*) FILTER (WHERE status = 'cancelled_by_customer'),
+			COUNT(*) FILTER (WHERE status = 'cancelled_by_barber'),
+			COUNT(*) FILTER (WHERE status <> 'pending'),
+			COALESCE(SUM(total_price) FILTER (WHERE status = 'completed'), 0)
+		FROM bookings
+		WHERE id = ANY($1) AND NOT is_test
+		GROUP BY barber_id, COALESCE(barber_service_id, 0)
+		ON CONFLICT (barber_id, barber_service_id) DO UPDATE SET
+			total = booking_archive_stats.total + EXCLUDED.total,
+			completed = booking_archive_stats.completed + EXCLUDED.completed,
+			cancelled = booking_archive_stats.cancelled + EXCLUDED.cancelled,
+			cancelled_by_customer = booking_archive_stats.cancelled_by_customer + EXCLUDED.cancelled_by_customer,
+			cancelled_by_barber = booking_archive_stats.cancelled_by_barber + EXCLUDED.cancelled_by_barber,
+			decided = booking_archive_stats.decided + EXCLUDED.decided,
+			revenue = booking_archive_stats.revenue + EXCLUDED.revenue
+	`, pq.Array(ids))
+	if err != nil {
+		return 0, fmt.Errorf("failed to update archived booking stats: %w", err)
+	}
+
+	// Copied by column name, so the archive's column order does not matter
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO bookings_archive
+		SELECT (jsonb_populate_record(NULL::bookings_archive, to_jsonb(b) || jsonb_build_object(
+			'archived_at', $2::timestamptz,
+			'related', jsonb_build_object(
+				'history', (SELECT COALESCE(jsonb_agg(to_jsonb(h) ORDER BY h.id), '[]')
+					FROM booking_history h WHERE h.booking_id = b.id),
+				'line_items', (SELECT COALESCE(jsonb_agg(to_jsonb(li) ORDER BY li.position, li.id), '[]')
+					FROM booking_line_items li WHERE li.booking_id = b.id),
+				'tax_lines', (SELECT COALESCE(jsonb_agg(to_jsonb(t) ORDER BY t.id), '[]')
+					FROM booking_tax_lines t WHERE t.booking_id = b.id),
+				'staff', (SELECT COALESCE(jsonb_agg(to_jsonb(s) ORDER BY s.barber_id), '[]')
+					FROM booking_staff s WHERE s.booking_id = b.id)
+			)
+		))).*
+		FROM bookings b
+		WHERE b.id = ANY($1)
+	`, pq.Array(ids), now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to archive bookings: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM bookings WHERE id = ANY($1)`, pq.Array(ids)); err != nil {
+		return 0, fmt.Errorf("failed to delete archived bookings: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return len(ids), nil
+}
+
+// FindByBookingNumber retrieves an archived booking by its booking number
+func (r *BookingArchiveRepository) FindByBookingNumber(ctx context.Context, bookingNumber string) (*models.ArchivedBooking, error) {
+	var booking models.ArchivedBooking
+	err := r.db.GetContext(ctx, &booking, `SELECT * FROM bookings_archive WHERE booking_number = $1`, bookingNumber)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrBookingNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find archived booking: %w", err)
+	}
+	return &booking, nil
+}
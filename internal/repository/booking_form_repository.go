@@ -0,0 +1,128 @@
+// internal/repository/booking_form_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// BOOKING FORM REPOSITORY - Barbers' custom booking-form fields
+// ========================================================================
+
+// BookingFormRepository handles custom booking-form fields
+type BookingFormRepository struct {
+	db *sqlx.DB
+}
+
+// NewBookingFormRepository creates a new booking form repository
+func NewBookingFormRepository(db *sqlx.DB) *BookingFormRepository {
+	return &BookingFormRepository{db: db}
+}
+
+// FindByID retrieves a field by its ID
+func (r *BookingFormRepository) FindByID(ctx context.Context, id int) (*models.BookingFormField, error) {
+	var field models.BookingFormField
+	err := r.db.GetContext(ctx, &field, `SELECT * FROM booking_form_fields WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrFormFieldNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find booking form field: %w", err)
+	}
+	return &field, nil
+}
+
+// FindByBarberID retrieves a barber's fields in display order, optionally
+// only the active ones
+func (r *BookingFormRepository) FindByBarberID(ctx context.Context, barberID int, activeOnly bool) ([]models.BookingFormField, error) {
+	query := `SELECT * FROM booking_form_fields WHERE barber_id = $1`
+	if activeOnly {
+		query += ` AND is_active = TRUE`
+	}
+	query += ` ORDER BY display_order ASC, id ASC`
+
+	fields := []models.BookingFormField{}
+	if err := r.db.SelectContext(ctx, &fields, query, barberID); err != nil {
+		return nil, fmt.Errorf("failed to find booking form fields: %w", err)
+	}
+	return fields, nil
+}
+
+// CountByBarberID counts a barber's fields
+func (r *BookingFormRepository) CountByBarberID(ctx context.Context, barberID int) (int, error) {
+	var count int
+	err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM booking_form_fields WHERE barber_id = $1`, barberID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count booking form fields: %w", err)
+	}
+	return count, nil
+}
+
+// Create inserts a new field
+func (r *BookingFormRepository) Create(ctx context.Context, field *models.BookingFormField) error {
+	query := `
+		INSERT INTO booking_form_fields (
+			barber_id, field_key, label, field_type, help_text, is_required, options,
+			max_length, min_value, max_value, pattern, is_active, display_order,
+			barber_service_id, consultation_answers
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		field.BarberID, field.Key, field.Label, field.FieldType, field.HelpText, field.IsRequired, field.Options,
+		field.MaxLength, field.MinValue, field.MaxValue, field.Pattern, field.IsActive, field.DisplayOrder,
+		field.BarberServiceID, field.ConsultationAnswers,
+	).Scan(&field.ID, &field.CreatedAt, &field.UpdatedAt)
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrDuplicateFormField
+		}
+		return fmt.Errorf("failed to create booking form field: %w", err)
+	}
+	return nil
+}
+
+// Update saves a field
+func (r *BookingFormRepository) Update(ctx context.Context, field *models.BookingFormField) error {
+	query := `
+		UPDATE booking_form_fields SET
+			field_key = $2, label = $3, field_type = $4, help_text = $5, is_required = $6, options = $7,
+			max_length = $8, min_value = $9, max_value = $10, pattern = $11, is_active = $12, display_order = $13,
+			barber_service_id = $14, consultation_answers = $15,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		field.ID, field.Key, field.Label, field.FieldType, field.HelpText, field.IsRequired, field.Options,
+		field.MaxLength, field.MinValue, field.MaxValue, field.Pattern, field.IsActive, field.DisplayOrder,
+		field.BarberServiceID, field.ConsultationAnswers,
+	).Scan(&field.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrFormFieldNotFound
+	}
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrDuplicateFormField
+		}
+		return fmt.Errorf("failed to update booking form field: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a field. Bookings keep their answers to it.
+func (r *BookingFormRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM booking_form_fields WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete booking form field: %w", err)
+	}
+	return CheckRowsAffected(result, ErrFormFieldNotFound)
+}
@@ -4,6 +4,8 @@ package repository
 import (
 	"barber-booking-system/internal/config"
 	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/sandbox"
+	"barber-booking-system/internal/statemachine"
 	"context"
 	"database/sql"
 	"fmt"
@@ -39,6 +41,7 @@ func NewBookingRepository(db *sqlx.DB) *BookingRepository {
 type BookingFilters struct {
 	CustomerID    int       `form:"customer_id"`
 	BarberID      int       `form:"barber_id"`
+	ShopID        int       `form:"-"` // Bookings with any of the shop's barbers
 	Status        string    `form:"status"`
 	Statuses      []string  `form:"statuses"`
 	PaymentStatus string    `form:"payment_status"`
@@ -53,8 +56,39 @@ type BookingFilters struct {
 	Limit         int       `form:"limit,default=50"`
 	Offset        int       `form:"offset,default=0"`
 
+	// Relations attached to list responses (see RelationLoader)
 	IncludeCustomer bool `form:"include_customer"`
 	IncludeBarber   bool `form:"include_barber"`
+	IncludeService  bool `form:"include_service"`
+}
+
+// Include returns the relations the filters ask to attach
+func (f BookingFilters) Include() Include {
+	return Include{Customer: f.IncludeCustomer, Barber: f.IncludeBarber, Service: f.IncludeService}
+}
+
+// BookingSortColumns are what FindAll sorts bookings by, newest first
+// without a sort
+var BookingSortColumns = SortColumns{
+	"scheduled_start_time": {Expr: "scheduled_start_time"},
+	"total_price":          {Expr: "total_price"},
+	"created_at":           {Expr: "created_at"},
+	"status":               {Expr: "status", Then: "created_at DESC"},
+	"payment_status":       {Expr: "payment_status", Then: "created_at DESC"},
+	"customer_name":        {Expr: "LOWER(customer_name)", NullsLast: true, Then: "created_at DESC"},
+	"booking_number":       {Expr: "booking_number"},
+}
+
+// ListParams implements ListFilters
+func (f *BookingFilters) ListParams() ListParams {
+	return ListParams{
+		SortBy: &f.SortBy, Order: &f.Order, Limit: &f.Limit, Offset: &f.Offset,
+		Sorts: BookingSortColumns,
+		Ranges: []DateRange{
+			{Name: "start_date", From: f.StartDateFrom, To: f.StartDateTo},
+			{Name: "created", From: f.CreatedFrom, To: f.CreatedTo},
+		},
+	}
 }
 
 // BookingHistoryFilters for audit trail queries
@@ -76,6 +110,7 @@ var (
 	ErrInvalidStatusChange     = fmt.Errorf("invalid status transition")
 	ErrBookingAlreadyCancelled = fmt.Errorf("booking is already cancelled")
 	ErrCancellationNotAllowed  = fmt.Errorf("booking cannot be cancelled")
+	ErrBookingStatusChanged    = fmt.Errorf("booking status changed since it was read")
 )
 
 // ========================================================================
@@ -102,30 +137,12 @@ var ValidPaymentStatuses = []string{
 	config.PaymentStatusFailed,
 }
 
-// ValidStatusTransitions defines allowed status changes
-// Key: current status, Value: allowed next statuses
-var ValidStatusTransitions = map[string][]string{
-	config.BookingStatusPending:             {config.BookingStatusConfirmed, config.BookingStatusCancelledByCustomer, config.BookingStatusCancelledByBarber},
-	config.BookingStatusConfirmed:           {config.BookingStatusInProgress, config.BookingStatusCancelledByCustomer, config.BookingStatusCancelledByBarber, config.BookingStatusNoShow},
-	config.BookingStatusInProgress:          {config.BookingStatusCompleted},
-	config.BookingStatusCompleted:           {}, // Terminal state
-	config.BookingStatusCancelledByCustomer: {}, // Terminal state
-	config.BookingStatusCancelledByBarber:   {}, // Terminal state
-	config.BookingStatusNoShow:              {}, // Terminal state
-}
+// bookingTransitions holds the booking status rules (see internal/statemachine)
+var bookingTransitions = statemachine.NewBooking[*models.Booking]()
 
 // IsValidStatusTransition checks if a status change is allowed
 func IsValidStatusTransition(currentStatus, newStatus string) bool {
-	allowedStatuses, exists := ValidStatusTransitions[currentStatus]
-	if !exists {
-		return false
-	}
-	for _, status := range allowedStatuses {
-		if status == newStatus {
-			return true
-		}
-	}
-	return false
+	return bookingTransitions.Can(currentStatus, newStatus)
 }
 
 // ========================================================================
@@ -140,21 +157,21 @@ func (r *BookingRepository) Create(ctx context.Context, booking *models.Booking)
 			service_name, service_category, estimated_duration_minutes,
 			customer_name, customer_email, customer_phone,
 			status, service_price, total_price, discount_amount, tax_amount, tip_amount, currency,
-			payment_status, payment_method, payment_reference,
+			payment_status, payment_method, payment_reference, deposit_amount, no_show_fee,
 			notes, special_requests, internal_notes,
 			scheduled_start_time, scheduled_end_time,
 			booking_source, referral_source, utm_campaign,
-			created_at, updated_at
+			created_at, updated_at, series_id, is_test, duration_segments, location_id, barber_service_id
 		) VALUES (
 			:uuid, :booking_number, :customer_id, :barber_id, :time_slot_id,
 			:service_name, :service_category, :estimated_duration_minutes,
 			:customer_name, :customer_email, :customer_phone,
 			:status, :service_price, :total_price, :discount_amount, :tax_amount, :tip_amount, :currency,
-			:payment_status, :payment_method, :payment_reference,
+			:payment_status, :payment_method, :payment_reference, :deposit_amount, :no_show_fee,
 			:notes, :special_requests, :internal_notes,
 			:scheduled_start_time, :scheduled_end_time,
 			:booking_source, :referral_source, :utm_campaign,
-			:created_at, :updated_at
+			:created_at, :updated_at, :series_id, :is_test, :duration_segments, :location_id, :barber_service_id
 		) RETURNING id
 	`
 
@@ -169,6 +186,9 @@ func (r *BookingRepository) Create(ctx context.Context, booking *models.Booking)
 
 	rows, err := r.db.NamedQueryContext(ctx, query, booking)
 	if err != nil {
+		if IsExclusionViolation(err) {
+			return ErrBookingConflict
+		}
 		return fmt.Errorf("failed to create booking: %w", err)
 	}
 	defer rows.Close()
@@ -249,109 +269,12 @@ func (r *BookingRepository) FindByBookingNumber(ctx context.Context, bookingNumb
 
 // FindAll retrieves bookings with optional filters
 func (r *BookingRepository) FindAll(ctx context.Context, filters BookingFilters) ([]models.Booking, error) {
-	// Base query
-	query := `SELECT * FROM bookings WHERE 1=1`
-	args := []interface{}{}
-	argCount := 1
-
-	// Customer filter
-	if filters.CustomerID > 0 {
-		query += fmt.Sprintf(" AND customer_id = $%d", argCount)
-		args = append(args, filters.CustomerID)
-		argCount++
-	}
-
-	// Barber filter
-	if filters.BarberID > 0 {
-		query += fmt.Sprintf(" AND barber_id = $%d", argCount)
-		args = append(args, filters.BarberID)
-		argCount++
-	}
-
-	// Single status filter
-	if filters.Status != "" {
-		query += fmt.Sprintf(" AND status = $%d", argCount)
-		args = append(args, filters.Status)
-		argCount++
-	}
-
-	// Multiple statuses filter (OR condition)
-	if len(filters.Statuses) > 0 {
-		placeholders := make([]string, len(filters.Statuses))
-		for i, status := range filters.Statuses {
-			placeholders[i] = fmt.Sprintf("$%d", argCount)
-			args = append(args, status)
-			argCount++
-		}
-		query += fmt.Sprintf(" AND status IN (%s)", strings.Join(placeholders, ", "))
-	}
-
-	// Payment status filter
-	if filters.PaymentStatus != "" {
-		query += fmt.Sprintf(" AND payment_status = $%d", argCount)
-		args = append(args, filters.PaymentStatus)
-		argCount++
-	}
-
-	// Date range - scheduled start time
-	if !filters.StartDateFrom.IsZero() {
-		query += fmt.Sprintf(" AND scheduled_start_time >= $%d", argCount)
-		args = append(args, filters.StartDateFrom)
-		argCount++
-	}
-	if !filters.StartDateTo.IsZero() {
-		query += fmt.Sprintf(" AND scheduled_start_time <= $%d", argCount)
-		args = append(args, filters.StartDateTo)
-		argCount++
-	}
-
-	// Date range - created at
-	if !filters.CreatedFrom.IsZero() {
-		query += fmt.Sprintf(" AND created_at >= $%d", argCount)
-		args = append(args, filters.CreatedFrom)
-		argCount++
-	}
-	if !filters.CreatedTo.IsZero() {
-		query += fmt.Sprintf(" AND created_at <= $%d", argCount)
-		args = append(args, filters.CreatedTo)
-		argCount++
-	}
-
-	// Search filter
-	if filters.Search != "" {
-		query += fmt.Sprintf(" AND (booking_number ILIKE $%d OR customer_name ILIKE $%d OR customer_email ILIKE $%d)",
-			argCount, argCount+1, argCount+2)
-		searchPattern := "%" + filters.Search + "%"
-		args = append(args, searchPattern, searchPattern, searchPattern)
-		argCount += 3
-	}
-
-	// Booking source filter
-	if filters.BookingSource != "" {
-		query += fmt.Sprintf(" AND booking_source = $%d", argCount)
-		args = append(args, filters.BookingSource)
-		argCount++
-	}
+	where, args := bookingWhere(ctx, filters, "")
+	query := `SELECT * FROM bookings` + where
+	argCount := len(args) + 1
 
 	// Sorting
-	orderBy := "created_at DESC" // Default sort
-	if filters.SortBy != "" {
-		order := "DESC"
-		if filters.Order != "" && (filters.Order == "ASC" || filters.Order == "asc") {
-			order = "ASC"
-		}
-		switch filters.SortBy {
-		case "scheduled_start_time":
-			orderBy = fmt.Sprintf("scheduled_start_time %s", order)
-		case "total_price":
-			orderBy = fmt.Sprintf("total_price %s", order)
-		case "created_at":
-			orderBy = fmt.Sprintf("created_at %s", order)
-		case "status":
-			orderBy = fmt.Sprintf("status %s", order)
-		}
-	}
-	query += " ORDER BY " + orderBy
+	query += " ORDER BY " + BookingSortColumns.OrderBy(filters.SortBy, filters.Order, BookingSortColumns["created_at"])
 
 	// Pagination
 	limit := 50 // Default limit
@@ -375,61 +298,36 @@ func (r *BookingRepository) FindAll(ctx context.Context, filters BookingFilters)
 	return bookings, nil
 }
 
-// ========================================================================
-// READ OPERATIONS - FindAll with Relations (prevents N+1 queries)
-// ========================================================================
-
-// BookingWithRelations represents a booking with optional loaded relations
-type BookingWithRelations struct {
-	models.Booking
-	CustomerName  *string `db:"customer_user_name"`
-	CustomerEmail *string `db:"customer_user_email"`
-	BarberName    *string `db:"barber_shop_name"`
-	BarberCity    *string `db:"barber_city"`
-	BarberPhone   *string `db:"barber_phone"`
-}
-
-// FindAllWithRelations retrieves bookings with optional relation loading
-// Use this instead of FindAll when you need customer/barber info
-func (r *BookingRepository) FindAllWithRelations(ctx context.Context, filters BookingFilters) ([]BookingWithRelations, error) {
-	// Build SELECT columns
-	selectCols := `bk.*`
-	joins := ""
-
-	if filters.IncludeCustomer {
-		selectCols += `, u.name as customer_user_name, u.email as customer_user_email`
-		joins += ` LEFT JOIN users u ON bk.customer_id = u.id`
-	}
-
-	if filters.IncludeBarber {
-		selectCols += `, b.shop_name as barber_shop_name, b.city as barber_city, b.phone as barber_phone`
-		joins += ` LEFT JOIN barbers b ON bk.barber_id = b.id`
+// bookingWhere builds the WHERE clause shared by FindAll and Count, with
+// columns qualified by prefix (e.g. "bk.")
+func bookingWhere(ctx context.Context, filters BookingFilters, prefix string) (string, []interface{}) {
+	// Sandbox requests only see test bookings, live requests only live ones
+	where := fmt.Sprintf(" WHERE %sis_test = $1", prefix)
+	args := []interface{}{sandbox.Enabled(ctx)}
+	argCount := 2
+
+	add := func(condition string, value interface{}) {
+		where += fmt.Sprintf(" AND %s%s $%d", prefix, condition, argCount)
+		args = append(args, value)
+		argCount++
 	}
 
-	// Base query
-	query := fmt.Sprintf(`SELECT %s FROM bookings bk%s WHERE 1=1`, selectCols, joins)
-	args := []interface{}{}
-	argCount := 1
-
-	// Apply filters (same as FindAll)
 	if filters.CustomerID > 0 {
-		query += fmt.Sprintf(" AND bk.customer_id = $%d", argCount)
-		args = append(args, filters.CustomerID)
-		argCount++
+		add("customer_id =", filters.CustomerID)
 	}
-
 	if filters.BarberID > 0 {
-		query += fmt.Sprintf(" AND bk.barber_id = $%d", argCount)
-		args = append(args, filters.BarberID)
+		add("barber_id =", filters.BarberID)
+	}
+	if filters.ShopID > 0 {
+		where += fmt.Sprintf(" AND %sbarber_id IN (SELECT id FROM barbers WHERE shop_id = $%d)", prefix, argCount)
+		args = append(args, filters.ShopID)
 		argCount++
 	}
-
 	if filters.Status != "" {
-		query += fmt.Sprintf(" AND bk.status = $%d", argCount)
-		args = append(args, filters.Status)
-		argCount++
+		add("status =", filters.Status)
 	}
 
+	// Multiple statuses filter (OR condition)
 	if len(filters.Statuses) > 0 {
 		placeholders := make([]string, len(filters.Statuses))
 		for i, status := range filters.Statuses {
@@ -437,99 +335,88 @@ func (r *BookingRepository) FindAllWithRelations(ctx context.Context, filters Bo
 			args = append(args, status)
 			argCount++
 		}
-		query += fmt.Sprintf(" AND bk.status IN (%s)", strings.Join(placeholders, ", "))
+		where += fmt.Sprintf(" AND %sstatus IN (%s)", prefix, strings.Join(placeholders, ", "))
 	}
 
 	if filters.PaymentStatus != "" {
-		query += fmt.Sprintf(" AND bk.payment_status = $%d", argCount)
-		args = append(args, filters.PaymentStatus)
-		argCount++
+		add("payment_status =", filters.PaymentStatus)
 	}
 
+	// Date range - scheduled start time
 	if !filters.StartDateFrom.IsZero() {
-		query += fmt.Sprintf(" AND bk.scheduled_start_time >= $%d", argCount)
-		args = append(args, filters.StartDateFrom)
-		argCount++
+		add("scheduled_start_time >=", filters.StartDateFrom)
 	}
-
 	if !filters.StartDateTo.IsZero() {
-		query += fmt.Sprintf(" AND bk.scheduled_start_time <= $%d", argCount)
-		args = append(args, filters.StartDateTo)
-		argCount++
+		add("scheduled_start_time <=", filters.StartDateTo)
 	}
 
+	// Date range - created at
 	if !filters.CreatedFrom.IsZero() {
-		query += fmt.Sprintf(" AND bk.created_at >= $%d", argCount)
-		args = append(args, filters.CreatedFrom)
-		argCount++
+		add("created_at >=", filters.CreatedFrom)
 	}
-
 	if !filters.CreatedTo.IsZero() {
-		query += fmt.Sprintf(" AND bk.created_at <= $%d", argCount)
-		args = append(args, filters.CreatedTo)
-		argCount++
+		add("created_at <=", filters.CreatedTo)
 	}
 
 	if filters.Search != "" {
-		query += fmt.Sprintf(" AND (bk.booking_number ILIKE $%d OR bk.customer_name ILIKE $%d OR bk.customer_email ILIKE $%d)",
-			argCount, argCount+1, argCount+2)
+		where += fmt.Sprintf(" AND (%sbooking_number ILIKE $%d OR %scustomer_name ILIKE $%d OR %scustomer_email ILIKE $%d)",
+			prefix, argCount, prefix, argCount+1, prefix, argCount+2)
 		searchPattern := "%" + filters.Search + "%"
 		args = append(args, searchPattern, searchPattern, searchPattern)
 		argCount += 3
 	}
 
 	if filters.BookingSource != "" {
-		query += fmt.Sprintf(" AND bk.booking_source = $%d", argCount)
-		args = append(args, filters.BookingSource)
-		argCount++
+		add("booking_source =", filters.BookingSource)
 	}
 
-	// Sorting
-	orderBy := "bk.created_at DESC"
-	if filters.SortBy != "" {
-		order := "DESC"
-		if filters.Order == "ASC" || filters.Order == "asc" {
-			order = "ASC"
-		}
-		switch filters.SortBy {
-		case "scheduled_start_time":
-			orderBy = fmt.Sprintf("bk.scheduled_start_time %s", order)
-		case "total_price":
-			orderBy = fmt.Sprintf("bk.total_price %s", order)
-		case "created_at":
-			orderBy = fmt.Sprintf("bk.created_at %s", order)
-		case "status":
-			orderBy = fmt.Sprintf("bk.status %s", order)
-		}
-	}
-	query += " ORDER BY " + orderBy
+	return where, args
+}
 
-	// Pagination
-	limit := 50
-	if filters.Limit > 0 {
-		limit = filters.Limit
-	}
-	offset := 0
-	if filters.Offset > 0 {
-		offset = filters.Offset
-	}
-	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
-	args = append(args, limit, offset)
+// ========================================================================
+// READ OPERATIONS - Specific Queries
+// ========================================================================
+
+// BookingWithRelations is a booking with its customer's and barber's
+// details, as read by FindCalendarBookings
+type BookingWithRelations struct {
+	models.Booking
+	CustomerName  *string `db:"customer_user_name"`
+	CustomerEmail *string `db:"customer_user_email"`
+	BarberName    *string `db:"barber_shop_name"`
+	BarberCity    *string `db:"barber_city"`
+	BarberPhone   *string `db:"barber_phone"`
+	BarberAddress *string `db:"barber_address"`
+}
+
+// FindCalendarBookings retrieves bookings on a user's calendar: those they
+// booked as a customer and, for barbers, those booked with them. Only
+// bookings that still hold their slot and end after from are included,
+// soonest first.
+func (r *BookingRepository) FindCalendarBookings(ctx context.Context, userID int, from time.Time, limit int) ([]BookingWithRelations, error) {
+	query := `
+		SELECT bk.*,
+			u.name AS customer_user_name,
+			b.shop_name AS barber_shop_name, b.city AS barber_city,
+			b.phone AS barber_phone, b.address AS barber_address
+		FROM bookings bk
+		JOIN barbers b ON bk.barber_id = b.id
+		LEFT JOIN users u ON bk.customer_id = u.id
+		WHERE (bk.customer_id = $1 OR b.user_id = $1)
+		AND bk.status IN ('pending', 'confirmed', 'in_progress')
+		AND bk.scheduled_end_time > $2
+		AND bk.is_test = $3
+		ORDER BY bk.scheduled_start_time ASC
+		LIMIT $4
+	`
 
-	// Execute query
 	var bookings []BookingWithRelations
-	err := r.db.SelectContext(ctx, &bookings, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find bookings with relations: %w", err)
+	if err := r.db.SelectContext(ctx, &bookings, query, userID, from, sandbox.Enabled(ctx), limit); err != nil {
+		return nil, fmt.Errorf("failed to find calendar bookings: %w", err)
 	}
-
 	return bookings, nil
 }
 
-// ========================================================================
-// READ OPERATIONS - Specific Queries
-// ========================================================================
-
 // FindByCustomerID retrieves all bookings for a customer
 func (r *BookingRepository) FindByCustomerID(ctx context.Context, customerID int, filters BookingFilters) ([]models.Booking, error) {
 	filters.CustomerID = customerID
@@ -582,12 +469,14 @@ func (r *BookingRepository) Update(ctx context.Context, booking *models.Booking)
 			service_name = :service_name,
 			service_category = :service_category,
 			estimated_duration_minutes = :estimated_duration_minutes,
+			duration_segments = :duration_segments,
 			customer_name = :customer_name,
 			customer_email = :customer_email,
 			customer_phone = :customer_phone,
 			notes = :notes,
 			special_requests = :special_requests,
 			internal_notes = :internal_notes,
+			custom_fields = :custom_fields,
 			scheduled_start_time = :scheduled_start_time,
 			scheduled_end_time = :scheduled_end_time,
 			updated_at = :updated_at
@@ -596,6 +485,9 @@ func (r *BookingRepository) Update(ctx context.Context, booking *models.Booking)
 
 	result, err := r.db.NamedExecContext(ctx, query, booking)
 	if err != nil {
+		if IsExclusionViolation(err) {
+			return ErrBookingConflict
+		}
 		return fmt.Errorf("failed to update booking: %w", err)
 	}
 
@@ -604,6 +496,16 @@ func (r *BookingRepository) Update(ctx context.Context, booking *models.Booking)
 
 // UpdateStatus updates only the status of a booking
 func (r *BookingRepository) UpdateStatus(ctx context.Context, id int, newStatus string) error {
+	return r.updateStatus(ctx, r.db, id, newStatus)
+}
+
+// UpdateStatusTx updates only the status of a booking within a transaction
+func (r *BookingRepository) UpdateStatusTx(ctx context.Context, tx *sqlx.Tx, id int, newStatus string) error {
+	return r.updateStatus(ctx, tx, id, newStatus)
+}
+
+// updateStatus validates and applies a status change through exec
+func (r *BookingRepository) updateStatus(ctx context.Context, exec sqlx.ExecerContext, id int, newStatus string) error {
 	// ─────────────────────────────────────────────────────────────────
 	// TODO: YOUR TASK #2 - Implement status update
 	// ─────────────────────────────────────────────────────────────────
@@ -646,7 +548,7 @@ func (r *BookingRepository) UpdateStatus(ctx context.Context, id int, newStatus
 	query += fmt.Sprintf(" WHERE id = $%d", argCount)
 	args = append(args, id)
 
-	result, err := r.db.ExecContext(ctx, query, args...)
+	result, err := exec.ExecContext(ctx, query, args...)
 	if err != nil {
 		return fmt.Errorf("failed to update booking status: %w", err)
 	}
@@ -700,23 +602,126 @@ func (r *BookingRepository) Cancel(ctx context.Context, id int, cancelledBy int,
 // CONFLICT CHECKING
 // ========================================================================
 
-// CheckConflict checks if there's a conflicting booking for a barber at the given time
-func (r *BookingRepository) CheckConflict(ctx context.Context, barberID int, startTime, endTime time.Time, excludeBookingID int) (bool, error) {
+// CheckConflict checks if there's a conflicting booking for a barber at the
+// given time. Bookings only conflict where their active segments overlap, so
+// bookings can interleave during each other's passive segments. Bookings at
+// another of the barber's locations also need the travel time in between.
+func (r *BookingRepository) CheckConflict(ctx context.Context, barberID int, startTime, endTime time.Time, segments models.DurationSegments, travel models.Travel, excludeBookingID int) (bool, error) {
 	query := `
-		SELECT COUNT(*) FROM bookings
+		SELECT id, scheduled_start_time, scheduled_end_time, duration_segments, location_id FROM bookings
 		WHERE barber_id = $1
 		AND status NOT IN ('cancelled_by_customer', 'cancelled_by_barber', 'no_show', 'completed')
 		AND id != $2
 		AND scheduled_start_time < $3
 		AND scheduled_end_time > $4
+		AND is_test = $5
 	`
 
-	var count int
-	err := r.db.GetContext(ctx, &count, query, barberID, excludeBookingID, endTime, startTime)
+	// Widen the search by the longest travel time
+	longest := travel.Times.Longest()
+	var candidates []models.Booking
+	err := r.db.SelectContext(ctx, &candidates, query, barberID, excludeBookingID, endTime.Add(longest), startTime.Add(-longest), sandbox.Enabled(ctx))
 	if err != nil {
 		return false, fmt.Errorf("failed to check booking conflict: %w", err)
 	}
-	return count > 0, nil
+	return activeConflict(candidates, segments.ActiveRanges(startTime, endTime), travel), nil
+}
+
+// activeConflict reports whether any candidate booking keeps the barber
+// busy during the active ranges
+func activeConflict(candidates []models.Booking, active []models.TimeRange, travel models.Travel) bool {
+	for i := range candidates {
+		if models.RangesOverlap(active, travel.Busy(&candidates[i])) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindActiveInRange retrieves a barber's bookings that still hold their slot
+// (pending, confirmed, in progress) and overlap the given range
+func (r *BookingRepository) FindActiveInRange(ctx context.Context, barberID int, from, to time.Time) ([]models.Booking, error) {
+	query := `
+		SELECT * FROM bookings
+		WHERE barber_id = $1
+		AND status IN ('pending', 'confirmed', 'in_progress')
+		AND scheduled_start_time < $2
+		AND scheduled_end_time > $3
+		AND is_test = $4
+		ORDER BY scheduled_start_time ASC
+	`
+
+	var bookings []models.Booking
+	if err := r.db.SelectContext(ctx, &bookings, query, barberID, to, from, sandbox.Enabled(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to find active bookings: %w", err)
+	}
+	return bookings, nil
+}
+
+// FindStalePending retrieves bookings still pending that were created
+// before createdBefore, oldest first. Live and sandbox bookings are both
+// returned.
+func (r *BookingRepository) FindStalePending(ctx context.Context, createdBefore time.Time) ([]models.Booking, error) {
+	query := `
+		SELECT * FROM bookings
+		WHERE status = $1
+		AND created_at < $2
+		ORDER BY created_at ASC
+	`
+
+	var bookings []models.Booking
+	if err := r.db.SelectContext(ctx, &bookings, query, config.BookingStatusPending, createdBefore); err != nil {
+		return nil, fmt.Errorf("failed to find stale pending bookings: %w", err)
+	}
+	return bookings, nil
+}
+
+// FindNoShowCandidates retrieves confirmed bookings nobody started whose
+// grace period has passed at now. Barbers without their own grace period
+// get defaultGraceMinutes. Live and sandbox bookings are both returned.
+func (r *BookingRepository) FindNoShowCandidates(ctx context.Context, now time.Time, defaultGraceMinutes int) ([]models.NoShowCandidate, error) {
+	query := `
+		SELECT bk.id AS booking_id, bk.barber_id, bar.user_id AS barber_user_id,
+			bk.scheduled_start_time,
+			COALESCE(bar.no_show_grace_minutes, $2) AS grace_minutes
+		FROM bookings bk
+		JOIN barbers bar ON bar.id = bk.barber_id
+		WHERE bk.status = $3
+		AND bk.actual_start_time IS NULL
+		AND bk.scheduled_start_time + make_interval(mins => COALESCE(bar.no_show_grace_minutes, $2)) <= $1
+		ORDER BY bk.scheduled_start_time ASC
+	`
+
+	candidates := []models.NoShowCandidate{}
+	if err := r.db.SelectContext(ctx, &candidates, query, now, defaultGraceMinutes, config.BookingStatusConfirmed); err != nil {
+		return nil, fmt.Errorf("failed to find no-show candidates: %w", err)
+	}
+	return candidates, nil
+}
+
+// FindDashboardBookings loads everything the barber dashboard needs in one
+// query: bookings starting in [dayStart, dayEnd) in any status, plus pending
+// bookings that have not ended yet and start before pendingUntil
+func (r *BookingRepository) FindDashboardBookings(ctx context.Context, barberID int, dayStart, dayEnd, now, pendingUntil time.Time) ([]models.DashboardBooking, error) {
+	query := `
+		SELECT id, booking_number, customer_id, customer_name, customer_phone,
+			service_name, status, scheduled_start_time, scheduled_end_time,
+			actual_start_time, total_price, tip_amount, currency, created_at
+		FROM bookings
+		WHERE barber_id = $1
+		AND (
+			(scheduled_start_time >= $2 AND scheduled_start_time < $3)
+			OR (status = 'pending' AND scheduled_end_time >= $4 AND scheduled_start_time < $5)
+		)
+		AND is_test = $6
+		ORDER BY scheduled_start_time ASC
+	`
+
+	var bookings []models.DashboardBooking
+	if err := r.db.SelectContext(ctx, &bookings, query, barberID, dayStart, dayEnd, now, pendingUntil, sandbox.Enabled(ctx)); err != nil {
+		return nil, fmt.Errorf("failed to find dashboard bookings: %w", err)
+	}
+	return bookings, nil
 }
 
 // ========================================================================
@@ -733,26 +738,70 @@ func (r *BookingRepository) BeginTx(ctx context.Context) (*sqlx.Tx, error) {
 }
 
 // CheckConflictForUpdate checks for booking conflicts with row locking (FOR UPDATE)
-// This prevents race conditions by locking conflicting rows until transaction commits
-func (r *BookingRepository) CheckConflictForUpdate(ctx context.Context, tx *sqlx.Tx, barberID int, startTime, endTime time.Time, excludeBookingID int) (bool, error) {
+// This prevents race conditions by locking conflicting rows until transaction commits.
+// Bookings with passive segments are not covered by the overlap constraint,
+// so conflict checks are also serialized per barber until the transaction ends.
+// Locked rows are waited for, never skipped: a skipped booking with passive
+// segments would go unchecked, and nothing else would catch the clash.
+func (r *BookingRepository) CheckConflictForUpdate(ctx context.Context, tx *sqlx.Tx, barberID int, startTime, endTime time.Time, segments models.DurationSegments, travel models.Travel, excludeBookingID int) (bool, error) {
+	if err := lockBarberBookings(ctx, tx, barberID); err != nil {
+		return false, err
+	}
+
 	query := `
-		SELECT EXISTS (
-			SELECT 1 FROM bookings
-			WHERE barber_id = $1
-			AND status NOT IN ('cancelled_by_customer', 'cancelled_by_barber', 'no_show', 'completed')
-			AND id != $2
-			AND scheduled_start_time < $3
-			AND scheduled_end_time > $4
-			FOR UPDATE SKIP LOCKED
-		)
+		SELECT id, scheduled_start_time, scheduled_end_time, duration_segments, location_id FROM bookings
+		WHERE barber_id = $1
+		AND status NOT IN ('cancelled_by_customer', 'cancelled_by_barber', 'no_show', 'completed')
+		AND id != $2
+		AND scheduled_start_time < $3
+		AND scheduled_end_time > $4
+		AND is_test = $5
+		FOR UPDATE
 	`
-	var hasConflict bool
-	err := tx.GetContext(ctx, &hasConflict, query, barberID, excludeBookingID, endTime, startTime)
+	longest := travel.Times.Longest()
+	var candidates []models.Booking
+	err := tx.SelectContext(ctx, &candidates, query, barberID, excludeBookingID, endTime.Add(longest), startTime.Add(-longest), sandbox.Enabled(ctx))
 	if err != nil {
 		return false, fmt.Errorf("failed to check booking conflict: %w", err)
 	}
 
-	return hasConflict, nil
+	return activeConflict(candidates, segments.ActiveRanges(startTime, endTime), travel), nil
+}
+
+// CheckConflictOutsideSeriesForUpdate is CheckConflictForUpdate ignoring every
+// booking of the given series, used when a whole series moves at once
+func (r *BookingRepository) CheckConflictOutsideSeriesForUpdate(ctx context.Context, tx *sqlx.Tx, barberID int, startTime, endTime time.Time, segments models.DurationSegments, travel models.Travel, seriesID int) (bool, error) {
+	if err := lockBarberBookings(ctx, tx, barberID); err != nil {
+		return false, err
+	}
+
+	query := `
+		SELECT id, scheduled_start_time, scheduled_end_time, duration_segments, location_id FROM bookings
+		WHERE barber_id = $1
+		AND status NOT IN ('cancelled_by_customer', 'cancelled_by_barber', 'no_show', 'completed')
+		AND (series_id IS NULL OR series_id != $2)
+		AND scheduled_start_time < $3
+		AND scheduled_end_time > $4
+		AND is_test = $5
+		FOR UPDATE
+	`
+	longest := travel.Times.Longest()
+	var candidates []models.Booking
+	err := tx.SelectContext(ctx, &candidates, query, barberID, seriesID, endTime.Add(longest), startTime.Add(-longest), sandbox.Enabled(ctx))
+	if err != nil {
+		return false, fmt.Errorf("failed to check booking conflict: %w", err)
+	}
+
+	return activeConflict(candidates, segments.ActiveRanges(startTime, endTime), travel), nil
+}
+
+// lockBarberBookings serializes booking conflict checks for a barber until
+// the transaction ends
+func lockBarberBookings(ctx context.Context, tx *sqlx.Tx, barberID int) error {
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('bookings'), $1)`, barberID); err != nil {
+		return fmt.Errorf("failed to lock barber bookings: %w", err)
+	}
+	return nil
 }
 
 // CreateTx inserts a new booking within a transaction
@@ -763,21 +812,23 @@ func (r *BookingRepository) CreateTx(ctx context.Context, tx *sqlx.Tx, booking *
 			service_name, service_category, estimated_duration_minutes,
 			customer_name, customer_email, customer_phone,
 			status, service_price, total_price, discount_amount, tax_amount, tip_amount, currency,
-			payment_status, payment_method, payment_reference,
+			payment_status, payment_method, payment_reference, deposit_amount, no_show_fee,
 			notes, special_requests, internal_notes,
 			scheduled_start_time, scheduled_end_time,
 			booking_source, referral_source, utm_campaign,
-			created_at, updated_at
+			created_at, updated_at, series_id, is_test, duration_segments, location_id, barber_service_id,
+			custom_fields
 		) VALUES (
 			$1, $2, $3, $4,
 			$5, $6, $7,
 			$8, $9, $10,
 			$11, $12, $13, $14, $15, $16, $17,
-			$18, $19, $20,
-			$21, $22, $23,
-			$24, $25,
-			$26, $27, $28,
-			$29, $30
+			$18, $19, $20, $21, $22,
+			$23, $24, $25,
+			$26, $27,
+			$28, $29, $30,
+			$31, $32, $33, $34, $35, $36, $37,
+			$38
 		) RETURNING id
 	`
 
@@ -795,17 +846,130 @@ func (r *BookingRepository) CreateTx(ctx context.Context, tx *sqlx.Tx, booking *
 		booking.ServiceName, booking.ServiceCategory, booking.EstimatedDurationMinutes,
 		booking.CustomerName, booking.CustomerEmail, booking.CustomerPhone,
 		booking.Status, booking.ServicePrice, booking.TotalPrice, booking.DiscountAmount, booking.TaxAmount, booking.TipAmount, booking.Currency,
-		booking.PaymentStatus, booking.PaymentMethod, booking.PaymentReference,
+		booking.PaymentStatus, booking.PaymentMethod, booking.PaymentReference, booking.DepositAmount, booking.NoShowFee,
 		booking.Notes, booking.SpecialRequests, booking.InternalNotes,
 		booking.ScheduledStartTime, booking.ScheduledEndTime,
 		booking.BookingSource, booking.ReferralSource, booking.UTMCampaign,
-		booking.CreatedAt, booking.UpdatedAt,
+		booking.CreatedAt, booking.UpdatedAt, booking.SeriesID, booking.IsTest, booking.DurationSegments, booking.LocationID, booking.BarberServiceID,
+		booking.CustomFields,
 	).Scan(&booking.ID)
 
 	if err != nil {
+		if IsExclusionViolation(err) {
+			return ErrBookingConflict
+		}
 		return fmt.Errorf("failed to create booking: %w", err)
 	}
 
+	if err := createLineItemsTx(ctx, tx, booking); err != nil {
+		return err
+	}
+	return createTaxLinesTx(ctx, tx, booking)
+}
+
+// CreateImported inserts a historical booking brought over from another
+// tool, with its history entry. Imported bookings skip the checks that
+// only apply to upcoming ones and never fire status hooks. A booking whose
+// import_ref the barber already has is ErrDuplicateImport.
+func (r *BookingRepository) CreateImported(ctx context.Context, booking *models.Booking, history *models.BookingHistory) error {
+	query := `
+		INSERT INTO bookings (
+			uuid, booking_number, customer_id, barber_id, barber_service_id,
+			service_name, estimated_duration_minutes,
+			customer_name, customer_email, customer_phone,
+			status, service_price, total_price, tip_amount, currency,
+			payment_status, payment_method, notes,
+			scheduled_start_time, scheduled_end_time, actual_start_time, actual_end_time, cancelled_at,
+			booking_source, import_ref, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5,
+			$6, $7,
+			$8, $9, $10,
+			$11, $12, $13, $14, $15,
+			$16, $17, $18,
+			$19, $20, $21, $22, $23,
+			$24, $25, $26, $26
+		)
+		ON CONFLICT (barber_id, import_ref) WHERE import_ref IS NOT NULL DO NOTHING
+		RETURNING id
+	`
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	SetCreateTimestamps(&booking.CreatedAt, &booking.UpdatedAt)
+	SetDefaultString(&booking.Currency, config.DefaultCurrency)
+
+	err = tx.QueryRowContext(ctx, query,
+		booking.UUID, booking.BookingNumber, booking.CustomerID, booking.BarberID, booking.BarberServiceID,
+		booking.ServiceName, booking.EstimatedDurationMinutes,
+		booking.CustomerName, booking.CustomerEmail, booking.CustomerPhone,
+		booking.Status, booking.ServicePrice, booking.TotalPrice, booking.TipAmount, booking.Currency,
+		booking.PaymentStatus, booking.PaymentMethod, booking.Notes,
+		booking.ScheduledStartTime, booking.ScheduledEndTime, booking.ActualStartTime, booking.ActualEndTime, booking.CancelledAt,
+		booking.BookingSource, booking.ImportRef, booking.CreatedAt,
+	).Scan(&booking.ID)
+	if err == sql.ErrNoRows {
+		return ErrDuplicateImport
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create imported booking: %w", err)
+	}
+
+	history.BookingID = booking.ID
+	if err := r.CreateHistoryTx(ctx, tx, history); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// createLineItemsTx saves the services and add-ons performed in the booking
+func createLineItemsTx(ctx context.Context, tx *sqlx.Tx, booking *models.Booking) error {
+	for i := range booking.LineItems {
+		item := &booking.LineItems[i]
+		item.BookingID = booking.ID
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO booking_line_items (booking_id, barber_service_id, add_on_id, service_name, duration_minutes, price, position)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING id, created_at
+		`, item.BookingID, item.BarberServiceID, item.AddOnID, item.ServiceName, item.DurationMinutes, item.Price, item.Position,
+		).Scan(&item.ID, &item.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to save booking line item: %w", err)
+		}
+	}
+	return nil
+}
+
+// FindLineItems retrieves the services and add-ons performed in a booking,
+// in order
+func (r *BookingRepository) FindLineItems(ctx context.Context, bookingID int) ([]models.BookingLineItem, error) {
+	items := []models.BookingLineItem{}
+	err := r.db.SelectContext(ctx, &items, `SELECT * FROM booking_line_items WHERE booking_id = $1 ORDER BY position ASC`, bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find booking line items: %w", err)
+	}
+	return items, nil
+}
+
+// createTaxLinesTx saves the booking's tax line items
+func createTaxLinesTx(ctx context.Context, tx *sqlx.Tx, booking *models.Booking) error {
+	for i := range booking.TaxLines {
+		line := &booking.TaxLines[i]
+		line.BookingID = booking.ID
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO booking_tax_lines (booking_id, tax_rule_id, name, rate_percent, inclusive, taxable_amount, amount)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING id, created_at
+		`, line.BookingID, line.TaxRuleID, line.Name, line.RatePercent, line.Inclusive, line.TaxableAmount, line.Amount,
+		).Scan(&line.ID, &line.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to save booking tax line: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -837,10 +1001,124 @@ func (r *BookingRepository) CreateHistoryTx(ctx context.Context, tx *sqlx.Tx, hi
 	return nil
 }
 
+// RescheduleTx moves a booking to a new time within a transaction
+func (r *BookingRepository) RescheduleTx(ctx context.Context, tx *sqlx.Tx, id int, startTime, endTime time.Time, durationMinutes int, segments models.DurationSegments) error {
+	query := `
+		UPDATE bookings SET
+			scheduled_start_time = $1,
+			scheduled_end_time = $2,
+			estimated_duration_minutes = $3,
+			duration_segments = $4,
+			updated_at = $5
+		WHERE id = $6
+	`
+
+	result, err := tx.ExecContext(ctx, query, startTime, endTime, durationMinutes, segments, time.Now(), id)
+	if err != nil {
+		if IsExclusionViolation(err) {
+			return ErrBookingConflict
+		}
+		return fmt.Errorf("failed to reschedule booking: %w", err)
+	}
+	return CheckRowsAffected(result, ErrBookingNotFound)
+}
+
+// CancelTx cancels a booking within a transaction. The caller is responsible
+// for checking that the booking is still cancellable.
+func (r *BookingRepository) CancelTx(ctx context.Context, tx *sqlx.Tx, id int, status string, cancelledBy *int, reasonCode *string, reason string) error {
+	now := time.Now()
+	query := `
+		UPDATE bookings SET
+			status = $1,
+			cancelled_at = $2,
+			cancelled_by = $3,
+			cancellation_reason = $4,
+			cancellation_reason_code = $5,
+			updated_at = $6
+		WHERE id = $7
+	`
+
+	result, err := tx.ExecContext(ctx, query, status, now, cancelledBy, reason, reasonCode, now, id)
+	if err != nil {
+		return fmt.Errorf("failed to cancel booking: %w", err)
+	}
+	return CheckRowsAffected(result, ErrBookingNotFound)
+}
+
+// SetCancellationTx records who cancelled a booking and why, within the
+// transaction that changes its status
+func (r *BookingRepository) SetCancellationTx(ctx context.Context, tx *sqlx.Tx, id int, cancelledBy *int, reasonCode *string, reason string) error {
+	query := `
+		UPDATE bookings SET
+			cancelled_at = NOW(),
+			cancelled_by = $1,
+			cancellation_reason = NULLIF($2, ''),
+			cancellation_reason_code = $3
+		WHERE id = $4
+	`
+
+	result, err := tx.ExecContext(ctx, query, cancelledBy, reason, reasonCode, id)
+	if err != nil {
+		return fmt.Errorf("failed to record cancellation: %w", err)
+	}
+	return CheckRowsAffected(result, ErrBookingNotFound)
+}
+
+// LockStatusTx locks a booking's row for the rest of tx and checks it is
+// still in status, returning ErrBookingStatusChanged when another request
+// moved it on since it was read
+func (r *BookingRepository) LockStatusTx(ctx context.Context, tx *sqlx.Tx, id int, status string) error {
+	var current string
+	err := tx.GetContext(ctx, &current, `SELECT status FROM bookings WHERE id = $1 FOR UPDATE`, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrBookingNotFound
+		}
+		return fmt.Errorf("failed to lock booking: %w", err)
+	}
+	if current != status {
+		return fmt.Errorf("%w: now %s", ErrBookingStatusChanged, current)
+	}
+	return nil
+}
+
+// CountCancellationReasons counts a barber's bookings cancelled in
+// [from, to) by reason code and cancelled status. Bookings cancelled
+// before cancellations were timestamped count by their last update.
+func (r *BookingRepository) CountCancellationReasons(ctx context.Context, barberID int, from, to time.Time) ([]models.CancellationReasonCount, error) {
+	var counts []models.CancellationReasonCount
+	err := r.db.SelectContext(ctx, &counts, `
+		SELECT COALESCE(cancellation_reason_code, '') AS code, status, COUNT(*) AS count
+		FROM bookings
+		WHERE barber_id = $1
+		  AND status IN ($2, $3, $4)
+		  AND COALESCE(cancelled_at, updated_at) >= $5
+		  AND COALESCE(cancelled_at, updated_at) < $6
+		  AND deleted_at IS NULL
+		  AND is_test = FALSE
+		GROUP BY 1, 2
+	`, barberID, config.BookingStatusCancelled, config.BookingStatusCancelledByCustomer, config.BookingStatusCancelledByBarber, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count cancellation reasons: %w", err)
+	}
+	return counts, nil
+}
+
 // ========================================================================
 // PAYMENT OPERATIONS
 // ========================================================================
 
+// UpdateCancellationFee records the amount kept from a prepaid booking on cancellation
+func (r *BookingRepository) UpdateCancellationFee(ctx context.Context, id int, fee float64) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE bookings SET cancellation_fee = $1, updated_at = $2 WHERE id = $3`,
+		fee, time.Now(), id)
+	if err != nil {
+		return fmt.Errorf("failed to update cancellation fee: %w", err)
+	}
+	return CheckRowsAffected(result, ErrBookingNotFound)
+}
+
 // UpdatePaymentStatus updates the payment status of a booking
 func (r *BookingRepository) UpdatePaymentStatus(ctx context.Context, id int, paymentStatus string, paymentMethod *string, paymentReference *string) error {
 	now := time.Now()
@@ -871,6 +1149,30 @@ func (r *BookingRepository) UpdatePaymentStatus(ctx context.Context, id int, pay
 	return CheckRowsAffected(result, ErrBookingNotFound)
 }
 
+// UpdatePricingTx saves a re-priced booking's service price, tax and total
+// and replaces its tax line items within a transaction
+func (r *BookingRepository) UpdatePricingTx(ctx context.Context, tx *sqlx.Tx, booking *models.Booking) error {
+	result, err := tx.ExecContext(ctx, `
+		UPDATE bookings SET
+			service_price = $1,
+			tax_amount = $2,
+			total_price = $3,
+			updated_at = $4
+		WHERE id = $5
+	`, booking.ServicePrice, booking.TaxAmount, booking.TotalPrice, time.Now(), booking.ID)
+	if err != nil {
+		return fmt.Errorf("failed to update booking pricing: %w", err)
+	}
+	if err := CheckRowsAffected(result, ErrBookingNotFound); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM booking_tax_lines WHERE booking_id = $1`, booking.ID); err != nil {
+		return fmt.Errorf("failed to replace booking tax lines: %w", err)
+	}
+	return createTaxLinesTx(ctx, tx, booking)
+}
+
 // ========================================================================
 // STATISTICS
 // ========================================================================
@@ -899,10 +1201,11 @@ func (r *BookingRepository) GetBarberStats(ctx context.Context, barberID int, fr
 		WHERE barber_id = $1
 		AND created_at >= $2
 		AND created_at <= $3
+		AND is_test = $4
 	`
 
 	var stats BookingStats
-	err := r.db.GetContext(ctx, &stats, query, barberID, from, to)
+	err := r.db.GetContext(ctx, &stats, query, barberID, from, to, sandbox.Enabled(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get barber stats: %w", err)
 	}
@@ -968,28 +1271,9 @@ func (r *BookingRepository) GetHistory(ctx context.Context, bookingID int) ([]mo
 
 // Count returns the total number of bookings matching the filters
 func (r *BookingRepository) Count(ctx context.Context, filters BookingFilters) (int, error) {
-	query := `SELECT COUNT(*) FROM bookings WHERE 1=1`
-	args := []interface{}{}
-	argCount := 1
-
-	// Apply same filters as FindAll (simplified version)
-	if filters.CustomerID > 0 {
-		query += fmt.Sprintf(" AND customer_id = $%d", argCount)
-		args = append(args, filters.CustomerID)
-		argCount++
-	}
-
-	if filters.BarberID > 0 {
-		query += fmt.Sprintf(" AND barber_id = $%d", argCount)
-		args = append(args, filters.BarberID)
-		argCount++
-	}
-
-	if filters.Status != "" {
-		query += fmt.Sprintf(" AND status = $%d", argCount)
-		args = append(args, filters.Status)
-		argCount++
-	}
+	// Same filters as FindAll; pagination and sorting are ignored
+	where, args := bookingWhere(ctx, filters, "")
+	query := `SELECT COUNT(*) FROM bookings` + where
 
 	var count int
 	err := r.db.GetContext(ctx, &count, query, args...)
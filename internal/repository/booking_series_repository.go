@@ -0,0 +1,117 @@
+// internal/repository/booking_series_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// BOOKING SERIES REPOSITORY - Data Access Layer for Recurring Bookings
+// ========================================================================
+
+// BookingSeriesRepository handles booking series data operations
+type BookingSeriesRepository struct {
+	db *sqlx.DB
+}
+
+// NewBookingSeriesRepository creates a new booking series repository
+func NewBookingSeriesRepository(db *sqlx.DB) *BookingSeriesRepository {
+	return &BookingSeriesRepository{db: db}
+}
+
+// ========================================================================
+// CREATE OPERATIONS
+// ========================================================================
+
+// CreateTx inserts a new series within a transaction
+func (r *BookingSeriesRepository) CreateTx(ctx context.Context, tx *sqlx.Tx, series *models.BookingSeries) error {
+	query := `
+		INSERT INTO booking_series (
+			uuid, customer_id, barber_id, barber_service_id,
+			frequency, occurrences, start_time, duration_minutes,
+			status, created_by, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id
+	`
+
+	SetCreateTimestamps(&series.CreatedAt, &series.UpdatedAt)
+
+	err := tx.QueryRowContext(ctx, query,
+		series.UUID, series.CustomerID, series.BarberID, series.BarberServiceID,
+		series.Frequency, series.Occurrences, series.StartTime, series.DurationMinutes,
+		series.Status, series.CreatedBy, series.CreatedAt, series.UpdatedAt,
+	).Scan(&series.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create booking series: %w", err)
+	}
+
+	return nil
+}
+
+// ========================================================================
+// READ OPERATIONS
+// ========================================================================
+
+// FindByID retrieves a booking series by its ID
+func (r *BookingSeriesRepository) FindByID(ctx context.Context, id int) (*models.BookingSeries, error) {
+	var series models.BookingSeries
+	err := r.db.GetContext(ctx, &series, `SELECT * FROM booking_series WHERE id = $1`, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrBookingSeriesNotFound
+		}
+		return nil, fmt.Errorf("failed to find booking series: %w", err)
+	}
+	return &series, nil
+}
+
+// FindOccurrences retrieves every booking in a series ordered by start time
+func (r *BookingSeriesRepository) FindOccurrences(ctx context.Context, seriesID int) ([]models.Booking, error) {
+	query := `
+		SELECT * FROM bookings
+		WHERE series_id = $1
+		ORDER BY scheduled_start_time ASC
+	`
+
+	var bookings []models.Booking
+	if err := r.db.SelectContext(ctx, &bookings, query, seriesID); err != nil {
+		return nil, fmt.Errorf("failed to find series occurrences: %w", err)
+	}
+	return bookings, nil
+}
+
+// ========================================================================
+// UPDATE OPERATIONS
+// ========================================================================
+
+// UpdateScheduleTx updates the series start time and duration within a transaction
+func (r *BookingSeriesRepository) UpdateScheduleTx(ctx context.Context, tx *sqlx.Tx, id int, startTime time.Time, durationMinutes int) error {
+	query := `
+		UPDATE booking_series
+		SET start_time = $1, duration_minutes = $2, updated_at = $3
+		WHERE id = $4
+	`
+
+	result, err := tx.ExecContext(ctx, query, startTime, durationMinutes, time.Now(), id)
+	if err != nil {
+		return fmt.Errorf("failed to update booking series: %w", err)
+	}
+	return CheckRowsAffected(result, ErrBookingSeriesNotFound)
+}
+
+// UpdateStatusTx sets the series status within a transaction
+func (r *BookingSeriesRepository) UpdateStatusTx(ctx context.Context, tx *sqlx.Tx, id int, status string) error {
+	query := `UPDATE booking_series SET status = $1, updated_at = $2 WHERE id = $3`
+
+	result, err := tx.ExecContext(ctx, query, status, time.Now(), id)
+	if err != nil {
+		return fmt.Errorf("failed to update booking series status: %w", err)
+	}
+	return CheckRowsAffected(result, ErrBookingSeriesNotFound)
+}
@@ -0,0 +1,79 @@
+// internal/repository/calendar_feed_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// CALENDAR FEED REPOSITORY - Users' subscribable booking calendars
+// ========================================================================
+
+// CalendarFeedRepository handles calendar feed tokens
+type CalendarFeedRepository struct {
+	db *sqlx.DB
+}
+
+// NewCalendarFeedRepository creates a new calendar feed repository
+func NewCalendarFeedRepository(db *sqlx.DB) *CalendarFeedRepository {
+	return &CalendarFeedRepository{db: db}
+}
+
+// FindByUserID returns a user's feed. Returns ErrCalendarFeedNotFound if
+// the user never asked for one.
+func (r *CalendarFeedRepository) FindByUserID(ctx context.Context, userID int) (*models.CalendarFeed, error) {
+	return r.findOne(ctx, `SELECT * FROM calendar_feeds WHERE user_id = $1`, userID)
+}
+
+// FindByToken returns the feed with the given token
+func (r *CalendarFeedRepository) FindByToken(ctx context.Context, token string) (*models.CalendarFeed, error) {
+	return r.findOne(ctx, `SELECT * FROM calendar_feeds WHERE token = $1`, token)
+}
+
+func (r *CalendarFeedRepository) findOne(ctx context.Context, query string, arg interface{}) (*models.CalendarFeed, error) {
+	var feed models.CalendarFeed
+	err := r.db.GetContext(ctx, &feed, query, arg)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrCalendarFeedNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find calendar feed: %w", err)
+	}
+	return &feed, nil
+}
+
+// Upsert saves a user's feed, replacing the token of an existing one
+func (r *CalendarFeedRepository) Upsert(ctx context.Context, feed *models.CalendarFeed) error {
+	query := `
+		INSERT INTO calendar_feeds (user_id, token)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET
+			token = EXCLUDED.token,
+			last_accessed_at = NULL,
+			updated_at = NOW()
+		RETURNING id, last_accessed_at, created_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query, feed.UserID, feed.Token).
+		Scan(&feed.ID, &feed.LastAccessedAt, &feed.CreatedAt, &feed.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save calendar feed: %w", err)
+	}
+	return nil
+}
+
+// MarkAccessed records that a calendar app fetched the feed
+func (r *CalendarFeedRepository) MarkAccessed(ctx context.Context, id int, at time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE calendar_feeds SET last_accessed_at = $2 WHERE id = $1`, id, at)
+	if err != nil {
+		return fmt.Errorf("failed to update calendar feed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+// internal/repository/commission_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/sandbox"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// COMMISSION REPOSITORY - Booking staff splits and earnings
+// ========================================================================
+
+// CommissionRepository handles assistants' shares of bookings and the
+// earnings they add up to
+type CommissionRepository struct {
+	db *sqlx.DB
+}
+
+// NewCommissionRepository creates a new commission repository
+func NewCommissionRepository(db *sqlx.DB) *CommissionRepository {
+	return &CommissionRepository{db: db}
+}
+
+// ========================================================================
+// BOOKING STAFF
+// ========================================================================
+
+// FindAssistants retrieves the assistants on a booking, largest share first
+func (r *CommissionRepository) FindAssistants(ctx context.Context, bookingID int) ([]models.BookingStaff, error) {
+	query := `
+		SELECT s.booking_id, s.barber_id, b.shop_name AS barber_name, $2::text AS role,
+			s.share_percent, s.created_at
+		FROM booking_staff s
+		JOIN barbers b ON b.id = s.barber_id
+		WHERE s.booking_id = $1
+		ORDER BY s.share_percent DESC, s.barber_id ASC
+	`
+
+	staff := []models.BookingStaff{}
+	if err := r.db.SelectContext(ctx, &staff, query, bookingID, config.StaffRoleAssistant); err != nil {
+		return nil, fmt.Errorf("failed to find booking staff: %w", err)
+	}
+	return staff, nil
+}
+
+// ReplaceAssistants replaces the assistants on a booking in a single
+// transaction
+func (r *CommissionRepository) ReplaceAssistants(ctx context.Context, bookingID int, assistants []models.BookingStaff) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM booking_staff WHERE booking_id = $1`, bookingID); err != nil {
+		return fmt.Errorf("failed to clear booking staff: %w", err)
+	}
+
+	for _, a := range assistants {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO booking_staff (booking_id, barber_id, share_percent)
+			VALUES ($1, $2, $3)
+		`, bookingID, a.BarberID, a.SharePercent)
+		if err != nil {
+			return fmt.Errorf("failed to save booking staff: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit booking staff: %w", err)
+	}
+	return nil
+}
+
+// ========================================================================
+// EARNINGS
+// ========================================================================
+
+// FindEarningsLines retrieves the completed bookings in [from, to) a barber
+// worked on, as lead or assistant, with their share. Amounts are the whole
+// booking's, before tax; NewEarningsReport applies the shares.
+func (r *CommissionRepository) FindEarningsLines(ctx context.Context, barberID int, from, to time.Time) ([]models.EarningsLine, error) {
+	query := `
+		SELECT bk.id AS booking_id, bk.booking_number, bk.service_name, bk.scheduled_start_time,
+			$5::text AS role,
+			100 - COALESCE((SELECT SUM(s.share_percent) FROM booking_staff s WHERE s.booking_id = bk.id), 0) AS share_percent,
+			bk.currency, bk.total_price - bk.tax_amount AS booking_amount, bk.tip_amount AS booking_tip
+		FROM bookings bk
+		WHERE bk.barber_id = $1
+			AND bk.status = 'completed'
+			AND bk.scheduled_start_time >= $2 AND bk.scheduled_start_time < $3
+			AND bk.is_test = $4
+		UNION ALL
+		SELECT bk.id, bk.booking_number, bk.service_name, bk.scheduled_start_time,
+			$6::text,
+			s.share_percent,
+			bk.currency, bk.total_price - bk.tax_amount, bk.tip_amount
+		FROM booking_staff s
+		JOIN bookings bk ON bk.id = s.booking_id
+		WHERE s.barber_id = $1
+			AND bk.status = 'completed'
+			AND bk.scheduled_start_time >= $2 AND bk.scheduled_start_time < $3
+			AND bk.is_test = $4
+		ORDER BY scheduled_start_time ASC, booking_id ASC
+	`
+
+	lines := []models.EarningsLine{}
+	err := r.db.SelectContext(ctx, &lines, query, barberID, from, to, sandbox.Enabled(ctx),
+		config.StaffRoleLead, config.StaffRoleAssistant)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find earnings: %w", err)
+	}
+	return lines, nil
+}
@@ -0,0 +1,116 @@
+// internal/repository/confirmation_request_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// CONFIRMATION REQUEST REPOSITORY - Check-ins for likely no-shows
+// ========================================================================
+
+// ConfirmationRequestRepository handles confirmation request database operations
+type ConfirmationRequestRepository struct {
+	db *sqlx.DB
+}
+
+// NewConfirmationRequestRepository creates a new confirmation request repository
+func NewConfirmationRequestRepository(db *sqlx.DB) *ConfirmationRequestRepository {
+	return &ConfirmationRequestRepository{db: db}
+}
+
+// FindCandidates returns confirmed customer bookings starting in (from, to]
+// that have not been sent a confirmation request, each with the customer's
+// last lookback completed or no-show appointments counted. Test bookings
+// are skipped.
+func (r *ConfirmationRequestRepository) FindCandidates(ctx context.Context, from, to time.Time, lookback int) ([]models.ConfirmationCandidate, error) {
+	query := `
+		SELECT b.id AS booking_id, b.customer_id, b.scheduled_start_time,
+			COUNT(past.id) AS finished,
+			COUNT(past.id) FILTER (WHERE past.status = $1) AS no_shows
+		FROM bookings b
+		LEFT JOIN LATERAL (
+			SELECT p.id, p.status FROM bookings p
+			WHERE p.customer_id = b.customer_id
+				AND p.status IN ($1, $2)
+				AND NOT p.is_test
+				AND p.scheduled_start_time < b.scheduled_start_time
+			ORDER BY p.scheduled_start_time DESC
+			LIMIT $3
+		) past ON TRUE
+		WHERE b.status = $4
+			AND b.customer_id IS NOT NULL
+			AND NOT b.is_test
+			AND b.scheduled_start_time > $5 AND b.scheduled_start_time <= $6
+			AND NOT EXISTS (SELECT 1 FROM confirmation_requests cr WHERE cr.booking_id = b.id)
+		GROUP BY b.id
+		ORDER BY b.scheduled_start_time ASC
+	`
+
+	candidates := []models.ConfirmationCandidate{}
+	err := r.db.SelectContext(ctx, &candidates, query,
+		config.BookingStatusNoShow, config.BookingStatusCompleted, lookback,
+		config.BookingStatusConfirmed, from, to,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find confirmation candidates: %w", err)
+	}
+	return candidates, nil
+}
+
+// Create inserts a confirmation request. It returns false without error
+// when the booking already has one.
+func (r *ConfirmationRequestRepository) Create(ctx context.Context, request *models.ConfirmationRequest) (bool, error) {
+	query := `
+		INSERT INTO confirmation_requests (token, booking_id, customer_id, no_show_risk, sent_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (booking_id) DO NOTHING
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		request.Token, request.BookingID, request.CustomerID, request.NoShowRisk, request.SentAt, request.ExpiresAt,
+	).Scan(&request.ID, &request.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to create confirmation request: %w", err)
+	}
+	return true, nil
+}
+
+// FindByToken retrieves a confirmation request by its token
+func (r *ConfirmationRequestRepository) FindByToken(ctx context.Context, token string) (*models.ConfirmationRequest, error) {
+	var request models.ConfirmationRequest
+	err := r.db.GetContext(ctx, &request, `SELECT * FROM confirmation_requests WHERE token = $1`, token)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrConfirmationRequestNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find confirmation request: %w", err)
+	}
+	return &request, nil
+}
+
+// RecordResponse stores the answer to an open, unanswered request
+func (r *ConfirmationRequestRepository) RecordResponse(ctx context.Context, id int, response string, now time.Time) error {
+	query := `
+		UPDATE confirmation_requests SET response = $1, responded_at = $2
+		WHERE id = $3 AND responded_at IS NULL AND expires_at > $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, response, now, id)
+	if err != nil {
+		return fmt.Errorf("failed to record confirmation response: %w", err)
+	}
+	return CheckRowsAffected(result, ErrConfirmationRequestClosed)
+}
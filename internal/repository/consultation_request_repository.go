@@ -0,0 +1,234 @@
+// internal/repository/consultation_request_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// CONSULTATION REQUEST REPOSITORY - Bookings that need a consultation
+// ========================================================================
+
+// ConsultationRequestRepository handles consultation requests
+type ConsultationRequestRepository struct {
+	db *sqlx.DB
+}
+
+// NewConsultationRequestRepository creates a new consultation request repository
+func NewConsultationRequestRepository(db *sqlx.DB) *ConsultationRequestRepository {
+	return &ConsultationRequestRepository{db: db}
+}
+
+// ConsultationRequestFilters narrows a barber's or a customer's
+// consultation requests
+type ConsultationRequestFilters struct {
+	BarberID   int    // 0 = any barber
+	CustomerID int    // 0 = any customer
+	Status     string // Empty = any status
+	Limit      int
+	Offset     int
+}
+
+// Create inserts a new request
+func (r *ConsultationRequestRepository) Create(ctx context.Context, request *models.ConsultationRequest) error {
+	query := `
+		INSERT INTO consultation_requests (
+			barber_id, barber_service_id, service_name, customer_id, customer_name, customer_email, customer_phone,
+			requested_start_time, answers, triggered_by, notes, status, preferred_times
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		request.BarberID, request.BarberServiceID, request.ServiceName, request.CustomerID,
+		request.CustomerName, request.CustomerEmail, request.CustomerPhone,
+		request.RequestedStartTime, request.Answers, request.TriggeredBy, request.Notes, request.Status,
+		request.PreferredTimes,
+	).Scan(&request.ID, &request.CreatedAt, &request.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create consultation request: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a request by its ID
+func (r *ConsultationRequestRepository) FindByID(ctx context.Context, id int) (*models.ConsultationRequest, error) {
+	var request models.ConsultationRequest
+	err := r.db.GetContext(ctx, &request, `SELECT * FROM consultation_requests WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrConsultationRequestNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find consultation request: %w", err)
+	}
+	return &request, nil
+}
+
+// FindAll lists requests, newest first
+func (r *ConsultationRequestRepository) FindAll(ctx context.Context, filters ConsultationRequestFilters) ([]models.ConsultationRequest, error) {
+	qb := NewQueryBuilder(`SELECT * FROM consultation_requests`).
+		WhereIf(filters.BarberID != 0, "barber_id = ?", filters.BarberID).
+		WhereIf(filters.CustomerID != 0, "customer_id = ?", filters.CustomerID).
+		WhereIf(filters.Status != "", "status = ?", filters.Status).
+		OrderBy("created_at DESC, id", "DESC")
+	query, args := qb.Paginate(filters.Limit, filters.Offset).Build()
+
+	requests := []models.ConsultationRequest{}
+	if err := r.db.SelectContext(ctx, &requests, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list consultation requests: %w", err)
+	}
+	return requests, nil
+}
+
+// Respond records the barber's answer to a pending request. It returns
+// ErrConsultationRequestNotFound if the request was answered meanwhile.
+func (r *ConsultationRequestRepository) Respond(ctx context.Context, request *models.ConsultationRequest) error {
+	query := `
+		UPDATE consultation_requests SET
+			status = $2, response_notes = $3, responded_by = $4, responded_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status IN ('open', 'quoted')
+		RETURNING responded_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		request.ID, request.Status, request.ResponseNotes, request.RespondedBy,
+	).Scan(&request.RespondedAt, &request.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrConsultationRequestNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to respond to consultation request: %w", err)
+	}
+	return nil
+}
+
+// Quote records the barber's quote on a pending request, replacing any
+// earlier one. It returns ErrConsultationRequestNotFound if the request
+// was answered meanwhile.
+func (r *ConsultationRequestRepository) Quote(ctx context.Context, request *models.ConsultationRequest) error {
+	query := `
+		UPDATE consultation_requests SET
+			status = 'quoted', quoted_price = $2, proposed_start_time = $3, response_notes = $4, responded_by = $5,
+			quoted_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status IN ('open', 'quoted')
+		RETURNING status, quoted_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		request.ID, request.QuotedPrice, request.ProposedStartTime, request.ResponseNotes, request.RespondedBy,
+	).Scan(&request.Status, &request.QuotedAt, &request.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrConsultationRequestNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to quote consultation request: %w", err)
+	}
+	return nil
+}
+
+// ClaimQuote marks a quoted request booked while its booking is made, so
+// a quote is accepted only once. It returns ErrConsultationQuoteChanged
+// if the quote is no longer the one read (by quoted_at) or was accepted.
+func (r *ConsultationRequestRepository) ClaimQuote(ctx context.Context, request *models.ConsultationRequest) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE consultation_requests SET status = 'booked', updated_at = NOW()
+		WHERE id = $1 AND status = 'quoted' AND quoted_at = $2
+	`, request.ID, request.QuotedAt)
+	if err != nil {
+		return fmt.Errorf("failed to accept consultation quote: %w", err)
+	}
+	if rows, err := result.RowsAffected(); err != nil || rows == 0 {
+		return ErrConsultationQuoteChanged
+	}
+	return nil
+}
+
+// ReleaseQuote gives a claimed quote back to the customer when its
+// booking could not be made
+func (r *ConsultationRequestRepository) ReleaseQuote(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE consultation_requests SET status = 'quoted', updated_at = NOW()
+		WHERE id = $1 AND status = 'booked' AND booking_id IS NULL
+	`, id)
+	if err != nil {
+		return fmt.Errorf("failed to release consultation quote: %w", err)
+	}
+	return nil
+}
+
+// SetBooking links a claimed quote to the booking made from it
+func (r *ConsultationRequestRepository) SetBooking(ctx context.Context, request *models.ConsultationRequest) error {
+	err := r.db.QueryRowxContext(ctx, `
+		UPDATE consultation_requests SET booking_id = $2, responded_at = NOW(), updated_at = NOW()
+		WHERE id = $1
+		RETURNING responded_at, updated_at
+	`, request.ID, request.BookingID).Scan(&request.RespondedAt, &request.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to link consultation booking: %w", err)
+	}
+	return nil
+}
+
+// FindPhotos retrieves a request's photos in upload order
+func (r *ConsultationRequestRepository) FindPhotos(ctx context.Context, requestID int) ([]models.ConsultationPhoto, error) {
+	query := `SELECT * FROM consultation_photos WHERE consultation_request_id = $1 ORDER BY id`
+
+	photos := []models.ConsultationPhoto{}
+	if err := r.db.SelectContext(ctx, &photos, query, requestID); err != nil {
+		return nil, fmt.Errorf("failed to find consultation photos: %w", err)
+	}
+	return photos, nil
+}
+
+// CreatePhoto adds a photo to a request. Returns ErrConsultationPhotoLimit
+// when the request already has maxPhotos photos.
+func (r *ConsultationRequestRepository) CreatePhoto(ctx context.Context, photo *models.ConsultationPhoto, maxPhotos int) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the request so concurrent uploads cannot pass the limit together
+	var count int
+	err = tx.GetContext(ctx, &count, `
+		SELECT (SELECT COUNT(*) FROM consultation_photos WHERE consultation_request_id = c.id)
+		FROM consultation_requests c WHERE c.id = $1
+		FOR UPDATE
+	`, photo.ConsultationRequestID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrConsultationRequestNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock consultation request: %w", err)
+	}
+	if count >= maxPhotos {
+		return ErrConsultationPhotoLimit
+	}
+
+	query := `
+		INSERT INTO consultation_photos (
+			consultation_request_id, image_key, thumbnail_key, url, thumbnail_url,
+			content_type, size_bytes, width, height, uploaded_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at
+	`
+	err = tx.QueryRowContext(ctx, query,
+		photo.ConsultationRequestID, photo.ImageKey, photo.ThumbnailKey, photo.URL, photo.ThumbnailURL,
+		photo.ContentType, photo.SizeBytes, photo.Width, photo.Height, photo.UploadedBy,
+	).Scan(&photo.ID, &photo.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create consultation photo: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit consultation photo: %w", err)
+	}
+	return nil
+}
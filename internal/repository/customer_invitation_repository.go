@@ -0,0 +1,82 @@
+// internal/repository/customer_invitation_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// CUSTOMER INVITATION REPOSITORY - Claiming imported accounts
+// ========================================================================
+
+// CustomerInvitationRepository handles invitations to claim imported accounts
+type CustomerInvitationRepository struct {
+	db *sqlx.DB
+}
+
+// NewCustomerInvitationRepository creates a new customer invitation repository
+func NewCustomerInvitationRepository(db *sqlx.DB) *CustomerInvitationRepository {
+	return &CustomerInvitationRepository{db: db}
+}
+
+// Create inserts a new invitation
+func (r *CustomerInvitationRepository) Create(ctx context.Context, invitation *models.CustomerInvitation) error {
+	query := `
+		INSERT INTO customer_invitations (user_id, barber_id, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		invitation.UserID, invitation.BarberID, invitation.TokenHash, invitation.ExpiresAt,
+	).Scan(&invitation.ID, &invitation.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create customer invitation: %w", err)
+	}
+	return nil
+}
+
+// FindByHash retrieves an invitation by the hash of its token
+func (r *CustomerInvitationRepository) FindByHash(ctx context.Context, tokenHash string) (*models.CustomerInvitation, error) {
+	var invitation models.CustomerInvitation
+	err := r.db.GetContext(ctx, &invitation, `SELECT * FROM customer_invitations WHERE token_hash = $1`, tokenHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find customer invitation: %w", err)
+	}
+	return &invitation, nil
+}
+
+// MarkClaimed closes an open invitation and every other invitation of the
+// same user. Only the first claim succeeds; later ones get
+// ErrInvitationClosed.
+func (r *CustomerInvitationRepository) MarkClaimed(ctx context.Context, invitation *models.CustomerInvitation, now time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE customer_invitations SET claimed_at = $2
+		WHERE id = $1 AND claimed_at IS NULL AND expires_at > $2
+	`, invitation.ID, now)
+	if err != nil {
+		return fmt.Errorf("failed to claim customer invitation: %w", err)
+	}
+	if err := CheckRowsAffected(result, ErrInvitationClosed); err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		UPDATE customer_invitations SET claimed_at = $2
+		WHERE user_id = $1 AND claimed_at IS NULL
+	`, invitation.UserID, now)
+	if err != nil {
+		return fmt.Errorf("failed to close customer invitations: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+// internal/repository/customer_timeline_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// CUSTOMER TIMELINE REPOSITORY - Activity timeline projection
+// ========================================================================
+
+// timelineEventsQuery is the event stream a customer's timeline is projected
+// from: booking history, reviews, and payments recorded on bookings
+const timelineEventsQuery = `
+	SELECT 'booking_history' AS source, bh.id AS source_id, b.id AS booking_id,
+		bh.change_type AS kind, bh.old_values, bh.new_values, bh.created_at AS occurred_at,
+		b.booking_number, b.service_name, br.shop_name AS barber_name,
+		b.scheduled_start_time, b.total_price, b.currency, NULL::int AS rating
+	FROM booking_history bh
+	JOIN bookings b ON b.id = bh.booking_id
+	LEFT JOIN barbers br ON br.id = b.barber_id
+	WHERE b.customer_id = $1
+
+	UNION ALL
+
+	SELECT 'review', r.id, b.id,
+		'review', NULL, NULL, r.created_at,
+		b.booking_number, b.service_name, br.shop_name,
+		b.scheduled_start_time, b.total_price, b.currency, r.overall_rating
+	FROM reviews r
+	JOIN bookings b ON b.id = r.booking_id
+	LEFT JOIN barbers br ON br.id = b.barber_id
+	WHERE r.customer_id = $1
+
+	UNION ALL
+
+	SELECT 'payment', b.id, b.id,
+		'paid', NULL, NULL, b.paid_at,
+		b.booking_number, b.service_name, br.shop_name,
+		b.scheduled_start_time, b.total_price, b.currency, NULL
+	FROM bookings b
+	LEFT JOIN barbers br ON br.id = b.barber_id
+	WHERE b.customer_id = $1 AND b.paid_at IS NOT NULL
+`
+
+// CustomerTimelineRepository handles the customer timeline read model
+type CustomerTimelineRepository struct {
+	db *sqlx.DB
+}
+
+// NewCustomerTimelineRepository creates a new customer timeline repository
+func NewCustomerTimelineRepository(db *sqlx.DB) *CustomerTimelineRepository {
+	return &CustomerTimelineRepository{db: db}
+}
+
+// TimelineFilters represents filter options for timeline queries
+type TimelineFilters struct {
+	EventTypes []string `form:"event_types"`
+	Limit      int      `form:"limit,default=50"`
+	Offset     int      `form:"offset,default=0"`
+}
+
+// ListParams implements ListFilters; events are always newest first
+func (f *TimelineFilters) ListParams() ListParams {
+	return ListParams{Limit: &f.Limit, Offset: &f.Offset}
+}
+
+// ========================================================================
+// EVENT STREAM
+// ========================================================================
+
+// LoadEvents returns every source event for a customer, oldest first
+func (r *CustomerTimelineRepository) LoadEvents(ctx context.Context, customerID int) ([]models.TimelineEvent, error) {
+	query := `SELECT * FROM (` + timelineEventsQuery + `) events ORDER BY occurred_at, source, source_id`
+
+	var events []models.TimelineEvent
+	if err := r.db.SelectContext(ctx, &events, query, customerID); err != nil {
+		return nil, fmt.Errorf("failed to load timeline events: %w", err)
+	}
+	return events, nil
+}
+
+// SourceVersion returns the newest event time and event count for a
+// customer's event stream; a cheap staleness check for the projection
+func (r *CustomerTimelineRepository) SourceVersion(ctx context.Context, customerID int) (models.TimelineVersion, error) {
+	query := `SELECT MAX(occurred_at) AS watermark, COUNT(*) AS event_count FROM (` + timelineEventsQuery + `) events`
+
+	var version models.TimelineVersion
+	if err := r.db.GetContext(ctx, &version, query, customerID); err != nil {
+		return version, fmt.Errorf("failed to read timeline source version: %w", err)
+	}
+	return version, nil
+}
+
+// ========================================================================
+// PROJECTION STORAGE
+// ========================================================================
+
+// FindState returns when a customer's timeline was last built.
+// Returns ErrCustomerTimelineNotFound if it has never been built.
+func (r *CustomerTimelineRepository) FindState(ctx context.Context, customerID int) (*models.TimelineState, error) {
+	var state models.TimelineState
+	err := r.db.GetContext(ctx, &state, `
+		SELECT customer_id, rebuilt_at, watermark, event_count
+		FROM customer_timeline_state
+		WHERE customer_id = $1
+	`, customerID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrCustomerTimelineNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find timeline state: %w", err)
+	}
+	return &state, nil
+}
+
+// Replace swaps a customer's timeline for freshly projected entries and
+// records the source version they were built from, in one transaction
+func (r *CustomerTimelineRepository) Replace(ctx context.Context, customerID int, entries []models.TimelineEntry, version models.TimelineVersion) (*models.TimelineState, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Serialize concurrent rebuilds of the same customer's timeline
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('customer_timeline'), $1)`, customerID); err != nil {
+		return nil, fmt.Errorf("failed to lock customer timeline: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM customer_timeline WHERE customer_id = $1`, customerID); err != nil {
+		return nil, fmt.Errorf("failed to clear customer timeline: %w", err)
+	}
+
+	for i := range entries {
+		e := &entries[i]
+		e.CustomerID = customerID
+		if e.Details == nil {
+			e.Details = models.JSONMap{}
+		}
+		err := tx.QueryRowContext(ctx, `
+			INSERT INTO customer_timeline (
+				customer_id, event_type, source, source_id, booking_id, title, details, occurred_at
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			RETURNING id
+		`, customerID, e.EventType, e.Source, e.SourceID, e.BookingID, e.Title, e.Details, e.OccurredAt).Scan(&e.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to insert timeline entry: %w", err)
+		}
+	}
+
+	state := &models.TimelineState{
+		CustomerID: customerID,
+		RebuiltAt:  time.Now(),
+		Watermark:  version.Watermark,
+		EventCount: version.EventCount,
+	}
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO customer_timeline_state (customer_id, rebuilt_at, watermark, event_count)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (customer_id) DO UPDATE SET
+			rebuilt_at = EXCLUDED.rebuilt_at,
+			watermark = EXCLUDED.watermark,
+			event_count = EXCLUDED.event_count
+	`, state.CustomerID, state.RebuiltAt, state.Watermark, state.EventCount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save timeline state: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit customer timeline: %w", err)
+	}
+	return state, nil
+}
+
+// FindEntries returns a page of a customer's timeline, newest first
+func (r *CustomerTimelineRepository) FindEntries(ctx context.Context, customerID int, filters TimelineFilters) ([]models.TimelineEntry, error) {
+	query := `
+		SELECT id, customer_id, event_type, source, source_id, booking_id, title, details, occurred_at
+		FROM customer_timeline
+		WHERE customer_id = $1
+	`
+	args := []interface{}{customerID}
+	argCount := 2
+
+	if len(filters.EventTypes) > 0 {
+		placeholders := make([]string, len(filters.EventTypes))
+		for i, t := range filters.EventTypes {
+			placeholders[i] = fmt.Sprintf("$%d", argCount)
+			args = append(args, t)
+			argCount++
+		}
+		query += fmt.Sprintf(" AND event_type IN (%s)", strings.Join(placeholders, ", "))
+	}
+
+	limit := 50
+	if filters.Limit > 0 {
+		limit = filters.Limit
+	}
+	query += fmt.Sprintf(" ORDER BY occurred_at DESC, id DESC LIMIT $%d OFFSET $%d", argCount, argCount+1)
+	args = append(args, limit, filters.Offset)
+
+	var entries []models.TimelineEntry
+	if err := r.db.SelectContext(ctx, &entries, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to find timeline entries: %w", err)
+	}
+	return entries, nil
+}
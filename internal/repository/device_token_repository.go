@@ -0,0 +1,89 @@
+// internal/repository/device_token_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// DEVICE TOKEN REPOSITORY - Push notification registrations
+// ========================================================================
+
+// DeviceTokenRepository handles device token database operations
+type DeviceTokenRepository struct {
+	db *sqlx.DB
+}
+
+// NewDeviceTokenRepository creates a new device token repository
+func NewDeviceTokenRepository(db *sqlx.DB) *DeviceTokenRepository {
+	return &DeviceTokenRepository{db: db}
+}
+
+// Upsert registers a device token for a user. A token that is already
+// registered is moved to this user and its platform and app version refreshed.
+func (r *DeviceTokenRepository) Upsert(ctx context.Context, device *models.DeviceToken) error {
+	query := `
+		INSERT INTO device_tokens (user_id, platform, token, app_version)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (token) DO UPDATE SET
+			user_id = EXCLUDED.user_id,
+			platform = EXCLUDED.platform,
+			app_version = EXCLUDED.app_version,
+			updated_at = NOW()
+		RETURNING id, last_used_at, created_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		device.UserID, device.Platform, device.Token, device.AppVersion,
+	).Scan(&device.ID, &device.LastUsedAt, &device.CreatedAt, &device.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to register device token: %w", err)
+	}
+	return nil
+}
+
+// FindByUser returns every device registered to a user
+func (r *DeviceTokenRepository) FindByUser(ctx context.Context, userID int) ([]models.DeviceToken, error) {
+	devices := []models.DeviceToken{}
+	err := r.db.SelectContext(ctx, &devices, `
+		SELECT * FROM device_tokens WHERE user_id = $1 ORDER BY id
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find device tokens: %w", err)
+	}
+	return devices, nil
+}
+
+// Delete unregisters one of a user's devices
+func (r *DeviceTokenRepository) Delete(ctx context.Context, userID int, token string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM device_tokens WHERE user_id = $1 AND token = $2
+	`, userID, token)
+	if err != nil {
+		return fmt.Errorf("failed to delete device token: %w", err)
+	}
+	return CheckRowsAffected(result, ErrDeviceTokenNotFound)
+}
+
+// DeleteByToken removes a token the push provider reported as invalid
+func (r *DeviceTokenRepository) DeleteByToken(ctx context.Context, token string) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM device_tokens WHERE token = $1`, token)
+	if err != nil {
+		return fmt.Errorf("failed to delete device token: %w", err)
+	}
+	return nil
+}
+
+// MarkUsed records a successful push to a device
+func (r *DeviceTokenRepository) MarkUsed(ctx context.Context, id int, at time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE device_tokens SET last_used_at = $1 WHERE id = $2`, at, id)
+	if err != nil {
+		return fmt.Errorf("failed to update device token: %w", err)
+	}
+	return nil
+}
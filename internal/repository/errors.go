@@ -23,11 +23,123 @@ var (
 	ErrBookingNotFound  = errors.New("booking not found")
 	ErrTimeSlotNotFound = errors.New("time slot not found")
 
+	// Booking series errors
+	ErrBookingSeriesNotFound = errors.New("booking series not found")
+
+	// Schedule errors
+	ErrBarberScheduleNotFound    = errors.New("barber schedule not found")
+	ErrScheduleExceptionNotFound = errors.New("schedule exception not found")
+	ErrTimeOffNotFound           = errors.New("time off not found")
+
 	// Review errors
-	ErrReviewNotFound = errors.New("review not found")
+	ErrReviewNotFound      = errors.New("review not found")
+	ErrReviewImageNotFound = errors.New("review image not found")
+
+	// Portfolio errors
+	ErrPortfolioMediaNotFound = errors.New("portfolio photo not found")
 
 	// Notification errors
 	ErrNotificationNotFound = errors.New("notification not found")
+
+	// Push device errors
+	ErrDeviceTokenNotFound = errors.New("device token not found")
+
+	// Win-back errors
+	ErrWinBackCampaignNotFound = errors.New("win-back campaign not found")
+	ErrCouponNotFound          = errors.New("coupon not found")
+
+	// Refresh token errors
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+
+	// Social sign-in errors
+	ErrUserIdentityNotFound = errors.New("linked sign-in account not found")
+
+	// Featured placement errors
+	ErrFeaturedPlacementNotFound = errors.New("featured placement not found")
+
+	// Experiment errors
+	ErrExperimentNotFound = errors.New("experiment not found")
+
+	// API quota errors
+	ErrAPIQuotaNotFound = errors.New("api quota not found")
+
+	// Auto-reply errors
+	ErrAutoReplyNotFound = errors.New("auto-reply not found")
+
+	// Suppression errors
+	ErrSuppressionNotFound = errors.New("suppression not found")
+
+	// Role errors
+	ErrRoleNotFound     = errors.New("role not found")
+	ErrUserRoleNotFound = errors.New("role is not assigned to this user")
+
+	// Audit log errors
+	ErrAuditLogNotFound = errors.New("audit log entry not found")
+
+	// Customer timeline errors
+	ErrCustomerTimelineNotFound = errors.New("customer timeline not built")
+
+	// NPS survey errors
+	ErrNPSSurveyNotFound = errors.New("nps survey not found")
+
+	// Review request errors
+	ErrReviewRequestNotFound = errors.New("review request not found")
+
+	// Confirmation request errors
+	ErrConfirmationRequestNotFound = errors.New("confirmation request not found")
+
+	// Booking action link errors
+	ErrActionLinkNotFound = errors.New("action link not found")
+
+	// Calendar feed errors
+	ErrCalendarFeedNotFound = errors.New("calendar feed not found")
+
+	// Location errors
+	ErrLocationNotFound = errors.New("location not found")
+
+	// Inventory errors
+	ErrInventoryItemNotFound = errors.New("inventory item not found")
+
+	// Tax errors
+	ErrTaxRuleNotFound = errors.New("tax rule not found")
+
+	// Service add-on errors
+	ErrAddOnNotFound = errors.New("service add-on not found")
+
+	// Customer invitation errors
+	ErrInvitationNotFound = errors.New("invitation not found")
+
+	// Webhook errors
+	ErrWebhookNotFound         = errors.New("webhook not found")
+	ErrWebhookDeliveryNotFound = errors.New("webhook delivery not found")
+
+	// Status page errors
+	ErrIncidentNotFound = errors.New("incident not found")
+
+	// Booking form errors
+	ErrFormFieldNotFound = errors.New("booking form field not found")
+
+	// Consultation request errors
+	ErrConsultationRequestNotFound = errors.New("consultation request not found")
+	ErrConsultationQuoteChanged    = errors.New("the consultation quote changed; review it before accepting")
+	ErrConsultationPhotoLimit      = errors.New("consultation request has the maximum number of photos")
+
+	// Open slot errors
+	ErrOpenSlotNotFound = errors.New("open slot not found")
+
+	// Support ticket errors
+	ErrSupportTicketNotFound = errors.New("support ticket not found")
+
+	// Shop errors
+	ErrShopNotFound       = errors.New("shop not found")
+	ErrShopAdminNotFound  = errors.New("user is not an admin of this shop")
+	ErrShopBarberNotFound = errors.New("barber does not work at this shop")
+
+	// Shop offboarding errors
+	ErrShopOffboardingNotFound = errors.New("shop offboarding not found")
+
+	// Schema backfill errors
+	ErrBackfillNotFound = errors.New("backfill not found")
 )
 
 // ========================================================================
@@ -48,9 +160,47 @@ var (
 
 	// Booking conflicts
 	ErrBookingConflict = errors.New("time slot already booked")
+	ErrDuplicateImport = errors.New("booking was already imported")
+
+	// Location duplicates
+	ErrDuplicateLocation = errors.New("barber already has a location with this name")
+
+	// Inventory duplicates
+	ErrDuplicateInventoryItem = errors.New("barber already tracks a product with this name")
+
+	// Service add-on duplicates
+	ErrDuplicateAddOn = errors.New("service already has an add-on with this name")
+
+	// Booking form duplicates
+	ErrDuplicateFormField = errors.New("booking form already has a field with this key")
+
+	// Client list duplicates
+	ErrDuplicateClient = errors.New("client is already on the barber's client list")
 
 	// Review duplicates
 	ErrDuplicateReview     = errors.New("review already exists for this booking")
+
+	// Social sign-in conflicts
+	ErrIdentityAlreadyLinked = errors.New("sign-in account is already linked to a user")
+
+	// Open slot conflicts
+	ErrOpenSlotTaken = errors.New("open slot has already been taken or is no longer available")
+
+	// Featured placement conflicts
+	ErrFeaturedSoldOut = errors.New("no featured slots left for these dates")
+	ErrFeaturedOverlap = errors.New("barber is already featured for these dates")
+
+	// Experiment conflicts
+	ErrDuplicateExperiment = errors.New("experiment key already exists")
+
+	// Role conflicts
+	ErrDuplicateRole       = errors.New("role already exists")
+	ErrRoleAlreadyAssigned = errors.New("role is already assigned to this user")
+
+	// Shop conflicts
+	ErrBarberInOtherShop  = errors.New("barber already works at a shop")
+	ErrDuplicateShopAdmin = errors.New("user is already an admin of this shop")
+	ErrOffboardingScheduled = errors.New("shop is already scheduled to leave the platform")
 )
 
 // ========================================================================
@@ -69,12 +219,37 @@ var (
 	ErrInvalidModeration   = errors.New("invalid moderation status")
 	ErrBookingNotCompleted = errors.New("can only review completed bookings")
 	ErrCannotModifyReview  = errors.New("review cannot be modified")
+	ErrReviewImageLimit    = errors.New("review has the maximum number of photos")
+	ErrAlreadyReported     = errors.New("you have already reported this review")
+
+	// Portfolio validation
+	ErrPortfolioLimit        = errors.New("service has the maximum number of photos of this kind")
+	ErrPortfolioOrderInvalid = errors.New("order must list each of the service's photos of this kind once")
 
 	// Notification validation
 	ErrInvalidNotificationType   = errors.New("invalid notification type")
 	ErrInvalidNotificationStatus = errors.New("invalid notification status")
 	ErrNotificationExpired       = errors.New("notification has expired")
 	ErrNotificationAlreadySent   = errors.New("notification has already been sent")
+
+	// NPS survey validation
+	ErrNPSSurveyExpired = errors.New("nps survey has expired")
+
+	// Review request validation
+	ErrReviewRequestClosed = errors.New("review request has already been used or expired")
+
+	// Confirmation request validation
+	ErrConfirmationRequestClosed = errors.New("confirmation request has already been answered or expired")
+
+	// Booking action link validation
+	ErrActionLinkUsed = errors.New("action link has already been used or expired")
+
+	// Customer invitation validation
+	ErrInvitationClosed = errors.New("invitation has already been claimed or expired")
+
+	// Coupon validation
+	ErrCouponExpired  = errors.New("coupon has expired")
+	ErrCouponRedeemed = errors.New("coupon has already been redeemed")
 )
 
 // ========================================================================
@@ -92,6 +267,19 @@ var (
 	ErrInsufficientNotice      = errors.New("insufficient notice for booking")
 	ErrCannotCancelCompleted   = errors.New("cannot cancel completed booking")
 	ErrAlreadyCancelled        = errors.New("booking already cancelled")
+
+	// Featured placement business rules
+	ErrFeaturedAlreadyStarted = errors.New("featured placement has already started")
+
+	// Experiment business rules
+	ErrExperimentNotEditable = errors.New("experiment can only be edited while in draft")
+	ErrExperimentNotExposed  = errors.New("user has not been exposed to this experiment")
+
+	// Role business rules
+	ErrSystemRole = errors.New("system roles cannot be assigned or deleted")
+
+	// Webhook business rules
+	ErrTooManyWebhooks = errors.New("barber has reached the maximum number of webhooks")
 )
 
 // ========================================================================
@@ -102,4 +290,14 @@ var (
 	ErrUnauthorized = errors.New("unauthorized")
 	ErrForbidden    = errors.New("forbidden")
 	ErrNotOwner     = errors.New("not the owner of this resource")
+
+	// Social sign-in
+	ErrIdentityEmailUnverified = errors.New("provider email is not verified")
+	ErrAccountEmailUnverified  = errors.New("an account with this email exists but has not verified it")
+	ErrLastSignInMethod        = errors.New("cannot unlink the only way to sign in")
+
+	// Refresh tokens
+	ErrRefreshTokenExpired = errors.New("refresh token has expired")
+	ErrRefreshTokenRevoked = errors.New("refresh token has been revoked")
+	ErrRefreshTokenReused  = errors.New("refresh token has already been used")
 )
@@ -0,0 +1,232 @@
+// internal/repository/experiment_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// EXPERIMENT REPOSITORY - A/B tests, exposures and conversions
+// ========================================================================
+
+// ExperimentRepository handles experiment database operations
+type ExperimentRepository struct {
+	db *sqlx.DB
+}
+
+// NewExperimentRepository creates a new experiment repository
+func NewExperimentRepository(db *sqlx.DB) *ExperimentRepository {
+	return &ExperimentRepository{db: db}
+}
+
+// ExperimentFilters narrows experiment listings
+type ExperimentFilters struct {
+	Status string
+	Limit  int
+	Offset int
+}
+
+const experimentSelect = `
+	SELECT id, key, name, description, status, variants, winner,
+		started_at, stopped_at, created_by, created_at, updated_at
+	FROM experiments
+`
+
+// Create inserts a new experiment
+func (r *ExperimentRepository) Create(ctx context.Context, experiment *models.Experiment) error {
+	query := `
+		INSERT INTO experiments (key, name, description, status, variants, created_by, created_at, updated_at)
+		VALUES (:key, :name, :description, :status, :variants, :created_by, :created_at, :updated_at)
+		RETURNING id
+	`
+	SetCreateTimestamps(&experiment.CreatedAt, &experiment.UpdatedAt)
+
+	rows, err := r.db.NamedQueryContext(ctx, query, experiment)
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrDuplicateExperiment
+		}
+		return fmt.Errorf("failed to create experiment: %w", err)
+	}
+	defer rows.Close()
+
+	if rows.Next() {
+		if err := rows.Scan(&experiment.ID); err != nil {
+			return fmt.Errorf("failed to scan experiment ID: %w", err)
+		}
+	}
+	return nil
+}
+
+// Update saves the name, description and variants of a draft experiment
+func (r *ExperimentRepository) Update(ctx context.Context, experiment *models.Experiment) error {
+	query := `
+		UPDATE experiments
+		SET name = :name, description = :description, variants = :variants, updated_at = :updated_at
+		WHERE id = :id AND status = 'draft'
+	`
+	SetUpdateTimestamp(&experiment.UpdatedAt)
+
+	result, err := r.db.NamedExecContext(ctx, query, experiment)
+	if err != nil {
+		return fmt.Errorf("failed to update experiment: %w", err)
+	}
+	return CheckRowsAffected(result, ErrExperimentNotEditable)
+}
+
+// UpdateStatus moves an experiment to status, recording when it first
+// started and when it stopped. winner is only kept when stopping.
+func (r *ExperimentRepository) UpdateStatus(ctx context.Context, id int, status string, winner *string, at time.Time) error {
+	query := `
+		UPDATE experiments
+		SET status = $2,
+			winner = CASE WHEN $2 = 'stopped' THEN $3 ELSE NULL END,
+			started_at = CASE WHEN $2 = 'running' THEN COALESCE(started_at, $4) ELSE started_at END,
+			stopped_at = CASE WHEN $2 = 'stopped' THEN $4 ELSE NULL END,
+			updated_at = $4
+		WHERE id = $1
+	`
+	result, err := r.db.ExecContext(ctx, query, id, status, winner, at)
+	if err != nil {
+		return fmt.Errorf("failed to update experiment status: %w", err)
+	}
+	return CheckRowsAffected(result, ErrExperimentNotFound)
+}
+
+// FindByKey returns the experiment with the given key
+func (r *ExperimentRepository) FindByKey(ctx context.Context, key string) (*models.Experiment, error) {
+	var experiment models.Experiment
+	err := r.db.GetContext(ctx, &experiment, experimentSelect+` WHERE key = $1`, key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrExperimentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find experiment: %w", err)
+	}
+	return &experiment, nil
+}
+
+// FindAll returns experiments, newest first
+func (r *ExperimentRepository) FindAll(ctx context.Context, filters ExperimentFilters) ([]models.Experiment, error) {
+	query, args := NewQueryBuilder(experimentSelect).
+		WhereIf(filters.Status != "", "status = ?", filters.Status).
+		OrderBy("created_at", "DESC").
+		Paginate(filters.Limit, filters.Offset).
+		Build()
+
+	experiments := []models.Experiment{}
+	if err := r.db.SelectContext(ctx, &experiments, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list experiments: %w", err)
+	}
+	return experiments, nil
+}
+
+// ========================================================================
+// EXPOSURES AND CONVERSIONS
+// ========================================================================
+
+// Expose logs that a user was shown variant. Only the first exposure is
+// kept; the stored exposure is returned either way, so a user keeps the
+// variant they first saw.
+func (r *ExperimentRepository) Expose(ctx context.Context, experimentID, userID int, variant string) (*models.ExperimentExposure, error) {
+	query := `
+		INSERT INTO experiment_exposures (experiment_id, user_id, variant)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (experiment_id, user_id) DO UPDATE SET experiment_id = EXCLUDED.experiment_id
+		RETURNING experiment_id, user_id, variant, exposed_at
+	`
+	var exposure models.ExperimentExposure
+	if err := r.db.GetContext(ctx, &exposure, query, experimentID, userID, variant); err != nil {
+		return nil, fmt.Errorf("failed to record experiment exposure: %w", err)
+	}
+	return &exposure, nil
+}
+
+// FindExposure returns a user's exposure to an experiment
+func (r *ExperimentRepository) FindExposure(ctx context.Context, experimentID, userID int) (*models.ExperimentExposure, error) {
+	query := `
+		SELECT experiment_id, user_id, variant, exposed_at
+		FROM experiment_exposures
+		WHERE experiment_id = $1 AND user_id = $2
+	`
+	var exposure models.ExperimentExposure
+	err := r.db.GetContext(ctx, &exposure, query, experimentID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrExperimentNotExposed
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find experiment exposure: %w", err)
+	}
+	return &exposure, nil
+}
+
+// RecordConversion inserts a conversion event
+func (r *ExperimentRepository) RecordConversion(ctx context.Context, conversion *models.ExperimentConversion) error {
+	query := `
+		INSERT INTO experiment_conversions (experiment_id, user_id, variant, event, value)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRowxContext(ctx, query,
+		conversion.ExperimentID, conversion.UserID, conversion.Variant, conversion.Event, conversion.Value,
+	).Scan(&conversion.ID, &conversion.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record experiment conversion: %w", err)
+	}
+	return nil
+}
+
+// Report counts, per variant, the exposed users and those who converted on
+// metric within windowDays of their exposure (0 = any time). The booking
+// metrics look at the user's bookings; any other metric is an event name.
+func (r *ExperimentRepository) Report(ctx context.Context, experimentID int, metric string, windowDays int) ([]models.ExperimentVariantResult, error) {
+	var conversions string
+	switch metric {
+	case config.ExperimentMetricBooking, config.ExperimentMetricCompletedBooking:
+		conversions = `
+			SELECT SUM(b.total_price) AS value
+			FROM bookings b
+			WHERE b.customer_id = x.user_id
+			AND NOT b.is_test
+			AND b.created_at >= x.exposed_at
+			AND ($2 = 0 OR b.created_at < x.exposed_at + make_interval(days => $2))
+			AND ($3 <> 'completed_booking' OR b.status = 'completed')
+			HAVING COUNT(*) > 0
+		`
+	default:
+		conversions = `
+			SELECT COALESCE(SUM(c.value), 0) AS value
+			FROM experiment_conversions c
+			WHERE c.experiment_id = x.experiment_id AND c.user_id = x.user_id
+			AND c.event = $3
+			AND c.created_at >= x.exposed_at
+			AND ($2 = 0 OR c.created_at < x.exposed_at + make_interval(days => $2))
+			HAVING COUNT(*) > 0
+		`
+	}
+
+	query := `
+		SELECT x.variant,
+			COUNT(*) AS exposures,
+			COUNT(conv.value) AS conversions,
+			COALESCE(SUM(conv.value), 0) AS value
+		FROM experiment_exposures x
+		LEFT JOIN LATERAL (` + conversions + `) conv ON TRUE
+		WHERE x.experiment_id = $1
+		GROUP BY x.variant
+	`
+
+	results := []models.ExperimentVariantResult{}
+	if err := r.db.SelectContext(ctx, &results, query, experimentID, windowDays, metric); err != nil {
+		return nil, fmt.Errorf("failed to build experiment report: %w", err)
+	}
+	return results, nil
+}
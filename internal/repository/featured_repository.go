@@ -0,0 +1,310 @@
+// internal/repository/featured_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ========================================================================
+// FEATURED REPOSITORY - Paid search placements, inventory and tracking
+// ========================================================================
+
+// featuredPlacementSelect selects placements with their lifetime counters
+const featuredPlacementSelect = `
+	SELECT fp.*, b.shop_name AS barber_shop_name,
+		COALESCE(st.impressions, 0) AS impressions,
+		COALESCE(st.clicks, 0) AS clicks
+	FROM featured_placements fp
+	JOIN barbers b ON b.id = fp.barber_id
+	LEFT JOIN (
+		SELECT placement_id, SUM(impressions) AS impressions, SUM(clicks) AS clicks
+		FROM featured_placement_stats
+		GROUP BY placement_id
+	) st ON st.placement_id = fp.id
+`
+
+// featuredMarketMatch matches placements in the market given by $1 (city)
+// and $2 (category ID, 0 = all categories)
+const featuredMarketMatch = `LOWER(fp.city) = LOWER($1) AND COALESCE(fp.category_id, 0) = $2`
+
+// FeaturedRepository handles featured placement database operations
+type FeaturedRepository struct {
+	db *sqlx.DB
+}
+
+// NewFeaturedRepository creates a new featured placement repository
+func NewFeaturedRepository(db *sqlx.DB) *FeaturedRepository {
+	return &FeaturedRepository{db: db}
+}
+
+// FeaturedPlacementFilters narrows placement listings
+type FeaturedPlacementFilters struct {
+	BarberID int
+	City     string
+	Status   string
+	Limit    int
+	Offset   int
+}
+
+// ========================================================================
+// INVENTORY
+// ========================================================================
+
+// SlotLimit returns the number of concurrent placements allowed in a market,
+// or defaultLimit when no override is set. categoryID 0 means all categories.
+func (r *FeaturedRepository) SlotLimit(ctx context.Context, city string, categoryID, defaultLimit int) (int, error) {
+	return slotLimit(ctx, r.db, city, categoryID, defaultLimit)
+}
+
+func slotLimit(ctx context.Context, q sqlx.QueryerContext, city string, categoryID, defaultLimit int) (int, error) {
+	var limit int
+	err := sqlx.GetContext(ctx, q, &limit, `
+		SELECT max_slots FROM featured_slot_limits
+		WHERE LOWER(city) = LOWER($1) AND COALESCE(category_id, 0) = $2
+	`, city, categoryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return defaultLimit, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to get featured slot limit: %w", err)
+	}
+	return limit, nil
+}
+
+// SlotUsage returns, for each day from start to end, how many placements in
+// the market hold a slot (pending payment or active)
+func (r *FeaturedRepository) SlotUsage(ctx context.Context, city string, categoryID int, start, end time.Time) ([]models.FeaturedSlotDay, error) {
+	return slotUsage(ctx, r.db, city, categoryID, start, end)
+}
+
+func slotUsage(ctx context.Context, q sqlx.QueryerContext, city string, categoryID int, start, end time.Time) ([]models.FeaturedSlotDay, error) {
+	query := `
+		SELECT d::date AS day, COUNT(fp.id) AS booked
+		FROM generate_series($3::date, $4::date, INTERVAL '1 day') d
+		LEFT JOIN featured_placements fp ON ` + featuredMarketMatch + `
+			AND fp.status <> 'cancelled'
+			AND d::date BETWEEN fp.starts_on AND fp.ends_on
+		GROUP BY d
+		ORDER BY d
+	`
+
+	days := []models.FeaturedSlotDay{}
+	if err := sqlx.SelectContext(ctx, q, &days, query, city, categoryID, start, end); err != nil {
+		return nil, fmt.Errorf("failed to get featured slot usage: %w", err)
+	}
+	return days, nil
+}
+
+// ListSlotLimits returns every market with an explicit slot limit
+func (r *FeaturedRepository) ListSlotLimits(ctx context.Context) ([]models.FeaturedSlotLimit, error) {
+	limits := []models.FeaturedSlotLimit{}
+	err := r.db.SelectContext(ctx, &limits, `
+		SELECT * FROM featured_slot_limits ORDER BY LOWER(city), COALESCE(category_id, 0)
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list featured slot limits: %w", err)
+	}
+	return limits, nil
+}
+
+// SetSlotLimit creates or replaces a market's slot limit. Lowering a limit
+// does not affect placements already sold.
+func (r *FeaturedRepository) SetSlotLimit(ctx context.Context, limit *models.FeaturedSlotLimit) error {
+	query := `
+		INSERT INTO featured_slot_limits (city, category_id, max_slots, updated_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (LOWER(city), COALESCE(category_id, 0)) DO UPDATE SET
+			max_slots = EXCLUDED.max_slots,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id, city, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query, limit.City, limit.CategoryID, limit.MaxSlots).
+		Scan(&limit.ID, &limit.City, &limit.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to set featured slot limit: %w", err)
+	}
+	return nil
+}
+
+// ========================================================================
+// PLACEMENTS
+// ========================================================================
+
+// Reserve inserts a pending placement if the market has a free slot on every
+// day of the range and the barber is not already featured there. Purchases
+// in the same market are serialized so two buyers cannot take the last slot.
+// Returns ErrFeaturedSoldOut or ErrFeaturedOverlap.
+func (r *FeaturedRepository) Reserve(ctx context.Context, placement *models.FeaturedPlacement, defaultLimit int) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	categoryID := 0
+	if placement.CategoryID != nil {
+		categoryID = *placement.CategoryID
+	}
+
+	_, err = tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('featured:' || LOWER($1) || ':' || $2::text))`,
+		placement.City, categoryID)
+	if err != nil {
+		return fmt.Errorf("failed to lock featured market: %w", err)
+	}
+
+	var overlapping bool
+	err = tx.GetContext(ctx, &overlapping, `
+		SELECT EXISTS (
+			SELECT 1 FROM featured_placements fp
+			WHERE `+featuredMarketMatch+`
+			AND fp.barber_id = $3
+			AND fp.status <> 'cancelled'
+			AND fp.starts_on <= $5 AND fp.ends_on >= $4
+		)
+	`, placement.City, categoryID, placement.BarberID, placement.StartsOn, placement.EndsOn)
+	if err != nil {
+		return fmt.Errorf("failed to check featured overlap: %w", err)
+	}
+	if overlapping {
+		return ErrFeaturedOverlap
+	}
+
+	limit, err := slotLimit(ctx, tx, placement.City, categoryID, defaultLimit)
+	if err != nil {
+		return err
+	}
+	usage, err := slotUsage(ctx, tx, placement.City, categoryID, placement.StartsOn, placement.EndsOn)
+	if err != nil {
+		return err
+	}
+	for _, day := range usage {
+		if day.Booked >= limit {
+			return ErrFeaturedSoldOut
+		}
+	}
+
+	err = tx.QueryRowxContext(ctx, `
+		INSERT INTO featured_placements (barber_id, city, category_id, starts_on, ends_on, status, amount, currency)
+		VALUES ($1, $2, $3, $4, $5, 'pending_payment', $6, $7)
+		RETURNING id, status, created_at, updated_at
+	`, placement.BarberID, placement.City, placement.CategoryID, placement.StartsOn, placement.EndsOn,
+		placement.Amount, placement.Currency,
+	).Scan(&placement.ID, &placement.Status, &placement.CreatedAt, &placement.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create featured placement: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit featured placement: %w", err)
+	}
+	return nil
+}
+
+// Activate records the payment for a pending placement and makes it live
+func (r *FeaturedRepository) Activate(ctx context.Context, id int, provider, reference string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE featured_placements SET
+			status = 'active',
+			payment_provider = $2,
+			payment_reference = $3,
+			updated_at = NOW()
+		WHERE id = $1 AND status = 'pending_payment'
+	`, id, provider, reference)
+	if err != nil {
+		return fmt.Errorf("failed to activate featured placement: %w", err)
+	}
+	return CheckRowsAffected(result, ErrFeaturedPlacementNotFound)
+}
+
+// Cancel releases a placement's slot, recording the refund if one was made
+func (r *FeaturedRepository) Cancel(ctx context.Context, id int, refundReference *string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE featured_placements SET
+			status = 'cancelled',
+			refund_reference = $2,
+			cancelled_at = NOW(),
+			updated_at = NOW()
+		WHERE id = $1 AND status <> 'cancelled'
+	`, id, refundReference)
+	if err != nil {
+		return fmt.Errorf("failed to cancel featured placement: %w", err)
+	}
+	return CheckRowsAffected(result, ErrFeaturedPlacementNotFound)
+}
+
+// FindByID retrieves a placement with its counters
+func (r *FeaturedRepository) FindByID(ctx context.Context, id int) (*models.FeaturedPlacement, error) {
+	var placement models.FeaturedPlacement
+	err := r.db.GetContext(ctx, &placement, featuredPlacementSelect+` WHERE fp.id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrFeaturedPlacementNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find featured placement: %w", err)
+	}
+	return &placement, nil
+}
+
+// FindAll lists placements, latest start first
+func (r *FeaturedRepository) FindAll(ctx context.Context, filters FeaturedPlacementFilters) ([]models.FeaturedPlacement, error) {
+	qb := NewQueryBuilder(featuredPlacementSelect).
+		WhereIf(filters.BarberID > 0, "fp.barber_id = ?", filters.BarberID).
+		WhereIf(filters.City != "", "LOWER(fp.city) = LOWER(?)", filters.City).
+		WhereIf(filters.Status != "", "fp.status = ?", filters.Status)
+
+	query, args := qb.
+		OrderBy("fp.starts_on DESC, fp.id", "DESC").
+		Paginate(filters.Limit, filters.Offset).
+		Build()
+
+	placements := []models.FeaturedPlacement{}
+	if err := r.db.SelectContext(ctx, &placements, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list featured placements: %w", err)
+	}
+	return placements, nil
+}
+
+// ========================================================================
+// TRACKING
+// ========================================================================
+
+// RecordImpressions counts one impression on day for each placement
+func (r *FeaturedRepository) RecordImpressions(ctx context.Context, placementIDs []int, day time.Time) error {
+	if len(placementIDs) == 0 {
+		return nil
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO featured_placement_stats (placement_id, day, impressions)
+		SELECT id, $2, COUNT(*) FROM unnest($1::int[]) AS id GROUP BY id
+		ON CONFLICT (placement_id, day) DO UPDATE SET
+			impressions = featured_placement_stats.impressions + EXCLUDED.impressions
+	`, pq.Array(placementIDs), day)
+	if err != nil {
+		return fmt.Errorf("failed to record featured impressions: %w", err)
+	}
+	return nil
+}
+
+// RecordClick counts a click on day for an active placement
+func (r *FeaturedRepository) RecordClick(ctx context.Context, placementID int, day time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO featured_placement_stats (placement_id, day, clicks)
+		SELECT id, $2, 1 FROM featured_placements WHERE id = $1 AND status = 'active'
+		ON CONFLICT (placement_id, day) DO UPDATE SET
+			clicks = featured_placement_stats.clicks + 1
+	`, placementID, day)
+	if err != nil {
+		return fmt.Errorf("failed to record featured click: %w", err)
+	}
+	return CheckRowsAffected(result, ErrFeaturedPlacementNotFound)
+}
@@ -0,0 +1,131 @@
+// internal/repository/financial_event_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// FINANCIAL EVENT REPOSITORY - Append-only, hash-chained ledger
+// ========================================================================
+
+// FinancialEventRepository appends to and reads the financial ledger
+type FinancialEventRepository struct {
+	db *sqlx.DB
+}
+
+// NewFinancialEventRepository creates a new financial event repository
+func NewFinancialEventRepository(db *sqlx.DB) *FinancialEventRepository {
+	return &FinancialEventRepository{db: db}
+}
+
+// Append adds an event to the end of the chain. Appends are serialized so
+// each event links to the one before it; the event gets its ID, previous
+// hash and hash. An event is never dated before the one it follows.
+func (r *FinancialEventRepository) Append(ctx context.Context, event *models.FinancialEvent) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock(hashtext('financial_events'))`); err != nil {
+		return fmt.Errorf("failed to lock financial ledger: %w", err)
+	}
+
+	var last struct {
+		Hash       string    `db:"hash"`
+		OccurredAt time.Time `db:"occurred_at"`
+	}
+	err = tx.GetContext(ctx, &last, `SELECT hash, occurred_at FROM financial_events ORDER BY id DESC LIMIT 1`)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		event.PrevHash = config.FinancialChainGenesis
+	case err != nil:
+		return fmt.Errorf("failed to find last financial event: %w", err)
+	default:
+		event.PrevHash = last.Hash
+		if event.OccurredAt.Before(last.OccurredAt) {
+			event.OccurredAt = last.OccurredAt
+		}
+	}
+
+	if err := tx.GetContext(ctx, &event.ID, `SELECT nextval(pg_get_serial_sequence('financial_events', 'id'))`); err != nil {
+		return fmt.Errorf("failed to allocate financial event id: %w", err)
+	}
+	event.OccurredAt = event.OccurredAt.UTC().Truncate(time.Microsecond)
+	event.Hash = event.ComputeHash()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO financial_events (
+			id, event_type, entity_type, entity_id, barber_id, amount, currency,
+			provider, reference, details, occurred_at, prev_hash, hash
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+	`, event.ID, event.EventType, event.EntityType, event.EntityID, event.BarberID, event.Amount, event.Currency,
+		event.Provider, event.Reference, event.Details, event.OccurredAt, event.PrevHash, event.Hash)
+	if err != nil {
+		return fmt.Errorf("failed to append financial event: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// FindAuthorization retrieves the authorization event for a provider
+// reference, or nil if the ledger has none
+func (r *FinancialEventRepository) FindAuthorization(ctx context.Context, reference string) (*models.FinancialEvent, error) {
+	var event models.FinancialEvent
+	err := r.db.GetContext(ctx, &event, `
+		SELECT * FROM financial_events
+		WHERE reference = $1 AND event_type = $2
+		ORDER BY id ASC
+		LIMIT 1
+	`, reference, config.FinancialEventPaymentAuthorized)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find authorization event: %w", err)
+	}
+	return &event, nil
+}
+
+// FindInPeriod retrieves the events that occurred in [from, to), in chain
+// order
+func (r *FinancialEventRepository) FindInPeriod(ctx context.Context, from, to time.Time) ([]models.FinancialEvent, error) {
+	events := []models.FinancialEvent{}
+	err := r.db.SelectContext(ctx, &events, `
+		SELECT * FROM financial_events
+		WHERE occurred_at >= $1 AND occurred_at < $2
+		ORDER BY id ASC
+	`, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find financial events: %w", err)
+	}
+	return events, nil
+}
+
+// FindAfter retrieves up to limit events following afterID, in chain order
+func (r *FinancialEventRepository) FindAfter(ctx context.Context, afterID int64, limit int) ([]models.FinancialEvent, error) {
+	events := []models.FinancialEvent{}
+	err := r.db.SelectContext(ctx, &events, `
+		SELECT * FROM financial_events
+		WHERE id > $1
+		ORDER BY id ASC
+		LIMIT $2
+	`, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find financial events: %w", err)
+	}
+	return events, nil
+}
@@ -69,6 +69,16 @@ func IsDuplicateError(err error) bool {
 		strings.Contains(errMsg, "violates unique")
 }
 
+// IsExclusionViolation checks if an error is caused by an exclusion constraint
+// (e.g. bookings_no_overlap rejecting an overlapping booking).
+func IsExclusionViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return strings.Contains(strings.ToLower(err.Error()), "violates exclusion constraint")
+}
+
 // IsFieldDuplicate checks if a specific field caused the duplicate error.
 // Useful for returning specific error messages.
 //
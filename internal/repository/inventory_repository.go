@@ -0,0 +1,190 @@
+// internal/repository/inventory_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// INVENTORY REPOSITORY - Product stock and usage
+// ========================================================================
+
+// InventoryRepository handles barbers' product stock and its usage by bookings
+type InventoryRepository struct {
+	db *sqlx.DB
+}
+
+// NewInventoryRepository creates a new inventory repository
+func NewInventoryRepository(db *sqlx.DB) *InventoryRepository {
+	return &InventoryRepository{db: db}
+}
+
+// ========================================================================
+// ITEMS
+// ========================================================================
+
+// FindByID retrieves an inventory item by its ID
+func (r *InventoryRepository) FindByID(ctx context.Context, id int) (*models.InventoryItem, error) {
+	var item models.InventoryItem
+	err := r.db.GetContext(ctx, &item, `SELECT * FROM inventory_items WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrInventoryItemNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find inventory item: %w", err)
+	}
+	return &item, nil
+}
+
+// FindByBarberID retrieves a barber's inventory by name, optionally only
+// the items at or below their low-stock threshold
+func (r *InventoryRepository) FindByBarberID(ctx context.Context, barberID int, lowStockOnly bool) ([]models.InventoryItem, error) {
+	query := `SELECT * FROM inventory_items WHERE barber_id = $1`
+	if lowStockOnly {
+		query += ` AND quantity <= low_stock_threshold`
+	}
+	query += ` ORDER BY name ASC`
+
+	items := []models.InventoryItem{}
+	if err := r.db.SelectContext(ctx, &items, query, barberID); err != nil {
+		return nil, fmt.Errorf("failed to find inventory items: %w", err)
+	}
+	return items, nil
+}
+
+// CountByBarberID counts the products a barber tracks
+func (r *InventoryRepository) CountByBarberID(ctx context.Context, barberID int) (int, error) {
+	var count int
+	err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM inventory_items WHERE barber_id = $1`, barberID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count inventory items: %w", err)
+	}
+	return count, nil
+}
+
+// Create inserts a new inventory item
+func (r *InventoryRepository) Create(ctx context.Context, item *models.InventoryItem) error {
+	query := `
+		INSERT INTO inventory_items (barber_id, name, unit, quantity, usage_per_service, low_stock_threshold)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		item.BarberID, item.Name, item.Unit, item.Quantity, item.UsagePerService, item.LowStockThreshold,
+	).Scan(&item.ID, &item.CreatedAt, &item.UpdatedAt)
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrDuplicateInventoryItem
+		}
+		return fmt.Errorf("failed to create inventory item: %w", err)
+	}
+	return nil
+}
+
+// Update saves an inventory item, including its stock
+func (r *InventoryRepository) Update(ctx context.Context, item *models.InventoryItem) error {
+	query := `
+		UPDATE inventory_items SET
+			name = $2, unit = $3, quantity = $4, usage_per_service = $5, low_stock_threshold = $6,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		item.ID, item.Name, item.Unit, item.Quantity, item.UsagePerService, item.LowStockThreshold,
+	).Scan(&item.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrInventoryItemNotFound
+	}
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrDuplicateInventoryItem
+		}
+		return fmt.Errorf("failed to update inventory item: %w", err)
+	}
+	return nil
+}
+
+// Delete removes an inventory item with its usage history
+func (r *InventoryRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM inventory_items WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete inventory item: %w", err)
+	}
+	return CheckRowsAffected(result, ErrInventoryItemNotFound)
+}
+
+// ========================================================================
+// USAGE
+// ========================================================================
+
+// RecordBookingUsage takes the products a booking's services require out of
+// the barber's stock: usage_per_service of each item whose name (ignoring
+// case) is in any of the services' required_products. Stock never goes below zero.
+// Usage is recorded once per booking, so running it again changes nothing.
+// Returns the items whose stock changed.
+func (r *InventoryRepository) RecordBookingUsage(ctx context.Context, bookingID int) ([]models.InventoryStockChange, error) {
+	query := `
+		WITH used AS (
+			INSERT INTO inventory_usage (item_id, barber_id, booking_id, quantity)
+			SELECT i.id, i.barber_id, bk.id, LEAST(i.usage_per_service, i.quantity)
+			FROM bookings bk
+			JOIN inventory_items i ON i.barber_id = bk.barber_id
+			WHERE bk.id = $1
+				AND i.usage_per_service > 0
+				AND EXISTS (
+					SELECT 1
+					FROM booking_line_items li
+					JOIN barber_services bs ON bs.id = li.barber_service_id
+					JOIN services s ON s.id = bs.service_id
+					CROSS JOIN LATERAL jsonb_array_elements_text(
+						CASE WHEN jsonb_typeof(s.required_products) = 'array' THEN s.required_products ELSE '[]'::jsonb END
+					) AS p(name)
+					WHERE li.booking_id = bk.id AND LOWER(TRIM(p.name)) = LOWER(i.name)
+				)
+			ON CONFLICT (item_id, booking_id) DO NOTHING
+			RETURNING item_id, quantity
+		)
+		UPDATE inventory_items i SET quantity = i.quantity - used.quantity, updated_at = NOW()
+		FROM used
+		JOIN inventory_items previous ON previous.id = used.item_id
+		WHERE i.id = used.item_id AND used.quantity > 0
+		RETURNING i.*, previous.quantity AS previous_quantity
+	`
+
+	changes := []models.InventoryStockChange{}
+	if err := r.db.SelectContext(ctx, &changes, query, bookingID); err != nil {
+		return nil, fmt.Errorf("failed to record inventory usage: %w", err)
+	}
+	return changes, nil
+}
+
+// UsageByItem totals a barber's product usage by completed bookings in
+// [from, to), for every item they track
+func (r *InventoryRepository) UsageByItem(ctx context.Context, barberID int, from, to time.Time) ([]models.InventoryItemUsage, error) {
+	query := `
+		SELECT i.id AS item_id, i.name, i.unit, i.quantity AS in_stock,
+			COALESCE(SUM(u.quantity), 0) AS quantity,
+			COUNT(u.id) AS bookings
+		FROM inventory_items i
+		LEFT JOIN inventory_usage u ON u.item_id = i.id AND u.created_at >= $2 AND u.created_at < $3
+		WHERE i.barber_id = $1
+		GROUP BY i.id
+		ORDER BY quantity DESC, i.name ASC
+	`
+
+	usage := []models.InventoryItemUsage{}
+	if err := r.db.SelectContext(ctx, &usage, query, barberID, from, to); err != nil {
+		return nil, fmt.Errorf("failed to find inventory usage: %w", err)
+	}
+	return usage, nil
+}
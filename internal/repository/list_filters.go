@@ -0,0 +1,89 @@
+// internal/repository/list_filters.go
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// LIST FILTERS - Shared validation of list endpoint queries
+// ========================================================================
+// Filter types bound from a list endpoint's query string implement
+// ListFilters, pointing at their paging and sorting fields and the date
+// ranges they filter on, so every list checks them the same way:
+// sort_by must be one the repository sorts by, order ASC or DESC, limit
+// is capped at the consumer's largest page, and ranges must not end before
+// they start.
+// ========================================================================
+
+// ListParams points at a list query's paging and sorting fields; nil
+// fields are not part of the query
+type ListParams struct {
+	SortBy *string
+	Order  *string
+	Limit  *int
+	Offset *int
+	Sorts  SortColumns // Keys sort_by accepts
+	Ranges []DateRange
+}
+
+// DateRange is a from/to pair of filters, named by their common prefix
+// (e.g. "created" for created_from and created_to)
+type DateRange struct {
+	Name string
+	From time.Time
+	To   time.Time
+}
+
+// ListFilters is a list query checked by ValidateListFilters
+type ListFilters interface {
+	ListParams() ListParams
+}
+
+// ValidateListFilters checks a list query, normalizing order to upper
+// case and capping limit at maxLimit (config.MaxPageLimit when not
+// positive). A zero limit, which repositories read as "no limit", becomes
+// config.DefaultPageLimit.
+func ValidateListFilters(filters ListFilters, maxLimit int) error {
+	if maxLimit <= 0 {
+		maxLimit = config.MaxPageLimit
+	}
+	params := filters.ListParams()
+
+	if params.SortBy != nil && *params.SortBy != "" {
+		if _, ok := params.Sorts[*params.SortBy]; !ok {
+			return fmt.Errorf("sort_by must be one of: %s", strings.Join(params.Sorts.Keys(), ", "))
+		}
+	}
+	if params.Order != nil && *params.Order != "" {
+		order := strings.ToUpper(*params.Order)
+		if order != "ASC" && order != "DESC" {
+			return fmt.Errorf("order must be ASC or DESC")
+		}
+		*params.Order = order
+	}
+	if params.Limit != nil {
+		if *params.Limit < 0 {
+			return fmt.Errorf("limit cannot be negative")
+		}
+		if *params.Limit == 0 {
+			*params.Limit = config.DefaultPageLimit
+		}
+		if *params.Limit > maxLimit {
+			*params.Limit = maxLimit
+		}
+	}
+	if params.Offset != nil && *params.Offset < 0 {
+		return fmt.Errorf("offset cannot be negative")
+	}
+	for _, r := range params.Ranges {
+		if !r.From.IsZero() && !r.To.IsZero() && r.From.After(r.To) {
+			return fmt.Errorf("%s_from cannot be after %s_to", r.Name, r.Name)
+		}
+	}
+	return nil
+}
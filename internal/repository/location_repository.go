@@ -0,0 +1,152 @@
+// internal/repository/location_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// LOCATION REPOSITORY - Barber locations and travel times between them
+// ========================================================================
+
+// LocationRepository handles barber locations and travel-time matrices
+type LocationRepository struct {
+	db *sqlx.DB
+}
+
+// NewLocationRepository creates a new location repository
+func NewLocationRepository(db *sqlx.DB) *LocationRepository {
+	return &LocationRepository{db: db}
+}
+
+// ========================================================================
+// LOCATIONS
+// ========================================================================
+
+// FindByID retrieves a location by its ID
+func (r *LocationRepository) FindByID(ctx context.Context, id int) (*models.BarberLocation, error) {
+	var location models.BarberLocation
+	err := r.db.GetContext(ctx, &location, `SELECT * FROM barber_locations WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrLocationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find location: %w", err)
+	}
+	return &location, nil
+}
+
+// FindByBarberID retrieves a barber's locations by name
+func (r *LocationRepository) FindByBarberID(ctx context.Context, barberID int) ([]models.BarberLocation, error) {
+	locations := []models.BarberLocation{}
+	err := r.db.SelectContext(ctx, &locations, `SELECT * FROM barber_locations WHERE barber_id = $1 ORDER BY name ASC`, barberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find locations: %w", err)
+	}
+	return locations, nil
+}
+
+// Create inserts a new location
+func (r *LocationRepository) Create(ctx context.Context, location *models.BarberLocation) error {
+	query := `
+		INSERT INTO barber_locations (barber_id, name, address, city)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query, location.BarberID, location.Name, location.Address, location.City).
+		Scan(&location.ID, &location.CreatedAt, &location.UpdatedAt)
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrDuplicateLocation
+		}
+		return fmt.Errorf("failed to create location: %w", err)
+	}
+	return nil
+}
+
+// Update saves a location's name and address
+func (r *LocationRepository) Update(ctx context.Context, location *models.BarberLocation) error {
+	query := `
+		UPDATE barber_locations SET name = $2, address = $3, city = $4, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query, location.ID, location.Name, location.Address, location.City).
+		Scan(&location.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrLocationNotFound
+	}
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrDuplicateLocation
+		}
+		return fmt.Errorf("failed to update location: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a location with its travel times. Bookings at the location
+// keep their time but lose the location.
+func (r *LocationRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM barber_locations WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete location: %w", err)
+	}
+	return CheckRowsAffected(result, ErrLocationNotFound)
+}
+
+// ========================================================================
+// TRAVEL TIMES
+// ========================================================================
+
+// FindTravelTimes retrieves a barber's travel-time matrix entries
+func (r *LocationRepository) FindTravelTimes(ctx context.Context, barberID int) ([]models.TravelTime, error) {
+	query := `
+		SELECT from_location_id, to_location_id, minutes FROM barber_travel_times
+		WHERE barber_id = $1
+		ORDER BY from_location_id ASC, to_location_id ASC
+	`
+
+	times := []models.TravelTime{}
+	if err := r.db.SelectContext(ctx, &times, query, barberID); err != nil {
+		return nil, fmt.Errorf("failed to find travel times: %w", err)
+	}
+	return times, nil
+}
+
+// ReplaceTravelTimes replaces a barber's travel-time matrix in a single
+// transaction
+func (r *LocationRepository) ReplaceTravelTimes(ctx context.Context, barberID int, times []models.TravelTime) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM barber_travel_times WHERE barber_id = $1`, barberID); err != nil {
+		return fmt.Errorf("failed to clear travel times: %w", err)
+	}
+
+	for _, t := range times {
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO barber_travel_times (barber_id, from_location_id, to_location_id, minutes)
+			VALUES ($1, $2, $3, $4)
+		`, barberID, t.FromLocationID, t.ToLocationID, t.Minutes)
+		if err != nil {
+			return fmt.Errorf("failed to save travel time: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit travel times: %w", err)
+	}
+	return nil
+}
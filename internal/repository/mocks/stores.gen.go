@@ -0,0 +1,1022 @@
+// Code generated by cmd/mockgen from stores.go. DO NOT EDIT.
+
+package mocks
+
+import (
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockBookingStore is a mock repository.BookingStore
+type MockBookingStore struct {
+	mock.Mock
+}
+
+var _ repository.BookingStore = (*MockBookingStore)(nil)
+
+func (m *MockBookingStore) FindByID(ctx context.Context, id int) (*models.Booking, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.Booking)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) FindByUUID(ctx context.Context, uuid string) (*models.Booking, error) {
+	args := m.Called(ctx, uuid)
+	r0, _ := args.Get(0).(*models.Booking)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) FindByBookingNumber(ctx context.Context, bookingNumber string) (*models.Booking, error) {
+	args := m.Called(ctx, bookingNumber)
+	r0, _ := args.Get(0).(*models.Booking)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) FindAll(ctx context.Context, filters repository.BookingFilters) ([]models.Booking, error) {
+	args := m.Called(ctx, filters)
+	r0, _ := args.Get(0).([]models.Booking)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) FindByBarberID(ctx context.Context, barberID int, filters repository.BookingFilters) ([]models.Booking, error) {
+	args := m.Called(ctx, barberID, filters)
+	r0, _ := args.Get(0).([]models.Booking)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) FindByCustomerID(ctx context.Context, customerID int, filters repository.BookingFilters) ([]models.Booking, error) {
+	args := m.Called(ctx, customerID, filters)
+	r0, _ := args.Get(0).([]models.Booking)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) Count(ctx context.Context, filters repository.BookingFilters) (int, error) {
+	args := m.Called(ctx, filters)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockBookingStore) FindActiveInRange(ctx context.Context, barberID int, from time.Time, to time.Time) ([]models.Booking, error) {
+	args := m.Called(ctx, barberID, from, to)
+	r0, _ := args.Get(0).([]models.Booking)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) FindCalendarBookings(ctx context.Context, userID int, from time.Time, limit int) ([]repository.BookingWithRelations, error) {
+	args := m.Called(ctx, userID, from, limit)
+	r0, _ := args.Get(0).([]repository.BookingWithRelations)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) FindDashboardBookings(ctx context.Context, barberID int, dayStart time.Time, dayEnd time.Time, now time.Time, pendingUntil time.Time) ([]models.DashboardBooking, error) {
+	args := m.Called(ctx, barberID, dayStart, dayEnd, now, pendingUntil)
+	r0, _ := args.Get(0).([]models.DashboardBooking)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) FindNoShowCandidates(ctx context.Context, now time.Time, defaultGraceMinutes int) ([]models.NoShowCandidate, error) {
+	args := m.Called(ctx, now, defaultGraceMinutes)
+	r0, _ := args.Get(0).([]models.NoShowCandidate)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) FindStalePending(ctx context.Context, createdBefore time.Time) ([]models.Booking, error) {
+	args := m.Called(ctx, createdBefore)
+	r0, _ := args.Get(0).([]models.Booking)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) FindLineItems(ctx context.Context, bookingID int) ([]models.BookingLineItem, error) {
+	args := m.Called(ctx, bookingID)
+	r0, _ := args.Get(0).([]models.BookingLineItem)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) GetTodayBookings(ctx context.Context, barberID int) ([]models.Booking, error) {
+	args := m.Called(ctx, barberID)
+	r0, _ := args.Get(0).([]models.Booking)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) GetUpcomingBookings(ctx context.Context, filters repository.BookingFilters) ([]models.Booking, error) {
+	args := m.Called(ctx, filters)
+	r0, _ := args.Get(0).([]models.Booking)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) GetBarberStats(ctx context.Context, barberID int, from time.Time, to time.Time) (*repository.BookingStats, error) {
+	args := m.Called(ctx, barberID, from, to)
+	r0, _ := args.Get(0).(*repository.BookingStats)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) CountCancellationReasons(ctx context.Context, barberID int, from time.Time, to time.Time) ([]models.CancellationReasonCount, error) {
+	args := m.Called(ctx, barberID, from, to)
+	r0, _ := args.Get(0).([]models.CancellationReasonCount)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) GetHistory(ctx context.Context, bookingID int) ([]models.BookingHistory, error) {
+	args := m.Called(ctx, bookingID)
+	r0, _ := args.Get(0).([]models.BookingHistory)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) CheckConflict(ctx context.Context, barberID int, startTime time.Time, endTime time.Time, segments models.DurationSegments, travel models.Travel, excludeBookingID int) (bool, error) {
+	args := m.Called(ctx, barberID, startTime, endTime, segments, travel, excludeBookingID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingStore) CreateImported(ctx context.Context, booking *models.Booking, history *models.BookingHistory) error {
+	args := m.Called(ctx, booking, history)
+	return args.Error(0)
+}
+
+func (m *MockBookingStore) CreateHistory(ctx context.Context, history *models.BookingHistory) error {
+	args := m.Called(ctx, history)
+	return args.Error(0)
+}
+
+func (m *MockBookingStore) Update(ctx context.Context, booking *models.Booking) error {
+	args := m.Called(ctx, booking)
+	return args.Error(0)
+}
+
+func (m *MockBookingStore) UpdatePaymentStatus(ctx context.Context, id int, paymentStatus string, paymentMethod *string, paymentReference *string) error {
+	args := m.Called(ctx, id, paymentStatus, paymentMethod, paymentReference)
+	return args.Error(0)
+}
+
+func (m *MockBookingStore) UpdateCancellationFee(ctx context.Context, id int, fee float64) error {
+	args := m.Called(ctx, id, fee)
+	return args.Error(0)
+}
+
+func (m *MockBookingStore) BeginTx(ctx context.Context) (*sqlx.Tx, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*sqlx.Tx)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingStore) CheckConflictForUpdate(ctx context.Context, tx *sqlx.Tx, barberID int, startTime time.Time, endTime time.Time, segments models.DurationSegments, travel models.Travel, excludeBookingID int) (bool, error) {
+	args := m.Called(ctx, tx, barberID, startTime, endTime, segments, travel, excludeBookingID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingStore) CheckConflictOutsideSeriesForUpdate(ctx context.Context, tx *sqlx.Tx, barberID int, startTime time.Time, endTime time.Time, segments models.DurationSegments, travel models.Travel, seriesID int) (bool, error) {
+	args := m.Called(ctx, tx, barberID, startTime, endTime, segments, travel, seriesID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockBookingStore) CreateTx(ctx context.Context, tx *sqlx.Tx, booking *models.Booking) error {
+	args := m.Called(ctx, tx, booking)
+	return args.Error(0)
+}
+
+func (m *MockBookingStore) CreateHistoryTx(ctx context.Context, tx *sqlx.Tx, history *models.BookingHistory) error {
+	args := m.Called(ctx, tx, history)
+	return args.Error(0)
+}
+
+func (m *MockBookingStore) UpdateStatusTx(ctx context.Context, tx *sqlx.Tx, id int, newStatus string) error {
+	args := m.Called(ctx, tx, id, newStatus)
+	return args.Error(0)
+}
+
+func (m *MockBookingStore) UpdatePricingTx(ctx context.Context, tx *sqlx.Tx, booking *models.Booking) error {
+	args := m.Called(ctx, tx, booking)
+	return args.Error(0)
+}
+
+func (m *MockBookingStore) RescheduleTx(ctx context.Context, tx *sqlx.Tx, id int, startTime time.Time, endTime time.Time, durationMinutes int, segments models.DurationSegments) error {
+	args := m.Called(ctx, tx, id, startTime, endTime, durationMinutes, segments)
+	return args.Error(0)
+}
+
+func (m *MockBookingStore) CancelTx(ctx context.Context, tx *sqlx.Tx, id int, status string, cancelledBy *int, reasonCode *string, reason string) error {
+	args := m.Called(ctx, tx, id, status, cancelledBy, reasonCode, reason)
+	return args.Error(0)
+}
+
+func (m *MockBookingStore) SetCancellationTx(ctx context.Context, tx *sqlx.Tx, id int, cancelledBy *int, reasonCode *string, reason string) error {
+	args := m.Called(ctx, tx, id, cancelledBy, reasonCode, reason)
+	return args.Error(0)
+}
+
+func (m *MockBookingStore) LockStatusTx(ctx context.Context, tx *sqlx.Tx, id int, status string) error {
+	args := m.Called(ctx, tx, id, status)
+	return args.Error(0)
+}
+
+// MockBookingSeriesStore is a mock repository.BookingSeriesStore
+type MockBookingSeriesStore struct {
+	mock.Mock
+}
+
+var _ repository.BookingSeriesStore = (*MockBookingSeriesStore)(nil)
+
+func (m *MockBookingSeriesStore) FindByID(ctx context.Context, id int) (*models.BookingSeries, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.BookingSeries)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingSeriesStore) FindOccurrences(ctx context.Context, seriesID int) ([]models.Booking, error) {
+	args := m.Called(ctx, seriesID)
+	r0, _ := args.Get(0).([]models.Booking)
+	return r0, args.Error(1)
+}
+
+func (m *MockBookingSeriesStore) CreateTx(ctx context.Context, tx *sqlx.Tx, series *models.BookingSeries) error {
+	args := m.Called(ctx, tx, series)
+	return args.Error(0)
+}
+
+func (m *MockBookingSeriesStore) UpdateScheduleTx(ctx context.Context, tx *sqlx.Tx, id int, startTime time.Time, durationMinutes int) error {
+	args := m.Called(ctx, tx, id, startTime, durationMinutes)
+	return args.Error(0)
+}
+
+func (m *MockBookingSeriesStore) UpdateStatusTx(ctx context.Context, tx *sqlx.Tx, id int, status string) error {
+	args := m.Called(ctx, tx, id, status)
+	return args.Error(0)
+}
+
+// MockReviewStore is a mock repository.ReviewStore
+type MockReviewStore struct {
+	mock.Mock
+}
+
+var _ repository.ReviewStore = (*MockReviewStore)(nil)
+
+func (m *MockReviewStore) FindByID(ctx context.Context, id int) (*models.Review, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.Review)
+	return r0, args.Error(1)
+}
+
+func (m *MockReviewStore) FindByBookingID(ctx context.Context, bookingID int) (*models.Review, error) {
+	args := m.Called(ctx, bookingID)
+	r0, _ := args.Get(0).(*models.Review)
+	return r0, args.Error(1)
+}
+
+func (m *MockReviewStore) FindAll(ctx context.Context, filters repository.ReviewFilters) ([]models.Review, error) {
+	args := m.Called(ctx, filters)
+	r0, _ := args.Get(0).([]models.Review)
+	return r0, args.Error(1)
+}
+
+func (m *MockReviewStore) FindByBarberID(ctx context.Context, barberID int, filters repository.ReviewFilters) ([]models.Review, error) {
+	args := m.Called(ctx, barberID, filters)
+	r0, _ := args.Get(0).([]models.Review)
+	return r0, args.Error(1)
+}
+
+func (m *MockReviewStore) FindByCustomerID(ctx context.Context, customerID int, filters repository.ReviewFilters) ([]models.Review, error) {
+	args := m.Called(ctx, customerID, filters)
+	r0, _ := args.Get(0).([]models.Review)
+	return r0, args.Error(1)
+}
+
+func (m *MockReviewStore) Count(ctx context.Context, filters repository.ReviewFilters) (int, error) {
+	args := m.Called(ctx, filters)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockReviewStore) ExistsByBookingID(ctx context.Context, bookingID int) (bool, error) {
+	args := m.Called(ctx, bookingID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockReviewStore) GetBarberStats(ctx context.Context, barberID int) (*repository.ReviewStats, error) {
+	args := m.Called(ctx, barberID)
+	r0, _ := args.Get(0).(*repository.ReviewStats)
+	return r0, args.Error(1)
+}
+
+func (m *MockReviewStore) CountMatchingComments(ctx context.Context, comment string, since time.Time) (int, error) {
+	args := m.Called(ctx, comment, since)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockReviewStore) CountRatingOnly(ctx context.Context, barberID int, since time.Time) (int, error) {
+	args := m.Called(ctx, barberID, since)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockReviewStore) Create(ctx context.Context, review *models.Review) error {
+	args := m.Called(ctx, review)
+	return args.Error(0)
+}
+
+func (m *MockReviewStore) Update(ctx context.Context, review *models.Review) error {
+	args := m.Called(ctx, review)
+	return args.Error(0)
+}
+
+func (m *MockReviewStore) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockReviewStore) AddBarberResponse(ctx context.Context, id int, response string, status string, notes *string) error {
+	args := m.Called(ctx, id, response, status, notes)
+	return args.Error(0)
+}
+
+func (m *MockReviewStore) ModerateBarberResponse(ctx context.Context, id int, status string, moderatorID int, notes *string) error {
+	args := m.Called(ctx, id, status, moderatorID, notes)
+	return args.Error(0)
+}
+
+func (m *MockReviewStore) UpdateModerationStatus(ctx context.Context, id int, status string, moderatorID int, notes *string) error {
+	args := m.Called(ctx, id, status, moderatorID, notes)
+	return args.Error(0)
+}
+
+func (m *MockReviewStore) IncrementHelpfulVotes(ctx context.Context, id int, isHelpful bool) error {
+	args := m.Called(ctx, id, isHelpful)
+	return args.Error(0)
+}
+
+func (m *MockReviewStore) BeginTx(ctx context.Context) (*sqlx.Tx, error) {
+	args := m.Called(ctx)
+	r0, _ := args.Get(0).(*sqlx.Tx)
+	return r0, args.Error(1)
+}
+
+func (m *MockReviewStore) CreateTx(ctx context.Context, tx *sqlx.Tx, review *models.Review) error {
+	args := m.Called(ctx, tx, review)
+	return args.Error(0)
+}
+
+// MockBarberStore is a mock repository.BarberStore
+type MockBarberStore struct {
+	mock.Mock
+}
+
+var _ repository.BarberStore = (*MockBarberStore)(nil)
+
+func (m *MockBarberStore) FindByID(ctx context.Context, id int) (*models.Barber, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.Barber)
+	return r0, args.Error(1)
+}
+
+func (m *MockBarberStore) FindByUUID(ctx context.Context, uuid string) (*models.Barber, error) {
+	args := m.Called(ctx, uuid)
+	r0, _ := args.Get(0).(*models.Barber)
+	return r0, args.Error(1)
+}
+
+func (m *MockBarberStore) FindByUserID(ctx context.Context, userID int) (*models.Barber, error) {
+	args := m.Called(ctx, userID)
+	r0, _ := args.Get(0).(*models.Barber)
+	return r0, args.Error(1)
+}
+
+func (m *MockBarberStore) FindAll(ctx context.Context, filters repository.BarberFilters) ([]models.Barber, error) {
+	args := m.Called(ctx, filters)
+	r0, _ := args.Get(0).([]models.Barber)
+	return r0, args.Error(1)
+}
+
+func (m *MockBarberStore) FindNearby(ctx context.Context, lat float64, lng float64, radiusKm float64, limit int) ([]models.Barber, error) {
+	args := m.Called(ctx, lat, lng, radiusKm, limit)
+	r0, _ := args.Get(0).([]models.Barber)
+	return r0, args.Error(1)
+}
+
+func (m *MockBarberStore) FindTopServices(ctx context.Context, barberIDs []int, perBarber int) (map[int][]models.BarberService, error) {
+	args := m.Called(ctx, barberIDs, perBarber)
+	r0, _ := args.Get(0).(map[int][]models.BarberService)
+	return r0, args.Error(1)
+}
+
+func (m *MockBarberStore) GetStatistics(ctx context.Context, id int) (*repository.BarberStatistics, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*repository.BarberStatistics)
+	return r0, args.Error(1)
+}
+
+func (m *MockBarberStore) Create(ctx context.Context, barber *models.Barber) error {
+	args := m.Called(ctx, barber)
+	return args.Error(0)
+}
+
+func (m *MockBarberStore) Update(ctx context.Context, barber *models.Barber) error {
+	args := m.Called(ctx, barber)
+	return args.Error(0)
+}
+
+func (m *MockBarberStore) UpdateStatus(ctx context.Context, id int, status string) error {
+	args := m.Called(ctx, id, status)
+	return args.Error(0)
+}
+
+func (m *MockBarberStore) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockBarberStore) RefreshBookingStats(ctx context.Context, barberID int) error {
+	args := m.Called(ctx, barberID)
+	return args.Error(0)
+}
+
+func (m *MockBarberStore) RefreshAllStats(ctx context.Context, now time.Time) (int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockBarberStore) UpdateRatingStatsTx(ctx context.Context, tx *sqlx.Tx, barberID int) error {
+	args := m.Called(ctx, tx, barberID)
+	return args.Error(0)
+}
+
+func (m *MockBarberStore) UpdateRatingStats(ctx context.Context, barberID int) error {
+	args := m.Called(ctx, barberID)
+	return args.Error(0)
+}
+
+func (m *MockBarberStore) ReconcileRatingStats(ctx context.Context, now time.Time) (int, int, error) {
+	args := m.Called(ctx, now)
+	return args.Int(0), args.Int(1), args.Error(2)
+}
+
+// MockUserStore is a mock repository.UserStore
+type MockUserStore struct {
+	mock.Mock
+}
+
+var _ repository.UserStore = (*MockUserStore)(nil)
+
+func (m *MockUserStore) FindByID(ctx context.Context, id int) (*models.User, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.User)
+	return r0, args.Error(1)
+}
+
+func (m *MockUserStore) FindByEmail(ctx context.Context, email string) (*models.User, error) {
+	args := m.Called(ctx, email)
+	r0, _ := args.Get(0).(*models.User)
+	return r0, args.Error(1)
+}
+
+func (m *MockUserStore) FindByPhone(ctx context.Context, phone string) (*models.User, error) {
+	args := m.Called(ctx, phone)
+	r0, _ := args.Get(0).(*models.User)
+	return r0, args.Error(1)
+}
+
+func (m *MockUserStore) EmailExists(ctx context.Context, email string) (bool, error) {
+	args := m.Called(ctx, email)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserStore) Create(ctx context.Context, user *models.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserStore) Update(ctx context.Context, user *models.User) error {
+	args := m.Called(ctx, user)
+	return args.Error(0)
+}
+
+func (m *MockUserStore) UpdatePassword(ctx context.Context, userID int, hashedPassword string) error {
+	args := m.Called(ctx, userID, hashedPassword)
+	return args.Error(0)
+}
+
+func (m *MockUserStore) UpdateLastLogin(ctx context.Context, userID int) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserStore) MarkEmailVerified(ctx context.Context, userID int) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserStore) IsAccountLocked(ctx context.Context, userID int) (bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockUserStore) IncrementFailedLoginAttempts(ctx context.Context, userID int) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserStore) ResetFailedLoginAttempts(ctx context.Context, userID int) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserStore) LockAccount(ctx context.Context, userID int, duration time.Duration) error {
+	args := m.Called(ctx, userID, duration)
+	return args.Error(0)
+}
+
+// MockRefreshTokenStore is a mock repository.RefreshTokenStore
+type MockRefreshTokenStore struct {
+	mock.Mock
+}
+
+var _ repository.RefreshTokenStore = (*MockRefreshTokenStore)(nil)
+
+func (m *MockRefreshTokenStore) Create(ctx context.Context, token *models.RefreshToken) error {
+	args := m.Called(ctx, token)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenStore) FindByHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	args := m.Called(ctx, tokenHash)
+	r0, _ := args.Get(0).(*models.RefreshToken)
+	return r0, args.Error(1)
+}
+
+func (m *MockRefreshTokenStore) Rotate(ctx context.Context, oldID int, successor *models.RefreshToken) error {
+	args := m.Called(ctx, oldID, successor)
+	return args.Error(0)
+}
+
+func (m *MockRefreshTokenStore) RevokeFamily(ctx context.Context, familyID string, at time.Time) (int64, error) {
+	args := m.Called(ctx, familyID, at)
+	r0, _ := args.Get(0).(int64)
+	return r0, args.Error(1)
+}
+
+func (m *MockRefreshTokenStore) RevokeAllForUser(ctx context.Context, userID int, at time.Time) (int64, error) {
+	args := m.Called(ctx, userID, at)
+	r0, _ := args.Get(0).(int64)
+	return r0, args.Error(1)
+}
+
+func (m *MockRefreshTokenStore) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	r0, _ := args.Get(0).(int64)
+	return r0, args.Error(1)
+}
+
+// MockUserIdentityStore is a mock repository.UserIdentityStore
+type MockUserIdentityStore struct {
+	mock.Mock
+}
+
+var _ repository.UserIdentityStore = (*MockUserIdentityStore)(nil)
+
+func (m *MockUserIdentityStore) Create(ctx context.Context, identity *models.UserIdentity) error {
+	args := m.Called(ctx, identity)
+	return args.Error(0)
+}
+
+func (m *MockUserIdentityStore) FindByProviderSubject(ctx context.Context, provider string, subject string) (*models.UserIdentity, error) {
+	args := m.Called(ctx, provider, subject)
+	r0, _ := args.Get(0).(*models.UserIdentity)
+	return r0, args.Error(1)
+}
+
+func (m *MockUserIdentityStore) FindByUser(ctx context.Context, userID int) ([]models.UserIdentity, error) {
+	args := m.Called(ctx, userID)
+	r0, _ := args.Get(0).([]models.UserIdentity)
+	return r0, args.Error(1)
+}
+
+func (m *MockUserIdentityStore) Delete(ctx context.Context, userID int, provider string) error {
+	args := m.Called(ctx, userID, provider)
+	return args.Error(0)
+}
+
+func (m *MockUserIdentityStore) UpdateLastLogin(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// MockServiceStore is a mock repository.ServiceStore
+type MockServiceStore struct {
+	mock.Mock
+}
+
+var _ repository.ServiceStore = (*MockServiceStore)(nil)
+
+func (m *MockServiceStore) FindByID(ctx context.Context, id int) (*models.Service, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.Service)
+	return r0, args.Error(1)
+}
+
+func (m *MockServiceStore) FindByUUID(ctx context.Context, uuid string) (*models.Service, error) {
+	args := m.Called(ctx, uuid)
+	r0, _ := args.Get(0).(*models.Service)
+	return r0, args.Error(1)
+}
+
+func (m *MockServiceStore) FindBySlug(ctx context.Context, slug string) (*models.Service, error) {
+	args := m.Called(ctx, slug)
+	r0, _ := args.Get(0).(*models.Service)
+	return r0, args.Error(1)
+}
+
+func (m *MockServiceStore) FindAll(ctx context.Context, filters repository.ServiceFilters) ([]models.Service, error) {
+	args := m.Called(ctx, filters)
+	r0, _ := args.Get(0).([]models.Service)
+	return r0, args.Error(1)
+}
+
+func (m *MockServiceStore) Count(ctx context.Context, filters repository.ServiceFilters) (int, error) {
+	args := m.Called(ctx, filters)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockServiceStore) Create(ctx context.Context, service *models.Service) error {
+	args := m.Called(ctx, service)
+	return args.Error(0)
+}
+
+func (m *MockServiceStore) Update(ctx context.Context, service *models.Service) error {
+	args := m.Called(ctx, service)
+	return args.Error(0)
+}
+
+func (m *MockServiceStore) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockServiceStore) FindCategoryByID(ctx context.Context, id int) (*models.ServiceCategory, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.ServiceCategory)
+	return r0, args.Error(1)
+}
+
+func (m *MockServiceStore) FindAllCategories(ctx context.Context, activeOnly bool) ([]models.ServiceCategory, error) {
+	args := m.Called(ctx, activeOnly)
+	r0, _ := args.Get(0).([]models.ServiceCategory)
+	return r0, args.Error(1)
+}
+
+func (m *MockServiceStore) CreateCategory(ctx context.Context, category *models.ServiceCategory) error {
+	args := m.Called(ctx, category)
+	return args.Error(0)
+}
+
+func (m *MockServiceStore) UpdateCategory(ctx context.Context, category *models.ServiceCategory) error {
+	args := m.Called(ctx, category)
+	return args.Error(0)
+}
+
+func (m *MockServiceStore) DeleteCategory(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockServiceStore) FindBarberServiceByID(ctx context.Context, id int) (*models.BarberService, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.BarberService)
+	return r0, args.Error(1)
+}
+
+func (m *MockServiceStore) FindBarberServices(ctx context.Context, filters repository.BarberServiceFilters) ([]models.BarberService, error) {
+	args := m.Called(ctx, filters)
+	r0, _ := args.Get(0).([]models.BarberService)
+	return r0, args.Error(1)
+}
+
+func (m *MockServiceStore) GetServicesByBarberID(ctx context.Context, barberID int) ([]models.BarberService, error) {
+	args := m.Called(ctx, barberID)
+	r0, _ := args.Get(0).([]models.BarberService)
+	return r0, args.Error(1)
+}
+
+func (m *MockServiceStore) GetBarbersByServiceID(ctx context.Context, serviceID int) ([]models.BarberService, error) {
+	args := m.Called(ctx, serviceID)
+	r0, _ := args.Get(0).([]models.BarberService)
+	return r0, args.Error(1)
+}
+
+func (m *MockServiceStore) CreateBarberService(ctx context.Context, bs *models.BarberService) error {
+	args := m.Called(ctx, bs)
+	return args.Error(0)
+}
+
+func (m *MockServiceStore) UpdateBarberService(ctx context.Context, bs *models.BarberService) error {
+	args := m.Called(ctx, bs)
+	return args.Error(0)
+}
+
+func (m *MockServiceStore) DeleteBarberService(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockServiceStore) RefreshBarberServiceStats(ctx context.Context, windowStart time.Time, now time.Time) (int, error) {
+	args := m.Called(ctx, windowStart, now)
+	return args.Int(0), args.Error(1)
+}
+
+// MockScheduleStore is a mock repository.ScheduleStore
+type MockScheduleStore struct {
+	mock.Mock
+}
+
+var _ repository.ScheduleStore = (*MockScheduleStore)(nil)
+
+func (m *MockScheduleStore) FindByBarberID(ctx context.Context, barberID int) (*models.BarberSchedule, error) {
+	args := m.Called(ctx, barberID)
+	r0, _ := args.Get(0).(*models.BarberSchedule)
+	return r0, args.Error(1)
+}
+
+func (m *MockScheduleStore) ReplaceWeekly(ctx context.Context, barberID int, timezone string, periods []models.SchedulePeriod) error {
+	args := m.Called(ctx, barberID, timezone, periods)
+	return args.Error(0)
+}
+
+func (m *MockScheduleStore) CreateException(ctx context.Context, exception *models.ScheduleException) error {
+	args := m.Called(ctx, exception)
+	return args.Error(0)
+}
+
+func (m *MockScheduleStore) DeleteException(ctx context.Context, barberID int, exceptionID int) error {
+	args := m.Called(ctx, barberID, exceptionID)
+	return args.Error(0)
+}
+
+// MockAuditLogStore is a mock repository.AuditLogStore
+type MockAuditLogStore struct {
+	mock.Mock
+}
+
+var _ repository.AuditLogStore = (*MockAuditLogStore)(nil)
+
+func (m *MockAuditLogStore) FindByID(ctx context.Context, id int) (*models.AuditLog, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.AuditLog)
+	return r0, args.Error(1)
+}
+
+func (m *MockAuditLogStore) FindAll(ctx context.Context, filters repository.AuditLogFilters) ([]models.AuditLog, error) {
+	args := m.Called(ctx, filters)
+	r0, _ := args.Get(0).([]models.AuditLog)
+	return r0, args.Error(1)
+}
+
+func (m *MockAuditLogStore) Create(ctx context.Context, entry *models.AuditLog) error {
+	args := m.Called(ctx, entry)
+	return args.Error(0)
+}
+
+// MockNotificationStore is a mock repository.NotificationStore
+type MockNotificationStore struct {
+	mock.Mock
+}
+
+var _ repository.NotificationStore = (*MockNotificationStore)(nil)
+
+func (m *MockNotificationStore) FindByID(ctx context.Context, id int) (*models.Notification, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.Notification)
+	return r0, args.Error(1)
+}
+
+func (m *MockNotificationStore) FindByUserID(ctx context.Context, userID int, filters repository.NotificationFilters) ([]models.Notification, error) {
+	args := m.Called(ctx, userID, filters)
+	r0, _ := args.Get(0).([]models.Notification)
+	return r0, args.Error(1)
+}
+
+func (m *MockNotificationStore) GetUnreadNotifications(ctx context.Context, userID int, limit int) ([]models.Notification, error) {
+	args := m.Called(ctx, userID, limit)
+	r0, _ := args.Get(0).([]models.Notification)
+	return r0, args.Error(1)
+}
+
+func (m *MockNotificationStore) GetPendingNotifications(ctx context.Context, limit int) ([]models.Notification, error) {
+	args := m.Called(ctx, limit)
+	r0, _ := args.Get(0).([]models.Notification)
+	return r0, args.Error(1)
+}
+
+func (m *MockNotificationStore) GetByRelatedEntity(ctx context.Context, entityType string, entityID int) ([]models.Notification, error) {
+	args := m.Called(ctx, entityType, entityID)
+	r0, _ := args.Get(0).([]models.Notification)
+	return r0, args.Error(1)
+}
+
+func (m *MockNotificationStore) GetUserStats(ctx context.Context, userID int) (*repository.NotificationStats, error) {
+	args := m.Called(ctx, userID)
+	r0, _ := args.Get(0).(*repository.NotificationStats)
+	return r0, args.Error(1)
+}
+
+func (m *MockNotificationStore) GetUnreadCount(ctx context.Context, userID int) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockNotificationStore) Count(ctx context.Context, filters repository.NotificationFilters) (int, error) {
+	args := m.Called(ctx, filters)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockNotificationStore) Create(ctx context.Context, notification *models.Notification) error {
+	args := m.Called(ctx, notification)
+	return args.Error(0)
+}
+
+func (m *MockNotificationStore) CreateBatch(ctx context.Context, notifications []*models.Notification) error {
+	args := m.Called(ctx, notifications)
+	return args.Error(0)
+}
+
+func (m *MockNotificationStore) Delete(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockNotificationStore) DeleteOldNotifications(ctx context.Context, olderThan time.Duration) (int, error) {
+	args := m.Called(ctx, olderThan)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockNotificationStore) DeleteExpiredNotifications(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockNotificationStore) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.Notification, error) {
+	args := m.Called(ctx, now, lease, limit)
+	r0, _ := args.Get(0).([]models.Notification)
+	return r0, args.Error(1)
+}
+
+func (m *MockNotificationStore) ScheduleRetry(ctx context.Context, id int, nextAttemptAt time.Time, lastError string, delivered []string) error {
+	args := m.Called(ctx, id, nextAttemptAt, lastError, delivered)
+	return args.Error(0)
+}
+
+func (m *MockNotificationStore) Requeue(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockNotificationStore) MarkAsSent(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockNotificationStore) MarkAsDelivered(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockNotificationStore) MarkAsRead(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockNotificationStore) MarkAllAsRead(ctx context.Context, userID int) (int, error) {
+	args := m.Called(ctx, userID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockNotificationStore) MarkAsFailed(ctx context.Context, id int, errorMsg string) error {
+	args := m.Called(ctx, id, errorMsg)
+	return args.Error(0)
+}
+
+func (m *MockNotificationStore) FailUndelivered(ctx context.Context, createdBefore time.Time, reason string) (int, error) {
+	args := m.Called(ctx, createdBefore, reason)
+	return args.Int(0), args.Error(1)
+}
+
+// MockWebhookStore is a mock repository.WebhookStore
+type MockWebhookStore struct {
+	mock.Mock
+}
+
+var _ repository.WebhookStore = (*MockWebhookStore)(nil)
+
+func (m *MockWebhookStore) FindSubscriptionByID(ctx context.Context, id int) (*models.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.WebhookSubscription)
+	return r0, args.Error(1)
+}
+
+func (m *MockWebhookStore) FindSubscriptionsByBarber(ctx context.Context, barberID int) ([]models.WebhookSubscription, error) {
+	args := m.Called(ctx, barberID)
+	r0, _ := args.Get(0).([]models.WebhookSubscription)
+	return r0, args.Error(1)
+}
+
+func (m *MockWebhookStore) FindSubscribers(ctx context.Context, barberID int, eventType string) ([]models.WebhookSubscription, error) {
+	args := m.Called(ctx, barberID, eventType)
+	r0, _ := args.Get(0).([]models.WebhookSubscription)
+	return r0, args.Error(1)
+}
+
+func (m *MockWebhookStore) CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	args := m.Called(ctx, sub)
+	return args.Error(0)
+}
+
+func (m *MockWebhookStore) UpdateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	args := m.Called(ctx, sub)
+	return args.Error(0)
+}
+
+func (m *MockWebhookStore) DeleteSubscription(ctx context.Context, id int) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockWebhookStore) FindDeliveriesBySubscription(ctx context.Context, subscriptionID int, status string, limit int, offset int) ([]models.WebhookDelivery, int, error) {
+	args := m.Called(ctx, subscriptionID, status, limit, offset)
+	r0, _ := args.Get(0).([]models.WebhookDelivery)
+	return r0, args.Int(1), args.Error(2)
+}
+
+func (m *MockWebhookStore) FindDeliveryByID(ctx context.Context, id int) (*models.WebhookDelivery, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.WebhookDelivery)
+	return r0, args.Error(1)
+}
+
+func (m *MockWebhookStore) CreateDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error {
+	args := m.Called(ctx, deliveries)
+	return args.Error(0)
+}
+
+func (m *MockWebhookStore) Requeue(ctx context.Context, id int) (*models.WebhookDelivery, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.WebhookDelivery)
+	return r0, args.Error(1)
+}
+
+func (m *MockWebhookStore) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.WebhookDelivery, error) {
+	args := m.Called(ctx, now, lease, limit)
+	r0, _ := args.Get(0).([]models.WebhookDelivery)
+	return r0, args.Error(1)
+}
+
+func (m *MockWebhookStore) MarkDelivered(ctx context.Context, id int, responseStatus int, at time.Time) error {
+	args := m.Called(ctx, id, responseStatus, at)
+	return args.Error(0)
+}
+
+func (m *MockWebhookStore) ScheduleRetry(ctx context.Context, id int, responseStatus *int, lastError string, nextAttemptAt time.Time) error {
+	args := m.Called(ctx, id, responseStatus, lastError, nextAttemptAt)
+	return args.Error(0)
+}
+
+func (m *MockWebhookStore) MarkFailed(ctx context.Context, id int, responseStatus *int, lastError string) error {
+	args := m.Called(ctx, id, responseStatus, lastError)
+	return args.Error(0)
+}
+
+// MockPortfolioStore is a mock repository.PortfolioStore
+type MockPortfolioStore struct {
+	mock.Mock
+}
+
+var _ repository.PortfolioStore = (*MockPortfolioStore)(nil)
+
+func (m *MockPortfolioStore) FindByBarberServiceID(ctx context.Context, barberServiceID int, kind string) ([]models.PortfolioMedia, error) {
+	args := m.Called(ctx, barberServiceID, kind)
+	r0, _ := args.Get(0).([]models.PortfolioMedia)
+	return r0, args.Error(1)
+}
+
+func (m *MockPortfolioStore) FindByID(ctx context.Context, id int) (*models.PortfolioMedia, error) {
+	args := m.Called(ctx, id)
+	r0, _ := args.Get(0).(*models.PortfolioMedia)
+	return r0, args.Error(1)
+}
+
+func (m *MockPortfolioStore) Create(ctx context.Context, media *models.PortfolioMedia, maxMedia int) error {
+	args := m.Called(ctx, media, maxMedia)
+	return args.Error(0)
+}
+
+func (m *MockPortfolioStore) UpdateCaption(ctx context.Context, id int, caption *string) error {
+	args := m.Called(ctx, id, caption)
+	return args.Error(0)
+}
+
+func (m *MockPortfolioStore) Reorder(ctx context.Context, barberServiceID int, kind string, ids []int) error {
+	args := m.Called(ctx, barberServiceID, kind, ids)
+	return args.Error(0)
+}
+
+func (m *MockPortfolioStore) Delete(ctx context.Context, barberServiceID int, id int) (*models.PortfolioMedia, error) {
+	args := m.Called(ctx, barberServiceID, id)
+	r0, _ := args.Get(0).(*models.PortfolioMedia)
+	return r0, args.Error(1)
+}
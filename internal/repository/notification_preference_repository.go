@@ -0,0 +1,99 @@
+// internal/repository/notification_preference_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// NOTIFICATION PREFERENCE REPOSITORY - Channels per notification type
+// ========================================================================
+
+// NotificationPreferenceRepository handles notification preference database operations
+type NotificationPreferenceRepository struct {
+	db *sqlx.DB
+}
+
+// NewNotificationPreferenceRepository creates a new notification preference repository
+func NewNotificationPreferenceRepository(db *sqlx.DB) *NotificationPreferenceRepository {
+	return &NotificationPreferenceRepository{db: db}
+}
+
+// FindByUser returns a user's preferences, by notification type
+func (r *NotificationPreferenceRepository) FindByUser(ctx context.Context, userID int) ([]models.NotificationPreference, error) {
+	var prefs []models.NotificationPreference
+	err := r.db.SelectContext(ctx, &prefs, `
+		SELECT * FROM notification_preferences
+		WHERE user_id = $1
+		ORDER BY notification_type
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load notification preferences: %w", err)
+	}
+	return prefs, nil
+}
+
+// Find returns a user's preference for one notification type, or nil when
+// they have not set one
+func (r *NotificationPreferenceRepository) Find(ctx context.Context, userID int, notifType string) (*models.NotificationPreference, error) {
+	var pref models.NotificationPreference
+	err := r.db.GetContext(ctx, &pref, `
+		SELECT * FROM notification_preferences
+		WHERE user_id = $1 AND notification_type = $2
+	`, userID, notifType)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load notification preference: %w", err)
+	}
+	return &pref, nil
+}
+
+// Update stores prefs and removes the user's preferences for the reset
+// types, in one transaction
+func (r *NotificationPreferenceRepository) Update(ctx context.Context, userID int, prefs []models.NotificationPreference, reset []string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	for _, pref := range prefs {
+		channels := pref.Channels
+		if channels == nil {
+			channels = models.StringArray{}
+		}
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO notification_preferences (user_id, notification_type, channels, updated_at)
+			VALUES ($1, $2, $3, NOW())
+			ON CONFLICT (user_id, notification_type) DO UPDATE SET
+				channels = EXCLUDED.channels,
+				updated_at = EXCLUDED.updated_at
+		`, userID, pref.NotificationType, channels)
+		if err != nil {
+			return fmt.Errorf("failed to save notification preference: %w", err)
+		}
+	}
+
+	for _, notifType := range reset {
+		_, err := tx.ExecContext(ctx, `
+			DELETE FROM notification_preferences
+			WHERE user_id = $1 AND notification_type = $2
+		`, userID, notifType)
+		if err != nil {
+			return fmt.Errorf("failed to reset notification preference: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit notification preferences: %w", err)
+	}
+	return nil
+}
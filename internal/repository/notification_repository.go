@@ -6,6 +6,7 @@ import (
 	"barber-booking-system/internal/models"
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -79,6 +80,32 @@ type NotificationFilters struct {
 	Order  string `form:"order"`
 	Limit  int    `form:"limit,default=50"`
 	Offset int    `form:"offset,default=0"`
+
+	// Relations attached to list responses (see RelationLoader)
+	IncludeBarber bool `form:"include_barber"`
+}
+
+// Include returns the relations the filters ask to attach
+func (f NotificationFilters) Include() Include {
+	return Include{Barber: f.IncludeBarber}
+}
+
+// NotificationSortColumns are what FindAll sorts notifications by, newest
+// first without a sort
+var NotificationSortColumns = SortColumns{
+	// Ascending puts urgent first: urgent > high > normal > low
+	"priority":      {Expr: "CASE priority WHEN 'urgent' THEN 1 WHEN 'high' THEN 2 WHEN 'normal' THEN 3 ELSE 4 END"},
+	"scheduled_for": {Expr: "scheduled_for", NullsLast: true},
+	"created_at":    {Expr: "created_at"},
+}
+
+// ListParams implements ListFilters
+func (f *NotificationFilters) ListParams() ListParams {
+	return ListParams{
+		SortBy: &f.SortBy, Order: &f.Order, Limit: &f.Limit, Offset: &f.Offset,
+		Sorts:  NotificationSortColumns,
+		Ranges: []DateRange{{Name: "created", From: f.CreatedFrom, To: f.CreatedTo}},
+	}
 }
 
 // NotificationStats represents notification statistics
@@ -124,6 +151,18 @@ var ValidNotificationTypes = []string{
 	config.NotificationTypePasswordReset,
 	config.NotificationTypePromotion,
 	config.NotificationTypeSystemAlert,
+	config.NotificationTypeNPSSurvey,
+	config.NotificationTypeWinBack,
+	config.NotificationTypeAutoReply,
+	config.NotificationTypeLowStock,
+	config.NotificationTypeConfirmationRequest,
+	config.NotificationTypeBookingNoShow,
+	config.NotificationTypeCustomerInvitation,
+	config.NotificationTypeOpenSlot,
+	config.NotificationTypeSupportTicket,
+	config.NotificationTypeSupportSLABreach,
+	config.NotificationTypeRescheduleOffer,
+	config.NotificationTypeConsultationRequest,
 }
 
 // ValidNotificationPriorities defines allowed priority levels - using config constants
@@ -260,20 +299,50 @@ func (r *NotificationRepository) FindByID(ctx context.Context, id int) (*models.
 
 // FindAll retrieves notifications with optional filters
 func (r *NotificationRepository) FindAll(ctx context.Context, filters NotificationFilters) ([]models.Notification, error) {
-	query := `SELECT * FROM notifications WHERE 1=1`
+	where, args := notificationWhere(filters)
+	query := `SELECT * FROM notifications` + where
+	argCount := len(args) + 1
+
+	// Sorting
+	query += " ORDER BY " + NotificationSortColumns.OrderBy(filters.SortBy, filters.Order, NotificationSortColumns["created_at"])
+
+	// Pagination
+	limit := 50
+	if filters.Limit > 0 {
+		limit = filters.Limit
+	}
+	offset := 0
+	if filters.Offset > 0 {
+		offset = filters.Offset
+	}
+	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
+	args = append(args, limit, offset)
+
+	var notifications []models.Notification
+	err := r.db.SelectContext(ctx, &notifications, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// notificationWhere builds the WHERE clause shared by FindAll and Count
+func notificationWhere(filters NotificationFilters) (string, []interface{}) {
+	where := " WHERE 1=1"
 	args := []interface{}{}
 	argCount := 1
 
 	// User filter
 	if filters.UserID > 0 {
-		query += fmt.Sprintf(" AND user_id = $%d", argCount)
+		where += fmt.Sprintf(" AND user_id = $%d", argCount)
 		args = append(args, filters.UserID)
 		argCount++
 	}
 
 	// Single type filter
 	if filters.Type != "" {
-		query += fmt.Sprintf(" AND type = $%d", argCount)
+		where += fmt.Sprintf(" AND type = $%d", argCount)
 		args = append(args, filters.Type)
 		argCount++
 	}
@@ -286,12 +355,12 @@ func (r *NotificationRepository) FindAll(ctx context.Context, filters Notificati
 			args = append(args, t)
 			argCount++
 		}
-		query += fmt.Sprintf(" AND type IN (%s)", strings.Join(placeholders, ", "))
+		where += fmt.Sprintf(" AND type IN (%s)", strings.Join(placeholders, ", "))
 	}
 
 	// Single status filter
 	if filters.Status != "" {
-		query += fmt.Sprintf(" AND status = $%d", argCount)
+		where += fmt.Sprintf(" AND status = $%d", argCount)
 		args = append(args, filters.Status)
 		argCount++
 	}
@@ -304,12 +373,12 @@ func (r *NotificationRepository) FindAll(ctx context.Context, filters Notificati
 			args = append(args, s)
 			argCount++
 		}
-		query += fmt.Sprintf(" AND status IN (%s)", strings.Join(placeholders, ", "))
+		where += fmt.Sprintf(" AND status IN (%s)", strings.Join(placeholders, ", "))
 	}
 
 	// Priority filter
 	if filters.Priority != "" {
-		query += fmt.Sprintf(" AND priority = $%d", argCount)
+		where += fmt.Sprintf(" AND priority = $%d", argCount)
 		args = append(args, filters.Priority)
 		argCount++
 	}
@@ -322,12 +391,12 @@ func (r *NotificationRepository) FindAll(ctx context.Context, filters Notificati
 			args = append(args, p)
 			argCount++
 		}
-		query += fmt.Sprintf(" AND priority IN (%s)", strings.Join(placeholders, ", "))
+		where += fmt.Sprintf(" AND priority IN (%s)", strings.Join(placeholders, ", "))
 	}
 
 	// Channel filter (checks if channel is in array)
 	if filters.Channel != "" {
-		query += fmt.Sprintf(" AND $%d = ANY(channels)", argCount)
+		where += fmt.Sprintf(" AND $%d = ANY(channels)", argCount)
 		args = append(args, filters.Channel)
 		argCount++
 	}
@@ -335,49 +404,49 @@ func (r *NotificationRepository) FindAll(ctx context.Context, filters Notificati
 	// Read status filter
 	if filters.IsRead != nil {
 		if *filters.IsRead {
-			query += " AND read_at IS NOT NULL"
+			where += " AND read_at IS NOT NULL"
 		}
 	}
 	if filters.IsUnread != nil {
 		if *filters.IsUnread {
-			query += " AND read_at IS NULL"
+			where += " AND read_at IS NULL"
 		}
 	}
 
 	// Related entity filters
 	if filters.RelatedEntityType != "" {
-		query += fmt.Sprintf(" AND related_entity_type = $%d", argCount)
+		where += fmt.Sprintf(" AND related_entity_type = $%d", argCount)
 		args = append(args, filters.RelatedEntityType)
 		argCount++
 	}
 	if filters.RelatedEntityID > 0 {
-		query += fmt.Sprintf(" AND related_entity_id = $%d", argCount)
+		where += fmt.Sprintf(" AND related_entity_id = $%d", argCount)
 		args = append(args, filters.RelatedEntityID)
 		argCount++
 	}
 
 	// Date range filters
 	if !filters.CreatedFrom.IsZero() {
-		query += fmt.Sprintf(" AND created_at >= $%d", argCount)
+		where += fmt.Sprintf(" AND created_at >= $%d", argCount)
 		args = append(args, filters.CreatedFrom)
 		argCount++
 	}
 	if !filters.CreatedTo.IsZero() {
-		query += fmt.Sprintf(" AND created_at <= $%d", argCount)
+		where += fmt.Sprintf(" AND created_at <= $%d", argCount)
 		args = append(args, filters.CreatedTo)
 		argCount++
 	}
 
 	// Scheduled for filter
 	if !filters.ScheduledFor.IsZero() {
-		query += fmt.Sprintf(" AND scheduled_for <= $%d", argCount)
+		where += fmt.Sprintf(" AND scheduled_for <= $%d", argCount)
 		args = append(args, filters.ScheduledFor)
 		argCount++
 	}
 
 	// Search filter
 	if filters.Search != "" {
-		query += fmt.Sprintf(" AND (title ILIKE $%d OR message ILIKE $%d)", argCount, argCount+1)
+		where += fmt.Sprintf(" AND (title ILIKE $%d OR message ILIKE $%d)", argCount, argCount+1)
 		searchPattern := "%" + filters.Search + "%"
 		args = append(args, searchPattern, searchPattern)
 		argCount += 2
@@ -385,47 +454,10 @@ func (r *NotificationRepository) FindAll(ctx context.Context, filters Notificati
 
 	// Expired filter
 	if !filters.IncludeExpired {
-		query += " AND (expires_at IS NULL OR expires_at > NOW())"
+		where += " AND (expires_at IS NULL OR expires_at > NOW())"
 	}
 
-	// Sorting
-	orderBy := "created_at DESC" // Default sort
-	if filters.SortBy != "" {
-		order := "DESC"
-		if filters.Order != "" && (filters.Order == "ASC" || filters.Order == "asc") {
-			order = "ASC"
-		}
-		switch filters.SortBy {
-		case "priority":
-			// Priority order: urgent > high > normal > low
-			orderBy = fmt.Sprintf("CASE priority WHEN 'urgent' THEN 1 WHEN 'high' THEN 2 WHEN 'normal' THEN 3 ELSE 4 END %s", order)
-		case "scheduled_for":
-			orderBy = fmt.Sprintf("scheduled_for %s NULLS LAST", order)
-		case "created_at":
-			orderBy = fmt.Sprintf("created_at %s", order)
-		}
-	}
-	query += " ORDER BY " + orderBy
-
-	// Pagination
-	limit := 50
-	if filters.Limit > 0 {
-		limit = filters.Limit
-	}
-	offset := 0
-	if filters.Offset > 0 {
-		offset = filters.Offset
-	}
-	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
-	args = append(args, limit, offset)
-
-	var notifications []models.Notification
-	err := r.db.SelectContext(ctx, &notifications, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find notifications: %w", err)
-	}
-
-	return notifications, nil
+	return where, args
 }
 
 // ========================================================================
@@ -451,11 +483,13 @@ func (r *NotificationRepository) GetUnreadNotifications(ctx context.Context, use
 	return r.FindAll(ctx, filters)
 }
 
-// GetPendingNotifications retrieves notifications ready to be sent
+// GetPendingNotifications retrieves notifications ready to be sent, created
+// within the delivery window
 func (r *NotificationRepository) GetPendingNotifications(ctx context.Context, limit int) ([]models.Notification, error) {
 	query := `
 		SELECT * FROM notifications
 		WHERE status = 'pending'
+		AND created_at >= $2
 		AND (scheduled_for IS NULL OR scheduled_for <= NOW())
 		AND (expires_at IS NULL OR expires_at > NOW())
 		ORDER BY
@@ -465,7 +499,7 @@ func (r *NotificationRepository) GetPendingNotifications(ctx context.Context, li
 	`
 
 	var notifications []models.Notification
-	err := r.db.SelectContext(ctx, &notifications, query, limit)
+	err := r.db.SelectContext(ctx, &notifications, query, limit, time.Now().Add(-config.NotificationDeliveryWindow))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get pending notifications: %w", err)
 	}
@@ -473,6 +507,84 @@ func (r *NotificationRepository) GetPendingNotifications(ctx context.Context, li
 	return notifications, nil
 }
 
+// ClaimPending claims up to limit deliverable notifications for a worker.
+// Claiming counts an attempt and hides the rows from other workers until
+// the lease ends, so a crashed worker's notifications are picked up again.
+// Only notifications created within the delivery window are considered,
+// which limits the scan to the latest partitions.
+func (r *NotificationRepository) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.Notification, error) {
+	query := `
+		UPDATE notifications SET
+			attempts = attempts + 1,
+			next_attempt_at = $2
+		WHERE created_at >= $4 AND id IN (
+			SELECT id FROM notifications
+			WHERE status = 'pending'
+			AND created_at >= $4
+			AND (scheduled_for IS NULL OR scheduled_for <= $1)
+			AND (expires_at IS NULL OR expires_at > $1)
+			AND (next_attempt_at IS NULL OR next_attempt_at <= $1)
+			ORDER BY
+				CASE priority WHEN 'urgent' THEN 1 WHEN 'high' THEN 2 WHEN 'normal' THEN 3 ELSE 4 END,
+				created_at ASC
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *
+	`
+
+	var notifications []models.Notification
+	err := r.db.SelectContext(ctx, &notifications, query, now, now.Add(lease), limit, now.Add(-config.NotificationDeliveryWindow))
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim pending notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+// ScheduleRetry records a failed delivery attempt and when to try again.
+// delivered lists the channels that already succeeded so a retry skips them.
+func (r *NotificationRepository) ScheduleRetry(ctx context.Context, id int, nextAttemptAt time.Time, lastError string, delivered []string) error {
+	deliveredJSON, err := json.Marshal(delivered)
+	if err != nil {
+		return fmt.Errorf("failed to encode delivered channels: %w", err)
+	}
+
+	query := `
+		UPDATE notifications SET
+			next_attempt_at = $1,
+			last_error = $2,
+			data = COALESCE(data, '{}'::jsonb) || jsonb_build_object('delivered_channels', $3::jsonb)
+		WHERE id = $4 AND status = 'pending'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, nextAttemptAt, lastError, string(deliveredJSON), id)
+	if err != nil {
+		return fmt.Errorf("failed to schedule notification retry: %w", err)
+	}
+
+	return CheckRowsAffected(result, ErrNotificationNotFound)
+}
+
+// Requeue returns a notification to the pending queue with a fresh set of
+// delivery attempts
+func (r *NotificationRepository) Requeue(ctx context.Context, id int) error {
+	query := `
+		UPDATE notifications SET
+			status = 'pending',
+			attempts = 0,
+			next_attempt_at = NULL
+		WHERE id = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to requeue notification: %w", err)
+	}
+
+	return CheckRowsAffected(result, ErrNotificationNotFound)
+}
+
 // GetByRelatedEntity retrieves notifications for a specific entity
 func (r *NotificationRepository) GetByRelatedEntity(ctx context.Context, entityType string, entityID int) ([]models.Notification, error) {
 	filters := NotificationFilters{
@@ -585,7 +697,8 @@ func (r *NotificationRepository) MarkAsFailed(ctx context.Context, id int, error
 	query := `
 		UPDATE notifications SET
 			status = 'failed',
-			data = data || jsonb_build_object('error', $1, 'failed_at', $2)
+			last_error = $1,
+			data = COALESCE(data, '{}'::jsonb) || jsonb_build_object('error', $1, 'failed_at', $2)
 		WHERE id = $3
 	`
 
@@ -597,6 +710,31 @@ func (r *NotificationRepository) MarkAsFailed(ctx context.Context, id int, error
 	return CheckRowsAffected(result, ErrNotificationNotFound)
 }
 
+// FailUndelivered marks notifications still pending that were created
+// before createdBefore as failed with reason. The delivery queries never
+// look past the delivery window, so these would otherwise stay pending.
+func (r *NotificationRepository) FailUndelivered(ctx context.Context, createdBefore time.Time, reason string) (int, error) {
+	query := `
+		UPDATE notifications SET
+			status = 'failed',
+			last_error = $2,
+			data = COALESCE(data, '{}'::jsonb) || jsonb_build_object('error', $2, 'failed_at', $3)
+		WHERE status = 'pending' AND created_at < $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, createdBefore, reason, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("failed to fail undelivered notifications: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return int(rowsAffected), nil
+}
+
 // ========================================================================
 // DELETE OPERATIONS
 // ========================================================================
@@ -678,31 +816,9 @@ func (r *NotificationRepository) GetUserStats(ctx context.Context, userID int) (
 
 // Count returns the total number of notifications matching the filters
 func (r *NotificationRepository) Count(ctx context.Context, filters NotificationFilters) (int, error) {
-	query := `SELECT COUNT(*) FROM notifications WHERE 1=1`
-	args := []interface{}{}
-	argCount := 1
-
-	if filters.UserID > 0 {
-		query += fmt.Sprintf(" AND user_id = $%d", argCount)
-		args = append(args, filters.UserID)
-		argCount++
-	}
-
-	if filters.Status != "" {
-		query += fmt.Sprintf(" AND status = $%d", argCount)
-		args = append(args, filters.Status)
-		argCount++
-	}
-
-	if filters.Type != "" {
-		query += fmt.Sprintf(" AND type = $%d", argCount)
-		args = append(args, filters.Type)
-		argCount++
-	}
-
-	if !filters.IncludeExpired {
-		query += " AND (expires_at IS NULL OR expires_at > NOW())"
-	}
+	// Same filters as FindAll; pagination and sorting are ignored
+	where, args := notificationWhere(filters)
+	query := `SELECT COUNT(*) FROM notifications` + where
 
 	var count int
 	err := r.db.GetContext(ctx, &count, query, args...)
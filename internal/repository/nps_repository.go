@@ -0,0 +1,133 @@
+// internal/repository/nps_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// NPS REPOSITORY - Post-appointment NPS micro-surveys
+// ========================================================================
+
+// NPSRepository handles NPS survey database operations
+type NPSRepository struct {
+	db *sqlx.DB
+}
+
+// NewNPSRepository creates a new NPS repository
+func NewNPSRepository(db *sqlx.DB) *NPSRepository {
+	return &NPSRepository{db: db}
+}
+
+// NPSTrendFilters selects the responses included in a trend
+type NPSTrendFilters struct {
+	BarberID *int // nil = platform-wide
+	From     time.Time
+	To       time.Time
+	Interval string // week or month
+}
+
+// CountCompletedBookings returns how many bookings a customer has completed
+func (r *NPSRepository) CountCompletedBookings(ctx context.Context, customerID int) (int, error) {
+	var count int
+	err := r.db.GetContext(ctx, &count, `
+		SELECT COUNT(*) FROM bookings
+		WHERE customer_id = $1 AND status = $2 AND NOT is_test
+	`, customerID, config.BookingStatusCompleted)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count completed bookings: %w", err)
+	}
+	return count, nil
+}
+
+// Create inserts a survey. It returns false without error when the booking
+// already has one (the completed hook can run more than once).
+func (r *NPSRepository) Create(ctx context.Context, survey *models.NPSSurvey) (bool, error) {
+	query := `
+		INSERT INTO nps_surveys (token, booking_id, customer_id, barber_id, sent_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (booking_id) DO NOTHING
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		survey.Token, survey.BookingID, survey.CustomerID, survey.BarberID, survey.SentAt, survey.ExpiresAt,
+	).Scan(&survey.ID, &survey.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to create nps survey: %w", err)
+	}
+	return true, nil
+}
+
+// FindByToken retrieves a survey by its link token
+func (r *NPSRepository) FindByToken(ctx context.Context, token string) (*models.NPSSurvey, error) {
+	var survey models.NPSSurvey
+	err := r.db.GetContext(ctx, &survey, `SELECT * FROM nps_surveys WHERE token = $1`, token)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNPSSurveyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find nps survey: %w", err)
+	}
+	return &survey, nil
+}
+
+// RecordResponse stores (or replaces) the answer to an open survey
+func (r *NPSRepository) RecordResponse(ctx context.Context, id, score int, comment *string, now time.Time) error {
+	query := `
+		UPDATE nps_surveys SET
+			score = $1,
+			comment = COALESCE($2, comment),
+			responded_at = $3
+		WHERE id = $4 AND expires_at > $3
+	`
+
+	result, err := r.db.ExecContext(ctx, query, score, comment, now, id)
+	if err != nil {
+		return fmt.Errorf("failed to record nps response: %w", err)
+	}
+	return CheckRowsAffected(result, ErrNPSSurveyExpired)
+}
+
+// Trend counts promoters, passives and detractors per week or month for
+// responses in [From, To)
+func (r *NPSRepository) Trend(ctx context.Context, filters NPSTrendFilters) ([]models.NPSTrendPoint, error) {
+	interval := config.NPSIntervalWeek
+	if filters.Interval == config.NPSIntervalMonth {
+		interval = config.NPSIntervalMonth
+	}
+
+	query := `
+		SELECT date_trunc($1, responded_at AT TIME ZONE 'UTC') AT TIME ZONE 'UTC' AS period_start,
+			COUNT(*) FILTER (WHERE score >= $2) AS promoters,
+			COUNT(*) FILTER (WHERE score >= $3 AND score < $2) AS passives,
+			COUNT(*) FILTER (WHERE score < $3) AS detractors
+		FROM nps_surveys
+		WHERE responded_at >= $4 AND responded_at < $5
+	`
+	args := []interface{}{interval, config.NPSPromoterMinScore, config.NPSPassiveMinScore, filters.From, filters.To}
+	argCount := 6
+
+	if filters.BarberID != nil {
+		query += fmt.Sprintf(" AND barber_id = $%d", argCount)
+		args = append(args, *filters.BarberID)
+	}
+	query += " GROUP BY period_start ORDER BY period_start ASC"
+
+	var points []models.NPSTrendPoint
+	if err := r.db.SelectContext(ctx, &points, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to load nps trend: %w", err)
+	}
+	return points, nil
+}
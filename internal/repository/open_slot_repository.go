@@ -0,0 +1,231 @@
+// internal/repository/open_slot_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// OPEN SLOT REPOSITORY - Last-minute openings and favorite barbers
+// ========================================================================
+
+// OpenSlotRepository handles open slots and customers' favorite barbers
+type OpenSlotRepository struct {
+	db *sqlx.DB
+}
+
+// NewOpenSlotRepository creates a new open slot repository
+func NewOpenSlotRepository(db *sqlx.DB) *OpenSlotRepository {
+	return &OpenSlotRepository{db: db}
+}
+
+// ========================================================================
+// FAVORITES
+// ========================================================================
+
+// AddFavorite favorites a barber for a customer; favoriting again is a no-op
+func (r *OpenSlotRepository) AddFavorite(ctx context.Context, userID, barberID int) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO favorite_barbers (user_id, barber_id) VALUES ($1, $2)
+		ON CONFLICT (user_id, barber_id) DO NOTHING
+	`, userID, barberID)
+	if err != nil {
+		return fmt.Errorf("failed to add favorite barber: %w", err)
+	}
+	return nil
+}
+
+// RemoveFavorite removes a barber from a customer's favorites; removing one
+// that is not a favorite is a no-op
+func (r *OpenSlotRepository) RemoveFavorite(ctx context.Context, userID, barberID int) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM favorite_barbers WHERE user_id = $1 AND barber_id = $2`, userID, barberID)
+	if err != nil {
+		return fmt.Errorf("failed to remove favorite barber: %w", err)
+	}
+	return nil
+}
+
+// FindFavorites retrieves a customer's favorite barbers, newest first
+func (r *OpenSlotRepository) FindFavorites(ctx context.Context, userID int) ([]models.FavoriteBarber, error) {
+	query := `
+		SELECT f.user_id, f.barber_id, b.shop_name, b.city, f.created_at
+		FROM favorite_barbers f
+		JOIN barbers b ON b.id = f.barber_id AND b.deleted_at IS NULL
+		WHERE f.user_id = $1
+		ORDER BY f.created_at DESC
+	`
+
+	favorites := []models.FavoriteBarber{}
+	if err := r.db.SelectContext(ctx, &favorites, query, userID); err != nil {
+		return nil, fmt.Errorf("failed to find favorite barbers: %w", err)
+	}
+	return favorites, nil
+}
+
+// ========================================================================
+// OPEN SLOTS
+// ========================================================================
+
+// FindByID retrieves an open slot by its ID
+func (r *OpenSlotRepository) FindByID(ctx context.Context, id int) (*models.OpenSlot, error) {
+	var slot models.OpenSlot
+	err := r.db.GetContext(ctx, &slot, `SELECT * FROM open_slots WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrOpenSlotNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find open slot: %w", err)
+	}
+	return &slot, nil
+}
+
+// FindByBarberID retrieves a barber's most recent open slots, newest first
+func (r *OpenSlotRepository) FindByBarberID(ctx context.Context, barberID, limit int) ([]models.OpenSlot, error) {
+	slots := []models.OpenSlot{}
+	err := r.db.SelectContext(ctx, &slots, `
+		SELECT * FROM open_slots WHERE barber_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, barberID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find open slots: %w", err)
+	}
+	return slots, nil
+}
+
+// CountCreatedSince counts the slots a barber broadcast since a time
+func (r *OpenSlotRepository) CountCreatedSince(ctx context.Context, barberID int, since time.Time) (int, error) {
+	var count int
+	err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM open_slots WHERE barber_id = $1 AND created_at >= $2`, barberID, since)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count open slots: %w", err)
+	}
+	return count, nil
+}
+
+// Create inserts a new open slot
+func (r *OpenSlotRepository) Create(ctx context.Context, slot *models.OpenSlot) error {
+	if slot.Audiences == nil {
+		slot.Audiences = models.StringArray{}
+	}
+
+	query := `
+		INSERT INTO open_slots (
+			barber_id, barber_service_id, service_name, start_time, duration_minutes,
+			service_price, discount_percent, message, audiences, radius_km,
+			status, claims_close_at, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		slot.BarberID, slot.BarberServiceID, slot.ServiceName, slot.StartTime, slot.DurationMinutes,
+		slot.ServicePrice, slot.DiscountPercent, slot.Message, slot.Audiences, slot.RadiusKm,
+		slot.Status, slot.ClaimsCloseAt, slot.CreatedBy,
+	).Scan(&slot.ID, &slot.CreatedAt, &slot.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create open slot: %w", err)
+	}
+	return nil
+}
+
+// SetRecipientCount records how many customers a slot was sent to
+func (r *OpenSlotRepository) SetRecipientCount(ctx context.Context, id, count int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE open_slots SET recipient_count = $2, updated_at = NOW() WHERE id = $1`, id, count)
+	if err != nil {
+		return fmt.Errorf("failed to update open slot: %w", err)
+	}
+	return nil
+}
+
+// FindRecipients retrieves the active customers an open slot goes to:
+// those who favorited the barber (when favorites is set) and those whose
+// location is within radiusKm of the barber (when nearby is set), up to
+// limit, favorites first and then nearest first. The barber's own account
+// is never included.
+func (r *OpenSlotRepository) FindRecipients(ctx context.Context, barberID int, favorites, nearby bool, radiusKm float64, limit int) ([]int, error) {
+	query := fmt.Sprintf(`
+		SELECT u.id FROM users u
+		JOIN barbers b ON b.id = $1
+		LEFT JOIN favorite_barbers f ON f.user_id = u.id AND f.barber_id = b.id
+		LEFT JOIN LATERAL (
+			SELECT %.1f * 2 * ASIN(LEAST(1, SQRT(
+				POWER(SIN(RADIANS(u.latitude - b.latitude) / 2), 2) +
+				COS(RADIANS(b.latitude)) * COS(RADIANS(u.latitude)) *
+				POWER(SIN(RADIANS(u.longitude - b.longitude) / 2), 2)
+			))) AS distance_km
+		) d ON TRUE
+		WHERE u.user_type = 'customer' AND u.status = 'active' AND u.deleted_at IS NULL
+			AND u.id <> b.user_id
+			AND (($2 AND f.user_id IS NOT NULL) OR ($3 AND d.distance_km <= $4))
+		ORDER BY f.user_id IS NULL, d.distance_km ASC NULLS LAST, u.id ASC
+		LIMIT $5
+	`, config.EarthRadiusKm)
+
+	ids := []int{}
+	if err := r.db.SelectContext(ctx, &ids, query, barberID, favorites, nearby, radiusKm, limit); err != nil {
+		return nil, fmt.Errorf("failed to find open slot recipients: %w", err)
+	}
+	return ids, nil
+}
+
+// Claim takes an open slot for a customer. Only one claim can succeed: it
+// returns ErrOpenSlotTaken if the slot is no longer open or claims have
+// closed by now.
+func (r *OpenSlotRepository) Claim(ctx context.Context, id, userID int, now time.Time) (*models.OpenSlot, error) {
+	var slot models.OpenSlot
+	err := r.db.GetContext(ctx, &slot, `
+		UPDATE open_slots SET status = $2, claimed_by = $3, claimed_at = $4, updated_at = NOW()
+		WHERE id = $1 AND status = $5 AND claims_close_at > $4
+		RETURNING *
+	`, id, config.OpenSlotStatusClaimed, userID, now, config.OpenSlotStatusOpen)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrOpenSlotTaken
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim open slot: %w", err)
+	}
+	return &slot, nil
+}
+
+// Release reopens a slot whose claim did not end in a booking
+func (r *OpenSlotRepository) Release(ctx context.Context, id, userID int) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE open_slots SET status = $3, claimed_by = NULL, claimed_at = NULL, updated_at = NOW()
+		WHERE id = $1 AND claimed_by = $2 AND status = $4 AND booking_id IS NULL
+	`, id, userID, config.OpenSlotStatusOpen, config.OpenSlotStatusClaimed)
+	if err != nil {
+		return fmt.Errorf("failed to release open slot: %w", err)
+	}
+	return nil
+}
+
+// SetBooking records the booking a claim made
+func (r *OpenSlotRepository) SetBooking(ctx context.Context, id, bookingID int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE open_slots SET booking_id = $2, updated_at = NOW() WHERE id = $1`, id, bookingID)
+	if err != nil {
+		return fmt.Errorf("failed to update open slot: %w", err)
+	}
+	return nil
+}
+
+// Cancel withdraws a slot that is still open, returning ErrOpenSlotTaken
+// if it was already claimed or cancelled
+func (r *OpenSlotRepository) Cancel(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE open_slots SET status = $2, updated_at = NOW()
+		WHERE id = $1 AND status = $3
+	`, id, config.OpenSlotStatusCancelled, config.OpenSlotStatusOpen)
+	if err != nil {
+		return fmt.Errorf("failed to cancel open slot: %w", err)
+	}
+	return CheckRowsAffected(result, ErrOpenSlotTaken)
+}
@@ -0,0 +1,131 @@
+// internal/repository/outbox_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// OUTBOX REPOSITORY - Domain events awaiting dispatch
+// ========================================================================
+
+// OutboxRepository handles the transactional outbox
+type OutboxRepository struct {
+	db *sqlx.DB
+}
+
+// NewOutboxRepository creates a new outbox repository
+func NewOutboxRepository(db *sqlx.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// CreateTx records an event within the transaction making the change it
+// describes, so it is kept only if that change commits
+func (r *OutboxRepository) CreateTx(ctx context.Context, tx *sqlx.Tx, event *models.OutboxEvent) error {
+	query := `
+		INSERT INTO outbox_events (event_id, event_type, aggregate_type, aggregate_id, payload, status, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, 'pending', $6)
+		RETURNING id, status, created_at
+	`
+	err := tx.QueryRowxContext(ctx, query,
+		event.EventID, event.EventType, event.AggregateType, event.AggregateID, []byte(event.Payload), event.OccurredAt,
+	).Scan(&event.ID, &event.Status, &event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record outbox event: %w", err)
+	}
+	return nil
+}
+
+// ClaimPending claims up to limit due events, oldest first, counting the
+// attempt and hiding them from other workers until the lease ends
+func (r *OutboxRepository) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.OutboxEvent, error) {
+	query := `
+		UPDATE outbox_events SET
+			attempts = attempts + 1,
+			next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM outbox_events
+			WHERE status = 'pending'
+			AND (next_attempt_at IS NULL OR next_attempt_at <= $1)
+			ORDER BY id ASC
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *
+	`
+
+	var events []models.OutboxEvent
+	if err := r.db.SelectContext(ctx, &events, query, now, now.Add(lease), limit); err != nil {
+		return nil, fmt.Errorf("failed to claim outbox events: %w", err)
+	}
+	return events, nil
+}
+
+// MarkHandled records that a handler has processed an event, so retries
+// skip it
+func (r *OutboxRepository) MarkHandled(ctx context.Context, id int64, handler string) error {
+	query := `
+		UPDATE outbox_events
+		SET completed_handlers = completed_handlers || to_jsonb($2::text)
+		WHERE id = $1 AND NOT completed_handlers @> jsonb_build_array($2::text)
+	`
+	if _, err := r.db.ExecContext(ctx, query, id, handler); err != nil {
+		return fmt.Errorf("failed to mark outbox event handled: %w", err)
+	}
+	return nil
+}
+
+// MarkDispatched records that every handler has processed an event
+func (r *OutboxRepository) MarkDispatched(ctx context.Context, id int64, at time.Time) error {
+	query := `
+		UPDATE outbox_events
+		SET status = 'dispatched', dispatched_at = $2, next_attempt_at = NULL, last_error = NULL
+		WHERE id = $1
+	`
+	if _, err := r.db.ExecContext(ctx, query, id, at); err != nil {
+		return fmt.Errorf("failed to mark outbox event dispatched: %w", err)
+	}
+	return nil
+}
+
+// ScheduleRetry records a failed attempt and when to try again
+func (r *OutboxRepository) ScheduleRetry(ctx context.Context, id int64, lastError string, nextAttemptAt time.Time) error {
+	query := `
+		UPDATE outbox_events
+		SET last_error = $2, next_attempt_at = $3
+		WHERE id = $1 AND status = 'pending'
+	`
+	if _, err := r.db.ExecContext(ctx, query, id, lastError, nextAttemptAt); err != nil {
+		return fmt.Errorf("failed to schedule outbox retry: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed gives up on an event
+func (r *OutboxRepository) MarkFailed(ctx context.Context, id int64, lastError string) error {
+	query := `
+		UPDATE outbox_events
+		SET status = 'failed', last_error = $2, next_attempt_at = NULL
+		WHERE id = $1
+	`
+	if _, err := r.db.ExecContext(ctx, query, id, lastError); err != nil {
+		return fmt.Errorf("failed to mark outbox event failed: %w", err)
+	}
+	return nil
+}
+
+// DeleteDispatchedBefore removes dispatched events older than before and
+// returns how many were deleted
+func (r *OutboxRepository) DeleteDispatchedBefore(ctx context.Context, before time.Time) (int, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM outbox_events WHERE status = 'dispatched' AND dispatched_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete dispatched outbox events: %w", err)
+	}
+	n, err := result.RowsAffected()
+	return int(n), err
+}
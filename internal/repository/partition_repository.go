@@ -0,0 +1,112 @@
+// internal/repository/partition_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ========================================================================
+// PARTITION REPOSITORY - Monthly partitions of range-partitioned tables
+// ========================================================================
+// Table and column names are identifiers from code, never user input; they
+// are quoted all the same.
+// ========================================================================
+
+// PartitionRepository creates and drops monthly table partitions
+type PartitionRepository struct {
+	db *sqlx.DB
+}
+
+// NewPartitionRepository creates a new partition repository
+func NewPartitionRepository(db *sqlx.DB) *PartitionRepository {
+	return &PartitionRepository{db: db}
+}
+
+// FindMonthly lists the monthly partitions of table, oldest first
+func (r *PartitionRepository) FindMonthly(ctx context.Context, table string) ([]models.MonthlyPartition, error) {
+	var names []string
+	err := r.db.SelectContext(ctx, &names, `
+		SELECT c.relname FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = $1::regclass
+	`, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partitions of %s: %w", table, err)
+	}
+
+	var partitions []models.MonthlyPartition
+	for _, name := range names {
+		if p, ok := models.ParseMonthlyPartition(table, name); ok {
+			partitions = append(partitions, p)
+		}
+	}
+	sort.Slice(partitions, func(i, j int) bool { return partitions[i].Month.Before(partitions[j].Month) })
+	return partitions, nil
+}
+
+// CreateMonthly adds partition p to table, partitioned by column. Rows of
+// that month already in the default partition are moved into it.
+func (r *PartitionRepository) CreateMonthly(ctx context.Context, table, column string, p models.MonthlyPartition) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	parent := pq.QuoteIdentifier(table)
+	partition := pq.QuoteIdentifier(p.Name)
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(
+		`CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING CONSTRAINTS)`, partition, parent))
+	if err != nil {
+		return fmt.Errorf("failed to create partition %s: %w", p.Name, err)
+	}
+
+	var defaultPartition sql.NullString
+	err = tx.GetContext(ctx, &defaultPartition, `
+		SELECT NULLIF(partdefid, 0)::regclass::text FROM pg_partitioned_table
+		WHERE partrelid = $1::regclass
+	`, table)
+	if err != nil {
+		return fmt.Errorf("failed to find default partition of %s: %w", table, err)
+	}
+	if defaultPartition.Valid {
+		// Already quoted by the regclass cast
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(`
+			WITH moved AS (
+				DELETE FROM %s WHERE %s >= $1 AND %s < $2 RETURNING *
+			)
+			INSERT INTO %s SELECT * FROM moved
+		`, defaultPartition.String, pq.QuoteIdentifier(column), pq.QuoteIdentifier(column), partition), p.Month, p.End())
+		if err != nil {
+			return fmt.Errorf("failed to move rows into partition %s: %w", p.Name, err)
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, fmt.Sprintf(`ALTER TABLE %s ATTACH PARTITION %s FOR VALUES FROM (%s) TO (%s)`,
+		parent, partition, pq.QuoteLiteral(p.Month.Format(time.RFC3339)), pq.QuoteLiteral(p.End().Format(time.RFC3339))))
+	if err != nil {
+		return fmt.Errorf("failed to attach partition %s: %w", p.Name, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// Drop drops partition p with all its rows
+func (r *PartitionRepository) Drop(ctx context.Context, p models.MonthlyPartition) error {
+	if _, err := r.db.ExecContext(ctx, `DROP TABLE `+pq.QuoteIdentifier(p.Name)); err != nil {
+		return fmt.Errorf("failed to drop partition %s: %w", p.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+// internal/repository/portfolio_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ========================================================================
+// PORTFOLIO REPOSITORY - Photos barbers show on their services
+// ========================================================================
+// Every change rewrites barber_services.portfolio_images and
+// before_after_images from the photos, in order, in the same transaction.
+// Changes to one service's photos are serialized by locking its row.
+// ========================================================================
+
+// PortfolioRepository handles portfolio media
+type PortfolioRepository struct {
+	db *sqlx.DB
+}
+
+// NewPortfolioRepository creates a new portfolio repository
+func NewPortfolioRepository(db *sqlx.DB) *PortfolioRepository {
+	return &PortfolioRepository{db: db}
+}
+
+// FindByBarberServiceID retrieves a service's photos of a kind in order;
+// kind "" lists all, work examples first, then before/after shots
+func (r *PortfolioRepository) FindByBarberServiceID(ctx context.Context, barberServiceID int, kind string) ([]models.PortfolioMedia, error) {
+	query := `
+		SELECT * FROM portfolio_media
+		WHERE barber_service_id = $1 AND ($2 = '' OR kind = $2)
+		ORDER BY kind = 'before_after', position, id
+	`
+
+	media := []models.PortfolioMedia{}
+	if err := r.db.SelectContext(ctx, &media, query, barberServiceID, kind); err != nil {
+		return nil, fmt.Errorf("failed to find portfolio media: %w", err)
+	}
+	return media, nil
+}
+
+// FindByID retrieves a photo
+func (r *PortfolioRepository) FindByID(ctx context.Context, id int) (*models.PortfolioMedia, error) {
+	var media models.PortfolioMedia
+	err := r.db.GetContext(ctx, &media, `SELECT * FROM portfolio_media WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPortfolioMediaNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find portfolio media: %w", err)
+	}
+	return &media, nil
+}
+
+// Create adds a photo after the service's others of its kind. Returns
+// ErrPortfolioLimit when the service already has maxMedia of that kind.
+func (r *PortfolioRepository) Create(ctx context.Context, media *models.PortfolioMedia, maxMedia int) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockBarberService(ctx, tx, media.BarberServiceID); err != nil {
+		return err
+	}
+
+	var count, last int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COUNT(*), COALESCE(MAX(position), 0) FROM portfolio_media
+		WHERE barber_service_id = $1 AND kind = $2
+	`, media.BarberServiceID, media.Kind).Scan(&count, &last)
+	if err != nil {
+		return fmt.Errorf("failed to count portfolio media: %w", err)
+	}
+	if count >= maxMedia {
+		return ErrPortfolioLimit
+	}
+	media.Position = last + 1
+
+	query := `
+		INSERT INTO portfolio_media (
+			barber_service_id, kind, position, caption,
+			image_key, thumbnail_key, url, thumbnail_url,
+			content_type, size_bytes, width, height, uploaded_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id, created_at, updated_at
+	`
+	err = tx.QueryRowContext(ctx, query,
+		media.BarberServiceID, media.Kind, media.Position, media.Caption,
+		media.ImageKey, media.ThumbnailKey, media.URL, media.ThumbnailURL,
+		media.ContentType, media.SizeBytes, media.Width, media.Height, media.UploadedBy,
+	).Scan(&media.ID, &media.CreatedAt, &media.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create portfolio media: %w", err)
+	}
+
+	if err := syncPortfolioURLs(ctx, tx, media.BarberServiceID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit portfolio media: %w", err)
+	}
+	return nil
+}
+
+// UpdateCaption sets or clears a photo's caption
+func (r *PortfolioRepository) UpdateCaption(ctx context.Context, id int, caption *string) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE portfolio_media SET caption = $1, updated_at = NOW() WHERE id = $2`, caption, id)
+	if err != nil {
+		return fmt.Errorf("failed to update portfolio caption: %w", err)
+	}
+	return CheckRowsAffected(result, ErrPortfolioMediaNotFound)
+}
+
+// Reorder puts a service's photos of a kind in the order of ids, which
+// must list each of them once (ErrPortfolioOrderInvalid otherwise)
+func (r *PortfolioRepository) Reorder(ctx context.Context, barberServiceID int, kind string, ids []int) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockBarberService(ctx, tx, barberServiceID); err != nil {
+		return err
+	}
+
+	var current []int
+	err = tx.SelectContext(ctx, &current,
+		`SELECT id FROM portfolio_media WHERE barber_service_id = $1 AND kind = $2`, barberServiceID, kind)
+	if err != nil {
+		return fmt.Errorf("failed to find portfolio media: %w", err)
+	}
+	if !sameIDs(current, ids) {
+		return ErrPortfolioOrderInvalid
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE portfolio_media m SET position = o.position, updated_at = NOW()
+		FROM unnest($1::int[]) WITH ORDINALITY AS o(id, position)
+		WHERE m.id = o.id
+	`, pq.Array(ids))
+	if err != nil {
+		return fmt.Errorf("failed to reorder portfolio media: %w", err)
+	}
+
+	if err := syncPortfolioURLs(ctx, tx, barberServiceID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit portfolio order: %w", err)
+	}
+	return nil
+}
+
+// Delete removes one of a service's photos and returns it so its files can
+// be removed
+func (r *PortfolioRepository) Delete(ctx context.Context, barberServiceID, id int) (*models.PortfolioMedia, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockBarberService(ctx, tx, barberServiceID); err != nil {
+		return nil, err
+	}
+
+	var media models.PortfolioMedia
+	err = tx.GetContext(ctx, &media,
+		`DELETE FROM portfolio_media WHERE id = $1 AND barber_service_id = $2 RETURNING *`, id, barberServiceID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPortfolioMediaNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete portfolio media: %w", err)
+	}
+
+	if err := syncPortfolioURLs(ctx, tx, barberServiceID); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit portfolio media deletion: %w", err)
+	}
+	return &media, nil
+}
+
+// lockBarberService locks a barber service's row for the transaction
+func lockBarberService(ctx context.Context, tx *sqlx.Tx, barberServiceID int) error {
+	var id int
+	err := tx.GetContext(ctx, &id, `SELECT id FROM barber_services WHERE id = $1 FOR UPDATE`, barberServiceID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrBarberServiceNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock barber service: %w", err)
+	}
+	return nil
+}
+
+// syncPortfolioURLs rewrites a barber service's image URL lists from its
+// photos
+func syncPortfolioURLs(ctx context.Context, tx *sqlx.Tx, barberServiceID int) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE barber_services bs SET
+			portfolio_images = (
+				SELECT COALESCE(jsonb_agg(m.url ORDER BY m.position, m.id), '[]') FROM portfolio_media m
+				WHERE m.barber_service_id = bs.id AND m.kind = 'portfolio'
+			),
+			before_after_images = (
+				SELECT COALESCE(jsonb_agg(m.url ORDER BY m.position, m.id), '[]') FROM portfolio_media m
+				WHERE m.barber_service_id = bs.id AND m.kind = 'before_after'
+			),
+			updated_at = NOW()
+		WHERE bs.id = $1
+	`, barberServiceID)
+	if err != nil {
+		return fmt.Errorf("failed to update barber service images: %w", err)
+	}
+	return nil
+}
+
+// sameIDs reports whether ids lists each of want exactly once
+func sameIDs(want, ids []int) bool {
+	if len(want) != len(ids) {
+		return false
+	}
+	remaining := make(map[int]bool, len(want))
+	for _, id := range want {
+		remaining[id] = true
+	}
+	for _, id := range ids {
+		if !remaining[id] {
+			return false
+		}
+		delete(remaining, id)
+	}
+	return true
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/ranking"
 )
 
 // ========================================================================
@@ -270,20 +271,10 @@ func (qb *QueryBuilder) OrderBy(column, direction string) *QueryBuilder {
 	return qb
 }
 
-// OrderByWithDefault sets ORDER BY with a default if sortBy is empty
-func (qb *QueryBuilder) OrderByWithDefault(sortBy, defaultSort string, sortMap map[string]string) *QueryBuilder {
-	// Use provided sortBy or default
-	if sortBy == "" {
-		sortBy = defaultSort
-	}
-
-	// Look up column mapping
-	if column, exists := sortMap[sortBy]; exists {
-		qb.orderBy = column
-	} else {
-		qb.orderBy = sortMap[defaultSort]
-	}
-
+// OrderByColumns sets ORDER BY from a list's sort columns, using fallback
+// when sortBy is empty or unknown
+func (qb *QueryBuilder) OrderByColumns(sortBy, order string, columns SortColumns, fallback SortColumn) *QueryBuilder {
+	qb.orderBy = columns.OrderBy(sortBy, order, fallback)
 	return qb
 }
 
@@ -344,6 +335,22 @@ func (qb *QueryBuilder) Build() (string, []interface{}) {
 	return query, qb.args
 }
 
+// BuildCount constructs a query counting the rows matched by the builder's
+// joins and conditions; ordering and pagination are ignored
+func (qb *QueryBuilder) BuildCount() (string, []interface{}) {
+	query := qb.baseQuery
+
+	if len(qb.joins) > 0 {
+		query += " " + strings.Join(qb.joins, " ")
+	}
+
+	if len(qb.conditions) > 0 {
+		query += " WHERE " + strings.Join(qb.conditions, " AND ")
+	}
+
+	return "SELECT COUNT(*) FROM (" + query + ") AS counted", qb.args
+}
+
 // ========================================================================
 // HELPER FUNCTIONS FOR COMMON PATTERNS
 // ========================================================================
@@ -367,3 +374,53 @@ func BuildBarberQuery() *QueryBuilder {
 	`
 	return NewQueryBuilder(baseQuery).WhereNull("b.deleted_at")
 }
+
+// rankedBarberColumns are the raw ranking signals selected by BuildRankedBarberQuery
+var rankedBarberColumns = ranking.Columns{
+	Rating:          "b.rating",
+	DistanceKm:      "ri.distance_km",
+	ResponseMinutes: "b.response_time_minutes",
+	AveragePrice:    "ri.avg_price",
+	Featured:        "fp.id IS NOT NULL",
+}
+
+// BuildRankedBarberQuery is BuildBarberQuery plus each barber's featured
+// placement running today (featured_placement_id, is_featured), distance
+// from the searcher (distance_km, NULL without lat/lng) and the scorer's
+// factor values and score (rank_factors, rank_score). City-wide placements
+// always apply; category placements only when categoryID matches.
+func BuildRankedBarberQuery(categoryID int, lat, lng *float64, scorer *ranking.Scorer) *QueryBuilder {
+	baseQuery := fmt.Sprintf(`
+		SELECT b.*, u.name as user_name, u.email as user_email,
+			fp.id as featured_placement_id, fp.id IS NOT NULL as is_featured,
+			ri.distance_km,
+			%s as rank_factors,
+			%s as rank_score
+		FROM barbers b
+		LEFT JOIN users u ON b.user_id = u.id
+		LEFT JOIN LATERAL (
+			SELECT p.id FROM featured_placements p
+			WHERE p.barber_id = b.id
+			AND p.status = 'active'
+			AND LOWER(p.city) = LOWER(b.city)
+			AND CURRENT_DATE BETWEEN p.starts_on AND p.ends_on
+			AND (p.category_id IS NULL OR p.category_id = $1)
+			ORDER BY p.category_id NULLS LAST
+			LIMIT 1
+		) fp ON TRUE
+		LEFT JOIN LATERAL (
+			SELECT
+				%.1f * 2 * ASIN(LEAST(1, SQRT(
+					POWER(SIN(RADIANS(b.latitude - $2::float8) / 2), 2) +
+					COS(RADIANS($2::float8)) * COS(RADIANS(b.latitude)) *
+					POWER(SIN(RADIANS(b.longitude - $3::float8) / 2), 2)
+				))) as distance_km,
+				(SELECT AVG(bs.price) FROM barber_services bs
+					WHERE bs.barber_id = b.id AND bs.is_active) as avg_price
+		) ri ON TRUE
+	`, scorer.FactorsSQL(rankedBarberColumns), scorer.ScoreSQL(rankedBarberColumns), config.EarthRadiusKm)
+	qb := NewQueryBuilder(baseQuery)
+	qb.args = append(qb.args, categoryID, lat, lng)
+	qb.argCount += 3
+	return qb.WhereNull("b.deleted_at")
+}
@@ -0,0 +1,127 @@
+// internal/repository/refresh_token_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// REFRESH TOKEN REPOSITORY - Rotating refresh tokens
+// ========================================================================
+
+// RefreshTokenRepository handles refresh token database operations
+type RefreshTokenRepository struct {
+	db *sqlx.DB
+}
+
+// NewRefreshTokenRepository creates a new refresh token repository
+func NewRefreshTokenRepository(db *sqlx.DB) *RefreshTokenRepository {
+	return &RefreshTokenRepository{db: db}
+}
+
+// Create stores a new refresh token
+func (r *RefreshTokenRepository) Create(ctx context.Context, token *models.RefreshToken) error {
+	query := `
+		INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		token.UserID, token.FamilyID, token.TokenHash, token.ExpiresAt, token.CreatedAt,
+	).Scan(&token.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+	return nil
+}
+
+// FindByHash retrieves a refresh token by the hash of its value
+func (r *RefreshTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error) {
+	var token models.RefreshToken
+	err := r.db.GetContext(ctx, &token, `SELECT * FROM refresh_tokens WHERE token_hash = $1`, tokenHash)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrRefreshTokenNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find refresh token: %w", err)
+	}
+	return &token, nil
+}
+
+// Rotate marks the token used and stores its successor in one transaction.
+// Returns ErrRefreshTokenReused if the token was used or revoked in the
+// meantime (e.g. two refreshes raced with the same token).
+func (r *RefreshTokenRepository) Rotate(ctx context.Context, oldID int, successor *models.RefreshToken) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowxContext(ctx, `
+		INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`, successor.UserID, successor.FamilyID, successor.TokenHash, successor.ExpiresAt, successor.CreatedAt,
+	).Scan(&successor.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE refresh_tokens SET used_at = $2, replaced_by = $3
+		WHERE id = $1 AND used_at IS NULL AND revoked_at IS NULL
+	`, oldID, successor.CreatedAt, successor.ID)
+	if err != nil {
+		return fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+	if err := CheckRowsAffected(result, ErrRefreshTokenReused); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit refresh token rotation: %w", err)
+	}
+	return nil
+}
+
+// RevokeFamily revokes every live token descended from the same login
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID string, at time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE refresh_tokens SET revoked_at = $2
+		WHERE family_id = $1 AND revoked_at IS NULL
+	`, familyID, at)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke refresh token family: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// RevokeAllForUser revokes every live refresh token of a user (all devices)
+func (r *RefreshTokenRepository) RevokeAllForUser(ctx context.Context, userID int, at time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE refresh_tokens SET revoked_at = $2
+		WHERE user_id = $1 AND revoked_at IS NULL
+	`, userID, at)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke refresh tokens: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// DeleteExpired removes tokens that expired before the given time
+func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context, before time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM refresh_tokens WHERE expires_at < $1`, before)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired refresh tokens: %w", err)
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,178 @@
+// internal/repository/relation_loader.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ========================================================================
+// RELATION LOADER - Batch hydration of list responses (prevents N+1)
+// ========================================================================
+// A list page is read on its own; the customers, barbers and services its
+// items refer to are then loaded by ID in one query and attached with
+// Hydrate. A hydrated list costs two queries whatever its length, and one
+// loader serves every list type that implements Hydratable.
+// ========================================================================
+
+// Include names the relations to attach to a list
+type Include struct {
+	Customer bool
+	Barber   bool
+	Service  bool
+}
+
+// Any reports whether any relation is included
+func (i Include) Any() bool {
+	return i.Customer || i.Barber || i.Service
+}
+
+// RelationIDs are the related records a list item refers to (0 = none)
+type RelationIDs struct {
+	CustomerID      int
+	BarberID        int
+	BarberServiceID int
+}
+
+// Relations are the related records loaded for a list item; nil when not
+// included or not found
+type Relations struct {
+	Customer *models.CustomerSummary
+	Barber   *models.BarberSummary
+	Service  *models.ServiceSummary
+}
+
+// Hydratable is a list item relations can be attached to
+type Hydratable interface {
+	RelationIDs() RelationIDs
+	SetRelations(Relations)
+}
+
+// RelationSet holds the relations loaded for a list, by ID
+type RelationSet struct {
+	Customers map[int]*models.CustomerSummary
+	Barbers   map[int]*models.BarberSummary
+	Services  map[int]*models.ServiceSummary
+}
+
+// For returns the relations of one list item
+func (s *RelationSet) For(ids RelationIDs) Relations {
+	return Relations{
+		Customer: s.Customers[ids.CustomerID],
+		Barber:   s.Barbers[ids.BarberID],
+		Service:  s.Services[ids.BarberServiceID],
+	}
+}
+
+// RelationLoader batch-loads the relations of list items
+type RelationLoader struct {
+	db *sqlx.DB
+}
+
+// NewRelationLoader creates a new relation loader
+func NewRelationLoader(db *sqlx.DB) *RelationLoader {
+	return &RelationLoader{db: db}
+}
+
+// Load fetches the included relations of refs in one query; relations
+// not included are left empty
+func (l *RelationLoader) Load(ctx context.Context, include Include, refs []RelationIDs) (*RelationSet, error) {
+	var customerIDs, barberIDs, serviceIDs []int
+	for _, ref := range refs {
+		if include.Customer && ref.CustomerID > 0 {
+			customerIDs = append(customerIDs, ref.CustomerID)
+		}
+		if include.Barber && ref.BarberID > 0 {
+			barberIDs = append(barberIDs, ref.BarberID)
+		}
+		if include.Service && ref.BarberServiceID > 0 {
+			serviceIDs = append(serviceIDs, ref.BarberServiceID)
+		}
+	}
+
+	set := &RelationSet{
+		Customers: map[int]*models.CustomerSummary{},
+		Barbers:   map[int]*models.BarberSummary{},
+		Services:  map[int]*models.ServiceSummary{},
+	}
+	if len(customerIDs) == 0 && len(barberIDs) == 0 && len(serviceIDs) == 0 {
+		return set, nil
+	}
+
+	query := `
+		SELECT
+			(SELECT COALESCE(jsonb_agg(jsonb_build_object(
+				'id', u.id, 'name', u.name, 'profile_picture_url', u.profile_picture_url
+			)), '[]') FROM users u WHERE u.id = ANY($1::int[])),
+			(SELECT COALESCE(jsonb_agg(jsonb_build_object(
+				'id', b.id, 'uuid', b.uuid, 'shop_name', b.shop_name, 'city', b.city,
+				'profile_image_url', b.profile_image_url, 'rating', b.rating
+			)), '[]') FROM barbers b WHERE b.id = ANY($2::int[])),
+			(SELECT COALESCE(jsonb_agg(jsonb_build_object(
+				'id', bs.id, 'service_id', bs.service_id, 'name', COALESCE(bs.custom_name, s.name),
+				'price', bs.price, 'currency', bs.currency, 'duration_minutes', bs.estimated_duration_min
+			)), '[]') FROM barber_services bs JOIN services s ON s.id = bs.service_id
+			WHERE bs.id = ANY($3::int[]))
+	`
+
+	var customersJSON, barbersJSON, servicesJSON []byte
+	err := l.db.QueryRowContext(ctx, query,
+		pq.Array(customerIDs), pq.Array(barberIDs), pq.Array(serviceIDs),
+	).Scan(&customersJSON, &barbersJSON, &servicesJSON)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load relations: %w", err)
+	}
+
+	var customers []models.CustomerSummary
+	var barbers []models.BarberSummary
+	var services []models.ServiceSummary
+	if err := json.Unmarshal(customersJSON, &customers); err != nil {
+		return nil, fmt.Errorf("failed to decode customers: %w", err)
+	}
+	if err := json.Unmarshal(barbersJSON, &barbers); err != nil {
+		return nil, fmt.Errorf("failed to decode barbers: %w", err)
+	}
+	if err := json.Unmarshal(servicesJSON, &services); err != nil {
+		return nil, fmt.Errorf("failed to decode services: %w", err)
+	}
+
+	for i := range customers {
+		set.Customers[customers[i].ID] = &customers[i]
+	}
+	for i := range barbers {
+		set.Barbers[barbers[i].ID] = &barbers[i]
+	}
+	for i := range services {
+		set.Services[services[i].ID] = &services[i]
+	}
+	return set, nil
+}
+
+// Hydrate attaches the included relations to each of items. A nil loader
+// or an empty include leaves items as they are.
+func Hydrate[T any, P interface {
+	*T
+	Hydratable
+}](ctx context.Context, loader *RelationLoader, include Include, items []T) error {
+	if loader == nil || !include.Any() || len(items) == 0 {
+		return nil
+	}
+
+	refs := make([]RelationIDs, len(items))
+	for i := range items {
+		refs[i] = P(&items[i]).RelationIDs()
+	}
+	set, err := loader.Load(ctx, include, refs)
+	if err != nil {
+		return err
+	}
+	for i := range items {
+		P(&items[i]).SetRelations(set.For(refs[i]))
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+// internal/repository/review_image_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// REVIEW IMAGE REPOSITORY - Photos attached to reviews
+// ========================================================================
+// Each photo's URL is also listed in reviews.images, which is what review
+// responses and the has_images filter read; adding or removing a photo
+// updates both in one transaction.
+// ========================================================================
+
+// ReviewImageRepository handles review photos
+type ReviewImageRepository struct {
+	db *sqlx.DB
+}
+
+// NewReviewImageRepository creates a new review image repository
+func NewReviewImageRepository(db *sqlx.DB) *ReviewImageRepository {
+	return &ReviewImageRepository{db: db}
+}
+
+// FindByReviewID retrieves a review's photos in upload order
+func (r *ReviewImageRepository) FindByReviewID(ctx context.Context, reviewID int) ([]models.ReviewImage, error) {
+	query := `SELECT * FROM review_images WHERE review_id = $1 ORDER BY id`
+
+	images := []models.ReviewImage{}
+	if err := r.db.SelectContext(ctx, &images, query, reviewID); err != nil {
+		return nil, fmt.Errorf("failed to find review images: %w", err)
+	}
+	return images, nil
+}
+
+// Create adds a photo to a review and its URL to reviews.images. Returns
+// ErrReviewImageLimit when the review already has maxImages photos.
+func (r *ReviewImageRepository) Create(ctx context.Context, image *models.ReviewImage, maxImages int) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the review so concurrent uploads cannot pass the limit together
+	var count int
+	err = tx.GetContext(ctx, &count, `
+		SELECT (SELECT COUNT(*) FROM review_images WHERE review_id = r.id)
+		FROM reviews r WHERE r.id = $1
+		FOR UPDATE
+	`, image.ReviewID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrReviewNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock review: %w", err)
+	}
+	if count >= maxImages {
+		return ErrReviewImageLimit
+	}
+
+	query := `
+		INSERT INTO review_images (
+			review_id, image_key, thumbnail_key, url, thumbnail_url,
+			content_type, size_bytes, width, height, uploaded_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at
+	`
+	err = tx.QueryRowContext(ctx, query,
+		image.ReviewID, image.ImageKey, image.ThumbnailKey, image.URL, image.ThumbnailURL,
+		image.ContentType, image.SizeBytes, image.Width, image.Height, image.UploadedBy,
+	).Scan(&image.ID, &image.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create review image: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE reviews SET images = COALESCE(images, '[]') || to_jsonb($1::text), updated_at = NOW()
+		WHERE id = $2
+	`, image.URL, image.ReviewID)
+	if err != nil {
+		return fmt.Errorf("failed to add review image url: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit review image: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a review's photo and its URL from reviews.images, and
+// returns the deleted photo so its files can be removed
+func (r *ReviewImageRepository) Delete(ctx context.Context, reviewID, id int) (*models.ReviewImage, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var image models.ReviewImage
+	err = tx.GetContext(ctx, &image, `DELETE FROM review_images WHERE id = $1 AND review_id = $2 RETURNING *`, id, reviewID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrReviewImageNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete review image: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE reviews SET images = images - $1::text, updated_at = NOW()
+		WHERE id = $2
+	`, image.URL, reviewID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to remove review image url: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit review image deletion: %w", err)
+	}
+	return &image, nil
+}
@@ -0,0 +1,114 @@
+// internal/repository/review_report_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// REVIEW REPORT REPOSITORY - Users' reports of published reviews
+// ========================================================================
+// Each report also counts towards reviews.report_count, the reports since
+// the review was last moderated (UpdateModerationStatus resets it). When
+// the count reaches the threshold a published review is flagged in the
+// same transaction, so concurrent reports cannot miss it.
+// ========================================================================
+
+// ReviewReportRepository handles review reports
+type ReviewReportRepository struct {
+	db *sqlx.DB
+}
+
+// NewReviewReportRepository creates a new review report repository
+func NewReviewReportRepository(db *sqlx.DB) *ReviewReportRepository {
+	return &ReviewReportRepository{db: db}
+}
+
+// FindByReviewID retrieves a review's reports, newest first, with the
+// reporters' names
+func (r *ReviewReportRepository) FindByReviewID(ctx context.Context, reviewID int) ([]models.ReviewReport, error) {
+	query := `
+		SELECT rr.*, u.name AS reporter_name
+		FROM review_reports rr
+		LEFT JOIN users u ON u.id = rr.reporter_id
+		WHERE rr.review_id = $1
+		ORDER BY rr.created_at DESC, rr.id DESC
+	`
+
+	reports := []models.ReviewReport{}
+	if err := r.db.SelectContext(ctx, &reports, query, reviewID); err != nil {
+		return nil, fmt.Errorf("failed to find review reports: %w", err)
+	}
+	return reports, nil
+}
+
+// Create stores a report and counts it against the review. A published
+// review reaching flagAt reports is flagged with notes; Create reports
+// whether it was. Returns ErrAlreadyReported when the user already
+// reported the review.
+func (r *ReviewReportRepository) Create(ctx context.Context, report *models.ReviewReport, flagAt int, notes string) (bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO review_reports (review_id, reporter_id, reason, details)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+	err = tx.QueryRowContext(ctx, query, report.ReviewID, report.ReporterID, report.Reason, report.Details).
+		Scan(&report.ID, &report.CreatedAt)
+	if err != nil {
+		if strings.Contains(err.Error(), "duplicate") || strings.Contains(err.Error(), "unique") {
+			return false, ErrAlreadyReported
+		}
+		return false, fmt.Errorf("failed to create review report: %w", err)
+	}
+
+	// The update locks the review, so each report sees the count before it
+	var count int
+	var published bool
+	err = tx.QueryRowContext(ctx, `
+		UPDATE reviews SET report_count = report_count + 1
+		WHERE id = $1
+		RETURNING report_count, is_published
+	`, report.ReviewID).Scan(&count, &published)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, ErrReviewNotFound
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to count review report: %w", err)
+	}
+
+	flagged := published && count >= flagAt
+	if flagged {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE reviews SET
+				moderation_status = $1,
+				is_published = false,
+				moderated_by = NULL,
+				moderation_notes = $2,
+				moderated_at = NOW(),
+				updated_at = NOW()
+			WHERE id = $3
+		`, config.ReviewModerationFlagged, notes, report.ReviewID)
+		if err != nil {
+			return false, fmt.Errorf("failed to flag reported review: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit review report: %w", err)
+	}
+	return flagged, nil
+}
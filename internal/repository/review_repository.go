@@ -47,6 +47,7 @@ type ReviewFilters struct {
 
 	// Status filters
 	ModerationStatus string   `form:"moderation_status"`
+	ResponseStatus   string   `form:"response_status"` // Moderation status of the barber's response
 	Statuses         []string `form:"statuses"`
 	IsPublished      *bool    `form:"is_published"`
 	IsVerified       *bool    `form:"is_verified"`
@@ -66,16 +67,41 @@ type ReviewFilters struct {
 	// Recommendation filters
 	WouldRecommend *bool `form:"would_recommend"`
 
+	// Reviews with at least this many open user reports
+	MinReports int `form:"min_reports"`
+
 	// Sorting and pagination
 	SortBy string `form:"sort_by"`
 	Order  string `form:"order"`
 	Limit  int    `form:"limit,default=50"`
 	Offset int    `form:"offset,default=0"`
 
-	// Relation loading flags (prevents N+1 queries)
+	// Relations attached to list responses (see RelationLoader)
 	IncludeCustomer bool `form:"include_customer"`
 	IncludeBarber   bool `form:"include_barber"`
-	IncludeBooking  bool `form:"include_booking"`
+}
+
+// Include returns the relations the filters ask to attach
+func (f ReviewFilters) Include() Include {
+	return Include{Customer: f.IncludeCustomer, Barber: f.IncludeBarber}
+}
+
+// ReviewSortColumns are what FindAll sorts reviews by, newest first
+// without a sort
+var ReviewSortColumns = SortColumns{
+	"created_at":     {Expr: "created_at"},
+	"overall_rating": {Expr: "overall_rating"},
+	"helpful_votes":  {Expr: "helpful_votes"},
+	"report_count":   {Expr: "report_count", Then: "created_at ASC"},
+}
+
+// ListParams implements ListFilters
+func (f *ReviewFilters) ListParams() ListParams {
+	return ListParams{
+		SortBy: &f.SortBy, Order: &f.Order, Limit: &f.Limit, Offset: &f.Offset,
+		Sorts:  ReviewSortColumns,
+		Ranges: []DateRange{{Name: "created", From: f.CreatedFrom, To: f.CreatedTo}},
+	}
 }
 
 // ReviewStats represents review statistics
@@ -111,6 +137,20 @@ func IsValidModerationStatus(status string) bool {
 	return IsValidValue(status, ValidModerationStatuses)
 }
 
+// ValidResponseModerationStatuses defines allowed barber response statuses
+var ValidResponseModerationStatuses = []string{
+	config.ReviewModerationPending,
+	config.ReviewModerationApproved,
+	config.ReviewModerationRejected,
+	config.ReviewModerationFlagged,
+	config.ReviewResponseRedacted,
+}
+
+// IsValidResponseModerationStatus checks if a barber response status is valid
+func IsValidResponseModerationStatus(status string) bool {
+	return IsValidValue(status, ValidResponseModerationStatuses)
+}
+
 // ========================================================================
 // CREATE OPERATIONS
 // ========================================================================
@@ -134,7 +174,7 @@ func (r *ReviewRepository) Create(ctx context.Context, review *models.Review) er
 			title, comment, pros, cons,
 			would_recommend, would_book_again, service_as_expected, duration_accurate,
 			images,
-			is_verified, is_published, moderation_status,
+			is_verified, is_published, moderation_status, moderation_notes, moderated_at,
 			created_at, updated_at
 		) VALUES (
 			:booking_id, :customer_id, :barber_id,
@@ -143,7 +183,7 @@ func (r *ReviewRepository) Create(ctx context.Context, review *models.Review) er
 			:title, :comment, :pros, :cons,
 			:would_recommend, :would_book_again, :service_as_expected, :duration_accurate,
 			:images,
-			:is_verified, :is_published, :moderation_status,
+			:is_verified, :is_published, :moderation_status, :moderation_notes, :moderated_at,
 			:created_at, :updated_at
 		) RETURNING id
 	`
@@ -219,57 +259,111 @@ func (r *ReviewRepository) ExistsByBookingID(ctx context.Context, bookingID int)
 	return exists, nil
 }
 
+// CountMatchingComments counts reviews created since the given time whose
+// comment, lower-cased with its whitespace collapsed, equals comment
+func (r *ReviewRepository) CountMatchingComments(ctx context.Context, comment string, since time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM reviews
+		WHERE lower(regexp_replace(btrim(comment), '\s+', ' ', 'g')) = $1
+		  AND created_at >= $2
+	`
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, comment, since); err != nil {
+		return 0, fmt.Errorf("failed to count matching comments: %w", err)
+	}
+	return count, nil
+}
+
+// CountRatingOnly counts a barber's reviews without a title or comment
+// created since the given time
+func (r *ReviewRepository) CountRatingOnly(ctx context.Context, barberID int, since time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*) FROM reviews
+		WHERE barber_id = $1
+		  AND COALESCE(btrim(title), '') = ''
+		  AND COALESCE(btrim(comment), '') = ''
+		  AND created_at >= $2
+	`
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, barberID, since); err != nil {
+		return 0, fmt.Errorf("failed to count rating-only reviews: %w", err)
+	}
+	return count, nil
+}
+
 // ========================================================================
 // READ OPERATIONS - FindAll with Filters
 // ========================================================================
 
 // FindAll retrieves reviews with optional filters
 func (r *ReviewRepository) FindAll(ctx context.Context, filters ReviewFilters) ([]models.Review, error) {
-	query := `SELECT * FROM reviews WHERE 1=1`
+	where, args := reviewWhere(filters, "")
+	query := `SELECT * FROM reviews` + where
+	argCount := len(args) + 1
+
+	// Sorting
+	query += " ORDER BY " + ReviewSortColumns.OrderBy(filters.SortBy, filters.Order, ReviewSortColumns["created_at"])
+
+	// Pagination
+	limit := 50
+	if filters.Limit > 0 {
+		limit = filters.Limit
+	}
+	offset := 0
+	if filters.Offset > 0 {
+		offset = filters.Offset
+	}
+	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
+	args = append(args, limit, offset)
+
+	var reviews []models.Review
+	err := r.db.SelectContext(ctx, &reviews, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reviews: %w", err)
+	}
+
+	return reviews, nil
+}
+// reviewWhere builds the WHERE clause shared by FindAll and Count, with
+// columns qualified by prefix (e.g. "r.")
+func reviewWhere(filters ReviewFilters, prefix string) (string, []interface{}) {
+	where := " WHERE 1=1"
 	args := []interface{}{}
 	argCount := 1
 
-	// Customer filter
-	if filters.CustomerID > 0 {
-		query += fmt.Sprintf(" AND customer_id = $%d", argCount)
-		args = append(args, filters.CustomerID)
+	add := func(condition string, value interface{}) {
+		where += fmt.Sprintf(" AND %s%s $%d", prefix, condition, argCount)
+		args = append(args, value)
 		argCount++
 	}
 
-	// Barber filter
+	if filters.CustomerID > 0 {
+		add("customer_id =", filters.CustomerID)
+	}
 	if filters.BarberID > 0 {
-		query += fmt.Sprintf(" AND barber_id = $%d", argCount)
-		args = append(args, filters.BarberID)
-		argCount++
+		add("barber_id =", filters.BarberID)
 	}
-
-	// Booking filter
 	if filters.BookingID > 0 {
-		query += fmt.Sprintf(" AND booking_id = $%d", argCount)
-		args = append(args, filters.BookingID)
-		argCount++
+		add("booking_id =", filters.BookingID)
 	}
 
 	// Rating filters
 	if filters.MinRating > 0 {
-		query += fmt.Sprintf(" AND overall_rating >= $%d", argCount)
-		args = append(args, filters.MinRating)
-		argCount++
+		add("overall_rating >=", filters.MinRating)
 	}
 	if filters.MaxRating > 0 {
-		query += fmt.Sprintf(" AND overall_rating <= $%d", argCount)
-		args = append(args, filters.MaxRating)
-		argCount++
+		add("overall_rating <=", filters.MaxRating)
 	}
 
-	// Moderation status filter
+	// Status filters
 	if filters.ModerationStatus != "" {
-		query += fmt.Sprintf(" AND moderation_status = $%d", argCount)
-		args = append(args, filters.ModerationStatus)
-		argCount++
+		add("moderation_status =", filters.ModerationStatus)
+	}
+	if filters.ResponseStatus != "" {
+		add("barber_response_status =", filters.ResponseStatus)
 	}
-
-	// Multiple statuses filter
 	if len(filters.Statuses) > 0 {
 		placeholders := make([]string, len(filters.Statuses))
 		for i, status := range filters.Statuses {
@@ -277,252 +371,63 @@ func (r *ReviewRepository) FindAll(ctx context.Context, filters ReviewFilters) (
 			args = append(args, status)
 			argCount++
 		}
-		query += fmt.Sprintf(" AND moderation_status IN (%s)", strings.Join(placeholders, ", "))
+		where += fmt.Sprintf(" AND %smoderation_status IN (%s)", prefix, strings.Join(placeholders, ", "))
 	}
-
-	// Published filter
 	if filters.IsPublished != nil {
-		query += fmt.Sprintf(" AND is_published = $%d", argCount)
-		args = append(args, *filters.IsPublished)
-		argCount++
+		add("is_published =", *filters.IsPublished)
 	}
-
-	// Verified filter
 	if filters.IsVerified != nil {
-		query += fmt.Sprintf(" AND is_verified = $%d", argCount)
-		args = append(args, *filters.IsVerified)
-		argCount++
+		add("is_verified =", *filters.IsVerified)
 	}
 
-	// Has comment filter
+	// Content filters
 	if filters.HasComment != nil {
 		if *filters.HasComment {
-			query += " AND comment IS NOT NULL AND comment != ''"
+			where += fmt.Sprintf(" AND %[1]scomment IS NOT NULL AND %[1]scomment != ''", prefix)
 		} else {
-			query += " AND (comment IS NULL OR comment = '')"
+			where += fmt.Sprintf(" AND (%[1]scomment IS NULL OR %[1]scomment = '')", prefix)
 		}
 	}
-
-	// Has images filter
 	if filters.HasImages != nil {
 		if *filters.HasImages {
-			query += " AND images IS NOT NULL AND array_length(images, 1) > 0"
+			where += fmt.Sprintf(" AND %[1]simages IS NOT NULL AND array_length(%[1]simages, 1) > 0", prefix)
 		} else {
-			query += " AND (images IS NULL OR array_length(images, 1) = 0)"
+			where += fmt.Sprintf(" AND (%[1]simages IS NULL OR array_length(%[1]simages, 1) = 0)", prefix)
 		}
 	}
-
-	// Has barber response filter
 	if filters.HasResponse != nil {
 		if *filters.HasResponse {
-			query += " AND barber_response IS NOT NULL AND barber_response != ''"
+			where += fmt.Sprintf(" AND %[1]sbarber_response IS NOT NULL AND %[1]sbarber_response != ''", prefix)
 		} else {
-			query += " AND (barber_response IS NULL OR barber_response = '')"
+			where += fmt.Sprintf(" AND (%[1]sbarber_response IS NULL OR %[1]sbarber_response = '')", prefix)
 		}
 	}
 
 	// Date range filters
 	if !filters.CreatedFrom.IsZero() {
-		query += fmt.Sprintf(" AND created_at >= $%d", argCount)
-		args = append(args, filters.CreatedFrom)
-		argCount++
+		add("created_at >=", filters.CreatedFrom)
 	}
 	if !filters.CreatedTo.IsZero() {
-		query += fmt.Sprintf(" AND created_at <= $%d", argCount)
-		args = append(args, filters.CreatedTo)
-		argCount++
+		add("created_at <=", filters.CreatedTo)
 	}
 
-	// Search filter
 	if filters.Search != "" {
-		query += fmt.Sprintf(" AND (title ILIKE $%d OR comment ILIKE $%d)", argCount, argCount+1)
+		where += fmt.Sprintf(" AND (%stitle ILIKE $%d OR %scomment ILIKE $%d)", prefix, argCount, prefix, argCount+1)
 		searchPattern := "%" + filters.Search + "%"
 		args = append(args, searchPattern, searchPattern)
 		argCount += 2
 	}
 
-	// Would recommend filter
 	if filters.WouldRecommend != nil {
-		query += fmt.Sprintf(" AND would_recommend = $%d", argCount)
-		args = append(args, *filters.WouldRecommend)
-		argCount++
+		add("would_recommend =", *filters.WouldRecommend)
 	}
-
-	// Sorting
-	orderBy := "created_at DESC" // Default sort
-	if filters.SortBy != "" {
-		order := "DESC"
-		if filters.Order != "" && (filters.Order == "ASC" || filters.Order == "asc") {
-			order = "ASC"
-		}
-		switch filters.SortBy {
-		case "overall_rating":
-			orderBy = fmt.Sprintf("overall_rating %s", order)
-		case "helpful_votes":
-			orderBy = fmt.Sprintf("helpful_votes %s", order)
-		case "created_at":
-			orderBy = fmt.Sprintf("created_at %s", order)
-		}
+	if filters.MinReports > 0 {
+		add("report_count >=", filters.MinReports)
 	}
-	query += " ORDER BY " + orderBy
 
-	// Pagination
-	limit := 50
-	if filters.Limit > 0 {
-		limit = filters.Limit
-	}
-	offset := 0
-	if filters.Offset > 0 {
-		offset = filters.Offset
-	}
-	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
-	args = append(args, limit, offset)
-
-	var reviews []models.Review
-	err := r.db.SelectContext(ctx, &reviews, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find reviews: %w", err)
-	}
-
-	return reviews, nil
+	return where, args
 }
-// ========================================================================
-// READ OPERATIONS - FindAll with Relations (prevents N+1 queries)
-// ========================================================================
-
-// ReviewWithRelations represents a review with optional loaded relations
-type ReviewWithRelations struct {
-	models.Review
-	CustomerName  *string `db:"customer_name"`
-	CustomerEmail *string `db:"customer_email"`
-	BarberName    *string `db:"barber_shop_name"`
-	BarberCity    *string `db:"barber_city"`
-	BookingNumber *string `db:"booking_number"`
-	ServiceName   *string `db:"service_name"`
-}
-
-// FindAllWithRelations retrieves reviews with optional relation loading
-// Use this instead of FindAll when you need customer/barber/booking info
-func (r *ReviewRepository) FindAllWithRelations(ctx context.Context, filters ReviewFilters) ([]ReviewWithRelations, error) {
-	// Build SELECT columns
-	selectCols := `r.*`
-	joins := ""
-
-	if filters.IncludeCustomer {
-		selectCols += `, u.name as customer_name, u.email as customer_email`
-		joins += ` LEFT JOIN users u ON r.customer_id = u.id`
-	}
-
-	if filters.IncludeBarber {
-		selectCols += `, b.shop_name as barber_shop_name, b.city as barber_city`
-		joins += ` LEFT JOIN barbers b ON r.barber_id = b.id`
-	}
-
-	if filters.IncludeBooking {
-		selectCols += `, bk.booking_number as booking_number, bk.service_name as service_name`
-		joins += ` LEFT JOIN bookings bk ON r.booking_id = bk.id`
-	}
-
-	// Base query
-	query := fmt.Sprintf(`SELECT %s FROM reviews r%s WHERE 1=1`, selectCols, joins)
-	args := []interface{}{}
-	argCount := 1
-
-	// Apply filters (same as FindAll)
-	if filters.CustomerID > 0 {
-		query += fmt.Sprintf(" AND r.customer_id = $%d", argCount)
-		args = append(args, filters.CustomerID)
-		argCount++
-	}
-
-	if filters.BarberID > 0 {
-		query += fmt.Sprintf(" AND r.barber_id = $%d", argCount)
-		args = append(args, filters.BarberID)
-		argCount++
-	}
-
-	if filters.BookingID > 0 {
-		query += fmt.Sprintf(" AND r.booking_id = $%d", argCount)
-		args = append(args, filters.BookingID)
-		argCount++
-	}
-
-	if filters.MinRating > 0 {
-		query += fmt.Sprintf(" AND r.overall_rating >= $%d", argCount)
-		args = append(args, filters.MinRating)
-		argCount++
-	}
-
-	if filters.MaxRating > 0 {
-		query += fmt.Sprintf(" AND r.overall_rating <= $%d", argCount)
-		args = append(args, filters.MaxRating)
-		argCount++
-	}
-
-	if filters.ModerationStatus != "" {
-		query += fmt.Sprintf(" AND r.moderation_status = $%d", argCount)
-		args = append(args, filters.ModerationStatus)
-		argCount++
-	}
-
-	if filters.IsPublished != nil {
-		query += fmt.Sprintf(" AND r.is_published = $%d", argCount)
-		args = append(args, *filters.IsPublished)
-		argCount++
-	}
-
-	if filters.IsVerified != nil {
-		query += fmt.Sprintf(" AND r.is_verified = $%d", argCount)
-		args = append(args, *filters.IsVerified)
-		argCount++
-	}
-
-	if filters.Search != "" {
-		query += fmt.Sprintf(" AND (r.title ILIKE $%d OR r.comment ILIKE $%d)", argCount, argCount+1)
-		searchPattern := "%" + filters.Search + "%"
-		args = append(args, searchPattern, searchPattern)
-		argCount += 2
-	}
 
-	// Sorting
-	orderBy := "r.created_at DESC"
-	if filters.SortBy != "" {
-		order := "DESC"
-		if filters.Order == "ASC" || filters.Order == "asc" {
-			order = "ASC"
-		}
-		switch filters.SortBy {
-		case "created_at":
-			orderBy = fmt.Sprintf("r.created_at %s", order)
-		case "overall_rating":
-			orderBy = fmt.Sprintf("r.overall_rating %s", order)
-		case "helpful_votes":
-			orderBy = fmt.Sprintf("r.helpful_votes %s", order)
-		}
-	}
-	query += " ORDER BY " + orderBy
-
-	// Pagination
-	limit := 50
-	if filters.Limit > 0 {
-		limit = filters.Limit
-	}
-	offset := 0
-	if filters.Offset > 0 {
-		offset = filters.Offset
-	}
-	query += fmt.Sprintf(" LIMIT $%d OFFSET $%d", argCount, argCount+1)
-	args = append(args, limit, offset)
-
-	// Execute query
-	var reviews []ReviewWithRelations
-	err := r.db.SelectContext(ctx, &reviews, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to find reviews with relations: %w", err)
-	}
-
-	return reviews, nil
-}
 // ========================================================================
 // READ OPERATIONS - Specific Queries
 // ========================================================================
@@ -585,7 +490,8 @@ func (r *ReviewRepository) Update(ctx context.Context, review *models.Review) er
 	return CheckRowsAffected(result, ErrReviewNotFound)
 }
 
-// UpdateModerationStatus updates the moderation status of a review
+// UpdateModerationStatus updates the moderation status of a review,
+// resolving its open user reports
 func (r *ReviewRepository) UpdateModerationStatus(ctx context.Context, id int, status string, moderatorID int, notes *string) error {
 	if !IsValidModerationStatus(status) {
 		return ErrInvalidModeration
@@ -599,6 +505,7 @@ func (r *ReviewRepository) UpdateModerationStatus(ctx context.Context, id int, s
 			moderation_notes = $3,
 			moderated_at = $4,
 			is_published = $5,
+			report_count = 0,
 			updated_at = $6
 		WHERE id = $7
 	`
@@ -614,18 +521,25 @@ func (r *ReviewRepository) UpdateModerationStatus(ctx context.Context, id int, s
 	return CheckRowsAffected(result, ErrReviewNotFound)
 }
 
-// AddBarberResponse adds a barber's response to a review
-func (r *ReviewRepository) AddBarberResponse(ctx context.Context, id int, response string) error {
+// AddBarberResponse records a barber's response to a review with the
+// moderation status screening gave it. An approved response goes live
+// right away; any other waits in pending_barber_response.
+func (r *ReviewRepository) AddBarberResponse(ctx context.Context, id int, response, status string, notes *string) error {
 	now := time.Now()
 	query := `
 		UPDATE reviews SET
-			barber_response = $1,
-			barber_response_at = $2,
-			updated_at = $3
-		WHERE id = $4
+			barber_response = CASE WHEN $2 = 'approved' THEN $1 END,
+			barber_response_at = CASE WHEN $2 = 'approved' THEN $4::timestamptz END,
+			pending_barber_response = CASE WHEN $2 = 'approved' THEN NULL ELSE $1 END,
+			barber_response_status = $2,
+			barber_response_notes = $3,
+			barber_response_moderated_by = NULL,
+			barber_response_moderated_at = CASE WHEN $2 = 'pending' THEN NULL ELSE $4::timestamptz END,
+			updated_at = $4
+		WHERE id = $5
 	`
 
-	result, err := r.db.ExecContext(ctx, query, response, now, now, id)
+	result, err := r.db.ExecContext(ctx, query, response, status, notes, now, id)
 	if err != nil {
 		return fmt.Errorf("failed to add barber response: %w", err)
 	}
@@ -633,6 +547,32 @@ func (r *ReviewRepository) AddBarberResponse(ctx context.Context, id int, respon
 	return CheckRowsAffected(result, ErrReviewNotFound)
 }
 
+// ModerateBarberResponse sets the moderation status of a review's barber
+// response. Approving puts the pending response live; rejecting or
+// redacting takes the response down and keeps its text for the record.
+func (r *ReviewRepository) ModerateBarberResponse(ctx context.Context, id int, status string, moderatorID int, notes *string) error {
+	now := time.Now()
+	query := `
+		UPDATE reviews SET
+			barber_response = CASE WHEN $1 = 'approved' THEN COALESCE(pending_barber_response, barber_response) END,
+			barber_response_at = CASE WHEN $1 = 'approved' THEN $4::timestamptz END,
+			pending_barber_response = CASE WHEN $1 = 'approved' THEN NULL ELSE COALESCE(pending_barber_response, barber_response) END,
+			barber_response_status = $1,
+			barber_response_notes = $3,
+			barber_response_moderated_by = $2,
+			barber_response_moderated_at = $4,
+			updated_at = $4
+		WHERE id = $5
+	`
+
+	result, err := r.db.ExecContext(ctx, query, status, moderatorID, notes, now, id)
+	if err != nil {
+		return fmt.Errorf("failed to moderate barber response: %w", err)
+	}
+
+	return CheckRowsAffected(result, ErrReviewNotFound)
+}
+
 // IncrementHelpfulVotes increments the helpful votes counter
 func (r *ReviewRepository) IncrementHelpfulVotes(ctx context.Context, id int, isHelpful bool) error {
 	var query string
@@ -717,32 +657,9 @@ func (r *ReviewRepository) GetBarberStats(ctx context.Context, barberID int) (*R
 
 // Count returns the total number of reviews matching the filters
 func (r *ReviewRepository) Count(ctx context.Context, filters ReviewFilters) (int, error) {
-	query := `SELECT COUNT(*) FROM reviews WHERE 1=1`
-	args := []interface{}{}
-	argCount := 1
-
-	if filters.CustomerID > 0 {
-		query += fmt.Sprintf(" AND customer_id = $%d", argCount)
-		args = append(args, filters.CustomerID)
-		argCount++
-	}
-
-	if filters.BarberID > 0 {
-		query += fmt.Sprintf(" AND barber_id = $%d", argCount)
-		args = append(args, filters.BarberID)
-		argCount++
-	}
-
-	if filters.ModerationStatus != "" {
-		query += fmt.Sprintf(" AND moderation_status = $%d", argCount)
-		args = append(args, filters.ModerationStatus)
-		argCount++
-	}
-
-	if filters.IsPublished != nil {
-		query += fmt.Sprintf(" AND is_published = $%d", argCount)
-		args = append(args, *filters.IsPublished)
-	}
+	// Same filters as FindAll; pagination and sorting are ignored
+	where, args := reviewWhere(filters, "")
+	query := `SELECT COUNT(*) FROM reviews` + where
 
 	var count int
 	err := r.db.GetContext(ctx, &count, query, args...)
@@ -0,0 +1,132 @@
+// internal/repository/review_request_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// REVIEW REQUEST REPOSITORY - Delayed review requests with one-time links
+// ========================================================================
+
+// ReviewRequestRepository handles review request database operations
+type ReviewRequestRepository struct {
+	db *sqlx.DB
+}
+
+// NewReviewRequestRepository creates a new review request repository
+func NewReviewRequestRepository(db *sqlx.DB) *ReviewRequestRepository {
+	return &ReviewRequestRepository{db: db}
+}
+
+// Create inserts a request. It returns false without error when the
+// booking already has one (the completed hook can run more than once).
+func (r *ReviewRequestRepository) Create(ctx context.Context, request *models.ReviewRequest) (bool, error) {
+	query := `
+		INSERT INTO review_requests (token, booking_id, customer_id, send_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (booking_id) DO NOTHING
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		request.Token, request.BookingID, request.CustomerID, request.SendAt, request.ExpiresAt,
+	).Scan(&request.ID, &request.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to create review request: %w", err)
+	}
+	return true, nil
+}
+
+// FindDue returns up to limit unsent requests whose send time has passed,
+// oldest first. Requests whose link would already have expired are left out.
+func (r *ReviewRequestRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]models.ReviewRequest, error) {
+	query := `
+		SELECT * FROM review_requests
+		WHERE sent_at IS NULL AND skipped_at IS NULL
+			AND send_at <= $1 AND expires_at > $1
+		ORDER BY send_at ASC
+		LIMIT $2
+	`
+
+	var requests []models.ReviewRequest
+	if err := r.db.SelectContext(ctx, &requests, query, now, limit); err != nil {
+		return nil, fmt.Errorf("failed to find due review requests: %w", err)
+	}
+	return requests, nil
+}
+
+// FindByToken retrieves a request by its link token
+func (r *ReviewRequestRepository) FindByToken(ctx context.Context, token string) (*models.ReviewRequest, error) {
+	var request models.ReviewRequest
+	err := r.db.GetContext(ctx, &request, `SELECT * FROM review_requests WHERE token = $1`, token)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrReviewRequestNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find review request: %w", err)
+	}
+	return &request, nil
+}
+
+// MarkSent records that the request went out
+func (r *ReviewRequestRepository) MarkSent(ctx context.Context, id int, now time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE review_requests SET sent_at = $1 WHERE id = $2`, now, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark review request sent: %w", err)
+	}
+	return nil
+}
+
+// MarkSkipped records that the request was not sent because the booking
+// had already been reviewed
+func (r *ReviewRequestRepository) MarkSkipped(ctx context.Context, id int, now time.Time) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE review_requests SET skipped_at = $1 WHERE id = $2`, now, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark review request skipped: %w", err)
+	}
+	return nil
+}
+
+// Claim marks a sent, unexpired link used so no other request can use it.
+// It fails with ErrReviewRequestClosed when the link is no longer open.
+func (r *ReviewRequestRepository) Claim(ctx context.Context, id int, now time.Time) error {
+	query := `
+		UPDATE review_requests SET used_at = $1
+		WHERE id = $2 AND sent_at IS NOT NULL AND used_at IS NULL AND expires_at > $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, now, id)
+	if err != nil {
+		return fmt.Errorf("failed to claim review request: %w", err)
+	}
+	return CheckRowsAffected(result, ErrReviewRequestClosed)
+}
+
+// Release reopens a claimed link whose review could not be written
+func (r *ReviewRequestRepository) Release(ctx context.Context, id int) error {
+	query := `UPDATE review_requests SET used_at = NULL WHERE id = $1 AND review_id IS NULL`
+	if _, err := r.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("failed to release review request: %w", err)
+	}
+	return nil
+}
+
+// SetReview links a claimed request to the review written from it
+func (r *ReviewRequestRepository) SetReview(ctx context.Context, id, reviewID int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE review_requests SET review_id = $1 WHERE id = $2`, reviewID, id)
+	if err != nil {
+		return fmt.Errorf("failed to link review request: %w", err)
+	}
+	return nil
+}
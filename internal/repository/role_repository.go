@@ -0,0 +1,141 @@
+// internal/repository/role_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// RoleRepository handles roles and their assignment to users
+type RoleRepository struct {
+	db *sqlx.DB
+}
+
+// NewRoleRepository creates a new role repository
+func NewRoleRepository(db *sqlx.DB) *RoleRepository {
+	return &RoleRepository{db: db}
+}
+
+const roleSelect = `
+	SELECT name, description, permissions, is_system, created_at, updated_at
+	FROM roles
+`
+
+// ========================================================================
+// ROLES
+// ========================================================================
+
+// FindAll returns every role, system roles first
+func (r *RoleRepository) FindAll(ctx context.Context) ([]models.Role, error) {
+	var roles []models.Role
+	if err := r.db.SelectContext(ctx, &roles, roleSelect+` ORDER BY is_system DESC, name`); err != nil {
+		return nil, fmt.Errorf("failed to find roles: %w", err)
+	}
+	return roles, nil
+}
+
+// FindByName returns the role with the given name
+func (r *RoleRepository) FindByName(ctx context.Context, name string) (*models.Role, error) {
+	var role models.Role
+	err := r.db.GetContext(ctx, &role, roleSelect+` WHERE name = $1`, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrRoleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find role: %w", err)
+	}
+	return &role, nil
+}
+
+// Create saves a new custom role
+func (r *RoleRepository) Create(ctx context.Context, role *models.Role) error {
+	query := `
+		INSERT INTO roles (name, description, permissions, is_system, created_at, updated_at)
+		VALUES (:name, :description, :permissions, FALSE, :created_at, :updated_at)
+	`
+	SetCreateTimestamps(&role.CreatedAt, &role.UpdatedAt)
+
+	if _, err := r.db.NamedExecContext(ctx, query, role); err != nil {
+		if IsDuplicateError(err) {
+			return ErrDuplicateRole
+		}
+		return fmt.Errorf("failed to create role: %w", err)
+	}
+	return nil
+}
+
+// Update saves a role's description and permissions
+func (r *RoleRepository) Update(ctx context.Context, role *models.Role) error {
+	query := `
+		UPDATE roles
+		SET description = :description, permissions = :permissions, updated_at = :updated_at
+		WHERE name = :name
+	`
+	SetUpdateTimestamp(&role.UpdatedAt)
+
+	result, err := r.db.NamedExecContext(ctx, query, role)
+	if err != nil {
+		return fmt.Errorf("failed to update role: %w", err)
+	}
+	return CheckRowsAffected(result, ErrRoleNotFound)
+}
+
+// Delete removes a custom role and its assignments
+func (r *RoleRepository) Delete(ctx context.Context, name string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM roles WHERE name = $1 AND NOT is_system`, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+	return CheckRowsAffected(result, ErrRoleNotFound)
+}
+
+// ========================================================================
+// ASSIGNMENTS
+// ========================================================================
+
+// FindUserRoles returns the roles assigned to a user
+func (r *RoleRepository) FindUserRoles(ctx context.Context, userID int) ([]models.UserRole, error) {
+	var roles []models.UserRole
+	err := r.db.SelectContext(ctx, &roles, `
+		SELECT user_id, role, granted_by, granted_at
+		FROM user_roles
+		WHERE user_id = $1
+		ORDER BY role
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find user roles: %w", err)
+	}
+	return roles, nil
+}
+
+// AssignRole grants a role to a user
+func (r *RoleRepository) AssignRole(ctx context.Context, assignment *models.UserRole) error {
+	query := `
+		INSERT INTO user_roles (user_id, role, granted_by)
+		VALUES ($1, $2, $3)
+		RETURNING granted_at
+	`
+	err := r.db.QueryRowxContext(ctx, query, assignment.UserID, assignment.Role, assignment.GrantedBy).
+		Scan(&assignment.GrantedAt)
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrRoleAlreadyAssigned
+		}
+		return fmt.Errorf("failed to assign role: %w", err)
+	}
+	return nil
+}
+
+// RevokeRole removes a role from a user
+func (r *RoleRepository) RevokeRole(ctx context.Context, userID int, role string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM user_roles WHERE user_id = $1 AND role = $2`, userID, role)
+	if err != nil {
+		return fmt.Errorf("failed to revoke role: %w", err)
+	}
+	return CheckRowsAffected(result, ErrUserRoleNotFound)
+}
@@ -0,0 +1,152 @@
+// internal/repository/schema_backfill_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ========================================================================
+// SCHEMA BACKFILL REPOSITORY - Batched backfills queued by migrations
+// ========================================================================
+
+// SchemaBackfillRepository handles migration backfills
+type SchemaBackfillRepository struct {
+	db *sqlx.DB
+}
+
+// NewSchemaBackfillRepository creates a new schema backfill repository
+func NewSchemaBackfillRepository(db *sqlx.DB) *SchemaBackfillRepository {
+	return &SchemaBackfillRepository{db: db}
+}
+
+// FindAll retrieves every backfill, oldest first
+func (r *SchemaBackfillRepository) FindAll(ctx context.Context) ([]models.SchemaBackfill, error) {
+	backfills := []models.SchemaBackfill{}
+	if err := r.db.SelectContext(ctx, &backfills, `SELECT * FROM schema_backfills ORDER BY created_at ASC, id ASC`); err != nil {
+		return nil, fmt.Errorf("failed to find backfills: %w", err)
+	}
+	return backfills, nil
+}
+
+// Claim takes the oldest pending backfill, or a running one whose lease has
+// expired, for lease. Returns nil when there is none.
+func (r *SchemaBackfillRepository) Claim(ctx context.Context, now time.Time, lease time.Duration) (*models.SchemaBackfill, error) {
+	query := `
+		UPDATE schema_backfills SET
+			status = $3,
+			locked_until = $2,
+			started_at = COALESCE(started_at, $1),
+			updated_at = $1
+		WHERE id = (
+			SELECT id FROM schema_backfills
+			WHERE status IN ($4, $3)
+			AND (locked_until IS NULL OR locked_until <= $1)
+			ORDER BY created_at ASC, id ASC
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *
+	`
+
+	var backfill models.SchemaBackfill
+	err := r.db.GetContext(ctx, &backfill, query,
+		now, now.Add(lease), config.BackfillStatusRunning, config.BackfillStatusPending)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim backfill: %w", err)
+	}
+	return &backfill, nil
+}
+
+// MaxKey returns the highest key in the backfill's table, or -1 when the
+// table is empty
+func (r *SchemaBackfillRepository) MaxKey(ctx context.Context, backfill *models.SchemaBackfill) (int64, error) {
+	query := fmt.Sprintf(`SELECT COALESCE(MAX(%s), -1) FROM %s`,
+		pq.QuoteIdentifier(backfill.KeyColumn), pq.QuoteIdentifier(backfill.TableName))
+
+	var maxKey int64
+	if err := r.db.GetContext(ctx, &maxKey, query); err != nil {
+		return 0, fmt.Errorf("failed to find max key of %s: %w", backfill.TableName, err)
+	}
+	return maxKey, nil
+}
+
+// RunBatch runs the backfill's UPDATE for keys in [from, to) and returns
+// the number of rows it changed
+func (r *SchemaBackfillRepository) RunBatch(ctx context.Context, backfill *models.SchemaBackfill, from, to int64) (int64, error) {
+	result, err := r.db.ExecContext(ctx, backfill.BatchSQL, from, to)
+	if err != nil {
+		return 0, fmt.Errorf("backfill %s failed for keys %d-%d: %w", backfill.Name, from, to-1, err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count backfilled rows: %w", err)
+	}
+	return rows, nil
+}
+
+// SaveProgress records the keys done and extends the lease
+func (r *SchemaBackfillRepository) SaveProgress(ctx context.Context, backfill *models.SchemaBackfill, now time.Time, lease time.Duration) error {
+	query := `
+		UPDATE schema_backfills SET
+			next_key = $2, max_key = $3, rows_updated = $4,
+			locked_until = $5, updated_at = $6
+		WHERE id = $1
+	`
+	_, err := r.db.ExecContext(ctx, query,
+		backfill.ID, backfill.NextKey, backfill.MaxKey, backfill.RowsUpdated, now.Add(lease), now)
+	if err != nil {
+		return fmt.Errorf("failed to save backfill progress: %w", err)
+	}
+	return nil
+}
+
+// Complete marks a backfill done, letting contract migrations that
+// require it run
+func (r *SchemaBackfillRepository) Complete(ctx context.Context, id int, now time.Time) error {
+	query := `
+		UPDATE schema_backfills SET
+			status = $2, locked_until = NULL, last_error = NULL, completed_at = $3, updated_at = $3
+		WHERE id = $1
+	`
+	if _, err := r.db.ExecContext(ctx, query, id, config.BackfillStatusCompleted, now); err != nil {
+		return fmt.Errorf("failed to complete backfill: %w", err)
+	}
+	return nil
+}
+
+// Fail stops a backfill until it is retried
+func (r *SchemaBackfillRepository) Fail(ctx context.Context, id int, message string, now time.Time) error {
+	query := `
+		UPDATE schema_backfills SET
+			status = $2, locked_until = NULL, last_error = $3, updated_at = $4
+		WHERE id = $1
+	`
+	if _, err := r.db.ExecContext(ctx, query, id, config.BackfillStatusFailed, message, now); err != nil {
+		return fmt.Errorf("failed to mark backfill failed: %w", err)
+	}
+	return nil
+}
+
+// Retry queues a failed backfill again, resuming where it stopped
+func (r *SchemaBackfillRepository) Retry(ctx context.Context, name string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE schema_backfills SET status = $2, last_error = NULL, updated_at = NOW()
+		WHERE name = $1 AND status = $3
+	`, name, config.BackfillStatusPending, config.BackfillStatusFailed)
+	if err != nil {
+		return fmt.Errorf("failed to retry backfill: %w", err)
+	}
+	return CheckRowsAffected(result, ErrBackfillNotFound)
+}
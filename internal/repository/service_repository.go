@@ -28,17 +28,42 @@ func NewServiceRepository(db *sqlx.DB) *ServiceRepository {
 
 // ServiceFilters represents filter options for services
 type ServiceFilters struct {
-	CategoryID   int
-	ServiceType  string
-	IsActive     *bool
-	IsApproved   *bool
-	Search       string
-	MinRating    float64
-	Complexity   int
-	TargetGender string
-	SortBy       string
-	Limit        int
-	Offset       int
+	CategoryID   int     `form:"category_id"`
+	ServiceType  string  `form:"service_type"`
+	IsActive     *bool   `form:"is_active"`
+	IsApproved   *bool   `form:"is_approved"`
+	Search       string  `form:"search"`
+	MinRating    float64 `form:"min_rating"`
+	Complexity   int     `form:"complexity"`
+	TargetGender string  `form:"target_gender"`
+	SortBy       string  `form:"sort_by"`
+	Limit        int     `form:"limit,default=20"`
+	Offset       int     `form:"offset,default=0"`
+}
+
+// ServiceSortColumns are what FindAll sorts services by, newest first
+// without a sort
+var ServiceSortColumns = SortColumns{
+	"name":       {Expr: "s.name", Order: "ASC"},
+	"popularity": {Expr: "s.global_popularity_score"},
+	"rating":     {Expr: "s.average_global_rating"},
+	"duration":   {Expr: "s.default_duration_min", Order: "ASC"},
+	"complexity": {Expr: "s.complexity", Order: "ASC"},
+}
+
+// barberServiceSortColumns are what FindBarberServices sorts by, in the
+// barber's display order without a sort
+var barberServiceSortColumns = SortColumns{
+	"price":      {Expr: "bs.price", Order: "ASC"},
+	"price_desc": {Expr: "bs.price"},
+	"rating":     {Expr: "bs.average_rating"},
+	"popularity": {Expr: "bs.popularity_score"},
+	"bookings":   {Expr: "bs.total_bookings"},
+}
+
+// ListParams implements ListFilters; each sort has a fixed direction
+func (f *ServiceFilters) ListParams() ListParams {
+	return ListParams{SortBy: &f.SortBy, Limit: &f.Limit, Offset: &f.Offset, Sorts: ServiceSortColumns}
 }
 
 // BarberServiceFilters represents filter options for barber services
@@ -57,17 +82,38 @@ type BarberServiceFilters struct {
 
 // FindAll retrieves all services with optional filters
 func (r *ServiceRepository) FindAll(ctx context.Context, filters ServiceFilters) ([]models.Service, error) {
-	// Define sort column mappings
-	sortMap := map[string]string{
-		"name":       "s.name ASC",
-		"popularity": "s.global_popularity_score DESC",
-		"rating":     "s.average_global_rating DESC",
-		"duration":   "s.default_duration_min ASC",
-		"complexity": "s.complexity ASC",
-		"default":    "s.created_at DESC",
+	// Build query using QueryBuilder
+	qb := serviceQuery(filters)
+
+	// Add sorting and pagination
+	query, args := qb.
+		OrderByColumns(filters.SortBy, "", ServiceSortColumns, SortColumn{Expr: "s.created_at"}).
+		Paginate(filters.Limit, filters.Offset).
+		Build()
+
+	// Execute query
+	var services []models.Service
+	err := r.db.SelectContext(ctx, &services, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch services: %w", err)
 	}
 
-	// Build query using QueryBuilder
+	return services, nil
+}
+
+// Count returns the total number of services matching the filters
+func (r *ServiceRepository) Count(ctx context.Context, filters ServiceFilters) (int, error) {
+	query, args := serviceQuery(filters).BuildCount()
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, args...); err != nil {
+		return 0, fmt.Errorf("failed to count services: %w", err)
+	}
+	return count, nil
+}
+
+// serviceQuery applies the service filters shared by FindAll and Count
+func serviceQuery(filters ServiceFilters) *QueryBuilder {
 	qb := BuildServiceQuery().
 		WhereIf(filters.CategoryID > 0, "s.category_id = ?", filters.CategoryID).
 		WhereIf(filters.ServiceType != "", "s.service_type = ?", filters.ServiceType).
@@ -96,20 +142,7 @@ func (r *ServiceRepository) FindAll(ctx context.Context, filters ServiceFilters)
 			}, filters.Search)
 	}
 
-	// Add sorting and pagination
-	query, args := qb.
-		OrderByWithDefault(filters.SortBy, "default", sortMap).
-		Paginate(filters.Limit, filters.Offset).
-		Build()
-
-	// Execute query
-	var services []models.Service
-	err := r.db.SelectContext(ctx, &services, query, args...)
-	if err != nil {
-		return nil, fmt.Errorf("failed to fetch services: %w", err)
-	}
-
-	return services, nil
+	return qb
 }
 
 // FindByID retrieves a service by ID
@@ -438,7 +471,7 @@ func (r *ServiceRepository) DeleteCategory(ctx context.Context, id int) error {
 // FindBarberServices retrieves all services offered by a barber
 func (r *ServiceRepository) FindBarberServices(ctx context.Context, filters BarberServiceFilters) ([]models.BarberService, error) {
 	query := `
-		SELECT bs.*, s.name as service_name, s.service_type, s.category_id
+		SELECT bs.*, s.name as service_name, s.service_type, s.category_id, s.allows_add_ons
 		FROM barber_services bs
 		LEFT JOIN services s ON bs.service_id = s.id
 		WHERE 1=1
@@ -483,22 +516,8 @@ func (r *ServiceRepository) FindBarberServices(ctx context.Context, filters Barb
 	}
 
 	// Sorting
-	orderBy := "bs.display_order ASC, bs.created_at DESC"
-	if filters.SortBy != "" {
-		switch filters.SortBy {
-		case "price":
-			orderBy = "bs.price ASC"
-		case "price_desc":
-			orderBy = "bs.price DESC"
-		case "rating":
-			orderBy = "bs.average_rating DESC"
-		case "popularity":
-			orderBy = "bs.popularity_score DESC"
-		case "bookings":
-			orderBy = "bs.total_bookings DESC"
-		}
-	}
-	query += " ORDER BY " + orderBy
+	query += " ORDER BY " + barberServiceSortColumns.OrderBy(filters.SortBy, "",
+		SortColumn{Expr: "bs.display_order", Order: "ASC", Then: "bs.created_at DESC"})
 
 	// Pagination
 	limit := config.BarberServicesPageLimit
@@ -525,7 +544,8 @@ func (r *ServiceRepository) FindBarberServices(ctx context.Context, filters Barb
 // FindBarberServiceByID retrieves a barber service by ID
 func (r *ServiceRepository) FindBarberServiceByID(ctx context.Context, id int) (*models.BarberService, error) {
 	query := `
-		SELECT bs.*, s.name as service_name
+		SELECT bs.*, s.name as service_name, s.allows_add_ons,
+			s.requires_consultation as service_requires_consultation
 		FROM barber_services bs
 		LEFT JOIN services s ON bs.service_id = s.id
 		WHERE bs.id = $1
@@ -549,7 +569,8 @@ func (r *ServiceRepository) CreateBarberService(ctx context.Context, bs *models.
 		INSERT INTO barber_services (
 			barber_id, service_id, custom_name, custom_description,
 			price, max_price, currency, discount_price, discount_valid_until,
-			estimated_duration_min, estimated_duration_max, buffer_time_minutes,
+			estimated_duration_min, estimated_duration_max, buffer_time_minutes, duration_segments,
+			deposit_type, deposit_value, no_show_fee,
 			advance_notice_hours, max_advance_booking_days, available_days, available_time_slots,
 			requires_consultation, consultation_duration, pre_service_instructions, post_service_care,
 			min_customer_age, max_customer_age,
@@ -564,7 +585,8 @@ func (r *ServiceRepository) CreateBarberService(ctx context.Context, bs *models.
 		) VALUES (
 			:barber_id, :service_id, :custom_name, :custom_description,
 			:price, :max_price, :currency, :discount_price, :discount_valid_until,
-			:estimated_duration_min, :estimated_duration_max, :buffer_time_minutes,
+			:estimated_duration_min, :estimated_duration_max, :buffer_time_minutes, :duration_segments,
+			:deposit_type, :deposit_value, :no_show_fee,
 			:advance_notice_hours, :max_advance_booking_days, :available_days, :available_time_slots,
 			:requires_consultation, :consultation_duration, :pre_service_instructions, :post_service_care,
 			:min_customer_age, :max_customer_age,
@@ -617,6 +639,10 @@ func (r *ServiceRepository) UpdateBarberService(ctx context.Context, bs *models.
 			estimated_duration_min = :estimated_duration_min,
 			estimated_duration_max = :estimated_duration_max,
 			buffer_time_minutes = :buffer_time_minutes,
+			duration_segments = :duration_segments,
+			deposit_type = :deposit_type,
+			deposit_value = :deposit_value,
+			no_show_fee = :no_show_fee,
 			advance_notice_hours = :advance_notice_hours,
 			max_advance_booking_days = :max_advance_booking_days,
 			available_days = :available_days,
@@ -693,3 +719,59 @@ func (r *ServiceRepository) GetBarbersByServiceID(ctx context.Context, serviceID
 
 	return r.FindBarberServices(ctx, filters)
 }
+
+// RefreshBarberServiceStats recalculates every barber service's booking and
+// revenue counters, all-time and since windowStart, and its cancellation
+// rate. Archived bookings count through their rolled-up counters; they are
+// far older than any window, so only live bookings are scanned for the
+// windowed ones. Returns the number of barber services updated.
+func (r *ServiceRepository) RefreshBarberServiceStats(ctx context.Context, windowStart, now time.Time) (int, error) {
+	query := `
+		UPDATE barber_services bs SET
+			total_bookings = s.completed,
+			total_revenue = s.revenue,
+			bookings_last_30_days = s.recent,
+			revenue_last_30_days = s.recent_revenue,
+			cancellation_rate = CASE WHEN s.decided > 0
+				THEN ROUND(100.0 * s.cancelled / s.decided, 2) ELSE 0 END,
+			updated_at = $2
+		FROM (
+			SELECT service_id,
+				SUM(completed) AS completed,
+				SUM(revenue) AS revenue,
+				SUM(recent) AS recent,
+				SUM(recent_revenue) AS recent_revenue,
+				SUM(cancelled) AS cancelled,
+				SUM(decided) AS decided
+			FROM (
+				SELECT barber_service_id AS service_id,
+					COUNT(*) FILTER (WHERE status = 'completed') AS completed,
+					COALESCE(SUM(total_price) FILTER (WHERE status = 'completed'), 0) AS revenue,
+					COUNT(*) FILTER (WHERE status = 'completed' AND scheduled_start_time >= $1) AS recent,
+					COALESCE(SUM(total_price) FILTER (WHERE status = 'completed' AND scheduled_start_time >= $1), 0) AS recent_revenue,
+					COUNT(*) FILTER (WHERE status IN ('cancelled', 'cancelled_by_customer', 'cancelled_by_barber')) AS cancelled,
+					COUNT(*) FILTER (WHERE status <> 'pending') AS decided
+				FROM bookings
+				WHERE NOT is_test AND barber_service_id IS NOT NULL
+				GROUP BY barber_service_id
+				UNION ALL
+				SELECT barber_service_id, completed, revenue, 0, 0,
+					cancelled + cancelled_by_customer + cancelled_by_barber, decided
+				FROM booking_archive_stats
+				WHERE barber_service_id <> 0
+			) parts
+			GROUP BY service_id
+		) s
+		WHERE bs.id = s.service_id
+	`
+
+	result, err := r.db.ExecContext(ctx, query, windowStart, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to refresh barber service stats: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return int(rows), nil
+}
@@ -0,0 +1,288 @@
+// internal/repository/shop_offboarding_repository.go
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ========================================================================
+// SHOP OFFBOARDING REPOSITORY - Shop exports and scheduled deletion
+// ========================================================================
+
+// ShopOffboardingRepository handles shop offboardings and reads the data a
+// shop exports
+type ShopOffboardingRepository struct {
+	db *sqlx.DB
+}
+
+// NewShopOffboardingRepository creates a new shop offboarding repository
+func NewShopOffboardingRepository(db *sqlx.DB) *ShopOffboardingRepository {
+	return &ShopOffboardingRepository{db: db}
+}
+
+// ========================================================================
+// OFFBOARDINGS
+// ========================================================================
+
+// Create schedules a shop's deletion. Returns ErrOffboardingScheduled if
+// one is already scheduled.
+func (r *ShopOffboardingRepository) Create(ctx context.Context, o *models.ShopOffboarding) error {
+	err := r.db.QueryRowxContext(ctx, `
+		INSERT INTO shop_offboardings (shop_id, shop_name, requested_by, reason, status, delete_after)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, summary, created_at, updated_at
+	`, o.ShopID, o.ShopName, o.RequestedBy, o.Reason, o.Status, o.DeleteAfter).
+		Scan(&o.ID, &o.Summary, &o.CreatedAt, &o.UpdatedAt)
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrOffboardingScheduled
+		}
+		return fmt.Errorf("failed to schedule shop offboarding: %w", err)
+	}
+	return nil
+}
+
+// FindLatestByShopID retrieves a shop's most recent offboarding
+func (r *ShopOffboardingRepository) FindLatestByShopID(ctx context.Context, shopID int) (*models.ShopOffboarding, error) {
+	var o models.ShopOffboarding
+	err := r.db.GetContext(ctx, &o, `
+		SELECT * FROM shop_offboardings WHERE shop_id = $1 ORDER BY created_at DESC, id DESC LIMIT 1
+	`, shopID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrShopOffboardingNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shop offboarding: %w", err)
+	}
+	return &o, nil
+}
+
+// Cancel cancels a scheduled offboarding whose deletion date has not come
+func (r *ShopOffboardingRepository) Cancel(ctx context.Context, id int, now time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE shop_offboardings SET status = $2, updated_at = $3
+		WHERE id = $1 AND status = $4 AND delete_after > $3
+	`, id, config.ShopOffboardingStatusCancelled, now, config.ShopOffboardingStatusScheduled)
+	if err != nil {
+		return fmt.Errorf("failed to cancel shop offboarding: %w", err)
+	}
+	return CheckRowsAffected(result, ErrShopOffboardingNotFound)
+}
+
+// FindDue retrieves up to limit scheduled offboardings whose deletion date
+// has passed, longest overdue first
+func (r *ShopOffboardingRepository) FindDue(ctx context.Context, now time.Time, limit int) ([]models.ShopOffboarding, error) {
+	offboardings := []models.ShopOffboarding{}
+	err := r.db.SelectContext(ctx, &offboardings, `
+		SELECT * FROM shop_offboardings
+		WHERE status = $1 AND delete_after <= $2
+		ORDER BY delete_after, id
+		LIMIT $3
+	`, config.ShopOffboardingStatusScheduled, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due shop offboardings: %w", err)
+	}
+	return offboardings, nil
+}
+
+// Postpone records why a due offboarding could not run yet
+func (r *ShopOffboardingRepository) Postpone(ctx context.Context, id int, reason string, now time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE shop_offboardings SET last_error = $2, updated_at = $3 WHERE id = $1
+	`, id, reason, now)
+	if err != nil {
+		return fmt.Errorf("failed to postpone shop offboarding: %w", err)
+	}
+	return nil
+}
+
+// CountUpcomingBookings counts the shop's bookings that still hold a slot
+// ending after now
+func (r *ShopOffboardingRepository) CountUpcomingBookings(ctx context.Context, shopID int, now time.Time) (int, error) {
+	var count int
+	err := r.db.GetContext(ctx, &count, `
+		SELECT COUNT(*) FROM bookings
+		WHERE barber_id IN (SELECT id FROM barbers WHERE shop_id = $1)
+		AND status IN ('pending', 'confirmed', 'in_progress')
+		AND scheduled_end_time > $2
+	`, shopID, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count upcoming shop bookings: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteShopData carries out a due offboarding in one transaction: the
+// shop's customers' personal data is erased from its live and archived
+// bookings, their history, its client list, reviews and survey answers
+// are deleted, its barbers are deactivated and the shop removed. The
+// offboarding is marked completed with the number of rows touched per
+// table. Financial events are append-only and stay.
+func (r *ShopOffboardingRepository) DeleteShopData(ctx context.Context, o *models.ShopOffboarding, now time.Time) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var barberIDs []int64
+	if err := tx.SelectContext(ctx, &barberIDs, `
+		SELECT id FROM barbers WHERE shop_id = $1 FOR UPDATE
+	`, o.ShopID); err != nil {
+		return fmt.Errorf("failed to find shop barbers: %w", err)
+	}
+	ids := pq.Array(barberIDs)
+
+	steps := []struct {
+		name  string
+		query string
+		args  []interface{}
+	}{
+		{"booking_history", `
+			DELETE FROM booking_history
+			WHERE booking_id IN (SELECT id FROM bookings WHERE barber_id = ANY($1))
+		`, []interface{}{ids}},
+		{"bookings", `
+			UPDATE bookings SET customer_name = NULL, customer_email = NULL, customer_phone = NULL,
+				notes = NULL, special_requests = NULL, internal_notes = NULL, custom_fields = NULL,
+				updated_at = $2
+			WHERE barber_id = ANY($1)
+		`, []interface{}{ids, now}},
+		{"bookings_archive", `
+			UPDATE bookings_archive SET customer_name = NULL, customer_email = NULL, customer_phone = NULL,
+				notes = NULL, special_requests = NULL, internal_notes = NULL, custom_fields = NULL,
+				related = related - 'history'
+			WHERE barber_id = ANY($1)
+		`, []interface{}{ids}},
+		{"barber_clients", `DELETE FROM barber_clients WHERE barber_id = ANY($1)`, []interface{}{ids}},
+		{"reviews", `DELETE FROM reviews WHERE barber_id = ANY($1)`, []interface{}{ids}},
+		{"nps_surveys", `DELETE FROM nps_surveys WHERE barber_id = ANY($1)`, []interface{}{ids}},
+		{"barbers", `
+			UPDATE barbers SET shop_id = NULL, status = $2, deleted_at = $3, updated_at = $3
+			WHERE id = ANY($1)
+		`, []interface{}{ids, config.BarberStatusInactive, now}},
+		{"shops", `DELETE FROM shops WHERE id = $1`, []interface{}{o.ShopID}},
+	}
+
+	summary := models.JSONMap{}
+	for _, step := range steps {
+		result, err := tx.ExecContext(ctx, step.query, step.args...)
+		if err != nil {
+			return fmt.Errorf("failed to delete shop data from %s: %w", step.name, err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to delete shop data from %s: %w", step.name, err)
+		}
+		summary[step.name] = rows
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE shop_offboardings
+		SET status = $2, completed_at = $3, summary = $4, last_error = NULL, updated_at = $3
+		WHERE id = $1
+	`, o.ID, config.ShopOffboardingStatusCompleted, now, summary)
+	if err != nil {
+		return fmt.Errorf("failed to complete shop offboarding: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	o.Status = config.ShopOffboardingStatusCompleted
+	o.CompletedAt = &now
+	o.Summary = summary
+	o.LastError = nil
+	return nil
+}
+
+// ========================================================================
+// EXPORT
+// ========================================================================
+//
+// Each export query returns up to limit rows with ids above afterID, in id
+// order, so callers page through a shop's data without holding it all.
+
+// ExportBookings retrieves a page of the shop's live and archived bookings.
+// Sandbox bookings are left out.
+func (r *ShopOffboardingRepository) ExportBookings(ctx context.Context, shopID, afterID, limit int) ([]models.ShopExportBooking, error) {
+	const columns = `id, booking_number, barber_id, customer_id, customer_name, service_name, status,
+		scheduled_start_time, scheduled_end_time, service_price, discount_amount, tax_amount,
+		tip_amount, total_price, currency, payment_status, booking_source, created_at`
+
+	bookings := []models.ShopExportBooking{}
+	err := r.db.SelectContext(ctx, &bookings, `
+		SELECT * FROM (
+			SELECT `+columns+`, false AS archived FROM bookings
+			WHERE barber_id IN (SELECT id FROM barbers WHERE shop_id = $1) AND NOT is_test
+			UNION ALL
+			SELECT `+columns+`, true AS archived FROM bookings_archive
+			WHERE barber_id IN (SELECT id FROM barbers WHERE shop_id = $1) AND NOT is_test
+		) b
+		WHERE id > $2
+		ORDER BY id
+		LIMIT $3
+	`, shopID, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export shop bookings: %w", err)
+	}
+	return bookings, nil
+}
+
+// ExportClients retrieves a page of the client lists of the shop's barbers
+func (r *ShopOffboardingRepository) ExportClients(ctx context.Context, shopID, afterID, limit int) ([]models.BarberClient, error) {
+	clients := []models.BarberClient{}
+	err := r.db.SelectContext(ctx, &clients, `
+		SELECT * FROM barber_clients
+		WHERE barber_id IN (SELECT id FROM barbers WHERE shop_id = $1) AND id > $2
+		ORDER BY id
+		LIMIT $3
+	`, shopID, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export shop clients: %w", err)
+	}
+	return clients, nil
+}
+
+// ExportReviews retrieves a page of the reviews of the shop's barbers
+func (r *ShopOffboardingRepository) ExportReviews(ctx context.Context, shopID, afterID, limit int) ([]models.ShopExportReview, error) {
+	reviews := []models.ShopExportReview{}
+	err := r.db.SelectContext(ctx, &reviews, `
+		SELECT id, booking_id, barber_id, overall_rating, title, comment, moderation_status,
+			is_published, barber_response, barber_response_at, created_at
+		FROM reviews
+		WHERE barber_id IN (SELECT id FROM barbers WHERE shop_id = $1) AND id > $2
+		ORDER BY id
+		LIMIT $3
+	`, shopID, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export shop reviews: %w", err)
+	}
+	return reviews, nil
+}
+
+// ExportFinancialEvents retrieves a page of the ledger events of the shop's
+// barbers
+func (r *ShopOffboardingRepository) ExportFinancialEvents(ctx context.Context, shopID int, afterID int64, limit int) ([]models.FinancialEvent, error) {
+	events := []models.FinancialEvent{}
+	err := r.db.SelectContext(ctx, &events, `
+		SELECT * FROM financial_events
+		WHERE barber_id IN (SELECT id FROM barbers WHERE shop_id = $1) AND id > $2
+		ORDER BY id
+		LIMIT $3
+	`, shopID, afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export shop financial events: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,241 @@
+// internal/repository/shop_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// SHOP REPOSITORY - Shops, their barbers and their admins
+// ========================================================================
+
+// ShopRepository handles shops, shop membership and shop admins
+type ShopRepository struct {
+	db *sqlx.DB
+}
+
+// NewShopRepository creates a new shop repository
+func NewShopRepository(db *sqlx.DB) *ShopRepository {
+	return &ShopRepository{db: db}
+}
+
+// ========================================================================
+// SHOPS
+// ========================================================================
+
+// FindByID retrieves a shop with its number of barbers
+func (r *ShopRepository) FindByID(ctx context.Context, id int) (*models.Shop, error) {
+	query := `
+		SELECT s.*,
+			(SELECT COUNT(*) FROM barbers b WHERE b.shop_id = s.id AND b.deleted_at IS NULL) AS barber_count
+		FROM shops s
+		WHERE s.id = $1
+	`
+
+	var shop models.Shop
+	err := r.db.GetContext(ctx, &shop, query, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrShopNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shop: %w", err)
+	}
+	return &shop, nil
+}
+
+// FindByBarberID retrieves the shop a barber works at. Returns
+// ErrShopNotFound for standalone barbers.
+func (r *ShopRepository) FindByBarberID(ctx context.Context, barberID int) (*models.Shop, error) {
+	query := `
+		SELECT s.* FROM shops s
+		JOIN barbers b ON b.shop_id = s.id
+		WHERE b.id = $1
+	`
+
+	var shop models.Shop
+	err := r.db.GetContext(ctx, &shop, query, barberID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrShopNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find barber's shop: %w", err)
+	}
+	return &shop, nil
+}
+
+// Create inserts a shop and makes its creator the owner in one transaction
+func (r *ShopRepository) Create(ctx context.Context, shop *models.Shop, ownerID int) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO shops (
+			name, description, address, address_line_2, city, state, country, postal_code,
+			latitude, longitude, phone, email, tax_id, timezone, opening_hours, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+		RETURNING id, uuid, created_at, updated_at
+	`
+	err = tx.QueryRowxContext(ctx, query,
+		shop.Name, shop.Description, shop.Address, shop.AddressLine2, shop.City, shop.State, shop.Country, shop.PostalCode,
+		shop.Latitude, shop.Longitude, shop.Phone, shop.Email, shop.TaxID, shop.Timezone, shop.OpeningHours, shop.CreatedBy,
+	).Scan(&shop.ID, &shop.UUID, &shop.CreatedAt, &shop.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create shop: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO shop_admins (shop_id, user_id, role) VALUES ($1, $2, $3)`,
+		shop.ID, ownerID, models.ShopRoleOwner)
+	if err != nil {
+		return fmt.Errorf("failed to add shop owner: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit shop: %w", err)
+	}
+	return nil
+}
+
+// Update saves a shop's details and settings
+func (r *ShopRepository) Update(ctx context.Context, shop *models.Shop) error {
+	query := `
+		UPDATE shops SET
+			name = $2, description = $3, address = $4, address_line_2 = $5,
+			city = $6, state = $7, country = $8, postal_code = $9,
+			latitude = $10, longitude = $11, phone = $12, email = $13, tax_id = $14,
+			timezone = $15, opening_hours = $16,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		shop.ID, shop.Name, shop.Description, shop.Address, shop.AddressLine2,
+		shop.City, shop.State, shop.Country, shop.PostalCode,
+		shop.Latitude, shop.Longitude, shop.Phone, shop.Email, shop.TaxID,
+		shop.Timezone, shop.OpeningHours,
+	).Scan(&shop.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrShopNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update shop: %w", err)
+	}
+	return nil
+}
+
+// ========================================================================
+// BARBERS
+// ========================================================================
+
+// FindBarbers retrieves the barbers working at a shop
+func (r *ShopRepository) FindBarbers(ctx context.Context, shopID int) ([]models.Barber, error) {
+	query := `
+		SELECT b.*, u.name as user_name, u.email as user_email
+		FROM barbers b
+		LEFT JOIN users u ON b.user_id = u.id
+		WHERE b.shop_id = $1 AND b.deleted_at IS NULL
+		ORDER BY b.shop_name ASC, b.id ASC
+	`
+
+	barbers := []models.Barber{}
+	if err := r.db.SelectContext(ctx, &barbers, query, shopID); err != nil {
+		return nil, fmt.Errorf("failed to find shop barbers: %w", err)
+	}
+	return barbers, nil
+}
+
+// AddBarber makes a standalone barber work at a shop. Returns
+// ErrBarberInOtherShop if they already work at one.
+func (r *ShopRepository) AddBarber(ctx context.Context, shopID, barberID int) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE barbers SET shop_id = $1, updated_at = NOW()
+		WHERE id = $2 AND deleted_at IS NULL AND (shop_id IS NULL OR shop_id = $1)
+	`, shopID, barberID)
+	if err != nil {
+		return fmt.Errorf("failed to add barber to shop: %w", err)
+	}
+	return CheckRowsAffected(result, ErrBarberInOtherShop)
+}
+
+// RemoveBarber makes a shop's barber standalone again
+func (r *ShopRepository) RemoveBarber(ctx context.Context, shopID, barberID int) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE barbers SET shop_id = NULL, updated_at = NOW()
+		WHERE id = $1 AND shop_id = $2
+	`, barberID, shopID)
+	if err != nil {
+		return fmt.Errorf("failed to remove barber from shop: %w", err)
+	}
+	return CheckRowsAffected(result, ErrShopBarberNotFound)
+}
+
+// ========================================================================
+// ADMINS
+// ========================================================================
+
+// FindAdmins retrieves a shop's admins, owner first
+func (r *ShopRepository) FindAdmins(ctx context.Context, shopID int) ([]models.ShopAdmin, error) {
+	query := `
+		SELECT sa.*, u.name as user_name, u.email as user_email
+		FROM shop_admins sa
+		LEFT JOIN users u ON sa.user_id = u.id
+		WHERE sa.shop_id = $1
+		ORDER BY sa.role = $2 DESC, sa.created_at ASC
+	`
+
+	admins := []models.ShopAdmin{}
+	if err := r.db.SelectContext(ctx, &admins, query, shopID, models.ShopRoleOwner); err != nil {
+		return nil, fmt.Errorf("failed to find shop admins: %w", err)
+	}
+	return admins, nil
+}
+
+// FindAdminRole returns a user's role at a shop. Returns
+// ErrShopAdminNotFound if they do not manage it.
+func (r *ShopRepository) FindAdminRole(ctx context.Context, shopID, userID int) (string, error) {
+	var role string
+	err := r.db.GetContext(ctx, &role, `SELECT role FROM shop_admins WHERE shop_id = $1 AND user_id = $2`, shopID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrShopAdminNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to find shop admin: %w", err)
+	}
+	return role, nil
+}
+
+// AddAdmin makes a user an admin of a shop
+func (r *ShopRepository) AddAdmin(ctx context.Context, admin *models.ShopAdmin) error {
+	err := r.db.QueryRowxContext(ctx, `
+		INSERT INTO shop_admins (shop_id, user_id, role)
+		VALUES ($1, $2, $3)
+		RETURNING created_at
+	`, admin.ShopID, admin.UserID, admin.Role).Scan(&admin.CreatedAt)
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrDuplicateShopAdmin
+		}
+		return fmt.Errorf("failed to add shop admin: %w", err)
+	}
+	return nil
+}
+
+// RemoveAdmin removes an admin from a shop. The owner cannot be removed.
+func (r *ShopRepository) RemoveAdmin(ctx context.Context, shopID, userID int) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM shop_admins WHERE shop_id = $1 AND user_id = $2 AND role <> $3
+	`, shopID, userID, models.ShopRoleOwner)
+	if err != nil {
+		return fmt.Errorf("failed to remove shop admin: %w", err)
+	}
+	return CheckRowsAffected(result, ErrShopAdminNotFound)
+}
@@ -0,0 +1,72 @@
+// internal/repository/sort_columns.go
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ========================================================================
+// SORT COLUMNS - Declarative sort_by allowlists
+// ========================================================================
+// Each list declares the sort_by keys it accepts and the SQL each orders
+// by. The keys are the whole allowlist: ValidateListFilters rejects any
+// other key, and only SQL written here reaches ORDER BY.
+// ========================================================================
+
+// SortColumn is the SQL a sort_by key orders by
+type SortColumn struct {
+	Expr      string // Column or expression, sorted in the requested order
+	Order     string // Order when the query gives none (DESC if empty)
+	NullsLast bool   // Rows without a value go last in either order
+	Before    string // Ordering applied ahead of Expr, as is
+	Then      string // Tie-break applied after Expr, as is
+}
+
+// SortColumns maps each sort_by key a list accepts to its SQL
+type SortColumns map[string]SortColumn
+
+// Keys returns the accepted sort_by keys, sorted
+func (s SortColumns) Keys() []string {
+	keys := make([]string, 0, len(s))
+	for key := range s {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// OrderBy returns the ORDER BY clause (without the keywords) for sortBy in
+// order, using fallback when sortBy is empty or not one of the keys
+func (s SortColumns) OrderBy(sortBy, order string, fallback SortColumn) string {
+	column, ok := s[sortBy]
+	if !ok {
+		column = fallback
+	}
+	return column.clause(order)
+}
+
+// clause renders the column in order (ASC or DESC, either case), or its
+// own default order
+func (c SortColumn) clause(order string) string {
+	direction := strings.ToUpper(order)
+	if direction != "ASC" && direction != "DESC" {
+		direction = strings.ToUpper(c.Order)
+	}
+	if direction != "ASC" {
+		direction = "DESC"
+	}
+
+	clause := fmt.Sprintf("%s %s", c.Expr, direction)
+	if c.NullsLast {
+		clause += " NULLS LAST"
+	}
+	if c.Before != "" {
+		clause = c.Before + ", " + clause
+	}
+	if c.Then != "" {
+		clause += ", " + c.Then
+	}
+	return clause
+}
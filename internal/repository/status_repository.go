@@ -0,0 +1,155 @@
+// internal/repository/status_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// STATUS REPOSITORY - Component health and incidents
+// ========================================================================
+
+// StatusRepository handles the status page's component statuses and incidents
+type StatusRepository struct {
+	db *sqlx.DB
+}
+
+// NewStatusRepository creates a new status repository
+func NewStatusRepository(db *sqlx.DB) *StatusRepository {
+	return &StatusRepository{db: db}
+}
+
+// Ping checks that the database answers
+func (r *StatusRepository) Ping(ctx context.Context) error {
+	return r.db.PingContext(ctx)
+}
+
+// ========================================================================
+// COMPONENT STATUSES
+// ========================================================================
+
+// FindComponentStatuses retrieves every component's latest check
+func (r *StatusRepository) FindComponentStatuses(ctx context.Context) ([]models.ComponentStatus, error) {
+	statuses := []models.ComponentStatus{}
+	if err := r.db.SelectContext(ctx, &statuses, `SELECT * FROM component_statuses ORDER BY component`); err != nil {
+		return nil, fmt.Errorf("failed to find component statuses: %w", err)
+	}
+	return statuses, nil
+}
+
+// SaveComponentStatus records a check, keeping changed_at unless the status changed
+func (r *StatusRepository) SaveComponentStatus(ctx context.Context, status *models.ComponentStatus) error {
+	query := `
+		INSERT INTO component_statuses (component, status, detail, checked_at, changed_at)
+		VALUES ($1, $2, $3, $4, $4)
+		ON CONFLICT (component) DO UPDATE SET
+			status = EXCLUDED.status,
+			detail = EXCLUDED.detail,
+			checked_at = EXCLUDED.checked_at,
+			changed_at = CASE
+				WHEN component_statuses.status = EXCLUDED.status THEN component_statuses.changed_at
+				ELSE EXCLUDED.checked_at
+			END
+		RETURNING changed_at
+	`
+	err := r.db.QueryRowxContext(ctx, query, status.Component, status.Status, status.Detail, status.CheckedAt).Scan(&status.ChangedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save component status: %w", err)
+	}
+	return nil
+}
+
+// ========================================================================
+// INCIDENTS
+// ========================================================================
+
+// CreateIncident stores a new incident
+func (r *StatusRepository) CreateIncident(ctx context.Context, incident *models.StatusIncident) error {
+	query := `
+		INSERT INTO status_incidents (component, impact, title, message, is_automatic, created_by, started_at, resolved_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at, updated_at
+	`
+	err := r.db.QueryRowxContext(ctx, query,
+		incident.Component, incident.Impact, incident.Title, incident.Message,
+		incident.IsAutomatic, incident.CreatedBy, incident.StartedAt, incident.ResolvedAt,
+	).Scan(&incident.ID, &incident.CreatedAt, &incident.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create incident: %w", err)
+	}
+	return nil
+}
+
+// FindIncidentByID retrieves an incident
+func (r *StatusRepository) FindIncidentByID(ctx context.Context, id int) (*models.StatusIncident, error) {
+	var incident models.StatusIncident
+	err := r.db.GetContext(ctx, &incident, `SELECT * FROM status_incidents WHERE id = $1`, id)
+	if err == sql.ErrNoRows {
+		return nil, ErrIncidentNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find incident: %w", err)
+	}
+	return &incident, nil
+}
+
+// FindOpenAutomaticIncident retrieves the unresolved incident a health
+// check opened for a component, if any (nil when there is none)
+func (r *StatusRepository) FindOpenAutomaticIncident(ctx context.Context, component string) (*models.StatusIncident, error) {
+	query := `
+		SELECT * FROM status_incidents
+		WHERE component = $1 AND is_automatic AND resolved_at IS NULL
+		ORDER BY started_at DESC
+		LIMIT 1
+	`
+	var incident models.StatusIncident
+	err := r.db.GetContext(ctx, &incident, query, component)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find open incident: %w", err)
+	}
+	return &incident, nil
+}
+
+// FindIncidentsSince retrieves incidents that were ongoing at any time
+// since since, newest first
+func (r *StatusRepository) FindIncidentsSince(ctx context.Context, since time.Time) ([]models.StatusIncident, error) {
+	query := `
+		SELECT * FROM status_incidents
+		WHERE resolved_at IS NULL OR resolved_at >= $1
+		ORDER BY started_at DESC, id DESC
+	`
+	incidents := []models.StatusIncident{}
+	if err := r.db.SelectContext(ctx, &incidents, query, since); err != nil {
+		return nil, fmt.Errorf("failed to find incidents: %w", err)
+	}
+	return incidents, nil
+}
+
+// UpdateIncident saves an incident's impact, title, message and resolution
+func (r *StatusRepository) UpdateIncident(ctx context.Context, incident *models.StatusIncident) error {
+	query := `
+		UPDATE status_incidents
+		SET impact = $2, title = $3, message = $4, resolved_at = $5, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+	err := r.db.QueryRowxContext(ctx, query,
+		incident.ID, incident.Impact, incident.Title, incident.Message, incident.ResolvedAt,
+	).Scan(&incident.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return ErrIncidentNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update incident: %w", err)
+	}
+	return nil
+}
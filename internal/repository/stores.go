@@ -0,0 +1,268 @@
+// internal/repository/stores.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+//go:generate go run ../../cmd/mockgen -o mocks/stores.gen.go stores.go
+
+// ========================================================================
+// STORES - Interfaces the service layer depends on
+// ========================================================================
+// Services hold these instead of the concrete repositories so their logic
+// can be unit tested against the generated mocks in repository/mocks. Each
+// interface lists only the methods services call; add a method here (and
+// run `go generate ./internal/repository/...`) before calling it from a
+// service.
+// ========================================================================
+
+// BookingStore is the booking data the service layer uses
+type BookingStore interface {
+	FindByID(ctx context.Context, id int) (*models.Booking, error)
+	FindByUUID(ctx context.Context, uuid string) (*models.Booking, error)
+	FindByBookingNumber(ctx context.Context, bookingNumber string) (*models.Booking, error)
+	FindAll(ctx context.Context, filters BookingFilters) ([]models.Booking, error)
+	FindByBarberID(ctx context.Context, barberID int, filters BookingFilters) ([]models.Booking, error)
+	FindByCustomerID(ctx context.Context, customerID int, filters BookingFilters) ([]models.Booking, error)
+	Count(ctx context.Context, filters BookingFilters) (int, error)
+	FindActiveInRange(ctx context.Context, barberID int, from, to time.Time) ([]models.Booking, error)
+	FindCalendarBookings(ctx context.Context, userID int, from time.Time, limit int) ([]BookingWithRelations, error)
+	FindDashboardBookings(ctx context.Context, barberID int, dayStart, dayEnd, now, pendingUntil time.Time) ([]models.DashboardBooking, error)
+	FindNoShowCandidates(ctx context.Context, now time.Time, defaultGraceMinutes int) ([]models.NoShowCandidate, error)
+	FindStalePending(ctx context.Context, createdBefore time.Time) ([]models.Booking, error)
+	FindLineItems(ctx context.Context, bookingID int) ([]models.BookingLineItem, error)
+	GetTodayBookings(ctx context.Context, barberID int) ([]models.Booking, error)
+	GetUpcomingBookings(ctx context.Context, filters BookingFilters) ([]models.Booking, error)
+	GetBarberStats(ctx context.Context, barberID int, from, to time.Time) (*BookingStats, error)
+	CountCancellationReasons(ctx context.Context, barberID int, from, to time.Time) ([]models.CancellationReasonCount, error)
+	GetHistory(ctx context.Context, bookingID int) ([]models.BookingHistory, error)
+	CheckConflict(ctx context.Context, barberID int, startTime, endTime time.Time, segments models.DurationSegments, travel models.Travel, excludeBookingID int) (bool, error)
+
+	CreateImported(ctx context.Context, booking *models.Booking, history *models.BookingHistory) error
+	CreateHistory(ctx context.Context, history *models.BookingHistory) error
+	Update(ctx context.Context, booking *models.Booking) error
+	UpdatePaymentStatus(ctx context.Context, id int, paymentStatus string, paymentMethod, paymentReference *string) error
+	UpdateCancellationFee(ctx context.Context, id int, fee float64) error
+
+	BeginTx(ctx context.Context) (*sqlx.Tx, error)
+	CheckConflictForUpdate(ctx context.Context, tx *sqlx.Tx, barberID int, startTime, endTime time.Time, segments models.DurationSegments, travel models.Travel, excludeBookingID int) (bool, error)
+	CheckConflictOutsideSeriesForUpdate(ctx context.Context, tx *sqlx.Tx, barberID int, startTime, endTime time.Time, segments models.DurationSegments, travel models.Travel, seriesID int) (bool, error)
+	CreateTx(ctx context.Context, tx *sqlx.Tx, booking *models.Booking) error
+	CreateHistoryTx(ctx context.Context, tx *sqlx.Tx, history *models.BookingHistory) error
+	UpdateStatusTx(ctx context.Context, tx *sqlx.Tx, id int, newStatus string) error
+	UpdatePricingTx(ctx context.Context, tx *sqlx.Tx, booking *models.Booking) error
+	RescheduleTx(ctx context.Context, tx *sqlx.Tx, id int, startTime, endTime time.Time, durationMinutes int, segments models.DurationSegments) error
+	CancelTx(ctx context.Context, tx *sqlx.Tx, id int, status string, cancelledBy *int, reasonCode *string, reason string) error
+	SetCancellationTx(ctx context.Context, tx *sqlx.Tx, id int, cancelledBy *int, reasonCode *string, reason string) error
+	LockStatusTx(ctx context.Context, tx *sqlx.Tx, id int, status string) error
+}
+
+// BookingSeriesStore is the recurring booking data the service layer uses
+type BookingSeriesStore interface {
+	FindByID(ctx context.Context, id int) (*models.BookingSeries, error)
+	FindOccurrences(ctx context.Context, seriesID int) ([]models.Booking, error)
+	CreateTx(ctx context.Context, tx *sqlx.Tx, series *models.BookingSeries) error
+	UpdateScheduleTx(ctx context.Context, tx *sqlx.Tx, id int, startTime time.Time, durationMinutes int) error
+	UpdateStatusTx(ctx context.Context, tx *sqlx.Tx, id int, status string) error
+}
+
+// ReviewStore is the review data the service layer uses
+type ReviewStore interface {
+	FindByID(ctx context.Context, id int) (*models.Review, error)
+	FindByBookingID(ctx context.Context, bookingID int) (*models.Review, error)
+	FindAll(ctx context.Context, filters ReviewFilters) ([]models.Review, error)
+	FindByBarberID(ctx context.Context, barberID int, filters ReviewFilters) ([]models.Review, error)
+	FindByCustomerID(ctx context.Context, customerID int, filters ReviewFilters) ([]models.Review, error)
+	Count(ctx context.Context, filters ReviewFilters) (int, error)
+	ExistsByBookingID(ctx context.Context, bookingID int) (bool, error)
+	GetBarberStats(ctx context.Context, barberID int) (*ReviewStats, error)
+	CountMatchingComments(ctx context.Context, comment string, since time.Time) (int, error)
+	CountRatingOnly(ctx context.Context, barberID int, since time.Time) (int, error)
+
+	Create(ctx context.Context, review *models.Review) error
+	Update(ctx context.Context, review *models.Review) error
+	Delete(ctx context.Context, id int) error
+	AddBarberResponse(ctx context.Context, id int, response, status string, notes *string) error
+	ModerateBarberResponse(ctx context.Context, id int, status string, moderatorID int, notes *string) error
+	UpdateModerationStatus(ctx context.Context, id int, status string, moderatorID int, notes *string) error
+	IncrementHelpfulVotes(ctx context.Context, id int, isHelpful bool) error
+
+	BeginTx(ctx context.Context) (*sqlx.Tx, error)
+	CreateTx(ctx context.Context, tx *sqlx.Tx, review *models.Review) error
+}
+
+// BarberStore is the barber data the service layer uses
+type BarberStore interface {
+	FindByID(ctx context.Context, id int) (*models.Barber, error)
+	FindByUUID(ctx context.Context, uuid string) (*models.Barber, error)
+	FindByUserID(ctx context.Context, userID int) (*models.Barber, error)
+	FindAll(ctx context.Context, filters BarberFilters) ([]models.Barber, error)
+	FindNearby(ctx context.Context, lat, lng, radiusKm float64, limit int) ([]models.Barber, error)
+	FindTopServices(ctx context.Context, barberIDs []int, perBarber int) (map[int][]models.BarberService, error)
+	GetStatistics(ctx context.Context, id int) (*BarberStatistics, error)
+
+	Create(ctx context.Context, barber *models.Barber) error
+	Update(ctx context.Context, barber *models.Barber) error
+	UpdateStatus(ctx context.Context, id int, status string) error
+	Delete(ctx context.Context, id int) error
+
+	RefreshBookingStats(ctx context.Context, barberID int) error
+	RefreshAllStats(ctx context.Context, now time.Time) (int, error)
+	UpdateRatingStatsTx(ctx context.Context, tx *sqlx.Tx, barberID int) error
+	UpdateRatingStats(ctx context.Context, barberID int) error
+	ReconcileRatingStats(ctx context.Context, now time.Time) (int, int, error)
+}
+
+// UserStore is the user data the service layer uses
+type UserStore interface {
+	FindByID(ctx context.Context, id int) (*models.User, error)
+	FindByEmail(ctx context.Context, email string) (*models.User, error)
+	FindByPhone(ctx context.Context, phone string) (*models.User, error)
+	EmailExists(ctx context.Context, email string) (bool, error)
+
+	Create(ctx context.Context, user *models.User) error
+	Update(ctx context.Context, user *models.User) error
+	UpdatePassword(ctx context.Context, userID int, hashedPassword string) error
+	UpdateLastLogin(ctx context.Context, userID int) error
+	MarkEmailVerified(ctx context.Context, userID int) error
+
+	IsAccountLocked(ctx context.Context, userID int) (bool, error)
+	IncrementFailedLoginAttempts(ctx context.Context, userID int) error
+	ResetFailedLoginAttempts(ctx context.Context, userID int) error
+	LockAccount(ctx context.Context, userID int, duration time.Duration) error
+}
+
+// RefreshTokenStore is the refresh token data the service layer uses
+type RefreshTokenStore interface {
+	Create(ctx context.Context, token *models.RefreshToken) error
+	FindByHash(ctx context.Context, tokenHash string) (*models.RefreshToken, error)
+	Rotate(ctx context.Context, oldID int, successor *models.RefreshToken) error
+	RevokeFamily(ctx context.Context, familyID string, at time.Time) (int64, error)
+	RevokeAllForUser(ctx context.Context, userID int, at time.Time) (int64, error)
+	DeleteExpired(ctx context.Context, before time.Time) (int64, error)
+}
+
+// UserIdentityStore is the linked sign-in account data the service layer
+// uses
+type UserIdentityStore interface {
+	Create(ctx context.Context, identity *models.UserIdentity) error
+	FindByProviderSubject(ctx context.Context, provider, subject string) (*models.UserIdentity, error)
+	FindByUser(ctx context.Context, userID int) ([]models.UserIdentity, error)
+	Delete(ctx context.Context, userID int, provider string) error
+	UpdateLastLogin(ctx context.Context, id int) error
+}
+
+// ServiceStore is the service catalog data the service layer uses
+type ServiceStore interface {
+	FindByID(ctx context.Context, id int) (*models.Service, error)
+	FindByUUID(ctx context.Context, uuid string) (*models.Service, error)
+	FindBySlug(ctx context.Context, slug string) (*models.Service, error)
+	FindAll(ctx context.Context, filters ServiceFilters) ([]models.Service, error)
+	Count(ctx context.Context, filters ServiceFilters) (int, error)
+	Create(ctx context.Context, service *models.Service) error
+	Update(ctx context.Context, service *models.Service) error
+	Delete(ctx context.Context, id int) error
+
+	FindCategoryByID(ctx context.Context, id int) (*models.ServiceCategory, error)
+	FindAllCategories(ctx context.Context, activeOnly bool) ([]models.ServiceCategory, error)
+	CreateCategory(ctx context.Context, category *models.ServiceCategory) error
+	UpdateCategory(ctx context.Context, category *models.ServiceCategory) error
+	DeleteCategory(ctx context.Context, id int) error
+
+	FindBarberServiceByID(ctx context.Context, id int) (*models.BarberService, error)
+	FindBarberServices(ctx context.Context, filters BarberServiceFilters) ([]models.BarberService, error)
+	GetServicesByBarberID(ctx context.Context, barberID int) ([]models.BarberService, error)
+	GetBarbersByServiceID(ctx context.Context, serviceID int) ([]models.BarberService, error)
+	CreateBarberService(ctx context.Context, bs *models.BarberService) error
+	UpdateBarberService(ctx context.Context, bs *models.BarberService) error
+	DeleteBarberService(ctx context.Context, id int) error
+
+	RefreshBarberServiceStats(ctx context.Context, windowStart, now time.Time) (int, error)
+}
+
+// ScheduleStore is the barber schedule data the service layer uses
+type ScheduleStore interface {
+	FindByBarberID(ctx context.Context, barberID int) (*models.BarberSchedule, error)
+	ReplaceWeekly(ctx context.Context, barberID int, timezone string, periods []models.SchedulePeriod) error
+	CreateException(ctx context.Context, exception *models.ScheduleException) error
+	DeleteException(ctx context.Context, barberID, exceptionID int) error
+}
+
+// AuditLogStore is the audit log data the service layer uses
+type AuditLogStore interface {
+	FindByID(ctx context.Context, id int) (*models.AuditLog, error)
+	FindAll(ctx context.Context, filters AuditLogFilters) ([]models.AuditLog, error)
+	Create(ctx context.Context, entry *models.AuditLog) error
+}
+
+// NotificationStore is the notification data the service layer uses
+type NotificationStore interface {
+	FindByID(ctx context.Context, id int) (*models.Notification, error)
+	FindByUserID(ctx context.Context, userID int, filters NotificationFilters) ([]models.Notification, error)
+	GetUnreadNotifications(ctx context.Context, userID int, limit int) ([]models.Notification, error)
+	GetPendingNotifications(ctx context.Context, limit int) ([]models.Notification, error)
+	GetByRelatedEntity(ctx context.Context, entityType string, entityID int) ([]models.Notification, error)
+	GetUserStats(ctx context.Context, userID int) (*NotificationStats, error)
+	GetUnreadCount(ctx context.Context, userID int) (int, error)
+	Count(ctx context.Context, filters NotificationFilters) (int, error)
+
+	Create(ctx context.Context, notification *models.Notification) error
+	CreateBatch(ctx context.Context, notifications []*models.Notification) error
+	Delete(ctx context.Context, id int) error
+	DeleteOldNotifications(ctx context.Context, olderThan time.Duration) (int, error)
+	DeleteExpiredNotifications(ctx context.Context) (int, error)
+
+	ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.Notification, error)
+	ScheduleRetry(ctx context.Context, id int, nextAttemptAt time.Time, lastError string, delivered []string) error
+	Requeue(ctx context.Context, id int) error
+	MarkAsSent(ctx context.Context, id int) error
+	MarkAsDelivered(ctx context.Context, id int) error
+	MarkAsRead(ctx context.Context, id int) error
+	MarkAllAsRead(ctx context.Context, userID int) (int, error)
+	MarkAsFailed(ctx context.Context, id int, errorMsg string) error
+	FailUndelivered(ctx context.Context, createdBefore time.Time, reason string) (int, error)
+}
+
+// WebhookStore is the webhook subscription and delivery data the service
+// layer uses
+type WebhookStore interface {
+	FindSubscriptionByID(ctx context.Context, id int) (*models.WebhookSubscription, error)
+	FindSubscriptionsByBarber(ctx context.Context, barberID int) ([]models.WebhookSubscription, error)
+	FindSubscribers(ctx context.Context, barberID int, eventType string) ([]models.WebhookSubscription, error)
+	CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	UpdateSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	DeleteSubscription(ctx context.Context, id int) error
+
+	FindDeliveriesBySubscription(ctx context.Context, subscriptionID int, status string, limit, offset int) ([]models.WebhookDelivery, int, error)
+	FindDeliveryByID(ctx context.Context, id int) (*models.WebhookDelivery, error)
+	CreateDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error
+	Requeue(ctx context.Context, id int) (*models.WebhookDelivery, error)
+	ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.WebhookDelivery, error)
+	MarkDelivered(ctx context.Context, id, responseStatus int, at time.Time) error
+	ScheduleRetry(ctx context.Context, id int, responseStatus *int, lastError string, nextAttemptAt time.Time) error
+	MarkFailed(ctx context.Context, id int, responseStatus *int, lastError string) error
+}
+
+// PortfolioStore is the portfolio media data the service layer uses
+type PortfolioStore interface {
+	FindByBarberServiceID(ctx context.Context, barberServiceID int, kind string) ([]models.PortfolioMedia, error)
+	FindByID(ctx context.Context, id int) (*models.PortfolioMedia, error)
+	Create(ctx context.Context, media *models.PortfolioMedia, maxMedia int) error
+	UpdateCaption(ctx context.Context, id int, caption *string) error
+	Reorder(ctx context.Context, barberServiceID int, kind string, ids []int) error
+	Delete(ctx context.Context, barberServiceID, id int) (*models.PortfolioMedia, error)
+}
+
+var (
+	_ BookingStore  = (*BookingRepository)(nil)
+	_ ReviewStore   = (*ReviewRepository)(nil)
+	_ BarberStore   = (*BarberRepository)(nil)
+	_ UserStore     = (*UserRepository)(nil)
+	_ ServiceStore  = (*ServiceRepository)(nil)
+	_ ScheduleStore = (*BarberScheduleRepository)(nil)
+)
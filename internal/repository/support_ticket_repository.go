@@ -0,0 +1,246 @@
+// internal/repository/support_ticket_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// SUPPORT TICKET REPOSITORY - Tickets and their conversations
+// ========================================================================
+
+// SupportTicketRepository handles support tickets and their messages
+type SupportTicketRepository struct {
+	db *sqlx.DB
+}
+
+// NewSupportTicketRepository creates a new support ticket repository
+func NewSupportTicketRepository(db *sqlx.DB) *SupportTicketRepository {
+	return &SupportTicketRepository{db: db}
+}
+
+// SupportTicketFilters narrows a ticket listing
+type SupportTicketFilters struct {
+	RequesterID int // 0 = any requester
+	AssignedTo  int // 0 = anyone or no one
+	Status      string
+	Priority    string
+	Category    string
+	Breached    bool // Only tickets that missed an SLA target
+	ByDueDate   bool // Soonest resolution due first (default: newest first)
+	Limit       int
+	Offset      int
+}
+
+// ========================================================================
+// TICKETS
+// ========================================================================
+
+// Create inserts a ticket and its opening message in one transaction
+func (r *SupportTicketRepository) Create(ctx context.Context, ticket *models.SupportTicket, message *models.SupportTicketMessage) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO support_tickets (
+			requester_id, booking_id, payment_reference, category, priority, status, subject,
+			first_response_due_at, resolution_due_at, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10)
+		RETURNING id, updated_at
+	`
+	err = tx.QueryRowxContext(ctx, query,
+		ticket.RequesterID, ticket.BookingID, ticket.PaymentReference, ticket.Category, ticket.Priority,
+		ticket.Status, ticket.Subject, ticket.FirstResponseDueAt, ticket.ResolutionDueAt, ticket.CreatedAt,
+	).Scan(&ticket.ID, &ticket.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create support ticket: %w", err)
+	}
+
+	message.TicketID = ticket.ID
+	if err := createSupportMessageTx(ctx, tx, message); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a ticket by its ID, without its messages
+func (r *SupportTicketRepository) FindByID(ctx context.Context, id int) (*models.SupportTicket, error) {
+	var ticket models.SupportTicket
+	err := r.db.GetContext(ctx, &ticket, `SELECT * FROM support_tickets WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSupportTicketNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find support ticket: %w", err)
+	}
+	return &ticket, nil
+}
+
+// FindAll lists tickets matching the filters
+func (r *SupportTicketRepository) FindAll(ctx context.Context, filters SupportTicketFilters) ([]models.SupportTicket, error) {
+	qb := NewQueryBuilder(`SELECT * FROM support_tickets`).
+		WhereIf(filters.RequesterID != 0, "requester_id = ?", filters.RequesterID).
+		WhereIf(filters.AssignedTo != 0, "assigned_to = ?", filters.AssignedTo).
+		WhereIf(filters.Status != "", "status = ?", filters.Status).
+		WhereIf(filters.Priority != "", "priority = ?", filters.Priority).
+		WhereIf(filters.Category != "", "category = ?", filters.Category)
+	if filters.Breached {
+		qb.WhereNotNull("COALESCE(first_response_breached_at, resolution_breached_at)")
+	}
+	if filters.ByDueDate {
+		qb.OrderBy("resolution_due_at ASC, id", "ASC")
+	} else {
+		qb.OrderBy("created_at DESC, id", "DESC")
+	}
+	query, args := qb.Paginate(filters.Limit, filters.Offset).Build()
+
+	tickets := []models.SupportTicket{}
+	if err := r.db.SelectContext(ctx, &tickets, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list support tickets: %w", err)
+	}
+	return tickets, nil
+}
+
+// CountActiveByRequester counts a user's tickets that are not resolved or
+// closed
+func (r *SupportTicketRepository) CountActiveByRequester(ctx context.Context, requesterID int) (int, error) {
+	var count int
+	err := r.db.GetContext(ctx, &count, `
+		SELECT COUNT(*) FROM support_tickets
+		WHERE requester_id = $1 AND status NOT IN ($2, $3)
+	`, requesterID, config.SupportStatusResolved, config.SupportStatusClosed)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count support tickets: %w", err)
+	}
+	return count, nil
+}
+
+// Save writes a ticket's workflow and SLA fields, together with a new
+// message when one is given, in one transaction
+func (r *SupportTicketRepository) Save(ctx context.Context, ticket *models.SupportTicket, message *models.SupportTicketMessage) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE support_tickets SET
+			priority = $2, status = $3, assigned_to = $4,
+			first_response_due_at = $5, resolution_due_at = $6, first_responded_at = $7,
+			sla_paused_at = $8, sla_paused_seconds = $9, resolved_at = $10, closed_at = $11,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+	err = tx.QueryRowxContext(ctx, query,
+		ticket.ID, ticket.Priority, ticket.Status, ticket.AssignedTo,
+		ticket.FirstResponseDueAt, ticket.ResolutionDueAt, ticket.FirstRespondedAt,
+		ticket.SLAPausedAt, ticket.SLAPausedSeconds, ticket.ResolvedAt, ticket.ClosedAt,
+	).Scan(&ticket.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrSupportTicketNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update support ticket: %w", err)
+	}
+
+	if message != nil {
+		message.TicketID = ticket.ID
+		if err := createSupportMessageTx(ctx, tx, message); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ========================================================================
+// MESSAGES
+// ========================================================================
+
+// FindMessages retrieves a ticket's conversation, oldest first; internal
+// notes are left out unless includeInternal is set
+func (r *SupportTicketRepository) FindMessages(ctx context.Context, ticketID int, includeInternal bool) ([]models.SupportTicketMessage, error) {
+	messages := []models.SupportTicketMessage{}
+	err := r.db.SelectContext(ctx, &messages, `
+		SELECT * FROM support_ticket_messages
+		WHERE ticket_id = $1 AND ($2 OR NOT is_internal)
+		ORDER BY created_at ASC, id ASC
+	`, ticketID, includeInternal)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find support ticket messages: %w", err)
+	}
+	return messages, nil
+}
+
+// createSupportMessageTx inserts a message on a ticket within tx
+func createSupportMessageTx(ctx context.Context, tx *sqlx.Tx, message *models.SupportTicketMessage) error {
+	err := tx.QueryRowxContext(ctx, `
+		INSERT INTO support_ticket_messages (ticket_id, author_id, is_staff, is_internal, body)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`, message.TicketID, message.AuthorID, message.IsStaff, message.IsInternal, message.Body,
+	).Scan(&message.ID, &message.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create support ticket message: %w", err)
+	}
+	return nil
+}
+
+// ========================================================================
+// SLA
+// ========================================================================
+
+// FindNewlyBreached retrieves active tickets that passed an SLA due date
+// by now and have not been flagged for it yet, oldest due first. Paused
+// tickets are not due for resolution while they wait on the requester.
+func (r *SupportTicketRepository) FindNewlyBreached(ctx context.Context, now time.Time, limit int) ([]models.SupportTicket, error) {
+	tickets := []models.SupportTicket{}
+	err := r.db.SelectContext(ctx, &tickets, `
+		SELECT * FROM support_tickets
+		WHERE status NOT IN ($2, $3)
+			AND (
+				(first_responded_at IS NULL AND first_response_breached_at IS NULL AND first_response_due_at <= $1)
+				OR (sla_paused_at IS NULL AND resolution_breached_at IS NULL AND resolution_due_at <= $1)
+			)
+		ORDER BY LEAST(first_response_due_at, resolution_due_at) ASC, id ASC
+		LIMIT $4
+	`, now, config.SupportStatusResolved, config.SupportStatusClosed, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find breached support tickets: %w", err)
+	}
+	return tickets, nil
+}
+
+// MarkBreached flags the SLA targets a ticket missed at now
+func (r *SupportTicketRepository) MarkBreached(ctx context.Context, id int, firstResponse, resolution bool, now time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE support_tickets SET
+			first_response_breached_at = CASE WHEN $2 THEN COALESCE(first_response_breached_at, $4) ELSE first_response_breached_at END,
+			resolution_breached_at = CASE WHEN $3 THEN COALESCE(resolution_breached_at, $4) ELSE resolution_breached_at END
+		WHERE id = $1
+	`, id, firstResponse, resolution, now)
+	if err != nil {
+		return fmt.Errorf("failed to flag support ticket breach: %w", err)
+	}
+	return nil
+}
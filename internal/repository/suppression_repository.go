@@ -0,0 +1,132 @@
+// internal/repository/suppression_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// SUPPRESSION REPOSITORY - Addresses outbound messages must skip
+// ========================================================================
+
+// SuppressionRepository handles the email/SMS suppression list
+type SuppressionRepository struct {
+	db *sqlx.DB
+}
+
+// NewSuppressionRepository creates a new suppression repository
+func NewSuppressionRepository(db *sqlx.DB) *SuppressionRepository {
+	return &SuppressionRepository{db: db}
+}
+
+// SuppressionFilters narrows suppression listings
+type SuppressionFilters struct {
+	Channel string
+	Reason  string
+	Address string // Substring of the normalized address
+	Limit   int
+	Offset  int
+}
+
+// Upsert adds an address to the suppression list. An address that is
+// already suppressed keeps its original created_at and takes the latest
+// reason, source and detail.
+func (r *SuppressionRepository) Upsert(ctx context.Context, suppression *models.Suppression) error {
+	query := `
+		INSERT INTO notification_suppressions (channel, address, reason, source, detail, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (channel, address) DO UPDATE SET
+			reason = EXCLUDED.reason,
+			source = EXCLUDED.source,
+			detail = EXCLUDED.detail,
+			created_by = EXCLUDED.created_by,
+			updated_at = NOW()
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		suppression.Channel, suppression.Address, suppression.Reason,
+		suppression.Source, suppression.Detail, suppression.CreatedBy,
+	).Scan(&suppression.ID, &suppression.CreatedAt, &suppression.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save suppression: %w", err)
+	}
+	return nil
+}
+
+// FindByID retrieves a suppression by ID
+func (r *SuppressionRepository) FindByID(ctx context.Context, id int) (*models.Suppression, error) {
+	var suppression models.Suppression
+	err := r.db.GetContext(ctx, &suppression, `SELECT * FROM notification_suppressions WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSuppressionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find suppression: %w", err)
+	}
+	return &suppression, nil
+}
+
+// FindByAddress returns the suppression for a normalized address
+func (r *SuppressionRepository) FindByAddress(ctx context.Context, channel, address string) (*models.Suppression, error) {
+	var suppression models.Suppression
+	err := r.db.GetContext(ctx, &suppression, `
+		SELECT * FROM notification_suppressions WHERE channel = $1 AND address = $2
+	`, channel, address)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrSuppressionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find suppression: %w", err)
+	}
+	return &suppression, nil
+}
+
+// FindAll lists suppressions, newest first
+func (r *SuppressionRepository) FindAll(ctx context.Context, filters SuppressionFilters) ([]models.Suppression, error) {
+	query, args := NewQueryBuilder(`SELECT * FROM notification_suppressions`).
+		WhereIf(filters.Channel != "", "channel = ?", filters.Channel).
+		WhereIf(filters.Reason != "", "reason = ?", filters.Reason).
+		WhereIf(filters.Address != "", "address LIKE ?", "%"+filters.Address+"%").
+		OrderBy("created_at DESC, id", "DESC").
+		Paginate(filters.Limit, filters.Offset).
+		Build()
+
+	suppressions := []models.Suppression{}
+	if err := r.db.SelectContext(ctx, &suppressions, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list suppressions: %w", err)
+	}
+	return suppressions, nil
+}
+
+// Delete lifts a suppression
+func (r *SuppressionRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM notification_suppressions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete suppression: %w", err)
+	}
+	return CheckRowsAffected(result, ErrSuppressionNotFound)
+}
+
+// DeleteByAddress lifts the suppression of an address if it was added for
+// reason (e.g. an opt-out reversed by the recipient). It reports whether a
+// suppression was lifted.
+func (r *SuppressionRepository) DeleteByAddress(ctx context.Context, channel, address, reason string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM notification_suppressions WHERE channel = $1 AND address = $2 AND reason = $3
+	`, channel, address, reason)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete suppression: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to check rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
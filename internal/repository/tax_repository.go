@@ -0,0 +1,180 @@
+// internal/repository/tax_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/sandbox"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// TAX REPOSITORY - Tax rules and the taxes charged on bookings
+// ========================================================================
+
+// TaxRepository handles tax rules and booking tax line items
+type TaxRepository struct {
+	db *sqlx.DB
+}
+
+// NewTaxRepository creates a new tax repository
+func NewTaxRepository(db *sqlx.DB) *TaxRepository {
+	return &TaxRepository{db: db}
+}
+
+// ========================================================================
+// TAX RULES
+// ========================================================================
+
+// FindAll retrieves every tax rule, most specific scope first
+func (r *TaxRepository) FindAll(ctx context.Context) ([]models.TaxRule, error) {
+	query := `
+		SELECT * FROM tax_rules
+		ORDER BY barber_id ASC NULLS LAST, shop_id ASC NULLS LAST, country ASC NULLS LAST, state ASC NULLS LAST, name ASC
+	`
+
+	rules := []models.TaxRule{}
+	if err := r.db.SelectContext(ctx, &rules, query); err != nil {
+		return nil, fmt.Errorf("failed to find tax rules: %w", err)
+	}
+	return rules, nil
+}
+
+// FindCandidates retrieves the active rules that may apply to a barber: the
+// barber's own, their shop's, their region's and the global ones. Use
+// models.ApplicableTaxRules to pick those that do.
+func (r *TaxRepository) FindCandidates(ctx context.Context, barber *models.Barber) ([]models.TaxRule, error) {
+	query := `
+		SELECT * FROM tax_rules
+		WHERE is_active
+			AND (barber_id = $1 OR shop_id = $4 OR (barber_id IS NULL AND shop_id IS NULL
+				AND (country IS NULL OR LOWER(country) = LOWER($2))
+				AND (state IS NULL OR LOWER(state) = LOWER($3))))
+		ORDER BY id ASC
+	`
+
+	rules := []models.TaxRule{}
+	if err := r.db.SelectContext(ctx, &rules, query, barber.ID, barber.Country, barber.State, barber.ShopID); err != nil {
+		return nil, fmt.Errorf("failed to find tax rules: %w", err)
+	}
+	return rules, nil
+}
+
+// FindByShopID retrieves a shop's tax rules
+func (r *TaxRepository) FindByShopID(ctx context.Context, shopID int) ([]models.TaxRule, error) {
+	rules := []models.TaxRule{}
+	err := r.db.SelectContext(ctx, &rules, `SELECT * FROM tax_rules WHERE shop_id = $1 ORDER BY name ASC`, shopID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find shop tax rules: %w", err)
+	}
+	return rules, nil
+}
+
+// FindByID retrieves a tax rule by its ID
+func (r *TaxRepository) FindByID(ctx context.Context, id int) (*models.TaxRule, error) {
+	var rule models.TaxRule
+	err := r.db.GetContext(ctx, &rule, `SELECT * FROM tax_rules WHERE id = $1`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTaxRuleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tax rule: %w", err)
+	}
+	return &rule, nil
+}
+
+// Create inserts a new tax rule
+func (r *TaxRepository) Create(ctx context.Context, rule *models.TaxRule) error {
+	query := `
+		INSERT INTO tax_rules (name, rate_percent, inclusive, barber_id, shop_id, country, state, is_active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at, updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		rule.Name, rule.RatePercent, rule.Inclusive, rule.BarberID, rule.ShopID, rule.Country, rule.State, rule.IsActive,
+	).Scan(&rule.ID, &rule.CreatedAt, &rule.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create tax rule: %w", err)
+	}
+	return nil
+}
+
+// Update saves a tax rule. Bookings already priced keep their tax lines.
+func (r *TaxRepository) Update(ctx context.Context, rule *models.TaxRule) error {
+	query := `
+		UPDATE tax_rules SET
+			name = $2, rate_percent = $3, inclusive = $4,
+			barber_id = $5, shop_id = $6, country = $7, state = $8, is_active = $9,
+			updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		rule.ID, rule.Name, rule.RatePercent, rule.Inclusive, rule.BarberID, rule.ShopID, rule.Country, rule.State, rule.IsActive,
+	).Scan(&rule.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrTaxRuleNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update tax rule: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a tax rule. Bookings already priced keep their tax lines.
+func (r *TaxRepository) Delete(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM tax_rules WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete tax rule: %w", err)
+	}
+	return CheckRowsAffected(result, ErrTaxRuleNotFound)
+}
+
+// ========================================================================
+// BOOKING TAX LINES
+// ========================================================================
+
+// FindBookingTaxLines retrieves the taxes charged on a booking
+func (r *TaxRepository) FindBookingTaxLines(ctx context.Context, bookingID int) ([]models.BookingTaxLine, error) {
+	lines := []models.BookingTaxLine{}
+	err := r.db.SelectContext(ctx, &lines, `SELECT * FROM booking_tax_lines WHERE booking_id = $1 ORDER BY id ASC`, bookingID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find booking tax lines: %w", err)
+	}
+	return lines, nil
+}
+
+// Report totals the taxes charged on completed bookings in [from, to) by
+// tax, optionally for one barber
+func (r *TaxRepository) Report(ctx context.Context, from, to time.Time, barberID *int) ([]models.TaxReportLine, error) {
+	query := `
+		SELECT t.name, t.rate_percent, t.inclusive,
+			COUNT(DISTINCT t.booking_id) AS bookings,
+			SUM(t.taxable_amount) AS taxable_amount,
+			SUM(t.amount) AS amount
+		FROM booking_tax_lines t
+		JOIN bookings bk ON bk.id = t.booking_id
+		WHERE bk.status = $1
+			AND bk.scheduled_start_time >= $2 AND bk.scheduled_start_time < $3
+			AND bk.is_test = $4
+			AND ($5::int IS NULL OR bk.barber_id = $5)
+		GROUP BY t.name, t.rate_percent, t.inclusive
+		ORDER BY amount DESC, t.name ASC
+	`
+
+	lines := []models.TaxReportLine{}
+	err := r.db.SelectContext(ctx, &lines, query,
+		config.BookingStatusCompleted, from, to, sandbox.Enabled(ctx), barberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build tax report: %w", err)
+	}
+	return lines, nil
+}
@@ -0,0 +1,85 @@
+// internal/repository/time_off_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// TIME OFF REPOSITORY - Periods barbers are away
+// ========================================================================
+
+// TimeOffRepository handles barber time off
+type TimeOffRepository struct {
+	db *sqlx.DB
+}
+
+// NewTimeOffRepository creates a new time off repository
+func NewTimeOffRepository(db *sqlx.DB) *TimeOffRepository {
+	return &TimeOffRepository{db: db}
+}
+
+// FindInRange retrieves a barber's time off overlapping [from, to), in
+// start order
+func (r *TimeOffRepository) FindInRange(ctx context.Context, barberID int, from, to time.Time) ([]models.TimeOff, error) {
+	query := `
+		SELECT * FROM time_off
+		WHERE barber_id = $1 AND start_at < $2 AND end_at > $3
+		ORDER BY start_at ASC
+	`
+
+	timeOff := []models.TimeOff{}
+	if err := r.db.SelectContext(ctx, &timeOff, query, barberID, to, from); err != nil {
+		return nil, fmt.Errorf("failed to find time off: %w", err)
+	}
+	return timeOff, nil
+}
+
+// FindUpcoming retrieves a barber's time off that has not ended by now, in
+// start order
+func (r *TimeOffRepository) FindUpcoming(ctx context.Context, barberID int, now time.Time) ([]models.TimeOff, error) {
+	query := `
+		SELECT * FROM time_off
+		WHERE barber_id = $1 AND end_at > $2
+		ORDER BY start_at ASC
+	`
+
+	timeOff := []models.TimeOff{}
+	if err := r.db.SelectContext(ctx, &timeOff, query, barberID, now); err != nil {
+		return nil, fmt.Errorf("failed to find upcoming time off: %w", err)
+	}
+	return timeOff, nil
+}
+
+// Create adds a time off block
+func (r *TimeOffRepository) Create(ctx context.Context, timeOff *models.TimeOff) error {
+	query := `
+		INSERT INTO time_off (barber_id, start_at, end_at, all_day, reason, created_by, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	timeOff.CreatedAt = time.Now()
+	err := r.db.QueryRowContext(ctx, query,
+		timeOff.BarberID, timeOff.StartAt, timeOff.EndAt, timeOff.AllDay,
+		timeOff.Reason, timeOff.CreatedBy, timeOff.CreatedAt,
+	).Scan(&timeOff.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create time off: %w", err)
+	}
+	return nil
+}
+
+// Delete removes one of a barber's time off blocks
+func (r *TimeOffRepository) Delete(ctx context.Context, barberID, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM time_off WHERE id = $1 AND barber_id = $2`, id, barberID)
+	if err != nil {
+		return fmt.Errorf("failed to delete time off: %w", err)
+	}
+	return CheckRowsAffected(result, ErrTimeOffNotFound)
+}
@@ -0,0 +1,86 @@
+// internal/repository/user_identity_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// UserIdentityRepository handles links between users and social sign-in accounts
+type UserIdentityRepository struct {
+	db *sqlx.DB
+}
+
+// NewUserIdentityRepository creates a new user identity repository
+func NewUserIdentityRepository(db *sqlx.DB) *UserIdentityRepository {
+	return &UserIdentityRepository{db: db}
+}
+
+const userIdentitySelect = `
+	SELECT id, user_id, provider, subject, email, created_at, last_login_at
+	FROM user_identities
+`
+
+// Create links a provider account to a user
+func (r *UserIdentityRepository) Create(ctx context.Context, identity *models.UserIdentity) error {
+	query := `
+		INSERT INTO user_identities (user_id, provider, subject, email)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+	err := r.db.QueryRowxContext(ctx, query, identity.UserID, identity.Provider, identity.Subject, identity.Email).
+		Scan(&identity.ID, &identity.CreatedAt)
+	if err != nil {
+		if IsDuplicateError(err) {
+			return ErrIdentityAlreadyLinked
+		}
+		return fmt.Errorf("failed to link sign-in account: %w", err)
+	}
+	return nil
+}
+
+// FindByProviderSubject returns the link for a provider account
+func (r *UserIdentityRepository) FindByProviderSubject(ctx context.Context, provider, subject string) (*models.UserIdentity, error) {
+	var identity models.UserIdentity
+	err := r.db.GetContext(ctx, &identity, userIdentitySelect+` WHERE provider = $1 AND subject = $2`, provider, subject)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrUserIdentityNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find sign-in account: %w", err)
+	}
+	return &identity, nil
+}
+
+// FindByUser returns the provider accounts linked to a user
+func (r *UserIdentityRepository) FindByUser(ctx context.Context, userID int) ([]models.UserIdentity, error) {
+	identities := []models.UserIdentity{}
+	err := r.db.SelectContext(ctx, &identities, userIdentitySelect+` WHERE user_id = $1 ORDER BY provider`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sign-in accounts: %w", err)
+	}
+	return identities, nil
+}
+
+// Delete unlinks a user's account at provider
+func (r *UserIdentityRepository) Delete(ctx context.Context, userID int, provider string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM user_identities WHERE user_id = $1 AND provider = $2`, userID, provider)
+	if err != nil {
+		return fmt.Errorf("failed to unlink sign-in account: %w", err)
+	}
+	return CheckRowsAffected(result, ErrUserIdentityNotFound)
+}
+
+// UpdateLastLogin records a sign-in through a linked account
+func (r *UserIdentityRepository) UpdateLastLogin(ctx context.Context, id int) error {
+	_, err := r.db.ExecContext(ctx, `UPDATE user_identities SET last_login_at = NOW() WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to update sign-in account: %w", err)
+	}
+	return nil
+}
@@ -153,6 +153,18 @@ func (r *UserRepository) UpdateLastLogin(ctx context.Context, userID int) error
 	return CheckRowsAffected(result, ErrUserNotFound)
 }
 
+// MarkEmailVerified records that the user proved they own their email address
+func (r *UserRepository) MarkEmailVerified(ctx context.Context, userID int) error {
+	query := `UPDATE users SET email_verified = true, updated_at = $1 WHERE id = $2 AND deleted_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), userID)
+	if err != nil {
+		return fmt.Errorf("failed to mark email verified: %w", err)
+	}
+
+	return CheckRowsAffected(result, ErrUserNotFound)
+}
+
 func (r *UserRepository) IncrementFailedLoginAttempts(ctx context.Context, userID int) error {
 	query := `
 		UPDATE users
@@ -234,6 +246,25 @@ func (r *UserRepository) IsAccountLocked(ctx context.Context, userID int) (bool,
 	return true, nil
 }
 
+// FindByPhone retrieves the one user whose phone number has these digits
+// (phone as normalized by models.NormalizeAddress). Numbers shared by
+// several accounts match none, since any of them could be the one meant.
+func (r *UserRepository) FindByPhone(ctx context.Context, phone string) (*models.User, error) {
+	query := `SELECT ` + USER_COLUMNS + ` FROM users ` + WHERE_ACTIVE + `
+		AND regexp_replace(phone, '[^0-9+]', '', 'g') = $1
+		ORDER BY id
+		LIMIT 2`
+
+	var users []models.User
+	if err := r.db.SelectContext(ctx, &users, query, phone); err != nil {
+		return nil, fmt.Errorf("failed to find user by phone: %w", err)
+	}
+	if len(users) != 1 {
+		return nil, ErrUserNotFound
+	}
+	return &users[0], nil
+}
+
 // EmailExists checks if email already exists in database
 func (r *UserRepository) EmailExists(ctx context.Context, email string) (bool, error) {
 	var exists bool
@@ -0,0 +1,261 @@
+// internal/repository/webhook_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// WEBHOOK REPOSITORY - Subscriptions and their delivery queue
+// ========================================================================
+
+// WebhookRepository handles webhook subscriptions and deliveries
+type WebhookRepository struct {
+	db *sqlx.DB
+}
+
+// NewWebhookRepository creates a new webhook repository
+func NewWebhookRepository(db *sqlx.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+// ========================================================================
+// SUBSCRIPTIONS
+// ========================================================================
+
+// CreateSubscription stores a new subscription
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	query := `
+		INSERT INTO webhook_subscriptions (barber_id, url, secret, events, description, is_active, created_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at
+	`
+	err := r.db.QueryRowxContext(ctx, query,
+		sub.BarberID, sub.URL, sub.Secret, sub.Events, sub.Description, sub.IsActive, sub.CreatedBy,
+	).Scan(&sub.ID, &sub.CreatedAt, &sub.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// FindSubscriptionByID retrieves a subscription
+func (r *WebhookRepository) FindSubscriptionByID(ctx context.Context, id int) (*models.WebhookSubscription, error) {
+	var sub models.WebhookSubscription
+	err := r.db.GetContext(ctx, &sub, `SELECT * FROM webhook_subscriptions WHERE id = $1`, id)
+	if err == sql.ErrNoRows {
+		return nil, ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook subscription: %w", err)
+	}
+	return &sub, nil
+}
+
+// FindSubscriptionsByBarber retrieves a barber's subscriptions, oldest first
+func (r *WebhookRepository) FindSubscriptionsByBarber(ctx context.Context, barberID int) ([]models.WebhookSubscription, error) {
+	subs := []models.WebhookSubscription{}
+	err := r.db.SelectContext(ctx, &subs, `SELECT * FROM webhook_subscriptions WHERE barber_id = $1 ORDER BY id`, barberID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook subscriptions: %w", err)
+	}
+	return subs, nil
+}
+
+// FindSubscribers retrieves a barber's active subscriptions to an event type
+func (r *WebhookRepository) FindSubscribers(ctx context.Context, barberID int, eventType string) ([]models.WebhookSubscription, error) {
+	query := `
+		SELECT * FROM webhook_subscriptions
+		WHERE barber_id = $1 AND is_active = true AND events @> jsonb_build_array($2::text)
+		ORDER BY id
+	`
+	var subs []models.WebhookSubscription
+	if err := r.db.SelectContext(ctx, &subs, query, barberID, eventType); err != nil {
+		return nil, fmt.Errorf("failed to find webhook subscribers: %w", err)
+	}
+	return subs, nil
+}
+
+// UpdateSubscription saves a subscription's URL, events, description and active flag
+func (r *WebhookRepository) UpdateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	query := `
+		UPDATE webhook_subscriptions
+		SET url = $2, events = $3, description = $4, is_active = $5, updated_at = NOW()
+		WHERE id = $1
+		RETURNING updated_at
+	`
+	err := r.db.QueryRowxContext(ctx, query, sub.ID, sub.URL, sub.Events, sub.Description, sub.IsActive).Scan(&sub.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return ErrWebhookNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update webhook subscription: %w", err)
+	}
+	return nil
+}
+
+// DeleteSubscription removes a subscription and its deliveries
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, id int) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook subscription: %w", err)
+	}
+	if rows, _ := result.RowsAffected(); rows == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+// ========================================================================
+// DELIVERIES
+// ========================================================================
+
+// CreateDeliveries queues an event for each subscription
+func (r *WebhookRepository) CreateDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO webhook_deliveries (subscription_id, event_id, event_type, payload, status)
+		VALUES ($1, $2, $3, $4, 'pending')
+	`
+	for _, d := range deliveries {
+		if _, err := tx.ExecContext(ctx, query, d.SubscriptionID, d.EventID, d.EventType, []byte(d.Payload)); err != nil {
+			return fmt.Errorf("failed to queue webhook delivery: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// FindDeliveriesBySubscription retrieves a page of a subscription's
+// deliveries, newest first, with the total count. An empty status matches
+// every status.
+func (r *WebhookRepository) FindDeliveriesBySubscription(ctx context.Context, subscriptionID int, status string, limit, offset int) ([]models.WebhookDelivery, int, error) {
+	var total int
+	countQuery := `
+		SELECT COUNT(*) FROM webhook_deliveries
+		WHERE subscription_id = $1 AND ($2 = '' OR status = $2)
+	`
+	if err := r.db.GetContext(ctx, &total, countQuery, subscriptionID, status); err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	query := `
+		SELECT * FROM webhook_deliveries
+		WHERE subscription_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`
+	deliveries := []models.WebhookDelivery{}
+	if err := r.db.SelectContext(ctx, &deliveries, query, subscriptionID, status, limit, offset); err != nil {
+		return nil, 0, fmt.Errorf("failed to find webhook deliveries: %w", err)
+	}
+	return deliveries, total, nil
+}
+
+// FindDeliveryByID retrieves a delivery by ID
+func (r *WebhookRepository) FindDeliveryByID(ctx context.Context, id int) (*models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	err := r.db.GetContext(ctx, &delivery, `SELECT * FROM webhook_deliveries WHERE id = $1`, id)
+	if err == sql.ErrNoRows {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find webhook delivery: %w", err)
+	}
+	return &delivery, nil
+}
+
+// Requeue makes a finished delivery pending again with a fresh set of
+// attempts. Deliveries still pending are left alone.
+func (r *WebhookRepository) Requeue(ctx context.Context, id int) (*models.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'pending', attempts = 0, next_attempt_at = NULL, delivered_at = NULL
+		WHERE id = $1 AND status != 'pending'
+		RETURNING *
+	`
+
+	var delivery models.WebhookDelivery
+	err := r.db.GetContext(ctx, &delivery, query, id)
+	if err == sql.ErrNoRows {
+		return nil, ErrWebhookDeliveryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to requeue webhook delivery: %w", err)
+	}
+	return &delivery, nil
+}
+
+// ClaimPending claims up to limit deliveries that are due, counting the
+// attempt and hiding them from other workers until the lease ends
+func (r *WebhookRepository) ClaimPending(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]models.WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries SET
+			attempts = attempts + 1,
+			next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = 'pending'
+			AND (next_attempt_at IS NULL OR next_attempt_at <= $1)
+			ORDER BY created_at ASC, id ASC
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *
+	`
+
+	var deliveries []models.WebhookDelivery
+	if err := r.db.SelectContext(ctx, &deliveries, query, now, now.Add(lease), limit); err != nil {
+		return nil, fmt.Errorf("failed to claim webhook deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// MarkDelivered records a successful delivery
+func (r *WebhookRepository) MarkDelivered(ctx context.Context, id, responseStatus int, at time.Time) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'delivered', response_status = $2, delivered_at = $3, next_attempt_at = NULL, last_error = NULL
+		WHERE id = $1
+	`
+	if _, err := r.db.ExecContext(ctx, query, id, responseStatus, at); err != nil {
+		return fmt.Errorf("failed to mark webhook delivered: %w", err)
+	}
+	return nil
+}
+
+// ScheduleRetry records a failed attempt and when to try again
+func (r *WebhookRepository) ScheduleRetry(ctx context.Context, id int, responseStatus *int, lastError string, nextAttemptAt time.Time) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET response_status = $2, last_error = $3, next_attempt_at = $4
+		WHERE id = $1 AND status = 'pending'
+	`
+	if _, err := r.db.ExecContext(ctx, query, id, responseStatus, lastError, nextAttemptAt); err != nil {
+		return fmt.Errorf("failed to schedule webhook retry: %w", err)
+	}
+	return nil
+}
+
+// MarkFailed gives up on a delivery
+func (r *WebhookRepository) MarkFailed(ctx context.Context, id int, responseStatus *int, lastError string) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'failed', response_status = $2, last_error = $3, next_attempt_at = NULL
+		WHERE id = $1
+	`
+	if _, err := r.db.ExecContext(ctx, query, id, responseStatus, lastError); err != nil {
+		return fmt.Errorf("failed to mark webhook failed: %w", err)
+	}
+	return nil
+}
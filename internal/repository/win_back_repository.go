@@ -0,0 +1,245 @@
+// internal/repository/win_back_repository.go
+package repository
+
+import (
+	"barber-booking-system/internal/models"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// WIN-BACK REPOSITORY - Lapsed-customer campaigns and their coupons
+// ========================================================================
+
+// WinBackRepository handles win-back campaign database operations
+type WinBackRepository struct {
+	db *sqlx.DB
+}
+
+// NewWinBackRepository creates a new win-back repository
+func NewWinBackRepository(db *sqlx.DB) *WinBackRepository {
+	return &WinBackRepository{db: db}
+}
+
+// WinBackCandidateFilters selects the customers a campaign messages
+type WinBackCandidateFilters struct {
+	LapsedBefore  time.Time // Last visit plus usual cadence must be before this
+	MinVisits     int
+	CooldownSince time.Time // Skip customers messaged after this
+	Limit         int
+}
+
+// FindCandidates returns active customers whose last completed visit plus
+// their average gap between visits falls before filters.LapsedBefore, who
+// have nothing booked and have not been messaged during the cooldown. The
+// most overdue come first.
+func (r *WinBackRepository) FindCandidates(ctx context.Context, filters WinBackCandidateFilters) ([]models.WinBackCandidate, error) {
+	query := `
+		WITH history AS (
+			SELECT customer_id,
+				COUNT(*) AS completed_visits,
+				MIN(scheduled_start_time) AS first_visit_at,
+				MAX(scheduled_start_time) AS last_visit_at
+			FROM bookings
+			WHERE customer_id IS NOT NULL AND status = 'completed' AND NOT is_test
+			GROUP BY customer_id
+			HAVING COUNT(*) >= $1
+		), lapsed AS (
+			SELECT *, last_visit_at + (last_visit_at - first_visit_at) / GREATEST(completed_visits - 1, 1) AS due_at
+			FROM history
+		)
+		SELECT l.customer_id, u.name AS customer_name, l.completed_visits, l.first_visit_at, l.last_visit_at,
+			last.service_name AS last_service_name, COALESCE(bu.name, '') AS last_barber_name
+		FROM lapsed l
+		JOIN users u ON u.id = l.customer_id AND u.status = 'active' AND u.deleted_at IS NULL
+		JOIN LATERAL (
+			SELECT b.service_name, b.barber_id FROM bookings b
+			WHERE b.customer_id = l.customer_id AND b.status = 'completed' AND NOT b.is_test
+			ORDER BY b.scheduled_start_time DESC
+			LIMIT 1
+		) last ON TRUE
+		LEFT JOIN barbers br ON br.id = last.barber_id
+		LEFT JOIN users bu ON bu.id = br.user_id
+		WHERE l.due_at < $2
+		AND NOT EXISTS (
+			SELECT 1 FROM bookings b
+			WHERE b.customer_id = l.customer_id
+			AND b.status IN ('pending', 'confirmed', 'in_progress')
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM win_back_messages m
+			WHERE m.customer_id = l.customer_id AND m.sent_at > $3
+		)
+		ORDER BY l.due_at ASC
+		LIMIT $4
+	`
+
+	candidates := []models.WinBackCandidate{}
+	err := r.db.SelectContext(ctx, &candidates, query,
+		filters.MinVisits, filters.LapsedBefore, filters.CooldownSince, filters.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find win-back candidates: %w", err)
+	}
+	return candidates, nil
+}
+
+// CreateCampaign inserts a campaign run
+func (r *WinBackRepository) CreateCampaign(ctx context.Context, campaign *models.WinBackCampaign) error {
+	query := `
+		INSERT INTO win_back_campaigns (inactive_weeks, coupon_percent_off, coupon_valid_days, started_at, triggered_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		campaign.InactiveWeeks, campaign.CouponPercentOff, campaign.CouponValidDays, campaign.StartedAt, campaign.TriggeredBy,
+	).Scan(&campaign.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create win-back campaign: %w", err)
+	}
+	return nil
+}
+
+// FinishCampaign records a run's totals
+func (r *WinBackRepository) FinishCampaign(ctx context.Context, campaign *models.WinBackCampaign) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE win_back_campaigns SET eligible_count = $1, sent_count = $2, finished_at = $3
+		WHERE id = $4
+	`, campaign.EligibleCount, campaign.SentCount, campaign.FinishedAt, campaign.ID)
+	if err != nil {
+		return fmt.Errorf("failed to finish win-back campaign: %w", err)
+	}
+	return CheckRowsAffected(result, ErrWinBackCampaignNotFound)
+}
+
+// CreateMessage records a customer messaged by a campaign
+func (r *WinBackRepository) CreateMessage(ctx context.Context, message *models.WinBackMessage) error {
+	query := `
+		INSERT INTO win_back_messages (
+			campaign_id, customer_id, last_visit_at, cadence_days,
+			coupon_code, coupon_percent_off, coupon_expires_at, sent_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id
+	`
+
+	err := r.db.QueryRowxContext(ctx, query,
+		message.CampaignID, message.CustomerID, message.LastVisitAt, message.CadenceDays,
+		message.CouponCode, message.CouponPercentOff, message.CouponExpiresAt, message.SentAt,
+	).Scan(&message.ID)
+	if err != nil {
+		return fmt.Errorf("failed to create win-back message: %w", err)
+	}
+	return nil
+}
+
+// SetMessageNotification links a message to the notification that carried it
+func (r *WinBackRepository) SetMessageNotification(ctx context.Context, messageID, notificationID int) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE win_back_messages SET notification_id = $1 WHERE id = $2
+	`, notificationID, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to link win-back notification: %w", err)
+	}
+	return nil
+}
+
+// DeleteMessage removes a message whose notification could not be created
+func (r *WinBackRepository) DeleteMessage(ctx context.Context, messageID int) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM win_back_messages WHERE id = $1`, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to delete win-back message: %w", err)
+	}
+	return nil
+}
+
+// FindByCouponCode retrieves the message that issued a coupon
+func (r *WinBackRepository) FindByCouponCode(ctx context.Context, code string) (*models.WinBackMessage, error) {
+	var message models.WinBackMessage
+	err := r.db.GetContext(ctx, &message, `SELECT * FROM win_back_messages WHERE coupon_code = $1`, code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrCouponNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find coupon: %w", err)
+	}
+	return &message, nil
+}
+
+// RedeemCoupon marks a coupon used by a booking. It fails with
+// ErrCouponRedeemed when another booking got there first.
+func (r *WinBackRepository) RedeemCoupon(ctx context.Context, code string, bookingID int, at time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE win_back_messages SET redeemed_booking_id = $1, redeemed_at = $2
+		WHERE coupon_code = $3 AND redeemed_at IS NULL
+	`, bookingID, at, code)
+	if err != nil {
+		return fmt.Errorf("failed to redeem coupon: %w", err)
+	}
+	return CheckRowsAffected(result, ErrCouponRedeemed)
+}
+
+// campaignStatsQuery aggregates campaign performance. A message converts when
+// the customer makes a booking that is not cancelled within the attribution
+// window ($1 days) after it was sent; only their first such booking counts.
+const campaignStatsQuery = `
+	SELECT c.*,
+		COUNT(n.read_at) AS opened,
+		COUNT(conv.id) AS converted,
+		COUNT(m.redeemed_at) AS coupons_redeemed,
+		COALESCE(SUM(conv.total_price), 0) AS revenue
+	FROM win_back_campaigns c
+	LEFT JOIN win_back_messages m ON m.campaign_id = c.id
+	LEFT JOIN notifications n ON n.id = m.notification_id
+	LEFT JOIN LATERAL (
+		SELECT b.id, b.total_price FROM bookings b
+		WHERE b.customer_id = m.customer_id
+		AND NOT b.is_test
+		AND b.created_at >= m.sent_at
+		AND b.created_at < m.sent_at + make_interval(days => $1)
+		AND b.status NOT IN ('cancelled', 'cancelled_by_customer', 'cancelled_by_barber')
+		ORDER BY b.created_at ASC
+		LIMIT 1
+	) conv ON TRUE
+`
+
+// ListCampaignStats returns campaigns, newest first, with their performance
+func (r *WinBackRepository) ListCampaignStats(ctx context.Context, attributionDays, limit, offset int) ([]models.WinBackCampaignStats, error) {
+	query := campaignStatsQuery + `
+		GROUP BY c.id
+		ORDER BY c.started_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	stats := []models.WinBackCampaignStats{}
+	if err := r.db.SelectContext(ctx, &stats, query, attributionDays, limit, offset); err != nil {
+		return nil, fmt.Errorf("failed to list win-back campaigns: %w", err)
+	}
+	for i := range stats {
+		stats[i].Finalize()
+	}
+	return stats, nil
+}
+
+// FindCampaignStats returns one campaign with its performance
+func (r *WinBackRepository) FindCampaignStats(ctx context.Context, id, attributionDays int) (*models.WinBackCampaignStats, error) {
+	query := campaignStatsQuery + `
+		WHERE c.id = $2
+		GROUP BY c.id
+	`
+
+	var stats models.WinBackCampaignStats
+	err := r.db.GetContext(ctx, &stats, query, attributionDays, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrWinBackCampaignNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find win-back campaign: %w", err)
+	}
+	stats.Finalize()
+	return &stats, nil
+}
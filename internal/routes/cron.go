@@ -0,0 +1,112 @@
+// internal/routes/cron.go
+package routes
+
+import (
+	"context"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/cron"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/services"
+)
+
+// registerCronJobs adds the application's cron-scheduled jobs to s. Jobs
+// with an invalid schedule are logged and left out.
+func registerCronJobs(s *cron.Scheduler, cfg config.CronConfig, notificationService *services.NotificationService, statsService *services.StatsService, pendingExpiryService *services.PendingExpiryService, outboxService *services.OutboxService, bookingArchiveService *services.BookingArchiveService, partitionService *services.PartitionService, shopOffboardingService *services.ShopOffboardingService) {
+	reminderHours := cfg.ReminderHoursBefore
+	if reminderHours <= 0 {
+		reminderHours = config.DefaultReminderHoursBefore
+	}
+	retention := cfg.NotificationRetention
+	if retention <= 0 {
+		retention = config.DefaultNotificationRetention
+	}
+	outboxRetention := cfg.OutboxRetention
+	if outboxRetention <= 0 {
+		outboxRetention = config.DefaultOutboxRetention
+	}
+
+	jobs := []cron.Job{
+		{
+			Name:     "booking_reminders",
+			Schedule: cfg.Reminders,
+			Run: func(ctx context.Context) error {
+				return notificationService.ScheduleBookingReminders(ctx, reminderHours)
+			},
+		},
+		{
+			Name:     "notification_cleanup",
+			Schedule: cfg.NotificationCleanup,
+			Run: func(ctx context.Context) error {
+				old, err := notificationService.CleanupOldNotifications(ctx, retention)
+				if err != nil {
+					return err
+				}
+				expired, err := notificationService.CleanupExpiredNotifications(ctx)
+				if err != nil {
+					return err
+				}
+				undelivered, err := notificationService.FailUndeliveredNotifications(ctx)
+				if err != nil {
+					return err
+				}
+				logger.FromContext(ctx).Info("Cleaned up notifications").
+					Int("old", old).
+					Int("expired", expired).
+					Int("undelivered", undelivered).
+					Send()
+				return nil
+			},
+		},
+		{
+			Name:     "stats_aggregation",
+			Schedule: cfg.StatsAggregation,
+			Run:      statsService.RunScheduled,
+		},
+		{
+			Name:     "booking_expiry",
+			Schedule: cfg.BookingExpiry,
+			Run:      pendingExpiryService.RunScheduled,
+		},
+		{
+			Name:     "outbox_cleanup",
+			Schedule: cfg.OutboxCleanup,
+			Run: func(ctx context.Context) error {
+				deleted, err := outboxService.Cleanup(ctx, outboxRetention)
+				if err != nil {
+					return err
+				}
+				logger.FromContext(ctx).Info("Cleaned up outbox events").
+					Int("deleted", deleted).
+					Send()
+				return nil
+			},
+		},
+		{
+			Name:     "booking_archive",
+			Schedule: cfg.BookingArchive,
+			Run: func(ctx context.Context) error {
+				_, err := bookingArchiveService.Archive(ctx, cfg.BookingArchiveYears)
+				return err
+			},
+		},
+		{
+			Name:     "partitions",
+			Schedule: cfg.Partitions,
+			Run: func(ctx context.Context) error {
+				return partitionService.MaintainNotifications(ctx, cfg.NotificationMonths)
+			},
+		},
+		{
+			Name:     "shop_offboarding",
+			Schedule: cfg.ShopOffboarding,
+			Run:      shopOffboardingService.RunScheduled,
+		},
+	}
+
+	for _, job := range jobs {
+		if err := s.Add(job); err != nil {
+			logger.Global().Error(err).Msg("Invalid cron job schedule")
+		}
+	}
+}
@@ -0,0 +1,144 @@
+// internal/routes/jobs.go
+package routes
+
+import (
+	"context"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/worker"
+)
+
+// registerJobs adds the application's background jobs to w
+func registerJobs(w *worker.Worker, cfg config.WorkerConfig, notificationService *services.NotificationService, winBackService *services.WinBackService, userService *services.UserService, apiUsageService *services.APIUsageService, confirmationRequestService *services.ConfirmationRequestService, reviewRequestService *services.ReviewRequestService, autoNoShowService *services.AutoNoShowService, webhookService *services.WebhookService, statusService *services.StatusService, outboxService *services.OutboxService, supportService *services.SupportService, backfillService *services.BackfillService) {
+	notificationService.SetDeliveryPolicy(services.DeliveryPolicy{
+		MaxAttempts: cfg.NotificationMaxAttempts,
+		RetryBase:   cfg.NotificationRetryBase,
+		RetryMax:    cfg.NotificationRetryMax,
+		Lease:       cfg.NotificationLease,
+	})
+
+	batchSize := cfg.NotificationBatchSize
+	if batchSize <= 0 {
+		batchSize = config.DefaultNotificationBatchSize
+	}
+	w.Add(worker.Job{
+		Name:     "notification_delivery",
+		Interval: cfg.NotificationPollInterval,
+		Run: func(ctx context.Context) error {
+			// Keep draining while full batches come back
+			for {
+				claimed, err := notificationService.ProcessPendingNotifications(ctx, batchSize)
+				if err != nil || claimed < batchSize || ctx.Err() != nil {
+					return err
+				}
+			}
+		},
+	})
+
+	webhookService.SetDeliveryPolicy(services.DeliveryPolicy{
+		MaxAttempts: cfg.WebhookMaxAttempts,
+		RetryBase:   cfg.WebhookRetryBase,
+		RetryMax:    cfg.WebhookRetryMax,
+	})
+
+	webhookBatchSize := cfg.WebhookBatchSize
+	if webhookBatchSize <= 0 {
+		webhookBatchSize = config.DefaultWebhookBatchSize
+	}
+	w.Add(worker.Job{
+		Name:     "webhook_delivery",
+		Interval: cfg.WebhookPollInterval,
+		Run: func(ctx context.Context) error {
+			for {
+				claimed, err := webhookService.ProcessPendingDeliveries(ctx, webhookBatchSize)
+				if err != nil || claimed < webhookBatchSize || ctx.Err() != nil {
+					return err
+				}
+			}
+		},
+	})
+
+	outboxService.SetDeliveryPolicy(services.DeliveryPolicy{
+		MaxAttempts: cfg.OutboxMaxAttempts,
+		RetryBase:   cfg.OutboxRetryBase,
+		RetryMax:    cfg.OutboxRetryMax,
+	})
+
+	outboxBatchSize := cfg.OutboxBatchSize
+	if outboxBatchSize <= 0 {
+		outboxBatchSize = config.DefaultOutboxBatchSize
+	}
+	dispatchOutbox := func(ctx context.Context) error {
+		for {
+			claimed, err := outboxService.ProcessPendingEvents(ctx, outboxBatchSize)
+			if err != nil || claimed < outboxBatchSize || ctx.Err() != nil {
+				return err
+			}
+		}
+	}
+	w.Add(worker.Job{
+		Name:     "outbox_dispatch",
+		Interval: cfg.OutboxPollInterval,
+		Run:      dispatchOutbox,
+	})
+	// Dispatch the events recorded by the last requests before exiting
+	w.AddFlush(worker.Job{
+		Name: "outbox_dispatch",
+		Run:  dispatchOutbox,
+	})
+
+	w.Add(worker.Job{
+		Name:     "win_back",
+		Interval: cfg.WinBackInterval,
+		Run:      winBackService.RunScheduled,
+	})
+
+	w.Add(worker.Job{
+		Name:     "confirmation_requests",
+		Interval: cfg.ConfirmationRequestInterval,
+		Run:      confirmationRequestService.RunScheduled,
+	})
+
+	w.Add(worker.Job{
+		Name:     "review_requests",
+		Interval: cfg.ReviewRequestInterval,
+		Run:      reviewRequestService.RunScheduled,
+	})
+
+	w.Add(worker.Job{
+		Name:     "auto_no_show",
+		Interval: cfg.AutoNoShowInterval,
+		Run:      autoNoShowService.RunScheduled,
+	})
+
+	w.Add(worker.Job{
+		Name:     "status_checks",
+		Interval: cfg.StatusCheckInterval,
+		Run:      statusService.RunChecks,
+	})
+
+	w.Add(worker.Job{
+		Name:     "support_sla",
+		Interval: cfg.SupportSLAInterval,
+		Run:      supportService.RunSLAChecks,
+	})
+
+	w.Add(worker.Job{
+		Name:     "migration_backfill",
+		Interval: cfg.BackfillInterval,
+		Run:      backfillService.RunPending,
+	})
+
+	w.Add(worker.Job{
+		Name:     "refresh_token_cleanup",
+		Interval: cfg.RefreshTokenCleanupInterval,
+		Run:      userService.CleanupRefreshTokens,
+	})
+
+	w.Add(worker.Job{
+		Name:     "api_usage_flush",
+		Interval: cfg.APIUsageFlushInterval,
+		Run:      apiUsageService.Flush,
+	})
+}
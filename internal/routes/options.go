@@ -0,0 +1,500 @@
+// internal/routes/options.go
+package routes
+
+import (
+	"time"
+
+	"barber-booking-system/internal/breaker"
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/cron"
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/oauth"
+	"barber-booking-system/internal/payments"
+	"barber-booking-system/internal/push"
+	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/sms"
+	"barber-booking-system/internal/storage"
+	"barber-booking-system/internal/worker"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ========================================================================
+// SETUP OPTIONS - Optional route configuration (functional options)
+// ========================================================================
+
+// setupOptions holds optional settings for Setup
+type setupOptions struct {
+	adminMiddleware []gin.HandlerFunc
+
+	// Body limits (0 = rely on the router-wide limit)
+	jsonBodyLimit     int64
+	uploadBodyLimit   int64
+	maxMultipartParts int
+
+	// Time source for services (nil = system clock)
+	clock clock.Clock
+
+	// Booking status hooks (nil bindings = config.DefaultBookingStatusHooks)
+	statusHooks       map[string][]string
+	statusHookActions map[string]services.StatusHook
+
+	// Payment provider for paid checkout and refunds (nil = checkout disabled)
+	paymentGateway payments.Gateway
+
+	// Where uploaded files such as review photos are kept (nil = local disk
+	// under ./uploads, served at /uploads)
+	uploadStorage storage.Storage
+
+	// Refund windows for cancelled prepaid bookings (nil = config defaults)
+	cancellationPolicy *config.CancellationPolicyConfig
+
+	// Bounds on price changes when bookings are completed (nil = config defaults)
+	priceAdjustment *config.PriceAdjustmentConfig
+
+	// Barber assignment for bookings made with a shop (zero values = config defaults)
+	assignment config.AssignmentConfig
+
+	// SMS provider for text confirmations and reminders (nil = no sms channel)
+	smsSender          sms.Sender
+	smsCallbackBaseURL string
+
+	// Push providers by device platform (nil = devices register but nothing is sent)
+	pushDispatcher *push.Dispatcher
+
+	// NPS survey cadence (nil = config defaults)
+	nps *config.NPSConfig
+
+	// Review request delay and link lifetime (nil = config defaults)
+	reviewRequests *config.ReviewRequestConfig
+
+	// Win-back campaign settings (zero values = config defaults, no coupon)
+	winBack config.WinBackConfig
+
+	// No-show risk confirmation requests (zero values = config defaults)
+	noShowRisk config.NoShowRiskConfig
+
+	// Expiry of bookings the barber never confirmed (zero values = config defaults)
+	pendingExpiry config.PendingExpiryConfig
+
+	// Deletion of shops leaving the platform (zero values = config defaults)
+	offboarding config.OffboardingConfig
+
+	// Automatic no-show marking (zero values = config defaults)
+	autoNoShow config.AutoNoShowConfig
+
+	// Invitations sent to imported clients (zero values = config defaults)
+	clientImport config.ClientImportConfig
+
+	// Outbound webhook deliveries (zero values = config defaults)
+	webhooks config.WebhookConfig
+
+	// Status page history and the provider breakers it reports
+	status         config.StatusConfig
+	statusBreakers map[string][]*breaker.Breaker
+
+	// Cron-scheduled jobs (nil = not registered)
+	scheduler  *cron.Scheduler
+	cronConfig config.CronConfig
+
+	// Reminder confirm/cancel links (empty secret = signed with the JWT secret)
+	actionLinks config.ActionLinkConfig
+
+	// Featured placement pricing and inventory (zero values = config defaults)
+	featured config.FeaturedConfig
+
+	// Barber listing ranking weights (zero values = config defaults)
+	ranking config.RankingConfig
+
+	// List page sizes (zero values = config defaults)
+	pagination config.PaginationConfig
+
+	// Timezone and language of notification times for users without
+	// preferences (zero values = UTC, English)
+	localization config.LocalizationConfig
+
+	// Overnight hold of non-urgent notifications, in each recipient's
+	// timezone (not set = no quiet hours)
+	quietHours config.QuietHoursConfig
+
+	// Spam and profanity screening of new reviews (not set = reviews wait
+	// in the pending queue unscreened)
+	reviewScreening config.ReviewScreeningConfig
+
+	// Social sign-in providers (none = social sign-in disabled)
+	oauthVerifiers []oauth.Verifier
+
+	// Per-consumer API usage tracking and quotas, shared with the rate
+	// limiters (nil = Setup creates its own)
+	apiUsage *services.APIUsageService
+
+	// Refuse X-Sandbox requests instead of running them in sandbox mode
+	sandboxDisabled bool
+
+	// Refresh token lifetime (0 = config.DefaultRefreshTokenExpiration)
+	refreshTokenExpiration time.Duration
+
+	// Background jobs are registered on this worker (nil = no background jobs)
+	worker       *worker.Worker
+	workerConfig config.WorkerConfig
+}
+
+// Option configures optional behaviour of Setup
+type Option func(*setupOptions)
+
+// WithAdminMiddleware adds middleware that runs before authentication on /api/v1/admin routes
+// (e.g. IP allowlisting). Nil handlers are ignored.
+func WithAdminMiddleware(handlers ...gin.HandlerFunc) Option {
+	return func(o *setupOptions) {
+		for _, h := range handlers {
+			if h != nil {
+				o.adminMiddleware = append(o.adminMiddleware, h)
+			}
+		}
+	}
+}
+
+// WithBodyLimits sets per-route-group request body limits: jsonMax for JSON API
+// groups, uploadMax and maxParts for upload endpoints.
+func WithBodyLimits(jsonMax, uploadMax int64, maxParts int) Option {
+	return func(o *setupOptions) {
+		o.jsonBodyLimit = jsonMax
+		o.uploadBodyLimit = uploadMax
+		o.maxMultipartParts = maxParts
+	}
+}
+
+// WithClock injects the time source used by time-dependent services (e.g. a fake clock in tests)
+func WithClock(c clock.Clock) Option {
+	return func(o *setupOptions) {
+		o.clock = c
+	}
+}
+
+// WithStatusHooks sets which actions run when a booking enters each status
+// (status -> action names, see config.BookingHooksConfig)
+func WithStatusHooks(bindings map[string][]string) Option {
+	return func(o *setupOptions) {
+		o.statusHooks = bindings
+	}
+}
+
+// WithStatusHookAction provides an action that status hooks can bind by name,
+// e.g. a calendar integration for config.StatusHookCalendarSync
+func WithStatusHookAction(name string, hook services.StatusHook) Option {
+	return func(o *setupOptions) {
+		if o.statusHookActions == nil {
+			o.statusHookActions = make(map[string]services.StatusHook)
+		}
+		o.statusHookActions[name] = hook
+	}
+}
+
+// WithPaymentGateway sets the payment provider used by booking checkout and
+// cancellation refunds. Nil leaves checkout disabled (503).
+func WithPaymentGateway(gateway payments.Gateway) Option {
+	return func(o *setupOptions) {
+		o.paymentGateway = gateway
+	}
+}
+
+// WithUploadStorage sets where uploaded files such as review photos are
+// kept. Local storage with a path public URL is also served by the router.
+func WithUploadStorage(store storage.Storage) Option {
+	return func(o *setupOptions) {
+		o.uploadStorage = store
+	}
+}
+
+// WithCancellationPolicy sets how much of a prepaid booking is refunded on cancellation
+func WithCancellationPolicy(policy config.CancellationPolicyConfig) Option {
+	return func(o *setupOptions) {
+		o.cancellationPolicy = &policy
+	}
+}
+
+// WithPriceAdjustment sets how far barbers may change a booking's price on completion
+func WithPriceAdjustment(policy config.PriceAdjustmentConfig) Option {
+	return func(o *setupOptions) {
+		o.priceAdjustment = &policy
+	}
+}
+
+// WithAssignment sets how a barber is picked for bookings made with a shop
+// instead of a particular barber
+func WithAssignment(cfg config.AssignmentConfig) Option {
+	return func(o *setupOptions) {
+		o.assignment = cfg
+	}
+}
+
+// WithSMSSender enables SMS confirmations and reminders for opted-in customers.
+// callbackBaseURL is the API's public URL used for delivery status callbacks;
+// empty disables them. A nil sender leaves the sms channel off.
+func WithSMSSender(sender sms.Sender, callbackBaseURL string) Option {
+	return func(o *setupOptions) {
+		o.smsSender = sender
+		o.smsCallbackBaseURL = callbackBaseURL
+	}
+}
+
+// WithPushDispatcher enables push delivery to registered devices
+func WithPushDispatcher(dispatcher *push.Dispatcher) Option {
+	return func(o *setupOptions) {
+		o.pushDispatcher = dispatcher
+	}
+}
+
+// WithNPS sets how often customers are sent NPS surveys
+func WithNPS(cfg config.NPSConfig) Option {
+	return func(o *setupOptions) {
+		o.nps = &cfg
+	}
+}
+
+// WithReviewRequests sets how long after completion customers are asked
+// for a review and how long the review link stays valid
+func WithReviewRequests(cfg config.ReviewRequestConfig) Option {
+	return func(o *setupOptions) {
+		o.reviewRequests = &cfg
+	}
+}
+
+// WithWinBack sets the win-back campaign settings
+func WithWinBack(cfg config.WinBackConfig) Option {
+	return func(o *setupOptions) {
+		o.winBack = cfg
+	}
+}
+
+// WithNoShowRisk sets when customers are asked to confirm their appointment
+func WithNoShowRisk(cfg config.NoShowRiskConfig) Option {
+	return func(o *setupOptions) {
+		o.noShowRisk = cfg
+	}
+}
+
+// WithPendingExpiry sets how long bookings may stay pending before they are
+// cancelled
+func WithPendingExpiry(cfg config.PendingExpiryConfig) Option {
+	return func(o *setupOptions) {
+		o.pendingExpiry = cfg
+	}
+}
+
+// WithOffboarding sets how long a leaving shop's data is kept before it is
+// deleted
+func WithOffboarding(cfg config.OffboardingConfig) Option {
+	return func(o *setupOptions) {
+		o.offboarding = cfg
+	}
+}
+
+// WithAutoNoShow sets the default grace period after which confirmed
+// bookings nobody started are marked no-show
+func WithAutoNoShow(cfg config.AutoNoShowConfig) Option {
+	return func(o *setupOptions) {
+		o.autoNoShow = cfg
+	}
+}
+
+// WithClientImport sets how long invitations to claim imported accounts
+// stay valid and the page they link to
+func WithClientImport(cfg config.ClientImportConfig) Option {
+	return func(o *setupOptions) {
+		o.clientImport = cfg
+	}
+}
+
+// WithWebhooks sets the request timeout for outbound webhook deliveries
+// and whether plain-HTTP and internal endpoints are allowed
+func WithWebhooks(cfg config.WebhookConfig) Option {
+	return func(o *setupOptions) {
+		o.webhooks = cfg
+	}
+}
+
+// WithStatus sets how much history the status page shows
+func WithStatus(cfg config.StatusConfig) Option {
+	return func(o *setupOptions) {
+		o.status = cfg
+	}
+}
+
+// WithCircuitBreaker reports b's state on the status page as part of
+// component's health. Nil breakers are ignored.
+func WithCircuitBreaker(component string, b *breaker.Breaker) Option {
+	return func(o *setupOptions) {
+		if b == nil {
+			return
+		}
+		if o.statusBreakers == nil {
+			o.statusBreakers = make(map[string][]*breaker.Breaker)
+		}
+		o.statusBreakers[component] = append(o.statusBreakers[component], b)
+	}
+}
+
+// WithScheduler registers the cron-scheduled jobs (reminders, cleanup,
+// stats aggregation, booking expiry) on s using the schedules in cfg. The
+// caller runs s.
+func WithScheduler(s *cron.Scheduler, cfg config.CronConfig) Option {
+	return func(o *setupOptions) {
+		o.scheduler = s
+		o.cronConfig = cfg
+	}
+}
+
+// WithActionLinks sets how the confirm/cancel links in reminders are signed,
+// addressed and expired
+func WithActionLinks(cfg config.ActionLinkConfig) Option {
+	return func(o *setupOptions) {
+		o.actionLinks = cfg
+	}
+}
+
+// WithFeatured sets featured placement pricing and inventory. Purchases are
+// charged through the WithPaymentGateway provider.
+func WithFeatured(cfg config.FeaturedConfig) Option {
+	return func(o *setupOptions) {
+		o.featured = cfg
+	}
+}
+
+// WithRanking sets the weights used to rank barber listings
+func WithRanking(cfg config.RankingConfig) Option {
+	return func(o *setupOptions) {
+		o.ranking = cfg
+	}
+}
+
+// WithPagination sets the page sizes list endpoints default to and accept
+func WithPagination(cfg config.PaginationConfig) Option {
+	return func(o *setupOptions) {
+		o.pagination = cfg
+	}
+}
+
+// WithLocalization sets how notification times are written for users who
+// have not chosen a timezone or language
+func WithLocalization(cfg config.LocalizationConfig) Option {
+	return func(o *setupOptions) {
+		o.localization = cfg
+	}
+}
+
+// WithQuietHours sets the local times non-urgent notifications are held
+// back between
+func WithQuietHours(cfg config.QuietHoursConfig) Option {
+	return func(o *setupOptions) {
+		o.quietHours = cfg
+	}
+}
+
+// WithReviewScreening sets how new reviews are screened for spam and
+// profanity before they are published
+func WithReviewScreening(cfg config.ReviewScreeningConfig) Option {
+	return func(o *setupOptions) {
+		o.reviewScreening = cfg
+	}
+}
+
+// WithSandbox enables or disables sandbox mode (enabled by default)
+func WithSandbox(enabled bool) Option {
+	return func(o *setupOptions) {
+		o.sandboxDisabled = !enabled
+	}
+}
+
+// WithOAuthProviders enables sign-in with the given providers (Google,
+// Apple). Nil verifiers are ignored.
+func WithOAuthProviders(verifiers ...oauth.Verifier) Option {
+	return func(o *setupOptions) {
+		for _, v := range verifiers {
+			if v != nil {
+				o.oauthVerifiers = append(o.oauthVerifiers, v)
+			}
+		}
+	}
+}
+
+// WithAPIUsage records API usage with the given service. Pass the service
+// given to the rate limiters as their quota lookup so quota changes made
+// through the admin API apply to them.
+func WithAPIUsage(usageService *services.APIUsageService) Option {
+	return func(o *setupOptions) {
+		o.apiUsage = usageService
+	}
+}
+
+// WithRefreshTokenExpiration sets how long refresh tokens stay valid
+func WithRefreshTokenExpiration(d time.Duration) Option {
+	return func(o *setupOptions) {
+		o.refreshTokenExpiration = d
+	}
+}
+
+// WithWorker registers the background jobs (notification delivery, scheduled
+// win-back campaigns, no-show risk confirmation requests, refresh token
+// cleanup, API usage flushing) on w. The caller is responsible for running it.
+func WithWorker(w *worker.Worker, cfg config.WorkerConfig) Option {
+	return func(o *setupOptions) {
+		o.worker = w
+		o.workerConfig = cfg
+	}
+}
+
+// npsConfig returns the NPS settings, falling back to the defaults
+func (o *setupOptions) npsConfig() config.NPSConfig {
+	if o.nps != nil {
+		return *o.nps
+	}
+	return config.NPSConfig{
+		EveryNthBooking:    config.DefaultNPSSurveyEveryN,
+		ResponseWindowDays: config.DefaultNPSResponseWindowDays,
+	}
+}
+
+// reviewRequestConfig returns the review request settings, falling back to
+// the defaults
+func (o *setupOptions) reviewRequestConfig() config.ReviewRequestConfig {
+	if o.reviewRequests != nil {
+		return *o.reviewRequests
+	}
+	return config.ReviewRequestConfig{
+		Delay:              config.DefaultReviewRequestDelay,
+		ResponseWindowDays: config.DefaultReviewRequestWindowDays,
+	}
+}
+
+// jsonMiddleware returns the body limit chain for JSON API route groups
+func (o *setupOptions) jsonMiddleware() []gin.HandlerFunc {
+	if o.jsonBodyLimit <= 0 {
+		return nil
+	}
+	return []gin.HandlerFunc{middleware.DefaultRequestBodyLimit(o.jsonBodyLimit)}
+}
+
+// uploadMiddleware returns the body and part-count limit chain for upload route groups.
+// Upload routes must live in their own group (gin allows several groups with the
+// same prefix) since nested body limits can only tighten, never relax.
+func (o *setupOptions) uploadMiddleware() []gin.HandlerFunc {
+	var handlers []gin.HandlerFunc
+	if o.uploadBodyLimit > 0 {
+		handlers = append(handlers, middleware.DefaultRequestBodyLimit(o.uploadBodyLimit))
+	}
+	if o.maxMultipartParts > 0 {
+		handlers = append(handlers, middleware.LimitMultipartParts(o.maxMultipartParts))
+	}
+	return handlers
+}
+
+// applyOptions builds setupOptions from the given options
+func applyOptions(opts []Option) *setupOptions {
+	o := &setupOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
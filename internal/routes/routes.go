@@ -3,10 +3,18 @@ package routes
 
 import (
 	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/config"
 	"barber-booking-system/internal/handlers"
+	"barber-booking-system/internal/logger"
 	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/payments"
+	"barber-booking-system/internal/ranking"
 	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/screening"
 	"barber-booking-system/internal/services"
+	"barber-booking-system/internal/storage"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -14,7 +22,9 @@ import (
 )
 
 // Setup configures all application routes
-func Setup(router *gin.Engine, db *sqlx.DB, jwtSecret string, jwtExpiration time.Duration, cacheService *cache.CacheService) {
+func Setup(router *gin.Engine, db *sqlx.DB, jwtSecret string, jwtExpiration time.Duration, cacheService *cache.CacheService, opts ...Option) {
+	options := applyOptions(opts)
+
 	// ========================================================================
 	// INITIALIZE REPOSITORIES
 	// ========================================================================
@@ -22,8 +32,53 @@ func Setup(router *gin.Engine, db *sqlx.DB, jwtSecret string, jwtExpiration time
 	barberRepo := repository.NewBarberRepository(db)
 	serviceRepo := repository.NewServiceRepository(db)
 	bookingRepo := repository.NewBookingRepository(db)
+	bookingSeriesRepo := repository.NewBookingSeriesRepository(db)
+	scheduleRepo := repository.NewBarberScheduleRepository(db)
 	reviewRepo := repository.NewReviewRepository(db)
+	reviewImageRepo := repository.NewReviewImageRepository(db)
+	reviewReportRepo := repository.NewReviewReportRepository(db)
+	portfolioRepo := repository.NewPortfolioRepository(db)
+	relationLoader := repository.NewRelationLoader(db)
 	notificationRepo := repository.NewNotificationRepository(db)
+	notificationPreferenceRepo := repository.NewNotificationPreferenceRepository(db)
+	auditLogRepo := repository.NewAuditLogRepository(db)
+	timelineRepo := repository.NewCustomerTimelineRepository(db)
+	npsRepo := repository.NewNPSRepository(db)
+	reviewRequestRepo := repository.NewReviewRequestRepository(db)
+	deviceTokenRepo := repository.NewDeviceTokenRepository(db)
+	winBackRepo := repository.NewWinBackRepository(db)
+	featuredRepo := repository.NewFeaturedRepository(db)
+	refreshTokenRepo := repository.NewRefreshTokenRepository(db)
+	experimentRepo := repository.NewExperimentRepository(db)
+	userIdentityRepo := repository.NewUserIdentityRepository(db)
+	roleRepo := repository.NewRoleRepository(db)
+	suppressionRepo := repository.NewSuppressionRepository(db)
+	autoReplyRepo := repository.NewAutoReplyRepository(db)
+	calendarFeedRepo := repository.NewCalendarFeedRepository(db)
+	locationRepo := repository.NewLocationRepository(db)
+	inventoryRepo := repository.NewInventoryRepository(db)
+	commissionRepo := repository.NewCommissionRepository(db)
+	taxRepo := repository.NewTaxRepository(db)
+	confirmationRequestRepo := repository.NewConfirmationRequestRepository(db)
+	addOnRepo := repository.NewAddOnRepository(db)
+	actionLinkRepo := repository.NewBookingActionLinkRepository(db)
+	barberClientRepo := repository.NewBarberClientRepository(db)
+	customerInvitationRepo := repository.NewCustomerInvitationRepository(db)
+	webhookRepo := repository.NewWebhookRepository(db)
+	statusRepo := repository.NewStatusRepository(db)
+	outboxRepo := repository.NewOutboxRepository(db)
+	bookingFormRepo := repository.NewBookingFormRepository(db)
+	consultationRequestRepo := repository.NewConsultationRequestRepository(db)
+	openSlotRepo := repository.NewOpenSlotRepository(db)
+	supportTicketRepo := repository.NewSupportTicketRepository(db)
+	financialEventRepo := repository.NewFinancialEventRepository(db)
+	bookingArchiveRepo := repository.NewBookingArchiveRepository(db)
+	partitionRepo := repository.NewPartitionRepository(db)
+	timeOffRepo := repository.NewTimeOffRepository(db)
+	shopRepo := repository.NewShopRepository(db)
+	shopOffboardingRepo := repository.NewShopOffboardingRepository(db)
+	analyticsRepo := repository.NewAnalyticsRepository(db)
+	backfillRepo := repository.NewSchemaBackfillRepository(db)
 
 	// ========================================================================
 	// INITIALIZE SERVICES
@@ -31,9 +86,197 @@ func Setup(router *gin.Engine, db *sqlx.DB, jwtSecret string, jwtExpiration time
 	userService := services.NewUserService(userRepo, jwtSecret, jwtExpiration)
 	barberService := services.NewBarberService(barberRepo, cacheService)
 	serviceService := services.NewServiceService(serviceRepo, cacheService)
-	bookingService := services.NewBookingService(bookingRepo, barberRepo, serviceRepo, cacheService)
+	bookingService := services.NewBookingService(bookingRepo, bookingSeriesRepo, scheduleRepo, barberRepo, serviceRepo, cacheService)
 	reviewService := services.NewReviewService(reviewRepo, bookingRepo, barberRepo, cacheService)
-	notificationService := services.NewNotificationService(notificationRepo, userRepo, bookingRepo)
+	notificationService := services.NewNotificationService(notificationRepo, userRepo, bookingRepo, cacheService)
+	auditService := services.NewAuditService(auditLogRepo)
+	ledgerService := services.NewFinancialLedgerService(financialEventRepo, commissionRepo, barberRepo)
+	// Every live provider operation is recorded in the financial ledger
+	paymentGateway := payments.WithRecorder(options.paymentGateway, ledgerService)
+	scheduleService := services.NewScheduleService(scheduleRepo, barberRepo)
+	timelineService := services.NewTimelineService(timelineRepo)
+	checkoutService := services.NewCheckoutService(bookingService, notificationService, paymentGateway)
+	npsService := services.NewNPSService(npsRepo, barberRepo, notificationService, options.npsConfig())
+	reviewRequestService := services.NewReviewRequestService(reviewRequestRepo, bookingRepo, reviewRepo, reviewService, notificationService, options.reviewRequestConfig())
+	winBackService := services.NewWinBackService(winBackRepo, notificationService, options.winBack)
+	featuredService := services.NewFeaturedService(featuredRepo, barberRepo, serviceRepo, paymentGateway, options.featured)
+	experimentService := services.NewExperimentService(experimentRepo)
+	roleService := services.NewRoleService(roleRepo, userRepo)
+	suppressionService := services.NewSuppressionService(suppressionRepo)
+	autoReplyService := services.NewAutoReplyService(autoReplyRepo, barberRepo, scheduleRepo, notificationService)
+	calendarFeedService := services.NewCalendarFeedService(calendarFeedRepo, bookingRepo)
+	locationService := services.NewLocationService(locationRepo, barberRepo)
+	inventoryService := services.NewInventoryService(inventoryRepo, barberRepo, notificationService)
+	commissionService := services.NewCommissionService(commissionRepo, bookingService, barberRepo)
+	taxService := services.NewTaxService(taxRepo, barberRepo)
+	addOnService := services.NewAddOnService(addOnRepo, serviceRepo, barberRepo)
+	bookingFormService := services.NewBookingFormService(bookingFormRepo, barberRepo, serviceRepo)
+	confirmationRequestService := services.NewConfirmationRequestService(confirmationRequestRepo, bookingRepo, bookingService, notificationService, options.noShowRisk)
+	pendingExpiryService := services.NewPendingExpiryService(bookingRepo, bookingService, notificationService, options.pendingExpiry)
+	statsService := services.NewStatsService(barberRepo, serviceRepo)
+	autoNoShowService := services.NewAutoNoShowService(bookingRepo, bookingService, notificationService, options.autoNoShow)
+	clientImportService := services.NewClientImportService(barberClientRepo, customerInvitationRepo, userRepo, barberRepo, notificationService, options.clientImport)
+	bookingImportService := services.NewBookingImportService(bookingRepo, barberRepo, serviceRepo, userRepo)
+	realtimeService := services.NewRealtimeService(cacheService, notificationService, barberRepo)
+	webhookService := services.NewWebhookService(webhookRepo, barberRepo, options.webhooks)
+	statusService := services.NewStatusService(statusRepo, cacheService, options.status)
+	outboxService := services.NewOutboxService(outboxRepo)
+	bookingArchiveService := services.NewBookingArchiveService(bookingArchiveRepo)
+	partitionService := services.NewPartitionService(partitionRepo)
+	backfillService := services.NewBackfillService(backfillRepo, options.workerConfig.BackfillBatchSize)
+	openSlotService := services.NewOpenSlotService(openSlotRepo, bookingService, barberRepo, notificationService)
+	timeOffService := services.NewTimeOffService(timeOffRepo, bookingRepo, barberRepo, bookingService, notificationService)
+	shopService := services.NewShopService(shopRepo, barberRepo, userRepo, taxRepo, taxService, bookingService)
+	shopOffboardingService := services.NewShopOffboardingService(shopOffboardingRepo, shopRepo, taxRepo, shopService, options.offboarding)
+	analyticsService := services.NewAnalyticsService(analyticsRepo)
+	uploadStorage := options.uploadStorage
+	if uploadStorage == nil {
+		uploadStorage = storage.NewLocalStorage("./uploads", config.DefaultUploadPublicPath)
+	}
+	reviewImageService := services.NewReviewImageService(reviewRepo, reviewImageRepo, uploadStorage, cacheService)
+	consultationService := services.NewConsultationService(consultationRequestRepo, barberRepo, serviceRepo, userRepo, bookingService, uploadStorage)
+	consultationService.SetNotifications(notificationService)
+	reviewReportService := services.NewReviewReportService(reviewRepo, reviewReportRepo, cacheService, options.reviewScreening.ReportThreshold)
+	reviewReportService.SetBarbers(barberRepo)
+	portfolioService := services.NewPortfolioService(portfolioRepo, serviceRepo, barberRepo, uploadStorage)
+	supportService := services.NewSupportService(supportTicketRepo, bookingService, userRepo, roleService, notificationService)
+	actionLinkConfig := options.actionLinks
+	if actionLinkConfig.Secret == "" {
+		actionLinkConfig.Secret = jwtSecret
+	}
+	actionLinkService := services.NewBookingActionLinkService(actionLinkRepo, bookingRepo, bookingService, auditService, actionLinkConfig)
+	apiUsageService := options.apiUsage
+	if apiUsageService == nil {
+		apiUsageService = services.NewAPIUsageService(repository.NewAPIUsageRepository(db), userRepo, config.DefaultAPIRateLimit)
+	}
+
+	userService.SetRefreshTokens(refreshTokenRepo, options.refreshTokenExpiration)
+	userService.SetOAuthProviders(userIdentityRepo, options.oauthVerifiers...)
+	userService.SetCustomerInvitations(customerInvitationRepo)
+	userService.SetClock(options.clock)
+	bookingService.SetClock(options.clock)
+	bookingService.SetPaymentGateway(paymentGateway)
+	bookingService.SetCouponRedeemer(winBackService)
+	bookingService.OnCreated(autoReplyService.BookingCreatedHook)
+	bookingService.SetLocations(locationRepo)
+	bookingService.SetTaxRules(taxRepo)
+	bookingService.SetAddOns(addOnRepo)
+	bookingService.SetBookingForm(bookingFormRepo)
+	bookingService.SetConsultations(consultationService)
+	bookingService.SetOutbox(outboxRepo)
+	bookingService.SetArchive(bookingArchiveRepo)
+	bookingService.SetTimeOff(timeOffRepo)
+	bookingService.SetShops(shopRepo)
+	bookingService.SetRelationLoader(relationLoader)
+	bookingService.SetAssignmentStrategy(options.assignment.Strategy)
+	if options.cancellationPolicy != nil {
+		bookingService.SetCancellationPolicy(*options.cancellationPolicy)
+	}
+	if options.priceAdjustment != nil {
+		bookingService.SetPriceAdjustmentPolicy(*options.priceAdjustment)
+	}
+	openSlotService.SetClock(options.clock)
+	consultationService.SetClock(options.clock)
+	timeOffService.SetClock(options.clock)
+	shopOffboardingService.SetClock(options.clock)
+	analyticsService.SetClock(options.clock)
+	backfillService.SetClock(options.clock)
+	supportService.SetClock(options.clock)
+	notificationService.SetClock(options.clock)
+	notificationService.SetSMSSender(options.smsSender, options.smsCallbackBaseURL)
+	notificationService.SetPushDelivery(deviceTokenRepo, options.pushDispatcher)
+	notificationService.SetSuppressions(suppressionService)
+	notificationService.SetActionLinks(actionLinkService)
+	notificationService.SetRelationLoader(relationLoader)
+	notificationService.SetLocalization(options.localization)
+	notificationService.SetQuietHours(options.quietHours)
+	notificationService.SetPreferences(notificationPreferenceRepo)
+	npsService.SetClock(options.clock)
+	reviewRequestService.SetClock(options.clock)
+	winBackService.SetClock(options.clock)
+	featuredService.SetClock(options.clock)
+	experimentService.SetClock(options.clock)
+	apiUsageService.SetClock(options.clock)
+	roleService.SetClock(options.clock)
+	roleService.SetAuditor(auditService)
+	serviceService.SetAuditor(auditService)
+	reviewService.SetAuditor(auditService)
+	reviewService.SetRelationLoader(relationLoader)
+	reviewService.SetScreening(screening.New(options.reviewScreening))
+	reviewService.SetNotifications(notificationService)
+	analyticsService.SetAuditor(auditService)
+	apiUsageService.SetAuditor(auditService)
+	featuredService.SetAuditor(auditService)
+	experimentService.SetAuditor(auditService)
+	suppressionService.SetAuditor(auditService)
+	taxService.SetAuditor(auditService)
+	statusService.SetAuditor(auditService)
+	autoReplyService.SetClock(options.clock)
+	calendarFeedService.SetClock(options.clock)
+	inventoryService.SetClock(options.clock)
+	commissionService.SetClock(options.clock)
+	ledgerService.SetClock(options.clock)
+	taxService.SetClock(options.clock)
+	confirmationRequestService.SetClock(options.clock)
+	actionLinkService.SetClock(options.clock)
+	pendingExpiryService.SetClock(options.clock)
+	autoNoShowService.SetClock(options.clock)
+	statsService.SetClock(options.clock)
+	clientImportService.SetClock(options.clock)
+	bookingImportService.SetClock(options.clock)
+	realtimeService.SetClock(options.clock)
+	webhookService.SetClock(options.clock)
+	statusService.SetClock(options.clock)
+	outboxService.SetClock(options.clock)
+	bookingArchiveService.SetClock(options.clock)
+	for component, breakers := range options.statusBreakers {
+		for _, b := range breakers {
+			statusService.AddBreaker(component, b)
+		}
+	}
+	reviewService.SetWebhooks(webhookService)
+	barberService.SetImpressionRecorder(featuredService)
+	barberService.SetRanking(ranking.New(options.ranking))
+
+	// Background jobs
+	if options.worker != nil {
+		registerJobs(options.worker, options.workerConfig, notificationService, winBackService, userService, apiUsageService, confirmationRequestService, reviewRequestService, autoNoShowService, webhookService, statusService, outboxService, supportService, backfillService)
+	}
+	if options.scheduler != nil {
+		registerCronJobs(options.scheduler, options.cronConfig, notificationService, statsService, pendingExpiryService, outboxService, bookingArchiveService, partitionService, shopOffboardingService)
+	}
+
+	// Booking status side effects (configurable via BOOKING_STATUS_HOOKS)
+	hookRegistry := services.NewDefaultStatusHookRegistry(barberRepo, cacheService)
+	hookRegistry.Register(config.StatusHookReviewRequest, reviewRequestService.RequestHook)
+	hookRegistry.Register(config.StatusHookNPSSurvey, npsService.SurveyHook)
+	hookRegistry.Register(config.StatusHookInventory, inventoryService.UsageHook)
+	hookRegistry.Register(config.StatusHookNoShowFee, bookingService.NoShowFeeHook)
+	for name, hook := range options.statusHookActions {
+		hookRegistry.Register(name, hook)
+	}
+	statusHooks := options.statusHooks
+	if statusHooks == nil {
+		statusHooks, _ = config.ParseStatusHooks(config.DefaultBookingStatusHooks)
+	}
+	if err := hookRegistry.Bind(bookingService, statusHooks); err != nil {
+		logger.Global().Error(err).Msg("Invalid booking status hook configuration")
+	}
+
+	// Live booking status updates and barber feeds (always on; no-op without Redis)
+	bookingService.OnStatusChange(realtimeService.BookingStatusHook)
+	bookingService.OnCreated(realtimeService.BookingCreatedHook)
+	bookingService.OnUpdated(realtimeService.BookingUpdatedHook)
+
+	// Platform fees and barber payouts (always on; the ledger is append-only)
+	bookingService.OnEnterStatus(config.BookingStatusCompleted, ledgerService.CompletedBookingHook)
+
+	// Booking domain events, relayed from the outbox by the background worker
+	outboxService.Handle(config.OutboxHandlerNotifications, notificationService.BookingEventHandler)
+	outboxService.Handle(config.OutboxHandlerWebhooks, webhookService.BookingEventHandler)
+	if cacheService != nil {
+		outboxService.Handle(config.OutboxHandlerCache, services.BookingCacheHandler(cacheService))
+	}
 
 	// ========================================================================
 	// INITIALIZE HANDLERS
@@ -43,60 +286,137 @@ func Setup(router *gin.Engine, db *sqlx.DB, jwtSecret string, jwtExpiration time
 	serviceHandler := handlers.NewServiceHandler(serviceService)
 	bookingHandler := handlers.NewBookingHandler(bookingService)
 	reviewHandler := handlers.NewReviewHandler(reviewService)
+	reviewImageHandler := handlers.NewReviewImageHandler(reviewImageService)
+	reviewReportHandler := handlers.NewReviewReportHandler(reviewReportService)
 	notificationHandler := handlers.NewNotificationHandler(notificationService)
+	adminHandler := handlers.NewAdminHandler(auditService)
+	scheduleHandler := handlers.NewScheduleHandler(scheduleService)
+	checkoutHandler := handlers.NewCheckoutHandler(checkoutService)
+	timelineHandler := handlers.NewTimelineHandler(timelineService)
+	npsHandler := handlers.NewNPSHandler(npsService)
+	reviewRequestHandler := handlers.NewReviewRequestHandler(reviewRequestService)
+	winBackHandler := handlers.NewWinBackHandler(winBackService)
+	featuredHandler := handlers.NewFeaturedHandler(featuredService)
+	experimentHandler := handlers.NewExperimentHandler(experimentService)
+	apiUsageHandler := handlers.NewAPIUsageHandler(apiUsageService)
+	roleHandler := handlers.NewRoleHandler(roleService)
+	suppressionHandler := handlers.NewSuppressionHandler(suppressionService)
+	autoReplyHandler := handlers.NewAutoReplyHandler(autoReplyService)
+	calendarHandler := handlers.NewCalendarHandler(calendarFeedService)
+	locationHandler := handlers.NewLocationHandler(locationService)
+	inventoryHandler := handlers.NewInventoryHandler(inventoryService)
+	commissionHandler := handlers.NewCommissionHandler(commissionService)
+	ledgerHandler := handlers.NewFinancialLedgerHandler(ledgerService)
+	taxHandler := handlers.NewTaxHandler(taxService)
+	confirmationRequestHandler := handlers.NewConfirmationRequestHandler(confirmationRequestService)
+	addOnHandler := handlers.NewAddOnHandler(addOnService)
+	portfolioHandler := handlers.NewPortfolioHandler(portfolioService)
+	bookingFormHandler := handlers.NewBookingFormHandler(bookingFormService)
+	consultationHandler := handlers.NewConsultationHandler(consultationService)
+	openSlotHandler := handlers.NewOpenSlotHandler(openSlotService)
+	timeOffHandler := handlers.NewTimeOffHandler(timeOffService)
+	shopHandler := handlers.NewShopHandler(shopService)
+	shopOffboardingHandler := handlers.NewShopOffboardingHandler(shopOffboardingService)
+	analyticsHandler := handlers.NewAnalyticsHandler(analyticsService)
+	supportHandler := handlers.NewSupportHandler(supportService)
+	actionLinkHandler := handlers.NewBookingActionLinkHandler(actionLinkService)
+	clientHandler := handlers.NewClientHandler(clientImportService)
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	statusHandler := handlers.NewStatusHandler(statusService)
+	bookingImportHandler := handlers.NewBookingImportHandler(bookingImportService)
+	realtimeHandler := handlers.NewRealtimeHandler(realtimeService)
+
+	// Uploads kept on local disk are served by the API itself
+	if local, ok := uploadStorage.(*storage.LocalStorage); ok && strings.HasPrefix(local.PublicURL(), "/") {
+		router.Static(local.PublicURL(), local.Root())
+	}
+
+	// perm requires a permission of the authenticated user (see RoleService)
+	perm := func(permission string) gin.HandlerFunc {
+		return middleware.RequirePermission(roleService, permission)
+	}
 
 	// ========================================================================
 	// API v1 ROUTES
 	// ========================================================================
 	v1 := router.Group("/api/v1")
-{
-    // ────────────────────────────────────────────────────────────────
-    // AUTHENTICATION ROUTES
-    // ────────────────────────────────────────────────────────────────
-    auth := v1.Group("/auth")
-    {
-        // Public auth routes with stricter rate limiting (brute force protection)
-        publicAuth := auth.Group("")
-        publicAuth.Use(middleware.RateLimitMiddleware(middleware.AuthRateLimitConfig()))
-        {
-            publicAuth.POST("/register", authHandler.Register)
-            publicAuth.POST("/login", authHandler.Login)
-            publicAuth.POST("/refresh", authHandler.RefreshToken)
-        }
-
-        // Protected auth routes (uses default rate limit from global middleware)
-        protected := auth.Group("")
-        protected.Use(middleware.RequireAuth(jwtSecret))
-        {
-            protected.GET("/me", authHandler.GetMe)
-            protected.PUT("/profile", authHandler.UpdateProfile)
-            protected.POST("/change-password", authHandler.ChangePassword)
-            protected.POST("/logout", authHandler.Logout)
-        }
-    }	
+	v1.Use(middleware.TrackUsage(apiUsageService))
+	v1.Use(middleware.PageLimits(options.pagination, jwtSecret, apiUsageService))
+	v1.Use(middleware.Sandbox(!options.sandboxDisabled))
+	jsonLimits := options.jsonMiddleware()
+
+	// ?fields= selection on the reads list screens use most
+	barberFields := middleware.SparseFields(models.Barber{})
+	serviceFields := middleware.SparseFields(models.Service{})
+	bookingFields := middleware.SparseFields(services.BookingResponse{})
+	{
+		// ────────────────────────────────────────────────────────────────
+		// AUTHENTICATION ROUTES
+		// ────────────────────────────────────────────────────────────────
+		auth := v1.Group("/auth")
+		auth.Use(jsonLimits...)
+		{
+			// Public auth routes with stricter rate limiting (brute force protection)
+			publicAuth := auth.Group("")
+			publicAuth.Use(middleware.RateLimitMiddleware(middleware.AuthRateLimitConfig()))
+			{
+				publicAuth.POST("/register", authHandler.Register)
+				publicAuth.POST("/login", authHandler.Login)
+				publicAuth.POST("/refresh", authHandler.RefreshToken)
+				publicAuth.POST("/oauth/:provider", authHandler.SocialLogin)
+				publicAuth.POST("/claim", authHandler.ClaimAccount)
+			}
+
+			// Protected auth routes (uses default rate limit from global middleware)
+			protected := auth.Group("")
+			protected.Use(middleware.RequireAuth(jwtSecret))
+			{
+				protected.GET("/me", authHandler.GetMe)
+				protected.PUT("/profile", authHandler.UpdateProfile)
+				protected.POST("/change-password", authHandler.ChangePassword)
+				protected.POST("/logout", authHandler.Logout)
+
+				// Linked Google/Apple accounts
+				protected.GET("/identities", authHandler.ListIdentities)
+				protected.POST("/identities/:provider", authHandler.LinkIdentity)
+				protected.DELETE("/identities/:provider", authHandler.UnlinkIdentity)
+			}
+		}
 
 		// ────────────────────────────────────────────────────────────────
 		// BARBER ROUTES
 		// ────────────────────────────────────────────────────────────────
 		barbers := v1.Group("/barbers")
+		barbers.Use(jsonLimits...)
 		{
 			// Public barber routes
-			barbers.GET("", barberHandler.GetAllBarbers)
-			barbers.GET("/search", barberHandler.SearchBarbers)
-			barbers.GET("/:id", barberHandler.GetBarber)
-			barbers.GET("/uuid/:uuid", barberHandler.GetBarberByUUID)
+			barbers.GET("", barberFields, barberHandler.GetAllBarbers)
+			barbers.GET("/search", barberFields, barberHandler.SearchBarbers)
+			barbers.GET("/nearby", barberFields, barberHandler.GetNearbyBarbers)
+			barbers.GET("/:id", barberFields, barberHandler.GetBarber)
+			barbers.GET("/uuid/:uuid", barberFields, barberHandler.GetBarberByUUID)
 			barbers.GET("/:id/statistics", barberHandler.GetBarberStatistics)
 			barbers.GET("/:id/services", serviceHandler.GetBarberServices)
+			barbers.GET("/:id/services/:serviceId/add-ons", addOnHandler.ListAddOns)
+			barbers.GET("/:id/services/:serviceId/portfolio", portfolioHandler.ListPortfolio)
+			barbers.GET("/:id/booking-form", bookingFormHandler.GetBookingForm)
 
 			// Barber booking routes (public - view bookings)
-			barbers.GET("/:id/bookings", bookingHandler.GetBarberBookings)
+			barbers.GET("/:id/bookings", bookingFields, bookingHandler.GetBarberBookings)
 			barbers.GET("/:id/bookings/today", bookingHandler.GetTodayBookings)
 			barbers.GET("/:id/bookings/stats", bookingHandler.GetBarberBookingStats)
+			barbers.GET("/:id/availability", bookingHandler.GetBarberAvailability)
 
 			// Barber review routes (public - view reviews)
 			barbers.GET("/:id/reviews", reviewHandler.GetBarberReviews)
 			barbers.GET("/:id/reviews/stats", reviewHandler.GetBarberReviewStats)
 
+			// Barber schedule routes (public - view working hours)
+			barbers.GET("/:id/schedule", scheduleHandler.GetSchedule)
+
+			// Barber locations (public - choose where to book)
+			barbers.GET("/:id/locations", locationHandler.ListLocations)
+
 			// Protected barber routes
 			protected := barbers.Group("")
 			protected.Use(middleware.RequireAuth(jwtSecret))
@@ -105,25 +425,311 @@ func Setup(router *gin.Engine, db *sqlx.DB, jwtSecret string, jwtExpiration time
 				protected.PUT("/:id", barberHandler.UpdateBarber)
 				protected.DELETE("/:id", barberHandler.DeleteBarber)
 				protected.PATCH("/:id/status", barberHandler.UpdateBarberStatus)
+
+				// Favorites (their last-minute openings are pushed to the customer)
+				protected.GET("/favorites", openSlotHandler.ListFavoriteBarbers)
+				protected.POST("/:id/favorite", openSlotHandler.FavoriteBarber)
+				protected.DELETE("/:id/favorite", openSlotHandler.UnfavoriteBarber)
+			}
+
+			// Schedule management (barbers manage their own, admins any)
+			schedule := barbers.Group("/:id/schedule")
+			schedule.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				schedule.PUT("", scheduleHandler.UpdateSchedule)
+				schedule.POST("/exceptions", scheduleHandler.CreateScheduleException)
+				schedule.DELETE("/exceptions/:exceptionId", scheduleHandler.DeleteScheduleException)
+			}
+
+			// Time off (barbers manage their own, admins any)
+			timeOff := barbers.Group("/:id/time-off")
+			timeOff.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				timeOff.GET("", timeOffHandler.ListTimeOff)
+				timeOff.POST("", timeOffHandler.CreateTimeOff)
+				timeOff.DELETE("/:timeOffId", timeOffHandler.DeleteTimeOff)
+			}
+
+			// Locations and travel times (barbers manage their own, admins any)
+			locations := barbers.Group("/:id/locations")
+			locations.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				locations.POST("", locationHandler.CreateLocation)
+				locations.PUT("/:locationId", locationHandler.UpdateLocation)
+				locations.DELETE("/:locationId", locationHandler.DeleteLocation)
+			}
+			travelTimes := barbers.Group("/:id/travel-times")
+			travelTimes.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				travelTimes.GET("", locationHandler.GetTravelTimes)
+				travelTimes.PUT("", locationHandler.SetTravelTimes)
+			}
+
+			// Service add-ons (barbers manage their own, admins any)
+			addOns := barbers.Group("/:id/services/:serviceId/add-ons")
+			addOns.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				addOns.POST("", addOnHandler.CreateAddOn)
+				addOns.PUT("/:addOnId", addOnHandler.UpdateAddOn)
+				addOns.DELETE("/:addOnId", addOnHandler.DeleteAddOn)
 			}
+
+			// Service portfolios (barbers manage their own, admins any;
+			// uploads have their own group below)
+			portfolio := barbers.Group("/:id/services/:serviceId/portfolio")
+			portfolio.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				portfolio.PUT("/order", portfolioHandler.ReorderPortfolio)
+				portfolio.PATCH("/:mediaId", portfolioHandler.UpdatePortfolioCaption)
+				portfolio.DELETE("/:mediaId", portfolioHandler.DeletePortfolioMedia)
+			}
+
+			// Custom booking form fields (barbers manage their own, admins any)
+			bookingForm := barbers.Group("/:id/booking-form/fields")
+			bookingForm.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				bookingForm.GET("", bookingFormHandler.ListBookingFormFields)
+				bookingForm.POST("", bookingFormHandler.CreateBookingFormField)
+				bookingForm.PUT("/:fieldId", bookingFormHandler.UpdateBookingFormField)
+				bookingForm.DELETE("/:fieldId", bookingFormHandler.DeleteBookingFormField)
+			}
+
+			// Consultation requests from customers and service booking
+			// questions (barbers answer their own, admins any)
+			consultations := barbers.Group("/:id/consultation-requests")
+			consultations.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				consultations.GET("", consultationHandler.ListConsultationRequests)
+				consultations.GET("/:requestId", consultationHandler.GetConsultationRequest)
+				consultations.PUT("/:requestId", consultationHandler.RespondToConsultationRequest)
+				consultations.PUT("/:requestId/quote", consultationHandler.QuoteConsultationRequest)
+			}
+
+			// Product inventory (barbers manage their own, admins any)
+			inventory := barbers.Group("/:id/inventory")
+			inventory.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				inventory.GET("", inventoryHandler.ListInventory)
+				inventory.POST("", inventoryHandler.CreateInventoryItem)
+				inventory.GET("/usage", inventoryHandler.GetInventoryUsage)
+				inventory.PUT("/:itemId", inventoryHandler.UpdateInventoryItem)
+				inventory.DELETE("/:itemId", inventoryHandler.DeleteInventoryItem)
+			}
+
+			// Earnings and payouts, including assistant splits (barbers see their own, admins any)
+			earnings := barbers.Group("/:id/earnings")
+			earnings.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				earnings.GET("", commissionHandler.GetBarberEarnings)
+			}
+
+			// Out-of-hours auto-reply (barbers manage their own, admins any)
+			autoReply := barbers.Group("/:id/auto-reply")
+			autoReply.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				autoReply.GET("", autoReplyHandler.GetAutoReply)
+				autoReply.PUT("", autoReplyHandler.UpdateAutoReply)
+			}
+
+			// Live dashboard (barbers see their own, admins any)
+			dashboard := barbers.Group("/:id/dashboard")
+			dashboard.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				dashboard.GET("/today", bookingHandler.GetBarberDashboard)
+			}
+
+			// Cancellations by reason (barbers see their own, admins any)
+			cancellations := barbers.Group("/:id/cancellation-reasons")
+			cancellations.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				cancellations.GET("", bookingHandler.GetBarberCancellationReasons)
+			}
+
+			// NPS trend (barbers see their own, admins any)
+			nps := barbers.Group("/:id/nps")
+			nps.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				nps.GET("", npsHandler.GetBarberNPS)
+			}
+
+			// Featured placement (barbers buy their own, admins any)
+			featured := barbers.Group("/:id/featured")
+			featured.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				featured.POST("", featuredHandler.PurchasePlacement)
+				featured.GET("", featuredHandler.ListBarberPlacements)
+				featured.DELETE("/:placementId", featuredHandler.CancelPlacement)
+			}
+
+			// Client list (barbers manage their own, admins any)
+			clients := barbers.Group("/:id/clients")
+			clients.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				clients.GET("", clientHandler.ListClients)
+			}
+
+			// Outbound webhooks (barbers manage their own, admins any)
+			webhooks := barbers.Group("/:id/webhooks")
+			webhooks.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				webhooks.GET("", webhookHandler.ListWebhooks)
+				webhooks.POST("", webhookHandler.CreateWebhook)
+				webhooks.PATCH("/:webhookId", webhookHandler.UpdateWebhook)
+				webhooks.DELETE("/:webhookId", webhookHandler.DeleteWebhook)
+				webhooks.GET("/:webhookId/deliveries", webhookHandler.ListWebhookDeliveries)
+				webhooks.POST("/:webhookId/deliveries/:deliveryId/redeliver", webhookHandler.RedeliverWebhook)
+			}
+
+			// Last-minute open slot broadcasts (barbers manage their own, admins any)
+			openSlots := barbers.Group("/:id/open-slots")
+			openSlots.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				openSlots.GET("", openSlotHandler.ListOpenSlots)
+				openSlots.POST("", openSlotHandler.BroadcastOpenSlot)
+				openSlots.DELETE("/:slotId", openSlotHandler.CancelOpenSlot)
+			}
+		}
+
+		// Client list imports (CSV upload; own group for the upload body limit)
+		clientImports := v1.Group("/barbers/:id/clients/import")
+		clientImports.Use(options.uploadMiddleware()...)
+		clientImports.Use(middleware.UnlimitedQueries())
+		clientImports.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+		{
+			clientImports.POST("", clientHandler.ImportClients)
+		}
+
+		// Portfolio photo uploads (multipart)
+		portfolioUploads := v1.Group("/barbers/:id/services/:serviceId/portfolio")
+		portfolioUploads.Use(options.uploadMiddleware()...)
+		portfolioUploads.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+		{
+			portfolioUploads.POST("", portfolioHandler.UploadPortfolioMedia)
+		}
+
+		// Appointment history imports (CSV upload)
+		bookingImports := v1.Group("/barbers/:id/bookings/import")
+		bookingImports.Use(options.uploadMiddleware()...)
+		bookingImports.Use(middleware.UnlimitedQueries())
+		bookingImports.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+		{
+			bookingImports.POST("", bookingImportHandler.ImportBookings)
+		}
+
+		// ────────────────────────────────────────────────────────────────
+		// FEATURED PLACEMENT ROUTES
+		// ────────────────────────────────────────────────────────────────
+		featured := v1.Group("/featured")
+		featured.Use(jsonLimits...)
+		{
+			// Public - inventory and click tracking for search results
+			featured.GET("/availability", featuredHandler.GetAvailability)
+			featured.POST("/:id/click", featuredHandler.RecordClick)
+		}
+
+		// ────────────────────────────────────────────────────────────────
+		// OPEN SLOT ROUTES
+		// ────────────────────────────────────────────────────────────────
+		openSlots := v1.Group("/open-slots")
+		openSlots.Use(jsonLimits...)
+		{
+			// Public - the slot a push notification links to
+			openSlots.GET("/:id", openSlotHandler.GetOpenSlot)
+
+			// Protected - first come, first served
+			openSlots.POST("/:id/claim", middleware.RequireAuth(jwtSecret), perm(config.PermissionBookingsWrite), openSlotHandler.ClaimOpenSlot)
+		}
+
+		// ────────────────────────────────────────────────────────────────
+		// CONSULTATION REQUEST ROUTES
+		// ────────────────────────────────────────────────────────────────
+		consultationRequests := v1.Group("/consultation-requests")
+		consultationRequests.Use(jsonLimits...)
+		consultationRequests.Use(middleware.RequireAuth(jwtSecret))
+		{
+			consultationRequests.POST("", consultationHandler.SubmitConsultationRequest)
+			consultationRequests.GET("", consultationHandler.ListMyConsultationRequests)
+			consultationRequests.GET("/:id", consultationHandler.GetMyConsultationRequest)
+			consultationRequests.POST("/:id/accept", perm(config.PermissionBookingsWrite), consultationHandler.AcceptConsultationQuote)
+		}
+
+		// Consultation photo uploads (multipart)
+		consultationUploads := v1.Group("/consultation-requests/:id/photos")
+		consultationUploads.Use(options.uploadMiddleware()...)
+		consultationUploads.Use(middleware.RequireAuth(jwtSecret))
+		{
+			consultationUploads.POST("", consultationHandler.UploadConsultationPhoto)
+		}
+
+		// ────────────────────────────────────────────────────────────────
+		// SHOP ROUTES
+		// ────────────────────────────────────────────────────────────────
+		shops := v1.Group("/shops")
+		shops.Use(jsonLimits...)
+		{
+			// Public shop routes
+			shops.GET("/:id", shopHandler.GetShop)
+			shops.GET("/:id/barbers", shopHandler.ListShopBarbers)
+
+			// Shop management (shop admins, or platform admins)
+			protected := shops.Group("")
+			protected.Use(middleware.RequireAuth(jwtSecret))
+			{
+				protected.PUT("/:id", shopHandler.UpdateShop)
+				protected.POST("/:id/barbers", shopHandler.AddShopBarber)
+				protected.DELETE("/:id/barbers/:barberId", shopHandler.RemoveShopBarber)
+				protected.GET("/:id/admins", shopHandler.ListShopAdmins)
+				protected.POST("/:id/admins", shopHandler.AddShopAdmin)
+				protected.DELETE("/:id/admins/:userId", shopHandler.RemoveShopAdmin)
+				protected.GET("/:id/tax-rules", shopHandler.ListShopTaxRules)
+				protected.POST("/:id/tax-rules", shopHandler.CreateShopTaxRule)
+				protected.DELETE("/:id/tax-rules/:ruleId", shopHandler.DeleteShopTaxRule)
+				protected.GET("/:id/bookings", shopHandler.GetShopBookings)
+				protected.GET("/:id/export", middleware.UnlimitedQueries(), shopOffboardingHandler.ExportShop)
+				protected.GET("/:id/offboarding", shopOffboardingHandler.GetShopOffboarding)
+				protected.POST("/:id/offboarding", shopOffboardingHandler.ScheduleShopOffboarding)
+				protected.DELETE("/:id/offboarding", shopOffboardingHandler.CancelShopOffboarding)
+			}
+
+			// Opening a shop (barbers and admins)
+			owners := shops.Group("")
+			owners.Use(middleware.RequireBarberOrAdmin(jwtSecret))
+			{
+				owners.POST("", shopHandler.CreateShop)
+			}
+		}
+
+		// ────────────────────────────────────────────────────────────────
+		// SUPPORT TICKET ROUTES
+		// ────────────────────────────────────────────────────────────────
+		support := v1.Group("/support/tickets")
+		support.Use(jsonLimits...)
+		support.Use(middleware.RequireAuth(jwtSecret))
+		{
+			support.POST("", supportHandler.OpenTicket)
+			support.GET("", supportHandler.ListMyTickets)
+			support.GET("/:id", supportHandler.GetMyTicket)
+			support.POST("/:id/messages", supportHandler.ReplyToMyTicket)
+			support.POST("/:id/close", supportHandler.CloseMyTicket)
 		}
 
 		// ────────────────────────────────────────────────────────────────
 		// SERVICE ROUTES
 		// ────────────────────────────────────────────────────────────────
 		svcs := v1.Group("/services")
+		svcs.Use(jsonLimits...)
 		{
 			// Public service routes
-			svcs.GET("", serviceHandler.GetAllServices)
-			svcs.GET("/search", serviceHandler.SearchServices)
-			svcs.GET("/:id", serviceHandler.GetService)
-			svcs.GET("/slug/:slug", serviceHandler.GetServiceBySlug)
+			svcs.GET("", serviceFields, serviceHandler.GetAllServices)
+			svcs.GET("/search", serviceFields, serviceHandler.SearchServices)
+			svcs.GET("/:id", serviceFields, serviceHandler.GetService)
+			svcs.GET("/slug/:slug", serviceFields, serviceHandler.GetServiceBySlug)
 			svcs.GET("/categories", serviceHandler.GetAllCategories)
 			svcs.GET("/categories/:id", serviceHandler.GetCategory)
 
 			// Protected service routes (admin only)
 			protected := svcs.Group("")
-			protected.Use(middleware.RequireAuth(jwtSecret))
+			protected.Use(middleware.RequireAuth(jwtSecret), perm(config.PermissionServicesManage))
 			{
 				protected.POST("", serviceHandler.CreateService)
 				protected.PUT("/:id", serviceHandler.UpdateService)
@@ -140,6 +746,7 @@ func Setup(router *gin.Engine, db *sqlx.DB, jwtSecret string, jwtExpiration time
 		// BARBER-SERVICE ROUTES (Junction table management)
 		// ────────────────────────────────────────────────────────────────
 		barberServices := v1.Group("/barber-services")
+		barberServices.Use(jsonLimits...)
 		{
 			// Protected (all require auth)
 			barberServices.Use(middleware.RequireAuth(jwtSecret))
@@ -154,31 +761,53 @@ func Setup(router *gin.Engine, db *sqlx.DB, jwtSecret string, jwtExpiration time
 		// BOOKING ROUTES
 		// ────────────────────────────────────────────────────────────────
 		bookings := v1.Group("/bookings")
+		bookings.Use(jsonLimits...)
 		{
 			// Public booking routes
 			bookings.GET("/availability", bookingHandler.CheckAvailability)
-			bookings.GET("/uuid/:uuid", bookingHandler.GetBookingByUUID)
-			bookings.GET("/number/:number", bookingHandler.GetBookingByNumber)
+			bookings.GET("/cancellation-reasons", bookingHandler.GetCancellationReasons)
+			bookings.GET("/uuid/:uuid", bookingFields, bookingHandler.GetBookingByUUID)
+			bookings.GET("/number/:number", bookingFields, bookingHandler.GetBookingByNumber)
+
+			// Public - the confirmation request token authorizes the answer
+			bookings.POST("/confirmations/:token/confirm", confirmationRequestHandler.ConfirmAttendance)
+			bookings.POST("/confirmations/:token/cancel", confirmationRequestHandler.CancelAttendance)
+
+			// Public - signed reminder links; opening one only previews it
+			bookings.GET("/actions/:token", actionLinkHandler.PreviewAction)
+			bookings.POST("/actions/:token", actionLinkHandler.PerformAction)
 
 			// Protected booking routes
 			protected := bookings.Group("")
 			protected.Use(middleware.RequireAuth(jwtSecret))
 			{
 				// Create booking
-				protected.POST("", bookingHandler.CreateBooking)
+				protected.POST("", perm(config.PermissionBookingsWrite), bookingHandler.CreateBooking)
+
+				// Book and pay (reserve, authorize, confirm, notify)
+				protected.POST("/checkout", perm(config.PermissionBookingsWrite), checkoutHandler.Checkout)
+
+				// Recurring booking series
+				protected.GET("/series/:id", perm(config.PermissionBookingsRead), bookingHandler.GetBookingSeries)
+				protected.PUT("/series/:id/reschedule", perm(config.PermissionBookingsWrite), middleware.UnlimitedQueries(), bookingHandler.RescheduleBookingSeries)
+				protected.DELETE("/series/:id", perm(config.PermissionBookingsWrite), middleware.UnlimitedQueries(), bookingHandler.CancelBookingSeries)
 
 				// Get bookings
-				protected.GET("/me", bookingHandler.GetMyBookings)
-				protected.GET("/:id", bookingHandler.GetBooking)
-				protected.GET("/:id/history", bookingHandler.GetBookingHistory)
+				protected.GET("/me", perm(config.PermissionBookingsRead), bookingFields, bookingHandler.GetMyBookings)
+				protected.GET("/:id", perm(config.PermissionBookingsRead), bookingFields, bookingHandler.GetBooking)
+				protected.GET("/:id/history", perm(config.PermissionBookingsRead), bookingHandler.GetBookingHistory)
+				protected.GET("/:id/ics", perm(config.PermissionBookingsRead), bookingHandler.GetBookingCalendar)
+				protected.GET("/:id/staff", perm(config.PermissionBookingsRead), commissionHandler.GetBookingStaff)
 
 				// Update booking
-				protected.PUT("/:id", bookingHandler.UpdateBooking)
-				protected.PATCH("/:id/status", bookingHandler.UpdateBookingStatus)
-				protected.PUT("/:id/reschedule", bookingHandler.RescheduleBooking)
+				protected.PUT("/:id", perm(config.PermissionBookingsWrite), bookingHandler.UpdateBooking)
+				protected.PATCH("/:id/status", perm(config.PermissionBookingsWrite), bookingHandler.UpdateBookingStatus)
+				protected.POST("/:id/complete", perm(config.PermissionBookingsWrite), bookingHandler.CompleteBooking)
+				protected.PUT("/:id/reschedule", perm(config.PermissionBookingsWrite), bookingHandler.RescheduleBooking)
+				protected.PUT("/:id/staff", perm(config.PermissionBookingsWrite), commissionHandler.SetBookingStaff)
 
 				// Cancel booking
-				protected.DELETE("/:id", bookingHandler.CancelBooking)
+				protected.DELETE("/:id", perm(config.PermissionBookingsWrite), bookingHandler.CancelBooking)
 			}
 		}
 
@@ -186,41 +815,62 @@ func Setup(router *gin.Engine, db *sqlx.DB, jwtSecret string, jwtExpiration time
 		// REVIEW ROUTES
 		// ────────────────────────────────────────────────────────────────
 		reviews := v1.Group("/reviews")
+		reviews.Use(jsonLimits...)
 		{
 			// Public review routes
 			reviews.GET("/:id", reviewHandler.GetReview)
 			reviews.GET("/booking/:booking_id", reviewHandler.GetReviewByBooking)
 			reviews.POST("/:id/vote", reviewHandler.VoteReview)
+			reviews.GET("/:id/images", reviewImageHandler.ListReviewImages)
+
+			// Public - the review link token stands in for a login
+			reviews.GET("/requests/:token", reviewRequestHandler.GetReviewLink)
+			reviews.POST("/requests/:token", reviewRequestHandler.SubmitLinkReview)
 
 			// Protected review routes
 			protected := reviews.Group("")
 			protected.Use(middleware.RequireAuth(jwtSecret))
 			{
 				// Create and manage reviews
-				protected.POST("", reviewHandler.CreateReview)
+				protected.POST("", perm(config.PermissionReviewsWrite), reviewHandler.CreateReview)
 				protected.GET("/me", reviewHandler.GetMyReviews)
-				protected.PUT("/:id", reviewHandler.UpdateReview)
-				protected.DELETE("/:id", reviewHandler.DeleteReview)
+				protected.PUT("/:id", perm(config.PermissionReviewsWrite), reviewHandler.UpdateReview)
+				protected.DELETE("/:id", perm(config.PermissionReviewsWrite), reviewHandler.DeleteReview)
+				protected.DELETE("/:id/images/:image_id", perm(config.PermissionReviewsWrite), reviewImageHandler.DeleteReviewImage)
 
 				// Check if can review
 				protected.GET("/can-review/:booking_id", reviewHandler.CanReviewBooking)
 
+				// Any signed-in user can report a published review
+				protected.POST("/:id/report", reviewReportHandler.ReportReview)
+
 				// Barber response
-				protected.POST("/:id/response", reviewHandler.AddBarberResponse)
+				protected.POST("/:id/response", perm(config.PermissionReviewsWrite), reviewHandler.AddBarberResponse)
 
-				// Admin moderation routes
-				protected.GET("/pending", reviewHandler.GetPendingReviews)
-				protected.PATCH("/:id/moderate", reviewHandler.ModerateReview)
+				// Moderation routes
+				protected.GET("/pending", perm(config.PermissionReviewsModerate), reviewHandler.GetPendingReviews)
+				protected.PATCH("/:id/moderate", perm(config.PermissionReviewsModerate), reviewHandler.ModerateReview)
 			}
 		}
 
+		// Review photo uploads (multipart)
+		reviewUploads := v1.Group("/reviews/:id/images")
+		reviewUploads.Use(options.uploadMiddleware()...)
+		reviewUploads.Use(middleware.RequireAuth(jwtSecret))
+		{
+			reviewUploads.POST("", perm(config.PermissionReviewsWrite), reviewImageHandler.UploadReviewImage)
+		}
+
 		// ────────────────────────────────────────────────────────────────
 		// NOTIFICATION ROUTES
 		// ────────────────────────────────────────────────────────────────
 		notifications := v1.Group("/notifications")
+		notifications.Use(jsonLimits...)
 		{
 			// Webhook endpoint (public - for push notification callbacks)
 			notifications.POST("/:id/webhook", notificationHandler.DeliveryWebhook)
+			notifications.POST("/:id/sms-status", notificationHandler.SMSStatusWebhook)
+			notifications.POST("/sms-inbound", notificationHandler.SMSInboundWebhook)
 
 			// Protected notification routes
 			protected := notifications.Group("")
@@ -230,6 +880,7 @@ func Setup(router *gin.Engine, db *sqlx.DB, jwtSecret string, jwtExpiration time
 				protected.GET("", notificationHandler.GetMyNotifications)
 				protected.GET("/unread", notificationHandler.GetUnreadNotifications)
 				protected.GET("/unread/count", notificationHandler.GetUnreadCount)
+				protected.GET("/unread-count", notificationHandler.PollUnreadCount)
 				protected.GET("/stats", notificationHandler.GetNotificationStats)
 				protected.GET("/:id", notificationHandler.GetNotification)
 
@@ -240,10 +891,214 @@ func Setup(router *gin.Engine, db *sqlx.DB, jwtSecret string, jwtExpiration time
 				// Delete
 				protected.DELETE("/:id", notificationHandler.DeleteNotification)
 
+				// Push devices
+				protected.POST("/devices", notificationHandler.RegisterDevice)
+				protected.DELETE("/devices/:token", notificationHandler.UnregisterDevice)
+
 				// Admin routes - create and send notifications
-				protected.POST("", notificationHandler.CreateNotification)
-				protected.POST("/booking", notificationHandler.SendBookingNotification)
+				protected.POST("", perm(config.PermissionNotificationsSend), notificationHandler.CreateNotification)
+				protected.POST("/booking", perm(config.PermissionNotificationsSend), notificationHandler.SendBookingNotification)
+				protected.GET("/templates", perm(config.PermissionNotificationsSend), notificationHandler.GetTemplateCatalog)
+				protected.POST("/templates/validate", perm(config.PermissionNotificationsSend), notificationHandler.ValidateTemplate)
 			}
 		}
+
+		// ────────────────────────────────────────────────────────────────
+		// LIVE UPDATE ROUTES (WebSocket, Server-Sent Events)
+		// ────────────────────────────────────────────────────────────────
+		// Browsers cannot set headers on WebSockets, so the token may also
+		// come as ?token=
+		ws := v1.Group("/ws")
+		ws.Use(middleware.AuthMiddleware(middleware.AuthConfig{
+			SecretKey:     jwtSecret,
+			TokenLookup:   "header:Authorization,query:token",
+			TokenHeadName: "Bearer",
+		}))
+		{
+			ws.GET("", realtimeHandler.Connect)
+		}
+
+		// Barber booking feed (Server-Sent Events); EventSource cannot set
+		// headers either
+		bookingFeed := v1.Group("/barbers/:id/bookings/stream")
+		bookingFeed.Use(middleware.AuthMiddleware(middleware.AuthConfig{
+			SecretKey:     jwtSecret,
+			TokenLookup:   "header:Authorization,query:token",
+			TokenHeadName: "Bearer",
+			AllowedRoles:  []string{"barber", "admin"},
+		}))
+		{
+			bookingFeed.GET("", realtimeHandler.BookingFeed)
+		}
+
+		// ────────────────────────────────────────────────────────────────
+		// USER SETTINGS ROUTES
+		// ────────────────────────────────────────────────────────────────
+		users := v1.Group("/users")
+		users.Use(jsonLimits...)
+		users.Use(middleware.RequireAuth(jwtSecret))
+		{
+			users.GET("/me/notification-preferences", notificationHandler.GetMyNotificationPreferences)
+			users.PUT("/me/notification-preferences", notificationHandler.UpdateMyNotificationPreferences)
+		}
+
+		// ────────────────────────────────────────────────────────────────
+		// ACTIVITY TIMELINE ROUTES
+		// ────────────────────────────────────────────────────────────────
+		activity := v1.Group("/activity")
+		activity.Use(jsonLimits...)
+		activity.Use(middleware.RequireAuth(jwtSecret))
+		{
+			activity.GET("/me", timelineHandler.GetMyActivity)
+		}
+
+		// ────────────────────────────────────────────────────────────────
+		// CALENDAR ROUTES
+		// ────────────────────────────────────────────────────────────────
+		calendar := v1.Group("/calendar")
+		calendar.Use(jsonLimits...)
+		{
+			// Public - the feed token authorizes calendar apps
+			calendar.GET("/feeds/:token", calendarHandler.GetFeed)
+
+			// Protected - manage the current user's feed
+			protected := calendar.Group("")
+			protected.Use(middleware.RequireAuth(jwtSecret))
+			{
+				protected.GET("/feed", calendarHandler.GetMyFeed)
+				protected.POST("/feed/reset", calendarHandler.ResetMyFeed)
+			}
+		}
+
+		// ────────────────────────────────────────────────────────────────
+		// SURVEY ROUTES
+		// ────────────────────────────────────────────────────────────────
+		surveys := v1.Group("/surveys")
+		surveys.Use(jsonLimits...)
+		{
+			// Public - the survey token authorizes the answer
+			surveys.POST("/nps/:token", npsHandler.RespondToSurvey)
+		}
+
+		// ────────────────────────────────────────────────────────────────
+		// EXPERIMENT ROUTES
+		// ────────────────────────────────────────────────────────────────
+		experimentRoutes := v1.Group("/experiments")
+		experimentRoutes.Use(jsonLimits...)
+		experimentRoutes.Use(middleware.RequireAuth(jwtSecret))
+		{
+			experimentRoutes.GET("/:key/variant", experimentHandler.GetVariant)
+			experimentRoutes.POST("/:key/conversions", experimentHandler.RecordConversion)
+		}
+
+		// ────────────────────────────────────────────────────────────────
+		// API USAGE ROUTES
+		// ────────────────────────────────────────────────────────────────
+		usage := v1.Group("/usage")
+		usage.Use(jsonLimits...)
+		usage.Use(middleware.RequireAuth(jwtSecret))
+		{
+			usage.GET("", apiUsageHandler.GetMyUsage)
+		}
+
+		// ────────────────────────────────────────────────────────────────
+		// STATUS PAGE (public)
+		// ────────────────────────────────────────────────────────────────
+		v1.GET("/status", statusHandler.GetStatus)
+
+		// ────────────────────────────────────────────────────────────────
+		// ADMIN ROUTES
+		// ────────────────────────────────────────────────────────────────
+		admin := v1.Group("/admin")
+		admin.Use(options.adminMiddleware...)
+		admin.Use(jsonLimits...)
+		admin.Use(middleware.RequireAuth(jwtSecret))
+		{
+			admin.GET("/activity", perm(config.PermissionActivityRead), adminHandler.GetActivityFeed)
+			admin.GET("/activity/:id", perm(config.PermissionActivityRead), adminHandler.GetActivityEntry)
+			admin.GET("/customers/:id/activity", perm(config.PermissionActivityRead), timelineHandler.GetCustomerActivity)
+			admin.POST("/customers/:id/activity/rebuild", perm(config.PermissionActivityManage), middleware.UnlimitedQueries(), timelineHandler.RebuildCustomerActivity)
+			admin.GET("/nps", perm(config.PermissionNPSRead), npsHandler.GetPlatformNPS)
+
+			// Financial ledger (hash-chained, for audits)
+			admin.GET("/finance/ledger/export", perm(config.PermissionFinanceExport), ledgerHandler.ExportLedger)
+
+			// Anonymized booking dataset (k-anonymous, for demand modeling)
+			admin.GET("/analytics/dataset", perm(config.PermissionAnalyticsExport), middleware.UnlimitedQueries(), analyticsHandler.ExportDataset)
+
+			// Win-back campaigns
+			admin.POST("/win-back/run", perm(config.PermissionCampaignsManage), middleware.UnlimitedQueries(), winBackHandler.RunCampaign)
+			admin.GET("/win-back/campaigns", perm(config.PermissionCampaignsManage), winBackHandler.ListCampaigns)
+			admin.GET("/win-back/campaigns/:id", perm(config.PermissionCampaignsManage), winBackHandler.GetCampaign)
+
+			// Featured placement
+			admin.GET("/featured/placements", perm(config.PermissionFeaturedManage), featuredHandler.ListPlacements)
+			admin.GET("/featured/limits", perm(config.PermissionFeaturedManage), featuredHandler.ListSlotLimits)
+			admin.PUT("/featured/limits", perm(config.PermissionFeaturedManage), featuredHandler.SetSlotLimit)
+
+			// Experiments
+			admin.POST("/experiments", perm(config.PermissionExperimentsManage), experimentHandler.CreateExperiment)
+			admin.GET("/experiments", perm(config.PermissionExperimentsManage), experimentHandler.ListExperiments)
+			admin.GET("/experiments/:key", perm(config.PermissionExperimentsManage), experimentHandler.GetExperiment)
+			admin.PUT("/experiments/:key", perm(config.PermissionExperimentsManage), experimentHandler.UpdateExperiment)
+			admin.PATCH("/experiments/:key/status", perm(config.PermissionExperimentsManage), experimentHandler.UpdateExperimentStatus)
+			admin.GET("/experiments/:key/report", perm(config.PermissionExperimentsManage), experimentHandler.GetExperimentReport)
+
+			// API usage and quotas
+			admin.GET("/usage", perm(config.PermissionUsageRead), apiUsageHandler.GetTopConsumers)
+			admin.GET("/usage/:userId", perm(config.PermissionUsageRead), apiUsageHandler.GetConsumerUsage)
+			admin.GET("/quotas", perm(config.PermissionQuotasManage), apiUsageHandler.ListQuotas)
+			admin.PUT("/quotas/:userId", perm(config.PermissionQuotasManage), apiUsageHandler.SetQuota)
+			admin.DELETE("/quotas/:userId", perm(config.PermissionQuotasManage), apiUsageHandler.DeleteQuota)
+
+			// Support tickets
+			admin.GET("/support/tickets", perm(config.PermissionSupportManage), supportHandler.ListTickets)
+			admin.GET("/support/tickets/:id", perm(config.PermissionSupportManage), supportHandler.GetTicket)
+			admin.PATCH("/support/tickets/:id", perm(config.PermissionSupportManage), supportHandler.UpdateTicket)
+			admin.POST("/support/tickets/:id/messages", perm(config.PermissionSupportManage), supportHandler.ReplyToTicket)
+
+			// Roles and permissions
+			admin.GET("/permissions", perm(config.PermissionRolesManage), roleHandler.ListPermissions)
+			admin.GET("/roles", perm(config.PermissionRolesManage), roleHandler.ListRoles)
+			admin.POST("/roles", perm(config.PermissionRolesManage), roleHandler.CreateRole)
+			admin.PUT("/roles/:name", perm(config.PermissionRolesManage), roleHandler.UpdateRole)
+			admin.DELETE("/roles/:name", perm(config.PermissionRolesManage), roleHandler.DeleteRole)
+			admin.GET("/users/:id/roles", perm(config.PermissionRolesManage), roleHandler.GetUserRoles)
+			admin.POST("/users/:id/roles", perm(config.PermissionRolesManage), roleHandler.AssignRole)
+			admin.DELETE("/users/:id/roles/:role", perm(config.PermissionRolesManage), roleHandler.RevokeRole)
+
+			// Email/SMS suppression list
+			admin.GET("/suppressions", perm(config.PermissionSuppressionsManage), suppressionHandler.ListSuppressions)
+			admin.GET("/suppressions/:id", perm(config.PermissionSuppressionsManage), suppressionHandler.GetSuppression)
+			admin.POST("/suppressions", perm(config.PermissionSuppressionsManage), suppressionHandler.CreateSuppression)
+			admin.DELETE("/suppressions/:id", perm(config.PermissionSuppressionsManage), suppressionHandler.DeleteSuppression)
+
+			// Tax rules and reports
+			admin.GET("/tax-rules", perm(config.PermissionTaxManage), taxHandler.ListTaxRules)
+			admin.POST("/tax-rules", perm(config.PermissionTaxManage), taxHandler.CreateTaxRule)
+			admin.PUT("/tax-rules/:id", perm(config.PermissionTaxManage), taxHandler.UpdateTaxRule)
+			admin.DELETE("/tax-rules/:id", perm(config.PermissionTaxManage), taxHandler.DeleteTaxRule)
+			admin.GET("/tax-report", perm(config.PermissionTaxManage), taxHandler.GetTaxReport)
+
+			// Status page incidents
+			admin.POST("/status/incidents", perm(config.PermissionStatusManage), statusHandler.CreateIncident)
+			admin.PATCH("/status/incidents/:id", perm(config.PermissionStatusManage), statusHandler.UpdateIncident)
+
+			// Review moderation queue
+			admin.GET("/reviews", perm(config.PermissionReviewsModerate), reviewHandler.GetModerationQueue)
+			admin.GET("/reviews/moderation-reasons", perm(config.PermissionReviewsModerate), reviewHandler.GetModerationReasons)
+			admin.POST("/reviews/moderate", perm(config.PermissionReviewsModerate), reviewHandler.BulkModerateReviews)
+			admin.POST("/reviews/:id/approve", perm(config.PermissionReviewsModerate), reviewHandler.ApproveReview)
+			admin.POST("/reviews/:id/reject", perm(config.PermissionReviewsModerate), reviewHandler.RejectReview)
+			admin.POST("/reviews/:id/flag", perm(config.PermissionReviewsModerate), reviewHandler.FlagReview)
+			admin.GET("/reviews/:id/reports", perm(config.PermissionReviewsModerate), reviewReportHandler.ListReviewReports)
+
+			// Barber response moderation
+			admin.GET("/reviews/responses", perm(config.PermissionReviewsModerate), reviewHandler.GetResponseModerationQueue)
+			admin.GET("/reviews/response-moderation-reasons", perm(config.PermissionReviewsModerate), reviewHandler.GetResponseModerationReasons)
+			admin.POST("/reviews/:id/response/approve", perm(config.PermissionReviewsModerate), reviewHandler.ApproveResponse)
+			admin.POST("/reviews/:id/response/reject", perm(config.PermissionReviewsModerate), reviewHandler.RejectResponse)
+			admin.POST("/reviews/:id/response/redact", perm(config.PermissionReviewsModerate), reviewHandler.RedactResponse)
+		}
 	}
 }
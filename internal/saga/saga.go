@@ -0,0 +1,137 @@
+// internal/saga/saga.go
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"barber-booking-system/internal/logger"
+)
+
+// ========================================================================
+// SAGA - Multi-step workflows with compensating actions
+// ========================================================================
+//
+// A saga runs its steps in order. When a step fails, the compensations of
+// every step that already completed run in reverse order, so a workflow
+// spanning several systems (database, payment provider, notifications)
+// never leaves a half-finished result behind. Compensations run with a
+// context that is not cancelled with the caller's, so a client disconnect
+// cannot stop the cleanup.
+// ========================================================================
+
+// Action performs (or undoes) one step of a saga
+type Action func(ctx context.Context) error
+
+// Step is a named action with an optional compensation
+type Step struct {
+	Name       string
+	Action     Action
+	Compensate Action // nil = nothing to undo
+}
+
+// Saga is an ordered list of steps
+type Saga struct {
+	name  string
+	steps []Step
+}
+
+// New creates an empty saga
+func New(name string) *Saga {
+	return &Saga{name: name}
+}
+
+// Step appends a step; compensate may be nil
+func (s *Saga) Step(name string, action, compensate Action) *Saga {
+	s.steps = append(s.steps, Step{Name: name, Action: action, Compensate: compensate})
+	return s
+}
+
+// Name returns the saga name
+func (s *Saga) Name() string {
+	return s.name
+}
+
+// Steps returns the step names in execution order
+func (s *Saga) Steps() []string {
+	names := make([]string, len(s.steps))
+	for i, step := range s.steps {
+		names[i] = step.Name
+	}
+	return names
+}
+
+// Error reports the step that failed and any compensation that also failed
+type Error struct {
+	Saga string
+	Step string
+	Err  error
+
+	// Compensated lists the steps rolled back successfully, in rollback order
+	Compensated []string
+
+	// CompensationErrors holds failures while rolling back; non-empty means
+	// the workflow may have left state behind that needs manual attention
+	CompensationErrors []error
+}
+
+func (e *Error) Error() string {
+	if len(e.CompensationErrors) > 0 {
+		return fmt.Sprintf("%s: step %q failed: %v (compensation failed: %v)",
+			e.Saga, e.Step, e.Err, errors.Join(e.CompensationErrors...))
+	}
+	return fmt.Sprintf("%s: step %q failed: %v", e.Saga, e.Step, e.Err)
+}
+
+// Unwrap returns the step error so callers can match it with errors.Is/As
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Run executes the steps in order, compensating completed steps on failure.
+// It returns nil or an *Error.
+func (s *Saga) Run(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+
+	for i, step := range s.steps {
+		if err := ctx.Err(); err != nil {
+			return s.rollback(ctx, i, step.Name, err)
+		}
+		if err := step.Action(ctx); err != nil {
+			log.Warn("Saga step failed").
+				Str("saga", s.name).
+				Str("step", step.Name).
+				Err(err).
+				Send()
+			return s.rollback(ctx, i, step.Name, err)
+		}
+	}
+	return nil
+}
+
+// rollback compensates steps[0:failed] in reverse order
+func (s *Saga) rollback(ctx context.Context, failed int, stepName string, cause error) error {
+	log := logger.FromContext(ctx)
+	sagaErr := &Error{Saga: s.name, Step: stepName, Err: cause}
+
+	compensateCtx := context.WithoutCancel(ctx)
+	for i := failed - 1; i >= 0; i-- {
+		step := s.steps[i]
+		if step.Compensate == nil {
+			continue
+		}
+		if err := step.Compensate(compensateCtx); err != nil {
+			log.Error(err).
+				Str("saga", s.name).
+				Str("step", step.Name).
+				Msg("Saga compensation failed")
+			sagaErr.CompensationErrors = append(sagaErr.CompensationErrors,
+				fmt.Errorf("compensate %s: %w", step.Name, err))
+			continue
+		}
+		sagaErr.Compensated = append(sagaErr.Compensated, step.Name)
+	}
+
+	return sagaErr
+}
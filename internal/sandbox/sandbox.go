@@ -0,0 +1,37 @@
+// internal/sandbox/sandbox.go
+package sandbox
+
+import "context"
+
+// ========================================================================
+// SANDBOX MODE - Test traffic against the live API
+// ========================================================================
+//
+// Partners integrate by sending the X-Sandbox header with their normal
+// credentials. Sandbox requests run the same code as live ones, but
+// bookings they create are marked as test data and kept apart from live
+// bookings (availability, listings, stats), payments go to a fake provider,
+// and notifications about test bookings are stored without being pushed or
+// texted. Sandbox requests can therefore be replayed freely.
+// ========================================================================
+
+// Header switches a request to sandbox mode when set to "true" (or "1")
+const Header = "X-Sandbox"
+
+type contextKey struct{}
+
+// WithSandbox marks ctx as a sandbox request
+func WithSandbox(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, true)
+}
+
+// Enabled reports whether ctx belongs to a sandbox request
+func Enabled(ctx context.Context) bool {
+	enabled, _ := ctx.Value(contextKey{}).(bool)
+	return enabled
+}
+
+// IsRequested reports whether a header value asks for sandbox mode
+func IsRequested(value string) bool {
+	return value == "true" || value == "1"
+}
@@ -0,0 +1,174 @@
+// internal/screening/screener.go
+package screening
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// SCREENING - Spam and profanity checks for user-written text
+// ========================================================================
+//
+// The text checks here run on the text alone: blocked words, matched as
+// whole words after undoing common letter swaps ("sh1t", "$hit"), links,
+// limited both in number and as a share of the words, and personal
+// information (email addresses, phone numbers). Checks that
+// need other reviews (duplicate text, rating-only floods) are run by the
+// review service against the database; their names live here so all the
+// findings read alike.
+// ========================================================================
+
+// Check names
+const (
+	CheckProfanity       = "profanity"
+	CheckLinks           = "links"
+	CheckPersonalInfo    = "personal_info"
+	CheckDuplicateText   = "duplicate_text"
+	CheckRatingOnlyFlood = "rating_only_flood"
+)
+
+// Finding is one reason a text looks suspicious
+type Finding struct {
+	Check  string `json:"check"`
+	Detail string `json:"detail"`
+}
+
+// String formats the finding for moderation notes
+func (f Finding) String() string {
+	return f.Check + ": " + f.Detail
+}
+
+// linkPattern matches URLs and bare domains
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+|\b[a-z0-9-]+\.(?:com|net|org|info|biz|io|co|me|xyz|ru|top|site|online|shop)\b`)
+
+// emailPattern matches email addresses
+var emailPattern = regexp.MustCompile(`(?i)\b[a-z0-9._%+-]+@[a-z0-9.-]+\.[a-z]{2,}\b`)
+
+// phonePattern matches phone numbers: ten or more digits, optionally with
+// a country code and the usual separators. Digits joined to letters or a
+// hyphen before them (booking numbers like BK-20250310-0042) are not phones.
+var phonePattern = regexp.MustCompile(`(?:^|[^\w-])(\+?\d{1,3}[\s.-]?)?\(?\d{3}\)?[\s.-]?\d{3}[\s.-]?\d{4}\b`)
+
+// leetReplacer undoes letter swaps used to get past word filters
+var leetReplacer = strings.NewReplacer(
+	"0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t",
+	"@", "a", "$", "s",
+)
+
+// wordSuffixes are endings stripped to match inflected blocked words
+var wordSuffixes = []string{"ing", "ers", "er", "ed", "es", "s", "y"}
+
+// Screener runs the text checks
+type Screener struct {
+	cfg     config.ReviewScreeningConfig
+	blocked map[string]bool
+}
+
+// New creates a screener from configuration
+func New(cfg config.ReviewScreeningConfig) *Screener {
+	blocked := make(map[string]bool, len(cfg.BlockedWords))
+	for _, word := range cfg.BlockedWords {
+		if word = strings.ToLower(strings.TrimSpace(word)); word != "" {
+			blocked[word] = true
+		}
+	}
+	return &Screener{cfg: cfg, blocked: blocked}
+}
+
+// Config returns the screening configuration
+func (s *Screener) Config() config.ReviewScreeningConfig {
+	return s.cfg
+}
+
+// CheckText runs the profanity, personal information and link checks
+func (s *Screener) CheckText(text string) []Finding {
+	var findings []Finding
+	if words := s.BlockedWords(text); len(words) > 0 {
+		findings = append(findings, Finding{
+			Check:  CheckProfanity,
+			Detail: "contains " + strings.Join(words, ", "),
+		})
+	}
+	if kinds := personalInfo(text); len(kinds) > 0 {
+		findings = append(findings, Finding{
+			Check:  CheckPersonalInfo,
+			Detail: "contains " + strings.Join(kinds, " and "),
+		})
+	}
+
+	links := len(linkPattern.FindAllString(text, -1))
+	if links == 0 {
+		return findings
+	}
+	words := len(strings.Fields(text))
+	switch {
+	case links > s.cfg.MaxLinks:
+		findings = append(findings, Finding{
+			Check:  CheckLinks,
+			Detail: fmt.Sprintf("%d links (at most %d allowed)", links, s.cfg.MaxLinks),
+		})
+	case float64(links)/float64(words) > s.cfg.MaxLinkDensity:
+		findings = append(findings, Finding{
+			Check:  CheckLinks,
+			Detail: fmt.Sprintf("%d of %d words are links", links, words),
+		})
+	}
+	return findings
+}
+
+// personalInfo names the kinds of personal information found in text
+func personalInfo(text string) []string {
+	var kinds []string
+	if emailPattern.MatchString(text) {
+		kinds = append(kinds, "an email address")
+	}
+	if phonePattern.MatchString(text) {
+		kinds = append(kinds, "a phone number")
+	}
+	return kinds
+}
+
+// BlockedWords returns the blocked words found in text, each once
+func (s *Screener) BlockedWords(text string) []string {
+	var found []string
+	seen := make(map[string]bool)
+	for _, token := range strings.Fields(strings.ToLower(text)) {
+		word := strings.TrimFunc(leetReplacer.Replace(token), func(r rune) bool {
+			return !unicode.IsLetter(r)
+		})
+		match, ok := s.blockedForm(word)
+		if ok && !seen[match] {
+			seen[match] = true
+			found = append(found, match)
+		}
+	}
+	return found
+}
+
+// blockedForm reports the blocked word a token is, as written or inflected
+func (s *Screener) blockedForm(word string) (string, bool) {
+	if word == "" {
+		return "", false
+	}
+	if s.blocked[word] {
+		return word, true
+	}
+	for _, suffix := range wordSuffixes {
+		stem := strings.TrimSuffix(word, suffix)
+		if stem != word && len(stem) >= 3 && s.blocked[stem] {
+			return stem, true
+		}
+	}
+	return "", false
+}
+
+// NormalizeText lower-cases text and collapses its whitespace, the form
+// duplicate comments are compared in
+func NormalizeText(text string) string {
+	return strings.ToLower(strings.Join(strings.Fields(text), " "))
+}
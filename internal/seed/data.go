@@ -0,0 +1,92 @@
+// internal/seed/data.go
+package seed
+
+// ========================================================================
+// SEED CATALOG - Fixed data the generated records are drawn from
+// ========================================================================
+
+// Category is a seeded service category
+type Category struct {
+	Name        string
+	Slug        string
+	Description string
+	Color       string
+}
+
+// Service is a seeded global catalog service
+type Service struct {
+	Name         string
+	Slug         string
+	CategorySlug string
+	ServiceType  string
+	Description  string
+	Duration     int     // Minutes
+	PriceMin     float64 // Barbers price the service within [PriceMin, PriceMax]
+	PriceMax     float64
+}
+
+// City is where seeded barbers and customers live
+type City struct {
+	Name      string
+	State     string
+	Country   string
+	Postal    string
+	Latitude  float64
+	Longitude float64
+}
+
+var categories = []Category{
+	{"Haircuts", "haircuts", "Professional hair cutting services for all styles", "#2563EB"},
+	{"Beard & Mustache", "beard-mustache", "Beard trimming, shaping and mustache styling", "#DC2626"},
+	{"Hair Styling", "hair-styling", "Styling for everyday looks and special occasions", "#7C3AED"},
+	{"Hair Treatments", "hair-treatments", "Scalp and hair health treatments", "#059669"},
+}
+
+var services = []Service{
+	{"Classic Business Cut", "classic-business-cut", "haircuts", "haircut", "A timeless, professional haircut with a clean finish", 30, 25, 40},
+	{"Modern Fade Cut", "modern-fade-cut", "haircuts", "haircut", "Fade with a gradual transition from short sides to a longer top", 45, 35, 55},
+	{"Buzz Cut", "buzz-cut", "haircuts", "haircut", "Even all-over clipper cut", 20, 15, 25},
+	{"Kids Cut", "kids-cut", "haircuts", "haircut", "Haircut for children under 12", 25, 15, 25},
+	{"Beard Trim & Shape", "beard-trim-shape", "beard-mustache", "grooming", "Precise beard trimming, edge work and styling", 20, 15, 30},
+	{"Hot Towel Shave", "hot-towel-shave", "beard-mustache", "grooming", "Traditional straight razor shave with hot towels", 40, 30, 50},
+	{"Special Occasion Styling", "special-occasion-styling", "hair-styling", "styling", "Styling for weddings, events and photo shoots", 60, 45, 80},
+	{"Wash & Style", "wash-and-style", "hair-styling", "styling", "Shampoo, conditioning and blow-dry styling", 30, 20, 35},
+	{"Scalp Treatment", "scalp-treatment", "hair-treatments", "treatment", "Exfoliating scalp treatment with massage", 45, 40, 70},
+}
+
+var cities = []City{
+	{"New York", "NY", "USA", "10001", 40.7128, -74.0060},
+	{"Brooklyn", "NY", "USA", "11201", 40.6892, -73.9442},
+	{"Chicago", "IL", "USA", "60601", 41.8781, -87.6298},
+	{"Austin", "TX", "USA", "78701", 30.2672, -97.7431},
+	{"Seattle", "WA", "USA", "98101", 47.6062, -122.3321},
+}
+
+var firstNames = []string{
+	"James", "Maria", "David", "Aisha", "Carlos", "Emily", "Kenji", "Sofia",
+	"Marcus", "Priya", "Liam", "Fatima", "Noah", "Elena", "Omar", "Grace",
+	"Mateo", "Hannah", "Tyrone", "Yuki", "Andre", "Chloe", "Ravi", "Nina",
+}
+
+var lastNames = []string{
+	"Smith", "Garcia", "Kim", "Johnson", "Nguyen", "Williams", "Okafor", "Rossi",
+	"Brown", "Patel", "Martinez", "Chen", "Davis", "Silva", "Khan", "Miller",
+}
+
+var shopSuffixes = []string{"Barbershop", "Cuts", "Grooming Co.", "Studio", "Barber Lounge"}
+
+var cancellationReasons = []string{
+	"Schedule conflict",
+	"Feeling unwell",
+	"Found an earlier appointment",
+	"Travel plans changed",
+}
+
+// reviewTexts are review titles and comments by rating (index 0 = 1 star)
+var reviewTexts = [5][]struct{ Title, Comment string }{
+	{{"Disappointing", "Waited a long time and the cut was uneven."}},
+	{{"Not great", "The cut was okay but felt rushed."}},
+	{{"Decent", "Fine haircut, nothing special."}, {"Average visit", "Good cut, the shop was busy and loud."}},
+	{{"Good cut", "Solid fade and friendly service."}, {"Would come back", "Listened to what I wanted and delivered."}},
+	{{"Best barber in town", "Perfect fade, great conversation, spotless shop."}, {"Excellent", "Always on time and the beard trim was flawless."}, {"Highly recommend", "Attention to detail is unmatched."}},
+}
@@ -0,0 +1,310 @@
+// internal/seed/plan.go
+package seed
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// SEED PLAN - Deterministic description of the data to insert
+// ========================================================================
+//
+// The plan is built from a random seed without touching the database, so
+// the same options always produce the same barbers, customers, bookings
+// and reviews (relative to now).
+// ========================================================================
+
+const (
+	// EmailDomain marks seeded users; Reset removes users at this domain
+	EmailDomain = "seed.example.com"
+
+	// Password is the password of every seeded user
+	Password = "password123"
+)
+
+// Working hours of seeded barbers (UTC): Tuesday to Saturday, 9:00 to
+// 18:00 with a lunch break at 13:00
+var (
+	workDays    = []time.Weekday{time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+	workStart   = 9
+	workEnd     = 18
+	breakStart  = 13
+	bookingHour = []int{9, 10, 11, 12, 14, 15, 16, 17}
+)
+
+// Options control how much data is generated
+type Options struct {
+	Barbers           int
+	Customers         int
+	BookingsPerBarber int
+	PastDays          int // Bookings start up to this many days ago
+	FutureDays        int // ... and up to this many days ahead
+	Seed              int64
+}
+
+// DefaultOptions is a small but varied data set
+func DefaultOptions() Options {
+	return Options{
+		Barbers:           6,
+		Customers:         40,
+		BookingsPerBarber: 40,
+		PastDays:          90,
+		FutureDays:        21,
+		Seed:              1,
+	}
+}
+
+// Person is a seeded user
+type Person struct {
+	Name  string
+	Email string
+	Phone string
+	City  City
+}
+
+// Barber is a seeded barber with the services they offer
+type Barber struct {
+	Person
+	ShopName        string
+	YearsExperience int
+	CommissionRate  float64
+	Services        []BarberService
+}
+
+// BarberService is a catalog service offered by a seeded barber
+type BarberService struct {
+	Service
+	Price float64
+}
+
+// Booking is a seeded booking; Barber, Customer and Service are indexes
+// into Plan.Barbers, Plan.Customers and the barber's Services
+type Booking struct {
+	Barber             int
+	Customer           int
+	Service            int
+	Start              time.Time
+	End                time.Time
+	Status             string
+	CreatedAt          time.Time
+	CancellationReason string
+	Review             *Review
+}
+
+// Review is a seeded review of a completed booking
+type Review struct {
+	Rating         int
+	Title          string
+	Comment        string
+	WouldRecommend bool
+}
+
+// Plan is the full data set to insert
+type Plan struct {
+	Categories []Category
+	Services   []Service
+	Admin      Person
+	Barbers    []Barber
+	Customers  []Person
+	Bookings   []Booking
+}
+
+// WorkingHours returns the weekly work periods of seeded barbers as
+// (weekday, start hour, end hour)
+func WorkingHours() [][3]int {
+	var periods [][3]int
+	for _, day := range workDays {
+		periods = append(periods,
+			[3]int{int(day), workStart, breakStart},
+			[3]int{int(day), breakStart + 1, workEnd})
+	}
+	return periods
+}
+
+// NewPlan builds the data set for opts. Bookings are placed in free hours
+// of each barber's working week, never today, so active bookings never
+// overlap and upcoming ones respect booking notice.
+func NewPlan(opts Options, now time.Time) *Plan {
+	rng := rand.New(rand.NewSource(opts.Seed))
+	now = now.UTC()
+
+	plan := &Plan{
+		Categories: categories,
+		Services:   services,
+		Admin: Person{
+			Name:  "Seed Admin",
+			Email: "admin@" + EmailDomain,
+			Phone: "+1-555-0100",
+			City:  cities[0],
+		},
+	}
+
+	for i := 0; i < opts.Barbers; i++ {
+		person := newPerson(rng, "barber", i)
+		barber := Barber{
+			Person:          person,
+			ShopName:        fmt.Sprintf("%s's %s", strings.Fields(person.Name)[0], shopSuffixes[rng.Intn(len(shopSuffixes))]),
+			YearsExperience: 2 + rng.Intn(20),
+			CommissionRate:  float64(10 + 5*rng.Intn(3)),
+		}
+		// Four to six services, in catalog order
+		for _, idx := range sortedSample(rng, len(services), 4+rng.Intn(3)) {
+			s := services[idx]
+			price := s.PriceMin + float64(rng.Intn(int(s.PriceMax-s.PriceMin)/5+1))*5
+			barber.Services = append(barber.Services, BarberService{Service: s, Price: price})
+		}
+		plan.Barbers = append(plan.Barbers, barber)
+	}
+
+	for i := 0; i < opts.Customers; i++ {
+		plan.Customers = append(plan.Customers, newPerson(rng, "customer", i))
+	}
+
+	if opts.Customers == 0 {
+		return plan
+	}
+	today := now.Truncate(24 * time.Hour)
+	for b := range plan.Barbers {
+		for _, start := range bookingSlots(rng, today, opts) {
+			plan.Bookings = append(plan.Bookings, newBooking(rng, plan, b, start, now))
+		}
+	}
+	return plan
+}
+
+// newPerson generates the i'th user of a role
+func newPerson(rng *rand.Rand, role string, i int) Person {
+	first := firstNames[rng.Intn(len(firstNames))]
+	last := lastNames[rng.Intn(len(lastNames))]
+	return Person{
+		Name:  first + " " + last,
+		Email: fmt.Sprintf("%s%d.%s.%s@%s", role, i+1, strings.ToLower(first), strings.ToLower(last), EmailDomain),
+		Phone: fmt.Sprintf("+1-555-%04d", 1000+rng.Intn(9000)),
+		City:  cities[rng.Intn(len(cities))],
+	}
+}
+
+// bookingSlots picks distinct bookable hours for one barber, in order
+func bookingSlots(rng *rand.Rand, today time.Time, opts Options) []time.Time {
+	var candidates []time.Time
+	for d := -opts.PastDays; d <= opts.FutureDays; d++ {
+		if d == 0 {
+			continue
+		}
+		day := today.AddDate(0, 0, d)
+		if !isWorkDay(day.Weekday()) {
+			continue
+		}
+		for _, hour := range bookingHour {
+			candidates = append(candidates, day.Add(time.Duration(hour)*time.Hour))
+		}
+	}
+
+	rng.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	if len(candidates) > opts.BookingsPerBarber {
+		candidates = candidates[:opts.BookingsPerBarber]
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i].Before(candidates[j]) })
+	return candidates
+}
+
+// newBooking generates a booking of barber b starting at start
+func newBooking(rng *rand.Rand, plan *Plan, b int, start, now time.Time) Booking {
+	barber := plan.Barbers[b]
+	booking := Booking{
+		Barber:   b,
+		Customer: rng.Intn(len(plan.Customers)),
+		Service:  rng.Intn(len(barber.Services)),
+		Start:    start,
+	}
+	booking.End = start.Add(time.Duration(barber.Services[booking.Service].Duration) * time.Minute)
+
+	booking.CreatedAt = start.Add(-time.Duration(1+rng.Intn(14*24)) * time.Hour)
+	if booking.CreatedAt.After(now) {
+		booking.CreatedAt = now
+	}
+
+	roll := rng.Intn(100)
+	if start.Before(now) {
+		switch {
+		case roll < 75:
+			booking.Status = config.BookingStatusCompleted
+		case roll < 87:
+			booking.Status = config.BookingStatusCancelledByCustomer
+		case roll < 92:
+			booking.Status = config.BookingStatusCancelledByBarber
+		default:
+			booking.Status = config.BookingStatusNoShow
+		}
+	} else {
+		switch {
+		case roll < 60:
+			booking.Status = config.BookingStatusConfirmed
+		case roll < 90:
+			booking.Status = config.BookingStatusPending
+		default:
+			booking.Status = config.BookingStatusCancelledByCustomer
+		}
+	}
+
+	switch booking.Status {
+	case config.BookingStatusCancelledByCustomer, config.BookingStatusCancelledByBarber:
+		booking.CancellationReason = cancellationReasons[rng.Intn(len(cancellationReasons))]
+	case config.BookingStatusCompleted:
+		if rng.Intn(100) < 60 {
+			booking.Review = newReview(rng)
+		}
+	}
+	return booking
+}
+
+// newReview generates a review, mostly positive
+func newReview(rng *rand.Rand) *Review {
+	roll := rng.Intn(100)
+	rating := 5
+	switch {
+	case roll < 3:
+		rating = 1
+	case roll < 8:
+		rating = 2
+	case roll < 20:
+		rating = 3
+	case roll < 50:
+		rating = 4
+	}
+	text := reviewTexts[rating-1][rng.Intn(len(reviewTexts[rating-1]))]
+	return &Review{
+		Rating:         rating,
+		Title:          text.Title,
+		Comment:        text.Comment,
+		WouldRecommend: rating >= 4,
+	}
+}
+
+// sortedSample returns n distinct indexes below max, in ascending order
+func sortedSample(rng *rand.Rand, max, n int) []int {
+	if n > max {
+		n = max
+	}
+	sample := rng.Perm(max)[:n]
+	sort.Ints(sample)
+	return sample
+}
+
+// isWorkDay reports whether seeded barbers work on day
+func isWorkDay(day time.Weekday) bool {
+	for _, d := range workDays {
+		if d == day {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,360 @@
+// internal/seed/seed.go
+package seed
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/repository"
+
+	"github.com/jmoiron/sqlx"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ========================================================================
+// SEEDER - Writes a plan to the database
+// ========================================================================
+
+// Summary counts what a seed run inserted
+type Summary struct {
+	Categories int
+	Services   int
+	Barbers    int
+	Customers  int
+	Bookings   int
+	Reviews    int
+}
+
+// seeder inserts a plan within one transaction
+type seeder struct {
+	tx           *sqlx.Tx
+	barberRepo   *repository.BarberRepository
+	passwordHash string
+	now          time.Time
+
+	categoryIDs   map[string]int    // By slug
+	categoryNames map[string]string // By slug
+	serviceIDs    map[string]int    // By slug
+	barberIDs     []int
+	barberUsers   []int
+	offerings     [][]int // barber_services IDs per barber, in Barber.Services order
+	customerIDs   []int
+	summary       Summary
+}
+
+// Exists reports whether the database has already been seeded
+func Exists(ctx context.Context, db *sqlx.DB) (bool, error) {
+	var exists bool
+	err := db.GetContext(ctx, &exists, `SELECT EXISTS (SELECT 1 FROM users WHERE email = $1)`, "admin@"+EmailDomain)
+	if err != nil {
+		return false, fmt.Errorf("failed to check for seeded data: %w", err)
+	}
+	return exists, nil
+}
+
+// Reset deletes every seeded user along with their barber profiles,
+// bookings and reviews. Catalog categories and services are kept. Returns
+// the number of users deleted.
+func Reset(ctx context.Context, db *sqlx.DB) (int, error) {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	pattern := "%@" + EmailDomain
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM bookings
+		WHERE barber_id IN (SELECT b.id FROM barbers b JOIN users u ON u.id = b.user_id WHERE u.email LIKE $1)
+		OR customer_id IN (SELECT id FROM users WHERE email LIKE $1)
+	`, pattern)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete seeded bookings: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM users WHERE email LIKE $1`, pattern)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete seeded users: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return int(deleted), nil
+}
+
+// Run inserts the plan in one transaction, then recalculates the listing
+// counters of barbers and barber services
+func Run(ctx context.Context, db *sqlx.DB, plan *Plan, now time.Time) (*Summary, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash seed password: %w", err)
+	}
+
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	s := &seeder{
+		tx:            tx,
+		barberRepo:    repository.NewBarberRepository(db),
+		passwordHash:  string(hash),
+		now:           now.UTC(),
+		categoryIDs:   map[string]int{},
+		categoryNames: map[string]string{},
+		serviceIDs:    map[string]int{},
+	}
+	steps := []func(context.Context, *Plan) error{
+		s.insertCatalog,
+		s.insertUsers,
+		s.insertBarbers,
+		s.insertBookings,
+	}
+	for _, step := range steps {
+		if err := step(ctx, plan); err != nil {
+			return nil, err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	barberRepo := repository.NewBarberRepository(db)
+	if _, err := barberRepo.RefreshAllStats(ctx, now); err != nil {
+		return nil, err
+	}
+	serviceRepo := repository.NewServiceRepository(db)
+	if _, err := serviceRepo.RefreshBarberServiceStats(ctx, now.AddDate(0, 0, -config.StatsWindowDays), now); err != nil {
+		return nil, err
+	}
+	return &s.summary, nil
+}
+
+// insertCatalog adds the categories and services missing from the catalog
+func (s *seeder) insertCatalog(ctx context.Context, plan *Plan) error {
+	for i, c := range plan.Categories {
+		_, err := s.tx.ExecContext(ctx, `
+			INSERT INTO service_categories (name, slug, description, category_path, color_hex, sort_order, is_active, is_featured)
+			VALUES ($1, $2, $3, $2, $4, $5, true, true)
+			ON CONFLICT (slug) DO NOTHING
+		`, c.Name, c.Slug, c.Description, c.Color, i+1)
+		if err != nil {
+			return fmt.Errorf("failed to seed category %s: %w", c.Slug, err)
+		}
+		var id int
+		if err := s.tx.GetContext(ctx, &id, `SELECT id FROM service_categories WHERE slug = $1`, c.Slug); err != nil {
+			return fmt.Errorf("failed to find category %s: %w", c.Slug, err)
+		}
+		s.categoryIDs[c.Slug] = id
+		s.categoryNames[c.Slug] = c.Name
+	}
+	s.summary.Categories = len(plan.Categories)
+
+	for _, svc := range plan.Services {
+		_, err := s.tx.ExecContext(ctx, `
+			INSERT INTO services (
+				name, slug, short_description, category_id, service_type, default_duration_min,
+				suggested_price_min, suggested_price_max, is_active, is_approved
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, true, true)
+			ON CONFLICT (slug) DO NOTHING
+		`, svc.Name, svc.Slug, svc.Description, s.categoryIDs[svc.CategorySlug], svc.ServiceType, svc.Duration,
+			svc.PriceMin, svc.PriceMax)
+		if err != nil {
+			return fmt.Errorf("failed to seed service %s: %w", svc.Slug, err)
+		}
+		var id int
+		if err := s.tx.GetContext(ctx, &id, `SELECT id FROM services WHERE slug = $1`, svc.Slug); err != nil {
+			return fmt.Errorf("failed to find service %s: %w", svc.Slug, err)
+		}
+		s.serviceIDs[svc.Slug] = id
+	}
+	s.summary.Services = len(plan.Services)
+	return nil
+}
+
+// insertUser adds a user and returns its ID
+func (s *seeder) insertUser(ctx context.Context, p Person, userType string) (int, error) {
+	var id int
+	err := s.tx.GetContext(ctx, &id, `
+		INSERT INTO users (
+			email, password_hash, name, phone, user_type, status, email_verified,
+			city, state, country, postal_code, latitude, longitude, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, true, $7, $8, $9, $10, $11, $12, $13, $13)
+		RETURNING id
+	`, p.Email, s.passwordHash, p.Name, p.Phone, userType, config.UserStatusActive,
+		p.City.Name, p.City.State, p.City.Country, p.City.Postal, p.City.Latitude, p.City.Longitude,
+		s.now.AddDate(0, -6, 0))
+	if err != nil {
+		return 0, fmt.Errorf("failed to seed user %s: %w", p.Email, err)
+	}
+	return id, nil
+}
+
+// insertUsers adds the admin and the customers
+func (s *seeder) insertUsers(ctx context.Context, plan *Plan) error {
+	if _, err := s.insertUser(ctx, plan.Admin, config.UserTypeAdmin); err != nil {
+		return err
+	}
+
+	for _, c := range plan.Customers {
+		id, err := s.insertUser(ctx, c, config.UserTypeCustomer)
+		if err != nil {
+			return err
+		}
+		s.customerIDs = append(s.customerIDs, id)
+	}
+	s.summary.Customers = len(plan.Customers)
+	return nil
+}
+
+// insertBarbers adds the barbers with their schedules and services
+func (s *seeder) insertBarbers(ctx context.Context, plan *Plan) error {
+	for i, b := range plan.Barbers {
+		userID, err := s.insertUser(ctx, b.Person, config.UserTypeBarber)
+		if err != nil {
+			return err
+		}
+
+		var barberID int
+		err = s.tx.GetContext(ctx, &barberID, `
+			INSERT INTO barbers (
+				user_id, shop_name, address, city, state, country, postal_code, latitude, longitude,
+				phone, business_email, description, years_experience, status, is_verified,
+				verification_date, commission_rate, auto_accept_bookings
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, true, $15, $16, false)
+			RETURNING id
+		`, userID, b.ShopName, fmt.Sprintf("%d Main St", 100+i*10), b.City.Name, b.City.State, b.City.Country,
+			b.City.Postal, b.City.Latitude, b.City.Longitude, b.Phone, b.Email,
+			fmt.Sprintf("%d years behind the chair.", b.YearsExperience), b.YearsExperience,
+			config.BarberStatusActive, s.now.AddDate(0, -5, 0), b.CommissionRate)
+		if err != nil {
+			return fmt.Errorf("failed to seed barber %s: %w", b.Email, err)
+		}
+
+		if _, err := s.tx.ExecContext(ctx, `INSERT INTO barber_schedules (barber_id, timezone) VALUES ($1, 'UTC')`, barberID); err != nil {
+			return fmt.Errorf("failed to seed schedule of barber %d: %w", barberID, err)
+		}
+		for _, period := range WorkingHours() {
+			_, err := s.tx.ExecContext(ctx, `
+				INSERT INTO barber_schedule_periods (barber_id, day_of_week, start_time, end_time, period_type)
+				VALUES ($1, $2, make_time($3, 0, 0), make_time($4, 0, 0), 'work')
+			`, barberID, period[0], period[1], period[2])
+			if err != nil {
+				return fmt.Errorf("failed to seed schedule of barber %d: %w", barberID, err)
+			}
+		}
+
+		var offerings []int
+		for position, svc := range b.Services {
+			var id int
+			err := s.tx.GetContext(ctx, &id, `
+				INSERT INTO barber_services (barber_id, service_id, price, estimated_duration_min, display_order, is_active)
+				VALUES ($1, $2, $3, $4, $5, true)
+				RETURNING id
+			`, barberID, s.serviceIDs[svc.Slug], svc.Price, svc.Duration, position)
+			if err != nil {
+				return fmt.Errorf("failed to seed service %s of barber %d: %w", svc.Slug, barberID, err)
+			}
+			offerings = append(offerings, id)
+		}
+
+		s.barberIDs = append(s.barberIDs, barberID)
+		s.barberUsers = append(s.barberUsers, userID)
+		s.offerings = append(s.offerings, offerings)
+	}
+	s.summary.Barbers = len(plan.Barbers)
+	return nil
+}
+
+// insertBookings adds the bookings, their line items and reviews, then
+// updates the barbers' ratings
+func (s *seeder) insertBookings(ctx context.Context, plan *Plan) error {
+	for i, b := range plan.Bookings {
+		barber := plan.Barbers[b.Barber]
+		svc := barber.Services[b.Service]
+		customer := plan.Customers[b.Customer]
+		customerID := s.customerIDs[b.Customer]
+		barberServiceID := s.offerings[b.Barber][b.Service]
+
+		var actualStart, actualEnd, paidAt, cancelledAt *time.Time
+		var cancelledBy *int
+		var reason *string
+		paymentStatus := config.PaymentStatusPending
+		switch b.Status {
+		case config.BookingStatusCompleted:
+			actualStart, actualEnd, paidAt = &b.Start, &b.End, &b.End
+			paymentStatus = config.PaymentStatusPaid
+		case config.BookingStatusCancelledByCustomer, config.BookingStatusCancelledByBarber:
+			at := b.CreatedAt.Add(b.Start.Sub(b.CreatedAt) / 2)
+			cancelledAt, reason = &at, &b.CancellationReason
+			cancelledBy = &customerID
+			if b.Status == config.BookingStatusCancelledByBarber {
+				cancelledBy = &s.barberUsers[b.Barber]
+			}
+			paymentStatus = config.PaymentStatusCancelled
+		}
+
+		var bookingID int
+		err := s.tx.GetContext(ctx, &bookingID, `
+			INSERT INTO bookings (
+				booking_number, customer_id, barber_id, barber_service_id, service_name, service_category,
+				estimated_duration_minutes, customer_name, customer_email, customer_phone, status,
+				service_price, total_price, currency, payment_status, paid_at,
+				scheduled_start_time, scheduled_end_time, actual_start_time, actual_end_time,
+				cancelled_at, cancelled_by, cancellation_reason, booking_source, created_at, updated_at
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $12, $13, $14, $15,
+				$16, $17, $18, $19, $20, $21, $22, 'web_app', $23, $23
+			)
+			RETURNING id
+		`, fmt.Sprintf("SD%s%05d", b.Start.Format("20060102"), i+1), customerID, s.barberIDs[b.Barber],
+			barberServiceID, svc.Name, s.categoryNames[svc.CategorySlug], svc.Duration, customer.Name, customer.Email, customer.Phone,
+			b.Status, svc.Price, config.DefaultCurrency, paymentStatus, paidAt,
+			b.Start, b.End, actualStart, actualEnd, cancelledAt, cancelledBy, reason, b.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to seed booking %d: %w", i+1, err)
+		}
+
+		_, err = s.tx.ExecContext(ctx, `
+			INSERT INTO booking_line_items (booking_id, barber_service_id, service_name, duration_minutes, price, position, created_at)
+			VALUES ($1, $2, $3, $4, $5, 0, $6)
+		`, bookingID, barberServiceID, svc.Name, svc.Duration, svc.Price, b.CreatedAt)
+		if err != nil {
+			return fmt.Errorf("failed to seed line item of booking %d: %w", bookingID, err)
+		}
+
+		if b.Review != nil {
+			reviewedAt := b.End.Add(24 * time.Hour)
+			if reviewedAt.After(s.now) {
+				reviewedAt = s.now
+			}
+			_, err := s.tx.ExecContext(ctx, `
+				INSERT INTO reviews (
+					booking_id, customer_id, barber_id, overall_rating, title, comment, would_recommend,
+					would_book_again, is_verified, is_published, moderation_status, created_at, updated_at
+				) VALUES ($1, $2, $3, $4, $5, $6, $7, $7, true, true, $8, $9, $9)
+			`, bookingID, customerID, s.barberIDs[b.Barber], b.Review.Rating, b.Review.Title, b.Review.Comment,
+				b.Review.WouldRecommend, config.ReviewModerationApproved, reviewedAt)
+			if err != nil {
+				return fmt.Errorf("failed to seed review of booking %d: %w", bookingID, err)
+			}
+			s.summary.Reviews++
+		}
+		s.summary.Bookings++
+	}
+
+	for _, barberID := range s.barberIDs {
+		if err := s.barberRepo.UpdateRatingStatsTx(ctx, s.tx, barberID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+// internal/services/add_on_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// ADD-ON SERVICE - Add-on catalogs of barber services
+// ========================================================================
+//
+// Barbers offer add-ons on their services whose service allows them. The
+// active ones are listed publicly and can be chosen with add_on_ids when
+// booking; each adds its duration and price to the appointment.
+// ========================================================================
+
+// AddOnService manages the add-on catalogs of barber services
+type AddOnService struct {
+	repo        *repository.AddOnRepository
+	serviceRepo repository.ServiceStore
+	barberRepo  repository.BarberStore
+}
+
+// NewAddOnService creates a new add-on service
+func NewAddOnService(
+	repo *repository.AddOnRepository,
+	serviceRepo repository.ServiceStore,
+	barberRepo repository.BarberStore,
+) *AddOnService {
+	return &AddOnService{
+		repo:        repo,
+		serviceRepo: serviceRepo,
+		barberRepo:  barberRepo,
+	}
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// AddOnRequest creates or replaces a service add-on
+type AddOnRequest struct {
+	Name            string  `json:"name" binding:"required,max=100" example:"Hot towel"`
+	Description     *string `json:"description" binding:"omitempty,max=500" example:"Hot towel before the shave"`
+	Price           float64 `json:"price" example:"5.00"`
+	DurationMinutes int     `json:"duration_minutes" binding:"omitempty,min=0,max=240" example:"10"`
+	IsActive        *bool   `json:"is_active" example:"true"` // Default: true
+	DisplayOrder    int     `json:"display_order" example:"1"`
+}
+
+// ========================================================================
+// CATALOG
+// ========================================================================
+
+// ListAddOns returns the active add-ons a barber offers on one of their
+// services
+func (s *AddOnService) ListAddOns(ctx context.Context, barberID, barberServiceID int) ([]models.ServiceAddOn, error) {
+	if _, err := s.findBarberService(ctx, barberID, barberServiceID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByBarberServiceID(ctx, barberServiceID, true)
+}
+
+// CreateAddOn adds an add-on to a barber service that allows them
+func (s *AddOnService) CreateAddOn(ctx context.Context, barberID, barberServiceID int, req AddOnRequest, userID int, isAdmin bool) (*models.ServiceAddOn, error) {
+	barberService, err := s.authorize(ctx, barberID, barberServiceID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if !barberService.AllowsAddOns {
+		return nil, fmt.Errorf("service does not allow add-ons")
+	}
+
+	count, err := s.repo.CountByBarberServiceID(ctx, barberServiceID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= config.MaxServiceAddOns {
+		return nil, fmt.Errorf("a service cannot have more than %d add-ons", config.MaxServiceAddOns)
+	}
+
+	addOn := &models.ServiceAddOn{BarberServiceID: barberServiceID}
+	if err := applyAddOnRequest(addOn, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, addOn); err != nil {
+		return nil, err
+	}
+	return addOn, nil
+}
+
+// UpdateAddOn replaces an add-on. Existing bookings keep the price and
+// duration they were made with.
+func (s *AddOnService) UpdateAddOn(ctx context.Context, barberID, barberServiceID, id int, req AddOnRequest, userID int, isAdmin bool) (*models.ServiceAddOn, error) {
+	addOn, err := s.findAddOn(ctx, barberID, barberServiceID, id, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyAddOnRequest(addOn, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, addOn); err != nil {
+		return nil, err
+	}
+	return addOn, nil
+}
+
+// DeleteAddOn removes an add-on from a service's catalog
+func (s *AddOnService) DeleteAddOn(ctx context.Context, barberID, barberServiceID, id int, userID int, isAdmin bool) error {
+	if _, err := s.findAddOn(ctx, barberID, barberServiceID, id, userID, isAdmin); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// findBarberService loads one of the barber's services
+func (s *AddOnService) findBarberService(ctx context.Context, barberID, barberServiceID int) (*models.BarberService, error) {
+	barberService, err := s.serviceRepo.FindBarberServiceByID(ctx, barberServiceID)
+	if err != nil {
+		return nil, err
+	}
+	if barberService.BarberID != barberID {
+		return nil, repository.ErrBarberServiceNotFound
+	}
+	return barberService, nil
+}
+
+// authorize loads one of the barber's services for a user who may manage
+// it: admins may manage any barber's, barbers only their own
+func (s *AddOnService) authorize(ctx context.Context, barberID, barberServiceID, userID int, isAdmin bool) (*models.BarberService, error) {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return nil, repository.ErrNotOwner
+	}
+	return s.findBarberService(ctx, barberID, barberServiceID)
+}
+
+// findAddOn loads one of a barber service's add-ons for a user who may
+// manage them
+func (s *AddOnService) findAddOn(ctx context.Context, barberID, barberServiceID, id int, userID int, isAdmin bool) (*models.ServiceAddOn, error) {
+	if _, err := s.authorize(ctx, barberID, barberServiceID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	addOn, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if addOn.BarberServiceID != barberServiceID {
+		return nil, repository.ErrAddOnNotFound
+	}
+	return addOn, nil
+}
+
+// applyAddOnRequest validates a request and copies it to the add-on
+func applyAddOnRequest(addOn *models.ServiceAddOn, req AddOnRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return fmt.Errorf("name cannot be blank")
+	}
+	if req.Price < 0 {
+		return fmt.Errorf("price cannot be negative")
+	}
+	if req.DurationMinutes < 0 {
+		return fmt.Errorf("duration_minutes cannot be negative")
+	}
+
+	addOn.Name = name
+	addOn.Description = req.Description
+	addOn.Price = req.Price
+	addOn.DurationMinutes = req.DurationMinutes
+	addOn.IsActive = req.IsActive == nil || *req.IsActive
+	addOn.DisplayOrder = req.DisplayOrder
+	return nil
+}
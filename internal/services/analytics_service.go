@@ -0,0 +1,185 @@
+// internal/services/analytics_service.go
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// ANALYTICS SERVICE - Anonymized booking datasets
+// ========================================================================
+
+// ErrDatasetTooSparse is returned when k-anonymity would suppress too many
+// bookings for the dataset to be released
+var ErrDatasetTooSparse = errors.New("too many bookings would be suppressed to release this dataset; widen the period, the price step or the granularity, or lower k")
+
+// AnalyticsService builds anonymized booking datasets for data science
+type AnalyticsService struct {
+	repo  *repository.AnalyticsRepository
+	audit *AuditService
+	clock clock.Clock
+}
+
+// NewAnalyticsService creates a new analytics service
+func NewAnalyticsService(repo *repository.AnalyticsRepository) *AnalyticsService {
+	return &AnalyticsService{
+		repo:  repo,
+		clock: clock.System,
+	}
+}
+
+// SetClock sets the clock default periods are resolved against
+func (s *AnalyticsService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// SetAuditor records dataset releases in the audit log
+func (s *AnalyticsService) SetAuditor(audit *AuditService) {
+	s.audit = audit
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// AnalyticsDatasetQuery selects and shapes a dataset
+type AnalyticsDatasetQuery struct {
+	From        string  `form:"from" example:"2026-01-01"`                                       // Default: 89 days before to
+	To          string  `form:"to" example:"2026-03-31"`                                         // Default: today
+	K           int     `form:"k" example:"10"`                                                  // Default: 10, minimum 5
+	PriceStep   float64 `form:"price_step" example:"10"`                                         // Default: 10
+	Granularity string  `form:"granularity" binding:"omitempty,oneof=week month" example:"week"` // Default: week
+}
+
+// AnalyticsDatasetRelease is a dataset with the period it covers
+type AnalyticsDatasetRelease struct {
+	*models.AnalyticsDataset
+	From time.Time // First day
+	To   time.Time // Last day
+}
+
+// ========================================================================
+// DATASETS
+// ========================================================================
+
+// BuildDataset reads the bookings scheduled in the query's period, reduces
+// them to bucketed attributes and suppresses the rows standing for fewer
+// than k bookings. Returns ErrDatasetTooSparse if more than
+// MaxAnalyticsSuppression of the bookings would be suppressed. The release
+// is recorded in the audit log.
+func (s *AnalyticsService) BuildDataset(ctx context.Context, query AnalyticsDatasetQuery) (*AnalyticsDatasetRelease, error) {
+	from, to, err := resolveDayRange(s.clock.Now(), query.From, query.To,
+		config.DefaultAnalyticsDatasetDays, config.MaxAnalyticsDatasetDays)
+	if err != nil {
+		return nil, err
+	}
+
+	k := query.K
+	if k == 0 {
+		k = config.DefaultAnalyticsK
+	}
+	if k < config.MinAnalyticsK {
+		return nil, fmt.Errorf("k must be at least %d", config.MinAnalyticsK)
+	}
+	priceStep := query.PriceStep
+	if priceStep == 0 {
+		priceStep = config.DefaultAnalyticsPriceStep
+	}
+	if priceStep < 0 {
+		return nil, fmt.Errorf("price_step must be positive")
+	}
+	granularity := query.Granularity
+	if granularity == "" {
+		granularity = config.AnalyticsGranularityWeek
+	}
+
+	records := []models.AnalyticsRecord{}
+	locations := map[string]*time.Location{}
+	afterID := 0
+	for {
+		bookings, err := s.repo.FindDatasetBookings(ctx, from, to.AddDate(0, 0, 1), afterID, config.AnalyticsBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		for i := range bookings {
+			loc := datasetLocation(locations, bookings[i].Timezone)
+			records = append(records, bookings[i].Bucket(loc, granularity, priceStep))
+		}
+		if len(bookings) < config.AnalyticsBatchSize {
+			break
+		}
+		afterID = bookings[len(bookings)-1].ID
+	}
+
+	dataset := models.BuildAnalyticsDataset(records, k)
+	log := logger.FromContext(ctx)
+	if dataset.SuppressionRate() > config.MaxAnalyticsSuppression {
+		log.Info("Analytics dataset refused as too sparse").
+			Int("bookings", dataset.Bookings).
+			Int("suppressed_bookings", dataset.SuppressedBookings).
+			Int("k", k).
+			Send()
+		return nil, ErrDatasetTooSparse
+	}
+
+	s.audit.RecordChange(ctx, config.AuditActionDatasetReleased, config.EntityTypeDataset, nil, nil, nil, models.JSONMap{
+		"from":                from.Format("2006-01-02"),
+		"to":                  to.Format("2006-01-02"),
+		"k":                   k,
+		"price_step":          priceStep,
+		"granularity":         granularity,
+		"rows":                len(dataset.Rows),
+		"bookings":            dataset.Bookings,
+		"suppressed_rows":     dataset.SuppressedRows,
+		"suppressed_bookings": dataset.SuppressedBookings,
+	})
+	log.Info("Analytics dataset released").
+		Int("rows", len(dataset.Rows)).
+		Int("bookings", dataset.Bookings).
+		Int("suppressed_bookings", dataset.SuppressedBookings).
+		Send()
+
+	return &AnalyticsDatasetRelease{AnalyticsDataset: dataset, From: from, To: to}, nil
+}
+
+// datasetLocation loads a barber timezone once per dataset; unknown zones
+// fall back to UTC
+func datasetLocation(cache map[string]*time.Location, name string) *time.Location {
+	if loc, ok := cache[name]; ok {
+		return loc
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		loc = time.UTC
+	}
+	cache[name] = loc
+	return loc
+}
+
+// WriteAnalyticsCSV writes a dataset's rows with the number of bookings
+// each stands for
+func WriteAnalyticsCSV(w io.Writer, dataset *models.AnalyticsDataset) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(append(append([]string{}, models.AnalyticsFields...), "bookings")); err != nil {
+		return err
+	}
+	for _, row := range dataset.Rows {
+		if err := out.Write(append(row.Fields(), strconv.Itoa(row.Bookings))); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
@@ -0,0 +1,323 @@
+// internal/services/api_usage_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// API USAGE SERVICE - Per-consumer traffic analytics and quotas
+// ========================================================================
+//
+// A consumer is an authenticated user (a partner integration signs in as
+// its own account). Every request they make is counted in memory per hour
+// and endpoint, with its status class and latency, and the counters are
+// written out by the api_usage_flush job. Consumers read their own usage;
+// admins see the busiest consumers and set per-consumer quotas, which the
+// rate limiters pick up through QuotaFor.
+// ========================================================================
+
+// APIUsageService records API usage and manages per-consumer quotas
+type APIUsageService struct {
+	repo         *repository.APIUsageRepository
+	userRepo     repository.UserStore
+	clock        clock.Clock
+	defaultLimit int // Requests per minute without a quota
+
+	// Counters not yet flushed
+	mu      sync.Mutex
+	pending map[apiUsageKey]*models.APIUsage
+
+	// Quotas as last loaded, by user ID
+	quotaMu       sync.Mutex
+	quotas        map[int]int
+	quotaLoadedAt time.Time
+
+	audit *AuditService
+}
+
+// apiUsageKey identifies one stored usage row
+type apiUsageKey struct {
+	userID int
+	hour   time.Time
+	method string
+	route  string
+}
+
+// NewAPIUsageService creates an API usage service. defaultLimit is the rate
+// limit (requests per minute) for consumers without a quota.
+func NewAPIUsageService(repo *repository.APIUsageRepository, userRepo repository.UserStore, defaultLimit int) *APIUsageService {
+	return &APIUsageService{
+		repo:         repo,
+		userRepo:     userRepo,
+		clock:        clock.System,
+		defaultLimit: defaultLimit,
+		pending:      make(map[apiUsageKey]*models.APIUsage),
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *APIUsageService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// APIUsageQuery selects a usage date range (UTC days, inclusive)
+type APIUsageQuery struct {
+	From string `form:"from" example:"2024-06-01"` // Default: 6 days before to
+	To   string `form:"to" example:"2024-06-07"`   // Default: today
+}
+
+// SetAPIQuotaRequest sets a consumer's rate limit
+type SetAPIQuotaRequest struct {
+	RequestsPerMinute int     `json:"requests_per_minute" binding:"required,min=1" example:"600"`
+	Note              *string `json:"note" binding:"omitempty,max=500" example:"Partner integration"`
+}
+
+// ========================================================================
+// RECORDING
+// ========================================================================
+
+// Record counts one completed request by an authenticated consumer
+func (s *APIUsageService) Record(userID int, method, route string, status int, latency time.Duration) {
+	key := apiUsageKey{
+		userID: userID,
+		hour:   s.clock.Now().UTC().Truncate(time.Hour),
+		method: method,
+		route:  route,
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	usage, ok := s.pending[key]
+	if !ok {
+		usage = &models.APIUsage{UserID: key.userID, Hour: key.hour, Method: key.method, Route: key.route}
+		s.pending[key] = usage
+	}
+	usage.Add(status, latency)
+}
+
+// Flush writes the buffered counters. On failure they are kept and retried
+// on the next flush.
+func (s *APIUsageService) Flush(ctx context.Context) error {
+	s.mu.Lock()
+	batch := s.pending
+	s.pending = make(map[apiUsageKey]*models.APIUsage)
+	s.mu.Unlock()
+
+	if len(batch) == 0 {
+		return nil
+	}
+
+	usage := make([]models.APIUsage, 0, len(batch))
+	for _, u := range batch {
+		usage = append(usage, *u)
+	}
+
+	if err := s.repo.Record(ctx, usage); err != nil {
+		s.mu.Lock()
+		for key, u := range batch {
+			if current, ok := s.pending[key]; ok {
+				current.Merge(*u)
+			} else {
+				s.pending[key] = u
+			}
+		}
+		s.mu.Unlock()
+		return err
+	}
+
+	logger.FromContext(ctx).Debug("Flushed api usage").
+		Int("rows", len(usage)).
+		Send()
+	return nil
+}
+
+// ========================================================================
+// REPORTS
+// ========================================================================
+
+// GetUsage returns a consumer's traffic over a date range
+func (s *APIUsageService) GetUsage(ctx context.Context, userID int, query APIUsageQuery) (*models.APIUsageReport, error) {
+	from, to, err := s.usageRange(query)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.APIUsageReport{UserID: userID, From: from, To: to}
+	report.RequestsPerMinute, report.CustomQuota = s.QuotaFor(ctx, userID)
+	if !report.CustomQuota {
+		report.RequestsPerMinute = s.defaultLimit
+	}
+
+	// The range is inclusive of the last day
+	end := to.AddDate(0, 0, 1)
+
+	totals, err := s.repo.Totals(ctx, userID, from, end)
+	if err != nil {
+		return nil, err
+	}
+	report.Totals = *totals
+
+	if report.Endpoints, err = s.repo.ByEndpoint(ctx, userID, from, end); err != nil {
+		return nil, err
+	}
+	if report.Daily, err = s.repo.ByDay(ctx, userID, from, end); err != nil {
+		return nil, err
+	}
+
+	report.Finalize()
+	return report, nil
+}
+
+// TopConsumers returns the busiest consumers over a date range
+func (s *APIUsageService) TopConsumers(ctx context.Context, query APIUsageQuery, limit int) ([]models.APIUsageStats, error) {
+	from, to, err := s.usageRange(query)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > config.MaxElevatedPageLimit {
+		limit = config.DefaultPageLimit
+	}
+
+	consumers, err := s.repo.TopConsumers(ctx, from, to.AddDate(0, 0, 1), limit)
+	if err != nil {
+		return nil, err
+	}
+	for i := range consumers {
+		consumers[i].Finalize()
+	}
+	return consumers, nil
+}
+
+// usageRange parses a usage query into whole UTC days
+func (s *APIUsageService) usageRange(query APIUsageQuery) (time.Time, time.Time, error) {
+	to := s.clock.Now().UTC().Truncate(24 * time.Hour)
+	if query.To != "" {
+		parsed, err := time.Parse("2006-01-02", query.To)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("to must be a date (YYYY-MM-DD)")
+		}
+		to = parsed
+	}
+
+	from := to.AddDate(0, 0, -(config.DefaultAPIUsageRangeDays - 1))
+	if query.From != "" {
+		parsed, err := time.Parse("2006-01-02", query.From)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("from must be a date (YYYY-MM-DD)")
+		}
+		from = parsed
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("from must not be after to")
+	}
+	if to.Sub(from) >= config.MaxAPIUsageRangeDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("range cannot exceed %d days", config.MaxAPIUsageRangeDays)
+	}
+	return from, to, nil
+}
+
+// ========================================================================
+// QUOTAS
+// ========================================================================
+
+// QuotaFor returns a consumer's quota in requests per minute, if one is set.
+// Quotas are reloaded at most every config.APIQuotaCacheTTL; if reloading
+// fails the previous quotas stay in effect.
+func (s *APIUsageService) QuotaFor(ctx context.Context, userID int) (int, bool) {
+	s.quotaMu.Lock()
+	defer s.quotaMu.Unlock()
+
+	now := s.clock.Now()
+	if s.quotas == nil || now.Sub(s.quotaLoadedAt) >= config.APIQuotaCacheTTL {
+		quotas, err := s.repo.ListQuotas(ctx)
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to load api quotas").
+				Err(err).
+				Send()
+			if s.quotas == nil {
+				s.quotas = map[int]int{}
+			}
+		} else {
+			s.quotas = make(map[int]int, len(quotas))
+			for _, q := range quotas {
+				s.quotas[q.UserID] = q.RequestsPerMinute
+			}
+		}
+		// Failed loads are retried after the same TTL so an outage
+		// doesn't put a query on every request
+		s.quotaLoadedAt = now
+	}
+
+	limit, ok := s.quotas[userID]
+	return limit, ok
+}
+
+// ListQuotas returns every per-consumer quota
+func (s *APIUsageService) ListQuotas(ctx context.Context) ([]models.APIQuota, error) {
+	return s.repo.ListQuotas(ctx)
+}
+
+// SetAuditor records quota changes in the audit log
+func (s *APIUsageService) SetAuditor(audit *AuditService) {
+	s.audit = audit
+}
+
+// SetQuota sets a consumer's rate limit; it takes effect on this instance
+// immediately and on others within config.APIQuotaCacheTTL
+func (s *APIUsageService) SetQuota(ctx context.Context, userID int, req SetAPIQuotaRequest) (*models.APIQuota, error) {
+	if req.RequestsPerMinute < 1 || req.RequestsPerMinute > config.MaxAPIQuotaPerMinute {
+		return nil, fmt.Errorf("requests_per_minute must be between 1 and %d", config.MaxAPIQuotaPerMinute)
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := &models.APIQuota{
+		UserID:            userID,
+		UserEmail:         &user.Email,
+		RequestsPerMinute: req.RequestsPerMinute,
+		Note:              req.Note,
+	}
+	if err := s.repo.SetQuota(ctx, quota); err != nil {
+		return nil, err
+	}
+
+	s.invalidateQuotas()
+	s.audit.RecordChange(ctx, config.AuditActionAPIQuotaSet, config.EntityTypeUser, &userID, nil, quota, nil)
+	return quota, nil
+}
+
+// DeleteQuota removes a consumer's quota so the default limit applies again
+func (s *APIUsageService) DeleteQuota(ctx context.Context, userID int) error {
+	if err := s.repo.DeleteQuota(ctx, userID); err != nil {
+		return err
+	}
+	s.invalidateQuotas()
+	s.audit.RecordChange(ctx, config.AuditActionAPIQuotaDeleted, config.EntityTypeUser, &userID, nil, nil, nil)
+	return nil
+}
+
+// invalidateQuotas makes the next QuotaFor reload quotas
+func (s *APIUsageService) invalidateQuotas() {
+	s.quotaMu.Lock()
+	s.quotas = nil
+	s.quotaMu.Unlock()
+}
@@ -0,0 +1,236 @@
+// internal/services/audit_service.go
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"barber-booking-system/internal/audit"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// AUDIT SERVICE - Platform audit trail and admin activity feed
+// ========================================================================
+
+// AuditService records and queries platform audit log entries
+type AuditService struct {
+	repo repository.AuditLogStore
+}
+
+// NewAuditService creates a new audit service
+func NewAuditService(repo repository.AuditLogStore) *AuditService {
+	return &AuditService{repo: repo}
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// ActivityFeedResponse is a page of the admin activity feed
+type ActivityFeedResponse struct {
+	Entries []models.AuditLog `json:"entries"`
+	// NextBeforeID is passed back as before_id to fetch the next (older) page; nil when exhausted
+	NextBeforeID *int `json:"next_before_id"`
+}
+
+// redactedMetadataKeys are never returned in the activity feed, even to admins
+var redactedMetadataKeys = []string{"password", "token", "secret", "authorization", "card_number", "cvv"}
+
+// ========================================================================
+// WRITE OPERATIONS
+// ========================================================================
+
+// Record writes an audit log entry. Audit writes are best-effort: failures are
+// logged and returned, and callers are expected to ignore them. Entries
+// without a request ID, actor or client take those of the request being
+// served.
+func (s *AuditService) Record(ctx context.Context, entry *models.AuditLog) error {
+	if entry.RequestID == nil {
+		if requestID := logger.RequestIDFromContext(ctx); requestID != "" {
+			entry.RequestID = &requestID
+		}
+	}
+	fillActor(ctx, entry)
+	if err := s.repo.Create(ctx, entry); err != nil {
+		logger.FromContext(ctx).Error(err).
+			Str("action", entry.Action).
+			Str("entity_type", entry.EntityType).
+			Msg("Failed to record audit log")
+		return err
+	}
+	return nil
+}
+
+// RecordChange records an admin action on an entity together with the
+// fields it changed. before and after are the entity as it was and as it
+// is now (nil for a creation or a deletion). A nil service records nothing,
+// so services can hold an optional auditor.
+func (s *AuditService) RecordChange(ctx context.Context, action, entityType string, entityID *int, before, after interface{}, metadata models.JSONMap) {
+	if s == nil {
+		return
+	}
+	oldValues, newValues := auditChanges(before, after)
+	// Best-effort: Record logs its own failures
+	_ = s.Record(ctx, &models.AuditLog{
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Metadata:   metadata,
+		OldValues:  oldValues,
+		NewValues:  newValues,
+	})
+}
+
+// fillActor completes an entry's actor and client from the request's
+// audit actor; entries recorded outside a request are the system's
+func fillActor(ctx context.Context, entry *models.AuditLog) {
+	actor, ok := audit.ActorFromContext(ctx)
+	if !ok {
+		if entry.ActorID == nil && entry.ActorType == "" {
+			entry.ActorType = config.AuditActorSystem
+		}
+		return
+	}
+	if entry.ActorID == nil && entry.ActorType == "" {
+		actorID := actor.UserID
+		entry.ActorID = &actorID
+		entry.ActorType = actor.UserType
+	}
+	if entry.IPAddress == nil && actor.IPAddress != "" {
+		entry.IPAddress = &actor.IPAddress
+	}
+	if entry.UserAgent == nil && actor.UserAgent != "" {
+		entry.UserAgent = &actor.UserAgent
+	}
+}
+
+// auditChanges returns the fields that differ between before and after,
+// as they were and as they are. Timestamps maintained by the database are
+// left out. With only one side, all of its fields are returned.
+func auditChanges(before, after interface{}) (oldValues, newValues models.JSONMap) {
+	oldValues, newValues = toJSONMap(before), toJSONMap(after)
+	if oldValues == nil || newValues == nil {
+		return oldValues, newValues
+	}
+
+	changedOld, changedNew := models.JSONMap{}, models.JSONMap{}
+	for key, value := range newValues {
+		if key == "created_at" || key == "updated_at" {
+			continue
+		}
+		if previous, ok := oldValues[key]; !ok || !reflect.DeepEqual(previous, value) {
+			changedOld[key] = oldValues[key]
+			changedNew[key] = value
+		}
+	}
+	for key, value := range oldValues {
+		if _, ok := newValues[key]; !ok {
+			changedOld[key] = value
+			changedNew[key] = nil
+		}
+	}
+	return changedOld, changedNew
+}
+
+// toJSONMap converts a value to its JSON fields; nil stays nil
+func toJSONMap(value interface{}) models.JSONMap {
+	if value == nil || reflect.ValueOf(value).Kind() == reflect.Ptr && reflect.ValueOf(value).IsNil() {
+		return nil
+	}
+	if m, ok := value.(models.JSONMap); ok {
+		return m
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return models.JSONMap{"value": fmt.Sprint(value)}
+	}
+	var m models.JSONMap
+	if err := json.Unmarshal(data, &m); err != nil {
+		return models.JSONMap{"value": json.RawMessage(data)}
+	}
+	return m
+}
+
+// ========================================================================
+// READ OPERATIONS
+// ========================================================================
+
+// GetActivityFeed returns recent audit log entries across the platform, newest first.
+// Filtering by actor also matches actions the actor performed while impersonating,
+// so investigations cannot miss activity hidden behind another account.
+func (s *AuditService) GetActivityFeed(ctx context.Context, filters repository.AuditLogFilters) (*ActivityFeedResponse, error) {
+	if filters.Limit <= 0 {
+		filters.Limit = config.DefaultPageLimit
+	}
+	if filters.Limit > config.MaxElevatedPageLimit {
+		filters.Limit = config.MaxElevatedPageLimit
+	}
+
+	entries, err := s.repo.FindAll(ctx, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get activity feed: %w", err)
+	}
+
+	for i := range entries {
+		redactEntry(&entries[i])
+	}
+
+	response := &ActivityFeedResponse{Entries: entries}
+	if len(entries) == filters.Limit {
+		nextID := entries[len(entries)-1].ID
+		response.NextBeforeID = &nextID
+	}
+
+	return response, nil
+}
+
+// GetEntry returns a single audit log entry
+func (s *AuditService) GetEntry(ctx context.Context, id int) (*models.AuditLog, error) {
+	entry, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	redactEntry(entry)
+	return entry, nil
+}
+
+// redactEntry masks sensitive values in an entry's metadata and changes
+func redactEntry(entry *models.AuditLog) {
+	entry.Metadata = redactMetadata(entry.Metadata)
+	if entry.OldValues != nil {
+		entry.OldValues = redactMetadata(entry.OldValues)
+	}
+	if entry.NewValues != nil {
+		entry.NewValues = redactMetadata(entry.NewValues)
+	}
+}
+
+// redactMetadata masks sensitive values in audit metadata
+func redactMetadata(metadata models.JSONMap) models.JSONMap {
+	if metadata == nil {
+		return models.JSONMap{}
+	}
+
+	for key, value := range metadata {
+		lower := strings.ToLower(key)
+		redacted := false
+		for _, sensitive := range redactedMetadataKeys {
+			if strings.Contains(lower, sensitive) {
+				metadata[key] = "[REDACTED]"
+				redacted = true
+				break
+			}
+		}
+		if nested, ok := value.(map[string]interface{}); ok && !redacted {
+			metadata[key] = map[string]interface{}(redactMetadata(models.JSONMap(nested)))
+		}
+	}
+	return metadata
+}
@@ -0,0 +1,132 @@
+// internal/services/auto_no_show_service.go
+package services
+
+import (
+	"context"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// AUTO NO-SHOW SERVICE - Marks confirmed bookings nobody started
+// ========================================================================
+//
+// A confirmed booking that was never moved to in_progress is marked
+// no_show once its grace period after the start time has passed. Barbers
+// set their own grace period (barbers.no_show_grace_minutes); the rest use
+// the platform default. The status change runs the usual no_show hooks
+// (no-show fee capture, barber stats) and both the customer and the barber
+// are told.
+// ========================================================================
+
+// AutoNoShowService marks overdue confirmed bookings as no-shows
+type AutoNoShowService struct {
+	bookingRepo         repository.BookingStore
+	bookingService      *BookingService
+	notificationService *NotificationService
+	clock               clock.Clock
+	config              config.AutoNoShowConfig
+}
+
+// NewAutoNoShowService creates a new auto no-show service. Unset settings
+// fall back to the defaults.
+func NewAutoNoShowService(
+	bookingRepo repository.BookingStore,
+	bookingService *BookingService,
+	notificationService *NotificationService,
+	cfg config.AutoNoShowConfig,
+) *AutoNoShowService {
+	if cfg.GraceMinutes <= 0 {
+		cfg.GraceMinutes = config.DefaultNoShowGraceMinutes
+	}
+	return &AutoNoShowService{
+		bookingRepo:         bookingRepo,
+		bookingService:      bookingService,
+		notificationService: notificationService,
+		clock:               clock.System,
+		config:              cfg,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *AutoNoShowService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// RunScheduled marks every confirmed booking past its grace period as a
+// no-show. A failure for one booking is logged and skipped so the rest are
+// still marked.
+func (s *AutoNoShowService) RunScheduled(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+
+	candidates, err := s.bookingRepo.FindNoShowCandidates(ctx, s.clock.Now(), s.config.GraceMinutes)
+	if err != nil {
+		return err
+	}
+
+	marked := 0
+	for i := range candidates {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := s.mark(ctx, &candidates[i]); err != nil {
+			log.Warn("Failed to mark booking as no-show").
+				Int("booking_id", candidates[i].BookingID).
+				Err(err).
+				Send()
+			continue
+		}
+		marked++
+	}
+
+	if marked > 0 {
+		log.Info("Marked overdue bookings as no-shows").
+			Int("count", marked).
+			Send()
+	}
+	return nil
+}
+
+// mark moves one booking to no_show and tells both parties
+func (s *AutoNoShowService) mark(ctx context.Context, candidate *models.NoShowCandidate) error {
+	booking, err := s.bookingRepo.FindByID(ctx, candidate.BookingID)
+	if err != nil {
+		return err
+	}
+	ctx = bookingContext(ctx, booking)
+
+	if _, err := s.bookingService.UpdateStatus(ctx, booking.ID, config.BookingStatusNoShow, nil); err != nil {
+		return err
+	}
+
+	history := &models.BookingHistory{
+		BookingID:  booking.ID,
+		ChangeType: "auto_no_show",
+		NewValues: models.JSONMap{
+			"grace_minutes": candidate.GraceMinutes,
+			"due_at":        candidate.DueAt(),
+		},
+	}
+	if err := s.bookingRepo.CreateHistory(ctx, history); err != nil {
+		logger.FromContext(ctx).Warn("Failed to create booking history").
+			Int("booking_id", booking.ID).
+			Err(err).
+			Send()
+	}
+
+	// Reload so the notification shows the no-show fee the hooks settled on
+	if updated, err := s.bookingRepo.FindByID(ctx, booking.ID); err == nil {
+		booking = updated
+	}
+	if err := s.notificationService.SendNoShowMarked(ctx, booking, candidate.BarberUserID, candidate.GraceMinutes); err != nil {
+		logger.FromContext(ctx).Warn("Failed to send no-show notification").
+			Int("booking_id", booking.ID).
+			Err(err).
+			Send()
+	}
+	return nil
+}
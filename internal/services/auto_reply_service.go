@@ -0,0 +1,261 @@
+// internal/services/auto_reply_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// AUTO-REPLY SERVICE - Out-of-hours replies to booking requests
+// ========================================================================
+//
+// A customer who requests a booking while the barber is not working (per
+// the barber's schedule) gets the barber's auto-reply as a notification,
+// telling them when the barber is next open. Barbers without a schedule
+// take bookings at any time, so they never auto-reply; neither do barbers
+// booking on a customer's behalf.
+// ========================================================================
+
+// AutoReplyService manages barber auto-replies and sends them
+type AutoReplyService struct {
+	repo          *repository.AutoReplyRepository
+	barberRepo    repository.BarberStore
+	scheduleRepo  repository.ScheduleStore
+	notifications *NotificationService
+	clock         clock.Clock
+}
+
+// NewAutoReplyService creates a new auto-reply service
+func NewAutoReplyService(
+	repo *repository.AutoReplyRepository,
+	barberRepo repository.BarberStore,
+	scheduleRepo repository.ScheduleStore,
+	notifications *NotificationService,
+) *AutoReplyService {
+	return &AutoReplyService{
+		repo:          repo,
+		barberRepo:    barberRepo,
+		scheduleRepo:  scheduleRepo,
+		notifications: notifications,
+		clock:         clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *AutoReplyService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// UpdateAutoReplyRequest configures a barber's auto-reply
+type UpdateAutoReplyRequest struct {
+	Enabled bool    `json:"enabled" example:"true"`
+	Message *string `json:"message" example:"Thanks! I'm away right now and will confirm when I'm back on {{next_open_time}}."` // Default: config.DefaultAutoReplyMessage
+}
+
+// AutoReplyResponse is a barber's auto-reply with a preview of the message
+// a customer requesting a booking now would receive
+type AutoReplyResponse struct {
+	models.BarberAutoReply
+	Variables []string `json:"variables"`      // Placeholders the message may use
+	Preview   string   `json:"preview"`        // Rendered for a sample booking
+	OpenNow   bool     `json:"open_now"`       // Whether the barber is working now (no reply is sent)
+	NextOpen  *string  `json:"next_open_time"` // In the barber's timezone
+}
+
+// ========================================================================
+// SETTINGS
+// ========================================================================
+
+// authorize ensures the user may manage the barber's auto-reply: admins
+// may manage any barber's, barbers only their own
+func (s *AutoReplyService) authorize(ctx context.Context, barberID, userID int, isAdmin bool) (*models.Barber, error) {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return nil, repository.ErrNotOwner
+	}
+	return barber, nil
+}
+
+// GetAutoReply returns a barber's auto-reply (the disabled default when
+// none is set up)
+func (s *AutoReplyService) GetAutoReply(ctx context.Context, barberID, userID int, isAdmin bool) (*AutoReplyResponse, error) {
+	barber, err := s.authorize(ctx, barberID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := s.repo.FindByBarberID(ctx, barberID)
+	if errors.Is(err, repository.ErrAutoReplyNotFound) {
+		reply = &models.BarberAutoReply{BarberID: barberID, Message: config.DefaultAutoReplyMessage}
+	} else if err != nil {
+		return nil, err
+	}
+	return s.toResponse(ctx, barber, reply)
+}
+
+// UpdateAutoReply saves a barber's auto-reply
+func (s *AutoReplyService) UpdateAutoReply(ctx context.Context, barberID int, req UpdateAutoReplyRequest, userID int, isAdmin bool) (*AutoReplyResponse, error) {
+	barber, err := s.authorize(ctx, barberID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &models.BarberAutoReply{
+		BarberID: barberID,
+		Enabled:  req.Enabled,
+		Message:  config.DefaultAutoReplyMessage,
+	}
+	if req.Message != nil {
+		reply.Message = *req.Message
+	}
+	if err := reply.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.Upsert(ctx, reply); err != nil {
+		return nil, err
+	}
+	return s.toResponse(ctx, barber, reply)
+}
+
+// toResponse adds the preview for a booking requested now
+func (s *AutoReplyService) toResponse(ctx context.Context, barber *models.Barber, reply *models.BarberAutoReply) (*AutoReplyResponse, error) {
+	resp := &AutoReplyResponse{
+		BarberAutoReply: *reply,
+		Variables:       config.AutoReplyVariables,
+		OpenNow:         true,
+	}
+
+	schedule, err := s.loadSchedule(ctx, barber.ID)
+	if err != nil {
+		return nil, err
+	}
+	nextOpen := unknownNextOpen
+	if schedule != nil {
+		now := s.clock.Now()
+		next, ok := schedule.NextOpening(now, config.AutoReplyLookaheadDays)
+		resp.OpenNow = ok && next.Equal(now)
+		nextOpen = formatNextOpen(schedule, next, ok)
+		if !resp.OpenNow {
+			resp.NextOpen = &nextOpen
+		}
+	}
+
+	resp.Preview = reply.Render(map[string]string{
+		"barber_name":    barberDisplayName(barber),
+		"customer_name":  "Alex",
+		"service_name":   "Haircut",
+		"booking_number": "BK-EXAMPLE",
+		"next_open_time": nextOpen,
+	})
+	return resp, nil
+}
+
+// ========================================================================
+// SENDING
+// ========================================================================
+
+// BookingCreatedHook sends the barber's auto-reply when a customer requests
+// a booking outside the barber's working hours
+func (s *AutoReplyService) BookingCreatedHook(ctx context.Context, booking *models.Booking, createdByUserID *int) error {
+	if booking.CustomerID == nil || createdByUserID == nil || *createdByUserID != *booking.CustomerID {
+		return nil
+	}
+
+	reply, err := s.repo.FindByBarberID(ctx, booking.BarberID)
+	if errors.Is(err, repository.ErrAutoReplyNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !reply.Enabled {
+		return nil
+	}
+
+	schedule, err := s.loadSchedule(ctx, booking.BarberID)
+	if err != nil || schedule == nil {
+		return err
+	}
+	now := s.clock.Now()
+	next, ok := schedule.NextOpening(now, config.AutoReplyLookaheadDays)
+	if ok && next.Equal(now) {
+		return nil // Working now; the barber will answer
+	}
+
+	barber, err := s.barberRepo.FindByID(ctx, booking.BarberID)
+	if err != nil {
+		return err
+	}
+	barberName := barberDisplayName(barber)
+	customerName, _, _ := booking.GetCustomerInfo()
+	message := reply.Render(map[string]string{
+		"barber_name":    barberName,
+		"customer_name":  customerName,
+		"service_name":   booking.ServiceName,
+		"booking_number": booking.BookingNumber,
+		"next_open_time": formatNextOpen(schedule, next, ok),
+	})
+
+	var nextOpenAt *time.Time
+	if ok {
+		nextOpenAt = &next
+	}
+	if err := s.notifications.SendAutoReply(ctx, booking, barberName, message, nextOpenAt); err != nil {
+		return fmt.Errorf("failed to send auto-reply: %w", err)
+	}
+
+	logger.FromContext(ctx).Info("Auto-reply sent").
+		Int("booking_id", booking.ID).
+		Int("barber_id", booking.BarberID).
+		Send()
+	return nil
+}
+
+// loadSchedule returns the barber's schedule, or nil when they have none
+func (s *AutoReplyService) loadSchedule(ctx context.Context, barberID int) (*models.BarberSchedule, error) {
+	schedule, err := s.scheduleRepo.FindByBarberID(ctx, barberID)
+	if errors.Is(err, repository.ErrBarberScheduleNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load barber schedule: %w", err)
+	}
+	return schedule, nil
+}
+
+// unknownNextOpen stands in for {{next_open_time}} when the barber has no
+// working hours within config.AutoReplyLookaheadDays
+const unknownNextOpen = "their next working day"
+
+// formatNextOpen renders the next opening in the barber's timezone
+func formatNextOpen(schedule *models.BarberSchedule, next time.Time, ok bool) string {
+	if !ok {
+		return unknownNextOpen
+	}
+	return next.In(schedule.Location()).Format(config.AutoReplyTimeFormat)
+}
+
+// barberDisplayName is the barber's own name, falling back to the shop's
+func barberDisplayName(barber *models.Barber) string {
+	if barber.UserName != nil && *barber.UserName != "" {
+		return *barber.UserName
+	}
+	return barber.ShopName
+}
@@ -0,0 +1,133 @@
+// internal/services/backfill_service.go
+package services
+
+import (
+	"context"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BACKFILL SERVICE - Runs the backfills queued by expand migrations
+// ========================================================================
+//
+// Expand migrations queue a backfill (see internal/migrate) instead of
+// updating a whole table in one statement. The worker runs it in small key
+// ranges, each its own short transaction, saving progress after each so a
+// restart resumes where it stopped. Reaching the highest key it knew of,
+// it looks again for rows added since (by the release still writing only
+// the old shape) before completing. A failing batch stops the backfill
+// until `migrate retry` queues it again.
+// ========================================================================
+
+// BackfillService runs migration backfills
+type BackfillService struct {
+	repo      *repository.SchemaBackfillRepository
+	batchSize int
+	lease     time.Duration
+	clock     clock.Clock
+}
+
+// NewBackfillService creates a new backfill service updating batchSize
+// keys per statement
+func NewBackfillService(repo *repository.SchemaBackfillRepository, batchSize int) *BackfillService {
+	if batchSize <= 0 {
+		batchSize = config.DefaultBackfillBatchSize
+	}
+	return &BackfillService{
+		repo:      repo,
+		batchSize: batchSize,
+		lease:     config.DefaultBackfillLease,
+		clock:     clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *BackfillService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// List returns every backfill
+func (s *BackfillService) List(ctx context.Context) ([]models.SchemaBackfill, error) {
+	return s.repo.FindAll(ctx)
+}
+
+// RunPending runs queued backfills one after another until none is left or
+// ctx is cancelled. Stopping between batches loses nothing.
+func (s *BackfillService) RunPending(ctx context.Context) error {
+	for ctx.Err() == nil {
+		backfill, err := s.repo.Claim(ctx, s.clock.Now(), s.lease)
+		if err != nil || backfill == nil {
+			return err
+		}
+		if err := s.run(ctx, backfill); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// run fills a claimed backfill batch by batch
+func (s *BackfillService) run(ctx context.Context, backfill *models.SchemaBackfill) error {
+	log := logger.FromContext(ctx)
+
+	if backfill.MaxKey == nil {
+		maxKey, err := s.repo.MaxKey(ctx, backfill)
+		if err != nil {
+			return s.fail(ctx, backfill, err)
+		}
+		backfill.MaxKey = &maxKey
+		log.Info("Backfill started").Str("backfill", backfill.Name).Int64("max_key", maxKey).Send()
+	}
+
+	for ctx.Err() == nil {
+		from, to, done := backfill.NextBatch(s.batchSize)
+		if done {
+			// Rows may have been added since the max key was read
+			maxKey, err := s.repo.MaxKey(ctx, backfill)
+			if err != nil {
+				return s.fail(ctx, backfill, err)
+			}
+			if maxKey > *backfill.MaxKey {
+				backfill.MaxKey = &maxKey
+				continue
+			}
+
+			if err := s.repo.Complete(ctx, backfill.ID, s.clock.Now()); err != nil {
+				return err
+			}
+			log.Info("Backfill completed").
+				Str("backfill", backfill.Name).
+				Int64("rows_updated", backfill.RowsUpdated).
+				Send()
+			return nil
+		}
+
+		rows, err := s.repo.RunBatch(ctx, backfill, from, to)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil // Aborted on shutdown; the batch is retried next run
+			}
+			return s.fail(ctx, backfill, err)
+		}
+		backfill.NextKey = to
+		backfill.RowsUpdated += rows
+		if err := s.repo.SaveProgress(ctx, backfill, s.clock.Now(), s.lease); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fail stops a backfill until it is retried
+func (s *BackfillService) fail(ctx context.Context, backfill *models.SchemaBackfill, cause error) error {
+	if err := s.repo.Fail(ctx, backfill.ID, cause.Error(), s.clock.Now()); err != nil {
+		return err
+	}
+	return cause
+}
@@ -9,18 +9,30 @@ import (
 	"barber-booking-system/internal/config"
 	"barber-booking-system/internal/logger"
 	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/ranking"
 	"barber-booking-system/internal/repository"
 
 	"github.com/google/uuid"
 )
 
 type BarberService struct {
-	repo  *repository.BarberRepository
+	repo  repository.BarberStore
 	cache *cache.CacheService
+
+	// Counts featured results shown in listings (optional)
+	impressions ImpressionRecorder
+
+	// Orders listings by default (nil = ranking.Default())
+	ranking *ranking.Scorer
+}
+
+// ImpressionRecorder counts featured placements shown in search results
+type ImpressionRecorder interface {
+	RecordImpressions(ctx context.Context, placementIDs []int) error
 }
 
 // NewBarberService creates a new barber service with optional cache
-func NewBarberService(repo *repository.BarberRepository, cache *cache.CacheService) *BarberService {
+func NewBarberService(repo repository.BarberStore, cache *cache.CacheService) *BarberService {
 	return &BarberService{
 		repo:  repo,
 		cache: cache,
@@ -92,6 +104,9 @@ type UpdateBarberRequest struct {
 	CoverImageURL   *string            `json:"cover_image_url,omitempty"`
 	GalleryImages   models.StringArray `json:"gallery_images,omitempty"`
 	WorkingHours    models.JSONMap     `json:"working_hours,omitempty"`
+
+	// Minutes after the start time a never-started booking becomes a no-show
+	NoShowGraceMinutes *int `json:"no_show_grace_minutes,omitempty" binding:"omitempty,min=1,max=240"`
 }
 
 // UpdateBarber is a wrapper that fetches, updates, and saves
@@ -137,6 +152,9 @@ func (s *BarberService) UpdateBarber(ctx context.Context, id int, req UpdateBarb
 	if req.PostalCode != nil {
 		barber.PostalCode = *req.PostalCode
 	}
+	if req.NoShowGraceMinutes != nil {
+		barber.NoShowGraceMinutes = req.NoShowGraceMinutes
+	}
 
 	// Save updates
 	if err := s.repo.Update(ctx, barber); err != nil {
@@ -270,15 +288,117 @@ func (s *BarberService) GetBarberStatistics(ctx context.Context, id int) (*repos
 	return stats, nil
 }
 
+// SetImpressionRecorder enables impression tracking for featured results
+// (nil disables it)
+func (s *BarberService) SetImpressionRecorder(recorder ImpressionRecorder) {
+	s.impressions = recorder
+}
+
 // SearchBarbers searches barbers by various criteria
 func (s *BarberService) SearchBarbers(ctx context.Context, query string, filters repository.BarberFilters) ([]models.Barber, error) {
 	filters.Search = query
-	return s.repo.FindAll(ctx, filters)
+	return s.GetAllBarbers(ctx, filters)
+}
+
+// NearbyBarbersQuery is a search for barbers around a point
+type NearbyBarbersQuery struct {
+	Latitude  *float64 `form:"lat" binding:"required,min=-90,max=90" example:"40.7128"`
+	Longitude *float64 `form:"lng" binding:"required,min=-180,max=180" example:"-74.0060"`
+	RadiusKm  float64  `form:"radius" binding:"omitempty,gt=0" example:"10"` // Default: 10, at most 100
+	Limit     int      `form:"limit" binding:"omitempty,min=1" example:"20"` // Default: 20, at most 50
 }
 
-// GetAllBarbers retrieves all barbers with filters
+// FindNearby retrieves the active barbers within the query's radius,
+// nearest first, each with its distance and most booked services
+func (s *BarberService) FindNearby(ctx context.Context, query NearbyBarbersQuery) ([]models.Barber, error) {
+	radius := query.RadiusKm
+	if radius == 0 {
+		radius = config.DefaultNearbyRadiusKm
+	}
+	if radius > config.MaxNearbyRadiusKm {
+		return nil, fmt.Errorf("radius cannot exceed %.0f km", config.MaxNearbyRadiusKm)
+	}
+	limit := query.Limit
+	if limit == 0 {
+		limit = config.DefaultNearbyLimit
+	}
+	limit = min(limit, config.MaxNearbyLimit)
+
+	barbers, err := s.repo.FindNearby(ctx, *query.Latitude, *query.Longitude, radius, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(barbers) == 0 {
+		return barbers, nil
+	}
+
+	ids := make([]int, len(barbers))
+	for i := range barbers {
+		ids[i] = barbers[i].ID
+	}
+	topServices, err := s.repo.FindTopServices(ctx, ids, config.NearbyTopServices)
+	if err != nil {
+		return nil, err
+	}
+	for i := range barbers {
+		barbers[i].TopServices = topServices[barbers[i].ID]
+	}
+	return barbers, nil
+}
+
+// SetRanking sets the scorer that orders barber listings by default
+func (s *BarberService) SetRanking(scorer *ranking.Scorer) {
+	s.ranking = scorer
+}
+
+// GetAllBarbers retrieves all barbers with filters, ranked by weighted score
+// unless another sort is requested
 func (s *BarberService) GetAllBarbers(ctx context.Context, filters repository.BarberFilters) ([]models.Barber, error) {
-	return s.repo.FindAll(ctx, filters)
+	if filters.Ranking == nil {
+		filters.Ranking = s.ranking
+	}
+	if filters.Ranking == nil {
+		filters.Ranking = ranking.Default()
+	}
+
+	barbers, err := s.repo.FindAll(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	if filters.Explain {
+		for i := range barbers {
+			barbers[i].Ranking = filters.Ranking.Explain(barbers[i].RankFactors)
+		}
+	}
+
+	s.recordImpressions(ctx, barbers)
+	return barbers, nil
+}
+
+// recordImpressions counts the featured barbers in a listing. Tracking
+// failures are logged and never fail the listing.
+func (s *BarberService) recordImpressions(ctx context.Context, barbers []models.Barber) {
+	if s.impressions == nil {
+		return
+	}
+
+	var placementIDs []int
+	for _, barber := range barbers {
+		if barber.FeaturedPlacementID != nil {
+			placementIDs = append(placementIDs, *barber.FeaturedPlacementID)
+		}
+	}
+	if len(placementIDs) == 0 {
+		return
+	}
+
+	if err := s.impressions.RecordImpressions(ctx, placementIDs); err != nil {
+		logger.FromContext(ctx).Warn("Failed to record featured impressions").
+			Int("count", len(placementIDs)).
+			Err(err).
+			Send()
+	}
 }
 
 // CreateBarber creates a new barber (UPDATED to use DTO pattern)
@@ -0,0 +1,137 @@
+// internal/services/booking_access.go
+package services
+
+import (
+	"context"
+	"errors"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BOOKING ACCESS - Who may read and change a booking
+// ========================================================================
+//
+// Customers may access their own bookings and barbers the bookings assigned
+// to them; a barber who books another barber is that booking's customer.
+// Admins (and anyone granted bookings:manage) may access every booking.
+// Guest bookings have no customer account, so only their barber and
+// admins can reach them by ID; guests use the UUID or booking number.
+//
+// Customers may cancel their bookings; every other status change is the
+// barber's (or an override's) to make.
+// ========================================================================
+
+// BookingActor is the authenticated user acting on a booking
+type BookingActor struct {
+	UserID   int
+	Override bool // May access any booking
+}
+
+// AuthorizeBooking loads a booking and checks the actor may access it,
+// returning repository.ErrNotOwner if not
+func (s *BookingService) AuthorizeBooking(ctx context.Context, id int, actor BookingActor) (*models.Booking, error) {
+	booking, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeParty(ctx, booking.CustomerID, booking.BarberID, actor); err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
+
+// AuthorizeBookingSeries loads a recurring series and checks the actor may
+// access it, by the same rules as its bookings: its customer, its barber,
+// or an override. Returns repository.ErrNotOwner if not.
+func (s *BookingService) AuthorizeBookingSeries(ctx context.Context, id int, actor BookingActor) (*models.BookingSeries, error) {
+	series, err := s.seriesRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeParty(ctx, series.CustomerID, series.BarberID, actor); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// authorizeParty checks the actor is the customer or the barber of a
+// booking (or series), or may access any
+func (s *BookingService) authorizeParty(ctx context.Context, customerID *int, barberID int, actor BookingActor) error {
+	if actor.Override {
+		return nil
+	}
+
+	// The customer doesn't need the barber lookup
+	party := models.Booking{CustomerID: customerID}
+	if party.IsAccessibleBy(actor.UserID, 0) {
+		return nil
+	}
+
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil && !errors.Is(err, repository.ErrBarberNotFound) {
+		return err
+	}
+	barberUserID := 0
+	if barber != nil {
+		barberUserID = barber.UserID
+	}
+	if !party.IsAccessibleBy(actor.UserID, barberUserID) {
+		return repository.ErrNotOwner
+	}
+	return nil
+}
+
+// UpdateStatusForActor changes a booking's status on behalf of an actor.
+// Customers may only cancel; confirming, starting, completing and marking
+// no-shows are left to the booking's barber, and completion goes through
+// CompleteBooking.
+func (s *BookingService) UpdateStatusForActor(ctx context.Context, id int, newStatus string, actor BookingActor) (*BookingResponse, error) {
+	if newStatus == config.BookingStatusCompleted {
+		return s.CompleteBooking(ctx, id, CompleteBookingRequest{}, actor)
+	}
+
+	booking, err := s.AuthorizeBooking(ctx, id, actor)
+	if err != nil {
+		return nil, err
+	}
+	cancelling := newStatus == config.BookingStatusCancelled ||
+		newStatus == config.BookingStatusCancelledByCustomer ||
+		newStatus == config.BookingStatusCancelledByBarber
+	if !cancelling {
+		if err := s.authorizeBarber(ctx, booking, actor); err != nil {
+			return nil, err
+		}
+	}
+	return s.changeStatus(ctx, booking, newStatus, &actor.UserID, "", nil)
+}
+
+// authorizeBarber checks the actor is the booking's barber or may act on
+// any booking, returning repository.ErrNotOwner if not
+func (s *BookingService) authorizeBarber(ctx context.Context, booking *models.Booking, actor BookingActor) error {
+	if actor.Override {
+		return nil
+	}
+	barber, err := s.barberRepo.FindByID(ctx, booking.BarberID)
+	if err != nil {
+		return err
+	}
+	if barber.UserID != actor.UserID {
+		return repository.ErrNotOwner
+	}
+	return nil
+}
+
+// GetBookingForActor retrieves a booking the actor may access
+func (s *BookingService) GetBookingForActor(ctx context.Context, id int, actor BookingActor) (*BookingResponse, error) {
+	booking, err := s.AuthorizeBooking(ctx, id, actor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.attachBookingDetails(ctx, booking); err != nil {
+		return nil, err
+	}
+	return s.toBookingResponse(booking), nil
+}
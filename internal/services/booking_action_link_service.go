@@ -0,0 +1,321 @@
+// internal/services/booking_action_link_service.go
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/actionlink"
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BOOKING ACTION LINK SERVICE - One-tap confirm/cancel from reminders
+// ========================================================================
+//
+// Every reminder carries a confirm link and a cancel link. Each is a signed
+// token (see package actionlink) naming the booking, the action and a link
+// ID shared by both. Opening a link (GET) only describes it, so mail and
+// SMS link scanners that prefetch URLs cannot act on the customer's
+// behalf; the action is taken when the link is posted to. The first use
+// closes the link ID, so a reminder's links work once between them.
+//
+// Uses are attributed to the booking's customer and recorded in the
+// booking history and the platform audit log with the client's IP, user
+// agent and request ID.
+// ========================================================================
+
+// BookingActionLinkService issues and answers reminder action links
+type BookingActionLinkService struct {
+	repo           *repository.BookingActionLinkRepository
+	bookingRepo    repository.BookingStore
+	bookingService *BookingService
+	auditService   *AuditService
+	signer         *actionlink.Signer // nil when no secret is configured
+	clock          clock.Clock
+	config         config.ActionLinkConfig
+}
+
+// NewBookingActionLinkService creates a new booking action link service.
+// Without a secret no links are issued and none are accepted.
+func NewBookingActionLinkService(
+	repo *repository.BookingActionLinkRepository,
+	bookingRepo repository.BookingStore,
+	bookingService *BookingService,
+	auditService *AuditService,
+	cfg config.ActionLinkConfig,
+) *BookingActionLinkService {
+	if cfg.TTL <= 0 {
+		cfg.TTL = config.DefaultActionLinkTTL
+	}
+	signer, _ := actionlink.NewSigner(cfg.Secret)
+	return &BookingActionLinkService{
+		repo:           repo,
+		bookingRepo:    bookingRepo,
+		bookingService: bookingService,
+		auditService:   auditService,
+		signer:         signer,
+		clock:          clock.System,
+		config:         cfg,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *BookingActionLinkService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// ActionLinkClient identifies who used a link, for the audit trail
+type ActionLinkClient struct {
+	IPAddress string
+	UserAgent string
+	RequestID string
+}
+
+// ActionLinkPreview describes what a link will do when posted to
+type ActionLinkPreview struct {
+	BookingID          int       `json:"booking_id"`
+	BookingNumber      string    `json:"booking_number"`
+	Action             string    `json:"action"` // confirm, cancel
+	ScheduledStartTime time.Time `json:"scheduled_start_time"`
+	BookingStatus      string    `json:"booking_status"`
+	ExpiresAt          time.Time `json:"expires_at"`
+}
+
+// ActionLinkResult confirms the action a link took
+type ActionLinkResult struct {
+	BookingID     int           `json:"booking_id"`
+	Action        string        `json:"action"`
+	BookingStatus string        `json:"booking_status"`
+	UsedAt        time.Time     `json:"used_at"`
+	Refund        *RefundResult `json:"refund,omitempty"` // Set when cancelling a prepaid booking
+}
+
+// ========================================================================
+// ISSUING
+// ========================================================================
+
+// IssueLinks creates the confirm and cancel links for a booking's reminder.
+// They expire after the configured TTL, or when the appointment starts if
+// that is sooner. Returns nil when links are not configured or the booking
+// cannot be answered by link (guest bookings, past or unconfirmed ones).
+func (s *BookingActionLinkService) IssueLinks(ctx context.Context, booking *models.Booking) (*models.BookingActionURLs, error) {
+	now := s.clock.Now()
+	if s.signer == nil || booking.CustomerID == nil ||
+		booking.Status != config.BookingStatusConfirmed || !booking.ScheduledStartTime.After(now) {
+		return nil, nil
+	}
+
+	expiresAt := now.Add(s.config.TTL)
+	if booking.ScheduledStartTime.Before(expiresAt) {
+		expiresAt = booking.ScheduledStartTime
+	}
+	// Tokens carry whole seconds; store what they carry so both agree
+	expiresAt = expiresAt.Truncate(time.Second)
+
+	linkID, err := newActionLinkID()
+	if err != nil {
+		return nil, err
+	}
+	link := &models.BookingActionLink{
+		ID:         linkID,
+		BookingID:  booking.ID,
+		CustomerID: booking.CustomerID,
+		ExpiresAt:  expiresAt,
+	}
+	if err := s.repo.Create(ctx, link); err != nil {
+		return nil, err
+	}
+
+	return &models.BookingActionURLs{
+		ConfirmURL: s.url(link, config.BookingActionConfirm),
+		CancelURL:  s.url(link, config.BookingActionCancel),
+		ExpiresAt:  expiresAt,
+	}, nil
+}
+
+// url returns the public URL of a link's action
+func (s *BookingActionLinkService) url(link *models.BookingActionLink, action string) string {
+	token := s.signer.Sign(actionlink.Claims{
+		LinkID:    link.ID,
+		BookingID: link.BookingID,
+		Action:    action,
+		ExpiresAt: link.ExpiresAt,
+	})
+	return s.config.BaseURL + config.ActionLinkPath + token
+}
+
+// newActionLinkID returns a random link ID. Hex keeps it free of the
+// separator used in tokens.
+func newActionLinkID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate action link id: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ========================================================================
+// ANSWERING
+// ========================================================================
+
+// Preview describes the action a link takes without taking it
+func (s *BookingActionLinkService) Preview(ctx context.Context, token string) (*ActionLinkPreview, error) {
+	claims, link, booking, err := s.open(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return &ActionLinkPreview{
+		BookingID:          booking.ID,
+		BookingNumber:      booking.BookingNumber,
+		Action:             claims.Action,
+		ScheduledStartTime: booking.ScheduledStartTime,
+		BookingStatus:      booking.Status,
+		ExpiresAt:          link.ExpiresAt,
+	}, nil
+}
+
+// Perform takes the action of a link on behalf of the booking's customer.
+// The use is recorded first so a second tap, or the other link of the same
+// reminder, cannot act again.
+func (s *BookingActionLinkService) Perform(ctx context.Context, token string, client ActionLinkClient) (*ActionLinkResult, error) {
+	claims, link, booking, err := s.open(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if booking.Status != config.BookingStatusConfirmed {
+		return nil, fmt.Errorf("booking must be confirmed to %s it by link (it is %s)", claims.Action, booking.Status)
+	}
+
+	now := s.clock.Now()
+	if err := s.repo.MarkUsed(ctx, link.ID, claims.Action, optionalString(client.IPAddress), optionalString(client.UserAgent), now); err != nil {
+		return nil, err
+	}
+
+	result := &ActionLinkResult{
+		BookingID:     booking.ID,
+		Action:        claims.Action,
+		BookingStatus: booking.Status,
+		UsedAt:        now,
+	}
+
+	if claims.Action == config.BookingActionCancel {
+		cancelled, err := s.bookingService.CancelBooking(ctx, booking.ID, CancelBookingRequest{
+			Reason:       "Cancelled from reminder link",
+			IsByCustomer: true,
+		}, link.CustomerID)
+		if err != nil {
+			return nil, err
+		}
+		result.BookingStatus = cancelled.Status
+		result.Refund = cancelled.Refund
+	} else {
+		s.recordHistory(ctx, booking.ID, link.CustomerID, "attendance_confirmed", models.JSONMap{"action_link_id": link.ID})
+	}
+
+	s.recordUse(ctx, booking, link, claims.Action, client)
+
+	logger.FromContext(ctx).Info("Booking action link used").
+		Int("booking_id", booking.ID).
+		Str("action", claims.Action).
+		Send()
+	return result, nil
+}
+
+// open verifies a token and loads its link and booking
+func (s *BookingActionLinkService) open(ctx context.Context, token string) (actionlink.Claims, *models.BookingActionLink, *models.Booking, error) {
+	if s.signer == nil {
+		return actionlink.Claims{}, nil, nil, actionlink.ErrInvalidToken
+	}
+	claims, err := s.signer.Verify(token, s.clock.Now())
+	if err != nil {
+		return claims, nil, nil, err
+	}
+	if claims.Action != config.BookingActionConfirm && claims.Action != config.BookingActionCancel {
+		return claims, nil, nil, actionlink.ErrInvalidToken
+	}
+
+	link, err := s.repo.FindByID(ctx, claims.LinkID)
+	if err != nil {
+		return claims, nil, nil, err
+	}
+	if link.BookingID != claims.BookingID {
+		return claims, nil, nil, actionlink.ErrInvalidToken
+	}
+	if link.IsUsed() {
+		return claims, nil, nil, repository.ErrActionLinkUsed
+	}
+
+	booking, err := s.bookingRepo.FindByID(ctx, link.BookingID)
+	if err != nil {
+		return claims, nil, nil, err
+	}
+	return claims, link, booking, nil
+}
+
+// recordUse writes the booking history entry and audit log record of a use
+func (s *BookingActionLinkService) recordUse(ctx context.Context, booking *models.Booking, link *models.BookingActionLink, action string, client ActionLinkClient) {
+	details := models.JSONMap{
+		"action_link_id": link.ID,
+		"action":         action,
+		"ip_address":     client.IPAddress,
+		"user_agent":     client.UserAgent,
+	}
+	s.recordHistory(ctx, booking.ID, link.CustomerID, "action_link_used", details)
+
+	if s.auditService == nil {
+		return
+	}
+	auditAction := "booking.confirmed_via_link"
+	if action == config.BookingActionCancel {
+		auditAction = "booking.cancelled_via_link"
+	}
+	bookingID := booking.ID
+	// Best-effort: AuditService.Record logs its own failures
+	_ = s.auditService.Record(ctx, &models.AuditLog{
+		ActorID:    link.CustomerID,
+		ActorType:  "customer",
+		Action:     auditAction,
+		EntityType: config.EntityTypeBooking,
+		EntityID:   &bookingID,
+		Metadata:   models.JSONMap{"action_link_id": link.ID, "booking_number": booking.BookingNumber},
+		IPAddress:  optionalString(client.IPAddress),
+		UserAgent:  optionalString(client.UserAgent),
+		RequestID:  optionalString(client.RequestID),
+	})
+}
+
+// recordHistory writes a booking history entry; failures are only logged
+func (s *BookingActionLinkService) recordHistory(ctx context.Context, bookingID int, changedBy *int, changeType string, values models.JSONMap) {
+	history := &models.BookingHistory{
+		BookingID:  bookingID,
+		ChangedBy:  changedBy,
+		ChangeType: changeType,
+		NewValues:  values,
+	}
+	if err := s.bookingRepo.CreateHistory(ctx, history); err != nil {
+		logger.FromContext(ctx).Warn("Failed to create booking history").
+			Int("booking_id", bookingID).
+			Err(err).
+			Send()
+	}
+}
+
+// optionalString returns nil for an empty string
+func optionalString(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,30 @@
+// internal/services/booking_archive.go
+package services
+
+import (
+	"context"
+
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BOOKING ARCHIVE - Read-through to archived bookings
+// ========================================================================
+
+// SetArchive lets lookups by booking number find archived bookings (nil =
+// only live bookings are found)
+func (s *BookingService) SetArchive(repo *repository.BookingArchiveRepository) {
+	s.archive = repo
+}
+
+// getArchivedBooking retrieves an archived booking as a live lookup would
+// return it, with its line items and tax lines
+func (s *BookingService) getArchivedBooking(ctx context.Context, bookingNumber string) (*BookingResponse, error) {
+	archived, err := s.archive.FindByBookingNumber(ctx, bookingNumber)
+	if err != nil {
+		return nil, err
+	}
+	response := s.toBookingResponse(archived.Restore())
+	response.ArchivedAt = &archived.ArchivedAt
+	return response, nil
+}
@@ -0,0 +1,85 @@
+// internal/services/booking_archive_service.go
+package services
+
+import (
+	"context"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BOOKING ARCHIVE SERVICE - Moves old bookings to cold storage
+// ========================================================================
+//
+// Finished bookings (completed, cancelled or no-show) whose appointment
+// ended more than the configured number of years ago are moved from
+// bookings to bookings_archive, with their history, line items, tax lines
+// and staff. Lookups by booking number fall through to the archive (see
+// BookingService.SetArchive) and listing stats keep counting them through
+// rolled-up counters; listings and reports only cover live bookings.
+// ========================================================================
+
+// archivableBookingStatuses are the statuses a booking never leaves
+var archivableBookingStatuses = []string{
+	config.BookingStatusCompleted,
+	config.BookingStatusCancelled,
+	config.BookingStatusCancelledByCustomer,
+	config.BookingStatusCancelledByBarber,
+	config.BookingStatusNoShow,
+}
+
+// BookingArchiveService archives old bookings
+type BookingArchiveService struct {
+	repo  *repository.BookingArchiveRepository
+	clock clock.Clock
+}
+
+// NewBookingArchiveService creates a new booking archive service
+func NewBookingArchiveService(repo *repository.BookingArchiveRepository) *BookingArchiveService {
+	return &BookingArchiveService{
+		repo:  repo,
+		clock: clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *BookingArchiveService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// Archive moves finished bookings older than afterYears to the archive, in
+// batches, up to BookingArchiveMaxBatches per run. afterYears <= 0 turns
+// archival off. Returns the number of bookings archived.
+func (s *BookingArchiveService) Archive(ctx context.Context, afterYears int) (int, error) {
+	if afterYears <= 0 {
+		return 0, nil
+	}
+	now := s.clock.Now()
+	cutoff := now.AddDate(-afterYears, 0, 0)
+
+	archived := 0
+	for batch := 0; batch < config.BookingArchiveMaxBatches; batch++ {
+		if ctx.Err() != nil {
+			return archived, ctx.Err()
+		}
+		moved, err := s.repo.ArchiveBatch(ctx, archivableBookingStatuses, cutoff, config.BookingArchiveBatchSize, now)
+		if err != nil {
+			return archived, err
+		}
+		archived += moved
+		if moved < config.BookingArchiveBatchSize {
+			break
+		}
+	}
+
+	if archived > 0 {
+		logger.FromContext(ctx).Info("Archived old bookings").
+			Int("count", archived).
+			Time("cutoff", cutoff).
+			Send()
+	}
+	return archived, nil
+}
@@ -0,0 +1,205 @@
+// internal/services/booking_assignment.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+)
+
+// ========================================================================
+// BARBER ASSIGNMENT - "Any available barber" bookings made with a shop
+// ========================================================================
+//
+// A booking request may name a shop instead of a barber. Its services are
+// then catalog services (services.id) rather than a barber's offerings:
+// every active barber of the shop who offers all of them and is free for
+// the slot is a candidate, and the assignment strategy picks one. The
+// booking is then made with that barber's offerings as usual, and the
+// assignment is recorded in the booking's history.
+// ========================================================================
+
+// ErrNoBarberAvailable is returned when none of a shop's barbers can take
+// a booking made without a barber
+var ErrNoBarberAvailable = errors.New("no barber at this shop is available at that time, please choose another time")
+
+// barberAssignment records how a shop booking's barber was picked
+type barberAssignment struct {
+	ShopID     int
+	Strategy   string
+	Load       int // The chosen barber's active bookings that day
+	Candidates int // Barbers who were free for the slot
+}
+
+// SetAssignmentStrategy sets how a barber is picked for bookings made with
+// a shop instead of a barber (empty = config.DefaultAssignmentStrategy)
+func (s *BookingService) SetAssignmentStrategy(strategy string) {
+	s.assignmentStrategy = strategy
+}
+
+// assignBarber picks one of the shop's barbers for a booking made without
+// one and rewrites req to book that barber's offerings of the requested
+// services. Returns ErrNoBarberAvailable when nobody is free.
+func (s *BookingService) assignBarber(ctx context.Context, req *CreateBookingRequest) (*barberAssignment, error) {
+	if req.ShopID == nil {
+		return nil, fmt.Errorf("barber_id or shop_id is required")
+	}
+	if s.shops == nil {
+		return nil, fmt.Errorf("shop bookings are not available")
+	}
+	if req.LocationID != nil {
+		return nil, fmt.Errorf("location_id cannot be used without a barber_id")
+	}
+	if len(req.AddOnIDs) > 0 {
+		return nil, fmt.Errorf("add-ons cannot be booked without a barber_id")
+	}
+	serviceIDs := requestedServiceIDs(req.ServiceID, req.ServiceIDs)
+	if len(serviceIDs) == 0 {
+		return nil, fmt.Errorf("service_id or service_ids must be provided")
+	}
+
+	if _, err := s.shops.FindByID(ctx, *req.ShopID); err != nil {
+		return nil, err
+	}
+	barbers, err := s.shops.FindBarbers(ctx, *req.ShopID)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []models.BarberCandidate
+	for i := range barbers {
+		candidate, err := s.barberCandidate(ctx, &barbers[i], serviceIDs, req)
+		if err != nil {
+			return nil, err
+		}
+		if candidate != nil {
+			candidates = append(candidates, *candidate)
+		}
+	}
+
+	strategy := s.assignmentStrategy
+	if strategy == "" {
+		strategy = config.DefaultAssignmentStrategy
+	}
+	chosen, ok := models.ChooseBarber(strategy, candidates, rand.Intn)
+	if !ok {
+		return nil, ErrNoBarberAvailable
+	}
+
+	req.BarberID = chosen.BarberID
+	req.ServiceID = 0
+	req.ServiceIDs = chosen.ServiceIDs
+	return &barberAssignment{
+		ShopID:     *req.ShopID,
+		Strategy:   strategy,
+		Load:       chosen.Load,
+		Candidates: len(candidates),
+	}, nil
+}
+
+// barberCandidate returns the shop barber as a candidate for the booking,
+// or nil if they are not accepting bookings, do not offer every requested
+// service, or are busy, away or off work during the slot
+func (s *BookingService) barberCandidate(ctx context.Context, barber *models.Barber, serviceIDs []int, req *CreateBookingRequest) (*models.BarberCandidate, error) {
+	if barber.Status != config.BarberStatusActive {
+		return nil, nil
+	}
+
+	offered, err := s.serviceRepo.GetServicesByBarberID(ctx, barber.ID)
+	if err != nil {
+		return nil, err
+	}
+	offerings := make(map[int]int, len(offered))
+	for _, bs := range offered {
+		if bs.IsActive {
+			offerings[bs.ServiceID] = bs.ID
+		}
+	}
+	ids := make([]int, len(serviceIDs))
+	for i, id := range serviceIDs {
+		offeringID, ok := offerings[id]
+		if !ok {
+			return nil, nil
+		}
+		ids[i] = offeringID
+	}
+
+	selection, err := s.resolveServices(ctx, barber.ID, ids, nil)
+	if err != nil {
+		return nil, err
+	}
+	duration := req.DurationMinutes
+	if duration == 0 {
+		duration = selection.TotalMinutes()
+	}
+
+	endTime := s.calculateEndTime(req.StartTime, duration)
+	if err := s.checkBarberSchedule(ctx, barber.ID, req.StartTime, duration); err != nil {
+		logger.FromContext(ctx).Debug("Shop barber unavailable").
+			Int("barber_id", barber.ID).
+			Err(err).
+			Send()
+		return nil, nil
+	}
+	travel, err := s.travelFor(ctx, barber.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTimeSlotAvailability(ctx, barber.ID, req.StartTime, endTime, 0, selection.DurationSegments(duration), travel); err != nil {
+		logger.FromContext(ctx).Debug("Shop barber busy").
+			Int("barber_id", barber.ID).
+			Err(err).
+			Send()
+		return nil, nil
+	}
+
+	load, err := s.dayLoad(ctx, barber.ID, req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	return &models.BarberCandidate{BarberID: barber.ID, Load: load, ServiceIDs: ids}, nil
+}
+
+// dayLoad counts the barber's active bookings on the day of t, in the
+// barber's time zone
+func (s *BookingService) dayLoad(ctx context.Context, barberID int, t time.Time) (int, error) {
+	loc := time.UTC
+	schedule, err := s.loadSchedule(ctx, barberID)
+	if err != nil {
+		return 0, err
+	}
+	if schedule != nil {
+		loc = schedule.Location()
+	}
+
+	local := t.In(loc)
+	dayStart := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	bookings, err := s.repo.FindActiveInRange(ctx, barberID, dayStart, dayStart.AddDate(0, 0, 1))
+	if err != nil {
+		return 0, err
+	}
+	return len(bookings), nil
+}
+
+// recordAssignment adds how the booking's barber was picked to its history
+func (s *BookingService) recordAssignment(ctx context.Context, booking *models.Booking, assignment *barberAssignment, createdByUserID *int) {
+	history := &models.BookingHistory{
+		BookingID:  booking.ID,
+		ChangedBy:  createdByUserID,
+		ChangeType: "barber_assigned",
+		NewValues: models.JSONMap{
+			"barber_id":    booking.BarberID,
+			"shop_id":      assignment.ShopID,
+			"strategy":     assignment.Strategy,
+			"day_bookings": assignment.Load,
+			"candidates":   assignment.Candidates,
+		},
+	}
+	_ = s.repo.CreateHistory(ctx, history) // Best effort, like the created entry
+}
@@ -0,0 +1,180 @@
+// internal/services/booking_availability_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// AVAILABLE SLOTS - Open times for a barber on a given day
+// ========================================================================
+
+// AvailabilityRequest selects the day and appointment length for slot listing
+type AvailabilityRequest struct {
+	Date       string `form:"date" binding:"required" example:"2025-03-10"`             // YYYY-MM-DD in the barber's timezone
+	ServiceID  int    `form:"service_id" example:"3"`                                   // Barber service; sets duration and buffer
+	ServiceIDs []int  `form:"service_ids" example:"3"`                                  // Several barber services back to back, in order
+	AddOnIDs   []int  `form:"add_on_ids" example:"7"`                                   // Add-ons of the services; extend the duration
+	Duration   int    `form:"duration" binding:"omitempty,min=15,max=480" example:"45"` // Minutes; overrides the service duration
+	Interval   int    `form:"interval" binding:"omitempty,min=5,max=240" example:"15"`  // Minutes between candidate start times
+	LocationID *int   `form:"location_id" example:"2"`                                  // Barber location; keeps travel time to bookings elsewhere
+}
+
+// AvailabilityResponse lists the open slots for a barber on one day
+type AvailabilityResponse struct {
+	BarberID        int                `json:"barber_id"`
+	Date            string             `json:"date"`
+	Timezone        string             `json:"timezone"`
+	HasSchedule     bool               `json:"has_schedule"` // false = default business hours
+	DurationMinutes int                `json:"duration_minutes"`
+	BufferMinutes   int                `json:"buffer_minutes"`
+	IntervalMinutes int                `json:"interval_minutes"`
+	WorkingHours    []models.TimeRange `json:"working_hours"`
+	Slots           []models.TimeRange `json:"slots"`
+}
+
+// GetAvailableSlots computes every open slot for a barber on a day by
+// combining working hours (or default business hours for barbers without a
+// schedule), existing bookings, time off, the appointment duration, and the service's
+// buffer time. At one of the barber's locations, slots also leave the travel
+// time to bookings at the others. Slots follow the same booking window as
+// CreateBooking.
+func (s *BookingService) GetAvailableSlots(ctx context.Context, barberID int, req AvailabilityRequest) (*AvailabilityResponse, error) {
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		return nil, fmt.Errorf("date must be in YYYY-MM-DD format")
+	}
+
+	if _, err := s.validateAndFetchBarber(ctx, barberID); err != nil {
+		return nil, err
+	}
+
+	// Duration, buffer and passive segments come from the services and their
+	// add-ons unless the duration is overridden
+	duration := req.Duration
+	buffer := 0
+	var segments models.DurationSegments
+	if ids := requestedServiceIDs(req.ServiceID, req.ServiceIDs); len(ids) > 0 {
+		selection, err := s.resolveServices(ctx, barberID, ids, req.AddOnIDs)
+		if err != nil {
+			return nil, err
+		}
+		if duration == 0 {
+			duration = selection.TotalMinutes()
+		}
+		buffer = selection.BufferMinutes()
+		segments = selection.DurationSegments(duration)
+	}
+	if duration == 0 {
+		duration = config.DefaultBookingDurationMinutes
+	}
+
+	interval := req.Interval
+	if interval == 0 {
+		interval = config.TimeSlotIntervalMinutes
+	}
+
+	travel, err := s.travelFor(ctx, barberID, req.LocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Working windows for the day
+	resp := &AvailabilityResponse{
+		BarberID:        barberID,
+		Date:            req.Date,
+		Timezone:        config.DefaultScheduleTimezone,
+		DurationMinutes: duration,
+		BufferMinutes:   buffer,
+		IntervalMinutes: interval,
+	}
+
+	var windows []models.TimeRange
+	schedule, err := s.loadSchedule(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule != nil {
+		resp.HasSchedule = true
+		resp.Timezone = schedule.Location().String()
+		windows = schedule.WorkingWindows(date)
+	} else {
+		day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+		windows = []models.TimeRange{{
+			Start: day.Add(config.BusinessHoursStart * time.Hour),
+			End:   day.Add(config.BusinessHoursEnd * time.Hour),
+		}}
+	}
+	resp.WorkingHours = windows
+	if resp.WorkingHours == nil {
+		resp.WorkingHours = []models.TimeRange{}
+	}
+
+	// Same window as validateBookingTime: 1 hour notice, 30 days ahead
+	now := s.clock.Now()
+	latest := now.Add(30 * 24 * time.Hour)
+
+	var busy []models.TimeRange
+	if len(windows) > 0 {
+		margin := time.Duration(buffer)*time.Minute + travel.Times.Longest()
+		dayStart := windows[0].Start.Add(-margin)
+		dayEnd := windows[len(windows)-1].End.Add(margin)
+		bookings, err := s.repo.FindActiveInRange(ctx, barberID, dayStart, dayEnd)
+		if err != nil {
+			return nil, err
+		}
+		// Other bookings only keep the barber busy during their active
+		// segments, or all of it plus travel time at another location
+		for i := range bookings {
+			busy = append(busy, travel.Busy(&bookings[i])...)
+		}
+
+		timeOff, err := s.timeOffRanges(ctx, barberID, dayStart, dayEnd)
+		if err != nil {
+			return nil, err
+		}
+		busy = append(busy, timeOff...)
+	}
+
+	slots := models.AvailableSlots(windows, busy, models.SlotOptions{
+		Duration: time.Duration(duration) * time.Minute,
+		Buffer:   time.Duration(buffer) * time.Minute,
+		Interval: time.Duration(interval) * time.Minute,
+		Earliest: now.Add(1 * time.Hour),
+		Segments: segments,
+	})
+
+	resp.Slots = []models.TimeRange{}
+	for _, slot := range slots {
+		if slot.Start.After(latest) {
+			break
+		}
+		resp.Slots = append(resp.Slots, slot)
+	}
+
+	return resp, nil
+}
+
+// loadSchedule returns the barber's schedule, else their shop's opening
+// hours, or nil if neither is defined
+func (s *BookingService) loadSchedule(ctx context.Context, barberID int) (*models.BarberSchedule, error) {
+	if s.scheduleRepo == nil {
+		return s.shopSchedule(ctx, barberID)
+	}
+
+	schedule, err := s.scheduleRepo.FindByBarberID(ctx, barberID)
+	if err != nil {
+		if errors.Is(err, repository.ErrBarberScheduleNotFound) {
+			return s.shopSchedule(ctx, barberID)
+		}
+		return nil, fmt.Errorf("failed to load barber schedule: %w", err)
+	}
+	return schedule, nil
+}
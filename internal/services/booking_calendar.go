@@ -0,0 +1,127 @@
+// internal/services/booking_calendar.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/ical"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BOOKING CALENDAR - Bookings as iCalendar events
+// ========================================================================
+//
+// A booking's event UID is derived from its UUID, so importing the .ics
+// file again (or refetching a feed) updates the event instead of adding
+// a copy. Customers see who they're booked with, barbers who is coming.
+// ========================================================================
+
+// GetBookingCalendar renders a booking the actor may access as an
+// iCalendar file
+func (s *BookingService) GetBookingCalendar(ctx context.Context, id int, actor BookingActor) ([]byte, error) {
+	booking, err := s.AuthorizeBooking(ctx, id, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := repository.BookingWithRelations{Booking: *booking}
+	barber, err := s.barberRepo.FindByID(ctx, booking.BarberID)
+	if err != nil && !errors.Is(err, repository.ErrBarberNotFound) {
+		return nil, err
+	}
+	if barber != nil {
+		entry.BarberName = &barber.ShopName
+		entry.BarberCity = &barber.City
+		entry.BarberAddress = &barber.Address
+	}
+
+	calendar := ical.Calendar{Events: []ical.Event{bookingEvent(&entry, actor.UserID)}}
+	return calendar.Marshal(s.clock.Now()), nil
+}
+
+// bookingEvent converts a booking to the event shown on userID's calendar
+func bookingEvent(b *repository.BookingWithRelations, userID int) ical.Event {
+	asCustomer := b.CustomerID != nil && *b.CustomerID == userID
+
+	var summary string
+	description := []string{"Booking " + b.BookingNumber}
+	if asCustomer {
+		summary = b.ServiceName
+		if shop := stringValue(b.BarberName); shop != "" {
+			summary = fmt.Sprintf("%s at %s", b.ServiceName, shop)
+		}
+		if phone := stringValue(b.BarberPhone); phone != "" {
+			description = append(description, "Phone: "+phone)
+		}
+	} else {
+		summary = fmt.Sprintf("%s with %s", b.ServiceName, calendarCustomerName(b))
+		if phone := stringValue(b.CustomerPhone); phone != "" {
+			description = append(description, "Phone: "+phone)
+		}
+		if requests := stringValue(b.SpecialRequests); requests != "" {
+			description = append(description, "Requests: "+requests)
+		}
+	}
+	if b.IsPending() {
+		description = append(description, "Awaiting confirmation")
+	}
+
+	var location []string
+	for _, part := range []*string{b.BarberName, b.BarberAddress, b.BarberCity} {
+		if value := stringValue(part); value != "" {
+			location = append(location, value)
+		}
+	}
+
+	return ical.Event{
+		UID:         fmt.Sprintf("booking-%s@%s", b.UUID, config.CalendarEventDomain),
+		Start:       b.ScheduledStartTime,
+		End:         b.ScheduledEndTime,
+		Summary:     summary,
+		Description: strings.Join(description, "\n"),
+		Location:    strings.Join(location, ", "),
+		Status:      calendarStatus(b.Status),
+		Created:     b.CreatedAt,
+		Modified:    b.UpdatedAt,
+	}
+}
+
+// calendarCustomerName returns the name of a booking's customer, whether
+// they have an account or booked as a guest
+func calendarCustomerName(b *repository.BookingWithRelations) string {
+	if name := stringValue(b.CustomerName); name != "" {
+		return name
+	}
+	if name := stringValue(b.Booking.CustomerName); name != "" {
+		return name
+	}
+	return "guest"
+}
+
+// calendarStatus maps a booking status to an event status
+func calendarStatus(status string) string {
+	switch status {
+	case config.BookingStatusPending:
+		return ical.StatusTentative
+	case config.BookingStatusCancelled,
+		config.BookingStatusCancelledByCustomer,
+		config.BookingStatusCancelledByBarber,
+		config.BookingStatusNoShow:
+		return ical.StatusCancelled
+	default:
+		return ical.StatusConfirmed
+	}
+}
+
+// stringValue returns *s, or "" for nil
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return strings.TrimSpace(*s)
+}
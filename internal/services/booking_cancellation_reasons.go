@@ -0,0 +1,159 @@
+// internal/services/booking_cancellation_reasons.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/i18n"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// CANCELLATION REASONS - Why bookings are cancelled, by reason code
+// ========================================================================
+//
+// Whoever cancels picks a reason from a short list (sick, schedule
+// conflict, ...) and may add free text. The list is shown in the reader's
+// language (see internal/i18n). Each
+// barber can see their cancellations counted by reason; cancellations
+// without a code count as unspecified.
+// ========================================================================
+
+// ErrInvalidCancellationReason is returned for unknown reason codes
+var ErrInvalidCancellationReason = errors.New("invalid cancellation reason")
+
+// cancellationReasonLabels are the reason labels by code, in English;
+// the i18n catalogs translate them
+var cancellationReasonLabels = map[string]string{
+	config.CancellationReasonSick:               "I'm sick",
+	config.CancellationReasonScheduleConflict:   "Schedule conflict",
+	config.CancellationReasonFoundAnotherBarber: "Found another barber",
+	config.CancellationReasonPrice:              "Price",
+	config.CancellationReasonOther:              "Other",
+	models.CancellationReasonUnspecified:        "No reason given",
+}
+
+// CancellationReason is one entry of the reason list
+type CancellationReason struct {
+	Code  string `json:"code" example:"schedule_conflict"`
+	Label string `json:"label" example:"Schedule conflict"`
+}
+
+// CancellationReasonStatsRequest selects the range of the reason
+// statistics
+type CancellationReasonStatsRequest struct {
+	From   string `form:"from" example:"2025-01-01"` // YYYY-MM-DD (default: 90 days before To)
+	To     string `form:"to" example:"2025-03-31"`   // YYYY-MM-DD inclusive (default: today)
+	Locale string `form:"locale" example:"es"`       // Language of the labels (default: the request's)
+}
+
+// CancellationReasons returns the reason list in a language (e.g. "es" or
+// "pt-BR"); unknown languages get English
+func (s *BookingService) CancellationReasons(locale string) []CancellationReason {
+	locale = matchLocale(locale)
+	reasons := make([]CancellationReason, len(config.CancellationReasonCodes))
+	for i, code := range config.CancellationReasonCodes {
+		reasons[i] = CancellationReason{Code: code, Label: cancellationReasonLabel(code, locale)}
+	}
+	return reasons
+}
+
+// GetCancellationReasonStats counts a barber's cancellations by reason.
+// Only the barber themselves or an admin may view them.
+func (s *BookingService) GetCancellationReasonStats(ctx context.Context, barberID, userID int, isAdmin bool, req CancellationReasonStatsRequest) (*models.CancellationReasonStats, error) {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return nil, repository.ErrNotOwner
+	}
+
+	from, to, err := s.cancellationStatsRange(req)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.repo.CountCancellationReasons(ctx, barberID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := models.BuildCancellationReasonStats(barberID, from, to, counts)
+	locale := i18n.FromContext(ctx)
+	if req.Locale != "" {
+		locale = matchLocale(req.Locale)
+	}
+	for i := range stats.Reasons {
+		stats.Reasons[i].Label = cancellationReasonLabel(stats.Reasons[i].Code, locale)
+	}
+	return stats, nil
+}
+
+// cancellationStatsRange parses the request; the default range is the 90
+// days ending today, in UTC
+func (s *BookingService) cancellationStatsRange(req CancellationReasonStatsRequest) (time.Time, time.Time, error) {
+	now := s.clock.Now().UTC()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	if req.To != "" {
+		parsed, err := time.Parse("2006-01-02", req.To)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("to must be in YYYY-MM-DD format")
+		}
+		to = parsed.AddDate(0, 0, 1)
+	}
+
+	from := to.AddDate(0, 0, -90)
+	if req.From != "" {
+		parsed, err := time.Parse("2006-01-02", req.From)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("from must be in YYYY-MM-DD format")
+		}
+		from = parsed
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("from must be before to")
+	}
+	return from, to, nil
+}
+
+// cancellationReasonCode validates a request's reason code and returns it
+// for storing (nil without one)
+func cancellationReasonCode(req CancelBookingRequest) (*string, error) {
+	code := strings.TrimSpace(req.ReasonCode)
+	if code == "" {
+		return nil, nil
+	}
+	for _, known := range config.CancellationReasonCodes {
+		if code == known {
+			return &code, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: reason_code must be one of %s", ErrInvalidCancellationReason,
+		strings.Join(config.CancellationReasonCodes, ", "))
+}
+
+// cancellationReasonLabel returns a reason's label in a supported language
+func cancellationReasonLabel(code, locale string) string {
+	label, ok := cancellationReasonLabels[code]
+	if !ok {
+		return code
+	}
+	return i18n.Translate(locale, label)
+}
+
+// matchLocale returns the supported language of a locale name, English for
+// unknown ones
+func matchLocale(name string) string {
+	if locale, ok := i18n.Match(name); ok {
+		return locale
+	}
+	return i18n.DefaultLocale
+}
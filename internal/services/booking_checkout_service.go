@@ -0,0 +1,165 @@
+// internal/services/booking_checkout_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/payments"
+	"barber-booking-system/internal/saga"
+)
+
+// ========================================================================
+// BOOKING CHECKOUT - Book and pay in one request (saga)
+// ========================================================================
+//
+// Steps and their compensations:
+//
+//	reserve_slot        create a pending booking   → cancel it (release hold)
+//	authorize_payment   hold funds on the card     → void the authorization
+//	record_payment      store the authorization    → mark payment cancelled
+//	confirm_booking     pending → confirmed
+//	send_notifications  booking confirmation
+//
+// A failure at any step rolls back every completed step in reverse order,
+// so a customer is never charged for a slot they did not get and a slot is
+// never held for a payment that did not go through.
+// ========================================================================
+
+// Checkout saga step names
+const (
+	CheckoutStepReserveSlot       = "reserve_slot"
+	CheckoutStepAuthorizePayment  = "authorize_payment"
+	CheckoutStepRecordPayment     = "record_payment"
+	CheckoutStepConfirmBooking    = "confirm_booking"
+	CheckoutStepSendNotifications = "send_notifications"
+)
+
+// CheckoutService orchestrates paid booking creation
+type CheckoutService struct {
+	bookingService      *BookingService
+	notificationService *NotificationService
+	gateway             payments.Gateway
+}
+
+// NewCheckoutService creates a checkout service; a nil gateway disables
+// checkout (requests fail with payments.ErrNotConfigured)
+func NewCheckoutService(
+	bookingService *BookingService,
+	notificationService *NotificationService,
+	gateway payments.Gateway,
+) *CheckoutService {
+	return &CheckoutService{
+		bookingService:      bookingService,
+		notificationService: notificationService,
+		gateway:             gateway,
+	}
+}
+
+// CheckoutRequest is a booking request paid up front
+type CheckoutRequest struct {
+	CreateBookingRequest
+
+	// Provider token for the customer's card (e.g. a Stripe pm_... id)
+	PaymentMethodID string `json:"payment_method_id" binding:"required"`
+}
+
+// CreateBookingWithPayment reserves the slot, authorizes payment, confirms
+// the booking and notifies the customer, compensating on any failure
+func (s *CheckoutService) CreateBookingWithPayment(ctx context.Context, req CheckoutRequest, createdByUserID *int) (*BookingResponse, error) {
+	log := logger.FromContext(ctx)
+
+	// Sandbox requests pay through the sandbox gateway
+	gateway := gatewayFor(ctx, s.gateway)
+	if gateway == nil {
+		return nil, payments.ErrNotConfigured
+	}
+	if req.Recurrence != nil {
+		return nil, fmt.Errorf("recurring bookings cannot be paid up front")
+	}
+	// The full payment is held, so no separate deposit is taken
+	req.depositCovered = true
+
+	var (
+		booking *BookingResponse
+		auth    *payments.Authorization
+	)
+
+	checkout := saga.New("booking_checkout").
+		Step(CheckoutStepReserveSlot,
+			func(ctx context.Context) error {
+				var err error
+				booking, err = s.bookingService.CreateBooking(ctx, req.CreateBookingRequest, createdByUserID)
+				return err
+			},
+			func(ctx context.Context) error {
+				_, err := s.bookingService.UpdateStatus(ctx, booking.ID, config.BookingStatusCancelled, createdByUserID)
+				return err
+			}).
+		Step(CheckoutStepAuthorizePayment,
+			func(ctx context.Context) error {
+				var err error
+				auth, err = gateway.Authorize(ctx, payments.AuthorizeRequest{
+					Amount:          payments.ToMinorUnits(booking.TotalPrice),
+					Currency:        strings.ToLower(booking.Currency),
+					PaymentMethodID: req.PaymentMethodID,
+					Description:     fmt.Sprintf("Booking %s", booking.BookingNumber),
+					IdempotencyKey:  "booking-" + booking.UUID,
+					Metadata: map[string]string{
+						"booking_id":     fmt.Sprintf("%d", booking.ID),
+						"barber_id":      fmt.Sprintf("%d", booking.BarberID),
+						"booking_number": booking.BookingNumber,
+					},
+				})
+				if err != nil {
+					method := gateway.Name()
+					_ = s.bookingService.RecordPaymentStatus(ctx, booking.Booking, config.PaymentStatusFailed, &method, nil, createdByUserID, nil)
+				}
+				return err
+			},
+			func(ctx context.Context) error {
+				return gateway.Void(ctx, auth.ID)
+			}).
+		Step(CheckoutStepRecordPayment,
+			func(ctx context.Context) error {
+				method := gateway.Name()
+				return s.bookingService.RecordPaymentStatus(ctx, booking.Booking, config.PaymentStatusAuthorized, &method, &auth.ID, createdByUserID, nil)
+			},
+			func(ctx context.Context) error {
+				method := gateway.Name()
+				return s.bookingService.RecordPaymentStatus(ctx, booking.Booking, config.PaymentStatusCancelled, &method, &auth.ID, createdByUserID, nil)
+			}).
+		Step(CheckoutStepConfirmBooking,
+			func(ctx context.Context) error {
+				var err error
+				booking, err = s.bookingService.UpdateStatus(ctx, booking.ID, config.BookingStatusConfirmed, createdByUserID)
+				return err
+			}, nil).
+		Step(CheckoutStepSendNotifications,
+			func(ctx context.Context) error {
+				return s.notificationService.SendBookingConfirmation(ctx, booking.ID)
+			}, nil)
+
+	if err := checkout.Run(ctx); err != nil {
+		var sagaErr *saga.Error
+		if errors.As(err, &sagaErr) && len(sagaErr.CompensationErrors) > 0 {
+			log.Error(err).
+				Str("failed_step", sagaErr.Step).
+				Msg("Booking checkout rollback incomplete - manual review required")
+		}
+		// Callers see the step's own error (slot conflict, card declined, ...)
+		return nil, errors.Unwrap(err)
+	}
+
+	log.Info("Booking checkout completed").
+		Int("booking_id", booking.ID).
+		Str("booking_number", booking.BookingNumber).
+		Str("payment_reference", auth.ID).
+		Send()
+
+	return booking, nil
+}
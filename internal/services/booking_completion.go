@@ -0,0 +1,117 @@
+// internal/services/booking_completion.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// BOOKING COMPLETION - Completing a booking, optionally at a new price
+// ========================================================================
+//
+// The barber can charge a different service price when completing a
+// booking (e.g. extra work was done), within the configured bounds and
+// with a reason. The booking is re-priced with the taxes it was booked
+// with, earnings and commission follow from the new total, and the before
+// and after prices are kept in the booking's history.
+// ========================================================================
+
+// SetPriceAdjustmentPolicy replaces the bounds on completion price changes
+func (s *BookingService) SetPriceAdjustmentPolicy(policy config.PriceAdjustmentConfig) {
+	s.priceAdjustment = policy
+}
+
+// CompleteBookingRequest completes a booking, optionally at a new price
+type CompleteBookingRequest struct {
+	ServicePrice *float64 `json:"service_price" binding:"omitempty,min=0" example:"45"`  // Price of the work done, before discount and tax
+	Reason       string   `json:"reason" binding:"max=500" example:"Added a beard trim"` // Required when service_price changes the price
+}
+
+// CompleteBooking marks a booking completed. Only the booking's barber and
+// actors with override may complete it; a new service_price is saved with
+// the status change.
+func (s *BookingService) CompleteBooking(ctx context.Context, id int, req CompleteBookingRequest, actor BookingActor) (*BookingResponse, error) {
+	booking, err := s.AuthorizeBooking(ctx, id, actor)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeBarber(ctx, booking, actor); err != nil {
+		return nil, err
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if req.ServicePrice == nil || *req.ServicePrice == booking.ServicePrice {
+		return s.changeStatus(ctx, booking, config.BookingStatusCompleted, &actor.UserID, reason, nil)
+	}
+
+	if reason == "" {
+		return nil, fmt.Errorf("reason is required when changing the service price")
+	}
+	if booking.PaymentStatus == config.PaymentStatusPaid {
+		return nil, fmt.Errorf("service price cannot be changed on a booking that is already paid")
+	}
+	// Check the transition first so a refused completion never re-prices
+	if err := s.transitions.Check(ctx, booking, booking.Status, config.BookingStatusCompleted); err != nil {
+		return nil, err
+	}
+	if err := models.CheckPriceAdjustment(booking.ServicePrice, *req.ServicePrice,
+		s.priceAdjustment.MaxIncreasePercent, s.priceAdjustment.MaxDecreasePercent); err != nil {
+		return nil, err
+	}
+
+	if err := s.attachTaxLines(ctx, booking); err != nil {
+		return nil, err
+	}
+	before, oldTotal := priceValues(booking), booking.TotalPrice
+	if err := booking.Reprice(*req.ServicePrice); err != nil {
+		return nil, err
+	}
+	history := &models.BookingHistory{
+		BookingID:    booking.ID,
+		ChangedBy:    &actor.UserID,
+		ChangeType:   "price_adjusted",
+		OldValues:    before,
+		NewValues:    priceValues(booking),
+		ChangeReason: &reason,
+	}
+
+	result, err := s.changeStatus(ctx, booking, config.BookingStatusCompleted, &actor.UserID, reason, func(tx *sqlx.Tx) error {
+		if err := s.repo.UpdatePricingTx(ctx, tx, booking); err != nil {
+			return err
+		}
+		return s.repo.CreateHistoryTx(ctx, tx, history)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Booking price adjusted on completion").
+		Int("booking_id", booking.ID).
+		Float64("old_total", oldTotal).
+		Float64("new_total", booking.TotalPrice).
+		Send()
+	return result, nil
+}
+
+// priceValues is a booking's pricing as recorded in its history
+func priceValues(booking *models.Booking) models.JSONMap {
+	taxLines := make([]models.JSONMap, len(booking.TaxLines))
+	for i, line := range booking.TaxLines {
+		taxLines[i] = models.JSONMap{"name": line.Name, "rate_percent": line.RatePercent, "amount": line.Amount}
+	}
+	return models.JSONMap{
+		"service_price":   booking.ServicePrice,
+		"discount_amount": booking.DiscountAmount,
+		"tax_amount":      booking.TaxAmount,
+		"total_price":     booking.TotalPrice,
+		"tax_lines":       taxLines,
+	}
+}
@@ -0,0 +1,96 @@
+// internal/services/booking_custom_fields.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BOOKING CUSTOM FIELDS - Answers to barbers' booking-form questions
+// ========================================================================
+
+// SetBookingForm lets bookings answer the barbers' custom booking-form
+// fields (nil = custom_fields are rejected and no answers are required)
+func (s *BookingService) SetBookingForm(repo *repository.BookingFormRepository) {
+	s.bookingForms = repo
+}
+
+// SetConsultations sends consultation requests to barbers when answers to
+// a service's booking questions call for one (nil = such bookings are
+// rejected)
+func (s *BookingService) SetConsultations(consultations *ConsultationService) {
+	s.consultations = consultations
+}
+
+// answerBookingForm validates responses against the barber's booking form
+// and the questions on the booked services, and returns the answers to
+// store on the booking with the fields that were asked
+func (s *BookingService) answerBookingForm(ctx context.Context, barberID int, barberServiceIDs []int, responses map[string]interface{}) (models.BookingFormAnswers, []models.BookingFormField, error) {
+	if s.bookingForms == nil {
+		if len(responses) > 0 {
+			return nil, nil, fmt.Errorf("custom_fields cannot be used: booking forms are not enabled")
+		}
+		return nil, nil, nil
+	}
+
+	all, err := s.bookingForms.FindByBarberID(ctx, barberID, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	fields := make([]models.BookingFormField, 0, len(all))
+	for _, field := range all {
+		if field.AppliesTo(barberServiceIDs) {
+			fields = append(fields, field)
+		}
+	}
+
+	answers, err := models.AnswerBookingForm(fields, responses)
+	if err != nil {
+		return nil, nil, err
+	}
+	return answers, fields, nil
+}
+
+// requestConsultation sends the barber a consultation request for a
+// booking whose answers called for one, and returns the
+// ConsultationRequiredError that stands in for the booking
+func (s *BookingService) requestConsultation(ctx context.Context, req CreateBookingRequest, selection models.ServiceSelection, fields []models.BookingFormField, answers models.BookingFormAnswers, triggers []string) error {
+	if s.consultations == nil {
+		return fmt.Errorf("this booking cannot be made online: the answers to %s need a consultation with the barber", strings.Join(triggers, ", "))
+	}
+
+	// The request is for the service of the first question that asked for it
+	request := &models.ConsultationRequest{
+		BarberID:           req.BarberID,
+		ServiceName:        serviceSelectionName(selection),
+		CustomerID:         req.CustomerID,
+		CustomerName:       req.CustomerName,
+		CustomerEmail:      req.CustomerEmail,
+		CustomerPhone:      req.CustomerPhone,
+		RequestedStartTime: req.StartTime,
+		Answers:            answers,
+		TriggeredBy:        triggers,
+		Notes:              req.Notes,
+	}
+	for _, field := range fields {
+		if field.Key != triggers[0] || field.BarberServiceID == nil {
+			continue
+		}
+		for _, selected := range selection {
+			if selected.ID == *field.BarberServiceID {
+				request.BarberServiceID = field.BarberServiceID
+				request.ServiceName = getServiceName(selected.BarberService)
+			}
+		}
+	}
+
+	if err := s.consultations.Create(ctx, request); err != nil {
+		return err
+	}
+	return &ConsultationRequiredError{Request: request}
+}
@@ -0,0 +1,87 @@
+// internal/services/booking_dashboard_service.go
+package services
+
+import (
+	"context"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BARBER DASHBOARD - Live "today" view for the barber's own chair
+// ========================================================================
+//
+// The booking snapshot is read with a single query and cached briefly (and
+// dropped whenever the barber's bookings change); the queue, countdown and
+// running-late state are recomputed from it on every request.
+// ========================================================================
+
+// GetBarberDashboard returns today's dashboard for a barber. Only the barber
+// themselves or an admin may view it.
+func (s *BookingService) GetBarberDashboard(ctx context.Context, barberID, userID int, isAdmin bool) (*models.BarberDashboard, error) {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return nil, repository.ErrNotOwner
+	}
+
+	now := s.clock.Now()
+	snapshot, err := s.dashboardSnapshot(ctx, barberID, now)
+	if err != nil {
+		return nil, err
+	}
+	return models.BuildBarberDashboard(*snapshot, now), nil
+}
+
+// dashboardSnapshot returns the cached booking snapshot for today, loading
+// it when missing or when the barber's day has rolled over
+func (s *BookingService) dashboardSnapshot(ctx context.Context, barberID int, now time.Time) (*models.DashboardSnapshot, error) {
+	if s.cache != nil {
+		var cached models.DashboardSnapshot
+		if err := s.cache.GetBarberDashboard(ctx, barberID, &cached); err == nil {
+			if loc, err := time.LoadLocation(cached.Timezone); err == nil && cached.Date == now.In(loc).Format("2006-01-02") {
+				return &cached, nil
+			}
+		}
+	}
+
+	loc := time.UTC
+	schedule, err := s.loadSchedule(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if schedule != nil {
+		loc = schedule.Location()
+	}
+
+	local := now.In(loc)
+	dayStart := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	pendingUntil := dayStart.AddDate(0, 0, config.DashboardPendingLookaheadDays)
+
+	bookings, err := s.repo.FindDashboardBookings(ctx, barberID, dayStart, dayEnd, now, pendingUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &models.DashboardSnapshot{
+		BarberID: barberID,
+		Date:     dayStart.Format("2006-01-02"),
+		Timezone: loc.String(),
+		Bookings: bookings,
+		LoadedAt: now,
+	}
+
+	if s.cache != nil {
+		if err := s.cache.CacheBarberDashboard(ctx, barberID, snapshot); err != nil {
+			logger.FromContext(ctx).Warn("Failed to cache barber dashboard").Int("barber_id", barberID).Err(err).Send()
+		}
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,152 @@
+// internal/services/booking_deposit.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/payments"
+)
+
+// ========================================================================
+// DEPOSITS AND NO-SHOW FEES
+// ========================================================================
+//
+// Services can require a deposit. CreateBooking holds it on the card given
+// as deposit_payment_method_id once the slot is reserved; if the hold
+// fails, the booking is cancelled so the slot is not kept. When the
+// booking becomes a no-show, the "no_show_fee" status hook captures the
+// no-show fee from the hold and releases the rest. Both are recorded in
+// the booking history as payment status changes.
+// ========================================================================
+
+// bookingDeposit returns the deposit and no-show fee for a new booking.
+// A booking paid up front in full (checkout) holds no separate deposit, but
+// its no-show fee still applies.
+func bookingDeposit(selection models.ServiceSelection, req CreateBookingRequest, totalPrice float64) (deposit, noShowFee float64) {
+	deposit = min(selection.Deposit(), totalPrice)
+	noShowFee = min(selection.NoShowFee(), deposit)
+	if req.depositCovered {
+		return 0, noShowFee
+	}
+	return deposit, noShowFee
+}
+
+// checkDepositPayment ensures a deposit can be taken before the slot is
+// reserved
+func (s *BookingService) checkDepositPayment(ctx context.Context, req CreateBookingRequest, deposit float64) error {
+	if deposit <= 0 {
+		return nil
+	}
+	if gatewayFor(ctx, s.payments) == nil {
+		return payments.ErrNotConfigured
+	}
+	if strings.TrimSpace(req.DepositPaymentMethodID) == "" {
+		return fmt.Errorf("deposit_payment_method_id must be provided: the service requires a deposit of %.2f", deposit)
+	}
+	return nil
+}
+
+// holdDeposit authorizes a new booking's deposit on the customer's card.
+// If the card is declined the booking is cancelled, releasing the slot.
+func (s *BookingService) holdDeposit(ctx context.Context, booking *models.Booking, req CreateBookingRequest, createdByUserID *int) error {
+	if booking.DepositAmount <= 0 {
+		return nil
+	}
+	log := logger.FromContext(ctx)
+	gateway := gatewayFor(ctx, s.payments)
+	method := gateway.Name()
+
+	auth, err := gateway.Authorize(ctx, payments.AuthorizeRequest{
+		Amount:          payments.ToMinorUnits(booking.DepositAmount),
+		Currency:        strings.ToLower(booking.Currency),
+		PaymentMethodID: req.DepositPaymentMethodID,
+		Description:     fmt.Sprintf("Deposit for booking %s", booking.BookingNumber),
+		IdempotencyKey:  "booking-deposit-" + booking.UUID,
+		Metadata: map[string]string{
+			"booking_id":     fmt.Sprintf("%d", booking.ID),
+			"barber_id":      fmt.Sprintf("%d", booking.BarberID),
+			"booking_number": booking.BookingNumber,
+			"charge":         "deposit",
+		},
+	})
+	if err != nil {
+		log.Warn("Deposit authorization failed").
+			Int("booking_id", booking.ID).
+			Float64("deposit_amount", booking.DepositAmount).
+			Err(err).
+			Send()
+		_ = s.RecordPaymentStatus(ctx, booking, config.PaymentStatusFailed, &method, nil, createdByUserID,
+			models.JSONMap{"deposit_amount": booking.DepositAmount})
+		s.releaseDepositSlot(ctx, booking, createdByUserID)
+		return err
+	}
+
+	if err := s.RecordPaymentStatus(ctx, booking, config.PaymentStatusAuthorized, &method, &auth.ID, createdByUserID,
+		models.JSONMap{"deposit_amount": booking.DepositAmount}); err != nil {
+		if voidErr := gateway.Void(ctx, auth.ID); voidErr != nil {
+			log.Error(voidErr).
+				Int("booking_id", booking.ID).
+				Str("payment_reference", auth.ID).
+				Msg("Failed to void unrecorded deposit - manual review required")
+		}
+		s.releaseDepositSlot(ctx, booking, createdByUserID)
+		return err
+	}
+	return nil
+}
+
+// releaseDepositSlot cancels a booking whose deposit could not be held
+func (s *BookingService) releaseDepositSlot(ctx context.Context, booking *models.Booking, changedBy *int) {
+	if _, err := s.UpdateStatus(ctx, booking.ID, config.BookingStatusCancelled, changedBy); err != nil {
+		logger.FromContext(ctx).Error(err).
+			Int("booking_id", booking.ID).
+			Msg("Failed to release slot after deposit failure - manual review required")
+	}
+}
+
+// NoShowFeeHook is the "no_show_fee" status hook: it captures the no-show
+// fee from the booking's held payment and releases the rest. Bookings
+// without a fee, or whose payment is not held, are left alone.
+func (s *BookingService) NoShowFeeHook(ctx context.Context, booking *models.Booking, _, _ string) error {
+	if booking.Status != config.BookingStatusNoShow || booking.PaymentStatus != config.PaymentStatusAuthorized {
+		return nil
+	}
+	if booking.PaymentReference == nil || (booking.NoShowFee <= 0 && booking.DepositAmount <= 0) {
+		return nil
+	}
+
+	gateway := gatewayFor(bookingContext(ctx, booking), s.payments)
+	if gateway == nil {
+		return payments.ErrNotConfigured
+	}
+
+	fee := booking.NoShowFee
+	status := config.PaymentStatusPaid
+	if fee > 0 {
+		// Capturing only the fee releases the rest of the hold
+		if err := gateway.Capture(ctx, *booking.PaymentReference, payments.ToMinorUnits(fee)); err != nil {
+			return fmt.Errorf("failed to capture no-show fee: %w", err)
+		}
+	} else {
+		if err := gateway.Void(ctx, *booking.PaymentReference); err != nil {
+			return fmt.Errorf("failed to release deposit: %w", err)
+		}
+		status = config.PaymentStatusCancelled
+	}
+
+	if err := s.RecordPaymentStatus(ctx, booking, status, booking.PaymentMethod, booking.PaymentReference, nil,
+		models.JSONMap{"no_show_fee": fee, "deposit_amount": booking.DepositAmount}); err != nil {
+		return err
+	}
+
+	logger.FromContext(ctx).Info("No-show fee charged").
+		Int("booking_id", booking.ID).
+		Float64("no_show_fee", fee).
+		Send()
+	return nil
+}
@@ -0,0 +1,95 @@
+// internal/services/booking_events.go
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+// ========================================================================
+// BOOKING DOMAIN EVENTS - Recorded in the outbox with each change
+// ========================================================================
+
+// SetOutbox sets where booking domain events are recorded (nil = none are).
+// Events are written in the same transaction as the booking change and
+// relayed by the OutboxService.
+func (s *BookingService) SetOutbox(outbox *repository.OutboxRepository) {
+	s.outbox = outbox
+}
+
+// recordEvent writes a booking domain event within tx. booking must
+// already reflect the change.
+func (s *BookingService) recordEvent(ctx context.Context, tx *sqlx.Tx, eventType string, booking *models.Booking, previousStatus string, changedBy *int, reason string) error {
+	if s.outbox == nil {
+		return nil
+	}
+
+	payload, err := json.Marshal(models.BookingEvent{
+		Booking:        booking,
+		PreviousStatus: previousStatus,
+		ChangedBy:      changedBy,
+		Reason:         reason,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode booking event: %w", err)
+	}
+
+	return s.outbox.CreateTx(ctx, tx, &models.OutboxEvent{
+		EventID:       uuid.New().String(),
+		EventType:     eventType,
+		AggregateType: config.OutboxAggregateBooking,
+		AggregateID:   booking.ID,
+		Payload:       payload,
+		OccurredAt:    s.clock.Now().UTC(),
+	})
+}
+
+// statusEventType returns the domain event for a booking's move to its
+// current status
+func statusEventType(booking *models.Booking) string {
+	if booking.IsCancelled() || booking.Status == config.BookingStatusCancelled {
+		return config.DomainEventBookingCancelled
+	}
+	return config.DomainEventBookingStatusChanged
+}
+
+// saveStatusWithEvent stores the booking's new status and its domain event
+// in one transaction, along with whatever also writes (may be nil)
+func (s *BookingService) saveStatusWithEvent(ctx context.Context, booking *models.Booking, previousStatus string, changedBy *int, reason string, also func(tx *sqlx.Tx) error) error {
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			tx.Rollback()
+		}
+	}()
+
+	if also != nil {
+		if err := also(tx); err != nil {
+			return err
+		}
+	}
+	if err := s.repo.UpdateStatusTx(ctx, tx, booking.ID, booking.Status); err != nil {
+		return err
+	}
+	if err := s.recordEvent(ctx, tx, statusEventType(booking), booking, previousStatus, changedBy, reason); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	committed = true
+	return nil
+}
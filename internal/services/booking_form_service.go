@@ -0,0 +1,306 @@
+// internal/services/booking_form_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BOOKING FORM SERVICE - Barbers' custom booking-form fields
+// ========================================================================
+//
+// Barbers add their own questions to the booking form. The active fields
+// are listed publicly so booking clients can render them, and answers are
+// passed as custom_fields (key -> value) when booking. The barber sees the
+// answers on the booking.
+//
+// Questions can also be put on one of the barber's services that require
+// a consultation; they are then required, and only asked when that
+// service is booked. Their consultation_answers send the barber a
+// consultation request instead of booking (see ConsultationService).
+// ========================================================================
+
+// formFieldKeyPattern is the shape of a field key: lowercase snake_case
+var formFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// BookingFormService manages barbers' booking forms
+type BookingFormService struct {
+	repo        *repository.BookingFormRepository
+	barberRepo  repository.BarberStore
+	serviceRepo repository.ServiceStore
+}
+
+// NewBookingFormService creates a new booking form service
+func NewBookingFormService(repo *repository.BookingFormRepository, barberRepo repository.BarberStore, serviceRepo repository.ServiceStore) *BookingFormService {
+	return &BookingFormService{
+		repo:        repo,
+		barberRepo:  barberRepo,
+		serviceRepo: serviceRepo,
+	}
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// BookingFormFieldRequest creates or replaces a custom field
+type BookingFormFieldRequest struct {
+	Key          string   `json:"key" binding:"required,max=50" example:"parking_spot"`
+	Label        string   `json:"label" binding:"required,max=100" example:"Parking spot number"`
+	FieldType    string   `json:"field_type" binding:"required" example:"text"` // text, number, select, checkbox
+	HelpText     *string  `json:"help_text" binding:"omitempty,max=300" example:"Find it on the sign in front of your car"`
+	IsRequired   bool     `json:"is_required" example:"false"`
+	Options      []string `json:"options"`                                                    // Select fields: the choices
+	MaxLength    *int     `json:"max_length" binding:"omitempty,min=1" example:"10"`          // Text fields (default 500)
+	MinValue     *float64 `json:"min_value"`                                                  // Number fields
+	MaxValue     *float64 `json:"max_value"`                                                  // Number fields
+	Pattern      *string  `json:"pattern" binding:"omitempty,max=200" example:"[A-Z]?[0-9]+"` // Text fields; the whole answer must match
+	IsActive     *bool    `json:"is_active" example:"true"`                                   // Default: true
+	DisplayOrder int      `json:"display_order" example:"1"`
+
+	// Ask only when this service is booked; it must require a consultation
+	BarberServiceID *int `json:"barber_service_id" example:"12"`
+	// Answers that need a consultation first (select and checkbox
+	// questions on a service; checkboxes answer "true" or "false")
+	ConsultationAnswers []string `json:"consultation_answers" example:"yes"`
+}
+
+// ========================================================================
+// FIELDS
+// ========================================================================
+
+// GetForm returns the active fields of a barber's booking form
+func (s *BookingFormService) GetForm(ctx context.Context, barberID int) ([]models.BookingFormField, error) {
+	if _, err := s.barberRepo.FindByID(ctx, barberID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByBarberID(ctx, barberID, true)
+}
+
+// ListFields returns all of a barber's fields, including inactive ones
+func (s *BookingFormService) ListFields(ctx context.Context, barberID, userID int, isAdmin bool) ([]models.BookingFormField, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByBarberID(ctx, barberID, false)
+}
+
+// CreateField adds a field to a barber's booking form
+func (s *BookingFormService) CreateField(ctx context.Context, barberID int, req BookingFormFieldRequest, userID int, isAdmin bool) (*models.BookingFormField, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountByBarberID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= config.MaxBookingFormFields {
+		return nil, fmt.Errorf("a booking form cannot have more than %d fields", config.MaxBookingFormFields)
+	}
+
+	field := &models.BookingFormField{BarberID: barberID}
+	if err := applyBookingFormFieldRequest(field, req); err != nil {
+		return nil, err
+	}
+	if err := s.checkFieldService(ctx, field); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, field); err != nil {
+		return nil, err
+	}
+	return field, nil
+}
+
+// UpdateField replaces a field. Bookings keep their answers as they were
+// asked.
+func (s *BookingFormService) UpdateField(ctx context.Context, barberID, id int, req BookingFormFieldRequest, userID int, isAdmin bool) (*models.BookingFormField, error) {
+	field, err := s.findField(ctx, barberID, id, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyBookingFormFieldRequest(field, req); err != nil {
+		return nil, err
+	}
+	if err := s.checkFieldService(ctx, field); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, field); err != nil {
+		return nil, err
+	}
+	return field, nil
+}
+
+// DeleteField removes a field from a barber's booking form
+func (s *BookingFormService) DeleteField(ctx context.Context, barberID, id, userID int, isAdmin bool) error {
+	if _, err := s.findField(ctx, barberID, id, userID, isAdmin); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// authorize ensures the user may manage the barber's booking form: admins
+// may manage any barber's, barbers only their own
+func (s *BookingFormService) authorize(ctx context.Context, barberID, userID int, isAdmin bool) error {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return repository.ErrNotOwner
+	}
+	return nil
+}
+
+// checkFieldService ensures a question on a service is on one of the
+// barber's services that require a consultation
+func (s *BookingFormService) checkFieldService(ctx context.Context, field *models.BookingFormField) error {
+	if field.BarberServiceID == nil {
+		return nil
+	}
+	barberService, err := s.serviceRepo.FindBarberServiceByID(ctx, *field.BarberServiceID)
+	if errors.Is(err, repository.ErrBarberServiceNotFound) || (err == nil && barberService.BarberID != field.BarberID) {
+		return fmt.Errorf("barber_service_id must be one of the barber's services")
+	}
+	if err != nil {
+		return err
+	}
+	if !barberService.NeedsConsultation() {
+		return fmt.Errorf("questions can only be put on services that require a consultation")
+	}
+	return nil
+}
+
+// findField loads one of the barber's fields for a user who may manage them
+func (s *BookingFormService) findField(ctx context.Context, barberID, id, userID int, isAdmin bool) (*models.BookingFormField, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	field, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if field.BarberID != barberID {
+		return nil, repository.ErrFormFieldNotFound
+	}
+	return field, nil
+}
+
+// applyBookingFormFieldRequest validates a request and copies it to the
+// field. Validation settings that do not apply to the field's type are
+// dropped.
+func applyBookingFormFieldRequest(field *models.BookingFormField, req BookingFormFieldRequest) error {
+	key := strings.TrimSpace(req.Key)
+	if !formFieldKeyPattern.MatchString(key) {
+		return fmt.Errorf("key must be lowercase letters, digits and underscores, starting with a letter")
+	}
+	label := strings.TrimSpace(req.Label)
+	if label == "" {
+		return fmt.Errorf("label cannot be blank")
+	}
+	if !slices.Contains(config.ValidBookingFormFieldTypes, req.FieldType) {
+		return fmt.Errorf("field_type must be one of: %s", strings.Join(config.ValidBookingFormFieldTypes, ", "))
+	}
+
+	field.Key = key
+	field.Label = label
+	field.FieldType = req.FieldType
+	field.HelpText = req.HelpText
+	field.IsRequired = req.IsRequired
+	field.Options = models.StringArray{}
+	field.MaxLength = nil
+	field.MinValue = nil
+	field.MaxValue = nil
+	field.Pattern = nil
+	field.IsActive = req.IsActive == nil || *req.IsActive
+	field.DisplayOrder = req.DisplayOrder
+	field.BarberServiceID = req.BarberServiceID
+	field.ConsultationAnswers = models.StringArray{}
+
+	// Questions on a service are always asked
+	if field.BarberServiceID != nil {
+		field.IsRequired = true
+	}
+
+	switch req.FieldType {
+	case config.BookingFormFieldText:
+		if req.MaxLength != nil && *req.MaxLength > config.MaxBookingFormMaxLength {
+			return fmt.Errorf("max_length cannot be more than %d", config.MaxBookingFormMaxLength)
+		}
+		field.MaxLength = req.MaxLength
+		if req.Pattern != nil && *req.Pattern != "" {
+			if _, err := regexp.Compile(*req.Pattern); err != nil {
+				return fmt.Errorf("pattern must be a valid regular expression: %v", err)
+			}
+			field.Pattern = req.Pattern
+		}
+
+	case config.BookingFormFieldNumber:
+		if req.MinValue != nil && req.MaxValue != nil && *req.MinValue > *req.MaxValue {
+			return fmt.Errorf("min_value cannot be more than max_value")
+		}
+		field.MinValue = req.MinValue
+		field.MaxValue = req.MaxValue
+
+	case config.BookingFormFieldSelect:
+		for _, option := range req.Options {
+			option = strings.TrimSpace(option)
+			if option == "" {
+				return fmt.Errorf("options cannot be blank")
+			}
+			if slices.Contains(field.Options, option) {
+				return fmt.Errorf("options cannot repeat %q", option)
+			}
+			field.Options = append(field.Options, option)
+		}
+		if len(field.Options) == 0 {
+			return fmt.Errorf("a select field must have options")
+		}
+		if len(field.Options) > config.MaxBookingFormOptions {
+			return fmt.Errorf("a select field cannot have more than %d options", config.MaxBookingFormOptions)
+		}
+	}
+	return applyConsultationAnswers(field, req.ConsultationAnswers)
+}
+
+// applyConsultationAnswers validates and sets the answers to a question on
+// a service that need a consultation: options of a select field, or
+// "true"/"false" for a checkbox
+func applyConsultationAnswers(field *models.BookingFormField, answers []string) error {
+	if len(answers) == 0 {
+		return nil
+	}
+	if field.BarberServiceID == nil {
+		return fmt.Errorf("consultation_answers can only be set on questions on a service (barber_service_id)")
+	}
+
+	for _, answer := range answers {
+		answer = strings.TrimSpace(answer)
+		switch field.FieldType {
+		case config.BookingFormFieldSelect:
+			if !slices.Contains(field.Options, answer) {
+				return fmt.Errorf("consultation_answers must be options of the field: %s", strings.Join(field.Options, ", "))
+			}
+		case config.BookingFormFieldCheckbox:
+			if answer != "true" && answer != "false" {
+				return fmt.Errorf("consultation_answers of a checkbox must be true or false")
+			}
+		default:
+			return fmt.Errorf("consultation_answers can only be set on select and checkbox fields")
+		}
+		if !slices.Contains(field.ConsultationAnswers, answer) {
+			field.ConsultationAnswers = append(field.ConsultationAnswers, answer)
+		}
+	}
+	return nil
+}
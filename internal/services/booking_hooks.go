@@ -0,0 +1,132 @@
+// internal/services/booking_hooks.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/statemachine"
+)
+
+// ========================================================================
+// BOOKING STATUS HOOKS - Named side effects bound to statuses by config
+// ========================================================================
+
+// StatusHook is an action run after a booking enters a status
+type StatusHook = statemachine.Hook[*models.Booking]
+
+// knownStatusHooks are the action names the application understands. A known
+// action with no provider (e.g. no calendar integration configured) is
+// skipped with a warning; an unknown name is a configuration error.
+var knownStatusHooks = map[string]bool{
+	config.StatusHookReviewRequest: true,
+	config.StatusHookBarberStats:   true,
+	config.StatusHookCalendarSync:  true,
+	config.StatusHookNoShowFee:     true,
+	config.StatusHookNPSSurvey:     true,
+	config.StatusHookInventory:     true,
+}
+
+// StatusHookRegistry maps action names to hooks
+type StatusHookRegistry struct {
+	actions map[string]StatusHook
+}
+
+// NewStatusHookRegistry creates an empty registry
+func NewStatusHookRegistry() *StatusHookRegistry {
+	return &StatusHookRegistry{actions: make(map[string]StatusHook)}
+}
+
+// NewDefaultStatusHookRegistry creates a registry with the built-in action:
+// barber stats. Providers for review requests, calendar sync, no-show fees,
+// NPS surveys and inventory register their own actions.
+func NewDefaultStatusHookRegistry(
+	barberRepo repository.BarberStore,
+	cache *cache.CacheService,
+) *StatusHookRegistry {
+	r := NewStatusHookRegistry()
+
+	r.Register(config.StatusHookBarberStats, func(ctx context.Context, booking *models.Booking, _, _ string) error {
+		if err := barberRepo.RefreshBookingStats(ctx, booking.BarberID); err != nil {
+			return err
+		}
+		if cache != nil {
+			_ = cache.InvalidateBarber(ctx, booking.BarberID)
+		}
+		return nil
+	})
+
+	return r
+}
+
+// Register adds or replaces an action
+func (r *StatusHookRegistry) Register(name string, hook StatusHook) {
+	r.actions[name] = hook
+}
+
+// Names returns the registered action names in sorted order
+func (r *StatusHookRegistry) Names() []string {
+	names := make([]string, 0, len(r.actions))
+	for name := range r.actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Bind registers the configured actions (status -> action names) as
+// on-enter hooks on the booking service. Invalid statuses and unknown
+// actions are skipped and reported in the returned error; the remaining
+// bindings still apply.
+func (r *StatusHookRegistry) Bind(s *BookingService, bindings map[string][]string) error {
+	log := logger.Global()
+	sm := models.NewBookingStateMachine()
+
+	statuses := make([]string, 0, len(bindings))
+	for status := range bindings {
+		statuses = append(statuses, status)
+	}
+	sort.Strings(statuses)
+
+	var errs []error
+	for _, status := range statuses {
+		if _, err := sm.GetAllowedTransitions(status); err != nil {
+			errs = append(errs, fmt.Errorf("status hook binding: %w", err))
+			continue
+		}
+
+		for _, name := range bindings[status] {
+			hook, ok := r.actions[name]
+			switch {
+			case ok:
+				s.OnEnterStatus(status, namedHook(name, hook))
+			case knownStatusHooks[name]:
+				log.Warn("Booking status hook has no provider, skipping").
+					Str("status", status).
+					Str("action", name).
+					Send()
+			default:
+				errs = append(errs, fmt.Errorf("status hook binding: unknown action %q for status %s", name, status))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// namedHook prefixes hook errors with the action name
+func namedHook(name string, hook StatusHook) StatusHook {
+	return func(ctx context.Context, booking *models.Booking, from, to string) error {
+		if err := hook(ctx, booking, from, to); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,505 @@
+// internal/services/booking_import_service.go
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/csvimport"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// ========================================================================
+// BOOKING IMPORT SERVICE - A shop's appointment history
+// ========================================================================
+//
+// Barbers moving to the platform upload their past appointments as CSV so
+// visit history, retention and win-back analytics, customer lifetime value
+// and "book again" work from the first day. Columns:
+//
+//	start            date and time (or separate date and time columns)
+//	service          service name; matched to the barber's services by name
+//	duration / end   minutes, or the end time (default: the service's duration)
+//	price, tip       amounts (default price: the service's current price)
+//	status           completed (default), no_show or cancelled
+//	customer_name, customer_email, customer_phone (or name, email, phone)
+//	payment_method, notes, reference
+//
+// Appointments are stored as bookings with booking_source "import". Only
+// past appointments are accepted, and none of the rules for upcoming
+// bookings apply: no working hours, notice, overlap or availability checks,
+// and no status hooks, notifications or payments. Customers are linked to
+// existing accounts by email, then phone; import the client list first so
+// they have one. Each row gets an import reference (the file's reference
+// column, or a digest of the appointment), so re-uploading a file skips
+// what was imported before. Barber and service counters catch up at the
+// next stats aggregation run.
+// ========================================================================
+
+// BookingImportService imports barbers' appointment history
+type BookingImportService struct {
+	bookingRepo repository.BookingStore
+	barberRepo  repository.BarberStore
+	serviceRepo repository.ServiceStore
+	userRepo    repository.UserStore
+	clock       clock.Clock
+}
+
+// NewBookingImportService creates a new booking import service
+func NewBookingImportService(
+	bookingRepo repository.BookingStore,
+	barberRepo repository.BarberStore,
+	serviceRepo repository.ServiceStore,
+	userRepo repository.UserStore,
+) *BookingImportService {
+	return &BookingImportService{
+		bookingRepo: bookingRepo,
+		barberRepo:  barberRepo,
+		serviceRepo: serviceRepo,
+		userRepo:    userRepo,
+		clock:       clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *BookingImportService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// BookingImportOptions controls an import
+type BookingImportOptions struct {
+	// Location reads times without a UTC offset (nil = UTC)
+	Location *time.Location
+}
+
+// BookingImportRowResult says what an import did with one row
+type BookingImportRowResult struct {
+	Line       int    `json:"line"`
+	Status     string `json:"status"` // created, duplicate, failed
+	BookingID  *int   `json:"booking_id,omitempty"`
+	CustomerID *int   `json:"customer_id,omitempty"` // The account the booking was linked to
+	Error      string `json:"error,omitempty"`
+}
+
+// BookingImportResult summarizes an import
+type BookingImportResult struct {
+	Total      int                      `json:"total"`
+	Created    int                      `json:"created"`
+	Linked     int                      `json:"linked"` // Created bookings linked to a customer account
+	Duplicates int                      `json:"duplicates"`
+	Failed     int                      `json:"failed"`
+	Rows       []BookingImportRowResult `json:"rows"`
+}
+
+// appointmentRow is a validated row of a booking history file
+type appointmentRow struct {
+	line          int
+	start, end    time.Time
+	serviceName   string
+	service       *models.BarberService // nil when no service of the barber has the name
+	price         float64
+	tip           float64
+	status        string
+	customerName  *string
+	customerEmail *string
+	customerPhone *string
+	paymentMethod *string
+	notes         *string
+	importRef     string
+}
+
+// ========================================================================
+// IMPORT
+// ========================================================================
+
+// authorize ensures the user may import the barber's history: admins may
+// import any barber's, barbers only their own
+func (s *BookingImportService) authorize(ctx context.Context, barberID, userID int, isAdmin bool) (*models.Barber, error) {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return nil, repository.ErrNotOwner
+	}
+	return barber, nil
+}
+
+// Import adds the past appointments in a CSV file as bookings of a barber
+func (s *BookingImportService) Import(ctx context.Context, barberID int, file io.Reader, opts BookingImportOptions, userID int, isAdmin bool) (*BookingImportResult, error) {
+	barber, err := s.authorize(ctx, barberID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Location == nil {
+		opts.Location = time.UTC
+	}
+
+	services, err := s.barberServicesByName(ctx, barber.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, result, err := readAppointmentRows(file, services, opts.Location, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		if seen[row.importRef] {
+			result.add(BookingImportRowResult{Line: row.line, Status: config.BookingImportDuplicate})
+			continue
+		}
+		seen[row.importRef] = true
+
+		rowResult, err := s.importRow(ctx, barber, row, userID)
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to import booking").
+				Int("barber_id", barberID).
+				Int("line", row.line).
+				Err(err).
+				Send()
+			rowResult = BookingImportRowResult{Line: row.line, Status: config.BookingImportFailed, Error: err.Error()}
+		}
+		result.add(rowResult)
+	}
+	sort.SliceStable(result.Rows, func(i, j int) bool { return result.Rows[i].Line < result.Rows[j].Line })
+
+	logger.FromContext(ctx).Info("Bookings imported").
+		Int("barber_id", barberID).
+		Int("total", result.Total).
+		Int("created", result.Created).
+		Int("linked", result.Linked).
+		Int("duplicates", result.Duplicates).
+		Int("failed", result.Failed).
+		Send()
+	return result, nil
+}
+
+// barberServicesByName indexes a barber's services, active or not, by
+// lower-case name (the barber's own name for it, and the catalog name)
+func (s *BookingImportService) barberServicesByName(ctx context.Context, barberID int) (map[string]*models.BarberService, error) {
+	list, err := s.serviceRepo.FindBarberServices(ctx, repository.BarberServiceFilters{
+		BarberID: barberID,
+		Limit:    1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*models.BarberService, len(list))
+	for i := range list {
+		bs := &list[i]
+		for _, name := range []*string{bs.CustomName, bs.ServiceName} {
+			if name == nil || *name == "" {
+				continue
+			}
+			key := strings.ToLower(strings.TrimSpace(*name))
+			if _, taken := byName[key]; !taken {
+				byName[key] = bs
+			}
+		}
+	}
+	return byName, nil
+}
+
+// readAppointmentRows reads and validates a whole booking history file.
+// Invalid rows are returned as failed results; malformed CSV fails the
+// import.
+func readAppointmentRows(file io.Reader, services map[string]*models.BarberService, loc *time.Location, now time.Time) ([]appointmentRow, *BookingImportResult, error) {
+	reader, err := csvimport.NewReader(file, "service")
+	if err != nil {
+		return nil, nil, err
+	}
+	if !reader.Has("start") && !(reader.Has("date") && reader.Has("time")) {
+		return nil, nil, fmt.Errorf("csv file must have a start column, or date and time columns")
+	}
+
+	result := &BookingImportResult{Rows: []BookingImportRowResult{}}
+	var rows []appointmentRow
+	for count := 0; ; count++ {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if count == config.MaxBookingImportRows {
+			return nil, nil, fmt.Errorf("csv file cannot have more than %d appointments; split it into several files", config.MaxBookingImportRows)
+		}
+
+		row, err := parseAppointmentRow(record, services, loc, now)
+		if err != nil {
+			result.add(BookingImportRowResult{Line: record.Line, Status: config.BookingImportFailed, Error: err.Error()})
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, result, nil
+}
+
+// parseAppointmentRow validates one row of a booking history file
+func parseAppointmentRow(record *csvimport.Row, services map[string]*models.BarberService, loc *time.Location, now time.Time) (appointmentRow, error) {
+	row := appointmentRow{line: record.Line}
+	var err error
+
+	// When
+	startValue := record.Get("start")
+	if startValue == "" {
+		startValue = strings.TrimSpace(record.Get("date") + " " + record.Get("time"))
+	}
+	if startValue == "" {
+		return row, fmt.Errorf("start is required")
+	}
+	if row.start, err = csvimport.ParseTime(startValue, loc); err != nil {
+		return row, fmt.Errorf("start: %w", err)
+	}
+
+	// What
+	row.serviceName = record.Get("service")
+	if row.serviceName == "" {
+		return row, fmt.Errorf("service is required")
+	}
+	if len([]rune(row.serviceName)) > config.MaxBookingServiceNameLength {
+		return row, fmt.Errorf("service cannot be longer than %d characters", config.MaxBookingServiceNameLength)
+	}
+	row.service = services[strings.ToLower(row.serviceName)]
+
+	// How long
+	duration, hasDuration, err := record.Int("duration")
+	if err != nil {
+		return row, err
+	}
+	switch {
+	case hasDuration:
+		row.end = row.start.Add(time.Duration(duration) * time.Minute)
+	case record.Get("end") != "":
+		if row.end, err = csvimport.ParseTime(record.Get("end"), loc); err != nil {
+			return row, fmt.Errorf("end: %w", err)
+		}
+		duration = int(row.end.Sub(row.start) / time.Minute)
+	case row.service != nil && row.service.GetEstimatedDuration() > 0:
+		duration = row.service.GetEstimatedDuration()
+		row.end = row.start.Add(time.Duration(duration) * time.Minute)
+	default:
+		duration = config.DefaultBookingDurationMinutes
+		row.end = row.start.Add(time.Duration(duration) * time.Minute)
+	}
+	if duration <= 0 || duration > config.MaxBookingDurationMinutes {
+		return row, fmt.Errorf("duration must be between 1 and %d minutes", config.MaxBookingDurationMinutes)
+	}
+	if row.end.After(now) {
+		return row, fmt.Errorf("only past appointments can be imported")
+	}
+
+	// How much
+	price, hasPrice, err := record.Float("price")
+	if err != nil {
+		return row, err
+	}
+	if !hasPrice && row.service != nil {
+		price = row.service.Price
+	}
+	row.price = price
+	if row.tip, _, err = record.Float("tip"); err != nil {
+		return row, err
+	}
+
+	// Outcome
+	switch strings.ReplaceAll(strings.ToLower(record.Get("status")), "-", "_") {
+	case "", "completed", "complete", "done":
+		row.status = config.BookingStatusCompleted
+	case "no_show", "noshow", "no show":
+		row.status = config.BookingStatusNoShow
+	case "cancelled", "canceled":
+		row.status = config.BookingStatusCancelledByCustomer
+	default:
+		return row, fmt.Errorf("status must be completed, no_show or cancelled, got %q", record.Get("status"))
+	}
+
+	// Who
+	if row.customerEmail, err = importedEmail(firstOf(record, "customer_email", "email")); err != nil {
+		return row, err
+	}
+	if row.customerPhone, err = importedPhone(firstOf(record, "customer_phone", "phone")); err != nil {
+		return row, err
+	}
+	if name := firstOf(record, "customer_name", "name"); name != "" {
+		if len([]rune(name)) > 100 {
+			return row, fmt.Errorf("customer name cannot be longer than 100 characters")
+		}
+		row.customerName = &name
+	}
+
+	if v := record.Get("payment_method"); v != "" {
+		if len(v) > 30 {
+			return row, fmt.Errorf("payment_method cannot be longer than 30 characters")
+		}
+		row.paymentMethod = &v
+	}
+	if v := record.Get("notes"); v != "" {
+		row.notes = &v
+	}
+
+	row.importRef, err = appointmentImportRef(record.Get("reference"), row)
+	return row, err
+}
+
+// firstOf returns the first non-empty value of the columns
+func firstOf(record *csvimport.Row, columns ...string) string {
+	for _, column := range columns {
+		if v := record.Get(column); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// appointmentImportRef identifies the row a booking came from: the file's
+// own reference, or a digest of when, what and who
+func appointmentImportRef(reference string, row appointmentRow) (string, error) {
+	if reference != "" {
+		if len(reference) > 90 {
+			return "", fmt.Errorf("reference cannot be longer than 90 characters")
+		}
+		return "ref:" + reference, nil
+	}
+
+	parts := []string{
+		row.start.UTC().Format(time.RFC3339),
+		strings.ToLower(row.serviceName),
+		stringValue(row.customerEmail),
+		stringValue(row.customerPhone),
+		strings.ToLower(stringValue(row.customerName)),
+	}
+	sum := sha256.Sum256([]byte(strings.Join(parts, "|")))
+	return "row:" + hex.EncodeToString(sum[:16]), nil
+}
+
+// importRow stores one appointment as a historical booking
+func (s *BookingImportService) importRow(ctx context.Context, barber *models.Barber, row appointmentRow, importedBy int) (BookingImportRowResult, error) {
+	result := BookingImportRowResult{Line: row.line}
+
+	customer, err := matchCustomer(ctx, s.userRepo, row.customerEmail, row.customerPhone)
+	if err != nil {
+		return result, err
+	}
+
+	booking := s.newImportedBooking(barber, row)
+	if customer != nil {
+		booking.CustomerID = &customer.ID
+		if booking.CustomerName == nil {
+			booking.CustomerName = &customer.Name
+		}
+	}
+	history := &models.BookingHistory{
+		ChangedBy:  &importedBy,
+		ChangeType: "imported",
+		NewValues:  models.JSONMap{"status": booking.Status, "import_ref": row.importRef},
+	}
+
+	// Booking numbers are random; retry the rare collision
+	for attempt := 0; ; attempt++ {
+		booking.BookingNumber = importedBookingNumber(row.start)
+		err = s.bookingRepo.CreateImported(ctx, booking, history)
+		if err == nil || errors.Is(err, repository.ErrDuplicateImport) || !repository.IsDuplicateError(err) || attempt == 2 {
+			break
+		}
+	}
+	if errors.Is(err, repository.ErrDuplicateImport) {
+		result.Status = config.BookingImportDuplicate
+		return result, nil
+	}
+	if err != nil {
+		return result, err
+	}
+
+	result.Status = config.BookingImportCreated
+	result.BookingID = &booking.ID
+	result.CustomerID = booking.CustomerID
+	return result, nil
+}
+
+// newImportedBooking builds the booking for an appointment row
+func (s *BookingImportService) newImportedBooking(barber *models.Barber, row appointmentRow) *models.Booking {
+	importRef := row.importRef
+	booking := &models.Booking{
+		UUID:                     uuid.New().String(),
+		BarberID:                 barber.ID,
+		ServiceName:              row.serviceName,
+		EstimatedDurationMinutes: int(row.end.Sub(row.start) / time.Minute),
+		CustomerName:             row.customerName,
+		CustomerEmail:            row.customerEmail,
+		CustomerPhone:            row.customerPhone,
+		Status:                   row.status,
+		ServicePrice:             row.price,
+		TotalPrice:               row.price,
+		TipAmount:                row.tip,
+		PaymentMethod:            row.paymentMethod,
+		Notes:                    row.notes,
+		ScheduledStartTime:       row.start,
+		ScheduledEndTime:         row.end,
+		BookingSource:            config.BookingSourceImport,
+		ImportRef:                &importRef,
+	}
+	if row.service != nil {
+		booking.BarberServiceID = &row.service.ID
+		booking.Currency = row.service.Currency
+	}
+
+	switch row.status {
+	case config.BookingStatusCompleted:
+		booking.PaymentStatus = config.PaymentStatusPaid
+		booking.ActualStartTime = &row.start
+		booking.ActualEndTime = &row.end
+	case config.BookingStatusCancelledByCustomer:
+		booking.PaymentStatus = config.PaymentStatusCancelled
+		booking.CancelledAt = &row.start
+	default:
+		booking.PaymentStatus = config.PaymentStatusPending
+	}
+	return booking
+}
+
+// importedBookingNumber returns a booking number for an imported booking:
+// IM, the appointment date and 8 random digits
+func importedBookingNumber(start time.Time) string {
+	return fmt.Sprintf("IM%s%08d", start.Format("20060102"), rand.Intn(100000000))
+}
+
+// add records a row's outcome in the summary
+func (r *BookingImportResult) add(row BookingImportRowResult) {
+	r.Total++
+	switch row.Status {
+	case config.BookingImportCreated:
+		r.Created++
+		if row.CustomerID != nil {
+			r.Linked++
+		}
+	case config.BookingImportDuplicate:
+		r.Duplicates++
+	case config.BookingImportFailed:
+		r.Failed++
+	}
+	r.Rows = append(r.Rows, row)
+}
@@ -0,0 +1,199 @@
+// internal/services/booking_line_items.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BOOKING LINE ITEMS - Several services in one appointment
+// ========================================================================
+
+// SetAddOns lets bookings include add-ons from the barber services'
+// catalogs (nil = add_on_ids are rejected)
+func (s *BookingService) SetAddOns(repo *repository.AddOnRepository) {
+	s.addOns = repo
+}
+
+// requestedServiceIDs returns the barber services a request is for:
+// service_ids, or service_id on its own
+func requestedServiceIDs(serviceID int, serviceIDs []int) []int {
+	if len(serviceIDs) > 0 {
+		return serviceIDs
+	}
+	if serviceID > 0 {
+		return []int{serviceID}
+	}
+	return nil
+}
+
+// bookedServiceIDs returns the barber services a saved booking is for
+func bookedServiceIDs(booking *models.Booking) []int {
+	var ids []int
+	for _, item := range booking.LineItems {
+		if item.AddOnID == nil && item.BarberServiceID != nil {
+			ids = append(ids, *item.BarberServiceID)
+		}
+	}
+	if len(ids) == 0 && booking.BarberServiceID != nil {
+		ids = append(ids, *booking.BarberServiceID)
+	}
+	return ids
+}
+
+// resolveServices fetches the barber services a booking is for, checking
+// each is active and offered by the booking's barber, along with the
+// add-ons chosen for them
+func (s *BookingService) resolveServices(ctx context.Context, barberID int, ids, addOnIDs []int) (models.ServiceSelection, error) {
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("service_id or service_ids must be provided")
+	}
+	if len(ids) > config.MaxBookingServices {
+		return nil, fmt.Errorf("a booking cannot have more than %d services", config.MaxBookingServices)
+	}
+
+	seen := make(map[int]bool, len(ids))
+	selection := make(models.ServiceSelection, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			return nil, fmt.Errorf("service %d cannot be booked twice in one appointment", id)
+		}
+		seen[id] = true
+
+		barberService, err := s.validateAndFetchBarberService(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if barberService.BarberID != barberID {
+			return nil, fmt.Errorf("service must belong to the selected barber")
+		}
+		selection = append(selection, models.SelectedService{BarberService: barberService})
+	}
+
+	if err := s.attachAddOns(ctx, selection, addOnIDs); err != nil {
+		return nil, err
+	}
+	return selection, nil
+}
+
+// attachAddOns adds the chosen add-ons to the booked services they belong
+// to. Each must be active and offered on a booked service that allows
+// add-ons.
+func (s *BookingService) attachAddOns(ctx context.Context, selection models.ServiceSelection, addOnIDs []int) error {
+	if len(addOnIDs) == 0 {
+		return nil
+	}
+	if s.addOns == nil {
+		return fmt.Errorf("add-ons are not available")
+	}
+	if len(addOnIDs) > config.MaxBookingAddOns {
+		return fmt.Errorf("a booking cannot have more than %d add-ons", config.MaxBookingAddOns)
+	}
+
+	addOns, err := s.addOns.FindByIDs(ctx, addOnIDs)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int]*models.ServiceAddOn, len(addOns))
+	for i := range addOns {
+		byID[addOns[i].ID] = &addOns[i]
+	}
+
+	seen := make(map[int]bool, len(addOnIDs))
+	for _, id := range addOnIDs {
+		if seen[id] {
+			return fmt.Errorf("add-on %d cannot be booked twice in one appointment", id)
+		}
+		seen[id] = true
+
+		addOn, ok := byID[id]
+		if !ok || !addOn.IsActive {
+			return fmt.Errorf("add-on %d is not available", id)
+		}
+		service := selectedServiceFor(selection, addOn.BarberServiceID)
+		if service == nil {
+			return fmt.Errorf("add-on %d must belong to one of the booked services", id)
+		}
+		if !service.AllowsAddOns {
+			return fmt.Errorf("service %d does not allow add-ons", service.ID)
+		}
+		service.AddOns = append(service.AddOns, addOn)
+	}
+	return nil
+}
+
+// selectedServiceFor returns the booked service with the given barber
+// service ID, or nil
+func selectedServiceFor(selection models.ServiceSelection, barberServiceID int) *models.SelectedService {
+	for i := range selection {
+		if selection[i].ID == barberServiceID {
+			return &selection[i]
+		}
+	}
+	return nil
+}
+
+// serviceSelectionName names a booking after its services, e.g.
+// "Haircut + Beard Trim"
+func serviceSelectionName(selection models.ServiceSelection) string {
+	names := make([]string, len(selection))
+	for i, selected := range selection {
+		names[i] = getServiceName(selected.BarberService)
+	}
+
+	name := []rune(strings.Join(names, " + "))
+	if len(name) > config.MaxBookingServiceNameLength {
+		name = append(name[:config.MaxBookingServiceNameLength-1], '…')
+	}
+	return string(name)
+}
+
+// bookingLineItems lists the services of a new booking, each followed by
+// its add-ons. A single service without add-ons takes the booking's own
+// duration and price, which the request may set.
+func bookingLineItems(selection models.ServiceSelection, durationMinutes int, servicePrice float64) []models.BookingLineItem {
+	var items []models.BookingLineItem
+	for _, selected := range selection {
+		serviceID := selected.ID
+		items = append(items, models.BookingLineItem{
+			BarberServiceID: &serviceID,
+			ServiceName:     getServiceName(selected.BarberService),
+			DurationMinutes: selected.EstimatedDurationMin,
+			Price:           selected.Price,
+			Position:        len(items),
+		})
+		for _, addOn := range selected.AddOns {
+			addOnID := addOn.ID
+			items = append(items, models.BookingLineItem{
+				BarberServiceID: &serviceID,
+				AddOnID:         &addOnID,
+				ServiceName:     addOn.Name,
+				DurationMinutes: addOn.DurationMinutes,
+				Price:           addOn.Price,
+				Position:        len(items),
+			})
+		}
+	}
+	if len(items) == 1 {
+		items[0].DurationMinutes = durationMinutes
+		items[0].Price = servicePrice
+	}
+	return items
+}
+
+// attachBookingDetails loads a booking's line items and the taxes charged
+// on it
+func (s *BookingService) attachBookingDetails(ctx context.Context, booking *models.Booking) error {
+	items, err := s.repo.FindLineItems(ctx, booking.ID)
+	if err != nil {
+		return err
+	}
+	booking.LineItems = items
+	return s.attachTaxLines(ctx, booking)
+}
@@ -0,0 +1,138 @@
+// internal/services/booking_refund.go
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/payments"
+)
+
+// ========================================================================
+// CANCELLATION REFUNDS - Return prepaid money per the cancellation policy
+// ========================================================================
+//
+// An authorization that was never captured is voided for a full refund, or
+// captured for just the cancellation fee (the rest of the hold is released).
+// A captured payment is refunded through the provider. Either way the
+// booking's payment status and history record what happened.
+// ========================================================================
+
+// Refund outcomes
+const (
+	RefundStatusRefunded = "refunded" // Money returned (void, partial capture or refund)
+	RefundStatusNone     = "none"     // Outside the refund window; the charge stands
+	RefundStatusFailed   = "failed"   // Provider error; needs manual follow-up
+)
+
+// RefundResult describes the refund issued for a cancelled booking
+type RefundResult struct {
+	models.RefundQuote
+	Status    string  `json:"status"`
+	Reference *string `json:"reference,omitempty"` // Provider refund reference, when one is created
+	Error     string  `json:"error,omitempty"`
+}
+
+// refundCancelledBooking applies the cancellation policy to a prepaid booking.
+// Failures are logged, recorded in the booking history and reported in the
+// result; they never undo the cancellation itself.
+func (s *BookingService) refundCancelledBooking(ctx context.Context, booking *models.Booking, byCustomer bool, changedBy *int) *RefundResult {
+	log := logger.FromContext(ctx)
+
+	quote := models.QuoteRefund(s.cancellationPolicy, booking, s.clock.Now(), byCustomer)
+	result := &RefundResult{RefundQuote: quote}
+
+	reference, err := s.settleCancelledPayment(ctx, booking, quote)
+	if err != nil {
+		log.Error(err).
+			Int("booking_id", booking.ID).
+			Float64("refund_amount", quote.Amount).
+			Msg("Failed to refund cancelled booking")
+
+		result.Status = RefundStatusFailed
+		result.Error = err.Error()
+		if histErr := s.repo.CreateHistory(ctx, &models.BookingHistory{
+			BookingID:  booking.ID,
+			ChangedBy:  changedBy,
+			ChangeType: "refund_failed",
+			NewValues: models.JSONMap{
+				"refund_amount": quote.Amount,
+				"error":         err.Error(),
+			},
+		}); histErr != nil {
+			log.Warn("Failed to create booking history").Int("booking_id", booking.ID).Err(histErr).Send()
+		}
+		return result
+	}
+	result.Reference = reference
+
+	paymentStatus := config.PaymentStatusRefunded
+	result.Status = RefundStatusRefunded
+	if quote.Amount == 0 {
+		paymentStatus = config.PaymentStatusPaid
+		result.Status = RefundStatusNone
+	}
+
+	details := models.JSONMap{
+		"refund_amount":    quote.Amount,
+		"refund_percent":   quote.Percent,
+		"cancellation_fee": quote.CancellationFee,
+		"refund_reason":    quote.Reason,
+	}
+	if reference != nil {
+		details["refund_reference"] = *reference
+	}
+	if err := s.RecordPaymentStatus(ctx, booking, paymentStatus, booking.PaymentMethod, booking.PaymentReference, changedBy, details); err != nil {
+		log.Error(err).
+			Int("booking_id", booking.ID).
+			Msg("Refund issued but payment status was not updated")
+	}
+	if quote.CancellationFee > 0 {
+		if err := s.repo.UpdateCancellationFee(ctx, booking.ID, quote.CancellationFee); err != nil {
+			log.Warn("Failed to record cancellation fee").Int("booking_id", booking.ID).Err(err).Send()
+		}
+	}
+
+	log.Info("Cancelled booking refunded").
+		Int("booking_id", booking.ID).
+		Int("refund_percent", quote.Percent).
+		Float64("refund_amount", quote.Amount).
+		Float64("cancellation_fee", quote.CancellationFee).
+		Send()
+
+	return result
+}
+
+// settleCancelledPayment moves money at the provider for a refund quote and
+// returns the provider's refund reference, if one was created
+func (s *BookingService) settleCancelledPayment(ctx context.Context, booking *models.Booking, quote models.RefundQuote) (*string, error) {
+	gateway := gatewayFor(bookingContext(ctx, booking), s.payments)
+	if gateway == nil {
+		return nil, payments.ErrNotConfigured
+	}
+	authorizationID := *booking.PaymentReference
+
+	switch booking.PaymentStatus {
+	case config.PaymentStatusAuthorized:
+		if quote.CancellationFee == 0 {
+			return nil, gateway.Void(ctx, authorizationID)
+		}
+		// Capturing only the fee releases the rest of the hold
+		return nil, gateway.Capture(ctx, authorizationID, payments.ToMinorUnits(quote.CancellationFee))
+
+	case config.PaymentStatusPaid:
+		if quote.Amount == 0 {
+			return nil, nil
+		}
+		refundID, err := gateway.Refund(ctx, authorizationID, payments.ToMinorUnits(quote.Amount))
+		if err != nil {
+			return nil, err
+		}
+		return &refundID, nil
+	}
+
+	return nil, fmt.Errorf("cannot refund a booking with payment status %q", booking.PaymentStatus)
+}
@@ -0,0 +1,503 @@
+// internal/services/booking_series_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// ========================================================================
+// RECURRING BOOKINGS - Standing appointments as booking series
+// ========================================================================
+
+// RecurrenceRequest asks for a booking to repeat on a fixed schedule
+type RecurrenceRequest struct {
+	Frequency   string `json:"frequency" binding:"required,oneof=weekly biweekly monthly"`
+	Occurrences int    `json:"occurrences" binding:"required,min=2,max=52"`
+}
+
+// RescheduleSeriesRequest moves every remaining occurrence of a series.
+// NewStartTime is the new time of the next upcoming occurrence; later
+// occurrences shift by the same amount.
+type RescheduleSeriesRequest struct {
+	NewStartTime    time.Time `json:"new_start_time" binding:"required"`
+	DurationMinutes int       `json:"duration_minutes"`
+	Reason          *string   `json:"reason"`
+}
+
+// BookingSeriesResponse is a series with its occurrences
+type BookingSeriesResponse struct {
+	Series   *models.BookingSeries `json:"series"`
+	Bookings []BookingResponse     `json:"bookings"`
+}
+
+// validateOccurrenceTime applies the single-booking rules to a series
+// occurrence, except that occurrences may be scheduled up to the recurring
+// horizon instead of the 30-day booking window
+func (s *BookingService) validateOccurrenceTime(ctx context.Context, barberID int, startTime time.Time, durationMinutes int) error {
+	now := s.clock.Now()
+
+	if startTime.Before(now.Add(1 * time.Hour)) {
+		return fmt.Errorf("booking must be at least 1 hour in advance")
+	}
+	if startTime.After(now.Add(config.MaxRecurringHorizon)) {
+		return fmt.Errorf("recurring bookings cannot be scheduled more than %d days in advance",
+			int(config.MaxRecurringHorizon.Hours()/24))
+	}
+	if durationMinutes < 15 || durationMinutes > 480 {
+		return fmt.Errorf("booking duration must be between 15 and 480 minutes")
+	}
+
+	return s.checkBarberSchedule(ctx, barberID, startTime, durationMinutes)
+}
+
+// seriesConflictError describes which occurrences collide with existing bookings
+func seriesConflictError(conflicts []time.Time, total int) error {
+	dates := make([]string, len(conflicts))
+	for i, t := range conflicts {
+		dates[i] = t.Format("2006-01-02 15:04")
+	}
+	return fmt.Errorf("time slot is not available for %d of %d occurrences (%s), please choose another time",
+		len(conflicts), total, strings.Join(dates, ", "))
+}
+
+// remainingOccurrences returns the upcoming occurrences that can still be changed
+func (s *BookingService) remainingOccurrences(bookings []models.Booking) []models.Booking {
+	now := s.clock.Now()
+	remaining := make([]models.Booking, 0, len(bookings))
+	for _, b := range bookings {
+		if b.CanBeCancelled() && b.ScheduledStartTime.After(now) {
+			remaining = append(remaining, b)
+		}
+	}
+	return remaining
+}
+
+// toSeriesResponse converts a series and its occurrences to a response
+func (s *BookingService) toSeriesResponse(series *models.BookingSeries, bookings []models.Booking) *BookingSeriesResponse {
+	responses := make([]BookingResponse, len(bookings))
+	for i := range bookings {
+		responses[i] = *s.toBookingResponse(&bookings[i])
+	}
+	return &BookingSeriesResponse{Series: series, Bookings: responses}
+}
+
+// ========================================================================
+// CREATE SERIES
+// ========================================================================
+
+// CreateBookingSeries creates a standing appointment. Every occurrence is
+// checked for conflicts up front and the whole series is created in a single
+// transaction, so either all occurrences are booked or none are.
+func (s *BookingService) CreateBookingSeries(ctx context.Context, req CreateBookingRequest, createdByUserID *int) (*BookingSeriesResponse, error) {
+	log := logger.FromContext(ctx)
+
+	if req.Recurrence == nil {
+		return nil, fmt.Errorf("recurrence is required for a booking series")
+	}
+	if req.BarberID == 0 {
+		return nil, fmt.Errorf("barber_id is required for a booking series")
+	}
+	if req.CouponCode != nil && *req.CouponCode != "" {
+		return nil, fmt.Errorf("coupons cannot be applied to a booking series")
+	}
+	rec := *req.Recurrence
+	if rec.Occurrences < config.MinRecurringOccurrences || rec.Occurrences > config.MaxRecurringOccurrences {
+		return nil, fmt.Errorf("occurrences must be between %d and %d",
+			config.MinRecurringOccurrences, config.MaxRecurringOccurrences)
+	}
+
+	serviceIDs := requestedServiceIDs(req.ServiceID, req.ServiceIDs)
+	selection, err := s.resolveServices(ctx, req.BarberID, serviceIDs, req.AddOnIDs)
+	if err != nil {
+		return nil, err
+	}
+	if selection.Deposit() > 0 {
+		return nil, fmt.Errorf("services that require a deposit cannot be booked as a series")
+	}
+	if req.DurationMinutes == 0 {
+		req.DurationMinutes = selection.TotalMinutes()
+	}
+
+	// The first occurrence follows the normal booking window
+	if err := s.validateBookingTime(ctx, req.BarberID, req.StartTime, req.DurationMinutes); err != nil {
+		return nil, err
+	}
+
+	startTimes, err := models.RecurrenceTimes(req.StartTime, rec.Frequency, rec.Occurrences)
+	if err != nil {
+		return nil, err
+	}
+	for _, start := range startTimes[1:] {
+		if err := s.validateOccurrenceTime(ctx, req.BarberID, start, req.DurationMinutes); err != nil {
+			return nil, err
+		}
+	}
+
+	barber, err := s.validateAndFetchBarber(ctx, req.BarberID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateCustomerInfo(req); err != nil {
+		return nil, err
+	}
+	customFields, formFields, err := s.answerBookingForm(ctx, req.BarberID, serviceIDs, req.CustomFields)
+	if err != nil {
+		return nil, err
+	}
+	if triggers := models.ConsultationTriggers(formFields, customFields); len(triggers) > 0 {
+		return nil, fmt.Errorf("a series cannot be booked when answers need a consultation (%s); book a single appointment to request one", strings.Join(triggers, ", "))
+	}
+	travel, err := s.travelFor(ctx, req.BarberID, req.LocationID)
+	if err != nil {
+		return nil, err
+	}
+
+	taxRules, err := s.taxRulesFor(ctx, barber)
+	if err != nil {
+		return nil, err
+	}
+	pricing := s.calculateBookingPricing(selection, req, taxRules)
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			tx.Rollback()
+		}
+	}()
+
+	// Pre-check every occurrence so the customer sees all clashes at once
+	segments := selection.DurationSegments(req.DurationMinutes)
+	var conflicts []time.Time
+	for _, start := range startTimes {
+		end := s.calculateEndTime(start, req.DurationMinutes)
+		hasConflict, err := s.repo.CheckConflictForUpdate(ctx, tx, req.BarberID, start, end, segments, travel, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check availability: %w", err)
+		}
+		if hasConflict {
+			conflicts = append(conflicts, start)
+		}
+	}
+	if len(conflicts) > 0 {
+		log.Warn("Booking series conflicts").
+			Int("barber_id", req.BarberID).
+			Int("conflicts", len(conflicts)).
+			Send()
+		return nil, seriesConflictError(conflicts, len(startTimes))
+	}
+
+	serviceID := selection[0].ID
+	series := &models.BookingSeries{
+		UUID:            uuid.New().String(),
+		CustomerID:      req.CustomerID,
+		BarberID:        req.BarberID,
+		BarberServiceID: &serviceID,
+		Frequency:       rec.Frequency,
+		Occurrences:     rec.Occurrences,
+		StartTime:       req.StartTime,
+		DurationMinutes: req.DurationMinutes,
+		Status:          config.BookingSeriesStatusActive,
+		CreatedBy:       createdByUserID,
+	}
+	if err := s.seriesRepo.CreateTx(ctx, tx, series); err != nil {
+		return nil, err
+	}
+
+	bookings := make([]models.Booking, 0, len(startTimes))
+	for _, start := range startTimes {
+		occurrenceReq := req
+		occurrenceReq.StartTime = start
+		booking := s.buildBookingFromRequest(ctx, occurrenceReq, selection, pricing,
+			s.calculateEndTime(start, req.DurationMinutes))
+		booking.SeriesID = &series.ID
+		booking.CustomFields = customFields
+
+		if err := s.repo.CreateTx(ctx, tx, booking); err != nil {
+			if errors.Is(err, repository.ErrBookingConflict) {
+				return nil, seriesConflictError([]time.Time{start}, len(startTimes))
+			}
+			return nil, err
+		}
+		if err := s.recordEvent(ctx, tx, config.DomainEventBookingCreated, booking, "", createdByUserID, ""); err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, *booking)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	committed = true
+
+	// Audit history is best effort, as for single bookings
+	for _, booking := range bookings {
+		_ = s.repo.CreateHistory(ctx, &models.BookingHistory{
+			BookingID:  booking.ID,
+			ChangedBy:  createdByUserID,
+			ChangeType: "created",
+			NewValues: models.JSONMap{
+				"status":      booking.Status,
+				"barber_id":   booking.BarberID,
+				"start_time":  booking.ScheduledStartTime,
+				"total_price": booking.TotalPrice,
+				"series_id":   series.ID,
+			},
+		})
+	}
+
+	if s.cache != nil {
+		_ = s.cache.InvalidateBarber(ctx, req.BarberID)
+	}
+
+	log.Info("Booking series created").
+		Int("series_id", series.ID).
+		Int("barber_id", series.BarberID).
+		Str("frequency", series.Frequency).
+		Int("occurrences", series.Occurrences).
+		Send()
+
+	return s.toSeriesResponse(series, bookings), nil
+}
+
+// ========================================================================
+// READ SERIES
+// ========================================================================
+
+// GetBookingSeries retrieves a series with all of its occurrences
+func (s *BookingService) GetBookingSeries(ctx context.Context, id int) (*BookingSeriesResponse, error) {
+	series, err := s.seriesRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	bookings, err := s.seriesRepo.FindOccurrences(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toSeriesResponse(series, bookings), nil
+}
+
+// ========================================================================
+// MODIFY SERIES
+// ========================================================================
+
+// CancelBookingSeries cancels every remaining occurrence and closes the series.
+// Past and already-finished occurrences are left untouched. Single occurrences
+// are cancelled through CancelBooking.
+func (s *BookingService) CancelBookingSeries(ctx context.Context, id int, req CancelBookingRequest, cancelledByUserID *int) (*BookingSeriesResponse, error) {
+	log := logger.FromContext(ctx)
+
+	reasonCode, err := cancellationReasonCode(req)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := s.seriesRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !series.IsActive() {
+		return nil, fmt.Errorf("booking series cannot be cancelled: it is already %s", series.Status)
+	}
+
+	bookings, err := s.seriesRepo.FindOccurrences(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	remaining := s.remainingOccurrences(bookings)
+
+	cancelStatus := config.BookingStatusCancelledByBarber
+	if req.IsByCustomer {
+		cancelStatus = config.BookingStatusCancelledByCustomer
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			tx.Rollback()
+		}
+	}()
+
+	for _, b := range remaining {
+		if err := s.repo.CancelTx(ctx, tx, b.ID, cancelStatus, cancelledByUserID, reasonCode, req.Reason); err != nil {
+			return nil, err
+		}
+		cancelled := b
+		cancelled.Status = cancelStatus
+		if err := s.recordEvent(ctx, tx, config.DomainEventBookingCancelled, &cancelled, b.Status, cancelledByUserID, req.Reason); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.seriesRepo.UpdateStatusTx(ctx, tx, id, config.BookingSeriesStatusCancelled); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	committed = true
+
+	reason := req.Reason
+	for _, b := range remaining {
+		_ = s.repo.CreateHistory(ctx, &models.BookingHistory{
+			BookingID:    b.ID,
+			ChangedBy:    cancelledByUserID,
+			ChangeType:   "status_changed",
+			OldValues:    models.JSONMap{"status": b.Status},
+			NewValues:    models.JSONMap{"status": cancelStatus, "series_id": id},
+			ChangeReason: &reason,
+		})
+	}
+
+	if s.cache != nil {
+		_ = s.cache.InvalidateBarber(ctx, series.BarberID)
+	}
+
+	log.Info("Booking series cancelled").
+		Int("series_id", id).
+		Int("cancelled_occurrences", len(remaining)).
+		Send()
+
+	return s.GetBookingSeries(ctx, id)
+}
+
+// RescheduleBookingSeries moves every remaining occurrence by the same offset.
+// All new times are conflict-checked before anything is written. Single
+// occurrences are moved through RescheduleBooking.
+func (s *BookingService) RescheduleBookingSeries(ctx context.Context, id int, req RescheduleSeriesRequest, rescheduledByUserID *int) (*BookingSeriesResponse, error) {
+	log := logger.FromContext(ctx)
+
+	series, err := s.seriesRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !series.IsActive() {
+		return nil, fmt.Errorf("booking series cannot be rescheduled: it is %s", series.Status)
+	}
+
+	bookings, err := s.seriesRepo.FindOccurrences(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	remaining := s.remainingOccurrences(bookings)
+	if len(remaining) == 0 {
+		return nil, fmt.Errorf("booking series has no upcoming occurrences that can be rescheduled")
+	}
+
+	durationMinutes := series.DurationMinutes
+	if req.DurationMinutes > 0 {
+		durationMinutes = req.DurationMinutes
+	}
+
+	offset := req.NewStartTime.Sub(remaining[0].ScheduledStartTime)
+	newStarts := make([]time.Time, len(remaining))
+	for i, b := range remaining {
+		newStarts[i] = b.ScheduledStartTime.Add(offset)
+		if err := s.validateOccurrenceTime(ctx, series.BarberID, newStarts[i], durationMinutes); err != nil {
+			return nil, err
+		}
+	}
+
+	travelTimes, err := s.travelTimes(ctx, series.BarberID)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := s.repo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			tx.Rollback()
+		}
+	}()
+
+	// Siblings move together, so only bookings outside the series can conflict
+	var conflicts []time.Time
+	for i, start := range newStarts {
+		end := s.calculateEndTime(start, durationMinutes)
+		segments := remaining[i].DurationSegments.ForDuration(durationMinutes)
+		travel := models.Travel{LocationID: remaining[i].LocationID, Times: travelTimes}
+		hasConflict, err := s.repo.CheckConflictOutsideSeriesForUpdate(ctx, tx, series.BarberID, start, end, segments, travel, id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check availability: %w", err)
+		}
+		if hasConflict {
+			conflicts = append(conflicts, start)
+		}
+	}
+	if len(conflicts) > 0 {
+		return nil, seriesConflictError(conflicts, len(newStarts))
+	}
+
+	for i, b := range remaining {
+		end := s.calculateEndTime(newStarts[i], durationMinutes)
+		segments := b.DurationSegments.ForDuration(durationMinutes)
+		if err := s.repo.RescheduleTx(ctx, tx, b.ID, newStarts[i], end, durationMinutes, segments); err != nil {
+			if errors.Is(err, repository.ErrBookingConflict) {
+				return nil, seriesConflictError([]time.Time{newStarts[i]}, len(newStarts))
+			}
+			return nil, err
+		}
+	}
+	if err := s.seriesRepo.UpdateScheduleTx(ctx, tx, id, series.StartTime.Add(offset), durationMinutes); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	committed = true
+
+	for i, b := range remaining {
+		_ = s.repo.CreateHistory(ctx, &models.BookingHistory{
+			BookingID:  b.ID,
+			ChangedBy:  rescheduledByUserID,
+			ChangeType: "rescheduled",
+			OldValues: models.JSONMap{
+				"scheduled_start_time": b.ScheduledStartTime,
+				"scheduled_end_time":   b.ScheduledEndTime,
+			},
+			NewValues: models.JSONMap{
+				"scheduled_start_time": newStarts[i],
+				"scheduled_end_time":   s.calculateEndTime(newStarts[i], durationMinutes),
+				"series_id":            id,
+			},
+			ChangeReason: req.Reason,
+		})
+	}
+
+	if s.cache != nil {
+		_ = s.cache.InvalidateBarber(ctx, series.BarberID)
+	}
+
+	log.Info("Booking series rescheduled").
+		Int("series_id", id).
+		Int("occurrences", len(remaining)).
+		Dur("offset", offset).
+		Send()
+
+	return s.GetBookingSeries(ctx, id)
+}
@@ -3,16 +3,23 @@ package services
 
 import (
 	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/clock"
 	"barber-booking-system/internal/config"
 	"barber-booking-system/internal/logger"
 	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/payments"
 	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/sandbox"
+	"barber-booking-system/internal/statemachine"
 	"context"
+	"errors"
 	"fmt"
 	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 )
 
 // ========================================================================
@@ -21,25 +28,184 @@ import (
 
 // BookingService handles booking business logic
 type BookingService struct {
-	repo        *repository.BookingRepository
-	barberRepo  *repository.BarberRepository
-	serviceRepo *repository.ServiceRepository
-	cache       *cache.CacheService
+	repo         repository.BookingStore
+	seriesRepo   repository.BookingSeriesStore
+	scheduleRepo repository.ScheduleStore
+	barberRepo   repository.BarberStore
+	serviceRepo  repository.ServiceStore
+	cache        *cache.CacheService
+	clock        clock.Clock
+	transitions  *statemachine.Machine[*models.Booking]
+
+	// Refunds for prepaid bookings on cancellation
+	payments           payments.Gateway
+	cancellationPolicy config.CancellationPolicyConfig
+
+	// Bounds on price changes when a booking is completed
+	priceAdjustment config.PriceAdjustmentConfig
+
+	// Promotional coupons (nil = coupon codes are rejected)
+	coupons CouponRedeemer
+
+	// Run after a booking is created (e.g. out-of-hours auto-replies)
+	createdHooks []BookingCreatedHook
+
+	// Run after a booking's details or time change (e.g. live dashboards)
+	updatedHooks []BookingUpdatedHook
+
+	// Barber locations and travel times (nil = locations are ignored)
+	locations *repository.LocationRepository
+
+	// Tax rules (nil = bookings are not taxed)
+	taxes *repository.TaxRepository
+
+	// Service add-on catalogs (nil = add-ons are rejected)
+	addOns *repository.AddOnRepository
+
+	// Domain events outbox (nil = no events are recorded)
+	outbox *repository.OutboxRepository
+
+	// Barbers' custom booking-form fields (nil = custom fields are rejected)
+	bookingForms *repository.BookingFormRepository
+
+	// Consultation requests made instead of bookings (nil = bookings whose
+	// answers call for a consultation are rejected)
+	consultations *ConsultationService
+
+	// Archived bookings (nil = lookups only see live bookings)
+	archive *repository.BookingArchiveRepository
+
+	// Relations attached to lists (nil = include_* parameters are ignored)
+	relations *repository.RelationLoader
+
+	// Barbers' time off (nil = barbers are never away)
+	timeOff *repository.TimeOffRepository
+
+	// Shops whose opening hours stand in for missing barber schedules
+	// (nil = barbers without a schedule get the default business hours)
+	shops *repository.ShopRepository
+
+	// How a shop barber is picked for bookings made with a shop instead
+	// of a barber (empty = config.DefaultAssignmentStrategy)
+	assignmentStrategy string
+}
+
+// BookingCreatedHook is run after a booking is created. createdByUserID is
+// the user who made the booking (nil for guests).
+type BookingCreatedHook func(ctx context.Context, booking *models.Booking, createdByUserID *int) error
+
+// BookingUpdatedHook is run after a booking's details are edited or it is
+// rescheduled. Status changes have their own hooks (see OnStatusChange).
+type BookingUpdatedHook func(ctx context.Context, booking *models.Booking, updatedByUserID *int) error
+
+// CouponRedeemer validates and redeems single-use coupons at booking time
+type CouponRedeemer interface {
+	// CouponDiscount returns the discount a customer's coupon gives on a price
+	CouponDiscount(ctx context.Context, code string, customerID int, price float64) (float64, error)
+
+	// RedeemCoupon marks the coupon used by a booking
+	RedeemCoupon(ctx context.Context, code string, bookingID int) error
 }
 
 // NewBookingService creates a new booking service
 func NewBookingService(
-	repo *repository.BookingRepository,
-	barberRepo *repository.BarberRepository,
-	serviceRepo *repository.ServiceRepository,
+	repo repository.BookingStore,
+	seriesRepo repository.BookingSeriesStore,
+	scheduleRepo repository.ScheduleStore,
+	barberRepo repository.BarberStore,
+	serviceRepo repository.ServiceStore,
 	cache *cache.CacheService,
 ) *BookingService {
-	return &BookingService{
-		repo:        repo,
-		barberRepo:  barberRepo,
-		serviceRepo: serviceRepo,
-		cache:       cache,
+	s := &BookingService{
+		repo:         repo,
+		seriesRepo:   seriesRepo,
+		scheduleRepo: scheduleRepo,
+		barberRepo:   barberRepo,
+		serviceRepo:  serviceRepo,
+		cache:        cache,
+		clock:        clock.System,
+		cancellationPolicy: config.CancellationPolicyConfig{
+			FullRefundHours:      config.DefaultFullRefundHours,
+			PartialRefundHours:   config.DefaultPartialRefundHours,
+			PartialRefundPercent: config.DefaultPartialRefundPercent,
+		},
+		priceAdjustment: config.PriceAdjustmentConfig{
+			MaxIncreasePercent: config.DefaultPriceAdjustmentMaxIncreasePercent,
+			MaxDecreasePercent: config.DefaultPriceAdjustmentMaxDecreasePercent,
+		},
+	}
+	s.transitions = statemachine.NewBooking[*models.Booking]().
+		Guard(config.BookingStatusPending, config.BookingStatusNoShow, s.guardNoShow).
+		Guard(config.BookingStatusConfirmed, config.BookingStatusNoShow, s.guardNoShow)
+	return s
+}
+
+// SetClock replaces the time source used for booking-window validation (nil restores the system clock)
+func (s *BookingService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// SetPaymentGateway sets the provider used to refund prepaid bookings on
+// cancellation (nil = refunds cannot be issued and are logged as failed)
+func (s *BookingService) SetPaymentGateway(g payments.Gateway) {
+	s.payments = g
+}
+
+// SetCancellationPolicy replaces the refund windows applied on cancellation
+func (s *BookingService) SetCancellationPolicy(policy config.CancellationPolicyConfig) {
+	s.cancellationPolicy = policy
+}
+
+// SetCouponRedeemer sets the source of coupons accepted by CreateBooking
+func (s *BookingService) SetCouponRedeemer(r CouponRedeemer) {
+	s.coupons = r
+}
+
+// OnCreated registers a hook run after a booking is created. Hook errors
+// are logged and never fail the booking.
+func (s *BookingService) OnCreated(hook BookingCreatedHook) {
+	s.createdHooks = append(s.createdHooks, hook)
+}
+
+// OnUpdated registers a hook run after a booking is edited or rescheduled.
+// Hook errors are logged and never fail the update.
+func (s *BookingService) OnUpdated(hook BookingUpdatedHook) {
+	s.updatedHooks = append(s.updatedHooks, hook)
+}
+
+// runUpdatedHooks runs the updated hooks, logging failures
+func (s *BookingService) runUpdatedHooks(ctx context.Context, booking *models.Booking, updatedByUserID *int) {
+	for _, hook := range s.updatedHooks {
+		if err := hook(ctx, booking, updatedByUserID); err != nil {
+			logger.FromContext(ctx).Warn("Booking updated hook failed").
+				Int("booking_id", booking.ID).
+				Err(err).
+				Send()
+		}
+	}
+}
+
+// OnEnterStatus registers a hook run after a booking's status changes to
+// status (e.g. completed → send a review request). Hook errors are logged
+// and never fail the status update.
+func (s *BookingService) OnEnterStatus(status string, hook statemachine.Hook[*models.Booking]) {
+	s.transitions.OnEnter(status, hook)
+}
+
+// OnStatusChange registers a hook run after every status change, whatever
+// the new status. Hook errors are logged and never fail the update.
+func (s *BookingService) OnStatusChange(hook statemachine.Hook[*models.Booking]) {
+	for _, status := range s.transitions.States() {
+		s.transitions.OnEnter(status, hook)
+	}
+}
+
+// guardNoShow prevents marking a booking as no-show before it has started
+func (s *BookingService) guardNoShow(_ context.Context, booking *models.Booking, _, _ string) error {
+	if s.clock.Now().Before(booking.ScheduledStartTime) {
+		return fmt.Errorf("cannot mark booking as no-show before its scheduled start time")
 	}
+	return nil
 }
 
 // ========================================================================
@@ -49,10 +215,23 @@ func NewBookingService(
 // CreateBookingRequest represents a request to create a booking
 type CreateBookingRequest struct {
 	// Required fields
-	BarberID        int       `json:"barber_id" binding:"required"`
-	ServiceID       int       `json:"service_id" binding:"required"`
-	StartTime       time.Time `json:"start_time" binding:"required"`
-	DurationMinutes int       `json:"duration_minutes" binding:"required,min=15,max=480"`
+	BarberID  int       `json:"barber_id"`
+	StartTime time.Time `json:"start_time" binding:"required"`
+
+	// Instead of barber_id: book any available barber of the shop. The
+	// services are then catalog services (services.id), not a barber's.
+	ShopID *int `json:"shop_id"`
+
+	// Services: service_id for one, or service_ids for several performed
+	// back to back, in order
+	ServiceID  int   `json:"service_id"`
+	ServiceIDs []int `json:"service_ids"`
+
+	// Optional extras from the booked services' add-on catalogs
+	AddOnIDs []int `json:"add_on_ids"`
+
+	// Default: the services' and add-ons' combined duration
+	DurationMinutes int `json:"duration_minutes" binding:"omitempty,min=15,max=480"`
 
 	// Customer info (either customer_id OR guest info)
 	CustomerID    *int    `json:"customer_id"`
@@ -64,10 +243,29 @@ type CreateBookingRequest struct {
 	Notes           *string `json:"notes"`
 	SpecialRequests *string `json:"special_requests"`
 	BookingSource   string  `json:"booking_source"` // mobile_app, web_app, phone, walk_in
+	LocationID      *int    `json:"location_id"`    // One of the barber's locations (default: the barber's address)
+
+	// Answers to the barber's custom booking-form fields (key -> value)
+	CustomFields map[string]interface{} `json:"custom_fields"`
 
 	// Pricing (optional - will be calculated if not provided)
 	ServicePrice   *float64 `json:"service_price"`
 	DiscountAmount *float64 `json:"discount_amount"`
+	CouponCode     *string  `json:"coupon_code"` // Replaces discount_amount; single bookings only
+
+	// Provider token for the card holding the deposit, when the services
+	// require one (e.g. a Stripe pm_... id)
+	DepositPaymentMethodID string `json:"deposit_payment_method_id"`
+
+	// Set by checkout, whose full prepayment takes the deposit's place
+	depositCovered bool
+
+	// Set when a customer accepts a consultation quote: the consultation
+	// answered the service's questions, so they are not asked again
+	consultation *models.ConsultationRequest
+
+	// Recurrence (optional - creates a standing appointment series)
+	Recurrence *RecurrenceRequest `json:"recurrence"`
 }
 
 // UpdateBookingRequest represents a request to update a booking
@@ -78,6 +276,9 @@ type UpdateBookingRequest struct {
 	Notes           *string `json:"notes"`
 	SpecialRequests *string `json:"special_requests"`
 	InternalNotes   *string `json:"internal_notes"`
+
+	// Replaces the answers to the barber's custom booking-form fields
+	CustomFields map[string]interface{} `json:"custom_fields"`
 }
 
 // RescheduleBookingRequest represents a request to reschedule
@@ -89,8 +290,13 @@ type RescheduleBookingRequest struct {
 
 // CancelBookingRequest represents a request to cancel
 type CancelBookingRequest struct {
-	Reason       string `json:"reason"`
+	ReasonCode   string `json:"reason_code" example:"schedule_conflict"` // One of the cancellation reasons (optional)
+	Reason       string `json:"reason"`                                  // Free text (optional)
 	IsByCustomer bool   `json:"is_by_customer"`
+
+	// Set by the pending expiry job: the booking is cancelled only if it is
+	// still in this status once its row is locked
+	onlyIfStatus string
 }
 
 // UpdateStatusRequest represents a status update request
@@ -104,6 +310,17 @@ type BookingResponse struct {
 	CanCancel     bool   `json:"can_cancel"`
 	CanReschedule bool   `json:"can_reschedule"`
 	TimeUntil     string `json:"time_until,omitempty"`
+
+	// Refund is set when cancelling a prepaid booking
+	Refund *RefundResult `json:"refund,omitempty"`
+
+	// ArchivedAt is set for bookings read from the archive
+	ArchivedAt *time.Time `json:"archived_at,omitempty"`
+
+	// Relations attached to lists on request (see relations.go)
+	Customer *models.CustomerSummary `json:"customer,omitempty"`
+	Barber   *models.BarberSummary   `json:"barber,omitempty"`
+	Service  *models.ServiceSummary  `json:"service,omitempty"`
 }
 
 // ========================================================================
@@ -113,7 +330,7 @@ type BookingResponse struct {
 // generateBookingNumber creates a unique human-readable booking number
 // Format: BK + YYYYMMDD + 4 random digits (e.g., BK202411281234)
 func (s *BookingService) generateBookingNumber() string {
-	now := time.Now()
+	now := s.clock.Now()
 	dateStr := now.Format("20060102")
 	randomNum := rand.Intn(10000)
 	return fmt.Sprintf("BK%s%04d", dateStr, randomNum)
@@ -124,21 +341,10 @@ func (s *BookingService) calculateEndTime(startTime time.Time, durationMinutes i
 	return startTime.Add(time.Duration(durationMinutes) * time.Minute)
 }
 
-// calculateTotalPrice calculates total price with tax
-// ─────────────────────────────────────────────────────────────────────────
-// TODO: YOUR TASK #1 - Implement this function
-// ─────────────────────────────────────────────────────────────────────────
-// Calculate: totalPrice = servicePrice - discountAmount + taxAmount
-// Tax rate is 8% (0.08)
-//
-// Steps:
-// 1. Calculate tax: taxAmount = (servicePrice - discountAmount) * 0.08
-// 2. Calculate total: total = servicePrice - discountAmount + taxAmount
-// 3. Return servicePrice, discountAmount, taxAmount, totalPrice
-// ─────────────────────────────────────────────────────────────────────────
-func (s *BookingService) calculateTotalPrice(servicePrice float64, discountAmount float64) *models.PricingBreakdown {
-	taxRate := 0.08
-	return models.CalculatePricing(servicePrice, discountAmount, taxRate)
+// calculateTotalPrice calculates total price with the taxes of the barber's
+// tax rules (see taxRulesFor)
+func (s *BookingService) calculateTotalPrice(servicePrice float64, discountAmount float64, taxRules []models.TaxRule) *models.PricingBreakdown {
+	return models.NewTaxedPricing(servicePrice, discountAmount, taxRules, config.DefaultCurrency)
 }
 
 // validateBookingTime checks if the booking time is valid
@@ -150,11 +356,12 @@ func (s *BookingService) calculateTotalPrice(servicePrice float64, discountAmoun
 // 2. Booking must be at least 1 hour in advance
 // 3. Booking must not be more than 30 days in advance
 // 4. Duration must be between 15 and 480 minutes
+// 5. Booking must fit the barber's schedule (if one is defined) and time off
 //
 // Return nil if valid, or error with descriptive message
 // ─────────────────────────────────────────────────────────────────────────
-func (s *BookingService) validateBookingTime(startTime time.Time, durationMinutes int) error {
-	now := time.Now()
+func (s *BookingService) validateBookingTime(ctx context.Context, barberID int, startTime time.Time, durationMinutes int) error {
+	now := s.clock.Now()
 
 	// Rule 1: Must be in the future
 	if startTime.Before(now) {
@@ -181,7 +388,24 @@ func (s *BookingService) validateBookingTime(startTime time.Time, durationMinute
 		return fmt.Errorf("booking duration cannot exceed 8 hours (480 minutes)")
 	}
 
-	return nil
+	// Rule 5: Working hours, breaks, and days off
+	return s.checkBarberSchedule(ctx, barberID, startTime, durationMinutes)
+}
+
+// checkBarberSchedule rejects bookings outside the barber's working hours
+// or during their time off. Barbers without a schedule accept bookings at
+// any other time.
+func (s *BookingService) checkBarberSchedule(ctx context.Context, barberID int, startTime time.Time, durationMinutes int) error {
+	if err := s.checkTimeOff(ctx, barberID, startTime, s.calculateEndTime(startTime, durationMinutes)); err != nil {
+		return err
+	}
+
+	schedule, err := s.loadSchedule(ctx, barberID)
+	if err != nil || schedule == nil {
+		return err
+	}
+
+	return schedule.CheckAvailability(startTime, s.calculateEndTime(startTime, durationMinutes))
 }
 
 // toBookingResponse converts a booking to a response with computed fields
@@ -193,8 +417,9 @@ func (s *BookingService) toBookingResponse(booking *models.Booking) *BookingResp
 	}
 
 	// Calculate time until booking
-	if booking.IsUpcoming() {
-		duration := time.Until(booking.ScheduledStartTime)
+	now := s.clock.Now()
+	if booking.IsUpcomingAt(now) {
+		duration := booking.ScheduledStartTime.Sub(now)
 		if duration.Hours() >= 24 {
 			days := int(duration.Hours() / 24)
 			response.TimeUntil = fmt.Sprintf("%d days", days)
@@ -245,9 +470,13 @@ func (s *BookingService) checkTimeSlotAvailability(
 	barberID int,
 	startTime, endTime time.Time,
 	excludeBookingID int,
+	segments models.DurationSegments,
+	travel models.Travel,
 ) error {
 	opts := models.NewTimeSlotCheckOptions(startTime, endTime,
-		models.WithExcludeBooking(excludeBookingID))
+		models.WithExcludeBooking(excludeBookingID),
+		models.WithDurationSegments(segments),
+		models.WithTravel(travel))
 	return s.checkTimeSlotAvailabilityWithOptions(ctx, barberID, opts)
 }
 func (s *BookingService) checkTimeSlotAvailabilityWithOptions(
@@ -265,6 +494,8 @@ func (s *BookingService) checkTimeSlotAvailabilityWithOptions(
 		barberID,
 		effectiveStart,
 		effectiveEnd,
+		opts.DurationSegments,
+		opts.Travel,
 		opts.ExcludeBookingID,
 	)
 	if err != nil {
@@ -303,12 +534,13 @@ type PricingResult struct {
 	DiscountAmount float64
 	TaxAmount      float64
 	TotalPrice     float64
+	TaxLines       []models.TaxLine
 }
 
 // calculateBookingPricing calculates all pricing components
-func (s *BookingService) calculateBookingPricing(barberService *models.BarberService, req CreateBookingRequest) PricingResult {
-	// Use provided price or default to barber service price
-	servicePrice := barberService.Price
+func (s *BookingService) calculateBookingPricing(selection models.ServiceSelection, req CreateBookingRequest, taxRules []models.TaxRule) PricingResult {
+	// Use provided price or default to the services' combined price
+	servicePrice := selection.TotalPrice()
 	if req.ServicePrice != nil {
 		servicePrice = *req.ServicePrice
 	}
@@ -320,20 +552,36 @@ func (s *BookingService) calculateBookingPricing(barberService *models.BarberSer
 	}
 
 	// Calculate tax and total
-	pricing := s.calculateTotalPrice(servicePrice, discountAmount)
+	pricing := s.calculateTotalPrice(servicePrice, discountAmount, taxRules)
 
 	return PricingResult{
 		ServicePrice:   pricing.ServicePrice,
 		DiscountAmount: pricing.DiscountAmount,
 		TaxAmount:      pricing.TaxAmount,
 		TotalPrice:     pricing.TotalPrice,
+		TaxLines:       pricing.TaxLines,
+	}
+}
+
+// couponDiscount returns the discount for the request's coupon. Coupons
+// belong to a customer, so guest bookings cannot use them.
+func (s *BookingService) couponDiscount(ctx context.Context, req CreateBookingRequest, selection models.ServiceSelection) (float64, error) {
+	if s.coupons == nil || req.CustomerID == nil {
+		return 0, repository.ErrCouponNotFound
+	}
+	price := selection.TotalPrice()
+	if req.ServicePrice != nil {
+		price = *req.ServicePrice
 	}
+	return s.coupons.CouponDiscount(ctx, *req.CouponCode, *req.CustomerID, price)
 }
 
-// buildBookingFromRequest constructs a booking model from request data
+// buildBookingFromRequest constructs a booking model from request data.
+// Bookings made by sandbox requests are test bookings.
 func (s *BookingService) buildBookingFromRequest(
+	ctx context.Context,
 	req CreateBookingRequest,
-	barberService *models.BarberService,
+	selection models.ServiceSelection,
 	pricing PricingResult,
 	endTime time.Time,
 ) *models.Booking {
@@ -343,14 +591,20 @@ func (s *BookingService) buildBookingFromRequest(
 
 		CustomerID: req.CustomerID,
 		BarberID:   req.BarberID,
+		LocationID: req.LocationID,
 
-		ServiceName:              getServiceName(barberService),
+		BarberServiceID:          &selection[0].ID,
+		ServiceName:              serviceSelectionName(selection),
 		EstimatedDurationMinutes: req.DurationMinutes,
+		DurationSegments:         selection.DurationSegments(req.DurationMinutes),
+		LineItems:                bookingLineItems(selection, req.DurationMinutes, pricing.ServicePrice),
 
 		CustomerName:  req.CustomerName,
 		CustomerEmail: req.CustomerEmail,
 		CustomerPhone: req.CustomerPhone,
 
+		IsTest: sandbox.Enabled(ctx),
+
 		Status: config.BookingStatusPending,
 
 		ServicePrice:   pricing.ServicePrice,
@@ -358,6 +612,7 @@ func (s *BookingService) buildBookingFromRequest(
 		TaxAmount:      pricing.TaxAmount,
 		TotalPrice:     pricing.TotalPrice,
 		Currency:       config.DefaultCurrency,
+		TaxLines:       bookingTaxLines(pricing.TaxLines),
 
 		PaymentStatus: config.PaymentStatusPending,
 
@@ -395,7 +650,7 @@ func getBookingSource(source string) string {
 // saveBookingWithHistory saves booking and creates audit trail
 // saveBookingWithHistory saves booking and creates audit trail within a transaction
 // This prevents race conditions by using SELECT ... FOR UPDATE to lock conflicting slots
-func (s *BookingService) saveBookingWithHistory(ctx context.Context, booking *models.Booking, createdByUserID *int) error {
+func (s *BookingService) saveBookingWithHistory(ctx context.Context, booking *models.Booking, travel models.Travel, createdByUserID *int) error {
 	// Start transaction
 	tx, err := s.repo.BeginTx(ctx)
 	if err != nil {
@@ -416,6 +671,8 @@ func (s *BookingService) saveBookingWithHistory(ctx context.Context, booking *mo
 		booking.BarberID,
 		booking.ScheduledStartTime,
 		booking.ScheduledEndTime,
+		booking.DurationSegments,
+		travel,
 		0, // No booking to exclude for new bookings
 	)
 	if err != nil {
@@ -427,9 +684,18 @@ func (s *BookingService) saveBookingWithHistory(ctx context.Context, booking *mo
 
 	// Create booking within transaction
 	if err := s.repo.CreateTx(ctx, tx, booking); err != nil {
+		// A concurrent booking won the race and the overlap constraint rejected this one
+		if errors.Is(err, repository.ErrBookingConflict) {
+			return fmt.Errorf("time slot is not available, please choose another time")
+		}
 		return fmt.Errorf("failed to create booking: %w", err)
 	}
 
+	// Record booking.created with the booking so it is published if and only if the booking commits
+	if err := s.recordEvent(ctx, tx, config.DomainEventBookingCreated, booking, "", createdByUserID, ""); err != nil {
+		return err
+	}
+
 	// Commit transaction BEFORE creating history (history is non-critical)
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
@@ -467,15 +733,44 @@ func (s *BookingService) saveBookingWithHistory(ctx context.Context, booking *mo
 func (s *BookingService) CreateBooking(ctx context.Context, req CreateBookingRequest, createdByUserID *int) (*BookingResponse, error) {
 	log := logger.FromContext(ctx)
 
+	// Step 0: Without a barber, pick one of the shop's barbers
+	var assignment *barberAssignment
+	if req.BarberID == 0 {
+		var err error
+		assignment, err = s.assignBarber(ctx, &req)
+		if err != nil {
+			log.Warn("Barber assignment failed").
+				Err(err).
+				Send()
+			return nil, err
+		}
+	}
+	serviceIDs := requestedServiceIDs(req.ServiceID, req.ServiceIDs)
+
 	log.Debug("Creating booking").
 		Int("barber_id", req.BarberID).
-		Int("service_id", req.ServiceID).
+		Ints("service_ids", serviceIDs).
 		Time("start_time", req.StartTime).
 		Int("duration_minutes", req.DurationMinutes).
 		Send()
 
-	// Step 1: Validate booking time
-	if err := s.validateBookingTime(req.StartTime, req.DurationMinutes); err != nil {
+	// Step 1: Validate the services; together they set the duration unless
+	// the request does
+	selection, err := s.resolveServices(ctx, req.BarberID, serviceIDs, req.AddOnIDs)
+	if err != nil {
+		log.Warn("Service validation failed").
+			Ints("service_ids", serviceIDs).
+			Ints("add_on_ids", req.AddOnIDs).
+			Err(err).
+			Send()
+		return nil, err
+	}
+	if req.DurationMinutes == 0 {
+		req.DurationMinutes = selection.TotalMinutes()
+	}
+
+	// Step 2: Validate booking time
+	if err := s.validateBookingTime(ctx, req.BarberID, req.StartTime, req.DurationMinutes); err != nil {
 		log.Warn("Booking time validation failed").
 			Err(err).
 			Time("start_time", req.StartTime).
@@ -483,7 +778,7 @@ func (s *BookingService) CreateBooking(ctx context.Context, req CreateBookingReq
 		return nil, err
 	}
 
-	// Step 2: Validate barber exists and is active
+	// Step 3: Validate barber exists and is active
 	barber, err := s.validateAndFetchBarber(ctx, req.BarberID)
 	if err != nil {
 		log.Warn("Barber validation failed").
@@ -493,19 +788,20 @@ func (s *BookingService) CreateBooking(ctx context.Context, req CreateBookingReq
 		return nil, err
 	}
 
-	// Step 3: Validate service exists and get pricing
-	barberService, err := s.validateAndFetchBarberService(ctx, req.ServiceID)
+	// Step 4: Check for time slot conflicts (other bookings may sit in the
+	// service's passive segments; bookings at the barber's other locations
+	// need the travel time in between)
+	endTime := s.calculateEndTime(req.StartTime, req.DurationMinutes)
+	segments := selection.DurationSegments(req.DurationMinutes)
+	travel, err := s.travelFor(ctx, req.BarberID, req.LocationID)
 	if err != nil {
-		log.Warn("Service validation failed").
-			Int("service_id", req.ServiceID).
+		log.Warn("Location validation failed").
+			Int("barber_id", req.BarberID).
 			Err(err).
 			Send()
 		return nil, err
 	}
-
-	// Step 4: Check for time slot conflicts
-	endTime := s.calculateEndTime(req.StartTime, req.DurationMinutes)
-	if err := s.checkTimeSlotAvailability(ctx, req.BarberID, req.StartTime, endTime, 0); err != nil {
+	if err := s.checkTimeSlotAvailability(ctx, req.BarberID, req.StartTime, endTime, 0, segments, travel); err != nil {
 		log.Warn("Time slot conflict").
 			Int("barber_id", req.BarberID).
 			Time("start_time", req.StartTime).
@@ -515,35 +811,109 @@ func (s *BookingService) CreateBooking(ctx context.Context, req CreateBookingReq
 		return nil, err
 	}
 
-	// Step 5: Validate customer info
+	// Step 5: Validate customer info and the barber's custom fields
 	if err := s.validateCustomerInfo(req); err != nil {
 		log.Warn("Customer info validation failed").
 			Err(err).
 			Send()
 		return nil, err
 	}
+	var customFields models.BookingFormAnswers
+	if req.consultation != nil {
+		customFields = req.consultation.Answers
+	} else {
+		var formFields []models.BookingFormField
+		customFields, formFields, err = s.answerBookingForm(ctx, req.BarberID, serviceIDs, req.CustomFields)
+		if err != nil {
+			log.Warn("Custom field validation failed").
+				Int("barber_id", req.BarberID).
+				Err(err).
+				Send()
+			return nil, err
+		}
+
+		// Answers to a consultation service's questions may call for a
+		// consultation: the barber gets a request instead of a booking
+		if triggers := models.ConsultationTriggers(formFields, customFields); len(triggers) > 0 {
+			return nil, s.requestConsultation(ctx, req, selection, formFields, customFields, triggers)
+		}
+	}
 
-	// Step 6: Calculate pricing
-	pricing := s.calculateBookingPricing(barberService, req)
+	// Step 6: Calculate pricing (a coupon replaces any manual discount;
+	// taxes follow the tax rules that apply to the barber)
+	if req.CouponCode != nil && *req.CouponCode != "" {
+		discount, err := s.couponDiscount(ctx, req, selection)
+		if err != nil {
+			log.Warn("Coupon rejected").
+				Str("coupon_code", *req.CouponCode).
+				Err(err).
+				Send()
+			return nil, err
+		}
+		req.DiscountAmount = &discount
+	}
+	taxRules, err := s.taxRulesFor(ctx, barber)
+	if err != nil {
+		return nil, err
+	}
+	pricing := s.calculateBookingPricing(selection, req, taxRules)
+	deposit, noShowFee := bookingDeposit(selection, req, pricing.TotalPrice)
+	if err := s.checkDepositPayment(ctx, req, deposit); err != nil {
+		log.Warn("Deposit cannot be taken").
+			Float64("deposit_amount", deposit).
+			Err(err).
+			Send()
+		return nil, err
+	}
 
 	// Step 7: Build booking model
-	booking := s.buildBookingFromRequest(req, barberService, pricing, endTime)
+	booking := s.buildBookingFromRequest(ctx, req, selection, pricing, endTime)
+	booking.DepositAmount = deposit
+	booking.NoShowFee = noShowFee
+	booking.CustomFields = customFields
 
 	// Step 8: Save booking with audit trail
-	if err := s.saveBookingWithHistory(ctx, booking, createdByUserID); err != nil {
+	if err := s.saveBookingWithHistory(ctx, booking, travel, createdByUserID); err != nil {
 		log.Error(err).
 			Int("barber_id", req.BarberID).
 			Msg("Failed to save booking")
 		return nil, err
 	}
+	if assignment != nil {
+		s.recordAssignment(ctx, booking, assignment, createdByUserID)
+	}
 
 	// Step 9: Invalidate cache
 	if s.cache != nil {
 		_ = s.cache.InvalidateBarber(ctx, req.BarberID)
 	}
 
-	// Suppress unused variable warning
-	_ = barber
+	// Step 10: Hold the deposit; a declined card cancels the booking
+	if err := s.holdDeposit(ctx, booking, req, createdByUserID); err != nil {
+		return nil, err
+	}
+
+	// Step 11: Use up the coupon. A concurrent booking may have redeemed it
+	// first; the discount stands and the race is logged.
+	if req.CouponCode != nil && *req.CouponCode != "" {
+		if err := s.coupons.RedeemCoupon(ctx, *req.CouponCode, booking.ID); err != nil {
+			log.Warn("Failed to redeem coupon").
+				Int("booking_id", booking.ID).
+				Str("coupon_code", *req.CouponCode).
+				Err(err).
+				Send()
+		}
+	}
+
+	// Step 12: Run created hooks
+	for _, hook := range s.createdHooks {
+		if err := hook(ctx, booking, createdByUserID); err != nil {
+			log.Warn("Booking created hook failed").
+				Int("booking_id", booking.ID).
+				Err(err).
+				Send()
+		}
+	}
 
 	log.Info("Booking created successfully").
 		Str("booking_number", booking.BookingNumber).
@@ -565,6 +935,9 @@ func (s *BookingService) GetBookingByID(ctx context.Context, id int) (*BookingRe
 	if err != nil {
 		return nil, err
 	}
+	if err := s.attachBookingDetails(ctx, booking); err != nil {
+		return nil, err
+	}
 	return s.toBookingResponse(booking), nil
 }
 
@@ -574,44 +947,74 @@ func (s *BookingService) GetBookingByUUID(ctx context.Context, uuid string) (*Bo
 	if err != nil {
 		return nil, err
 	}
+	if err := s.attachBookingDetails(ctx, booking); err != nil {
+		return nil, err
+	}
 	return s.toBookingResponse(booking), nil
 }
 
-// GetBookingByNumber retrieves a booking by booking number
+// GetBookingByNumber retrieves a booking by booking number, falling back to
+// the archive for bookings no longer live
 func (s *BookingService) GetBookingByNumber(ctx context.Context, bookingNumber string) (*BookingResponse, error) {
 	booking, err := s.repo.FindByBookingNumber(ctx, bookingNumber)
+	if errors.Is(err, repository.ErrBookingNotFound) && s.archive != nil {
+		return s.getArchivedBooking(ctx, bookingNumber)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if err := s.attachBookingDetails(ctx, booking); err != nil {
+		return nil, err
+	}
 	return s.toBookingResponse(booking), nil
 }
 
-// GetCustomerBookings retrieves all bookings for a customer
-func (s *BookingService) GetCustomerBookings(ctx context.Context, customerID int, filters repository.BookingFilters) ([]BookingResponse, error) {
+// GetCustomerBookings retrieves a page of bookings for a customer, with the total
+// number of bookings matching the filters
+func (s *BookingService) GetCustomerBookings(ctx context.Context, customerID int, filters repository.BookingFilters) ([]BookingResponse, int, error) {
 	bookings, err := s.repo.FindByCustomerID(ctx, customerID, filters)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	filters.CustomerID = customerID
+	total, err := s.repo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	responses := make([]BookingResponse, len(bookings))
 	for i, booking := range bookings {
 		responses[i] = *s.toBookingResponse(&booking)
 	}
-	return responses, nil
+	if err := repository.Hydrate(ctx, s.relations, filters.Include(), responses); err != nil {
+		return nil, 0, err
+	}
+	return responses, total, nil
 }
 
-// GetBarberBookings retrieves all bookings for a barber
-func (s *BookingService) GetBarberBookings(ctx context.Context, barberID int, filters repository.BookingFilters) ([]BookingResponse, error) {
+// GetBarberBookings retrieves a page of bookings for a barber, with the total
+// number of bookings matching the filters
+func (s *BookingService) GetBarberBookings(ctx context.Context, barberID int, filters repository.BookingFilters) ([]BookingResponse, int, error) {
 	bookings, err := s.repo.FindByBarberID(ctx, barberID, filters)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	filters.BarberID = barberID
+	total, err := s.repo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	responses := make([]BookingResponse, len(bookings))
 	for i, booking := range bookings {
 		responses[i] = *s.toBookingResponse(&booking)
 	}
-	return responses, nil
+	if err := repository.Hydrate(ctx, s.relations, filters.Include(), responses); err != nil {
+		return nil, 0, err
+	}
+	return responses, total, nil
 }
 
 // GetUpcomingBookings retrieves upcoming bookings
@@ -625,6 +1028,9 @@ func (s *BookingService) GetUpcomingBookings(ctx context.Context, filters reposi
 	for i, booking := range bookings {
 		responses[i] = *s.toBookingResponse(&booking)
 	}
+	if err := repository.Hydrate(ctx, s.relations, filters.Include(), responses); err != nil {
+		return nil, err
+	}
 	return responses, nil
 }
 
@@ -680,6 +1086,17 @@ func (s *BookingService) UpdateBooking(ctx context.Context, id int, req UpdateBo
 	if req.InternalNotes != nil {
 		booking.InternalNotes = req.InternalNotes
 	}
+	if req.CustomFields != nil {
+		customFields, formFields, err := s.answerBookingForm(ctx, booking.BarberID, bookedServiceIDs(booking), req.CustomFields)
+		if err != nil {
+			return nil, err
+		}
+		if triggers := models.ConsultationTriggers(formFields, customFields); len(triggers) > 0 {
+			return nil, fmt.Errorf("custom_fields cannot be changed to answers that need a consultation (%s); cancel and book again to request one", strings.Join(triggers, ", "))
+		}
+		oldValues["custom_fields"] = booking.CustomFields
+		booking.CustomFields = customFields
+	}
 
 	// Save changes
 	if err := s.repo.Update(ctx, booking); err != nil {
@@ -698,11 +1115,55 @@ func (s *BookingService) UpdateBooking(ctx context.Context, id int, req UpdateBo
 			"notes":          booking.Notes,
 		},
 	}
+	if req.CustomFields != nil {
+		history.NewValues["custom_fields"] = booking.CustomFields
+	}
 	_ = s.repo.CreateHistory(ctx, history)
 
+	s.runUpdatedHooks(ctx, booking, updatedByUserID)
+
 	return s.toBookingResponse(booking), nil
 }
 
+// RecordPaymentStatus updates a booking's payment status and records the
+// change in the booking history. details (e.g. refund_amount) are merged into
+// the history entry's new values.
+func (s *BookingService) RecordPaymentStatus(
+	ctx context.Context,
+	booking *models.Booking,
+	status string,
+	method, reference *string,
+	changedBy *int,
+	details models.JSONMap,
+) error {
+	if err := s.repo.UpdatePaymentStatus(ctx, booking.ID, status, method, reference); err != nil {
+		return err
+	}
+
+	newValues := models.JSONMap{"payment_status": status}
+	for k, v := range details {
+		newValues[k] = v
+	}
+	history := &models.BookingHistory{
+		BookingID:  booking.ID,
+		ChangedBy:  changedBy,
+		ChangeType: "payment_status_changed",
+		OldValues:  models.JSONMap{"payment_status": booking.PaymentStatus},
+		NewValues:  newValues,
+	}
+	if err := s.repo.CreateHistory(ctx, history); err != nil {
+		logger.FromContext(ctx).Warn("Failed to create booking history").
+			Int("booking_id", booking.ID).
+			Err(err).
+			Send()
+	}
+
+	booking.PaymentStatus = status
+	booking.PaymentMethod = method
+	booking.PaymentReference = reference
+	return nil
+}
+
 // ========================================================================
 // UPDATED: internal/services/booking_service.go
 // ========================================================================
@@ -712,6 +1173,12 @@ func (s *BookingService) UpdateBooking(ctx context.Context, id int, req UpdateBo
 
 // UpdateStatus updates the booking status with state machine validation
 func (s *BookingService) UpdateStatus(ctx context.Context, id int, newStatus string, updatedByUserID *int) (*BookingResponse, error) {
+	return s.updateStatus(ctx, id, newStatus, updatedByUserID, "")
+}
+
+// updateStatus changes a booking's status, recording reason (if any) in its
+// history and domain event
+func (s *BookingService) updateStatus(ctx context.Context, id int, newStatus string, updatedByUserID *int, reason string) (*BookingResponse, error) {
 	log := logger.FromContext(ctx)
 
 	log.Debug("Updating booking status").
@@ -725,21 +1192,31 @@ func (s *BookingService) UpdateStatus(ctx context.Context, id int, newStatus str
 		return nil, err
 	}
 
+	return s.changeStatus(ctx, booking, newStatus, updatedByUserID, reason, nil)
+}
+
+// changeStatus moves a loaded booking to newStatus. also (may be nil) runs
+// in the status change's transaction, for changes that must be saved with
+// it.
+func (s *BookingService) changeStatus(ctx context.Context, booking *models.Booking, newStatus string, updatedByUserID *int, reason string, also func(tx *sqlx.Tx) error) (*BookingResponse, error) {
+	log := logger.FromContext(ctx)
+	id := booking.ID
 	oldStatus := booking.Status
 
-	// Validate state transition using state machine
-	if err := booking.ValidateStatusTransition(newStatus); err != nil {
+	// Validate state transition (declared rules and guards)
+	if err := s.transitions.Check(ctx, booking, oldStatus, newStatus); err != nil {
 		log.Warn("Invalid status transition").
 			Int("booking_id", id).
 			Str("old_status", oldStatus).
 			Str("new_status", newStatus).
 			Err(err).
 			Send()
-		return nil, fmt.Errorf("invalid status transition: %w", err)
+		return nil, err
 	}
 
-	// Update status in database
-	if err := s.repo.UpdateStatus(ctx, id, newStatus); err != nil {
+	// Update status and record the domain event in one transaction
+	booking.Status = newStatus
+	if err := s.saveStatusWithEvent(ctx, booking, oldStatus, updatedByUserID, reason, also); err != nil {
 		log.Error(err).
 			Int("booking_id", id).
 			Str("new_status", newStatus).
@@ -755,6 +1232,9 @@ func (s *BookingService) UpdateStatus(ctx context.Context, id int, newStatus str
 		OldValues:  models.JSONMap{"status": oldStatus},
 		NewValues:  models.JSONMap{"status": newStatus},
 	}
+	if reason != "" {
+		history.ChangeReason = &reason
+	}
 
 	// Log history (don't fail if history creation fails)
 	if err := s.repo.CreateHistory(ctx, history); err != nil {
@@ -775,6 +1255,15 @@ func (s *BookingService) UpdateStatus(ctx context.Context, id int, newStatus str
 		Str("new_status", newStatus).
 		Send()
 
+	// Run on-enter hooks (side effects must not fail the update)
+	if err := s.transitions.Enter(ctx, booking, oldStatus, newStatus); err != nil {
+		log.Warn("Booking status hook failed").
+			Int("booking_id", id).
+			Str("new_status", newStatus).
+			Err(err).
+			Send()
+	}
+
 	// Return updated booking
 	return s.GetBookingByID(ctx, id)
 }
@@ -805,9 +1294,15 @@ func (s *BookingService) CancelBooking(ctx context.Context, id int, req CancelBo
 	log.Info("Cancelling booking").
 		Int("booking_id", id).
 		Bool("is_by_customer", req.IsByCustomer).
+		Str("reason_code", req.ReasonCode).
 		Str("reason", req.Reason).
 		Send()
 
+	reasonCode, err := cancellationReasonCode(req)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get existing booking
 	booking, err := s.repo.FindByID(ctx, id)
 	if err != nil {
@@ -846,8 +1341,20 @@ func (s *BookingService) CancelBooking(ctx context.Context, id int, req CancelBo
 		return nil, fmt.Errorf("booking is already in a terminal state: %s", booking.Status)
 	}
 
-	// Update status to cancelled
-	result, err := s.UpdateStatus(ctx, id, cancelStatus, cancelledByUserID)
+	if req.onlyIfStatus != "" && booking.Status != req.onlyIfStatus {
+		return nil, fmt.Errorf("%w: now %s", repository.ErrBookingStatusChanged, booking.Status)
+	}
+
+	// Update status to cancelled, recording who cancelled and why
+	result, err := s.changeStatus(ctx, booking, cancelStatus, cancelledByUserID, req.Reason, func(tx *sqlx.Tx) error {
+		// Re-check under the row lock: the barber may confirm in between
+		if req.onlyIfStatus != "" {
+			if err := s.repo.LockStatusTx(ctx, tx, id, req.onlyIfStatus); err != nil {
+				return err
+			}
+		}
+		return s.repo.SetCancellationTx(ctx, tx, id, cancelledByUserID, reasonCode, req.Reason)
+	})
 	if err != nil {
 		log.Error(err).
 			Int("booking_id", id).
@@ -855,6 +1362,15 @@ func (s *BookingService) CancelBooking(ctx context.Context, id int, req CancelBo
 		return nil, err
 	}
 
+	// Refund prepaid bookings per the cancellation policy
+	if booking.IsPrepaid() {
+		refund := s.refundCancelledBooking(ctx, booking, req.IsByCustomer, cancelledByUserID)
+		if refreshed, err := s.GetBookingByID(ctx, id); err == nil {
+			result = refreshed
+		}
+		result.Refund = refund
+	}
+
 	log.Info("Booking cancelled successfully").
 		Int("booking_id", id).
 		Str("booking_number", booking.BookingNumber).
@@ -887,6 +1403,7 @@ func (s *BookingService) RescheduleBooking(ctx context.Context, id int, req Resc
 			Send()
 		return nil, err
 	}
+	ctx = bookingContext(ctx, booking)
 
 	// Check if booking can be rescheduled
 	if !booking.CanBeCancelled() {
@@ -903,8 +1420,12 @@ func (s *BookingService) RescheduleBooking(ctx context.Context, id int, req Resc
 		durationMinutes = req.DurationMinutes
 	}
 
-	// Validate new time
-	if err := s.validateBookingTime(req.NewStartTime, durationMinutes); err != nil {
+	// Validate new time (series occurrences may sit beyond the 30-day window)
+	validateTime := s.validateBookingTime
+	if booking.SeriesID != nil {
+		validateTime = s.validateOccurrenceTime
+	}
+	if err := validateTime(ctx, booking.BarberID, req.NewStartTime, durationMinutes); err != nil {
 		log.Warn("New booking time validation failed").
 			Time("new_start_time", req.NewStartTime).
 			Err(err).
@@ -912,9 +1433,15 @@ func (s *BookingService) RescheduleBooking(ctx context.Context, id int, req Resc
 		return nil, err
 	}
 
-	// Check for conflicts (exclude current booking)
+	// Check for conflicts (exclude current booking). A new duration no longer
+	// matches the service's segments, so the booking becomes fully active.
 	newEndTime := s.calculateEndTime(req.NewStartTime, durationMinutes)
-	hasConflict, err := s.repo.CheckConflict(ctx, booking.BarberID, req.NewStartTime, newEndTime, id)
+	segments := booking.DurationSegments.ForDuration(durationMinutes)
+	travel, err := s.travelFor(ctx, booking.BarberID, booking.LocationID)
+	if err != nil {
+		return nil, err
+	}
+	hasConflict, err := s.repo.CheckConflict(ctx, booking.BarberID, req.NewStartTime, newEndTime, segments, travel, id)
 	if err != nil {
 		log.Error(err).
 			Int("booking_id", id).
@@ -941,9 +1468,13 @@ func (s *BookingService) RescheduleBooking(ctx context.Context, id int, req Resc
 	booking.ScheduledStartTime = req.NewStartTime
 	booking.ScheduledEndTime = newEndTime
 	booking.EstimatedDurationMinutes = durationMinutes
+	booking.DurationSegments = segments
 
 	// Save using Update method
 	if err := s.repo.Update(ctx, booking); err != nil {
+		if errors.Is(err, repository.ErrBookingConflict) {
+			return nil, fmt.Errorf("new time slot is not available, please choose another time")
+		}
 		log.Error(err).
 			Int("booking_id", id).
 			Msg("Failed to reschedule booking")
@@ -976,6 +1507,8 @@ func (s *BookingService) RescheduleBooking(ctx context.Context, id int, req Resc
 		Time("new_start_time", req.NewStartTime).
 		Send()
 
+	s.runUpdatedHooks(ctx, booking, rescheduledByUserID)
+
 	return s.toBookingResponse(booking), nil
 }
 
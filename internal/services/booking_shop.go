@@ -0,0 +1,59 @@
+// internal/services/booking_shop.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BOOKING SHOPS - Shop opening hours for barbers without a schedule
+// ========================================================================
+
+// SetShops makes barbers without a schedule of their own work their shop's
+// opening hours (nil = they get the default business hours)
+func (s *BookingService) SetShops(repo *repository.ShopRepository) {
+	s.shops = repo
+}
+
+// shopSchedule returns the opening hours of the barber's shop as their
+// schedule, or nil for standalone barbers and shops without hours
+func (s *BookingService) shopSchedule(ctx context.Context, barberID int) (*models.BarberSchedule, error) {
+	if s.shops == nil {
+		return nil, nil
+	}
+
+	shop, err := s.shops.FindByBarberID(ctx, barberID)
+	if err != nil {
+		if errors.Is(err, repository.ErrShopNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to load shop hours: %w", err)
+	}
+	return shop.Schedule(barberID), nil
+}
+
+// GetShopBookings retrieves a page of bookings with any of a shop's
+// barbers, with the total number of bookings matching the filters
+func (s *BookingService) GetShopBookings(ctx context.Context, shopID int, filters repository.BookingFilters) ([]BookingResponse, int, error) {
+	filters.ShopID = shopID
+	bookings, err := s.repo.FindAll(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	responses := make([]BookingResponse, len(bookings))
+	for i, booking := range bookings {
+		responses[i] = *s.toBookingResponse(&booking)
+	}
+	return responses, total, nil
+}
@@ -0,0 +1,56 @@
+// internal/services/booking_tax.go
+package services
+
+import (
+	"context"
+
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BOOKING TAX - Taxes from the tax rules that apply to a barber
+// ========================================================================
+
+// SetTaxRules prices bookings with the tax rules that apply to their barber
+// (nil = bookings are not taxed)
+func (s *BookingService) SetTaxRules(repo *repository.TaxRepository) {
+	s.taxes = repo
+}
+
+// taxRulesFor returns the tax rules that apply to a barber's bookings
+func (s *BookingService) taxRulesFor(ctx context.Context, barber *models.Barber) ([]models.TaxRule, error) {
+	if s.taxes == nil {
+		return nil, nil
+	}
+	candidates, err := s.taxes.FindCandidates(ctx, barber)
+	if err != nil {
+		return nil, err
+	}
+	return models.ApplicableTaxRules(candidates, barber), nil
+}
+
+// attachTaxLines loads the taxes charged on a booking
+func (s *BookingService) attachTaxLines(ctx context.Context, booking *models.Booking) error {
+	if s.taxes == nil {
+		return nil
+	}
+	lines, err := s.taxes.FindBookingTaxLines(ctx, booking.ID)
+	if err != nil {
+		return err
+	}
+	booking.TaxLines = lines
+	return nil
+}
+
+// bookingTaxLines turns priced tax lines into line items for a new booking
+func bookingTaxLines(lines []models.TaxLine) []models.BookingTaxLine {
+	if len(lines) == 0 {
+		return nil
+	}
+	items := make([]models.BookingTaxLine, len(lines))
+	for i, line := range lines {
+		items[i] = models.BookingTaxLine{TaxLine: line}
+	}
+	return items
+}
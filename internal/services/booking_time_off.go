@@ -0,0 +1,57 @@
+// internal/services/booking_time_off.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BOOKING TIME OFF - Keeping bookings out of barbers' time off
+// ========================================================================
+
+// SetTimeOff makes booking validation and slot listing respect barbers'
+// time off (nil = barbers are never away)
+func (s *BookingService) SetTimeOff(repo *repository.TimeOffRepository) {
+	s.timeOff = repo
+}
+
+// checkTimeOff rejects a booking from start to end that overlaps the
+// barber's time off
+func (s *BookingService) checkTimeOff(ctx context.Context, barberID int, start, end time.Time) error {
+	if s.timeOff == nil {
+		return nil
+	}
+
+	timeOff, err := s.timeOff.FindInRange(ctx, barberID, start, end)
+	if err != nil {
+		return err
+	}
+	if blocked := models.OverlappingTimeOff(timeOff, models.TimeRange{Start: start, End: end}); blocked != nil {
+		return fmt.Errorf("booking cannot be made: barber is unavailable from %s to %s",
+			blocked.StartAt.Format(time.RFC3339), blocked.EndAt.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// timeOffRanges returns the barber's time off overlapping [from, to) as
+// busy ranges for slot listing
+func (s *BookingService) timeOffRanges(ctx context.Context, barberID int, from, to time.Time) ([]models.TimeRange, error) {
+	if s.timeOff == nil {
+		return nil, nil
+	}
+
+	timeOff, err := s.timeOff.FindInRange(ctx, barberID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	ranges := make([]models.TimeRange, len(timeOff))
+	for i := range timeOff {
+		ranges[i] = timeOff[i].Range()
+	}
+	return ranges, nil
+}
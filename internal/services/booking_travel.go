@@ -0,0 +1,55 @@
+// internal/services/booking_travel.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// BOOKING TRAVEL - Travel buffers between a barber's locations
+// ========================================================================
+
+// SetLocations enables bookings at barbers' locations, with travel buffers
+// between bookings at different locations (nil = locations are ignored)
+func (s *BookingService) SetLocations(repo *repository.LocationRepository) {
+	s.locations = repo
+}
+
+// travelTimes returns a barber's travel-time matrix
+func (s *BookingService) travelTimes(ctx context.Context, barberID int) (models.TravelTimes, error) {
+	if s.locations == nil {
+		return nil, nil
+	}
+	entries, err := s.locations.FindTravelTimes(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	return models.NewTravelTimes(entries), nil
+}
+
+// travelFor returns the travel buffers for a booking at one of the barber's
+// locations (nil = the barber's address, which needs none)
+func (s *BookingService) travelFor(ctx context.Context, barberID int, locationID *int) (models.Travel, error) {
+	if locationID == nil || s.locations == nil {
+		return models.Travel{LocationID: locationID}, nil
+	}
+
+	location, err := s.locations.FindByID(ctx, *locationID)
+	if err != nil && !errors.Is(err, repository.ErrLocationNotFound) {
+		return models.Travel{}, err
+	}
+	if location == nil || location.BarberID != barberID {
+		return models.Travel{}, fmt.Errorf("location must be one of the barber's locations")
+	}
+
+	times, err := s.travelTimes(ctx, barberID)
+	if err != nil {
+		return models.Travel{}, err
+	}
+	return models.Travel{LocationID: locationID, Times: times}, nil
+}
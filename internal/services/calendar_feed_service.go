@@ -0,0 +1,155 @@
+// internal/services/calendar_feed_service.go
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/ical"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// CALENDAR FEED SERVICE - Subscribable calendars of upcoming bookings
+// ========================================================================
+//
+// Each user gets one feed URL listing their upcoming bookings, as a
+// customer and (for barbers) with customers. Calendar apps poll the URL
+// without logging in, so the token in it is the only credential: it is
+// created on first request and replaced when the user resets the feed,
+// which stops every existing subscription.
+// ========================================================================
+
+// CalendarFeedService manages calendar feeds and renders them
+type CalendarFeedService struct {
+	repo        *repository.CalendarFeedRepository
+	bookingRepo repository.BookingStore
+	clock       clock.Clock
+}
+
+// NewCalendarFeedService creates a new calendar feed service
+func NewCalendarFeedService(repo *repository.CalendarFeedRepository, bookingRepo repository.BookingStore) *CalendarFeedService {
+	return &CalendarFeedService{
+		repo:        repo,
+		bookingRepo: bookingRepo,
+		clock:       clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *CalendarFeedService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// CalendarFeedResponse is a user's feed with the URLs to subscribe to
+type CalendarFeedResponse struct {
+	models.CalendarFeed
+	URL       string `json:"url" example:"https://api.example.com/api/v1/calendar/feeds/3f2a9c.ics"`
+	WebcalURL string `json:"webcal_url" example:"webcal://api.example.com/api/v1/calendar/feeds/3f2a9c.ics"` // Opens the subscribe dialog of Apple Calendar
+}
+
+// ========================================================================
+// FEED MANAGEMENT
+// ========================================================================
+
+// GetFeed returns the user's feed, creating it on first use. URLs are
+// built on baseURL, the public URL of this API.
+func (s *CalendarFeedService) GetFeed(ctx context.Context, userID int, baseURL string) (*CalendarFeedResponse, error) {
+	feed, err := s.repo.FindByUserID(ctx, userID)
+	if errors.Is(err, repository.ErrCalendarFeedNotFound) {
+		return s.ResetFeed(ctx, userID, baseURL)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return feedResponse(feed, baseURL), nil
+}
+
+// ResetFeed gives the user's feed a new token; subscriptions to the old URL
+// stop updating
+func (s *CalendarFeedService) ResetFeed(ctx context.Context, userID int, baseURL string) (*CalendarFeedResponse, error) {
+	token, err := newFeedToken()
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &models.CalendarFeed{UserID: userID, Token: token}
+	if err := s.repo.Upsert(ctx, feed); err != nil {
+		return nil, err
+	}
+	return feedResponse(feed, baseURL), nil
+}
+
+// feedResponse adds the subscription URLs to a feed
+func feedResponse(feed *models.CalendarFeed, baseURL string) *CalendarFeedResponse {
+	url := fmt.Sprintf("%s/api/v1/calendar/feeds/%s.ics", strings.TrimSuffix(baseURL, "/"), feed.Token)
+	webcal := url
+	for _, scheme := range []string{"https://", "http://"} {
+		if strings.HasPrefix(url, scheme) {
+			webcal = "webcal://" + strings.TrimPrefix(url, scheme)
+		}
+	}
+	return &CalendarFeedResponse{CalendarFeed: *feed, URL: url, WebcalURL: webcal}
+}
+
+// newFeedToken returns a random URL-safe feed token
+func newFeedToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate calendar feed token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ========================================================================
+// RENDERING
+// ========================================================================
+
+// RenderFeed renders the upcoming bookings of the feed identified by token
+func (s *CalendarFeedService) RenderFeed(ctx context.Context, token string) ([]byte, error) {
+	feed, err := s.repo.FindByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	bookings, err := s.bookingRepo.FindCalendarBookings(ctx, feed.UserID, now, config.CalendarFeedMaxEvents)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar := ical.Calendar{
+		Name:            config.CalendarFeedName,
+		RefreshInterval: config.CalendarFeedRefreshInterval,
+		Events:          make([]ical.Event, len(bookings)),
+	}
+	for i := range bookings {
+		calendar.Events[i] = bookingEvent(&bookings[i], feed.UserID)
+	}
+
+	s.markAccessed(ctx, feed, now)
+	return calendar.Marshal(now), nil
+}
+
+// markAccessed records the fetch; failing to do so doesn't fail the feed
+func (s *CalendarFeedService) markAccessed(ctx context.Context, feed *models.CalendarFeed, at time.Time) {
+	if err := s.repo.MarkAccessed(ctx, feed.ID, at); err != nil {
+		logger.FromContext(ctx).Warn("Failed to record calendar feed access").
+			Int("user_id", feed.UserID).
+			Err(err).
+			Send()
+	}
+}
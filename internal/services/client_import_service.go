@@ -0,0 +1,499 @@
+// internal/services/client_import_service.go
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"net/url"
+	"sort"
+	"strings"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/csvimport"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// ========================================================================
+// CLIENT IMPORT SERVICE - Bringing a shop's client list along
+// ========================================================================
+//
+// Barbers moving to the platform upload their client list as CSV with the
+// columns name, email, phone and notes, plus optional email_consent and
+// sms_consent (yes/no). Every row needs a name and an email or phone.
+// The whole file is checked before anything is saved, so a malformed file
+// imports nothing; after that each row succeeds or fails on its own.
+//
+// Rows are matched to existing accounts by email, then by phone. Clients
+// already on the barber's list, or repeated in the file, are skipped as
+// duplicates, so re-uploading a file is safe. New clients with an email
+// get a customer account without a password, whose notification settings
+// follow their consent flags; those who consented to email are invited to
+// claim it by choosing a password (see UserService.ClaimAccount).
+// ========================================================================
+
+// ClientImportService imports barbers' client lists
+type ClientImportService struct {
+	clientRepo     *repository.BarberClientRepository
+	invitationRepo *repository.CustomerInvitationRepository
+	userRepo       repository.UserStore
+	barberRepo     repository.BarberStore
+	notifications  *NotificationService
+	clock          clock.Clock
+	config         config.ClientImportConfig
+}
+
+// NewClientImportService creates a new client import service
+func NewClientImportService(
+	clientRepo *repository.BarberClientRepository,
+	invitationRepo *repository.CustomerInvitationRepository,
+	userRepo repository.UserStore,
+	barberRepo repository.BarberStore,
+	notifications *NotificationService,
+	cfg config.ClientImportConfig,
+) *ClientImportService {
+	if cfg.InvitationTTL <= 0 {
+		cfg.InvitationTTL = config.DefaultCustomerInvitationTTL
+	}
+	if cfg.ClaimURL == "" {
+		cfg.ClaimURL = config.DefaultCustomerClaimURL
+	}
+	return &ClientImportService{
+		clientRepo:     clientRepo,
+		invitationRepo: invitationRepo,
+		userRepo:       userRepo,
+		barberRepo:     barberRepo,
+		notifications:  notifications,
+		clock:          clock.System,
+		config:         cfg,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *ClientImportService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// ClientImportOptions controls an import
+type ClientImportOptions struct {
+	// SendInvitations emails new clients who consented to email an
+	// invitation to claim their account
+	SendInvitations bool
+}
+
+// ClientImportRowResult says what an import did with one row
+type ClientImportRowResult struct {
+	Line     int    `json:"line"`
+	Name     string `json:"name,omitempty"`
+	Status   string `json:"status"` // created, linked, duplicate, failed
+	ClientID *int   `json:"client_id,omitempty"`
+	UserID   *int   `json:"user_id,omitempty"`
+	Invited  bool   `json:"invited,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// ClientImportResult summarizes an import
+type ClientImportResult struct {
+	Total      int                     `json:"total"`
+	Created    int                     `json:"created"`
+	Linked     int                     `json:"linked"`
+	Duplicates int                     `json:"duplicates"`
+	Failed     int                     `json:"failed"`
+	Invited    int                     `json:"invited"`
+	Rows       []ClientImportRowResult `json:"rows"`
+}
+
+// clientRow is a validated row of an import file
+type clientRow struct {
+	line         int
+	name         string
+	email        *string
+	phone        *string
+	notes        *string
+	emailConsent bool
+	smsConsent   bool
+}
+
+// ========================================================================
+// CLIENT LIST
+// ========================================================================
+
+// authorize ensures the user may manage the barber's clients: admins may
+// manage any barber's, barbers only their own
+func (s *ClientImportService) authorize(ctx context.Context, barberID, userID int, isAdmin bool) (*models.Barber, error) {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return nil, repository.ErrNotOwner
+	}
+	return barber, nil
+}
+
+// ListClients returns a page of a barber's client list and its total size
+func (s *ClientImportService) ListClients(ctx context.Context, barberID, limit, offset, userID int, isAdmin bool) ([]models.BarberClient, int, error) {
+	if _, err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, 0, err
+	}
+	if limit < config.MinPageLimit || limit > config.MaxElevatedPageLimit {
+		limit = config.DefaultPageLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return s.clientRepo.FindByBarberID(ctx, barberID, limit, offset)
+}
+
+// ========================================================================
+// IMPORT
+// ========================================================================
+
+// Import adds the clients in a CSV file to a barber's client list
+func (s *ClientImportService) Import(ctx context.Context, barberID int, file io.Reader, opts ClientImportOptions, userID int, isAdmin bool) (*ClientImportResult, error) {
+	barber, err := s.authorize(ctx, barberID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, result, err := readClientRows(file)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		// Rows repeating an email or phone seen earlier in the file
+		if duplicateInFile(seen, row) {
+			result.add(ClientImportRowResult{Line: row.line, Name: row.name, Status: config.ClientImportDuplicate})
+			continue
+		}
+
+		rowResult, err := s.importRow(ctx, barber, row, opts, userID)
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to import client").
+				Int("barber_id", barberID).
+				Int("line", row.line).
+				Err(err).
+				Send()
+			rowResult = ClientImportRowResult{Line: row.line, Name: row.name, Status: config.ClientImportFailed, Error: err.Error()}
+		}
+		result.add(rowResult)
+	}
+	sort.SliceStable(result.Rows, func(i, j int) bool { return result.Rows[i].Line < result.Rows[j].Line })
+
+	logger.FromContext(ctx).Info("Clients imported").
+		Int("barber_id", barberID).
+		Int("total", result.Total).
+		Int("created", result.Created).
+		Int("linked", result.Linked).
+		Int("duplicates", result.Duplicates).
+		Int("failed", result.Failed).
+		Int("invited", result.Invited).
+		Send()
+	return result, nil
+}
+
+// readClientRows reads and validates a whole import file. Invalid rows are
+// returned as failed results; malformed CSV fails the import.
+func readClientRows(file io.Reader) ([]clientRow, *ClientImportResult, error) {
+	reader, err := csvimport.NewReader(file, "name")
+	if err != nil {
+		return nil, nil, err
+	}
+	if !reader.Has("email") && !reader.Has("phone") {
+		return nil, nil, fmt.Errorf("csv file must have an email or phone column")
+	}
+
+	result := &ClientImportResult{Rows: []ClientImportRowResult{}}
+	var rows []clientRow
+	for count := 0; ; count++ {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if count == config.MaxClientImportRows {
+			return nil, nil, fmt.Errorf("csv file cannot have more than %d clients; split it into several files", config.MaxClientImportRows)
+		}
+
+		row, err := parseClientRow(record)
+		if err != nil {
+			result.add(ClientImportRowResult{Line: record.Line, Name: record.Get("name"), Status: config.ClientImportFailed, Error: err.Error()})
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, result, nil
+}
+
+// parseClientRow validates one row of an import file
+func parseClientRow(record *csvimport.Row) (clientRow, error) {
+	row := clientRow{line: record.Line, name: record.Get("name")}
+	if n := len([]rune(row.name)); n < 2 || n > 100 {
+		return row, fmt.Errorf("name must be between 2 and 100 characters")
+	}
+
+	var err error
+	if row.email, err = importedEmail(record.Get("email")); err != nil {
+		return row, err
+	}
+	if row.phone, err = importedPhone(record.Get("phone")); err != nil {
+		return row, err
+	}
+	if row.email == nil && row.phone == nil {
+		return row, fmt.Errorf("email or phone is required")
+	}
+
+	if v := record.Get("notes"); v != "" {
+		if len([]rune(v)) > 1000 {
+			return row, fmt.Errorf("notes cannot be longer than 1000 characters")
+		}
+		row.notes = &v
+	}
+
+	if row.emailConsent, err = record.Bool("email_consent"); err != nil {
+		return row, err
+	}
+	if row.smsConsent, err = record.Bool("sms_consent"); err != nil {
+		return row, err
+	}
+	return row, nil
+}
+
+// importedEmail normalizes an email address from an import file (nil when
+// empty)
+func importedEmail(v string) (*string, error) {
+	if v == "" {
+		return nil, nil
+	}
+	email := models.NormalizeAddress(config.NotificationChannelEmail, v)
+	if addr, err := mail.ParseAddress(email); err != nil || addr.Address != email {
+		return nil, fmt.Errorf("email %q must be a valid email address", v)
+	}
+	return &email, nil
+}
+
+// importedPhone normalizes a phone number from an import file (nil when
+// empty)
+func importedPhone(v string) (*string, error) {
+	if v == "" {
+		return nil, nil
+	}
+	phone := models.NormalizeAddress(config.NotificationChannelSMS, v)
+	if digits := len(strings.TrimPrefix(phone, "+")); digits < 7 || digits > 15 {
+		return nil, fmt.Errorf("phone %q must have between 7 and 15 digits", v)
+	}
+	return &phone, nil
+}
+
+// duplicateInFile reports whether a row repeats an email or phone of an
+// earlier row, and remembers the row's
+func duplicateInFile(seen map[string]bool, row clientRow) bool {
+	var keys []string
+	if row.email != nil {
+		keys = append(keys, "email:"+*row.email)
+	}
+	if row.phone != nil {
+		keys = append(keys, "phone:"+*row.phone)
+	}
+	for _, key := range keys {
+		if seen[key] {
+			return true
+		}
+	}
+	for _, key := range keys {
+		seen[key] = true
+	}
+	return false
+}
+
+// importRow adds one client to the barber's list, linking or creating
+// their account
+func (s *ClientImportService) importRow(ctx context.Context, barber *models.Barber, row clientRow, opts ClientImportOptions, importedBy int) (ClientImportRowResult, error) {
+	result := ClientImportRowResult{Line: row.line, Name: row.name}
+
+	user, err := matchCustomer(ctx, s.userRepo, row.email, row.phone)
+	if err != nil {
+		return result, err
+	}
+	var userID *int
+	if user != nil {
+		userID = &user.ID
+	}
+
+	exists, err := s.clientRepo.Exists(ctx, barber.ID, userID, row.email, row.phone)
+	if err != nil {
+		return result, err
+	}
+	if exists {
+		result.Status = config.ClientImportDuplicate
+		result.UserID = userID
+		return result, nil
+	}
+
+	result.Status = config.ClientImportLinked
+	created := false
+	if user == nil && row.email != nil {
+		if user, err = s.createCustomer(ctx, row, importedBy); err != nil {
+			return result, err
+		}
+		userID = &user.ID
+		created = true
+	}
+	if user == nil || created {
+		result.Status = config.ClientImportCreated
+	}
+
+	client := &models.BarberClient{
+		BarberID:     barber.ID,
+		UserID:       userID,
+		Name:         row.name,
+		Email:        row.email,
+		Phone:        row.phone,
+		Notes:        row.notes,
+		EmailConsent: row.emailConsent,
+		SMSConsent:   row.smsConsent,
+		Source:       config.ClientSourceImport,
+		CreatedBy:    &importedBy,
+	}
+	if err := s.clientRepo.Create(ctx, client); err != nil {
+		if errors.Is(err, repository.ErrDuplicateClient) {
+			result.Status = config.ClientImportDuplicate
+			return result, nil
+		}
+		return result, err
+	}
+	result.ClientID = &client.ID
+	result.UserID = userID
+
+	if created && opts.SendInvitations && row.emailConsent {
+		// The client is imported either way; a failed invitation is only logged
+		if err := s.invite(ctx, barber, user); err != nil {
+			logger.FromContext(ctx).Warn("Failed to invite imported client").
+				Int("barber_id", barber.ID).
+				Int("user_id", user.ID).
+				Err(err).
+				Send()
+		} else {
+			result.Invited = true
+		}
+	}
+	return result, nil
+}
+
+// matchCustomer finds the existing account of an imported client by
+// email, then by phone. It returns nil when there is none.
+func matchCustomer(ctx context.Context, userRepo repository.UserStore, email, phone *string) (*models.User, error) {
+	if email != nil {
+		user, err := userRepo.FindByEmail(ctx, *email)
+		if err == nil {
+			return user, nil
+		}
+		if !errors.Is(err, repository.ErrUserNotFound) {
+			return nil, err
+		}
+	}
+	if phone != nil {
+		user, err := userRepo.FindByPhone(ctx, *phone)
+		if err == nil {
+			return user, nil
+		}
+		if !errors.Is(err, repository.ErrUserNotFound) {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+// createCustomer creates an account for an imported client. It has no
+// password until the client claims it, so nobody can sign in to it.
+func (s *ClientImportService) createCustomer(ctx context.Context, row clientRow, importedBy int) (*models.User, error) {
+	user := &models.User{
+		UUID:         uuid.New().String(),
+		Email:        *row.email,
+		PasswordHash: "", // Set when the client claims the account
+		Name:         row.name,
+		Phone:        row.phone,
+		UserType:     config.UserTypeCustomer,
+		Status:       config.UserStatusActive,
+		Preferences: models.JSONMap{
+			"language": "en",
+			"timezone": "UTC",
+		},
+		NotificationSettings: models.JSONMap{
+			"email": row.emailConsent,
+			"sms":   row.smsConsent,
+			"push":  true,
+		},
+		CreatedBy: &importedBy,
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to create customer account: %w", err)
+	}
+	return user, nil
+}
+
+// invite issues an invitation to claim an imported account and emails it
+func (s *ClientImportService) invite(ctx context.Context, barber *models.Barber, user *models.User) error {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	barberID := barber.ID
+	invitation := &models.CustomerInvitation{
+		UserID:    user.ID,
+		BarberID:  &barberID,
+		TokenHash: hashInvitationToken(token),
+		ExpiresAt: s.clock.Now().Add(s.config.InvitationTTL),
+	}
+	if err := s.invitationRepo.Create(ctx, invitation); err != nil {
+		return err
+	}
+	return s.notifications.SendCustomerInvitation(ctx, user.ID, barberDisplayName(barber), s.claimURL(token), invitation.ExpiresAt)
+}
+
+// claimURL returns the link an invitation sends the client to
+func (s *ClientImportService) claimURL(token string) string {
+	sep := "?"
+	if strings.Contains(s.config.ClaimURL, "?") {
+		sep = "&"
+	}
+	return s.config.ClaimURL + sep + "token=" + url.QueryEscape(token)
+}
+
+// add records a row's outcome in the summary
+func (r *ClientImportResult) add(row ClientImportRowResult) {
+	r.Total++
+	switch row.Status {
+	case config.ClientImportCreated:
+		r.Created++
+	case config.ClientImportLinked:
+		r.Linked++
+	case config.ClientImportDuplicate:
+		r.Duplicates++
+	case config.ClientImportFailed:
+		r.Failed++
+	}
+	if row.Invited {
+		r.Invited++
+	}
+	r.Rows = append(r.Rows, row)
+}
@@ -0,0 +1,222 @@
+// internal/services/commission_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// COMMISSION SERVICE - Assistants' shares, earnings and payouts
+// ========================================================================
+//
+// A booking's barber (or an admin) can record assistants who performed
+// part of the work, each with a percentage of the booking. Earnings
+// reports credit every barber with their share of the completed bookings
+// they worked on and work out the payout after the platform commission.
+// ========================================================================
+
+// CommissionService manages booking staff splits and earnings reports
+type CommissionService struct {
+	repo       *repository.CommissionRepository
+	bookings   *BookingService
+	barberRepo repository.BarberStore
+	clock      clock.Clock
+}
+
+// NewCommissionService creates a new commission service
+func NewCommissionService(
+	repo *repository.CommissionRepository,
+	bookings *BookingService,
+	barberRepo repository.BarberStore,
+) *CommissionService {
+	return &CommissionService{
+		repo:       repo,
+		bookings:   bookings,
+		barberRepo: barberRepo,
+		clock:      clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *CommissionService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// AssistantShare is an assistant's percentage of a booking
+type AssistantShare struct {
+	BarberID     int     `json:"barber_id" binding:"required" example:"7"`
+	SharePercent float64 `json:"share_percent" binding:"required" example:"30"`
+}
+
+// BookingStaffRequest replaces the assistants on a booking. The booking's
+// barber keeps what the assistants don't get; an empty list gives it all
+// back to them.
+type BookingStaffRequest struct {
+	Assistants []AssistantShare `json:"assistants"`
+}
+
+// BookingStaffResponse lists everyone who worked on a booking, lead first
+type BookingStaffResponse struct {
+	BookingID int                   `json:"booking_id"`
+	Staff     []models.BookingStaff `json:"staff"`
+}
+
+// EarningsQuery selects an earnings report date range (UTC days, inclusive)
+type EarningsQuery struct {
+	From string `form:"from" example:"2024-06-01"` // Default: 29 days before to
+	To   string `form:"to" example:"2024-06-30"`   // Default: today
+}
+
+// ========================================================================
+// BOOKING STAFF
+// ========================================================================
+
+// GetBookingStaff lists who worked on a booking the actor may access
+func (s *CommissionService) GetBookingStaff(ctx context.Context, bookingID int, actor BookingActor) (*BookingStaffResponse, error) {
+	booking, err := s.bookings.AuthorizeBooking(ctx, bookingID, actor)
+	if err != nil {
+		return nil, err
+	}
+	barber, err := s.barberRepo.FindByID(ctx, booking.BarberID)
+	if err != nil {
+		return nil, err
+	}
+	return s.bookingStaff(ctx, booking, barber)
+}
+
+// SetBookingStaff replaces the assistants on a booking. Only the booking's
+// barber and admins may split a booking.
+func (s *CommissionService) SetBookingStaff(ctx context.Context, bookingID int, req BookingStaffRequest, actor BookingActor) (*BookingStaffResponse, error) {
+	booking, err := s.bookings.AuthorizeBooking(ctx, bookingID, actor)
+	if err != nil {
+		return nil, err
+	}
+	barber, err := s.barberRepo.FindByID(ctx, booking.BarberID)
+	if err != nil {
+		return nil, err
+	}
+	if !actor.Override && barber.UserID != actor.UserID {
+		return nil, repository.ErrNotOwner
+	}
+	if booking.IsCancelled() || booking.Status == config.BookingStatusNoShow {
+		return nil, fmt.Errorf("staff cannot be changed on a %s booking", booking.Status)
+	}
+
+	assistants, err := s.validateAssistants(ctx, booking, req.Assistants)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.ReplaceAssistants(ctx, booking.ID, assistants); err != nil {
+		return nil, err
+	}
+	return s.bookingStaff(ctx, booking, barber)
+}
+
+// bookingStaff lists the lead with their remaining share, then the assistants
+func (s *CommissionService) bookingStaff(ctx context.Context, booking *models.Booking, barber *models.Barber) (*BookingStaffResponse, error) {
+	assistants, err := s.repo.FindAssistants(ctx, booking.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	lead := models.BookingStaff{
+		BookingID:    booking.ID,
+		BarberID:     booking.BarberID,
+		BarberName:   &barber.ShopName,
+		Role:         config.StaffRoleLead,
+		SharePercent: models.LeadSharePercent(assistants),
+		CreatedAt:    booking.CreatedAt,
+	}
+	return &BookingStaffResponse{
+		BookingID: booking.ID,
+		Staff:     append([]models.BookingStaff{lead}, assistants...),
+	}, nil
+}
+
+// validateAssistants checks each assistant is another existing barber,
+// listed once, and that the lead keeps part of the booking
+func (s *CommissionService) validateAssistants(ctx context.Context, booking *models.Booking, shares []AssistantShare) ([]models.BookingStaff, error) {
+	if len(shares) > config.MaxBookingAssistants {
+		return nil, fmt.Errorf("booking cannot have more than %d assistants", config.MaxBookingAssistants)
+	}
+
+	assistants := make([]models.BookingStaff, 0, len(shares))
+	seen := make(map[int]bool, len(shares))
+	total := 0.0
+	for _, share := range shares {
+		if share.BarberID == booking.BarberID {
+			return nil, fmt.Errorf("the booking's barber cannot also be an assistant")
+		}
+		if seen[share.BarberID] {
+			return nil, fmt.Errorf("assistant %d cannot be listed twice", share.BarberID)
+		}
+		seen[share.BarberID] = true
+
+		percent := math.Round(share.SharePercent*100) / 100
+		if percent <= 0 || percent >= 100 {
+			return nil, fmt.Errorf("share_percent must be between 0 and 100")
+		}
+		total += percent
+
+		if _, err := s.barberRepo.FindByID(ctx, share.BarberID); err != nil {
+			if errors.Is(err, repository.ErrBarberNotFound) {
+				return nil, fmt.Errorf("assistant %d must be an existing barber", share.BarberID)
+			}
+			return nil, err
+		}
+
+		assistants = append(assistants, models.BookingStaff{
+			BookingID:    booking.ID,
+			BarberID:     share.BarberID,
+			Role:         config.StaffRoleAssistant,
+			SharePercent: percent,
+		})
+	}
+
+	if total >= 100 {
+		return nil, fmt.Errorf("assistants' shares must add up to less than 100 percent")
+	}
+	return assistants, nil
+}
+
+// ========================================================================
+// EARNINGS
+// ========================================================================
+
+// GetEarnings reports a barber's share of the completed bookings they
+// worked on over a date range, with the payout after platform commission.
+// Barbers may only see their own.
+func (s *CommissionService) GetEarnings(ctx context.Context, barberID int, query EarningsQuery, userID int, isAdmin bool) (*models.EarningsReport, error) {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return nil, repository.ErrNotOwner
+	}
+
+	from, to, err := resolveDayRange(s.clock.Now(), query.From, query.To,
+		config.DefaultEarningsRangeDays, config.MaxEarningsRangeDays)
+	if err != nil {
+		return nil, err
+	}
+
+	// The range is inclusive of the last day
+	lines, err := s.repo.FindEarningsLines(ctx, barberID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	return models.NewEarningsReport(barberID, from, to, barber.CommissionRate, lines), nil
+}
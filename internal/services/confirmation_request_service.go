@@ -0,0 +1,245 @@
+// internal/services/confirmation_request_service.go
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// CONFIRMATION REQUEST SERVICE - Extra check-in for likely no-shows
+// ========================================================================
+//
+// A customer's no-show risk is the share of their recent past appointments
+// that ended as no-shows. When a confirmed booking enters the reminder
+// window (24h before by default) and its customer is high risk, they get
+// an extra notification asking them to confirm or cancel. The token in the
+// notification answers it without logging in. Cancelling cancels the
+// booking right away, so the slot is released for other customers instead
+// of sitting empty until the no-show.
+// ========================================================================
+
+// ConfirmationRequestService handles no-show risk confirmation requests
+type ConfirmationRequestService struct {
+	repo                *repository.ConfirmationRequestRepository
+	bookingRepo         repository.BookingStore
+	bookingService      *BookingService
+	notificationService *NotificationService
+	clock               clock.Clock
+	config              config.NoShowRiskConfig
+}
+
+// NewConfirmationRequestService creates a new confirmation request service.
+// Unset settings fall back to the defaults.
+func NewConfirmationRequestService(
+	repo *repository.ConfirmationRequestRepository,
+	bookingRepo repository.BookingStore,
+	bookingService *BookingService,
+	notificationService *NotificationService,
+	cfg config.NoShowRiskConfig,
+) *ConfirmationRequestService {
+	if cfg.Threshold <= 0 {
+		cfg.Threshold = config.DefaultNoShowRiskThreshold
+	}
+	if cfg.MinBookings <= 0 {
+		cfg.MinBookings = config.DefaultNoShowRiskMinBookings
+	}
+	if cfg.LookbackBookings <= 0 {
+		cfg.LookbackBookings = config.DefaultNoShowRiskLookbackBookings
+	}
+	if cfg.HoursBefore <= 0 {
+		cfg.HoursBefore = config.DefaultConfirmationRequestHoursBefore
+	}
+	return &ConfirmationRequestService{
+		repo:                repo,
+		bookingRepo:         bookingRepo,
+		bookingService:      bookingService,
+		notificationService: notificationService,
+		clock:               clock.System,
+		config:              cfg,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *ConfirmationRequestService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// ConfirmationResponseResult confirms a customer's answer
+type ConfirmationResponseResult struct {
+	BookingID     int           `json:"booking_id"`
+	Response      string        `json:"response"` // confirmed, cancelled
+	BookingStatus string        `json:"booking_status"`
+	RespondedAt   time.Time     `json:"responded_at"`
+	Refund        *RefundResult `json:"refund,omitempty"` // Set when cancelling a prepaid booking
+}
+
+// ========================================================================
+// DISPATCH
+// ========================================================================
+
+// RunScheduled sends confirmation requests for high-risk bookings that have
+// entered the confirmation window. A failure for one booking is logged and
+// skipped so the rest still go out.
+func (s *ConfirmationRequestService) RunScheduled(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+	now := s.clock.Now()
+
+	candidates, err := s.repo.FindCandidates(ctx, now, now.Add(time.Duration(s.config.HoursBefore)*time.Hour), s.config.LookbackBookings)
+	if err != nil {
+		return err
+	}
+
+	for i := range candidates {
+		candidate := &candidates[i]
+		if !candidate.IsHighRisk(s.config.Threshold, s.config.MinBookings) {
+			continue
+		}
+		if err := s.sendRequest(ctx, candidate, now); err != nil {
+			log.Warn("Failed to send confirmation request").
+				Int("booking_id", candidate.BookingID).
+				Err(err).
+				Send()
+		}
+	}
+	return nil
+}
+
+// sendRequest records and sends the confirmation request for one booking
+func (s *ConfirmationRequestService) sendRequest(ctx context.Context, candidate *models.ConfirmationCandidate, now time.Time) error {
+	booking, err := s.bookingRepo.FindByID(ctx, candidate.BookingID)
+	if err != nil {
+		return err
+	}
+
+	token, err := newConfirmationToken()
+	if err != nil {
+		return err
+	}
+	request := &models.ConfirmationRequest{
+		Token:      token,
+		BookingID:  booking.ID,
+		CustomerID: candidate.CustomerID,
+		NoShowRisk: candidate.Risk(),
+		SentAt:     now,
+		ExpiresAt:  booking.ScheduledStartTime,
+	}
+
+	created, err := s.repo.Create(ctx, request)
+	if err != nil || !created {
+		return err
+	}
+
+	if err := s.notificationService.SendConfirmationRequest(ctx, booking, request); err != nil {
+		return fmt.Errorf("failed to send confirmation request: %w", err)
+	}
+
+	logger.FromContext(ctx).Info("Confirmation request sent").
+		Int("booking_id", booking.ID).
+		Int("customer_id", request.CustomerID).
+		Float64("no_show_risk", request.NoShowRisk).
+		Send()
+	return nil
+}
+
+// newConfirmationToken returns a random URL-safe confirmation token
+func newConfirmationToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate confirmation token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ========================================================================
+// RESPONSES
+// ========================================================================
+
+// Confirm records that the customer is coming
+func (s *ConfirmationRequestService) Confirm(ctx context.Context, token string) (*ConfirmationResponseResult, error) {
+	return s.respond(ctx, token, config.ConfirmationResponseConfirmed)
+}
+
+// Cancel cancels the booking on the customer's behalf, releasing the slot
+func (s *ConfirmationRequestService) Cancel(ctx context.Context, token string) (*ConfirmationResponseResult, error) {
+	return s.respond(ctx, token, config.ConfirmationResponseCancelled)
+}
+
+// respond records the answer to the request identified by token. Each
+// request can be answered once, until the appointment starts.
+func (s *ConfirmationRequestService) respond(ctx context.Context, token, response string) (*ConfirmationResponseResult, error) {
+	request, err := s.repo.FindByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	if request.IsAnswered() || request.IsExpired(now) {
+		return nil, repository.ErrConfirmationRequestClosed
+	}
+
+	booking, err := s.bookingRepo.FindByID(ctx, request.BookingID)
+	if err != nil {
+		return nil, err
+	}
+	if booking.Status != config.BookingStatusConfirmed {
+		return nil, fmt.Errorf("booking must be confirmed to answer a confirmation request (it is %s)", booking.Status)
+	}
+
+	result := &ConfirmationResponseResult{
+		BookingID:     booking.ID,
+		Response:      response,
+		BookingStatus: booking.Status,
+		RespondedAt:   now,
+	}
+
+	// The answer is recorded first so a second tap cannot act twice
+	if err := s.repo.RecordResponse(ctx, request.ID, response, now); err != nil {
+		return nil, err
+	}
+
+	customerID := request.CustomerID
+	if response == config.ConfirmationResponseCancelled {
+		cancelled, err := s.bookingService.CancelBooking(ctx, booking.ID, CancelBookingRequest{
+			Reason:       "Cancelled from no-show risk confirmation request",
+			IsByCustomer: true,
+		}, &customerID)
+		if err != nil {
+			return nil, err
+		}
+		result.BookingStatus = cancelled.Status
+		result.Refund = cancelled.Refund
+	} else {
+		history := &models.BookingHistory{
+			BookingID:  booking.ID,
+			ChangedBy:  &customerID,
+			ChangeType: "attendance_confirmed",
+			NewValues:  models.JSONMap{"confirmation_request_id": request.ID},
+		}
+		if err := s.bookingRepo.CreateHistory(ctx, history); err != nil {
+			logger.FromContext(ctx).Warn("Failed to create booking history").
+				Int("booking_id", booking.ID).
+				Err(err).
+				Send()
+		}
+	}
+
+	logger.FromContext(ctx).Info("Confirmation request answered").
+		Int("booking_id", booking.ID).
+		Str("response", response).
+		Send()
+	return result, nil
+}
@@ -0,0 +1,559 @@
+// internal/services/consultation_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/storage"
+)
+
+// ========================================================================
+// CONSULTATION SERVICE - Bookings that need a consultation first
+// ========================================================================
+//
+// Barbers can ask required questions on services that require a
+// consultation (see BookingFormService). When a customer's answers call
+// for a consultation, the booking is not made: a consultation request
+// goes to the barber instead, who follows up with the customer and marks
+// the request booked or declined. The customer is told either way.
+//
+// Customers can also ask for a consultation themselves, suggesting times
+// and attaching photos. The barber answers with a quote (a price and a
+// proposed time) or declines; a customer who accepts the quote is booked
+// in at the quoted price. Quotes can be revised until they are accepted.
+// ========================================================================
+
+// ConsultationService manages consultation requests
+type ConsultationService struct {
+	repo        *repository.ConsultationRequestRepository
+	barberRepo  repository.BarberStore
+	serviceRepo repository.ServiceStore
+	userRepo    repository.UserStore
+	bookings    *BookingService
+	storage     storage.Storage
+	clock       clock.Clock
+
+	// Tells barbers about new requests and customers about answers
+	// (optional)
+	notifications *NotificationService
+}
+
+// NewConsultationService creates a new consultation service
+func NewConsultationService(
+	repo *repository.ConsultationRequestRepository,
+	barberRepo repository.BarberStore,
+	serviceRepo repository.ServiceStore,
+	userRepo repository.UserStore,
+	bookings *BookingService,
+	store storage.Storage,
+) *ConsultationService {
+	return &ConsultationService{
+		repo:        repo,
+		barberRepo:  barberRepo,
+		serviceRepo: serviceRepo,
+		userRepo:    userRepo,
+		bookings:    bookings,
+		storage:     store,
+		clock:       clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *ConsultationService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// SetNotifications tells barbers about new requests and customers about
+// the barber's answer (nil disables it)
+func (s *ConsultationService) SetNotifications(notifications *NotificationService) {
+	s.notifications = notifications
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// ConsultationRequiredError is returned instead of a booking when answers
+// to a service's booking questions call for a consultation. Request is
+// the consultation request the barber got instead.
+type ConsultationRequiredError struct {
+	Request *models.ConsultationRequest
+}
+
+func (e *ConsultationRequiredError) Error() string {
+	return fmt.Sprintf("%s needs a consultation with the barber before it can be booked", e.Request.ServiceName)
+}
+
+// ConsultationRequestListRequest filters a barber's or a customer's
+// consultation requests
+type ConsultationRequestListRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=open quoted booked declined"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int    `form:"offset" binding:"omitempty,min=0"`
+}
+
+// SubmitConsultationRequest asks a barber for a consultation about one of
+// their services that requires one
+type SubmitConsultationRequest struct {
+	BarberServiceID int         `json:"barber_service_id" binding:"required,min=1" example:"12"`
+	PreferredTimes  []time.Time `json:"preferred_times" binding:"required,min=1,max=5"` // Up to 5, all in the future
+	Notes           *string     `json:"notes" binding:"omitempty,max=1000" example:"Thinking of going platinum from dark brown"`
+}
+
+// ConsultationQuoteRequest quotes a price and proposes a time for a
+// consultation request
+type ConsultationQuoteRequest struct {
+	Price     float64   `json:"price" binding:"min=0" example:"120"`
+	StartTime time.Time `json:"start_time" binding:"required"`
+	Notes     *string   `json:"notes" binding:"omitempty,max=1000" example:"Includes a patch test 48 hours before"`
+}
+
+// AcceptConsultationQuoteRequest accepts a consultation quote as a booking
+type AcceptConsultationQuoteRequest struct {
+	// Provider token for the card holding the deposit, when the service
+	// requires one
+	DepositPaymentMethodID string `json:"deposit_payment_method_id"`
+}
+
+// ConsultationResponseRequest answers a consultation request
+type ConsultationResponseRequest struct {
+	Status string  `json:"status" binding:"required,oneof=booked declined" example:"booked"`
+	Notes  *string `json:"notes" binding:"omitempty,max=1000" example:"Called to book a patch test on Friday"`
+}
+
+// ========================================================================
+// REQUESTS
+// ========================================================================
+
+// Create saves a new request and tells the barber
+func (s *ConsultationService) Create(ctx context.Context, request *models.ConsultationRequest) error {
+	request.Status = config.ConsultationRequestOpen
+	if err := s.repo.Create(ctx, request); err != nil {
+		return err
+	}
+
+	logger.FromContext(ctx).Info("Consultation requested instead of booking").
+		Int("consultation_request_id", request.ID).
+		Int("barber_id", request.BarberID).
+		Strs("triggered_by", request.TriggeredBy).
+		Send()
+
+	s.notifyBarber(ctx, request)
+	return nil
+}
+
+// List returns a page of a barber's requests, newest first. Only the
+// barber themselves or an admin may see them.
+func (s *ConsultationService) List(ctx context.Context, barberID, userID int, isAdmin bool, req *ConsultationRequestListRequest) ([]models.ConsultationRequest, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	if req.Limit == 0 {
+		req.Limit = config.DefaultPageLimit
+	}
+	return s.repo.FindAll(ctx, repository.ConsultationRequestFilters{
+		BarberID: barberID,
+		Status:   req.Status,
+		Limit:    req.Limit,
+		Offset:   req.Offset,
+	})
+}
+
+// Find returns one of a barber's requests with the customer's photos.
+// Only the barber themselves or an admin may see it.
+func (s *ConsultationService) Find(ctx context.Context, barberID, id, userID int, isAdmin bool) (*models.ConsultationRequest, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	request, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.BarberID != barberID {
+		return nil, repository.ErrConsultationRequestNotFound
+	}
+	if request.Photos, err = s.repo.FindPhotos(ctx, id); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// Respond marks a pending request booked or declined and tells the
+// customer
+func (s *ConsultationService) Respond(ctx context.Context, barberID, id int, req ConsultationResponseRequest, userID int, isAdmin bool) (*models.ConsultationRequest, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	request, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.BarberID != barberID {
+		return nil, repository.ErrConsultationRequestNotFound
+	}
+	if !request.IsPending() {
+		return nil, fmt.Errorf("consultation request cannot be answered: it is already %s", request.Status)
+	}
+
+	request.Status = req.Status
+	request.ResponseNotes = trimNotes(req.Notes)
+	request.RespondedBy = &userID
+	if err := s.repo.Respond(ctx, request); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Consultation request answered").
+		Int("consultation_request_id", request.ID).
+		Int("barber_id", barberID).
+		Str("status", request.Status).
+		Send()
+
+	s.notifyCustomer(ctx, request)
+	return request, nil
+}
+
+// Quote answers a pending request with a price and a proposed time,
+// replacing any earlier quote, and tells the customer
+func (s *ConsultationService) Quote(ctx context.Context, barberID, id int, req ConsultationQuoteRequest, userID int, isAdmin bool) (*models.ConsultationRequest, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	request, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.BarberID != barberID {
+		return nil, repository.ErrConsultationRequestNotFound
+	}
+	if !request.IsPending() {
+		return nil, fmt.Errorf("consultation request cannot be quoted: it is already %s", request.Status)
+	}
+	if request.BarberServiceID == nil {
+		return nil, fmt.Errorf("consultation request cannot be quoted: %s is no longer offered", request.ServiceName)
+	}
+	if !req.StartTime.After(s.clock.Now()) {
+		return nil, fmt.Errorf("proposed time must be in the future")
+	}
+
+	startTime := req.StartTime.UTC()
+	request.QuotedPrice = &req.Price
+	request.ProposedStartTime = &startTime
+	request.ResponseNotes = trimNotes(req.Notes)
+	request.RespondedBy = &userID
+	if err := s.repo.Quote(ctx, request); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Consultation request quoted").
+		Int("consultation_request_id", request.ID).
+		Int("barber_id", barberID).
+		Float64("quoted_price", req.Price).
+		Time("proposed_start_time", startTime).
+		Send()
+
+	s.notifyQuoted(ctx, request)
+	return request, nil
+}
+
+// ========================================================================
+// CUSTOMER REQUESTS
+// ========================================================================
+
+// Submit asks the barber of a service that requires a consultation for
+// one, at one of the customer's preferred times, and tells the barber
+func (s *ConsultationService) Submit(ctx context.Context, customerID int, req SubmitConsultationRequest) (*models.ConsultationRequest, error) {
+	barberService, err := s.serviceRepo.FindBarberServiceByID(ctx, req.BarberServiceID)
+	if err != nil {
+		return nil, err
+	}
+	if !barberService.IsActive {
+		return nil, fmt.Errorf("service is not currently offered")
+	}
+	if !barberService.NeedsConsultation() {
+		return nil, fmt.Errorf("%s does not need a consultation: book it directly", getServiceName(barberService))
+	}
+	times, err := s.preferredTimes(req.PreferredTimes)
+	if err != nil {
+		return nil, err
+	}
+	customer, err := s.userRepo.FindByID(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &models.ConsultationRequest{
+		BarberID:           barberService.BarberID,
+		BarberServiceID:    &barberService.ID,
+		ServiceName:        getServiceName(barberService),
+		CustomerID:         &customerID,
+		CustomerName:       &customer.Name,
+		CustomerEmail:      &customer.Email,
+		CustomerPhone:      customer.Phone,
+		RequestedStartTime: times[0],
+		Answers:            models.BookingFormAnswers{},
+		TriggeredBy:        models.StringArray{},
+		Notes:              trimNotes(req.Notes),
+		PreferredTimes:     times,
+	}
+	if err := s.Create(ctx, request); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// ListMine returns a page of the customer's requests, newest first
+func (s *ConsultationService) ListMine(ctx context.Context, customerID int, req *ConsultationRequestListRequest) ([]models.ConsultationRequest, error) {
+	if req.Limit == 0 {
+		req.Limit = config.DefaultPageLimit
+	}
+	return s.repo.FindAll(ctx, repository.ConsultationRequestFilters{
+		CustomerID: customerID,
+		Status:     req.Status,
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+	})
+}
+
+// Get returns one of the customer's requests with its photos
+func (s *ConsultationService) Get(ctx context.Context, customerID, id int) (*models.ConsultationRequest, error) {
+	request, err := s.findOwn(ctx, customerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.Photos, err = s.repo.FindPhotos(ctx, id); err != nil {
+		return nil, err
+	}
+	return request, nil
+}
+
+// AttachPhoto stores a photo and its thumbnail and adds them to the
+// customer's pending request
+func (s *ConsultationService) AttachPhoto(ctx context.Context, customerID, id int, data []byte) (*models.ConsultationPhoto, error) {
+	request, err := s.findOwn(ctx, customerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if !request.IsPending() {
+		return nil, fmt.Errorf("photos cannot be added: the consultation request is already %s", request.Status)
+	}
+
+	// Check the limit before the work of decoding; CreatePhoto enforces it
+	existing, err := s.repo.FindPhotos(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= config.MaxConsultationPhotos {
+		return nil, repository.ErrConsultationPhotoLimit
+	}
+
+	stored, err := storeImage(ctx, s.storage, fmt.Sprintf("consultations/%d", id), data)
+	if err != nil {
+		return nil, err
+	}
+	photo := &models.ConsultationPhoto{
+		ConsultationRequestID: id,
+		ImageKey:              stored.ImageKey,
+		ThumbnailKey:          stored.ThumbnailKey,
+		URL:                   stored.URL,
+		ThumbnailURL:          stored.ThumbnailURL,
+		ContentType:           stored.ContentType,
+		SizeBytes:             stored.SizeBytes,
+		Width:                 stored.Width,
+		Height:                stored.Height,
+		UploadedBy:            &customerID,
+	}
+	if err := s.repo.CreatePhoto(ctx, photo, config.MaxConsultationPhotos); err != nil {
+		removeStoredFiles(ctx, s.storage, photo.ImageKey, photo.ThumbnailKey)
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Consultation photo attached").
+		Int("consultation_request_id", id).
+		Int("photo_id", photo.ID).
+		Int("size_bytes", photo.SizeBytes).
+		Send()
+
+	return photo, nil
+}
+
+// Accept books the customer in at the quoted price and time. The quote is
+// claimed first so it is booked only once; if the booking cannot be made
+// (the time was taken meanwhile, say) the quote stays open to accept.
+func (s *ConsultationService) Accept(ctx context.Context, customerID, id int, req AcceptConsultationQuoteRequest) (*BookingResponse, error) {
+	log := logger.FromContext(ctx)
+
+	request, err := s.findOwn(ctx, customerID, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := request.CanAccept(s.clock.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.ClaimQuote(ctx, request); err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookings.CreateBooking(ctx, CreateBookingRequest{
+		BarberID:               request.BarberID,
+		ServiceID:              *request.BarberServiceID,
+		StartTime:              *request.ProposedStartTime,
+		CustomerID:             &customerID,
+		CustomerName:           request.CustomerName,
+		CustomerEmail:          request.CustomerEmail,
+		CustomerPhone:          request.CustomerPhone,
+		Notes:                  request.Notes,
+		ServicePrice:           request.QuotedPrice,
+		DepositPaymentMethodID: req.DepositPaymentMethodID,
+		consultation:           request,
+	}, &customerID)
+	if err != nil {
+		if releaseErr := s.repo.ReleaseQuote(ctx, request.ID); releaseErr != nil {
+			log.Error(releaseErr).
+				Int("consultation_request_id", request.ID).
+				Msg("Failed to release consultation quote")
+		}
+		return nil, err
+	}
+
+	request.Status = config.ConsultationRequestBooked
+	request.BookingID = &booking.ID
+	if err := s.repo.SetBooking(ctx, request); err != nil {
+		// The booking stands; only the link back to it is missing
+		log.Warn("Failed to link consultation request to its booking").
+			Int("consultation_request_id", request.ID).
+			Int("booking_id", booking.ID).
+			Err(err).
+			Send()
+	}
+
+	log.Info("Consultation quote accepted").
+		Int("consultation_request_id", request.ID).
+		Int("booking_id", booking.ID).
+		Float64("quoted_price", *request.QuotedPrice).
+		Send()
+
+	return booking, nil
+}
+
+// findOwn returns one of the customer's requests; other customers'
+// requests are reported missing
+func (s *ConsultationService) findOwn(ctx context.Context, customerID, id int) (*models.ConsultationRequest, error) {
+	request, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if request.CustomerID == nil || *request.CustomerID != customerID {
+		return nil, repository.ErrConsultationRequestNotFound
+	}
+	return request, nil
+}
+
+// preferredTimes checks a customer's suggested times and returns them in
+// UTC, earliest first, without repeats
+func (s *ConsultationService) preferredTimes(times []time.Time) (models.PreferredTimes, error) {
+	if len(times) == 0 {
+		return nil, fmt.Errorf("at least one preferred time is required")
+	}
+	if len(times) > config.MaxConsultationPreferredTimes {
+		return nil, fmt.Errorf("at most %d preferred times can be suggested", config.MaxConsultationPreferredTimes)
+	}
+
+	now := s.clock.Now()
+	seen := make(map[time.Time]bool, len(times))
+	preferred := make(models.PreferredTimes, 0, len(times))
+	for _, t := range times {
+		t = t.UTC()
+		if !t.After(now) {
+			return nil, fmt.Errorf("preferred times must be in the future")
+		}
+		if !seen[t] {
+			seen[t] = true
+			preferred = append(preferred, t)
+		}
+	}
+	sort.Slice(preferred, func(i, j int) bool { return preferred[i].Before(preferred[j]) })
+	return preferred, nil
+}
+
+// trimNotes trims notes, dropping empty ones
+func trimNotes(notes *string) *string {
+	if notes == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*notes)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// authorize ensures the user may manage the barber's requests: admins may
+// manage any barber's, barbers only their own
+func (s *ConsultationService) authorize(ctx context.Context, barberID, userID int, isAdmin bool) error {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return repository.ErrNotOwner
+	}
+	return nil
+}
+
+// notifyBarber tells the barber about a new request. Best-effort: the
+// request is listed either way.
+func (s *ConsultationService) notifyBarber(ctx context.Context, request *models.ConsultationRequest) {
+	if s.notifications == nil {
+		return
+	}
+	barber, err := s.barberRepo.FindByID(ctx, request.BarberID)
+	if err == nil {
+		err = s.notifications.SendConsultationRequested(ctx, barber.UserID, request)
+	}
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to notify barber of consultation request").
+			Int("consultation_request_id", request.ID).
+			Int("barber_id", request.BarberID).
+			Err(err).
+			Send()
+	}
+}
+
+// notifyQuoted tells a signed-in customer the barber quoted their
+// request; guests are contacted by the barber directly
+func (s *ConsultationService) notifyQuoted(ctx context.Context, request *models.ConsultationRequest) {
+	if s.notifications == nil || request.CustomerID == nil {
+		return
+	}
+	if err := s.notifications.SendConsultationQuoted(ctx, *request.CustomerID, request); err != nil {
+		logger.FromContext(ctx).Warn("Failed to notify customer of consultation quote").
+			Int("consultation_request_id", request.ID).
+			Int("customer_id", *request.CustomerID).
+			Err(err).
+			Send()
+	}
+}
+
+// notifyCustomer tells a signed-in customer the barber answered their
+// request; guests are contacted by the barber directly
+func (s *ConsultationService) notifyCustomer(ctx context.Context, request *models.ConsultationRequest) {
+	if s.notifications == nil || request.CustomerID == nil {
+		return
+	}
+	if err := s.notifications.SendConsultationAnswered(ctx, *request.CustomerID, request); err != nil {
+		logger.FromContext(ctx).Warn("Failed to notify customer of consultation answer").
+			Int("consultation_request_id", request.ID).
+			Int("customer_id", *request.CustomerID).
+			Err(err).
+			Send()
+	}
+}
@@ -0,0 +1,38 @@
+// internal/services/day_range.go
+package services
+
+import (
+	"fmt"
+	"time"
+)
+
+// resolveDayRange parses an inclusive range of UTC days for reports
+// (YYYY-MM-DD). to defaults to today and from to defaultDays days ending on
+// to; the range may span at most maxDays days.
+func resolveDayRange(now time.Time, fromParam, toParam string, defaultDays, maxDays int) (time.Time, time.Time, error) {
+	to := now.UTC().Truncate(24 * time.Hour)
+	if toParam != "" {
+		parsed, err := time.Parse("2006-01-02", toParam)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("to must be a date (YYYY-MM-DD)")
+		}
+		to = parsed
+	}
+
+	from := to.AddDate(0, 0, -(defaultDays - 1))
+	if fromParam != "" {
+		parsed, err := time.Parse("2006-01-02", fromParam)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("from must be a date (YYYY-MM-DD)")
+		}
+		from = parsed
+	}
+
+	if from.After(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("from must not be after to")
+	}
+	if to.Sub(from) >= time.Duration(maxDays)*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("range cannot exceed %d days", maxDays)
+	}
+	return from, to, nil
+}
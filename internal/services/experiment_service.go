@@ -0,0 +1,329 @@
+// internal/services/experiment_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/experiments"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// EXPERIMENT SERVICE - A/B tests of product features
+// ========================================================================
+//
+// Code under test asks for a user's variant by experiment key (VariantFor)
+// and branches on it; clients can do the same through the variant lookup
+// endpoint. While an experiment runs, users are split by hash and the
+// first lookup is logged as an exposure. Conversion is reported per
+// variant from exposed users' later bookings or from events recorded
+// against the experiment.
+//
+// Draft and stopped experiments serve everyone the control (or, once
+// stopped, the chosen winner) without logging exposures, so code can ship
+// before its experiment starts and keep working after it ends.
+// ========================================================================
+
+// ExperimentService handles A/B experiments
+type ExperimentService struct {
+	repo  *repository.ExperimentRepository
+	clock clock.Clock
+	audit *AuditService
+}
+
+// NewExperimentService creates a new experiment service
+func NewExperimentService(repo *repository.ExperimentRepository) *ExperimentService {
+	return &ExperimentService{
+		repo:  repo,
+		clock: clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *ExperimentService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// CreateExperimentRequest defines a new experiment; it starts as a draft
+type CreateExperimentRequest struct {
+	Key         string                `json:"key" binding:"required" example:"slot_suggestions"`
+	Name        string                `json:"name" binding:"required,max=100" example:"Slot suggestion algorithm"`
+	Description *string               `json:"description"`
+	Variants    []experiments.Variant `json:"variants" binding:"required,dive"` // The first is the control
+}
+
+// UpdateExperimentRequest edits a draft experiment
+type UpdateExperimentRequest struct {
+	Name        *string               `json:"name" binding:"omitempty,max=100"`
+	Description *string               `json:"description"`
+	Variants    []experiments.Variant `json:"variants" binding:"omitempty,dive"`
+}
+
+// UpdateExperimentStatusRequest starts, stops or resumes an experiment
+type UpdateExperimentStatusRequest struct {
+	Status string  `json:"status" binding:"required,oneof=running stopped" example:"running"`
+	Winner *string `json:"winner"` // Variant served to everyone once stopped (default: the control)
+}
+
+// ExperimentListRequest pages through experiments
+type ExperimentListRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=draft running stopped"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int    `form:"offset" binding:"omitempty,min=0"`
+}
+
+// ExperimentReportRequest selects the conversion metric of a report
+type ExperimentReportRequest struct {
+	Metric     string `form:"metric"`                                        // booking (default), completed_booking or a recorded event name
+	WindowDays int    `form:"window_days" binding:"omitempty,min=1,max=365"` // Count conversions this long after exposure (default: any time)
+}
+
+// RecordConversionRequest records a conversion event for the current user
+type RecordConversionRequest struct {
+	Event string   `json:"event" binding:"required" example:"suggestion_accepted"`
+	Value *float64 `json:"value" binding:"omitempty,min=0"`
+}
+
+// VariantAssignment is the variant a user gets
+type VariantAssignment struct {
+	Experiment string `json:"experiment"`
+	Variant    string `json:"variant"`
+	Exposed    bool   `json:"exposed"` // false when the experiment is not running
+}
+
+// ========================================================================
+// ADMINISTRATION
+// ========================================================================
+
+// SetAuditor records experiment changes in the audit log
+func (s *ExperimentService) SetAuditor(audit *AuditService) {
+	s.audit = audit
+}
+
+// Create defines a new draft experiment
+func (s *ExperimentService) Create(ctx context.Context, req *CreateExperimentRequest, createdBy *int) (*models.Experiment, error) {
+	if err := experiments.ValidateKey(req.Key); err != nil {
+		return nil, err
+	}
+	variants := experiments.Variants(req.Variants)
+	if err := variants.Validate(); err != nil {
+		return nil, err
+	}
+
+	experiment := &models.Experiment{
+		Key:         req.Key,
+		Name:        req.Name,
+		Description: req.Description,
+		Status:      config.ExperimentStatusDraft,
+		Variants:    variants,
+		CreatedBy:   createdBy,
+	}
+	if err := s.repo.Create(ctx, experiment); err != nil {
+		return nil, err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionExperimentCreated, config.EntityTypeExperiment, &experiment.ID, nil, experiment, nil)
+	return experiment, nil
+}
+
+// Update edits a draft experiment. Variants are frozen once it has run, so
+// users keep the variant they were assigned.
+func (s *ExperimentService) Update(ctx context.Context, key string, req *UpdateExperimentRequest) (*models.Experiment, error) {
+	experiment, err := s.repo.FindByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if experiment.Status != config.ExperimentStatusDraft {
+		return nil, repository.ErrExperimentNotEditable
+	}
+
+	before := *experiment
+	if req.Name != nil {
+		experiment.Name = *req.Name
+	}
+	if req.Description != nil {
+		experiment.Description = req.Description
+	}
+	if req.Variants != nil {
+		variants := experiments.Variants(req.Variants)
+		if err := variants.Validate(); err != nil {
+			return nil, err
+		}
+		experiment.Variants = variants
+	}
+
+	if err := s.repo.Update(ctx, experiment); err != nil {
+		return nil, err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionExperimentUpdated, config.EntityTypeExperiment, &experiment.ID, &before, experiment, nil)
+	return experiment, nil
+}
+
+// UpdateStatus starts, stops or resumes an experiment
+func (s *ExperimentService) UpdateStatus(ctx context.Context, key string, req *UpdateExperimentStatusRequest) (*models.Experiment, error) {
+	experiment, err := s.repo.FindByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	var winner *string
+	if req.Status == config.ExperimentStatusStopped {
+		if experiment.Status != config.ExperimentStatusRunning {
+			return nil, fmt.Errorf("%w: only a running experiment can be stopped", repository.ErrInvalidStatusTransition)
+		}
+		if req.Winner != nil {
+			if !experiment.Variants.Has(*req.Winner) {
+				return nil, fmt.Errorf("winner must be one of the experiment's variants")
+			}
+			winner = req.Winner
+		}
+	}
+
+	if err := s.repo.UpdateStatus(ctx, experiment.ID, req.Status, winner, s.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Experiment status changed").
+		Str("experiment", key).
+		Str("from", experiment.Status).
+		Str("to", req.Status).
+		Send()
+
+	updated, err := s.repo.FindByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionExperimentStatus, config.EntityTypeExperiment, &updated.ID, experiment, updated, nil)
+	return updated, nil
+}
+
+// Get returns an experiment
+func (s *ExperimentService) Get(ctx context.Context, key string) (*models.Experiment, error) {
+	return s.repo.FindByKey(ctx, key)
+}
+
+// List returns experiments, newest first
+func (s *ExperimentService) List(ctx context.Context, req *ExperimentListRequest) ([]models.Experiment, error) {
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+	return s.repo.FindAll(ctx, repository.ExperimentFilters{
+		Status: req.Status,
+		Limit:  req.Limit,
+		Offset: req.Offset,
+	})
+}
+
+// Report returns conversion by variant
+func (s *ExperimentService) Report(ctx context.Context, key string, req *ExperimentReportRequest) (*models.ExperimentReport, error) {
+	metric := req.Metric
+	if metric == "" {
+		metric = config.ExperimentMetricBooking
+	}
+	if err := experiments.ValidateEvent(metric); err != nil {
+		return nil, err
+	}
+
+	experiment, err := s.repo.FindByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	results, err := s.repo.Report(ctx, experiment.ID, metric, req.WindowDays)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.ExperimentReport{
+		Experiment: experiment,
+		Metric:     metric,
+		WindowDays: req.WindowDays,
+		Variants:   results,
+	}
+	report.Finalize()
+	return report, nil
+}
+
+// ========================================================================
+// ASSIGNMENT
+// ========================================================================
+
+// Variant returns the user's variant of an experiment, logging an exposure
+// the first time a running experiment is looked up
+func (s *ExperimentService) Variant(ctx context.Context, key string, userID int) (*VariantAssignment, error) {
+	experiment, err := s.repo.FindByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment := &VariantAssignment{Experiment: key}
+	if !experiment.IsRunning() {
+		assignment.Variant = experiment.DefaultVariant()
+		return assignment, nil
+	}
+
+	variant := experiments.Assign(experiment.Key, userID, experiment.Variants)
+	exposure, err := s.repo.Expose(ctx, experiment.ID, userID, variant)
+	if err != nil {
+		return nil, err
+	}
+	assignment.Variant = exposure.Variant
+	assignment.Exposed = true
+	return assignment, nil
+}
+
+// VariantFor is Variant for code under test: it never fails, returning ""
+// (keep the current behaviour) when the experiment is unknown or the
+// lookup fails
+func (s *ExperimentService) VariantFor(ctx context.Context, key string, userID int) string {
+	assignment, err := s.Variant(ctx, key, userID)
+	if err != nil {
+		if err != repository.ErrExperimentNotFound {
+			logger.FromContext(ctx).Warn("Experiment lookup failed").
+				Str("experiment", key).
+				Int("user_id", userID).
+				Err(err).
+				Send()
+		}
+		return ""
+	}
+	return assignment.Variant
+}
+
+// RecordConversion records an event for a user exposed to the experiment
+func (s *ExperimentService) RecordConversion(ctx context.Context, key string, userID int, req *RecordConversionRequest) (*models.ExperimentConversion, error) {
+	if err := experiments.ValidateEvent(req.Event); err != nil {
+		return nil, err
+	}
+	if req.Event == config.ExperimentMetricBooking || req.Event == config.ExperimentMetricCompletedBooking {
+		return nil, fmt.Errorf("event %q is measured from bookings and cannot be recorded", req.Event)
+	}
+
+	experiment, err := s.repo.FindByKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	exposure, err := s.repo.FindExposure(ctx, experiment.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	conversion := &models.ExperimentConversion{
+		ExperimentID: experiment.ID,
+		UserID:       userID,
+		Variant:      exposure.Variant,
+		Event:        req.Event,
+		Value:        req.Value,
+	}
+	if err := s.repo.RecordConversion(ctx, conversion); err != nil {
+		return nil, err
+	}
+	return conversion, nil
+}
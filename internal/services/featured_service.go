@@ -0,0 +1,485 @@
+// internal/services/featured_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/payments"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/saga"
+	"barber-booking-system/internal/sandbox"
+)
+
+// ========================================================================
+// FEATURED SERVICE - Paid placement in barber search results
+// ========================================================================
+//
+// A barber buys featured placement in their own city, either city-wide or
+// within one service category, for a range of days. Each market has a cap
+// on how many barbers can be featured on the same day. A purchase runs as a
+// saga:
+//
+//	reserve_slot      hold the slot (pending_payment)  → release it
+//	authorize_payment hold funds on the card            → void
+//	capture_payment   charge the card                   → refund
+//	activate          pending_payment → active
+//
+// Featured barbers are listed first in search results while the placement
+// runs; listings count an impression per result shown and clients report
+// clicks.
+// ========================================================================
+
+// Featured purchase saga step names
+const (
+	FeaturedStepReserveSlot      = "reserve_slot"
+	FeaturedStepAuthorizePayment = "authorize_payment"
+	FeaturedStepCapturePayment   = "capture_payment"
+	FeaturedStepActivate         = "activate"
+)
+
+// FeaturedService handles featured placement purchases, inventory and tracking
+type FeaturedService struct {
+	repo        *repository.FeaturedRepository
+	barberRepo  repository.BarberStore
+	serviceRepo repository.ServiceStore
+	gateway     payments.Gateway
+	clock       clock.Clock
+	config      config.FeaturedConfig
+	audit       *AuditService
+}
+
+// NewFeaturedService creates a featured placement service. Unset settings
+// fall back to the defaults; a nil gateway disables purchases (requests fail
+// with payments.ErrNotConfigured) while listings and tracking still work.
+func NewFeaturedService(
+	repo *repository.FeaturedRepository,
+	barberRepo repository.BarberStore,
+	serviceRepo repository.ServiceStore,
+	gateway payments.Gateway,
+	cfg config.FeaturedConfig,
+) *FeaturedService {
+	if cfg.DailyPriceCents <= 0 {
+		cfg.DailyPriceCents = config.DefaultFeaturedDailyPriceCents
+	}
+	if cfg.Currency == "" {
+		cfg.Currency = strings.ToLower(config.DefaultCurrency)
+	}
+	if cfg.DefaultSlotLimit <= 0 {
+		cfg.DefaultSlotLimit = config.DefaultFeaturedSlotLimit
+	}
+	if cfg.MaxDays <= 0 {
+		cfg.MaxDays = config.DefaultFeaturedMaxDays
+	}
+	if cfg.MaxLeadDays <= 0 {
+		cfg.MaxLeadDays = config.DefaultFeaturedMaxLeadDays
+	}
+	return &FeaturedService{
+		repo:        repo,
+		barberRepo:  barberRepo,
+		serviceRepo: serviceRepo,
+		gateway:     gateway,
+		clock:       clock.System,
+		config:      cfg,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *FeaturedService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// PurchaseFeaturedRequest buys featured placement in the barber's city
+type PurchaseFeaturedRequest struct {
+	CategoryID      *int   `json:"category_id" binding:"omitempty,min=1" example:"3"` // Omit for city-wide placement
+	StartsOn        string `json:"starts_on" binding:"required" example:"2026-11-01"` // YYYY-MM-DD
+	EndsOn          string `json:"ends_on" binding:"required" example:"2026-11-14"`   // YYYY-MM-DD, inclusive
+	PaymentMethodID string `json:"payment_method_id" binding:"required" example:"pm_card_visa"`
+}
+
+// FeaturedAvailabilityRequest asks how many slots are left in a market
+type FeaturedAvailabilityRequest struct {
+	City       string `form:"city" binding:"required"`
+	CategoryID int    `form:"category_id" binding:"omitempty,min=1"`
+	StartsOn   string `form:"starts_on" binding:"required"`
+	EndsOn     string `form:"ends_on" binding:"required"`
+}
+
+// FeaturedAvailability is the slot inventory and price for a market and range
+type FeaturedAvailability struct {
+	City       string                   `json:"city"`
+	CategoryID *int                     `json:"category_id"`
+	Limit      int                      `json:"limit"`     // Concurrent placements allowed per day
+	Available  bool                     `json:"available"` // A slot is free on every day
+	DailyPrice float64                  `json:"daily_price"`
+	TotalPrice float64                  `json:"total_price"`
+	Currency   string                   `json:"currency"`
+	Days       []models.FeaturedSlotDay `json:"days"`
+}
+
+// FeaturedPlacementListRequest pages through placements
+type FeaturedPlacementListRequest struct {
+	City   string `form:"city"`
+	Status string `form:"status" binding:"omitempty,oneof=pending_payment active cancelled"`
+	Limit  int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int    `form:"offset" binding:"omitempty,min=0"`
+}
+
+// SetFeaturedSlotLimitRequest sets how many barbers can be featured at once in a market
+type SetFeaturedSlotLimitRequest struct {
+	City       string `json:"city" binding:"required,max=100" example:"Austin"`
+	CategoryID *int   `json:"category_id" binding:"omitempty,min=1"` // Omit for the city-wide market
+	MaxSlots   *int   `json:"max_slots" binding:"required,min=0,max=100" example:"5"`
+}
+
+// ========================================================================
+// INVENTORY
+// ========================================================================
+
+// GetAvailability returns per-day slot usage and the price for a market
+func (s *FeaturedService) GetAvailability(ctx context.Context, req *FeaturedAvailabilityRequest) (*FeaturedAvailability, error) {
+	start, end, err := s.parseRange(req.StartsOn, req.EndsOn)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := s.repo.SlotLimit(ctx, req.City, req.CategoryID, s.config.DefaultSlotLimit)
+	if err != nil {
+		return nil, err
+	}
+	days, err := s.repo.SlotUsage(ctx, req.City, req.CategoryID, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := &FeaturedAvailability{
+		City:       req.City,
+		Limit:      limit,
+		Available:  true,
+		DailyPrice: centsToAmount(s.config.DailyPriceCents),
+		TotalPrice: centsToAmount(s.price(len(days))),
+		Currency:   s.config.Currency,
+		Days:       days,
+	}
+	if req.CategoryID > 0 {
+		availability.CategoryID = &req.CategoryID
+	}
+	for i := range days {
+		days[i].Limit = limit
+		days[i].Remaining = max(limit-days[i].Booked, 0)
+		if days[i].Remaining == 0 {
+			availability.Available = false
+		}
+	}
+	return availability, nil
+}
+
+// ListSlotLimits returns the markets with an explicit slot limit
+func (s *FeaturedService) ListSlotLimits(ctx context.Context) ([]models.FeaturedSlotLimit, error) {
+	return s.repo.ListSlotLimits(ctx)
+}
+
+// SetAuditor records slot limit changes in the audit log
+func (s *FeaturedService) SetAuditor(audit *AuditService) {
+	s.audit = audit
+}
+
+// SetSlotLimit sets a market's slot limit
+func (s *FeaturedService) SetSlotLimit(ctx context.Context, req *SetFeaturedSlotLimitRequest) (*models.FeaturedSlotLimit, error) {
+	if req.CategoryID != nil {
+		if _, err := s.serviceRepo.FindCategoryByID(ctx, *req.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	limit := &models.FeaturedSlotLimit{
+		City:       strings.TrimSpace(req.City),
+		CategoryID: req.CategoryID,
+		MaxSlots:   *req.MaxSlots,
+	}
+	if err := s.repo.SetSlotLimit(ctx, limit); err != nil {
+		return nil, err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionFeaturedLimitSet, config.EntityTypeFeaturedLimit, &limit.ID, nil, limit, nil)
+	return limit, nil
+}
+
+// ========================================================================
+// PURCHASES
+// ========================================================================
+
+// Purchase reserves a featured slot for the barber and charges for it,
+// releasing the slot and returning the money if any step fails
+func (s *FeaturedService) Purchase(ctx context.Context, barberID int, req *PurchaseFeaturedRequest, userID int, isAdmin bool) (*models.FeaturedPlacement, error) {
+	log := logger.FromContext(ctx)
+
+	// Placements are live inventory, so there is nothing to test against
+	if sandbox.Enabled(ctx) {
+		return nil, fmt.Errorf("featured placements cannot be purchased in sandbox mode")
+	}
+	if s.gateway == nil {
+		return nil, payments.ErrNotConfigured
+	}
+
+	barber, err := s.authorize(ctx, barberID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if barber.Status != config.BarberStatusActive {
+		return nil, fmt.Errorf("only active barbers can be featured")
+	}
+	if strings.TrimSpace(barber.City) == "" {
+		return nil, fmt.Errorf("barber profile must have a city to be featured")
+	}
+	if req.CategoryID != nil {
+		if _, err := s.serviceRepo.FindCategoryByID(ctx, *req.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	start, end, err := s.parseRange(req.StartsOn, req.EndsOn)
+	if err != nil {
+		return nil, err
+	}
+	amount := s.price(models.FeaturedDays(start, end))
+
+	placement := &models.FeaturedPlacement{
+		BarberID:   barberID,
+		City:       barber.City,
+		CategoryID: req.CategoryID,
+		StartsOn:   start,
+		EndsOn:     end,
+		Amount:     centsToAmount(amount),
+		Currency:   s.config.Currency,
+	}
+	var auth *payments.Authorization
+
+	purchase := saga.New("featured_purchase").
+		Step(FeaturedStepReserveSlot,
+			func(ctx context.Context) error {
+				return s.repo.Reserve(ctx, placement, s.config.DefaultSlotLimit)
+			},
+			func(ctx context.Context) error {
+				return s.repo.Cancel(ctx, placement.ID, nil)
+			}).
+		Step(FeaturedStepAuthorizePayment,
+			func(ctx context.Context) error {
+				var err error
+				auth, err = s.gateway.Authorize(ctx, payments.AuthorizeRequest{
+					Amount:          amount,
+					Currency:        s.config.Currency,
+					PaymentMethodID: req.PaymentMethodID,
+					Description:     fmt.Sprintf("Featured placement in %s, %s to %s", barber.City, req.StartsOn, req.EndsOn),
+					IdempotencyKey:  fmt.Sprintf("featured-%d", placement.ID),
+					Metadata: map[string]string{
+						"featured_placement_id": fmt.Sprintf("%d", placement.ID),
+						"barber_id":             fmt.Sprintf("%d", barberID),
+					},
+				})
+				return err
+			},
+			func(ctx context.Context) error {
+				return s.gateway.Void(ctx, auth.ID)
+			}).
+		Step(FeaturedStepCapturePayment,
+			func(ctx context.Context) error {
+				return s.gateway.Capture(ctx, auth.ID, 0)
+			},
+			func(ctx context.Context) error {
+				_, err := s.gateway.Refund(ctx, auth.ID, 0)
+				return err
+			}).
+		Step(FeaturedStepActivate,
+			func(ctx context.Context) error {
+				return s.repo.Activate(ctx, placement.ID, s.gateway.Name(), auth.ID)
+			}, nil)
+
+	if err := purchase.Run(ctx); err != nil {
+		var sagaErr *saga.Error
+		if errors.As(err, &sagaErr) && len(sagaErr.CompensationErrors) > 0 {
+			log.Error(err).
+				Str("failed_step", sagaErr.Step).
+				Int("featured_placement_id", placement.ID).
+				Msg("Featured purchase rollback incomplete - manual review required")
+		}
+		return nil, errors.Unwrap(err)
+	}
+
+	log.Info("Featured placement purchased").
+		Int("featured_placement_id", placement.ID).
+		Int("barber_id", barberID).
+		Str("city", placement.City).
+		Str("payment_reference", auth.ID).
+		Send()
+
+	return s.repo.FindByID(ctx, placement.ID)
+}
+
+// Cancel cancels a placement and refunds it. Barbers can cancel before the
+// placement starts for a full refund; admins can cancel at any time and
+// the days not yet run are refunded.
+func (s *FeaturedService) Cancel(ctx context.Context, barberID, placementID, userID int, isAdmin bool) (*models.FeaturedPlacement, error) {
+	if _, err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	placement, err := s.repo.FindByID(ctx, placementID)
+	if err != nil {
+		return nil, err
+	}
+	if placement.BarberID != barberID || placement.Status != config.FeaturedStatusActive {
+		return nil, repository.ErrFeaturedPlacementNotFound
+	}
+
+	today := s.clock.Now()
+	if placement.HasStarted(today) && !isAdmin {
+		return nil, repository.ErrFeaturedAlreadyStarted
+	}
+
+	var refundReference *string
+	if refund := s.refundAmount(placement, today); refund > 0 && placement.PaymentReference != nil {
+		if s.gateway == nil {
+			return nil, payments.ErrNotConfigured
+		}
+		ref, err := s.gateway.Refund(ctx, *placement.PaymentReference, refund)
+		if err != nil {
+			return nil, fmt.Errorf("failed to refund featured placement: %w", err)
+		}
+		refundReference = &ref
+	}
+
+	if err := s.repo.Cancel(ctx, placement.ID, refundReference); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Featured placement cancelled").
+		Int("featured_placement_id", placement.ID).
+		Int("barber_id", barberID).
+		Int("cancelled_by", userID).
+		Send()
+
+	return s.repo.FindByID(ctx, placement.ID)
+}
+
+// refundAmount returns the minor units to refund when cancelling on today:
+// everything before the start, otherwise the days from today to the end
+func (s *FeaturedService) refundAmount(placement *models.FeaturedPlacement, today time.Time) int64 {
+	total := payments.ToMinorUnits(placement.Amount)
+	if !placement.HasStarted(today) {
+		return total
+	}
+	days := placement.Days()
+	if days == 0 {
+		return 0
+	}
+	unused := models.FeaturedDays(today, placement.EndsOn)
+	return total * int64(max(unused, 0)) / int64(days)
+}
+
+// ListForBarber returns a barber's placements with their performance
+func (s *FeaturedService) ListForBarber(ctx context.Context, barberID, userID int, isAdmin bool, req *FeaturedPlacementListRequest) ([]models.FeaturedPlacement, error) {
+	if _, err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.list(ctx, repository.FeaturedPlacementFilters{BarberID: barberID}, req)
+}
+
+// ListAll returns placements across all barbers (admin)
+func (s *FeaturedService) ListAll(ctx context.Context, req *FeaturedPlacementListRequest) ([]models.FeaturedPlacement, error) {
+	return s.list(ctx, repository.FeaturedPlacementFilters{}, req)
+}
+
+func (s *FeaturedService) list(ctx context.Context, filters repository.FeaturedPlacementFilters, req *FeaturedPlacementListRequest) ([]models.FeaturedPlacement, error) {
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+	filters.City = req.City
+	filters.Status = req.Status
+	filters.Limit = req.Limit
+	filters.Offset = req.Offset
+
+	placements, err := s.repo.FindAll(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+	for i := range placements {
+		placements[i].Finalize()
+	}
+	return placements, nil
+}
+
+// ========================================================================
+// TRACKING
+// ========================================================================
+
+// RecordImpressions counts featured results shown in a listing
+func (s *FeaturedService) RecordImpressions(ctx context.Context, placementIDs []int) error {
+	return s.repo.RecordImpressions(ctx, placementIDs, s.clock.Now())
+}
+
+// RecordClick counts a click on a featured result
+func (s *FeaturedService) RecordClick(ctx context.Context, placementID int) error {
+	return s.repo.RecordClick(ctx, placementID, s.clock.Now())
+}
+
+// ========================================================================
+// HELPERS
+// ========================================================================
+
+// authorize loads the barber and checks the user may manage its placements
+func (s *FeaturedService) authorize(ctx context.Context, barberID, userID int, isAdmin bool) (*models.Barber, error) {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return nil, repository.ErrNotOwner
+	}
+	return barber, nil
+}
+
+// parseRange validates a placement date range against today and the limits
+func (s *FeaturedService) parseRange(startsOn, endsOn string) (time.Time, time.Time, error) {
+	start, err := time.Parse("2006-01-02", startsOn)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("starts_on must be in YYYY-MM-DD format")
+	}
+	end, err := time.Parse("2006-01-02", endsOn)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("ends_on must be in YYYY-MM-DD format")
+	}
+
+	today := s.clock.Now().Format("2006-01-02")
+	switch {
+	case start.Format("2006-01-02") < today:
+		return time.Time{}, time.Time{}, fmt.Errorf("starts_on cannot be in the past")
+	case end.Before(start):
+		return time.Time{}, time.Time{}, fmt.Errorf("ends_on must not be before starts_on")
+	case models.FeaturedDays(start, end) > s.config.MaxDays:
+		return time.Time{}, time.Time{}, fmt.Errorf("placement must be at most %d days", s.config.MaxDays)
+	case models.FeaturedDays(s.clock.Now(), start) > s.config.MaxLeadDays+1:
+		return time.Time{}, time.Time{}, fmt.Errorf("starts_on must be within %d days", s.config.MaxLeadDays)
+	}
+	return start, end, nil
+}
+
+// price returns the cost of a placement of the given length in minor units
+func (s *FeaturedService) price(days int) int64 {
+	return s.config.DailyPriceCents * int64(days)
+}
+
+// centsToAmount converts minor units to a decimal amount
+func centsToAmount(cents int64) float64 {
+	return float64(cents) / 100
+}
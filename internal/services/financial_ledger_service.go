@@ -0,0 +1,405 @@
+// internal/services/financial_ledger_service.go
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/payments"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/sandbox"
+)
+
+// ========================================================================
+// FINANCIAL LEDGER SERVICE - Hash-chained record of money movements
+// ========================================================================
+//
+// The live payment gateway reports every hold, capture, void and refund
+// the provider accepts (see payments.WithRecorder), and completed bookings
+// add the platform fee and each barber's payout. Events are appended to a
+// hash chain that auditors can export per period and verify offline; the
+// debug CLI verifies the whole chain. Sandbox traffic is never recorded.
+// ========================================================================
+
+// ledgerCSVHeader is the column layout of a ledger export
+var ledgerCSVHeader = []string{
+	"id", "occurred_at", "event_type", "entity_type", "entity_id", "barber_id",
+	"amount_minor", "amount", "currency", "provider", "reference", "details", "prev_hash", "hash",
+}
+
+// FinancialLedgerService records, exports and verifies the financial ledger
+type FinancialLedgerService struct {
+	repo           *repository.FinancialEventRepository
+	commissionRepo *repository.CommissionRepository
+	barberRepo     repository.BarberStore
+	clock          clock.Clock
+}
+
+// NewFinancialLedgerService creates a new financial ledger service
+func NewFinancialLedgerService(
+	repo *repository.FinancialEventRepository,
+	commissionRepo *repository.CommissionRepository,
+	barberRepo repository.BarberStore,
+) *FinancialLedgerService {
+	return &FinancialLedgerService{
+		repo:           repo,
+		commissionRepo: commissionRepo,
+		barberRepo:     barberRepo,
+		clock:          clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *FinancialLedgerService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// LedgerExportQuery selects the UTC days to export (inclusive)
+type LedgerExportQuery struct {
+	From string `form:"from" example:"2026-03-01"` // Default: 30 days before to
+	To   string `form:"to" example:"2026-03-31"`   // Default: today
+}
+
+// LedgerVerification is the result of checking the whole chain
+type LedgerVerification struct {
+	Events   int    `json:"events"`
+	HeadHash string `json:"head_hash"`           // Hash of the last event verified
+	Error    string `json:"error,omitempty"`     // Why the chain stopped verifying
+	BrokenAt *int64 `json:"broken_at,omitempty"` // First event that does not verify
+}
+
+// Valid reports whether the whole chain verified
+func (v *LedgerVerification) Valid() bool {
+	return v.BrokenAt == nil
+}
+
+// ========================================================================
+// RECORDING
+// ========================================================================
+
+// RecordPayment appends a provider operation to the ledger. Captures,
+// voids and refunds inherit the booking or placement, barber and currency
+// of their authorization; a capture or refund of 0 is the whole
+// authorized amount. Failures are logged for manual review: the money has
+// already moved at the provider.
+func (s *FinancialLedgerService) RecordPayment(ctx context.Context, op payments.Operation) {
+	if sandbox.Enabled(ctx) {
+		return
+	}
+
+	provider, reference := op.Provider, op.AuthorizationID
+	event := &models.FinancialEvent{
+		Amount:     op.Amount,
+		Currency:   strings.ToUpper(op.Currency),
+		Provider:   &provider,
+		Reference:  &reference,
+		OccurredAt: s.clock.Now(),
+	}
+	details := models.JSONMap{}
+
+	switch op.Kind {
+	case payments.OperationAuthorize:
+		event.EventType = config.FinancialEventPaymentAuthorized
+		event.EntityType, event.EntityID = paymentEntity(op.Metadata)
+		if barberID, err := strconv.Atoi(op.Metadata["barber_id"]); err == nil {
+			event.BarberID = &barberID
+		}
+		if charge := op.Metadata["charge"]; charge != "" {
+			details["charge"] = charge
+		}
+	case payments.OperationCapture, payments.OperationVoid, payments.OperationRefund:
+		event.EventType = map[string]string{
+			payments.OperationCapture: config.FinancialEventPaymentCaptured,
+			payments.OperationVoid:    config.FinancialEventPaymentVoided,
+			payments.OperationRefund:  config.FinancialEventRefundIssued,
+		}[op.Kind]
+		if op.RefundID != "" {
+			details["refund_id"] = op.RefundID
+		}
+		auth, err := s.repo.FindAuthorization(ctx, reference)
+		if err != nil {
+			s.logRecordFailure(ctx, op, err)
+			return
+		}
+		if auth != nil {
+			event.EntityType, event.EntityID, event.BarberID = auth.EntityType, auth.EntityID, auth.BarberID
+			event.Currency = auth.Currency
+			if op.Kind == payments.OperationVoid || event.Amount == 0 {
+				event.Amount = auth.Amount
+			}
+		}
+	default:
+		s.logRecordFailure(ctx, op, fmt.Errorf("unknown payment operation %q", op.Kind))
+		return
+	}
+	if event.Currency == "" {
+		event.Currency = config.DefaultCurrency
+	}
+
+	if err := s.append(ctx, event, details); err != nil {
+		s.logRecordFailure(ctx, op, err)
+	}
+}
+
+// CompletedBookingHook is a status hook for completed bookings: it
+// records the platform fee and the payout owed to each barber who worked
+// on the booking, split and charged as in their earnings reports.
+func (s *FinancialLedgerService) CompletedBookingHook(ctx context.Context, booking *models.Booking, _, _ string) error {
+	if booking.IsTest || sandbox.Enabled(ctx) {
+		return nil
+	}
+
+	assistants, err := s.commissionRepo.FindAssistants(ctx, booking.ID)
+	if err != nil {
+		return err
+	}
+	staff := append([]models.BookingStaff{{
+		BarberID:     booking.BarberID,
+		Role:         config.StaffRoleLead,
+		SharePercent: models.LeadSharePercent(assistants),
+	}}, assistants...)
+
+	entityType, bookingID := config.EntityTypeBooking, booking.ID
+	for _, member := range staff {
+		barber, err := s.barberRepo.FindByID(ctx, member.BarberID)
+		if err != nil {
+			return err
+		}
+		report := models.NewEarningsReport(member.BarberID, booking.ScheduledStartTime, booking.ScheduledStartTime,
+			barber.CommissionRate, []models.EarningsLine{{
+				BookingID:     booking.ID,
+				Role:          member.Role,
+				SharePercent:  member.SharePercent,
+				Currency:      booking.Currency,
+				BookingAmount: booking.TotalPrice - booking.TaxAmount,
+				BookingTip:    booking.TipAmount,
+			}})
+		details := models.JSONMap{
+			"booking_number":  booking.BookingNumber,
+			"role":            member.Role,
+			"share_percent":   member.SharePercent,
+			"commission_rate": barber.CommissionRate,
+			"earnings":        report.Earnings,
+			"tip":             report.Tips,
+		}
+
+		for eventType, amount := range map[string]float64{
+			config.FinancialEventFeeCharged:    report.Commission,
+			config.FinancialEventPayoutAccrued: report.Payout,
+		} {
+			if amount <= 0 {
+				continue
+			}
+			barberID := member.BarberID
+			event := &models.FinancialEvent{
+				EventType:  eventType,
+				EntityType: &entityType,
+				EntityID:   &bookingID,
+				BarberID:   &barberID,
+				Amount:     payments.ToMinorUnits(amount),
+				Currency:   strings.ToUpper(report.Currency),
+				OccurredAt: s.clock.Now(),
+			}
+			if err := s.append(ctx, event, details); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// append stores details canonically (sorted keys) and appends the event
+func (s *FinancialLedgerService) append(ctx context.Context, event *models.FinancialEvent, details models.JSONMap) error {
+	data, err := json.Marshal(details)
+	if err != nil {
+		return fmt.Errorf("failed to encode financial event details: %w", err)
+	}
+	event.Details = string(data)
+	return s.repo.Append(ctx, event)
+}
+
+// logRecordFailure reports a provider operation the ledger missed
+func (s *FinancialLedgerService) logRecordFailure(ctx context.Context, op payments.Operation, err error) {
+	logger.FromContext(ctx).Error(err).
+		Str("operation", op.Kind).
+		Str("payment_reference", op.AuthorizationID).
+		Int64("amount", op.Amount).
+		Msg("Failed to record financial event - manual review required")
+}
+
+// paymentEntity is the booking or featured placement an authorization is for
+func paymentEntity(metadata map[string]string) (*string, *int) {
+	for key, entityType := range map[string]string{
+		"booking_id":            config.EntityTypeBooking,
+		"featured_placement_id": config.EntityTypeFeatured,
+	} {
+		if id, err := strconv.Atoi(metadata[key]); err == nil {
+			return &entityType, &id
+		}
+	}
+	return nil, nil
+}
+
+// ========================================================================
+// EXPORT AND VERIFICATION
+// ========================================================================
+
+// ExportPeriod returns the UTC days an export query covers, as [from, to)
+func (s *FinancialLedgerService) ExportPeriod(query LedgerExportQuery) (time.Time, time.Time, error) {
+	from, to, err := resolveDayRange(s.clock.Now(), query.From, query.To,
+		config.DefaultLedgerExportDays, config.MaxLedgerExportDays)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return from, to.AddDate(0, 0, 1), nil
+}
+
+// Export writes the events that occurred in [from, to) as CSV, in chain
+// order. The first row's prev_hash links the export to the events before
+// the period, so the rows can be verified on their own.
+func (s *FinancialLedgerService) Export(ctx context.Context, w io.Writer, from, to time.Time) error {
+	events, err := s.repo.FindInPeriod(ctx, from, to)
+	if err != nil {
+		return err
+	}
+	return WriteLedgerCSV(w, events)
+}
+
+// Verify walks the whole chain from the first event and reports the first
+// event that does not verify, if any
+func (s *FinancialLedgerService) Verify(ctx context.Context) (*LedgerVerification, error) {
+	result := &LedgerVerification{HeadHash: config.FinancialChainGenesis}
+	var afterID int64
+	for {
+		events, err := s.repo.FindAfter(ctx, afterID, config.LedgerVerifyBatchSize)
+		if err != nil {
+			return nil, err
+		}
+		if len(events) == 0 {
+			return result, nil
+		}
+
+		head, err := models.VerifyFinancialChain(result.HeadHash, events)
+		if chainErr, ok := err.(*models.ChainError); ok {
+			result.BrokenAt = &chainErr.EventID
+			result.Error = chainErr.Reason
+			for _, event := range events {
+				if event.ID == chainErr.EventID {
+					break
+				}
+				result.Events++
+			}
+			return result, nil
+		}
+		result.HeadHash = head
+		result.Events += len(events)
+		afterID = events[len(events)-1].ID
+	}
+}
+
+// WriteLedgerCSV writes events in the ledger export format
+func WriteLedgerCSV(w io.Writer, events []models.FinancialEvent) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(ledgerCSVHeader); err != nil {
+		return err
+	}
+	for i := range events {
+		e := &events[i]
+		if err := out.Write([]string{
+			strconv.FormatInt(e.ID, 10),
+			e.OccurredAt.UTC().Format(time.RFC3339Nano),
+			e.EventType,
+			stringValue(e.EntityType),
+			optionalInt(e.EntityID),
+			optionalInt(e.BarberID),
+			strconv.FormatInt(e.Amount, 10),
+			e.FormattedAmount(),
+			e.Currency,
+			stringValue(e.Provider),
+			stringValue(e.Reference),
+			e.Details,
+			e.PrevHash,
+			e.Hash,
+		}); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// ParseLedgerCSV reads a ledger export back into events for verification.
+// Empty entity, barber, provider and reference columns read as NULL.
+func ParseLedgerCSV(r io.Reader) ([]models.FinancialEvent, error) {
+	rows, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ledger export: %w", err)
+	}
+	if len(rows) == 0 || strings.Join(rows[0], ",") != strings.Join(ledgerCSVHeader, ",") {
+		return nil, fmt.Errorf("ledger export must start with the header row %s", strings.Join(ledgerCSVHeader, ","))
+	}
+
+	events := make([]models.FinancialEvent, 0, len(rows)-1)
+	for line, row := range rows[1:] {
+		if len(row) != len(ledgerCSVHeader) {
+			return nil, fmt.Errorf("row %d must have %d columns", line+2, len(ledgerCSVHeader))
+		}
+		event := models.FinancialEvent{
+			EventType:  row[2],
+			EntityType: optionalString(row[3]),
+			Currency:   row[8],
+			Provider:   optionalString(row[9]),
+			Reference:  optionalString(row[10]),
+			Details:    row[11],
+			PrevHash:   row[12],
+			Hash:       row[13],
+		}
+		var errs [5]error
+		event.ID, errs[0] = strconv.ParseInt(row[0], 10, 64)
+		event.OccurredAt, errs[1] = time.Parse(time.RFC3339Nano, row[1])
+		event.EntityID, errs[2] = parseOptionalInt(row[4])
+		event.BarberID, errs[3] = parseOptionalInt(row[5])
+		event.Amount, errs[4] = strconv.ParseInt(row[6], 10, 64)
+		for _, err := range errs {
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", line+2, err)
+			}
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// optionalInt formats a nullable number for CSV: empty when nil
+func optionalInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
+
+// parseOptionalInt reads a nullable CSV number: empty is nil
+func parseOptionalInt(value string) (*int, error) {
+	if value == "" {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, err
+	}
+	return &n, nil
+}
@@ -0,0 +1,99 @@
+// internal/services/image_upload.go
+package services
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/imaging"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/storage"
+
+	"github.com/google/uuid"
+)
+
+// ========================================================================
+// IMAGE UPLOADS - Photos stored with a thumbnail
+// ========================================================================
+//
+// Every uploaded photo goes through the same steps: it is checked by
+// content, scaled down to config.UploadImageMaxDimension, re-encoded
+// (dropping camera metadata such as GPS location) and stored next to a
+// thumbnail under a fresh key.
+// ========================================================================
+
+// ErrImageTooLarge is returned for uploads over config.MaxImageSizeBytes
+var ErrImageTooLarge = fmt.Errorf("image cannot exceed %d MB", config.MaxImageSizeBytes/(1024*1024))
+
+// storedImage is an uploaded photo as stored
+type storedImage struct {
+	ImageKey     string
+	ThumbnailKey string
+	URL          string
+	ThumbnailURL string
+	ContentType  string
+	SizeBytes    int
+	Width        int
+	Height       int
+}
+
+// storeImage validates a photo and stores it and its thumbnail under
+// prefix/<uuid>. Nothing is left behind when it fails.
+func storeImage(ctx context.Context, store storage.Storage, prefix string, data []byte) (*storedImage, error) {
+	if len(data) > config.MaxImageSizeBytes {
+		return nil, ErrImageTooLarge
+	}
+	img, err := imaging.Decode(data, config.MaxImagePixels)
+	if err != nil {
+		return nil, err
+	}
+
+	photo := img.Fit(config.UploadImageMaxDimension, config.UploadImageMaxDimension)
+	var photoData, thumbData bytes.Buffer
+	if err := photo.Encode(&photoData); err != nil {
+		return nil, fmt.Errorf("failed to encode image: %w", err)
+	}
+	if err := img.Fit(config.ThumbnailWidth, config.ThumbnailHeight).Encode(&thumbData); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	name := prefix + "/" + uuid.NewString()
+	stored := &storedImage{
+		ImageKey:     name + img.Extension(),
+		ThumbnailKey: name + "_thumb" + img.Extension(),
+		ContentType:  img.ContentType(),
+		SizeBytes:    photoData.Len(),
+		Width:        photo.Bounds().Dx(),
+		Height:       photo.Bounds().Dy(),
+	}
+	stored.URL = store.URL(stored.ImageKey)
+	stored.ThumbnailURL = store.URL(stored.ThumbnailKey)
+
+	if err := store.Put(ctx, stored.ImageKey, photoData.Bytes(), stored.ContentType); err != nil {
+		return nil, err
+	}
+	if err := store.Put(ctx, stored.ThumbnailKey, thumbData.Bytes(), stored.ContentType); err != nil {
+		removeStoredFiles(ctx, store, stored.ImageKey)
+		return nil, err
+	}
+	return stored, nil
+}
+
+// removeStoredFiles deletes stored files, logging failures: an orphaned
+// file is harmless, so it never fails the request. Empty keys, of photos
+// hosted elsewhere, are skipped.
+func removeStoredFiles(ctx context.Context, store storage.Storage, keys ...string) {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if err := store.Delete(ctx, key); err != nil {
+			logger.FromContext(ctx).Warn("Failed to delete uploaded file").
+				Str("key", key).
+				Err(err).
+				Send()
+		}
+	}
+}
@@ -0,0 +1,254 @@
+// internal/services/inventory_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// INVENTORY SERVICE - Product stock, usage and low-stock alerts
+// ========================================================================
+//
+// Barbers track the products their services use. Items are matched by name
+// against a service's required_products; when a booking is completed (the
+// "inventory" status hook) each matching item loses usage_per_service from
+// stock, and the barber is notified once an item drops to its low-stock
+// threshold.
+// ========================================================================
+
+// InventoryService manages barbers' product stock
+type InventoryService struct {
+	repo          *repository.InventoryRepository
+	barberRepo    repository.BarberStore
+	notifications *NotificationService
+	clock         clock.Clock
+}
+
+// NewInventoryService creates a new inventory service
+func NewInventoryService(
+	repo *repository.InventoryRepository,
+	barberRepo repository.BarberStore,
+	notifications *NotificationService,
+) *InventoryService {
+	return &InventoryService{
+		repo:          repo,
+		barberRepo:    barberRepo,
+		notifications: notifications,
+		clock:         clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *InventoryService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// InventoryItemRequest creates or replaces an inventory item. Quantity sets
+// the stock on hand (e.g. after a delivery).
+type InventoryItemRequest struct {
+	Name              string `json:"name" binding:"required,max=100" example:"Hair dye (black)"`
+	Unit              string `json:"unit" binding:"omitempty,max=20" example:"tube"`
+	Quantity          int    `json:"quantity" example:"24"`
+	UsagePerService   *int   `json:"usage_per_service" example:"1"` // Default: 1
+	LowStockThreshold int    `json:"low_stock_threshold" example:"5"`
+}
+
+// InventoryUsageQuery selects a usage report date range (UTC days, inclusive)
+type InventoryUsageQuery struct {
+	From string `form:"from" example:"2024-06-01"` // Default: 29 days before to
+	To   string `form:"to" example:"2024-06-30"`   // Default: today
+}
+
+// ========================================================================
+// ITEMS
+// ========================================================================
+
+// authorize ensures the user may manage the barber's inventory: admins may
+// manage any barber's, barbers only their own
+func (s *InventoryService) authorize(ctx context.Context, barberID, userID int, isAdmin bool) error {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return repository.ErrNotOwner
+	}
+	return nil
+}
+
+// ListItems returns a barber's inventory, optionally only low-stock items
+func (s *InventoryService) ListItems(ctx context.Context, barberID int, lowStockOnly bool, userID int, isAdmin bool) ([]models.InventoryItem, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByBarberID(ctx, barberID, lowStockOnly)
+}
+
+// CreateItem starts tracking a product for a barber
+func (s *InventoryService) CreateItem(ctx context.Context, barberID int, req InventoryItemRequest, userID int, isAdmin bool) (*models.InventoryItem, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	count, err := s.repo.CountByBarberID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if count >= config.MaxInventoryItems {
+		return nil, fmt.Errorf("barber cannot track more than %d products", config.MaxInventoryItems)
+	}
+
+	item := &models.InventoryItem{BarberID: barberID}
+	if err := applyInventoryItemRequest(item, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// UpdateItem replaces an inventory item, including its stock
+func (s *InventoryService) UpdateItem(ctx context.Context, barberID, id int, req InventoryItemRequest, userID int, isAdmin bool) (*models.InventoryItem, error) {
+	item, err := s.findItem(ctx, barberID, id, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyInventoryItemRequest(item, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, item); err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// DeleteItem stops tracking a product and drops its usage history
+func (s *InventoryService) DeleteItem(ctx context.Context, barberID, id int, userID int, isAdmin bool) error {
+	if _, err := s.findItem(ctx, barberID, id, userID, isAdmin); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// findItem loads one of the barber's items for a user who may manage them
+func (s *InventoryService) findItem(ctx context.Context, barberID, id int, userID int, isAdmin bool) (*models.InventoryItem, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	item, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if item.BarberID != barberID {
+		return nil, repository.ErrInventoryItemNotFound
+	}
+	return item, nil
+}
+
+// applyInventoryItemRequest validates a request and copies it to the item
+func applyInventoryItemRequest(item *models.InventoryItem, req InventoryItemRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return fmt.Errorf("name cannot be blank")
+	}
+	if req.Quantity < 0 {
+		return fmt.Errorf("quantity cannot be negative")
+	}
+	if req.LowStockThreshold < 0 {
+		return fmt.Errorf("low_stock_threshold cannot be negative")
+	}
+	usage := 1
+	if req.UsagePerService != nil {
+		usage = *req.UsagePerService
+	}
+	if usage < 0 {
+		return fmt.Errorf("usage_per_service cannot be negative")
+	}
+
+	item.Name = name
+	item.Unit = strings.TrimSpace(req.Unit)
+	if item.Unit == "" {
+		item.Unit = config.DefaultInventoryUnit
+	}
+	item.Quantity = req.Quantity
+	item.UsagePerService = usage
+	item.LowStockThreshold = req.LowStockThreshold
+	return nil
+}
+
+// ========================================================================
+// USAGE
+// ========================================================================
+
+// UsageHook is the "inventory" status hook: it takes a completed booking's
+// products out of stock and alerts the barber about items that ran low
+func (s *InventoryService) UsageHook(ctx context.Context, booking *models.Booking, _, _ string) error {
+	return s.RecordUsage(ctx, booking)
+}
+
+// RecordUsage takes a completed booking's products out of the barber's
+// stock. Test bookings and bookings without a barber service use nothing.
+func (s *InventoryService) RecordUsage(ctx context.Context, booking *models.Booking) error {
+	if booking.Status != config.BookingStatusCompleted || booking.BarberServiceID == nil || booking.IsTest {
+		return nil
+	}
+
+	changes, err := s.repo.RecordBookingUsage(ctx, booking.ID)
+	if err != nil {
+		return err
+	}
+
+	var barber *models.Barber
+	for i := range changes {
+		change := &changes[i]
+		if !change.BecameLowStock() {
+			continue
+		}
+		if barber == nil {
+			if barber, err = s.barberRepo.FindByID(ctx, booking.BarberID); err != nil {
+				return err
+			}
+		}
+		if err := s.notifications.SendLowStockAlert(ctx, barber.UserID, &change.InventoryItem); err != nil {
+			logger.FromContext(ctx).Warn("Failed to send low stock alert").
+				Int("inventory_item_id", change.ID).
+				Err(err).
+				Send()
+		}
+	}
+	return nil
+}
+
+// GetUsageReport totals how much of each product completed bookings used
+// over a date range
+func (s *InventoryService) GetUsageReport(ctx context.Context, barberID int, query InventoryUsageQuery, userID int, isAdmin bool) (*models.InventoryUsageReport, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	from, to, err := resolveDayRange(s.clock.Now(), query.From, query.To,
+		config.DefaultInventoryUsageRangeDays, config.MaxInventoryUsageRangeDays)
+	if err != nil {
+		return nil, err
+	}
+
+	// The range is inclusive of the last day
+	items, err := s.repo.UsageByItem(ctx, barberID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+	return &models.InventoryUsageReport{BarberID: barberID, From: from, To: to, Items: items}, nil
+}
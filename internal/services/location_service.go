@@ -0,0 +1,216 @@
+// internal/services/location_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// LOCATION SERVICE - Barber locations and travel times
+// ========================================================================
+//
+// Barbers who work at several locations list them here and say how long
+// they need to get from one to another. Bookings made at a location then
+// keep that travel time free to and from the barber's bookings at the
+// other locations (see BookingService.SetLocations).
+// ========================================================================
+
+// LocationService manages barber locations and travel-time matrices
+type LocationService struct {
+	repo       *repository.LocationRepository
+	barberRepo repository.BarberStore
+}
+
+// NewLocationService creates a new location service
+func NewLocationService(repo *repository.LocationRepository, barberRepo repository.BarberStore) *LocationService {
+	return &LocationService{
+		repo:       repo,
+		barberRepo: barberRepo,
+	}
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// LocationRequest creates or replaces a location
+type LocationRequest struct {
+	Name    string  `json:"name" binding:"required,max=100" example:"Downtown studio"`
+	Address *string `json:"address" example:"12 Main St"`
+	City    *string `json:"city" binding:"omitempty,max=100" example:"Springfield"`
+}
+
+// TravelTimesRequest replaces a barber's travel-time matrix. A time given
+// for one direction also applies to the other unless that one is given too.
+type TravelTimesRequest struct {
+	TravelTimes []models.TravelTime `json:"travel_times"`
+}
+
+// ========================================================================
+// LOCATIONS
+// ========================================================================
+
+// authorize ensures the user may manage the barber's locations: admins
+// may manage any barber's, barbers only their own
+func (s *LocationService) authorize(ctx context.Context, barberID, userID int, isAdmin bool) error {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return repository.ErrNotOwner
+	}
+	return nil
+}
+
+// ListLocations returns a barber's locations
+func (s *LocationService) ListLocations(ctx context.Context, barberID int) ([]models.BarberLocation, error) {
+	if _, err := s.barberRepo.FindByID(ctx, barberID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByBarberID(ctx, barberID)
+}
+
+// CreateLocation adds a location to a barber
+func (s *LocationService) CreateLocation(ctx context.Context, barberID int, req LocationRequest, userID int, isAdmin bool) (*models.BarberLocation, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.FindByBarberID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= config.MaxBarberLocations {
+		return nil, fmt.Errorf("barber cannot have more than %d locations", config.MaxBarberLocations)
+	}
+
+	location := &models.BarberLocation{BarberID: barberID}
+	if err := applyLocationRequest(location, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, location); err != nil {
+		return nil, err
+	}
+	return location, nil
+}
+
+// UpdateLocation replaces a location's name and address
+func (s *LocationService) UpdateLocation(ctx context.Context, barberID, id int, req LocationRequest, userID int, isAdmin bool) (*models.BarberLocation, error) {
+	location, err := s.findLocation(ctx, barberID, id, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyLocationRequest(location, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, location); err != nil {
+		return nil, err
+	}
+	return location, nil
+}
+
+// DeleteLocation removes a location and its travel times. Bookings at the
+// location stay, without a location.
+func (s *LocationService) DeleteLocation(ctx context.Context, barberID, id int, userID int, isAdmin bool) error {
+	if _, err := s.findLocation(ctx, barberID, id, userID, isAdmin); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// findLocation loads one of the barber's locations for a user who may
+// manage them
+func (s *LocationService) findLocation(ctx context.Context, barberID, id int, userID int, isAdmin bool) (*models.BarberLocation, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	location, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if location.BarberID != barberID {
+		return nil, repository.ErrLocationNotFound
+	}
+	return location, nil
+}
+
+// applyLocationRequest validates a request and copies it to the location
+func applyLocationRequest(location *models.BarberLocation, req LocationRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return fmt.Errorf("name cannot be blank")
+	}
+	location.Name = name
+	location.Address = req.Address
+	location.City = req.City
+	return nil
+}
+
+// ========================================================================
+// TRAVEL TIMES
+// ========================================================================
+
+// GetTravelTimes returns a barber's travel-time matrix
+func (s *LocationService) GetTravelTimes(ctx context.Context, barberID int, userID int, isAdmin bool) ([]models.TravelTime, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.repo.FindTravelTimes(ctx, barberID)
+}
+
+// SetTravelTimes replaces a barber's travel-time matrix. Existing bookings
+// are not checked against the new times.
+func (s *LocationService) SetTravelTimes(ctx context.Context, barberID int, req TravelTimesRequest, userID int, isAdmin bool) ([]models.TravelTime, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	locations, err := s.repo.FindByBarberID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTravelTimes(req.TravelTimes, locations); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.ReplaceTravelTimes(ctx, barberID, req.TravelTimes); err != nil {
+		return nil, err
+	}
+	return s.repo.FindTravelTimes(ctx, barberID)
+}
+
+// validateTravelTimes checks every entry joins two different locations of
+// the barber, once, within the allowed time
+func validateTravelTimes(times []models.TravelTime, locations []models.BarberLocation) error {
+	owned := make(map[int]bool, len(locations))
+	for _, l := range locations {
+		owned[l.ID] = true
+	}
+
+	seen := make(map[[2]int]bool, len(times))
+	for _, t := range times {
+		if !owned[t.FromLocationID] || !owned[t.ToLocationID] {
+			return fmt.Errorf("travel times must be between the barber's locations")
+		}
+		if t.FromLocationID == t.ToLocationID {
+			return fmt.Errorf("travel time cannot be from a location to itself")
+		}
+		if t.Minutes < 0 || t.Minutes > config.MaxTravelMinutes {
+			return fmt.Errorf("travel time must be between 0 and %d minutes", config.MaxTravelMinutes)
+		}
+		pair := [2]int{t.FromLocationID, t.ToLocationID}
+		if seen[pair] {
+			return fmt.Errorf("travel time from location %d to %d cannot be given twice", t.FromLocationID, t.ToLocationID)
+		}
+		seen[pair] = true
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+// internal/services/notification_delivery.go
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/sms"
+	"barber-booking-system/internal/worker"
+)
+
+// ========================================================================
+// NOTIFICATION DELIVERY - Background dispatch of pending notifications
+// ========================================================================
+//
+// Creating a notification only stores it (which is all the in-app channel
+// needs). The worker claims pending notifications in batches and sends them
+// over their other channels. A notification is marked sent once every
+// channel succeeds; otherwise it is retried with exponential backoff,
+// skipping channels that already went out, until MaxAttempts is reached
+// and it is marked failed.
+// ========================================================================
+
+// DeliveryPolicy controls how pending notifications are claimed and retried
+type DeliveryPolicy struct {
+	MaxAttempts int           // Attempts before a notification is marked failed
+	RetryBase   time.Duration // Delay after the first failure; doubles per attempt
+	RetryMax    time.Duration
+	Lease       time.Duration // How long a claimed notification is hidden from other workers
+}
+
+// defaultDeliveryPolicy returns the configured defaults
+func defaultDeliveryPolicy() DeliveryPolicy {
+	return DeliveryPolicy{
+		MaxAttempts: config.DefaultNotificationMaxAttempts,
+		RetryBase:   config.DefaultNotificationRetryBase,
+		RetryMax:    config.DefaultNotificationRetryMax,
+		Lease:       config.DefaultNotificationLease,
+	}
+}
+
+// SetDeliveryPolicy replaces the retry policy; unset fields keep their defaults
+func (s *NotificationService) SetDeliveryPolicy(policy DeliveryPolicy) {
+	defaults := defaultDeliveryPolicy()
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = defaults.MaxAttempts
+	}
+	if policy.RetryBase <= 0 {
+		policy.RetryBase = defaults.RetryBase
+	}
+	if policy.RetryMax <= 0 {
+		policy.RetryMax = defaults.RetryMax
+	}
+	if policy.Lease <= 0 {
+		policy.Lease = defaults.Lease
+	}
+	s.delivery = policy
+}
+
+// ProcessPendingNotifications claims up to limit pending notifications and
+// delivers them. It returns how many were claimed.
+func (s *NotificationService) ProcessPendingNotifications(ctx context.Context, limit int) (int, error) {
+	notifications, err := s.repo.ClaimPending(ctx, s.clock.Now(), s.delivery.Lease, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range notifications {
+		if ctx.Err() != nil {
+			// Unprocessed claims become visible again when their lease ends
+			break
+		}
+		s.processNotification(ctx, &notifications[i])
+	}
+	return len(notifications), nil
+}
+
+// processNotification delivers one claimed notification and records the outcome
+func (s *NotificationService) processNotification(ctx context.Context, notification *models.Notification) {
+	log := logger.FromContext(ctx)
+
+	delivered, err := s.deliver(ctx, notification)
+	if err == nil {
+		err := s.repo.MarkAsSent(ctx, notification.ID)
+		if err != nil && !errors.Is(err, repository.ErrNotificationAlreadySent) {
+			log.Warn("Failed to mark notification sent").Int("notification_id", notification.ID).Err(err).Send()
+		}
+		return
+	}
+
+	if notification.Attempts >= s.delivery.MaxAttempts || isPermanentDeliveryError(err) {
+		log.Error(err).
+			Int("notification_id", notification.ID).
+			Int("attempts", notification.Attempts).
+			Msg("Notification delivery failed")
+		if markErr := s.repo.MarkAsFailed(ctx, notification.ID, err.Error()); markErr != nil {
+			log.Warn("Failed to mark notification failed").Int("notification_id", notification.ID).Err(markErr).Send()
+		}
+		return
+	}
+
+	retryAt := s.clock.Now().Add(worker.Backoff(notification.Attempts, s.delivery.RetryBase, s.delivery.RetryMax))
+	log.Warn("Notification delivery failed, will retry").
+		Int("notification_id", notification.ID).
+		Int("attempts", notification.Attempts).
+		Time("retry_at", retryAt).
+		Err(err).
+		Send()
+	if err := s.repo.ScheduleRetry(ctx, notification.ID, retryAt, err.Error(), delivered); err != nil &&
+		!errors.Is(err, repository.ErrNotificationNotFound) {
+		log.Warn("Failed to schedule notification retry").Int("notification_id", notification.ID).Err(err).Send()
+	}
+}
+
+// deliver sends a notification over each of its channels that has not
+// already succeeded. It returns the channels delivered so far and the first
+// error; later channels are still attempted after a failure.
+func (s *NotificationService) deliver(ctx context.Context, notification *models.Notification) ([]string, error) {
+	if isSandboxNotification(notification) {
+		// Notifications about test bookings are only stored
+		return notification.Channels, nil
+	}
+
+	delivered := deliveredChannels(notification)
+
+	var firstErr error
+	for _, channel := range notification.Channels {
+		if slices.Contains(delivered, channel) {
+			continue
+		}
+
+		var err error
+		switch channel {
+		case config.NotificationChannelPush:
+			err = s.sendPush(ctx, notification)
+		case config.NotificationChannelSMS:
+			err = s.sendSMS(ctx, notification)
+		default:
+			// In-app notifications are delivered by being stored; email has
+			// no provider yet (when it does, it must skip suppressed
+			// addresses as sendSMS does)
+		}
+
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		delivered = append(delivered, channel)
+	}
+	return delivered, firstErr
+}
+
+// deliveredChannels returns the channels recorded as sent by earlier attempts
+func deliveredChannels(notification *models.Notification) []string {
+	values, _ := notification.Data["delivered_channels"].([]interface{})
+	channels := make([]string, 0, len(values))
+	for _, v := range values {
+		if channel, ok := v.(string); ok {
+			channels = append(channels, channel)
+		}
+	}
+	return channels
+}
+
+// isPermanentDeliveryError reports failures that retrying cannot fix
+func isPermanentDeliveryError(err error) bool {
+	return errors.Is(err, sms.ErrNotConfigured)
+}
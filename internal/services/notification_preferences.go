@@ -0,0 +1,164 @@
+// internal/services/notification_preferences.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// NOTIFICATION PREFERENCES - Which types go out on which channels
+// ========================================================================
+//
+// Each notification type goes out on its default channels (see
+// getDefaultChannels) unless the user has chosen channels for it: e.g.
+// reminders on push only, promotions off. A chosen list replaces the
+// type's channels, and an empty one turns the type off. SMS additionally
+// needs the user's SMS opt-in, so it is only used when the sender would
+// have texted anyway. Account security messages cannot be turned off.
+// ========================================================================
+
+// ErrInvalidNotificationPreference is returned for preferences that
+// cannot be saved
+var ErrInvalidNotificationPreference = errors.New("invalid notification preference")
+
+// NotificationPreferenceResponse is how one notification type is delivered
+// to the user
+type NotificationPreferenceResponse struct {
+	Type            string   `json:"type" example:"booking_reminder"`
+	Channels        []string `json:"channels"`         // The channels it goes out on; empty = off
+	DefaultChannels []string `json:"default_channels"` // The channels without a preference
+	Customized      bool     `json:"customized"`       // Channels chosen by the user
+}
+
+// UpdateNotificationPreferencesRequest sets channels per notification type.
+// An empty list turns a type off and null restores its default channels;
+// types not listed keep their current setting.
+type UpdateNotificationPreferencesRequest struct {
+	Preferences map[string][]string `json:"preferences" binding:"required"`
+}
+
+// SetPreferences enables per-user notification preferences
+func (s *NotificationService) SetPreferences(prefs *repository.NotificationPreferenceRepository) {
+	s.preferences = prefs
+}
+
+// GetPreferences returns how each notification type the user can configure
+// is delivered to them, by type
+func (s *NotificationService) GetPreferences(ctx context.Context, userID int) ([]NotificationPreferenceResponse, error) {
+	chosen := map[string]models.NotificationPreference{}
+	if s.preferences != nil {
+		prefs, err := s.preferences.FindByUser(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		for _, pref := range prefs {
+			chosen[pref.NotificationType] = pref
+		}
+	}
+
+	types := configurableNotificationTypes()
+	responses := make([]NotificationPreferenceResponse, 0, len(types))
+	for _, notifType := range types {
+		response := NotificationPreferenceResponse{
+			Type:            notifType,
+			DefaultChannels: getDefaultChannels(notifType),
+		}
+		response.Channels = response.DefaultChannels
+		if pref, ok := chosen[notifType]; ok {
+			response.Channels = []string(pref.Channels)
+			if response.Channels == nil {
+				response.Channels = []string{}
+			}
+			response.Customized = true
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+// UpdatePreferences saves the user's channel choices and returns the
+// resulting preferences
+func (s *NotificationService) UpdatePreferences(ctx context.Context, userID int, req UpdateNotificationPreferencesRequest) ([]NotificationPreferenceResponse, error) {
+	if s.preferences == nil {
+		return nil, fmt.Errorf("%w: notification preferences are not enabled", ErrInvalidNotificationPreference)
+	}
+
+	var prefs []models.NotificationPreference
+	var reset []string
+	for notifType, channels := range req.Preferences {
+		if !slices.Contains(configurableNotificationTypes(), notifType) {
+			return nil, fmt.Errorf("%w: %s is not a notification type you can configure", ErrInvalidNotificationPreference, notifType)
+		}
+		if channels == nil {
+			reset = append(reset, notifType)
+			continue
+		}
+
+		pref := models.NotificationPreference{UserID: userID, NotificationType: notifType, Channels: models.StringArray{}}
+		for _, channel := range channels {
+			if !repository.IsValidNotificationChannel(channel) {
+				return nil, fmt.Errorf("%w: unknown channel %q for %s", ErrInvalidNotificationPreference, channel, notifType)
+			}
+			if !slices.Contains(pref.Channels, channel) {
+				pref.Channels = append(pref.Channels, channel)
+			}
+		}
+		prefs = append(prefs, pref)
+	}
+
+	if err := s.preferences.Update(ctx, userID, prefs, reset); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Notification preferences updated").
+		Int("user_id", userID).
+		Int("set", len(prefs)).
+		Int("reset", len(reset)).
+		Send()
+
+	return s.GetPreferences(ctx, userID)
+}
+
+// preferredChannels applies the user's preference for a notification type
+// to the channels its sender picked. An empty result means the user turned
+// the type off. A preference that cannot be loaded is ignored so the
+// notification still goes out.
+func (s *NotificationService) preferredChannels(ctx context.Context, userID int, notifType string, picked []string) []string {
+	if s.preferences == nil || slices.Contains(config.RequiredNotificationTypes, notifType) {
+		return picked
+	}
+
+	pref, err := s.preferences.Find(ctx, userID, notifType)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to load notification preference").
+			Int("user_id", userID).
+			Str("type", notifType).
+			Err(err).
+			Send()
+		return picked
+	}
+	if pref == nil {
+		return picked
+	}
+	return pref.ChannelsFor(picked)
+}
+
+// configurableNotificationTypes are the types users can set channels for
+func configurableNotificationTypes() []string {
+	types := make([]string, 0, len(repository.ValidNotificationTypes))
+	for _, notifType := range repository.ValidNotificationTypes {
+		if !slices.Contains(config.RequiredNotificationTypes, notifType) {
+			types = append(types, notifType)
+		}
+	}
+	slices.Sort(types)
+	return types
+}
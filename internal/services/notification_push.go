@@ -0,0 +1,142 @@
+// internal/services/notification_push.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/push"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// PUSH DELIVERY - Device notifications through FCM and APNs
+// ========================================================================
+//
+// Notifications whose channels include "push" are sent by the delivery
+// worker to every device the user has registered, unless they turned push
+// off (notification_settings.push = false). The badge is the user's unread
+// count. Tokens the provider rejects are forgotten; transient failures are
+// retried by the dispatcher and, if every device still fails, by the worker.
+// ========================================================================
+
+// RegisterDeviceRequest registers an app install for push notifications
+type RegisterDeviceRequest struct {
+	Token      string  `json:"token" binding:"required,max=512"`
+	Platform   string  `json:"platform" binding:"required,oneof=ios android web"`
+	AppVersion *string `json:"app_version" binding:"omitempty,max=50"`
+}
+
+// SetPushDelivery sets the device registry and the push dispatcher. A nil
+// or empty dispatcher keeps device registration working without sending.
+func (s *NotificationService) SetPushDelivery(devices *repository.DeviceTokenRepository, dispatcher *push.Dispatcher) {
+	s.devices = devices
+	s.push = dispatcher
+}
+
+// RegisterDevice registers (or re-registers) a device token for a user
+func (s *NotificationService) RegisterDevice(ctx context.Context, userID int, req RegisterDeviceRequest) (*models.DeviceToken, error) {
+	device := &models.DeviceToken{
+		UserID:     userID,
+		Platform:   req.Platform,
+		Token:      req.Token,
+		AppVersion: req.AppVersion,
+	}
+	if err := s.devices.Upsert(ctx, device); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Push device registered").
+		Int("user_id", userID).
+		Str("platform", req.Platform).
+		Send()
+	return device, nil
+}
+
+// UnregisterDevice removes one of a user's device tokens
+func (s *NotificationService) UnregisterDevice(ctx context.Context, userID int, token string) error {
+	return s.devices.Delete(ctx, userID, token)
+}
+
+// sendPush sends a notification to the user's devices. It succeeds when at
+// least one device received it or there is nothing to send to (push not
+// configured, opted out, no devices, or only invalid tokens).
+func (s *NotificationService) sendPush(ctx context.Context, notification *models.Notification) error {
+	if !s.push.Enabled() || s.devices == nil {
+		return nil
+	}
+	log := logger.FromContext(ctx)
+
+	user, err := s.userRepo.FindByID(ctx, notification.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to load user for push: %w", err)
+	}
+	if user.HasOptedOut(config.NotificationChannelPush) {
+		return nil
+	}
+
+	devices, err := s.devices.FindByUser(ctx, notification.UserID)
+	if err != nil {
+		return err
+	}
+
+	msg := push.Message{
+		Title: notification.Title,
+		Body:  notification.Message,
+		Data: map[string]string{
+			"notification_id": strconv.Itoa(notification.ID),
+			"type":            notification.Type,
+		},
+	}
+	if notification.RelatedEntityType != nil && notification.RelatedEntityID != nil {
+		msg.Data["related_entity_type"] = *notification.RelatedEntityType
+		msg.Data["related_entity_id"] = strconv.Itoa(*notification.RelatedEntityID)
+	}
+	if unread, err := s.repo.GetUnreadCount(ctx, notification.UserID); err == nil {
+		msg.Badge = &unread
+	} else {
+		log.Warn("Failed to count unread notifications for badge").Int("user_id", notification.UserID).Err(err).Send()
+	}
+
+	var lastErr error
+	delivered := 0
+	for _, device := range devices {
+		msg.Token = device.Token
+		err := s.push.Send(ctx, device.Platform, msg)
+		switch {
+		case err == nil:
+			delivered++
+			if err := s.devices.MarkUsed(ctx, device.ID, s.clock.Now()); err != nil {
+				log.Warn("Failed to update push device").Int("device_id", device.ID).Err(err).Send()
+			}
+		case errors.Is(err, push.ErrNotConfigured):
+			// No provider for this platform
+		case errors.Is(err, push.ErrInvalidToken):
+			log.Info("Removing invalid push device").
+				Int("user_id", device.UserID).
+				Str("platform", device.Platform).
+				Send()
+			if err := s.devices.DeleteByToken(ctx, device.Token); err != nil {
+				log.Warn("Failed to remove push device").Int("device_id", device.ID).Err(err).Send()
+			}
+		default:
+			lastErr = err
+			log.Warn("Failed to send push notification").
+				Int("notification_id", notification.ID).
+				Int("device_id", device.ID).
+				Str("platform", device.Platform).
+				Err(err).
+				Send()
+		}
+	}
+
+	if delivered == 0 && lastErr != nil {
+		return lastErr
+	}
+	return nil
+}
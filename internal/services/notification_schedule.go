@@ -0,0 +1,114 @@
+// internal/services/notification_schedule.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+)
+
+// ========================================================================
+// NOTIFICATION SCHEDULE - When notifications go out, in local time
+// ========================================================================
+//
+// Notifications can be scheduled for a wall-clock time in the recipient's
+// timezone (scheduled_local) rather than an absolute instant, using the
+// timezone preference times are written in (see notification_time.go).
+// Non-urgent notifications that would reach out (push, SMS or email)
+// during the recipient's quiet hours are held back until the quiet hours
+// end. High and urgent priorities, account security messages and
+// notifications shown only in the app go out as due.
+// ========================================================================
+
+// ErrInvalidSchedule is returned for notification times that cannot be used
+var ErrInvalidSchedule = errors.New("invalid notification schedule")
+
+// scheduledLocalLayout is the format of CreateNotificationRequest.ScheduledLocal
+const scheduledLocalLayout = "2006-01-02T15:04"
+
+// SetQuietHours holds back non-urgent notifications during quiet hours in
+// each recipient's timezone. A disabled config turns them off; invalid
+// times do too (the config is validated on load).
+func (s *NotificationService) SetQuietHours(cfg config.QuietHoursConfig) {
+	s.quietHours = models.QuietHours{}
+	if !cfg.Enabled {
+		return
+	}
+	if quietHours, err := models.ParseQuietHours(cfg.Start, cfg.End); err == nil {
+		s.quietHours = quietHours
+	}
+}
+
+// scheduleFor returns when a new notification goes out (nil for now): the
+// requested time, read in the recipient's timezone when given as local
+// time, and moved past their quiet hours when they apply
+func (s *NotificationService) scheduleFor(ctx context.Context, req CreateNotificationRequest) (*time.Time, error) {
+	deferrable := s.deferrable(req)
+	if req.ScheduledLocal == "" && !deferrable {
+		return req.ScheduledFor, nil
+	}
+
+	loc := s.styleFor(ctx, &req.UserID).Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	scheduledFor := req.ScheduledFor
+	if req.ScheduledLocal != "" {
+		if req.ScheduledFor != nil {
+			return nil, fmt.Errorf("%w: send scheduled_for or scheduled_local, not both", ErrInvalidSchedule)
+		}
+		local, err := time.ParseInLocation(scheduledLocalLayout, req.ScheduledLocal, loc)
+		if err != nil {
+			return nil, fmt.Errorf("%w: scheduled_local must be in YYYY-MM-DDTHH:MM format", ErrInvalidSchedule)
+		}
+		scheduledFor = &local
+	}
+	if !deferrable {
+		return scheduledFor, nil
+	}
+
+	due := s.clock.Now()
+	if scheduledFor != nil && scheduledFor.After(due) {
+		due = *scheduledFor
+	}
+	deferred := s.quietHours.Defer(due, loc)
+	if deferred.Equal(due) {
+		return scheduledFor, nil
+	}
+	// Better during quiet hours than never
+	if req.ExpiresAt != nil && !deferred.Before(*req.ExpiresAt) {
+		return scheduledFor, nil
+	}
+
+	logger.FromContext(ctx).Debug("Notification held back for quiet hours").
+		Int("user_id", req.UserID).
+		Str("type", req.Type).
+		Str("timezone", loc.String()).
+		Str("deferred_until", deferred.UTC().Format(time.RFC3339)).
+		Send()
+	return &deferred, nil
+}
+
+// deferrable returns true if quiet hours hold back a notification
+func (s *NotificationService) deferrable(req CreateNotificationRequest) bool {
+	if s.quietHours.IsZero() {
+		return false
+	}
+	if req.Priority == config.NotificationPriorityHigh || req.Priority == config.NotificationPriorityUrgent {
+		return false
+	}
+	if slices.Contains(config.RequiredNotificationTypes, req.Type) {
+		return false
+	}
+	// Notifications only in the app wait there silently anyway
+	return slices.ContainsFunc(req.Channels, func(channel string) bool {
+		return channel != config.NotificationChannelApp
+	})
+}
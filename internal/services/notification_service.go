@@ -4,12 +4,19 @@ package services
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/clock"
 	"barber-booking-system/internal/config"
 	"barber-booking-system/internal/logger"
 	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/push"
 	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/sms"
+	"barber-booking-system/internal/timefmt"
 )
 
 // ========================================================================
@@ -18,24 +25,76 @@ import (
 
 // NotificationService handles notification business logic
 type NotificationService struct {
-	repo        *repository.NotificationRepository
-	userRepo    *repository.UserRepository
-	bookingRepo *repository.BookingRepository
+	repo        repository.NotificationStore
+	userRepo    repository.UserStore
+	bookingRepo repository.BookingStore
+	cache       *cache.CacheService
+	clock       clock.Clock
+
+	// SMS delivery (optional)
+	sms                sms.Sender
+	smsCallbackBaseURL string
+
+	// Push delivery (optional)
+	devices *repository.DeviceTokenRepository
+	push    *push.Dispatcher
+
+	// Addresses email and SMS must skip (optional)
+	suppressions *SuppressionService
+
+	// One-tap confirm/cancel links in reminders (optional)
+	actionLinks ReminderLinkIssuer
+
+	// Background delivery retries
+	delivery DeliveryPolicy
+
+	// Barbers attached to lists (optional)
+	relations *repository.RelationLoader
+
+	// How times are written for users without preferences
+	timeStyle timefmt.Style
+
+	// Channels users chose per notification type (optional)
+	preferences *repository.NotificationPreferenceRepository
+
+	// When non-urgent notifications are held back (zero = never)
+	quietHours models.QuietHours
 }
 
 // NewNotificationService creates a new notification service
 func NewNotificationService(
-	repo *repository.NotificationRepository,
-	userRepo *repository.UserRepository,
-	bookingRepo *repository.BookingRepository,
+	repo repository.NotificationStore,
+	userRepo repository.UserStore,
+	bookingRepo repository.BookingStore,
+	cache *cache.CacheService,
 ) *NotificationService {
 	return &NotificationService{
 		repo:        repo,
 		userRepo:    userRepo,
 		bookingRepo: bookingRepo,
+		cache:       cache,
+		clock:       clock.System,
+		delivery:    defaultDeliveryPolicy(),
+		timeStyle:   timefmt.Default("", ""),
 	}
 }
 
+// SetClock replaces the time source used for expiry and reminder windows (nil restores the system clock)
+func (s *NotificationService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ReminderLinkIssuer issues the confirm/cancel links put in reminders
+type ReminderLinkIssuer interface {
+	// IssueLinks returns a booking's links, or nil when it gets none
+	IssueLinks(ctx context.Context, booking *models.Booking) (*models.BookingActionURLs, error)
+}
+
+// SetActionLinks enables confirm/cancel links in booking reminders (nil disables them)
+func (s *NotificationService) SetActionLinks(issuer ReminderLinkIssuer) {
+	s.actionLinks = issuer
+}
+
 // ========================================================================
 // REQUEST/RESPONSE STRUCTS
 // ========================================================================
@@ -54,6 +113,7 @@ type CreateNotificationRequest struct {
 	RelatedEntityID   *int                   `json:"related_entity_id"`
 	Data              map[string]interface{} `json:"data"`
 	ScheduledFor      *time.Time             `json:"scheduled_for"`
+	ScheduledLocal    string                 `json:"scheduled_local"` // YYYY-MM-DDTHH:MM in the recipient's timezone, instead of scheduled_for
 	ExpiresAt         *time.Time             `json:"expires_at"`
 }
 
@@ -70,6 +130,15 @@ type NotificationResponse struct {
 	IsRead    bool   `json:"is_read"`
 	TimeAgo   string `json:"time_ago"`
 	IsExpired bool   `json:"is_expired"`
+
+	// Barber attached to lists on request (see relations.go)
+	Barber *models.BarberSummary `json:"barber,omitempty"`
+}
+
+// UnreadCountResponse is returned by the long-polling unread-count endpoint
+type UnreadCountResponse struct {
+	UnreadCount int  `json:"unread_count"`
+	Changed     bool `json:"changed"`
 }
 
 // NotificationStatsResponse wraps stats with additional info
@@ -87,11 +156,11 @@ func (s *NotificationService) toNotificationResponse(notification *models.Notifi
 	response := &NotificationResponse{
 		Notification: notification,
 		IsRead:       notification.ReadAt != nil,
-		IsExpired:    notification.ExpiresAt != nil && notification.ExpiresAt.Before(time.Now()),
+		IsExpired:    notification.ExpiresAt != nil && notification.ExpiresAt.Before(s.clock.Now()),
 	}
 
 	// Calculate time ago
-	duration := time.Since(notification.CreatedAt)
+	duration := s.clock.Now().Sub(notification.CreatedAt)
 	switch {
 	case duration < time.Minute:
 		response.TimeAgo = "just now"
@@ -108,18 +177,62 @@ func (s *NotificationService) toNotificationResponse(notification *models.Notifi
 	return response
 }
 
+// signalUnreadChange notifies long-polling clients that a user's unread count may have changed.
+// Best-effort: failures only delay clients until their poll times out.
+func (s *NotificationService) signalUnreadChange(ctx context.Context, userID int) {
+	if s.cache == nil {
+		return
+	}
+	if err := s.cache.PublishUnreadChange(ctx, userID); err != nil {
+		logger.FromContext(ctx).Warn("Failed to publish unread change").
+			Int("user_id", userID).
+			Err(err).
+			Send()
+	}
+}
+
+// publishNotification pushes a new in-app notification to the user's live
+// connections. Notifications scheduled for later, or not shown in the app,
+// are skipped. Best-effort: clients still see it on their next fetch.
+func (s *NotificationService) publishNotification(ctx context.Context, response *NotificationResponse) {
+	if s.cache == nil || !slices.Contains(response.Channels, config.NotificationChannelApp) {
+		return
+	}
+	if response.ScheduledFor != nil && response.ScheduledFor.After(s.clock.Now()) {
+		return
+	}
+
+	event := RealtimeEvent{Type: config.RealtimeEventNotification, Data: response, SentAt: s.clock.Now()}
+	if err := s.cache.PublishUserEvent(ctx, response.UserID, event); err != nil {
+		logger.FromContext(ctx).Warn("Failed to publish notification event").
+			Int("notification_id", response.ID).
+			Int("user_id", response.UserID).
+			Err(err).
+			Send()
+	}
+}
+
 // getDefaultChannels returns default notification channels based on type
 func getDefaultChannels(notifType string) []string {
 	switch notifType {
 	case config.NotificationTypeBookingConfirmation, config.NotificationTypeBookingCancelled, config.NotificationTypeBookingRescheduled:
 		return []string{config.NotificationChannelApp, config.NotificationChannelEmail}
-	case config.NotificationTypeBookingReminder:
+	case config.NotificationTypeBookingReminder, config.NotificationTypeConfirmationRequest:
 		return []string{config.NotificationChannelApp, config.NotificationChannelPush}
 	case config.NotificationTypeReviewRequest:
 		return []string{config.NotificationChannelApp, config.NotificationChannelEmail}
+	case config.NotificationTypeWinBack, config.NotificationTypeRescheduleOffer:
+		return []string{config.NotificationChannelApp, config.NotificationChannelPush, config.NotificationChannelEmail}
+	case config.NotificationTypeAutoReply, config.NotificationTypeOpenSlot:
+		return []string{config.NotificationChannelApp, config.NotificationChannelPush}
+	case config.NotificationTypeLowStock, config.NotificationTypeBookingNoShow,
+		config.NotificationTypeSupportTicket, config.NotificationTypeSupportSLABreach,
+		config.NotificationTypeReviewResponse, config.NotificationTypeConsultationRequest:
+		return []string{config.NotificationChannelApp, config.NotificationChannelEmail}
 	case config.NotificationTypePaymentReceived, config.NotificationTypePaymentFailed:
 		return []string{config.NotificationChannelApp, config.NotificationChannelEmail}
-	case config.NotificationTypeAccountWelcome, config.NotificationTypeAccountVerification, config.NotificationTypePasswordReset:
+	case config.NotificationTypeAccountWelcome, config.NotificationTypeAccountVerification, config.NotificationTypePasswordReset,
+		config.NotificationTypeCustomerInvitation:
 		return []string{config.NotificationChannelEmail}
 	case config.NotificationTypeSystemAlert:
 		return []string{config.NotificationChannelApp}
@@ -132,7 +245,8 @@ func getDefaultChannels(notifType string) []string {
 // CREATE OPERATIONS
 // ========================================================================
 
-// CreateNotification creates a new notification
+// CreateNotification creates a new notification. It returns nil without an
+// error when the recipient has turned the notification type off.
 func (s *NotificationService) CreateNotification(ctx context.Context, req CreateNotificationRequest) (*NotificationResponse, error) {
 	log := logger.FromContext(ctx)
 
@@ -157,7 +271,23 @@ func (s *NotificationService) CreateNotification(ctx context.Context, req Create
 
 	// Set default channels
 	if len(req.Channels) == 0 {
-		req.Channels = []string{config.NotificationChannelApp}
+		req.Channels = getDefaultChannels(req.Type)
+	}
+
+	// The recipient's choice of channels for this type wins
+	req.Channels = s.preferredChannels(ctx, req.UserID, req.Type, req.Channels)
+	if len(req.Channels) == 0 {
+		log.Debug("Notification type turned off by recipient").
+			Int("user_id", req.UserID).
+			Str("type", req.Type).
+			Send()
+		return nil, nil
+	}
+
+	// When it goes out in the recipient's timezone, after their quiet hours
+	scheduledFor, err := s.scheduleFor(ctx, req)
+	if err != nil {
+		return nil, err
 	}
 
 	// Build notification model
@@ -171,7 +301,7 @@ func (s *NotificationService) CreateNotification(ctx context.Context, req Create
 		RelatedEntityType: req.RelatedEntityType,
 		RelatedEntityID:   req.RelatedEntityID,
 		Data:              req.Data,
-		ScheduledFor:      req.ScheduledFor,
+		ScheduledFor:      scheduledFor,
 		ExpiresAt:         req.ExpiresAt,
 		Status:            config.NotificationStatusPending,
 	}
@@ -191,7 +321,11 @@ func (s *NotificationService) CreateNotification(ctx context.Context, req Create
 		Str("type", req.Type).
 		Send()
 
-	return s.toNotificationResponse(notification), nil
+	response := s.toNotificationResponse(notification)
+	s.signalUnreadChange(ctx, req.UserID)
+	s.publishNotification(ctx, response)
+
+	return response, nil
 }
 
 // ========================================================================
@@ -204,12 +338,109 @@ func (s *NotificationService) SendBookingReminder(ctx context.Context, bookingID
 		return err
 	}
 
+	templateKey := "reminder"
+	var vars map[string]string
+	var data map[string]interface{}
+	if links := s.reminderLinks(ctx, booking); links != nil {
+		data = map[string]interface{}{
+			"confirm_url":            links.ConfirmURL,
+			"cancel_url":             links.CancelURL,
+			"action_link_expires_at": links.ExpiresAt,
+		}
+		// Only absolute links are any use in the text of an email or SMS
+		if strings.HasPrefix(links.ConfirmURL, "http") {
+			templateKey = "reminder_with_links"
+			vars = map[string]string{"confirm_url": links.ConfirmURL, "cancel_url": links.CancelURL}
+		}
+	}
+
+	return s.sendBookingNotificationWithTemplate(ctx, booking, templateKey, vars, data, nil)
+}
+
+// reminderLinks issues the confirm/cancel links for a reminder. A reminder
+// still goes out without them if they cannot be issued.
+func (s *NotificationService) reminderLinks(ctx context.Context, booking *models.Booking) *models.BookingActionURLs {
+	if s.actionLinks == nil {
+		return nil
+	}
+	links, err := s.actionLinks.IssueLinks(ctx, booking)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to issue reminder action links").
+			Int("booking_id", booking.ID).
+			Err(err).
+			Send()
+		return nil
+	}
+	return links
+}
+
+// SendConfirmationRequest asks the customer of a high no-show risk booking to
+// confirm or cancel. The notification data carries the request token the
+// client posts the answer with.
+func (s *NotificationService) SendConfirmationRequest(ctx context.Context, booking *models.Booking, request *models.ConfirmationRequest) error {
+	responsePath := "/api/v1/bookings/confirmations/" + request.Token
+	expiresAt := request.ExpiresAt
 	return s.sendBookingNotificationWithTemplate(
-		ctx, booking, "reminder",
-		[]interface{}{booking.ScheduledStartTime.Format("Monday, January 2 at 3:04 PM")},
+		ctx, booking, "confirmation_request", nil,
+		map[string]interface{}{
+			"confirmation_token": request.Token,
+			"confirm_path":       responsePath + "/confirm",
+			"cancel_path":        responsePath + "/cancel",
+			"scheduled_time":     booking.ScheduledStartTime,
+		},
+		&expiresAt,
+	)
+}
+
+// SendBookingExpired tells the customer their booking was cancelled because
+// the barber did not confirm it in time
+func (s *NotificationService) SendBookingExpired(ctx context.Context, booking *models.Booking) error {
+	return s.sendBookingNotificationWithTemplate(
+		ctx, booking, "expired", nil,
+		map[string]interface{}{"reason": "not_confirmed"},
 		nil,
+	)
+}
+
+// SendNoShowMarked tells the customer and the barber that a booking nobody
+// started was marked no-show after its grace period
+func (s *NotificationService) SendNoShowMarked(ctx context.Context, booking *models.Booking, barberUserID, graceMinutes int) error {
+	data := map[string]interface{}{
+		"booking_number": booking.BookingNumber,
+		"barber_id":      booking.BarberID,
+		"grace_minutes":  graceMinutes,
+		"no_show_fee":    booking.NoShowFee,
+	}
+
+	customerErr := s.sendBookingNotificationWithTemplate(
+		ctx, booking, "no_show", nil,
+		data,
 		nil,
 	)
+
+	customer, _, _ := booking.GetCustomerInfo()
+	if customer == "" {
+		customer = "The customer"
+	}
+	if booking.IsTest {
+		data[sandboxDataKey] = true
+	}
+	entityType := config.EntityTypeBooking
+	_, barberErr := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:            barberUserID,
+		Title:             "Booking marked no-show",
+		Message:           fmt.Sprintf("%s did not arrive for booking %s on %s. It was marked as a no-show %d minutes after the start time", customer, booking.BookingNumber, s.FormatTimeFor(ctx, barberUserID, booking.ScheduledStartTime), graceMinutes),
+		Type:              config.NotificationTypeBookingNoShow,
+		Priority:          config.NotificationPriorityNormal,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &booking.ID,
+		Data:              data,
+	})
+
+	if customerErr != nil {
+		return customerErr
+	}
+	return barberErr
 }
 
 // SendBookingCancellation sends a booking cancellation notification
@@ -274,6 +505,28 @@ func (s *NotificationService) SendBookingCancellation(ctx context.Context, booki
 	return nil
 }
 
+// BookingEventHandler tells the customer when their booking is cancelled by
+// someone else (the barber, an admin or the system) from the outbox.
+// Register it with OutboxService.Handle.
+func (s *NotificationService) BookingEventHandler(ctx context.Context, event *models.OutboxEvent) error {
+	if event.EventType != config.DomainEventBookingCancelled {
+		return nil
+	}
+	data, err := decodeBookingEvent(event)
+	if err != nil {
+		return err
+	}
+
+	booking := data.Booking
+	if booking.CustomerID == nil || booking.IsTest {
+		return nil
+	}
+	if data.ChangedBy != nil && *data.ChangedBy == *booking.CustomerID {
+		return nil
+	}
+	return s.SendBookingCancellation(ctx, booking.ID, data.Reason)
+}
+
 // SendBookingRescheduled sends a booking rescheduled notification
 func (s *NotificationService) SendBookingRescheduled(ctx context.Context, bookingID int, oldTime, newTime time.Time) error {
 	booking, err := s.bookingRepo.FindByID(ctx, bookingID)
@@ -281,12 +534,12 @@ func (s *NotificationService) SendBookingRescheduled(ctx context.Context, bookin
 		return err
 	}
 
+	style := s.styleFor(ctx, booking.CustomerID)
 	return s.sendBookingNotificationWithTemplate(
 		ctx, booking, "rescheduled",
-		[]interface{}{
-			booking.BookingNumber,
-			oldTime.Format("Monday, January 2 at 3:04 PM"),
-			newTime.Format("Monday, January 2 at 3:04 PM"),
+		map[string]string{
+			"old_start_time": style.DateTime(oldTime),
+			"new_start_time": style.DateTime(newTime),
 		},
 		map[string]interface{}{"old_time": oldTime, "new_time": newTime},
 		nil,
@@ -304,11 +557,10 @@ func (s *NotificationService) SendReviewRequest(ctx context.Context, bookingID i
 		return nil
 	}
 
-	expiresAt := time.Now().Add(7 * 24 * time.Hour)
+	expiresAt := s.clock.Now().Add(7 * 24 * time.Hour)
 
 	return s.sendBookingNotificationWithTemplate(
-		ctx, booking, "review_request",
-		[]interface{}{booking.ServiceName},
+		ctx, booking, "review_request", nil,
 		map[string]interface{}{
 			"booking_id":   bookingID,
 			"service_name": booking.ServiceName,
@@ -317,6 +569,22 @@ func (s *NotificationService) SendReviewRequest(ctx context.Context, bookingID i
 	)
 }
 
+// SendReviewLink sends the scheduled review request for a completed
+// booking. The notification data carries the one-time review link token.
+func (s *NotificationService) SendReviewLink(ctx context.Context, booking *models.Booking, request *models.ReviewRequest) error {
+	expiresAt := request.ExpiresAt
+	return s.sendBookingNotificationWithTemplate(
+		ctx, booking, "review_request", nil,
+		map[string]interface{}{
+			"booking_id":   booking.ID,
+			"service_name": booking.ServiceName,
+			"review_token": request.Token,
+			"review_path":  "/api/v1/reviews/requests/" + request.Token,
+		},
+		&expiresAt,
+	)
+}
+
 // SendBookingConfirmation sends a booking confirmation notification
 func (s *NotificationService) SendBookingConfirmation(ctx context.Context, bookingID int) error {
 	log := logger.FromContext(ctx)
@@ -344,7 +612,9 @@ func (s *NotificationService) SendBookingConfirmation(ctx context.Context, booki
 	title := "Booking Confirmed"
 	message := fmt.Sprintf("Your booking %s has been confirmed for %s",
 		booking.BookingNumber,
-		booking.ScheduledStartTime.Format("Monday, January 2 at 3:04 PM"))
+		s.FormatTimeFor(ctx, *booking.CustomerID, booking.ScheduledStartTime))
+
+	phone := s.smsRecipient(ctx, booking)
 
 	entityType := config.EntityTypeBooking
 	req := CreateNotificationRequest{
@@ -362,8 +632,12 @@ func (s *NotificationService) SendBookingConfirmation(ctx context.Context, booki
 		},
 	}
 
-	_, err = s.CreateNotification(ctx, req)
-	if err != nil {
+	if phone != "" {
+		req.Channels = append(getDefaultChannels(req.Type), config.NotificationChannelSMS)
+		req.Data[smsRecipientKey] = phone
+	}
+
+	if _, err := s.CreateNotification(ctx, req); err != nil {
 		log.Error(err).
 			Int("booking_id", bookingID).
 			Msg("Failed to send booking confirmation")
@@ -379,98 +653,375 @@ func (s *NotificationService) SendBookingConfirmation(ctx context.Context, booki
 	return nil
 }
 
-// ========================================================================
-// NOTIFICATION TEMPLATES (DRY - extracted from repeated patterns)
-// ========================================================================
+// SendNPSSurvey sends the one-tap NPS prompt for a completed booking. The
+// notification data carries the survey token the client posts the score with.
+func (s *NotificationService) SendNPSSurvey(ctx context.Context, booking *models.Booking, survey *models.NPSSurvey) error {
+	entityType := config.EntityTypeBooking
+	expiresAt := survey.ExpiresAt
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:            survey.CustomerID,
+		Title:             "How likely are you to recommend us?",
+		Message:           fmt.Sprintf("On a scale of 0 to 10, how likely are you to recommend us to a friend after your %s?", booking.ServiceName),
+		Type:              config.NotificationTypeNPSSurvey,
+		Priority:          config.NotificationPriorityLow,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &booking.ID,
+		Data: map[string]interface{}{
+			"booking_number": booking.BookingNumber,
+			"barber_id":      booking.BarberID,
+			"survey_token":   survey.Token,
+			"response_path":  "/api/v1/surveys/nps/" + survey.Token,
+			"score_min":      0,
+			"score_max":      config.NPSMaxScore,
+		},
+		ExpiresAt: &expiresAt,
+	})
+	return err
+}
 
-// BookingNotificationTemplate defines a notification template for booking events
-type BookingNotificationTemplate struct {
-	Title           string
-	MessageTemplate string // Uses %s placeholders
-	Type            string
-	Priority        string
-}
-
-// bookingNotificationTemplates maps notification types to their templates
-var bookingNotificationTemplates = map[string]BookingNotificationTemplate{
-	"confirmation": {
-		Title:           "Booking Confirmed",
-		MessageTemplate: "Your booking %s has been confirmed for %s",
-		Type:            config.NotificationTypeBookingConfirmation,
-		Priority:        config.NotificationPriorityNormal,
-	},
-	"reminder": {
-		Title:           "Upcoming Appointment Reminder",
-		MessageTemplate: "Reminder: Your appointment is scheduled for %s",
-		Type:            config.NotificationTypeBookingReminder,
-		Priority:        config.NotificationPriorityHigh,
-	},
-	"cancellation": {
-		Title:           "Booking Cancelled",
-		MessageTemplate: "Your booking %s has been cancelled",
-		Type:            config.NotificationTypeBookingCancelled,
-		Priority:        config.NotificationPriorityHigh,
-	},
-	"rescheduled": {
-		Title:           "Booking Rescheduled",
-		MessageTemplate: "Your booking %s has been rescheduled from %s to %s",
-		Type:            config.NotificationTypeBookingRescheduled,
-		Priority:        config.NotificationPriorityHigh,
-	},
-	"review_request": {
-		Title:           "How was your experience?",
-		MessageTemplate: "Please take a moment to review your recent appointment (%s). Your feedback helps us improve!",
-		Type:            config.NotificationTypeReviewRequest,
-		Priority:        config.NotificationPriorityNormal,
-	},
-}
-
-// sendBookingNotificationWithTemplate is a helper that sends booking notifications using templates
-func (s *NotificationService) sendBookingNotificationWithTemplate(
-	ctx context.Context,
-	booking *models.Booking,
-	templateKey string,
-	messageArgs []interface{},
-	extraData map[string]interface{},
-	expiresAt *time.Time,
-) error {
+// SendAutoReply sends a customer a barber's out-of-hours reply to their
+// booking request
+func (s *NotificationService) SendAutoReply(ctx context.Context, booking *models.Booking, barberName, message string, nextOpenAt *time.Time) error {
 	if booking.CustomerID == nil {
-		return nil // No notification for guest bookings
-	}
-
-	template, exists := bookingNotificationTemplates[templateKey]
-	if !exists {
-		return fmt.Errorf("unknown notification template: %s", templateKey)
+		return nil
 	}
 
-	message := fmt.Sprintf(template.MessageTemplate, messageArgs...)
-
 	entityType := config.EntityTypeBooking
 	data := map[string]interface{}{
 		"booking_number": booking.BookingNumber,
 		"barber_id":      booking.BarberID,
 	}
-	// Merge extra data
-	for k, v := range extraData {
-		data[k] = v
+	if nextOpenAt != nil {
+		data["next_open_at"] = *nextOpenAt
+	}
+	if booking.IsTest {
+		data[sandboxDataKey] = true
 	}
 
-	req := CreateNotificationRequest{
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
 		UserID:            *booking.CustomerID,
-		Title:             template.Title,
+		Title:             fmt.Sprintf("Message from %s", barberName),
 		Message:           message,
-		Type:              template.Type,
-		Priority:          template.Priority,
+		Type:              config.NotificationTypeAutoReply,
+		Priority:          config.NotificationPriorityNormal,
 		RelatedEntityType: &entityType,
 		RelatedEntityID:   &booking.ID,
 		Data:              data,
-		ExpiresAt:         expiresAt,
+	})
+	return err
+}
+
+// SendRescheduleOffer tells a customer their booking falls in the barber's
+// time off and suggests open slots to move it to
+func (s *NotificationService) SendRescheduleOffer(ctx context.Context, booking *models.Booking, barberName string, timeOff *models.TimeOff, slots []models.TimeRange) error {
+	if booking.CustomerID == nil {
+		return nil
+	}
+
+	style := s.styleFor(ctx, booking.CustomerID)
+	body := fmt.Sprintf("%s is unavailable for your appointment on %s. Please choose a new time",
+		barberName, style.DateTime(booking.ScheduledStartTime))
+	if len(slots) > 0 {
+		body += fmt.Sprintf(", for example %s", style.DateTime(slots[0].Start))
+	}
+	body += "."
+
+	entityType := config.EntityTypeBooking
+	data := map[string]interface{}{
+		"booking_number":  booking.BookingNumber,
+		"barber_id":       booking.BarberID,
+		"time_off_id":     timeOff.ID,
+		"suggested_slots": slots,
+	}
+	if booking.IsTest {
+		data[sandboxDataKey] = true
 	}
 
-	_, err := s.CreateNotification(ctx, req)
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:            *booking.CustomerID,
+		Title:             fmt.Sprintf("Please reschedule your appointment with %s", barberName),
+		Message:           body,
+		Type:              config.NotificationTypeRescheduleOffer,
+		Priority:          config.NotificationPriorityHigh,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &booking.ID,
+		Data:              data,
+	})
 	return err
 }
 
+// SendOpenSlot tells a customer about a barber's last-minute opening. The
+// notification expires when claims on the slot close.
+func (s *NotificationService) SendOpenSlot(ctx context.Context, userID int, barberName string, slot *models.OpenSlot) error {
+	body := fmt.Sprintf("%s has a %s opening on %s", barberName, slot.ServiceName,
+		s.FormatTimeFor(ctx, userID, slot.StartTime))
+	if slot.DiscountPercent > 0 {
+		body += fmt.Sprintf(" at %d%% off", slot.DiscountPercent)
+	}
+	body += ". First to claim it gets it!"
+	if slot.Message != nil {
+		body += " " + *slot.Message
+	}
+
+	entityType := config.EntityTypeOpenSlot
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:            userID,
+		Title:             fmt.Sprintf("Last-minute opening with %s", barberName),
+		Message:           body,
+		Type:              config.NotificationTypeOpenSlot,
+		Priority:          config.NotificationPriorityHigh,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &slot.ID,
+		Data: map[string]interface{}{
+			"open_slot_id":     slot.ID,
+			"barber_id":        slot.BarberID,
+			"start_time":       slot.StartTime,
+			"discount_percent": slot.DiscountPercent,
+			"price":            slot.Price(),
+		},
+		ExpiresAt: &slot.ClaimsCloseAt,
+	})
+	return err
+}
+
+// SendSupportTicketUpdate notifies a requester or assignee about activity
+// on a support ticket
+func (s *NotificationService) SendSupportTicketUpdate(ctx context.Context, userID int, ticket *models.SupportTicket, title, message string) error {
+	entityType := config.EntityTypeSupportTicket
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:            userID,
+		Title:             title,
+		Message:           message,
+		Type:              config.NotificationTypeSupportTicket,
+		Priority:          config.NotificationPriorityNormal,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &ticket.ID,
+		Data: map[string]interface{}{
+			"ticket_id": ticket.ID,
+			"status":    ticket.Status,
+			"priority":  ticket.Priority,
+		},
+	})
+	return err
+}
+
+// SendSupportSLABreach tells a ticket's assignee it missed an SLA target
+func (s *NotificationService) SendSupportSLABreach(ctx context.Context, userID int, ticket *models.SupportTicket, target string) error {
+	entityType := config.EntityTypeSupportTicket
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:            userID,
+		Title:             fmt.Sprintf("Ticket #%d missed its %s target", ticket.ID, target),
+		Message:           fmt.Sprintf("\"%s\" (%s priority) is past its %s due date.", ticket.Subject, ticket.Priority, target),
+		Type:              config.NotificationTypeSupportSLABreach,
+		Priority:          config.NotificationPriorityHigh,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &ticket.ID,
+		Data: map[string]interface{}{
+			"ticket_id": ticket.ID,
+			"target":    target,
+			"priority":  ticket.Priority,
+		},
+	})
+	return err
+}
+
+// SendConsultationRequested tells a barber a customer asked for a
+// consultation, or their answers to a service's booking questions asked
+// for one instead of booking
+func (s *NotificationService) SendConsultationRequested(ctx context.Context, barberUserID int, request *models.ConsultationRequest) error {
+	customer := "A customer"
+	if request.CustomerName != nil && *request.CustomerName != "" {
+		customer = *request.CustomerName
+	}
+	message := fmt.Sprintf("%s asked for %s on %s. Their answers need a consultation first; get in touch to book them in or decline.", customer, request.ServiceName, s.FormatTimeFor(ctx, barberUserID, request.RequestedStartTime))
+	if len(request.TriggeredBy) == 0 {
+		message = fmt.Sprintf("%s would like a consultation about %s and suggested %d time(s), the earliest %s. Send them a quote and a time, or decline.", customer, request.ServiceName, len(request.PreferredTimes), s.FormatTimeFor(ctx, barberUserID, request.RequestedStartTime))
+	}
+	entityType := config.EntityTypeConsultation
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:            barberUserID,
+		Title:             fmt.Sprintf("Consultation requested for %s", request.ServiceName),
+		Message:           message,
+		Type:              config.NotificationTypeConsultationRequest,
+		Priority:          config.NotificationPriorityNormal,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &request.ID,
+		Data: map[string]interface{}{
+			"consultation_request_id": request.ID,
+			"barber_id":               request.BarberID,
+			"requested_start_time":    request.RequestedStartTime,
+			"triggered_by":            request.TriggeredBy,
+		},
+	})
+	return err
+}
+
+// SendConsultationAnswered tells a customer whether the barber booked or
+// declined their consultation request
+func (s *NotificationService) SendConsultationAnswered(ctx context.Context, customerID int, request *models.ConsultationRequest) error {
+	title := fmt.Sprintf("Your %s consultation request was accepted", request.ServiceName)
+	message := fmt.Sprintf("Your barber has booked you in for %s.", request.ServiceName)
+	if request.Status == config.ConsultationRequestDeclined {
+		title = fmt.Sprintf("Your %s consultation request was declined", request.ServiceName)
+		message = fmt.Sprintf("Your barber cannot offer %s for the time you asked for.", request.ServiceName)
+	}
+	if request.ResponseNotes != nil {
+		message += " " + *request.ResponseNotes
+	}
+
+	entityType := config.EntityTypeConsultation
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:            customerID,
+		Title:             title,
+		Message:           message,
+		Type:              config.NotificationTypeConsultationRequest,
+		Priority:          config.NotificationPriorityNormal,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &request.ID,
+		Data: map[string]interface{}{
+			"consultation_request_id": request.ID,
+			"barber_id":               request.BarberID,
+			"status":                  request.Status,
+		},
+	})
+	return err
+}
+
+// SendConsultationQuoted tells a customer the barber quoted a price and
+// time for their consultation request, which they can accept as a booking
+func (s *NotificationService) SendConsultationQuoted(ctx context.Context, customerID int, request *models.ConsultationRequest) error {
+	message := fmt.Sprintf("Your barber can do %s for %.2f on %s. Accept the quote to book it.", request.ServiceName, *request.QuotedPrice, s.FormatTimeFor(ctx, customerID, *request.ProposedStartTime))
+	if request.ResponseNotes != nil {
+		message += " " + *request.ResponseNotes
+	}
+
+	entityType := config.EntityTypeConsultation
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:            customerID,
+		Title:             fmt.Sprintf("Your %s quote is ready", request.ServiceName),
+		Message:           message,
+		Type:              config.NotificationTypeConsultationRequest,
+		Priority:          config.NotificationPriorityNormal,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &request.ID,
+		Data: map[string]interface{}{
+			"consultation_request_id": request.ID,
+			"barber_id":               request.BarberID,
+			"status":                  request.Status,
+			"quoted_price":            *request.QuotedPrice,
+			"proposed_start_time":     *request.ProposedStartTime,
+		},
+	})
+	return err
+}
+
+// SendLowStockAlert tells a barber a product has run low after a service
+// used some of it
+func (s *NotificationService) SendLowStockAlert(ctx context.Context, barberUserID int, item *models.InventoryItem) error {
+	entityType := config.EntityTypeInventoryItem
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:            barberUserID,
+		Title:             fmt.Sprintf("Running low on %s", item.Name),
+		Message:           fmt.Sprintf("%d %s of %s left in stock", item.Quantity, item.Unit, item.Name),
+		Type:              config.NotificationTypeLowStock,
+		Priority:          config.NotificationPriorityHigh,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &item.ID,
+		Data: map[string]interface{}{
+			"barber_id":           item.BarberID,
+			"quantity":            item.Quantity,
+			"low_stock_threshold": item.LowStockThreshold,
+		},
+	})
+	return err
+}
+
+// SendReviewResponseRejected tells a barber their response to a review was
+// rejected or taken down by a moderator, and why
+func (s *NotificationService) SendReviewResponseRejected(ctx context.Context, barberUserID int, review *models.Review, status string, notes *string) error {
+	title := "Your review response was not published"
+	message := "A moderator rejected your response to a review. You can write a new one."
+	if status == config.ReviewResponseRedacted {
+		title = "Your review response was removed"
+		message = "A moderator removed your response to a review. You can write a new one."
+	}
+	if notes != nil && *notes != "" {
+		message += "\n\n" + *notes
+	}
+
+	entityType := config.EntityTypeReview
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:            barberUserID,
+		Title:             title,
+		Message:           message,
+		Type:              config.NotificationTypeReviewResponse,
+		Priority:          config.NotificationPriorityNormal,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &review.ID,
+		Data: map[string]interface{}{
+			"review_id":       review.ID,
+			"barber_id":       review.BarberID,
+			"response_status": status,
+		},
+	})
+	return err
+}
+
+// SendCustomerInvitation emails an imported client the link to claim the
+// account their barber's import created
+func (s *NotificationService) SendCustomerInvitation(ctx context.Context, userID int, barberName, claimURL string, expiresAt time.Time) error {
+	_, err := s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:  userID,
+		Title:   fmt.Sprintf("%s has moved bookings online", barberName),
+		Message: fmt.Sprintf("%s now takes bookings here and has set up an account for you. Choose a password to see your appointments and book online: %s", barberName, claimURL),
+		Type:    config.NotificationTypeCustomerInvitation,
+		Data: map[string]interface{}{
+			"claim_url":   claimURL,
+			"barber_name": barberName,
+		},
+		ExpiresAt: &expiresAt,
+	})
+	return err
+}
+
+// SendWinBack sends a lapsed customer a personalized invitation to book
+// again, including their coupon when the campaign issued one
+func (s *NotificationService) SendWinBack(ctx context.Context, candidate *models.WinBackCandidate, message *models.WinBackMessage) (*NotificationResponse, error) {
+	title := "We miss you!"
+	if name := candidate.FirstName(); name != "" {
+		title = fmt.Sprintf("We miss you, %s!", name)
+	}
+
+	weeks := int(message.SentAt.Sub(candidate.LastVisitAt).Hours() / (24 * 7))
+	body := fmt.Sprintf("It's been %d weeks since your %s", weeks, candidate.LastServiceName)
+	if candidate.LastBarberName != "" {
+		body += " with " + candidate.LastBarberName
+	}
+	body += ". Ready for a fresh cut?"
+
+	data := map[string]interface{}{
+		"campaign_id":   message.CampaignID,
+		"last_visit_at": candidate.LastVisitAt,
+	}
+	if message.CouponCode != nil {
+		body += fmt.Sprintf(" Book by %s and get %d%% off with code %s.",
+			s.styleFor(ctx, &candidate.CustomerID).Date(*message.CouponExpiresAt), message.CouponPercentOff, *message.CouponCode)
+		data["coupon_code"] = *message.CouponCode
+		data["coupon_percent_off"] = message.CouponPercentOff
+		data["coupon_expires_at"] = *message.CouponExpiresAt
+	}
+
+	return s.CreateNotification(ctx, CreateNotificationRequest{
+		UserID:    candidate.CustomerID,
+		Title:     title,
+		Message:   body,
+		Type:      config.NotificationTypeWinBack,
+		Priority:  config.NotificationPriorityLow,
+		Data:      data,
+		ExpiresAt: message.CouponExpiresAt,
+	})
+}
+
 // ========================================================================
 // READ OPERATIONS
 // ========================================================================
@@ -490,18 +1041,28 @@ func (s *NotificationService) GetNotificationByID(ctx context.Context, id int, u
 	return s.toNotificationResponse(notification), nil
 }
 
-// GetUserNotifications retrieves all notifications for a user
-func (s *NotificationService) GetUserNotifications(ctx context.Context, userID int, filters repository.NotificationFilters) ([]NotificationResponse, error) {
+// GetUserNotifications retrieves a page of notifications for a user, with the
+// total number of matching notifications
+func (s *NotificationService) GetUserNotifications(ctx context.Context, userID int, filters repository.NotificationFilters) ([]NotificationResponse, int, error) {
 	notifications, err := s.repo.FindByUserID(ctx, userID, filters)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	filters.UserID = userID
+	total, err := s.repo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	responses := make([]NotificationResponse, len(notifications))
 	for i, n := range notifications {
 		responses[i] = *s.toNotificationResponse(&n)
 	}
-	return responses, nil
+	if err := repository.Hydrate(ctx, s.relations, filters.Include(), responses); err != nil {
+		return nil, 0, err
+	}
+	return responses, total, nil
 }
 
 // GetUnreadNotifications retrieves unread notifications for a user
@@ -540,6 +1101,48 @@ func (s *NotificationService) GetUnreadCount(ctx context.Context, userID int) (i
 	return s.repo.GetUnreadCount(ctx, userID)
 }
 
+// WaitForUnreadCount returns the unread count, long-polling for up to wait when the
+// count still equals lastKnown. A negative lastKnown or zero wait returns immediately.
+// Without Redis there are no change signals, so the current count is returned as-is.
+func (s *NotificationService) WaitForUnreadCount(ctx context.Context, userID, lastKnown int, wait time.Duration) (*UnreadCountResponse, error) {
+	if s.cache == nil || wait <= 0 || lastKnown < 0 {
+		count, err := s.repo.GetUnreadCount(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		return &UnreadCountResponse{UnreadCount: count, Changed: lastKnown >= 0 && count != lastKnown}, nil
+	}
+
+	// Subscribe before reading so a change between the read and the wait is not lost
+	waiter, err := s.cache.SubscribeUnreadChanges(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Unread change subscription unavailable, skipping long-poll").
+			Int("user_id", userID).
+			Err(err).
+			Send()
+		return s.WaitForUnreadCount(ctx, userID, lastKnown, 0)
+	}
+	defer waiter.Close()
+
+	count, err := s.repo.GetUnreadCount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if count != lastKnown {
+		return &UnreadCountResponse{UnreadCount: count, Changed: true}, nil
+	}
+
+	if !waiter.Wait(ctx, wait) {
+		return &UnreadCountResponse{UnreadCount: count, Changed: false}, nil
+	}
+
+	count, err = s.repo.GetUnreadCount(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &UnreadCountResponse{UnreadCount: count, Changed: count != lastKnown}, nil
+}
+
 // ========================================================================
 // UPDATE OPERATIONS
 // ========================================================================
@@ -556,12 +1159,25 @@ func (s *NotificationService) MarkAsRead(ctx context.Context, id int, userID int
 		return fmt.Errorf("notification not found")
 	}
 
-	return s.repo.MarkAsRead(ctx, id)
+	if err := s.repo.MarkAsRead(ctx, id); err != nil {
+		return err
+	}
+
+	s.signalUnreadChange(ctx, userID)
+	return nil
 }
 
 // MarkAllAsRead marks all notifications for a user as read
 func (s *NotificationService) MarkAllAsRead(ctx context.Context, userID int) (int, error) {
-	return s.repo.MarkAllAsRead(ctx, userID)
+	count, err := s.repo.MarkAllAsRead(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if count > 0 {
+		s.signalUnreadChange(ctx, userID)
+	}
+	return count, nil
 }
 
 // MarkAsDelivered marks a notification as delivered (for push notification callbacks)
@@ -585,7 +1201,14 @@ func (s *NotificationService) DeleteNotification(ctx context.Context, id int, us
 		return fmt.Errorf("notification not found")
 	}
 
-	return s.repo.Delete(ctx, id)
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	if notification.ReadAt == nil {
+		s.signalUnreadChange(ctx, userID)
+	}
+	return nil
 }
 
 // ========================================================================
@@ -607,6 +1230,29 @@ func (s *NotificationService) MarkNotificationFailed(ctx context.Context, id int
 	return s.repo.MarkAsFailed(ctx, id, errorMsg)
 }
 
+// RetryNotification re-queues a failed notification so the worker sends it again
+func (s *NotificationService) RetryNotification(ctx context.Context, id int) error {
+	notification, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if notification.Status != config.NotificationStatusFailed {
+		return fmt.Errorf("notification %d is %s, only failed notifications can be retried", id, notification.Status)
+	}
+
+	if err := s.repo.Requeue(ctx, id); err != nil {
+		return fmt.Errorf("failed to requeue notification: %w", err)
+	}
+
+	logger.FromContext(ctx).Info("Notification requeued for delivery").
+		Int("notification_id", id).
+		Int("user_id", notification.UserID).
+		Send()
+
+	return nil
+}
+
 // CleanupOldNotifications removes old read notifications
 func (s *NotificationService) CleanupOldNotifications(ctx context.Context, olderThan time.Duration) (int, error) {
 	return s.repo.DeleteOldNotifications(ctx, olderThan)
@@ -617,36 +1263,59 @@ func (s *NotificationService) CleanupExpiredNotifications(ctx context.Context) (
 	return s.repo.DeleteExpiredNotifications(ctx)
 }
 
+// undeliveredReason is recorded on notifications that aged out of the
+// delivery window while still pending
+const undeliveredReason = "not delivered within the delivery window"
+
+// FailUndeliveredNotifications marks pending notifications created before
+// the delivery window as failed, since the worker no longer claims them
+func (s *NotificationService) FailUndeliveredNotifications(ctx context.Context) (int, error) {
+	return s.repo.FailUndelivered(ctx, s.clock.Now().Add(-config.NotificationDeliveryWindow), undeliveredReason)
+}
+
 // ========================================================================
 // BATCH OPERATIONS
 // ========================================================================
 
 // SendBulkNotification sends the same notification to multiple users
 func (s *NotificationService) SendBulkNotification(ctx context.Context, userIDs []int, title, message, notifType string) error {
-	notifications := make([]*models.Notification, len(userIDs))
+	notifications := make([]*models.Notification, 0, len(userIDs))
 
-	for i, userID := range userIDs {
-		notifications[i] = &models.Notification{
+	for _, userID := range userIDs {
+		// Recipients who turned the type off are left out
+		channels := s.preferredChannels(ctx, userID, notifType, getDefaultChannels(notifType))
+		if len(channels) == 0 {
+			continue
+		}
+		notifications = append(notifications, &models.Notification{
 			UserID:   userID,
 			Title:    title,
 			Message:  message,
 			Type:     notifType,
-			Channels: getDefaultChannels(notifType),
+			Channels: channels,
 			Status:   config.NotificationStatusPending,
 			Priority: config.NotificationPriorityNormal,
-		}
+		})
 	}
 
-	return s.repo.CreateBatch(ctx, notifications)
+	if err := s.repo.CreateBatch(ctx, notifications); err != nil {
+		return err
+	}
+
+	for _, notification := range notifications {
+		s.signalUnreadChange(ctx, notification.UserID)
+	}
+	return nil
 }
 
 // ScheduleBookingReminders schedules reminder notifications for upcoming bookings
 func (s *NotificationService) ScheduleBookingReminders(ctx context.Context, hoursBeforeBooking int) error {
 	// Get upcoming bookings within the reminder window
-	reminderTime := time.Now().Add(time.Duration(hoursBeforeBooking) * time.Hour)
+	now := s.clock.Now()
+	reminderTime := now.Add(time.Duration(hoursBeforeBooking) * time.Hour)
 
 	filters := repository.BookingFilters{
-		StartDateFrom: time.Now(),
+		StartDateFrom: now,
 		StartDateTo:   reminderTime,
 		Statuses:      []string{config.BookingStatusConfirmed},
 	}
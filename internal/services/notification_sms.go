@@ -0,0 +1,251 @@
+// internal/services/notification_sms.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/sms"
+)
+
+// ========================================================================
+// SMS DELIVERY - Text confirmations and reminders for opted-in customers
+// ========================================================================
+//
+// A booking notification gains the "sms" channel when the customer has
+// opted in (notification_settings.sms) and a phone number is known: the
+// booking's customer_phone, falling back to the profile phone. The number
+// is stored with the notification and the delivery worker texts it; the
+// provider's status callback then marks the notification delivered or
+// failed.
+//
+// Numbers on the suppression list are skipped at send time. The provider
+// feeds the list: failures that mean a number can never be texted, and
+// opt-outs (a refused send or a STOP reply to the inbound webhook) suppress
+// the number; a START reply lifts the opt-out.
+// ========================================================================
+
+// smsRecipientKey is the notification data key holding the number to text
+const smsRecipientKey = "sms_to"
+
+// SetSMSSender enables the sms channel. callbackBaseURL is this API's public
+// URL; when empty, no delivery status callbacks are requested.
+func (s *NotificationService) SetSMSSender(sender sms.Sender, callbackBaseURL string) {
+	s.sms = sender
+	s.smsCallbackBaseURL = strings.TrimSuffix(callbackBaseURL, "/")
+}
+
+// SetSuppressions makes email and SMS delivery skip suppressed addresses
+func (s *NotificationService) SetSuppressions(suppressions *SuppressionService) {
+	s.suppressions = suppressions
+}
+
+// SMSStatusCallbackURL returns the URL the provider reports a notification's
+// delivery status to (empty when callbacks are disabled)
+func (s *NotificationService) SMSStatusCallbackURL(notificationID int) string {
+	if s.smsCallbackBaseURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/api/v1/notifications/%d/sms-status", s.smsCallbackBaseURL, notificationID)
+}
+
+// SMSInboundURL returns the URL the provider forwards replies to (empty
+// when callbacks are disabled)
+func (s *NotificationService) SMSInboundURL() string {
+	if s.smsCallbackBaseURL == "" {
+		return ""
+	}
+	return s.smsCallbackBaseURL + "/api/v1/notifications/sms-inbound"
+}
+
+// smsRecipient returns the phone number to text about a booking, or "" when
+// SMS is disabled, the customer has not opted in, or no number is known
+func (s *NotificationService) smsRecipient(ctx context.Context, booking *models.Booking) string {
+	if s.sms == nil || booking.CustomerID == nil {
+		return ""
+	}
+
+	user, err := s.userRepo.FindByID(ctx, *booking.CustomerID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to load customer for SMS").
+			Int("booking_id", booking.ID).
+			Err(err).
+			Send()
+		return ""
+	}
+	if !user.HasOptedIn(config.NotificationChannelSMS) {
+		return ""
+	}
+
+	if booking.CustomerPhone != nil && *booking.CustomerPhone != "" {
+		return *booking.CustomerPhone
+	}
+	if user.Phone != nil {
+		return *user.Phone
+	}
+	return ""
+}
+
+// sendSMS texts a notification to the number stored when it was created.
+// Notifications without a number are skipped.
+func (s *NotificationService) sendSMS(ctx context.Context, notification *models.Notification) error {
+	phone, _ := notification.Data[smsRecipientKey].(string)
+	if phone == "" {
+		return nil
+	}
+	if s.sms == nil {
+		return sms.ErrNotConfigured
+	}
+
+	if suppressed, err := s.isSuppressed(ctx, config.NotificationChannelSMS, phone); err != nil {
+		return err
+	} else if suppressed {
+		logger.FromContext(ctx).Info("SMS skipped, number is suppressed").
+			Int("notification_id", notification.ID).
+			Send()
+		return nil
+	}
+
+	sid, err := s.sms.Send(ctx, sms.Message{
+		To:             phone,
+		Body:           notification.Title + ": " + notification.Message,
+		StatusCallback: s.SMSStatusCallbackURL(notification.ID),
+	})
+	if err != nil {
+		// A number that can never be texted is suppressed and the
+		// notification completes without SMS rather than retrying
+		var providerErr *sms.ProviderError
+		if errors.As(err, &providerErr) && s.suppressSMS(ctx, phone, providerErr.Code) {
+			return nil
+		}
+		return fmt.Errorf("%s: %w", s.sms.Name(), err)
+	}
+
+	logger.FromContext(ctx).Info("SMS sent").
+		Int("notification_id", notification.ID).
+		Str("provider", s.sms.Name()).
+		Str("message_id", sid).
+		Send()
+	return nil
+}
+
+// HandleSMSStatus applies a provider delivery status callback. Intermediate
+// states (queued, sending, sent) are acknowledged without changes.
+func (s *NotificationService) HandleSMSStatus(ctx context.Context, id int, params url.Values, signature string) error {
+	if s.sms == nil {
+		return sms.ErrNotConfigured
+	}
+	if !s.sms.VerifyCallback(s.SMSStatusCallbackURL(id), params, signature) {
+		return sms.ErrInvalidSignature
+	}
+
+	notification, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	status := params.Get("MessageStatus")
+	switch status {
+	case sms.StatusDelivered:
+		err = s.repo.MarkAsDelivered(ctx, id)
+		if errors.Is(err, repository.ErrNotificationNotFound) {
+			// Already read or delivered; nothing to update
+			err = nil
+		}
+	case sms.StatusUndelivered, sms.StatusFailed:
+		reason := "sms " + status
+		if code := params.Get("ErrorCode"); code != "" {
+			reason += " (error code " + code + ")"
+			if phone, _ := notification.Data[smsRecipientKey].(string); phone != "" {
+				s.suppressSMS(ctx, phone, code)
+			}
+		}
+		err = s.repo.MarkAsFailed(ctx, id, reason)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	logger.FromContext(ctx).Info("SMS delivery status received").
+		Int("notification_id", id).
+		Int("user_id", notification.UserID).
+		Str("status", status).
+		Str("message_id", params.Get("MessageSid")).
+		Send()
+	return nil
+}
+
+// HandleSMSInbound applies a reply forwarded by the provider: STOP and
+// similar keywords suppress the sender's number, START lifts the opt-out.
+// Other replies are acknowledged without changes.
+func (s *NotificationService) HandleSMSInbound(ctx context.Context, params url.Values, signature string) error {
+	if s.sms == nil || s.SMSInboundURL() == "" {
+		return sms.ErrNotConfigured
+	}
+	if !s.sms.VerifyCallback(s.SMSInboundURL(), params, signature) {
+		return sms.ErrInvalidSignature
+	}
+	if s.suppressions == nil {
+		return nil
+	}
+
+	from, body := params.Get("From"), params.Get("Body")
+	switch {
+	case from == "":
+		return nil
+	case sms.IsOptOut(body):
+		_, err := s.suppressions.Suppress(ctx, SuppressionEntry{
+			Channel: config.NotificationChannelSMS,
+			Address: from,
+			Reason:  config.SuppressionReasonOptOut,
+			Source:  s.sms.Name(),
+			Detail:  "replied " + strings.ToUpper(strings.TrimSpace(body)),
+		})
+		return err
+	case sms.IsOptIn(body):
+		return s.suppressions.LiftOptOut(ctx, config.NotificationChannelSMS, from)
+	}
+	return nil
+}
+
+// isSuppressed reports whether address is on the suppression list
+func (s *NotificationService) isSuppressed(ctx context.Context, channel, address string) (bool, error) {
+	if s.suppressions == nil {
+		return false, nil
+	}
+	return s.suppressions.IsSuppressed(ctx, channel, address)
+}
+
+// suppressSMS suppresses a number when the provider error code means it can
+// no longer be texted. It reports whether the number was suppressed.
+func (s *NotificationService) suppressSMS(ctx context.Context, phone, code string) bool {
+	reason := sms.SuppressionReason(code)
+	if s.suppressions == nil || reason == "" {
+		return false
+	}
+
+	_, err := s.suppressions.Suppress(ctx, SuppressionEntry{
+		Channel: config.NotificationChannelSMS,
+		Address: phone,
+		Reason:  reason,
+		Source:  s.sms.Name(),
+		Detail:  "error code " + code,
+	})
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to suppress sms number").
+			Str("error_code", code).
+			Err(err).
+			Send()
+		return false
+	}
+	return true
+}
@@ -0,0 +1,345 @@
+// internal/services/notification_templates.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/i18n"
+	"barber-booking-system/internal/models"
+)
+
+// ========================================================================
+// NOTIFICATION TEMPLATES - Booking notifications with named placeholders
+// ========================================================================
+//
+// Booking notification titles and messages name what they insert:
+// "{booking_number}", "{start_time}". Each template accepts the variables
+// every booking has plus the few its sender provides. The catalog lists
+// them for whoever edits the text, and rendering refuses a placeholder the
+// template does not accept rather than sending it to customers as is.
+// Templates are written in English and sent in the customer's language
+// when the i18n catalogs translate them (see internal/i18n).
+// ========================================================================
+
+// ErrUnknownTemplate is returned for a template key that does not exist
+var ErrUnknownTemplate = errors.New("unknown notification template")
+
+// ErrUnknownTemplateVariable is returned for placeholders a template does
+// not accept
+var ErrUnknownTemplateVariable = errors.New("unknown template variable")
+
+// TemplateVariable is a placeholder a notification template can use
+type TemplateVariable struct {
+	Name        string `json:"name" example:"booking_number"`
+	Placeholder string `json:"placeholder" example:"{booking_number}"`
+	Description string `json:"description" example:"The booking's reference number"`
+	Example     string `json:"example" example:"BK-20250310-0042"`
+}
+
+// templateVariables describes every variable a template can accept
+var templateVariables = map[string]TemplateVariable{
+	"booking_number": {Description: "The booking's reference number", Example: "BK-20250310-0042"},
+	"service_name":   {Description: "The first service booked", Example: "Skin Fade"},
+	"start_time":     {Description: "When the appointment starts, in the customer's timezone, language and clock format", Example: "Monday, March 10 at 3:30 PM"},
+	"old_start_time": {Description: "When a rescheduled appointment used to start", Example: "Monday, March 10 at 3:30 PM"},
+	"new_start_time": {Description: "When a rescheduled appointment now starts", Example: "Tuesday, March 11 at 10:00 AM"},
+	"confirm_url":    {Description: "One-tap link confirming the appointment", Example: "https://example.com/api/v1/bookings/actions/abc123"},
+	"cancel_url":     {Description: "One-tap link cancelling the appointment", Example: "https://example.com/api/v1/bookings/actions/def456"},
+}
+
+// bookingTemplateVariables are accepted by every booking template
+var bookingTemplateVariables = []string{"booking_number", "service_name", "start_time"}
+
+// BookingNotificationTemplate defines a notification template for booking events
+type BookingNotificationTemplate struct {
+	Title           string
+	MessageTemplate string // Uses {variable} placeholders
+	Type            string
+	Priority        string
+	SMS             bool     // Also text opted-in customers
+	Variables       []string // Accepted besides bookingTemplateVariables
+}
+
+// bookingNotificationTemplates maps notification types to their templates
+var bookingNotificationTemplates = map[string]BookingNotificationTemplate{
+	"confirmation": {
+		Title:           "Booking Confirmed",
+		MessageTemplate: "Your booking {booking_number} has been confirmed for {start_time}",
+		Type:            config.NotificationTypeBookingConfirmation,
+		Priority:        config.NotificationPriorityNormal,
+		SMS:             true,
+	},
+	"reminder": {
+		Title:           "Upcoming Appointment Reminder",
+		MessageTemplate: "Reminder: Your appointment is scheduled for {start_time}",
+		Type:            config.NotificationTypeBookingReminder,
+		Priority:        config.NotificationPriorityHigh,
+		SMS:             true,
+	},
+	"reminder_with_links": {
+		Title:           "Upcoming Appointment Reminder",
+		MessageTemplate: "Reminder: Your appointment is scheduled for {start_time}. Confirm: {confirm_url} Cancel: {cancel_url}",
+		Type:            config.NotificationTypeBookingReminder,
+		Priority:        config.NotificationPriorityHigh,
+		SMS:             true,
+		Variables:       []string{"confirm_url", "cancel_url"},
+	},
+	"confirmation_request": {
+		Title:           "Are you still coming?",
+		MessageTemplate: "Please confirm your appointment on {start_time}, or cancel it so someone else can have the slot",
+		Type:            config.NotificationTypeConfirmationRequest,
+		Priority:        config.NotificationPriorityHigh,
+		SMS:             true,
+	},
+	"cancellation": {
+		Title:           "Booking Cancelled",
+		MessageTemplate: "Your booking {booking_number} has been cancelled",
+		Type:            config.NotificationTypeBookingCancelled,
+		Priority:        config.NotificationPriorityHigh,
+	},
+	"expired": {
+		Title:           "Booking Not Confirmed",
+		MessageTemplate: "Your booking {booking_number} for {start_time} was not confirmed by the barber in time and has been cancelled. Please book another time",
+		Type:            config.NotificationTypeBookingCancelled,
+		Priority:        config.NotificationPriorityHigh,
+		SMS:             true,
+	},
+	"no_show": {
+		Title:           "Missed Appointment",
+		MessageTemplate: "You missed your appointment {booking_number} on {start_time}, so it has been marked as a no-show. Contact the barber if this is a mistake",
+		Type:            config.NotificationTypeBookingNoShow,
+		Priority:        config.NotificationPriorityHigh,
+	},
+	"rescheduled": {
+		Title:           "Booking Rescheduled",
+		MessageTemplate: "Your booking {booking_number} has been rescheduled from {old_start_time} to {new_start_time}",
+		Type:            config.NotificationTypeBookingRescheduled,
+		Priority:        config.NotificationPriorityHigh,
+		Variables:       []string{"old_start_time", "new_start_time"},
+	},
+	"review_request": {
+		Title:           "How was your experience?",
+		MessageTemplate: "Please take a moment to review your recent appointment ({service_name}). Your feedback helps us improve!",
+		Type:            config.NotificationTypeReviewRequest,
+		Priority:        config.NotificationPriorityNormal,
+	},
+}
+
+// AcceptedVariables returns the names of the variables the template
+// accepts, sorted
+func (t BookingNotificationTemplate) AcceptedVariables() []string {
+	names := append(append([]string{}, bookingTemplateVariables...), t.Variables...)
+	sort.Strings(names)
+	return names
+}
+
+// templatePlaceholder matches a {variable} placeholder
+var templatePlaceholder = regexp.MustCompile(`\{([A-Za-z0-9_]+)\}`)
+
+// TemplatePlaceholders returns the variable names text uses, in order of
+// first use
+func TemplatePlaceholders(text string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, match := range templatePlaceholder.FindAllStringSubmatch(text, -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// RenderTemplate replaces the placeholders in text with values. A
+// placeholder not in accepted is an ErrUnknownTemplateVariable; an
+// accepted one without a value renders empty.
+func RenderTemplate(text string, accepted []string, values map[string]string) (string, error) {
+	allowed := make(map[string]bool, len(accepted))
+	for _, name := range accepted {
+		allowed[name] = true
+	}
+
+	var unknown []string
+	for _, name := range TemplatePlaceholders(text) {
+		if !allowed[name] {
+			unknown = append(unknown, "{"+name+"}")
+		}
+	}
+	if len(unknown) > 0 {
+		return "", fmt.Errorf("%w: %s (accepted: %s)", ErrUnknownTemplateVariable,
+			strings.Join(unknown, ", "), strings.Join(accepted, ", "))
+	}
+
+	return templatePlaceholder.ReplaceAllStringFunc(text, func(placeholder string) string {
+		return values[placeholder[1:len(placeholder)-1]]
+	}), nil
+}
+
+// ========================================================================
+// CATALOG
+// ========================================================================
+
+// NotificationTemplateInfo describes a booking notification template and
+// the variables it accepts
+type NotificationTemplateInfo struct {
+	Key       string             `json:"key" example:"rescheduled"`
+	Type      string             `json:"type" example:"booking_rescheduled"`
+	Title     string             `json:"title"`
+	Message   string             `json:"message"`
+	SMS       bool               `json:"sms"`
+	Variables []TemplateVariable `json:"variables"`
+}
+
+// ValidateTemplateRequest is template text to check against a template's
+// variables
+type ValidateTemplateRequest struct {
+	Key     string `json:"key" binding:"required" example:"rescheduled"`
+	Title   string `json:"title" binding:"max=255"`
+	Message string `json:"message" binding:"required,max=2000" example:"Moved from {old_start_time} to {new_start_time}"`
+}
+
+// ValidateTemplateResult lists the variables valid template text uses
+type ValidateTemplateResult struct {
+	Key       string   `json:"key"`
+	Variables []string `json:"variables"` // In order of first use
+}
+
+// TemplateCatalog lists the booking notification templates with the
+// variables each accepts, by key
+func (s *NotificationService) TemplateCatalog() []NotificationTemplateInfo {
+	keys := make([]string, 0, len(bookingNotificationTemplates))
+	for key := range bookingNotificationTemplates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	catalog := make([]NotificationTemplateInfo, 0, len(keys))
+	for _, key := range keys {
+		template := bookingNotificationTemplates[key]
+		info := NotificationTemplateInfo{
+			Key:     key,
+			Type:    template.Type,
+			Title:   template.Title,
+			Message: template.MessageTemplate,
+			SMS:     template.SMS,
+		}
+		for _, name := range template.AcceptedVariables() {
+			variable := templateVariables[name]
+			variable.Name = name
+			variable.Placeholder = "{" + name + "}"
+			info.Variables = append(info.Variables, variable)
+		}
+		catalog = append(catalog, info)
+	}
+	return catalog
+}
+
+// ValidateTemplate checks that template text only uses the variables the
+// template with the given key accepts
+func (s *NotificationService) ValidateTemplate(req ValidateTemplateRequest) (*ValidateTemplateResult, error) {
+	template, ok := bookingNotificationTemplates[req.Key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTemplate, req.Key)
+	}
+
+	accepted := template.AcceptedVariables()
+	for _, text := range []string{req.Title, req.Message} {
+		if _, err := RenderTemplate(text, accepted, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	return &ValidateTemplateResult{
+		Key:       req.Key,
+		Variables: TemplatePlaceholders(req.Title + "\n" + req.Message),
+	}, nil
+}
+
+// ========================================================================
+// SENDING
+// ========================================================================
+
+// sendBookingNotificationWithTemplate sends a booking notification from a
+// template. vars holds the template's own variables; the booking ones are
+// filled in here.
+func (s *NotificationService) sendBookingNotificationWithTemplate(
+	ctx context.Context,
+	booking *models.Booking,
+	templateKey string,
+	vars map[string]string,
+	extraData map[string]interface{},
+	expiresAt *time.Time,
+) error {
+	if booking.CustomerID == nil {
+		return nil // No notification for guest bookings
+	}
+
+	template, exists := bookingNotificationTemplates[templateKey]
+	if !exists {
+		return fmt.Errorf("%w: %s", ErrUnknownTemplate, templateKey)
+	}
+
+	style := s.styleFor(ctx, booking.CustomerID)
+	values := map[string]string{
+		"booking_number": booking.BookingNumber,
+		"service_name":   booking.ServiceName,
+		"start_time":     style.DateTime(booking.ScheduledStartTime),
+	}
+	for k, v := range vars {
+		values[k] = v
+	}
+
+	accepted := template.AcceptedVariables()
+	title, err := RenderTemplate(i18n.Translate(style.Locale, template.Title), accepted, values)
+	if err != nil {
+		return fmt.Errorf("notification template %s: %w", templateKey, err)
+	}
+	message, err := RenderTemplate(i18n.Translate(style.Locale, template.MessageTemplate), accepted, values)
+	if err != nil {
+		return fmt.Errorf("notification template %s: %w", templateKey, err)
+	}
+
+	entityType := config.EntityTypeBooking
+	data := map[string]interface{}{
+		"booking_number": booking.BookingNumber,
+		"barber_id":      booking.BarberID,
+	}
+	// Merge extra data
+	for k, v := range extraData {
+		data[k] = v
+	}
+	if booking.IsTest {
+		data[sandboxDataKey] = true
+	}
+
+	req := CreateNotificationRequest{
+		UserID:            *booking.CustomerID,
+		Title:             title,
+		Message:           message,
+		Type:              template.Type,
+		Priority:          template.Priority,
+		RelatedEntityType: &entityType,
+		RelatedEntityID:   &booking.ID,
+		Data:              data,
+		ExpiresAt:         expiresAt,
+	}
+
+	var phone string
+	if template.SMS && !booking.IsTest {
+		phone = s.smsRecipient(ctx, booking)
+	}
+	if phone != "" {
+		req.Channels = append(getDefaultChannels(req.Type), config.NotificationChannelSMS)
+		req.Data[smsRecipientKey] = phone
+	}
+
+	_, err = s.CreateNotification(ctx, req)
+	return err
+}
@@ -0,0 +1,52 @@
+// internal/services/notification_time.go
+package services
+
+import (
+	"context"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/timefmt"
+)
+
+// ========================================================================
+// NOTIFICATION TIMES - Times in messages, as each recipient reads them
+// ========================================================================
+//
+// Times in notification messages are written in the recipient's timezone
+// and language, on their 12- or 24-hour clock, from the timezone, locale
+// and time_format in their preferences (see internal/timefmt). Recipients
+// without preferences, or whose user cannot be loaded, get the configured
+// defaults. Notification data keeps the raw timestamps for clients.
+// ========================================================================
+
+// SetLocalization sets the timezone and language of notification times
+// for users without preferences (empty values use UTC and English)
+func (s *NotificationService) SetLocalization(cfg config.LocalizationConfig) {
+	s.timeStyle = timefmt.Default(cfg.DefaultTimezone, cfg.DefaultLocale)
+}
+
+// styleFor returns how times are written for a user (nil for guests)
+func (s *NotificationService) styleFor(ctx context.Context, userID *int) timefmt.Style {
+	style := s.timeStyle
+	if userID == nil || s.userRepo == nil {
+		return style
+	}
+
+	user, err := s.userRepo.FindByID(ctx, *userID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to load user time preferences").
+			Int("user_id", *userID).
+			Err(err).
+			Send()
+		return style
+	}
+	return style.WithPreferences(user.Preferences)
+}
+
+// FormatTimeFor writes a time for a user's notification, e.g. "Monday,
+// January 2 at 3:04 PM" in their timezone and language
+func (s *NotificationService) FormatTimeFor(ctx context.Context, userID int, t time.Time) string {
+	return s.styleFor(ctx, &userID).DateTime(t)
+}
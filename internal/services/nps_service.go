@@ -0,0 +1,262 @@
+// internal/services/nps_service.go
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// NPS SERVICE - One-tap satisfaction surveys and NPS trends
+// ========================================================================
+//
+// Separate from reviews: after every Nth completed booking the customer
+// gets a notification carrying a survey token. Tapping a 0-10 score posts
+// it to the public survey endpoint; no login is needed because the token
+// is the credential. Answers can be changed until the survey expires.
+// ========================================================================
+
+// NPSService handles NPS surveys
+type NPSService struct {
+	repo                *repository.NPSRepository
+	barberRepo          repository.BarberStore
+	notificationService *NotificationService
+	clock               clock.Clock
+	config              config.NPSConfig
+}
+
+// NewNPSService creates a new NPS service
+func NewNPSService(
+	repo *repository.NPSRepository,
+	barberRepo repository.BarberStore,
+	notificationService *NotificationService,
+	cfg config.NPSConfig,
+) *NPSService {
+	if cfg.ResponseWindowDays <= 0 {
+		cfg.ResponseWindowDays = config.DefaultNPSResponseWindowDays
+	}
+	return &NPSService{
+		repo:                repo,
+		barberRepo:          barberRepo,
+		notificationService: notificationService,
+		clock:               clock.System,
+		config:              cfg,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *NPSService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// NPSResponseRequest is a customer's answer to a survey
+type NPSResponseRequest struct {
+	Score   *int    `json:"score" binding:"required,min=0,max=10" example:"9"`
+	Comment *string `json:"comment" binding:"omitempty,max=1000"`
+}
+
+// NPSResponseResult confirms a recorded answer
+type NPSResponseResult struct {
+	BookingID   int       `json:"booking_id"`
+	Score       int       `json:"score"`
+	Category    string    `json:"category"` // promoter, passive, detractor
+	RespondedAt time.Time `json:"responded_at"`
+	ExpiresAt   time.Time `json:"expires_at"` // The answer can be changed until then
+}
+
+// NPSTrendRequest selects the range and bucket size of a trend
+type NPSTrendRequest struct {
+	From     string `form:"from" example:"2025-01-01"` // YYYY-MM-DD (default: 12 intervals before To)
+	To       string `form:"to" example:"2025-03-31"`   // YYYY-MM-DD inclusive (default: today)
+	Interval string `form:"interval" binding:"omitempty,oneof=week month" example:"week"`
+}
+
+// ========================================================================
+// SURVEY DISPATCH
+// ========================================================================
+
+// SurveyHook is the nps_survey booking status action
+func (s *NPSService) SurveyHook(ctx context.Context, booking *models.Booking, _, _ string) error {
+	return s.MaybeSendSurvey(ctx, booking)
+}
+
+// MaybeSendSurvey sends a survey if this completed booking is the customer's
+// Nth, 2Nth, ... completed booking. Guest bookings are never surveyed.
+func (s *NPSService) MaybeSendSurvey(ctx context.Context, booking *models.Booking) error {
+	if s.config.EveryNthBooking <= 0 || booking.CustomerID == nil || booking.Status != config.BookingStatusCompleted {
+		return nil
+	}
+
+	completed, err := s.repo.CountCompletedBookings(ctx, *booking.CustomerID)
+	if err != nil {
+		return err
+	}
+	if completed == 0 || completed%s.config.EveryNthBooking != 0 {
+		return nil
+	}
+
+	token, err := newSurveyToken()
+	if err != nil {
+		return err
+	}
+	now := s.clock.Now()
+	survey := &models.NPSSurvey{
+		Token:      token,
+		BookingID:  booking.ID,
+		CustomerID: *booking.CustomerID,
+		BarberID:   booking.BarberID,
+		SentAt:     now,
+		ExpiresAt:  now.AddDate(0, 0, s.config.ResponseWindowDays),
+	}
+
+	created, err := s.repo.Create(ctx, survey)
+	if err != nil || !created {
+		return err
+	}
+
+	if err := s.notificationService.SendNPSSurvey(ctx, booking, survey); err != nil {
+		return fmt.Errorf("failed to send nps survey: %w", err)
+	}
+
+	logger.FromContext(ctx).Info("NPS survey sent").
+		Int("booking_id", booking.ID).
+		Int("customer_id", survey.CustomerID).
+		Int("completed_bookings", completed).
+		Send()
+	return nil
+}
+
+// newSurveyToken returns a random URL-safe survey token
+func newSurveyToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate survey token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ========================================================================
+// RESPONSES
+// ========================================================================
+
+// Respond records the answer for the survey identified by token
+func (s *NPSService) Respond(ctx context.Context, token string, req NPSResponseRequest) (*NPSResponseResult, error) {
+	survey, err := s.repo.FindByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.clock.Now()
+	if survey.IsExpired(now) {
+		return nil, repository.ErrNPSSurveyExpired
+	}
+
+	score := *req.Score
+	if err := s.repo.RecordResponse(ctx, survey.ID, score, req.Comment, now); err != nil {
+		return nil, err
+	}
+
+	return &NPSResponseResult{
+		BookingID:   survey.BookingID,
+		Score:       score,
+		Category:    models.NPSCategory(score),
+		RespondedAt: now,
+		ExpiresAt:   survey.ExpiresAt,
+	}, nil
+}
+
+// ========================================================================
+// TRENDS
+// ========================================================================
+
+// GetBarberTrend returns a barber's NPS trend. Only the barber themselves or
+// an admin may view it.
+func (s *NPSService) GetBarberTrend(ctx context.Context, barberID, userID int, isAdmin bool, req NPSTrendRequest) (*models.NPSTrend, error) {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return nil, repository.ErrNotOwner
+	}
+	return s.trend(ctx, &barberID, req)
+}
+
+// GetPlatformTrend returns the NPS trend across all barbers
+func (s *NPSService) GetPlatformTrend(ctx context.Context, req NPSTrendRequest) (*models.NPSTrend, error) {
+	return s.trend(ctx, nil, req)
+}
+
+// trend resolves the requested range and loads the points
+func (s *NPSService) trend(ctx context.Context, barberID *int, req NPSTrendRequest) (*models.NPSTrend, error) {
+	filters, err := s.trendFilters(req)
+	if err != nil {
+		return nil, err
+	}
+	filters.BarberID = barberID
+
+	points, err := s.repo.Trend(ctx, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	overall, points := models.BuildNPSTrend(points)
+	return &models.NPSTrend{
+		BarberID: barberID,
+		Interval: filters.Interval,
+		From:     filters.From,
+		To:       filters.To,
+		Overall:  overall,
+		Points:   points,
+	}, nil
+}
+
+// trendFilters parses the request; the default range is the last 12 weeks
+// (or months) ending today, in UTC
+func (s *NPSService) trendFilters(req NPSTrendRequest) (repository.NPSTrendFilters, error) {
+	filters := repository.NPSTrendFilters{Interval: req.Interval}
+	if filters.Interval == "" {
+		filters.Interval = config.NPSIntervalWeek
+	}
+
+	now := s.clock.Now().UTC()
+	filters.To = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, 1)
+	if req.To != "" {
+		to, err := time.Parse("2006-01-02", req.To)
+		if err != nil {
+			return filters, fmt.Errorf("to must be in YYYY-MM-DD format")
+		}
+		filters.To = to.AddDate(0, 0, 1)
+	}
+
+	if filters.Interval == config.NPSIntervalMonth {
+		filters.From = filters.To.AddDate(0, -12, 0)
+	} else {
+		filters.From = filters.To.AddDate(0, 0, -12*7)
+	}
+	if req.From != "" {
+		from, err := time.Parse("2006-01-02", req.From)
+		if err != nil {
+			return filters, fmt.Errorf("from must be in YYYY-MM-DD format")
+		}
+		filters.From = from
+	}
+
+	if !filters.From.Before(filters.To) {
+		return filters, fmt.Errorf("from must be before to")
+	}
+	return filters, nil
+}
@@ -0,0 +1,354 @@
+// internal/services/open_slot_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// OPEN SLOT SERVICE - Broadcasting last-minute openings
+// ========================================================================
+//
+// A barber broadcasts a slot that just freed up to customers who favorited
+// them and customers nearby. The slot is locked by the first claim, which
+// books it at the broadcast price; if that booking fails the slot opens
+// again for the next customer.
+// ========================================================================
+
+// OpenSlotService manages open slot broadcasts and favorite barbers
+type OpenSlotService struct {
+	repo                *repository.OpenSlotRepository
+	bookingService      *BookingService
+	barberRepo          repository.BarberStore
+	notificationService *NotificationService
+	clock               clock.Clock
+}
+
+// NewOpenSlotService creates a new open slot service
+func NewOpenSlotService(
+	repo *repository.OpenSlotRepository,
+	bookingService *BookingService,
+	barberRepo repository.BarberStore,
+	notificationService *NotificationService,
+) *OpenSlotService {
+	return &OpenSlotService{
+		repo:                repo,
+		bookingService:      bookingService,
+		barberRepo:          barberRepo,
+		notificationService: notificationService,
+		clock:               clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *OpenSlotService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// BroadcastOpenSlotRequest broadcasts a last-minute opening
+type BroadcastOpenSlotRequest struct {
+	BarberServiceID int       `json:"barber_service_id" binding:"required" example:"12"`
+	StartTime       time.Time `json:"start_time" binding:"required"`
+	DurationMinutes int       `json:"duration_minutes" binding:"omitempty,min=15,max=480" example:"30"` // Default: the service's duration
+	DiscountPercent int       `json:"discount_percent" binding:"min=0" example:"20"`                    // Flash discount, at most 50
+	Message         *string   `json:"message" binding:"omitempty,max=300" example:"Just had a cancellation!"`
+	Audiences       []string  `json:"audiences"`                                      // favorites, nearby (default: both)
+	RadiusKm        *float64  `json:"radius_km" binding:"omitempty,gt=0" example:"5"` // Nearby audience (default 5, at most 50)
+}
+
+// ClaimOpenSlotRequest books an open slot for the claiming customer
+type ClaimOpenSlotRequest struct {
+	Notes                  *string                `json:"notes"`
+	CustomFields           map[string]interface{} `json:"custom_fields"`
+	DepositPaymentMethodID string                 `json:"deposit_payment_method_id"`
+}
+
+// OpenSlotResponse is an open slot as customers see it
+type OpenSlotResponse struct {
+	*models.OpenSlot
+	Price       float64 `json:"price"` // After the flash discount, before tax
+	IsClaimable bool    `json:"is_claimable"`
+}
+
+// ========================================================================
+// BROADCASTS
+// ========================================================================
+
+// Broadcast creates an open slot and notifies its audience. The slot must
+// start within OpenSlotMaxLeadHours and be free in the barber's calendar.
+func (s *OpenSlotService) Broadcast(ctx context.Context, barberID, userID int, isAdmin bool, req BroadcastOpenSlotRequest) (*models.OpenSlot, error) {
+	barber, err := s.authorize(ctx, barberID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	barberService, err := s.bookingService.validateAndFetchBarberService(ctx, req.BarberServiceID)
+	if err != nil {
+		return nil, err
+	}
+	if barberService.BarberID != barberID {
+		return nil, fmt.Errorf("service must be one of the barber's services")
+	}
+
+	now := s.clock.Now()
+	if req.StartTime.Before(now.Add(config.OpenSlotClaimNotice)) {
+		return nil, fmt.Errorf("start_time must be at least %s from now", config.OpenSlotClaimNotice)
+	}
+	if req.StartTime.After(now.Add(config.OpenSlotMaxLeadHours * time.Hour)) {
+		return nil, fmt.Errorf("start_time must be within %d hours", config.OpenSlotMaxLeadHours)
+	}
+	if req.DiscountPercent > config.MaxOpenSlotDiscountPercent {
+		return nil, fmt.Errorf("discount_percent cannot exceed %d", config.MaxOpenSlotDiscountPercent)
+	}
+
+	audiences, radiusKm, err := resolveOpenSlotAudiences(req.Audiences, req.RadiusKm)
+	if err != nil {
+		return nil, err
+	}
+	nearby := slices.Contains(audiences, config.OpenSlotAudienceNearby)
+	if nearby && (barber.Latitude == nil || barber.Longitude == nil) {
+		if len(audiences) == 1 {
+			return nil, fmt.Errorf("barber location must be set to broadcast to nearby customers")
+		}
+		nearby = false
+	}
+
+	sent, err := s.repo.CountCreatedSince(ctx, barberID, now.UTC().Truncate(24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+	if sent >= config.MaxOpenSlotsPerDay {
+		return nil, fmt.Errorf("cannot broadcast more than %d open slots a day", config.MaxOpenSlotsPerDay)
+	}
+
+	duration := req.DurationMinutes
+	if duration == 0 {
+		duration = barberService.GetEstimatedDuration()
+	}
+	if err := s.bookingService.checkBarberSchedule(ctx, barberID, req.StartTime, duration); err != nil {
+		return nil, err
+	}
+	available, err := s.bookingService.CheckAvailability(ctx, barberID, req.StartTime, duration)
+	if err != nil {
+		return nil, err
+	}
+	if !available {
+		return nil, fmt.Errorf("slot cannot be broadcast: the barber already has a booking at that time")
+	}
+
+	slot := &models.OpenSlot{
+		BarberID:        barberID,
+		BarberServiceID: barberService.ID,
+		ServiceName:     openSlotServiceName(barberService),
+		StartTime:       req.StartTime,
+		DurationMinutes: duration,
+		ServicePrice:    barberService.Price,
+		DiscountPercent: req.DiscountPercent,
+		Message:         trimmedOrNil(req.Message),
+		Audiences:       models.StringArray(audiences),
+		Status:          config.OpenSlotStatusOpen,
+		ClaimsCloseAt:   models.ClaimsCloseFor(req.StartTime),
+		CreatedBy:       &userID,
+	}
+	if nearby {
+		slot.RadiusKm = &radiusKm
+	}
+	if err := s.repo.Create(ctx, slot); err != nil {
+		return nil, err
+	}
+
+	recipients, err := s.repo.FindRecipients(ctx, barberID,
+		slices.Contains(audiences, config.OpenSlotAudienceFavorites), nearby, radiusKm, config.MaxOpenSlotRecipients)
+	if err != nil {
+		return nil, err
+	}
+
+	log := logger.FromContext(ctx)
+	for _, recipientID := range recipients {
+		if err := s.notificationService.SendOpenSlot(ctx, recipientID, barber.ShopName, slot); err != nil {
+			log.Warn("Failed to send open slot notification").
+				Int("open_slot_id", slot.ID).
+				Int("user_id", recipientID).
+				Err(err).
+				Send()
+		}
+	}
+	if err := s.repo.SetRecipientCount(ctx, slot.ID, len(recipients)); err != nil {
+		return nil, err
+	}
+	slot.RecipientCount = len(recipients)
+
+	log.Info("Open slot broadcast").
+		Int("open_slot_id", slot.ID).
+		Int("barber_id", barberID).
+		Int("recipients", len(recipients)).
+		Send()
+	return slot, nil
+}
+
+// List returns a barber's most recent open slots
+func (s *OpenSlotService) List(ctx context.Context, barberID, userID int, isAdmin bool) ([]models.OpenSlot, error) {
+	if _, err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByBarberID(ctx, barberID, config.MaxOpenSlotsListed)
+}
+
+// Cancel withdraws one of the barber's slots that has not been claimed
+func (s *OpenSlotService) Cancel(ctx context.Context, barberID, id, userID int, isAdmin bool) error {
+	if _, err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return err
+	}
+	slot, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if slot.BarberID != barberID {
+		return repository.ErrOpenSlotNotFound
+	}
+	return s.repo.Cancel(ctx, id)
+}
+
+// ========================================================================
+// CLAIMS
+// ========================================================================
+
+// Get returns an open slot as customers see it
+func (s *OpenSlotService) Get(ctx context.Context, id int) (*OpenSlotResponse, error) {
+	slot, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &OpenSlotResponse{
+		OpenSlot:    slot,
+		Price:       slot.Price(),
+		IsClaimable: slot.IsClaimable(s.clock.Now()),
+	}, nil
+}
+
+// Claim locks an open slot for a customer and books it at the broadcast
+// price. Only the first claim succeeds; if the booking cannot be made the
+// slot is released for the next customer.
+func (s *OpenSlotService) Claim(ctx context.Context, id, userID int, req ClaimOpenSlotRequest) (*BookingResponse, error) {
+	slot, err := s.repo.Claim(ctx, id, userID, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	servicePrice, discount := slot.ServicePrice, slot.DiscountAmount()
+	booking, err := s.bookingService.CreateBooking(ctx, CreateBookingRequest{
+		BarberID:               slot.BarberID,
+		StartTime:              slot.StartTime,
+		ServiceID:              slot.BarberServiceID,
+		DurationMinutes:        slot.DurationMinutes,
+		CustomerID:             &userID,
+		Notes:                  req.Notes,
+		BookingSource:          config.BookingSourceOpenSlot,
+		CustomFields:           req.CustomFields,
+		ServicePrice:           &servicePrice,
+		DiscountAmount:         &discount,
+		DepositPaymentMethodID: req.DepositPaymentMethodID,
+	}, &userID)
+	if err != nil {
+		if releaseErr := s.repo.Release(ctx, id, userID); releaseErr != nil {
+			logger.FromContext(ctx).Error(releaseErr).
+				Int("open_slot_id", id).
+				Msg("Failed to release open slot after booking failure")
+		}
+		return nil, err
+	}
+
+	if err := s.repo.SetBooking(ctx, id, booking.ID); err != nil {
+		return nil, err
+	}
+	return booking, nil
+}
+
+// ========================================================================
+// FAVORITES
+// ========================================================================
+
+// AddFavorite favorites a barber for a customer
+func (s *OpenSlotService) AddFavorite(ctx context.Context, userID, barberID int) error {
+	if _, err := s.barberRepo.FindByID(ctx, barberID); err != nil {
+		return err
+	}
+	return s.repo.AddFavorite(ctx, userID, barberID)
+}
+
+// RemoveFavorite removes a barber from a customer's favorites
+func (s *OpenSlotService) RemoveFavorite(ctx context.Context, userID, barberID int) error {
+	return s.repo.RemoveFavorite(ctx, userID, barberID)
+}
+
+// ListFavorites returns a customer's favorite barbers
+func (s *OpenSlotService) ListFavorites(ctx context.Context, userID int) ([]models.FavoriteBarber, error) {
+	return s.repo.FindFavorites(ctx, userID)
+}
+
+// ========================================================================
+// HELPERS
+// ========================================================================
+
+// authorize loads the barber for a user who may broadcast their slots
+func (s *OpenSlotService) authorize(ctx context.Context, barberID, userID int, isAdmin bool) (*models.Barber, error) {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return nil, repository.ErrNotOwner
+	}
+	return barber, nil
+}
+
+// resolveOpenSlotAudiences validates a broadcast's audiences and radius,
+// defaulting to every audience and DefaultOpenSlotRadiusKm
+func resolveOpenSlotAudiences(requested []string, radius *float64) ([]string, float64, error) {
+	audiences := []string{}
+	for _, audience := range requested {
+		if !slices.Contains(config.ValidOpenSlotAudiences, audience) {
+			return nil, 0, fmt.Errorf("audiences must be %s", strings.Join(config.ValidOpenSlotAudiences, " or "))
+		}
+		if !slices.Contains(audiences, audience) {
+			audiences = append(audiences, audience)
+		}
+	}
+	if len(audiences) == 0 {
+		audiences = slices.Clone(config.ValidOpenSlotAudiences)
+	}
+
+	radiusKm := config.DefaultOpenSlotRadiusKm
+	if radius != nil {
+		radiusKm = *radius
+	}
+	if radiusKm <= 0 || radiusKm > config.MaxOpenSlotRadiusKm {
+		return nil, 0, fmt.Errorf("radius_km must be between 0 and %g", config.MaxOpenSlotRadiusKm)
+	}
+	return audiences, radiusKm, nil
+}
+
+// openSlotServiceName is the name customers see for a slot's service
+func openSlotServiceName(barberService *models.BarberService) string {
+	if barberService.CustomName != nil && *barberService.CustomName != "" {
+		return *barberService.CustomName
+	}
+	if barberService.ServiceName != nil {
+		return *barberService.ServiceName
+	}
+	return barberService.GetDisplayName()
+}
@@ -0,0 +1,214 @@
+// internal/services/outbox_service.go
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/worker"
+)
+
+// ========================================================================
+// OUTBOX SERVICE - Relays recorded domain events to their handlers
+// ========================================================================
+//
+// Booking changes record their domain events (booking.created,
+// booking.status_changed, booking.cancelled) in the outbox table in the
+// same transaction as the change. The background worker claims pending
+// events in order and passes each to every registered handler:
+//
+//	notifications   tells customers their booking was cancelled
+//	webhooks        queues deliveries to barbers' webhook subscriptions
+//	cache           invalidates the barber's cached profile and dashboard
+//
+// A handler that succeeds is recorded on the event, so a retry after
+// another handler failed does not repeat it. Events are retried with
+// backoff and marked failed after MaxAttempts.
+// ========================================================================
+
+// OutboxHandler processes one domain event. Handlers ignore event types
+// they have no use for; an error retries the event.
+type OutboxHandler func(ctx context.Context, event *models.OutboxEvent) error
+
+// namedOutboxHandler is a registered handler
+type namedOutboxHandler struct {
+	name   string
+	handle OutboxHandler
+}
+
+// OutboxService dispatches outbox events
+type OutboxService struct {
+	repo     *repository.OutboxRepository
+	clock    clock.Clock
+	handlers []namedOutboxHandler
+	delivery DeliveryPolicy
+}
+
+// NewOutboxService creates a new outbox service with no handlers
+func NewOutboxService(repo *repository.OutboxRepository) *OutboxService {
+	return &OutboxService{
+		repo:  repo,
+		clock: clock.System,
+		delivery: DeliveryPolicy{
+			MaxAttempts: config.DefaultOutboxMaxAttempts,
+			RetryBase:   config.DefaultOutboxRetryBase,
+			RetryMax:    config.DefaultOutboxRetryMax,
+			Lease:       config.DefaultOutboxLease,
+		},
+	}
+}
+
+// SetClock replaces the time source used for retries (nil restores the system clock)
+func (s *OutboxService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// SetDeliveryPolicy replaces the retry policy; unset fields keep their current values
+func (s *OutboxService) SetDeliveryPolicy(policy DeliveryPolicy) {
+	if policy.MaxAttempts > 0 {
+		s.delivery.MaxAttempts = policy.MaxAttempts
+	}
+	if policy.RetryBase > 0 {
+		s.delivery.RetryBase = policy.RetryBase
+	}
+	if policy.RetryMax > 0 {
+		s.delivery.RetryMax = policy.RetryMax
+	}
+	if policy.Lease > 0 {
+		s.delivery.Lease = policy.Lease
+	}
+}
+
+// Handle registers a handler under a name, which is recorded on events it
+// has processed and so must stay the same across releases. Registering a
+// name again replaces its handler.
+func (s *OutboxService) Handle(name string, handler OutboxHandler) {
+	for i := range s.handlers {
+		if s.handlers[i].name == name {
+			s.handlers[i].handle = handler
+			return
+		}
+	}
+	s.handlers = append(s.handlers, namedOutboxHandler{name: name, handle: handler})
+}
+
+// ========================================================================
+// DISPATCH
+// ========================================================================
+
+// ProcessPendingEvents claims up to limit due events and dispatches them.
+// It returns how many were claimed.
+func (s *OutboxService) ProcessPendingEvents(ctx context.Context, limit int) (int, error) {
+	events, err := s.repo.ClaimPending(ctx, s.clock.Now(), s.delivery.Lease, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	for i := range events {
+		if ctx.Err() != nil {
+			// Unprocessed claims become visible again when their lease ends
+			break
+		}
+		s.dispatch(ctx, &events[i])
+	}
+	return len(events), nil
+}
+
+// dispatch passes a claimed event to the handlers that have not yet
+// processed it and records the outcome
+func (s *OutboxService) dispatch(ctx context.Context, event *models.OutboxEvent) {
+	log := logger.FromContext(ctx)
+
+	var errs []error
+	for _, h := range s.handlers {
+		if event.HandledBy(h.name) {
+			continue
+		}
+		if err := h.handle(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", h.name, err))
+			continue
+		}
+		if err := s.repo.MarkHandled(ctx, event.ID, h.name); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) == 0 {
+		if err := s.repo.MarkDispatched(ctx, event.ID, s.clock.Now()); err != nil {
+			log.Warn("Failed to mark outbox event dispatched").Int64("event_id", event.ID).Err(err).Send()
+		}
+		return
+	}
+
+	err := errors.Join(errs...)
+	if event.Attempts >= s.delivery.MaxAttempts {
+		log.Error(err).
+			Int64("event_id", event.ID).
+			Str("event_type", event.EventType).
+			Int("aggregate_id", event.AggregateID).
+			Int("attempts", event.Attempts).
+			Msg("Outbox event dispatch failed")
+		if err := s.repo.MarkFailed(ctx, event.ID, err.Error()); err != nil {
+			log.Warn("Failed to mark outbox event failed").Int64("event_id", event.ID).Err(err).Send()
+		}
+		return
+	}
+
+	retryAt := s.clock.Now().Add(worker.Backoff(event.Attempts, s.delivery.RetryBase, s.delivery.RetryMax))
+	log.Warn("Outbox event dispatch failed, will retry").
+		Int64("event_id", event.ID).
+		Str("event_type", event.EventType).
+		Int("attempts", event.Attempts).
+		Time("retry_at", retryAt).
+		Err(err).
+		Send()
+	if err := s.repo.ScheduleRetry(ctx, event.ID, err.Error(), retryAt); err != nil {
+		log.Warn("Failed to schedule outbox retry").Int64("event_id", event.ID).Err(err).Send()
+	}
+}
+
+// Cleanup deletes events dispatched more than retention ago and returns
+// how many were deleted. Failed events are kept for inspection.
+func (s *OutboxService) Cleanup(ctx context.Context, retention time.Duration) (int, error) {
+	return s.repo.DeleteDispatchedBefore(ctx, s.clock.Now().Add(-retention))
+}
+
+// ========================================================================
+// HANDLERS
+// ========================================================================
+
+// decodeBookingEvent reads a booking event's payload
+func decodeBookingEvent(event *models.OutboxEvent) (*models.BookingEvent, error) {
+	var data models.BookingEvent
+	if err := json.Unmarshal(event.Payload, &data); err != nil {
+		return nil, fmt.Errorf("failed to decode %s event: %w", event.EventType, err)
+	}
+	if data.Booking == nil {
+		return nil, fmt.Errorf("%s event has no booking", event.EventType)
+	}
+	return &data, nil
+}
+
+// BookingCacheHandler invalidates the cached barber profile and dashboard
+// whenever one of the barber's bookings changes
+func BookingCacheHandler(c *cache.CacheService) OutboxHandler {
+	return func(ctx context.Context, event *models.OutboxEvent) error {
+		if event.AggregateType != config.OutboxAggregateBooking {
+			return nil
+		}
+		data, err := decodeBookingEvent(event)
+		if err != nil {
+			return err
+		}
+		return c.InvalidateBarber(ctx, data.Booking.BarberID)
+	}
+}
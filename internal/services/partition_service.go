@@ -0,0 +1,96 @@
+// internal/services/partition_service.go
+package services
+
+import (
+	"context"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// PARTITION SERVICE - Keeps monthly partitions ahead and prunes old ones
+// ========================================================================
+//
+// notifications is range partitioned by created_at, one partition per
+// month (migration 000042). Each run creates the partitions of the current
+// and next PartitionMonthsAhead months, so inserts never land in the
+// default partition, and drops the partitions past retention, which is
+// much cheaper than deleting their rows.
+// ========================================================================
+
+// PartitionService maintains monthly table partitions
+type PartitionService struct {
+	repo  *repository.PartitionRepository
+	clock clock.Clock
+}
+
+// NewPartitionService creates a new partition service
+func NewPartitionService(repo *repository.PartitionRepository) *PartitionService {
+	return &PartitionService{
+		repo:  repo,
+		clock: clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *PartitionService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// MaintainNotifications maintains the notifications partitions, keeping
+// keepMonths months before the current one (keepMonths <= 0 keeps all)
+func (s *PartitionService) MaintainNotifications(ctx context.Context, keepMonths int) error {
+	return s.maintain(ctx, "notifications", "created_at", keepMonths)
+}
+
+// maintain creates missing partitions up to PartitionMonthsAhead and drops
+// those entirely older than keepMonths before the current month
+func (s *PartitionService) maintain(ctx context.Context, table, column string, keepMonths int) error {
+	existing, err := s.repo.FindMonthly(ctx, table)
+	if err != nil {
+		return err
+	}
+	have := make(map[string]bool, len(existing))
+	for _, p := range existing {
+		have[p.Name] = true
+	}
+
+	current := models.MonthStart(s.clock.Now())
+	var created, dropped []string
+	for i := 0; i <= config.PartitionMonthsAhead; i++ {
+		p := models.NewMonthlyPartition(table, current.AddDate(0, i, 0))
+		if have[p.Name] {
+			continue
+		}
+		if err := s.repo.CreateMonthly(ctx, table, column, p); err != nil {
+			return err
+		}
+		created = append(created, p.Name)
+	}
+
+	if keepMonths > 0 {
+		cutoff := current.AddDate(0, -keepMonths, 0)
+		for _, p := range existing {
+			if p.End().After(cutoff) {
+				break
+			}
+			if err := s.repo.Drop(ctx, p); err != nil {
+				return err
+			}
+			dropped = append(dropped, p.Name)
+		}
+	}
+
+	if len(created) > 0 || len(dropped) > 0 {
+		logger.FromContext(ctx).Info("Maintained table partitions").
+			Str("table", table).
+			Strs("created", created).
+			Strs("dropped", dropped).
+			Send()
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+// internal/services/pending_expiry_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// PENDING EXPIRY SERVICE - Releases slots the barber never confirmed
+// ========================================================================
+//
+// A pending booking holds its slot until the barber confirms it. Bookings
+// still pending once the TTL has passed since they were made are cancelled
+// as cancelled_by_barber: the barber did not take them, so prepaid ones
+// are refunded in full. The slot is released and the customer told to book
+// another time.
+//
+// The barber can confirm a booking between the scan and its cancellation,
+// so each one is cancelled only if its row, once locked, is still pending.
+// Bookings confirmed meanwhile are left alone and nobody is notified.
+// ========================================================================
+
+// PendingExpiryService cancels bookings left pending too long
+type PendingExpiryService struct {
+	bookingRepo         repository.BookingStore
+	bookingService      *BookingService
+	notificationService *NotificationService
+	clock               clock.Clock
+	config              config.PendingExpiryConfig
+}
+
+// NewPendingExpiryService creates a new pending expiry service. Unset
+// settings fall back to the defaults.
+func NewPendingExpiryService(
+	bookingRepo repository.BookingStore,
+	bookingService *BookingService,
+	notificationService *NotificationService,
+	cfg config.PendingExpiryConfig,
+) *PendingExpiryService {
+	if cfg.TTL <= 0 {
+		cfg.TTL = config.DefaultPendingBookingTTL
+	}
+	return &PendingExpiryService{
+		bookingRepo:         bookingRepo,
+		bookingService:      bookingService,
+		notificationService: notificationService,
+		clock:               clock.System,
+		config:              cfg,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *PendingExpiryService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// RunScheduled cancels every booking that has been pending longer than the
+// TTL. A failure for one booking is logged and skipped so the rest still
+// expire.
+func (s *PendingExpiryService) RunScheduled(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+	now := s.clock.Now()
+
+	bookings, err := s.bookingRepo.FindStalePending(ctx, now.Add(-s.config.TTL))
+	if err != nil {
+		return err
+	}
+
+	expired := 0
+	for i := range bookings {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		err := s.expire(ctx, &bookings[i], now)
+		if errors.Is(err, repository.ErrBookingStatusChanged) {
+			log.Debug("Pending booking was handled before it expired").
+				Int("booking_id", bookings[i].ID).
+				Err(err).
+				Send()
+			continue
+		}
+		if err != nil {
+			log.Warn("Failed to expire pending booking").
+				Int("booking_id", bookings[i].ID).
+				Err(err).
+				Send()
+			continue
+		}
+		expired++
+	}
+
+	if expired > 0 {
+		log.Info("Expired pending bookings").
+			Int("count", expired).
+			Dur("ttl", s.config.TTL).
+			Send()
+	}
+	return nil
+}
+
+// expire cancels one pending booking and tells its customer. It returns
+// ErrBookingStatusChanged, having changed nothing, when the booking is no
+// longer pending.
+func (s *PendingExpiryService) expire(ctx context.Context, booking *models.Booking, now time.Time) error {
+	ctx = bookingContext(ctx, booking)
+
+	if _, err := s.bookingService.CancelBooking(ctx, booking.ID, CancelBookingRequest{
+		Reason:       "Not confirmed by the barber in time",
+		onlyIfStatus: config.BookingStatusPending,
+	}, nil); err != nil {
+		return err
+	}
+
+	history := &models.BookingHistory{
+		BookingID:  booking.ID,
+		ChangeType: "pending_expired",
+		OldValues:  models.JSONMap{"status": booking.Status},
+		NewValues: models.JSONMap{
+			"pending_since": booking.CreatedAt,
+			"expired_at":    now,
+			"ttl":           s.config.TTL.String(),
+		},
+	}
+	if err := s.bookingRepo.CreateHistory(ctx, history); err != nil {
+		logger.FromContext(ctx).Warn("Failed to create booking history").
+			Int("booking_id", booking.ID).
+			Err(err).
+			Send()
+	}
+
+	if err := s.notificationService.SendBookingExpired(ctx, booking); err != nil {
+		logger.FromContext(ctx).Warn("Failed to send booking expired notification").
+			Int("booking_id", booking.ID).
+			Err(err).
+			Send()
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+// internal/services/portfolio_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/storage"
+)
+
+// ========================================================================
+// PORTFOLIO SERVICE - Photos barbers show on their services
+// ========================================================================
+//
+// Each barber service has two galleries: examples of the barber's work and
+// before/after shots, of up to config.MaxGalleryImages photos each. Photos
+// are stored with a thumbnail (see storeImage), captioned and ordered by
+// the barber; the galleries are listed publicly.
+// ========================================================================
+
+// PortfolioService manages the portfolio media of barber services
+type PortfolioService struct {
+	repo        repository.PortfolioStore
+	serviceRepo repository.ServiceStore
+	barberRepo  repository.BarberStore
+	storage     storage.Storage
+}
+
+// NewPortfolioService creates a new portfolio service
+func NewPortfolioService(
+	repo repository.PortfolioStore,
+	serviceRepo repository.ServiceStore,
+	barberRepo repository.BarberStore,
+	store storage.Storage,
+) *PortfolioService {
+	return &PortfolioService{
+		repo:        repo,
+		serviceRepo: serviceRepo,
+		barberRepo:  barberRepo,
+		storage:     store,
+	}
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// PortfolioQuery filters a service's photos
+type PortfolioQuery struct {
+	Kind string `form:"kind" binding:"omitempty,oneof=portfolio before_after"`
+}
+
+// PortfolioCaptionRequest sets or clears a photo's caption
+type PortfolioCaptionRequest struct {
+	Caption *string `json:"caption" example:"Skin fade with a textured crop"` // Null or empty clears it
+}
+
+// PortfolioOrderRequest orders a service's photos of a kind
+type PortfolioOrderRequest struct {
+	Kind     string `json:"kind" binding:"required,oneof=portfolio before_after" example:"portfolio"`
+	MediaIDs []int  `json:"media_ids" binding:"required" example:"3,1,2"` // Every photo of the kind, in the new order
+}
+
+// ========================================================================
+// GALLERIES
+// ========================================================================
+
+// ListMedia returns a barber service's photos, in order
+func (s *PortfolioService) ListMedia(ctx context.Context, barberID, barberServiceID int, kind string) ([]models.PortfolioMedia, error) {
+	if _, err := s.findBarberService(ctx, barberID, barberServiceID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByBarberServiceID(ctx, barberServiceID, kind)
+}
+
+// UploadMedia stores a photo and adds it at the end of the service's
+// gallery of its kind
+func (s *PortfolioService) UploadMedia(ctx context.Context, barberID, barberServiceID int, kind string, caption *string, data []byte, userID int, isAdmin bool) (*models.PortfolioMedia, error) {
+	if kind != config.PortfolioKindWork && kind != config.PortfolioKindBeforeAfter {
+		return nil, fmt.Errorf("kind must be %s or %s", config.PortfolioKindWork, config.PortfolioKindBeforeAfter)
+	}
+	caption, err := normalizeCaption(caption)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.authorize(ctx, barberID, barberServiceID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	// Check the limit before the work of decoding; Create enforces it
+	existing, err := s.repo.FindByBarberServiceID(ctx, barberServiceID, kind)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= config.MaxGalleryImages {
+		return nil, repository.ErrPortfolioLimit
+	}
+
+	stored, err := storeImage(ctx, s.storage, fmt.Sprintf("barber-services/%d", barberServiceID), data)
+	if err != nil {
+		return nil, err
+	}
+	media := &models.PortfolioMedia{
+		BarberServiceID: barberServiceID,
+		Kind:            kind,
+		Caption:         caption,
+		ImageKey:        stored.ImageKey,
+		ThumbnailKey:    stored.ThumbnailKey,
+		URL:             stored.URL,
+		ThumbnailURL:    stored.ThumbnailURL,
+		ContentType:     stored.ContentType,
+		SizeBytes:       stored.SizeBytes,
+		Width:           stored.Width,
+		Height:          stored.Height,
+		UploadedBy:      &userID,
+	}
+	if err := s.repo.Create(ctx, media, config.MaxGalleryImages); err != nil {
+		removeStoredFiles(ctx, s.storage, media.ImageKey, media.ThumbnailKey)
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Portfolio photo added").
+		Int("barber_service_id", barberServiceID).
+		Int("media_id", media.ID).
+		Str("kind", kind).
+		Send()
+
+	return media, nil
+}
+
+// UpdateCaption sets or clears a photo's caption
+func (s *PortfolioService) UpdateCaption(ctx context.Context, barberID, barberServiceID, id int, req PortfolioCaptionRequest, userID int, isAdmin bool) (*models.PortfolioMedia, error) {
+	caption, err := normalizeCaption(req.Caption)
+	if err != nil {
+		return nil, err
+	}
+	media, err := s.findMedia(ctx, barberID, barberServiceID, id, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateCaption(ctx, id, caption); err != nil {
+		return nil, err
+	}
+	media.Caption = caption
+	return media, nil
+}
+
+// ReorderMedia orders a service's photos of a kind and returns them
+func (s *PortfolioService) ReorderMedia(ctx context.Context, barberID, barberServiceID int, req PortfolioOrderRequest, userID int, isAdmin bool) ([]models.PortfolioMedia, error) {
+	if _, err := s.authorize(ctx, barberID, barberServiceID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Reorder(ctx, barberServiceID, req.Kind, req.MediaIDs); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByBarberServiceID(ctx, barberServiceID, req.Kind)
+}
+
+// DeleteMedia removes a photo and its files
+func (s *PortfolioService) DeleteMedia(ctx context.Context, barberID, barberServiceID, id int, userID int, isAdmin bool) error {
+	if _, err := s.authorize(ctx, barberID, barberServiceID, userID, isAdmin); err != nil {
+		return err
+	}
+	media, err := s.repo.Delete(ctx, barberServiceID, id)
+	if err != nil {
+		return err
+	}
+	removeStoredFiles(ctx, s.storage, media.ImageKey, media.ThumbnailKey)
+
+	logger.FromContext(ctx).Info("Portfolio photo deleted").
+		Int("barber_service_id", barberServiceID).
+		Int("media_id", id).
+		Send()
+
+	return nil
+}
+
+// ========================================================================
+// HELPERS
+// ========================================================================
+
+// normalizeCaption trims a caption; empty captions are cleared
+func normalizeCaption(caption *string) (*string, error) {
+	if caption == nil {
+		return nil, nil
+	}
+	trimmed := strings.TrimSpace(*caption)
+	if trimmed == "" {
+		return nil, nil
+	}
+	if len([]rune(trimmed)) > config.MaxPortfolioCaptionLength {
+		return nil, fmt.Errorf("caption cannot exceed %d characters", config.MaxPortfolioCaptionLength)
+	}
+	return &trimmed, nil
+}
+
+// findBarberService loads one of the barber's services
+func (s *PortfolioService) findBarberService(ctx context.Context, barberID, barberServiceID int) (*models.BarberService, error) {
+	barberService, err := s.serviceRepo.FindBarberServiceByID(ctx, barberServiceID)
+	if err != nil {
+		return nil, err
+	}
+	if barberService.BarberID != barberID {
+		return nil, repository.ErrBarberServiceNotFound
+	}
+	return barberService, nil
+}
+
+// authorize loads one of the barber's services for a user who may manage
+// its photos: admins may manage any barber's, barbers only their own
+func (s *PortfolioService) authorize(ctx context.Context, barberID, barberServiceID, userID int, isAdmin bool) (*models.BarberService, error) {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return nil, repository.ErrNotOwner
+	}
+	return s.findBarberService(ctx, barberID, barberServiceID)
+}
+
+// findMedia loads one of a barber service's photos for a user who may
+// manage them
+func (s *PortfolioService) findMedia(ctx context.Context, barberID, barberServiceID, id int, userID int, isAdmin bool) (*models.PortfolioMedia, error) {
+	if _, err := s.authorize(ctx, barberID, barberServiceID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	media, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if media.BarberServiceID != barberServiceID {
+		return nil, repository.ErrPortfolioMediaNotFound
+	}
+	return media, nil
+}
@@ -0,0 +1,308 @@
+// internal/services/realtime_service.go
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// REALTIME SERVICE - Live updates over WebSockets
+// ========================================================================
+//
+// Each connected client gets JSON events as they happen:
+//
+//	notification     a new in-app notification
+//	booking_status   one of the user's bookings (as customer or barber) changed status
+//	unread_count     the unread notification count, on connect and on every change
+//	heartbeat        sent when nothing else has been for a while
+//
+// Barber dashboards can instead follow a barber's booking feed (Server-Sent
+// Events): booking.created, booking.updated and booking.cancelled for every
+// booking with that barber, after an initial ready event.
+//
+// Events go through Redis pub/sub, one channel per user or barber, so a change made
+// on one server instance reaches connections held by any other. Without
+// Redis there is no live feed and clients fall back to polling.
+// ========================================================================
+
+// ErrRealtimeUnavailable is returned when live updates need Redis and it is not configured
+var ErrRealtimeUnavailable = errors.New("real-time updates are unavailable")
+
+// RealtimeService publishes and streams users' live events
+type RealtimeService struct {
+	cache         *cache.CacheService
+	notifications *NotificationService
+	barberRepo    repository.BarberStore
+	clock         clock.Clock
+}
+
+// NewRealtimeService creates a new realtime service (nil cache disables live updates)
+func NewRealtimeService(
+	cache *cache.CacheService,
+	notifications *NotificationService,
+	barberRepo repository.BarberStore,
+) *RealtimeService {
+	return &RealtimeService{
+		cache:         cache,
+		notifications: notifications,
+		barberRepo:    barberRepo,
+		clock:         clock.System,
+	}
+}
+
+// SetClock replaces the time source used to stamp events (nil restores the system clock)
+func (s *RealtimeService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// EVENTS
+// ========================================================================
+
+// RealtimeEvent is one message sent to a connected client
+type RealtimeEvent struct {
+	Type   string      `json:"type"` // notification, booking_status, unread_count, heartbeat
+	Data   interface{} `json:"data,omitempty"`
+	SentAt time.Time   `json:"sent_at"`
+}
+
+// BookingStatusEvent is the data of a booking_status event
+type BookingStatusEvent struct {
+	BookingID          int       `json:"booking_id"`
+	BookingNumber      string    `json:"booking_number"`
+	BarberID           int       `json:"barber_id"`
+	OldStatus          string    `json:"old_status"`
+	NewStatus          string    `json:"new_status"`
+	ScheduledStartTime time.Time `json:"scheduled_start_time"`
+}
+
+// BookingFeedEvent is the data of a barber booking feed event
+type BookingFeedEvent struct {
+	Booking        *models.Booking `json:"booking"`
+	PreviousStatus string          `json:"previous_status,omitempty"` // Set for status changes
+}
+
+// UnreadCountEvent is the data of an unread_count event
+type UnreadCountEvent struct {
+	UnreadCount int `json:"unread_count"`
+}
+
+// Enabled reports whether live updates are available
+func (s *RealtimeService) Enabled() bool {
+	return s.cache != nil
+}
+
+// BookingStatusHook tells the booking's customer and barber about a status
+// change. Register it for every status (see BookingService.OnStatusChange).
+func (s *RealtimeService) BookingStatusHook(ctx context.Context, booking *models.Booking, from, to string) error {
+	if s.cache == nil {
+		return nil
+	}
+
+	var recipients []int
+	if booking.CustomerID != nil {
+		recipients = append(recipients, *booking.CustomerID)
+	}
+	barber, err := s.barberRepo.FindByID(ctx, booking.BarberID)
+	if err != nil {
+		return err
+	}
+	if booking.CustomerID == nil || barber.UserID != *booking.CustomerID {
+		recipients = append(recipients, barber.UserID)
+	}
+
+	event := RealtimeEvent{
+		Type: config.RealtimeEventBookingStatus,
+		Data: BookingStatusEvent{
+			BookingID:          booking.ID,
+			BookingNumber:      booking.BookingNumber,
+			BarberID:           booking.BarberID,
+			OldStatus:          from,
+			NewStatus:          to,
+			ScheduledStartTime: booking.ScheduledStartTime,
+		},
+		SentAt: s.clock.Now(),
+	}
+	var errs []error
+	for _, userID := range recipients {
+		if err := s.cache.PublishUserEvent(ctx, userID, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	feedType := config.RealtimeEventBookingUpdated
+	if booking.IsCancelled() || to == config.BookingStatusCancelled {
+		feedType = config.RealtimeEventBookingCancelled
+	}
+	errs = append(errs, s.publishBookingFeed(ctx, feedType, BookingFeedEvent{Booking: booking, PreviousStatus: from}))
+	return errors.Join(errs...)
+}
+
+// BookingCreatedHook adds a new booking to the barber's booking feed.
+// Register it with BookingService.OnCreated.
+func (s *RealtimeService) BookingCreatedHook(ctx context.Context, booking *models.Booking, _ *int) error {
+	return s.publishBookingFeed(ctx, config.RealtimeEventBookingCreated, BookingFeedEvent{Booking: booking})
+}
+
+// BookingUpdatedHook sends an edited or rescheduled booking to the barber's
+// booking feed. Register it with BookingService.OnUpdated.
+func (s *RealtimeService) BookingUpdatedHook(ctx context.Context, booking *models.Booking, _ *int) error {
+	return s.publishBookingFeed(ctx, config.RealtimeEventBookingUpdated, BookingFeedEvent{Booking: booking})
+}
+
+// publishBookingFeed sends an event to the booking's barber feed
+func (s *RealtimeService) publishBookingFeed(ctx context.Context, eventType string, data BookingFeedEvent) error {
+	if s.cache == nil {
+		return nil
+	}
+	return s.cache.PublishBarberEvent(ctx, data.Booking.BarberID, RealtimeEvent{
+		Type:   eventType,
+		Data:   data,
+		SentAt: s.clock.Now(),
+	})
+}
+
+// ========================================================================
+// STREAMING
+// ========================================================================
+
+// Serve streams a user's events to send until ctx is done or send fails:
+// the unread count first, then events as they arrive, with a heartbeat
+// whenever the connection has been idle for RealtimeHeartbeatInterval
+func (s *RealtimeService) Serve(ctx context.Context, userID int, send func(payload []byte) error) error {
+	if s.cache == nil {
+		return ErrRealtimeUnavailable
+	}
+
+	// Subscribe before reading the count so no change is missed in between
+	stream, err := s.cache.SubscribeUserEvents(ctx, userID)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	if err := s.sendUnreadCount(ctx, userID, send); err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Next(ctx, config.RealtimeHeartbeatInterval)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		switch {
+		case msg == nil:
+			err = s.sendEvent(send, RealtimeEvent{Type: config.RealtimeEventHeartbeat, SentAt: s.clock.Now()})
+		case msg.UnreadChanged:
+			err = s.sendUnreadCount(ctx, userID, send)
+		default:
+			err = send([]byte(msg.Payload))
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// ServeBarberFeed streams a barber's booking feed to send until ctx is done
+// or send fails. Admins may follow any barber, barbers only themselves;
+// nothing is sent unless the user is allowed. A ready event is sent once
+// subscribed, after which clients should (re)load the barber's bookings so
+// nothing changed while disconnected is missed. Heartbeats are sent
+// whenever the stream has been idle for RealtimeHeartbeatInterval.
+func (s *RealtimeService) ServeBarberFeed(ctx context.Context, barberID, userID int, isAdmin bool, send func(eventType string, payload []byte) error) error {
+	if s.cache == nil {
+		return ErrRealtimeUnavailable
+	}
+
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return repository.ErrNotOwner
+	}
+
+	stream, err := s.cache.SubscribeBarberEvents(ctx, barberID)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	sendEvent := func(event RealtimeEvent) error {
+		payload, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		return send(event.Type, payload)
+	}
+
+	if err := sendEvent(RealtimeEvent{Type: config.RealtimeEventReady, SentAt: s.clock.Now()}); err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Next(ctx, config.RealtimeHeartbeatInterval)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		if msg == nil {
+			err = sendEvent(RealtimeEvent{Type: config.RealtimeEventHeartbeat, SentAt: s.clock.Now()})
+		} else {
+			var event struct {
+				Type string `json:"type"`
+			}
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				continue
+			}
+			err = send(event.Type, []byte(msg.Payload))
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// sendUnreadCount sends the user's current unread count
+func (s *RealtimeService) sendUnreadCount(ctx context.Context, userID int, send func([]byte) error) error {
+	count, err := s.notifications.GetUnreadCount(ctx, userID)
+	if err != nil {
+		logger.FromContext(ctx).Warn("Failed to read unread count for live update").
+			Int("user_id", userID).
+			Err(err).
+			Send()
+		return nil
+	}
+	return s.sendEvent(send, RealtimeEvent{
+		Type:   config.RealtimeEventUnreadCount,
+		Data:   UnreadCountEvent{UnreadCount: count},
+		SentAt: s.clock.Now(),
+	})
+}
+
+// sendEvent encodes and sends one event
+func (s *RealtimeService) sendEvent(send func([]byte) error, event RealtimeEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return send(payload)
+}
@@ -0,0 +1,98 @@
+// internal/services/relations.go
+package services
+
+import (
+	"encoding/json"
+
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// LIST RELATIONS - Customers, barbers and services on list responses
+// ========================================================================
+//
+// Lists of bookings, reviews and notifications attach the related records
+// their include_* query parameters ask for, loaded for the whole page at
+// once by repository.Hydrate. The response fields shadow the relations of
+// the embedded models, which lists never populate.
+// ========================================================================
+
+// SetRelationLoader lets booking lists include customers, barbers and
+// services (nil = include_* parameters are ignored)
+func (s *BookingService) SetRelationLoader(loader *repository.RelationLoader) {
+	s.relations = loader
+}
+
+// SetRelationLoader lets review lists include customers and barbers (nil =
+// include_* parameters are ignored)
+func (s *ReviewService) SetRelationLoader(loader *repository.RelationLoader) {
+	s.relations = loader
+}
+
+// SetRelationLoader lets notification lists include the barbers they are
+// about (nil = include_barber is ignored)
+func (s *NotificationService) SetRelationLoader(loader *repository.RelationLoader) {
+	s.relations = loader
+}
+
+// RelationIDs implements repository.Hydratable
+func (r *BookingResponse) RelationIDs() repository.RelationIDs {
+	ids := repository.RelationIDs{BarberID: r.BarberID}
+	if r.CustomerID != nil {
+		ids.CustomerID = *r.CustomerID
+	}
+	if r.BarberServiceID != nil {
+		ids.BarberServiceID = *r.BarberServiceID
+	}
+	return ids
+}
+
+// SetRelations implements repository.Hydratable
+func (r *BookingResponse) SetRelations(relations repository.Relations) {
+	r.Customer = relations.Customer
+	r.Barber = relations.Barber
+	r.Service = relations.Service
+}
+
+// RelationIDs implements repository.Hydratable
+func (r *ReviewResponse) RelationIDs() repository.RelationIDs {
+	ids := repository.RelationIDs{BarberID: r.BarberID}
+	if r.CustomerID != nil {
+		ids.CustomerID = *r.CustomerID
+	}
+	return ids
+}
+
+// SetRelations implements repository.Hydratable
+func (r *ReviewResponse) SetRelations(relations repository.Relations) {
+	r.Customer = relations.Customer
+	r.Barber = relations.Barber
+}
+
+// RelationIDs implements repository.Hydratable: notifications about a
+// booking name its barber in their data
+func (r *NotificationResponse) RelationIDs() repository.RelationIDs {
+	return repository.RelationIDs{BarberID: dataInt(r.Data, "barber_id")}
+}
+
+// SetRelations implements repository.Hydratable
+func (r *NotificationResponse) SetRelations(relations repository.Relations) {
+	r.Barber = relations.Barber
+}
+
+// dataInt reads an ID from notification data, which holds ints when just
+// created and JSON numbers once read back (0 = missing)
+func dataInt(data map[string]interface{}, key string) int {
+	switch v := data[key].(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case json.Number:
+		n, _ := v.Int64()
+		return int(n)
+	}
+	return 0
+}
@@ -0,0 +1,144 @@
+// internal/services/review_image_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/storage"
+)
+
+// ========================================================================
+// REVIEW IMAGE SERVICE - Photos attached to reviews
+// ========================================================================
+//
+// A review's author attaches photos while the review awaits moderation,
+// like any other edit, so moderators see them before they are published.
+// Uploads are stored with a thumbnail (see storeImage). The author can
+// remove a photo at any time, moderators too.
+// ========================================================================
+
+// ReviewImageService handles review photos
+type ReviewImageService struct {
+	reviews repository.ReviewStore
+	images  *repository.ReviewImageRepository
+	storage storage.Storage
+	cache   *cache.CacheService
+}
+
+// NewReviewImageService creates a new review image service
+func NewReviewImageService(
+	reviews repository.ReviewStore,
+	images *repository.ReviewImageRepository,
+	store storage.Storage,
+	cache *cache.CacheService,
+) *ReviewImageService {
+	return &ReviewImageService{
+		reviews: reviews,
+		images:  images,
+		storage: store,
+		cache:   cache,
+	}
+}
+
+// ListImages returns a review's photos in upload order
+func (s *ReviewImageService) ListImages(ctx context.Context, reviewID int) ([]models.ReviewImage, error) {
+	if _, err := s.reviews.FindByID(ctx, reviewID); err != nil {
+		return nil, err
+	}
+	return s.images.FindByReviewID(ctx, reviewID)
+}
+
+// AttachImage stores a photo and its thumbnail and adds them to the
+// customer's review
+func (s *ReviewImageService) AttachImage(ctx context.Context, reviewID, customerID int, data []byte) (*models.ReviewImage, error) {
+	log := logger.FromContext(ctx)
+
+	review, err := s.reviews.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if review.CustomerID == nil || *review.CustomerID != customerID {
+		return nil, repository.ErrNotOwner
+	}
+	if review.ModerationStatus != config.ReviewModerationPending {
+		return nil, repository.ErrCannotModifyReview
+	}
+
+	// Check the limit before the work of decoding; Create enforces it
+	existing, err := s.images.FindByReviewID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= config.MaxReviewImages {
+		return nil, repository.ErrReviewImageLimit
+	}
+
+	stored, err := storeImage(ctx, s.storage, fmt.Sprintf("reviews/%d", reviewID), data)
+	if err != nil {
+		return nil, err
+	}
+	record := &models.ReviewImage{
+		ReviewID:     reviewID,
+		ImageKey:     stored.ImageKey,
+		ThumbnailKey: stored.ThumbnailKey,
+		URL:          stored.URL,
+		ThumbnailURL: stored.ThumbnailURL,
+		ContentType:  stored.ContentType,
+		SizeBytes:    stored.SizeBytes,
+		Width:        stored.Width,
+		Height:       stored.Height,
+		UploadedBy:   &customerID,
+	}
+	if err := s.images.Create(ctx, record, config.MaxReviewImages); err != nil {
+		removeStoredFiles(ctx, s.storage, record.ImageKey, record.ThumbnailKey)
+		return nil, err
+	}
+
+	if s.cache != nil {
+		_ = s.cache.InvalidateBarber(ctx, review.BarberID)
+	}
+
+	log.Info("Review image attached").
+		Int("review_id", reviewID).
+		Int("image_id", record.ID).
+		Int("size_bytes", record.SizeBytes).
+		Send()
+
+	return record, nil
+}
+
+// DeleteImage removes a photo from a review; only the review's author or a
+// moderator may
+func (s *ReviewImageService) DeleteImage(ctx context.Context, reviewID, imageID, userID int, canModerate bool) error {
+	review, err := s.reviews.FindByID(ctx, reviewID)
+	if err != nil {
+		return err
+	}
+	if !canModerate && (review.CustomerID == nil || *review.CustomerID != userID) {
+		return repository.ErrNotOwner
+	}
+
+	image, err := s.images.Delete(ctx, reviewID, imageID)
+	if err != nil {
+		return err
+	}
+	removeStoredFiles(ctx, s.storage, image.ImageKey, image.ThumbnailKey)
+
+	if s.cache != nil {
+		_ = s.cache.InvalidateBarber(ctx, review.BarberID)
+	}
+
+	logger.FromContext(ctx).Info("Review image deleted").
+		Int("review_id", reviewID).
+		Int("image_id", imageID).
+		Int("user_id", userID).
+		Send()
+
+	return nil
+}
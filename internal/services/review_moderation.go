@@ -0,0 +1,285 @@
+// internal/services/review_moderation.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// REVIEW MODERATION QUEUE - Admin workflow over ModerateReview
+// ========================================================================
+//
+// Moderators work through reviews by moderation status, oldest first, and
+// approve, reject or flag them one at a time or in bulk. Rejecting or
+// flagging takes one of the reason templates below; its message becomes
+// the review's moderation notes, followed by the moderator's own notes.
+// ========================================================================
+
+var (
+	// ErrModerationReasonRequired is returned when rejecting, flagging or
+	// redacting without a reason
+	ErrModerationReasonRequired = errors.New("reason is required to reject, flag or redact")
+
+	// ErrUnknownModerationReason is returned for reasons that are not
+	// templates, or not templates for the action
+	ErrUnknownModerationReason = errors.New("unknown moderation reason")
+)
+
+// ModerationReason is a reason template moderators pick when rejecting or
+// flagging a review
+type ModerationReason struct {
+	Key     string   `json:"key" example:"spam"`
+	Label   string   `json:"label" example:"Spam or advertising"`
+	Message string   `json:"message" example:"The review promotes another business or links to unrelated content."`
+	Actions []string `json:"actions" example:"reject,flag"` // Actions the reason applies to
+}
+
+// moderationReasons are the reason templates, in the order moderators see
+// them
+var moderationReasons = []ModerationReason{
+	{
+		Key:     "spam",
+		Label:   "Spam or advertising",
+		Message: "The review promotes another business or links to unrelated content.",
+		Actions: []string{config.ModerationActionReject, config.ModerationActionFlag},
+	},
+	{
+		Key:     "offensive_language",
+		Label:   "Offensive language",
+		Message: "The review contains abusive, hateful or discriminatory language.",
+		Actions: []string{config.ModerationActionReject, config.ModerationActionFlag},
+	},
+	{
+		Key:     "personal_information",
+		Label:   "Personal information",
+		Message: "The review shares someone's contact details or other personal information.",
+		Actions: []string{config.ModerationActionReject, config.ModerationActionFlag},
+	},
+	{
+		Key:     "off_topic",
+		Label:   "Off topic",
+		Message: "The review is not about the appointment or the barber's service.",
+		Actions: []string{config.ModerationActionReject},
+	},
+	{
+		Key:     "conflict_of_interest",
+		Label:   "Conflict of interest",
+		Message: "The review appears to be written by the barber, their staff or a competitor.",
+		Actions: []string{config.ModerationActionReject, config.ModerationActionFlag},
+	},
+	{
+		Key:     "disputed",
+		Label:   "Disputed by the barber",
+		Message: "The barber disputes the review; it is held until the dispute is resolved.",
+		Actions: []string{config.ModerationActionFlag},
+	},
+	{
+		Key:     "needs_second_review",
+		Label:   "Needs a second opinion",
+		Message: "The review needs another moderator to look at it.",
+		Actions: []string{config.ModerationActionFlag},
+	},
+}
+
+// moderationActionStatus maps queue actions to the statuses they set
+var moderationActionStatus = map[string]string{
+	config.ModerationActionApprove: config.ReviewModerationApproved,
+	config.ModerationActionReject:  config.ReviewModerationRejected,
+	config.ModerationActionFlag:    config.ReviewModerationFlagged,
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// ModerationActionRequest approves, rejects or flags a review
+type ModerationActionRequest struct {
+	Reason string  `json:"reason" example:"spam"` // Reason template key; required to reject, flag or redact
+	Notes  *string `json:"notes" binding:"omitempty,max=1000"`
+}
+
+// BulkModerationRequest applies one action to several reviews
+type BulkModerationRequest struct {
+	ReviewIDs []int   `json:"review_ids" binding:"required,min=1,max=100" example:"12,15,18"`
+	Action    string  `json:"action" binding:"required,oneof=approve reject flag" example:"reject"`
+	Reason    string  `json:"reason" example:"spam"`
+	Notes     *string `json:"notes" binding:"omitempty,max=1000"`
+}
+
+// BulkModerationItemResult says what a bulk moderation did with one review
+type BulkModerationItemResult struct {
+	ReviewID int    `json:"review_id"`
+	Status   string `json:"status"` // moderated, failed
+	Error    string `json:"error,omitempty"`
+}
+
+// BulkModerationResult summarizes a bulk moderation
+type BulkModerationResult struct {
+	Action    string                     `json:"action"`
+	Total     int                        `json:"total"`
+	Moderated int                        `json:"moderated"`
+	Failed    int                        `json:"failed"`
+	Reviews   []BulkModerationItemResult `json:"reviews"`
+}
+
+// ========================================================================
+// QUEUE
+// ========================================================================
+
+// ModerationReasons returns the reason templates
+func (s *ReviewService) ModerationReasons() []ModerationReason {
+	return moderationReasons
+}
+
+// GetModerationQueue retrieves a page of reviews by moderation status
+// (pending by default), oldest first unless sorted otherwise, with the
+// total number of matching reviews
+func (s *ReviewService) GetModerationQueue(ctx context.Context, filters repository.ReviewFilters) ([]ReviewResponse, int, error) {
+	if filters.ModerationStatus == "" {
+		filters.ModerationStatus = config.ReviewModerationPending
+	}
+	if !repository.IsValidModerationStatus(filters.ModerationStatus) {
+		return nil, 0, repository.ErrInvalidModeration
+	}
+	if filters.SortBy == "" {
+		filters.SortBy = "created_at"
+		filters.Order = "ASC"
+	}
+
+	reviews, err := s.repo.FindAll(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	responses := make([]ReviewResponse, len(reviews))
+	for i, review := range reviews {
+		responses[i] = *s.toReviewResponse(&review, nil)
+	}
+	if err := repository.Hydrate(ctx, s.relations, filters.Include(), responses); err != nil {
+		return nil, 0, err
+	}
+	return responses, total, nil
+}
+
+// ========================================================================
+// ACTIONS
+// ========================================================================
+
+// ApplyModeration approves, rejects or flags a review
+func (s *ReviewService) ApplyModeration(ctx context.Context, id int, action string, req ModerationActionRequest, moderatorID int) (*ReviewResponse, error) {
+	moderation, err := moderationFor(action, req.Reason, req.Notes)
+	if err != nil {
+		return nil, err
+	}
+	return s.ModerateReview(ctx, id, moderation, moderatorID)
+}
+
+// BulkModerate applies one action to each of the reviews, reporting the
+// outcome of each; one review failing does not stop the others
+func (s *ReviewService) BulkModerate(ctx context.Context, req BulkModerationRequest, moderatorID int) (*BulkModerationResult, error) {
+	if len(req.ReviewIDs) > config.MaxBulkModeration {
+		return nil, fmt.Errorf("cannot moderate more than %d reviews at once", config.MaxBulkModeration)
+	}
+	moderation, err := moderationFor(req.Action, req.Reason, req.Notes)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BulkModerationResult{Action: req.Action, Reviews: []BulkModerationItemResult{}}
+	seen := make(map[int]bool, len(req.ReviewIDs))
+	for _, id := range req.ReviewIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		item := BulkModerationItemResult{ReviewID: id, Status: "moderated"}
+		if _, err := s.ModerateReview(ctx, id, moderation, moderatorID); err != nil {
+			item.Status = "failed"
+			item.Error = err.Error()
+			result.Failed++
+		} else {
+			result.Moderated++
+		}
+		result.Reviews = append(result.Reviews, item)
+	}
+	result.Total = len(result.Reviews)
+
+	logger.FromContext(ctx).Info("Reviews moderated in bulk").
+		Str("action", req.Action).
+		Int("moderator_id", moderatorID).
+		Int("moderated", result.Moderated).
+		Int("failed", result.Failed).
+		Send()
+
+	return result, nil
+}
+
+// moderationFor builds the moderation an action sets: its status and
+// notes from the reason template and the moderator's notes
+func moderationFor(action, reasonKey string, notes *string) (ModerateReviewRequest, error) {
+	status, ok := moderationActionStatus[action]
+	if !ok {
+		return ModerateReviewRequest{}, fmt.Errorf("action must be one of %s, %s or %s",
+			config.ModerationActionApprove, config.ModerationActionReject, config.ModerationActionFlag)
+	}
+
+	combined, err := moderationNotes(moderationReasons, action, reasonKey, notes)
+	if err != nil {
+		return ModerateReviewRequest{}, err
+	}
+	return ModerateReviewRequest{Status: status, Notes: combined}, nil
+}
+
+// moderationNotes combines the message of the reason template picked from
+// reasons with the moderator's notes. Every action but approve needs a
+// reason.
+func moderationNotes(reasons []ModerationReason, action, reasonKey string, notes *string) (*string, error) {
+	var parts []string
+	if reasonKey != "" {
+		reason, ok := findModerationReason(reasons, reasonKey, action)
+		if !ok {
+			return nil, fmt.Errorf("%w %q for %s", ErrUnknownModerationReason, reasonKey, action)
+		}
+		parts = append(parts, reason.Label+": "+reason.Message)
+	} else if action != config.ModerationActionApprove {
+		return nil, ErrModerationReasonRequired
+	}
+	if notes != nil && strings.TrimSpace(*notes) != "" {
+		parts = append(parts, strings.TrimSpace(*notes))
+	}
+
+	if len(parts) == 0 {
+		return nil, nil
+	}
+	combined := strings.Join(parts, "\n\n")
+	return &combined, nil
+}
+
+// findModerationReason looks up a reason template that applies to action
+func findModerationReason(reasons []ModerationReason, key, action string) (ModerationReason, bool) {
+	for _, reason := range reasons {
+		if reason.Key != key {
+			continue
+		}
+		for _, a := range reason.Actions {
+			if a == action {
+				return reason, true
+			}
+		}
+		return ModerationReason{}, false
+	}
+	return ModerationReason{}, false
+}
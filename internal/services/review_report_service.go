@@ -0,0 +1,147 @@
+// internal/services/review_report_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// REVIEW REPORT SERVICE - Users reporting published reviews
+// ========================================================================
+//
+// Any signed-in user can report a published review once, other than its
+// author. When a review's open reports reach the threshold it is flagged
+// and unpublished until a moderator looks at it; moderating the review
+// resolves its reports. Moderators see the reports with the review.
+// ========================================================================
+
+// ErrReportDetailsRequired is returned for "other" reports without details
+var ErrReportDetailsRequired = errors.New("details are required when the reason is other")
+
+// ReviewReportService handles review reports
+type ReviewReportService struct {
+	reviews   repository.ReviewStore
+	reports   *repository.ReviewReportRepository
+	barbers   repository.BarberStore
+	cache     *cache.CacheService
+	threshold int
+}
+
+// NewReviewReportService creates a new review report service. Reviews are
+// flagged after threshold reports (config.DefaultReviewReportThreshold
+// when not positive).
+func NewReviewReportService(
+	reviews repository.ReviewStore,
+	reports *repository.ReviewReportRepository,
+	cache *cache.CacheService,
+	threshold int,
+) *ReviewReportService {
+	if threshold <= 0 {
+		threshold = config.DefaultReviewReportThreshold
+	}
+	return &ReviewReportService{
+		reviews:   reviews,
+		reports:   reports,
+		cache:     cache,
+		threshold: threshold,
+	}
+}
+
+// SetBarbers recalculates barber ratings when reports flag a review (nil
+// leaves them to the nightly stats job)
+func (s *ReviewReportService) SetBarbers(barbers repository.BarberStore) {
+	s.barbers = barbers
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// ReportReviewRequest reports a review
+type ReportReviewRequest struct {
+	Reason  string  `json:"reason" binding:"required,oneof=spam offensive fake personal_information off_topic other" example:"spam"`
+	Details *string `json:"details" binding:"omitempty,max=1000" example:"Advertises another shop"`
+}
+
+// ========================================================================
+// OPERATIONS
+// ========================================================================
+
+// ReportReview records a user's report of a published review, flagging
+// the review once it has enough open reports
+func (s *ReviewReportService) ReportReview(ctx context.Context, reviewID int, req ReportReviewRequest, userID int) (*models.ReviewReport, error) {
+	log := logger.FromContext(ctx)
+
+	var details *string
+	if req.Details != nil && strings.TrimSpace(*req.Details) != "" {
+		trimmed := strings.TrimSpace(*req.Details)
+		details = &trimmed
+	}
+	if req.Reason == config.ReviewReportOther && details == nil {
+		return nil, ErrReportDetailsRequired
+	}
+
+	// Unpublished reviews are not visible to report
+	review, err := s.reviews.FindByID(ctx, reviewID)
+	if err != nil {
+		return nil, err
+	}
+	if !review.IsPublished {
+		return nil, repository.ErrReviewNotFound
+	}
+	if review.CustomerID != nil && *review.CustomerID == userID {
+		return nil, fmt.Errorf("you cannot report your own review")
+	}
+
+	report := &models.ReviewReport{
+		ReviewID:   reviewID,
+		ReporterID: &userID,
+		Reason:     req.Reason,
+		Details:    details,
+	}
+	notes := fmt.Sprintf("Flagged after %d user reports", s.threshold)
+	flagged, err := s.reports.Create(ctx, report, s.threshold, notes)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("Review reported").
+		Int("review_id", reviewID).
+		Int("reporter_id", userID).
+		Str("reason", req.Reason).
+		Bool("flagged", flagged).
+		Send()
+
+	// A flagged review leaves the barber's public reviews and ratings
+	if flagged {
+		if s.barbers != nil {
+			if err := s.barbers.UpdateRatingStats(ctx, review.BarberID); err != nil {
+				log.Warn("Failed to refresh barber rating stats").
+					Int("barber_id", review.BarberID).
+					Err(err).
+					Send()
+			}
+		}
+		if s.cache != nil {
+			_ = s.cache.InvalidateBarber(ctx, review.BarberID)
+		}
+	}
+	return report, nil
+}
+
+// ListReports returns a review's reports, newest first (moderators only)
+func (s *ReviewReportService) ListReports(ctx context.Context, reviewID int) ([]models.ReviewReport, error) {
+	if _, err := s.reviews.FindByID(ctx, reviewID); err != nil {
+		return nil, err
+	}
+	return s.reports.FindByReviewID(ctx, reviewID)
+}
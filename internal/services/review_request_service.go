@@ -0,0 +1,286 @@
+// internal/services/review_request_service.go
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// REVIEW REQUEST SERVICE - Asking for a review after the appointment
+// ========================================================================
+//
+// Completing a booking schedules a review request for a while later (two
+// hours by default), when the customer has left the chair but still
+// remembers the visit. The worker sends requests as they fall due, and
+// skips those whose booking has been reviewed in the meantime. The link in
+// the request carries a token that lets the customer write their review
+// without logging in; it works once. Guest bookings have no account to
+// notify and are not asked.
+// ========================================================================
+
+// reviewRequestBatchSize caps the requests sent per worker run
+const reviewRequestBatchSize = 100
+
+// ReviewRequestService schedules and sends review requests and takes the
+// reviews written from their links
+type ReviewRequestService struct {
+	repo                *repository.ReviewRequestRepository
+	bookingRepo         repository.BookingStore
+	reviewRepo          repository.ReviewStore
+	reviewService       *ReviewService
+	notificationService *NotificationService
+	clock               clock.Clock
+	config              config.ReviewRequestConfig
+}
+
+// NewReviewRequestService creates a new review request service. Unset
+// settings fall back to the defaults.
+func NewReviewRequestService(
+	repo *repository.ReviewRequestRepository,
+	bookingRepo repository.BookingStore,
+	reviewRepo repository.ReviewStore,
+	reviewService *ReviewService,
+	notificationService *NotificationService,
+	cfg config.ReviewRequestConfig,
+) *ReviewRequestService {
+	if cfg.Delay < 0 {
+		cfg.Delay = config.DefaultReviewRequestDelay
+	}
+	if cfg.ResponseWindowDays <= 0 {
+		cfg.ResponseWindowDays = config.DefaultReviewRequestWindowDays
+	}
+	return &ReviewRequestService{
+		repo:                repo,
+		bookingRepo:         bookingRepo,
+		reviewRepo:          reviewRepo,
+		reviewService:       reviewService,
+		notificationService: notificationService,
+		clock:               clock.System,
+		config:              cfg,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *ReviewRequestService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// ReviewLinkInfo describes the booking a review link is for, so the review
+// form can be shown before the customer writes anything
+type ReviewLinkInfo struct {
+	BookingID          int       `json:"booking_id"`
+	BookingNumber      string    `json:"booking_number"`
+	BarberID           int       `json:"barber_id"`
+	ServiceName        string    `json:"service_name"`
+	ScheduledStartTime time.Time `json:"scheduled_start_time"`
+	ExpiresAt          time.Time `json:"expires_at"`
+}
+
+// ========================================================================
+// SCHEDULING
+// ========================================================================
+
+// RequestHook is the review_request booking status action
+func (s *ReviewRequestService) RequestHook(ctx context.Context, booking *models.Booking, _, _ string) error {
+	return s.Schedule(ctx, booking)
+}
+
+// Schedule records a review request for a completed customer booking, due
+// once the configured delay has passed. A booking is asked at most once.
+func (s *ReviewRequestService) Schedule(ctx context.Context, booking *models.Booking) error {
+	if booking.CustomerID == nil || booking.Status != config.BookingStatusCompleted || booking.IsTest {
+		return nil
+	}
+
+	token, err := newReviewRequestToken()
+	if err != nil {
+		return err
+	}
+	sendAt := s.clock.Now().Add(s.config.Delay)
+	request := &models.ReviewRequest{
+		Token:      token,
+		BookingID:  booking.ID,
+		CustomerID: *booking.CustomerID,
+		SendAt:     sendAt,
+		ExpiresAt:  sendAt.AddDate(0, 0, s.config.ResponseWindowDays),
+	}
+
+	created, err := s.repo.Create(ctx, request)
+	if err != nil || !created {
+		return err
+	}
+
+	logger.FromContext(ctx).Debug("Review request scheduled").
+		Int("booking_id", booking.ID).
+		Str("send_at", sendAt.Format(time.RFC3339)).
+		Send()
+	return nil
+}
+
+// newReviewRequestToken returns a random URL-safe review link token
+func newReviewRequestToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate review request token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ========================================================================
+// DISPATCH
+// ========================================================================
+
+// RunScheduled sends the review requests that have fallen due. A failure
+// for one request is logged and skipped so the rest still go out; the
+// failed request is retried on the next run.
+func (s *ReviewRequestService) RunScheduled(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+	now := s.clock.Now()
+
+	requests, err := s.repo.FindDue(ctx, now, reviewRequestBatchSize)
+	if err != nil {
+		return err
+	}
+
+	sent, skipped := 0, 0
+	for i := range requests {
+		request := &requests[i]
+		ok, err := s.send(ctx, request, now)
+		if err != nil {
+			log.Warn("Failed to send review request").
+				Int("booking_id", request.BookingID).
+				Err(err).
+				Send()
+			continue
+		}
+		if ok {
+			sent++
+		} else {
+			skipped++
+		}
+	}
+
+	if sent > 0 || skipped > 0 {
+		log.Info("Review requests processed").
+			Int("sent", sent).
+			Int("skipped", skipped).
+			Send()
+	}
+	return nil
+}
+
+// send sends one request, or skips it when the booking has been reviewed
+// or is no longer completed. It reports whether the request went out.
+func (s *ReviewRequestService) send(ctx context.Context, request *models.ReviewRequest, now time.Time) (bool, error) {
+	reviewed, err := s.reviewRepo.ExistsByBookingID(ctx, request.BookingID)
+	if err != nil {
+		return false, err
+	}
+
+	booking, err := s.bookingRepo.FindByID(ctx, request.BookingID)
+	if err != nil {
+		return false, err
+	}
+
+	if reviewed || booking.Status != config.BookingStatusCompleted {
+		return false, s.repo.MarkSkipped(ctx, request.ID, now)
+	}
+
+	if err := s.notificationService.SendReviewLink(ctx, booking, request); err != nil {
+		return false, err
+	}
+	return true, s.repo.MarkSent(ctx, request.ID, now)
+}
+
+// ========================================================================
+// REVIEW LINKS
+// ========================================================================
+
+// GetLink describes the booking an open review link is for
+func (s *ReviewRequestService) GetLink(ctx context.Context, token string) (*ReviewLinkInfo, error) {
+	request, err := s.openRequest(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	booking, err := s.bookingRepo.FindByID(ctx, request.BookingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReviewLinkInfo{
+		BookingID:          booking.ID,
+		BookingNumber:      booking.BookingNumber,
+		BarberID:           booking.BarberID,
+		ServiceName:        booking.ServiceName,
+		ScheduledStartTime: booking.ScheduledStartTime,
+		ExpiresAt:          request.ExpiresAt,
+	}, nil
+}
+
+// SubmitReview writes the review for the booking a link is for, as the
+// booking's customer. The link is used up by the review; if the review
+// cannot be written the link stays open.
+func (s *ReviewRequestService) SubmitReview(ctx context.Context, token string, req CreateReviewRequest) (*ReviewResponse, error) {
+	request, err := s.openRequest(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if req.BookingID != request.BookingID {
+		return nil, fmt.Errorf("booking_id must be the booking of the review link (%d)", request.BookingID)
+	}
+
+	if err := s.repo.Claim(ctx, request.ID, s.clock.Now()); err != nil {
+		return nil, err
+	}
+
+	review, err := s.reviewService.CreateReview(ctx, req, request.CustomerID)
+	if err != nil {
+		if releaseErr := s.repo.Release(ctx, request.ID); releaseErr != nil {
+			logger.FromContext(ctx).Warn("Failed to reopen review link").
+				Int("booking_id", request.BookingID).
+				Err(releaseErr).
+				Send()
+		}
+		return nil, err
+	}
+
+	if err := s.repo.SetReview(ctx, request.ID, review.ID); err != nil {
+		logger.FromContext(ctx).Warn("Failed to link review to its request").
+			Int("booking_id", request.BookingID).
+			Int("review_id", review.ID).
+			Err(err).
+			Send()
+	}
+	return review, nil
+}
+
+// openRequest returns the request for token if its link can still be used
+func (s *ReviewRequestService) openRequest(ctx context.Context, token string) (*models.ReviewRequest, error) {
+	request, err := s.repo.FindByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if request.SentAt == nil {
+		// Links are only handed out by sending the request
+		return nil, repository.ErrReviewRequestNotFound
+	}
+	if !request.IsOpen(s.clock.Now()) {
+		return nil, repository.ErrReviewRequestClosed
+	}
+	return request, nil
+}
@@ -0,0 +1,229 @@
+// internal/services/review_response_moderation.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// REVIEW RESPONSE MODERATION - Barbers' replies to reviews
+// ========================================================================
+//
+// A barber's response runs through the same screening as a review
+// (profanity, personal information, links). Responses with findings are
+// flagged for moderators; the others go live, or wait as pending when
+// auto-publishing is off. Moderators approve or reject waiting responses
+// and can redact live ones. Rejected and redacted responses come down, the
+// barber is told why, and they may respond again.
+// ========================================================================
+
+// responseModerationReasons are the reason templates for rejecting or
+// redacting barber responses, in the order moderators see them
+var responseModerationReasons = []ModerationReason{
+	{
+		Key:     "offensive_language",
+		Label:   "Offensive language",
+		Message: "The response contains abusive, hateful or discriminatory language.",
+		Actions: []string{config.ModerationActionReject, config.ModerationActionRedact},
+	},
+	{
+		Key:     "personal_information",
+		Label:   "Personal information",
+		Message: "The response shares the customer's or someone else's contact details or other personal information.",
+		Actions: []string{config.ModerationActionReject, config.ModerationActionRedact},
+	},
+	{
+		Key:     "spam",
+		Label:   "Spam or advertising",
+		Message: "The response advertises or links to unrelated content.",
+		Actions: []string{config.ModerationActionReject, config.ModerationActionRedact},
+	},
+	{
+		Key:     "off_topic",
+		Label:   "Off topic",
+		Message: "The response does not address the review.",
+		Actions: []string{config.ModerationActionReject},
+	},
+}
+
+// responseActionStatus maps response moderation actions to the statuses
+// they set
+var responseActionStatus = map[string]string{
+	config.ModerationActionApprove: config.ReviewModerationApproved,
+	config.ModerationActionReject:  config.ReviewModerationRejected,
+	config.ModerationActionRedact:  config.ReviewResponseRedacted,
+}
+
+// responseActionFrom lists the response statuses each action applies to:
+// waiting responses are approved or rejected, live ones redacted
+var responseActionFrom = map[string][]string{
+	config.ModerationActionApprove: {config.ReviewModerationPending, config.ReviewModerationFlagged},
+	config.ModerationActionReject:  {config.ReviewModerationPending, config.ReviewModerationFlagged},
+	config.ModerationActionRedact:  {config.ReviewModerationApproved},
+}
+
+// SetNotifications tells barbers when their responses are rejected or
+// redacted (nil disables it)
+func (s *ReviewService) SetNotifications(notifications *NotificationService) {
+	s.notifications = notifications
+}
+
+// screenResponse runs a barber response through screening and returns the
+// moderation status it is saved with, and the findings as notes
+func (s *ReviewService) screenResponse(ctx context.Context, review *models.Review, response string) (string, *string) {
+	if s.screener == nil || !s.screener.Config().Enabled {
+		return config.ReviewModerationPending, nil
+	}
+
+	findings := s.screener.CheckText(response)
+	if len(findings) > 0 {
+		notes := make([]string, len(findings))
+		checks := make([]string, len(findings))
+		for i, finding := range findings {
+			notes[i] = finding.String()
+			checks[i] = finding.Check
+		}
+		combined := "Flagged by automatic screening:\n" + strings.Join(notes, "\n")
+
+		logger.FromContext(ctx).Info("Review response flagged by screening").
+			Int("review_id", review.ID).
+			Int("barber_id", review.BarberID).
+			Strs("checks", checks).
+			Send()
+		return config.ReviewModerationFlagged, &combined
+	}
+
+	if s.screener.Config().AutoPublish {
+		return config.ReviewModerationApproved, nil
+	}
+	return config.ReviewModerationPending, nil
+}
+
+// ResponseModerationReasons returns the reason templates for barber
+// responses
+func (s *ReviewService) ResponseModerationReasons() []ModerationReason {
+	return responseModerationReasons
+}
+
+// GetResponseModerationQueue retrieves a page of reviews by the moderation
+// status of their barber response (pending by default), oldest first
+// unless sorted otherwise, with the total number of matching reviews
+func (s *ReviewService) GetResponseModerationQueue(ctx context.Context, filters repository.ReviewFilters) ([]ReviewResponse, int, error) {
+	if filters.ResponseStatus == "" {
+		filters.ResponseStatus = config.ReviewModerationPending
+	}
+	if !repository.IsValidResponseModerationStatus(filters.ResponseStatus) {
+		return nil, 0, fmt.Errorf("response_status must be one of %s", strings.Join(repository.ValidResponseModerationStatuses, ", "))
+	}
+	if filters.SortBy == "" {
+		filters.SortBy = "created_at"
+		filters.Order = "ASC"
+	}
+
+	reviews, err := s.repo.FindAll(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	responses := make([]ReviewResponse, len(reviews))
+	for i, review := range reviews {
+		responses[i] = *s.toReviewResponse(&review, nil)
+		responses[i].PendingResponse = review.PendingBarberResponse
+		responses[i].ResponseNotes = review.BarberResponseNotes
+	}
+	if err := repository.Hydrate(ctx, s.relations, filters.Include(), responses); err != nil {
+		return nil, 0, err
+	}
+	return responses, total, nil
+}
+
+// ModerateResponse approves, rejects or redacts the barber response to a
+// review. Rejecting and redacting need one of the response reasons, and
+// tell the barber.
+func (s *ReviewService) ModerateResponse(ctx context.Context, id int, action string, req ModerationActionRequest, moderatorID int) (*ReviewResponse, error) {
+	status, ok := responseActionStatus[action]
+	if !ok {
+		return nil, fmt.Errorf("action must be one of %s, %s or %s",
+			config.ModerationActionApprove, config.ModerationActionReject, config.ModerationActionRedact)
+	}
+	notes, err := moderationNotes(responseModerationReasons, action, req.Reason, req.Notes)
+	if err != nil {
+		return nil, err
+	}
+
+	review, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	oldStatus := review.ResponseStatus()
+	if oldStatus == "" {
+		return nil, fmt.Errorf("cannot moderate: the review has no barber response")
+	}
+	if !slices.Contains(responseActionFrom[action], oldStatus) {
+		return nil, fmt.Errorf("cannot %s a response that is %s", action, oldStatus)
+	}
+
+	if err := s.repo.ModerateBarberResponse(ctx, id, status, moderatorID, notes); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Review response moderated").
+		Int("review_id", id).
+		Int("moderator_id", moderatorID).
+		Str("old_status", oldStatus).
+		Str("new_status", status).
+		Send()
+
+	s.audit.RecordChange(ctx, config.AuditActionResponseModerated, config.EntityTypeReview, &id,
+		models.JSONMap{"barber_response_status": oldStatus, "barber_response_notes": review.BarberResponseNotes},
+		models.JSONMap{"barber_response_status": status, "barber_response_notes": notes},
+		models.JSONMap{"barber_id": review.BarberID})
+
+	updated, err := s.GetReviewByID(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	updated.PendingResponse = updated.PendingBarberResponse
+	updated.ResponseNotes = updated.BarberResponseNotes
+
+	if status == config.ReviewModerationApproved {
+		s.publishReviewEvent(ctx, config.WebhookEventReviewResponded, updated.Review)
+	} else {
+		s.notifyResponseRejected(ctx, updated.Review, status, notes)
+	}
+	return updated, nil
+}
+
+// notifyResponseRejected tells the barber their response was rejected or
+// redacted. Best-effort: a failure is logged and never fails moderation.
+func (s *ReviewService) notifyResponseRejected(ctx context.Context, review *models.Review, status string, notes *string) {
+	if s.notifications == nil {
+		return
+	}
+	log := logger.FromContext(ctx)
+
+	barber, err := s.barberRepo.FindByID(ctx, review.BarberID)
+	if err == nil {
+		err = s.notifications.SendReviewResponseRejected(ctx, barber.UserID, review, status, notes)
+	}
+	if err != nil {
+		log.Warn("Failed to notify barber of rejected review response").
+			Int("review_id", review.ID).
+			Int("barber_id", review.BarberID).
+			Err(err).
+			Send()
+	}
+}
@@ -0,0 +1,128 @@
+// internal/services/review_screening.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/screening"
+)
+
+// ========================================================================
+// REVIEW SCREENING - Automatic checks before a review is published
+// ========================================================================
+//
+// New reviews run through the screener's text checks (profanity, links)
+// and two checks against other reviews: a comment matching a recent one,
+// and a barber getting a burst of reviews without any text. Reviews with
+// findings are flagged for the moderation queue with the findings as
+// notes; the others are published, or left pending when auto-publishing
+// is off. A check that cannot run leaves the review pending.
+// ========================================================================
+
+// SetScreening screens new reviews before they are saved (nil disables
+// screening; reviews then wait in the pending queue)
+func (s *ReviewService) SetScreening(screener *screening.Screener) {
+	s.screener = screener
+}
+
+// screenReview runs a new review through screening and sets the moderation
+// status it is saved with
+func (s *ReviewService) screenReview(ctx context.Context, review *models.Review) {
+	if s.screener == nil || !s.screener.Config().Enabled {
+		return
+	}
+	log := logger.FromContext(ctx)
+
+	findings, err := s.reviewFindings(ctx, review)
+	if err != nil {
+		log.Warn("Review screening failed; leaving review pending").
+			Int("booking_id", review.BookingID).
+			Err(err).
+			Send()
+		return
+	}
+
+	now := time.Now()
+	if len(findings) > 0 {
+		notes := make([]string, len(findings))
+		checks := make([]string, len(findings))
+		for i, finding := range findings {
+			notes[i] = finding.String()
+			checks[i] = finding.Check
+		}
+		combined := "Flagged by automatic screening:\n" + strings.Join(notes, "\n")
+
+		review.ModerationStatus = config.ReviewModerationFlagged
+		review.ModerationNotes = &combined
+		review.ModeratedAt = &now
+		review.IsPublished = false
+
+		log.Info("Review flagged by screening").
+			Int("booking_id", review.BookingID).
+			Int("barber_id", review.BarberID).
+			Strs("checks", checks).
+			Send()
+		return
+	}
+
+	if s.screener.Config().AutoPublish {
+		review.ModerationStatus = config.ReviewModerationApproved
+		review.ModeratedAt = &now
+		review.IsPublished = true
+	}
+}
+
+// reviewFindings runs every check on a review
+func (s *ReviewService) reviewFindings(ctx context.Context, review *models.Review) ([]screening.Finding, error) {
+	cfg := s.screener.Config()
+
+	var parts []string
+	for _, field := range []*string{review.Title, review.Comment, review.Pros, review.Cons} {
+		if !isBlank(field) {
+			parts = append(parts, *field)
+		}
+	}
+	findings := s.screener.CheckText(strings.Join(parts, "\n"))
+
+	if review.Comment != nil {
+		comment := screening.NormalizeText(*review.Comment)
+		if len(comment) >= cfg.DuplicateMinLength {
+			matches, err := s.repo.CountMatchingComments(ctx, comment, time.Now().Add(-cfg.DuplicateWindow))
+			if err != nil {
+				return nil, err
+			}
+			if matches > 0 {
+				findings = append(findings, screening.Finding{
+					Check:  screening.CheckDuplicateText,
+					Detail: fmt.Sprintf("comment matches %d other recent review(s)", matches),
+				})
+			}
+		}
+	}
+
+	if isBlank(review.Title) && isBlank(review.Comment) && cfg.RatingOnlyLimit > 0 {
+		recent, err := s.repo.CountRatingOnly(ctx, review.BarberID, time.Now().Add(-cfg.RatingOnlyWindow))
+		if err != nil {
+			return nil, err
+		}
+		if recent >= cfg.RatingOnlyLimit {
+			findings = append(findings, screening.Finding{
+				Check:  screening.CheckRatingOnlyFlood,
+				Detail: fmt.Sprintf("barber got %d reviews without text in a short time", recent+1),
+			})
+		}
+	}
+
+	return findings, nil
+}
+
+// isBlank reports whether an optional text field is missing or whitespace
+func isBlank(text *string) bool {
+	return text == nil || strings.TrimSpace(*text) == ""
+}
@@ -4,12 +4,14 @@ package services
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"barber-booking-system/internal/cache"
 	"barber-booking-system/internal/config"
 	"barber-booking-system/internal/logger"
 	"barber-booking-system/internal/models"
 	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/screening"
 )
 
 // ========================================================================
@@ -18,17 +20,37 @@ import (
 
 // ReviewService handles review business logic
 type ReviewService struct {
-	repo        *repository.ReviewRepository
-	bookingRepo *repository.BookingRepository
-	barberRepo  *repository.BarberRepository
+	repo        repository.ReviewStore
+	bookingRepo repository.BookingStore
+	barberRepo  repository.BarberStore
 	cache       *cache.CacheService
+
+	// Review events for barbers' webhooks (optional)
+	webhooks WebhookPublisher
+
+	// Moderation decisions for the audit log (optional)
+	audit *AuditService
+
+	// Relations attached to lists (optional)
+	relations *repository.RelationLoader
+
+	// Spam and profanity checks for new reviews (optional)
+	screener *screening.Screener
+
+	// Tells barbers their responses were rejected (optional)
+	notifications *NotificationService
+}
+
+// WebhookPublisher queues events for a barber's webhook subscriptions
+type WebhookPublisher interface {
+	Publish(ctx context.Context, barberID int, eventType string, data interface{}) error
 }
 
 // NewReviewService creates a new review service
 func NewReviewService(
-	repo *repository.ReviewRepository,
-	bookingRepo *repository.BookingRepository,
-	barberRepo *repository.BarberRepository,
+	repo repository.ReviewStore,
+	bookingRepo repository.BookingStore,
+	barberRepo repository.BarberStore,
 	cache *cache.CacheService,
 ) *ReviewService {
 	return &ReviewService{
@@ -39,6 +61,16 @@ func NewReviewService(
 	}
 }
 
+// SetWebhooks enables review.* webhook events (nil disables them)
+func (s *ReviewService) SetWebhooks(publisher WebhookPublisher) {
+	s.webhooks = publisher
+}
+
+// SetAuditor records moderation decisions in the audit log
+func (s *ReviewService) SetAuditor(audit *AuditService) {
+	s.audit = audit
+}
+
 // ========================================================================
 // REQUEST/RESPONSE STRUCTS
 // ========================================================================
@@ -119,6 +151,15 @@ type ReviewResponse struct {
 	CanRespond       bool    `json:"can_respond"`
 	CustomerName     string  `json:"customer_name,omitempty"`
 	BarberName       string  `json:"barber_name,omitempty"`
+
+	// The barber's response as moderation sees it (the barber and
+	// moderators only; see review_response_moderation.go)
+	PendingResponse *string `json:"pending_response,omitempty"`
+	ResponseNotes   *string `json:"response_moderation_notes,omitempty"`
+
+	// Relations attached to lists on request (see relations.go)
+	Customer *models.CustomerSummary `json:"customer,omitempty"`
+	Barber   *models.BarberSummary   `json:"barber,omitempty"`
 }
 
 // ReviewStatsResponse wraps stats with additional info
@@ -127,10 +168,75 @@ type ReviewStatsResponse struct {
 	RatingDistribution map[int]int `json:"rating_distribution"`
 }
 
+// ReviewEventData is the data of review webhook events: what a barber's
+// site needs to show the review as a testimonial. Customers are not named.
+type ReviewEventData struct {
+	ReviewID              int        `json:"review_id"`
+	BarberID              int        `json:"barber_id"`
+	OverallRating         int        `json:"overall_rating"`
+	ServiceQualityRating  *int       `json:"service_quality_rating,omitempty"`
+	PunctualityRating     *int       `json:"punctuality_rating,omitempty"`
+	CleanlinessRating     *int       `json:"cleanliness_rating,omitempty"`
+	ValueForMoneyRating   *int       `json:"value_for_money_rating,omitempty"`
+	ProfessionalismRating *int       `json:"professionalism_rating,omitempty"`
+	Title                 *string    `json:"title,omitempty"`
+	Comment               *string    `json:"comment,omitempty"`
+	Pros                  *string    `json:"pros,omitempty"`
+	Cons                  *string    `json:"cons,omitempty"`
+	WouldRecommend        *bool      `json:"would_recommend,omitempty"`
+	Images                []string   `json:"images"`
+	IsVerified            bool       `json:"is_verified"`
+	IsPublished           bool       `json:"is_published"`
+	BarberResponse        *string    `json:"barber_response,omitempty"`
+	BarberResponseAt      *time.Time `json:"barber_response_at,omitempty"`
+	CreatedAt             time.Time  `json:"created_at"`
+}
+
 // ========================================================================
 // HELPER FUNCTIONS
 // ========================================================================
 
+// publishReviewEvent queues a review webhook event. Best-effort: a failure
+// is logged and never fails the review change.
+func (s *ReviewService) publishReviewEvent(ctx context.Context, eventType string, review *models.Review) {
+	if s.webhooks == nil {
+		return
+	}
+
+	images := []string(review.Images)
+	if images == nil {
+		images = []string{}
+	}
+	data := ReviewEventData{
+		ReviewID:              review.ID,
+		BarberID:              review.BarberID,
+		OverallRating:         review.OverallRating,
+		ServiceQualityRating:  review.ServiceQualityRating,
+		PunctualityRating:     review.PunctualityRating,
+		CleanlinessRating:     review.CleanlinessRating,
+		ValueForMoneyRating:   review.ValueForMoneyRating,
+		ProfessionalismRating: review.ProfessionalismRating,
+		Title:                 review.Title,
+		Comment:               review.Comment,
+		Pros:                  review.Pros,
+		Cons:                  review.Cons,
+		WouldRecommend:        review.WouldRecommend,
+		Images:                images,
+		IsVerified:            review.IsVerified,
+		IsPublished:           review.IsPublished,
+		BarberResponse:        review.BarberResponse,
+		BarberResponseAt:      review.BarberResponseAt,
+		CreatedAt:             review.CreatedAt,
+	}
+	if err := s.webhooks.Publish(ctx, review.BarberID, eventType, data); err != nil {
+		logger.FromContext(ctx).Warn("Failed to queue review webhook").
+			Int("review_id", review.ID).
+			Str("event", eventType).
+			Err(err).
+			Send()
+	}
+}
+
 // validateRating validates a rating value
 func validateRating(rating int) error {
 	if rating < int(config.MinRating) || rating > int(config.MaxRating) {
@@ -275,7 +381,10 @@ func (s *ReviewService) CreateReview(ctx context.Context, req CreateReviewReques
 		ModerationStatus: config.ReviewModerationPending,
 	}
 
-	// Step 8: Save review
+	// Step 8: Screen for spam and profanity; suspicious reviews are flagged
+	s.screenReview(ctx, review)
+
+	// Step 9: Save review
 	if err := s.repo.Create(ctx, review); err != nil {
 		log.Error(err).
 			Int("booking_id", req.BookingID).
@@ -283,7 +392,7 @@ func (s *ReviewService) CreateReview(ctx context.Context, req CreateReviewReques
 		return nil, err
 	}
 
-	// Step 9: Invalidate barber cache
+	// Step 10: Invalidate barber cache
 	if s.cache != nil {
 		_ = s.cache.InvalidateBarber(ctx, booking.BarberID)
 	}
@@ -293,8 +402,14 @@ func (s *ReviewService) CreateReview(ctx context.Context, req CreateReviewReques
 		Int("booking_id", req.BookingID).
 		Int("barber_id", booking.BarberID).
 		Int("rating", req.OverallRating).
+		Str("moderation_status", review.ModerationStatus).
 		Send()
 
+	s.publishReviewEvent(ctx, config.WebhookEventReviewCreated, review)
+	if review.IsPublished {
+		s.publishReviewEvent(ctx, config.WebhookEventReviewApproved, review)
+	}
+
 	return s.toReviewResponse(review, &customerID), nil
 }
 
@@ -333,6 +448,21 @@ func (s *ReviewService) saveReviewWithStatsUpdate(ctx context.Context, review *m
 	return nil
 }
 
+// refreshRatingStats recalculates a barber's rating stats after one of
+// their reviews was published or unpublished. A failure is only logged;
+// the nightly stats job reconciles ratings that were missed.
+func (s *ReviewService) refreshRatingStats(ctx context.Context, barberID int) {
+	if err := s.barberRepo.UpdateRatingStats(ctx, barberID); err != nil {
+		logger.FromContext(ctx).Warn("Failed to refresh barber rating stats").
+			Int("barber_id", barberID).
+			Err(err).
+			Send()
+	}
+	if s.cache != nil {
+		_ = s.cache.InvalidateBarber(ctx, barberID)
+	}
+}
+
 // ========================================================================
 // READ OPERATIONS
 // ========================================================================
@@ -355,8 +485,9 @@ func (s *ReviewService) GetReviewByBookingID(ctx context.Context, bookingID int,
 	return s.toReviewResponse(review, userID), nil
 }
 
-// GetBarberReviews retrieves all published reviews for a barber
-func (s *ReviewService) GetBarberReviews(ctx context.Context, barberID int, filters repository.ReviewFilters) ([]ReviewResponse, error) {
+// GetBarberReviews retrieves a page of published reviews for a barber, with
+// the total number of matching reviews
+func (s *ReviewService) GetBarberReviews(ctx context.Context, barberID int, filters repository.ReviewFilters) ([]ReviewResponse, int, error) {
 	// Only show published and approved reviews to the public
 	isPublished := true
 	filters.IsPublished = &isPublished
@@ -364,44 +495,72 @@ func (s *ReviewService) GetBarberReviews(ctx context.Context, barberID int, filt
 
 	reviews, err := s.repo.FindByBarberID(ctx, barberID, filters)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	filters.BarberID = barberID
+	total, err := s.repo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	responses := make([]ReviewResponse, len(reviews))
 	for i, review := range reviews {
 		responses[i] = *s.toReviewResponse(&review, nil)
 	}
-	return responses, nil
+	if err := repository.Hydrate(ctx, s.relations, filters.Include(), responses); err != nil {
+		return nil, 0, err
+	}
+	return responses, total, nil
 }
 
-// GetCustomerReviews retrieves all reviews by a customer
-func (s *ReviewService) GetCustomerReviews(ctx context.Context, customerID int, filters repository.ReviewFilters) ([]ReviewResponse, error) {
+// GetCustomerReviews retrieves a page of reviews by a customer, with the total
+// number of matching reviews
+func (s *ReviewService) GetCustomerReviews(ctx context.Context, customerID int, filters repository.ReviewFilters) ([]ReviewResponse, int, error) {
 	reviews, err := s.repo.FindByCustomerID(ctx, customerID, filters)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	filters.CustomerID = customerID
+	total, err := s.repo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	responses := make([]ReviewResponse, len(reviews))
 	for i, review := range reviews {
 		responses[i] = *s.toReviewResponse(&review, &customerID)
 	}
-	return responses, nil
+	if err := repository.Hydrate(ctx, s.relations, filters.Include(), responses); err != nil {
+		return nil, 0, err
+	}
+	return responses, total, nil
 }
 
-// GetPendingReviews retrieves reviews pending moderation (admin only)
-func (s *ReviewService) GetPendingReviews(ctx context.Context, filters repository.ReviewFilters) ([]ReviewResponse, error) {
+// GetPendingReviews retrieves a page of reviews pending moderation (admin
+// only), with the total number of matching reviews
+func (s *ReviewService) GetPendingReviews(ctx context.Context, filters repository.ReviewFilters) ([]ReviewResponse, int, error) {
 	filters.ModerationStatus = config.ReviewModerationPending
 
 	reviews, err := s.repo.FindAll(ctx, filters)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
+	}
+
+	total, err := s.repo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, err
 	}
 
 	responses := make([]ReviewResponse, len(reviews))
 	for i, review := range reviews {
 		responses[i] = *s.toReviewResponse(&review, nil)
 	}
-	return responses, nil
+	if err := repository.Hydrate(ctx, s.relations, filters.Include(), responses); err != nil {
+		return nil, 0, err
+	}
+	return responses, total, nil
 }
 
 // ========================================================================
@@ -544,9 +703,9 @@ func (s *ReviewService) ModerateReview(ctx context.Context, id int, req Moderate
 		return nil, err
 	}
 
-	// Invalidate barber cache if review is now published
-	if req.Status == config.ReviewModerationApproved && s.cache != nil {
-		_ = s.cache.InvalidateBarber(ctx, review.BarberID)
+	// Ratings change when the review enters or leaves the published set
+	if review.IsPublished || req.Status == config.ReviewModerationApproved {
+		s.refreshRatingStats(ctx, review.BarberID)
 	}
 
 	log.Info("Review moderated successfully").
@@ -556,11 +715,25 @@ func (s *ReviewService) ModerateReview(ctx context.Context, id int, req Moderate
 		Int("barber_id", review.BarberID).
 		Send()
 
+	s.audit.RecordChange(ctx, config.AuditActionReviewModerated, config.EntityTypeReview, &id,
+		models.JSONMap{"moderation_status": oldStatus, "moderation_notes": review.ModerationNotes},
+		models.JSONMap{"moderation_status": req.Status, "moderation_notes": req.Notes},
+		models.JSONMap{"barber_id": review.BarberID})
+
 	// Fetch updated review
-	return s.GetReviewByID(ctx, id, nil)
+	updated, err := s.GetReviewByID(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	if req.Status == config.ReviewModerationApproved && oldStatus != config.ReviewModerationApproved {
+		s.publishReviewEvent(ctx, config.WebhookEventReviewApproved, updated.Review)
+	}
+	return updated, nil
 }
 
-// AddBarberResponse allows a barber to respond to a review
+// AddBarberResponse allows a barber to respond to a review. The response
+// is screened like a review: it goes live when it passes and auto-publishing
+// is on, and otherwise waits for a moderator.
 func (s *ReviewService) AddBarberResponse(ctx context.Context, id int, req BarberResponseRequest, barberUserID int) (*ReviewResponse, error) {
 	// Get existing review
 	review, err := s.repo.FindByID(ctx, id)
@@ -578,18 +751,29 @@ func (s *ReviewService) AddBarberResponse(ctx context.Context, id int, req Barbe
 		return nil, fmt.Errorf("you can only respond to your own reviews")
 	}
 
-	// Check if already responded
-	if review.BarberResponse != nil && *review.BarberResponse != "" {
+	// Check if already responded (a rejected or removed response can be
+	// replaced)
+	if !review.CanAddResponse() {
 		return nil, fmt.Errorf("you have already responded to this review")
 	}
 
 	// Add response
-	if err := s.repo.AddBarberResponse(ctx, id, req.Response); err != nil {
+	status, notes := s.screenResponse(ctx, review, req.Response)
+	if err := s.repo.AddBarberResponse(ctx, id, req.Response, status, notes); err != nil {
 		return nil, err
 	}
 
 	// Fetch updated review
-	return s.GetReviewByID(ctx, id, nil)
+	updated, err := s.GetReviewByID(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	updated.PendingResponse = updated.PendingBarberResponse
+	updated.ResponseNotes = updated.BarberResponseNotes
+	if status == config.ReviewModerationApproved {
+		s.publishReviewEvent(ctx, config.WebhookEventReviewResponded, updated.Review)
+	}
+	return updated, nil
 }
 
 // VoteReview allows users to vote on review helpfulness
@@ -641,8 +825,10 @@ func (s *ReviewService) DeleteReview(ctx context.Context, id int, userID int, is
 		return err
 	}
 
-	// Invalidate barber cache
-	if s.cache != nil {
+	// Invalidate barber cache, recalculating ratings if it was published
+	if review.IsPublished {
+		s.refreshRatingStats(ctx, review.BarberID)
+	} else if s.cache != nil {
 		_ = s.cache.InvalidateBarber(ctx, review.BarberID)
 	}
 
@@ -0,0 +1,351 @@
+// internal/services/role_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// ROLE SERVICE - Role-based access control
+// ========================================================================
+//
+// Every account holds the system role named after its user type (customer,
+// barber or admin), which is what its JWT carries. Admins can define more
+// roles, each a set of permissions such as bookings:write or
+// reviews:moderate, and assign them to individual users. A user's
+// permissions are the union of their system role and assigned roles; the
+// admin role holds "*", which grants everything.
+//
+// Role definitions and assignments are cached for config.RBACCacheTTL, so
+// changes take effect on this instance immediately and on others within
+// the TTL.
+// ========================================================================
+
+// roleNamePattern restricts custom role names to lowercase identifiers
+var roleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{1,49}$`)
+
+// RoleService manages roles and resolves user permissions
+type RoleService struct {
+	repo     *repository.RoleRepository
+	userRepo repository.UserStore
+	audit    *AuditService
+	clock    clock.Clock
+
+	mu            sync.Mutex
+	roles         map[string][]string // Permissions by role name
+	rolesLoadedAt time.Time
+	userRoles     map[int]cachedUserRoles
+}
+
+// cachedUserRoles is a user's assigned role names as last loaded
+type cachedUserRoles struct {
+	roles    []string
+	loadedAt time.Time
+}
+
+// NewRoleService creates a new role service
+func NewRoleService(repo *repository.RoleRepository, userRepo repository.UserStore) *RoleService {
+	return &RoleService{
+		repo:      repo,
+		userRepo:  userRepo,
+		clock:     clock.System,
+		userRoles: make(map[int]cachedUserRoles),
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *RoleService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// SetAuditor records role and assignment changes in the audit log
+func (s *RoleService) SetAuditor(audit *AuditService) {
+	s.audit = audit
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// CreateRoleRequest defines a custom role
+type CreateRoleRequest struct {
+	Name        string   `json:"name" binding:"required" example:"moderator"`
+	Description *string  `json:"description" binding:"omitempty,max=500" example:"Moderates reviews"`
+	Permissions []string `json:"permissions" binding:"required" example:"reviews:moderate"`
+}
+
+// UpdateRoleRequest replaces a role's description and permissions
+type UpdateRoleRequest struct {
+	Description *string  `json:"description" binding:"omitempty,max=500"`
+	Permissions []string `json:"permissions" binding:"required"`
+}
+
+// AssignRoleRequest grants a role to a user
+type AssignRoleRequest struct {
+	Role string `json:"role" binding:"required" example:"moderator"`
+}
+
+// ========================================================================
+// PERMISSIONS
+// ========================================================================
+
+// Permissions returns the set of permissions a user holds through their
+// system role (userType) and assigned roles
+func (s *RoleService) Permissions(ctx context.Context, userID int, userType string) (map[string]bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.clock.Now()
+	if s.roles == nil || now.Sub(s.rolesLoadedAt) >= config.RBACCacheTTL {
+		if err := s.loadRolesLocked(ctx, now); err != nil {
+			return nil, err
+		}
+	}
+
+	cached, ok := s.userRoles[userID]
+	if !ok || now.Sub(cached.loadedAt) >= config.RBACCacheTTL {
+		assigned, err := s.repo.FindUserRoles(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		cached = cachedUserRoles{loadedAt: now}
+		for _, ur := range assigned {
+			cached.roles = append(cached.roles, ur.Role)
+		}
+		s.userRoles[userID] = cached
+	}
+
+	perms := make(map[string]bool)
+	for _, role := range append([]string{userType}, cached.roles...) {
+		for _, p := range s.roles[role] {
+			perms[p] = true
+		}
+	}
+	return perms, nil
+}
+
+// loadRolesLocked reloads role definitions and drops cached assignments.
+// If loading fails, previously loaded roles stay in effect.
+func (s *RoleService) loadRolesLocked(ctx context.Context, now time.Time) error {
+	roles, err := s.repo.FindAll(ctx)
+	if err != nil {
+		if s.roles == nil {
+			return err
+		}
+		logger.FromContext(ctx).Warn("Failed to reload roles").
+			Err(err).
+			Send()
+	} else {
+		s.roles = make(map[string][]string, len(roles))
+		for _, r := range roles {
+			s.roles[r.Name] = r.Permissions
+		}
+		s.userRoles = make(map[int]cachedUserRoles)
+	}
+	s.rolesLoadedAt = now
+	return nil
+}
+
+// invalidate makes the next Permissions call reload everything
+func (s *RoleService) invalidate() {
+	s.mu.Lock()
+	s.roles = nil
+	s.userRoles = make(map[int]cachedUserRoles)
+	s.mu.Unlock()
+}
+
+// ========================================================================
+// ROLES
+// ========================================================================
+
+// ListPermissions returns every permission a role can grant
+func (s *RoleService) ListPermissions() []string {
+	return append([]string(nil), config.ValidPermissions...)
+}
+
+// ListRoles returns every role
+func (s *RoleService) ListRoles(ctx context.Context) ([]models.Role, error) {
+	return s.repo.FindAll(ctx)
+}
+
+// CreateRole defines a custom role
+func (s *RoleService) CreateRole(ctx context.Context, req CreateRoleRequest) (*models.Role, error) {
+	if !roleNamePattern.MatchString(req.Name) {
+		return nil, fmt.Errorf("name must be 2-50 lowercase letters, digits, '-' or '_', starting with a letter")
+	}
+	perms, err := validatePermissions(req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	role := &models.Role{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: perms,
+	}
+	if err := s.repo.Create(ctx, role); err != nil {
+		return nil, err
+	}
+
+	s.invalidate()
+	s.audit.RecordChange(ctx, config.AuditActionRoleCreated, config.EntityTypeRole, nil, nil, role, models.JSONMap{"role": role.Name})
+	return role, nil
+}
+
+// UpdateRole replaces a role's description and permissions. The system
+// customer and barber roles can be changed too; the admin role always
+// keeps every permission.
+func (s *RoleService) UpdateRole(ctx context.Context, name string, req UpdateRoleRequest) (*models.Role, error) {
+	role, err := s.repo.FindByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if role.Name == config.UserTypeAdmin {
+		return nil, fmt.Errorf("the admin role cannot be changed")
+	}
+	perms, err := validatePermissions(req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	before := *role
+	role.Description = req.Description
+	role.Permissions = perms
+	if err := s.repo.Update(ctx, role); err != nil {
+		return nil, err
+	}
+
+	s.invalidate()
+	s.audit.RecordChange(ctx, config.AuditActionRoleUpdated, config.EntityTypeRole, nil, &before, role, models.JSONMap{"role": role.Name})
+	return role, nil
+}
+
+// DeleteRole removes a custom role and unassigns it from everyone
+func (s *RoleService) DeleteRole(ctx context.Context, name string) error {
+	role, err := s.repo.FindByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if role.IsSystem {
+		return repository.ErrSystemRole
+	}
+	if err := s.repo.Delete(ctx, name); err != nil {
+		return err
+	}
+
+	s.invalidate()
+	s.audit.RecordChange(ctx, config.AuditActionRoleDeleted, config.EntityTypeRole, nil, role, nil, models.JSONMap{"role": role.Name})
+	return nil
+}
+
+// validatePermissions checks and de-duplicates the permissions of a custom
+// role. "*" is reserved for the admin role.
+func validatePermissions(perms []string) (models.StringArray, error) {
+	valid := make(map[string]bool, len(config.ValidPermissions))
+	for _, p := range config.ValidPermissions {
+		valid[p] = true
+	}
+
+	seen := make(map[string]bool, len(perms))
+	result := models.StringArray{}
+	for _, p := range perms {
+		if !valid[p] {
+			return nil, fmt.Errorf("permission %q must be one of the listed permissions", p)
+		}
+		if !seen[p] {
+			seen[p] = true
+			result = append(result, p)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// ========================================================================
+// ASSIGNMENTS
+// ========================================================================
+
+// GetUserAccess returns a user's roles and effective permissions
+func (s *RoleService) GetUserAccess(ctx context.Context, userID int) (*models.UserAccess, error) {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := s.repo.FindUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	perms, err := s.Permissions(ctx, userID, user.UserType)
+	if err != nil {
+		return nil, err
+	}
+
+	access := &models.UserAccess{
+		UserID:      userID,
+		UserType:    user.UserType,
+		Roles:       roles,
+		Permissions: make([]string, 0, len(perms)),
+	}
+	if access.Roles == nil {
+		access.Roles = []models.UserRole{}
+	}
+	for p := range perms {
+		access.Permissions = append(access.Permissions, p)
+	}
+	sort.Strings(access.Permissions)
+	return access, nil
+}
+
+// AssignRole grants a custom role to a user
+func (s *RoleService) AssignRole(ctx context.Context, userID int, req AssignRoleRequest, grantedBy int) (*models.UserRole, error) {
+	if _, err := s.userRepo.FindByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	role, err := s.repo.FindByName(ctx, req.Role)
+	if err != nil {
+		return nil, err
+	}
+	if role.IsSystem {
+		return nil, repository.ErrSystemRole
+	}
+
+	assignment := &models.UserRole{UserID: userID, Role: role.Name, GrantedBy: &grantedBy}
+	if err := s.repo.AssignRole(ctx, assignment); err != nil {
+		return nil, err
+	}
+
+	s.invalidateUser(userID)
+	s.audit.RecordChange(ctx, config.AuditActionUserRoleAssigned, config.EntityTypeUser, &userID,
+		nil, models.JSONMap{"role": role.Name}, models.JSONMap{"role": role.Name})
+	return assignment, nil
+}
+
+// RevokeRole removes a role from a user
+func (s *RoleService) RevokeRole(ctx context.Context, userID int, role string) error {
+	if err := s.repo.RevokeRole(ctx, userID, role); err != nil {
+		return err
+	}
+	s.invalidateUser(userID)
+	s.audit.RecordChange(ctx, config.AuditActionUserRoleRevoked, config.EntityTypeUser, &userID,
+		models.JSONMap{"role": role}, nil, models.JSONMap{"role": role})
+	return nil
+}
+
+// invalidateUser makes the next Permissions call reload a user's roles
+func (s *RoleService) invalidateUser(userID int) {
+	s.mu.Lock()
+	delete(s.userRoles, userID)
+	s.mu.Unlock()
+}
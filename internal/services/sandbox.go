@@ -0,0 +1,46 @@
+// internal/services/sandbox.go
+package services
+
+import (
+	"context"
+
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/payments"
+	"barber-booking-system/internal/sandbox"
+)
+
+// ========================================================================
+// SANDBOX - Fake providers and test data for sandbox requests
+// ========================================================================
+
+// sandboxPayments takes every payment of sandbox requests and test bookings
+var sandboxPayments payments.Gateway = payments.NewSandboxGateway()
+
+// gatewayFor returns the payment gateway for ctx: the sandbox gateway for
+// sandbox requests, otherwise live (which may be nil)
+func gatewayFor(ctx context.Context, live payments.Gateway) payments.Gateway {
+	if sandbox.Enabled(ctx) {
+		return sandboxPayments
+	}
+	return live
+}
+
+// bookingContext puts work on a test booking in sandbox mode, even when the
+// request itself is live, so it only sees other test bookings and uses the
+// sandbox payment gateway
+func bookingContext(ctx context.Context, booking *models.Booking) context.Context {
+	if booking.IsTest && !sandbox.Enabled(ctx) {
+		return sandbox.WithSandbox(ctx)
+	}
+	return ctx
+}
+
+// sandboxDataKey marks notifications about test bookings. They are stored
+// (so they show up in-app) but never sent over push or sms.
+const sandboxDataKey = "sandbox"
+
+// isSandboxNotification reports whether a notification is about a test booking
+func isSandboxNotification(notification *models.Notification) bool {
+	flag, _ := notification.Data[sandboxDataKey].(bool)
+	return flag
+}
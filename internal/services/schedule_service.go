@@ -0,0 +1,188 @@
+// internal/services/schedule_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// SCHEDULE SERVICE - Barber working hours, breaks, and days off
+// ========================================================================
+
+// ScheduleService manages barber schedules
+type ScheduleService struct {
+	repo       repository.ScheduleStore
+	barberRepo repository.BarberStore
+}
+
+// NewScheduleService creates a new schedule service
+func NewScheduleService(repo repository.ScheduleStore, barberRepo repository.BarberStore) *ScheduleService {
+	return &ScheduleService{
+		repo:       repo,
+		barberRepo: barberRepo,
+	}
+}
+
+// ========================================================================
+// REQUEST STRUCTS
+// ========================================================================
+
+// SchedulePeriodInput is one weekly work period or break
+type SchedulePeriodInput struct {
+	DayOfWeek  int     `json:"day_of_week" binding:"min=0,max=6" example:"1"`
+	StartTime  string  `json:"start_time" binding:"required" example:"09:00"`
+	EndTime    string  `json:"end_time" binding:"required" example:"17:00"`
+	PeriodType string  `json:"period_type" example:"work"` // work (default) or break
+	Label      *string `json:"label" example:"Lunch"`
+}
+
+// UpdateScheduleRequest replaces a barber's weekly schedule
+type UpdateScheduleRequest struct {
+	Timezone string                `json:"timezone" example:"America/New_York"` // Defaults to UTC
+	Periods  []SchedulePeriodInput `json:"periods" binding:"required,dive"`
+}
+
+// CreateScheduleExceptionRequest adds a day-off or custom-hours range
+type CreateScheduleExceptionRequest struct {
+	StartDate     string  `json:"start_date" binding:"required" example:"2025-12-24"`
+	EndDate       string  `json:"end_date" example:"2025-12-26"` // Defaults to start_date
+	ExceptionType string  `json:"exception_type" binding:"required" example:"holiday"`
+	StartTime     *string `json:"start_time" example:"10:00"` // custom_hours only
+	EndTime       *string `json:"end_time" example:"14:00"`   // custom_hours only
+	Reason        *string `json:"reason" example:"Christmas"`
+}
+
+// ========================================================================
+// AUTHORIZATION
+// ========================================================================
+
+// authorize ensures the user may manage the barber's schedule: admins may
+// manage any schedule, barbers only their own
+func (s *ScheduleService) authorize(ctx context.Context, barberID, userID int, isAdmin bool) error {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return repository.ErrNotOwner
+	}
+	return nil
+}
+
+// ========================================================================
+// OPERATIONS
+// ========================================================================
+
+// GetSchedule returns a barber's schedule with periods and exceptions
+func (s *ScheduleService) GetSchedule(ctx context.Context, barberID int) (*models.BarberSchedule, error) {
+	if _, err := s.barberRepo.FindByID(ctx, barberID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindByBarberID(ctx, barberID)
+}
+
+// UpdateSchedule replaces the barber's timezone and weekly periods
+func (s *ScheduleService) UpdateSchedule(ctx context.Context, barberID int, req UpdateScheduleRequest, userID int, isAdmin bool) (*models.BarberSchedule, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = config.DefaultScheduleTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, fmt.Errorf("timezone must be a valid IANA name, got %q", timezone)
+	}
+
+	periods := make([]models.SchedulePeriod, len(req.Periods))
+	for i, in := range req.Periods {
+		periodType := in.PeriodType
+		if periodType == "" {
+			periodType = config.SchedulePeriodWork
+		}
+		periods[i] = models.SchedulePeriod{
+			BarberID:   barberID,
+			DayOfWeek:  in.DayOfWeek,
+			StartTime:  in.StartTime,
+			EndTime:    in.EndTime,
+			PeriodType: periodType,
+			Label:      in.Label,
+		}
+		if err := periods[i].Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.ReplaceWeekly(ctx, barberID, timezone, periods); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Barber schedule updated").
+		Int("barber_id", barberID).
+		Int("periods", len(periods)).
+		Str("timezone", timezone).
+		Send()
+
+	return s.repo.FindByBarberID(ctx, barberID)
+}
+
+// AddException adds a vacation, holiday, closure, or custom-hours range
+func (s *ScheduleService) AddException(ctx context.Context, barberID int, req CreateScheduleExceptionRequest, userID int, isAdmin bool) (*models.ScheduleException, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	startDate, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, fmt.Errorf("start_date must be in YYYY-MM-DD format")
+	}
+	endDate := startDate
+	if req.EndDate != "" {
+		if endDate, err = time.Parse("2006-01-02", req.EndDate); err != nil {
+			return nil, fmt.Errorf("end_date must be in YYYY-MM-DD format")
+		}
+	}
+
+	exception := &models.ScheduleException{
+		BarberID:      barberID,
+		StartDate:     startDate,
+		EndDate:       endDate,
+		ExceptionType: req.ExceptionType,
+		Reason:        req.Reason,
+	}
+	if req.ExceptionType == config.ScheduleExceptionCustomHours {
+		exception.StartTime = req.StartTime
+		exception.EndTime = req.EndTime
+	}
+	if err := exception.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateException(ctx, exception); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Barber schedule exception added").
+		Int("barber_id", barberID).
+		Int("exception_id", exception.ID).
+		Str("exception_type", exception.ExceptionType).
+		Send()
+
+	return exception, nil
+}
+
+// RemoveException deletes one of the barber's schedule exceptions
+func (s *ScheduleService) RemoveException(ctx context.Context, barberID, exceptionID int, userID int, isAdmin bool) error {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return err
+	}
+	return s.repo.DeleteException(ctx, barberID, exceptionID)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/config"
 	"barber-booking-system/internal/models"
 	"barber-booking-system/internal/repository"
 
@@ -17,23 +18,39 @@ import (
 
 // ServiceService handles service business logic
 type ServiceService struct {
-	repo  *repository.ServiceRepository
+	repo  repository.ServiceStore
 	cache *cache.CacheService
+	audit *AuditService
 }
 
 // NewServiceService creates a new service service
-func NewServiceService(repo *repository.ServiceRepository, cache *cache.CacheService) *ServiceService {
+func NewServiceService(repo repository.ServiceStore, cache *cache.CacheService) *ServiceService {
 	return &ServiceService{
 		repo:  repo,
 		cache: cache,
 	}
 }
 
+// SetAuditor records approvals and category changes in the audit log
+func (s *ServiceService) SetAuditor(audit *AuditService) {
+	s.audit = audit
+}
+
 // ==================== Service Operations ====================
 
-// GetAllServices retrieves all services with filters
-func (s *ServiceService) GetAllServices(ctx context.Context, filters repository.ServiceFilters) ([]models.Service, error) {
-	return s.repo.FindAll(ctx, filters)
+// GetAllServices retrieves a page of services with filters, with the total
+// number of matching services
+func (s *ServiceService) GetAllServices(ctx context.Context, filters repository.ServiceFilters) ([]models.Service, int, error) {
+	services, err := s.repo.FindAll(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.repo.Count(ctx, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	return services, total, nil
 }
 
 // GetServiceByID retrieves a service by ID with caching
@@ -269,11 +286,16 @@ func (s *ServiceService) ApproveService(ctx context.Context, id int, approvedBy
 		return err
 	}
 
+	before := *service
 	service.IsApproved = true
 	service.ApprovalNotes = notes
 	service.LastModifiedBy = approvedBy
 
-	return s.repo.Update(ctx, service)
+	if err := s.repo.Update(ctx, service); err != nil {
+		return err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionServiceApproved, config.EntityTypeService, &service.ID, &before, service, nil)
+	return nil
 }
 
 // SearchServices searches services by query
@@ -335,6 +357,7 @@ func (s *ServiceService) CreateCategory(ctx context.Context, req CreateCategoryR
 	if err := s.repo.CreateCategory(ctx, category); err != nil {
 		return nil, fmt.Errorf("failed to create category: %w", err)
 	}
+	s.audit.RecordChange(ctx, config.AuditActionCategoryCreated, config.EntityTypeCategory, &category.ID, nil, category, nil)
 
 	return category, nil
 }
@@ -345,6 +368,7 @@ func (s *ServiceService) UpdateCategory(ctx context.Context, id int, req UpdateC
 	if err != nil {
 		return nil, err
 	}
+	before := *category
 
 	// Apply updates
 	if req.Name != nil {
@@ -378,13 +402,22 @@ func (s *ServiceService) UpdateCategory(ctx context.Context, id int, req UpdateC
 	if err := s.repo.UpdateCategory(ctx, category); err != nil {
 		return nil, fmt.Errorf("failed to update category: %w", err)
 	}
+	s.audit.RecordChange(ctx, config.AuditActionCategoryUpdated, config.EntityTypeCategory, &category.ID, &before, category, nil)
 
 	return category, nil
 }
 
 // DeleteCategory soft deletes a service category
 func (s *ServiceService) DeleteCategory(ctx context.Context, id int) error {
-	return s.repo.DeleteCategory(ctx, id)
+	category, err := s.repo.FindCategoryByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.DeleteCategory(ctx, id); err != nil {
+		return err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionCategoryDeleted, config.EntityTypeCategory, &id, category, nil, nil)
+	return nil
 }
 
 // ==================== Barber Service Operations ====================
@@ -424,6 +457,10 @@ func (s *ServiceService) AddServiceToBarber(ctx context.Context, req CreateBarbe
 		EstimatedDurationMin:   req.EstimatedDurationMin,
 		EstimatedDurationMax:   req.EstimatedDurationMax,
 		BufferTimeMinutes:      req.BufferTimeMinutes,
+		DurationSegments:       req.DurationSegments,
+		DepositType:            req.DepositType,
+		DepositValue:           req.DepositValue,
+		NoShowFee:              req.NoShowFee,
 		AdvanceNoticeHours:     req.AdvanceNoticeHours,
 		MaxAdvanceBookingDays:  req.MaxAdvanceBookingDays,
 		AvailableDays:          req.AvailableDays,
@@ -499,6 +536,31 @@ func (s *ServiceService) UpdateBarberService(ctx context.Context, id int, req Up
 	if req.BufferTimeMinutes != nil {
 		barberService.BufferTimeMinutes = *req.BufferTimeMinutes
 	}
+	if req.DurationSegments != nil {
+		barberService.DurationSegments = *req.DurationSegments
+		if len(barberService.DurationSegments) == 0 {
+			barberService.DurationSegments = nil
+		}
+	}
+	// Segments must still add up to the (possibly new) duration
+	if err := barberService.DurationSegments.Validate(barberService.EstimatedDurationMin); err != nil {
+		return nil, err
+	}
+	if req.DepositType != nil {
+		barberService.DepositType = req.DepositType
+		if *req.DepositType == "" {
+			barberService.DepositType, barberService.DepositValue, barberService.NoShowFee = nil, nil, nil
+		}
+	}
+	if req.DepositValue != nil {
+		barberService.DepositValue = req.DepositValue
+	}
+	if req.NoShowFee != nil {
+		barberService.NoShowFee = req.NoShowFee
+	}
+	if err := models.ValidateDeposit(barberService.DepositType, barberService.DepositValue, barberService.NoShowFee); err != nil {
+		return nil, err
+	}
 	if req.AdvanceNoticeHours != nil {
 		barberService.AdvanceNoticeHours = *req.AdvanceNoticeHours
 	}
@@ -584,6 +646,11 @@ func (s *ServiceService) validateBarberServiceRequest(req CreateBarberServiceReq
 	}
 	if req.EstimatedDurationMin <= 0 {
 		errors = append(errors, "estimated_duration_min must be positive")
+	} else if err := req.DurationSegments.Validate(req.EstimatedDurationMin); err != nil {
+		errors = append(errors, err.Error())
+	}
+	if err := models.ValidateDeposit(req.DepositType, req.DepositValue, req.NoShowFee); err != nil {
+		errors = append(errors, err.Error())
 	}
 
 	if len(errors) > 0 {
@@ -711,62 +778,70 @@ type UpdateCategoryRequest struct {
 
 // CreateBarberServiceRequest represents the request to add a service to a barber
 type CreateBarberServiceRequest struct {
-	BarberID               int                `json:"barber_id" binding:"required"`
-	ServiceID              int                `json:"service_id" binding:"required"`
-	CustomName             *string            `json:"custom_name"`
-	CustomDescription      *string            `json:"custom_description"`
-	Price                  float64            `json:"price" binding:"required"`
-	MaxPrice               *float64           `json:"max_price"`
-	Currency               string             `json:"currency"`
-	DiscountPrice          *float64           `json:"discount_price"`
-	DiscountValidUntil     *time.Time         `json:"discount_valid_until"`
-	EstimatedDurationMin   int                `json:"estimated_duration_min" binding:"required"`
-	EstimatedDurationMax   *int               `json:"estimated_duration_max"`
-	BufferTimeMinutes      int                `json:"buffer_time_minutes"`
-	AdvanceNoticeHours     int                `json:"advance_notice_hours"`
-	MaxAdvanceBookingDays  *int               `json:"max_advance_booking_days"`
-	AvailableDays          models.StringArray `json:"available_days"`
-	AvailableTimeSlots     models.JSONMap     `json:"available_time_slots"`
-	RequiresConsultation   *bool              `json:"requires_consultation"`
-	ConsultationDuration   *int               `json:"consultation_duration"`
-	PreServiceInstructions *string            `json:"pre_service_instructions"`
-	PostServiceCare        *string            `json:"post_service_care"`
-	MinCustomerAge         *int               `json:"min_customer_age"`
-	MaxCustomerAge         *int               `json:"max_customer_age"`
-	IsSeasonal             bool               `json:"is_seasonal"`
-	SeasonalStartMonth     *int               `json:"seasonal_start_month"`
-	SeasonalEndMonth       *int               `json:"seasonal_end_month"`
-	PortfolioImages        models.StringArray `json:"portfolio_images"`
-	BeforeAfterImages      models.StringArray `json:"before_after_images"`
-	IsPromotional          bool               `json:"is_promotional"`
-	PromotionalText        *string            `json:"promotional_text"`
-	PromotionStartDate     *time.Time         `json:"promotion_start_date"`
-	PromotionEndDate       *time.Time         `json:"promotion_end_date"`
-	IsFeatured             bool               `json:"is_featured"`
-	DisplayOrder           int                `json:"display_order"`
-	ServiceNote            *string            `json:"service_note"`
+	BarberID               int                     `json:"barber_id" binding:"required"`
+	ServiceID              int                     `json:"service_id" binding:"required"`
+	CustomName             *string                 `json:"custom_name"`
+	CustomDescription      *string                 `json:"custom_description"`
+	Price                  float64                 `json:"price" binding:"required"`
+	MaxPrice               *float64                `json:"max_price"`
+	Currency               string                  `json:"currency"`
+	DiscountPrice          *float64                `json:"discount_price"`
+	DiscountValidUntil     *time.Time              `json:"discount_valid_until"`
+	EstimatedDurationMin   int                     `json:"estimated_duration_min" binding:"required"`
+	EstimatedDurationMax   *int                    `json:"estimated_duration_max"`
+	BufferTimeMinutes      int                     `json:"buffer_time_minutes"`
+	DurationSegments       models.DurationSegments `json:"duration_segments"` // Active/passive split adding up to estimated_duration_min
+	DepositType            *string                 `json:"deposit_type"`      // percentage, fixed; nil = no deposit
+	DepositValue           *float64                `json:"deposit_value"`     // Percent of the price, or an amount
+	NoShowFee              *float64                `json:"no_show_fee"`       // Kept from the deposit on a no-show; nil = the whole deposit
+	AdvanceNoticeHours     int                     `json:"advance_notice_hours"`
+	MaxAdvanceBookingDays  *int                    `json:"max_advance_booking_days"`
+	AvailableDays          models.StringArray      `json:"available_days"`
+	AvailableTimeSlots     models.JSONMap          `json:"available_time_slots"`
+	RequiresConsultation   *bool                   `json:"requires_consultation"`
+	ConsultationDuration   *int                    `json:"consultation_duration"`
+	PreServiceInstructions *string                 `json:"pre_service_instructions"`
+	PostServiceCare        *string                 `json:"post_service_care"`
+	MinCustomerAge         *int                    `json:"min_customer_age"`
+	MaxCustomerAge         *int                    `json:"max_customer_age"`
+	IsSeasonal             bool                    `json:"is_seasonal"`
+	SeasonalStartMonth     *int                    `json:"seasonal_start_month"`
+	SeasonalEndMonth       *int                    `json:"seasonal_end_month"`
+	PortfolioImages        models.StringArray      `json:"portfolio_images"`
+	BeforeAfterImages      models.StringArray      `json:"before_after_images"`
+	IsPromotional          bool                    `json:"is_promotional"`
+	PromotionalText        *string                 `json:"promotional_text"`
+	PromotionStartDate     *time.Time              `json:"promotion_start_date"`
+	PromotionEndDate       *time.Time              `json:"promotion_end_date"`
+	IsFeatured             bool                    `json:"is_featured"`
+	DisplayOrder           int                     `json:"display_order"`
+	ServiceNote            *string                 `json:"service_note"`
 }
 
 // UpdateBarberServiceRequest represents the request to update a barber's service
 type UpdateBarberServiceRequest struct {
-	CustomName            *string            `json:"custom_name,omitempty"`
-	CustomDescription     *string            `json:"custom_description,omitempty"`
-	Price                 *float64           `json:"price,omitempty"`
-	MaxPrice              *float64           `json:"max_price,omitempty"`
-	Currency              *string            `json:"currency,omitempty"`
-	DiscountPrice         *float64           `json:"discount_price,omitempty"`
-	DiscountValidUntil    *time.Time         `json:"discount_valid_until,omitempty"`
-	EstimatedDurationMin  *int               `json:"estimated_duration_min,omitempty"`
-	EstimatedDurationMax  *int               `json:"estimated_duration_max,omitempty"`
-	BufferTimeMinutes     *int               `json:"buffer_time_minutes,omitempty"`
-	AdvanceNoticeHours    *int               `json:"advance_notice_hours,omitempty"`
-	MaxAdvanceBookingDays *int               `json:"max_advance_booking_days,omitempty"`
-	AvailableDays         models.StringArray `json:"available_days,omitempty"`
-	PortfolioImages       models.StringArray `json:"portfolio_images,omitempty"`
-	IsPromotional         *bool              `json:"is_promotional,omitempty"`
-	PromotionalText       *string            `json:"promotional_text,omitempty"`
-	IsFeatured            *bool              `json:"is_featured,omitempty"`
-	DisplayOrder          *int               `json:"display_order,omitempty"`
-	ServiceNote           *string            `json:"service_note,omitempty"`
-	IsActive              *bool              `json:"is_active,omitempty"`
+	CustomName            *string                  `json:"custom_name,omitempty"`
+	CustomDescription     *string                  `json:"custom_description,omitempty"`
+	Price                 *float64                 `json:"price,omitempty"`
+	MaxPrice              *float64                 `json:"max_price,omitempty"`
+	Currency              *string                  `json:"currency,omitempty"`
+	DiscountPrice         *float64                 `json:"discount_price,omitempty"`
+	DiscountValidUntil    *time.Time               `json:"discount_valid_until,omitempty"`
+	EstimatedDurationMin  *int                     `json:"estimated_duration_min,omitempty"`
+	EstimatedDurationMax  *int                     `json:"estimated_duration_max,omitempty"`
+	BufferTimeMinutes     *int                     `json:"buffer_time_minutes,omitempty"`
+	DurationSegments      *models.DurationSegments `json:"duration_segments,omitempty"` // Empty list = fully active
+	DepositType           *string                  `json:"deposit_type,omitempty"`      // Empty = no deposit (clears the value and no-show fee)
+	DepositValue          *float64                 `json:"deposit_value,omitempty"`
+	NoShowFee             *float64                 `json:"no_show_fee,omitempty"`
+	AdvanceNoticeHours    *int                     `json:"advance_notice_hours,omitempty"`
+	MaxAdvanceBookingDays *int                     `json:"max_advance_booking_days,omitempty"`
+	AvailableDays         models.StringArray       `json:"available_days,omitempty"`
+	PortfolioImages       models.StringArray       `json:"portfolio_images,omitempty"`
+	IsPromotional         *bool                    `json:"is_promotional,omitempty"`
+	PromotionalText       *string                  `json:"promotional_text,omitempty"`
+	IsFeatured            *bool                    `json:"is_featured,omitempty"`
+	DisplayOrder          *int                     `json:"display_order,omitempty"`
+	ServiceNote           *string                  `json:"service_note,omitempty"`
+	IsActive              *bool                    `json:"is_active,omitempty"`
 }
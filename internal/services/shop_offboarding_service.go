@@ -0,0 +1,447 @@
+// internal/services/shop_offboarding_service.go
+package services
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// SHOP OFFBOARDING SERVICE - Data exports and leaving the platform
+// ========================================================================
+//
+// A shop's owner (or a platform admin) can download the shop's data as a
+// zip of CSV and JSON files at any time. Customers' contact details are
+// only exported from the client list, and only where the client consented
+// to that channel. Asking to leave schedules the deletion for when the
+// retention period has passed; until then the shop keeps working, can
+// export again, and can cancel. The deletion job waits for the shop's
+// upcoming bookings to finish before erasing anything.
+// ========================================================================
+
+// ShopOffboardingService exports shop data and deletes shops that leave
+type ShopOffboardingService struct {
+	repo        *repository.ShopOffboardingRepository
+	shopRepo    *repository.ShopRepository
+	taxRepo     *repository.TaxRepository
+	shopService *ShopService
+	clock       clock.Clock
+	config      config.OffboardingConfig
+}
+
+// NewShopOffboardingService creates a new shop offboarding service. Unset
+// settings fall back to the defaults.
+func NewShopOffboardingService(
+	repo *repository.ShopOffboardingRepository,
+	shopRepo *repository.ShopRepository,
+	taxRepo *repository.TaxRepository,
+	shopService *ShopService,
+	cfg config.OffboardingConfig,
+) *ShopOffboardingService {
+	if cfg.Retention <= 0 {
+		cfg.Retention = config.DefaultShopDataRetention
+	}
+	return &ShopOffboardingService{
+		repo:        repo,
+		shopRepo:    shopRepo,
+		taxRepo:     taxRepo,
+		shopService: shopService,
+		clock:       clock.System,
+		config:      cfg,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *ShopOffboardingService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ShopOffboardingRequest asks for a shop to leave the platform
+type ShopOffboardingRequest struct {
+	Reason *string `json:"reason" binding:"omitempty,max=1000" example:"Closing the business"`
+}
+
+// ========================================================================
+// OFFBOARDING
+// ========================================================================
+
+// Schedule schedules the shop's deletion once the retention period has
+// passed. Owner only.
+func (s *ShopOffboardingService) Schedule(ctx context.Context, shopID, userID int, isAdmin bool, req ShopOffboardingRequest) (*models.ShopOffboarding, error) {
+	if err := s.shopService.authorize(ctx, shopID, userID, isAdmin, true); err != nil {
+		return nil, err
+	}
+	shop, err := s.shopRepo.FindByID(ctx, shopID)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &models.ShopOffboarding{
+		ShopID:      shopID,
+		ShopName:    shop.Name,
+		RequestedBy: &userID,
+		Reason:      req.Reason,
+		Status:      config.ShopOffboardingStatusScheduled,
+		DeleteAfter: s.clock.Now().Add(s.config.Retention),
+	}
+	if err := s.repo.Create(ctx, o); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Shop offboarding scheduled").
+		Int("shop_id", shopID).
+		Int("requested_by", userID).
+		Time("delete_after", o.DeleteAfter).
+		Send()
+	return o, nil
+}
+
+// Get returns the shop's most recent offboarding. Shop admins only.
+func (s *ShopOffboardingService) Get(ctx context.Context, shopID, userID int, isAdmin bool) (*models.ShopOffboarding, error) {
+	if err := s.shopService.authorize(ctx, shopID, userID, isAdmin, false); err != nil {
+		return nil, err
+	}
+	return s.repo.FindLatestByShopID(ctx, shopID)
+}
+
+// Cancel keeps the shop on the platform. Owner only, before the deletion
+// date.
+func (s *ShopOffboardingService) Cancel(ctx context.Context, shopID, userID int, isAdmin bool) error {
+	if err := s.shopService.authorize(ctx, shopID, userID, isAdmin, true); err != nil {
+		return err
+	}
+	o, err := s.repo.FindLatestByShopID(ctx, shopID)
+	if err != nil {
+		return err
+	}
+	if !o.CanCancel(s.clock.Now()) {
+		return fmt.Errorf("offboarding cannot be cancelled once it is %s or past its deletion date", o.Status)
+	}
+	return s.repo.Cancel(ctx, o.ID, s.clock.Now())
+}
+
+// RunScheduled deletes the data of shops whose retention period has
+// passed. Shops with upcoming bookings are put off until the next run; a
+// failure for one shop is logged and skipped so the rest still run.
+func (s *ShopOffboardingService) RunScheduled(ctx context.Context) error {
+	log := logger.FromContext(ctx)
+	now := s.clock.Now()
+
+	due, err := s.repo.FindDue(ctx, now, config.ShopOffboardingBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for i := range due {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		o := &due[i]
+
+		upcoming, err := s.repo.CountUpcomingBookings(ctx, o.ShopID, now)
+		if err != nil {
+			return err
+		}
+		if upcoming > 0 {
+			reason := fmt.Sprintf("waiting for %d upcoming booking(s) to finish or be cancelled", upcoming)
+			if err := s.repo.Postpone(ctx, o.ID, reason, now); err != nil {
+				return err
+			}
+			log.Info("Shop deletion put off").
+				Int("shop_id", o.ShopID).
+				Int("upcoming_bookings", upcoming).
+				Send()
+			continue
+		}
+
+		if err := s.repo.DeleteShopData(ctx, o, now); err != nil {
+			log.Error(err).
+				Int("shop_id", o.ShopID).
+				Msg("Failed to delete shop data")
+			continue
+		}
+		log.Info("Shop data deleted").
+			Int("shop_id", o.ShopID).
+			Interface("summary", o.Summary).
+			Send()
+	}
+	return nil
+}
+
+// ========================================================================
+// EXPORT
+// ========================================================================
+
+// shopExportManifest describes an export's files
+type shopExportManifest struct {
+	ShopID      int            `json:"shop_id"`
+	ShopName    string         `json:"shop_name"`
+	GeneratedAt time.Time      `json:"generated_at"`
+	Files       map[string]int `json:"files"` // File name -> data rows
+	Notes       []string       `json:"notes"`
+}
+
+// shopExportNotes explain what an export holds
+var shopExportNotes = []string{
+	"bookings.csv holds live and archived bookings; customer contact details are not included",
+	"customers.csv holds the barbers' client lists; an email or phone is included only if the client consented to that channel",
+	"financial_events.csv uses the financial ledger export format; its rows are a subset of the chain and keep their hashes",
+	"Amounts in bookings.csv are in major units of the booking's currency",
+}
+
+// Export writes the shop's data to w as a zip archive: shop.json (details,
+// barbers, tax rules), bookings.csv, customers.csv, reviews.csv,
+// financial_events.csv and manifest.json. Owner only.
+func (s *ShopOffboardingService) Export(ctx context.Context, shopID, userID int, isAdmin bool, w io.Writer) error {
+	if err := s.shopService.authorize(ctx, shopID, userID, isAdmin, true); err != nil {
+		return err
+	}
+	shop, err := s.shopRepo.FindByID(ctx, shopID)
+	if err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+	manifest := shopExportManifest{
+		ShopID:      shop.ID,
+		ShopName:    shop.Name,
+		GeneratedAt: s.clock.Now().UTC(),
+		Files:       map[string]int{},
+		Notes:       shopExportNotes,
+	}
+
+	steps := []struct {
+		name  string
+		write func(io.Writer) (int, error)
+	}{
+		{"shop.json", func(f io.Writer) (int, error) { return s.exportShop(ctx, f, shop) }},
+		{"bookings.csv", func(f io.Writer) (int, error) { return s.exportBookings(ctx, f, shopID) }},
+		{"customers.csv", func(f io.Writer) (int, error) { return s.exportClients(ctx, f, shopID) }},
+		{"reviews.csv", func(f io.Writer) (int, error) { return s.exportReviews(ctx, f, shopID) }},
+		{"financial_events.csv", func(f io.Writer) (int, error) { return s.exportFinancialEvents(ctx, f, shopID) }},
+	}
+	for _, step := range steps {
+		f, err := archive.Create(step.name)
+		if err != nil {
+			return err
+		}
+		rows, err := step.write(f)
+		if err != nil {
+			return fmt.Errorf("failed to export %s: %w", step.name, err)
+		}
+		manifest.Files[step.name] = rows
+	}
+
+	f, err := archive.Create("manifest.json")
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(f, manifest); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+// exportShop writes the shop's details, barbers and tax rules
+func (s *ShopOffboardingService) exportShop(ctx context.Context, w io.Writer, shop *models.Shop) (int, error) {
+	barbers, err := s.shopRepo.FindBarbers(ctx, shop.ID)
+	if err != nil {
+		return 0, err
+	}
+	taxRules, err := s.taxRepo.FindByShopID(ctx, shop.ID)
+	if err != nil {
+		return 0, err
+	}
+	return 1, writeJSON(w, map[string]interface{}{
+		"shop":      shop,
+		"barbers":   barbers,
+		"tax_rules": taxRules,
+	})
+}
+
+// exportBookings writes the shop's bookings as CSV
+func (s *ShopOffboardingService) exportBookings(ctx context.Context, w io.Writer, shopID int) (int, error) {
+	out := csv.NewWriter(w)
+	if err := out.Write([]string{
+		"id", "booking_number", "barber_id", "customer_id", "customer_name", "service_name", "status",
+		"scheduled_start_time", "scheduled_end_time", "service_price", "discount_amount", "tax_amount",
+		"tip_amount", "total_price", "currency", "payment_status", "booking_source", "created_at", "archived",
+	}); err != nil {
+		return 0, err
+	}
+
+	rows, afterID := 0, 0
+	for {
+		bookings, err := s.repo.ExportBookings(ctx, shopID, afterID, config.ShopExportBatchSize)
+		if err != nil {
+			return rows, err
+		}
+		for _, b := range bookings {
+			if err := out.Write([]string{
+				strconv.Itoa(b.ID),
+				b.BookingNumber,
+				strconv.Itoa(b.BarberID),
+				optionalInt(b.CustomerID),
+				stringValue(b.CustomerName),
+				b.ServiceName,
+				b.Status,
+				b.ScheduledStartTime.UTC().Format(time.RFC3339),
+				b.ScheduledEndTime.UTC().Format(time.RFC3339),
+				exportAmount(b.ServicePrice),
+				exportAmount(b.DiscountAmount),
+				exportAmount(b.TaxAmount),
+				exportAmount(b.TipAmount),
+				exportAmount(b.TotalPrice),
+				b.Currency,
+				b.PaymentStatus,
+				b.BookingSource,
+				b.CreatedAt.UTC().Format(time.RFC3339),
+				strconv.FormatBool(b.Archived),
+			}); err != nil {
+				return rows, err
+			}
+			afterID = b.ID
+		}
+		rows += len(bookings)
+		if len(bookings) < config.ShopExportBatchSize {
+			break
+		}
+	}
+	out.Flush()
+	return rows, out.Error()
+}
+
+// exportClients writes the barbers' client lists as CSV, with contact
+// details only where the client consented
+func (s *ShopOffboardingService) exportClients(ctx context.Context, w io.Writer, shopID int) (int, error) {
+	out := csv.NewWriter(w)
+	if err := out.Write([]string{
+		"id", "barber_id", "user_id", "name", "email", "phone", "email_consent", "sms_consent", "notes", "source", "created_at",
+	}); err != nil {
+		return 0, err
+	}
+
+	rows, afterID := 0, 0
+	for {
+		clients, err := s.repo.ExportClients(ctx, shopID, afterID, config.ShopExportBatchSize)
+		if err != nil {
+			return rows, err
+		}
+		for i := range clients {
+			c := &clients[i]
+			email, phone := c.ConsentedContact()
+			if err := out.Write([]string{
+				strconv.Itoa(c.ID),
+				strconv.Itoa(c.BarberID),
+				optionalInt(c.UserID),
+				c.Name,
+				stringValue(email),
+				stringValue(phone),
+				strconv.FormatBool(c.EmailConsent),
+				strconv.FormatBool(c.SMSConsent),
+				stringValue(c.Notes),
+				c.Source,
+				c.CreatedAt.UTC().Format(time.RFC3339),
+			}); err != nil {
+				return rows, err
+			}
+			afterID = c.ID
+		}
+		rows += len(clients)
+		if len(clients) < config.ShopExportBatchSize {
+			break
+		}
+	}
+	out.Flush()
+	return rows, out.Error()
+}
+
+// exportReviews writes the reviews of the shop's barbers as CSV
+func (s *ShopOffboardingService) exportReviews(ctx context.Context, w io.Writer, shopID int) (int, error) {
+	out := csv.NewWriter(w)
+	if err := out.Write([]string{
+		"id", "booking_id", "barber_id", "overall_rating", "title", "comment", "moderation_status",
+		"is_published", "barber_response", "barber_response_at", "created_at",
+	}); err != nil {
+		return 0, err
+	}
+
+	rows, afterID := 0, 0
+	for {
+		reviews, err := s.repo.ExportReviews(ctx, shopID, afterID, config.ShopExportBatchSize)
+		if err != nil {
+			return rows, err
+		}
+		for _, r := range reviews {
+			responseAt := ""
+			if r.BarberResponseAt != nil {
+				responseAt = r.BarberResponseAt.UTC().Format(time.RFC3339)
+			}
+			if err := out.Write([]string{
+				strconv.Itoa(r.ID),
+				strconv.Itoa(r.BookingID),
+				strconv.Itoa(r.BarberID),
+				strconv.Itoa(r.OverallRating),
+				stringValue(r.Title),
+				stringValue(r.Comment),
+				r.ModerationStatus,
+				strconv.FormatBool(r.IsPublished),
+				stringValue(r.BarberResponse),
+				responseAt,
+				r.CreatedAt.UTC().Format(time.RFC3339),
+			}); err != nil {
+				return rows, err
+			}
+			afterID = r.ID
+		}
+		rows += len(reviews)
+		if len(reviews) < config.ShopExportBatchSize {
+			break
+		}
+	}
+	out.Flush()
+	return rows, out.Error()
+}
+
+// exportFinancialEvents writes the ledger events of the shop's barbers in
+// the ledger export format
+func (s *ShopOffboardingService) exportFinancialEvents(ctx context.Context, w io.Writer, shopID int) (int, error) {
+	var all []models.FinancialEvent
+	var afterID int64
+	for {
+		events, err := s.repo.ExportFinancialEvents(ctx, shopID, afterID, config.ShopExportBatchSize)
+		if err != nil {
+			return 0, err
+		}
+		all = append(all, events...)
+		if len(events) < config.ShopExportBatchSize {
+			break
+		}
+		afterID = events[len(events)-1].ID
+	}
+	return len(all), WriteLedgerCSV(w, all)
+}
+
+// exportAmount formats a major-unit amount for CSV
+func exportAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
+
+// writeJSON writes v as indented JSON
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
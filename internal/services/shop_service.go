@@ -0,0 +1,336 @@
+// internal/services/shop_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// SHOP SERVICE - Shops, their barbers, admins, tax rules and bookings
+// ========================================================================
+//
+// Anyone may view a shop and its barbers. A shop's admins (and platform
+// admins) manage its details, barbers and tax rules and see its bookings;
+// only its owner manages the admins. Barbers may leave their shop.
+// ========================================================================
+
+// ShopService manages shops
+type ShopService struct {
+	repo           *repository.ShopRepository
+	barberRepo     repository.BarberStore
+	userRepo       repository.UserStore
+	taxRepo        *repository.TaxRepository
+	taxService     *TaxService
+	bookingService *BookingService
+}
+
+// NewShopService creates a new shop service
+func NewShopService(
+	repo *repository.ShopRepository,
+	barberRepo repository.BarberStore,
+	userRepo repository.UserStore,
+	taxRepo *repository.TaxRepository,
+	taxService *TaxService,
+	bookingService *BookingService,
+) *ShopService {
+	return &ShopService{
+		repo:           repo,
+		barberRepo:     barberRepo,
+		userRepo:       userRepo,
+		taxRepo:        taxRepo,
+		taxService:     taxService,
+		bookingService: bookingService,
+	}
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// ShopRequest creates or replaces a shop's details and settings
+type ShopRequest struct {
+	Name         string           `json:"name" binding:"required,max=200" example:"Downtown Cuts"`
+	Description  *string          `json:"description" example:"Three chairs on Main Street"`
+	Address      string           `json:"address" example:"12 Main St"`
+	AddressLine2 *string          `json:"address_line_2"`
+	City         string           `json:"city" binding:"max=100" example:"New York"`
+	State        string           `json:"state" binding:"max=100" example:"NY"`
+	Country      string           `json:"country" binding:"max=100" example:"USA"`
+	PostalCode   string           `json:"postal_code" binding:"max=20" example:"10001"`
+	Latitude     *float64         `json:"latitude" example:"40.7128"`
+	Longitude    *float64         `json:"longitude" example:"-74.006"`
+	Phone        *string          `json:"phone" binding:"omitempty,max=30" example:"+1 212 555 0100"`
+	Email        *string          `json:"email" binding:"omitempty,email" example:"hello@downtowncuts.com"`
+	TaxID        *string          `json:"tax_id" binding:"omitempty,max=100"`
+	Timezone     string           `json:"timezone" example:"America/New_York"` // Default: UTC
+	OpeningHours models.ShopHours `json:"opening_hours"`                       // Hours of barbers without a schedule
+}
+
+// AddShopBarberRequest adds a barber to a shop
+type AddShopBarberRequest struct {
+	BarberID int `json:"barber_id" binding:"required" example:"3"`
+}
+
+// AddShopAdminRequest makes a user an admin of a shop
+type AddShopAdminRequest struct {
+	UserID int `json:"user_id" binding:"required" example:"12"`
+}
+
+// ========================================================================
+// AUTHORIZATION
+// ========================================================================
+
+// authorize ensures the user may manage the shop: platform admins and the
+// shop's admins may, or only its owner when ownerOnly is set
+func (s *ShopService) authorize(ctx context.Context, shopID, userID int, isAdmin, ownerOnly bool) error {
+	if _, err := s.repo.FindByID(ctx, shopID); err != nil {
+		return err
+	}
+	if isAdmin {
+		return nil
+	}
+
+	role, err := s.repo.FindAdminRole(ctx, shopID, userID)
+	if errors.Is(err, repository.ErrShopAdminNotFound) {
+		return repository.ErrNotOwner
+	}
+	if err != nil {
+		return err
+	}
+	if ownerOnly && role != models.ShopRoleOwner {
+		return repository.ErrNotOwner
+	}
+	return nil
+}
+
+// ========================================================================
+// SHOPS
+// ========================================================================
+
+// Create adds a shop owned by the user creating it
+func (s *ShopService) Create(ctx context.Context, userID int, req ShopRequest) (*models.Shop, error) {
+	shop := &models.Shop{CreatedBy: &userID}
+	if err := applyShopRequest(shop, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, shop, userID); err != nil {
+		return nil, err
+	}
+	return shop, nil
+}
+
+// Get retrieves a shop
+func (s *ShopService) Get(ctx context.Context, shopID int) (*models.Shop, error) {
+	return s.repo.FindByID(ctx, shopID)
+}
+
+// Update replaces a shop's details and settings
+func (s *ShopService) Update(ctx context.Context, shopID, userID int, isAdmin bool, req ShopRequest) (*models.Shop, error) {
+	if err := s.authorize(ctx, shopID, userID, isAdmin, false); err != nil {
+		return nil, err
+	}
+
+	shop, err := s.repo.FindByID(ctx, shopID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyShopRequest(shop, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, shop); err != nil {
+		return nil, err
+	}
+	return shop, nil
+}
+
+// applyShopRequest validates a request and copies it onto the shop
+func applyShopRequest(shop *models.Shop, req ShopRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return fmt.Errorf("name is required")
+	}
+
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = config.DefaultScheduleTimezone
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return fmt.Errorf("timezone must be a valid IANA name, got %q", timezone)
+	}
+
+	if req.Latitude != nil && (*req.Latitude < -90 || *req.Latitude > 90) {
+		return fmt.Errorf("latitude must be between -90 and 90")
+	}
+	if req.Longitude != nil && (*req.Longitude < -180 || *req.Longitude > 180) {
+		return fmt.Errorf("longitude must be between -180 and 180")
+	}
+	if err := req.OpeningHours.Validate(); err != nil {
+		return err
+	}
+
+	shop.Name = name
+	shop.Description = trimmedOrNil(req.Description)
+	shop.Address = strings.TrimSpace(req.Address)
+	shop.AddressLine2 = trimmedOrNil(req.AddressLine2)
+	shop.City = strings.TrimSpace(req.City)
+	shop.State = strings.TrimSpace(req.State)
+	shop.Country = strings.TrimSpace(req.Country)
+	shop.PostalCode = strings.TrimSpace(req.PostalCode)
+	shop.Latitude = req.Latitude
+	shop.Longitude = req.Longitude
+	shop.Phone = trimmedOrNil(req.Phone)
+	shop.Email = trimmedOrNil(req.Email)
+	shop.TaxID = trimmedOrNil(req.TaxID)
+	shop.Timezone = timezone
+	shop.OpeningHours = req.OpeningHours
+	if shop.OpeningHours == nil {
+		shop.OpeningHours = models.ShopHours{}
+	}
+	return nil
+}
+
+// ========================================================================
+// BARBERS
+// ========================================================================
+
+// ListBarbers retrieves the barbers working at a shop
+func (s *ShopService) ListBarbers(ctx context.Context, shopID int) ([]models.Barber, error) {
+	if _, err := s.repo.FindByID(ctx, shopID); err != nil {
+		return nil, err
+	}
+	return s.repo.FindBarbers(ctx, shopID)
+}
+
+// AddBarber adds a standalone barber to the shop
+func (s *ShopService) AddBarber(ctx context.Context, shopID, userID int, isAdmin bool, req AddShopBarberRequest) (*models.Barber, error) {
+	if err := s.authorize(ctx, shopID, userID, isAdmin, false); err != nil {
+		return nil, err
+	}
+
+	barber, err := s.barberRepo.FindByID(ctx, req.BarberID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.AddBarber(ctx, shopID, barber.ID); err != nil {
+		return nil, err
+	}
+	barber.ShopID = &shopID
+	return barber, nil
+}
+
+// RemoveBarber makes one of the shop's barbers standalone. The shop's
+// admins may remove any barber; barbers may leave.
+func (s *ShopService) RemoveBarber(ctx context.Context, shopID, barberID, userID int, isAdmin bool) error {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return err
+	}
+	if barber.UserID != userID {
+		if err := s.authorize(ctx, shopID, userID, isAdmin, false); err != nil {
+			return err
+		}
+	}
+	return s.repo.RemoveBarber(ctx, shopID, barberID)
+}
+
+// ========================================================================
+// ADMINS
+// ========================================================================
+
+// ListAdmins retrieves the shop's admins
+func (s *ShopService) ListAdmins(ctx context.Context, shopID, userID int, isAdmin bool) ([]models.ShopAdmin, error) {
+	if err := s.authorize(ctx, shopID, userID, isAdmin, false); err != nil {
+		return nil, err
+	}
+	return s.repo.FindAdmins(ctx, shopID)
+}
+
+// AddAdmin makes a user an admin of the shop
+func (s *ShopService) AddAdmin(ctx context.Context, shopID, userID int, isAdmin bool, req AddShopAdminRequest) (*models.ShopAdmin, error) {
+	if err := s.authorize(ctx, shopID, userID, isAdmin, true); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.FindByID(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	admin := &models.ShopAdmin{
+		ShopID:    shopID,
+		UserID:    user.ID,
+		Role:      models.ShopRoleAdmin,
+		UserName:  &user.Name,
+		UserEmail: &user.Email,
+	}
+	if err := s.repo.AddAdmin(ctx, admin); err != nil {
+		return nil, err
+	}
+	return admin, nil
+}
+
+// RemoveAdmin removes an admin from the shop. The owner stays.
+func (s *ShopService) RemoveAdmin(ctx context.Context, shopID, adminUserID, userID int, isAdmin bool) error {
+	if err := s.authorize(ctx, shopID, userID, isAdmin, true); err != nil {
+		return err
+	}
+	return s.repo.RemoveAdmin(ctx, shopID, adminUserID)
+}
+
+// ========================================================================
+// TAX RULES
+// ========================================================================
+
+// ListTaxRules retrieves the shop's tax rules
+func (s *ShopService) ListTaxRules(ctx context.Context, shopID, userID int, isAdmin bool) ([]models.TaxRule, error) {
+	if err := s.authorize(ctx, shopID, userID, isAdmin, false); err != nil {
+		return nil, err
+	}
+	return s.taxRepo.FindByShopID(ctx, shopID)
+}
+
+// CreateTaxRule adds a tax rule for the shop's barbers. It applies ahead of
+// regional rules to bookings made from then on.
+func (s *ShopService) CreateTaxRule(ctx context.Context, shopID, userID int, isAdmin bool, req TaxRuleRequest) (*models.TaxRule, error) {
+	if err := s.authorize(ctx, shopID, userID, isAdmin, false); err != nil {
+		return nil, err
+	}
+	return s.taxService.CreateShopRule(ctx, shopID, req)
+}
+
+// DeleteTaxRule removes one of the shop's tax rules
+func (s *ShopService) DeleteTaxRule(ctx context.Context, shopID, ruleID, userID int, isAdmin bool) error {
+	if err := s.authorize(ctx, shopID, userID, isAdmin, false); err != nil {
+		return err
+	}
+
+	rule, err := s.taxRepo.FindByID(ctx, ruleID)
+	if err != nil {
+		return err
+	}
+	if rule.ShopID == nil || *rule.ShopID != shopID {
+		return repository.ErrTaxRuleNotFound
+	}
+	return s.taxService.DeleteRule(ctx, ruleID)
+}
+
+// ========================================================================
+// BOOKINGS
+// ========================================================================
+
+// GetBookings retrieves a page of bookings with any of the shop's barbers,
+// with the total number matching the filters
+func (s *ShopService) GetBookings(ctx context.Context, shopID, userID int, isAdmin bool, filters repository.BookingFilters) ([]BookingResponse, int, error) {
+	if err := s.authorize(ctx, shopID, userID, isAdmin, false); err != nil {
+		return nil, 0, err
+	}
+	return s.bookingService.GetShopBookings(ctx, shopID, filters)
+}
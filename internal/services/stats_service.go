@@ -0,0 +1,78 @@
+// internal/services/stats_service.go
+package services
+
+import (
+	"context"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// STATS SERVICE - Periodic aggregation of listing counters
+// ========================================================================
+//
+// Barber and barber service counters shown in listings (bookings, revenue,
+// cancellation rates, last-30-days figures) are recalculated from the
+// bookings table in bulk on a schedule rather than on every booking change.
+// Sandbox bookings are left out. Ratings are maintained as reviews are
+// published and unpublished; the same run reconciles them with the reviews.
+// ========================================================================
+
+// StatsService recalculates barber and barber service counters
+type StatsService struct {
+	barberRepo  repository.BarberStore
+	serviceRepo repository.ServiceStore
+	clock       clock.Clock
+}
+
+// NewStatsService creates a new stats service
+func NewStatsService(barberRepo repository.BarberStore, serviceRepo repository.ServiceStore) *StatsService {
+	return &StatsService{
+		barberRepo:  barberRepo,
+		serviceRepo: serviceRepo,
+		clock:       clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *StatsService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// RunScheduled recalculates every barber's and barber service's counters
+// and corrects ratings that drifted from their reviews
+func (s *StatsService) RunScheduled(ctx context.Context) error {
+	now := s.clock.Now()
+
+	barbers, err := s.barberRepo.RefreshAllStats(ctx, now)
+	if err != nil {
+		return err
+	}
+	services, err := s.serviceRepo.RefreshBarberServiceStats(ctx, now.AddDate(0, 0, -config.StatsWindowDays), now)
+	if err != nil {
+		return err
+	}
+
+	// Ratings are kept up to date as reviews are moderated; this catches
+	// any update that was missed
+	driftedBarbers, driftedServices, err := s.barberRepo.ReconcileRatingStats(ctx, now)
+	if err != nil {
+		return err
+	}
+
+	log := logger.FromContext(ctx)
+	log.Info("Aggregated listing stats").
+		Int("barbers", barbers).
+		Int("barber_services", services).
+		Send()
+	if driftedBarbers > 0 || driftedServices > 0 {
+		log.Warn("Corrected drifted rating stats").
+			Int("barbers", driftedBarbers).
+			Int("barber_services", driftedServices).
+			Send()
+	}
+	return nil
+}
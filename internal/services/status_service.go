@@ -0,0 +1,477 @@
+// internal/services/status_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/breaker"
+	"barber-booking-system/internal/cache"
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// STATUS SERVICE - Public status page
+// ========================================================================
+//
+// Each component is checked on a schedule:
+//
+//	api            the database answers (major outage) and the cache does (degraded)
+//	payments       the payment provider's circuit breaker (only when payments are configured)
+//	notifications  the SMS and push providers' circuit breakers
+//
+// A closed breaker is operational, a half-open one degraded and an open
+// one an outage; when a component has several providers, one down is
+// degraded and all down an outage. A check that finds a component unwell
+// opens an incident, which the first healthy check resolves. Staff can
+// also post incidents; a component's status is the worse of its last
+// check and its open incidents.
+//
+// Breakers live in each server's memory, so with several instances the
+// last to check wins.
+// ========================================================================
+
+// statusCheckTimeout bounds each component's check
+const statusCheckTimeout = 5 * time.Second
+
+// componentNames are the components' display names
+var componentNames = map[string]string{
+	config.StatusComponentAPI:           "API",
+	config.StatusComponentPayments:      "Payments",
+	config.StatusComponentNotifications: "Notifications",
+}
+
+// componentSeverity orders statuses from best to worst
+var componentSeverity = map[string]int{
+	config.ComponentOperational: 0,
+	config.ComponentDegraded:    1,
+	config.ComponentMajorOutage: 2,
+}
+
+// worseStatus returns the worse of two component statuses
+func worseStatus(a, b string) string {
+	if componentSeverity[b] > componentSeverity[a] {
+		return b
+	}
+	return a
+}
+
+// StatusService checks component health and builds the status page
+type StatusService struct {
+	repo        *repository.StatusRepository
+	cache       *cache.CacheService
+	breakers    map[string][]*breaker.Breaker
+	historyDays int
+	clock       clock.Clock
+	audit       *AuditService
+}
+
+// NewStatusService creates a new status service (nil cache skips the cache check)
+func NewStatusService(repo *repository.StatusRepository, cache *cache.CacheService, cfg config.StatusConfig) *StatusService {
+	historyDays := cfg.HistoryDays
+	if historyDays <= 0 {
+		historyDays = config.DefaultStatusHistoryDays
+	}
+	if historyDays > config.MaxStatusHistoryDays {
+		historyDays = config.MaxStatusHistoryDays
+	}
+	return &StatusService{
+		repo:        repo,
+		cache:       cache,
+		breakers:    make(map[string][]*breaker.Breaker),
+		historyDays: historyDays,
+		clock:       clock.System,
+	}
+}
+
+// SetClock replaces the time source used for checks and history (nil restores the system clock)
+func (s *StatusService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// AddBreaker reports b's state as part of component's health. Nil
+// breakers are ignored.
+func (s *StatusService) AddBreaker(component string, b *breaker.Breaker) {
+	if b != nil {
+		s.breakers[component] = append(s.breakers[component], b)
+	}
+}
+
+// components returns the components shown on the status page: payments
+// only when a payment provider is configured
+func (s *StatusService) components() []string {
+	components := make([]string, 0, len(config.StatusComponents))
+	for _, component := range config.StatusComponents {
+		if component == config.StatusComponentPayments && len(s.breakers[component]) == 0 {
+			continue
+		}
+		components = append(components, component)
+	}
+	return components
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// StatusPage is the public status feed
+type StatusPage struct {
+	Status     string                  `json:"status"` // The worst component status
+	UpdatedAt  time.Time               `json:"updated_at"`
+	Components []ComponentReport       `json:"components"`
+	Incidents  []models.StatusIncident `json:"incidents"` // Ongoing, or resolved within the history window; newest first
+}
+
+// ComponentReport is one component's current status and daily history
+type ComponentReport struct {
+	Component     string         `json:"component"`
+	Name          string         `json:"name"`
+	Status        string         `json:"status"`
+	CheckedAt     *time.Time     `json:"checked_at,omitempty"`
+	UptimePercent float64        `json:"uptime_percent"` // Over the whole history
+	History       []ComponentDay `json:"history"`        // Oldest first, ending today (UTC)
+}
+
+// ComponentDay is a component's status over one UTC day
+type ComponentDay struct {
+	Date          string  `json:"date"`   // YYYY-MM-DD
+	Status        string  `json:"status"` // The worst incident impact that day
+	UptimePercent float64 `json:"uptime_percent"`
+}
+
+// CreateIncidentRequest posts an incident
+type CreateIncidentRequest struct {
+	Component string     `json:"component" binding:"required"`
+	Impact    string     `json:"impact" binding:"required"`
+	Title     string     `json:"title" binding:"required,max=200"`
+	Message   *string    `json:"message" binding:"omitempty,max=5000"`
+	StartedAt *time.Time `json:"started_at"` // Defaults to now
+}
+
+// UpdateIncidentRequest changes an incident (nil fields are left as they are)
+type UpdateIncidentRequest struct {
+	Impact   *string `json:"impact"`
+	Title    *string `json:"title" binding:"omitempty,min=1,max=200"`
+	Message  *string `json:"message" binding:"omitempty,max=5000"`
+	Resolved *bool   `json:"resolved"` // true resolves the incident now, false reopens it
+}
+
+// ========================================================================
+// HEALTH CHECKS
+// ========================================================================
+
+// RunChecks checks every component, records the results, and opens or
+// resolves their automatic incidents
+func (s *StatusService) RunChecks(ctx context.Context) error {
+	var errs []error
+	for _, component := range s.components() {
+		if err := s.checkComponent(ctx, component); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", component, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkComponent checks one component and records the result
+func (s *StatusService) checkComponent(ctx context.Context, component string) error {
+	status, detail := s.check(ctx, component)
+	now := s.clock.Now()
+
+	result := &models.ComponentStatus{
+		Component: component,
+		Status:    status,
+		CheckedAt: now,
+	}
+	if detail != "" {
+		result.Detail = &detail
+	}
+	if err := s.repo.SaveComponentStatus(ctx, result); err != nil {
+		return err
+	}
+
+	open, err := s.repo.FindOpenAutomaticIncident(ctx, component)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case status == config.ComponentOperational && open != nil:
+		open.ResolvedAt = &now
+		return s.repo.UpdateIncident(ctx, open)
+	case status == config.ComponentOperational:
+		return nil
+	case open == nil:
+		logger.FromContext(ctx).Warn("Component is not operational").
+			Str("component", component).
+			Str("status", status).
+			Str("detail", detail).
+			Send()
+		return s.repo.CreateIncident(ctx, &models.StatusIncident{
+			Component:   component,
+			Impact:      status,
+			Title:       incidentTitle(component, status),
+			Message:     result.Detail,
+			IsAutomatic: true,
+			StartedAt:   now,
+		})
+	case open.Impact != status:
+		open.Impact = status
+		open.Title = incidentTitle(component, status)
+		open.Message = result.Detail
+		return s.repo.UpdateIncident(ctx, open)
+	}
+	return nil
+}
+
+// check returns a component's status and, unless operational, why
+func (s *StatusService) check(ctx context.Context, component string) (string, string) {
+	if component == config.StatusComponentAPI {
+		ctx, cancel := context.WithTimeout(ctx, statusCheckTimeout)
+		defer cancel()
+
+		if err := s.repo.Ping(ctx); err != nil {
+			return config.ComponentMajorOutage, "The database is unreachable"
+		}
+		if s.cache != nil {
+			if err := s.cache.Ping(ctx); err != nil {
+				return config.ComponentDegraded, "The cache is unreachable; responses may be slower"
+			}
+		}
+		return config.ComponentOperational, ""
+	}
+
+	breakers := s.breakers[component]
+	open := 0
+	var failing []string
+	for _, b := range breakers {
+		switch b.State() {
+		case breaker.StateOpen:
+			open++
+			failing = append(failing, b.Name())
+		case breaker.StateHalfOpen:
+			failing = append(failing, b.Name())
+		}
+	}
+
+	detail := fmt.Sprintf("Failing provider: %s", strings.Join(failing, ", "))
+	switch {
+	case len(failing) == 0:
+		return config.ComponentOperational, ""
+	case open == len(breakers):
+		return config.ComponentMajorOutage, detail
+	default:
+		return config.ComponentDegraded, detail
+	}
+}
+
+// incidentTitle names an automatic incident
+func incidentTitle(component, impact string) string {
+	if impact == config.ComponentMajorOutage {
+		return componentNames[component] + " outage"
+	}
+	return componentNames[component] + " degraded"
+}
+
+// ========================================================================
+// STATUS PAGE
+// ========================================================================
+
+// GetStatusPage returns every component's current status and daily
+// history, and the incidents within the history window
+func (s *StatusService) GetStatusPage(ctx context.Context) (*StatusPage, error) {
+	now := s.clock.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	since := today.AddDate(0, 0, -(s.historyDays - 1))
+
+	statuses, err := s.repo.FindComponentStatuses(ctx)
+	if err != nil {
+		return nil, err
+	}
+	incidents, err := s.repo.FindIncidentsSince(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &StatusPage{
+		Status:     config.ComponentOperational,
+		UpdatedAt:  now,
+		Components: []ComponentReport{},
+		Incidents:  incidents,
+	}
+	for _, component := range s.components() {
+		report := s.componentReport(component, statuses, incidents, since, now)
+		page.Status = worseStatus(page.Status, report.Status)
+		page.Components = append(page.Components, report)
+	}
+	return page, nil
+}
+
+// componentReport builds one component's current status and history
+func (s *StatusService) componentReport(component string, statuses []models.ComponentStatus, incidents []models.StatusIncident, since, now time.Time) ComponentReport {
+	report := ComponentReport{
+		Component: component,
+		Name:      componentNames[component],
+		Status:    config.ComponentOperational,
+		History:   make([]ComponentDay, 0, s.historyDays),
+	}
+	for _, status := range statuses {
+		if status.Component == component {
+			report.Status = status.Status
+			checkedAt := status.CheckedAt
+			report.CheckedAt = &checkedAt
+		}
+	}
+
+	var own []models.StatusIncident
+	for _, incident := range incidents {
+		if incident.Component != component {
+			continue
+		}
+		own = append(own, incident)
+		if !incident.IsResolved() {
+			report.Status = worseStatus(report.Status, incident.Impact)
+		}
+	}
+
+	var total, down time.Duration
+	for day := since; day.Before(now); day = day.AddDate(0, 0, 1) {
+		end := day.AddDate(0, 0, 1)
+		if end.After(now) {
+			end = now
+		}
+		entry := ComponentDay{Date: day.Format("2006-01-02"), Status: config.ComponentOperational}
+		outage := time.Duration(0)
+		for i := range own {
+			overlap := own[i].Overlap(day, end)
+			if overlap == 0 {
+				continue
+			}
+			entry.Status = worseStatus(entry.Status, own[i].Impact)
+			if own[i].Impact == config.ComponentMajorOutage {
+				outage += overlap
+			}
+		}
+		// Overlapping outages must not count twice
+		length := end.Sub(day)
+		outage = min(outage, length)
+		entry.UptimePercent = uptimePercent(length, outage)
+		report.History = append(report.History, entry)
+		total += length
+		down += outage
+	}
+	report.UptimePercent = uptimePercent(total, down)
+	return report
+}
+
+// uptimePercent is the share of length not down, to two decimal places
+func uptimePercent(length, down time.Duration) float64 {
+	if length <= 0 {
+		return 100
+	}
+	return math.Round((1-float64(down)/float64(length))*10000) / 100
+}
+
+// ========================================================================
+// INCIDENTS
+// ========================================================================
+
+// validateIncidentImpact checks an incident's impact
+func validateIncidentImpact(impact string) error {
+	if impact != config.ComponentDegraded && impact != config.ComponentMajorOutage {
+		return fmt.Errorf("impact must be degraded or major_outage")
+	}
+	return nil
+}
+
+// SetAuditor records incidents posted or changed in the audit log
+func (s *StatusService) SetAuditor(audit *AuditService) {
+	s.audit = audit
+}
+
+// CreateIncident posts an incident for a component
+func (s *StatusService) CreateIncident(ctx context.Context, req CreateIncidentRequest, userID int) (*models.StatusIncident, error) {
+	if !slices.Contains(config.StatusComponents, req.Component) {
+		return nil, fmt.Errorf("component must be one of %s", strings.Join(config.StatusComponents, ", "))
+	}
+	if err := validateIncidentImpact(req.Impact); err != nil {
+		return nil, err
+	}
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		return nil, fmt.Errorf("title cannot be empty")
+	}
+
+	now := s.clock.Now()
+	startedAt := now
+	if req.StartedAt != nil {
+		if req.StartedAt.After(now) {
+			return nil, fmt.Errorf("started_at cannot be in the future")
+		}
+		startedAt = *req.StartedAt
+	}
+
+	incident := &models.StatusIncident{
+		Component: req.Component,
+		Impact:    req.Impact,
+		Title:     title,
+		Message:   req.Message,
+		CreatedBy: &userID,
+		StartedAt: startedAt,
+	}
+	if err := s.repo.CreateIncident(ctx, incident); err != nil {
+		return nil, err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionIncidentCreated, config.EntityTypeIncident, &incident.ID, nil, incident, nil)
+	return incident, nil
+}
+
+// UpdateIncident changes an incident's impact, title or message, or
+// resolves or reopens it
+func (s *StatusService) UpdateIncident(ctx context.Context, id int, req UpdateIncidentRequest) (*models.StatusIncident, error) {
+	incident, err := s.repo.FindIncidentByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *incident
+
+	if req.Impact != nil {
+		if err := validateIncidentImpact(*req.Impact); err != nil {
+			return nil, err
+		}
+		incident.Impact = *req.Impact
+	}
+	if req.Title != nil {
+		title := strings.TrimSpace(*req.Title)
+		if title == "" {
+			return nil, fmt.Errorf("title cannot be empty")
+		}
+		incident.Title = title
+	}
+	if req.Message != nil {
+		incident.Message = req.Message
+	}
+	if req.Resolved != nil {
+		switch {
+		case *req.Resolved && !incident.IsResolved():
+			now := s.clock.Now()
+			incident.ResolvedAt = &now
+		case !*req.Resolved:
+			incident.ResolvedAt = nil
+		}
+	}
+
+	if err := s.repo.UpdateIncident(ctx, incident); err != nil {
+		return nil, err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionIncidentUpdated, config.EntityTypeIncident, &id, &before, incident, nil)
+	return incident, nil
+}
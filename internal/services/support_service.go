@@ -0,0 +1,500 @@
+// internal/services/support_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// SUPPORT SERVICE - Support tickets, their workflow and SLA timers
+// ========================================================================
+//
+// Customers and barbers open tickets, optionally about one of their
+// bookings or its payment. Staff holding support:manage assign, answer and
+// move tickets through open -> in_progress -> waiting_on_customer ->
+// resolved -> closed. The requester is notified of replies and of their
+// ticket being resolved; assignees are notified of assignments, replies
+// and missed SLA targets.
+// ========================================================================
+
+// supportSLABatchSize caps the tickets flagged per SLA check
+const supportSLABatchSize = 100
+
+// permissionChecker resolves the permissions a user holds
+type permissionChecker interface {
+	Permissions(ctx context.Context, userID int, userType string) (map[string]bool, error)
+}
+
+// SupportService manages support tickets
+type SupportService struct {
+	repo                *repository.SupportTicketRepository
+	bookingService      *BookingService
+	userRepo            repository.UserStore
+	permissions         permissionChecker
+	notificationService *NotificationService
+	clock               clock.Clock
+}
+
+// NewSupportService creates a new support service
+func NewSupportService(
+	repo *repository.SupportTicketRepository,
+	bookingService *BookingService,
+	userRepo repository.UserStore,
+	permissions permissionChecker,
+	notificationService *NotificationService,
+) *SupportService {
+	return &SupportService{
+		repo:                repo,
+		bookingService:      bookingService,
+		userRepo:            userRepo,
+		permissions:         permissions,
+		notificationService: notificationService,
+		clock:               clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *SupportService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// CreateSupportTicketRequest opens a ticket
+type CreateSupportTicketRequest struct {
+	Category         string  `json:"category" binding:"required,oneof=booking payment account other" example:"payment"`
+	Subject          string  `json:"subject" binding:"required,max=200" example:"Charged twice for my haircut"`
+	Description      string  `json:"description" binding:"required,max=5000" example:"I see two charges of $45 on my card."`
+	BookingID        *int    `json:"booking_id" example:"42"`                       // One of your bookings
+	PaymentReference *string `json:"payment_reference" binding:"omitempty,max=255"` // Default: the booking's payment reference
+}
+
+// SupportTicketListRequest filters a ticket listing
+type SupportTicketListRequest struct {
+	Status     string `form:"status" binding:"omitempty,oneof=open in_progress waiting_on_customer resolved closed"`
+	Priority   string `form:"priority" binding:"omitempty,oneof=low normal high urgent"`
+	Category   string `form:"category" binding:"omitempty,oneof=booking payment account other"`
+	AssignedTo int    `form:"assigned_to" binding:"omitempty,min=1"` // Staff only
+	Breached   bool   `form:"breached"`                              // Staff only: tickets that missed an SLA target
+	Limit      int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset     int    `form:"offset" binding:"omitempty,min=0"`
+}
+
+// SupportReplyRequest adds a message to a ticket
+type SupportReplyRequest struct {
+	Body       string `json:"body" binding:"required,max=5000" example:"Thanks, that fixed it."`
+	IsInternal bool   `json:"is_internal" example:"false"`                                                                      // Staff only: a note the requester does not see
+	Status     string `json:"status" binding:"omitempty,oneof=open in_progress waiting_on_customer resolved closed" example:""` // Staff only: move the ticket with the reply
+}
+
+// UpdateSupportTicketRequest changes a ticket's workflow fields
+type UpdateSupportTicketRequest struct {
+	Status     *string `json:"status" binding:"omitempty,oneof=open in_progress waiting_on_customer resolved closed" example:"in_progress"`
+	Priority   *string `json:"priority" binding:"omitempty,oneof=low normal high urgent" example:"urgent"`
+	AssignedTo *int    `json:"assigned_to" example:"7"` // A staff member holding support:manage; 0 unassigns
+}
+
+// SupportTicketResponse is a ticket with where it stands against its SLA
+type SupportTicketResponse struct {
+	*models.SupportTicket
+	FirstResponseOverdue bool `json:"first_response_overdue"`
+	ResolutionOverdue    bool `json:"resolution_overdue"`
+	SLAPaused            bool `json:"sla_paused"`
+}
+
+// ========================================================================
+// REQUESTER OPERATIONS
+// ========================================================================
+
+// OpenTicket opens a ticket for a customer or barber. A booking on the
+// ticket must be one the requester may access.
+func (s *SupportService) OpenTicket(ctx context.Context, userID int, req CreateSupportTicketRequest) (*SupportTicketResponse, error) {
+	subject := strings.TrimSpace(req.Subject)
+	description := strings.TrimSpace(req.Description)
+	if subject == "" || description == "" {
+		return nil, fmt.Errorf("subject and description cannot be blank")
+	}
+
+	active, err := s.repo.CountActiveByRequester(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if active >= config.MaxOpenSupportTickets {
+		return nil, fmt.Errorf("cannot have more than %d open tickets", config.MaxOpenSupportTickets)
+	}
+
+	paymentReference := trimmedOrNil(req.PaymentReference)
+	if req.BookingID != nil {
+		booking, err := s.bookingService.AuthorizeBooking(ctx, *req.BookingID, BookingActor{UserID: userID})
+		if err != nil {
+			return nil, err
+		}
+		if paymentReference == nil && req.Category == config.SupportCategoryPayment {
+			paymentReference = booking.PaymentReference
+		}
+	}
+
+	ticket := &models.SupportTicket{
+		RequesterID:      userID,
+		BookingID:        req.BookingID,
+		PaymentReference: paymentReference,
+		Category:         req.Category,
+		Priority:         models.DefaultSupportPriority(req.Category),
+		Status:           config.SupportStatusOpen,
+		Subject:          subject,
+		CreatedAt:        s.clock.Now(),
+	}
+	ticket.ApplySLA()
+	message := &models.SupportTicketMessage{AuthorID: &userID, Body: description}
+	if err := s.repo.Create(ctx, ticket, message); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Support ticket opened").
+		Int("ticket_id", ticket.ID).
+		Str("category", ticket.Category).
+		Str("priority", ticket.Priority).
+		Send()
+
+	ticket.Messages = []models.SupportTicketMessage{*message}
+	return s.response(ticket), nil
+}
+
+// ListMine returns the requester's own tickets, newest first
+func (s *SupportService) ListMine(ctx context.Context, userID int, req *SupportTicketListRequest) ([]models.SupportTicket, error) {
+	if req.Limit == 0 {
+		req.Limit = config.DefaultPageLimit
+	}
+	return s.repo.FindAll(ctx, repository.SupportTicketFilters{
+		RequesterID: userID,
+		Status:      req.Status,
+		Priority:    req.Priority,
+		Category:    req.Category,
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+	})
+}
+
+// GetMine returns one of the requester's tickets with its conversation,
+// leaving out staff-only notes
+func (s *SupportService) GetMine(ctx context.Context, id, userID int) (*SupportTicketResponse, error) {
+	ticket, err := s.findOwn(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if ticket.Messages, err = s.repo.FindMessages(ctx, id, false); err != nil {
+		return nil, err
+	}
+	return s.response(ticket), nil
+}
+
+// Reply adds the requester's message to their ticket. A ticket waiting on
+// them goes back to support, and a resolved one is reopened.
+func (s *SupportService) Reply(ctx context.Context, id, userID int, req SupportReplyRequest) (*models.SupportTicketMessage, error) {
+	ticket, err := s.findOwn(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, fmt.Errorf("body cannot be blank")
+	}
+
+	now := s.clock.Now()
+	switch ticket.Status {
+	case config.SupportStatusClosed:
+		return nil, fmt.Errorf("ticket cannot be replied to once closed; open a new ticket")
+	case config.SupportStatusWaitingOnCustomer:
+		err = ticket.SetStatus(config.SupportStatusInProgress, now)
+	case config.SupportStatusResolved:
+		err = ticket.SetStatus(config.SupportStatusOpen, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	message := &models.SupportTicketMessage{AuthorID: &userID, Body: body}
+	if err := s.repo.Save(ctx, ticket, message); err != nil {
+		return nil, err
+	}
+
+	if ticket.AssignedTo != nil {
+		s.notify(ctx, *ticket.AssignedTo, ticket,
+			fmt.Sprintf("New reply on ticket #%d", ticket.ID),
+			fmt.Sprintf("The requester replied to \"%s\".", ticket.Subject))
+	}
+	return message, nil
+}
+
+// Close closes the requester's own ticket
+func (s *SupportService) Close(ctx context.Context, id, userID int) (*SupportTicketResponse, error) {
+	ticket, err := s.findOwn(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ticket.SetStatus(config.SupportStatusClosed, s.clock.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Save(ctx, ticket, nil); err != nil {
+		return nil, err
+	}
+	return s.response(ticket), nil
+}
+
+// ========================================================================
+// STAFF OPERATIONS
+// ========================================================================
+
+// List returns tickets for staff, soonest resolution due first
+func (s *SupportService) List(ctx context.Context, req *SupportTicketListRequest) ([]models.SupportTicket, error) {
+	if req.Limit == 0 {
+		req.Limit = config.DefaultPageLimit
+	}
+	return s.repo.FindAll(ctx, repository.SupportTicketFilters{
+		AssignedTo: req.AssignedTo,
+		Status:     req.Status,
+		Priority:   req.Priority,
+		Category:   req.Category,
+		Breached:   req.Breached,
+		ByDueDate:  true,
+		Limit:      req.Limit,
+		Offset:     req.Offset,
+	})
+}
+
+// Get returns a ticket for staff with its whole conversation
+func (s *SupportService) Get(ctx context.Context, id int) (*SupportTicketResponse, error) {
+	ticket, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ticket.Messages, err = s.repo.FindMessages(ctx, id, true); err != nil {
+		return nil, err
+	}
+	return s.response(ticket), nil
+}
+
+// Update changes a ticket's status, priority or assignee. A new priority
+// recalculates the SLA due dates; the new assignee is notified.
+func (s *SupportService) Update(ctx context.Context, id int, req UpdateSupportTicketRequest) (*SupportTicketResponse, error) {
+	ticket, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	previousAssignee := ticket.AssignedTo
+	if req.AssignedTo != nil {
+		if *req.AssignedTo == 0 {
+			ticket.AssignedTo = nil
+		} else {
+			if err := s.checkAssignee(ctx, *req.AssignedTo); err != nil {
+				return nil, err
+			}
+			ticket.AssignedTo = req.AssignedTo
+		}
+	}
+	if req.Priority != nil {
+		if err := ticket.SetPriority(*req.Priority); err != nil {
+			return nil, err
+		}
+	}
+	wasResolved := ticket.Status == config.SupportStatusResolved
+	if req.Status != nil {
+		if err := ticket.SetStatus(*req.Status, s.clock.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Save(ctx, ticket, nil); err != nil {
+		return nil, err
+	}
+
+	if ticket.AssignedTo != nil && (previousAssignee == nil || *previousAssignee != *ticket.AssignedTo) {
+		s.notify(ctx, *ticket.AssignedTo, ticket,
+			fmt.Sprintf("Ticket #%d assigned to you", ticket.ID),
+			fmt.Sprintf("\"%s\" (%s priority) is due to be resolved by %s.",
+				ticket.Subject, ticket.Priority, s.notificationService.FormatTimeFor(ctx, *ticket.AssignedTo, ticket.ResolutionDueAt)))
+	}
+	if !wasResolved && ticket.Status == config.SupportStatusResolved {
+		s.notifyRequesterResolved(ctx, ticket)
+	}
+	return s.response(ticket), nil
+}
+
+// StaffReply adds a staff message or internal note to a ticket. The first
+// reply the requester can see stops the first response timer; an open
+// ticket moves to in_progress unless the reply names another status.
+func (s *SupportService) StaffReply(ctx context.Context, id, staffID int, req SupportReplyRequest) (*models.SupportTicketMessage, error) {
+	ticket, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	body := strings.TrimSpace(req.Body)
+	if body == "" {
+		return nil, fmt.Errorf("body cannot be blank")
+	}
+	if ticket.Status == config.SupportStatusClosed {
+		return nil, fmt.Errorf("ticket cannot be replied to once closed")
+	}
+
+	now := s.clock.Now()
+	wasResolved := ticket.Status == config.SupportStatusResolved
+	status := req.Status
+	if status == "" && !req.IsInternal && ticket.Status == config.SupportStatusOpen {
+		status = config.SupportStatusInProgress
+	}
+	if status != "" {
+		if err := ticket.SetStatus(status, now); err != nil {
+			return nil, err
+		}
+	}
+	if !req.IsInternal {
+		ticket.RecordFirstResponse(now)
+	}
+
+	message := &models.SupportTicketMessage{AuthorID: &staffID, IsStaff: true, IsInternal: req.IsInternal, Body: body}
+	if err := s.repo.Save(ctx, ticket, message); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !wasResolved && ticket.Status == config.SupportStatusResolved:
+		s.notifyRequesterResolved(ctx, ticket)
+	case !req.IsInternal:
+		s.notify(ctx, ticket.RequesterID, ticket,
+			fmt.Sprintf("Support replied to ticket #%d", ticket.ID),
+			fmt.Sprintf("There is a new reply on \"%s\".", ticket.Subject))
+	}
+	return message, nil
+}
+
+// ========================================================================
+// SLA MONITORING
+// ========================================================================
+
+// RunSLAChecks flags active tickets that missed an SLA target since the
+// last run and notifies their assignees. Unassigned tickets are flagged
+// and logged. A failure for one ticket is logged and skipped.
+func (s *SupportService) RunSLAChecks(ctx context.Context) error {
+	now := s.clock.Now()
+	tickets, err := s.repo.FindNewlyBreached(ctx, now, supportSLABatchSize)
+	if err != nil {
+		return err
+	}
+
+	log := logger.FromContext(ctx)
+	for i := range tickets {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		ticket := &tickets[i]
+		firstResponse := ticket.FirstRespondedAt == nil && ticket.FirstResponseBreachedAt == nil &&
+			!now.Before(ticket.FirstResponseDueAt)
+		resolution := ticket.SLAPausedAt == nil && ticket.ResolutionBreachedAt == nil &&
+			!now.Before(ticket.ResolutionDueAt)
+
+		if err := s.repo.MarkBreached(ctx, ticket.ID, firstResponse, resolution, now); err != nil {
+			log.Warn("Failed to flag support ticket SLA breach").
+				Int("ticket_id", ticket.ID).
+				Err(err).
+				Send()
+			continue
+		}
+
+		target := "resolution"
+		if firstResponse {
+			target = "first response"
+		}
+		log.Warn("Support ticket missed its SLA").
+			Int("ticket_id", ticket.ID).
+			Str("priority", ticket.Priority).
+			Str("target", target).
+			Send()
+
+		if ticket.AssignedTo != nil {
+			if err := s.notificationService.SendSupportSLABreach(ctx, *ticket.AssignedTo, ticket, target); err != nil {
+				log.Warn("Failed to send SLA breach notification").
+					Int("ticket_id", ticket.ID).
+					Err(err).
+					Send()
+			}
+		}
+	}
+	return nil
+}
+
+// ========================================================================
+// HELPERS
+// ========================================================================
+
+// findOwn loads a ticket the user opened
+func (s *SupportService) findOwn(ctx context.Context, id, userID int) (*models.SupportTicket, error) {
+	ticket, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ticket.RequesterID != userID {
+		return nil, repository.ErrNotOwner
+	}
+	return ticket, nil
+}
+
+// checkAssignee verifies a user can work tickets: active and holding
+// support:manage
+func (s *SupportService) checkAssignee(ctx context.Context, userID int) error {
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("assignee not found: %w", err)
+	}
+	if user.Status != config.UserStatusActive {
+		return fmt.Errorf("assignee must be an active user")
+	}
+	perms, err := s.permissions.Permissions(ctx, user.ID, user.UserType)
+	if err != nil {
+		return err
+	}
+	if !perms[config.PermissionSupportManage] && !perms[config.PermissionAll] {
+		return fmt.Errorf("assignee must hold the %s permission", config.PermissionSupportManage)
+	}
+	return nil
+}
+
+// notify sends a ticket notification, logging failures: the ticket change
+// is already saved
+func (s *SupportService) notify(ctx context.Context, userID int, ticket *models.SupportTicket, title, message string) {
+	if err := s.notificationService.SendSupportTicketUpdate(ctx, userID, ticket, title, message); err != nil {
+		logger.FromContext(ctx).Warn("Failed to send support ticket notification").
+			Int("ticket_id", ticket.ID).
+			Int("user_id", userID).
+			Err(err).
+			Send()
+	}
+}
+
+// notifyRequesterResolved tells the requester their ticket was resolved
+func (s *SupportService) notifyRequesterResolved(ctx context.Context, ticket *models.SupportTicket) {
+	s.notify(ctx, ticket.RequesterID, ticket,
+		fmt.Sprintf("Ticket #%d resolved", ticket.ID),
+		fmt.Sprintf("\"%s\" has been resolved. Reply to the ticket if you still need help.", ticket.Subject))
+}
+
+// response adds the ticket's SLA standing
+func (s *SupportService) response(ticket *models.SupportTicket) *SupportTicketResponse {
+	now := s.clock.Now()
+	return &SupportTicketResponse{
+		SupportTicket:        ticket,
+		FirstResponseOverdue: ticket.FirstResponseOverdue(now),
+		ResolutionOverdue:    ticket.ResolutionOverdue(now),
+		SLAPaused:            ticket.SLAPausedAt != nil,
+	}
+}
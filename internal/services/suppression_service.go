@@ -0,0 +1,205 @@
+// internal/services/suppression_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// SUPPRESSION SERVICE - Email addresses and phone numbers not to message
+// ========================================================================
+//
+// Providers feed the list with hard bounces, spam complaints and opt-outs
+// (an SMS reply of STOP, or a send refused because the recipient
+// unsubscribed), and admins add entries by hand. The notification
+// dispatcher checks it before every email or SMS and skips suppressed
+// addresses. Admins override an entry by deleting it; an opt-out is also
+// lifted when the recipient opts back in.
+// ========================================================================
+
+// SuppressionService manages the outbound message suppression list
+type SuppressionService struct {
+	repo  *repository.SuppressionRepository
+	audit *AuditService
+}
+
+// NewSuppressionService creates a new suppression service
+func NewSuppressionService(repo *repository.SuppressionRepository) *SuppressionService {
+	return &SuppressionService{repo: repo}
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// SuppressionListRequest pages through the suppression list
+type SuppressionListRequest struct {
+	Channel string `form:"channel" binding:"omitempty,oneof=email sms"`
+	Reason  string `form:"reason" binding:"omitempty,oneof=hard_bounce spam_complaint opt_out manual"`
+	Address string `form:"address" binding:"omitempty,max=255"` // Matches part of an address
+	Limit   int    `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset  int    `form:"offset" binding:"omitempty,min=0"`
+}
+
+// CreateSuppressionRequest suppresses an address by hand
+type CreateSuppressionRequest struct {
+	Channel string  `json:"channel" binding:"required,oneof=email sms" example:"email"`
+	Address string  `json:"address" binding:"required,max=255" example:"customer@example.com"`
+	Reason  string  `json:"reason" binding:"omitempty,oneof=hard_bounce spam_complaint opt_out manual" example:"manual"` // Default: manual
+	Detail  *string `json:"detail" binding:"omitempty,max=500" example:"Customer asked by phone not to be emailed"`
+}
+
+// SuppressionEntry is an address reported as undeliverable or unwanted
+type SuppressionEntry struct {
+	Channel   string
+	Address   string
+	Reason    string
+	Source    string // Provider name, or admin
+	Detail    string
+	CreatedBy *int
+}
+
+// ========================================================================
+// DISPATCH
+// ========================================================================
+
+// IsSuppressed reports whether messages to address on channel must be skipped
+func (s *SuppressionService) IsSuppressed(ctx context.Context, channel, address string) (bool, error) {
+	_, err := s.repo.FindByAddress(ctx, channel, models.NormalizeAddress(channel, address))
+	if errors.Is(err, repository.ErrSuppressionNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Suppress adds an address to the list, or updates the reason of one that
+// is already on it
+func (s *SuppressionService) Suppress(ctx context.Context, entry SuppressionEntry) (*models.Suppression, error) {
+	if !slices.Contains(config.SuppressionChannels, entry.Channel) {
+		return nil, fmt.Errorf("channel must be one of: email, sms")
+	}
+	if !slices.Contains(config.SuppressionReasons, entry.Reason) {
+		return nil, fmt.Errorf("reason must be one of: hard_bounce, spam_complaint, opt_out, manual")
+	}
+	address := models.NormalizeAddress(entry.Channel, entry.Address)
+	if address == "" {
+		return nil, fmt.Errorf("address must not be empty")
+	}
+
+	suppression := &models.Suppression{
+		Channel:   entry.Channel,
+		Address:   address,
+		Reason:    entry.Reason,
+		Source:    entry.Source,
+		CreatedBy: entry.CreatedBy,
+	}
+	if entry.Detail != "" {
+		suppression.Detail = &entry.Detail
+	}
+	if err := s.repo.Upsert(ctx, suppression); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Address suppressed").
+		Int("suppression_id", suppression.ID).
+		Str("channel", suppression.Channel).
+		Str("reason", suppression.Reason).
+		Str("source", suppression.Source).
+		Send()
+	return suppression, nil
+}
+
+// LiftOptOut removes an opt-out after the recipient opted back in. Other
+// suppressions (bounces, complaints, admin entries) stay in place.
+func (s *SuppressionService) LiftOptOut(ctx context.Context, channel, address string) error {
+	lifted, err := s.repo.DeleteByAddress(ctx, channel, models.NormalizeAddress(channel, address), config.SuppressionReasonOptOut)
+	if err != nil {
+		return err
+	}
+	if lifted {
+		logger.FromContext(ctx).Info("Opt-out lifted").
+			Str("channel", channel).
+			Send()
+	}
+	return nil
+}
+
+// ========================================================================
+// ADMIN
+// ========================================================================
+
+// List returns suppressions, newest first
+func (s *SuppressionService) List(ctx context.Context, req *SuppressionListRequest) ([]models.Suppression, error) {
+	if req.Limit == 0 {
+		req.Limit = config.DefaultPageLimit
+	}
+	address := req.Address
+	if req.Channel != "" {
+		address = models.NormalizeAddress(req.Channel, address)
+	}
+
+	return s.repo.FindAll(ctx, repository.SuppressionFilters{
+		Channel: req.Channel,
+		Reason:  req.Reason,
+		Address: address,
+		Limit:   req.Limit,
+		Offset:  req.Offset,
+	})
+}
+
+// Get returns one suppression
+func (s *SuppressionService) Get(ctx context.Context, id int) (*models.Suppression, error) {
+	return s.repo.FindByID(ctx, id)
+}
+
+// SetAuditor records suppressions admins add or lift in the audit log
+func (s *SuppressionService) SetAuditor(audit *AuditService) {
+	s.audit = audit
+}
+
+// Create suppresses an address on an admin's behalf
+func (s *SuppressionService) Create(ctx context.Context, adminID int, req *CreateSuppressionRequest) (*models.Suppression, error) {
+	entry := SuppressionEntry{
+		Channel:   req.Channel,
+		Address:   req.Address,
+		Reason:    req.Reason,
+		Source:    config.SuppressionSourceAdmin,
+		CreatedBy: &adminID,
+	}
+	if entry.Reason == "" {
+		entry.Reason = config.SuppressionReasonManual
+	}
+	if req.Detail != nil {
+		entry.Detail = *req.Detail
+	}
+	suppression, err := s.Suppress(ctx, entry)
+	if err != nil {
+		return nil, err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionAddressSuppressed, config.EntityTypeSuppression, &suppression.ID, nil, suppression, nil)
+	return suppression, nil
+}
+
+// Delete lifts a suppression so the address is messaged again
+func (s *SuppressionService) Delete(ctx context.Context, id int) error {
+	suppression, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionSuppressionLifted, config.EntityTypeSuppression, &id, suppression, nil, nil)
+	return nil
+}
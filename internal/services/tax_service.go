@@ -0,0 +1,208 @@
+// internal/services/tax_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// TAX SERVICE - Tax rule administration and tax reports
+// ========================================================================
+
+// TaxService manages tax rules and reports the taxes charged on bookings
+type TaxService struct {
+	repo       *repository.TaxRepository
+	barberRepo repository.BarberStore
+	clock      clock.Clock
+	audit      *AuditService
+}
+
+// NewTaxService creates a new tax service
+func NewTaxService(repo *repository.TaxRepository, barberRepo repository.BarberStore) *TaxService {
+	return &TaxService{
+		repo:       repo,
+		barberRepo: barberRepo,
+		clock:      clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *TaxService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// TaxRuleRequest creates or replaces a tax rule. Set barber_id for one
+// barber, country (and optionally state) for a region, or neither for a
+// rule that applies everywhere. Shop rules are created through the shop and
+// take neither.
+type TaxRuleRequest struct {
+	Name        string  `json:"name" binding:"required,max=100" example:"NY sales tax"`
+	RatePercent float64 `json:"rate_percent" example:"8.875"`
+	Inclusive   bool    `json:"inclusive" example:"false"` // Prices already include the tax
+	BarberID    *int    `json:"barber_id" example:"3"`
+	Country     *string `json:"country" binding:"omitempty,max=100" example:"USA"`
+	State       *string `json:"state" binding:"omitempty,max=100" example:"NY"`
+	IsActive    *bool   `json:"is_active" example:"true"` // Default: true
+}
+
+// TaxReportQuery selects a tax report date range (UTC days, inclusive) and
+// optionally a barber
+type TaxReportQuery struct {
+	From     string `form:"from" example:"2024-06-01"` // Default: 29 days before to
+	To       string `form:"to" example:"2024-06-30"`   // Default: today
+	BarberID *int   `form:"barber_id" example:"3"`
+}
+
+// ========================================================================
+// TAX RULES
+// ========================================================================
+
+// ListRules retrieves every tax rule
+func (s *TaxService) ListRules(ctx context.Context) ([]models.TaxRule, error) {
+	return s.repo.FindAll(ctx)
+}
+
+// SetAuditor records platform tax rule changes in the audit log
+func (s *TaxService) SetAuditor(audit *AuditService) {
+	s.audit = audit
+}
+
+// CreateRule adds a tax rule. It applies to bookings made from then on.
+func (s *TaxService) CreateRule(ctx context.Context, req TaxRuleRequest) (*models.TaxRule, error) {
+	rule := &models.TaxRule{IsActive: true}
+	if err := s.applyRuleRequest(ctx, rule, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, rule); err != nil {
+		return nil, err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionTaxRuleCreated, config.EntityTypeTaxRule, &rule.ID, nil, rule, nil)
+	return rule, nil
+}
+
+// CreateShopRule adds a tax rule for a shop's barbers
+func (s *TaxService) CreateShopRule(ctx context.Context, shopID int, req TaxRuleRequest) (*models.TaxRule, error) {
+	rule := &models.TaxRule{ShopID: &shopID, IsActive: true}
+	if err := s.applyRuleRequest(ctx, rule, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// UpdateRule replaces a tax rule. Bookings already made keep the taxes
+// they were charged.
+func (s *TaxService) UpdateRule(ctx context.Context, id int, req TaxRuleRequest) (*models.TaxRule, error) {
+	rule, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	before := *rule
+	if err := s.applyRuleRequest(ctx, rule, req); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionTaxRuleUpdated, config.EntityTypeTaxRule, &id, &before, rule, nil)
+	return rule, nil
+}
+
+// DeleteRule removes a tax rule
+func (s *TaxService) DeleteRule(ctx context.Context, id int) error {
+	rule, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.audit.RecordChange(ctx, config.AuditActionTaxRuleDeleted, config.EntityTypeTaxRule, &id, rule, nil, nil)
+	return nil
+}
+
+// applyRuleRequest validates a request and copies it onto the rule
+func (s *TaxService) applyRuleRequest(ctx context.Context, rule *models.TaxRule, req TaxRuleRequest) error {
+	// A 0% rule exempts its scope from less specific rules
+	if req.RatePercent < 0 || req.RatePercent >= config.MaxTaxRatePercent {
+		return fmt.Errorf("rate_percent must be at least 0 and below %d", config.MaxTaxRatePercent)
+	}
+
+	country, state := trimmedOrNil(req.Country), trimmedOrNil(req.State)
+	if req.BarberID != nil && (country != nil || state != nil) {
+		return fmt.Errorf("a barber's tax rule cannot also have a country or state")
+	}
+	if rule.ShopID != nil && (req.BarberID != nil || country != nil || state != nil) {
+		return fmt.Errorf("a shop's tax rule cannot also have a barber, country or state")
+	}
+	if state != nil && country == nil {
+		return fmt.Errorf("a state tax rule must have a country")
+	}
+	if req.BarberID != nil {
+		if _, err := s.barberRepo.FindByID(ctx, *req.BarberID); err != nil {
+			return err
+		}
+	}
+
+	rule.Name = strings.TrimSpace(req.Name)
+	rule.RatePercent = req.RatePercent
+	rule.Inclusive = req.Inclusive
+	rule.BarberID = req.BarberID
+	rule.Country = country
+	rule.State = state
+	if req.IsActive != nil {
+		rule.IsActive = *req.IsActive
+	}
+	return nil
+}
+
+// trimmedOrNil trims a string, treating blank as unset
+func trimmedOrNil(value *string) *string {
+	if value == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*value)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
+// ========================================================================
+// REPORTS
+// ========================================================================
+
+// GetReport totals the taxes charged on completed bookings by tax
+func (s *TaxService) GetReport(ctx context.Context, query TaxReportQuery) (*models.TaxReport, error) {
+	from, to, err := resolveDayRange(s.clock.Now(), query.From, query.To,
+		config.DefaultTaxReportRangeDays, config.MaxTaxReportRangeDays)
+	if err != nil {
+		return nil, err
+	}
+	if query.BarberID != nil {
+		if _, err := s.barberRepo.FindByID(ctx, *query.BarberID); err != nil {
+			return nil, err
+		}
+	}
+
+	// The range is inclusive of the last day
+	lines, err := s.repo.Report(ctx, from, to.AddDate(0, 0, 1), query.BarberID)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.NewTaxReport(query.BarberID, from, to, lines), nil
+}
@@ -0,0 +1,281 @@
+// internal/services/time_off_service.go
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// TIME OFF SERVICE - Vacations and other periods barbers are away
+// ========================================================================
+//
+// Time off blocks whole days (in the barber's timezone) or an exact range.
+// Existing bookings in the blocked period are kept and reported back; with
+// offer_reschedule their customers are asked to move them and sent the
+// barber's next open slots.
+// ========================================================================
+
+// TimeOffService manages barbers' time off
+type TimeOffService struct {
+	repo                *repository.TimeOffRepository
+	bookingRepo         repository.BookingStore
+	barberRepo          repository.BarberStore
+	bookingService      *BookingService
+	notificationService *NotificationService
+	clock               clock.Clock
+}
+
+// NewTimeOffService creates a new time off service
+func NewTimeOffService(
+	repo *repository.TimeOffRepository,
+	bookingRepo repository.BookingStore,
+	barberRepo repository.BarberStore,
+	bookingService *BookingService,
+	notificationService *NotificationService,
+) *TimeOffService {
+	return &TimeOffService{
+		repo:                repo,
+		bookingRepo:         bookingRepo,
+		barberRepo:          barberRepo,
+		bookingService:      bookingService,
+		notificationService: notificationService,
+		clock:               clock.System,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *TimeOffService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// CreateTimeOffRequest blocks out whole dates or an exact range. Give
+// either start_date (and optionally end_date) or start_at and end_at.
+type CreateTimeOffRequest struct {
+	StartDate       string     `json:"start_date" example:"2025-08-04"` // Whole days in the barber's timezone
+	EndDate         string     `json:"end_date" example:"2025-08-08"`   // Inclusive; defaults to start_date
+	StartAt         *time.Time `json:"start_at"`                        // Exact range instead of dates
+	EndAt           *time.Time `json:"end_at"`
+	Reason          *string    `json:"reason" binding:"omitempty,max=200" example:"Summer vacation"`
+	OfferReschedule bool       `json:"offer_reschedule"` // Ask affected customers to move their bookings
+}
+
+// AffectedBooking is an active booking inside newly blocked time
+type AffectedBooking struct {
+	BookingID          int                `json:"booking_id"`
+	BookingNumber      string             `json:"booking_number"`
+	CustomerID         *int               `json:"customer_id"`
+	ScheduledStartTime time.Time          `json:"scheduled_start_time"`
+	ScheduledEndTime   time.Time          `json:"scheduled_end_time"`
+	RescheduleOffered  bool               `json:"reschedule_offered"`
+	SuggestedSlots     []models.TimeRange `json:"suggested_slots"`
+}
+
+// TimeOffResponse is created time off with the bookings it affects
+type TimeOffResponse struct {
+	*models.TimeOff
+	AffectedBookings []AffectedBooking `json:"affected_bookings"`
+}
+
+// ========================================================================
+// OPERATIONS
+// ========================================================================
+
+// authorize ensures the user may manage the barber's time off: admins may
+// manage anyone's, barbers only their own
+func (s *TimeOffService) authorize(ctx context.Context, barberID, userID int, isAdmin bool) (*models.Barber, error) {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return nil, repository.ErrNotOwner
+	}
+	return barber, nil
+}
+
+// List returns the barber's time off that has not ended yet
+func (s *TimeOffService) List(ctx context.Context, barberID, userID int, isAdmin bool) ([]models.TimeOff, error) {
+	if _, err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.repo.FindUpcoming(ctx, barberID, s.clock.Now())
+}
+
+// Create blocks out time for the barber and reports the active bookings
+// inside it, offering their customers a reschedule if requested
+func (s *TimeOffService) Create(ctx context.Context, barberID, userID int, isAdmin bool, req CreateTimeOffRequest) (*TimeOffResponse, error) {
+	barber, err := s.authorize(ctx, barberID, userID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	timeOff := &models.TimeOff{
+		BarberID:  barberID,
+		Reason:    trimmedOrNil(req.Reason),
+		CreatedBy: &userID,
+	}
+	switch {
+	case req.StartDate != "" && (req.StartAt != nil || req.EndAt != nil):
+		return nil, fmt.Errorf("give either start_date and end_date or start_at and end_at, not both")
+	case req.StartDate != "":
+		blocked, err := s.resolveDates(ctx, barberID, req.StartDate, req.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		timeOff.StartAt, timeOff.EndAt, timeOff.AllDay = blocked.Start, blocked.End, true
+	case req.StartAt != nil && req.EndAt != nil:
+		timeOff.StartAt, timeOff.EndAt = *req.StartAt, *req.EndAt
+	default:
+		return nil, fmt.Errorf("start_date or start_at and end_at are required")
+	}
+	if err := timeOff.Validate(); err != nil {
+		return nil, err
+	}
+	if !timeOff.EndAt.After(s.clock.Now()) {
+		return nil, fmt.Errorf("time off must end in the future")
+	}
+
+	if err := s.repo.Create(ctx, timeOff); err != nil {
+		return nil, err
+	}
+
+	bookings, err := s.bookingRepo.FindActiveInRange(ctx, barberID, timeOff.StartAt, timeOff.EndAt)
+	if err != nil {
+		return nil, err
+	}
+
+	log := logger.FromContext(ctx)
+	resp := &TimeOffResponse{TimeOff: timeOff, AffectedBookings: []AffectedBooking{}}
+	suggestions := make(map[int][]models.TimeRange) // By duration in minutes
+	for i := range bookings {
+		booking := &bookings[i]
+		affected := AffectedBooking{
+			BookingID:          booking.ID,
+			BookingNumber:      booking.BookingNumber,
+			CustomerID:         booking.CustomerID,
+			ScheduledStartTime: booking.ScheduledStartTime,
+			ScheduledEndTime:   booking.ScheduledEndTime,
+			SuggestedSlots:     []models.TimeRange{},
+		}
+
+		if req.OfferReschedule && booking.CustomerID != nil {
+			minutes := int(booking.GetDuration().Minutes())
+			slots, ok := suggestions[minutes]
+			if !ok {
+				slots = s.suggestSlots(ctx, barberID, timeOff, minutes)
+				suggestions[minutes] = slots
+			}
+			affected.SuggestedSlots = slots
+
+			if err := s.notificationService.SendRescheduleOffer(ctx, booking, barber.ShopName, timeOff, slots); err != nil {
+				log.Warn("Failed to send reschedule offer").
+					Int("booking_id", booking.ID).
+					Int("time_off_id", timeOff.ID).
+					Err(err).
+					Send()
+			} else {
+				affected.RescheduleOffered = true
+			}
+		}
+		resp.AffectedBookings = append(resp.AffectedBookings, affected)
+	}
+
+	log.Info("Barber time off added").
+		Int("barber_id", barberID).
+		Int("time_off_id", timeOff.ID).
+		Int("affected_bookings", len(resp.AffectedBookings)).
+		Send()
+	return resp, nil
+}
+
+// Delete removes one of the barber's time off blocks
+func (s *TimeOffService) Delete(ctx context.Context, barberID, timeOffID, userID int, isAdmin bool) error {
+	if _, err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, barberID, timeOffID)
+}
+
+// ========================================================================
+// HELPERS
+// ========================================================================
+
+// resolveDates converts a YYYY-MM-DD date range into whole days in the
+// barber's timezone (UTC without a schedule)
+func (s *TimeOffService) resolveDates(ctx context.Context, barberID int, start, end string) (models.TimeRange, error) {
+	startDate, err := time.Parse("2006-01-02", start)
+	if err != nil {
+		return models.TimeRange{}, fmt.Errorf("start_date must be in YYYY-MM-DD format")
+	}
+	endDate := startDate
+	if end != "" {
+		if endDate, err = time.Parse("2006-01-02", end); err != nil {
+			return models.TimeRange{}, fmt.Errorf("end_date must be in YYYY-MM-DD format")
+		}
+	}
+	if endDate.Before(startDate) {
+		return models.TimeRange{}, fmt.Errorf("end_date must be on or after start_date")
+	}
+
+	loc := time.UTC
+	schedule, err := s.bookingService.loadSchedule(ctx, barberID)
+	if err != nil {
+		return models.TimeRange{}, err
+	}
+	if schedule != nil {
+		loc = schedule.Location()
+	}
+	return models.TimeOffDays(startDate, endDate, loc), nil
+}
+
+// suggestSlots finds the barber's first open slots of the given length
+// after the time off ends. Failures only leave the offer without
+// suggestions.
+func (s *TimeOffService) suggestSlots(ctx context.Context, barberID int, timeOff *models.TimeOff, minutes int) []models.TimeRange {
+	if minutes < 15 || minutes > 480 {
+		minutes = config.DefaultBookingDurationMinutes
+	}
+
+	loc := time.UTC
+	if schedule, err := s.bookingService.loadSchedule(ctx, barberID); err == nil && schedule != nil {
+		loc = schedule.Location()
+	}
+
+	slots := []models.TimeRange{}
+	day := timeOff.EndAt.In(loc)
+	for i := 0; i < config.TimeOffRescheduleSearchDays && len(slots) < config.TimeOffRescheduleSuggestions; i++ {
+		resp, err := s.bookingService.GetAvailableSlots(ctx, barberID, AvailabilityRequest{
+			Date:     day.AddDate(0, 0, i).Format("2006-01-02"),
+			Duration: minutes,
+		})
+		if err != nil {
+			logger.FromContext(ctx).Warn("Failed to find reschedule suggestions").
+				Int("barber_id", barberID).
+				Int("time_off_id", timeOff.ID).
+				Err(err).
+				Send()
+			break
+		}
+		for _, slot := range resp.Slots {
+			if len(slots) == config.TimeOffRescheduleSuggestions {
+				break
+			}
+			if !slot.Start.Before(timeOff.EndAt) {
+				slots = append(slots, slot)
+			}
+		}
+	}
+	return slots
+}
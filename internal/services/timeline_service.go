@@ -0,0 +1,121 @@
+// internal/services/timeline_service.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// TIMELINE SERVICE - Customer activity timeline (read model)
+// ========================================================================
+//
+// The "my activity" screen reads a denormalized customer_timeline table
+// instead of joining bookings, history, reviews and payments per request.
+// The table is a projection of those sources: reads compare the stored
+// source version with the live one and rebuild the customer's rows from
+// the event stream when they differ (or were never built).
+// ========================================================================
+
+// TimelineService builds and serves customer activity timelines
+type TimelineService struct {
+	repo *repository.CustomerTimelineRepository
+}
+
+// NewTimelineService creates a new timeline service
+func NewTimelineService(repo *repository.CustomerTimelineRepository) *TimelineService {
+	return &TimelineService{repo: repo}
+}
+
+// TimelineResponse is a page of a customer's activity timeline
+type TimelineResponse struct {
+	Entries   []models.TimelineEntry `json:"entries"`
+	RebuiltAt time.Time              `json:"rebuilt_at"`
+	Rebuilt   bool                   `json:"rebuilt"` // True when this request refreshed the projection
+}
+
+// GetTimeline returns a page of the customer's timeline, newest first,
+// rebuilding the projection first if the source events have changed
+func (s *TimelineService) GetTimeline(ctx context.Context, customerID int, filters repository.TimelineFilters) (*TimelineResponse, error) {
+	if filters.Limit <= 0 {
+		filters.Limit = config.DefaultPageLimit
+	}
+	if filters.Limit > config.MaxElevatedPageLimit {
+		filters.Limit = config.MaxElevatedPageLimit
+	}
+
+	state, rebuilt, err := s.ensureFresh(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.repo.FindEntries(ctx, customerID, filters)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get timeline: %w", err)
+	}
+	if entries == nil {
+		entries = []models.TimelineEntry{}
+	}
+
+	return &TimelineResponse{Entries: entries, RebuiltAt: state.RebuiltAt, Rebuilt: rebuilt}, nil
+}
+
+// Rebuild replays the customer's event stream into the timeline table
+func (s *TimelineService) Rebuild(ctx context.Context, customerID int) (*models.TimelineState, error) {
+	// Read the version first: events landing mid-rebuild leave the state
+	// behind the source, so the next read rebuilds again
+	version, err := s.repo.SourceVersion(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.repo.LoadEvents(ctx, customerID)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := models.ProjectTimeline(customerID, events)
+	state, err := s.repo.Replace(ctx, customerID, entries, version)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Debug("Customer timeline rebuilt").
+		Int("customer_id", customerID).
+		Int("events", len(events)).
+		Int("entries", len(entries)).
+		Send()
+
+	return state, nil
+}
+
+// ensureFresh rebuilds the timeline when it is missing or behind its sources
+func (s *TimelineService) ensureFresh(ctx context.Context, customerID int) (*models.TimelineState, bool, error) {
+	state, err := s.repo.FindState(ctx, customerID)
+	if err != nil && !errors.Is(err, repository.ErrCustomerTimelineNotFound) {
+		return nil, false, err
+	}
+
+	if state != nil {
+		version, err := s.repo.SourceVersion(ctx, customerID)
+		if err != nil {
+			return nil, false, err
+		}
+		if state.IsCurrent(version) {
+			return state, false, nil
+		}
+	}
+
+	state, err = s.Rebuild(ctx, customerID)
+	if err != nil {
+		return nil, false, err
+	}
+	return state, true, nil
+}
@@ -0,0 +1,252 @@
+// internal/services/user_oauth.go
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/oauth"
+	"barber-booking-system/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// ========================================================================
+// SOCIAL SIGN-IN - Google and Apple accounts
+// ========================================================================
+//
+// The client signs in with the provider and sends us the ID token. A
+// provider account already linked to a user signs that user in. Otherwise
+// it is linked by email: when the provider has verified the address, it is
+// linked to the local account with that email, or a new account is created
+// for it. Nothing is linked automatically unless both sides have verified
+// the address: a provider's unverified address proves nothing, and a local
+// account that never verified its address may have been registered by
+// someone else ahead of its owner. Either way the owner can sign in with
+// their password and link the provider account from there.
+//
+// Social sign-ins issue the same access and refresh tokens as a password
+// login. Accounts created this way have no password.
+// ========================================================================
+
+// SocialLoginRequest signs in with a provider ID token
+type SocialLoginRequest struct {
+	IDToken string `json:"id_token" binding:"required"`
+	Nonce   string `json:"nonce"`                                  // Checked against the token's nonce claim when set
+	Name    string `json:"name" binding:"omitempty,min=2,max=100"` // For new accounts (Apple only shares it with the client)
+}
+
+// LinkIdentityRequest links a provider account to the current user
+type LinkIdentityRequest struct {
+	IDToken string `json:"id_token" binding:"required"`
+	Nonce   string `json:"nonce"`
+}
+
+// SetOAuthProviders enables social sign-in with the given providers. Nil
+// verifiers are ignored.
+func (s *UserService) SetOAuthProviders(identities repository.UserIdentityStore, verifiers ...oauth.Verifier) {
+	s.identities = identities
+	s.oauthVerifiers = make(map[string]oauth.Verifier, len(verifiers))
+	for _, verifier := range verifiers {
+		if verifier != nil {
+			s.oauthVerifiers[verifier.Name()] = verifier
+		}
+	}
+}
+
+// SocialLogin signs in, links or registers the owner of a provider ID token
+func (s *UserService) SocialLogin(ctx context.Context, provider string, req SocialLoginRequest) (*AuthResponse, error) {
+	identity, err := s.verifyIdentity(ctx, provider, req.IDToken, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	var user *models.User
+	link, err := s.identities.FindByProviderSubject(ctx, identity.Provider, identity.Subject)
+	switch {
+	case err == nil:
+		user, err = s.userRepo.FindByID(ctx, link.UserID)
+		if err != nil {
+			return nil, err
+		}
+	case errors.Is(err, repository.ErrUserIdentityNotFound):
+		user, link, err = s.linkByEmail(ctx, identity, req.Name)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	if user.Status != config.UserStatusActive {
+		return nil, fmt.Errorf("account is %s. Please contact support", user.Status)
+	}
+
+	log := logger.FromContext(ctx)
+	if err := s.userRepo.UpdateLastLogin(ctx, user.ID); err != nil {
+		log.Warn("Failed to update last login").Int("user_id", user.ID).Err(err).Send()
+	}
+	if err := s.identities.UpdateLastLogin(ctx, link.ID); err != nil {
+		log.Warn("Failed to update sign-in account").Int("user_id", user.ID).Err(err).Send()
+	}
+
+	return s.newAuthResponse(ctx, user)
+}
+
+// linkByEmail links a provider account seen for the first time to the user
+// with its verified email, creating the user if there is none. A local
+// account whose email is unverified is not linked: whoever registered it
+// keeps its password, so linking would hand the provider account's owner an
+// account someone else can still sign in to.
+func (s *UserService) linkByEmail(ctx context.Context, identity *oauth.Identity, name string) (*models.User, *models.UserIdentity, error) {
+	if identity.Email == "" || !identity.EmailVerified {
+		return nil, nil, repository.ErrIdentityEmailUnverified
+	}
+	log := logger.FromContext(ctx)
+
+	user, err := s.userRepo.FindByEmail(ctx, identity.Email)
+	switch {
+	case err == nil:
+		if !user.EmailVerified {
+			log.Warn("Sign-in account not linked to unverified account").
+				Int("user_id", user.ID).
+				Str("provider", identity.Provider).
+				Send()
+			return nil, nil, repository.ErrAccountEmailUnverified
+		}
+	case errors.Is(err, repository.ErrUserNotFound):
+		if user, err = s.createSocialUser(ctx, identity, name); err != nil {
+			return nil, nil, err
+		}
+	default:
+		return nil, nil, err
+	}
+
+	link, err := s.linkIdentity(ctx, user.ID, identity)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Info("Sign-in account linked").
+		Int("user_id", user.ID).
+		Str("provider", identity.Provider).
+		Send()
+	return user, link, nil
+}
+
+// createSocialUser registers a customer account without a password
+func (s *UserService) createSocialUser(ctx context.Context, identity *oauth.Identity, name string) (*models.User, error) {
+	if name == "" {
+		name = identity.Name
+	}
+	if len(strings.TrimSpace(name)) < 2 {
+		name, _, _ = strings.Cut(identity.Email, "@")
+	}
+
+	user := &models.User{
+		UUID:          uuid.New().String(),
+		Email:         identity.Email,
+		PasswordHash:  "", // Social-only account; never matches a password
+		Name:          name,
+		UserType:      config.UserTypeCustomer,
+		Status:        config.UserStatusActive,
+		EmailVerified: true,
+		Preferences: models.JSONMap{
+			"language": "en",
+			"timezone": "UTC",
+		},
+		NotificationSettings: models.JSONMap{
+			"email": true,
+			"sms":   false,
+			"push":  true,
+		},
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	return user, nil
+}
+
+// ========================================================================
+// ACCOUNT LINKING
+// ========================================================================
+
+// LinkIdentity links a provider account to a signed-in user
+func (s *UserService) LinkIdentity(ctx context.Context, userID int, provider string, req LinkIdentityRequest) (*models.UserIdentity, error) {
+	identity, err := s.verifyIdentity(ctx, provider, req.IDToken, req.Nonce)
+	if err != nil {
+		return nil, err
+	}
+	return s.linkIdentity(ctx, userID, identity)
+}
+
+// ListIdentities returns the provider accounts linked to a user
+func (s *UserService) ListIdentities(ctx context.Context, userID int) ([]models.UserIdentity, error) {
+	if s.identities == nil {
+		return []models.UserIdentity{}, nil
+	}
+	return s.identities.FindByUser(ctx, userID)
+}
+
+// UnlinkIdentity removes a user's provider account. A user without a
+// password keeps at least one linked account so they can still sign in.
+func (s *UserService) UnlinkIdentity(ctx context.Context, userID int, provider string) error {
+	if s.identities == nil {
+		return repository.ErrUserIdentityNotFound
+	}
+
+	user, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.PasswordHash == "" {
+		linked, err := s.identities.FindByUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if len(linked) <= 1 {
+			return repository.ErrLastSignInMethod
+		}
+	}
+
+	return s.identities.Delete(ctx, userID, provider)
+}
+
+// linkIdentity stores the link between a user and a provider account
+func (s *UserService) linkIdentity(ctx context.Context, userID int, identity *oauth.Identity) (*models.UserIdentity, error) {
+	link := &models.UserIdentity{
+		UserID:   userID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+	}
+	if identity.Email != "" {
+		link.Email = &identity.Email
+	}
+	if err := s.identities.Create(ctx, link); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+// verifyIdentity checks an ID token with the named provider
+func (s *UserService) verifyIdentity(ctx context.Context, provider, idToken, nonce string) (*oauth.Identity, error) {
+	verifier, ok := s.oauthVerifiers[provider]
+	if !ok || s.identities == nil {
+		return nil, oauth.ErrNotConfigured
+	}
+
+	identity, err := verifier.Verify(ctx, idToken, nonce)
+	if err != nil {
+		logger.FromContext(ctx).Warn("ID token rejected").
+			Str("provider", provider).
+			Err(err).
+			Send()
+		return nil, err
+	}
+	return identity, nil
+}
@@ -0,0 +1,201 @@
+// internal/services/user_refresh_token.go
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// REFRESH TOKENS - Staying signed in without long-lived access tokens
+// ========================================================================
+//
+// Login and registration return a short-lived access token and a refresh
+// token. Each refresh token works once: exchanging it returns a new pair
+// and marks it used. Every token rotated from one login shares a family;
+// if a used token is presented again, someone else holds a copy, so the
+// whole family is revoked and both parties must sign in again. Logout
+// revokes the family (or every family with all_devices).
+// ========================================================================
+
+// RefreshTokenRequest exchanges a refresh token for a new token pair
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+// LogoutRequest says which sessions to end
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"` // Ends this session
+	AllDevices   bool   `json:"all_devices"`   // Ends every session of the user
+}
+
+// SetRefreshTokens enables refresh tokens valid for ttl (0 = the default)
+func (s *UserService) SetRefreshTokens(repo repository.RefreshTokenStore, ttl time.Duration) {
+	if ttl <= 0 {
+		ttl = config.DefaultRefreshTokenExpiration
+	}
+	s.refreshTokens = repo
+	s.refreshExpiration = ttl
+}
+
+// RefreshToken exchanges a refresh token for a new access token and a new
+// refresh token. Presenting a token that was already exchanged revokes its
+// family and returns repository.ErrRefreshTokenReused.
+func (s *UserService) RefreshToken(ctx context.Context, refreshToken string) (*AuthResponse, error) {
+	if s.refreshTokens == nil {
+		return nil, repository.ErrRefreshTokenNotFound
+	}
+	log := logger.FromContext(ctx)
+	now := s.clock.Now()
+
+	current, err := s.refreshTokens.FindByHash(ctx, hashRefreshToken(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case current.RevokedAt != nil:
+		return nil, repository.ErrRefreshTokenRevoked
+	case current.UsedAt != nil:
+		s.revokeReusedFamily(ctx, current)
+		return nil, repository.ErrRefreshTokenReused
+	case current.IsExpired(now):
+		return nil, repository.ErrRefreshTokenExpired
+	}
+
+	user, err := s.userRepo.FindByID(ctx, current.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status != config.UserStatusActive {
+		return nil, fmt.Errorf("account is %s. Please contact support", user.Status)
+	}
+
+	raw, successor, err := s.newRefreshToken(user.ID, current.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.refreshTokens.Rotate(ctx, current.ID, successor); err != nil {
+		if errors.Is(err, repository.ErrRefreshTokenReused) {
+			// Lost a race with another exchange of the same token
+			s.revokeReusedFamily(ctx, current)
+		}
+		return nil, err
+	}
+
+	response, err := s.accessResponse(user)
+	if err != nil {
+		return nil, err
+	}
+	response.RefreshToken = raw
+	response.RefreshExpiresAt = &successor.ExpiresAt
+
+	log.Debug("Refresh token rotated").
+		Int("user_id", user.ID).
+		Str("family_id", current.FamilyID).
+		Send()
+
+	return response, nil
+}
+
+// Logout revokes the session of the given refresh token, or every session
+// of the user with AllDevices. Access tokens already issued stay valid
+// until they expire.
+func (s *UserService) Logout(ctx context.Context, userID int, req LogoutRequest) error {
+	if s.refreshTokens == nil {
+		return nil
+	}
+	now := s.clock.Now()
+
+	if req.AllDevices {
+		_, err := s.refreshTokens.RevokeAllForUser(ctx, userID, now)
+		return err
+	}
+	if req.RefreshToken == "" {
+		return nil
+	}
+
+	token, err := s.refreshTokens.FindByHash(ctx, hashRefreshToken(req.RefreshToken))
+	if err != nil {
+		return err
+	}
+	if token.UserID != userID {
+		return repository.ErrRefreshTokenNotFound
+	}
+	_, err = s.refreshTokens.RevokeFamily(ctx, token.FamilyID, now)
+	return err
+}
+
+// CleanupRefreshTokens deletes expired refresh tokens; it is the entry
+// point for the scheduled job
+func (s *UserService) CleanupRefreshTokens(ctx context.Context) error {
+	if s.refreshTokens == nil {
+		return nil
+	}
+	deleted, err := s.refreshTokens.DeleteExpired(ctx, s.clock.Now())
+	if err != nil {
+		return err
+	}
+	if deleted > 0 {
+		logger.FromContext(ctx).Info("Expired refresh tokens deleted").
+			Int64("count", deleted).
+			Send()
+	}
+	return nil
+}
+
+// revokeReusedFamily ends every session descended from a token that was
+// presented after it had already been exchanged
+func (s *UserService) revokeReusedFamily(ctx context.Context, token *models.RefreshToken) {
+	log := logger.FromContext(ctx)
+
+	revoked, err := s.refreshTokens.RevokeFamily(ctx, token.FamilyID, s.clock.Now())
+	if err != nil {
+		log.Error(err).
+			Int("user_id", token.UserID).
+			Str("family_id", token.FamilyID).
+			Msg("Failed to revoke reused refresh token family")
+		return
+	}
+	log.Warn("Refresh token reuse detected, session revoked").
+		Int("user_id", token.UserID).
+		Str("family_id", token.FamilyID).
+		Int64("revoked", revoked).
+		Send()
+}
+
+// newRefreshToken generates a refresh token in familyID, returning the raw
+// value for the client and the record to store
+func (s *UserService) newRefreshToken(userID int, familyID string) (string, *models.RefreshToken, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", nil, fmt.Errorf("failed to generate refresh token: %w", err)
+	}
+	raw := base64.RawURLEncoding.EncodeToString(b)
+
+	now := s.clock.Now()
+	return raw, &models.RefreshToken{
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: hashRefreshToken(raw),
+		ExpiresAt: now.Add(s.refreshExpiration),
+		CreatedAt: now,
+	}, nil
+}
+
+// hashRefreshToken returns the stored form of a refresh token
+func hashRefreshToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
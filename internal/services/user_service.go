@@ -2,16 +2,18 @@
 package services
 
 import (
+	"barber-booking-system/internal/clock"
 	"barber-booking-system/internal/config"
 	"barber-booking-system/internal/middleware"
 	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/oauth"
 	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/timefmt"
 	"context"
 	"fmt"
 	"strings"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -23,20 +25,39 @@ const (
 
 // UserService handles user business logic
 type UserService struct {
-	userRepo      *repository.UserRepository
+	userRepo      repository.UserStore
 	jwtSecret     string
 	jwtExpiration time.Duration
+
+	// Rotating refresh tokens (nil = logins return only an access token)
+	refreshTokens     repository.RefreshTokenStore
+	refreshExpiration time.Duration
+
+	// Social sign-in (no verifiers = disabled)
+	identities     repository.UserIdentityStore
+	oauthVerifiers map[string]oauth.Verifier
+
+	// Invitations to claim imported accounts (nil = claiming disabled)
+	invitations *repository.CustomerInvitationRepository
+
+	clock clock.Clock
 }
 
 // NewUserService creates a new user service
-func NewUserService(userRepo *repository.UserRepository, jwtSecret string, jwtExpiration time.Duration) *UserService {
+func NewUserService(userRepo repository.UserStore, jwtSecret string, jwtExpiration time.Duration) *UserService {
 	return &UserService{
 		userRepo:      userRepo,
 		jwtSecret:     jwtSecret,
 		jwtExpiration: jwtExpiration,
+		clock:         clock.System,
 	}
 }
 
+// SetClock replaces the time source (nil restores the system clock)
+func (s *UserService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
 // Request structs
 
 // RegisterRequest represents user registration data
@@ -67,6 +88,10 @@ type UpdateProfileRequest struct {
 	Country           *string                `json:"country" binding:"omitempty"`
 	PostalCode        *string                `json:"postal_code" binding:"omitempty"`
 	Preferences       map[string]interface{} `json:"preferences" binding:"omitempty"`
+
+	// NotificationSettings opts in or out of delivery channels (email, sms, push);
+	// omitted channels keep their current setting
+	NotificationSettings map[string]bool `json:"notification_settings" binding:"omitempty"`
 }
 
 // ChangePasswordRequest represents password change data
@@ -80,33 +105,39 @@ type ChangePasswordRequest struct {
 
 // AuthResponse represents authentication response
 type AuthResponse struct {
-	Token     string              `json:"token"`
-	ExpiresAt time.Time           `json:"expires_at"`
-	User      UserProfileResponse `json:"user"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Exchange at POST /auth/refresh for a new token pair; single use
+	RefreshToken     string     `json:"refresh_token,omitempty"`
+	RefreshExpiresAt *time.Time `json:"refresh_expires_at,omitempty"`
+
+	User UserProfileResponse `json:"user"`
 }
 
 // UserProfileResponse represents user profile data (without password)
 type UserProfileResponse struct {
-	ID                int                    `json:"id"`
-	UUID              string                 `json:"uuid"`
-	Email             string                 `json:"email"`
-	Name              string                 `json:"name"`
-	Phone             *string                `json:"phone"`
-	UserType          string                 `json:"user_type"`
-	Status            string                 `json:"status"`
-	EmailVerified     bool                   `json:"email_verified"`
-	PhoneVerified     bool                   `json:"phone_verified"`
-	DateOfBirth       *time.Time             `json:"date_of_birth"`
-	Gender            *string                `json:"gender"`
-	ProfilePictureURL *string                `json:"profile_picture_url"`
-	Address           *string                `json:"address"`
-	City              *string                `json:"city"`
-	State             *string                `json:"state"`
-	Country           *string                `json:"country"`
-	PostalCode        *string                `json:"postal_code"`
-	Preferences       map[string]interface{} `json:"preferences"`
-	CreatedAt         time.Time              `json:"created_at"`
-	LastLoginAt       *time.Time             `json:"last_login_at"`
+	ID                   int                    `json:"id"`
+	UUID                 string                 `json:"uuid"`
+	Email                string                 `json:"email"`
+	Name                 string                 `json:"name"`
+	Phone                *string                `json:"phone"`
+	UserType             string                 `json:"user_type"`
+	Status               string                 `json:"status"`
+	EmailVerified        bool                   `json:"email_verified"`
+	PhoneVerified        bool                   `json:"phone_verified"`
+	DateOfBirth          *time.Time             `json:"date_of_birth"`
+	Gender               *string                `json:"gender"`
+	ProfilePictureURL    *string                `json:"profile_picture_url"`
+	Address              *string                `json:"address"`
+	City                 *string                `json:"city"`
+	State                *string                `json:"state"`
+	Country              *string                `json:"country"`
+	PostalCode           *string                `json:"postal_code"`
+	Preferences          map[string]interface{} `json:"preferences"`
+	NotificationSettings map[string]interface{} `json:"notification_settings"`
+	CreatedAt            time.Time              `json:"created_at"`
+	LastLoginAt          *time.Time             `json:"last_login_at"`
 }
 
 // Register registers a new user
@@ -169,18 +200,8 @@ func (s *UserService) Register(ctx context.Context, req RegisterRequest) (*AuthR
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
 
-	// Generate JWT token
-	token, err := middleware.GenerateToken(user.ID, user.Email, user.UserType, s.jwtSecret, s.jwtExpiration)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
-	}
-
-	// Return auth response
-	return &AuthResponse{
-		Token:     token,
-		ExpiresAt: time.Now().Add(s.jwtExpiration),
-		User:      s.toProfileResponse(user),
-	}, nil
+	// Generate access and refresh tokens
+	return s.newAuthResponse(ctx, user)
 }
 
 // Login authenticates a user and returns JWT token
@@ -229,50 +250,41 @@ func (s *UserService) Login(ctx context.Context, req LoginRequest) (*AuthRespons
 		fmt.Printf("Warning: failed to update last login: %v\n", err)
 	}
 
-	// Generate JWT token
-	token, err := middleware.GenerateToken(user.ID, user.Email, user.UserType, s.jwtSecret, s.jwtExpiration)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate token: %w", err)
-	}
-
-	// Return auth response
-	return &AuthResponse{
-		Token:     token,
-		ExpiresAt: time.Now().Add(s.jwtExpiration),
-		User:      s.toProfileResponse(user),
-	}, nil
+	// Generate access and refresh tokens (a new token family per login)
+	return s.newAuthResponse(ctx, user)
 }
 
-// RefreshToken generates a new token from an existing token
-func (s *UserService) RefreshToken(ctx context.Context, oldToken string) (*AuthResponse, error) {
-	// Generate new token using middleware function
-	newToken, err := middleware.RefreshToken(oldToken, s.jwtSecret, s.jwtExpiration)
-	if err != nil {
-		return nil, fmt.Errorf("failed to refresh token: %w", err)
-	}
-
-	// We need to extract user ID from the old token to get fresh user data
-	// Since parseToken is private, we'll use a workaround by parsing the token ourselves
-	token, err := jwt.ParseWithClaims(oldToken, &middleware.Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(s.jwtSecret), nil
-	})
+// newAuthResponse issues an access token and, when refresh tokens are
+// enabled, a refresh token starting a new family
+func (s *UserService) newAuthResponse(ctx context.Context, user *models.User) (*AuthResponse, error) {
+	response, err := s.accessResponse(user)
 	if err != nil {
-		return nil, fmt.Errorf("invalid token: %w", err)
+		return nil, err
 	}
 
-	claims, ok := token.Claims.(*middleware.Claims)
-	if !ok || !token.Valid {
-		return nil, fmt.Errorf("invalid token claims")
+	if s.refreshTokens != nil {
+		raw, refresh, err := s.newRefreshToken(user.ID, uuid.New().String())
+		if err != nil {
+			return nil, err
+		}
+		if err := s.refreshTokens.Create(ctx, refresh); err != nil {
+			return nil, err
+		}
+		response.RefreshToken = raw
+		response.RefreshExpiresAt = &refresh.ExpiresAt
 	}
+	return response, nil
+}
 
-	// Get updated user info
-	user, err := s.userRepo.FindByID(ctx, claims.UserID)
+// accessResponse issues an access token for the user
+func (s *UserService) accessResponse(user *models.User) (*AuthResponse, error) {
+	token, err := middleware.GenerateToken(user.ID, user.Email, user.UserType, s.jwtSecret, s.jwtExpiration)
 	if err != nil {
-		return nil, fmt.Errorf("user not found: %w", err)
+		return nil, fmt.Errorf("failed to generate token: %w", err)
 	}
 
 	return &AuthResponse{
-		Token:     newToken,
+		Token:     token,
 		ExpiresAt: time.Now().Add(s.jwtExpiration),
 		User:      s.toProfileResponse(user),
 	}, nil
@@ -329,8 +341,25 @@ func (s *UserService) UpdateProfile(ctx context.Context, userID int, req UpdateP
 		user.PostalCode = req.PostalCode
 	}
 	if req.Preferences != nil {
+		// Notification times are written from these
+		if err := timefmt.ValidatePreferences(req.Preferences); err != nil {
+			return nil, err
+		}
 		user.Preferences = req.Preferences
 	}
+	if len(req.NotificationSettings) > 0 {
+		if user.NotificationSettings == nil {
+			user.NotificationSettings = models.JSONMap{}
+		}
+		for channel, enabled := range req.NotificationSettings {
+			switch channel {
+			case config.NotificationChannelEmail, config.NotificationChannelSMS, config.NotificationChannelPush:
+				user.NotificationSettings[channel] = enabled
+			default:
+				return nil, fmt.Errorf("invalid notification channel: %s", channel)
+			}
+		}
+	}
 
 	// Save changes
 	if err := s.userRepo.Update(ctx, user); err != nil {
@@ -380,6 +409,13 @@ func (s *UserService) ChangePassword(ctx context.Context, userID int, req Change
 		return fmt.Errorf("failed to update password: %w", err)
 	}
 
+	// Sign out every other device
+	if s.refreshTokens != nil {
+		if _, err := s.refreshTokens.RevokeAllForUser(ctx, userID, s.clock.Now()); err != nil {
+			return fmt.Errorf("failed to revoke sessions: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -437,25 +473,26 @@ func (s *UserService) toProfileResponse(user *models.User) UserProfileResponse {
 	}
 
 	return UserProfileResponse{
-		ID:                user.ID,
-		UUID:              user.UUID,
-		Email:             user.Email,
-		Name:              user.Name,
-		Phone:             user.Phone,
-		UserType:          user.UserType,
-		Status:            user.Status,
-		EmailVerified:     user.EmailVerified,
-		PhoneVerified:     user.PhoneVerified,
-		DateOfBirth:       user.DateOfBirth,
-		Gender:            user.Gender,
-		ProfilePictureURL: user.ProfilePictureURL,
-		Address:           user.Address,
-		City:              user.City,
-		State:             user.State,
-		Country:           user.Country,
-		PostalCode:        user.PostalCode,
-		Preferences:       preferences,
-		CreatedAt:         user.CreatedAt,
-		LastLoginAt:       user.LastLoginAt,
+		ID:                   user.ID,
+		UUID:                 user.UUID,
+		Email:                user.Email,
+		Name:                 user.Name,
+		Phone:                user.Phone,
+		UserType:             user.UserType,
+		Status:               user.Status,
+		EmailVerified:        user.EmailVerified,
+		PhoneVerified:        user.PhoneVerified,
+		DateOfBirth:          user.DateOfBirth,
+		Gender:               user.Gender,
+		ProfilePictureURL:    user.ProfilePictureURL,
+		Address:              user.Address,
+		City:                 user.City,
+		State:                user.State,
+		Country:              user.Country,
+		PostalCode:           user.PostalCode,
+		Preferences:          preferences,
+		NotificationSettings: user.NotificationSettings,
+		CreatedAt:            user.CreatedAt,
+		LastLoginAt:          user.LastLoginAt,
 	}
 }
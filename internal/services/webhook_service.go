@@ -0,0 +1,543 @@
+// internal/services/webhook_service.go
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/webhook"
+	"barber-booking-system/internal/worker"
+
+	"github.com/google/uuid"
+)
+
+// ========================================================================
+// WEBHOOK SERVICE - Outbound events for barbers' own sites
+// ========================================================================
+//
+// Barbers register URLs and choose which events each receives. Publishing
+// an event only queues one delivery per matching subscription; the
+// background worker claims due deliveries, POSTs the signed event, and
+// retries failures with exponential backoff until MaxAttempts, when the
+// delivery is marked failed. Every delivery of an event carries the same
+// event ID, so receivers can ignore repeats.
+// ========================================================================
+
+// WebhookService manages webhook subscriptions and delivers their events
+type WebhookService struct {
+	repo       repository.WebhookStore
+	barberRepo repository.BarberStore
+	cfg        config.WebhookConfig
+	client     *http.Client
+	clock      clock.Clock
+	delivery   DeliveryPolicy
+}
+
+// NewWebhookService creates a new webhook service
+func NewWebhookService(
+	repo repository.WebhookStore,
+	barberRepo repository.BarberStore,
+	cfg config.WebhookConfig,
+) *WebhookService {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = config.DefaultWebhookTimeout
+	}
+	return &WebhookService{
+		repo:       repo,
+		barberRepo: barberRepo,
+		cfg:        cfg,
+		client:     webhook.NewClient(cfg.Timeout, cfg.AllowInsecure),
+		clock:      clock.System,
+		delivery: DeliveryPolicy{
+			MaxAttempts: config.DefaultWebhookMaxAttempts,
+			RetryBase:   config.DefaultWebhookRetryBase,
+			RetryMax:    config.DefaultWebhookRetryMax,
+			Lease:       config.DefaultWebhookLease,
+		},
+	}
+}
+
+// SetClock replaces the time source used for signatures and retries (nil restores the system clock)
+func (s *WebhookService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// SetHTTPClient replaces the client deliveries are sent with
+func (s *WebhookService) SetHTTPClient(client *http.Client) {
+	s.client = client
+}
+
+// SetDeliveryPolicy replaces the retry policy; unset fields keep their current values
+func (s *WebhookService) SetDeliveryPolicy(policy DeliveryPolicy) {
+	if policy.MaxAttempts > 0 {
+		s.delivery.MaxAttempts = policy.MaxAttempts
+	}
+	if policy.RetryBase > 0 {
+		s.delivery.RetryBase = policy.RetryBase
+	}
+	if policy.RetryMax > 0 {
+		s.delivery.RetryMax = policy.RetryMax
+	}
+	if policy.Lease > 0 {
+		s.delivery.Lease = policy.Lease
+	}
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// CreateWebhookRequest registers a URL for events
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" binding:"required,max=2000"`
+	Events      []string `json:"events" binding:"required,min=1"`
+	Description *string  `json:"description" binding:"omitempty,max=200"`
+}
+
+// UpdateWebhookRequest changes a subscription (nil fields are left as they are)
+type UpdateWebhookRequest struct {
+	URL         *string  `json:"url" binding:"omitempty,max=2000"`
+	Events      []string `json:"events"`
+	Description *string  `json:"description" binding:"omitempty,max=200"`
+	IsActive    *bool    `json:"is_active"`
+}
+
+// CreatedWebhookResponse is a new subscription with its signing secret,
+// which is shown only once
+type CreatedWebhookResponse struct {
+	*models.WebhookSubscription
+	Secret string `json:"secret"`
+}
+
+// ========================================================================
+// SUBSCRIPTIONS
+// ========================================================================
+
+// authorize ensures the user may manage the barber's webhooks: admins may
+// manage any barber's, barbers only their own
+func (s *WebhookService) authorize(ctx context.Context, barberID, userID int, isAdmin bool) error {
+	barber, err := s.barberRepo.FindByID(ctx, barberID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin && barber.UserID != userID {
+		return repository.ErrNotOwner
+	}
+	return nil
+}
+
+// findOwned retrieves one of the barber's subscriptions
+func (s *WebhookService) findOwned(ctx context.Context, barberID, id int) (*models.WebhookSubscription, error) {
+	sub, err := s.repo.FindSubscriptionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if sub.BarberID != barberID {
+		return nil, repository.ErrWebhookNotFound
+	}
+	return sub, nil
+}
+
+// validateWebhookEvents checks the events a subscription asks for and
+// removes repeats
+func validateWebhookEvents(events []string) (models.StringArray, error) {
+	if len(events) == 0 {
+		return nil, fmt.Errorf("events must list at least one event")
+	}
+	valid := models.StringArray{}
+	for _, event := range events {
+		if !slices.Contains(config.ValidWebhookEvents, event) {
+			return nil, fmt.Errorf("events must be among %s, got %q", strings.Join(config.ValidWebhookEvents, ", "), event)
+		}
+		if !slices.Contains(valid, event) {
+			valid = append(valid, event)
+		}
+	}
+	return valid, nil
+}
+
+// ListWebhooks returns a barber's subscriptions
+func (s *WebhookService) ListWebhooks(ctx context.Context, barberID, userID int, isAdmin bool) ([]models.WebhookSubscription, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.repo.FindSubscriptionsByBarber(ctx, barberID)
+}
+
+// CreateWebhook registers a URL for a barber's events
+func (s *WebhookService) CreateWebhook(ctx context.Context, barberID int, req CreateWebhookRequest, userID int, isAdmin bool) (*CreatedWebhookResponse, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	if err := webhook.ValidateURL(req.URL, s.cfg.AllowInsecure); err != nil {
+		return nil, err
+	}
+	events, err := validateWebhookEvents(req.Events)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repo.FindSubscriptionsByBarber(ctx, barberID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= config.MaxWebhookSubscriptionsPerBarber {
+		return nil, repository.ErrTooManyWebhooks
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+	sub := &models.WebhookSubscription{
+		BarberID:    barberID,
+		URL:         req.URL,
+		Secret:      secret,
+		Events:      events,
+		Description: req.Description,
+		IsActive:    true,
+		CreatedBy:   &userID,
+	}
+	if err := s.repo.CreateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).Info("Webhook registered").
+		Int("webhook_id", sub.ID).
+		Int("barber_id", barberID).
+		Strs("events", events).
+		Send()
+	return &CreatedWebhookResponse{WebhookSubscription: sub, Secret: secret}, nil
+}
+
+// UpdateWebhook changes one of a barber's subscriptions
+func (s *WebhookService) UpdateWebhook(ctx context.Context, barberID, id int, req UpdateWebhookRequest, userID int, isAdmin bool) (*models.WebhookSubscription, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	sub, err := s.findOwned(ctx, barberID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if err := webhook.ValidateURL(*req.URL, s.cfg.AllowInsecure); err != nil {
+			return nil, err
+		}
+		sub.URL = *req.URL
+	}
+	if req.Events != nil {
+		if sub.Events, err = validateWebhookEvents(req.Events); err != nil {
+			return nil, err
+		}
+	}
+	if req.Description != nil {
+		sub.Description = req.Description
+	}
+	if req.IsActive != nil {
+		sub.IsActive = *req.IsActive
+	}
+
+	if err := s.repo.UpdateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// DeleteWebhook removes one of a barber's subscriptions and its pending deliveries
+func (s *WebhookService) DeleteWebhook(ctx context.Context, barberID, id, userID int, isAdmin bool) error {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return err
+	}
+	if _, err := s.findOwned(ctx, barberID, id); err != nil {
+		return err
+	}
+	return s.repo.DeleteSubscription(ctx, id)
+}
+
+// ========================================================================
+// DELIVERY LOG
+// ========================================================================
+
+// ListDeliveries returns a page of a subscription's deliveries, newest
+// first, optionally only those with status
+func (s *WebhookService) ListDeliveries(ctx context.Context, barberID, id int, status string, limit, offset, userID int, isAdmin bool) ([]models.WebhookDelivery, int, error) {
+	if status != "" && status != config.WebhookDeliveryPending && status != config.WebhookDeliveryDelivered && status != config.WebhookDeliveryFailed {
+		return nil, 0, fmt.Errorf("status must be pending, delivered or failed")
+	}
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, 0, err
+	}
+	if _, err := s.findOwned(ctx, barberID, id); err != nil {
+		return nil, 0, err
+	}
+	return s.repo.FindDeliveriesBySubscription(ctx, id, status, limit, offset)
+}
+
+// Redeliver queues a delivered or failed delivery to be sent again, with
+// the same event ID and a fresh set of attempts
+func (s *WebhookService) Redeliver(ctx context.Context, barberID, id, deliveryID, userID int, isAdmin bool) (*models.WebhookDelivery, error) {
+	if err := s.authorize(ctx, barberID, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	if _, err := s.findOwned(ctx, barberID, id); err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.repo.FindDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.SubscriptionID != id {
+		return nil, repository.ErrWebhookDeliveryNotFound
+	}
+	if delivery.Status == config.WebhookDeliveryPending {
+		return nil, fmt.Errorf("delivery is still pending and cannot be redelivered yet")
+	}
+	return s.repo.Requeue(ctx, deliveryID)
+}
+
+// ========================================================================
+// PUBLISHING
+// ========================================================================
+
+// Publish queues an event for the barber's subscriptions to its type
+func (s *WebhookService) Publish(ctx context.Context, barberID int, eventType string, data interface{}) error {
+	return s.publish(ctx, models.WebhookEvent{
+		ID:        uuid.New().String(),
+		Type:      eventType,
+		BarberID:  barberID,
+		CreatedAt: s.clock.Now().UTC(),
+		Data:      data,
+	})
+}
+
+// publish queues an event for the barber's subscribers to its type
+func (s *WebhookService) publish(ctx context.Context, event models.WebhookEvent) error {
+	subs, err := s.repo.FindSubscribers(ctx, event.BarberID, event.Type)
+	if err != nil || len(subs) == 0 {
+		return err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+
+	deliveries := make([]models.WebhookDelivery, len(subs))
+	for i, sub := range subs {
+		deliveries[i] = models.WebhookDelivery{
+			SubscriptionID: sub.ID,
+			EventID:        event.ID,
+			EventType:      event.Type,
+			Payload:        payload,
+		}
+	}
+	return s.repo.CreateDeliveries(ctx, deliveries)
+}
+
+// BookingEventData is the data of booking webhook events; the event's
+// created_at is when the change happened
+type BookingEventData struct {
+	BookingID          int       `json:"booking_id"`
+	BookingNumber      string    `json:"booking_number"`
+	BarberID           int       `json:"barber_id"`
+	Status             string    `json:"status"`
+	ServiceName        string    `json:"service_name"`
+	CustomerName       *string   `json:"customer_name,omitempty"`
+	CustomerEmail      *string   `json:"customer_email,omitempty"`
+	CustomerPhone      *string   `json:"customer_phone,omitempty"`
+	ScheduledStartTime time.Time `json:"scheduled_start_time"`
+	ScheduledEndTime   time.Time `json:"scheduled_end_time"`
+	TotalPrice         float64   `json:"total_price"`
+	Currency           string    `json:"currency"`
+	PaymentStatus      string    `json:"payment_status"`
+	BookingSource      string    `json:"booking_source"`
+	IsTest             bool      `json:"is_test"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// newBookingEventData builds the event data for a booking
+func newBookingEventData(booking *models.Booking) BookingEventData {
+	return BookingEventData{
+		BookingID:          booking.ID,
+		BookingNumber:      booking.BookingNumber,
+		BarberID:           booking.BarberID,
+		Status:             booking.Status,
+		ServiceName:        booking.ServiceName,
+		CustomerName:       booking.CustomerName,
+		CustomerEmail:      booking.CustomerEmail,
+		CustomerPhone:      booking.CustomerPhone,
+		ScheduledStartTime: booking.ScheduledStartTime,
+		ScheduledEndTime:   booking.ScheduledEndTime,
+		TotalPrice:         booking.TotalPrice,
+		Currency:           booking.Currency,
+		PaymentStatus:      booking.PaymentStatus,
+		BookingSource:      booking.BookingSource,
+		IsTest:             booking.IsTest,
+		CreatedAt:          booking.CreatedAt,
+	}
+}
+
+// BookingEventHandler publishes booking.created, booking.cancelled and
+// booking.completed from the outbox. The webhook event shares the domain
+// event's ID, so receivers can dedupe a retried dispatch. Register it with
+// OutboxService.Handle.
+func (s *WebhookService) BookingEventHandler(ctx context.Context, event *models.OutboxEvent) error {
+	if event.AggregateType != config.OutboxAggregateBooking {
+		return nil
+	}
+	data, err := decodeBookingEvent(event)
+	if err != nil {
+		return err
+	}
+
+	var eventType string
+	switch {
+	case event.EventType == config.DomainEventBookingCreated:
+		eventType = config.WebhookEventBookingCreated
+	case event.EventType == config.DomainEventBookingCancelled:
+		eventType = config.WebhookEventBookingCancelled
+	case data.Booking.Status == config.BookingStatusCompleted:
+		eventType = config.WebhookEventBookingCompleted
+	default:
+		return nil
+	}
+
+	return s.publish(ctx, models.WebhookEvent{
+		ID:        event.EventID,
+		Type:      eventType,
+		BarberID:  data.Booking.BarberID,
+		CreatedAt: event.OccurredAt.UTC(),
+		Data:      newBookingEventData(data.Booking),
+	})
+}
+
+// ========================================================================
+// DELIVERY
+// ========================================================================
+
+// ProcessPendingDeliveries claims up to limit due deliveries and sends
+// them. It returns how many were claimed.
+func (s *WebhookService) ProcessPendingDeliveries(ctx context.Context, limit int) (int, error) {
+	deliveries, err := s.repo.ClaimPending(ctx, s.clock.Now(), s.delivery.Lease, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	subs := make(map[int]*models.WebhookSubscription)
+	for i := range deliveries {
+		if ctx.Err() != nil {
+			// Unprocessed claims become visible again when their lease ends
+			break
+		}
+		d := &deliveries[i]
+		sub, ok := subs[d.SubscriptionID]
+		if !ok {
+			if sub, err = s.repo.FindSubscriptionByID(ctx, d.SubscriptionID); err != nil {
+				logger.FromContext(ctx).Warn("Webhook subscription unavailable for delivery").
+					Int("delivery_id", d.ID).
+					Err(err).
+					Send()
+				continue
+			}
+			subs[d.SubscriptionID] = sub
+		}
+		s.processDelivery(ctx, sub, d)
+	}
+	return len(deliveries), nil
+}
+
+// processDelivery sends one claimed delivery and records the outcome
+func (s *WebhookService) processDelivery(ctx context.Context, sub *models.WebhookSubscription, d *models.WebhookDelivery) {
+	log := logger.FromContext(ctx)
+
+	if !sub.IsActive {
+		if err := s.repo.MarkFailed(ctx, d.ID, nil, "webhook is disabled"); err != nil {
+			log.Warn("Failed to mark webhook delivery failed").Int("delivery_id", d.ID).Err(err).Send()
+		}
+		return
+	}
+
+	status, err := s.send(ctx, sub, d)
+	var responseStatus *int
+	if status > 0 {
+		responseStatus = &status
+	}
+
+	if err == nil {
+		if err := s.repo.MarkDelivered(ctx, d.ID, status, s.clock.Now()); err != nil {
+			log.Warn("Failed to mark webhook delivered").Int("delivery_id", d.ID).Err(err).Send()
+		}
+		return
+	}
+
+	if d.Attempts >= s.delivery.MaxAttempts || errors.Is(err, webhook.ErrBlockedAddress) {
+		log.Warn("Webhook delivery failed").
+			Int("delivery_id", d.ID).
+			Int("webhook_id", sub.ID).
+			Int("attempts", d.Attempts).
+			Err(err).
+			Send()
+		if err := s.repo.MarkFailed(ctx, d.ID, responseStatus, err.Error()); err != nil {
+			log.Warn("Failed to mark webhook delivery failed").Int("delivery_id", d.ID).Err(err).Send()
+		}
+		return
+	}
+
+	retryAt := s.clock.Now().Add(worker.Backoff(d.Attempts, s.delivery.RetryBase, s.delivery.RetryMax))
+	log.Debug("Webhook delivery failed, will retry").
+		Int("delivery_id", d.ID).
+		Int("webhook_id", sub.ID).
+		Int("attempts", d.Attempts).
+		Time("retry_at", retryAt).
+		Err(err).
+		Send()
+	if err := s.repo.ScheduleRetry(ctx, d.ID, responseStatus, err.Error(), retryAt); err != nil {
+		log.Warn("Failed to schedule webhook retry").Int("delivery_id", d.ID).Err(err).Send()
+	}
+}
+
+// send POSTs a delivery's event, signed with the subscription's secret. It
+// returns the response status (0 when there was no response) and an error
+// unless the receiver answered 2xx.
+func (s *WebhookService) send(ctx context.Context, sub *models.WebhookSubscription, d *models.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "BarberBooking-Webhooks/1.0")
+	req.Header.Set(webhook.EventHeader, d.EventType)
+	req.Header.Set(webhook.EventIDHeader, d.EventID)
+	req.Header.Set(webhook.SignatureHeader, webhook.Sign(sub.Secret, s.clock.Now(), d.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		if errors.Is(err, webhook.ErrBlockedAddress) {
+			return 0, webhook.ErrBlockedAddress
+		}
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+		return resp.StatusCode, nil
+	}
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, config.MaxWebhookErrorLength))
+	return resp.StatusCode, fmt.Errorf("receiver responded %d: %s", resp.StatusCode, strings.ToValidUTF8(strings.TrimSpace(string(body)), ""))
+}
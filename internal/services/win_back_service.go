@@ -0,0 +1,296 @@
+// internal/services/win_back_service.go
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+)
+
+// ========================================================================
+// WIN-BACK SERVICE - Automated campaigns for lapsed customers
+// ========================================================================
+//
+// A customer is lapsed once the time since their last completed visit
+// exceeds their own average gap between visits by the configured number of
+// weeks. Each run of the job is recorded as a campaign; every customer it
+// messages gets a personalized notification and, when configured, a
+// single-use percentage-off coupon redeemable on their next booking.
+// Performance is measured from notification reads, later bookings within
+// the attribution window, and coupon redemptions.
+// ========================================================================
+
+// couponAlphabet omits characters that are easy to misread (0/O, 1/I/L)
+const couponAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+
+// WinBackService handles win-back campaigns
+type WinBackService struct {
+	repo                *repository.WinBackRepository
+	notificationService *NotificationService
+	clock               clock.Clock
+	config              config.WinBackConfig
+}
+
+// NewWinBackService creates a new win-back service. Unset settings fall
+// back to the defaults; CouponPercentOff stays 0 (no coupon) unless set.
+func NewWinBackService(
+	repo *repository.WinBackRepository,
+	notificationService *NotificationService,
+	cfg config.WinBackConfig,
+) *WinBackService {
+	if cfg.InactiveWeeks <= 0 {
+		cfg.InactiveWeeks = config.DefaultWinBackInactiveWeeks
+	}
+	if cfg.MinVisits < 2 {
+		cfg.MinVisits = config.DefaultWinBackMinVisits
+	}
+	if cfg.CooldownDays <= 0 {
+		cfg.CooldownDays = config.DefaultWinBackCooldownDays
+	}
+	if cfg.CouponValidDays <= 0 {
+		cfg.CouponValidDays = config.DefaultWinBackCouponValidDays
+	}
+	if cfg.AttributionDays <= 0 {
+		cfg.AttributionDays = config.DefaultWinBackAttributionDays
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = config.DefaultWinBackBatchSize
+	}
+	return &WinBackService{
+		repo:                repo,
+		notificationService: notificationService,
+		clock:               clock.System,
+		config:              cfg,
+	}
+}
+
+// SetClock replaces the time source (nil restores the system clock)
+func (s *WinBackService) SetClock(c clock.Clock) {
+	s.clock = clock.OrSystem(c)
+}
+
+// ========================================================================
+// REQUEST/RESPONSE STRUCTS
+// ========================================================================
+
+// RunWinBackRequest overrides the configured settings for one run
+type RunWinBackRequest struct {
+	InactiveWeeks    *int `json:"inactive_weeks" binding:"omitempty,min=1,max=104" example:"4"`
+	CouponPercentOff *int `json:"coupon_percent_off" binding:"omitempty,min=0,max=100" example:"15"`
+	DryRun           bool `json:"dry_run"` // List who would be messaged without sending
+}
+
+// WinBackCampaignListRequest pages through campaigns
+type WinBackCampaignListRequest struct {
+	Limit  int `form:"limit" binding:"omitempty,min=1,max=100"`
+	Offset int `form:"offset" binding:"omitempty,min=0"`
+}
+
+// WinBackRunResult summarizes a run
+type WinBackRunResult struct {
+	Campaign   *models.WinBackCampaign   `json:"campaign,omitempty"`   // nil on dry runs
+	Candidates []models.WinBackCandidate `json:"candidates,omitempty"` // Dry runs only
+	DryRun     bool                      `json:"dry_run"`
+	Eligible   int                       `json:"eligible"`
+	Sent       int                       `json:"sent"`
+}
+
+// ========================================================================
+// CAMPAIGN RUNS
+// ========================================================================
+
+// RunScheduled runs a campaign with the configured settings; it is the
+// entry point for the scheduled job
+func (s *WinBackService) RunScheduled(ctx context.Context) error {
+	_, err := s.RunCampaign(ctx, RunWinBackRequest{}, nil)
+	return err
+}
+
+// RunCampaign finds lapsed customers and messages them. A failure for one
+// customer is logged and skipped so the rest of the batch still goes out.
+func (s *WinBackService) RunCampaign(ctx context.Context, req RunWinBackRequest, triggeredBy *int) (*WinBackRunResult, error) {
+	log := logger.FromContext(ctx)
+	now := s.clock.Now()
+
+	inactiveWeeks := s.config.InactiveWeeks
+	if req.InactiveWeeks != nil {
+		inactiveWeeks = *req.InactiveWeeks
+	}
+	couponPercentOff := s.config.CouponPercentOff
+	if req.CouponPercentOff != nil {
+		couponPercentOff = *req.CouponPercentOff
+	}
+
+	candidates, err := s.repo.FindCandidates(ctx, repository.WinBackCandidateFilters{
+		LapsedBefore:  now.AddDate(0, 0, -7*inactiveWeeks),
+		MinVisits:     s.config.MinVisits,
+		CooldownSince: now.AddDate(0, 0, -s.config.CooldownDays),
+		Limit:         s.config.BatchSize,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &WinBackRunResult{DryRun: req.DryRun, Eligible: len(candidates)}
+	if req.DryRun {
+		result.Candidates = candidates
+		return result, nil
+	}
+
+	campaign := &models.WinBackCampaign{
+		InactiveWeeks:    inactiveWeeks,
+		CouponPercentOff: couponPercentOff,
+		StartedAt:        now,
+		TriggeredBy:      triggeredBy,
+	}
+	if couponPercentOff > 0 {
+		campaign.CouponValidDays = s.config.CouponValidDays
+	}
+	if err := s.repo.CreateCampaign(ctx, campaign); err != nil {
+		return nil, err
+	}
+
+	for i := range candidates {
+		if ctx.Err() != nil {
+			break
+		}
+		if err := s.sendMessage(ctx, campaign, &candidates[i], now); err != nil {
+			log.Error(err).
+				Int("campaign_id", campaign.ID).
+				Int("customer_id", candidates[i].CustomerID).
+				Msg("Failed to send win-back message")
+			continue
+		}
+		campaign.SentCount++
+	}
+
+	finishedAt := s.clock.Now()
+	campaign.EligibleCount = len(candidates)
+	campaign.FinishedAt = &finishedAt
+	if err := s.repo.FinishCampaign(ctx, campaign); err != nil {
+		return nil, err
+	}
+
+	log.Info("Win-back campaign finished").
+		Int("campaign_id", campaign.ID).
+		Int("eligible", campaign.EligibleCount).
+		Int("sent", campaign.SentCount).
+		Send()
+
+	result.Campaign = campaign
+	result.Sent = campaign.SentCount
+	return result, nil
+}
+
+// sendMessage records and sends one customer's win-back message
+func (s *WinBackService) sendMessage(ctx context.Context, campaign *models.WinBackCampaign, candidate *models.WinBackCandidate, now time.Time) error {
+	message := &models.WinBackMessage{
+		CampaignID:  campaign.ID,
+		CustomerID:  candidate.CustomerID,
+		LastVisitAt: candidate.LastVisitAt,
+		CadenceDays: int(candidate.Cadence() / (24 * time.Hour)),
+		SentAt:      now,
+	}
+	if campaign.CouponPercentOff > 0 {
+		code, err := newCouponCode()
+		if err != nil {
+			return err
+		}
+		expiresAt := now.AddDate(0, 0, campaign.CouponValidDays)
+		message.CouponCode = &code
+		message.CouponPercentOff = campaign.CouponPercentOff
+		message.CouponExpiresAt = &expiresAt
+	}
+
+	if err := s.repo.CreateMessage(ctx, message); err != nil {
+		return err
+	}
+
+	notification, err := s.notificationService.SendWinBack(ctx, candidate, message)
+	if err != nil {
+		// Let the customer be picked up again by the next run
+		if delErr := s.repo.DeleteMessage(ctx, message.ID); delErr != nil {
+			logger.FromContext(ctx).Warn("Failed to remove unsent win-back message").
+				Int("message_id", message.ID).
+				Err(delErr).
+				Send()
+		}
+		return err
+	}
+	if notification == nil {
+		// The customer turned win-back notifications off; the message is
+		// kept so they are not picked again
+		return nil
+	}
+	return s.repo.SetMessageNotification(ctx, message.ID, notification.ID)
+}
+
+// newCouponCode returns a random, human-friendly coupon code
+func newCouponCode() (string, error) {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate coupon code: %w", err)
+	}
+	for i := range b {
+		b[i] = couponAlphabet[int(b[i])%len(couponAlphabet)]
+	}
+	return config.WinBackCouponPrefix + string(b), nil
+}
+
+// ========================================================================
+// PERFORMANCE
+// ========================================================================
+
+// ListCampaigns returns campaigns, newest first, with their performance
+func (s *WinBackService) ListCampaigns(ctx context.Context, req *WinBackCampaignListRequest) ([]models.WinBackCampaignStats, error) {
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+	return s.repo.ListCampaignStats(ctx, s.config.AttributionDays, req.Limit, req.Offset)
+}
+
+// GetCampaign returns one campaign with its performance
+func (s *WinBackService) GetCampaign(ctx context.Context, id int) (*models.WinBackCampaignStats, error) {
+	return s.repo.FindCampaignStats(ctx, id, s.config.AttributionDays)
+}
+
+// ========================================================================
+// COUPONS (CouponRedeemer)
+// ========================================================================
+
+// CouponDiscount validates a customer's coupon and returns its discount on
+// price. Coupons issued to someone else are reported as not found.
+func (s *WinBackService) CouponDiscount(ctx context.Context, code string, customerID int, price float64) (float64, error) {
+	message, err := s.repo.FindByCouponCode(ctx, normalizeCouponCode(code))
+	if err != nil {
+		return 0, err
+	}
+	if message.CustomerID != customerID {
+		return 0, repository.ErrCouponNotFound
+	}
+	if message.RedeemedAt != nil {
+		return 0, repository.ErrCouponRedeemed
+	}
+	if message.CouponExpiresAt != nil && !s.clock.Now().Before(*message.CouponExpiresAt) {
+		return 0, repository.ErrCouponExpired
+	}
+	return message.CouponDiscount(price), nil
+}
+
+// RedeemCoupon marks a coupon used by a booking
+func (s *WinBackService) RedeemCoupon(ctx context.Context, code string, bookingID int) error {
+	return s.repo.RedeemCoupon(ctx, normalizeCouponCode(code), bookingID, s.clock.Now())
+}
+
+// normalizeCouponCode makes codes case- and whitespace-insensitive
+func normalizeCouponCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
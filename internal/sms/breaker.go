@@ -0,0 +1,56 @@
+// internal/sms/breaker.go
+package sms
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+
+	"barber-booking-system/internal/breaker"
+)
+
+// breakerSender rejects messages while the provider's circuit breaker is open
+type breakerSender struct {
+	sender  Sender
+	breaker *breaker.Breaker
+}
+
+// WithBreaker guards s with b. A nil sender stays nil.
+func WithBreaker(s Sender, b *breaker.Breaker) Sender {
+	if s == nil {
+		return nil
+	}
+	return &breakerSender{sender: s, breaker: b}
+}
+
+// IsProviderFailure reports whether err means the provider is failing.
+// Rejections of a particular message (4xx, e.g. an invalid number) are not.
+func IsProviderFailure(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var providerErr *ProviderError
+	if errors.As(err, &providerErr) {
+		return strings.HasPrefix(providerErr.Status, "5") || strings.HasPrefix(providerErr.Status, "429")
+	}
+	return true
+}
+
+func (s *breakerSender) Name() string {
+	return s.sender.Name()
+}
+
+func (s *breakerSender) Send(ctx context.Context, msg Message) (string, error) {
+	var id string
+	err := s.breaker.Do(func() error {
+		var err error
+		id, err = s.sender.Send(ctx, msg)
+		return err
+	})
+	return id, err
+}
+
+func (s *breakerSender) VerifyCallback(callbackURL string, params url.Values, signature string) bool {
+	return s.sender.VerifyCallback(callbackURL, params, signature)
+}
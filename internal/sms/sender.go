@@ -0,0 +1,108 @@
+// internal/sms/sender.go
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ========================================================================
+// SMS SENDER - Provider-agnostic text messages
+// ========================================================================
+//
+// Messages are handed to the provider and accepted asynchronously; the
+// provider later reports the final outcome through a status callback that
+// is signed so it cannot be forged.
+// ========================================================================
+
+var (
+	// ErrNotConfigured is returned when no SMS provider is set up
+	ErrNotConfigured = errors.New("sms is not configured")
+
+	// ErrInvalidSignature is returned when a status callback fails verification
+	ErrInvalidSignature = errors.New("invalid sms callback signature")
+)
+
+// Delivery states reported by status callbacks
+const (
+	StatusQueued      = "queued"
+	StatusSending     = "sending"
+	StatusSent        = "sent"
+	StatusDelivered   = "delivered"
+	StatusUndelivered = "undelivered"
+	StatusFailed      = "failed"
+)
+
+// ProviderError is a provider's refusal of a message. Code is the
+// provider's error code, which SuppressionReason can classify.
+type ProviderError struct {
+	Provider string
+	Status   string // HTTP status, e.g. "400 Bad Request"
+	Code     string
+	Message  string
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("%s returned %s: %s (code %s)", e.Provider, e.Status, e.Message, e.Code)
+}
+
+// Message is a text message to send
+type Message struct {
+	To   string // E.164 phone number
+	Body string
+
+	// StatusCallback receives delivery updates; empty disables them
+	StatusCallback string
+}
+
+// Sender is implemented by SMS providers
+type Sender interface {
+	// Name identifies the provider
+	Name() string
+
+	// Send hands a message to the provider and returns its message ID
+	Send(ctx context.Context, msg Message) (string, error)
+
+	// VerifyCallback checks that a status callback to callbackURL with the
+	// given form parameters was signed by the provider
+	VerifyCallback(callbackURL string, params url.Values, signature string) bool
+}
+
+// IsFinal reports whether a delivery status will not change again
+func IsFinal(status string) bool {
+	switch status {
+	case StatusDelivered, StatusUndelivered, StatusFailed:
+		return true
+	}
+	return false
+}
+
+// Keywords recipients reply with to stop or resume texts (the carrier-
+// mandated set; providers also handle them themselves)
+var (
+	optOutKeywords = []string{"STOP", "STOPALL", "UNSUBSCRIBE", "CANCEL", "END", "QUIT"}
+	optInKeywords  = []string{"START", "YES", "UNSTOP"}
+)
+
+// IsOptOut reports whether an inbound message body asks to stop texts
+func IsOptOut(body string) bool {
+	return isKeyword(body, optOutKeywords)
+}
+
+// IsOptIn reports whether an inbound message body asks to resume texts
+func IsOptIn(body string) bool {
+	return isKeyword(body, optInKeywords)
+}
+
+func isKeyword(body string, keywords []string) bool {
+	body = strings.ToUpper(strings.TrimSpace(body))
+	for _, k := range keywords {
+		if body == k {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+// internal/sms/twilio.go
+package sms
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// TWILIO SENDER - Programmable Messaging
+// ========================================================================
+
+const twilioRequestTimeout = 15 * time.Second
+
+// TwilioSender sends messages through the Twilio REST API
+type TwilioSender struct {
+	accountSID          string
+	authToken           string
+	fromNumber          string
+	messagingServiceSID string
+	baseURL             string
+	client              *http.Client
+}
+
+// NewTwilioSender creates a Twilio sender; it returns ErrNotConfigured
+// when credentials or a sender number are missing
+func NewTwilioSender(cfg config.TwilioConfig) (*TwilioSender, error) {
+	if !cfg.IsConfigured() {
+		return nil, ErrNotConfigured
+	}
+	baseURL := cfg.APIBaseURL
+	if baseURL == "" {
+		baseURL = "https://api.twilio.com"
+	}
+	return &TwilioSender{
+		accountSID:          cfg.AccountSID,
+		authToken:           cfg.AuthToken,
+		fromNumber:          cfg.FromNumber,
+		messagingServiceSID: cfg.MessagingServiceSID,
+		baseURL:             baseURL,
+		client:              &http.Client{Timeout: twilioRequestTimeout},
+	}, nil
+}
+
+// Name returns the provider name
+func (t *TwilioSender) Name() string {
+	return "twilio"
+}
+
+// twilioError is Twilio's error envelope
+type twilioError struct {
+	Code     int    `json:"code"`
+	Message  string `json:"message"`
+	MoreInfo string `json:"more_info"`
+}
+
+// Send creates a Message resource and returns its SID
+func (t *TwilioSender) Send(ctx context.Context, msg Message) (string, error) {
+	if msg.To == "" {
+		return "", fmt.Errorf("recipient phone number is required")
+	}
+	if strings.TrimSpace(msg.Body) == "" {
+		return "", fmt.Errorf("message body is required")
+	}
+
+	form := url.Values{}
+	form.Set("To", msg.To)
+	form.Set("Body", msg.Body)
+	if t.messagingServiceSID != "" {
+		form.Set("MessagingServiceSid", t.messagingServiceSID)
+	} else {
+		form.Set("From", t.fromNumber)
+	}
+	if msg.StatusCallback != "" {
+		form.Set("StatusCallback", msg.StatusCallback)
+	}
+
+	endpoint, err := url.JoinPath(t.baseURL, "2010-04-01", "Accounts", t.accountSID, "Messages.json")
+	if err != nil {
+		return "", fmt.Errorf("invalid twilio endpoint: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("failed to build twilio request: %w", err)
+	}
+	req.SetBasicAuth(t.accountSID, t.authToken)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("twilio request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		var apiErr twilioError
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		return "", &ProviderError{
+			Provider: t.Name(),
+			Status:   resp.Status,
+			Code:     strconv.Itoa(apiErr.Code),
+			Message:  apiErr.Message,
+		}
+	}
+
+	var message struct {
+		SID    string `json:"sid"`
+		Status string `json:"status"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&message); err != nil {
+		return "", fmt.Errorf("failed to decode twilio response: %w", err)
+	}
+	return message.SID, nil
+}
+
+// Twilio error codes that mean a number should no longer be texted, from
+// API errors and from ErrorCode in status callbacks
+var twilioSuppressionCodes = map[string]string{
+	"21211": config.SuppressionReasonHardBounce, // Invalid 'To' phone number
+	"21610": config.SuppressionReasonOptOut,     // Recipient replied STOP
+	"21614": config.SuppressionReasonHardBounce, // Not a mobile number
+	"30004": config.SuppressionReasonOptOut,     // Recipient blocked messages
+	"30005": config.SuppressionReasonHardBounce, // Unknown destination handset
+	"30006": config.SuppressionReasonHardBounce, // Landline or unreachable carrier
+}
+
+// SuppressionReason returns the suppression reason for a Twilio error code,
+// or "" when the failure may be temporary
+func SuppressionReason(code string) string {
+	return twilioSuppressionCodes[code]
+}
+
+// VerifyCallback validates the X-Twilio-Signature header: the base64
+// HMAC-SHA1, keyed with the auth token, of the full callback URL followed by
+// every POST parameter name and value sorted by name
+func (t *TwilioSender) VerifyCallback(callbackURL string, params url.Values, signature string) bool {
+	if signature == "" {
+		return false
+	}
+	expected, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	return hmac.Equal(expected, twilioSignature(t.authToken, callbackURL, params))
+}
+
+// twilioSignature computes the raw signature for a callback
+func twilioSignature(authToken, callbackURL string, params url.Values) []byte {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(callbackURL)
+	for _, k := range keys {
+		for _, v := range params[k] {
+			b.WriteString(k)
+			b.WriteString(v)
+		}
+	}
+
+	mac := hmac.New(sha1.New, []byte(authToken))
+	mac.Write([]byte(b.String()))
+	return mac.Sum(nil)
+}
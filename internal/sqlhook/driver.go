@@ -0,0 +1,152 @@
+// internal/sqlhook/driver.go
+package sqlhook
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"sync"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+// ========================================================================
+// SQL HOOKS - Per-request checks on every database call
+// ========================================================================
+//
+// The server can open the database through a driver that wraps lib/pq and
+// runs hooks with the caller's context before each call reaches Postgres.
+// Hooks see the request a query runs for, so they can count its queries
+// (internal/querybudget) or fail and delay them (internal/chaos). An error
+// from a hook is returned in place of the call's result.
+// ========================================================================
+
+// PostgresDriver is the name of the hooked Postgres driver
+const PostgresDriver = "postgres+hooks"
+
+// Database operations hooks run before
+const (
+	OpQuery   = "query"
+	OpExec    = "exec"
+	OpPrepare = "prepare"
+	OpBegin   = "begin"
+	OpPing    = "ping"
+)
+
+// Hook runs before a database operation
+type Hook func(ctx context.Context, op string) error
+
+var registerOnce sync.Once
+
+// RegisterPostgres registers PostgresDriver running hooks, in order, and
+// returns its name. Only the first call registers the driver.
+func RegisterPostgres(hooks ...Hook) string {
+	registerOnce.Do(func() {
+		sql.Register(PostgresDriver, WrapDriver(&pq.Driver{}, hooks...))
+		sqlx.BindDriver(PostgresDriver, sqlx.DOLLAR)
+	})
+	return PostgresDriver
+}
+
+// WrapDriver returns a driver whose connections run hooks before every
+// query, statement, transaction and ping
+func WrapDriver(d driver.Driver, hooks ...Hook) driver.Driver {
+	return hookDriver{Driver: d, hooks: hooks}
+}
+
+type hookDriver struct {
+	driver.Driver
+	hooks []Hook
+}
+
+func (d hookDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &hookConn{Conn: conn, hooks: d.hooks}, nil
+}
+
+// hookConn delegates to the wrapped connection after running the hooks.
+// Optional interfaces the wrapped connection lacks report driver.ErrSkip
+// so database/sql falls back as it would without the wrapper.
+type hookConn struct {
+	driver.Conn
+	hooks []Hook
+}
+
+func (c *hookConn) before(ctx context.Context, op string) error {
+	for _, hook := range c.hooks {
+		if err := hook(ctx, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *hookConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	if err := c.before(ctx, OpQuery); err != nil {
+		return nil, err
+	}
+	return queryer.QueryContext(ctx, query, args)
+}
+
+func (c *hookConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	if err := c.before(ctx, OpExec); err != nil {
+		return nil, err
+	}
+	return execer.ExecContext(ctx, query, args)
+}
+
+func (c *hookConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if err := c.before(ctx, OpPrepare); err != nil {
+		return nil, err
+	}
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+func (c *hookConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if err := c.before(ctx, OpBegin); err != nil {
+		return nil, err
+	}
+	if beginner, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return beginner.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin()
+}
+
+func (c *hookConn) Ping(ctx context.Context) error {
+	if err := c.before(ctx, OpPing); err != nil {
+		return err
+	}
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *hookConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *hookConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
@@ -0,0 +1,39 @@
+// internal/statemachine/booking.go
+package statemachine
+
+import "barber-booking-system/internal/config"
+
+// ========================================================================
+// BOOKING STATUS MACHINE
+// ========================================================================
+//
+// The single source of truth for booking status transitions:
+//
+//	pending ──► confirmed ──► in_progress ──► completed
+//	   │            │              │
+//	   ├──► cancelled ◄────────────┘   (also cancelled_by_customer/barber)
+//	   └──► no_show ◄─── confirmed
+//
+// Regenerate docs/booking_state_machine.md after changing it:
+//
+//	go generate ./internal/statemachine/...
+// ========================================================================
+
+//go:generate go run ../../cmd/statediagram -o ../../docs/booking_state_machine.md
+
+// NewBooking returns a fresh booking machine with the standard states and
+// transitions. Each caller gets its own instance so guards and hooks
+// registered by one component do not leak into another.
+func NewBooking[T any]() *Machine[T] {
+	return New[T]("booking").
+		State(config.BookingStatusPending, config.BookingStatusConfirmed, config.BookingStatusInProgress).
+		Terminal(config.BookingStatusCompleted, config.BookingStatusCancelled, config.BookingStatusNoShow).
+		Alias(config.BookingStatusCancelledByCustomer, config.BookingStatusCancelled).
+		Alias(config.BookingStatusCancelledByBarber, config.BookingStatusCancelled).
+		Allow(config.BookingStatusPending,
+			config.BookingStatusConfirmed, config.BookingStatusCancelled, config.BookingStatusNoShow).
+		Allow(config.BookingStatusConfirmed,
+			config.BookingStatusInProgress, config.BookingStatusCancelled, config.BookingStatusNoShow).
+		Allow(config.BookingStatusInProgress,
+			config.BookingStatusCompleted, config.BookingStatusCancelled)
+}
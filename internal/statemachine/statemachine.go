@@ -0,0 +1,308 @@
+// internal/statemachine/statemachine.go
+
+// Package statemachine provides a small, reusable finite state machine for
+// entity status fields: declared states and transitions, per-transition
+// guards, on-enter hooks, and Mermaid/Graphviz diagram generation.
+//
+// A Machine is built once at startup and is safe for concurrent use after
+// that; registering guards or hooks while transitions are being checked is
+// not supported.
+package statemachine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ErrTransitionNotAllowed is wrapped by every error returned for a
+// transition rejected by the declared rules or by a guard
+var ErrTransitionNotAllowed = errors.New("invalid status transition")
+
+// Guard vetoes a transition for a specific subject (e.g. "cannot mark a
+// booking as no-show before it starts"). Returning an error blocks it.
+type Guard[T any] func(ctx context.Context, subject T, from, to string) error
+
+// Hook is a side effect that runs after a subject has entered a state
+type Hook[T any] func(ctx context.Context, subject T, from, to string) error
+
+type edge struct {
+	from, to string
+}
+
+// Machine holds the states, transitions, guards and hooks for one entity type
+type Machine[T any] struct {
+	name        string
+	initial     string
+	states      []string
+	known       map[string]bool
+	terminal    map[string]bool
+	aliases     map[string]string // variant -> canonical state
+	transitions map[string][]string
+	guards      map[edge][]Guard[T]
+	onEnter     map[string][]Hook[T]
+}
+
+// New creates an empty machine; name is used as the diagram title
+func New[T any](name string) *Machine[T] {
+	return &Machine[T]{
+		name:        name,
+		known:       make(map[string]bool),
+		terminal:    make(map[string]bool),
+		aliases:     make(map[string]string),
+		transitions: make(map[string][]string),
+		guards:      make(map[edge][]Guard[T]),
+		onEnter:     make(map[string][]Hook[T]),
+	}
+}
+
+// ========================================================================
+// DEFINITION
+// ========================================================================
+
+// State declares states in display order. The first state declared on a
+// machine is its initial state.
+func (m *Machine[T]) State(names ...string) *Machine[T] {
+	for _, name := range names {
+		if m.known[name] {
+			continue
+		}
+		if m.initial == "" {
+			m.initial = name
+		}
+		m.known[name] = true
+		m.states = append(m.states, name)
+	}
+	return m
+}
+
+// Terminal declares final states that allow no further transitions
+func (m *Machine[T]) Terminal(names ...string) *Machine[T] {
+	m.State(names...)
+	for _, name := range names {
+		m.terminal[name] = true
+	}
+	return m
+}
+
+// Alias declares variant as a more specific form of canonical (e.g.
+// cancelled_by_customer of cancelled). Transitions to and from the variant
+// follow the canonical state's rules, and the variant is not listed
+// separately in allowed transitions or diagrams.
+func (m *Machine[T]) Alias(variant, canonical string) *Machine[T] {
+	m.aliases[variant] = canonical
+	return m
+}
+
+// Allow declares transitions from one state to each of the given states
+func (m *Machine[T]) Allow(from string, to ...string) *Machine[T] {
+	m.State(from)
+	m.State(to...)
+	m.transitions[from] = append(m.transitions[from], to...)
+	return m
+}
+
+// Guard registers a guard for a transition. An alias variant may be used
+// as the target to guard only that variant.
+func (m *Machine[T]) Guard(from, to string, guard Guard[T]) *Machine[T] {
+	key := edge{from, to}
+	m.guards[key] = append(m.guards[key], guard)
+	return m
+}
+
+// OnEnter registers a hook run by Enter when a subject reaches state.
+// Hooks on a canonical state also run when entering any of its aliases.
+func (m *Machine[T]) OnEnter(state string, hook Hook[T]) *Machine[T] {
+	m.onEnter[state] = append(m.onEnter[state], hook)
+	return m
+}
+
+// ========================================================================
+// QUERIES
+// ========================================================================
+
+// canonical resolves an alias to its canonical state
+func (m *Machine[T]) canonical(state string) string {
+	if c, ok := m.aliases[state]; ok {
+		return c
+	}
+	return state
+}
+
+// isKnown reports whether state is declared directly or as an alias
+func (m *Machine[T]) isKnown(state string) bool {
+	return m.known[m.canonical(state)]
+}
+
+// Name returns the machine name
+func (m *Machine[T]) Name() string {
+	return m.name
+}
+
+// Initial returns the initial state
+func (m *Machine[T]) Initial() string {
+	return m.initial
+}
+
+// States returns the declared (canonical) states in display order
+func (m *Machine[T]) States() []string {
+	return append([]string(nil), m.states...)
+}
+
+// IsTerminal reports whether state is a final state; unknown states are not
+func (m *Machine[T]) IsTerminal(state string) bool {
+	return m.terminal[m.canonical(state)]
+}
+
+// Allowed returns the states reachable from the given state in one step
+func (m *Machine[T]) Allowed(from string) ([]string, error) {
+	if !m.isKnown(from) {
+		return nil, fmt.Errorf("invalid status: %s", from)
+	}
+	return append([]string{}, m.transitions[m.canonical(from)]...), nil
+}
+
+// Validate checks a transition against the declared rules only (no guards)
+func (m *Machine[T]) Validate(from, to string) error {
+	if !m.isKnown(from) {
+		return fmt.Errorf("invalid current status: %s", from)
+	}
+	if !m.isKnown(to) {
+		return fmt.Errorf("invalid target status: %s", to)
+	}
+
+	allowed := m.transitions[m.canonical(from)]
+	for _, s := range allowed {
+		if s == m.canonical(to) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf(
+		"%w: cannot change from '%s' to '%s'. Allowed transitions: %v",
+		ErrTransitionNotAllowed, from, to, allowed,
+	)
+}
+
+// Can reports whether a transition is allowed by the declared rules
+func (m *Machine[T]) Can(from, to string) bool {
+	return m.Validate(from, to) == nil
+}
+
+// ========================================================================
+// TRANSITIONS
+// ========================================================================
+
+// Check validates a transition for a specific subject: the declared rules
+// first, then every guard registered for the transition
+func (m *Machine[T]) Check(ctx context.Context, subject T, from, to string) error {
+	if err := m.Validate(from, to); err != nil {
+		return err
+	}
+
+	keys := []edge{{m.canonical(from), m.canonical(to)}}
+	if to != m.canonical(to) {
+		keys = append(keys, edge{m.canonical(from), to})
+	}
+	for _, key := range keys {
+		for _, guard := range m.guards[key] {
+			if err := guard(ctx, subject, from, to); err != nil {
+				return fmt.Errorf("%w: %w", ErrTransitionNotAllowed, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Enter runs the on-enter hooks for the new state after the transition has
+// been persisted. Every hook runs; their errors are joined.
+func (m *Machine[T]) Enter(ctx context.Context, subject T, from, to string) error {
+	states := []string{m.canonical(to)}
+	if to != m.canonical(to) {
+		states = append(states, to)
+	}
+
+	var errs []error
+	for _, state := range states {
+		for _, hook := range m.onEnter[state] {
+			if err := hook(ctx, subject, from, to); err != nil {
+				errs = append(errs, fmt.Errorf("%s hook: %w", state, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ========================================================================
+// DIAGRAMS
+// ========================================================================
+
+// Mermaid renders the machine as a Mermaid stateDiagram-v2
+func (m *Machine[T]) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	fmt.Fprintf(&b, "    %%%% %s\n", m.name)
+	if m.initial != "" {
+		fmt.Fprintf(&b, "    [*] --> %s\n", m.initial)
+	}
+	for _, from := range m.states {
+		for _, to := range m.transitions[from] {
+			fmt.Fprintf(&b, "    %s --> %s%s\n", from, to, m.guardLabel(from, to, ": "))
+		}
+	}
+	for _, state := range m.states {
+		if m.terminal[state] {
+			fmt.Fprintf(&b, "    %s --> [*]\n", state)
+		}
+	}
+	for _, variant := range m.sortedAliases() {
+		fmt.Fprintf(&b, "    note right of %s: also %s\n", m.aliases[variant], variant)
+	}
+	return b.String()
+}
+
+// DOT renders the machine as a Graphviz digraph
+func (m *Machine[T]) DOT() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", m.name)
+	b.WriteString("    rankdir=LR;\n")
+	b.WriteString("    node [shape=box, style=rounded];\n")
+	for _, state := range m.states {
+		attrs := ""
+		if m.terminal[state] {
+			attrs = " [peripheries=2]"
+		}
+		fmt.Fprintf(&b, "    %q%s;\n", state, attrs)
+	}
+	for _, from := range m.states {
+		for _, to := range m.transitions[from] {
+			label := m.guardLabel(from, to, "")
+			if label != "" {
+				fmt.Fprintf(&b, "    %q -> %q [label=%q];\n", from, to, label)
+			} else {
+				fmt.Fprintf(&b, "    %q -> %q;\n", from, to)
+			}
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// guardLabel marks guarded transitions in diagrams
+func (m *Machine[T]) guardLabel(from, to, prefix string) string {
+	if len(m.guards[edge{from, to}]) == 0 {
+		return ""
+	}
+	return prefix + "[guarded]"
+}
+
+func (m *Machine[T]) sortedAliases() []string {
+	variants := make([]string, 0, len(m.aliases))
+	for v := range m.aliases {
+		variants = append(variants, v)
+	}
+	sort.Strings(variants)
+	return variants
+}
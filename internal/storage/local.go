@@ -0,0 +1,87 @@
+// internal/storage/local.go
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"barber-booking-system/internal/config"
+)
+
+// LocalStorage keeps files in a directory, served by this API at publicURL
+type LocalStorage struct {
+	root      string
+	publicURL string
+}
+
+// NewLocalStorage creates a storage rooted at dir
+func NewLocalStorage(dir, publicURL string) *LocalStorage {
+	if publicURL == "" {
+		publicURL = config.DefaultUploadPublicPath
+	}
+	return &LocalStorage{root: dir, publicURL: publicURL}
+}
+
+// Root returns the directory files are kept in
+func (s *LocalStorage) Root() string {
+	return s.root
+}
+
+// PublicURL returns the base URL files are served from
+func (s *LocalStorage) PublicURL() string {
+	return s.publicURL
+}
+
+// Put writes data to a temporary file and renames it into place, so a
+// reader never sees a partly written file
+func (s *LocalStorage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	path := filepath.Join(s.root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create upload directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create upload file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write upload file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write upload file: %w", err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return fmt.Errorf("failed to write upload file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to store upload file: %w", err)
+	}
+	return nil
+}
+
+// Delete removes the file under key
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	err := os.Remove(filepath.Join(s.root, filepath.FromSlash(key)))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete upload file: %w", err)
+	}
+	return nil
+}
+
+// URL returns publicURL/key
+func (s *LocalStorage) URL(key string) string {
+	return joinURL(s.publicURL, key)
+}
@@ -0,0 +1,209 @@
+// internal/storage/s3.go
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// S3 STORAGE - Objects in an S3 or S3-compatible bucket
+// ========================================================================
+//
+// Requests are signed with AWS Signature Version 4, so no SDK is needed.
+// Objects are written with a long cache lifetime: keys are never reused,
+// a changed file gets a new key.
+// ========================================================================
+
+const (
+	s3RequestTimeout = 30 * time.Second
+	s3CacheControl   = "public, max-age=31536000, immutable"
+)
+
+// S3Storage keeps files in an S3 bucket
+type S3Storage struct {
+	cfg       config.S3Config
+	endpoint  *url.URL
+	publicURL string
+	client    *http.Client
+	now       func() time.Time
+}
+
+// NewS3Storage creates an S3 storage; it returns ErrNotConfigured when the
+// bucket, region or credentials are missing. Files are served from
+// publicURL, or straight from the bucket when it is empty.
+func NewS3Storage(cfg config.S3Config, publicURL string) (*S3Storage, error) {
+	if !cfg.IsConfigured() {
+		return nil, ErrNotConfigured
+	}
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", cfg.Region)
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid S3 endpoint %q", endpoint)
+	}
+	return &S3Storage{
+		cfg:       cfg,
+		endpoint:  u,
+		publicURL: publicURL,
+		client:    &http.Client{Timeout: s3RequestTimeout},
+		now:       time.Now,
+	}, nil
+}
+
+// Put uploads an object
+func (s *S3Storage) Put(ctx context.Context, key string, data []byte, contentType string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	req, err := s.newRequest(ctx, http.MethodPut, key, data)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Cache-Control", s3CacheControl)
+	return s.do(req)
+}
+
+// Delete removes an object; S3 reports success for missing objects too
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+	return s.do(req)
+}
+
+// URL returns publicURL/key, or the object's URL in the bucket
+func (s *S3Storage) URL(key string) string {
+	if s.publicURL != "" {
+		return joinURL(s.publicURL, key)
+	}
+	host, path := s.objectLocation(key)
+	return s.endpoint.Scheme + "://" + host + path
+}
+
+// objectLocation returns the host and escaped path addressing an object
+func (s *S3Storage) objectLocation(key string) (host, path string) {
+	path = "/" + uriEncode(key, false)
+	if s.cfg.PathStyle {
+		return s.endpoint.Host, "/" + uriEncode(s.cfg.Bucket, true) + path
+	}
+	return s.cfg.Bucket + "." + s.endpoint.Host, path
+}
+
+// newRequest builds a signed request for an object
+func (s *S3Storage) newRequest(ctx context.Context, method, key string, body []byte) (*http.Request, error) {
+	host, path := s.objectLocation(key)
+	req, err := http.NewRequestWithContext(ctx, method, s.endpoint.Scheme+"://"+host+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build S3 request: %w", err)
+	}
+	req.ContentLength = int64(len(body))
+	s.sign(req, host, path, body)
+	return req, nil
+}
+
+// sign adds a Signature Version 4 Authorization header covering the host,
+// the payload hash and the date
+func (s *S3Storage) sign(req *http.Request, host, path string, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	day := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	const signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		"", // No query string
+		"host:" + host,
+		"x-amz-content-sha256:" + payloadHash,
+		"x-amz-date:" + amzDate,
+		"",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := day + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), day)
+	key = hmacSHA256(key, s.cfg.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.cfg.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// do sends a request and turns an error response into an error
+func (s *S3Storage) do(req *http.Request) error {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("S3 %s failed: %w", req.Method, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("S3 %s returned %s: %s", req.Method, resp.Status, strings.TrimSpace(string(detail)))
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// uriEncode percent-encodes every byte but the unreserved characters, as
+// Signature Version 4 requires; slashes are kept unless encodeSlash is set
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
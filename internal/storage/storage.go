@@ -0,0 +1,71 @@
+// internal/storage/storage.go
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"barber-booking-system/internal/config"
+)
+
+// ========================================================================
+// STORAGE - Uploaded files on local disk or in S3
+// ========================================================================
+//
+// Files are stored under keys such as reviews/12/3f2c….jpg and served
+// from the configured public URL, so the rest of the application never
+// deals with paths or buckets. Keys are chosen by the application, never
+// by the uploader.
+// ========================================================================
+
+var (
+	// ErrNotConfigured is returned when the configured driver lacks settings
+	ErrNotConfigured = errors.New("upload storage is not configured")
+
+	// ErrInvalidKey is returned for keys that could escape the storage root
+	ErrInvalidKey = errors.New("invalid storage key")
+)
+
+// Storage keeps uploaded files
+type Storage interface {
+	// Put stores data under key, replacing any file already there
+	Put(ctx context.Context, key string, data []byte, contentType string) error
+
+	// Delete removes the file under key; a missing file is not an error
+	Delete(ctx context.Context, key string) error
+
+	// URL returns the public URL of the file under key
+	URL(key string) string
+}
+
+// New creates the storage the upload configuration selects
+func New(cfg config.UploadConfig) (Storage, error) {
+	switch cfg.Driver {
+	case "", config.UploadDriverLocal:
+		return NewLocalStorage(cfg.Directory, cfg.PublicURL), nil
+	case config.UploadDriverS3:
+		return NewS3Storage(cfg.S3, cfg.PublicURL)
+	default:
+		return nil, fmt.Errorf("unknown upload driver %q", cfg.Driver)
+	}
+}
+
+// validateKey rejects empty, absolute and parent-relative keys
+func validateKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return ErrInvalidKey
+		}
+	}
+	return nil
+}
+
+// joinURL appends a key to a base URL
+func joinURL(base, key string) string {
+	return strings.TrimRight(base, "/") + "/" + key
+}
@@ -0,0 +1,234 @@
+// internal/timefmt/timefmt.go
+package timefmt
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ========================================================================
+// TIMEFMT - Times written the way the reader reads them
+// ========================================================================
+//
+// Times in notification messages are written in the recipient's timezone
+// and language, with their choice of 12- or 24-hour clock. The choices
+// come from the user's preferences (see the Preference keys); anything
+// missing or unknown falls back to the defaults the Style was built from.
+// Go's time layouts only know English names, so each locale brings its
+// own day and month names and word order.
+// ========================================================================
+
+// Preference keys in users.preferences
+const (
+	PreferenceTimezone   = "timezone"    // IANA zone, e.g. "Europe/Madrid"
+	PreferenceLocale     = "locale"      // Language, e.g. "es" or "pt-BR"
+	PreferenceTimeFormat = "time_format" // "12h" or "24h"
+)
+
+// Clock formats accepted for PreferenceTimeFormat
+const (
+	Clock12 = "12h"
+	Clock24 = "24h"
+)
+
+// locale is how one language writes dates and times
+type locale struct {
+	days   [7]string  // Sunday first, as time.Weekday
+	months [12]string // January first
+	clock  string     // Default clock format
+
+	// dateTime writes a full date and time, date a day of the year
+	dateTime func(day, date, clock string) string
+	date     func(day int, month string) string
+}
+
+// locales are the supported languages, by ISO 639-1 code
+var locales = map[string]locale{
+	"en": {
+		days:   [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"},
+		months: [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		clock:  Clock12,
+		dateTime: func(day, date, clock string) string {
+			return fmt.Sprintf("%s, %s at %s", day, date, clock)
+		},
+		date: func(day int, month string) string { return fmt.Sprintf("%s %d", month, day) },
+	},
+	"es": {
+		days:   [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+		months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		clock:  Clock24,
+		dateTime: func(day, date, clock string) string {
+			return fmt.Sprintf("%s, %s a las %s", day, date, clock)
+		},
+		date: func(day int, month string) string { return fmt.Sprintf("%d de %s", day, month) },
+	},
+	"fr": {
+		days:   [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+		months: [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		clock:  Clock24,
+		dateTime: func(day, date, clock string) string {
+			return fmt.Sprintf("%s %s à %s", day, date, clock)
+		},
+		date: func(day int, month string) string { return fmt.Sprintf("%d %s", day, month) },
+	},
+	"de": {
+		days:   [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+		months: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		clock:  Clock24,
+		dateTime: func(day, date, clock string) string {
+			return fmt.Sprintf("%s, %s um %s", day, date, clock)
+		},
+		date: func(day int, month string) string { return fmt.Sprintf("%d. %s", day, month) },
+	},
+	"pt": {
+		days:   [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+		months: [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		clock:  Clock24,
+		dateTime: func(day, date, clock string) string {
+			return fmt.Sprintf("%s, %s às %s", day, date, clock)
+		},
+		date: func(day int, month string) string { return fmt.Sprintf("%d de %s", day, month) },
+	},
+	"it": {
+		days:   [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+		months: [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		clock:  Clock24,
+		dateTime: func(day, date, clock string) string {
+			return fmt.Sprintf("%s %s alle %s", day, date, clock)
+		},
+		date: func(day int, month string) string { return fmt.Sprintf("%d %s", day, month) },
+	},
+}
+
+// DefaultLocale is used for unknown languages
+const DefaultLocale = "en"
+
+// Style is how times are written for one reader
+type Style struct {
+	Location *time.Location
+	Locale   string // Supported language code
+	Clock    string // Clock12 or Clock24; empty uses the locale's own
+}
+
+// Default returns the style for readers without preferences. An unknown
+// timezone or locale falls back to UTC or English.
+func Default(timezone, localeName string) Style {
+	style := Style{Location: time.UTC, Locale: DefaultLocale}
+	if loc, err := time.LoadLocation(timezone); err == nil && timezone != "" {
+		style.Location = loc
+	}
+	if code, ok := supported(localeName); ok {
+		style.Locale = code
+	}
+	return style
+}
+
+// WithPreferences returns the style with a user's preferences applied over
+// it; missing or invalid preferences keep the style's own settings
+func (s Style) WithPreferences(prefs map[string]interface{}) Style {
+	if name, ok := prefs[PreferenceTimezone].(string); ok && name != "" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			s.Location = loc
+		}
+	}
+	if name, ok := prefs[PreferenceLocale].(string); ok {
+		if code, ok := supported(name); ok {
+			s.Locale = code
+		}
+	}
+	if clock, ok := prefs[PreferenceTimeFormat].(string); ok && (clock == Clock12 || clock == Clock24) {
+		s.Clock = clock
+	}
+	return s
+}
+
+// ErrInvalidPreference is returned for time preferences that cannot be used
+var ErrInvalidPreference = errors.New("invalid time preference")
+
+// ValidatePreferences checks the time preferences a user saves; other
+// preference keys are not looked at
+func ValidatePreferences(prefs map[string]interface{}) error {
+	if value, ok := prefs[PreferenceTimezone]; ok && value != nil {
+		name, isString := value.(string)
+		if _, err := time.LoadLocation(name); !isString || name == "" || err != nil {
+			return fmt.Errorf("%w: %s must be an IANA timezone such as Europe/Madrid", ErrInvalidPreference, PreferenceTimezone)
+		}
+	}
+	if value, ok := prefs[PreferenceLocale]; ok && value != nil {
+		name, _ := value.(string)
+		if _, ok := supported(name); !ok {
+			return fmt.Errorf("%w: %s must be one of: %s", ErrInvalidPreference, PreferenceLocale, strings.Join(SupportedLocales(), ", "))
+		}
+	}
+	if value, ok := prefs[PreferenceTimeFormat]; ok && value != nil {
+		if clock, _ := value.(string); clock != Clock12 && clock != Clock24 {
+			return fmt.Errorf("%w: %s must be %s or %s", ErrInvalidPreference, PreferenceTimeFormat, Clock12, Clock24)
+		}
+	}
+	return nil
+}
+
+// SupportedLocales lists the supported language codes
+func SupportedLocales() []string {
+	codes := make([]string, 0, len(locales))
+	for code := range locales {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+// DateTime writes a day, date and time of day, e.g. "Monday, January 2 at
+// 3:04 PM" or "lunes, 2 de enero a las 15:04"
+func (s Style) DateTime(t time.Time) string {
+	t = t.In(s.location())
+	l := s.locale()
+	return l.dateTime(l.days[t.Weekday()], l.date(t.Day(), l.months[t.Month()-1]), s.clockTime(t, l))
+}
+
+// Date writes a day of the year, e.g. "January 2" or "2 de enero"
+func (s Style) Date(t time.Time) string {
+	t = t.In(s.location())
+	l := s.locale()
+	return l.date(t.Day(), l.months[t.Month()-1])
+}
+
+// clockTime writes the time of day on the style's clock
+func (s Style) clockTime(t time.Time, l locale) string {
+	clock := s.Clock
+	if clock == "" {
+		clock = l.clock
+	}
+	if clock == Clock24 {
+		return t.Format("15:04")
+	}
+	return t.Format("3:04 PM")
+}
+
+func (s Style) location() *time.Location {
+	if s.Location == nil {
+		return time.UTC
+	}
+	return s.Location
+}
+
+func (s Style) locale() locale {
+	if l, ok := locales[s.Locale]; ok {
+		return l
+	}
+	return locales[DefaultLocale]
+}
+
+// supported maps a language tag ("pt-BR", "es_MX", "FR") to a supported
+// language code
+func supported(name string) (string, bool) {
+	code := strings.ToLower(strings.TrimSpace(name))
+	if i := strings.IndexAny(code, "-_"); i >= 0 {
+		code = code[:i]
+	}
+	_, ok := locales[code]
+	return code, ok
+}
@@ -0,0 +1,182 @@
+// internal/webhook/webhook.go
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ========================================================================
+// WEBHOOKS - Signing and safe delivery of outbound events
+// ========================================================================
+//
+// Every delivery carries a signature header:
+//
+//	X-Webhook-Signature: t=1714746600,v1=5f2b...
+//
+// where v1 is the hex HMAC-SHA256 of "<t>.<body>" keyed with the
+// subscription's secret. Receivers recompute it (see Verify) and reject
+// stale timestamps so captured requests cannot be replayed.
+//
+// Subscription URLs are chosen by users, so the delivery client refuses to
+// connect to loopback, private and link-local addresses, checked after DNS
+// resolution, and never follows redirects.
+// ========================================================================
+
+// Delivery headers
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	EventIDHeader   = "X-Webhook-ID"
+)
+
+// secretPrefix marks webhook signing secrets
+const secretPrefix = "whsec_"
+
+var (
+	// ErrInvalidSignature is returned for missing, malformed or wrong signatures
+	ErrInvalidSignature = errors.New("invalid webhook signature")
+
+	// ErrStaleSignature is returned for correct signatures outside the tolerance
+	ErrStaleSignature = errors.New("webhook signature timestamp is too old")
+
+	// ErrBlockedAddress is returned when a webhook URL resolves to an internal address
+	ErrBlockedAddress = errors.New("webhook address is not publicly routable")
+)
+
+// NewSecret returns a random signing secret
+func NewSecret() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return secretPrefix + hex.EncodeToString(b), nil
+}
+
+// Sign returns the signature header value for a body sent at t
+func Sign(secret string, t time.Time, body []byte) string {
+	ts := strconv.FormatInt(t.Unix(), 10)
+	return "t=" + ts + ",v1=" + mac(secret, ts, body)
+}
+
+// Verify checks a signature header value against the body received at
+// now. Signatures older (or newer) than tolerance are rejected.
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var ts string
+	var signatures []string
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			continue
+		}
+		switch key {
+		case "t":
+			ts = value
+		case "v1":
+			signatures = append(signatures, value)
+		}
+	}
+	unix, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || len(signatures) == 0 {
+		return ErrInvalidSignature
+	}
+
+	expected := mac(secret, ts, body)
+	valid := false
+	for _, sig := range signatures {
+		if hmac.Equal([]byte(sig), []byte(expected)) {
+			valid = true
+		}
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+
+	age := now.Sub(time.Unix(unix, 0))
+	if age > tolerance || age < -tolerance {
+		return ErrStaleSignature
+	}
+	return nil
+}
+
+// mac returns the hex HMAC-SHA256 of "<ts>.<body>"
+func mac(secret, ts string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(ts))
+	h.Write([]byte("."))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ValidateURL checks a subscription URL: absolute, https (http only when
+// allowInsecure), with a host and no credentials
+func ValidateURL(raw string, allowInsecure bool) error {
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("url must be an absolute URL such as https://example.com/webhooks")
+	}
+	if u.Scheme != "https" && !(allowInsecure && u.Scheme == "http") {
+		return fmt.Errorf("url must use https")
+	}
+	if u.User != nil {
+		return fmt.Errorf("url cannot contain credentials")
+	}
+	if !allowInsecure {
+		if ip := net.ParseIP(u.Hostname()); ip != nil && isInternal(ip) {
+			return ErrBlockedAddress
+		}
+		if strings.EqualFold(u.Hostname(), "localhost") {
+			return ErrBlockedAddress
+		}
+	}
+	return nil
+}
+
+// NewClient returns the HTTP client deliveries are sent with. Unless
+// allowInternal is set (local development), it refuses to connect to
+// internal addresses.
+func NewClient(timeout time.Duration, allowInternal bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowInternal {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isInternal(ip) {
+				return ErrBlockedAddress
+			}
+			return nil
+		}
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConnsPerHost: 2,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// isInternal reports addresses a webhook must not reach
+func isInternal(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified()
+}
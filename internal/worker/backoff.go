@@ -0,0 +1,23 @@
+// internal/worker/backoff.go
+package worker
+
+import "time"
+
+// Backoff returns the delay before retrying after the given failed attempt
+// (1-based): base, 2*base, 4*base, ... capped at max
+func Backoff(attempt int, base, max time.Duration) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	delay := base
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= max || delay <= 0 {
+			return max
+		}
+	}
+	if delay > max {
+		return max
+	}
+	return delay
+}
@@ -0,0 +1,141 @@
+// internal/worker/drain.go
+package worker
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ========================================================================
+// DRAIN - Letting in-flight runs finish on shutdown
+// ========================================================================
+//
+// Cancelling the context passed to Run only stops new runs from starting.
+// Runs already in flight keep a context detached from it, so a shutdown
+// does not fail their queries halfway. Shutdown waits for them and only
+// when its deadline passes cancels their context, which jobs treat as a
+// checkpoint: they stop after the current batch or item and leave the rest
+// (claimed rows are released when their lease ends) for the next run.
+// ========================================================================
+
+// AbortGrace is how long Shutdown waits for aborted runs to return
+const AbortGrace = 2 * time.Second
+
+// DrainStatus reports how a shutdown went
+type DrainStatus struct {
+	Drained     bool          // Every in-flight run finished before the deadline
+	Interrupted []string      // Jobs whose runs were aborted at the deadline
+	Flushed     []string      // Flush jobs run after draining
+	Unflushed   []string      // Flush jobs skipped because the deadline passed
+	Duration    time.Duration // Time spent shutting down
+}
+
+// Runs tracks the runs in flight during one Run call. Worker and
+// cron.Scheduler each keep one.
+type Runs struct {
+	mu      sync.Mutex
+	running map[string]int
+	runCtx  context.Context
+	stop    context.CancelFunc
+	abort   context.CancelFunc
+	done    chan struct{}
+	stopped bool
+}
+
+// Start begins a Run. It returns the context that stops new runs, which is
+// cancelled with ctx or by Shutdown.
+func (r *Runs) Start(ctx context.Context) context.Context {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stopCtx, stop := context.WithCancel(ctx)
+	r.runCtx, r.abort = context.WithCancel(context.WithoutCancel(ctx))
+	r.stop = stop
+	r.running = make(map[string]int)
+	r.done = make(chan struct{})
+	if r.stopped {
+		stop()
+	}
+	return stopCtx
+}
+
+// Finish marks the Run as returned
+func (r *Runs) Finish() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stop()
+	r.abort()
+	close(r.done)
+}
+
+// Once runs a job a single time (see RunOnce), tracked as in flight
+func (r *Runs) Once(job Job) {
+	r.mu.Lock()
+	ctx := r.runCtx
+	r.running[job.Name]++
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		if r.running[job.Name]--; r.running[job.Name] <= 0 {
+			delete(r.running, job.Name)
+		}
+		r.mu.Unlock()
+	}()
+
+	RunOnce(ctx, job)
+}
+
+// Running returns the names of the jobs in flight
+func (r *Runs) Running() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(r.running))
+	for name := range r.running {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Started reports whether Run has been called
+func (r *Runs) Started() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.done != nil
+}
+
+// Shutdown stops new runs and waits for the Run to return. When ctx ends
+// first, in-flight runs are aborted and given AbortGrace to return.
+func (r *Runs) Shutdown(ctx context.Context) DrainStatus {
+	start := time.Now()
+
+	r.mu.Lock()
+	r.stopped = true
+	done, stop, abort := r.done, r.stop, r.abort
+	r.mu.Unlock()
+
+	status := DrainStatus{Drained: true}
+	if done == nil {
+		return status
+	}
+
+	stop()
+	select {
+	case <-done:
+	case <-ctx.Done():
+		status.Drained = false
+		status.Interrupted = r.Running()
+		abort()
+		select {
+		case <-done:
+		case <-time.After(AbortGrace):
+		}
+	}
+	status.Duration = time.Since(start)
+	return status
+}
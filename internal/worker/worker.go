@@ -0,0 +1,176 @@
+// internal/worker/worker.go
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"barber-booking-system/internal/logger"
+)
+
+// ========================================================================
+// WORKER - In-process background jobs
+// ========================================================================
+//
+// Each job runs on its own interval in its own goroutine. A run that fails
+// or panics is logged and the job carries on at the next tick. Run returns
+// once the context is cancelled and every in-flight run has finished, so
+// shutdown never cuts a run off halfway (see drain.go). Flush jobs run once
+// during Shutdown, after the in-flight runs.
+// ========================================================================
+
+// Job is a unit of background work run on an interval
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(ctx context.Context) error
+}
+
+// Worker runs registered jobs
+type Worker struct {
+	mu    sync.Mutex
+	jobs  []Job
+	flush []Job
+	runs  Runs
+}
+
+// New creates an empty worker
+func New() *Worker {
+	return &Worker{}
+}
+
+// Add registers a job. Jobs without a positive interval are ignored, which
+// lets configuration disable a job by setting its interval to 0.
+func (w *Worker) Add(job Job) {
+	if job.Interval <= 0 || job.Run == nil {
+		return
+	}
+	w.mu.Lock()
+	w.jobs = append(w.jobs, job)
+	w.mu.Unlock()
+}
+
+// AddFlush registers a job run once during Shutdown, after in-flight runs
+// have drained (e.g. dispatching work queued by the last requests)
+func (w *Worker) AddFlush(job Job) {
+	if job.Run == nil {
+		return
+	}
+	w.mu.Lock()
+	w.flush = append(w.flush, job)
+	w.mu.Unlock()
+}
+
+// Jobs returns the names of the registered jobs
+func (w *Worker) Jobs() []string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	names := make([]string, len(w.jobs))
+	for i, job := range w.jobs {
+		names[i] = job.Name
+	}
+	return names
+}
+
+// Run starts every job and blocks until ctx is cancelled and all runs finish.
+// Each job's first run happens after one interval. Cancelling ctx stops new
+// runs; runs in flight finish on a context of their own.
+func (w *Worker) Run(ctx context.Context) {
+	w.mu.Lock()
+	jobs := append([]Job(nil), w.jobs...)
+	w.mu.Unlock()
+
+	ctx = w.runs.Start(ctx)
+	defer w.runs.Finish()
+
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job Job) {
+			defer wg.Done()
+			w.loop(ctx, job)
+		}(job)
+	}
+	wg.Wait()
+}
+
+// loop runs one job on its interval until ctx is cancelled
+func (w *Worker) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// A tick racing the stop signal must not start a run
+			if ctx.Err() != nil {
+				return
+			}
+			w.runs.Once(job)
+		}
+	}
+}
+
+// Running returns the names of the jobs in flight
+func (w *Worker) Running() []string {
+	return w.runs.Running()
+}
+
+// Shutdown stops new runs, waits for in-flight runs until ctx ends (then
+// aborts them), and runs the flush jobs if time remains. Flush jobs only
+// run if the worker was running.
+func (w *Worker) Shutdown(ctx context.Context) DrainStatus {
+	start := time.Now()
+	status := w.runs.Shutdown(ctx)
+	if !w.runs.Started() {
+		return status
+	}
+
+	w.mu.Lock()
+	flush := append([]Job(nil), w.flush...)
+	w.mu.Unlock()
+
+	for _, job := range flush {
+		if ctx.Err() != nil {
+			status.Unflushed = append(status.Unflushed, job.Name)
+			continue
+		}
+		RunOnce(ctx, job)
+		status.Flushed = append(status.Flushed, job.Name)
+	}
+	status.Duration = time.Since(start)
+	return status
+}
+
+// RunOnce runs a job a single time, logging (rather than returning) failures
+// and recovering from panics
+func RunOnce(ctx context.Context, job Job) {
+	log := logger.FromContext(ctx)
+	start := time.Now()
+
+	err := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+		return job.Run(ctx)
+	}()
+
+	if err != nil && ctx.Err() == nil {
+		log.Error(err).
+			Str("job", job.Name).
+			Dur("duration", time.Since(start)).
+			Msg("Background job failed")
+		return
+	}
+	log.Debug("Background job finished").
+		Str("job", job.Name).
+		Dur("duration", time.Since(start)).
+		Send()
+}
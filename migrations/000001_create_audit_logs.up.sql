@@ -0,0 +1,24 @@
+-- Platform-wide audit log used by the admin activity feed.
+-- actor_id is the user whose session performed the action; impersonator_id is
+-- set when an admin acted on that user's behalf, so impersonated actions stay
+-- attributable to the real operator.
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id              BIGSERIAL PRIMARY KEY,
+    actor_id        INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    actor_type      VARCHAR(20),
+    impersonator_id INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    action          VARCHAR(100) NOT NULL,
+    entity_type     VARCHAR(50)  NOT NULL,
+    entity_id       INTEGER,
+    metadata        JSONB        NOT NULL DEFAULT '{}',
+    ip_address      VARCHAR(45),
+    user_agent      TEXT,
+    request_id      VARCHAR(64),
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at ON audit_logs (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor ON audit_logs (actor_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_impersonator ON audit_logs (impersonator_id) WHERE impersonator_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_audit_logs_entity ON audit_logs (entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_action ON audit_logs (action);
@@ -0,0 +1,24 @@
+-- Recurring appointments. A series owns the recurrence rule; each occurrence is
+-- a regular row in bookings linked through bookings.series_id, so availability,
+-- reminders and payments keep working per occurrence.
+CREATE TABLE IF NOT EXISTS booking_series (
+    id                SERIAL PRIMARY KEY,
+    uuid              VARCHAR(64)  NOT NULL UNIQUE DEFAULT gen_random_uuid()::text,
+    customer_id       INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    barber_id         INTEGER      NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    barber_service_id INTEGER REFERENCES barber_services(id) ON DELETE SET NULL,
+    frequency         VARCHAR(20)  NOT NULL CHECK (frequency IN ('weekly', 'biweekly', 'monthly')),
+    occurrences       INTEGER      NOT NULL CHECK (occurrences > 0),
+    start_time        TIMESTAMPTZ  NOT NULL,
+    duration_minutes  INTEGER      NOT NULL,
+    status            VARCHAR(20)  NOT NULL DEFAULT 'active',
+    created_by        INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_series_customer ON booking_series (customer_id);
+CREATE INDEX IF NOT EXISTS idx_booking_series_barber ON booking_series (barber_id);
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS series_id INTEGER REFERENCES booking_series(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_bookings_series ON bookings (series_id, scheduled_start_time) WHERE series_id IS NOT NULL;
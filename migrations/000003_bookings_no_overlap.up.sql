@@ -0,0 +1,16 @@
+-- Database-level guarantee that a barber cannot hold two active bookings at
+-- overlapping times. The service layer checks for conflicts first, but two
+-- concurrent transactions can both see an empty slot; this constraint makes
+-- the second insert fail (SQLSTATE 23P01) instead of double-booking.
+-- Existing overlapping active bookings must be resolved before applying.
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'bookings_no_overlap') THEN
+        ALTER TABLE bookings ADD CONSTRAINT bookings_no_overlap EXCLUDE USING gist (
+            barber_id WITH =,
+            tstzrange(scheduled_start_time, scheduled_end_time, '[)') WITH &&
+        ) WHERE (status IN ('pending', 'confirmed', 'in_progress'));
+    END IF;
+END $$;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS barber_schedule_exceptions;
+DROP TABLE IF EXISTS barber_schedule_periods;
+DROP TABLE IF EXISTS barber_schedules;
@@ -0,0 +1,41 @@
+-- Barber working schedules. A barber without a barber_schedules row has no
+-- schedule restrictions (legacy behaviour); once a schedule exists, bookings
+-- must fall inside a weekly work period, avoid weekly breaks, and not land on
+-- an exception day (vacation, holiday, closed) unless it defines custom hours.
+CREATE TABLE IF NOT EXISTS barber_schedules (
+    barber_id  INTEGER     PRIMARY KEY REFERENCES barbers(id) ON DELETE CASCADE,
+    timezone   VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Weekly recurring periods; times are wall-clock in the schedule timezone
+CREATE TABLE IF NOT EXISTS barber_schedule_periods (
+    id          SERIAL PRIMARY KEY,
+    barber_id   INTEGER     NOT NULL REFERENCES barber_schedules(barber_id) ON DELETE CASCADE,
+    day_of_week SMALLINT    NOT NULL CHECK (day_of_week BETWEEN 0 AND 6), -- 0=Sunday
+    start_time  TIME        NOT NULL,
+    end_time    TIME        NOT NULL,
+    period_type VARCHAR(10) NOT NULL DEFAULT 'work' CHECK (period_type IN ('work', 'break')),
+    label       VARCHAR(100),
+    CHECK (start_time < end_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_barber_schedule_periods_barber ON barber_schedule_periods (barber_id, day_of_week);
+
+-- Date-range overrides: days off, or custom hours replacing the weekly periods
+CREATE TABLE IF NOT EXISTS barber_schedule_exceptions (
+    id             SERIAL PRIMARY KEY,
+    barber_id      INTEGER     NOT NULL REFERENCES barber_schedules(barber_id) ON DELETE CASCADE,
+    start_date     DATE        NOT NULL,
+    end_date       DATE        NOT NULL,
+    exception_type VARCHAR(20) NOT NULL CHECK (exception_type IN ('vacation', 'holiday', 'closed', 'custom_hours')),
+    start_time     TIME,
+    end_time       TIME,
+    reason         TEXT,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (start_date <= end_date),
+    CHECK (exception_type <> 'custom_hours' OR (start_time IS NOT NULL AND end_time IS NOT NULL AND start_time < end_time))
+);
+
+CREATE INDEX IF NOT EXISTS idx_barber_schedule_exceptions_barber ON barber_schedule_exceptions (barber_id, start_date, end_date);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS customer_timeline_state;
+DROP TABLE IF EXISTS customer_timeline;
@@ -0,0 +1,26 @@
+-- Denormalized per-customer activity timeline (the "my activity" screen).
+-- Rows are a projection of booking_history, reviews and booking payments and
+-- are disposable: the application rebuilds a customer's rows whenever the
+-- source events change, so nothing here is the source of truth.
+CREATE TABLE IF NOT EXISTS customer_timeline (
+    id          BIGSERIAL PRIMARY KEY,
+    customer_id INTEGER      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event_type  VARCHAR(40)  NOT NULL,
+    source      VARCHAR(20)  NOT NULL, -- booking_history, review, payment
+    source_id   INTEGER      NOT NULL,
+    booking_id  INTEGER REFERENCES bookings(id) ON DELETE CASCADE,
+    title       VARCHAR(255) NOT NULL,
+    details     JSONB        NOT NULL DEFAULT '{}',
+    occurred_at TIMESTAMPTZ  NOT NULL,
+    UNIQUE (customer_id, source, source_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_timeline_feed ON customer_timeline (customer_id, occurred_at DESC, id DESC);
+
+-- Version of the source events each customer's timeline was built from
+CREATE TABLE IF NOT EXISTS customer_timeline_state (
+    customer_id INTEGER     PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    rebuilt_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    watermark   TIMESTAMPTZ,
+    event_count INTEGER     NOT NULL DEFAULT 0
+);
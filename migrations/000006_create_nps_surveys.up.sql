@@ -0,0 +1,20 @@
+-- One-tap NPS micro-surveys sent after every Nth completed booking.
+-- Separate from reviews: a single 0-10 score (plus an optional comment)
+-- answered from a tokenized link without signing in.
+CREATE TABLE IF NOT EXISTS nps_surveys (
+    id           SERIAL PRIMARY KEY,
+    token        VARCHAR(64) NOT NULL UNIQUE,
+    booking_id   INTEGER     NOT NULL UNIQUE REFERENCES bookings(id) ON DELETE CASCADE,
+    customer_id  INTEGER     NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    barber_id    INTEGER     NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    score        SMALLINT CHECK (score BETWEEN 0 AND 10),
+    comment      TEXT,
+    sent_at      TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMPTZ NOT NULL,
+    responded_at TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((score IS NULL) = (responded_at IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_nps_surveys_barber_responses ON nps_surveys (barber_id, responded_at) WHERE responded_at IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_nps_surveys_responses ON nps_surveys (responded_at) WHERE responded_at IS NOT NULL;
@@ -0,0 +1,15 @@
+-- Push notification device registrations. A token belongs to one app
+-- install; re-registering it (e.g. after a different user signs in on the
+-- same phone) moves it to the new user.
+CREATE TABLE IF NOT EXISTS device_tokens (
+    id           SERIAL PRIMARY KEY,
+    user_id      INTEGER      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    platform     VARCHAR(10)  NOT NULL CHECK (platform IN ('ios', 'android', 'web')),
+    token        VARCHAR(512) NOT NULL UNIQUE,
+    app_version  VARCHAR(50),
+    last_used_at TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_device_tokens_user ON device_tokens (user_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS win_back_messages;
+DROP TABLE IF EXISTS win_back_campaigns;
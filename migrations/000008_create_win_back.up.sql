@@ -0,0 +1,33 @@
+-- Win-back campaigns: each run of the job is a campaign; every customer it
+-- messages gets one row in win_back_messages, optionally with a single-use
+-- coupon. Performance (opens, conversions, redemptions) is derived from the
+-- linked notification and the customer's later bookings.
+CREATE TABLE IF NOT EXISTS win_back_campaigns (
+    id                 SERIAL PRIMARY KEY,
+    inactive_weeks     INTEGER     NOT NULL,
+    coupon_percent_off INTEGER     NOT NULL DEFAULT 0 CHECK (coupon_percent_off BETWEEN 0 AND 100),
+    coupon_valid_days  INTEGER     NOT NULL DEFAULT 0,
+    eligible_count     INTEGER     NOT NULL DEFAULT 0,
+    sent_count         INTEGER     NOT NULL DEFAULT 0,
+    started_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at        TIMESTAMPTZ,
+    triggered_by       INTEGER REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE TABLE IF NOT EXISTS win_back_messages (
+    id                  SERIAL PRIMARY KEY,
+    campaign_id         INTEGER     NOT NULL REFERENCES win_back_campaigns(id) ON DELETE CASCADE,
+    customer_id         INTEGER     NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    notification_id     INTEGER REFERENCES notifications(id) ON DELETE SET NULL,
+    last_visit_at       TIMESTAMPTZ NOT NULL,
+    cadence_days        INTEGER     NOT NULL,
+    coupon_code         VARCHAR(20) UNIQUE,
+    coupon_percent_off  INTEGER     NOT NULL DEFAULT 0,
+    coupon_expires_at   TIMESTAMPTZ,
+    redeemed_booking_id INTEGER REFERENCES bookings(id) ON DELETE SET NULL,
+    redeemed_at         TIMESTAMPTZ,
+    sent_at             TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_win_back_messages_campaign ON win_back_messages (campaign_id);
+CREATE INDEX IF NOT EXISTS idx_win_back_messages_customer ON win_back_messages (customer_id, sent_at DESC);
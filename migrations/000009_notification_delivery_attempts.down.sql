@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_notifications_pending_delivery;
+
+ALTER TABLE notifications
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS next_attempt_at,
+    DROP COLUMN IF EXISTS attempts;
@@ -0,0 +1,12 @@
+-- Delivery bookkeeping for the background notification worker. A worker
+-- claims a pending notification by bumping attempts and pushing
+-- next_attempt_at out by its lease; failed attempts push it out by the
+-- retry backoff instead.
+ALTER TABLE notifications
+    ADD COLUMN IF NOT EXISTS attempts        INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS next_attempt_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS last_error      TEXT;
+
+CREATE INDEX IF NOT EXISTS idx_notifications_pending_delivery
+    ON notifications (next_attempt_at)
+    WHERE status = 'pending';
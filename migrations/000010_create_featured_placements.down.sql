@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS featured_placement_stats;
+DROP TABLE IF EXISTS featured_placements;
+DROP TABLE IF EXISTS featured_slot_limits;
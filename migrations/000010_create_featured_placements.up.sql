@@ -0,0 +1,49 @@
+-- Featured placement: barbers pay to be listed first in search results for
+-- their city (optionally only within one service category) over a date
+-- range. Inventory is capped per city/category; featured_slot_limits
+-- overrides the configured default for specific markets.
+CREATE TABLE IF NOT EXISTS featured_slot_limits (
+    id          SERIAL PRIMARY KEY,
+    city        VARCHAR(100) NOT NULL,
+    category_id INTEGER REFERENCES service_categories(id) ON DELETE CASCADE,
+    max_slots   INTEGER      NOT NULL CHECK (max_slots >= 0),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_featured_slot_limits_market
+    ON featured_slot_limits (LOWER(city), COALESCE(category_id, 0));
+
+CREATE TABLE IF NOT EXISTS featured_placements (
+    id                SERIAL PRIMARY KEY,
+    barber_id         INTEGER       NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    city              VARCHAR(100)  NOT NULL,
+    category_id       INTEGER REFERENCES service_categories(id) ON DELETE CASCADE,
+    starts_on         DATE          NOT NULL,
+    ends_on           DATE          NOT NULL,
+    status            VARCHAR(20)   NOT NULL DEFAULT 'pending_payment'
+                          CHECK (status IN ('pending_payment', 'active', 'cancelled')),
+    amount            NUMERIC(10,2) NOT NULL,
+    currency          VARCHAR(3)    NOT NULL,
+    payment_provider  VARCHAR(50),
+    payment_reference VARCHAR(255),
+    refund_reference  VARCHAR(255),
+    created_at        TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    cancelled_at      TIMESTAMPTZ,
+    CHECK (ends_on >= starts_on)
+);
+
+CREATE INDEX IF NOT EXISTS idx_featured_placements_market
+    ON featured_placements (LOWER(city), COALESCE(category_id, 0), starts_on, ends_on)
+    WHERE status <> 'cancelled';
+CREATE INDEX IF NOT EXISTS idx_featured_placements_barber
+    ON featured_placements (barber_id, starts_on DESC);
+
+-- Daily impression and click counters per placement
+CREATE TABLE IF NOT EXISTS featured_placement_stats (
+    placement_id INTEGER NOT NULL REFERENCES featured_placements(id) ON DELETE CASCADE,
+    day          DATE    NOT NULL,
+    impressions  INTEGER NOT NULL DEFAULT 0,
+    clicks       INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (placement_id, day)
+);
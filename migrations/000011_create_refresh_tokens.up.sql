@@ -0,0 +1,19 @@
+-- Refresh tokens let clients get new access tokens without logging in
+-- again. Tokens rotate on every use: each refresh marks the presented token
+-- used and issues a successor in the same family (one family per login).
+-- Presenting a used token again means it was copied, so the whole family
+-- is revoked. Only a SHA-256 hash of each token is stored.
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id          SERIAL PRIMARY KEY,
+    user_id     INTEGER     NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    family_id   UUID        NOT NULL,
+    token_hash  CHAR(64)    NOT NULL UNIQUE,
+    expires_at  TIMESTAMPTZ NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    used_at     TIMESTAMPTZ,
+    revoked_at  TIMESTAMPTZ,
+    replaced_by INTEGER REFERENCES refresh_tokens(id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user ON refresh_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family ON refresh_tokens (family_id);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS experiment_conversions;
+DROP TABLE IF EXISTS experiment_exposures;
+DROP TABLE IF EXISTS experiments;
@@ -0,0 +1,41 @@
+-- A/B experiments: variants are assigned by hashing the experiment key and
+-- user ID. The first time a user is shown an experiment, the assignment is
+-- logged as an exposure; conversion is measured from exposed users' later
+-- bookings or from events recorded against the experiment.
+CREATE TABLE IF NOT EXISTS experiments (
+    id          SERIAL PRIMARY KEY,
+    key         VARCHAR(50)  NOT NULL UNIQUE,
+    name        VARCHAR(100) NOT NULL,
+    description TEXT,
+    status      VARCHAR(20)  NOT NULL DEFAULT 'draft'
+        CHECK (status IN ('draft', 'running', 'stopped')),
+    variants    JSONB        NOT NULL DEFAULT '[]',
+    winner      VARCHAR(30),
+    started_at  TIMESTAMPTZ,
+    stopped_at  TIMESTAMPTZ,
+    created_by  INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS experiment_exposures (
+    experiment_id INTEGER     NOT NULL REFERENCES experiments(id) ON DELETE CASCADE,
+    user_id       INTEGER     NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    variant       VARCHAR(30) NOT NULL,
+    exposed_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (experiment_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_experiment_exposures_user ON experiment_exposures (user_id);
+
+CREATE TABLE IF NOT EXISTS experiment_conversions (
+    id            SERIAL PRIMARY KEY,
+    experiment_id INTEGER     NOT NULL REFERENCES experiments(id) ON DELETE CASCADE,
+    user_id       INTEGER     NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    variant       VARCHAR(30) NOT NULL,
+    event         VARCHAR(50) NOT NULL,
+    value         DECIMAL(10,2),
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_experiment_conversions_experiment ON experiment_conversions (experiment_id, event);
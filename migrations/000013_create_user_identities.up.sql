@@ -0,0 +1,14 @@
+-- Social sign-in: each row links a local account to an account at a sign-in
+-- provider (Google, Apple), identified by the provider's stable subject ID.
+-- A user can link at most one account per provider.
+CREATE TABLE IF NOT EXISTS user_identities (
+    id            SERIAL PRIMARY KEY,
+    user_id       INTEGER      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    provider      VARCHAR(20)  NOT NULL,
+    subject       VARCHAR(255) NOT NULL,
+    email         VARCHAR(255),
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    last_login_at TIMESTAMPTZ,
+    UNIQUE (provider, subject),
+    UNIQUE (user_id, provider)
+);
@@ -0,0 +1,24 @@
+-- API usage per consumer (authenticated user), aggregated per hour and
+-- endpoint. The API buffers counters in memory and upserts them here.
+CREATE TABLE IF NOT EXISTS api_usage (
+    user_id            INTEGER      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    hour               TIMESTAMPTZ  NOT NULL,
+    method             VARCHAR(10)  NOT NULL,
+    route              VARCHAR(255) NOT NULL,
+    request_count      INTEGER      NOT NULL DEFAULT 0,
+    client_error_count INTEGER      NOT NULL DEFAULT 0,
+    server_error_count INTEGER      NOT NULL DEFAULT 0,
+    total_latency_ms   BIGINT       NOT NULL DEFAULT 0,
+    max_latency_ms     INTEGER      NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, hour, method, route)
+);
+
+CREATE INDEX IF NOT EXISTS idx_api_usage_hour ON api_usage (hour);
+
+-- Per-consumer rate limits; consumers without a row get API_RATE_LIMIT
+CREATE TABLE IF NOT EXISTS api_quotas (
+    user_id             INTEGER     PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    requests_per_minute INTEGER     NOT NULL CHECK (requests_per_minute > 0),
+    note                TEXT,
+    updated_at          TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
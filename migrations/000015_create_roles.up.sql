@@ -0,0 +1,27 @@
+-- Role-based access control. Every account holds the system role matching
+-- its user_type; admins can define further roles and assign them to users.
+-- A user's permissions are the union of their roles' permissions.
+CREATE TABLE IF NOT EXISTS roles (
+    name        VARCHAR(50) PRIMARY KEY,
+    description TEXT,
+    permissions JSONB       NOT NULL DEFAULT '[]',
+    is_system   BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO roles (name, description, permissions, is_system) VALUES
+    ('customer', 'Every customer account', '["bookings:read", "bookings:write", "reviews:write"]', TRUE),
+    ('barber',   'Every barber account',   '["bookings:read", "bookings:write", "reviews:write"]', TRUE),
+    ('admin',    'Every admin account',    '["*"]', TRUE)
+ON CONFLICT (name) DO NOTHING;
+
+CREATE TABLE IF NOT EXISTS user_roles (
+    user_id    INTEGER     NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role       VARCHAR(50) NOT NULL REFERENCES roles(name) ON DELETE CASCADE,
+    granted_by INTEGER     REFERENCES users(id) ON DELETE SET NULL,
+    granted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, role)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_roles_role ON user_roles (role);
@@ -0,0 +1,10 @@
+DELETE FROM bookings WHERE is_test;
+
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
+ALTER TABLE bookings ADD CONSTRAINT bookings_no_overlap EXCLUDE USING gist (
+    barber_id WITH =,
+    tstzrange(scheduled_start_time, scheduled_end_time, '[)') WITH &&
+) WHERE (status IN ('pending', 'confirmed', 'in_progress'));
+
+DROP INDEX IF EXISTS idx_bookings_is_test;
+ALTER TABLE bookings DROP COLUMN IF EXISTS is_test;
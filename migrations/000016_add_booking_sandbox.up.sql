@@ -0,0 +1,13 @@
+-- Bookings created in sandbox mode (X-Sandbox header) are test data. They
+-- never conflict with live bookings, so the overlap constraint from
+-- 000003 is rebuilt to compare test and live bookings separately.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS is_test BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_bookings_is_test ON bookings (is_test) WHERE is_test;
+
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
+ALTER TABLE bookings ADD CONSTRAINT bookings_no_overlap EXCLUDE USING gist (
+    barber_id WITH =,
+    is_test WITH =,
+    tstzrange(scheduled_start_time, scheduled_end_time, '[)') WITH &&
+) WHERE (status IN ('pending', 'confirmed', 'in_progress'));
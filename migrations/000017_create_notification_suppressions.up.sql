@@ -0,0 +1,18 @@
+-- Email addresses and phone numbers that must not be sent to, fed by hard
+-- bounces, spam complaints and opt-outs reported by providers and by admins.
+-- Addresses are normalized (lower-case emails, digits-only phone numbers
+-- with a leading +). Deleting a row lifts the suppression.
+CREATE TABLE IF NOT EXISTS notification_suppressions (
+    id         SERIAL PRIMARY KEY,
+    channel    VARCHAR(10)  NOT NULL CHECK (channel IN ('email', 'sms')),
+    address    VARCHAR(255) NOT NULL,
+    reason     VARCHAR(20)  NOT NULL CHECK (reason IN ('hard_bounce', 'spam_complaint', 'opt_out', 'manual')),
+    source     VARCHAR(50)  NOT NULL, -- Provider name, or admin
+    detail     TEXT,                  -- e.g. the provider error code
+    created_by INTEGER      REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    UNIQUE (channel, address)
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_suppressions_created_at ON notification_suppressions (created_at DESC);
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS barber_auto_replies;
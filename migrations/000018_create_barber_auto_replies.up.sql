@@ -0,0 +1,10 @@
+-- Messages sent to customers who request a booking while the barber is
+-- not working. The message may use {{placeholders}} such as
+-- {{next_open_time}}, rendered when the reply is sent.
+CREATE TABLE IF NOT EXISTS barber_auto_replies (
+    barber_id  INTEGER     PRIMARY KEY REFERENCES barbers(id) ON DELETE CASCADE,
+    enabled    BOOLEAN     NOT NULL DEFAULT FALSE,
+    message    TEXT        NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
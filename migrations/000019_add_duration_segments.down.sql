@@ -0,0 +1,11 @@
+-- Overlapping active bookings (interleaved during passive segments) must be
+-- resolved before rolling back.
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
+ALTER TABLE bookings ADD CONSTRAINT bookings_no_overlap EXCLUDE USING gist (
+    barber_id WITH =,
+    is_test WITH =,
+    tstzrange(scheduled_start_time, scheduled_end_time, '[)') WITH &&
+) WHERE (status IN ('pending', 'confirmed', 'in_progress'));
+
+ALTER TABLE bookings DROP COLUMN IF EXISTS duration_segments;
+ALTER TABLE barber_services DROP COLUMN IF EXISTS duration_segments;
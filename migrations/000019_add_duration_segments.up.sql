@@ -0,0 +1,16 @@
+-- Services with passive time (e.g. color processing) split their duration
+-- into active and passive segments, stored as [{"minutes": 30, "passive":
+-- false}, ...]. Bookings copy the segments of their service so the barber
+-- can take other bookings during the passive ones.
+ALTER TABLE barber_services ADD COLUMN IF NOT EXISTS duration_segments JSONB;
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS duration_segments JSONB;
+
+-- Bookings with passive segments may overlap other bookings, so the
+-- overlap constraint from 000016 only covers fully active bookings. The
+-- service layer serializes conflict checks per barber for the rest.
+ALTER TABLE bookings DROP CONSTRAINT IF EXISTS bookings_no_overlap;
+ALTER TABLE bookings ADD CONSTRAINT bookings_no_overlap EXCLUDE USING gist (
+    barber_id WITH =,
+    is_test WITH =,
+    tstzrange(scheduled_start_time, scheduled_end_time, '[)') WITH &&
+) WHERE (status IN ('pending', 'confirmed', 'in_progress') AND duration_segments IS NULL);
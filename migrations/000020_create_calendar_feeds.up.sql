@@ -0,0 +1,10 @@
+-- Per-user calendar subscription (webcal) feeds. The token in the feed URL
+-- authorizes it, so it is unique and replaced when the user resets the feed.
+CREATE TABLE IF NOT EXISTS calendar_feeds (
+    id               SERIAL      PRIMARY KEY,
+    user_id          INTEGER     NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    token            VARCHAR(64) NOT NULL UNIQUE,
+    last_accessed_at TIMESTAMPTZ,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,3 @@
+ALTER TABLE bookings DROP COLUMN IF EXISTS location_id;
+DROP TABLE IF EXISTS barber_travel_times;
+DROP TABLE IF EXISTS barber_locations;
@@ -0,0 +1,26 @@
+-- Locations a barber takes bookings at, and how long the barber needs to
+-- travel between them. Bookings at different locations are kept apart by
+-- the travel time (enforced by the application).
+CREATE TABLE IF NOT EXISTS barber_locations (
+    id         SERIAL       PRIMARY KEY,
+    barber_id  INTEGER      NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    name       VARCHAR(100) NOT NULL,
+    address    TEXT,
+    city       VARCHAR(100),
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    UNIQUE (barber_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS barber_travel_times (
+    barber_id        INTEGER NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    from_location_id INTEGER NOT NULL REFERENCES barber_locations(id) ON DELETE CASCADE,
+    to_location_id   INTEGER NOT NULL REFERENCES barber_locations(id) ON DELETE CASCADE,
+    minutes          INTEGER NOT NULL CHECK (minutes >= 0),
+    PRIMARY KEY (from_location_id, to_location_id),
+    CHECK (from_location_id <> to_location_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_barber_travel_times_barber ON barber_travel_times (barber_id);
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS location_id INTEGER REFERENCES barber_locations(id) ON DELETE SET NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE bookings DROP COLUMN IF EXISTS barber_service_id;
+DROP TABLE IF EXISTS inventory_usage;
+DROP TABLE IF EXISTS inventory_items;
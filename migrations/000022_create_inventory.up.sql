@@ -0,0 +1,32 @@
+-- Products a barber keeps in stock (dye, razors, ...). Completing a booking
+-- takes usage_per_service of each product its service requires (matched by
+-- name against services.required_products) out of stock.
+CREATE TABLE IF NOT EXISTS inventory_items (
+    id                  SERIAL       PRIMARY KEY,
+    barber_id           INTEGER      NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    name                VARCHAR(100) NOT NULL,
+    unit                VARCHAR(20)  NOT NULL DEFAULT 'unit',
+    quantity            INTEGER      NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    usage_per_service   INTEGER      NOT NULL DEFAULT 1 CHECK (usage_per_service >= 0),
+    low_stock_threshold INTEGER      NOT NULL DEFAULT 0 CHECK (low_stock_threshold >= 0),
+    created_at          TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at          TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_inventory_items_barber_name ON inventory_items (barber_id, LOWER(name));
+
+-- One row per product taken out of stock for a completed booking
+CREATE TABLE IF NOT EXISTS inventory_usage (
+    id         SERIAL      PRIMARY KEY,
+    item_id    INTEGER     NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    barber_id  INTEGER     NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    booking_id INTEGER     NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    quantity   INTEGER     NOT NULL CHECK (quantity >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (item_id, booking_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_inventory_usage_barber_created ON inventory_usage (barber_id, created_at);
+
+-- The barber service a booking was made for, to look up its required products
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS barber_service_id INTEGER REFERENCES barber_services(id) ON DELETE SET NULL;
@@ -0,0 +1,12 @@
+-- Assistants who performed part of a booking, with their percentage of its
+-- earnings. The booking's barber keeps the rest (enforced by the
+-- application: the shares on a booking add up to less than 100).
+CREATE TABLE IF NOT EXISTS booking_staff (
+    booking_id    INTEGER      NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    barber_id     INTEGER      NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    share_percent NUMERIC(5,2) NOT NULL CHECK (share_percent > 0 AND share_percent < 100),
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (booking_id, barber_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_staff_barber ON booking_staff (barber_id);
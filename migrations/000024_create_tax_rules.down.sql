@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS booking_tax_lines;
+DROP TABLE IF EXISTS tax_rules;
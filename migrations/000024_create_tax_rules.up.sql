@@ -0,0 +1,40 @@
+-- Taxes charged on bookings, per barber, per region (country, optionally
+-- narrowed to a state) or everywhere. The most specific matching rules
+-- apply; rules at the same level stack.
+CREATE TABLE IF NOT EXISTS tax_rules (
+    id           SERIAL       PRIMARY KEY,
+    name         VARCHAR(100) NOT NULL,
+    rate_percent NUMERIC(6,3) NOT NULL CHECK (rate_percent >= 0 AND rate_percent <= 100),
+    inclusive    BOOLEAN      NOT NULL DEFAULT FALSE,
+    barber_id    INTEGER      REFERENCES barbers(id) ON DELETE CASCADE,
+    country      VARCHAR(100),
+    state        VARCHAR(100),
+    is_active    BOOLEAN      NOT NULL DEFAULT TRUE,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    CHECK (barber_id IS NULL OR (country IS NULL AND state IS NULL)),
+    CHECK (state IS NULL OR country IS NOT NULL)
+);
+
+CREATE INDEX IF NOT EXISTS idx_tax_rules_barber ON tax_rules (barber_id) WHERE barber_id IS NOT NULL;
+
+-- Bookings were taxed at a flat 8% before tax rules; keep that as the
+-- global default until it is configured
+INSERT INTO tax_rules (name, rate_percent)
+SELECT 'Sales tax', 8
+WHERE NOT EXISTS (SELECT 1 FROM tax_rules);
+
+-- The taxes charged on a booking, as they were when it was priced
+CREATE TABLE IF NOT EXISTS booking_tax_lines (
+    id             SERIAL        PRIMARY KEY,
+    booking_id     INTEGER       NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    tax_rule_id    INTEGER       REFERENCES tax_rules(id) ON DELETE SET NULL,
+    name           VARCHAR(100)  NOT NULL,
+    rate_percent   NUMERIC(6,3)  NOT NULL,
+    inclusive      BOOLEAN       NOT NULL,
+    taxable_amount NUMERIC(10,2) NOT NULL,
+    amount         NUMERIC(10,2) NOT NULL,
+    created_at     TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_tax_lines_booking ON booking_tax_lines (booking_id);
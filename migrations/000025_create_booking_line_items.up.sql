@@ -0,0 +1,20 @@
+-- The services performed in a booking, in order. A booking may cover
+-- several barber services back to back; its duration and price are theirs
+-- combined.
+CREATE TABLE IF NOT EXISTS booking_line_items (
+    id                SERIAL        PRIMARY KEY,
+    booking_id        INTEGER       NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    barber_service_id INTEGER       REFERENCES barber_services(id) ON DELETE SET NULL,
+    service_name      VARCHAR(150)  NOT NULL,
+    duration_minutes  INTEGER       NOT NULL CHECK (duration_minutes >= 0),
+    price             NUMERIC(10,2) NOT NULL CHECK (price >= 0),
+    position          SMALLINT      NOT NULL DEFAULT 0,
+    created_at        TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    UNIQUE (booking_id, position)
+);
+
+-- Bookings made before line items covered a single service
+INSERT INTO booking_line_items (booking_id, barber_service_id, service_name, duration_minutes, price, position)
+SELECT b.id, b.barber_service_id, b.service_name, b.estimated_duration_minutes, b.service_price, 0
+FROM bookings b
+WHERE NOT EXISTS (SELECT 1 FROM booking_line_items li WHERE li.booking_id = b.id);
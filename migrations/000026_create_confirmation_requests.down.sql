@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_bookings_customer_finished;
+DROP TABLE IF EXISTS confirmation_requests;
@@ -0,0 +1,20 @@
+-- Extra "are you still coming?" requests sent ahead of bookings whose
+-- customer often misses appointments. The token answers the request
+-- (confirm or cancel) without signing in; cancelling frees the slot.
+CREATE TABLE IF NOT EXISTS confirmation_requests (
+    id           SERIAL       PRIMARY KEY,
+    token        VARCHAR(64)  NOT NULL UNIQUE,
+    booking_id   INTEGER      NOT NULL UNIQUE REFERENCES bookings(id) ON DELETE CASCADE,
+    customer_id  INTEGER      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    no_show_risk NUMERIC(4,3) NOT NULL CHECK (no_show_risk BETWEEN 0 AND 1),
+    sent_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    expires_at   TIMESTAMPTZ  NOT NULL,
+    response     VARCHAR(20)  CHECK (response IN ('confirmed', 'cancelled')),
+    responded_at TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    CHECK ((response IS NULL) = (responded_at IS NULL))
+);
+
+-- Customer no-show history is read from their past bookings
+CREATE INDEX IF NOT EXISTS idx_bookings_customer_finished ON bookings (customer_id, scheduled_start_time DESC)
+    WHERE status IN ('completed', 'no_show');
@@ -0,0 +1,3 @@
+ALTER TABLE booking_line_items DROP COLUMN IF EXISTS add_on_id;
+
+DROP TABLE IF EXISTS barber_service_add_ons;
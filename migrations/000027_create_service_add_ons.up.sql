@@ -0,0 +1,22 @@
+-- Optional extras a barber offers on one of their services (e.g. a hot
+-- towel with a shave). Add-ons chosen at booking time extend the booking's
+-- duration and price and are kept on it as line items after their service.
+CREATE TABLE IF NOT EXISTS barber_service_add_ons (
+    id                SERIAL        PRIMARY KEY,
+    barber_service_id INTEGER       NOT NULL REFERENCES barber_services(id) ON DELETE CASCADE,
+    name              VARCHAR(100)  NOT NULL,
+    description       TEXT,
+    price             NUMERIC(10,2) NOT NULL CHECK (price >= 0),
+    duration_minutes  INTEGER       NOT NULL DEFAULT 0 CHECK (duration_minutes >= 0),
+    is_active         BOOLEAN       NOT NULL DEFAULT TRUE,
+    display_order     INTEGER       NOT NULL DEFAULT 0,
+    created_at        TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_barber_service_add_ons_name
+    ON barber_service_add_ons (barber_service_id, LOWER(name));
+
+-- Line items for add-ons point at the add-on as well as its service
+ALTER TABLE booking_line_items
+    ADD COLUMN IF NOT EXISTS add_on_id INTEGER REFERENCES barber_service_add_ons(id) ON DELETE SET NULL;
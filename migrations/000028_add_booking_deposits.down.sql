@@ -0,0 +1,8 @@
+ALTER TABLE bookings
+    DROP COLUMN IF EXISTS no_show_fee,
+    DROP COLUMN IF EXISTS deposit_amount;
+
+ALTER TABLE barber_services
+    DROP COLUMN IF EXISTS no_show_fee,
+    DROP COLUMN IF EXISTS deposit_value,
+    DROP COLUMN IF EXISTS deposit_type;
@@ -0,0 +1,12 @@
+-- Deposits: a barber service may require a deposit (a percentage of its
+-- price or a fixed amount), held on the customer's card when booking. On a
+-- no-show the no-show fee (default: the whole deposit) is captured from
+-- the hold.
+ALTER TABLE barber_services
+    ADD COLUMN IF NOT EXISTS deposit_type  VARCHAR(20)   CHECK (deposit_type IN ('percentage', 'fixed')),
+    ADD COLUMN IF NOT EXISTS deposit_value NUMERIC(10,2) CHECK (deposit_value > 0),
+    ADD COLUMN IF NOT EXISTS no_show_fee   NUMERIC(10,2) CHECK (no_show_fee >= 0);
+
+ALTER TABLE bookings
+    ADD COLUMN IF NOT EXISTS deposit_amount NUMERIC(10,2) NOT NULL DEFAULT 0 CHECK (deposit_amount >= 0),
+    ADD COLUMN IF NOT EXISTS no_show_fee    NUMERIC(10,2) NOT NULL DEFAULT 0 CHECK (no_show_fee >= 0);
@@ -0,0 +1,17 @@
+-- Signed one-tap links in booking reminders. Each reminder carries a
+-- confirm and a cancel link sharing one link ID; the first one used closes
+-- both. Who used it, how and from where is kept for the audit trail.
+CREATE TABLE IF NOT EXISTS booking_action_links (
+    id              VARCHAR(64)  PRIMARY KEY,
+    booking_id      INTEGER      NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    customer_id     INTEGER      REFERENCES users(id) ON DELETE SET NULL,
+    expires_at      TIMESTAMPTZ  NOT NULL,
+    used_at         TIMESTAMPTZ,
+    used_action     VARCHAR(20)  CHECK (used_action IN ('confirm', 'cancel')),
+    used_ip         VARCHAR(64),
+    used_user_agent TEXT,
+    created_at      TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    CHECK ((used_action IS NULL) = (used_at IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_action_links_booking ON booking_action_links (booking_id);
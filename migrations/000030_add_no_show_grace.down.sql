@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_bookings_confirmed_start;
+
+ALTER TABLE barbers
+    DROP COLUMN IF EXISTS no_show_grace_minutes;
@@ -0,0 +1,9 @@
+-- Automatic no-shows: confirmed bookings nobody started are marked no-show
+-- once their grace period after the start time has passed. Barbers may set
+-- their own grace period; NULL uses the platform default.
+ALTER TABLE barbers
+    ADD COLUMN IF NOT EXISTS no_show_grace_minutes INTEGER CHECK (no_show_grace_minutes BETWEEN 1 AND 240);
+
+CREATE INDEX IF NOT EXISTS idx_bookings_confirmed_start
+    ON bookings (scheduled_start_time)
+    WHERE status = 'confirmed';
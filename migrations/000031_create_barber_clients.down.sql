@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS customer_invitations;
+DROP TABLE IF EXISTS barber_clients;
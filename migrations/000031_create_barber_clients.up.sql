@@ -0,0 +1,39 @@
+-- A barber's client list. Shops moving to the platform import their
+-- existing clients from CSV; each row is matched to an existing account by
+-- email or phone, or gets a new customer account the client can claim
+-- from an emailed invitation. Consent to be contacted by email and SMS is
+-- recorded as given to the shop.
+CREATE TABLE IF NOT EXISTS barber_clients (
+    id            SERIAL PRIMARY KEY,
+    barber_id     INTEGER      NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    user_id       INTEGER      REFERENCES users(id) ON DELETE SET NULL,
+    name          VARCHAR(100) NOT NULL,
+    email         VARCHAR(255),
+    phone         VARCHAR(30),
+    notes         TEXT,
+    email_consent BOOLEAN      NOT NULL DEFAULT false,
+    sms_consent   BOOLEAN      NOT NULL DEFAULT false,
+    source        VARCHAR(20)  NOT NULL DEFAULT 'import' CHECK (source IN ('import')),
+    created_by    INTEGER      REFERENCES users(id) ON DELETE SET NULL,
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+-- One entry per client: email is stored lower-case and phone as digits
+CREATE UNIQUE INDEX IF NOT EXISTS idx_barber_clients_user ON barber_clients (barber_id, user_id) WHERE user_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_barber_clients_email ON barber_clients (barber_id, email) WHERE email IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_barber_clients_phone ON barber_clients (barber_id, phone) WHERE phone IS NOT NULL;
+
+-- Invitations to claim an account created by an import. Only a SHA-256
+-- hash of each token is stored; claiming sets the account's password.
+CREATE TABLE IF NOT EXISTS customer_invitations (
+    id         SERIAL PRIMARY KEY,
+    user_id    INTEGER     NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    barber_id  INTEGER     REFERENCES barbers(id) ON DELETE SET NULL,
+    token_hash CHAR(64)    NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    claimed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_customer_invitations_user ON customer_invitations (user_id);
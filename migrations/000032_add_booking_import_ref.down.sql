@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_bookings_import_ref;
+
+ALTER TABLE bookings DROP COLUMN IF EXISTS import_ref;
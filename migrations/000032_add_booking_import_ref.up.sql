@@ -0,0 +1,9 @@
+-- Historical appointments imported from a shop's previous booking tool are
+-- stored as bookings with booking_source 'import'. import_ref identifies
+-- the row they came from (the file's own reference, or a digest of the
+-- appointment), so uploading the same history twice adds nothing.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS import_ref VARCHAR(100);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_bookings_import_ref
+    ON bookings (barber_id, import_ref)
+    WHERE import_ref IS NOT NULL;
@@ -0,0 +1,36 @@
+-- Outbound webhooks. A barber registers URLs on their own site and picks
+-- the events each should receive; events are queued as deliveries and
+-- POSTed, signed with the subscription's secret, by the background worker,
+-- which retries failures with backoff.
+CREATE TABLE IF NOT EXISTS webhook_subscriptions (
+    id          SERIAL PRIMARY KEY,
+    barber_id   INTEGER      NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    url         TEXT         NOT NULL,
+    secret      VARCHAR(100) NOT NULL,
+    events      JSONB        NOT NULL DEFAULT '[]',
+    description VARCHAR(200),
+    is_active   BOOLEAN      NOT NULL DEFAULT true,
+    created_by  INTEGER      REFERENCES users(id) ON DELETE SET NULL,
+    created_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at  TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_subscriptions_barber ON webhook_subscriptions (barber_id);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id              SERIAL PRIMARY KEY,
+    subscription_id INTEGER     NOT NULL REFERENCES webhook_subscriptions(id) ON DELETE CASCADE,
+    event_id        UUID        NOT NULL,
+    event_type      VARCHAR(50) NOT NULL,
+    payload         JSONB       NOT NULL,
+    status          VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'delivered', 'failed')),
+    attempts        INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ,
+    response_status INTEGER,
+    last_error      TEXT,
+    delivered_at    TIMESTAMPTZ,
+    created_at      TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_pending ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_subscription ON webhook_deliveries (subscription_id, created_at DESC);
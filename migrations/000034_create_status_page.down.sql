@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS status_incidents;
+DROP TABLE IF EXISTS component_statuses;
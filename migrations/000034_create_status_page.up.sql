@@ -0,0 +1,28 @@
+-- Public status page. component_statuses holds each component's latest
+-- health check; status_incidents is the history, opened and resolved
+-- automatically when a check's result changes, or posted by staff.
+CREATE TABLE IF NOT EXISTS component_statuses (
+    component  VARCHAR(50)  PRIMARY KEY,
+    status     VARCHAR(20)  NOT NULL CHECK (status IN ('operational', 'degraded', 'major_outage')),
+    detail     TEXT,
+    checked_at TIMESTAMPTZ  NOT NULL,
+    changed_at TIMESTAMPTZ  NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS status_incidents (
+    id           SERIAL PRIMARY KEY,
+    component    VARCHAR(50)  NOT NULL,
+    impact       VARCHAR(20)  NOT NULL CHECK (impact IN ('degraded', 'major_outage')),
+    title        VARCHAR(200) NOT NULL,
+    message      TEXT,
+    is_automatic BOOLEAN      NOT NULL DEFAULT false,
+    created_by   INTEGER      REFERENCES users(id) ON DELETE SET NULL,
+    started_at   TIMESTAMPTZ  NOT NULL,
+    resolved_at  TIMESTAMPTZ,
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    CHECK (resolved_at IS NULL OR resolved_at >= started_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_status_incidents_started ON status_incidents (started_at DESC);
+CREATE INDEX IF NOT EXISTS idx_status_incidents_open ON status_incidents (component) WHERE resolved_at IS NULL;
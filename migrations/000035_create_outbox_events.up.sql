@@ -0,0 +1,24 @@
+-- Transactional outbox. Booking changes write their domain events here in
+-- the same transaction as the change itself, so an event is recorded if
+-- and only if the change commits. The background worker relays each event
+-- to its handlers (notifications, webhooks, cache invalidation), recording
+-- which have succeeded so retries only rerun the ones that failed.
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id                 BIGSERIAL PRIMARY KEY,
+    event_id           UUID        NOT NULL UNIQUE,
+    event_type         VARCHAR(50) NOT NULL,
+    aggregate_type     VARCHAR(50) NOT NULL,
+    aggregate_id       INTEGER     NOT NULL,
+    payload            JSONB       NOT NULL,
+    status             VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'dispatched', 'failed')),
+    attempts           INTEGER     NOT NULL DEFAULT 0,
+    next_attempt_at    TIMESTAMPTZ,
+    completed_handlers JSONB       NOT NULL DEFAULT '[]',
+    last_error         TEXT,
+    occurred_at        TIMESTAMPTZ NOT NULL,
+    dispatched_at      TIMESTAMPTZ,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_outbox_events_pending ON outbox_events (id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_outbox_events_aggregate ON outbox_events (aggregate_type, aggregate_id, id);
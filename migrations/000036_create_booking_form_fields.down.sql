@@ -0,0 +1,2 @@
+ALTER TABLE bookings DROP COLUMN IF EXISTS custom_fields;
+DROP TABLE IF EXISTS booking_form_fields;
@@ -0,0 +1,27 @@
+-- Extra questions a barber adds to their booking form (e.g. a parking spot
+-- number or preferred clipper guard). Answers are validated against the
+-- fields when booking and stored on the booking as JSON, each with the
+-- field's label and type at the time, so they still read correctly after
+-- the field is changed or removed.
+CREATE TABLE IF NOT EXISTS booking_form_fields (
+    id            SERIAL        PRIMARY KEY,
+    barber_id     INTEGER       NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    field_key     VARCHAR(50)   NOT NULL,
+    label         VARCHAR(100)  NOT NULL,
+    field_type    VARCHAR(20)   NOT NULL CHECK (field_type IN ('text', 'number', 'select', 'checkbox')),
+    help_text     VARCHAR(300),
+    is_required   BOOLEAN       NOT NULL DEFAULT FALSE,
+    options       JSONB         NOT NULL DEFAULT '[]',
+    max_length    INTEGER,
+    min_value     NUMERIC(12,2),
+    max_value     NUMERIC(12,2),
+    pattern       VARCHAR(200),
+    is_active     BOOLEAN       NOT NULL DEFAULT TRUE,
+    display_order INTEGER       NOT NULL DEFAULT 0,
+    created_at    TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_booking_form_fields_key ON booking_form_fields (barber_id, field_key);
+
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS custom_fields JSONB;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS open_slots;
+DROP TABLE IF EXISTS favorite_barbers;
@@ -0,0 +1,39 @@
+-- Customers' favorite barbers. Favorites hear about their barbers' open
+-- slots.
+CREATE TABLE IF NOT EXISTS favorite_barbers (
+    user_id    INTEGER     NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    barber_id  INTEGER     NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, barber_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_favorite_barbers_barber ON favorite_barbers (barber_id);
+
+-- Last-minute openings a barber broadcasts (e.g. right after a
+-- cancellation) to favorited and nearby customers, optionally with a flash
+-- discount. The first customer to claim an open slot books it: the claim
+-- moves the row from open to claimed, so only one claim can win.
+CREATE TABLE IF NOT EXISTS open_slots (
+    id                SERIAL        PRIMARY KEY,
+    barber_id         INTEGER       NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    barber_service_id INTEGER       NOT NULL REFERENCES barber_services(id) ON DELETE CASCADE,
+    service_name      VARCHAR(200)  NOT NULL,
+    start_time        TIMESTAMPTZ   NOT NULL,
+    duration_minutes  INTEGER       NOT NULL CHECK (duration_minutes > 0),
+    service_price     NUMERIC(10,2) NOT NULL CHECK (service_price >= 0),
+    discount_percent  INTEGER       NOT NULL DEFAULT 0 CHECK (discount_percent BETWEEN 0 AND 100),
+    message           VARCHAR(300),
+    audiences         JSONB         NOT NULL DEFAULT '[]',
+    radius_km         NUMERIC(6,2),
+    recipient_count   INTEGER       NOT NULL DEFAULT 0,
+    status            VARCHAR(20)   NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'claimed', 'cancelled')),
+    claims_close_at   TIMESTAMPTZ   NOT NULL,
+    claimed_by        INTEGER       REFERENCES users(id) ON DELETE SET NULL,
+    claimed_at        TIMESTAMPTZ,
+    booking_id        INTEGER       REFERENCES bookings(id) ON DELETE SET NULL,
+    created_by        INTEGER       REFERENCES users(id) ON DELETE SET NULL,
+    created_at        TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_open_slots_barber ON open_slots (barber_id, created_at DESC);
@@ -0,0 +1,46 @@
+-- Support tickets opened by customers and barbers, optionally about one of
+-- their bookings or a payment, and worked by support staff. Each ticket
+-- carries SLA due dates for the first reply and for resolution; the
+-- resolution clock stops while the ticket waits on the requester
+-- (sla_paused_at) and the paused time is added back (sla_paused_seconds).
+CREATE TABLE IF NOT EXISTS support_tickets (
+    id                         SERIAL PRIMARY KEY,
+    requester_id               INTEGER      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    booking_id                 INTEGER      REFERENCES bookings(id) ON DELETE SET NULL,
+    payment_reference          VARCHAR(255),
+    category                   VARCHAR(20)  NOT NULL CHECK (category IN ('booking', 'payment', 'account', 'other')),
+    priority                   VARCHAR(10)  NOT NULL CHECK (priority IN ('low', 'normal', 'high', 'urgent')),
+    status                     VARCHAR(30)  NOT NULL DEFAULT 'open'
+                               CHECK (status IN ('open', 'in_progress', 'waiting_on_customer', 'resolved', 'closed')),
+    subject                    VARCHAR(200) NOT NULL,
+    assigned_to                INTEGER      REFERENCES users(id) ON DELETE SET NULL,
+    first_response_due_at      TIMESTAMPTZ  NOT NULL,
+    resolution_due_at          TIMESTAMPTZ  NOT NULL,
+    first_responded_at         TIMESTAMPTZ,
+    sla_paused_at              TIMESTAMPTZ,
+    sla_paused_seconds         INTEGER      NOT NULL DEFAULT 0,
+    first_response_breached_at TIMESTAMPTZ,
+    resolution_breached_at     TIMESTAMPTZ,
+    resolved_at                TIMESTAMPTZ,
+    closed_at                  TIMESTAMPTZ,
+    created_at                 TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at                 TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_support_tickets_requester ON support_tickets (requester_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_support_tickets_assigned ON support_tickets (assigned_to) WHERE assigned_to IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_support_tickets_active_due ON support_tickets (resolution_due_at)
+    WHERE status NOT IN ('resolved', 'closed');
+
+-- The conversation on a ticket. Internal notes are seen by staff only.
+CREATE TABLE IF NOT EXISTS support_ticket_messages (
+    id          SERIAL PRIMARY KEY,
+    ticket_id   INTEGER     NOT NULL REFERENCES support_tickets(id) ON DELETE CASCADE,
+    author_id   INTEGER     REFERENCES users(id) ON DELETE SET NULL,
+    is_staff    BOOLEAN     NOT NULL DEFAULT false,
+    is_internal BOOLEAN     NOT NULL DEFAULT false,
+    body        TEXT        NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_support_ticket_messages_ticket ON support_ticket_messages (ticket_id, created_at);
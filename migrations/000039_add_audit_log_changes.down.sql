@@ -0,0 +1,3 @@
+ALTER TABLE audit_logs
+    DROP COLUMN IF EXISTS old_values,
+    DROP COLUMN IF EXISTS new_values;
@@ -0,0 +1,6 @@
+-- Admin actions record what they changed: the affected fields as they were
+-- before (old_values) and after (new_values). Creations have no old values
+-- and deletions no new ones.
+ALTER TABLE audit_logs
+    ADD COLUMN IF NOT EXISTS old_values JSONB,
+    ADD COLUMN IF NOT EXISTS new_values JSONB;
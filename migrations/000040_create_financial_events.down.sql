@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS financial_events;
+DROP FUNCTION IF EXISTS financial_events_append_only();
@@ -0,0 +1,42 @@
+-- Append-only ledger of money movements: card holds, captures, voids and
+-- refunds as they happen at the payment provider, and the platform fee and
+-- barber payout of each completed booking. Amounts are in minor units.
+--
+-- Each event is chained to the one before it: hash is the SHA-256 of the
+-- event's fields and prev_hash (see models.FinancialEvent.ComputeHash), so
+-- editing, removing or reordering any event breaks every hash after it.
+-- The trigger below refuses updates and deletes outright.
+CREATE TABLE IF NOT EXISTS financial_events (
+    id          BIGSERIAL    PRIMARY KEY,
+    event_type  VARCHAR(30)  NOT NULL,
+    entity_type VARCHAR(30),
+    entity_id   INTEGER,
+    barber_id   INTEGER,
+    amount      BIGINT       NOT NULL,
+    currency    VARCHAR(3)   NOT NULL,
+    provider    VARCHAR(30),
+    reference   VARCHAR(255),
+    details     TEXT         NOT NULL DEFAULT '{}',
+    occurred_at TIMESTAMPTZ  NOT NULL,
+    prev_hash   CHAR(64)     NOT NULL UNIQUE,
+    hash        CHAR(64)     NOT NULL UNIQUE
+);
+
+CREATE INDEX IF NOT EXISTS idx_financial_events_occurred ON financial_events (occurred_at, id);
+CREATE INDEX IF NOT EXISTS idx_financial_events_reference ON financial_events (reference) WHERE reference IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION financial_events_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'financial_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS financial_events_no_update ON financial_events;
+CREATE TRIGGER financial_events_no_update
+    BEFORE UPDATE OR DELETE ON financial_events
+    FOR EACH ROW EXECUTE FUNCTION financial_events_append_only();
+
+DROP TRIGGER IF EXISTS financial_events_no_truncate ON financial_events;
+CREATE TRIGGER financial_events_no_truncate
+    BEFORE TRUNCATE ON financial_events
+    FOR EACH STATEMENT EXECUTE FUNCTION financial_events_append_only();
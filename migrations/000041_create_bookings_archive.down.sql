@@ -0,0 +1,31 @@
+-- Move archived bookings and their related rows back before dropping the
+-- archive
+INSERT INTO bookings
+SELECT (jsonb_populate_record(NULL::bookings, to_jsonb(a))).*
+FROM bookings_archive a;
+
+INSERT INTO booking_history
+SELECT (jsonb_populate_recordset(NULL::booking_history, a.related->'history')).*
+FROM bookings_archive a WHERE jsonb_typeof(a.related->'history') = 'array';
+
+INSERT INTO booking_line_items
+SELECT (jsonb_populate_recordset(NULL::booking_line_items, a.related->'line_items')).*
+FROM bookings_archive a WHERE jsonb_typeof(a.related->'line_items') = 'array';
+
+INSERT INTO booking_tax_lines
+SELECT (jsonb_populate_recordset(NULL::booking_tax_lines, a.related->'tax_lines')).*
+FROM bookings_archive a WHERE jsonb_typeof(a.related->'tax_lines') = 'array';
+
+INSERT INTO booking_staff
+SELECT (jsonb_populate_recordset(NULL::booking_staff, a.related->'staff')).*
+FROM bookings_archive a WHERE jsonb_typeof(a.related->'staff') = 'array';
+
+DELETE FROM reviews WHERE booking_id NOT IN (SELECT id FROM bookings);
+DELETE FROM nps_surveys WHERE booking_id NOT IN (SELECT id FROM bookings);
+ALTER TABLE reviews ADD CONSTRAINT reviews_booking_id_fkey
+    FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE CASCADE;
+ALTER TABLE nps_surveys ADD CONSTRAINT nps_surveys_booking_id_fkey
+    FOREIGN KEY (booking_id) REFERENCES bookings(id) ON DELETE CASCADE;
+
+DROP TABLE IF EXISTS booking_archive_stats;
+DROP TABLE IF EXISTS bookings_archive;
@@ -0,0 +1,35 @@
+-- Cold storage for old bookings. The archival job moves finished bookings
+-- past the retention age here, in the same transaction that deletes them
+-- from bookings, so the hot table (and its indexes) only hold recent data.
+-- Rows are copied by column name: a migration that adds a column to
+-- bookings must add it here too.
+CREATE TABLE IF NOT EXISTS bookings_archive (
+    LIKE bookings INCLUDING DEFAULTS,
+    related     JSONB       NOT NULL DEFAULT '{}', -- history, line_items, tax_lines, staff
+    archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id),
+    UNIQUE (booking_number)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookings_archive_barber ON bookings_archive (barber_id, scheduled_start_time);
+CREATE INDEX IF NOT EXISTS idx_bookings_archive_customer ON bookings_archive (customer_id, scheduled_start_time);
+
+-- Counters of archived bookings, added to the live counts by the stats
+-- queries so totals survive archival without scanning the archive.
+-- barber_service_id is 0 for bookings without a recorded service.
+CREATE TABLE IF NOT EXISTS booking_archive_stats (
+    barber_id             INTEGER       NOT NULL,
+    barber_service_id     INTEGER       NOT NULL DEFAULT 0,
+    total                 INTEGER       NOT NULL DEFAULT 0,
+    completed             INTEGER       NOT NULL DEFAULT 0,
+    cancelled             INTEGER       NOT NULL DEFAULT 0, -- status 'cancelled'
+    cancelled_by_customer INTEGER       NOT NULL DEFAULT 0,
+    cancelled_by_barber   INTEGER       NOT NULL DEFAULT 0,
+    decided               INTEGER       NOT NULL DEFAULT 0, -- Not pending
+    revenue               NUMERIC(12,2) NOT NULL DEFAULT 0, -- Completed bookings' total price
+    PRIMARY KEY (barber_id, barber_service_id)
+);
+
+-- Reviews and NPS responses outlive the bookings they were left on
+ALTER TABLE reviews DROP CONSTRAINT IF EXISTS reviews_booking_id_fkey;
+ALTER TABLE nps_surveys DROP CONSTRAINT IF EXISTS nps_surveys_booking_id_fkey;
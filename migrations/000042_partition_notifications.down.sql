@@ -0,0 +1,27 @@
+ALTER TABLE notifications RENAME TO notifications_partitioned;
+
+CREATE TABLE notifications (
+    LIKE notifications_partitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS
+);
+
+INSERT INTO notifications SELECT * FROM notifications_partitioned;
+
+ALTER SEQUENCE notifications_id_seq OWNED BY notifications.id;
+DROP TABLE notifications_partitioned;
+
+ALTER TABLE notifications ADD PRIMARY KEY (id);
+ALTER TABLE notifications ADD CONSTRAINT notifications_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications (user_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_status ON notifications (status);
+CREATE INDEX IF NOT EXISTS idx_notifications_pending_delivery
+    ON notifications (next_attempt_at)
+    WHERE status = 'pending';
+
+UPDATE win_back_messages m SET notification_id = NULL
+WHERE notification_id IS NOT NULL
+AND NOT EXISTS (SELECT 1 FROM notifications n WHERE n.id = m.notification_id);
+
+ALTER TABLE win_back_messages ADD CONSTRAINT win_back_messages_notification_id_fkey
+    FOREIGN KEY (notification_id) REFERENCES notifications(id) ON DELETE SET NULL;
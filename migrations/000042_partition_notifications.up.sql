@@ -0,0 +1,58 @@
+-- Monthly range partitions of notifications by created_at. The delivery
+-- queries only look at recent months and retention drops whole partitions
+-- instead of deleting rows, so the table's size no longer slows the hot
+-- path. The partition maintenance job keeps months ahead created;
+-- notifications_default catches rows outside every partition until the
+-- job moves them into their month.
+--
+-- bookings are deliberately not partitioned: a partitioned table can only
+-- enforce uniqueness on keys that include the partition key, which would
+-- drop the bookings_no_overlap exclusion constraint (000003), the foreign
+-- keys into bookings and globally unique booking numbers. Old bookings are
+-- moved to bookings_archive (000041) instead.
+--
+-- The primary key becomes (id, created_at); id stays unique through its
+-- sequence. win_back_messages.notification_id loses its foreign key.
+ALTER TABLE win_back_messages DROP CONSTRAINT IF EXISTS win_back_messages_notification_id_fkey;
+
+ALTER TABLE notifications RENAME TO notifications_unpartitioned;
+
+CREATE TABLE notifications (
+    LIKE notifications_unpartitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE notifications_default PARTITION OF notifications DEFAULT;
+
+-- One partition per month from the oldest notification to three months ahead
+DO $$
+DECLARE
+    month DATE;
+BEGIN
+    SELECT date_trunc('month', COALESCE(MIN(created_at), NOW()) AT TIME ZONE 'UTC')::date
+    INTO month FROM notifications_unpartitioned;
+
+    WHILE month < (date_trunc('month', NOW() AT TIME ZONE 'UTC') + INTERVAL '4 months')::date LOOP
+        EXECUTE format(
+            'CREATE TABLE IF NOT EXISTS %I PARTITION OF notifications FOR VALUES FROM (%L) TO (%L)',
+            'notifications_' || to_char(month, '"y"YYYY"m"MM'),
+            month::timestamp AT TIME ZONE 'UTC',
+            (month + INTERVAL '1 month')::timestamp AT TIME ZONE 'UTC'
+        );
+        month := (month + INTERVAL '1 month')::date;
+    END LOOP;
+END $$;
+
+INSERT INTO notifications SELECT * FROM notifications_unpartitioned;
+
+ALTER SEQUENCE notifications_id_seq OWNED BY notifications.id;
+DROP TABLE notifications_unpartitioned;
+
+ALTER TABLE notifications ADD PRIMARY KEY (id, created_at);
+ALTER TABLE notifications ADD CONSTRAINT notifications_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications (user_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_status ON notifications (status);
+CREATE INDEX IF NOT EXISTS idx_notifications_pending_delivery
+    ON notifications (next_attempt_at)
+    WHERE status = 'pending';
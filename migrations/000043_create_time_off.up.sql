@@ -0,0 +1,17 @@
+-- Blocks of time a barber is away (vacations, appointments, sick days).
+-- Unlike schedule exceptions they are exact instants rather than local
+-- dates, need no weekly schedule, and slot listing and booking validation
+-- treat them as busy. all_day marks blocks created from whole dates.
+CREATE TABLE IF NOT EXISTS time_off (
+    id         SERIAL       PRIMARY KEY,
+    barber_id  INTEGER      NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    start_at   TIMESTAMPTZ  NOT NULL,
+    end_at     TIMESTAMPTZ  NOT NULL,
+    all_day    BOOLEAN      NOT NULL DEFAULT FALSE,
+    reason     VARCHAR(200),
+    created_by INTEGER      REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    CHECK (end_at > start_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_time_off_barber ON time_off (barber_id, end_at);
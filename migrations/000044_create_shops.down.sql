@@ -0,0 +1,9 @@
+DROP INDEX IF EXISTS idx_tax_rules_shop;
+ALTER TABLE tax_rules DROP CONSTRAINT IF EXISTS tax_rules_shop_scope_check;
+ALTER TABLE tax_rules DROP COLUMN IF EXISTS shop_id;
+
+DROP INDEX IF EXISTS idx_barbers_shop;
+ALTER TABLE barbers DROP COLUMN IF EXISTS shop_id;
+
+DROP TABLE IF EXISTS shop_admins;
+DROP TABLE IF EXISTS shops;
@@ -0,0 +1,45 @@
+-- Shops: a business several barbers work at. A barber belongs to at most
+-- one shop. The shop's opening hours are the working hours of its barbers
+-- who have no schedule of their own, and shop-scoped tax rules apply to its
+-- barbers' bookings ahead of regional ones.
+CREATE TABLE IF NOT EXISTS shops (
+    id             SERIAL        PRIMARY KEY,
+    uuid           VARCHAR(64)   NOT NULL UNIQUE DEFAULT gen_random_uuid()::text,
+    name           VARCHAR(200)  NOT NULL,
+    description    TEXT,
+    address        TEXT          NOT NULL DEFAULT '',
+    address_line_2 TEXT,
+    city           VARCHAR(100)  NOT NULL DEFAULT '',
+    state          VARCHAR(100)  NOT NULL DEFAULT '',
+    country        VARCHAR(100)  NOT NULL DEFAULT '',
+    postal_code    VARCHAR(20)   NOT NULL DEFAULT '',
+    latitude       DOUBLE PRECISION,
+    longitude      DOUBLE PRECISION,
+    phone          VARCHAR(30),
+    email          VARCHAR(255),
+    tax_id         VARCHAR(100),
+    timezone       VARCHAR(64)   NOT NULL DEFAULT 'UTC',
+    opening_hours  JSONB         NOT NULL DEFAULT '[]',
+    created_by     INTEGER       REFERENCES users(id) ON DELETE SET NULL,
+    created_at     TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at     TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+-- Users who manage a shop. The owner also manages the shop's admins.
+CREATE TABLE IF NOT EXISTS shop_admins (
+    shop_id    INTEGER     NOT NULL REFERENCES shops(id) ON DELETE CASCADE,
+    user_id    INTEGER     NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role       VARCHAR(20) NOT NULL DEFAULT 'admin' CHECK (role IN ('owner', 'admin')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (shop_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_shop_admins_user ON shop_admins (user_id);
+
+ALTER TABLE barbers ADD COLUMN IF NOT EXISTS shop_id INTEGER REFERENCES shops(id) ON DELETE SET NULL;
+CREATE INDEX IF NOT EXISTS idx_barbers_shop ON barbers (shop_id) WHERE shop_id IS NOT NULL;
+
+ALTER TABLE tax_rules ADD COLUMN IF NOT EXISTS shop_id INTEGER REFERENCES shops(id) ON DELETE CASCADE;
+ALTER TABLE tax_rules ADD CONSTRAINT tax_rules_shop_scope_check
+    CHECK (shop_id IS NULL OR (barber_id IS NULL AND country IS NULL AND state IS NULL));
+CREATE INDEX IF NOT EXISTS idx_tax_rules_shop ON tax_rules (shop_id) WHERE shop_id IS NOT NULL;
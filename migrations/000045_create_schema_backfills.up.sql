@@ -0,0 +1,26 @@
+-- Backfills queued by expand migrations (see internal/migrate). The
+-- background worker fills batch_sql's key ranges from next_key up to max_key,
+-- re-reading max_key when it gets there, and contract migrations that
+-- require a backfill wait until it has completed.
+CREATE TABLE IF NOT EXISTS schema_backfills (
+    id                SERIAL       PRIMARY KEY,
+    name              VARCHAR(100) NOT NULL UNIQUE,
+    migration_version BIGINT       NOT NULL,
+    table_name        VARCHAR(100) NOT NULL,
+    key_column        VARCHAR(100) NOT NULL DEFAULT 'id',
+    batch_sql         TEXT         NOT NULL, -- UPDATE for keys in [$1, $2)
+    status            VARCHAR(20)  NOT NULL DEFAULT 'pending'
+                      CHECK (status IN ('pending', 'running', 'completed', 'failed')),
+    next_key          BIGINT       NOT NULL DEFAULT 0, -- Keys below are done
+    max_key           BIGINT,
+    rows_updated      BIGINT       NOT NULL DEFAULT 0,
+    locked_until      TIMESTAMPTZ,
+    last_error        TEXT,
+    started_at        TIMESTAMPTZ,
+    completed_at      TIMESTAMPTZ,
+    created_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_schema_backfills_runnable ON schema_backfills (created_at)
+    WHERE status IN ('pending', 'running');
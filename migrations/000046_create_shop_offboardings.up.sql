@@ -0,0 +1,24 @@
+-- Shops leaving the platform. The shop can export its data until
+-- delete_after; then the offboarding job erases its customers' personal
+-- data, reviews and client lists and removes the shop. Bookings are kept
+-- anonymised, and financial events in full, for the ledger's retention.
+-- shop_id has no foreign key so the record outlives the shop.
+CREATE TABLE IF NOT EXISTS shop_offboardings (
+    id           SERIAL       PRIMARY KEY,
+    shop_id      INTEGER      NOT NULL,
+    shop_name    VARCHAR(200) NOT NULL,
+    requested_by INTEGER      REFERENCES users(id) ON DELETE SET NULL,
+    reason       TEXT,
+    status       VARCHAR(20)  NOT NULL DEFAULT 'scheduled'
+                 CHECK (status IN ('scheduled', 'cancelled', 'completed')),
+    delete_after TIMESTAMPTZ  NOT NULL,
+    completed_at TIMESTAMPTZ,
+    last_error   TEXT,         -- Why the last deletion attempt was put off
+    summary      JSONB        NOT NULL DEFAULT '{}', -- Rows deleted or anonymised, by table
+    created_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_shop_offboardings_scheduled ON shop_offboardings (shop_id)
+    WHERE status = 'scheduled';
+CREATE INDEX IF NOT EXISTS idx_shop_offboardings_shop ON shop_offboardings (shop_id, created_at);
@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS idx_barbers_geo;
@@ -0,0 +1,5 @@
+-- Nearby barber search narrows barbers to a bounding box around the
+-- searcher before computing distances; the box's latitude range is served
+-- by this index.
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_barbers_geo ON barbers (latitude, longitude)
+    WHERE deleted_at IS NULL AND latitude IS NOT NULL AND longitude IS NOT NULL;
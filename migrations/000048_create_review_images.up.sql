@@ -0,0 +1,19 @@
+-- Photos attached to reviews. Files live in upload storage under
+-- image_key and thumbnail_key; url is the entry this photo added to
+-- reviews.images, so deleting the photo removes exactly that entry.
+CREATE TABLE IF NOT EXISTS review_images (
+    id            SERIAL       PRIMARY KEY,
+    review_id     INTEGER      NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    image_key     VARCHAR(255) NOT NULL,
+    thumbnail_key VARCHAR(255) NOT NULL,
+    url           TEXT         NOT NULL,
+    thumbnail_url TEXT         NOT NULL,
+    content_type  VARCHAR(50)  NOT NULL,
+    size_bytes    INTEGER      NOT NULL,
+    width         INTEGER      NOT NULL,
+    height        INTEGER      NOT NULL,
+    uploaded_by   INTEGER      REFERENCES users(id) ON DELETE SET NULL,
+    created_at    TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_review_images_review ON review_images (review_id, id);
@@ -0,0 +1,40 @@
+-- Photos barbers show on their services: examples of their work and
+-- before/after shots, each with an optional caption, in the barber's order.
+-- barber_services.portfolio_images and before_after_images list the URLs
+-- in that order and are rewritten whenever the photos change.
+CREATE TABLE IF NOT EXISTS portfolio_media (
+    id                SERIAL       PRIMARY KEY,
+    barber_service_id INTEGER      NOT NULL REFERENCES barber_services(id) ON DELETE CASCADE,
+    kind              VARCHAR(20)  NOT NULL CHECK (kind IN ('portfolio', 'before_after')),
+    position          INTEGER      NOT NULL,
+    caption           VARCHAR(300),
+    image_key         VARCHAR(255) NOT NULL,
+    thumbnail_key     VARCHAR(255) NOT NULL,
+    url               TEXT         NOT NULL,
+    thumbnail_url     TEXT         NOT NULL,
+    content_type      VARCHAR(50)  NOT NULL,
+    size_bytes        INTEGER      NOT NULL,
+    width             INTEGER      NOT NULL,
+    height            INTEGER      NOT NULL,
+    uploaded_by       INTEGER      REFERENCES users(id) ON DELETE SET NULL,
+    created_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at        TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_portfolio_media_service ON portfolio_media (barber_service_id, kind, position);
+
+-- Keep the URLs services already list, as photos hosted elsewhere: they
+-- have no stored files (empty keys) and their own URL as the thumbnail.
+INSERT INTO portfolio_media (
+    barber_service_id, kind, position, image_key, thumbnail_key,
+    url, thumbnail_url, content_type, size_bytes, width, height
+)
+SELECT bs.id, 'portfolio', e.position, '', '', e.url, e.url, '', 0, 0, 0
+FROM barber_services bs,
+     jsonb_array_elements_text(COALESCE(bs.portfolio_images, '[]')) WITH ORDINALITY AS e(url, position)
+WHERE jsonb_typeof(bs.portfolio_images) = 'array'
+UNION ALL
+SELECT bs.id, 'before_after', e.position, '', '', e.url, e.url, '', 0, 0, 0
+FROM barber_services bs,
+     jsonb_array_elements_text(COALESCE(bs.before_after_images, '[]')) WITH ORDINALITY AS e(url, position)
+WHERE jsonb_typeof(bs.before_after_images) = 'array';
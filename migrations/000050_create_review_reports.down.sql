@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS review_reports;
+ALTER TABLE reviews DROP COLUMN IF EXISTS report_count;
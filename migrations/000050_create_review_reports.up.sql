@@ -0,0 +1,16 @@
+-- Reports of published reviews by users. reviews.report_count counts the
+-- reports since the review was last moderated: moderating a review
+-- resolves its reports, and enough open reports flag it for moderators.
+ALTER TABLE reviews ADD COLUMN IF NOT EXISTS report_count INTEGER NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS review_reports (
+    id          SERIAL      PRIMARY KEY,
+    review_id   INTEGER     NOT NULL REFERENCES reviews(id) ON DELETE CASCADE,
+    reporter_id INTEGER     REFERENCES users(id) ON DELETE SET NULL,
+    reason      VARCHAR(30) NOT NULL,
+    details     TEXT,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One report per user per review
+CREATE UNIQUE INDEX IF NOT EXISTS idx_review_reports_reporter ON review_reports (review_id, reporter_id);
@@ -0,0 +1,20 @@
+-- Review requests sent a while after a booking is completed. The token in
+-- the request's link lets the customer write their review without signing
+-- in; it works once. Requests due while the booking already has a review
+-- are skipped instead of sent.
+CREATE TABLE IF NOT EXISTS review_requests (
+    id          SERIAL      PRIMARY KEY,
+    token       VARCHAR(64) NOT NULL UNIQUE,
+    booking_id  INTEGER     NOT NULL UNIQUE REFERENCES bookings(id) ON DELETE CASCADE,
+    customer_id INTEGER     NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    send_at     TIMESTAMPTZ NOT NULL,
+    sent_at     TIMESTAMPTZ,
+    skipped_at  TIMESTAMPTZ,
+    expires_at  TIMESTAMPTZ NOT NULL,
+    used_at     TIMESTAMPTZ,
+    review_id   INTEGER     REFERENCES reviews(id) ON DELETE SET NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Requests still waiting to go out
+CREATE INDEX IF NOT EXISTS idx_review_requests_due ON review_requests (send_at) WHERE sent_at IS NULL AND skipped_at IS NULL;
@@ -0,0 +1,10 @@
+-- Per-user choice of delivery channels for each notification type. A type
+-- without a row goes out on its default channels; a row with no channels
+-- turns the type off for that user.
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id           INTEGER     NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    notification_type VARCHAR(50) NOT NULL,
+    channels          JSONB       NOT NULL DEFAULT '[]',
+    updated_at        TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, notification_type)
+);
@@ -0,0 +1,6 @@
+ALTER TABLE reviews
+    DROP COLUMN IF EXISTS barber_response_moderated_at,
+    DROP COLUMN IF EXISTS barber_response_moderated_by,
+    DROP COLUMN IF EXISTS barber_response_notes,
+    DROP COLUMN IF EXISTS pending_barber_response,
+    DROP COLUMN IF EXISTS barber_response_status;
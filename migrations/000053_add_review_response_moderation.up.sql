@@ -0,0 +1,14 @@
+-- Moderation of barbers' responses to reviews. barber_response holds the
+-- live response only; a response awaiting moderation, or one rejected or
+-- redacted, is kept in pending_barber_response. Responses written before
+-- moderation existed stay live.
+ALTER TABLE reviews
+    ADD COLUMN IF NOT EXISTS barber_response_status       VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS pending_barber_response      TEXT,
+    ADD COLUMN IF NOT EXISTS barber_response_notes        TEXT,
+    ADD COLUMN IF NOT EXISTS barber_response_moderated_by INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS barber_response_moderated_at TIMESTAMPTZ;
+
+UPDATE reviews SET barber_response_status = 'approved'
+WHERE barber_response IS NOT NULL AND barber_response != '' AND barber_response_status IS NULL;
+
@@ -0,0 +1,2 @@
+ALTER TABLE bookings_archive DROP COLUMN IF EXISTS cancellation_reason_code;
+ALTER TABLE bookings DROP COLUMN IF EXISTS cancellation_reason_code;
@@ -0,0 +1,5 @@
+-- Structured cancellation reasons (sick, schedule_conflict, ...) next to
+-- the free-text cancellation_reason, so cancellations can be counted by
+-- reason. Bookings cancelled before this have no code.
+ALTER TABLE bookings ADD COLUMN IF NOT EXISTS cancellation_reason_code VARCHAR(30);
+ALTER TABLE bookings_archive ADD COLUMN IF NOT EXISTS cancellation_reason_code VARCHAR(30);
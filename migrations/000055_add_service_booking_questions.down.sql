@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS consultation_requests;
+
+ALTER TABLE booking_form_fields
+    DROP COLUMN IF EXISTS consultation_answers,
+    DROP COLUMN IF EXISTS barber_service_id;
@@ -0,0 +1,30 @@
+-- Booking questions on services that require a consultation. A question
+-- with a barber_service_id is only asked when that service is booked, and
+-- answering one of its consultation_answers creates a consultation request
+-- for the barber instead of the booking.
+ALTER TABLE booking_form_fields
+    ADD COLUMN IF NOT EXISTS barber_service_id    INTEGER REFERENCES barber_services(id) ON DELETE CASCADE,
+    ADD COLUMN IF NOT EXISTS consultation_answers JSONB NOT NULL DEFAULT '[]';
+
+CREATE TABLE IF NOT EXISTS consultation_requests (
+    id                   SERIAL        PRIMARY KEY,
+    barber_id            INTEGER       NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    barber_service_id    INTEGER       REFERENCES barber_services(id) ON DELETE SET NULL,
+    service_name         VARCHAR(200)  NOT NULL,
+    customer_id          INTEGER       REFERENCES users(id) ON DELETE SET NULL,
+    customer_name        VARCHAR(100),
+    customer_email       VARCHAR(255),
+    customer_phone       VARCHAR(30),
+    requested_start_time TIMESTAMPTZ   NOT NULL,
+    answers              JSONB,
+    triggered_by         JSONB         NOT NULL DEFAULT '[]',
+    notes                TEXT,
+    status               VARCHAR(20)   NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'booked', 'declined')),
+    response_notes       TEXT,
+    responded_by         INTEGER       REFERENCES users(id) ON DELETE SET NULL,
+    responded_at         TIMESTAMPTZ,
+    created_at           TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_consultation_requests_barber ON consultation_requests (barber_id, status, created_at DESC);
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS consultation_photos;
+
+UPDATE consultation_requests SET status = 'open' WHERE status = 'quoted';
+ALTER TABLE consultation_requests DROP CONSTRAINT IF EXISTS consultation_requests_status_check;
+ALTER TABLE consultation_requests ADD CONSTRAINT consultation_requests_status_check
+    CHECK (status IN ('open', 'booked', 'declined'));
+
+ALTER TABLE consultation_requests
+    DROP COLUMN IF EXISTS booking_id,
+    DROP COLUMN IF EXISTS quoted_at,
+    DROP COLUMN IF EXISTS proposed_start_time,
+    DROP COLUMN IF EXISTS quoted_price,
+    DROP COLUMN IF EXISTS preferred_times;
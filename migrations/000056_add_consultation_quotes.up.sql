@@ -0,0 +1,32 @@
+-- Consultation requests customers submit themselves, with preferred times
+-- and photos, and the barber's quote: a price and a proposed slot. When
+-- the customer accepts the quote it becomes booking_id.
+ALTER TABLE consultation_requests
+    ADD COLUMN IF NOT EXISTS preferred_times     JSONB         NOT NULL DEFAULT '[]',
+    ADD COLUMN IF NOT EXISTS quoted_price        DECIMAL(10,2),
+    ADD COLUMN IF NOT EXISTS proposed_start_time TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS quoted_at           TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS booking_id          INTEGER       REFERENCES bookings(id) ON DELETE SET NULL;
+
+ALTER TABLE consultation_requests DROP CONSTRAINT IF EXISTS consultation_requests_status_check;
+ALTER TABLE consultation_requests ADD CONSTRAINT consultation_requests_status_check
+    CHECK (status IN ('open', 'quoted', 'booked', 'declined'));
+
+-- Photos customers attach to their consultation requests. Files live in
+-- upload storage under image_key and thumbnail_key.
+CREATE TABLE IF NOT EXISTS consultation_photos (
+    id                      SERIAL       PRIMARY KEY,
+    consultation_request_id INTEGER      NOT NULL REFERENCES consultation_requests(id) ON DELETE CASCADE,
+    image_key               VARCHAR(255) NOT NULL,
+    thumbnail_key           VARCHAR(255) NOT NULL,
+    url                     TEXT         NOT NULL,
+    thumbnail_url           TEXT         NOT NULL,
+    content_type            VARCHAR(50)  NOT NULL,
+    size_bytes              INTEGER      NOT NULL,
+    width                   INTEGER      NOT NULL,
+    height                  INTEGER      NOT NULL,
+    uploaded_by             INTEGER      REFERENCES users(id) ON DELETE SET NULL,
+    created_at              TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_consultation_photos_request ON consultation_photos (consultation_request_id, id);
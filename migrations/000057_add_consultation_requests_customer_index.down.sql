@@ -0,0 +1 @@
+DROP INDEX CONCURRENTLY IF EXISTS idx_consultation_requests_customer;
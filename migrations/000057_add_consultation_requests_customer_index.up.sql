@@ -0,0 +1,2 @@
+-- Customers list their own consultation requests, newest first
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_consultation_requests_customer ON consultation_requests (customer_id, created_at DESC);
@@ -0,0 +1,458 @@
+-- scripts/schema/baseline.sql
+--
+-- Baseline schema snapshot: the tables that existed before versioned
+-- migrations were introduced. Apply this to an empty database, then run
+-- migrations/*.up.sql in order, then (optionally) scripts/seeds.
+--
+-- Column types mirror internal/models: StringArray and JSONMap fields are
+-- JSONB, money is NUMERIC(10,2), and UUIDs are stored as text because
+-- seeded and legacy rows use non-RFC identifiers.
+
+-- =============================================================================
+-- USERS
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS users (
+    id                    SERIAL PRIMARY KEY,
+    uuid                  VARCHAR(64)  NOT NULL UNIQUE DEFAULT gen_random_uuid()::text,
+    email                 VARCHAR(255) NOT NULL UNIQUE,
+    password_hash         VARCHAR(255) NOT NULL,
+    name                  VARCHAR(100) NOT NULL,
+    phone                 VARCHAR(30),
+    user_type             VARCHAR(20)  NOT NULL DEFAULT 'customer',
+    status                VARCHAR(20)  NOT NULL DEFAULT 'active',
+    email_verified        BOOLEAN      NOT NULL DEFAULT false,
+    phone_verified        BOOLEAN      NOT NULL DEFAULT false,
+    two_factor_enabled    BOOLEAN      NOT NULL DEFAULT false,
+    failed_login_attempts INTEGER      NOT NULL DEFAULT 0,
+    locked_until          TIMESTAMPTZ,
+    date_of_birth         DATE,
+    gender                VARCHAR(30),
+    profile_picture_url   TEXT,
+    address               TEXT,
+    city                  VARCHAR(100),
+    state                 VARCHAR(100),
+    country               VARCHAR(100),
+    postal_code           VARCHAR(20),
+    latitude              DOUBLE PRECISION,
+    longitude             DOUBLE PRECISION,
+    preferences           JSONB        NOT NULL DEFAULT '{}',
+    notification_settings JSONB        NOT NULL DEFAULT '{}',
+    created_at            TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    updated_at            TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    last_login_at         TIMESTAMPTZ,
+    created_by            INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    deleted_at            TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_users_user_type ON users(user_type);
+CREATE INDEX IF NOT EXISTS idx_users_status ON users(status);
+
+CREATE TABLE IF NOT EXISTS user_sessions (
+    id               SERIAL PRIMARY KEY,
+    user_id          INTEGER      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    session_token    VARCHAR(512) NOT NULL UNIQUE,
+    refresh_token    VARCHAR(512),
+    device_type      VARCHAR(50),
+    device_id        VARCHAR(255),
+    ip_address       VARCHAR(64),
+    user_agent       TEXT,
+    location_city    VARCHAR(100),
+    location_country VARCHAR(100),
+    expires_at       TIMESTAMPTZ  NOT NULL,
+    created_at       TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    last_activity_at TIMESTAMPTZ  NOT NULL DEFAULT NOW(),
+    is_active        BOOLEAN      NOT NULL DEFAULT true
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_sessions_user_id ON user_sessions(user_id);
+
+CREATE TABLE IF NOT EXISTS verification_codes (
+    id         SERIAL PRIMARY KEY,
+    user_id    INTEGER     NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    code       VARCHAR(32) NOT NULL,
+    type       VARCHAR(30) NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    used_at    TIMESTAMPTZ,
+    attempts   INTEGER     NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_verification_codes_user_id ON verification_codes(user_id);
+
+-- =============================================================================
+-- SERVICE CATALOG
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS service_categories (
+    id                 SERIAL PRIMARY KEY,
+    name               VARCHAR(100)  NOT NULL,
+    slug               VARCHAR(120)  NOT NULL UNIQUE,
+    description        TEXT,
+    parent_category_id INTEGER REFERENCES service_categories(id) ON DELETE SET NULL,
+    level              INTEGER       NOT NULL DEFAULT 1,
+    category_path      TEXT          NOT NULL DEFAULT '',
+    icon_url           TEXT,
+    color_hex          VARCHAR(7),
+    image_url          TEXT,
+    sort_order         INTEGER       NOT NULL DEFAULT 0,
+    is_active          BOOLEAN       NOT NULL DEFAULT true,
+    is_featured        BOOLEAN       NOT NULL DEFAULT false,
+    meta_title         VARCHAR(255),
+    meta_description   TEXT,
+    keywords           JSONB,
+    service_count      INTEGER       NOT NULL DEFAULT 0,
+    barber_count       INTEGER       NOT NULL DEFAULT 0,
+    average_price      NUMERIC(10,2) NOT NULL DEFAULT 0,
+    popularity_score   NUMERIC(6,2)  NOT NULL DEFAULT 0,
+    created_at         TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE IF NOT EXISTS services (
+    id                      SERIAL PRIMARY KEY,
+    uuid                    VARCHAR(64)   NOT NULL UNIQUE DEFAULT gen_random_uuid()::text,
+    name                    VARCHAR(150)  NOT NULL,
+    slug                    VARCHAR(180)  NOT NULL UNIQUE,
+    short_description       TEXT          NOT NULL DEFAULT '',
+    detailed_description    TEXT,
+    category_id             INTEGER       NOT NULL REFERENCES service_categories(id),
+    service_type            VARCHAR(50)   NOT NULL,
+    complexity              INTEGER       NOT NULL DEFAULT 1,
+    skill_level_required    VARCHAR(30)   NOT NULL DEFAULT 'beginner',
+    default_duration_min    INTEGER       NOT NULL,
+    default_duration_max    INTEGER,
+    suggested_price_min     NUMERIC(10,2),
+    suggested_price_max     NUMERIC(10,2),
+    currency                VARCHAR(3)    NOT NULL DEFAULT 'USD',
+    target_gender           VARCHAR(20)   NOT NULL DEFAULT 'all',
+    target_age_min          INTEGER,
+    target_age_max          INTEGER,
+    hair_types              JSONB,
+    requires_consultation   BOOLEAN       NOT NULL DEFAULT false,
+    required_tools          JSONB,
+    required_products       JSONB,
+    required_certifications JSONB,
+    allergen_warnings       JSONB,
+    health_precautions      JSONB,
+    requires_health_check   BOOLEAN       NOT NULL DEFAULT false,
+    image_url               TEXT,
+    gallery_images          JSONB,
+    video_url               TEXT,
+    tags                    JSONB,
+    search_keywords         JSONB,
+    meta_description        TEXT,
+    has_variations          BOOLEAN       NOT NULL DEFAULT false,
+    allows_add_ons          BOOLEAN       NOT NULL DEFAULT false,
+    global_popularity_score NUMERIC(6,2)  NOT NULL DEFAULT 0,
+    total_global_bookings   INTEGER       NOT NULL DEFAULT 0,
+    average_global_rating   NUMERIC(3,2)  NOT NULL DEFAULT 0,
+    total_global_reviews    INTEGER       NOT NULL DEFAULT 0,
+    is_active               BOOLEAN       NOT NULL DEFAULT true,
+    is_approved             BOOLEAN       NOT NULL DEFAULT false,
+    approval_notes          TEXT,
+    created_at              TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at              TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    created_by              INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    last_modified_by        INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    version                 INTEGER       NOT NULL DEFAULT 1,
+    change_log              JSONB
+);
+
+CREATE INDEX IF NOT EXISTS idx_services_category_id ON services(category_id);
+CREATE INDEX IF NOT EXISTS idx_services_is_active ON services(is_active);
+
+-- =============================================================================
+-- BARBERS
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS barbers (
+    id                           SERIAL PRIMARY KEY,
+    user_id                      INTEGER       NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    uuid                         VARCHAR(64)   NOT NULL UNIQUE DEFAULT gen_random_uuid()::text,
+    shop_name                    VARCHAR(150)  NOT NULL,
+    business_name                VARCHAR(200),
+    business_registration_number VARCHAR(100),
+    tax_id                       VARCHAR(100),
+    address                      TEXT          NOT NULL,
+    address_line_2               TEXT,
+    city                         VARCHAR(100)  NOT NULL,
+    state                        VARCHAR(100)  NOT NULL DEFAULT '',
+    country                      VARCHAR(100)  NOT NULL,
+    postal_code                  VARCHAR(20)   NOT NULL DEFAULT '',
+    latitude                     DOUBLE PRECISION,
+    longitude                    DOUBLE PRECISION,
+    phone                        VARCHAR(30),
+    business_email               VARCHAR(255),
+    website_url                  TEXT,
+    description                  TEXT,
+    years_experience             INTEGER,
+    specialties                  JSONB,
+    certifications               JSONB,
+    languages_spoken             JSONB,
+    profile_image_url            TEXT,
+    cover_image_url              TEXT,
+    gallery_images               JSONB,
+    working_hours                JSONB,
+    rating                       NUMERIC(3,2)  NOT NULL DEFAULT 0,
+    total_reviews                INTEGER       NOT NULL DEFAULT 0,
+    total_bookings               INTEGER       NOT NULL DEFAULT 0,
+    response_time_minutes        INTEGER       NOT NULL DEFAULT 0,
+    acceptance_rate              NUMERIC(5,2)  NOT NULL DEFAULT 0,
+    cancellation_rate            NUMERIC(5,2)  NOT NULL DEFAULT 0,
+    status                       VARCHAR(20)   NOT NULL DEFAULT 'pending',
+    is_verified                  BOOLEAN       NOT NULL DEFAULT false,
+    verification_date            TIMESTAMPTZ,
+    verification_notes           TEXT,
+    advance_booking_days         INTEGER       NOT NULL DEFAULT 30,
+    min_booking_notice_hours     INTEGER       NOT NULL DEFAULT 2,
+    auto_accept_bookings         BOOLEAN       NOT NULL DEFAULT false,
+    instant_booking_enabled      BOOLEAN       NOT NULL DEFAULT false,
+    commission_rate              NUMERIC(5,2)  NOT NULL DEFAULT 0,
+    payout_method                VARCHAR(30)   NOT NULL DEFAULT 'bank_transfer',
+    payout_details               JSONB,
+    created_at                   TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at                   TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    last_active_at               TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    deleted_at                   TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_barbers_status ON barbers(status);
+CREATE INDEX IF NOT EXISTS idx_barbers_city ON barbers(city);
+
+CREATE TABLE IF NOT EXISTS barber_services (
+    id                       SERIAL PRIMARY KEY,
+    barber_id                INTEGER       NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    service_id               INTEGER       NOT NULL REFERENCES services(id) ON DELETE CASCADE,
+    custom_name              VARCHAR(150),
+    custom_description       TEXT,
+    price                    NUMERIC(10,2) NOT NULL,
+    max_price                NUMERIC(10,2),
+    currency                 VARCHAR(3)    NOT NULL DEFAULT 'USD',
+    discount_price           NUMERIC(10,2),
+    discount_valid_until     TIMESTAMPTZ,
+    estimated_duration_min   INTEGER       NOT NULL,
+    estimated_duration_max   INTEGER,
+    buffer_time_minutes      INTEGER       NOT NULL DEFAULT 0,
+    advance_notice_hours     INTEGER       NOT NULL DEFAULT 0,
+    max_advance_booking_days INTEGER,
+    available_days           JSONB,
+    available_time_slots     JSONB,
+    requires_consultation    BOOLEAN,
+    consultation_duration    INTEGER,
+    pre_service_instructions TEXT,
+    post_service_care        TEXT,
+    min_customer_age         INTEGER,
+    max_customer_age         INTEGER,
+    is_seasonal              BOOLEAN       NOT NULL DEFAULT false,
+    seasonal_start_month     INTEGER,
+    seasonal_end_month       INTEGER,
+    portfolio_images         JSONB,
+    before_after_images      JSONB,
+    total_bookings           INTEGER       NOT NULL DEFAULT 0,
+    total_revenue            NUMERIC(12,2) NOT NULL DEFAULT 0,
+    average_rating           NUMERIC(3,2)  NOT NULL DEFAULT 0,
+    total_reviews            INTEGER       NOT NULL DEFAULT 0,
+    cancellation_rate        NUMERIC(5,2)  NOT NULL DEFAULT 0,
+    customer_satisfaction    NUMERIC(5,2)  NOT NULL DEFAULT 0,
+    repeat_customer_rate     NUMERIC(5,2)  NOT NULL DEFAULT 0,
+    bookings_last_30_days    INTEGER       NOT NULL DEFAULT 0,
+    revenue_last_30_days     NUMERIC(12,2) NOT NULL DEFAULT 0,
+    popularity_score         NUMERIC(6,2)  NOT NULL DEFAULT 0,
+    demand_level             NUMERIC(4,2)  NOT NULL DEFAULT 0,
+    is_promotional           BOOLEAN       NOT NULL DEFAULT false,
+    promotional_text         TEXT,
+    promotion_start_date     TIMESTAMPTZ,
+    promotion_end_date       TIMESTAMPTZ,
+    is_featured              BOOLEAN       NOT NULL DEFAULT false,
+    display_order            INTEGER       NOT NULL DEFAULT 0,
+    service_note             TEXT,
+    is_active                BOOLEAN       NOT NULL DEFAULT true,
+    paused_reason            TEXT,
+    paused_until             TIMESTAMPTZ,
+    created_at               TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at               TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    UNIQUE (barber_id, service_id)
+);
+
+CREATE TABLE IF NOT EXISTS barber_availability (
+    id                 SERIAL PRIMARY KEY,
+    barber_id          INTEGER     NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    date               DATE        NOT NULL,
+    day_of_week        INTEGER     NOT NULL,
+    start_time         TIME        NOT NULL,
+    end_time           TIME        NOT NULL,
+    availability_type  VARCHAR(20) NOT NULL DEFAULT 'available',
+    is_recurring       BOOLEAN     NOT NULL DEFAULT false,
+    recurring_pattern  VARCHAR(20),
+    recurring_end_date DATE,
+    notes              TEXT,
+    blocked_reason     TEXT,
+    created_at         TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at         TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_barber_availability_barber_id ON barber_availability(barber_id);
+
+CREATE TABLE IF NOT EXISTS time_slots (
+    id                       SERIAL PRIMARY KEY,
+    barber_id                INTEGER       NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    start_time               TIMESTAMPTZ   NOT NULL,
+    end_time                 TIMESTAMPTZ   NOT NULL,
+    duration_minutes         INTEGER       NOT NULL,
+    is_available             BOOLEAN       NOT NULL DEFAULT true,
+    slot_type                VARCHAR(20)   NOT NULL DEFAULT 'regular',
+    base_price               NUMERIC(10,2) NOT NULL DEFAULT 0,
+    dynamic_price            NUMERIC(10,2),
+    discount_percentage      NUMERIC(5,2)  NOT NULL DEFAULT 0,
+    service_id               INTEGER REFERENCES barber_services(id) ON DELETE SET NULL,
+    max_customers            INTEGER       NOT NULL DEFAULT 1,
+    min_advance_notice_hours INTEGER       NOT NULL DEFAULT 0,
+    notes                    TEXT,
+    special_requirements     JSONB,
+    created_at               TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at               TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    created_by               INTEGER REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- =============================================================================
+-- BOOKINGS
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS bookings (
+    id                         SERIAL PRIMARY KEY,
+    uuid                       VARCHAR(64)   NOT NULL UNIQUE DEFAULT gen_random_uuid()::text,
+    booking_number             VARCHAR(32)   NOT NULL UNIQUE,
+    customer_id                INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    barber_id                  INTEGER       NOT NULL REFERENCES barbers(id),
+    time_slot_id               INTEGER REFERENCES time_slots(id) ON DELETE SET NULL,
+    service_name               VARCHAR(150)  NOT NULL,
+    service_category           VARCHAR(100),
+    estimated_duration_minutes INTEGER       NOT NULL,
+    customer_name              VARCHAR(100),
+    customer_email             VARCHAR(255),
+    customer_phone             VARCHAR(30),
+    status                     VARCHAR(30)   NOT NULL DEFAULT 'pending',
+    service_price              NUMERIC(10,2) NOT NULL DEFAULT 0,
+    total_price                NUMERIC(10,2) NOT NULL DEFAULT 0,
+    discount_amount            NUMERIC(10,2) NOT NULL DEFAULT 0,
+    tax_amount                 NUMERIC(10,2) NOT NULL DEFAULT 0,
+    tip_amount                 NUMERIC(10,2) NOT NULL DEFAULT 0,
+    currency                   VARCHAR(3)    NOT NULL DEFAULT 'USD',
+    payment_status             VARCHAR(20)   NOT NULL DEFAULT 'pending',
+    payment_method             VARCHAR(30),
+    payment_reference          VARCHAR(255),
+    paid_at                    TIMESTAMPTZ,
+    notes                      TEXT,
+    special_requests           TEXT,
+    internal_notes             TEXT,
+    confirmation_method        VARCHAR(20),
+    confirmation_sent_at       TIMESTAMPTZ,
+    reminder_sent_at           TIMESTAMPTZ,
+    scheduled_start_time       TIMESTAMPTZ   NOT NULL,
+    scheduled_end_time         TIMESTAMPTZ   NOT NULL,
+    actual_start_time          TIMESTAMPTZ,
+    actual_end_time            TIMESTAMPTZ,
+    cancelled_at               TIMESTAMPTZ,
+    cancelled_by               INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    cancellation_reason        TEXT,
+    cancellation_fee           NUMERIC(10,2) NOT NULL DEFAULT 0,
+    booking_source             VARCHAR(20)   NOT NULL DEFAULT 'web_app',
+    referral_source            VARCHAR(100),
+    utm_campaign               VARCHAR(100),
+    ml_prediction_score        NUMERIC(5,4),
+    customer_segment           VARCHAR(50),
+    booking_value_score        NUMERIC(6,2),
+    created_at                 TIMESTAMPTZ   NOT NULL DEFAULT NOW(),
+    updated_at                 TIMESTAMPTZ   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_bookings_customer_id ON bookings(customer_id);
+CREATE INDEX IF NOT EXISTS idx_bookings_barber_id ON bookings(barber_id);
+CREATE INDEX IF NOT EXISTS idx_bookings_status ON bookings(status);
+CREATE INDEX IF NOT EXISTS idx_bookings_scheduled_start_time ON bookings(scheduled_start_time);
+CREATE INDEX IF NOT EXISTS idx_bookings_barber_schedule ON bookings(barber_id, scheduled_start_time, scheduled_end_time);
+
+CREATE TABLE IF NOT EXISTS booking_history (
+    id            SERIAL PRIMARY KEY,
+    booking_id    INTEGER     NOT NULL REFERENCES bookings(id) ON DELETE CASCADE,
+    changed_by    INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    change_type   VARCHAR(50) NOT NULL,
+    old_values    JSONB,
+    new_values    JSONB,
+    change_reason TEXT,
+    ip_address    VARCHAR(64),
+    user_agent    TEXT,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_booking_history_booking_id ON booking_history(booking_id);
+
+-- =============================================================================
+-- REVIEWS
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS reviews (
+    id                     SERIAL PRIMARY KEY,
+    booking_id             INTEGER     NOT NULL UNIQUE REFERENCES bookings(id) ON DELETE CASCADE,
+    customer_id            INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    barber_id              INTEGER     NOT NULL REFERENCES barbers(id) ON DELETE CASCADE,
+    overall_rating         INTEGER     NOT NULL,
+    service_quality_rating INTEGER,
+    punctuality_rating     INTEGER,
+    cleanliness_rating     INTEGER,
+    value_for_money_rating INTEGER,
+    professionalism_rating INTEGER,
+    title                  VARCHAR(200),
+    comment                TEXT,
+    pros                   TEXT,
+    cons                   TEXT,
+    would_recommend        BOOLEAN,
+    would_book_again       BOOLEAN,
+    service_as_expected    BOOLEAN,
+    duration_accurate      BOOLEAN,
+    images                 JSONB,
+    is_verified            BOOLEAN     NOT NULL DEFAULT false,
+    is_published           BOOLEAN     NOT NULL DEFAULT false,
+    moderation_status      VARCHAR(20) NOT NULL DEFAULT 'pending',
+    moderation_notes       TEXT,
+    moderated_by           INTEGER REFERENCES users(id) ON DELETE SET NULL,
+    moderated_at           TIMESTAMPTZ,
+    helpful_votes          INTEGER     NOT NULL DEFAULT 0,
+    total_votes            INTEGER     NOT NULL DEFAULT 0,
+    barber_response        TEXT,
+    barber_response_at     TIMESTAMPTZ,
+    created_at             TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at             TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviews_barber_id ON reviews(barber_id);
+CREATE INDEX IF NOT EXISTS idx_reviews_customer_id ON reviews(customer_id);
+CREATE INDEX IF NOT EXISTS idx_reviews_is_published ON reviews(is_published);
+
+-- =============================================================================
+-- NOTIFICATIONS
+-- =============================================================================
+
+CREATE TABLE IF NOT EXISTS notifications (
+    id                  SERIAL PRIMARY KEY,
+    user_id             INTEGER      NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title               VARCHAR(255) NOT NULL,
+    message             TEXT         NOT NULL,
+    type                VARCHAR(50)  NOT NULL,
+    channels            JSONB,
+    status              VARCHAR(20)  NOT NULL DEFAULT 'pending',
+    sent_at             TIMESTAMPTZ,
+    delivered_at        TIMESTAMPTZ,
+    read_at             TIMESTAMPTZ,
+    related_entity_type VARCHAR(50),
+    related_entity_id   INTEGER,
+    data                JSONB,
+    priority            VARCHAR(20)  NOT NULL DEFAULT 'normal',
+    scheduled_for       TIMESTAMPTZ,
+    expires_at          TIMESTAMPTZ,
+    created_at          TIMESTAMPTZ  NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notifications_user_id ON notifications(user_id);
+CREATE INDEX IF NOT EXISTS idx_notifications_status ON notifications(status);
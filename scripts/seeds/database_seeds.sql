@@ -1,3 +1,93 @@
+-- Database Seed Scripts for Barbershop Application
+-- Run these scripts in order to populate your database with sample data
+
+-- =============================================================================
+-- 1. USERS SEED DATA
+-- =============================================================================
+
+-- Insert sample users (customers, barbers, admins)
+INSERT INTO users (uuid, email, password_hash, name, phone, user_type, status, email_verified, phone_verified, date_of_birth, gender, profile_picture_url, address, city, state, country, postal_code, latitude, longitude, preferences, notification_settings, created_at, updated_at, last_login_at) VALUES
+
+-- Admin Users
+('550e8400-e29b-41d4-a716-446655440001', 'admin@barbershop.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'System Administrator', '+1-555-0101', 'admin', 'active', true, true, '1985-03-15', 'male', 'https://images.unsplash.com/photo-1507003211169-0a1dd7228f2d?w=150', '123 Admin St', 'New York', 'NY', 'USA', '10001', 40.7128, -74.0060, '{"theme": "dark", "language": "en"}', '{"email": true, "sms": true, "push": true}', NOW() - INTERVAL '30 days', NOW(), NOW() - INTERVAL '1 day'),
+
+-- Customer Users
+('550e8400-e29b-41d4-a716-446655440002', 'john.doe@email.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'John Doe', '+1-555-0102', 'customer', 'active', true, true, '1990-07-22', 'male', 'https://images.unsplash.com/photo-1472099645785-5658abf4ff4e?w=150', '456 Main St', 'New York', 'NY', 'USA', '10002', 40.7589, -73.9851, '{"preferred_barber": 1, "hair_type": "straight"}', '{"email": true, "sms": false, "push": true}', NOW() - INTERVAL '15 days', NOW(), NOW() - INTERVAL '2 hours'),
+
+('550e8400-e29b-41d4-a716-446655440003', 'sarah.wilson@email.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'Sarah Wilson', '+1-555-0103', 'customer', 'active', true, false, '1988-11-08', 'female', 'https://images.unsplash.com/photo-1494790108755-2616b612b6e5?w=150', '789 Oak Ave', 'Brooklyn', 'NY', 'USA', '11201', 40.6892, -73.9442, '{"hair_type": "curly", "preferred_style": "modern"}', '{"email": true, "sms": true, "push": false}', NOW() - INTERVAL '10 days', NOW(), NOW() - INTERVAL '1 hour'),
+
+('550e8400-e29b-41d4-a716-446655440004', 'mike.johnson@email.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'Mike Johnson', '+1-555-0104', 'customer', 'active', true, true, '1995-02-14', 'male', 'https://images.unsplash.com/photo-1507003211169-0a1dd7228f2d?w=150', '321 Pine St', 'Manhattan', 'NY', 'USA', '10003', 40.7505, -73.9934, '{"hair_type": "thick", "beard_style": "full"}', '{"email": false, "sms": true, "push": true}', NOW() - INTERVAL '5 days', NOW(), NOW() - INTERVAL '30 minutes'),
+
+-- Barber Users
+('550e8400-e29b-41d4-a716-446655440005', 'tony.soprano@barbershop.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'Tony Soprano', '+1-555-0105', 'barber', 'active', true, true, '1980-09-12', 'male', 'https://images.unsplash.com/photo-1521119989659-a83eee488004?w=150', '100 Barber Row', 'New York', 'NY', 'USA', '10001', 40.7128, -74.0060, '{"specialties": ["classic_cuts", "beard_styling"], "languages": ["en", "it"]}', '{"email": true, "sms": true, "push": true}', NOW() - INTERVAL '20 days', NOW(), NOW() - INTERVAL '15 minutes'),
+
+('550e8400-e29b-41d4-a716-446655440006', 'maria.gonzalez@barbershop.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'Maria Gonzalez', '+1-555-0106', 'barber', 'active', true, true, '1987-04-03', 'female', 'https://images.unsplash.com/photo-1580618672591-eb180b1a973f?w=150', '200 Style Ave', 'Brooklyn', 'NY', 'USA', '11201', 40.6892, -73.9442, '{"specialties": ["modern_cuts", "color"], "languages": ["en", "es"]}', '{"email": true, "sms": false, "push": true}', NOW() - INTERVAL '25 days', NOW(), NOW() - INTERVAL '1 hour'),
+
+('550e8400-e29b-41d4-a716-446655440007', 'david.kim@barbershop.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'David Kim', '+1-555-0107', 'barber', 'active', true, true, '1985-12-18', 'male', 'https://images.unsplash.com/photo-1507003211169-0a1dd7228f2d?w=150', '300 Trend St', 'Queens', 'NY', 'USA', '11101', 40.7505, -73.9934, '{"specialties": ["asian_styles", "precision_cuts"], "languages": ["en", "ko"]}', '{"email": true, "sms": true, "push": true}', NOW() - INTERVAL '18 days', NOW(), NOW() - INTERVAL '30 minutes');
+
+-- =============================================================================
+-- 2. SERVICE CATEGORIES SEED DATA
+-- =============================================================================
+
+INSERT INTO service_categories (name, slug, description, parent_category_id, level, category_path, icon_url, color_hex, image_url, sort_order, is_active, is_featured, meta_title, meta_description, keywords, service_count, barber_count, average_price, popularity_score, created_at, updated_at) VALUES
+
+-- Main Categories
+('Haircuts', 'haircuts', 'Professional hair cutting services for all styles and preferences', NULL, 1, 'haircuts', 'https://cdn.barbershop.com/icons/haircut.svg', '#2563EB', 'https://images.unsplash.com/photo-1622286346003-c3748d7d2c34?w=300', 1, true, true, 'Professional Haircuts | Barbershop', 'Expert haircut services including classic, modern, and trendy styles', '["haircut", "styling", "mens", "womens"]', 8, 15, 35.00, 95.5, NOW(), NOW()),
+
+('Beard & Mustache', 'beard-mustache', 'Expert beard trimming, shaping, and mustache styling services', NULL, 1, 'beard-mustache', 'https://cdn.barbershop.com/icons/beard.svg', '#DC2626', 'https://images.unsplash.com/photo-1621605815971-fbc98d665033?w=300', 2, true, true, 'Beard & Mustache Services | Barbershop', 'Professional beard trimming and mustache styling services', '["beard", "mustache", "trimming", "shaping"]', 5, 12, 25.00, 88.2, NOW(), NOW()),
+
+('Hair Styling', 'hair-styling', 'Creative hair styling and special occasion styling services', NULL, 1, 'hair-styling', 'https://cdn.barbershop.com/icons/styling.svg', '#7C3AED', 'https://images.unsplash.com/photo-1562004760-acb5f2f1dfef?w=300', 3, true, true, 'Hair Styling Services | Barbershop', 'Professional hair styling for special occasions and everyday looks', '["styling", "formal", "special", "occasion"]', 4, 8, 45.00, 78.9, NOW(), NOW()),
+
+('Hair Treatments', 'hair-treatments', 'Therapeutic hair and scalp treatments for hair health', NULL, 1, 'hair-treatments', 'https://cdn.barbershop.com/icons/treatment.svg', '#059669', 'https://images.unsplash.com/photo-1560066984-138dadb4c035?w=300', 4, true, false, 'Hair Treatments | Barbershop', 'Professional hair and scalp treatments for optimal hair health', '["treatment", "scalp", "therapy", "health"]', 3, 5, 60.00, 65.4, NOW(), NOW()),
+
+-- Sub Categories
+('Classic Cuts', 'classic-cuts', 'Traditional and timeless haircut styles', 1, 2, 'haircuts/classic-cuts', NULL, '#1E40AF', NULL, 1, true, false, NULL, NULL, '["classic", "traditional", "timeless"]', 3, 10, 30.00, 85.2, NOW(), NOW()),
+
+('Modern Cuts', 'modern-cuts', 'Contemporary and trendy haircut styles', 1, 2, 'haircuts/modern-cuts', NULL, '#3B82F6', NULL, 2, true, true, NULL, NULL, '["modern", "trendy", "contemporary"]', 5, 12, 40.00, 92.8, NOW(), NOW());
+
+-- =============================================================================
+-- 3. SERVICES SEED DATA (Global Catalog)
+-- =============================================================================
+
+INSERT INTO services (uuid, name, slug, short_description, detailed_description, category_id, service_type, complexity, skill_level_required, default_duration_min, default_duration_max, suggested_price_min, suggested_price_max, currency, target_gender, target_age_min, target_age_max, hair_types, requires_consultation, required_tools, required_products, required_certifications, allergen_warnings, health_precautions, requires_health_check, image_url, gallery_images, video_url, tags, search_keywords, meta_description, has_variations, allows_add_ons, global_popularity_score, total_global_bookings, average_global_rating, total_global_reviews, is_active, is_approved, approval_notes, created_at, updated_at, created_by, last_modified_by, version, change_log) VALUES
+
+-- Haircut Services
+('service-uuid-001', 'Classic Business Cut', 'classic-business-cut', 'Professional business haircut for the modern gentleman', 'A timeless, professional haircut perfect for business environments. Includes precision cutting, styling, and finishing touches for a polished look that commands respect.', 5, 'haircut', 3, 'intermediate', 30, 45, 25.00, 40.00, 'USD', 'male', 18, 65, '["straight", "wavy", "thick", "all"]', false, '["scissors", "comb", "clippers", "styling_brush"]', '["shampoo", "conditioner", "styling_gel"]', '[]', '[]', '[]', false, 'https://images.unsplash.com/photo-1622286346003-c3748d7d2c34?w=400', '["https://images.unsplash.com/photo-1622286346003-c3748d7d2c34?w=400"]', NULL, '["classic", "business", "professional", "conservative"]', '["business cut", "professional haircut", "classic style"]', 'Professional classic business haircut for men', true, true, 92.5, 2847, 4.6, 1205, true, true, 'Popular classic style', NOW() - INTERVAL '60 days', NOW(), 1, 1, 1, '{"initial": "Service created"}'),
+
+('service-uuid-002', 'Modern Fade Cut', 'modern-fade-cut', 'Trendy fade haircut with modern styling', 'Contemporary fade cut featuring gradual length transition from short sides to longer top. Customizable fade height and styling options for a fresh, modern look.', 6, 'haircut', 4, 'advanced', 45, 60, 35.00, 55.00, 'USD', 'male', 16, 45, '["straight", "wavy", "curly", "all"]', true, '["clippers", "scissors", "razor", "comb", "styling_brush"]', '["shampoo", "pomade", "hair_wax"]', '[]', '[]', '[]', false, 'https://images.unsplash.com/photo-1503951914875-452162b0f3f1?w=400', '["https://images.unsplash.com/photo-1503951914875-452162b0f3f1?w=400"]', 'https://youtube.com/watch?v=fade-tutorial', '["modern", "fade", "trendy", "gradient"]', '["fade cut", "modern fade", "skin fade"]', 'Modern fade haircut with precision styling', true, true, 96.8, 3652, 4.8, 1847, true, true, 'Highly requested modern style', NOW() - INTERVAL '45 days', NOW(), 1, 1, 1, '{"initial": "Service created"}'),
+
+('service-uuid-003', 'Beard Trim & Shape', 'beard-trim-shape', 'Professional beard trimming and shaping service', 'Expert beard trimming and shaping to maintain your desired look. Includes precise trimming, edge work, and styling with premium beard products.', 2, 'grooming', 3, 'intermediate', 20, 30, 15.00, 30.00, 'USD', 'male', 18, 70, '["all"]', false, '["beard_trimmer", "scissors", "razor", "comb"]', '["beard_oil", "beard_balm", "aftershave"]', '[]', '["fragrance"]', '["skin_sensitivity"]', false, 'https://images.unsplash.com/photo-1621605815971-fbc98d665033?w=400', '["https://images.unsplash.com/photo-1621605815971-fbc98d665033?w=400"]', NULL, '["beard", "trim", "shape", "grooming"]', '["beard trim", "beard shaping", "facial hair"]', 'Professional beard trimming and shaping service', true, true, 89.2, 1956, 4.5, 876, true, true, 'Essential grooming service', NOW() - INTERVAL '30 days', NOW(), 1, 1, 1, '{"initial": "Service created"}'),
+
+('service-uuid-004', 'Hot Towel Shave', 'hot-towel-shave', 'Traditional hot towel straight razor shave', 'Classic barbershop experience with hot towel treatment, premium shaving cream, and straight razor shave. Includes aftershave treatment and moisturizing.', 2, 'grooming', 5, 'expert', 45, 60, 40.00, 70.00, 'USD', 'male', 18, 80, '["all"]', true, '["straight_razor", "hot_towels", "shaving_brush", "strop"]', '["shaving_cream", "pre_shave_oil", "aftershave", "moisturizer"]', '["barbering_license"]', '["fragrance", "lanolin"]', '["skin_sensitivity", "blood_thinners"]', true, 'https://images.unsplash.com/photo-1585747860715-2ba37e788b70?w=400', '["https://images.unsplash.com/photo-1585747860715-2ba37e788b70?w=400"]', NULL, '["traditional", "shave", "hot_towel", "luxury"]', '["hot towel shave", "straight razor", "traditional shave"]', 'Traditional hot towel straight razor shave experience', false, true, 78.4, 892, 4.9, 425, true, true, 'Premium traditional service', NOW() - INTERVAL '20 days', NOW(), 1, 1, 1, '{"initial": "Service created"}'),
+
+('service-uuid-005', 'Kids Haircut', 'kids-haircut', 'Fun and gentle haircuts for children', 'Child-friendly haircut service with patient approach and fun atmosphere. Includes basic styling and can accommodate fidgety children with toys and entertainment.', 1, 'haircut', 2, 'beginner', 20, 30, 15.00, 25.00, 'USD', 'all', 3, 17, '["straight", "wavy", "curly", "all"]', false, '["scissors", "clippers", "comb", "cape"]', '["mild_shampoo", "detangling_spray"]', '[]', '[]', '[]', false, 'https://images.unsplash.com/photo-1564463489817-3f6eccb47b89?w=400', '["https://images.unsplash.com/photo-1564463489817-3f6eccb47b89?w=400"]', NULL, '["kids", "children", "gentle", "fun"]', '["kids haircut", "children haircut", "child friendly"]', 'Fun and gentle haircuts for children of all ages', true, false, 85.7, 1653, 4.4, 743, true, true, 'Family-friendly service', NOW() - INTERVAL '40 days', NOW(), 1, 1, 1, '{"initial": "Service created"}'),
+
+('service-uuid-006', 'Hair Wash & Style', 'hair-wash-style', 'Complete hair washing and styling service', 'Thorough hair washing with premium products followed by professional styling. Perfect for special occasions or regular maintenance.', 3, 'styling', 2, 'intermediate', 30, 45, 20.00, 35.00, 'USD', 'all', 12, 80, '["straight", "wavy", "curly", "coily", "all"]', false, '["shampoo_bowl", "hair_dryer", "styling_brush", "curling_iron"]', '["professional_shampoo", "conditioner", "styling_mousse", "hairspray"]', '[]', '["sulfates", "parabens"]', '["scalp_sensitivity"]', false, 'https://images.unsplash.com/photo-1562004760-acb5f2f1dfef?w=400', '["https://images.unsplash.com/photo-1562004760-acb5f2f1dfef?w=400"]', NULL, '["wash", "style", "maintenance", "care"]', '["hair wash", "styling", "hair care"]', 'Professional hair washing and styling service', true, true, 82.3, 1247, 4.3, 567, true, true, 'Regular maintenance service', NOW() - INTERVAL '35 days', NOW(), 1, 1, 1, '{"initial": "Service created"}');
+
+-- =============================================================================
+-- 4. BARBERS SEED DATA
+-- =============================================================================
+
+INSERT INTO barbers (user_id, uuid, shop_name, business_name, business_registration_number, tax_id, address, address_line_2, city, state, country, postal_code, latitude, longitude, phone, business_email, website_url, description, years_experience, specialties, certifications, languages_spoken, profile_image_url, cover_image_url, gallery_images, working_hours, rating, total_reviews, total_bookings, response_time_minutes, acceptance_rate, cancellation_rate, status, is_verified, verification_date, verification_notes, advance_booking_days, min_booking_notice_hours, auto_accept_bookings, instant_booking_enabled, commission_rate, payout_method, payout_details, created_at, updated_at, last_active_at) VALUES
+
+-- Tony's Classic Barbershop
+(5, '550e8400-e29b-41d4-a716-446655440101', 'Tony''s Classic Barbershop', 'Antonio Soprano Barber Services LLC', 'LLC2023001', 'TAX123456789', '100 Barber Row', 'Suite 101', 'New York', 'NY', 'USA', '10001', 40.7128, -74.0060, '+1-555-0105', 'tony@classicbarbershop.com', 'https://tonysclassicbarbershop.com', 'Traditional Italian barbershop offering classic cuts, hot towel shaves, and authentic grooming services. Family-owned business with 15+ years of experience serving NYC.', 15, '["classic_cuts", "hot_towel_shave", "beard_styling", "traditional_grooming"]', '["Master_Barber_Certificate", "Traditional_Shaving_Certification"]', '["English", "Italian"]', 'https://images.unsplash.com/photo-1521119989659-a83eee488004?w=300', 'https://images.unsplash.com/photo-1585747860715-2ba37e788b70?w=800', '["https://images.unsplash.com/photo-1585747860715-2ba37e788b70?w=400", "https://images.unsplash.com/photo-1622286346003-c3748d7d2c34?w=400", "https://images.unsplash.com/photo-1621605815971-fbc98d665033?w=400"]', '{"monday": {"open": "09:00", "close": "18:00"}, "tuesday": {"open": "09:00", "close": "18:00"}, "wednesday": {"open": "09:00", "close": "18:00"}, "thursday": {"open": "09:00", "close": "19:00"}, "friday": {"open": "09:00", "close": "19:00"}, "saturday": {"open": "08:00", "close": "17:00"}, "sunday": {"closed": true}}', 4.8, 127, 892, 15, 94.5, 3.2, 'active', true, NOW() - INTERVAL '25 days', 'Excellent traditional barber with strong customer base', 30, 2, false, true, 15.0, 'bank_transfer', '{"bank": "Chase Bank", "account_type": "business_checking"}', NOW() - INTERVAL '20 days', NOW(), NOW() - INTERVAL '15 minutes'),
+
+-- Maria's Modern Salon
+(6, '550e8400-e29b-41d4-a716-446655440102', 'Maria''s Modern Salon', 'Maria Gonzalez Hair Studio Inc', 'INC2023002', 'TAX987654321', '200 Style Ave', 'Floor 2', 'Brooklyn', 'NY', 'USA', '11201', 40.6892, -73.9442, '+1-555-0106', 'maria@modernstyles.com', 'https://mariasmodernsalon.com', 'Contemporary hair salon specializing in modern cuts, color treatments, and creative styling. Bilingual service with focus on latest trends and techniques.', 8, '["modern_cuts", "color_treatments", "creative_styling", "womens_cuts"]', '["Cosmetology_License", "Color_Specialist_Certification", "Balayage_Expert"]', '["English", "Spanish"]', 'https://images.unsplash.com/photo-1580618672591-eb180b1a973f?w=300', 'https://images.unsplash.com/photo-1562004760-acb5f2f1dfef?w=800', '["https://images.unsplash.com/photo-1562004760-acb5f2f1dfef?w=400", "https://images.unsplash.com/photo-1503951914875-452162b0f3f1?w=400", "https://images.unsplash.com/photo-1560066984-138dadb4c035?w=400"]', '{"monday": {"closed": true}, "tuesday": {"open": "10:00", "close": "19:00"}, "wednesday": {"open": "10:00", "close": "19:00"}, "thursday": {"open": "10:00", "close": "20:00"}, "friday": {"open": "10:00", "close": "20:00"}, "saturday": {"open": "09:00", "close": "18:00"}, "sunday": {"open": "11:00", "close": "16:00"}}', 4.6, 89, 654, 25, 89.2, 5.1, 'active', true, NOW() - INTERVAL '30 days', 'Talented stylist with modern approach', 45, 4, true, false, 18.0, 'paypal', '{"email": "maria.payments@email.com"}', NOW() - INTERVAL '25 days', NOW(), NOW() - INTERVAL '1 hour'),
+
+-- David's Precision Cuts
+(7, '550e8400-e29b-41d4-a716-446655440103', 'David''s Precision Cuts', 'DK Hair Design Studio', 'LLC2023003', 'TAX456789123', '300 Trend St', NULL, 'Queens', 'NY', 'USA', '11101', 40.7505, -73.9934, '+1-555-0107', 'david@precisioncuts.com', 'https://davidsprecisioncuts.com', 'Precision cutting specialist focusing on Asian hair types and modern styling techniques. Attention to detail and personalized service guaranteed.', 10, '["precision_cuts", "asian_hair_specialist", "modern_styling", "fade_expert"]', '["Advanced_Cutting_Certification", "Asian_Hair_Specialist", "Fade_Master"]', '["English", "Korean", "Mandarin"]', 'https://images.unsplash.com/photo-1507003211169-0a1dd7228f2d?w=300', 'https://images.unsplash.com/photo-1503951914875-452162b0f3f1?w=800', '["https://images.unsplash.com/photo-1503951914875-452162b0f3f1?w=400", "https://images.unsplash.com/photo-1622286346003-c3748d7d2c34?w=400"]', '{"monday": {"open": "10:00", "close": "18:00"}, "tuesday": {"open": "10:00", "close": "18:00"}, "wednesday": {"open": "10:00", "close": "18:00"}, "thursday": {"open": "10:00", "close": "19:00"}, "friday": {"open": "10:00", "close": "19:00"}, "saturday": {"open": "09:00", "close": "17:00"}, "sunday": {"closed": true}}', 4.9, 156, 1123, 12, 96.8, 2.1, 'active', true, NOW() - INTERVAL '18 days', 'Exceptional precision and technique', 60, 3, false, true, 20.0, 'stripe', '{"account_id": "acct_1234567890"}', NOW() - INTERVAL '18 days', NOW(), NOW() - INTERVAL '30 minutes');
+
+-- =============================================================================
+-- 5. BARBER SERVICES SEED DATA
+-- =============================================================================
+
+INSERT INTO barber_services (barber_id, service_id, custom_name, custom_description, price, max_price, currency, discount_price, discount_valid_until, estimated_duration_min, estimated_duration_max, buffer_time_minutes, advance_notice_hours, max_advance_booking_days, available_days, available_time_slots, requires_consultation, consultation_duration, pre_service_instructions, post_service_care, min_customer_age, max_customer_age, is_seasonal, seasonal_start_month, seasonal_end_month, portfolio_images, before_after_images, total_bookings, total_revenue, average_rating, total_reviews, cancellation_rate, customer_satisfaction, repeat_customer_rate, bookings_last_30_days, revenue_last_30_days, popularity_score, demand_level, is_promotional, promotional_text, promotion_start_date, promotion_end_date, is_featured, display_order, service_note, is_active, paused_reason, paused_until, created_at, updated_at) VALUES
+
+-- Tony's Services
+(1, 1, 'Executive Business Cut', 'Premium business haircut with traditional Italian styling techniques', 45.00, 55.00, 'USD', 40.00, NOW() + INTERVAL '7 days', 35, 45, 15, 2, 30, '["monday", "tuesday", "wednesday", "thursday", "friday", "saturday"]', '{}', false, NULL, 'Please arrive with clean, dry hair', 'Avoid washing hair for 24 hours for best styling results', 16, 65, false, NULL, NULL, '["https://images.unsplash.com/photo-1622286346003-c3748d7d2c34?w=400"]', '["https://before-after-1.jpg", "https://before-after-2.jpg"]', 234, 10530.00, 4.8, 89, 2.1, 96.5, 78.2, 18, 810.00, 94.5, 0.8, true, 'Limited time: $5 off executive cuts!', NOW(), NOW() + INTERVAL '7 days', true, 1, 'Signature service with complimentary hot towel', true, NULL, NULL, NOW() - INTERVAL '20 days', NOW()),
+
 (1, 3, 'Traditional Beard Sculpting', 'Artisanal beard trimming using traditional Italian techniques', 30.00, 40.00, 'USD', NULL, NULL, 25, 35, 10, 2, 30, '["monday", "tuesday", "wednesday", "thursday", "friday", "saturday"]', '{}', false, NULL, 'Come with at least 2 weeks of beard growth', 'Apply provided beard oil daily', 18, 70, false, NULL, NULL, '["https://images.unsplash.com/photo-1621605815971-fbc98d665033?w=400"]', '["https://beard-before-after-1.jpg"]', 156, 4680.00, 4.7, 67, 3.2, 94.1, 82.4, 12, 360.00, 89.2, 0.7, false, NULL, NULL, NULL, false, 2, 'Includes premium beard oil treatment', true, NULL, NULL, NOW() - INTERVAL '20 days', NOW()),
 
 (1, 4, 'Authentic Italian Hot Towel Shave', 'Traditional straight razor shave with family recipe pre-shave oils', 65.00, 75.00, 'USD', NULL, NULL, 50, 60, 20, 4, 14, '["tuesday", "wednesday", "thursday", "friday", "saturday"]', '{}', true, 15, 'No caffeine 2 hours before appointment', 'Avoid sun exposure for 24 hours', 18, 80, false, NULL, NULL, '["https://images.unsplash.com/photo-1585747860715-2ba37e788b70?w=400"]', '[]', 89, 5785.00, 4.9, 42, 1.1, 98.8, 71.4, 7, 455.00, 78.4, 0.9, false, NULL, NULL, NULL, true, 3, 'Premium service, requires consultation', true, NULL, NULL, NOW() - INTERVAL '20 days', NOW()),
@@ -276,7 +366,7 @@ CREATE INDEX IF NOT EXISTS idx_notifications_status ON notifications(status);
 -- SEED SCRIPT COMPLETION MESSAGE
 -- =============================================================================
 
-DO $
+DO $$
 BEGIN
     RAISE NOTICE '=============================================================================';
     RAISE NOTICE 'DATABASE SEEDING COMPLETED SUCCESSFULLY!';
@@ -313,94 +403,4 @@ BEGIN
     RAISE NOTICE '=============================================================================';
     RAISE NOTICE 'Your barbershop database is ready for testing!';
     RAISE NOTICE '=============================================================================';
-END $;-- Database Seed Scripts for Barbershop Application
--- Run these scripts in order to populate your database with sample data
-
--- =============================================================================
--- 1. USERS SEED DATA
--- =============================================================================
-
--- Insert sample users (customers, barbers, admins)
-INSERT INTO users (uuid, email, password_hash, name, phone, user_type, status, email_verified, phone_verified, date_of_birth, gender, profile_picture_url, address, city, state, country, postal_code, latitude, longitude, preferences, notification_settings, created_at, updated_at, last_login_at) VALUES
-
--- Admin Users
-('550e8400-e29b-41d4-a716-446655440001', 'admin@barbershop.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'System Administrator', '+1-555-0101', 'admin', 'active', true, true, '1985-03-15', 'male', 'https://images.unsplash.com/photo-1507003211169-0a1dd7228f2d?w=150', '123 Admin St', 'New York', 'NY', 'USA', '10001', 40.7128, -74.0060, '{"theme": "dark", "language": "en"}', '{"email": true, "sms": true, "push": true}', NOW() - INTERVAL '30 days', NOW(), NOW() - INTERVAL '1 day'),
-
--- Customer Users
-('550e8400-e29b-41d4-a716-446655440002', 'john.doe@email.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'John Doe', '+1-555-0102', 'customer', 'active', true, true, '1990-07-22', 'male', 'https://images.unsplash.com/photo-1472099645785-5658abf4ff4e?w=150', '456 Main St', 'New York', 'NY', 'USA', '10002', 40.7589, -73.9851, '{"preferred_barber": 1, "hair_type": "straight"}', '{"email": true, "sms": false, "push": true}', NOW() - INTERVAL '15 days', NOW(), NOW() - INTERVAL '2 hours'),
-
-('550e8400-e29b-41d4-a716-446655440003', 'sarah.wilson@email.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'Sarah Wilson', '+1-555-0103', 'customer', 'active', true, false, '1988-11-08', 'female', 'https://images.unsplash.com/photo-1494790108755-2616b612b6e5?w=150', '789 Oak Ave', 'Brooklyn', 'NY', 'USA', '11201', 40.6892, -73.9442, '{"hair_type": "curly", "preferred_style": "modern"}', '{"email": true, "sms": true, "push": false}', NOW() - INTERVAL '10 days', NOW(), NOW() - INTERVAL '1 hour'),
-
-('550e8400-e29b-41d4-a716-446655440004', 'mike.johnson@email.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'Mike Johnson', '+1-555-0104', 'customer', 'active', true, true, '1995-02-14', 'male', 'https://images.unsplash.com/photo-1507003211169-0a1dd7228f2d?w=150', '321 Pine St', 'Manhattan', 'NY', 'USA', '10003', 40.7505, -73.9934, '{"hair_type": "thick", "beard_style": "full"}', '{"email": false, "sms": true, "push": true}', NOW() - INTERVAL '5 days', NOW(), NOW() - INTERVAL '30 minutes'),
-
--- Barber Users
-('550e8400-e29b-41d4-a716-446655440005', 'tony.soprano@barbershop.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'Tony Soprano', '+1-555-0105', 'barber', 'active', true, true, '1980-09-12', 'male', 'https://images.unsplash.com/photo-1521119989659-a83eee488004?w=150', '100 Barber Row', 'New York', 'NY', 'USA', '10001', 40.7128, -74.0060, '{"specialties": ["classic_cuts", "beard_styling"], "languages": ["en", "it"]}', '{"email": true, "sms": true, "push": true}', NOW() - INTERVAL '20 days', NOW(), NOW() - INTERVAL '15 minutes'),
-
-('550e8400-e29b-41d4-a716-446655440006', 'maria.gonzalez@barbershop.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'Maria Gonzalez', '+1-555-0106', 'barber', 'active', true, true, '1987-04-03', 'female', 'https://images.unsplash.com/photo-1580618672591-eb180b1a973f?w=150', '200 Style Ave', 'Brooklyn', 'NY', 'USA', '11201', 40.6892, -73.9442, '{"specialties": ["modern_cuts", "color"], "languages": ["en", "es"]}', '{"email": true, "sms": false, "push": true}', NOW() - INTERVAL '25 days', NOW(), NOW() - INTERVAL '1 hour'),
-
-('550e8400-e29b-41d4-a716-446655440007', 'david.kim@barbershop.com', '$2a$12$LQv3c1yqBWVHxkd0LHAkCOYz6TtxMQJqhN8/LeENZW3D7gVrK5ZK.', 'David Kim', '+1-555-0107', 'barber', 'active', true, true, '1985-12-18', 'male', 'https://images.unsplash.com/photo-1507003211169-0a1dd7228f2d?w=150', '300 Trend St', 'Queens', 'NY', 'USA', '11101', 40.7505, -73.9934, '{"specialties": ["asian_styles", "precision_cuts"], "languages": ["en", "ko"]}', '{"email": true, "sms": true, "push": true}', NOW() - INTERVAL '18 days', NOW(), NOW() - INTERVAL '30 minutes');
-
--- =============================================================================
--- 2. SERVICE CATEGORIES SEED DATA
--- =============================================================================
-
-INSERT INTO service_categories (name, slug, description, parent_category_id, level, category_path, icon_url, color_hex, image_url, sort_order, is_active, is_featured, meta_title, meta_description, keywords, service_count, barber_count, average_price, popularity_score, created_at, updated_at) VALUES
-
--- Main Categories
-('Haircuts', 'haircuts', 'Professional hair cutting services for all styles and preferences', NULL, 1, 'haircuts', 'https://cdn.barbershop.com/icons/haircut.svg', '#2563EB', 'https://images.unsplash.com/photo-1622286346003-c3748d7d2c34?w=300', 1, true, true, 'Professional Haircuts | Barbershop', 'Expert haircut services including classic, modern, and trendy styles', '["haircut", "styling", "mens", "womens"]', 8, 15, 35.00, 95.5, NOW(), NOW()),
-
-('Beard & Mustache', 'beard-mustache', 'Expert beard trimming, shaping, and mustache styling services', NULL, 1, 'beard-mustache', 'https://cdn.barbershop.com/icons/beard.svg', '#DC2626', 'https://images.unsplash.com/photo-1621605815971-fbc98d665033?w=300', 2, true, true, 'Beard & Mustache Services | Barbershop', 'Professional beard trimming and mustache styling services', '["beard", "mustache", "trimming", "shaping"]', 5, 12, 25.00, 88.2, NOW(), NOW()),
-
-('Hair Styling', 'hair-styling', 'Creative hair styling and special occasion styling services', NULL, 1, 'hair-styling', 'https://cdn.barbershop.com/icons/styling.svg', '#7C3AED', 'https://images.unsplash.com/photo-1562004760-acb5f2f1dfef?w=300', 3, true, true, 'Hair Styling Services | Barbershop', 'Professional hair styling for special occasions and everyday looks', '["styling", "formal", "special", "occasion"]', 4, 8, 45.00, 78.9, NOW(), NOW()),
-
-('Hair Treatments', 'hair-treatments', 'Therapeutic hair and scalp treatments for hair health', NULL, 1, 'hair-treatments', 'https://cdn.barbershop.com/icons/treatment.svg', '#059669', 'https://images.unsplash.com/photo-1560066984-138dadb4c035?w=300', 4, true, false, 'Hair Treatments | Barbershop', 'Professional hair and scalp treatments for optimal hair health', '["treatment", "scalp", "therapy", "health"]', 3, 5, 60.00, 65.4, NOW(), NOW()),
-
--- Sub Categories
-('Classic Cuts', 'classic-cuts', 'Traditional and timeless haircut styles', 1, 2, 'haircuts/classic-cuts', NULL, '#1E40AF', NULL, 1, true, false, NULL, NULL, '["classic", "traditional", "timeless"]', 3, 10, 30.00, 85.2, NOW(), NOW()),
-
-('Modern Cuts', 'modern-cuts', 'Contemporary and trendy haircut styles', 1, 2, 'haircuts/modern-cuts', NULL, '#3B82F6', NULL, 2, true, true, NULL, NULL, '["modern", "trendy", "contemporary"]', 5, 12, 40.00, 92.8, NOW(), NOW());
-
--- =============================================================================
--- 3. SERVICES SEED DATA (Global Catalog)
--- =============================================================================
-
-INSERT INTO services (uuid, name, slug, short_description, detailed_description, category_id, service_type, complexity, skill_level_required, default_duration_min, default_duration_max, suggested_price_min, suggested_price_max, currency, target_gender, target_age_min, target_age_max, hair_types, requires_consultation, required_tools, required_products, required_certifications, allergen_warnings, health_precautions, requires_health_check, image_url, gallery_images, video_url, tags, search_keywords, meta_description, has_variations, allows_add_ons, global_popularity_score, total_global_bookings, average_global_rating, total_global_reviews, is_active, is_approved, approval_notes, created_at, updated_at, created_by, last_modified_by, version, change_log) VALUES
-
--- Haircut Services
-('service-uuid-001', 'Classic Business Cut', 'classic-business-cut', 'Professional business haircut for the modern gentleman', 'A timeless, professional haircut perfect for business environments. Includes precision cutting, styling, and finishing touches for a polished look that commands respect.', 5, 'haircut', 3, 'intermediate', 30, 45, 25.00, 40.00, 'USD', 'male', 18, 65, '["straight", "wavy", "thick", "all"]', false, '["scissors", "comb", "clippers", "styling_brush"]', '["shampoo", "conditioner", "styling_gel"]', '[]', '[]', '[]', false, 'https://images.unsplash.com/photo-1622286346003-c3748d7d2c34?w=400', '["https://images.unsplash.com/photo-1622286346003-c3748d7d2c34?w=400"]', NULL, '["classic", "business", "professional", "conservative"]', '["business cut", "professional haircut", "classic style"]', 'Professional classic business haircut for men', true, true, 92.5, 2847, 4.6, 1205, true, true, 'Popular classic style', NOW() - INTERVAL '60 days', NOW(), 1, 1, 1, '{"initial": "Service created"}'),
-
-('service-uuid-002', 'Modern Fade Cut', 'modern-fade-cut', 'Trendy fade haircut with modern styling', 'Contemporary fade cut featuring gradual length transition from short sides to longer top. Customizable fade height and styling options for a fresh, modern look.', 6, 'haircut', 4, 'advanced', 45, 60, 35.00, 55.00, 'USD', 'male', 16, 45, '["straight", "wavy", "curly", "all"]', true, '["clippers", "scissors", "razor", "comb", "styling_brush"]', '["shampoo", "pomade", "hair_wax"]', '[]', '[]', '[]', false, 'https://images.unsplash.com/photo-1503951914875-452162b0f3f1?w=400', '["https://images.unsplash.com/photo-1503951914875-452162b0f3f1?w=400"]', 'https://youtube.com/watch?v=fade-tutorial', '["modern", "fade", "trendy", "gradient"]', '["fade cut", "modern fade", "skin fade"]', 'Modern fade haircut with precision styling', true, true, 96.8, 3652, 4.8, 1847, true, true, 'Highly requested modern style', NOW() - INTERVAL '45 days', NOW(), 1, 1, 1, '{"initial": "Service created"}'),
-
-('service-uuid-003', 'Beard Trim & Shape', 'beard-trim-shape', 'Professional beard trimming and shaping service', 'Expert beard trimming and shaping to maintain your desired look. Includes precise trimming, edge work, and styling with premium beard products.', 2, 'grooming', 3, 'intermediate', 20, 30, 15.00, 30.00, 'USD', 'male', 18, 70, '["all"]', false, '["beard_trimmer", "scissors", "razor", "comb"]', '["beard_oil", "beard_balm", "aftershave"]', '[]', '["fragrance"]', '["skin_sensitivity"]', false, 'https://images.unsplash.com/photo-1621605815971-fbc98d665033?w=400', '["https://images.unsplash.com/photo-1621605815971-fbc98d665033?w=400"]', NULL, '["beard", "trim", "shape", "grooming"]', '["beard trim", "beard shaping", "facial hair"]', 'Professional beard trimming and shaping service', true, true, 89.2, 1956, 4.5, 876, true, true, 'Essential grooming service', NOW() - INTERVAL '30 days', NOW(), 1, 1, 1, '{"initial": "Service created"}'),
-
-('service-uuid-004', 'Hot Towel Shave', 'hot-towel-shave', 'Traditional hot towel straight razor shave', 'Classic barbershop experience with hot towel treatment, premium shaving cream, and straight razor shave. Includes aftershave treatment and moisturizing.', 2, 'grooming', 5, 'expert', 45, 60, 40.00, 70.00, 'USD', 'male', 18, 80, '["all"]', true, '["straight_razor", "hot_towels", "shaving_brush", "strop"]', '["shaving_cream", "pre_shave_oil", "aftershave", "moisturizer"]', '["barbering_license"]', '["fragrance", "lanolin"]', '["skin_sensitivity", "blood_thinners"]', true, 'https://images.unsplash.com/photo-1585747860715-2ba37e788b70?w=400', '["https://images.unsplash.com/photo-1585747860715-2ba37e788b70?w=400"]', NULL, '["traditional", "shave", "hot_towel", "luxury"]', '["hot towel shave", "straight razor", "traditional shave"]', 'Traditional hot towel straight razor shave experience', false, true, 78.4, 892, 4.9, 425, true, true, 'Premium traditional service', NOW() - INTERVAL '20 days', NOW(), 1, 1, 1, '{"initial": "Service created"}'),
-
-('service-uuid-005', 'Kids Haircut', 'kids-haircut', 'Fun and gentle haircuts for children', 'Child-friendly haircut service with patient approach and fun atmosphere. Includes basic styling and can accommodate fidgety children with toys and entertainment.', 1, 'haircut', 2, 'beginner', 20, 30, 15.00, 25.00, 'USD', 'all', 3, 17, '["straight", "wavy", "curly", "all"]', false, '["scissors", "clippers", "comb", "cape"]', '["mild_shampoo", "detangling_spray"]', '[]', '[]', '[]', false, 'https://images.unsplash.com/photo-1564463489817-3f6eccb47b89?w=400', '["https://images.unsplash.com/photo-1564463489817-3f6eccb47b89?w=400"]', NULL, '["kids", "children", "gentle", "fun"]', '["kids haircut", "children haircut", "child friendly"]', 'Fun and gentle haircuts for children of all ages', true, false, 85.7, 1653, 4.4, 743, true, true, 'Family-friendly service', NOW() - INTERVAL '40 days', NOW(), 1, 1, 1, '{"initial": "Service created"}'),
-
-('service-uuid-006', 'Hair Wash & Style', 'hair-wash-style', 'Complete hair washing and styling service', 'Thorough hair washing with premium products followed by professional styling. Perfect for special occasions or regular maintenance.', 3, 'styling', 2, 'intermediate', 30, 45, 20.00, 35.00, 'USD', 'all', 12, 80, '["straight", "wavy", "curly", "coily", "all"]', false, '["shampoo_bowl", "hair_dryer", "styling_brush", "curling_iron"]', '["professional_shampoo", "conditioner", "styling_mousse", "hairspray"]', '[]', '["sulfates", "parabens"]', '["scalp_sensitivity"]', false, 'https://images.unsplash.com/photo-1562004760-acb5f2f1dfef?w=400', '["https://images.unsplash.com/photo-1562004760-acb5f2f1dfef?w=400"]', NULL, '["wash", "style", "maintenance", "care"]', '["hair wash", "styling", "hair care"]', 'Professional hair washing and styling service', true, true, 82.3, 1247, 4.3, 567, true, true, 'Regular maintenance service', NOW() - INTERVAL '35 days', NOW(), 1, 1, 1, '{"initial": "Service created"}');
-
--- =============================================================================
--- 4. BARBERS SEED DATA
--- =============================================================================
-
-INSERT INTO barbers (user_id, uuid, shop_name, business_name, business_registration_number, tax_id, address, address_line_2, city, state, country, postal_code, latitude, longitude, phone, business_email, website_url, description, years_experience, specialties, certifications, languages_spoken, profile_image_url, cover_image_url, gallery_images, working_hours, rating, total_reviews, total_bookings, response_time_minutes, acceptance_rate, cancellation_rate, status, is_verified, verification_date, verification_notes, advance_booking_days, min_booking_notice_hours, auto_accept_bookings, instant_booking_enabled, commission_rate, payout_method, payout_details, created_at, updated_at, last_active_at) VALUES
-
--- Tony's Classic Barbershop
-(5, '550e8400-e29b-41d4-a716-446655440101', 'Tony''s Classic Barbershop', 'Antonio Soprano Barber Services LLC', 'LLC2023001', 'TAX123456789', '100 Barber Row', 'Suite 101', 'New York', 'NY', 'USA', '10001', 40.7128, -74.0060, '+1-555-0105', 'tony@classicbarbershop.com', 'https://tonysclassicbarbershop.com', 'Traditional Italian barbershop offering classic cuts, hot towel shaves, and authentic grooming services. Family-owned business with 15+ years of experience serving NYC.', 15, '["classic_cuts", "hot_towel_shave", "beard_styling", "traditional_grooming"]', '["Master_Barber_Certificate", "Traditional_Shaving_Certification"]', '["English", "Italian"]', 'https://images.unsplash.com/photo-1521119989659-a83eee488004?w=300', 'https://images.unsplash.com/photo-1585747860715-2ba37e788b70?w=800', '["https://images.unsplash.com/photo-1585747860715-2ba37e788b70?w=400", "https://images.unsplash.com/photo-1622286346003-c3748d7d2c34?w=400", "https://images.unsplash.com/photo-1621605815971-fbc98d665033?w=400"]', '{"monday": {"open": "09:00", "close": "18:00"}, "tuesday": {"open": "09:00", "close": "18:00"}, "wednesday": {"open": "09:00", "close": "18:00"}, "thursday": {"open": "09:00", "close": "19:00"}, "friday": {"open": "09:00", "close": "19:00"}, "saturday": {"open": "08:00", "close": "17:00"}, "sunday": {"closed": true}}', 4.8, 127, 892, 15, 94.5, 3.2, 'active', true, NOW() - INTERVAL '25 days', 'Excellent traditional barber with strong customer base', 30, 2, false, true, 15.0, 'bank_transfer', '{"bank": "Chase Bank", "account_type": "business_checking"}', NOW() - INTERVAL '20 days', NOW(), NOW() - INTERVAL '15 minutes'),
-
--- Maria's Modern Salon
-(6, '550e8400-e29b-41d4-a716-446655440102', 'Maria''s Modern Salon', 'Maria Gonzalez Hair Studio Inc', 'INC2023002', 'TAX987654321', '200 Style Ave', 'Floor 2', 'Brooklyn', 'NY', 'USA', '11201', 40.6892, -73.9442, '+1-555-0106', 'maria@modernstyles.com', 'https://mariasmodernsalon.com', 'Contemporary hair salon specializing in modern cuts, color treatments, and creative styling. Bilingual service with focus on latest trends and techniques.', 8, '["modern_cuts", "color_treatments", "creative_styling", "womens_cuts"]', '["Cosmetology_License", "Color_Specialist_Certification", "Balayage_Expert"]', '["English", "Spanish"]', 'https://images.unsplash.com/photo-1580618672591-eb180b1a973f?w=300', 'https://images.unsplash.com/photo-1562004760-acb5f2f1dfef?w=800', '["https://images.unsplash.com/photo-1562004760-acb5f2f1dfef?w=400", "https://images.unsplash.com/photo-1503951914875-452162b0f3f1?w=400", "https://images.unsplash.com/photo-1560066984-138dadb4c035?w=400"]', '{"monday": {"closed": true}, "tuesday": {"open": "10:00", "close": "19:00"}, "wednesday": {"open": "10:00", "close": "19:00"}, "thursday": {"open": "10:00", "close": "20:00"}, "friday": {"open": "10:00", "close": "20:00"}, "saturday": {"open": "09:00", "close": "18:00"}, "sunday": {"open": "11:00", "close": "16:00"}}', 4.6, 89, 654, 25, 89.2, 5.1, 'active', true, NOW() - INTERVAL '30 days', 'Talented stylist with modern approach', 45, 4, true, false, 18.0, 'paypal', '{"email": "maria.payments@email.com"}', NOW() - INTERVAL '25 days', NOW(), NOW() - INTERVAL '1 hour'),
-
--- David's Precision Cuts
-(7, '550e8400-e29b-41d4-a716-446655440103', 'David''s Precision Cuts', 'DK Hair Design Studio', 'LLC2023003', 'TAX456789123', '300 Trend St', NULL, 'Queens', 'NY', 'USA', '11101', 40.7505, -73.9934, '+1-555-0107', 'david@precisioncuts.com', 'https://davidsprecisioncuts.com', 'Precision cutting specialist focusing on Asian hair types and modern styling techniques. Attention to detail and personalized service guaranteed.', 10, '["precision_cuts", "asian_hair_specialist", "modern_styling", "fade_expert"]', '["Advanced_Cutting_Certification", "Asian_Hair_Specialist", "Fade_Master"]', '["English", "Korean", "Mandarin"]', 'https://images.unsplash.com/photo-1507003211169-0a1dd7228f2d?w=300', 'https://images.unsplash.com/photo-1503951914875-452162b0f3f1?w=800', '["https://images.unsplash.com/photo-1503951914875-452162b0f3f1?w=400", "https://images.unsplash.com/photo-1622286346003-c3748d7d2c34?w=400"]', '{"monday": {"open": "10:00", "close": "18:00"}, "tuesday": {"open": "10:00", "close": "18:00"}, "wednesday": {"open": "10:00", "close": "18:00"}, "thursday": {"open": "10:00", "close": "19:00"}, "friday": {"open": "10:00", "close": "19:00"}, "saturday": {"open": "09:00", "close": "17:00"}, "sunday": {"closed": true}}', 4.9, 156, 1123, 12, 96.8, 2.1, 'active', true, NOW() - INTERVAL '18 days', 'Exceptional precision and technique', 60, 3, false, true, 20.0, 'stripe', '{"account_id": "acct_1234567890"}', NOW() - INTERVAL '18 days', NOW(), NOW() - INTERVAL '30 minutes');
-
--- =============================================================================
--- 5. BARBER SERVICES SEED DATA
--- =============================================================================
-
-INSERT INTO barber_services (barber_id, service_id, custom_name, custom_description, price, max_price, currency, discount_price, discount_valid_until, estimated_duration_min, estimated_duration_max, buffer_time_minutes, advance_notice_hours, max_advance_booking_days, available_days, available_time_slots, requires_consultation, consultation_duration, pre_service_instructions, post_service_care, min_customer_age, max_customer_age, is_seasonal, seasonal_start_month, seasonal_end_month, portfolio_images, before_after_images, total_bookings, total_revenue, average_rating, total_reviews, cancellation_rate, customer_satisfaction, repeat_customer_rate, bookings_last_30_days, revenue_last_30_days, popularity_score, demand_level, is_promotional, promotional_text, promotion_start_date, promotion_end_date, is_featured, display_order, service_note, is_active, paused_reason, paused_until, created_at, updated_at) VALUES
-
--- Tony's Services
-(1, 1, 'Executive Business Cut', 'Premium business haircut with traditional Italian styling techniques', 45.00, 55.00, 'USD', 40.00, NOW() + INTERVAL '7 days', 35, 45, 15, 2, 30, '["monday", "tuesday", "wednesday", "thursday", "friday", "saturday"]', '{}', false, NULL, 'Please arrive with clean, dry hair', 'Avoid washing hair for 24 hours for best styling results', 16, 65, false, NULL, NULL, '["https://images.unsplash.com/photo-1622286346003-c3748d7d2c34?w=400"]', '["https://before-after-1.jpg", "https://before-after-2.jpg"]', 234, 10530.00, 4.8, 89, 2.1, 96.5, 78.2, 18, 810.00, 94.5, 0.8, true, 'Limited time: $5 off executive cuts!', NOW(), NOW() + INTERVAL '7 days', true, 1, 'Signature service with complimentary hot towel', true, NULL, NULL, NOW() - INTERVAL '20 days', NOW()),
-
-(1, 3, 'Traditional Beard Sculpting', 'Artisanal beard trimming using traditional Italian techniques', 30.00, 40.00, 'USD', NULL, NULL, 25, 35, 10, 2, 30, '["monday", "tuesday", "wednesday
\ No newline at end of file
+END $$;
@@ -0,0 +1,11 @@
+#!/usr/bin/env bash
+# Runs the booking race-condition suite under the Go race detector.
+# Needs Docker (testcontainers) or DATABASE_URL pointing at a migrated database.
+#
+#   ./scripts/test_race.sh            # 3 passes
+#   RACE_COUNT=10 ./scripts/test_race.sh
+set -euo pipefail
+
+cd "$(dirname "$0")/.."
+
+go test -race -count="${RACE_COUNT:-3}" -run 'TestBookingRace' -v ./tests/integration/...
@@ -0,0 +1,9 @@
+// sdk/generate.go
+
+// Package sdk holds the generated API client SDKs. Regenerate after updating
+// the swagger spec:
+//
+//	go run ./cmd/specgen && go generate ./sdk/...
+package sdk
+
+//go:generate go run ../cmd/sdkgen -spec ../docs/swagger.json -go-out go/barbershop/api.gen.go -ts-out typescript/src/client.gen.ts
@@ -0,0 +1,8286 @@
+// Code generated by cmd/sdkgen from docs/swagger.json. DO NOT EDIT.
+
+package barbershop
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+var (
+	_ = json.RawMessage{}
+	_ = fmt.Sprint
+	_ url.Values
+)
+
+// ============================================
+// Models
+// ============================================
+
+// Variant mirrors experiments.Variant
+type Variant struct {
+	Name   string `json:"name"`
+	Weight int    `json:"weight"`
+}
+
+// PaginatedResponse Paginated response wrapper with metadata
+type PaginatedResponse struct {
+	Data    json.RawMessage `json:"data,omitempty"`
+	Meta    *Pagination     `json:"meta,omitempty"`
+	Success *bool           `json:"success,omitempty"`
+}
+
+// Pagination Pagination metadata for list responses
+type Pagination struct {
+	Count   *int  `json:"count,omitempty"`
+	HasMore *bool `json:"has_more,omitempty"`
+	Limit   *int  `json:"limit,omitempty"`
+	// Largest limit the consumer may ask for; larger limits are capped
+	MaxLimit *int `json:"max_limit,omitempty"`
+	Offset   *int `json:"offset,omitempty"`
+	Total    *int `json:"total,omitempty"`
+}
+
+// StatusUpdateRequest mirrors handlers.StatusUpdateRequest
+type StatusUpdateRequest struct {
+	Status string `json:"status"`
+}
+
+// SuccessResponse Standard success response wrapper
+type SuccessResponse struct {
+	Data    json.RawMessage `json:"data,omitempty"`
+	Message *string         `json:"message,omitempty"`
+	Meta    json.RawMessage `json:"meta,omitempty"`
+	Success *bool           `json:"success,omitempty"`
+}
+
+// ErrorResponse mirrors middleware.ErrorResponse
+type ErrorResponse struct {
+	Code    *string                `json:"code,omitempty"`
+	Details map[string]interface{} `json:"details,omitempty"`
+	Error   *string                `json:"error,omitempty"`
+	Message *string                `json:"message,omitempty"`
+	// RequestID is the request's X-Request-ID, for quoting to support
+	RequestID *string `json:"request_id,omitempty"`
+}
+
+// APIQuota mirrors models.APIQuota
+type APIQuota struct {
+	Note              *string `json:"note,omitempty"`
+	RequestsPerMinute *int    `json:"requests_per_minute,omitempty"`
+	UpdatedAt         *string `json:"updated_at,omitempty"`
+	UserEmail         *string `json:"user_email,omitempty"`
+	UserID            *int    `json:"user_id,omitempty"`
+}
+
+// APIUsageReport mirrors models.APIUsageReport
+type APIUsageReport struct {
+	// Whether the limit is a per-consumer override
+	CustomQuota *bool           `json:"custom_quota,omitempty"`
+	Daily       []APIUsageStats `json:"daily,omitempty"`
+	Endpoints   []APIUsageStats `json:"endpoints,omitempty"`
+	From        *string         `json:"from,omitempty"`
+	// Current rate limit
+	RequestsPerMinute *int           `json:"requests_per_minute,omitempty"`
+	To                *string        `json:"to,omitempty"`
+	Totals            *APIUsageStats `json:"totals,omitempty"`
+	UserID            *int           `json:"user_id,omitempty"`
+}
+
+// APIUsageStats mirrors models.APIUsageStats
+type APIUsageStats struct {
+	AvgLatencyMs     *float64 `json:"avg_latency_ms,omitempty"`
+	ClientErrorCount *int     `json:"client_error_count,omitempty"`
+	Day              *string  `json:"day,omitempty"`
+	// Percent of requests answered 4xx or 5xx
+	ErrorRate        *float64 `json:"error_rate,omitempty"`
+	MaxLatencyMs     *int     `json:"max_latency_ms,omitempty"`
+	Method           *string  `json:"method,omitempty"`
+	RequestCount     *int     `json:"request_count,omitempty"`
+	Route            *string  `json:"route,omitempty"`
+	ServerErrorCount *int     `json:"server_error_count,omitempty"`
+	UserEmail        *string  `json:"user_email,omitempty"`
+	UserID           *int     `json:"user_id,omitempty"`
+}
+
+// AuditLog mirrors models.AuditLog
+type AuditLog struct {
+	// e.g. booking.cancelled, review.moderated
+	Action  *string `json:"action,omitempty"`
+	ActorID *int    `json:"actor_id,omitempty"`
+	// customer, barber, admin, system
+	ActorType *string `json:"actor_type,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	EntityID  *int    `json:"entity_id,omitempty"`
+	// booking, review, user, service, ...
+	EntityType *string `json:"entity_type,omitempty"`
+	ID         *int    `json:"id,omitempty"`
+	// ImpersonatorID is the real operator when an admin acted on ActorID's behalf
+	ImpersonatorID *int `json:"impersonator_id,omitempty"`
+	// Request context
+	IPAddress *string `json:"ip_address,omitempty"`
+	Metadata  JSONMap `json:"metadata,omitempty"`
+	NewValues JSONMap `json:"new_values,omitempty"`
+	// The changed fields before and after the action (nil when not recorded)
+	OldValues json.RawMessage `json:"old_values,omitempty"`
+	RequestID *string         `json:"request_id,omitempty"`
+	UserAgent *string         `json:"user_agent,omitempty"`
+}
+
+// Barber mirrors models.Barber
+type Barber struct {
+	AcceptanceRate *float64 `json:"acceptance_rate,omitempty"`
+	// Location and contact
+	Address      *string `json:"address,omitempty"`
+	AddressLine2 *string `json:"address_line_2,omitempty"`
+	// Business settings
+	AdvanceBookingDays         *int     `json:"advance_booking_days,omitempty"`
+	AutoAcceptBookings         *bool    `json:"auto_accept_bookings,omitempty"`
+	BusinessEmail              *string  `json:"business_email,omitempty"`
+	BusinessName               *string  `json:"business_name,omitempty"`
+	BusinessRegistrationNumber *string  `json:"business_registration_number,omitempty"`
+	CancellationRate           *float64 `json:"cancellation_rate,omitempty"`
+	Certifications             []string `json:"certifications,omitempty"`
+	City                       *string  `json:"city,omitempty"`
+	// Financial information
+	CommissionRate *float64 `json:"commission_rate,omitempty"`
+	Country        *string  `json:"country,omitempty"`
+	CoverImageURL  *string  `json:"cover_image_url,omitempty"`
+	// Audit fields
+	CreatedAt *string `json:"created_at,omitempty"`
+	DeletedAt *string `json:"deleted_at,omitempty"`
+	// Business details
+	Description *string `json:"description,omitempty"`
+	// Ranking (search listings only). DistanceKm is set when the searcher
+	// sent a location; Ranking only when the listing asked for explain=true.
+	DistanceKm            *float64 `json:"distance_km,omitempty"`
+	FeaturedPlacementID   *int     `json:"featured_placement_id,omitempty"`
+	GalleryImages         []string `json:"gallery_images,omitempty"`
+	ID                    *int     `json:"id,omitempty"`
+	InstantBookingEnabled *bool    `json:"instant_booking_enabled,omitempty"`
+	// Featured placement running today (search listings only). Clients
+	// report clicks on featured results against FeaturedPlacementID.
+	IsFeatured            *bool    `json:"is_featured,omitempty"`
+	IsVerified            *bool    `json:"is_verified,omitempty"`
+	LanguagesSpoken       []string `json:"languages_spoken,omitempty"`
+	LastActiveAt          *string  `json:"last_active_at,omitempty"`
+	Latitude              *float64 `json:"latitude,omitempty"`
+	Longitude             *float64 `json:"longitude,omitempty"`
+	MinBookingNoticeHours *int     `json:"min_booking_notice_hours,omitempty"`
+	// NoShowGraceMinutes is how long after the start time a confirmed
+	// booking nobody started is marked no-show (nil = platform default)
+	NoShowGraceMinutes *int    `json:"no_show_grace_minutes,omitempty"`
+	PayoutDetails      JSONMap `json:"payout_details,omitempty"`
+	PayoutMethod       *string `json:"payout_method,omitempty"`
+	Phone              *string `json:"phone,omitempty"`
+	PostalCode         *string `json:"postal_code,omitempty"`
+	// Media and branding
+	ProfileImageURL *string      `json:"profile_image_url,omitempty"`
+	Ranking         *Explanation `json:"ranking,omitempty"`
+	// Business metrics
+	Rating *float64 `json:"rating,omitempty"`
+	// Performance metrics
+	ResponseTimeMinutes *int            `json:"response_time_minutes,omitempty"`
+	Services            []BarberService `json:"services,omitempty"`
+	// Shop the barber works at (nil = standalone)
+	ShopID *int `json:"shop_id,omitempty"`
+	// Business information
+	ShopName    *string  `json:"shop_name,omitempty"`
+	Specialties []string `json:"specialties,omitempty"`
+	State       *string  `json:"state,omitempty"`
+	// Status and verification
+	Status *string `json:"status,omitempty"`
+	TaxID  *string `json:"tax_id,omitempty"`
+	// A nearby search's pick of the barber's most booked services
+	TopServices   []BarberService `json:"top_services,omitempty"`
+	TotalBookings *int            `json:"total_bookings,omitempty"`
+	TotalReviews  *int            `json:"total_reviews,omitempty"`
+	UpdatedAt     *string         `json:"updated_at,omitempty"`
+	// Relations (populated when needed)
+	User      json.RawMessage `json:"user,omitempty"`
+	UserEmail *string         `json:"user_email,omitempty"`
+	// Links to User table
+	UserID            *int    `json:"user_id,omitempty"`
+	UserName          *string `json:"user_name,omitempty"`
+	UUID              *string `json:"uuid,omitempty"`
+	VerificationDate  *string `json:"verification_date,omitempty"`
+	VerificationNotes *string `json:"verification_notes,omitempty"`
+	WebsiteURL        *string `json:"website_url,omitempty"`
+	// Working hours (flexible JSON structure)
+	WorkingHours    json.RawMessage `json:"working_hours,omitempty"`
+	YearsExperience *int            `json:"years_experience,omitempty"`
+}
+
+// BarberClient mirrors models.BarberClient
+type BarberClient struct {
+	BarberID  *int    `json:"barber_id,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	CreatedBy *int    `json:"created_by,omitempty"`
+	// Lower-case
+	Email        *string `json:"email,omitempty"`
+	EmailConsent *bool   `json:"email_consent,omitempty"`
+	ID           *int    `json:"id,omitempty"`
+	Name         *string `json:"name,omitempty"`
+	Notes        *string `json:"notes,omitempty"`
+	// Digits, with a leading + if given
+	Phone      *string `json:"phone,omitempty"`
+	SMSConsent *bool   `json:"sms_consent,omitempty"`
+	// import
+	Source    *string `json:"source,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+	// The client's account; nil for clients without email
+	UserID *int `json:"user_id,omitempty"`
+}
+
+// BarberDashboard mirrors models.BarberDashboard
+type BarberDashboard struct {
+	BarberID *int                  `json:"barber_id,omitempty"`
+	Counts   *DashboardCounts      `json:"counts,omitempty"`
+	Current  *DashboardAppointment `json:"current,omitempty"`
+	// When the bookings were read; may trail GeneratedAt when cached
+	DataAsOf             *string                `json:"data_as_of,omitempty"`
+	Date                 *string                `json:"date,omitempty"`
+	Earnings             *DashboardEarnings     `json:"earnings,omitempty"`
+	GeneratedAt          *string                `json:"generated_at,omitempty"`
+	LateByMinutes        *int                   `json:"late_by_minutes,omitempty"`
+	Next                 *DashboardAppointment  `json:"next,omitempty"`
+	NextStartsInSeconds  *int                   `json:"next_starts_in_seconds,omitempty"`
+	PendingConfirmations []DashboardAppointment `json:"pending_confirmations,omitempty"`
+	Queue                []DashboardAppointment `json:"queue,omitempty"`
+	RunningLate          *bool                  `json:"running_late,omitempty"`
+	Timezone             *string                `json:"timezone,omitempty"`
+}
+
+// BarberLocation mirrors models.BarberLocation
+type BarberLocation struct {
+	Address   *string `json:"address,omitempty"`
+	BarberID  *int    `json:"barber_id,omitempty"`
+	City      *string `json:"city,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	ID        *int    `json:"id,omitempty"`
+	Name      *string `json:"name,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// BarberService mirrors models.BarberService
+type BarberService struct {
+	// Barber's booking rules
+	AdvanceNoticeHours *int `json:"advance_notice_hours,omitempty"`
+	// From the service; add-ons can be booked with it
+	AllowsAddOns *bool `json:"allows_add_ons,omitempty"`
+	// ["monday", "tuesday"]
+	AvailableDays []string `json:"available_days,omitempty"`
+	// Custom time restrictions
+	AvailableTimeSlots json.RawMessage `json:"available_time_slots,omitempty"`
+	// 4.7 stars
+	AverageRating *float64 `json:"average_rating,omitempty"`
+	// The barber offering it
+	Barber json.RawMessage `json:"barber,omitempty"`
+	// FK to barbers table
+	BarberID *int `json:"barber_id,omitempty"`
+	// Before/after photos
+	BeforeAfterImages []string `json:"before_after_images,omitempty"`
+	// Recent performance (for ML and trending)
+	BookingsLast30Days *int `json:"bookings_last_30_days,omitempty"`
+	// Break between services
+	BufferTimeMinutes *int `json:"buffer_time_minutes,omitempty"`
+	// 5.2%
+	CancellationRate *float64 `json:"cancellation_rate,omitempty"`
+	CategoryID       *int     `json:"category_id,omitempty"`
+	// 15 minutes
+	ConsultationDuration *int `json:"consultation_duration,omitempty"`
+	// Audit fields
+	CreatedAt *string `json:"created_at,omitempty"`
+	// USD, EUR, etc.
+	Currency *string `json:"currency,omitempty"`
+	// Barber's custom description
+	CustomDescription *string `json:"custom_description,omitempty"`
+	// Barber's customization of the service
+	CustomName *string `json:"custom_name,omitempty"`
+	// 94.5%
+	CustomerSatisfaction *float64 `json:"customer_satisfaction,omitempty"`
+	// Current demand (0-1)
+	DemandLevel *float64 `json:"demand_level,omitempty"`
+	// Deposit held when booking (nil = no deposit) and the part of it kept
+	// when the customer does not show up
+	DepositType *string `json:"deposit_type,omitempty"`
+	// Percent of the price, or an amount
+	DepositValue *float64 `json:"deposit_value,omitempty"`
+	// Special offer price
+	DiscountPrice      *float64 `json:"discount_price,omitempty"`
+	DiscountValidUntil *string  `json:"discount_valid_until,omitempty"`
+	// Display and ordering
+	DisplayOrder *int `json:"display_order,omitempty"`
+	// Active/passive split of the duration (nil = fully active)
+	DurationSegments []DurationSegment `json:"duration_segments,omitempty"`
+	// 45 minutes
+	EstimatedDurationMax *int `json:"estimated_duration_max,omitempty"`
+	// Barber's timing
+	EstimatedDurationMin *int `json:"estimated_duration_min,omitempty"`
+	ID                   *int `json:"id,omitempty"`
+	// Status
+	IsActive *bool `json:"is_active,omitempty"`
+	// Highlight this service
+	IsFeatured *bool `json:"is_featured,omitempty"`
+	// Marketing and promotions
+	IsPromotional *bool `json:"is_promotional,omitempty"`
+	// Seasonal availability
+	IsSeasonal *bool `json:"is_seasonal,omitempty"`
+	// 30 days max
+	MaxAdvanceBookingDays *int `json:"max_advance_booking_days,omitempty"`
+	// No upper limit usually
+	MaxCustomerAge *int `json:"max_customer_age,omitempty"`
+	// For variable/surge pricing
+	MaxPrice *float64 `json:"max_price,omitempty"`
+	// Age restrictions
+	MinCustomerAge *int `json:"min_customer_age,omitempty"`
+	// nil = the whole deposit
+	NoShowFee *float64 `json:"no_show_fee,omitempty"`
+	// Why service is paused
+	PausedReason *string `json:"paused_reason,omitempty"`
+	// Temporary pause
+	PausedUntil *string `json:"paused_until,omitempty"`
+	// ML-calculated score
+	PopularityScore *float64 `json:"popularity_score,omitempty"`
+	// Barber's portfolio for this service
+	PortfolioImages []string `json:"portfolio_images,omitempty"`
+	// "Avoid washing for 24h"
+	PostServiceCare *string `json:"post_service_care,omitempty"`
+	// "Wash hair first"
+	PreServiceInstructions *string `json:"pre_service_instructions,omitempty"`
+	// Barber's pricing
+	Price              *float64 `json:"price,omitempty"`
+	PromotionEndDate   *string  `json:"promotion_end_date,omitempty"`
+	PromotionStartDate *string  `json:"promotion_start_date,omitempty"`
+	// "20% off this week!"
+	PromotionalText *string `json:"promotional_text,omitempty"`
+	// 67.3%
+	RepeatCustomerRate *float64 `json:"repeat_customer_rate,omitempty"`
+	// Barber's service-specific requirements
+	RequiresConsultation *bool `json:"requires_consultation,omitempty"`
+	// $300.00
+	RevenueLast30Days *float64 `json:"revenue_last_30_days,omitempty"`
+	// 10 (October)
+	SeasonalEndMonth *int `json:"seasonal_end_month,omitempty"`
+	// 3 (March)
+	SeasonalStartMonth *int `json:"seasonal_start_month,omitempty"`
+	// Relations (populated via joins when needed)
+	Service json.RawMessage `json:"service,omitempty"`
+	// FK to services table
+	ServiceID   *int    `json:"service_id,omitempty"`
+	ServiceName *string `json:"service_name,omitempty"`
+	// Special note for customers
+	ServiceNote *string `json:"service_note,omitempty"`
+	ServiceType *string `json:"service_type,omitempty"`
+	// Performance metrics for this barber's service
+	TotalBookings *int `json:"total_bookings,omitempty"`
+	// $3,900.00
+	TotalRevenue *float64 `json:"total_revenue,omitempty"`
+	// 89 reviews
+	TotalReviews *int    `json:"total_reviews,omitempty"`
+	UpdatedAt    *string `json:"updated_at,omitempty"`
+}
+
+// BarberSummary mirrors models.BarberSummary
+type BarberSummary struct {
+	City            *string  `json:"city,omitempty"`
+	ID              *int     `json:"id,omitempty"`
+	ProfileImageURL *string  `json:"profile_image_url,omitempty"`
+	Rating          *float64 `json:"rating,omitempty"`
+	ShopName        *string  `json:"shop_name,omitempty"`
+	UUID            *string  `json:"uuid,omitempty"`
+}
+
+// Booking mirrors models.Booking
+type Booking struct {
+	ActualEndTime   *string `json:"actual_end_time,omitempty"`
+	ActualStartTime *string `json:"actual_start_time,omitempty"`
+	Barber          *Barber `json:"barber,omitempty"`
+	BarberID        *int    `json:"barber_id,omitempty"`
+	// Service information
+	BarberServiceID *int `json:"barber_service_id,omitempty"`
+	// Human-readable reference
+	BookingNumber *string `json:"booking_number,omitempty"`
+	// Source and attribution
+	BookingSource   *string  `json:"booking_source,omitempty"`
+	CancellationFee *float64 `json:"cancellation_fee,omitempty"`
+	// Free text
+	CancellationReason     *string `json:"cancellation_reason,omitempty"`
+	CancellationReasonCode *string `json:"cancellation_reason_code,omitempty"`
+	// Cancellation information
+	CancelledAt *string `json:"cancelled_at,omitempty"`
+	CancelledBy *int    `json:"cancelled_by,omitempty"`
+	// Communication tracking
+	ConfirmationMethod *string `json:"confirmation_method,omitempty"`
+	ConfirmationSentAt *string `json:"confirmation_sent_at,omitempty"`
+	// Audit fields
+	CreatedAt *string `json:"created_at,omitempty"`
+	Currency  *string `json:"currency,omitempty"`
+	// Answers to the barber's custom booking-form fields
+	CustomFields []BookingFormAnswer `json:"custom_fields,omitempty"`
+	// Relations (populated when needed)
+	Customer      json.RawMessage `json:"customer,omitempty"`
+	CustomerEmail *string         `json:"customer_email,omitempty"`
+	// Relationships
+	CustomerID *int `json:"customer_id,omitempty"`
+	// Customer information (for guest bookings)
+	CustomerName  *string `json:"customer_name,omitempty"`
+	CustomerPhone *string `json:"customer_phone,omitempty"`
+	// Deposit held when booking and the fee kept from it on a no-show
+	DepositAmount  *float64 `json:"deposit_amount,omitempty"`
+	DiscountAmount *float64 `json:"discount_amount,omitempty"`
+	// Active/passive split copied from the service (nil = fully active)
+	DurationSegments         []DurationSegment `json:"duration_segments,omitempty"`
+	EstimatedDurationMinutes *int              `json:"estimated_duration_minutes,omitempty"`
+	ID                       *int              `json:"id,omitempty"`
+	// Row an imported booking came from
+	ImportRef *string `json:"import_ref,omitempty"`
+	// For barber use only
+	InternalNotes *string `json:"internal_notes,omitempty"`
+	// Created in sandbox mode
+	IsTest *bool `json:"is_test,omitempty"`
+	// The services performed, in order (populated when needed)
+	LineItems []BookingLineItem `json:"line_items,omitempty"`
+	// One of the barber's locations (nil = the barber's address)
+	LocationID *int     `json:"location_id,omitempty"`
+	NoShowFee  *float64 `json:"no_show_fee,omitempty"`
+	// Booking details
+	Notes            *string `json:"notes,omitempty"`
+	PaidAt           *string `json:"paid_at,omitempty"`
+	PaymentMethod    *string `json:"payment_method,omitempty"`
+	PaymentReference *string `json:"payment_reference,omitempty"`
+	// Payment information
+	PaymentStatus    *string `json:"payment_status,omitempty"`
+	ReferralSource   *string `json:"referral_source,omitempty"`
+	ReminderSentAt   *string `json:"reminder_sent_at,omitempty"`
+	Review           *Review `json:"review,omitempty"`
+	ScheduledEndTime *string `json:"scheduled_end_time,omitempty"`
+	// Timing
+	ScheduledStartTime *string `json:"scheduled_start_time,omitempty"`
+	// Set for occurrences of a recurring booking
+	SeriesID        *int    `json:"series_id,omitempty"`
+	ServiceCategory *string `json:"service_category,omitempty"`
+	ServiceName     *string `json:"service_name,omitempty"`
+	// Pricing breakdown
+	ServicePrice    *float64 `json:"service_price,omitempty"`
+	SpecialRequests *string  `json:"special_requests,omitempty"`
+	// Booking status
+	Status    *string  `json:"status,omitempty"`
+	TaxAmount *float64 `json:"tax_amount,omitempty"`
+	// Tax line items behind TaxAmount (populated when needed)
+	TaxLines    []BookingTaxLine `json:"tax_lines,omitempty"`
+	TimeSlot    *TimeSlot        `json:"time_slot,omitempty"`
+	TimeSlotID  *int             `json:"time_slot_id,omitempty"`
+	TipAmount   *float64         `json:"tip_amount,omitempty"`
+	TotalPrice  *float64         `json:"total_price,omitempty"`
+	UpdatedAt   *string          `json:"updated_at,omitempty"`
+	UTMCampaign *string          `json:"utm_campaign,omitempty"`
+	UUID        *string          `json:"uuid,omitempty"`
+}
+
+// BookingFormAnswer mirrors models.BookingFormAnswer
+type BookingFormAnswer struct {
+	Key   *string `json:"key,omitempty"`
+	Label *string `json:"label,omitempty"`
+	Type  *string `json:"type,omitempty"`
+	// string, number or bool by type
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// BookingFormField mirrors models.BookingFormField
+type BookingFormField struct {
+	BarberID *int `json:"barber_id,omitempty"`
+	// Questions on one service are only asked when it is booked (nil =
+	// asked for every booking); the answers that need a consultation
+	// first (select options, or "true"/"false" for checkboxes)
+	BarberServiceID     *int     `json:"barber_service_id,omitempty"`
+	ConsultationAnswers []string `json:"consultation_answers,omitempty"`
+	CreatedAt           *string  `json:"created_at,omitempty"`
+	DisplayOrder        *int     `json:"display_order,omitempty"`
+	// text, number, select, checkbox
+	FieldType  *string `json:"field_type,omitempty"`
+	HelpText   *string `json:"help_text,omitempty"`
+	ID         *int    `json:"id,omitempty"`
+	IsActive   *bool   `json:"is_active,omitempty"`
+	IsRequired *bool   `json:"is_required,omitempty"`
+	// Key of the answer in custom_fields
+	Key   *string `json:"key,omitempty"`
+	Label *string `json:"label,omitempty"`
+	// Text fields
+	MaxLength *int `json:"max_length,omitempty"`
+	// Number fields
+	MaxValue *float64 `json:"max_value,omitempty"`
+	// Number fields
+	MinValue *float64 `json:"min_value,omitempty"`
+	// Choices of a select field
+	Options []string `json:"options,omitempty"`
+	// Text fields; the whole answer must match
+	Pattern   *string `json:"pattern,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// BookingLineItem mirrors models.BookingLineItem
+type BookingLineItem struct {
+	// Set for add-ons; nil once the add-on is deleted
+	AddOnID *int `json:"add_on_id,omitempty"`
+	// nil once the service is deleted
+	BarberServiceID *int    `json:"barber_service_id,omitempty"`
+	BookingID       *int    `json:"booking_id,omitempty"`
+	CreatedAt       *string `json:"created_at,omitempty"`
+	DurationMinutes *int    `json:"duration_minutes,omitempty"`
+	ID              *int    `json:"id,omitempty"`
+	// Order the services are performed in
+	Position    *int     `json:"position,omitempty"`
+	Price       *float64 `json:"price,omitempty"`
+	ServiceName *string  `json:"service_name,omitempty"`
+}
+
+// BookingStaff mirrors models.BookingStaff
+type BookingStaff struct {
+	BarberID     *int     `json:"barber_id,omitempty"`
+	BarberName   *string  `json:"barber_name,omitempty"`
+	BookingID    *int     `json:"booking_id,omitempty"`
+	CreatedAt    *string  `json:"created_at,omitempty"`
+	Role         *string  `json:"role,omitempty"`
+	SharePercent *float64 `json:"share_percent,omitempty"`
+}
+
+// BookingTaxLine mirrors models.BookingTaxLine
+type BookingTaxLine struct {
+	Amount      *float64 `json:"amount,omitempty"`
+	BookingID   *int     `json:"booking_id,omitempty"`
+	CreatedAt   *string  `json:"created_at,omitempty"`
+	ID          *int     `json:"id,omitempty"`
+	Inclusive   *bool    `json:"inclusive,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	RatePercent *float64 `json:"rate_percent,omitempty"`
+	TaxRuleID   *int     `json:"tax_rule_id,omitempty"`
+	// Price before any tax
+	TaxableAmount *float64 `json:"taxable_amount,omitempty"`
+}
+
+// CancellationReasonStat mirrors models.CancellationReasonStat
+type CancellationReasonStat struct {
+	ByBarber   *int    `json:"by_barber,omitempty"`
+	ByCustomer *int    `json:"by_customer,omitempty"`
+	Code       *string `json:"code,omitempty"`
+	Count      *int    `json:"count,omitempty"`
+	Label      *string `json:"label,omitempty"`
+	// Share of all cancellations in the range
+	Percent *float64 `json:"percent,omitempty"`
+}
+
+// CancellationReasonStats mirrors models.CancellationReasonStats
+type CancellationReasonStats struct {
+	BarberID *int                     `json:"barber_id,omitempty"`
+	From     *string                  `json:"from,omitempty"`
+	Reasons  []CancellationReasonStat `json:"reasons,omitempty"`
+	// Exclusive
+	To    *string `json:"to,omitempty"`
+	Total *int    `json:"total,omitempty"`
+}
+
+// ConsultationPhoto mirrors models.ConsultationPhoto
+type ConsultationPhoto struct {
+	ConsultationRequestID *int    `json:"consultation_request_id,omitempty"`
+	ContentType           *string `json:"content_type,omitempty"`
+	CreatedAt             *string `json:"created_at,omitempty"`
+	Height                *int    `json:"height,omitempty"`
+	ID                    *int    `json:"id,omitempty"`
+	SizeBytes             *int    `json:"size_bytes,omitempty"`
+	ThumbnailURL          *string `json:"thumbnail_url,omitempty"`
+	UploadedBy            *int    `json:"uploaded_by,omitempty"`
+	URL                   *string `json:"url,omitempty"`
+	Width                 *int    `json:"width,omitempty"`
+}
+
+// ConsultationRequest mirrors models.ConsultationRequest
+type ConsultationRequest struct {
+	Answers  []BookingFormAnswer `json:"answers,omitempty"`
+	BarberID *int                `json:"barber_id,omitempty"`
+	// nil once the service is removed
+	BarberServiceID *int `json:"barber_service_id,omitempty"`
+	// The booking made from the accepted quote
+	BookingID     *int    `json:"booking_id,omitempty"`
+	CreatedAt     *string `json:"created_at,omitempty"`
+	CustomerEmail *string `json:"customer_email,omitempty"`
+	// Who asked (customer for signed-in users, the contact details for
+	// guests)
+	CustomerID    *int                `json:"customer_id,omitempty"`
+	CustomerName  *string             `json:"customer_name,omitempty"`
+	CustomerPhone *string             `json:"customer_phone,omitempty"`
+	ID            *int                `json:"id,omitempty"`
+	Notes         *string             `json:"notes,omitempty"`
+	Photos        []ConsultationPhoto `json:"photos,omitempty"`
+	// From customers who ask for a consultation themselves
+	PreferredTimes    []string `json:"preferred_times,omitempty"`
+	ProposedStartTime *string  `json:"proposed_start_time,omitempty"`
+	QuotedAt          *string  `json:"quoted_at,omitempty"`
+	// The barber's quote, which the customer may accept as a booking
+	QuotedPrice *float64 `json:"quoted_price,omitempty"`
+	// The booking that was asked for
+	RequestedStartTime *string `json:"requested_start_time,omitempty"`
+	RespondedAt        *string `json:"responded_at,omitempty"`
+	RespondedBy        *int    `json:"responded_by,omitempty"`
+	ResponseNotes      *string `json:"response_notes,omitempty"`
+	ServiceName        *string `json:"service_name,omitempty"`
+	// The barber's answer
+	Status *string `json:"status,omitempty"`
+	// Keys of the questions that asked for the consultation
+	TriggeredBy []string `json:"triggered_by,omitempty"`
+	UpdatedAt   *string  `json:"updated_at,omitempty"`
+}
+
+// CustomerSummary mirrors models.CustomerSummary
+type CustomerSummary struct {
+	ID                *int    `json:"id,omitempty"`
+	Name              *string `json:"name,omitempty"`
+	ProfilePictureURL *string `json:"profile_picture_url,omitempty"`
+}
+
+// DashboardAppointment mirrors models.DashboardAppointment
+type DashboardAppointment struct {
+	ActualStartTime    *string  `json:"actual_start_time,omitempty"`
+	BookingNumber      *string  `json:"booking_number,omitempty"`
+	CreatedAt          *string  `json:"created_at,omitempty"`
+	Currency           *string  `json:"currency,omitempty"`
+	CustomerID         *int     `json:"customer_id,omitempty"`
+	CustomerName       *string  `json:"customer_name,omitempty"`
+	CustomerPhone      *string  `json:"customer_phone,omitempty"`
+	ID                 *int     `json:"id,omitempty"`
+	LateByMinutes      *int     `json:"late_by_minutes,omitempty"`
+	ScheduledEndTime   *string  `json:"scheduled_end_time,omitempty"`
+	ScheduledStartTime *string  `json:"scheduled_start_time,omitempty"`
+	ServiceName        *string  `json:"service_name,omitempty"`
+	Status             *string  `json:"status,omitempty"`
+	TipAmount          *float64 `json:"tip_amount,omitempty"`
+	TotalPrice         *float64 `json:"total_price,omitempty"`
+}
+
+// DashboardCounts mirrors models.DashboardCounts
+type DashboardCounts struct {
+	Cancelled *int `json:"cancelled,omitempty"`
+	Completed *int `json:"completed,omitempty"`
+	NoShow    *int `json:"no_show,omitempty"`
+	Remaining *int `json:"remaining,omitempty"`
+	Total     *int `json:"total,omitempty"`
+}
+
+// DashboardEarnings mirrors models.DashboardEarnings
+type DashboardEarnings struct {
+	CompletedCount *int    `json:"completed_count,omitempty"`
+	Currency       *string `json:"currency,omitempty"`
+	// Total plus the price of today's remaining appointments
+	Projected *float64 `json:"projected,omitempty"`
+	Revenue   *float64 `json:"revenue,omitempty"`
+	Tips      *float64 `json:"tips,omitempty"`
+	Total     *float64 `json:"total,omitempty"`
+}
+
+// DeviceToken mirrors models.DeviceToken
+type DeviceToken struct {
+	AppVersion *string `json:"app_version,omitempty"`
+	CreatedAt  *string `json:"created_at,omitempty"`
+	ID         *int    `json:"id,omitempty"`
+	// Last successful push
+	LastUsedAt *string `json:"last_used_at,omitempty"`
+	// ios, android or web
+	Platform  *string `json:"platform,omitempty"`
+	Token     *string `json:"token,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+	UserID    *int    `json:"user_id,omitempty"`
+}
+
+// DurationSegment mirrors models.DurationSegment
+type DurationSegment struct {
+	Minutes *int `json:"minutes,omitempty"`
+	// The barber is free during this segment
+	Passive *bool `json:"passive,omitempty"`
+}
+
+// EarningsLine mirrors models.EarningsLine
+type EarningsLine struct {
+	// This barber's part
+	Amount *float64 `json:"amount,omitempty"`
+	// The whole booking, before tax
+	BookingAmount *float64 `json:"booking_amount,omitempty"`
+	BookingID     *int     `json:"booking_id,omitempty"`
+	BookingNumber *string  `json:"booking_number,omitempty"`
+	BookingTip    *float64 `json:"booking_tip,omitempty"`
+	Commission    *float64 `json:"commission,omitempty"`
+	Currency      *string  `json:"currency,omitempty"`
+	Payout        *float64 `json:"payout,omitempty"`
+	Role          *string  `json:"role,omitempty"`
+	ServiceName   *string  `json:"service_name,omitempty"`
+	SharePercent  *float64 `json:"share_percent,omitempty"`
+	StartTime     *string  `json:"start_time,omitempty"`
+	Tip           *float64 `json:"tip,omitempty"`
+}
+
+// EarningsReport mirrors models.EarningsReport
+type EarningsReport struct {
+	BarberID *int `json:"barber_id,omitempty"`
+	Bookings *int `json:"bookings,omitempty"`
+	// Platform commission on earnings
+	Commission *float64 `json:"commission,omitempty"`
+	// Platform commission, percent
+	CommissionRate *float64 `json:"commission_rate,omitempty"`
+	Currency       *string  `json:"currency,omitempty"`
+	// Share of booking totals, before tax
+	Earnings *float64       `json:"earnings,omitempty"`
+	From     *string        `json:"from,omitempty"`
+	Lines    []EarningsLine `json:"lines,omitempty"`
+	// Earnings - commission + tips
+	Payout *float64 `json:"payout,omitempty"`
+	// Share of tips
+	Tips *float64 `json:"tips,omitempty"`
+	To   *string  `json:"to,omitempty"`
+}
+
+// Experiment mirrors models.Experiment
+type Experiment struct {
+	CreatedAt   *string `json:"created_at,omitempty"`
+	CreatedBy   *int    `json:"created_by,omitempty"`
+	Description *string `json:"description,omitempty"`
+	ID          *int    `json:"id,omitempty"`
+	// Referenced by the code under test
+	Key       *string   `json:"key,omitempty"`
+	Name      *string   `json:"name,omitempty"`
+	StartedAt *string   `json:"started_at,omitempty"`
+	Status    *string   `json:"status,omitempty"`
+	StoppedAt *string   `json:"stopped_at,omitempty"`
+	UpdatedAt *string   `json:"updated_at,omitempty"`
+	Variants  []Variant `json:"variants,omitempty"`
+	// Served to everyone once stopped
+	Winner *string `json:"winner,omitempty"`
+}
+
+// ExperimentConversion mirrors models.ExperimentConversion
+type ExperimentConversion struct {
+	CreatedAt    *string  `json:"created_at,omitempty"`
+	Event        *string  `json:"event,omitempty"`
+	ExperimentID *int     `json:"experiment_id,omitempty"`
+	ID           *int     `json:"id,omitempty"`
+	UserID       *int     `json:"user_id,omitempty"`
+	Value        *float64 `json:"value,omitempty"`
+	Variant      *string  `json:"variant,omitempty"`
+}
+
+// ExperimentReport mirrors models.ExperimentReport
+type ExperimentReport struct {
+	Experiment *Experiment               `json:"experiment,omitempty"`
+	Metric     *string                   `json:"metric,omitempty"`
+	Variants   []ExperimentVariantResult `json:"variants,omitempty"`
+	// Conversions counted this long after exposure (0 = any time)
+	WindowDays *int `json:"window_days,omitempty"`
+}
+
+// ExperimentVariantResult mirrors models.ExperimentVariantResult
+type ExperimentVariantResult struct {
+	// Percentage of exposures; nil without exposures
+	ConversionRate *float64 `json:"conversion_rate,omitempty"`
+	// Exposed users who converted
+	Conversions *int `json:"conversions,omitempty"`
+	Exposures   *int `json:"exposures,omitempty"`
+	// Relative change over the control's rate, in percent
+	Lift *float64 `json:"lift,omitempty"`
+	// Booking revenue or summed event values
+	Value   *float64 `json:"value,omitempty"`
+	Variant *string  `json:"variant,omitempty"`
+}
+
+// FavoriteBarber mirrors models.FavoriteBarber
+type FavoriteBarber struct {
+	BarberID  *int    `json:"barber_id,omitempty"`
+	City      *string `json:"city,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	ShopName  *string `json:"shop_name,omitempty"`
+	UserID    *int    `json:"user_id,omitempty"`
+}
+
+// FeaturedPlacement mirrors models.FeaturedPlacement
+type FeaturedPlacement struct {
+	Amount         *float64 `json:"amount,omitempty"`
+	BarberID       *int     `json:"barber_id,omitempty"`
+	BarberShopName *string  `json:"barber_shop_name,omitempty"`
+	CancelledAt    *string  `json:"cancelled_at,omitempty"`
+	// nil = all categories
+	CategoryID *int    `json:"category_id,omitempty"`
+	City       *string `json:"city,omitempty"`
+	// Percentage of impressions; nil before any impression
+	ClickThroughRate *float64 `json:"click_through_rate,omitempty"`
+	Clicks           *int     `json:"clicks,omitempty"`
+	CreatedAt        *string  `json:"created_at,omitempty"`
+	Currency         *string  `json:"currency,omitempty"`
+	// Inclusive
+	EndsOn *string `json:"ends_on,omitempty"`
+	ID     *int    `json:"id,omitempty"`
+	// Performance over the whole placement
+	Impressions      *int    `json:"impressions,omitempty"`
+	PaymentProvider  *string `json:"payment_provider,omitempty"`
+	PaymentReference *string `json:"payment_reference,omitempty"`
+	RefundReference  *string `json:"refund_reference,omitempty"`
+	StartsOn         *string `json:"starts_on,omitempty"`
+	// pending_payment, active, cancelled
+	Status    *string `json:"status,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// FeaturedSlotDay mirrors models.FeaturedSlotDay
+type FeaturedSlotDay struct {
+	Booked    *int    `json:"booked,omitempty"`
+	Day       *string `json:"day,omitempty"`
+	Limit     *int    `json:"limit,omitempty"`
+	Remaining *int    `json:"remaining,omitempty"`
+}
+
+// FeaturedSlotLimit mirrors models.FeaturedSlotLimit
+type FeaturedSlotLimit struct {
+	CategoryID *int    `json:"category_id,omitempty"`
+	City       *string `json:"city,omitempty"`
+	ID         *int    `json:"id,omitempty"`
+	MaxSlots   *int    `json:"max_slots,omitempty"`
+	UpdatedAt  *string `json:"updated_at,omitempty"`
+}
+
+// InventoryItem mirrors models.InventoryItem
+type InventoryItem struct {
+	BarberID  *int    `json:"barber_id,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	ID        *int    `json:"id,omitempty"`
+	// Alert at or below this quantity (0 = when out of stock)
+	LowStockThreshold *int `json:"low_stock_threshold,omitempty"`
+	// Matched against services' required_products
+	Name      *string `json:"name,omitempty"`
+	Quantity  *int    `json:"quantity,omitempty"`
+	Unit      *string `json:"unit,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+	// Taken out of stock per completed booking
+	UsagePerService *int `json:"usage_per_service,omitempty"`
+}
+
+// InventoryItemUsage mirrors models.InventoryItemUsage
+type InventoryItemUsage struct {
+	Bookings *int    `json:"bookings,omitempty"`
+	InStock  *int    `json:"in_stock,omitempty"`
+	ItemID   *int    `json:"item_id,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Quantity *int    `json:"quantity,omitempty"`
+	Unit     *string `json:"unit,omitempty"`
+}
+
+// InventoryUsageReport mirrors models.InventoryUsageReport
+type InventoryUsageReport struct {
+	BarberID *int                 `json:"barber_id,omitempty"`
+	From     *string              `json:"from,omitempty"`
+	Items    []InventoryItemUsage `json:"items,omitempty"`
+	To       *string              `json:"to,omitempty"`
+}
+
+// JSONMap mirrors models.JSONMap
+type JSONMap map[string]interface{}
+
+// NpsSummary mirrors models.NPSSummary
+type NpsSummary struct {
+	Detractors *int `json:"detractors,omitempty"`
+	Passives   *int `json:"passives,omitempty"`
+	Promoters  *int `json:"promoters,omitempty"`
+	Responses  *int `json:"responses,omitempty"`
+	// nil without responses
+	Score *float64 `json:"score,omitempty"`
+}
+
+// NpsTrend mirrors models.NPSTrend
+type NpsTrend struct {
+	// nil for platform-wide
+	BarberID *int            `json:"barber_id,omitempty"`
+	From     *string         `json:"from,omitempty"`
+	Interval *string         `json:"interval,omitempty"`
+	Overall  *NpsSummary     `json:"overall,omitempty"`
+	Points   []NpsTrendPoint `json:"points,omitempty"`
+	To       *string         `json:"to,omitempty"`
+}
+
+// NpsTrendPoint mirrors models.NPSTrendPoint
+type NpsTrendPoint struct {
+	Detractors  *int    `json:"detractors,omitempty"`
+	Passives    *int    `json:"passives,omitempty"`
+	PeriodStart *string `json:"period_start,omitempty"`
+	Promoters   *int    `json:"promoters,omitempty"`
+	Responses   *int    `json:"responses,omitempty"`
+	// nil without responses
+	Score *float64 `json:"score,omitempty"`
+}
+
+// OpenSlot mirrors models.OpenSlot
+type OpenSlot struct {
+	// favorites, nearby
+	Audiences       []string `json:"audiences,omitempty"`
+	BarberID        *int     `json:"barber_id,omitempty"`
+	BarberServiceID *int     `json:"barber_service_id,omitempty"`
+	BookingID       *int     `json:"booking_id,omitempty"`
+	ClaimedAt       *string  `json:"claimed_at,omitempty"`
+	ClaimedBy       *int     `json:"claimed_by,omitempty"`
+	ClaimsCloseAt   *string  `json:"claims_close_at,omitempty"`
+	CreatedAt       *string  `json:"created_at,omitempty"`
+	CreatedBy       *int     `json:"created_by,omitempty"`
+	// Flash discount, 0 for none
+	DiscountPercent *int    `json:"discount_percent,omitempty"`
+	DurationMinutes *int    `json:"duration_minutes,omitempty"`
+	ID              *int    `json:"id,omitempty"`
+	Message         *string `json:"message,omitempty"`
+	// Nearby audience only
+	RadiusKm *float64 `json:"radius_km,omitempty"`
+	// Customers notified
+	RecipientCount *int    `json:"recipient_count,omitempty"`
+	ServiceName    *string `json:"service_name,omitempty"`
+	// Before the flash discount
+	ServicePrice *float64 `json:"service_price,omitempty"`
+	StartTime    *string  `json:"start_time,omitempty"`
+	// open, claimed, cancelled
+	Status    *string `json:"status,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// PortfolioMedia mirrors models.PortfolioMedia
+type PortfolioMedia struct {
+	BarberServiceID *int    `json:"barber_service_id,omitempty"`
+	Caption         *string `json:"caption,omitempty"`
+	ContentType     *string `json:"content_type,omitempty"`
+	CreatedAt       *string `json:"created_at,omitempty"`
+	Height          *int    `json:"height,omitempty"`
+	ID              *int    `json:"id,omitempty"`
+	// portfolio, before_after
+	Kind *string `json:"kind,omitempty"`
+	// Order among the service's photos of this kind
+	Position     *int    `json:"position,omitempty"`
+	SizeBytes    *int    `json:"size_bytes,omitempty"`
+	ThumbnailURL *string `json:"thumbnail_url,omitempty"`
+	UpdatedAt    *string `json:"updated_at,omitempty"`
+	UploadedBy   *int    `json:"uploaded_by,omitempty"`
+	URL          *string `json:"url,omitempty"`
+	Width        *int    `json:"width,omitempty"`
+}
+
+// Review mirrors models.Review
+type Review struct {
+	Barber   *Barber `json:"barber,omitempty"`
+	BarberID *int    `json:"barber_id,omitempty"`
+	// Barber response. BarberResponse is the live response; a response
+	// awaiting moderation, rejected or redacted is PendingBarberResponse.
+	BarberResponse   *string `json:"barber_response,omitempty"`
+	BarberResponseAt *string `json:"barber_response_at,omitempty"`
+	// pending, approved, rejected, flagged, redacted
+	BarberResponseStatus *string  `json:"barber_response_status,omitempty"`
+	Booking              *Booking `json:"booking,omitempty"`
+	// One review per booking
+	BookingID         *int    `json:"booking_id,omitempty"`
+	CleanlinessRating *int    `json:"cleanliness_rating,omitempty"`
+	Comment           *string `json:"comment,omitempty"`
+	Cons              *string `json:"cons,omitempty"`
+	CreatedAt         *string `json:"created_at,omitempty"`
+	// Relations
+	Customer         json.RawMessage `json:"customer,omitempty"`
+	CustomerID       *int            `json:"customer_id,omitempty"`
+	DurationAccurate *bool           `json:"duration_accurate,omitempty"`
+	// Community interaction
+	HelpfulVotes *int `json:"helpful_votes,omitempty"`
+	ID           *int `json:"id,omitempty"`
+	// Media attachments
+	Images      []string `json:"images,omitempty"`
+	IsPublished *bool    `json:"is_published,omitempty"`
+	// Verification and moderation
+	IsVerified      *bool   `json:"is_verified,omitempty"`
+	ModeratedAt     *string `json:"moderated_at,omitempty"`
+	ModeratedBy     *int    `json:"moderated_by,omitempty"`
+	ModerationNotes *string `json:"moderation_notes,omitempty"`
+	// pending, approved, rejected, flagged
+	ModerationStatus *string `json:"moderation_status,omitempty"`
+	// Overall and detailed ratings (1-5 scale)
+	OverallRating         *int    `json:"overall_rating,omitempty"`
+	ProfessionalismRating *int    `json:"professionalism_rating,omitempty"`
+	Pros                  *string `json:"pros,omitempty"`
+	PunctualityRating     *int    `json:"punctuality_rating,omitempty"`
+	// User reports since the review was last moderated
+	ReportCount          *int  `json:"report_count,omitempty"`
+	ServiceAsExpected    *bool `json:"service_as_expected,omitempty"`
+	ServiceQualityRating *int  `json:"service_quality_rating,omitempty"`
+	// Review content
+	Title               *string `json:"title,omitempty"`
+	TotalVotes          *int    `json:"total_votes,omitempty"`
+	UpdatedAt           *string `json:"updated_at,omitempty"`
+	ValueForMoneyRating *int    `json:"value_for_money_rating,omitempty"`
+	WouldBookAgain      *bool   `json:"would_book_again,omitempty"`
+	// Additional feedback
+	WouldRecommend *bool `json:"would_recommend,omitempty"`
+}
+
+// ReviewImage mirrors models.ReviewImage
+type ReviewImage struct {
+	ContentType  *string `json:"content_type,omitempty"`
+	CreatedAt    *string `json:"created_at,omitempty"`
+	Height       *int    `json:"height,omitempty"`
+	ID           *int    `json:"id,omitempty"`
+	ReviewID     *int    `json:"review_id,omitempty"`
+	SizeBytes    *int    `json:"size_bytes,omitempty"`
+	ThumbnailURL *string `json:"thumbnail_url,omitempty"`
+	UploadedBy   *int    `json:"uploaded_by,omitempty"`
+	URL          *string `json:"url,omitempty"`
+	Width        *int    `json:"width,omitempty"`
+}
+
+// ReviewReport mirrors models.ReviewReport
+type ReviewReport struct {
+	CreatedAt *string `json:"created_at,omitempty"`
+	Details   *string `json:"details,omitempty"`
+	ID        *int    `json:"id,omitempty"`
+	// spam, offensive, fake, personal_information, off_topic, other
+	Reason *string `json:"reason,omitempty"`
+	// Nil once the reporter's account is deleted
+	ReporterID   *int    `json:"reporter_id,omitempty"`
+	ReporterName *string `json:"reporter_name,omitempty"`
+	ReviewID     *int    `json:"review_id,omitempty"`
+}
+
+// Role mirrors models.Role
+type Role struct {
+	CreatedAt   *string  `json:"created_at,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	IsSystem    *bool    `json:"is_system,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	UpdatedAt   *string  `json:"updated_at,omitempty"`
+}
+
+// Service mirrors models.Service
+type Service struct {
+	// Health and safety
+	AllergenWarnings []string `json:"allergen_warnings,omitempty"`
+	// Can add beard trim, etc.
+	AllowsAddOns  *bool   `json:"allows_add_ons,omitempty"`
+	ApprovalNotes *string `json:"approval_notes,omitempty"`
+	// 0-5
+	AverageGlobalRating *float64         `json:"average_global_rating,omitempty"`
+	Category            *ServiceCategory `json:"category,omitempty"`
+	CategoryID          *int             `json:"category_id,omitempty"`
+	// Relations (populated when needed via joins)
+	CategoryName *string `json:"category_name,omitempty"`
+	ChangeLog    JSONMap `json:"change_log,omitempty"`
+	// 1-5 scale (1=easy, 5=expert)
+	Complexity *int `json:"complexity,omitempty"`
+	// Audit fields
+	CreatedAt *string `json:"created_at,omitempty"`
+	// Admin user ID
+	CreatedBy *int `json:"created_by,omitempty"`
+	// USD, EUR, etc.
+	Currency *string `json:"currency,omitempty"`
+	// 45 minutes
+	DefaultDurationMax *int `json:"default_duration_max,omitempty"`
+	// Default duration (barbers can override)
+	DefaultDurationMin  *int     `json:"default_duration_min,omitempty"`
+	DetailedDescription *string  `json:"detailed_description,omitempty"`
+	GalleryImages       []string `json:"gallery_images,omitempty"`
+	// Global statistics (updated periodically)
+	GlobalPopularityScore *float64 `json:"global_popularity_score,omitempty"`
+	// straight, wavy, curly, coily, all
+	HairTypes []string `json:"hair_types,omitempty"`
+	// Service variations and add-ons
+	HasVariations *bool `json:"has_variations,omitempty"`
+	// ["pregnancy", "skin_sensitivity"]
+	HealthPrecautions []string `json:"health_precautions,omitempty"`
+	ID                *int     `json:"id,omitempty"`
+	// Media
+	ImageURL *string `json:"image_url,omitempty"`
+	// Status and approval
+	IsActive *bool `json:"is_active,omitempty"`
+	// Platform approval required
+	IsApproved             *bool    `json:"is_approved,omitempty"`
+	LastModifiedBy         *int     `json:"last_modified_by,omitempty"`
+	MetaDescription        *string  `json:"meta_description,omitempty"`
+	Name                   *string  `json:"name,omitempty"`
+	RequiredCertifications []string `json:"required_certifications,omitempty"`
+	// ["shampoo", "conditioner"]
+	RequiredProducts []string `json:"required_products,omitempty"`
+	// ["scissors", "comb", "clippers"]
+	RequiredTools []string `json:"required_tools,omitempty"`
+	// Service requirements
+	RequiresConsultation *bool `json:"requires_consultation,omitempty"`
+	RequiresHealthCheck  *bool `json:"requires_health_check,omitempty"`
+	// ["fade", "buzz cut", "trim"]
+	SearchKeywords []string `json:"search_keywords,omitempty"`
+	// Service characteristics
+	ServiceType *string `json:"service_type,omitempty"`
+	// Service details
+	ShortDescription *string `json:"short_description,omitempty"`
+	// beginner, intermediate, advanced, expert
+	SkillLevelRequired *string `json:"skill_level_required,omitempty"`
+	// URL-friendly name
+	Slug *string `json:"slug,omitempty"`
+	// $40.00
+	SuggestedPriceMax *float64 `json:"suggested_price_max,omitempty"`
+	// Suggested pricing (barbers set their own prices)
+	SuggestedPriceMin *float64 `json:"suggested_price_min,omitempty"`
+	// SEO and search
+	Tags         []string `json:"tags,omitempty"`
+	TargetAgeMax *int     `json:"target_age_max,omitempty"`
+	TargetAgeMin *int     `json:"target_age_min,omitempty"`
+	// Target demographics
+	TargetGender        *string `json:"target_gender,omitempty"`
+	TotalGlobalBookings *int    `json:"total_global_bookings,omitempty"`
+	TotalGlobalReviews  *int    `json:"total_global_reviews,omitempty"`
+	UpdatedAt           *string `json:"updated_at,omitempty"`
+	UUID                *string `json:"uuid,omitempty"`
+	// Version control
+	Version  *int    `json:"version,omitempty"`
+	VideoURL *string `json:"video_url,omitempty"`
+}
+
+// ServiceAddOn mirrors models.ServiceAddOn
+type ServiceAddOn struct {
+	BarberServiceID *int    `json:"barber_service_id,omitempty"`
+	CreatedAt       *string `json:"created_at,omitempty"`
+	Description     *string `json:"description,omitempty"`
+	DisplayOrder    *int    `json:"display_order,omitempty"`
+	// 0 = no extra time
+	DurationMinutes *int     `json:"duration_minutes,omitempty"`
+	ID              *int     `json:"id,omitempty"`
+	IsActive        *bool    `json:"is_active,omitempty"`
+	Name            *string  `json:"name,omitempty"`
+	Price           *float64 `json:"price,omitempty"`
+	UpdatedAt       *string  `json:"updated_at,omitempty"`
+}
+
+// ServiceCategory mirrors models.ServiceCategory
+type ServiceCategory struct {
+	// Average price in category
+	AveragePrice *float64 `json:"average_price,omitempty"`
+	// Barbers offering services
+	BarberCount *int `json:"barber_count,omitempty"`
+	// "haircuts/mens"
+	CategoryPath *string `json:"category_path,omitempty"`
+	// #FF6B35
+	ColorHex *string `json:"color_hex,omitempty"`
+	// Audit fields
+	CreatedAt *string `json:"created_at,omitempty"`
+	// "Professional hair cutting services"
+	Description *string `json:"description,omitempty"`
+	// Display attributes
+	IconURL *string `json:"icon_url,omitempty"`
+	ID      *int    `json:"id,omitempty"`
+	// Category banner
+	ImageURL *string `json:"image_url,omitempty"`
+	// Status
+	IsActive *bool `json:"is_active,omitempty"`
+	// Show prominently
+	IsFeatured *bool    `json:"is_featured,omitempty"`
+	Keywords   []string `json:"keywords,omitempty"`
+	// 1=main, 2=sub, etc.
+	Level           *int    `json:"level,omitempty"`
+	MetaDescription *string `json:"meta_description,omitempty"`
+	// SEO
+	MetaTitle *string `json:"meta_title,omitempty"`
+	// "Haircuts"
+	Name *string `json:"name,omitempty"`
+	// Relations
+	ParentCategory json.RawMessage `json:"parent_category,omitempty"`
+	// Hierarchy support
+	ParentCategoryID *int `json:"parent_category_id,omitempty"`
+	// Category popularity
+	PopularityScore *float64 `json:"popularity_score,omitempty"`
+	// Statistics (updated periodically)
+	ServiceCount *int      `json:"service_count,omitempty"`
+	Services     []Service `json:"services,omitempty"`
+	// "haircuts"
+	Slug *string `json:"slug,omitempty"`
+	// Display order
+	SortOrder     *int              `json:"sort_order,omitempty"`
+	SubCategories []ServiceCategory `json:"sub_categories,omitempty"`
+	UpdatedAt     *string           `json:"updated_at,omitempty"`
+}
+
+// ServiceSummary mirrors models.ServiceSummary
+type ServiceSummary struct {
+	Currency        *string  `json:"currency,omitempty"`
+	DurationMinutes *int     `json:"duration_minutes,omitempty"`
+	ID              *int     `json:"id,omitempty"`
+	Name            *string  `json:"name,omitempty"`
+	Price           *float64 `json:"price,omitempty"`
+	ServiceID       *int     `json:"service_id,omitempty"`
+}
+
+// Shop mirrors models.Shop
+type Shop struct {
+	// Location and contact
+	Address      *string `json:"address,omitempty"`
+	AddressLine2 *string `json:"address_line_2,omitempty"`
+	// BarberCount is set by listings
+	BarberCount  *int              `json:"barber_count,omitempty"`
+	City         *string           `json:"city,omitempty"`
+	Country      *string           `json:"country,omitempty"`
+	CreatedAt    *string           `json:"created_at,omitempty"`
+	CreatedBy    *int              `json:"created_by,omitempty"`
+	Description  *string           `json:"description,omitempty"`
+	Email        *string           `json:"email,omitempty"`
+	ID           *int              `json:"id,omitempty"`
+	Latitude     *float64          `json:"latitude,omitempty"`
+	Longitude    *float64          `json:"longitude,omitempty"`
+	Name         *string           `json:"name,omitempty"`
+	OpeningHours []ShopHoursPeriod `json:"opening_hours,omitempty"`
+	Phone        *string           `json:"phone,omitempty"`
+	PostalCode   *string           `json:"postal_code,omitempty"`
+	State        *string           `json:"state,omitempty"`
+	TaxID        *string           `json:"tax_id,omitempty"`
+	// Settings
+	Timezone  *string `json:"timezone,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+	UUID      *string `json:"uuid,omitempty"`
+}
+
+// ShopAdmin mirrors models.ShopAdmin
+type ShopAdmin struct {
+	CreatedAt *string `json:"created_at,omitempty"`
+	// owner, admin
+	Role      *string `json:"role,omitempty"`
+	ShopID    *int    `json:"shop_id,omitempty"`
+	UserEmail *string `json:"user_email,omitempty"`
+	UserID    *int    `json:"user_id,omitempty"`
+	UserName  *string `json:"user_name,omitempty"`
+}
+
+// ShopHoursPeriod mirrors models.ShopHoursPeriod
+type ShopHoursPeriod struct {
+	// 0=Sunday, 6=Saturday
+	DayOfWeek *int    `json:"day_of_week,omitempty"`
+	EndTime   *string `json:"end_time,omitempty"`
+	StartTime *string `json:"start_time,omitempty"`
+}
+
+// ShopOffboarding mirrors models.ShopOffboarding
+type ShopOffboarding struct {
+	CompletedAt *string `json:"completed_at,omitempty"`
+	CreatedAt   *string `json:"created_at,omitempty"`
+	// The data is deleted at the first run after this
+	DeleteAfter *string `json:"delete_after,omitempty"`
+	ID          *int    `json:"id,omitempty"`
+	// Why the last deletion attempt was put off
+	LastError   *string `json:"last_error,omitempty"`
+	Reason      *string `json:"reason,omitempty"`
+	RequestedBy *int    `json:"requested_by,omitempty"`
+	ShopID      *int    `json:"shop_id,omitempty"`
+	ShopName    *string `json:"shop_name,omitempty"`
+	// scheduled, cancelled, completed
+	Status *string `json:"status,omitempty"`
+	// Rows deleted or anonymised, by table
+	Summary   json.RawMessage `json:"summary,omitempty"`
+	UpdatedAt *string         `json:"updated_at,omitempty"`
+}
+
+// StatusIncident mirrors models.StatusIncident
+type StatusIncident struct {
+	Component *string `json:"component,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	ID        *int    `json:"id,omitempty"`
+	// degraded, major_outage
+	Impact *string `json:"impact,omitempty"`
+	// Opened by a health check rather than staff
+	IsAutomatic *bool   `json:"is_automatic,omitempty"`
+	Message     *string `json:"message,omitempty"`
+	ResolvedAt  *string `json:"resolved_at,omitempty"`
+	StartedAt   *string `json:"started_at,omitempty"`
+	Title       *string `json:"title,omitempty"`
+	UpdatedAt   *string `json:"updated_at,omitempty"`
+}
+
+// SupportTicket mirrors models.SupportTicket
+type SupportTicket struct {
+	AssignedTo *int `json:"assigned_to,omitempty"`
+	BookingID  *int `json:"booking_id,omitempty"`
+	// booking, payment, account, other
+	Category                *string `json:"category,omitempty"`
+	ClosedAt                *string `json:"closed_at,omitempty"`
+	CreatedAt               *string `json:"created_at,omitempty"`
+	FirstRespondedAt        *string `json:"first_responded_at,omitempty"`
+	FirstResponseBreachedAt *string `json:"first_response_breached_at,omitempty"`
+	FirstResponseDueAt      *string `json:"first_response_due_at,omitempty"`
+	ID                      *int    `json:"id,omitempty"`
+	// Loaded with the ticket when viewing it
+	Messages         []SupportTicketMessage `json:"messages,omitempty"`
+	PaymentReference *string                `json:"payment_reference,omitempty"`
+	// low, normal, high, urgent
+	Priority             *string `json:"priority,omitempty"`
+	RequesterID          *int    `json:"requester_id,omitempty"`
+	ResolutionBreachedAt *string `json:"resolution_breached_at,omitempty"`
+	ResolutionDueAt      *string `json:"resolution_due_at,omitempty"`
+	ResolvedAt           *string `json:"resolved_at,omitempty"`
+	SlaPausedAt          *string `json:"sla_paused_at,omitempty"`
+	// open, in_progress, waiting_on_customer, resolved, closed
+	Status    *string `json:"status,omitempty"`
+	Subject   *string `json:"subject,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// SupportTicketMessage mirrors models.SupportTicketMessage
+type SupportTicketMessage struct {
+	AuthorID  *int    `json:"author_id,omitempty"`
+	Body      *string `json:"body,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	ID        *int    `json:"id,omitempty"`
+	// Staff-only note
+	IsInternal *bool `json:"is_internal,omitempty"`
+	IsStaff    *bool `json:"is_staff,omitempty"`
+	TicketID   *int  `json:"ticket_id,omitempty"`
+}
+
+// Suppression mirrors models.Suppression
+type Suppression struct {
+	// Normalized, see NormalizeAddress
+	Address *string `json:"address,omitempty"`
+	// email or sms
+	Channel   *string `json:"channel,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	// Admin who added it
+	CreatedBy *int    `json:"created_by,omitempty"`
+	Detail    *string `json:"detail,omitempty"`
+	ID        *int    `json:"id,omitempty"`
+	// hard_bounce, spam_complaint, opt_out or manual
+	Reason *string `json:"reason,omitempty"`
+	// Provider that reported it, or admin
+	Source    *string `json:"source,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// TaxReport mirrors models.TaxReport
+type TaxReport struct {
+	BarberID *int            `json:"barber_id,omitempty"`
+	From     *string         `json:"from,omitempty"`
+	Lines    []TaxReportLine `json:"lines,omitempty"`
+	To       *string         `json:"to,omitempty"`
+	Total    *float64        `json:"total,omitempty"`
+}
+
+// TaxReportLine mirrors models.TaxReportLine
+type TaxReportLine struct {
+	Amount        *float64 `json:"amount,omitempty"`
+	Bookings      *int     `json:"bookings,omitempty"`
+	Inclusive     *bool    `json:"inclusive,omitempty"`
+	Name          *string  `json:"name,omitempty"`
+	RatePercent   *float64 `json:"rate_percent,omitempty"`
+	TaxableAmount *float64 `json:"taxable_amount,omitempty"`
+}
+
+// TaxRule mirrors models.TaxRule
+type TaxRule struct {
+	BarberID  *int    `json:"barber_id,omitempty"`
+	Country   *string `json:"country,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	ID        *int    `json:"id,omitempty"`
+	// Prices already include this tax
+	Inclusive   *bool    `json:"inclusive,omitempty"`
+	IsActive    *bool    `json:"is_active,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	RatePercent *float64 `json:"rate_percent,omitempty"`
+	ShopID      *int     `json:"shop_id,omitempty"`
+	State       *string  `json:"state,omitempty"`
+	UpdatedAt   *string  `json:"updated_at,omitempty"`
+}
+
+// TimeOff mirrors models.TimeOff
+type TimeOff struct {
+	// Created from whole dates
+	AllDay    *bool   `json:"all_day,omitempty"`
+	BarberID  *int    `json:"barber_id,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	CreatedBy *int    `json:"created_by,omitempty"`
+	// Exclusive
+	EndAt   *string `json:"end_at,omitempty"`
+	ID      *int    `json:"id,omitempty"`
+	Reason  *string `json:"reason,omitempty"`
+	StartAt *string `json:"start_at,omitempty"`
+}
+
+// TimeRange mirrors models.TimeRange
+type TimeRange struct {
+	EndTime   *string `json:"end_time,omitempty"`
+	StartTime *string `json:"start_time,omitempty"`
+}
+
+// TimeSlot mirrors models.TimeSlot
+type TimeSlot struct {
+	// Relations
+	Barber   json.RawMessage `json:"barber,omitempty"`
+	BarberID *int            `json:"barber_id,omitempty"`
+	// Pricing
+	BasePrice *float64 `json:"base_price,omitempty"`
+	// Audit fields
+	CreatedAt          *string  `json:"created_at,omitempty"`
+	CreatedBy          *int     `json:"created_by,omitempty"`
+	DiscountPercentage *float64 `json:"discount_percentage,omitempty"`
+	DurationMinutes    *int     `json:"duration_minutes,omitempty"`
+	// AI-optimized pricing
+	DynamicPrice *float64 `json:"dynamic_price,omitempty"`
+	EndTime      *string  `json:"end_time,omitempty"`
+	ID           *int     `json:"id,omitempty"`
+	// Availability and type
+	IsAvailable           *bool `json:"is_available,omitempty"`
+	MaxCustomers          *int  `json:"max_customers,omitempty"`
+	MinAdvanceNoticeHours *int  `json:"min_advance_notice_hours,omitempty"`
+	// Metadata
+	Notes   *string        `json:"notes,omitempty"`
+	Service *BarberService `json:"service,omitempty"`
+	// Service constraints
+	ServiceID *int `json:"service_id,omitempty"`
+	// regular, premium, last_minute, group
+	SlotType            *string `json:"slot_type,omitempty"`
+	SpecialRequirements JSONMap `json:"special_requirements,omitempty"`
+	StartTime           *string `json:"start_time,omitempty"`
+	UpdatedAt           *string `json:"updated_at,omitempty"`
+}
+
+// TimelineEntry mirrors models.TimelineEntry
+type TimelineEntry struct {
+	BookingID  *int    `json:"booking_id,omitempty"`
+	CustomerID *int    `json:"customer_id,omitempty"`
+	Details    JSONMap `json:"details,omitempty"`
+	// See config.TimelineEvent*
+	EventType  *string `json:"event_type,omitempty"`
+	ID         *int    `json:"id,omitempty"`
+	OccurredAt *string `json:"occurred_at,omitempty"`
+	// booking_history, review, payment
+	Source   *string `json:"source,omitempty"`
+	SourceID *int    `json:"source_id,omitempty"`
+	Title    *string `json:"title,omitempty"`
+}
+
+// TimelineState mirrors models.TimelineState
+type TimelineState struct {
+	CustomerID *int    `json:"customer_id,omitempty"`
+	EventCount *int    `json:"event_count,omitempty"`
+	RebuiltAt  *string `json:"rebuilt_at,omitempty"`
+	// Newest source event
+	Watermark *string `json:"watermark,omitempty"`
+}
+
+// TravelTime mirrors models.TravelTime
+type TravelTime struct {
+	FromLocationID *int `json:"from_location_id,omitempty"`
+	Minutes        *int `json:"minutes,omitempty"`
+	ToLocationID   *int `json:"to_location_id,omitempty"`
+}
+
+// User mirrors models.User
+type User struct {
+	// Location information
+	Address *string `json:"address,omitempty"`
+	City    *string `json:"city,omitempty"`
+	Country *string `json:"country,omitempty"`
+	// Audit fields
+	CreatedAt *string `json:"created_at,omitempty"`
+	CreatedBy *int    `json:"created_by,omitempty"`
+	// Personal information
+	DateOfBirth *string `json:"date_of_birth,omitempty"`
+	DeletedAt   *string `json:"deleted_at,omitempty"`
+	Email       *string `json:"email,omitempty"`
+	// Verification and security
+	EmailVerified       *bool `json:"email_verified,omitempty"`
+	FailedLoginAttempts *int  `json:"failed_login_attempts,omitempty"`
+	// male, female, other, prefer_not_to_say
+	Gender               *string  `json:"gender,omitempty"`
+	ID                   *int     `json:"id,omitempty"`
+	LastLoginAt          *string  `json:"last_login_at,omitempty"`
+	Latitude             *float64 `json:"latitude,omitempty"`
+	LockedUntil          *string  `json:"locked_until,omitempty"`
+	Longitude            *float64 `json:"longitude,omitempty"`
+	Name                 *string  `json:"name,omitempty"`
+	NotificationSettings JSONMap  `json:"notification_settings,omitempty"`
+	Phone                *string  `json:"phone,omitempty"`
+	PhoneVerified        *bool    `json:"phone_verified,omitempty"`
+	PostalCode           *string  `json:"postal_code,omitempty"`
+	// User preferences and settings
+	Preferences       json.RawMessage `json:"preferences,omitempty"`
+	ProfilePictureURL *string         `json:"profile_picture_url,omitempty"`
+	State             *string         `json:"state,omitempty"`
+	// active, inactive, suspended, deleted
+	Status           *string `json:"status,omitempty"`
+	TwoFactorEnabled *bool   `json:"two_factor_enabled,omitempty"`
+	UpdatedAt        *string `json:"updated_at,omitempty"`
+	// customer, barber, admin
+	UserType *string `json:"user_type,omitempty"`
+	UUID     *string `json:"uuid,omitempty"`
+}
+
+// UserAccess mirrors models.UserAccess
+type UserAccess struct {
+	Permissions []string   `json:"permissions,omitempty"`
+	Roles       []UserRole `json:"roles,omitempty"`
+	UserID      *int       `json:"user_id,omitempty"`
+	UserType    *string    `json:"user_type,omitempty"`
+}
+
+// UserIdentity mirrors models.UserIdentity
+type UserIdentity struct {
+	CreatedAt *string `json:"created_at,omitempty"`
+	// Email reported by the provider when linked
+	Email       *string `json:"email,omitempty"`
+	ID          *int    `json:"id,omitempty"`
+	LastLoginAt *string `json:"last_login_at,omitempty"`
+	// google, apple
+	Provider *string `json:"provider,omitempty"`
+	UserID   *int    `json:"user_id,omitempty"`
+}
+
+// UserRole mirrors models.UserRole
+type UserRole struct {
+	GrantedAt *string `json:"granted_at,omitempty"`
+	GrantedBy *int    `json:"granted_by,omitempty"`
+	Role      *string `json:"role,omitempty"`
+	UserID    *int    `json:"user_id,omitempty"`
+}
+
+// WebhookDelivery mirrors models.WebhookDelivery
+type WebhookDelivery struct {
+	Attempts      *int                   `json:"attempts,omitempty"`
+	CreatedAt     *string                `json:"created_at,omitempty"`
+	DeliveredAt   *string                `json:"delivered_at,omitempty"`
+	EventID       *string                `json:"event_id,omitempty"`
+	EventType     *string                `json:"event_type,omitempty"`
+	ID            *int                   `json:"id,omitempty"`
+	LastError     *string                `json:"last_error,omitempty"`
+	NextAttemptAt *string                `json:"next_attempt_at,omitempty"`
+	Payload       map[string]interface{} `json:"payload,omitempty"`
+	// HTTP status of the last attempt
+	ResponseStatus *int `json:"response_status,omitempty"`
+	// pending, delivered, failed
+	Status         *string `json:"status,omitempty"`
+	SubscriptionID *int    `json:"subscription_id,omitempty"`
+}
+
+// WebhookSubscription mirrors models.WebhookSubscription
+type WebhookSubscription struct {
+	BarberID    *int     `json:"barber_id,omitempty"`
+	CreatedAt   *string  `json:"created_at,omitempty"`
+	CreatedBy   *int     `json:"created_by,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Events      []string `json:"events,omitempty"`
+	ID          *int     `json:"id,omitempty"`
+	IsActive    *bool    `json:"is_active,omitempty"`
+	UpdatedAt   *string  `json:"updated_at,omitempty"`
+	URL         *string  `json:"url,omitempty"`
+}
+
+// WinBackCampaign mirrors models.WinBackCampaign
+type WinBackCampaign struct {
+	CouponPercentOff *int    `json:"coupon_percent_off,omitempty"`
+	CouponValidDays  *int    `json:"coupon_valid_days,omitempty"`
+	EligibleCount    *int    `json:"eligible_count,omitempty"`
+	FinishedAt       *string `json:"finished_at,omitempty"`
+	ID               *int    `json:"id,omitempty"`
+	InactiveWeeks    *int    `json:"inactive_weeks,omitempty"`
+	SentCount        *int    `json:"sent_count,omitempty"`
+	StartedAt        *string `json:"started_at,omitempty"`
+	// nil when run by the scheduler
+	TriggeredBy *int `json:"triggered_by,omitempty"`
+}
+
+// WinBackCampaignStats mirrors models.WinBackCampaignStats
+type WinBackCampaignStats struct {
+	ConversionRate *float64 `json:"conversion_rate,omitempty"`
+	// Customers who booked within the attribution window
+	Converted        *int    `json:"converted,omitempty"`
+	CouponPercentOff *int    `json:"coupon_percent_off,omitempty"`
+	CouponValidDays  *int    `json:"coupon_valid_days,omitempty"`
+	CouponsRedeemed  *int    `json:"coupons_redeemed,omitempty"`
+	EligibleCount    *int    `json:"eligible_count,omitempty"`
+	FinishedAt       *string `json:"finished_at,omitempty"`
+	ID               *int    `json:"id,omitempty"`
+	InactiveWeeks    *int    `json:"inactive_weeks,omitempty"`
+	// Percentages of SentCount; nil before anything is sent
+	OpenRate *float64 `json:"open_rate,omitempty"`
+	// Notifications read
+	Opened *int `json:"opened,omitempty"`
+	// Total price of converting bookings
+	Revenue   *float64 `json:"revenue,omitempty"`
+	SentCount *int     `json:"sent_count,omitempty"`
+	StartedAt *string  `json:"started_at,omitempty"`
+	// nil when run by the scheduler
+	TriggeredBy *int `json:"triggered_by,omitempty"`
+}
+
+// WinBackCandidate mirrors models.WinBackCandidate
+type WinBackCandidate struct {
+	CompletedVisits *int    `json:"completed_visits,omitempty"`
+	CustomerID      *int    `json:"customer_id,omitempty"`
+	CustomerName    *string `json:"customer_name,omitempty"`
+	FirstVisitAt    *string `json:"first_visit_at,omitempty"`
+	LastBarberName  *string `json:"last_barber_name,omitempty"`
+	LastServiceName *string `json:"last_service_name,omitempty"`
+	LastVisitAt     *string `json:"last_visit_at,omitempty"`
+}
+
+// Contribution mirrors ranking.Contribution
+type Contribution struct {
+	Contribution *float64 `json:"contribution,omitempty"`
+	Factor       *string  `json:"factor,omitempty"`
+	// 0-1
+	Value *float64 `json:"value,omitempty"`
+	// Normalized
+	Weight *float64 `json:"weight,omitempty"`
+}
+
+// Explanation mirrors ranking.Explanation
+type Explanation struct {
+	Factors []Contribution `json:"factors,omitempty"`
+	Score   *float64       `json:"score,omitempty"`
+}
+
+// AcceptConsultationQuoteRequest mirrors services.AcceptConsultationQuoteRequest
+type AcceptConsultationQuoteRequest struct {
+	// Provider token for the card holding the deposit, when the service
+	// requires one
+	DepositPaymentMethodID *string `json:"deposit_payment_method_id,omitempty"`
+}
+
+// ActionLinkPreview mirrors services.ActionLinkPreview
+type ActionLinkPreview struct {
+	// confirm, cancel
+	Action             *string `json:"action,omitempty"`
+	BookingID          *int    `json:"booking_id,omitempty"`
+	BookingNumber      *string `json:"booking_number,omitempty"`
+	BookingStatus      *string `json:"booking_status,omitempty"`
+	ExpiresAt          *string `json:"expires_at,omitempty"`
+	ScheduledStartTime *string `json:"scheduled_start_time,omitempty"`
+}
+
+// ActionLinkResult mirrors services.ActionLinkResult
+type ActionLinkResult struct {
+	Action        *string `json:"action,omitempty"`
+	BookingID     *int    `json:"booking_id,omitempty"`
+	BookingStatus *string `json:"booking_status,omitempty"`
+	// Set when cancelling a prepaid booking
+	Refund json.RawMessage `json:"refund,omitempty"`
+	UsedAt *string         `json:"used_at,omitempty"`
+}
+
+// ActivityFeedResponse mirrors services.ActivityFeedResponse
+type ActivityFeedResponse struct {
+	Entries []AuditLog `json:"entries,omitempty"`
+	// NextBeforeID is passed back as before_id to fetch the next (older) page; nil when exhausted
+	NextBeforeID *int `json:"next_before_id,omitempty"`
+}
+
+// AddOnRequest mirrors services.AddOnRequest
+type AddOnRequest struct {
+	Description     *string `json:"description,omitempty"`
+	DisplayOrder    *int    `json:"display_order,omitempty"`
+	DurationMinutes *int    `json:"duration_minutes,omitempty"`
+	// Default: true
+	IsActive *bool    `json:"is_active,omitempty"`
+	Name     string   `json:"name"`
+	Price    *float64 `json:"price,omitempty"`
+}
+
+// AddShopAdminRequest mirrors services.AddShopAdminRequest
+type AddShopAdminRequest struct {
+	UserID int `json:"user_id"`
+}
+
+// AddShopBarberRequest mirrors services.AddShopBarberRequest
+type AddShopBarberRequest struct {
+	BarberID int `json:"barber_id"`
+}
+
+// AffectedBooking mirrors services.AffectedBooking
+type AffectedBooking struct {
+	BookingID          *int        `json:"booking_id,omitempty"`
+	BookingNumber      *string     `json:"booking_number,omitempty"`
+	CustomerID         *int        `json:"customer_id,omitempty"`
+	RescheduleOffered  *bool       `json:"reschedule_offered,omitempty"`
+	ScheduledEndTime   *string     `json:"scheduled_end_time,omitempty"`
+	ScheduledStartTime *string     `json:"scheduled_start_time,omitempty"`
+	SuggestedSlots     []TimeRange `json:"suggested_slots,omitempty"`
+}
+
+// AssignRoleRequest mirrors services.AssignRoleRequest
+type AssignRoleRequest struct {
+	Role string `json:"role"`
+}
+
+// AssistantShare mirrors services.AssistantShare
+type AssistantShare struct {
+	BarberID     int     `json:"barber_id"`
+	SharePercent float64 `json:"share_percent"`
+}
+
+// AuthResponse mirrors services.AuthResponse
+type AuthResponse struct {
+	ExpiresAt        *string `json:"expires_at,omitempty"`
+	RefreshExpiresAt *string `json:"refresh_expires_at,omitempty"`
+	// Exchange at POST /auth/refresh for a new token pair; single use
+	RefreshToken *string              `json:"refresh_token,omitempty"`
+	Token        *string              `json:"token,omitempty"`
+	User         *UserProfileResponse `json:"user,omitempty"`
+}
+
+// AutoReplyResponse mirrors services.AutoReplyResponse
+type AutoReplyResponse struct {
+	BarberID  *int    `json:"barber_id,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	Enabled   *bool   `json:"enabled,omitempty"`
+	// May use {{placeholders}}, see config.AutoReplyVariables
+	Message *string `json:"message,omitempty"`
+	// In the barber's timezone
+	NextOpenTime *string `json:"next_open_time,omitempty"`
+	// Whether the barber is working now (no reply is sent)
+	OpenNow *bool `json:"open_now,omitempty"`
+	// Rendered for a sample booking
+	Preview   *string `json:"preview,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+	// Placeholders the message may use
+	Variables []string `json:"variables,omitempty"`
+}
+
+// BarberResponseRequest mirrors services.BarberResponseRequest
+type BarberResponseRequest struct {
+	Response string `json:"response"`
+}
+
+// BookingFormFieldRequest mirrors services.BookingFormFieldRequest
+type BookingFormFieldRequest struct {
+	// Ask only when this service is booked; it must require a consultation
+	BarberServiceID *int `json:"barber_service_id,omitempty"`
+	// Answers that need a consultation first (select and checkbox
+	// questions on a service; checkboxes answer "true" or "false")
+	ConsultationAnswers []string `json:"consultation_answers,omitempty"`
+	DisplayOrder        *int     `json:"display_order,omitempty"`
+	// text, number, select, checkbox
+	FieldType string  `json:"field_type"`
+	HelpText  *string `json:"help_text,omitempty"`
+	// Default: true
+	IsActive   *bool  `json:"is_active,omitempty"`
+	IsRequired *bool  `json:"is_required,omitempty"`
+	Key        string `json:"key"`
+	Label      string `json:"label"`
+	// Text fields (default 500)
+	MaxLength *int `json:"max_length,omitempty"`
+	// Number fields
+	MaxValue *float64 `json:"max_value,omitempty"`
+	// Number fields
+	MinValue *float64 `json:"min_value,omitempty"`
+	// Select fields: the choices
+	Options []string `json:"options,omitempty"`
+	// Text fields; the whole answer must match
+	Pattern *string `json:"pattern,omitempty"`
+}
+
+// BookingImportResult mirrors services.BookingImportResult
+type BookingImportResult struct {
+	Created    *int `json:"created,omitempty"`
+	Duplicates *int `json:"duplicates,omitempty"`
+	Failed     *int `json:"failed,omitempty"`
+	// Created bookings linked to a customer account
+	Linked *int                     `json:"linked,omitempty"`
+	Rows   []BookingImportRowResult `json:"rows,omitempty"`
+	Total  *int                     `json:"total,omitempty"`
+}
+
+// BookingImportRowResult mirrors services.BookingImportRowResult
+type BookingImportRowResult struct {
+	BookingID *int `json:"booking_id,omitempty"`
+	// The account the booking was linked to
+	CustomerID *int    `json:"customer_id,omitempty"`
+	Error      *string `json:"error,omitempty"`
+	Line       *int    `json:"line,omitempty"`
+	// created, duplicate, failed
+	Status *string `json:"status,omitempty"`
+}
+
+// BookingResponse mirrors services.BookingResponse
+type BookingResponse struct {
+	ActualEndTime   *string `json:"actual_end_time,omitempty"`
+	ActualStartTime *string `json:"actual_start_time,omitempty"`
+	// ArchivedAt is set for bookings read from the archive
+	ArchivedAt *string        `json:"archived_at,omitempty"`
+	Barber     *BarberSummary `json:"barber,omitempty"`
+	BarberID   *int           `json:"barber_id,omitempty"`
+	// Service information
+	BarberServiceID *int `json:"barber_service_id,omitempty"`
+	// Human-readable reference
+	BookingNumber *string `json:"booking_number,omitempty"`
+	// Source and attribution
+	BookingSource   *string  `json:"booking_source,omitempty"`
+	CanCancel       *bool    `json:"can_cancel,omitempty"`
+	CanReschedule   *bool    `json:"can_reschedule,omitempty"`
+	CancellationFee *float64 `json:"cancellation_fee,omitempty"`
+	// Free text
+	CancellationReason     *string `json:"cancellation_reason,omitempty"`
+	CancellationReasonCode *string `json:"cancellation_reason_code,omitempty"`
+	// Cancellation information
+	CancelledAt *string `json:"cancelled_at,omitempty"`
+	CancelledBy *int    `json:"cancelled_by,omitempty"`
+	// Communication tracking
+	ConfirmationMethod *string `json:"confirmation_method,omitempty"`
+	ConfirmationSentAt *string `json:"confirmation_sent_at,omitempty"`
+	// Audit fields
+	CreatedAt *string `json:"created_at,omitempty"`
+	Currency  *string `json:"currency,omitempty"`
+	// Answers to the barber's custom booking-form fields
+	CustomFields []BookingFormAnswer `json:"custom_fields,omitempty"`
+	// Relations attached to lists on request (see relations.go)
+	Customer      json.RawMessage `json:"customer,omitempty"`
+	CustomerEmail *string         `json:"customer_email,omitempty"`
+	// Relationships
+	CustomerID *int `json:"customer_id,omitempty"`
+	// Customer information (for guest bookings)
+	CustomerName  *string `json:"customer_name,omitempty"`
+	CustomerPhone *string `json:"customer_phone,omitempty"`
+	// Deposit held when booking and the fee kept from it on a no-show
+	DepositAmount  *float64 `json:"deposit_amount,omitempty"`
+	DiscountAmount *float64 `json:"discount_amount,omitempty"`
+	// Active/passive split copied from the service (nil = fully active)
+	DurationSegments         []DurationSegment `json:"duration_segments,omitempty"`
+	EstimatedDurationMinutes *int              `json:"estimated_duration_minutes,omitempty"`
+	ID                       *int              `json:"id,omitempty"`
+	// Row an imported booking came from
+	ImportRef *string `json:"import_ref,omitempty"`
+	// For barber use only
+	InternalNotes *string `json:"internal_notes,omitempty"`
+	// Created in sandbox mode
+	IsTest *bool `json:"is_test,omitempty"`
+	// The services performed, in order (populated when needed)
+	LineItems []BookingLineItem `json:"line_items,omitempty"`
+	// One of the barber's locations (nil = the barber's address)
+	LocationID *int     `json:"location_id,omitempty"`
+	NoShowFee  *float64 `json:"no_show_fee,omitempty"`
+	// Booking details
+	Notes            *string `json:"notes,omitempty"`
+	PaidAt           *string `json:"paid_at,omitempty"`
+	PaymentMethod    *string `json:"payment_method,omitempty"`
+	PaymentReference *string `json:"payment_reference,omitempty"`
+	// Payment information
+	PaymentStatus  *string `json:"payment_status,omitempty"`
+	ReferralSource *string `json:"referral_source,omitempty"`
+	// Refund is set when cancelling a prepaid booking
+	Refund           json.RawMessage `json:"refund,omitempty"`
+	ReminderSentAt   *string         `json:"reminder_sent_at,omitempty"`
+	Review           *Review         `json:"review,omitempty"`
+	ScheduledEndTime *string         `json:"scheduled_end_time,omitempty"`
+	// Timing
+	ScheduledStartTime *string `json:"scheduled_start_time,omitempty"`
+	// Set for occurrences of a recurring booking
+	SeriesID        *int            `json:"series_id,omitempty"`
+	Service         *ServiceSummary `json:"service,omitempty"`
+	ServiceCategory *string         `json:"service_category,omitempty"`
+	ServiceName     *string         `json:"service_name,omitempty"`
+	// Pricing breakdown
+	ServicePrice    *float64 `json:"service_price,omitempty"`
+	SpecialRequests *string  `json:"special_requests,omitempty"`
+	// Booking status
+	Status    *string  `json:"status,omitempty"`
+	TaxAmount *float64 `json:"tax_amount,omitempty"`
+	// Tax line items behind TaxAmount (populated when needed)
+	TaxLines    []BookingTaxLine `json:"tax_lines,omitempty"`
+	TimeSlot    *TimeSlot        `json:"time_slot,omitempty"`
+	TimeSlotID  *int             `json:"time_slot_id,omitempty"`
+	TimeUntil   *string          `json:"time_until,omitempty"`
+	TipAmount   *float64         `json:"tip_amount,omitempty"`
+	TotalPrice  *float64         `json:"total_price,omitempty"`
+	UpdatedAt   *string          `json:"updated_at,omitempty"`
+	UTMCampaign *string          `json:"utm_campaign,omitempty"`
+	UUID        *string          `json:"uuid,omitempty"`
+}
+
+// BookingStaffRequest mirrors services.BookingStaffRequest
+type BookingStaffRequest struct {
+	Assistants []AssistantShare `json:"assistants,omitempty"`
+}
+
+// BookingStaffResponse mirrors services.BookingStaffResponse
+type BookingStaffResponse struct {
+	BookingID *int           `json:"booking_id,omitempty"`
+	Staff     []BookingStaff `json:"staff,omitempty"`
+}
+
+// BroadcastOpenSlotRequest mirrors services.BroadcastOpenSlotRequest
+type BroadcastOpenSlotRequest struct {
+	// favorites, nearby (default: both)
+	Audiences       []string `json:"audiences,omitempty"`
+	BarberServiceID int      `json:"barber_service_id"`
+	// Flash discount, at most 50
+	DiscountPercent *int `json:"discount_percent,omitempty"`
+	// Default: the service's duration
+	DurationMinutes *int    `json:"duration_minutes,omitempty"`
+	Message         *string `json:"message,omitempty"`
+	// Nearby audience (default 5, at most 50)
+	RadiusKm  *float64 `json:"radius_km,omitempty"`
+	StartTime string   `json:"start_time"`
+}
+
+// BulkModerationItemResult mirrors services.BulkModerationItemResult
+type BulkModerationItemResult struct {
+	Error    *string `json:"error,omitempty"`
+	ReviewID *int    `json:"review_id,omitempty"`
+	// moderated, failed
+	Status *string `json:"status,omitempty"`
+}
+
+// BulkModerationRequest mirrors services.BulkModerationRequest
+type BulkModerationRequest struct {
+	Action    string  `json:"action"`
+	Notes     *string `json:"notes,omitempty"`
+	Reason    *string `json:"reason,omitempty"`
+	ReviewIds []int   `json:"review_ids"`
+}
+
+// BulkModerationResult mirrors services.BulkModerationResult
+type BulkModerationResult struct {
+	Action    *string                    `json:"action,omitempty"`
+	Failed    *int                       `json:"failed,omitempty"`
+	Moderated *int                       `json:"moderated,omitempty"`
+	Reviews   []BulkModerationItemResult `json:"reviews,omitempty"`
+	Total     *int                       `json:"total,omitempty"`
+}
+
+// CalendarFeedResponse mirrors services.CalendarFeedResponse
+type CalendarFeedResponse struct {
+	CreatedAt *string `json:"created_at,omitempty"`
+	ID        *int    `json:"id,omitempty"`
+	// Last fetched by a calendar app
+	LastAccessedAt *string `json:"last_accessed_at,omitempty"`
+	UpdatedAt      *string `json:"updated_at,omitempty"`
+	URL            *string `json:"url,omitempty"`
+	UserID         *int    `json:"user_id,omitempty"`
+	// Opens the subscribe dialog of Apple Calendar
+	WebcalURL *string `json:"webcal_url,omitempty"`
+}
+
+// CancelBookingRequest mirrors services.CancelBookingRequest
+type CancelBookingRequest struct {
+	IsByCustomer *bool `json:"is_by_customer,omitempty"`
+	// Free text (optional)
+	Reason *string `json:"reason,omitempty"`
+	// One of the cancellation reasons (optional)
+	ReasonCode *string `json:"reason_code,omitempty"`
+}
+
+// CancellationReason mirrors services.CancellationReason
+type CancellationReason struct {
+	Code  *string `json:"code,omitempty"`
+	Label *string `json:"label,omitempty"`
+}
+
+// ChangePasswordRequest mirrors services.ChangePasswordRequest
+type ChangePasswordRequest struct {
+	ConfirmPassword string `json:"confirm_password"`
+	NewPassword     string `json:"new_password"`
+	OldPassword     string `json:"old_password"`
+}
+
+// CheckoutRequest mirrors services.CheckoutRequest
+type CheckoutRequest struct {
+	// Optional extras from the booked services' add-on catalogs
+	AddOnIds []int `json:"add_on_ids,omitempty"`
+	// Required fields
+	BarberID *int `json:"barber_id,omitempty"`
+	// mobile_app, web_app, phone, walk_in
+	BookingSource *string `json:"booking_source,omitempty"`
+	// Replaces discount_amount; single bookings only
+	CouponCode *string `json:"coupon_code,omitempty"`
+	// Answers to the barber's custom booking-form fields (key -> value)
+	CustomFields  map[string]interface{} `json:"custom_fields,omitempty"`
+	CustomerEmail *string                `json:"customer_email,omitempty"`
+	// Customer info (either customer_id OR guest info)
+	CustomerID    *int    `json:"customer_id,omitempty"`
+	CustomerName  *string `json:"customer_name,omitempty"`
+	CustomerPhone *string `json:"customer_phone,omitempty"`
+	// Provider token for the card holding the deposit, when the services
+	// require one (e.g. a Stripe pm_... id)
+	DepositPaymentMethodID *string  `json:"deposit_payment_method_id,omitempty"`
+	DiscountAmount         *float64 `json:"discount_amount,omitempty"`
+	// Default: the services' and add-ons' combined duration
+	DurationMinutes *int `json:"duration_minutes,omitempty"`
+	// One of the barber's locations (default: the barber's address)
+	LocationID *int `json:"location_id,omitempty"`
+	// Optional fields
+	Notes *string `json:"notes,omitempty"`
+	// Provider token for the customer's card (e.g. a Stripe pm_... id)
+	PaymentMethodID string `json:"payment_method_id"`
+	// Recurrence (optional - creates a standing appointment series)
+	Recurrence json.RawMessage `json:"recurrence,omitempty"`
+	// Services: service_id for one, or service_ids for several performed
+	// back to back, in order
+	ServiceID  *int  `json:"service_id,omitempty"`
+	ServiceIds []int `json:"service_ids,omitempty"`
+	// Pricing (optional - will be calculated if not provided)
+	ServicePrice *float64 `json:"service_price,omitempty"`
+	// Instead of barber_id: book any available barber of the shop. The
+	// services are then catalog services (services.id), not a barber's.
+	ShopID          *int    `json:"shop_id,omitempty"`
+	SpecialRequests *string `json:"special_requests,omitempty"`
+	StartTime       string  `json:"start_time"`
+}
+
+// ClaimAccountRequest mirrors services.ClaimAccountRequest
+type ClaimAccountRequest struct {
+	Password string `json:"password"`
+	Token    string `json:"token"`
+}
+
+// ClaimOpenSlotRequest mirrors services.ClaimOpenSlotRequest
+type ClaimOpenSlotRequest struct {
+	CustomFields           map[string]interface{} `json:"custom_fields,omitempty"`
+	DepositPaymentMethodID *string                `json:"deposit_payment_method_id,omitempty"`
+	Notes                  *string                `json:"notes,omitempty"`
+}
+
+// ClientImportResult mirrors services.ClientImportResult
+type ClientImportResult struct {
+	Created    *int                    `json:"created,omitempty"`
+	Duplicates *int                    `json:"duplicates,omitempty"`
+	Failed     *int                    `json:"failed,omitempty"`
+	Invited    *int                    `json:"invited,omitempty"`
+	Linked     *int                    `json:"linked,omitempty"`
+	Rows       []ClientImportRowResult `json:"rows,omitempty"`
+	Total      *int                    `json:"total,omitempty"`
+}
+
+// ClientImportRowResult mirrors services.ClientImportRowResult
+type ClientImportRowResult struct {
+	ClientID *int    `json:"client_id,omitempty"`
+	Error    *string `json:"error,omitempty"`
+	Invited  *bool   `json:"invited,omitempty"`
+	Line     *int    `json:"line,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	// created, linked, duplicate, failed
+	Status *string `json:"status,omitempty"`
+	UserID *int    `json:"user_id,omitempty"`
+}
+
+// CompleteBookingRequest mirrors services.CompleteBookingRequest
+type CompleteBookingRequest struct {
+	// Required when service_price changes the price
+	Reason *string `json:"reason,omitempty"`
+	// Price of the work done, before discount and tax
+	ServicePrice *float64 `json:"service_price,omitempty"`
+}
+
+// ComponentDay mirrors services.ComponentDay
+type ComponentDay struct {
+	// YYYY-MM-DD
+	Date *string `json:"date,omitempty"`
+	// The worst incident impact that day
+	Status        *string  `json:"status,omitempty"`
+	UptimePercent *float64 `json:"uptime_percent,omitempty"`
+}
+
+// ComponentReport mirrors services.ComponentReport
+type ComponentReport struct {
+	CheckedAt *string `json:"checked_at,omitempty"`
+	Component *string `json:"component,omitempty"`
+	// Oldest first, ending today (UTC)
+	History []ComponentDay `json:"history,omitempty"`
+	Name    *string        `json:"name,omitempty"`
+	Status  *string        `json:"status,omitempty"`
+	// Over the whole history
+	UptimePercent *float64 `json:"uptime_percent,omitempty"`
+}
+
+// ConfirmationResponseResult mirrors services.ConfirmationResponseResult
+type ConfirmationResponseResult struct {
+	BookingID     *int    `json:"booking_id,omitempty"`
+	BookingStatus *string `json:"booking_status,omitempty"`
+	// Set when cancelling a prepaid booking
+	Refund      json.RawMessage `json:"refund,omitempty"`
+	RespondedAt *string         `json:"responded_at,omitempty"`
+	// confirmed, cancelled
+	Response *string `json:"response,omitempty"`
+}
+
+// ConsultationQuoteRequest mirrors services.ConsultationQuoteRequest
+type ConsultationQuoteRequest struct {
+	Notes     *string  `json:"notes,omitempty"`
+	Price     *float64 `json:"price,omitempty"`
+	StartTime string   `json:"start_time"`
+}
+
+// ConsultationResponseRequest mirrors services.ConsultationResponseRequest
+type ConsultationResponseRequest struct {
+	Notes  *string `json:"notes,omitempty"`
+	Status string  `json:"status"`
+}
+
+// CreateBarberRequest mirrors services.CreateBarberRequest
+type CreateBarberRequest struct {
+	Address                    string   `json:"address"`
+	AddressLine2               *string  `json:"address_line_2,omitempty"`
+	BusinessEmail              *string  `json:"business_email,omitempty"`
+	BusinessName               *string  `json:"business_name,omitempty"`
+	BusinessRegistrationNumber *string  `json:"business_registration_number,omitempty"`
+	Certifications             []string `json:"certifications,omitempty"`
+	City                       string   `json:"city"`
+	Country                    string   `json:"country"`
+	Description                *string  `json:"description,omitempty"`
+	LanguagesSpoken            []string `json:"languages_spoken,omitempty"`
+	Latitude                   *float64 `json:"latitude,omitempty"`
+	Longitude                  *float64 `json:"longitude,omitempty"`
+	Phone                      *string  `json:"phone,omitempty"`
+	PostalCode                 string   `json:"postal_code"`
+	ShopName                   string   `json:"shop_name"`
+	Specialties                []string `json:"specialties,omitempty"`
+	State                      string   `json:"state"`
+	TaxID                      *string  `json:"tax_id,omitempty"`
+	UserID                     int      `json:"user_id"`
+	WebsiteURL                 *string  `json:"website_url,omitempty"`
+	WorkingHours               JSONMap  `json:"working_hours,omitempty"`
+	YearsExperience            *int     `json:"years_experience,omitempty"`
+}
+
+// CreateBarberServiceRequest mirrors services.CreateBarberServiceRequest
+type CreateBarberServiceRequest struct {
+	AdvanceNoticeHours   *int     `json:"advance_notice_hours,omitempty"`
+	AvailableDays        []string `json:"available_days,omitempty"`
+	AvailableTimeSlots   JSONMap  `json:"available_time_slots,omitempty"`
+	BarberID             int      `json:"barber_id"`
+	BeforeAfterImages    []string `json:"before_after_images,omitempty"`
+	BufferTimeMinutes    *int     `json:"buffer_time_minutes,omitempty"`
+	ConsultationDuration *int     `json:"consultation_duration,omitempty"`
+	Currency             *string  `json:"currency,omitempty"`
+	CustomDescription    *string  `json:"custom_description,omitempty"`
+	CustomName           *string  `json:"custom_name,omitempty"`
+	// percentage, fixed; nil = no deposit
+	DepositType *string `json:"deposit_type,omitempty"`
+	// Percent of the price, or an amount
+	DepositValue       *float64 `json:"deposit_value,omitempty"`
+	DiscountPrice      *float64 `json:"discount_price,omitempty"`
+	DiscountValidUntil *string  `json:"discount_valid_until,omitempty"`
+	DisplayOrder       *int     `json:"display_order,omitempty"`
+	// Active/passive split adding up to estimated_duration_min
+	DurationSegments      []DurationSegment `json:"duration_segments,omitempty"`
+	EstimatedDurationMax  *int              `json:"estimated_duration_max,omitempty"`
+	EstimatedDurationMin  int               `json:"estimated_duration_min"`
+	IsFeatured            *bool             `json:"is_featured,omitempty"`
+	IsPromotional         *bool             `json:"is_promotional,omitempty"`
+	IsSeasonal            *bool             `json:"is_seasonal,omitempty"`
+	MaxAdvanceBookingDays *int              `json:"max_advance_booking_days,omitempty"`
+	MaxCustomerAge        *int              `json:"max_customer_age,omitempty"`
+	MaxPrice              *float64          `json:"max_price,omitempty"`
+	MinCustomerAge        *int              `json:"min_customer_age,omitempty"`
+	// Kept from the deposit on a no-show; nil = the whole deposit
+	NoShowFee              *float64 `json:"no_show_fee,omitempty"`
+	PortfolioImages        []string `json:"portfolio_images,omitempty"`
+	PostServiceCare        *string  `json:"post_service_care,omitempty"`
+	PreServiceInstructions *string  `json:"pre_service_instructions,omitempty"`
+	Price                  float64  `json:"price"`
+	PromotionEndDate       *string  `json:"promotion_end_date,omitempty"`
+	PromotionStartDate     *string  `json:"promotion_start_date,omitempty"`
+	PromotionalText        *string  `json:"promotional_text,omitempty"`
+	RequiresConsultation   *bool    `json:"requires_consultation,omitempty"`
+	SeasonalEndMonth       *int     `json:"seasonal_end_month,omitempty"`
+	SeasonalStartMonth     *int     `json:"seasonal_start_month,omitempty"`
+	ServiceID              int      `json:"service_id"`
+	ServiceNote            *string  `json:"service_note,omitempty"`
+}
+
+// CreateBookingRequest mirrors services.CreateBookingRequest
+type CreateBookingRequest struct {
+	// Optional extras from the booked services' add-on catalogs
+	AddOnIds []int `json:"add_on_ids,omitempty"`
+	// Required fields
+	BarberID *int `json:"barber_id,omitempty"`
+	// mobile_app, web_app, phone, walk_in
+	BookingSource *string `json:"booking_source,omitempty"`
+	// Replaces discount_amount; single bookings only
+	CouponCode *string `json:"coupon_code,omitempty"`
+	// Answers to the barber's custom booking-form fields (key -> value)
+	CustomFields  map[string]interface{} `json:"custom_fields,omitempty"`
+	CustomerEmail *string                `json:"customer_email,omitempty"`
+	// Customer info (either customer_id OR guest info)
+	CustomerID    *int    `json:"customer_id,omitempty"`
+	CustomerName  *string `json:"customer_name,omitempty"`
+	CustomerPhone *string `json:"customer_phone,omitempty"`
+	// Provider token for the card holding the deposit, when the services
+	// require one (e.g. a Stripe pm_... id)
+	DepositPaymentMethodID *string  `json:"deposit_payment_method_id,omitempty"`
+	DiscountAmount         *float64 `json:"discount_amount,omitempty"`
+	// Default: the services' and add-ons' combined duration
+	DurationMinutes *int `json:"duration_minutes,omitempty"`
+	// One of the barber's locations (default: the barber's address)
+	LocationID *int `json:"location_id,omitempty"`
+	// Optional fields
+	Notes *string `json:"notes,omitempty"`
+	// Recurrence (optional - creates a standing appointment series)
+	Recurrence json.RawMessage `json:"recurrence,omitempty"`
+	// Services: service_id for one, or service_ids for several performed
+	// back to back, in order
+	ServiceID  *int  `json:"service_id,omitempty"`
+	ServiceIds []int `json:"service_ids,omitempty"`
+	// Pricing (optional - will be calculated if not provided)
+	ServicePrice *float64 `json:"service_price,omitempty"`
+	// Instead of barber_id: book any available barber of the shop. The
+	// services are then catalog services (services.id), not a barber's.
+	ShopID          *int    `json:"shop_id,omitempty"`
+	SpecialRequests *string `json:"special_requests,omitempty"`
+	StartTime       string  `json:"start_time"`
+}
+
+// CreateCategoryRequest mirrors services.CreateCategoryRequest
+type CreateCategoryRequest struct {
+	CategoryPath     *string  `json:"category_path,omitempty"`
+	ColorHex         *string  `json:"color_hex,omitempty"`
+	Description      *string  `json:"description,omitempty"`
+	IconURL          *string  `json:"icon_url,omitempty"`
+	ImageURL         *string  `json:"image_url,omitempty"`
+	IsFeatured       *bool    `json:"is_featured,omitempty"`
+	Keywords         []string `json:"keywords,omitempty"`
+	Level            *int     `json:"level,omitempty"`
+	MetaDescription  *string  `json:"meta_description,omitempty"`
+	MetaTitle        *string  `json:"meta_title,omitempty"`
+	Name             string   `json:"name"`
+	ParentCategoryID *int     `json:"parent_category_id,omitempty"`
+	Slug             *string  `json:"slug,omitempty"`
+	SortOrder        *int     `json:"sort_order,omitempty"`
+}
+
+// CreateExperimentRequest mirrors services.CreateExperimentRequest
+type CreateExperimentRequest struct {
+	Description *string `json:"description,omitempty"`
+	Key         string  `json:"key"`
+	Name        string  `json:"name"`
+	// The first is the control
+	Variants []Variant `json:"variants"`
+}
+
+// CreateIncidentRequest mirrors services.CreateIncidentRequest
+type CreateIncidentRequest struct {
+	Component string  `json:"component"`
+	Impact    string  `json:"impact"`
+	Message   *string `json:"message,omitempty"`
+	// Defaults to now
+	StartedAt *string `json:"started_at,omitempty"`
+	Title     string  `json:"title"`
+}
+
+// CreateNotificationRequest mirrors services.CreateNotificationRequest
+type CreateNotificationRequest struct {
+	// Optional fields
+	Channels          []string               `json:"channels,omitempty"`
+	Data              map[string]interface{} `json:"data,omitempty"`
+	ExpiresAt         *string                `json:"expires_at,omitempty"`
+	Message           string                 `json:"message"`
+	Priority          *string                `json:"priority,omitempty"`
+	RelatedEntityID   *int                   `json:"related_entity_id,omitempty"`
+	RelatedEntityType *string                `json:"related_entity_type,omitempty"`
+	ScheduledFor      *string                `json:"scheduled_for,omitempty"`
+	// YYYY-MM-DDTHH:MM in the recipient's timezone, instead of scheduled_for
+	ScheduledLocal *string `json:"scheduled_local,omitempty"`
+	Title          string  `json:"title"`
+	Type           string  `json:"type"`
+	UserID         int     `json:"user_id"`
+}
+
+// CreateReviewRequest mirrors services.CreateReviewRequest
+type CreateReviewRequest struct {
+	BookingID         int     `json:"booking_id"`
+	CleanlinessRating *int    `json:"cleanliness_rating,omitempty"`
+	Comment           *string `json:"comment,omitempty"`
+	Cons              *string `json:"cons,omitempty"`
+	DurationAccurate  *bool   `json:"duration_accurate,omitempty"`
+	// Media
+	Images []string `json:"images,omitempty"`
+	// Ratings (required: overall, optional: detailed)
+	OverallRating         int     `json:"overall_rating"`
+	ProfessionalismRating *int    `json:"professionalism_rating,omitempty"`
+	Pros                  *string `json:"pros,omitempty"`
+	PunctualityRating     *int    `json:"punctuality_rating,omitempty"`
+	ServiceAsExpected     *bool   `json:"service_as_expected,omitempty"`
+	ServiceQualityRating  *int    `json:"service_quality_rating,omitempty"`
+	// Content
+	Title               *string `json:"title,omitempty"`
+	ValueForMoneyRating *int    `json:"value_for_money_rating,omitempty"`
+	WouldBookAgain      *bool   `json:"would_book_again,omitempty"`
+	// Feedback
+	WouldRecommend *bool `json:"would_recommend,omitempty"`
+}
+
+// CreateRoleRequest mirrors services.CreateRoleRequest
+type CreateRoleRequest struct {
+	Description *string  `json:"description,omitempty"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+// CreateScheduleExceptionRequest mirrors services.CreateScheduleExceptionRequest
+type CreateScheduleExceptionRequest struct {
+	// Defaults to start_date
+	EndDate *string `json:"end_date,omitempty"`
+	// custom_hours only
+	EndTime       *string `json:"end_time,omitempty"`
+	ExceptionType string  `json:"exception_type"`
+	Reason        *string `json:"reason,omitempty"`
+	StartDate     string  `json:"start_date"`
+	// custom_hours only
+	StartTime *string `json:"start_time,omitempty"`
+}
+
+// CreateServiceRequest mirrors services.CreateServiceRequest
+type CreateServiceRequest struct {
+	AllergenWarnings       []string `json:"allergen_warnings,omitempty"`
+	AllowsAddOns           *bool    `json:"allows_add_ons,omitempty"`
+	CategoryID             int      `json:"category_id"`
+	Complexity             int      `json:"complexity"`
+	CreatedBy              *int     `json:"created_by,omitempty"`
+	Currency               *string  `json:"currency,omitempty"`
+	DefaultDurationMax     *int     `json:"default_duration_max,omitempty"`
+	DefaultDurationMin     int      `json:"default_duration_min"`
+	DetailedDescription    *string  `json:"detailed_description,omitempty"`
+	GalleryImages          []string `json:"gallery_images,omitempty"`
+	HairTypes              []string `json:"hair_types,omitempty"`
+	HasVariations          *bool    `json:"has_variations,omitempty"`
+	HealthPrecautions      []string `json:"health_precautions,omitempty"`
+	ImageURL               *string  `json:"image_url,omitempty"`
+	MetaDescription        *string  `json:"meta_description,omitempty"`
+	Name                   string   `json:"name"`
+	RequiredCertifications []string `json:"required_certifications,omitempty"`
+	RequiredProducts       []string `json:"required_products,omitempty"`
+	RequiredTools          []string `json:"required_tools,omitempty"`
+	RequiresConsultation   *bool    `json:"requires_consultation,omitempty"`
+	RequiresHealthCheck    *bool    `json:"requires_health_check,omitempty"`
+	SearchKeywords         []string `json:"search_keywords,omitempty"`
+	ServiceType            string   `json:"service_type"`
+	ShortDescription       string   `json:"short_description"`
+	SkillLevelRequired     *string  `json:"skill_level_required,omitempty"`
+	Slug                   *string  `json:"slug,omitempty"`
+	SuggestedPriceMax      *float64 `json:"suggested_price_max,omitempty"`
+	SuggestedPriceMin      *float64 `json:"suggested_price_min,omitempty"`
+	Tags                   []string `json:"tags,omitempty"`
+	TargetAgeMax           *int     `json:"target_age_max,omitempty"`
+	TargetAgeMin           *int     `json:"target_age_min,omitempty"`
+	TargetGender           *string  `json:"target_gender,omitempty"`
+	VideoURL               *string  `json:"video_url,omitempty"`
+}
+
+// CreateSupportTicketRequest mirrors services.CreateSupportTicketRequest
+type CreateSupportTicketRequest struct {
+	// One of your bookings
+	BookingID   *int   `json:"booking_id,omitempty"`
+	Category    string `json:"category"`
+	Description string `json:"description"`
+	// Default: the booking's payment reference
+	PaymentReference *string `json:"payment_reference,omitempty"`
+	Subject          string  `json:"subject"`
+}
+
+// CreateSuppressionRequest mirrors services.CreateSuppressionRequest
+type CreateSuppressionRequest struct {
+	Address string  `json:"address"`
+	Channel string  `json:"channel"`
+	Detail  *string `json:"detail,omitempty"`
+	// Default: manual
+	Reason *string `json:"reason,omitempty"`
+}
+
+// CreateTimeOffRequest mirrors services.CreateTimeOffRequest
+type CreateTimeOffRequest struct {
+	EndAt *string `json:"end_at,omitempty"`
+	// Inclusive; defaults to start_date
+	EndDate *string `json:"end_date,omitempty"`
+	// Ask affected customers to move their bookings
+	OfferReschedule *bool   `json:"offer_reschedule,omitempty"`
+	Reason          *string `json:"reason,omitempty"`
+	// Exact range instead of dates
+	StartAt *string `json:"start_at,omitempty"`
+	// Whole days in the barber's timezone
+	StartDate *string `json:"start_date,omitempty"`
+}
+
+// CreateWebhookRequest mirrors services.CreateWebhookRequest
+type CreateWebhookRequest struct {
+	Description *string  `json:"description,omitempty"`
+	Events      []string `json:"events"`
+	URL         string   `json:"url"`
+}
+
+// CreatedWebhookResponse mirrors services.CreatedWebhookResponse
+type CreatedWebhookResponse struct {
+	BarberID    *int     `json:"barber_id,omitempty"`
+	CreatedAt   *string  `json:"created_at,omitempty"`
+	CreatedBy   *int     `json:"created_by,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Events      []string `json:"events,omitempty"`
+	ID          *int     `json:"id,omitempty"`
+	IsActive    *bool    `json:"is_active,omitempty"`
+	Secret      *string  `json:"secret,omitempty"`
+	UpdatedAt   *string  `json:"updated_at,omitempty"`
+	URL         *string  `json:"url,omitempty"`
+}
+
+// FeaturedAvailability mirrors services.FeaturedAvailability
+type FeaturedAvailability struct {
+	// A slot is free on every day
+	Available  *bool             `json:"available,omitempty"`
+	CategoryID *int              `json:"category_id,omitempty"`
+	City       *string           `json:"city,omitempty"`
+	Currency   *string           `json:"currency,omitempty"`
+	DailyPrice *float64          `json:"daily_price,omitempty"`
+	Days       []FeaturedSlotDay `json:"days,omitempty"`
+	// Concurrent placements allowed per day
+	Limit      *int     `json:"limit,omitempty"`
+	TotalPrice *float64 `json:"total_price,omitempty"`
+}
+
+// InventoryItemRequest mirrors services.InventoryItemRequest
+type InventoryItemRequest struct {
+	LowStockThreshold *int    `json:"low_stock_threshold,omitempty"`
+	Name              string  `json:"name"`
+	Quantity          *int    `json:"quantity,omitempty"`
+	Unit              *string `json:"unit,omitempty"`
+	// Default: 1
+	UsagePerService *int `json:"usage_per_service,omitempty"`
+}
+
+// LinkIdentityRequest mirrors services.LinkIdentityRequest
+type LinkIdentityRequest struct {
+	IDToken string  `json:"id_token"`
+	Nonce   *string `json:"nonce,omitempty"`
+}
+
+// LocationRequest mirrors services.LocationRequest
+type LocationRequest struct {
+	Address *string `json:"address,omitempty"`
+	City    *string `json:"city,omitempty"`
+	Name    string  `json:"name"`
+}
+
+// LoginRequest mirrors services.LoginRequest
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// LogoutRequest mirrors services.LogoutRequest
+type LogoutRequest struct {
+	// Ends every session of the user
+	AllDevices *bool `json:"all_devices,omitempty"`
+	// Ends this session
+	RefreshToken *string `json:"refresh_token,omitempty"`
+}
+
+// ModerateReviewRequest mirrors services.ModerateReviewRequest
+type ModerateReviewRequest struct {
+	Notes  *string `json:"notes,omitempty"`
+	Status string  `json:"status"`
+}
+
+// ModerationActionRequest mirrors services.ModerationActionRequest
+type ModerationActionRequest struct {
+	Notes *string `json:"notes,omitempty"`
+	// Reason template key; required to reject, flag or redact
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ModerationReason mirrors services.ModerationReason
+type ModerationReason struct {
+	// Actions the reason applies to
+	Actions []string `json:"actions,omitempty"`
+	Key     *string  `json:"key,omitempty"`
+	Label   *string  `json:"label,omitempty"`
+	Message *string  `json:"message,omitempty"`
+}
+
+// NpsResponseRequest mirrors services.NPSResponseRequest
+type NpsResponseRequest struct {
+	Comment *string `json:"comment,omitempty"`
+	Score   int     `json:"score"`
+}
+
+// NpsResponseResult mirrors services.NPSResponseResult
+type NpsResponseResult struct {
+	BookingID *int `json:"booking_id,omitempty"`
+	// promoter, passive, detractor
+	Category *string `json:"category,omitempty"`
+	// The answer can be changed until then
+	ExpiresAt   *string `json:"expires_at,omitempty"`
+	RespondedAt *string `json:"responded_at,omitempty"`
+	Score       *int    `json:"score,omitempty"`
+}
+
+// NotificationPreferenceResponse mirrors services.NotificationPreferenceResponse
+type NotificationPreferenceResponse struct {
+	// The channels it goes out on; empty = off
+	Channels []string `json:"channels,omitempty"`
+	// Channels chosen by the user
+	Customized *bool `json:"customized,omitempty"`
+	// The channels without a preference
+	DefaultChannels []string `json:"default_channels,omitempty"`
+	Type            *string  `json:"type,omitempty"`
+}
+
+// NotificationResponse mirrors services.NotificationResponse
+type NotificationResponse struct {
+	// Background delivery
+	Attempts *int `json:"attempts,omitempty"`
+	// Barber attached to lists on request (see relations.go)
+	Barber json.RawMessage `json:"barber,omitempty"`
+	// Delivery channels and status
+	Channels  []string `json:"channels,omitempty"`
+	CreatedAt *string  `json:"created_at,omitempty"`
+	// Notification data and settings
+	Data          json.RawMessage `json:"data,omitempty"`
+	DeliveredAt   *string         `json:"delivered_at,omitempty"`
+	ExpiresAt     *string         `json:"expires_at,omitempty"`
+	ID            *int            `json:"id,omitempty"`
+	IsExpired     *bool           `json:"is_expired,omitempty"`
+	IsRead        *bool           `json:"is_read,omitempty"`
+	LastError     *string         `json:"last_error,omitempty"`
+	Message       *string         `json:"message,omitempty"`
+	NextAttemptAt *string         `json:"next_attempt_at,omitempty"`
+	// low, normal, high, urgent
+	Priority        *string `json:"priority,omitempty"`
+	ReadAt          *string `json:"read_at,omitempty"`
+	RelatedEntityID *int    `json:"related_entity_id,omitempty"`
+	// Related entities
+	RelatedEntityType *string `json:"related_entity_type,omitempty"`
+	// Scheduling
+	ScheduledFor *string `json:"scheduled_for,omitempty"`
+	// Delivery tracking
+	SentAt *string `json:"sent_at,omitempty"`
+	// pending, sent, delivered, read, failed
+	Status  *string `json:"status,omitempty"`
+	TimeAgo *string `json:"time_ago,omitempty"`
+	Title   *string `json:"title,omitempty"`
+	// booking_confirmation, booking_reminder, booking_cancelled, etc.
+	Type *string `json:"type,omitempty"`
+	// Relations
+	User   json.RawMessage `json:"user,omitempty"`
+	UserID *int            `json:"user_id,omitempty"`
+}
+
+// NotificationTemplateInfo mirrors services.NotificationTemplateInfo
+type NotificationTemplateInfo struct {
+	Key       *string            `json:"key,omitempty"`
+	Message   *string            `json:"message,omitempty"`
+	SMS       *bool              `json:"sms,omitempty"`
+	Title     *string            `json:"title,omitempty"`
+	Type      *string            `json:"type,omitempty"`
+	Variables []TemplateVariable `json:"variables,omitempty"`
+}
+
+// OpenSlotResponse mirrors services.OpenSlotResponse
+type OpenSlotResponse struct {
+	// favorites, nearby
+	Audiences       []string `json:"audiences,omitempty"`
+	BarberID        *int     `json:"barber_id,omitempty"`
+	BarberServiceID *int     `json:"barber_service_id,omitempty"`
+	BookingID       *int     `json:"booking_id,omitempty"`
+	ClaimedAt       *string  `json:"claimed_at,omitempty"`
+	ClaimedBy       *int     `json:"claimed_by,omitempty"`
+	ClaimsCloseAt   *string  `json:"claims_close_at,omitempty"`
+	CreatedAt       *string  `json:"created_at,omitempty"`
+	CreatedBy       *int     `json:"created_by,omitempty"`
+	// Flash discount, 0 for none
+	DiscountPercent *int    `json:"discount_percent,omitempty"`
+	DurationMinutes *int    `json:"duration_minutes,omitempty"`
+	ID              *int    `json:"id,omitempty"`
+	IsClaimable     *bool   `json:"is_claimable,omitempty"`
+	Message         *string `json:"message,omitempty"`
+	// After the flash discount, before tax
+	Price *float64 `json:"price,omitempty"`
+	// Nearby audience only
+	RadiusKm *float64 `json:"radius_km,omitempty"`
+	// Customers notified
+	RecipientCount *int    `json:"recipient_count,omitempty"`
+	ServiceName    *string `json:"service_name,omitempty"`
+	// Before the flash discount
+	ServicePrice *float64 `json:"service_price,omitempty"`
+	StartTime    *string  `json:"start_time,omitempty"`
+	// open, claimed, cancelled
+	Status    *string `json:"status,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// PortfolioCaptionRequest mirrors services.PortfolioCaptionRequest
+type PortfolioCaptionRequest struct {
+	// Null or empty clears it
+	Caption *string `json:"caption,omitempty"`
+}
+
+// PortfolioOrderRequest mirrors services.PortfolioOrderRequest
+type PortfolioOrderRequest struct {
+	Kind string `json:"kind"`
+	// Every photo of the kind, in the new order
+	MediaIds []int `json:"media_ids"`
+}
+
+// PurchaseFeaturedRequest mirrors services.PurchaseFeaturedRequest
+type PurchaseFeaturedRequest struct {
+	// Omit for city-wide placement
+	CategoryID *int `json:"category_id,omitempty"`
+	// YYYY-MM-DD, inclusive
+	EndsOn          string `json:"ends_on"`
+	PaymentMethodID string `json:"payment_method_id"`
+	// YYYY-MM-DD
+	StartsOn string `json:"starts_on"`
+}
+
+// RecordConversionRequest mirrors services.RecordConversionRequest
+type RecordConversionRequest struct {
+	Event string   `json:"event"`
+	Value *float64 `json:"value,omitempty"`
+}
+
+// RecurrenceRequest mirrors services.RecurrenceRequest
+type RecurrenceRequest struct {
+	Frequency   string `json:"frequency"`
+	Occurrences int    `json:"occurrences"`
+}
+
+// RefreshTokenRequest mirrors services.RefreshTokenRequest
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// RefundResult mirrors services.RefundResult
+type RefundResult struct {
+	// Refunded to the customer
+	Amount *float64 `json:"amount,omitempty"`
+	// Kept by the shop
+	CancellationFee *float64 `json:"cancellation_fee,omitempty"`
+	Error           *string  `json:"error,omitempty"`
+	// 0-100
+	Percent *int    `json:"percent,omitempty"`
+	Reason  *string `json:"reason,omitempty"`
+	// Provider refund reference, when one is created
+	Reference *string `json:"reference,omitempty"`
+	Status    *string `json:"status,omitempty"`
+}
+
+// RegisterDeviceRequest mirrors services.RegisterDeviceRequest
+type RegisterDeviceRequest struct {
+	AppVersion *string `json:"app_version,omitempty"`
+	Platform   string  `json:"platform"`
+	Token      string  `json:"token"`
+}
+
+// RegisterRequest mirrors services.RegisterRequest
+type RegisterRequest struct {
+	Email    string  `json:"email"`
+	Name     string  `json:"name"`
+	Password string  `json:"password"`
+	Phone    *string `json:"phone,omitempty"`
+	UserType *string `json:"user_type,omitempty"`
+}
+
+// ReportReviewRequest mirrors services.ReportReviewRequest
+type ReportReviewRequest struct {
+	Details *string `json:"details,omitempty"`
+	Reason  string  `json:"reason"`
+}
+
+// RescheduleBookingRequest mirrors services.RescheduleBookingRequest
+type RescheduleBookingRequest struct {
+	DurationMinutes *int    `json:"duration_minutes,omitempty"`
+	NewStartTime    string  `json:"new_start_time"`
+	Reason          *string `json:"reason,omitempty"`
+}
+
+// RescheduleSeriesRequest mirrors services.RescheduleSeriesRequest
+type RescheduleSeriesRequest struct {
+	DurationMinutes *int    `json:"duration_minutes,omitempty"`
+	NewStartTime    string  `json:"new_start_time"`
+	Reason          *string `json:"reason,omitempty"`
+}
+
+// ReviewLinkInfo mirrors services.ReviewLinkInfo
+type ReviewLinkInfo struct {
+	BarberID           *int    `json:"barber_id,omitempty"`
+	BookingID          *int    `json:"booking_id,omitempty"`
+	BookingNumber      *string `json:"booking_number,omitempty"`
+	ExpiresAt          *string `json:"expires_at,omitempty"`
+	ScheduledStartTime *string `json:"scheduled_start_time,omitempty"`
+	ServiceName        *string `json:"service_name,omitempty"`
+}
+
+// ReviewResponse mirrors services.ReviewResponse
+type ReviewResponse struct {
+	AverageRating *float64       `json:"average_rating,omitempty"`
+	Barber        *BarberSummary `json:"barber,omitempty"`
+	BarberID      *int           `json:"barber_id,omitempty"`
+	BarberName    *string        `json:"barber_name,omitempty"`
+	// Barber response. BarberResponse is the live response; a response
+	// awaiting moderation, rejected or redacted is PendingBarberResponse.
+	BarberResponse   *string `json:"barber_response,omitempty"`
+	BarberResponseAt *string `json:"barber_response_at,omitempty"`
+	// pending, approved, rejected, flagged, redacted
+	BarberResponseStatus *string  `json:"barber_response_status,omitempty"`
+	Booking              *Booking `json:"booking,omitempty"`
+	// One review per booking
+	BookingID         *int    `json:"booking_id,omitempty"`
+	CanEdit           *bool   `json:"can_edit,omitempty"`
+	CanRespond        *bool   `json:"can_respond,omitempty"`
+	CleanlinessRating *int    `json:"cleanliness_rating,omitempty"`
+	Comment           *string `json:"comment,omitempty"`
+	Cons              *string `json:"cons,omitempty"`
+	CreatedAt         *string `json:"created_at,omitempty"`
+	// Relations attached to lists on request (see relations.go)
+	Customer         json.RawMessage `json:"customer,omitempty"`
+	CustomerID       *int            `json:"customer_id,omitempty"`
+	CustomerName     *string         `json:"customer_name,omitempty"`
+	DurationAccurate *bool           `json:"duration_accurate,omitempty"`
+	// Community interaction
+	HelpfulVotes     *int     `json:"helpful_votes,omitempty"`
+	HelpfulnessRatio *float64 `json:"helpfulness_ratio,omitempty"`
+	ID               *int     `json:"id,omitempty"`
+	// Media attachments
+	Images      []string `json:"images,omitempty"`
+	IsPositive  *bool    `json:"is_positive,omitempty"`
+	IsPublished *bool    `json:"is_published,omitempty"`
+	// Verification and moderation
+	IsVerified      *bool   `json:"is_verified,omitempty"`
+	ModeratedAt     *string `json:"moderated_at,omitempty"`
+	ModeratedBy     *int    `json:"moderated_by,omitempty"`
+	ModerationNotes *string `json:"moderation_notes,omitempty"`
+	// pending, approved, rejected, flagged
+	ModerationStatus *string `json:"moderation_status,omitempty"`
+	// Overall and detailed ratings (1-5 scale)
+	OverallRating *int `json:"overall_rating,omitempty"`
+	// The barber's response as moderation sees it (the barber and
+	// moderators only; see review_response_moderation.go)
+	PendingResponse       *string `json:"pending_response,omitempty"`
+	ProfessionalismRating *int    `json:"professionalism_rating,omitempty"`
+	Pros                  *string `json:"pros,omitempty"`
+	PunctualityRating     *int    `json:"punctuality_rating,omitempty"`
+	// User reports since the review was last moderated
+	ReportCount             *int    `json:"report_count,omitempty"`
+	ResponseModerationNotes *string `json:"response_moderation_notes,omitempty"`
+	ServiceAsExpected       *bool   `json:"service_as_expected,omitempty"`
+	ServiceQualityRating    *int    `json:"service_quality_rating,omitempty"`
+	// Review content
+	Title               *string `json:"title,omitempty"`
+	TotalVotes          *int    `json:"total_votes,omitempty"`
+	UpdatedAt           *string `json:"updated_at,omitempty"`
+	ValueForMoneyRating *int    `json:"value_for_money_rating,omitempty"`
+	WouldBookAgain      *bool   `json:"would_book_again,omitempty"`
+	// Additional feedback
+	WouldRecommend *bool `json:"would_recommend,omitempty"`
+}
+
+// RunWinBackRequest mirrors services.RunWinBackRequest
+type RunWinBackRequest struct {
+	CouponPercentOff *int `json:"coupon_percent_off,omitempty"`
+	// List who would be messaged without sending
+	DryRun        *bool `json:"dry_run,omitempty"`
+	InactiveWeeks *int  `json:"inactive_weeks,omitempty"`
+}
+
+// SchedulePeriodInput mirrors services.SchedulePeriodInput
+type SchedulePeriodInput struct {
+	DayOfWeek *int    `json:"day_of_week,omitempty"`
+	EndTime   string  `json:"end_time"`
+	Label     *string `json:"label,omitempty"`
+	// work (default) or break
+	PeriodType *string `json:"period_type,omitempty"`
+	StartTime  string  `json:"start_time"`
+}
+
+// SendBookingNotificationRequest mirrors services.SendBookingNotificationRequest
+type SendBookingNotificationRequest struct {
+	BookingID        int     `json:"booking_id"`
+	CustomMessage    *string `json:"custom_message,omitempty"`
+	NotificationType string  `json:"notification_type"`
+}
+
+// SetAPIQuotaRequest mirrors services.SetAPIQuotaRequest
+type SetAPIQuotaRequest struct {
+	Note              *string `json:"note,omitempty"`
+	RequestsPerMinute int     `json:"requests_per_minute"`
+}
+
+// SetFeaturedSlotLimitRequest mirrors services.SetFeaturedSlotLimitRequest
+type SetFeaturedSlotLimitRequest struct {
+	// Omit for the city-wide market
+	CategoryID *int   `json:"category_id,omitempty"`
+	City       string `json:"city"`
+	MaxSlots   int    `json:"max_slots"`
+}
+
+// ShopOffboardingRequest mirrors services.ShopOffboardingRequest
+type ShopOffboardingRequest struct {
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ShopRequest mirrors services.ShopRequest
+type ShopRequest struct {
+	Address      *string  `json:"address,omitempty"`
+	AddressLine2 *string  `json:"address_line_2,omitempty"`
+	City         *string  `json:"city,omitempty"`
+	Country      *string  `json:"country,omitempty"`
+	Description  *string  `json:"description,omitempty"`
+	Email        *string  `json:"email,omitempty"`
+	Latitude     *float64 `json:"latitude,omitempty"`
+	Longitude    *float64 `json:"longitude,omitempty"`
+	Name         string   `json:"name"`
+	// Hours of barbers without a schedule
+	OpeningHours []ShopHoursPeriod `json:"opening_hours,omitempty"`
+	Phone        *string           `json:"phone,omitempty"`
+	PostalCode   *string           `json:"postal_code,omitempty"`
+	State        *string           `json:"state,omitempty"`
+	TaxID        *string           `json:"tax_id,omitempty"`
+	// Default: UTC
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// SocialLoginRequest mirrors services.SocialLoginRequest
+type SocialLoginRequest struct {
+	IDToken string `json:"id_token"`
+	// For new accounts (Apple only shares it with the client)
+	Name *string `json:"name,omitempty"`
+	// Checked against the token's nonce claim when set
+	Nonce *string `json:"nonce,omitempty"`
+}
+
+// StatusPage mirrors services.StatusPage
+type StatusPage struct {
+	Components []ComponentReport `json:"components,omitempty"`
+	// Ongoing, or resolved within the history window; newest first
+	Incidents []StatusIncident `json:"incidents,omitempty"`
+	// The worst component status
+	Status    *string `json:"status,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// SubmitConsultationRequest mirrors services.SubmitConsultationRequest
+type SubmitConsultationRequest struct {
+	BarberServiceID int     `json:"barber_service_id"`
+	Notes           *string `json:"notes,omitempty"`
+	// Up to 5, all in the future
+	PreferredTimes []string `json:"preferred_times"`
+}
+
+// SupportReplyRequest mirrors services.SupportReplyRequest
+type SupportReplyRequest struct {
+	Body string `json:"body"`
+	// Staff only: a note the requester does not see
+	IsInternal *bool `json:"is_internal,omitempty"`
+	// Staff only: move the ticket with the reply
+	Status *string `json:"status,omitempty"`
+}
+
+// SupportTicketResponse mirrors services.SupportTicketResponse
+type SupportTicketResponse struct {
+	AssignedTo *int `json:"assigned_to,omitempty"`
+	BookingID  *int `json:"booking_id,omitempty"`
+	// booking, payment, account, other
+	Category                *string `json:"category,omitempty"`
+	ClosedAt                *string `json:"closed_at,omitempty"`
+	CreatedAt               *string `json:"created_at,omitempty"`
+	FirstRespondedAt        *string `json:"first_responded_at,omitempty"`
+	FirstResponseBreachedAt *string `json:"first_response_breached_at,omitempty"`
+	FirstResponseDueAt      *string `json:"first_response_due_at,omitempty"`
+	FirstResponseOverdue    *bool   `json:"first_response_overdue,omitempty"`
+	ID                      *int    `json:"id,omitempty"`
+	// Loaded with the ticket when viewing it
+	Messages         []SupportTicketMessage `json:"messages,omitempty"`
+	PaymentReference *string                `json:"payment_reference,omitempty"`
+	// low, normal, high, urgent
+	Priority             *string `json:"priority,omitempty"`
+	RequesterID          *int    `json:"requester_id,omitempty"`
+	ResolutionBreachedAt *string `json:"resolution_breached_at,omitempty"`
+	ResolutionDueAt      *string `json:"resolution_due_at,omitempty"`
+	ResolutionOverdue    *bool   `json:"resolution_overdue,omitempty"`
+	ResolvedAt           *string `json:"resolved_at,omitempty"`
+	SlaPaused            *bool   `json:"sla_paused,omitempty"`
+	SlaPausedAt          *string `json:"sla_paused_at,omitempty"`
+	// open, in_progress, waiting_on_customer, resolved, closed
+	Status    *string `json:"status,omitempty"`
+	Subject   *string `json:"subject,omitempty"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// TaxRuleRequest mirrors services.TaxRuleRequest
+type TaxRuleRequest struct {
+	BarberID *int    `json:"barber_id,omitempty"`
+	Country  *string `json:"country,omitempty"`
+	// Prices already include the tax
+	Inclusive *bool `json:"inclusive,omitempty"`
+	// Default: true
+	IsActive    *bool    `json:"is_active,omitempty"`
+	Name        string   `json:"name"`
+	RatePercent *float64 `json:"rate_percent,omitempty"`
+	State       *string  `json:"state,omitempty"`
+}
+
+// TemplateVariable mirrors services.TemplateVariable
+type TemplateVariable struct {
+	Description *string `json:"description,omitempty"`
+	Example     *string `json:"example,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Placeholder *string `json:"placeholder,omitempty"`
+}
+
+// TimeOffResponse mirrors services.TimeOffResponse
+type TimeOffResponse struct {
+	AffectedBookings []AffectedBooking `json:"affected_bookings,omitempty"`
+	// Created from whole dates
+	AllDay    *bool   `json:"all_day,omitempty"`
+	BarberID  *int    `json:"barber_id,omitempty"`
+	CreatedAt *string `json:"created_at,omitempty"`
+	CreatedBy *int    `json:"created_by,omitempty"`
+	// Exclusive
+	EndAt   *string `json:"end_at,omitempty"`
+	ID      *int    `json:"id,omitempty"`
+	Reason  *string `json:"reason,omitempty"`
+	StartAt *string `json:"start_at,omitempty"`
+}
+
+// TimelineResponse mirrors services.TimelineResponse
+type TimelineResponse struct {
+	Entries []TimelineEntry `json:"entries,omitempty"`
+	// True when this request refreshed the projection
+	Rebuilt   *bool   `json:"rebuilt,omitempty"`
+	RebuiltAt *string `json:"rebuilt_at,omitempty"`
+}
+
+// TravelTimesRequest mirrors services.TravelTimesRequest
+type TravelTimesRequest struct {
+	TravelTimes []TravelTime `json:"travel_times,omitempty"`
+}
+
+// UnreadCountResponse mirrors services.UnreadCountResponse
+type UnreadCountResponse struct {
+	Changed     *bool `json:"changed,omitempty"`
+	UnreadCount *int  `json:"unread_count,omitempty"`
+}
+
+// UpdateAutoReplyRequest mirrors services.UpdateAutoReplyRequest
+type UpdateAutoReplyRequest struct {
+	Enabled *bool `json:"enabled,omitempty"`
+	// Default: config.DefaultAutoReplyMessage
+	Message *string `json:"message,omitempty"`
+}
+
+// UpdateBarberRequest mirrors services.UpdateBarberRequest
+type UpdateBarberRequest struct {
+	Address         *string  `json:"address,omitempty"`
+	AddressLine2    *string  `json:"address_line_2,omitempty"`
+	BusinessEmail   *string  `json:"business_email,omitempty"`
+	BusinessName    *string  `json:"business_name,omitempty"`
+	Certifications  []string `json:"certifications,omitempty"`
+	City            *string  `json:"city,omitempty"`
+	Country         *string  `json:"country,omitempty"`
+	CoverImageURL   *string  `json:"cover_image_url,omitempty"`
+	Description     *string  `json:"description,omitempty"`
+	GalleryImages   []string `json:"gallery_images,omitempty"`
+	LanguagesSpoken []string `json:"languages_spoken,omitempty"`
+	// Minutes after the start time a never-started booking becomes a no-show
+	NoShowGraceMinutes *int     `json:"no_show_grace_minutes,omitempty"`
+	Phone              *string  `json:"phone,omitempty"`
+	PostalCode         *string  `json:"postal_code,omitempty"`
+	ProfileImageURL    *string  `json:"profile_image_url,omitempty"`
+	ShopName           *string  `json:"shop_name,omitempty"`
+	Specialties        []string `json:"specialties,omitempty"`
+	State              *string  `json:"state,omitempty"`
+	WebsiteURL         *string  `json:"website_url,omitempty"`
+	WorkingHours       JSONMap  `json:"working_hours,omitempty"`
+	YearsExperience    *int     `json:"years_experience,omitempty"`
+}
+
+// UpdateBarberServiceRequest mirrors services.UpdateBarberServiceRequest
+type UpdateBarberServiceRequest struct {
+	AdvanceNoticeHours *int     `json:"advance_notice_hours,omitempty"`
+	AvailableDays      []string `json:"available_days,omitempty"`
+	BufferTimeMinutes  *int     `json:"buffer_time_minutes,omitempty"`
+	Currency           *string  `json:"currency,omitempty"`
+	CustomDescription  *string  `json:"custom_description,omitempty"`
+	CustomName         *string  `json:"custom_name,omitempty"`
+	// Empty = no deposit (clears the value and no-show fee)
+	DepositType        *string  `json:"deposit_type,omitempty"`
+	DepositValue       *float64 `json:"deposit_value,omitempty"`
+	DiscountPrice      *float64 `json:"discount_price,omitempty"`
+	DiscountValidUntil *string  `json:"discount_valid_until,omitempty"`
+	DisplayOrder       *int     `json:"display_order,omitempty"`
+	// Empty list = fully active
+	DurationSegments      []DurationSegment `json:"duration_segments,omitempty"`
+	EstimatedDurationMax  *int              `json:"estimated_duration_max,omitempty"`
+	EstimatedDurationMin  *int              `json:"estimated_duration_min,omitempty"`
+	IsActive              *bool             `json:"is_active,omitempty"`
+	IsFeatured            *bool             `json:"is_featured,omitempty"`
+	IsPromotional         *bool             `json:"is_promotional,omitempty"`
+	MaxAdvanceBookingDays *int              `json:"max_advance_booking_days,omitempty"`
+	MaxPrice              *float64          `json:"max_price,omitempty"`
+	NoShowFee             *float64          `json:"no_show_fee,omitempty"`
+	PortfolioImages       []string          `json:"portfolio_images,omitempty"`
+	Price                 *float64          `json:"price,omitempty"`
+	PromotionalText       *string           `json:"promotional_text,omitempty"`
+	ServiceNote           *string           `json:"service_note,omitempty"`
+}
+
+// UpdateBookingRequest mirrors services.UpdateBookingRequest
+type UpdateBookingRequest struct {
+	// Replaces the answers to the barber's custom booking-form fields
+	CustomFields    map[string]interface{} `json:"custom_fields,omitempty"`
+	CustomerEmail   *string                `json:"customer_email,omitempty"`
+	CustomerName    *string                `json:"customer_name,omitempty"`
+	CustomerPhone   *string                `json:"customer_phone,omitempty"`
+	InternalNotes   *string                `json:"internal_notes,omitempty"`
+	Notes           *string                `json:"notes,omitempty"`
+	SpecialRequests *string                `json:"special_requests,omitempty"`
+}
+
+// UpdateCategoryRequest mirrors services.UpdateCategoryRequest
+type UpdateCategoryRequest struct {
+	ColorHex    *string `json:"color_hex,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IconURL     *string `json:"icon_url,omitempty"`
+	ImageURL    *string `json:"image_url,omitempty"`
+	IsActive    *bool   `json:"is_active,omitempty"`
+	IsFeatured  *bool   `json:"is_featured,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Slug        *string `json:"slug,omitempty"`
+	SortOrder   *int    `json:"sort_order,omitempty"`
+}
+
+// UpdateExperimentRequest mirrors services.UpdateExperimentRequest
+type UpdateExperimentRequest struct {
+	Description *string   `json:"description,omitempty"`
+	Name        *string   `json:"name,omitempty"`
+	Variants    []Variant `json:"variants,omitempty"`
+}
+
+// UpdateExperimentStatusRequest mirrors services.UpdateExperimentStatusRequest
+type UpdateExperimentStatusRequest struct {
+	Status string `json:"status"`
+	// Variant served to everyone once stopped (default: the control)
+	Winner *string `json:"winner,omitempty"`
+}
+
+// UpdateIncidentRequest mirrors services.UpdateIncidentRequest
+type UpdateIncidentRequest struct {
+	Impact  *string `json:"impact,omitempty"`
+	Message *string `json:"message,omitempty"`
+	// true resolves the incident now, false reopens it
+	Resolved *bool   `json:"resolved,omitempty"`
+	Title    *string `json:"title,omitempty"`
+}
+
+// UpdateNotificationPreferencesRequest mirrors services.UpdateNotificationPreferencesRequest
+type UpdateNotificationPreferencesRequest struct {
+	Preferences map[string]interface{} `json:"preferences"`
+}
+
+// UpdateProfileRequest mirrors services.UpdateProfileRequest
+type UpdateProfileRequest struct {
+	Address     *string `json:"address,omitempty"`
+	City        *string `json:"city,omitempty"`
+	Country     *string `json:"country,omitempty"`
+	DateOfBirth *string `json:"date_of_birth,omitempty"`
+	Gender      *string `json:"gender,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	// NotificationSettings opts in or out of delivery channels (email, sms, push);
+	// omitted channels keep their current setting
+	NotificationSettings map[string]interface{} `json:"notification_settings,omitempty"`
+	Phone                *string                `json:"phone,omitempty"`
+	PostalCode           *string                `json:"postal_code,omitempty"`
+	Preferences          map[string]interface{} `json:"preferences,omitempty"`
+	ProfilePictureURL    *string                `json:"profile_picture_url,omitempty"`
+	State                *string                `json:"state,omitempty"`
+}
+
+// UpdateReviewRequest mirrors services.UpdateReviewRequest
+type UpdateReviewRequest struct {
+	CleanlinessRating     *int     `json:"cleanliness_rating,omitempty"`
+	Comment               *string  `json:"comment,omitempty"`
+	Cons                  *string  `json:"cons,omitempty"`
+	DurationAccurate      *bool    `json:"duration_accurate,omitempty"`
+	Images                []string `json:"images,omitempty"`
+	OverallRating         *int     `json:"overall_rating,omitempty"`
+	ProfessionalismRating *int     `json:"professionalism_rating,omitempty"`
+	Pros                  *string  `json:"pros,omitempty"`
+	PunctualityRating     *int     `json:"punctuality_rating,omitempty"`
+	ServiceAsExpected     *bool    `json:"service_as_expected,omitempty"`
+	ServiceQualityRating  *int     `json:"service_quality_rating,omitempty"`
+	Title                 *string  `json:"title,omitempty"`
+	ValueForMoneyRating   *int     `json:"value_for_money_rating,omitempty"`
+	WouldBookAgain        *bool    `json:"would_book_again,omitempty"`
+	WouldRecommend        *bool    `json:"would_recommend,omitempty"`
+}
+
+// UpdateRoleRequest mirrors services.UpdateRoleRequest
+type UpdateRoleRequest struct {
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
+// UpdateScheduleRequest mirrors services.UpdateScheduleRequest
+type UpdateScheduleRequest struct {
+	Periods []SchedulePeriodInput `json:"periods"`
+	// Defaults to UTC
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// UpdateServiceRequest mirrors services.UpdateServiceRequest
+type UpdateServiceRequest struct {
+	AllowsAddOns         *bool    `json:"allows_add_ons,omitempty"`
+	CategoryID           *int     `json:"category_id,omitempty"`
+	Complexity           *int     `json:"complexity,omitempty"`
+	Currency             *string  `json:"currency,omitempty"`
+	DefaultDurationMax   *int     `json:"default_duration_max,omitempty"`
+	DefaultDurationMin   *int     `json:"default_duration_min,omitempty"`
+	DetailedDescription  *string  `json:"detailed_description,omitempty"`
+	GalleryImages        []string `json:"gallery_images,omitempty"`
+	HairTypes            []string `json:"hair_types,omitempty"`
+	HasVariations        *bool    `json:"has_variations,omitempty"`
+	ImageURL             *string  `json:"image_url,omitempty"`
+	IsActive             *bool    `json:"is_active,omitempty"`
+	LastModifiedBy       *int     `json:"last_modified_by,omitempty"`
+	Name                 *string  `json:"name,omitempty"`
+	RequiredProducts     []string `json:"required_products,omitempty"`
+	RequiredTools        []string `json:"required_tools,omitempty"`
+	RequiresConsultation *bool    `json:"requires_consultation,omitempty"`
+	SearchKeywords       []string `json:"search_keywords,omitempty"`
+	ServiceType          *string  `json:"service_type,omitempty"`
+	ShortDescription     *string  `json:"short_description,omitempty"`
+	SkillLevelRequired   *string  `json:"skill_level_required,omitempty"`
+	Slug                 *string  `json:"slug,omitempty"`
+	SuggestedPriceMax    *float64 `json:"suggested_price_max,omitempty"`
+	SuggestedPriceMin    *float64 `json:"suggested_price_min,omitempty"`
+	Tags                 []string `json:"tags,omitempty"`
+	TargetGender         *string  `json:"target_gender,omitempty"`
+}
+
+// UpdateStatusRequest mirrors services.UpdateStatusRequest
+type UpdateStatusRequest struct {
+	Status string `json:"status"`
+}
+
+// UpdateSupportTicketRequest mirrors services.UpdateSupportTicketRequest
+type UpdateSupportTicketRequest struct {
+	// A staff member holding support:manage; 0 unassigns
+	AssignedTo *int    `json:"assigned_to,omitempty"`
+	Priority   *string `json:"priority,omitempty"`
+	Status     *string `json:"status,omitempty"`
+}
+
+// UpdateWebhookRequest mirrors services.UpdateWebhookRequest
+type UpdateWebhookRequest struct {
+	Description *string  `json:"description,omitempty"`
+	Events      []string `json:"events,omitempty"`
+	IsActive    *bool    `json:"is_active,omitempty"`
+	URL         *string  `json:"url,omitempty"`
+}
+
+// UserProfileResponse mirrors services.UserProfileResponse
+type UserProfileResponse struct {
+	Address              *string                `json:"address,omitempty"`
+	City                 *string                `json:"city,omitempty"`
+	Country              *string                `json:"country,omitempty"`
+	CreatedAt            *string                `json:"created_at,omitempty"`
+	DateOfBirth          *string                `json:"date_of_birth,omitempty"`
+	Email                *string                `json:"email,omitempty"`
+	EmailVerified        *bool                  `json:"email_verified,omitempty"`
+	Gender               *string                `json:"gender,omitempty"`
+	ID                   *int                   `json:"id,omitempty"`
+	LastLoginAt          *string                `json:"last_login_at,omitempty"`
+	Name                 *string                `json:"name,omitempty"`
+	NotificationSettings map[string]interface{} `json:"notification_settings,omitempty"`
+	Phone                *string                `json:"phone,omitempty"`
+	PhoneVerified        *bool                  `json:"phone_verified,omitempty"`
+	PostalCode           *string                `json:"postal_code,omitempty"`
+	Preferences          map[string]interface{} `json:"preferences,omitempty"`
+	ProfilePictureURL    *string                `json:"profile_picture_url,omitempty"`
+	State                *string                `json:"state,omitempty"`
+	Status               *string                `json:"status,omitempty"`
+	UserType             *string                `json:"user_type,omitempty"`
+	UUID                 *string                `json:"uuid,omitempty"`
+}
+
+// ValidateTemplateRequest mirrors services.ValidateTemplateRequest
+type ValidateTemplateRequest struct {
+	Key     string  `json:"key"`
+	Message string  `json:"message"`
+	Title   *string `json:"title,omitempty"`
+}
+
+// ValidateTemplateResult mirrors services.ValidateTemplateResult
+type ValidateTemplateResult struct {
+	Key *string `json:"key,omitempty"`
+	// In order of first use
+	Variables []string `json:"variables,omitempty"`
+}
+
+// VariantAssignment mirrors services.VariantAssignment
+type VariantAssignment struct {
+	Experiment *string `json:"experiment,omitempty"`
+	// false when the experiment is not running
+	Exposed *bool   `json:"exposed,omitempty"`
+	Variant *string `json:"variant,omitempty"`
+}
+
+// VoteReviewRequest mirrors services.VoteReviewRequest
+type VoteReviewRequest struct {
+	IsHelpful *bool `json:"is_helpful,omitempty"`
+}
+
+// WinBackRunResult mirrors services.WinBackRunResult
+type WinBackRunResult struct {
+	// nil on dry runs
+	Campaign json.RawMessage `json:"campaign,omitempty"`
+	// Dry runs only
+	Candidates []WinBackCandidate `json:"candidates,omitempty"`
+	DryRun     *bool              `json:"dry_run,omitempty"`
+	Eligible   *int               `json:"eligible,omitempty"`
+	Sent       *int               `json:"sent,omitempty"`
+}
+
+// ============================================
+// Operations
+// ============================================
+
+// GetMyActivityTimelineParams holds the query parameters for GetMyActivityTimeline
+type GetMyActivityTimelineParams struct {
+	// Filter by event type (e.g. booking_created, review_posted)
+	EventTypes []string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+}
+
+func (p *GetMyActivityTimelineParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	for _, v := range p.EventTypes {
+		q.Add("event_types", fmt.Sprint(v))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// GetMyActivityTimeline - Get my activity timeline
+//
+// GET /api/v1/activity/me
+// Requires authentication.
+func (c *Client) GetMyActivityTimeline(ctx context.Context, params *GetMyActivityTimelineParams) (*TimelineResponse, error) {
+	var out TimelineResponse
+	if err := c.do(ctx, "GET", "/api/v1/activity/me", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetPlatformActivityFeedParams holds the query parameters for GetPlatformActivityFeed
+type GetPlatformActivityFeedParams struct {
+	// Filter by actor (direct or impersonating)
+	ActorID *int
+	// Filter by actor type
+	ActorType *string
+	// Filter by impersonating admin
+	ImpersonatorID *int
+	// Only impersonated (true) or direct (false) actions
+	Impersonated *bool
+	// Filter by entity type
+	EntityType *string
+	// Filter by entity ID
+	EntityID *int
+	// Filter by action
+	Action *string
+	// Created at or after (RFC3339)
+	CreatedFrom *string
+	// Created at or before (RFC3339)
+	CreatedTo *string
+	// Return entries older than this ID
+	BeforeID *int
+	// Limit results
+	Limit *int
+}
+
+func (p *GetPlatformActivityFeedParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.ActorID != nil {
+		q.Set("actor_id", fmt.Sprint(*p.ActorID))
+	}
+	if p.ActorType != nil {
+		q.Set("actor_type", fmt.Sprint(*p.ActorType))
+	}
+	if p.ImpersonatorID != nil {
+		q.Set("impersonator_id", fmt.Sprint(*p.ImpersonatorID))
+	}
+	if p.Impersonated != nil {
+		q.Set("impersonated", fmt.Sprint(*p.Impersonated))
+	}
+	if p.EntityType != nil {
+		q.Set("entity_type", fmt.Sprint(*p.EntityType))
+	}
+	if p.EntityID != nil {
+		q.Set("entity_id", fmt.Sprint(*p.EntityID))
+	}
+	if p.Action != nil {
+		q.Set("action", fmt.Sprint(*p.Action))
+	}
+	if p.CreatedFrom != nil {
+		q.Set("created_from", fmt.Sprint(*p.CreatedFrom))
+	}
+	if p.CreatedTo != nil {
+		q.Set("created_to", fmt.Sprint(*p.CreatedTo))
+	}
+	if p.BeforeID != nil {
+		q.Set("before_id", fmt.Sprint(*p.BeforeID))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	return q
+}
+
+// GetPlatformActivityFeed - Get platform activity feed
+//
+// GET /api/v1/admin/activity
+// Requires authentication.
+func (c *Client) GetPlatformActivityFeed(ctx context.Context, params *GetPlatformActivityFeedParams) (*ActivityFeedResponse, error) {
+	var out ActivityFeedResponse
+	if err := c.do(ctx, "GET", "/api/v1/admin/activity", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetAuditLogEntry - Get an audit log entry
+//
+// GET /api/v1/admin/activity/{id}
+// Requires authentication.
+func (c *Client) GetAuditLogEntry(ctx context.Context, id int) (*AuditLog, error) {
+	var out AuditLog
+	if err := c.do(ctx, "GET", expandPath("/api/v1/admin/activity/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExportAnonymizedBookingDatasetParams holds the query parameters for ExportAnonymizedBookingDataset
+type ExportAnonymizedBookingDatasetParams struct {
+	// First day (YYYY-MM-DD, default 89 days before to)
+	From *string
+	// Last day (YYYY-MM-DD, default today)
+	To *string
+	// Minimum bookings per row (default 10, at least 5)
+	K *int
+	// Price band width in major units (default 10)
+	PriceStep *float64
+	// Period of a row: week (default) or month
+	Granularity *string
+}
+
+func (p *ExportAnonymizedBookingDatasetParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.From != nil {
+		q.Set("from", fmt.Sprint(*p.From))
+	}
+	if p.To != nil {
+		q.Set("to", fmt.Sprint(*p.To))
+	}
+	if p.K != nil {
+		q.Set("k", fmt.Sprint(*p.K))
+	}
+	if p.PriceStep != nil {
+		q.Set("price_step", fmt.Sprint(*p.PriceStep))
+	}
+	if p.Granularity != nil {
+		q.Set("granularity", fmt.Sprint(*p.Granularity))
+	}
+	return q
+}
+
+// ExportAnonymizedBookingDataset - Export an anonymized booking dataset
+//
+// GET /api/v1/admin/analytics/dataset
+// Requires authentication.
+func (c *Client) ExportAnonymizedBookingDataset(ctx context.Context, params *ExportAnonymizedBookingDatasetParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", "/api/v1/admin/analytics/dataset", params.values(), nil, false, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetCustomersActivityTimelineAdminParams holds the query parameters for GetCustomersActivityTimelineAdmin
+type GetCustomersActivityTimelineAdminParams struct {
+	// Filter by event type
+	EventTypes []string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+}
+
+func (p *GetCustomersActivityTimelineAdminParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	for _, v := range p.EventTypes {
+		q.Add("event_types", fmt.Sprint(v))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// GetCustomersActivityTimelineAdmin - Get a customer's activity timeline (admin)
+//
+// GET /api/v1/admin/customers/{id}/activity
+// Requires authentication.
+func (c *Client) GetCustomersActivityTimelineAdmin(ctx context.Context, id int, params *GetCustomersActivityTimelineAdminParams) (*TimelineResponse, error) {
+	var out TimelineResponse
+	if err := c.do(ctx, "GET", expandPath("/api/v1/admin/customers/{id}/activity", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RebuildCustomersActivityTimelineAdmin - Rebuild a customer's activity timeline (admin)
+//
+// POST /api/v1/admin/customers/{id}/activity/rebuild
+// Requires authentication.
+func (c *Client) RebuildCustomersActivityTimelineAdmin(ctx context.Context, id int) (*TimelineState, error) {
+	var out TimelineState
+	if err := c.do(ctx, "POST", expandPath("/api/v1/admin/customers/{id}/activity/rebuild", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListExperimentsParams holds the query parameters for ListExperiments
+type ListExperimentsParams struct {
+	// Filter by status
+	Status *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+}
+
+func (p *ListExperimentsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// ListExperiments - List experiments
+//
+// GET /api/v1/admin/experiments
+// Requires authentication.
+func (c *Client) ListExperiments(ctx context.Context, params *ListExperimentsParams) ([]Experiment, error) {
+	var out []Experiment
+	if err := c.do(ctx, "GET", "/api/v1/admin/experiments", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateExperiment - Create an experiment
+//
+// POST /api/v1/admin/experiments
+// Requires authentication.
+func (c *Client) CreateExperiment(ctx context.Context, body CreateExperimentRequest) (*Experiment, error) {
+	var out Experiment
+	if err := c.do(ctx, "POST", "/api/v1/admin/experiments", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetExperiment - Get an experiment
+//
+// GET /api/v1/admin/experiments/{key}
+// Requires authentication.
+func (c *Client) GetExperiment(ctx context.Context, key string) (*Experiment, error) {
+	var out Experiment
+	if err := c.do(ctx, "GET", expandPath("/api/v1/admin/experiments/{key}", "key", key), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateExperiment - Update an experiment
+//
+// PUT /api/v1/admin/experiments/{key}
+// Requires authentication.
+func (c *Client) UpdateExperiment(ctx context.Context, key string, body UpdateExperimentRequest) (*Experiment, error) {
+	var out Experiment
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/admin/experiments/{key}", "key", key), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ExperimentConversionReportParams holds the query parameters for ExperimentConversionReport
+type ExperimentConversionReportParams struct {
+	// Conversion metric
+	Metric *string
+	// Only count conversions this many days after exposure
+	WindowDays *int
+}
+
+func (p *ExperimentConversionReportParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Metric != nil {
+		q.Set("metric", fmt.Sprint(*p.Metric))
+	}
+	if p.WindowDays != nil {
+		q.Set("window_days", fmt.Sprint(*p.WindowDays))
+	}
+	return q
+}
+
+// ExperimentConversionReport - Experiment conversion report
+//
+// GET /api/v1/admin/experiments/{key}/report
+// Requires authentication.
+func (c *Client) ExperimentConversionReport(ctx context.Context, key string, params *ExperimentConversionReportParams) (*ExperimentReport, error) {
+	var out ExperimentReport
+	if err := c.do(ctx, "GET", expandPath("/api/v1/admin/experiments/{key}/report", "key", key), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StartOrStopExperiment - Start or stop an experiment
+//
+// PATCH /api/v1/admin/experiments/{key}/status
+// Requires authentication.
+func (c *Client) StartOrStopExperiment(ctx context.Context, key string, body UpdateExperimentStatusRequest) (*Experiment, error) {
+	var out Experiment
+	if err := c.do(ctx, "PATCH", expandPath("/api/v1/admin/experiments/{key}/status", "key", key), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListFeaturedSlotLimits - List featured slot limits
+//
+// GET /api/v1/admin/featured/limits
+// Requires authentication.
+func (c *Client) ListFeaturedSlotLimits(ctx context.Context) ([]FeaturedSlotLimit, error) {
+	var out []FeaturedSlotLimit
+	if err := c.do(ctx, "GET", "/api/v1/admin/featured/limits", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetFeaturedSlotLimit - Set a featured slot limit
+//
+// PUT /api/v1/admin/featured/limits
+// Requires authentication.
+func (c *Client) SetFeaturedSlotLimit(ctx context.Context, body SetFeaturedSlotLimitRequest) (*FeaturedSlotLimit, error) {
+	var out FeaturedSlotLimit
+	if err := c.do(ctx, "PUT", "/api/v1/admin/featured/limits", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListFeaturedPlacementsParams holds the query parameters for ListFeaturedPlacements
+type ListFeaturedPlacementsParams struct {
+	// Filter by city
+	City *string
+	// Filter by status (pending_payment, active, cancelled)
+	Status *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+}
+
+func (p *ListFeaturedPlacementsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.City != nil {
+		q.Set("city", fmt.Sprint(*p.City))
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// ListFeaturedPlacements - List featured placements
+//
+// GET /api/v1/admin/featured/placements
+// Requires authentication.
+func (c *Client) ListFeaturedPlacements(ctx context.Context, params *ListFeaturedPlacementsParams) ([]FeaturedPlacement, error) {
+	var out []FeaturedPlacement
+	if err := c.do(ctx, "GET", "/api/v1/admin/featured/placements", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExportFinancialLedgerParams holds the query parameters for ExportFinancialLedger
+type ExportFinancialLedgerParams struct {
+	// First day (YYYY-MM-DD, default 30 days before to)
+	From *string
+	// Last day (YYYY-MM-DD, default today)
+	To *string
+}
+
+func (p *ExportFinancialLedgerParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.From != nil {
+		q.Set("from", fmt.Sprint(*p.From))
+	}
+	if p.To != nil {
+		q.Set("to", fmt.Sprint(*p.To))
+	}
+	return q
+}
+
+// ExportFinancialLedger - Export the financial ledger
+//
+// GET /api/v1/admin/finance/ledger/export
+// Requires authentication.
+func (c *Client) ExportFinancialLedger(ctx context.Context, params *ExportFinancialLedgerParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", "/api/v1/admin/finance/ledger/export", params.values(), nil, false, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetPlatformWideNpsTrendAdminParams holds the query parameters for GetPlatformWideNpsTrendAdmin
+type GetPlatformWideNpsTrendAdminParams struct {
+	// Start date (YYYY-MM-DD)
+	From *string
+	// End date, inclusive (YYYY-MM-DD)
+	To *string
+	// Bucket size
+	Interval *string
+}
+
+func (p *GetPlatformWideNpsTrendAdminParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.From != nil {
+		q.Set("from", fmt.Sprint(*p.From))
+	}
+	if p.To != nil {
+		q.Set("to", fmt.Sprint(*p.To))
+	}
+	if p.Interval != nil {
+		q.Set("interval", fmt.Sprint(*p.Interval))
+	}
+	return q
+}
+
+// GetPlatformWideNpsTrendAdmin - Get the platform-wide NPS trend (admin)
+//
+// GET /api/v1/admin/nps
+// Requires authentication.
+func (c *Client) GetPlatformWideNpsTrendAdmin(ctx context.Context, params *GetPlatformWideNpsTrendAdminParams) (*NpsTrend, error) {
+	var out NpsTrend
+	if err := c.do(ctx, "GET", "/api/v1/admin/nps", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListPermissions - List permissions
+//
+// GET /api/v1/admin/permissions
+// Requires authentication.
+func (c *Client) ListPermissions(ctx context.Context) ([]string, error) {
+	var out []string
+	if err := c.do(ctx, "GET", "/api/v1/admin/permissions", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListAPIQuotas - List API quotas
+//
+// GET /api/v1/admin/quotas
+// Requires authentication.
+func (c *Client) ListAPIQuotas(ctx context.Context) ([]APIQuota, error) {
+	var out []APIQuota
+	if err := c.do(ctx, "GET", "/api/v1/admin/quotas", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoveAPIQuota - Remove an API quota
+//
+// DELETE /api/v1/admin/quotas/{userId}
+// Requires authentication.
+func (c *Client) RemoveAPIQuota(ctx context.Context, userID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/admin/quotas/{userId}", "userId", userID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetAPIQuota - Set an API quota
+//
+// PUT /api/v1/admin/quotas/{userId}
+// Requires authentication.
+func (c *Client) SetAPIQuota(ctx context.Context, userID int, body SetAPIQuotaRequest) (*APIQuota, error) {
+	var out APIQuota
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/admin/quotas/{userId}", "userId", userID), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReviewModerationQueueParams holds the query parameters for ReviewModerationQueue
+type ReviewModerationQueueParams struct {
+	// Moderation status
+	ModerationStatus *string
+	// Filter by barber
+	BarberID *int
+	// Only reviews with at least this many open user reports
+	MinReports *int
+	// Sort by field (created_at, overall_rating, helpful_votes, report_count)
+	SortBy *string
+	// Sort order (ASC/DESC)
+	Order *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+	// Attach each customer's name and picture
+	IncludeCustomer *bool
+	// Attach each barber's shop summary
+	IncludeBarber *bool
+}
+
+func (p *ReviewModerationQueueParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.ModerationStatus != nil {
+		q.Set("moderation_status", fmt.Sprint(*p.ModerationStatus))
+	}
+	if p.BarberID != nil {
+		q.Set("barber_id", fmt.Sprint(*p.BarberID))
+	}
+	if p.MinReports != nil {
+		q.Set("min_reports", fmt.Sprint(*p.MinReports))
+	}
+	if p.SortBy != nil {
+		q.Set("sort_by", fmt.Sprint(*p.SortBy))
+	}
+	if p.Order != nil {
+		q.Set("order", fmt.Sprint(*p.Order))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	if p.IncludeCustomer != nil {
+		q.Set("include_customer", fmt.Sprint(*p.IncludeCustomer))
+	}
+	if p.IncludeBarber != nil {
+		q.Set("include_barber", fmt.Sprint(*p.IncludeBarber))
+	}
+	return q
+}
+
+// ReviewModerationQueue - Review moderation queue
+//
+// GET /api/v1/admin/reviews
+// Requires authentication.
+func (c *Client) ReviewModerationQueue(ctx context.Context, params *ReviewModerationQueueParams) ([]ReviewResponse, error) {
+	var out []ReviewResponse
+	if err := c.do(ctx, "GET", "/api/v1/admin/reviews", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ModerateReviewsInBulk - Moderate reviews in bulk
+//
+// POST /api/v1/admin/reviews/moderate
+// Requires authentication.
+func (c *Client) ModerateReviewsInBulk(ctx context.Context, body BulkModerationRequest) (*BulkModerationResult, error) {
+	var out BulkModerationResult
+	if err := c.do(ctx, "POST", "/api/v1/admin/reviews/moderate", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ModerationReasonTemplates - Moderation reason templates
+//
+// GET /api/v1/admin/reviews/moderation-reasons
+// Requires authentication.
+func (c *Client) ModerationReasonTemplates(ctx context.Context) ([]ModerationReason, error) {
+	var out []ModerationReason
+	if err := c.do(ctx, "GET", "/api/v1/admin/reviews/moderation-reasons", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ResponseModerationReasonTemplates - Response moderation reason templates
+//
+// GET /api/v1/admin/reviews/response-moderation-reasons
+// Requires authentication.
+func (c *Client) ResponseModerationReasonTemplates(ctx context.Context) ([]ModerationReason, error) {
+	var out []ModerationReason
+	if err := c.do(ctx, "GET", "/api/v1/admin/reviews/response-moderation-reasons", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewResponseModerationQueueParams holds the query parameters for ReviewResponseModerationQueue
+type ReviewResponseModerationQueueParams struct {
+	// Response moderation status
+	ResponseStatus *string
+	// Filter by barber
+	BarberID *int
+	// Sort by field (created_at, overall_rating, helpful_votes, report_count)
+	SortBy *string
+	// Sort order (ASC/DESC)
+	Order *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+	// Attach each customer's name and picture
+	IncludeCustomer *bool
+	// Attach each barber's shop summary
+	IncludeBarber *bool
+}
+
+func (p *ReviewResponseModerationQueueParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.ResponseStatus != nil {
+		q.Set("response_status", fmt.Sprint(*p.ResponseStatus))
+	}
+	if p.BarberID != nil {
+		q.Set("barber_id", fmt.Sprint(*p.BarberID))
+	}
+	if p.SortBy != nil {
+		q.Set("sort_by", fmt.Sprint(*p.SortBy))
+	}
+	if p.Order != nil {
+		q.Set("order", fmt.Sprint(*p.Order))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	if p.IncludeCustomer != nil {
+		q.Set("include_customer", fmt.Sprint(*p.IncludeCustomer))
+	}
+	if p.IncludeBarber != nil {
+		q.Set("include_barber", fmt.Sprint(*p.IncludeBarber))
+	}
+	return q
+}
+
+// ReviewResponseModerationQueue - Review response moderation queue
+//
+// GET /api/v1/admin/reviews/responses
+// Requires authentication.
+func (c *Client) ReviewResponseModerationQueue(ctx context.Context, params *ReviewResponseModerationQueueParams) ([]ReviewResponse, error) {
+	var out []ReviewResponse
+	if err := c.do(ctx, "GET", "/api/v1/admin/reviews/responses", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApproveReview - Approve a review
+//
+// POST /api/v1/admin/reviews/{id}/approve
+// Requires authentication.
+func (c *Client) ApproveReview(ctx context.Context, id int, body ModerationActionRequest) (*ReviewResponse, error) {
+	var out ReviewResponse
+	if err := c.do(ctx, "POST", expandPath("/api/v1/admin/reviews/{id}/approve", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FlagReview - Flag a review
+//
+// POST /api/v1/admin/reviews/{id}/flag
+// Requires authentication.
+func (c *Client) FlagReview(ctx context.Context, id int, body ModerationActionRequest) (*ReviewResponse, error) {
+	var out ReviewResponse
+	if err := c.do(ctx, "POST", expandPath("/api/v1/admin/reviews/{id}/flag", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RejectReview - Reject a review
+//
+// POST /api/v1/admin/reviews/{id}/reject
+// Requires authentication.
+func (c *Client) RejectReview(ctx context.Context, id int, body ModerationActionRequest) (*ReviewResponse, error) {
+	var out ReviewResponse
+	if err := c.do(ctx, "POST", expandPath("/api/v1/admin/reviews/{id}/reject", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListReviewsReports - List a review's reports
+//
+// GET /api/v1/admin/reviews/{id}/reports
+// Requires authentication.
+func (c *Client) ListReviewsReports(ctx context.Context, id int) ([]ReviewReport, error) {
+	var out []ReviewReport
+	if err := c.do(ctx, "GET", expandPath("/api/v1/admin/reviews/{id}/reports", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApproveBarberResponse - Approve a barber response
+//
+// POST /api/v1/admin/reviews/{id}/response/approve
+// Requires authentication.
+func (c *Client) ApproveBarberResponse(ctx context.Context, id int, body ModerationActionRequest) (*ReviewResponse, error) {
+	var out ReviewResponse
+	if err := c.do(ctx, "POST", expandPath("/api/v1/admin/reviews/{id}/response/approve", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RedactBarberResponse - Redact a barber response
+//
+// POST /api/v1/admin/reviews/{id}/response/redact
+// Requires authentication.
+func (c *Client) RedactBarberResponse(ctx context.Context, id int, body ModerationActionRequest) (*ReviewResponse, error) {
+	var out ReviewResponse
+	if err := c.do(ctx, "POST", expandPath("/api/v1/admin/reviews/{id}/response/redact", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RejectBarberResponse - Reject a barber response
+//
+// POST /api/v1/admin/reviews/{id}/response/reject
+// Requires authentication.
+func (c *Client) RejectBarberResponse(ctx context.Context, id int, body ModerationActionRequest) (*ReviewResponse, error) {
+	var out ReviewResponse
+	if err := c.do(ctx, "POST", expandPath("/api/v1/admin/reviews/{id}/response/reject", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListRoles - List roles
+//
+// GET /api/v1/admin/roles
+// Requires authentication.
+func (c *Client) ListRoles(ctx context.Context) ([]Role, error) {
+	var out []Role
+	if err := c.do(ctx, "GET", "/api/v1/admin/roles", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateRole - Create a role
+//
+// POST /api/v1/admin/roles
+// Requires authentication.
+func (c *Client) CreateRole(ctx context.Context, body CreateRoleRequest) (*Role, error) {
+	var out Role
+	if err := c.do(ctx, "POST", "/api/v1/admin/roles", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteRole - Delete a role
+//
+// DELETE /api/v1/admin/roles/{name}
+// Requires authentication.
+func (c *Client) DeleteRole(ctx context.Context, name string) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/admin/roles/{name}", "name", name), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateRole - Update a role
+//
+// PUT /api/v1/admin/roles/{name}
+// Requires authentication.
+func (c *Client) UpdateRole(ctx context.Context, name string, body UpdateRoleRequest) (*Role, error) {
+	var out Role
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/admin/roles/{name}", "name", name), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// PostIncident - Post an incident
+//
+// POST /api/v1/admin/status/incidents
+// Requires authentication.
+func (c *Client) PostIncident(ctx context.Context, body CreateIncidentRequest) (*StatusIncident, error) {
+	var out StatusIncident
+	if err := c.do(ctx, "POST", "/api/v1/admin/status/incidents", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateIncident - Update an incident
+//
+// PATCH /api/v1/admin/status/incidents/{id}
+// Requires authentication.
+func (c *Client) UpdateIncident(ctx context.Context, id int, body UpdateIncidentRequest) (*StatusIncident, error) {
+	var out StatusIncident
+	if err := c.do(ctx, "PATCH", expandPath("/api/v1/admin/status/incidents/{id}", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSupportTicketsParams holds the query parameters for ListSupportTickets
+type ListSupportTicketsParams struct {
+	// open, in_progress, waiting_on_customer, resolved or closed
+	Status *string
+	// low, normal, high or urgent
+	Priority *string
+	// booking, payment, account or other
+	Category *string
+	// Assignee user ID
+	AssignedTo *int
+	// Only tickets that missed an SLA target
+	Breached *bool
+	// Page size
+	Limit *int
+	// Offset
+	Offset *int
+}
+
+func (p *ListSupportTicketsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.Priority != nil {
+		q.Set("priority", fmt.Sprint(*p.Priority))
+	}
+	if p.Category != nil {
+		q.Set("category", fmt.Sprint(*p.Category))
+	}
+	if p.AssignedTo != nil {
+		q.Set("assigned_to", fmt.Sprint(*p.AssignedTo))
+	}
+	if p.Breached != nil {
+		q.Set("breached", fmt.Sprint(*p.Breached))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// ListSupportTickets - List support tickets
+//
+// GET /api/v1/admin/support/tickets
+// Requires authentication.
+func (c *Client) ListSupportTickets(ctx context.Context, params *ListSupportTicketsParams) ([]SupportTicket, error) {
+	var out []SupportTicket
+	if err := c.do(ctx, "GET", "/api/v1/admin/support/tickets", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetSupportTicket - Get a support ticket
+//
+// GET /api/v1/admin/support/tickets/{id}
+// Requires authentication.
+func (c *Client) GetSupportTicket(ctx context.Context, id int) (*SupportTicketResponse, error) {
+	var out SupportTicketResponse
+	if err := c.do(ctx, "GET", expandPath("/api/v1/admin/support/tickets/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateSupportTicket - Update a support ticket
+//
+// PATCH /api/v1/admin/support/tickets/{id}
+// Requires authentication.
+func (c *Client) UpdateSupportTicket(ctx context.Context, id int, body UpdateSupportTicketRequest) (*SupportTicketResponse, error) {
+	var out SupportTicketResponse
+	if err := c.do(ctx, "PATCH", expandPath("/api/v1/admin/support/tickets/{id}", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReplyToSupportTicket - Reply to a support ticket
+//
+// POST /api/v1/admin/support/tickets/{id}/messages
+// Requires authentication.
+func (c *Client) ReplyToSupportTicket(ctx context.Context, id int, body SupportReplyRequest) (*SupportTicketMessage, error) {
+	var out SupportTicketMessage
+	if err := c.do(ctx, "POST", expandPath("/api/v1/admin/support/tickets/{id}/messages", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListSuppressedAddressesParams holds the query parameters for ListSuppressedAddresses
+type ListSuppressedAddressesParams struct {
+	// Filter by channel (email, sms)
+	Channel *string
+	// Filter by reason (hard_bounce, spam_complaint, opt_out, manual)
+	Reason *string
+	// Filter by part of the address
+	Address *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+}
+
+func (p *ListSuppressedAddressesParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Channel != nil {
+		q.Set("channel", fmt.Sprint(*p.Channel))
+	}
+	if p.Reason != nil {
+		q.Set("reason", fmt.Sprint(*p.Reason))
+	}
+	if p.Address != nil {
+		q.Set("address", fmt.Sprint(*p.Address))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// ListSuppressedAddresses - List suppressed addresses
+//
+// GET /api/v1/admin/suppressions
+// Requires authentication.
+func (c *Client) ListSuppressedAddresses(ctx context.Context, params *ListSuppressedAddressesParams) ([]Suppression, error) {
+	var out []Suppression
+	if err := c.do(ctx, "GET", "/api/v1/admin/suppressions", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SuppressAddress - Suppress an address
+//
+// POST /api/v1/admin/suppressions
+// Requires authentication.
+func (c *Client) SuppressAddress(ctx context.Context, body CreateSuppressionRequest) (*Suppression, error) {
+	var out Suppression
+	if err := c.do(ctx, "POST", "/api/v1/admin/suppressions", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LiftSuppression - Lift a suppression
+//
+// DELETE /api/v1/admin/suppressions/{id}
+// Requires authentication.
+func (c *Client) LiftSuppression(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/admin/suppressions/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetSuppressedAddress - Get a suppressed address
+//
+// GET /api/v1/admin/suppressions/{id}
+// Requires authentication.
+func (c *Client) GetSuppressedAddress(ctx context.Context, id int) (*Suppression, error) {
+	var out Suppression
+	if err := c.do(ctx, "GET", expandPath("/api/v1/admin/suppressions/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTaxReportParams holds the query parameters for GetTaxReport
+type GetTaxReportParams struct {
+	// First day (YYYY-MM-DD)
+	From *string
+	// Last day (YYYY-MM-DD)
+	To *string
+	// Barber ID
+	BarberID *int
+}
+
+func (p *GetTaxReportParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.From != nil {
+		q.Set("from", fmt.Sprint(*p.From))
+	}
+	if p.To != nil {
+		q.Set("to", fmt.Sprint(*p.To))
+	}
+	if p.BarberID != nil {
+		q.Set("barber_id", fmt.Sprint(*p.BarberID))
+	}
+	return q
+}
+
+// GetTaxReport - Get a tax report
+//
+// GET /api/v1/admin/tax-report
+// Requires authentication.
+func (c *Client) GetTaxReport(ctx context.Context, params *GetTaxReportParams) (*TaxReport, error) {
+	var out TaxReport
+	if err := c.do(ctx, "GET", "/api/v1/admin/tax-report", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTaxRules - List tax rules
+//
+// GET /api/v1/admin/tax-rules
+// Requires authentication.
+func (c *Client) ListTaxRules(ctx context.Context) ([]TaxRule, error) {
+	var out []TaxRule
+	if err := c.do(ctx, "GET", "/api/v1/admin/tax-rules", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateTaxRule - Create a tax rule
+//
+// POST /api/v1/admin/tax-rules
+// Requires authentication.
+func (c *Client) CreateTaxRule(ctx context.Context, body TaxRuleRequest) (*TaxRule, error) {
+	var out TaxRule
+	if err := c.do(ctx, "POST", "/api/v1/admin/tax-rules", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteTaxRule - Delete a tax rule
+//
+// DELETE /api/v1/admin/tax-rules/{id}
+// Requires authentication.
+func (c *Client) DeleteTaxRule(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/admin/tax-rules/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateTaxRule - Update a tax rule
+//
+// PUT /api/v1/admin/tax-rules/{id}
+// Requires authentication.
+func (c *Client) UpdateTaxRule(ctx context.Context, id int, body TaxRuleRequest) (*TaxRule, error) {
+	var out TaxRule
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/admin/tax-rules/{id}", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListTopAPIConsumersParams holds the query parameters for ListTopAPIConsumers
+type ListTopAPIConsumersParams struct {
+	// First day (YYYY-MM-DD)
+	From *string
+	// Last day (YYYY-MM-DD)
+	To *string
+	// Number of consumers
+	Limit *int
+}
+
+func (p *ListTopAPIConsumersParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.From != nil {
+		q.Set("from", fmt.Sprint(*p.From))
+	}
+	if p.To != nil {
+		q.Set("to", fmt.Sprint(*p.To))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	return q
+}
+
+// ListTopAPIConsumers - List top API consumers
+//
+// GET /api/v1/admin/usage
+// Requires authentication.
+func (c *Client) ListTopAPIConsumers(ctx context.Context, params *ListTopAPIConsumersParams) ([]APIUsageStats, error) {
+	var out []APIUsageStats
+	if err := c.do(ctx, "GET", "/api/v1/admin/usage", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetConsumersAPIUsageParams holds the query parameters for GetConsumersAPIUsage
+type GetConsumersAPIUsageParams struct {
+	// First day (YYYY-MM-DD)
+	From *string
+	// Last day (YYYY-MM-DD)
+	To *string
+}
+
+func (p *GetConsumersAPIUsageParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.From != nil {
+		q.Set("from", fmt.Sprint(*p.From))
+	}
+	if p.To != nil {
+		q.Set("to", fmt.Sprint(*p.To))
+	}
+	return q
+}
+
+// GetConsumersAPIUsage - Get a consumer's API usage
+//
+// GET /api/v1/admin/usage/{userId}
+// Requires authentication.
+func (c *Client) GetConsumersAPIUsage(ctx context.Context, userID int, params *GetConsumersAPIUsageParams) (*APIUsageReport, error) {
+	var out APIUsageReport
+	if err := c.do(ctx, "GET", expandPath("/api/v1/admin/usage/{userId}", "userId", userID), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUsersRoles - Get a user's roles
+//
+// GET /api/v1/admin/users/{id}/roles
+// Requires authentication.
+func (c *Client) GetUsersRoles(ctx context.Context, id int) (*UserAccess, error) {
+	var out UserAccess
+	if err := c.do(ctx, "GET", expandPath("/api/v1/admin/users/{id}/roles", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AssignRole - Assign a role
+//
+// POST /api/v1/admin/users/{id}/roles
+// Requires authentication.
+func (c *Client) AssignRole(ctx context.Context, id int, body AssignRoleRequest) (*UserRole, error) {
+	var out UserRole
+	if err := c.do(ctx, "POST", expandPath("/api/v1/admin/users/{id}/roles", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeRole - Revoke a role
+//
+// DELETE /api/v1/admin/users/{id}/roles/{role}
+// Requires authentication.
+func (c *Client) RevokeRole(ctx context.Context, id int, role string) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/admin/users/{id}/roles/{role}", "id", id, "role", role), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListWinBackCampaignsParams holds the query parameters for ListWinBackCampaigns
+type ListWinBackCampaignsParams struct {
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+}
+
+func (p *ListWinBackCampaignsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// ListWinBackCampaigns - List win-back campaigns
+//
+// GET /api/v1/admin/win-back/campaigns
+// Requires authentication.
+func (c *Client) ListWinBackCampaigns(ctx context.Context, params *ListWinBackCampaignsParams) ([]WinBackCampaignStats, error) {
+	var out []WinBackCampaignStats
+	if err := c.do(ctx, "GET", "/api/v1/admin/win-back/campaigns", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetWinBackCampaign - Get a win-back campaign
+//
+// GET /api/v1/admin/win-back/campaigns/{id}
+// Requires authentication.
+func (c *Client) GetWinBackCampaign(ctx context.Context, id int) (*WinBackCampaignStats, error) {
+	var out WinBackCampaignStats
+	if err := c.do(ctx, "GET", expandPath("/api/v1/admin/win-back/campaigns/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RunWinBackCampaign - Run a win-back campaign
+//
+// POST /api/v1/admin/win-back/run
+// Requires authentication.
+func (c *Client) RunWinBackCampaign(ctx context.Context, body RunWinBackRequest) (*WinBackRunResult, error) {
+	var out WinBackRunResult
+	if err := c.do(ctx, "POST", "/api/v1/admin/win-back/run", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ChangeUserPassword - Change user password
+//
+// POST /api/v1/auth/change-password
+// Requires authentication.
+func (c *Client) ChangeUserPassword(ctx context.Context, body ChangePasswordRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", "/api/v1/auth/change-password", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClaimImportedAccount - Claim an imported account
+//
+// POST /api/v1/auth/claim
+func (c *Client) ClaimImportedAccount(ctx context.Context, body ClaimAccountRequest) (*AuthResponse, error) {
+	var out AuthResponse
+	if err := c.do(ctx, "POST", "/api/v1/auth/claim", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListLinkedSignInAccounts - List linked sign-in accounts
+//
+// GET /api/v1/auth/identities
+// Requires authentication.
+func (c *Client) ListLinkedSignInAccounts(ctx context.Context) ([]UserIdentity, error) {
+	var out []UserIdentity
+	if err := c.do(ctx, "GET", "/api/v1/auth/identities", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UnlinkGoogleOrAppleAccount - Unlink a Google or Apple account
+//
+// DELETE /api/v1/auth/identities/{provider}
+// Requires authentication.
+func (c *Client) UnlinkGoogleOrAppleAccount(ctx context.Context, provider string) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/auth/identities/{provider}", "provider", provider), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LinkGoogleOrAppleAccount - Link a Google or Apple account
+//
+// POST /api/v1/auth/identities/{provider}
+// Requires authentication.
+func (c *Client) LinkGoogleOrAppleAccount(ctx context.Context, provider string, body LinkIdentityRequest) (*UserIdentity, error) {
+	var out UserIdentity
+	if err := c.do(ctx, "POST", expandPath("/api/v1/auth/identities/{provider}", "provider", provider), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UserLogin - User login
+//
+// POST /api/v1/auth/login
+func (c *Client) UserLogin(ctx context.Context, body LoginRequest) (*AuthResponse, error) {
+	var out AuthResponse
+	if err := c.do(ctx, "POST", "/api/v1/auth/login", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UserLogout - User logout
+//
+// POST /api/v1/auth/logout
+// Requires authentication.
+func (c *Client) UserLogout(ctx context.Context, body LogoutRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", "/api/v1/auth/logout", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetCurrentUserProfile - Get current user profile
+//
+// GET /api/v1/auth/me
+// Requires authentication.
+func (c *Client) GetCurrentUserProfile(ctx context.Context) (*UserProfileResponse, error) {
+	var out UserProfileResponse
+	if err := c.do(ctx, "GET", "/api/v1/auth/me", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SignInWithGoogleOrApple - Sign in with Google or Apple
+//
+// POST /api/v1/auth/oauth/{provider}
+func (c *Client) SignInWithGoogleOrApple(ctx context.Context, provider string, body SocialLoginRequest) (*AuthResponse, error) {
+	var out AuthResponse
+	if err := c.do(ctx, "POST", expandPath("/api/v1/auth/oauth/{provider}", "provider", provider), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateUserProfile - Update user profile
+//
+// PUT /api/v1/auth/profile
+// Requires authentication.
+func (c *Client) UpdateUserProfile(ctx context.Context, body UpdateProfileRequest) (*UserProfileResponse, error) {
+	var out UserProfileResponse
+	if err := c.do(ctx, "PUT", "/api/v1/auth/profile", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RefreshTokens - Refresh tokens
+//
+// POST /api/v1/auth/refresh
+func (c *Client) RefreshTokens(ctx context.Context, body RefreshTokenRequest) (*AuthResponse, error) {
+	var out AuthResponse
+	if err := c.do(ctx, "POST", "/api/v1/auth/refresh", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RegisterNewUser - Register a new user
+//
+// POST /api/v1/auth/register
+func (c *Client) RegisterNewUser(ctx context.Context, body RegisterRequest) (*AuthResponse, error) {
+	var out AuthResponse
+	if err := c.do(ctx, "POST", "/api/v1/auth/register", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddServiceToBarber - Add service to barber
+//
+// POST /api/v1/barber-services
+func (c *Client) AddServiceToBarber(ctx context.Context, body CreateBarberServiceRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", "/api/v1/barber-services", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoveServiceFromBarber - Remove service from barber
+//
+// DELETE /api/v1/barber-services/{id}
+func (c *Client) RemoveServiceFromBarber(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barber-services/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarberServiceByID - Get barber service by ID
+//
+// GET /api/v1/barber-services/{id}
+func (c *Client) GetBarberServiceByID(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barber-services/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateBarberService - Update barber service
+//
+// PUT /api/v1/barber-services/{id}
+func (c *Client) UpdateBarberService(ctx context.Context, id int, body UpdateBarberServiceRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/barber-services/{id}", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetAllBarbersParams holds the query parameters for GetAllBarbers
+type GetAllBarbersParams struct {
+	// Filter by status
+	Status *string
+	// Filter by city
+	City *string
+	// Filter by state
+	State *string
+	// Filter by verification
+	IsVerified *bool
+	// Minimum rating
+	MinRating *float64
+	// Only barbers offering a service in this category
+	CategoryID *int
+	// Search term
+	Search *string
+	// Sort by field (rank, rating, total_bookings, shop_name, user_name, newest)
+	SortBy *string
+	// Searcher latitude (with lng) for distance ranking
+	Lat *float64
+	// Searcher longitude (with lat) for distance ranking
+	Lng *float64
+	// Include per-factor ranking contributions
+	Explain *bool
+	// Number of results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *GetAllBarbersParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.City != nil {
+		q.Set("city", fmt.Sprint(*p.City))
+	}
+	if p.State != nil {
+		q.Set("state", fmt.Sprint(*p.State))
+	}
+	if p.IsVerified != nil {
+		q.Set("is_verified", fmt.Sprint(*p.IsVerified))
+	}
+	if p.MinRating != nil {
+		q.Set("min_rating", fmt.Sprint(*p.MinRating))
+	}
+	if p.CategoryID != nil {
+		q.Set("category_id", fmt.Sprint(*p.CategoryID))
+	}
+	if p.Search != nil {
+		q.Set("search", fmt.Sprint(*p.Search))
+	}
+	if p.SortBy != nil {
+		q.Set("sort_by", fmt.Sprint(*p.SortBy))
+	}
+	if p.Lat != nil {
+		q.Set("lat", fmt.Sprint(*p.Lat))
+	}
+	if p.Lng != nil {
+		q.Set("lng", fmt.Sprint(*p.Lng))
+	}
+	if p.Explain != nil {
+		q.Set("explain", fmt.Sprint(*p.Explain))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// GetAllBarbers - Get all barbers
+//
+// GET /api/v1/barbers
+func (c *Client) GetAllBarbers(ctx context.Context, params *GetAllBarbersParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", "/api/v1/barbers", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateNewBarber - Create new barber
+//
+// POST /api/v1/barbers
+// Requires authentication.
+func (c *Client) CreateNewBarber(ctx context.Context, body CreateBarberRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", "/api/v1/barbers", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListFavoriteBarbers - List favorite barbers
+//
+// GET /api/v1/barbers/favorites
+// Requires authentication.
+func (c *Client) ListFavoriteBarbers(ctx context.Context) ([]FavoriteBarber, error) {
+	var out []FavoriteBarber
+	if err := c.do(ctx, "GET", "/api/v1/barbers/favorites", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FindBarbersNearbyParams holds the query parameters for FindBarbersNearby
+type FindBarbersNearbyParams struct {
+	// Latitude
+	Lat float64
+	// Longitude
+	Lng float64
+	// Radius in km (default 10, at most 100)
+	Radius *float64
+	// Maximum barbers (default 20, at most 50)
+	Limit *int
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *FindBarbersNearbyParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	q.Set("lat", fmt.Sprint(p.Lat))
+	q.Set("lng", fmt.Sprint(p.Lng))
+	if p.Radius != nil {
+		q.Set("radius", fmt.Sprint(*p.Radius))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// FindBarbersNearby - Find barbers nearby
+//
+// GET /api/v1/barbers/nearby
+func (c *Client) FindBarbersNearby(ctx context.Context, params *FindBarbersNearbyParams) ([]Barber, error) {
+	var out []Barber
+	if err := c.do(ctx, "GET", "/api/v1/barbers/nearby", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchBarbersParams holds the query parameters for SearchBarbers
+type SearchBarbersParams struct {
+	// Search query
+	Q string
+	// Filter by city
+	City *string
+	// Filter by state
+	State *string
+	// Only barbers offering a service in this category
+	CategoryID *int
+	// Searcher latitude (with lng) for distance ranking
+	Lat *float64
+	// Searcher longitude (with lat) for distance ranking
+	Lng *float64
+	// Include per-factor ranking contributions
+	Explain *bool
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *SearchBarbersParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	q.Set("q", fmt.Sprint(p.Q))
+	if p.City != nil {
+		q.Set("city", fmt.Sprint(*p.City))
+	}
+	if p.State != nil {
+		q.Set("state", fmt.Sprint(*p.State))
+	}
+	if p.CategoryID != nil {
+		q.Set("category_id", fmt.Sprint(*p.CategoryID))
+	}
+	if p.Lat != nil {
+		q.Set("lat", fmt.Sprint(*p.Lat))
+	}
+	if p.Lng != nil {
+		q.Set("lng", fmt.Sprint(*p.Lng))
+	}
+	if p.Explain != nil {
+		q.Set("explain", fmt.Sprint(*p.Explain))
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// SearchBarbers - Search barbers
+//
+// GET /api/v1/barbers/search
+func (c *Client) SearchBarbers(ctx context.Context, params *SearchBarbersParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", "/api/v1/barbers/search", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarberByUUIDParams holds the query parameters for GetBarberByUUID
+type GetBarberByUUIDParams struct {
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *GetBarberByUUIDParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// GetBarberByUUID - Get barber by UUID
+//
+// GET /api/v1/barbers/uuid/{uuid}
+func (c *Client) GetBarberByUUID(ctx context.Context, uuid string, params *GetBarberByUUIDParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/uuid/{uuid}", "uuid", uuid), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarbersServices - Get barber's services
+//
+// GET /api/v1/barbers/{barber_id}/services
+func (c *Client) GetBarbersServices(ctx context.Context, barberID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{barber_id}/services", "barber_id", barberID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteBarber - Delete barber
+//
+// DELETE /api/v1/barbers/{id}
+func (c *Client) DeleteBarber(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barbers/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarberByIDParams holds the query parameters for GetBarberByID
+type GetBarberByIDParams struct {
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *GetBarberByIDParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// GetBarberByID - Get barber by ID
+//
+// GET /api/v1/barbers/{id}
+func (c *Client) GetBarberByID(ctx context.Context, id int, params *GetBarberByIDParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateBarber - Update barber
+//
+// PUT /api/v1/barbers/{id}
+func (c *Client) UpdateBarber(ctx context.Context, id int, body UpdateBarberRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/barbers/{id}", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarbersAutoReply - Get a barber's auto-reply
+//
+// GET /api/v1/barbers/{id}/auto-reply
+// Requires authentication.
+func (c *Client) GetBarbersAutoReply(ctx context.Context, id int) (*AutoReplyResponse, error) {
+	var out AutoReplyResponse
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/auto-reply", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SetBarbersAutoReply - Set a barber's auto-reply
+//
+// PUT /api/v1/barbers/{id}/auto-reply
+// Requires authentication.
+func (c *Client) SetBarbersAutoReply(ctx context.Context, id int, body UpdateAutoReplyRequest) (*AutoReplyResponse, error) {
+	var out AutoReplyResponse
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/barbers/{id}/auto-reply", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListOpenTimeSlotsForBarberParams holds the query parameters for ListOpenTimeSlotsForBarber
+type ListOpenTimeSlotsForBarberParams struct {
+	// Day in the barber's timezone (YYYY-MM-DD)
+	Date string
+	// Barber service ID (sets duration and buffer)
+	ServiceID *int
+	// Duration in minutes (overrides the service duration)
+	Duration *int
+	// Minutes between candidate start times
+	Interval *int
+	// Barber location (keeps travel time to bookings at the barber's other locations)
+	LocationID *int
+}
+
+func (p *ListOpenTimeSlotsForBarberParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	q.Set("date", fmt.Sprint(p.Date))
+	if p.ServiceID != nil {
+		q.Set("service_id", fmt.Sprint(*p.ServiceID))
+	}
+	if p.Duration != nil {
+		q.Set("duration", fmt.Sprint(*p.Duration))
+	}
+	if p.Interval != nil {
+		q.Set("interval", fmt.Sprint(*p.Interval))
+	}
+	if p.LocationID != nil {
+		q.Set("location_id", fmt.Sprint(*p.LocationID))
+	}
+	return q
+}
+
+// ListOpenTimeSlotsForBarber - List open time slots for a barber
+//
+// GET /api/v1/barbers/{id}/availability
+func (c *Client) ListOpenTimeSlotsForBarber(ctx context.Context, id int, params *ListOpenTimeSlotsForBarberParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/availability", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarbersBookingForm - Get a barber's booking form
+//
+// GET /api/v1/barbers/{id}/booking-form
+func (c *Client) GetBarbersBookingForm(ctx context.Context, id int) ([]BookingFormField, error) {
+	var out []BookingFormField
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/booking-form", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListBookingFormFields - List booking form fields
+//
+// GET /api/v1/barbers/{id}/booking-form/fields
+// Requires authentication.
+func (c *Client) ListBookingFormFields(ctx context.Context, id int) ([]BookingFormField, error) {
+	var out []BookingFormField
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/booking-form/fields", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddBookingFormField - Add a booking form field
+//
+// POST /api/v1/barbers/{id}/booking-form/fields
+// Requires authentication.
+func (c *Client) AddBookingFormField(ctx context.Context, id int, body BookingFormFieldRequest) (*BookingFormField, error) {
+	var out BookingFormField
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/booking-form/fields", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveBookingFormField - Remove a booking form field
+//
+// DELETE /api/v1/barbers/{id}/booking-form/fields/{fieldId}
+// Requires authentication.
+func (c *Client) RemoveBookingFormField(ctx context.Context, id int, fieldID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barbers/{id}/booking-form/fields/{fieldId}", "id", id, "fieldId", fieldID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateBookingFormField - Update a booking form field
+//
+// PUT /api/v1/barbers/{id}/booking-form/fields/{fieldId}
+// Requires authentication.
+func (c *Client) UpdateBookingFormField(ctx context.Context, id int, fieldID int, body BookingFormFieldRequest) (*BookingFormField, error) {
+	var out BookingFormField
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/barbers/{id}/booking-form/fields/{fieldId}", "id", id, "fieldId", fieldID), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetBarbersBookingsParams holds the query parameters for GetBarbersBookings
+type GetBarbersBookingsParams struct {
+	// Filter by status
+	Status *string
+	// Filter by payment status
+	PaymentStatus *string
+	// Filter by start date from (RFC3339)
+	StartDateFrom *string
+	// Filter by start date to (RFC3339)
+	StartDateTo *string
+	// Sort by field (scheduled_start_time, total_price, created_at, status, payment_status, customer_name, booking_number)
+	SortBy *string
+	// Sort order (ASC/DESC)
+	Order *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+	// Attach each customer's name and picture
+	IncludeCustomer *bool
+	// Attach each barber's shop summary
+	IncludeBarber *bool
+	// Attach each booked service's name, price and duration
+	IncludeService *bool
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *GetBarbersBookingsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.PaymentStatus != nil {
+		q.Set("payment_status", fmt.Sprint(*p.PaymentStatus))
+	}
+	if p.StartDateFrom != nil {
+		q.Set("start_date_from", fmt.Sprint(*p.StartDateFrom))
+	}
+	if p.StartDateTo != nil {
+		q.Set("start_date_to", fmt.Sprint(*p.StartDateTo))
+	}
+	if p.SortBy != nil {
+		q.Set("sort_by", fmt.Sprint(*p.SortBy))
+	}
+	if p.Order != nil {
+		q.Set("order", fmt.Sprint(*p.Order))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	if p.IncludeCustomer != nil {
+		q.Set("include_customer", fmt.Sprint(*p.IncludeCustomer))
+	}
+	if p.IncludeBarber != nil {
+		q.Set("include_barber", fmt.Sprint(*p.IncludeBarber))
+	}
+	if p.IncludeService != nil {
+		q.Set("include_service", fmt.Sprint(*p.IncludeService))
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// GetBarbersBookings - Get barber's bookings
+//
+// GET /api/v1/barbers/{id}/bookings
+func (c *Client) GetBarbersBookings(ctx context.Context, id int, params *GetBarbersBookingsParams) ([]BookingResponse, error) {
+	var out []BookingResponse
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/bookings", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImportAppointmentHistoryFromCsv - Import appointment history from CSV
+//
+// POST /api/v1/barbers/{id}/bookings/import
+// Requires authentication.
+func (c *Client) ImportAppointmentHistoryFromCsv(ctx context.Context, id int) (*BookingImportResult, error) {
+	var out BookingImportResult
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/bookings/import", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetBookingStatisticsForBarberParams holds the query parameters for GetBookingStatisticsForBarber
+type GetBookingStatisticsForBarberParams struct {
+	// From date (RFC3339)
+	From *string
+	// To date (RFC3339)
+	To *string
+}
+
+func (p *GetBookingStatisticsForBarberParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.From != nil {
+		q.Set("from", fmt.Sprint(*p.From))
+	}
+	if p.To != nil {
+		q.Set("to", fmt.Sprint(*p.To))
+	}
+	return q
+}
+
+// GetBookingStatisticsForBarber - Get booking statistics for a barber
+//
+// GET /api/v1/barbers/{id}/bookings/stats
+func (c *Client) GetBookingStatisticsForBarber(ctx context.Context, id int, params *GetBookingStatisticsForBarberParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/bookings/stats", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LiveBookingFeedServerSentEventsParams holds the query parameters for LiveBookingFeedServerSentEvents
+type LiveBookingFeedServerSentEventsParams struct {
+	// Access token, when the Authorization header cannot be set
+	Token *string
+}
+
+func (p *LiveBookingFeedServerSentEventsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Token != nil {
+		q.Set("token", fmt.Sprint(*p.Token))
+	}
+	return q
+}
+
+// LiveBookingFeedServerSentEvents - Live booking feed (Server-Sent Events)
+//
+// GET /api/v1/barbers/{id}/bookings/stream
+// Requires authentication.
+func (c *Client) LiveBookingFeedServerSentEvents(ctx context.Context, id int, params *LiveBookingFeedServerSentEventsParams) (*string, error) {
+	var out string
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/bookings/stream", "id", id), params.values(), nil, false, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTodaysBookingsForBarber - Get today's bookings for a barber
+//
+// GET /api/v1/barbers/{id}/bookings/today
+func (c *Client) GetTodaysBookingsForBarber(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/bookings/today", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarbersCancellationsByReasonParams holds the query parameters for GetBarbersCancellationsByReason
+type GetBarbersCancellationsByReasonParams struct {
+	// Start date (YYYY-MM-DD)
+	From *string
+	// End date, inclusive (YYYY-MM-DD)
+	To *string
+	// Language of the labels (default: the Accept-Language one)
+	Locale *string
+}
+
+func (p *GetBarbersCancellationsByReasonParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.From != nil {
+		q.Set("from", fmt.Sprint(*p.From))
+	}
+	if p.To != nil {
+		q.Set("to", fmt.Sprint(*p.To))
+	}
+	if p.Locale != nil {
+		q.Set("locale", fmt.Sprint(*p.Locale))
+	}
+	return q
+}
+
+// GetBarbersCancellationsByReason - Get a barber's cancellations by reason
+//
+// GET /api/v1/barbers/{id}/cancellation-reasons
+// Requires authentication.
+func (c *Client) GetBarbersCancellationsByReason(ctx context.Context, id int, params *GetBarbersCancellationsByReasonParams) (*CancellationReasonStats, error) {
+	var out CancellationReasonStats
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/cancellation-reasons", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListBarbersClientsParams holds the query parameters for ListBarbersClients
+type ListBarbersClientsParams struct {
+	// Page size
+	Limit *int
+	// Offset
+	Offset *int
+}
+
+func (p *ListBarbersClientsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// ListBarbersClients - List a barber's clients
+//
+// GET /api/v1/barbers/{id}/clients
+// Requires authentication.
+func (c *Client) ListBarbersClients(ctx context.Context, id int, params *ListBarbersClientsParams) ([]BarberClient, error) {
+	var out []BarberClient
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/clients", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ImportClientsFromCsv - Import clients from CSV
+//
+// POST /api/v1/barbers/{id}/clients/import
+// Requires authentication.
+func (c *Client) ImportClientsFromCsv(ctx context.Context, id int) (*ClientImportResult, error) {
+	var out ClientImportResult
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/clients/import", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListBarbersConsultationRequestsParams holds the query parameters for ListBarbersConsultationRequests
+type ListBarbersConsultationRequestsParams struct {
+	// Filter by status (open, quoted, booked, declined)
+	Status *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+}
+
+func (p *ListBarbersConsultationRequestsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// ListBarbersConsultationRequests - List a barber's consultation requests
+//
+// GET /api/v1/barbers/{id}/consultation-requests
+// Requires authentication.
+func (c *Client) ListBarbersConsultationRequests(ctx context.Context, id int, params *ListBarbersConsultationRequestsParams) ([]ConsultationRequest, error) {
+	var out []ConsultationRequest
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/consultation-requests", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarbersConsultationRequest - Get a barber's consultation request
+//
+// GET /api/v1/barbers/{id}/consultation-requests/{requestId}
+// Requires authentication.
+func (c *Client) GetBarbersConsultationRequest(ctx context.Context, id int, requestID int) (*ConsultationRequest, error) {
+	var out ConsultationRequest
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/consultation-requests/{requestId}", "id", id, "requestId", requestID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AnswerConsultationRequest - Answer a consultation request
+//
+// PUT /api/v1/barbers/{id}/consultation-requests/{requestId}
+// Requires authentication.
+func (c *Client) AnswerConsultationRequest(ctx context.Context, id int, requestID int, body ConsultationResponseRequest) (*ConsultationRequest, error) {
+	var out ConsultationRequest
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/barbers/{id}/consultation-requests/{requestId}", "id", id, "requestId", requestID), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// QuoteConsultationRequest - Quote a consultation request
+//
+// PUT /api/v1/barbers/{id}/consultation-requests/{requestId}/quote
+// Requires authentication.
+func (c *Client) QuoteConsultationRequest(ctx context.Context, id int, requestID int, body ConsultationQuoteRequest) (*ConsultationRequest, error) {
+	var out ConsultationRequest
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/barbers/{id}/consultation-requests/{requestId}/quote", "id", id, "requestId", requestID), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetBarbersLiveDashboardForToday - Get the barber's live dashboard for today
+//
+// GET /api/v1/barbers/{id}/dashboard/today
+// Requires authentication.
+func (c *Client) GetBarbersLiveDashboardForToday(ctx context.Context, id int) (*BarberDashboard, error) {
+	var out BarberDashboard
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/dashboard/today", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetBarbersEarningsParams holds the query parameters for GetBarbersEarnings
+type GetBarbersEarningsParams struct {
+	// First day (YYYY-MM-DD)
+	From *string
+	// Last day (YYYY-MM-DD)
+	To *string
+}
+
+func (p *GetBarbersEarningsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.From != nil {
+		q.Set("from", fmt.Sprint(*p.From))
+	}
+	if p.To != nil {
+		q.Set("to", fmt.Sprint(*p.To))
+	}
+	return q
+}
+
+// GetBarbersEarnings - Get a barber's earnings
+//
+// GET /api/v1/barbers/{id}/earnings
+// Requires authentication.
+func (c *Client) GetBarbersEarnings(ctx context.Context, id int, params *GetBarbersEarningsParams) (*EarningsReport, error) {
+	var out EarningsReport
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/earnings", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnfavoriteBarber - Unfavorite a barber
+//
+// DELETE /api/v1/barbers/{id}/favorite
+// Requires authentication.
+func (c *Client) UnfavoriteBarber(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barbers/{id}/favorite", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// FavoriteBarber - Favorite a barber
+//
+// POST /api/v1/barbers/{id}/favorite
+// Requires authentication.
+func (c *Client) FavoriteBarber(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/favorite", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListBarbersFeaturedPlacementsParams holds the query parameters for ListBarbersFeaturedPlacements
+type ListBarbersFeaturedPlacementsParams struct {
+	// Filter by status (pending_payment, active, cancelled)
+	Status *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+}
+
+func (p *ListBarbersFeaturedPlacementsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// ListBarbersFeaturedPlacements - List a barber's featured placements
+//
+// GET /api/v1/barbers/{id}/featured
+// Requires authentication.
+func (c *Client) ListBarbersFeaturedPlacements(ctx context.Context, id int, params *ListBarbersFeaturedPlacementsParams) ([]FeaturedPlacement, error) {
+	var out []FeaturedPlacement
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/featured", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BuyFeaturedPlacement - Buy featured placement
+//
+// POST /api/v1/barbers/{id}/featured
+// Requires authentication.
+func (c *Client) BuyFeaturedPlacement(ctx context.Context, id int, body PurchaseFeaturedRequest) (*FeaturedPlacement, error) {
+	var out FeaturedPlacement
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/featured", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CancelFeaturedPlacement - Cancel a featured placement
+//
+// DELETE /api/v1/barbers/{id}/featured/{placementId}
+// Requires authentication.
+func (c *Client) CancelFeaturedPlacement(ctx context.Context, id int, placementID int) (*FeaturedPlacement, error) {
+	var out FeaturedPlacement
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barbers/{id}/featured/{placementId}", "id", id, "placementId", placementID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListBarbersInventoryParams holds the query parameters for ListBarbersInventory
+type ListBarbersInventoryParams struct {
+	// Only items at or below their low-stock threshold
+	LowStock *bool
+}
+
+func (p *ListBarbersInventoryParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.LowStock != nil {
+		q.Set("low_stock", fmt.Sprint(*p.LowStock))
+	}
+	return q
+}
+
+// ListBarbersInventory - List a barber's inventory
+//
+// GET /api/v1/barbers/{id}/inventory
+// Requires authentication.
+func (c *Client) ListBarbersInventory(ctx context.Context, id int, params *ListBarbersInventoryParams) ([]InventoryItem, error) {
+	var out []InventoryItem
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/inventory", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TrackProduct - Track a product
+//
+// POST /api/v1/barbers/{id}/inventory
+// Requires authentication.
+func (c *Client) TrackProduct(ctx context.Context, id int, body InventoryItemRequest) (*InventoryItem, error) {
+	var out InventoryItem
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/inventory", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetBarbersProductUsageParams holds the query parameters for GetBarbersProductUsage
+type GetBarbersProductUsageParams struct {
+	// First day (YYYY-MM-DD)
+	From *string
+	// Last day (YYYY-MM-DD)
+	To *string
+}
+
+func (p *GetBarbersProductUsageParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.From != nil {
+		q.Set("from", fmt.Sprint(*p.From))
+	}
+	if p.To != nil {
+		q.Set("to", fmt.Sprint(*p.To))
+	}
+	return q
+}
+
+// GetBarbersProductUsage - Get a barber's product usage
+//
+// GET /api/v1/barbers/{id}/inventory/usage
+// Requires authentication.
+func (c *Client) GetBarbersProductUsage(ctx context.Context, id int, params *GetBarbersProductUsageParams) (*InventoryUsageReport, error) {
+	var out InventoryUsageReport
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/inventory/usage", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// StopTrackingProduct - Stop tracking a product
+//
+// DELETE /api/v1/barbers/{id}/inventory/{itemId}
+// Requires authentication.
+func (c *Client) StopTrackingProduct(ctx context.Context, id int, itemID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barbers/{id}/inventory/{itemId}", "id", id, "itemId", itemID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateProduct - Update a product
+//
+// PUT /api/v1/barbers/{id}/inventory/{itemId}
+// Requires authentication.
+func (c *Client) UpdateProduct(ctx context.Context, id int, itemID int, body InventoryItemRequest) (*InventoryItem, error) {
+	var out InventoryItem
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/barbers/{id}/inventory/{itemId}", "id", id, "itemId", itemID), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListBarbersLocations - List a barber's locations
+//
+// GET /api/v1/barbers/{id}/locations
+func (c *Client) ListBarbersLocations(ctx context.Context, id int) ([]BarberLocation, error) {
+	var out []BarberLocation
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/locations", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddLocation - Add a location
+//
+// POST /api/v1/barbers/{id}/locations
+// Requires authentication.
+func (c *Client) AddLocation(ctx context.Context, id int, body LocationRequest) (*BarberLocation, error) {
+	var out BarberLocation
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/locations", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveLocation - Remove a location
+//
+// DELETE /api/v1/barbers/{id}/locations/{locationId}
+// Requires authentication.
+func (c *Client) RemoveLocation(ctx context.Context, id int, locationID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barbers/{id}/locations/{locationId}", "id", id, "locationId", locationID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateLocation - Update a location
+//
+// PUT /api/v1/barbers/{id}/locations/{locationId}
+// Requires authentication.
+func (c *Client) UpdateLocation(ctx context.Context, id int, locationID int, body LocationRequest) (*BarberLocation, error) {
+	var out BarberLocation
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/barbers/{id}/locations/{locationId}", "id", id, "locationId", locationID), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetBarbersNpsTrendParams holds the query parameters for GetBarbersNpsTrend
+type GetBarbersNpsTrendParams struct {
+	// Start date (YYYY-MM-DD)
+	From *string
+	// End date, inclusive (YYYY-MM-DD)
+	To *string
+	// Bucket size
+	Interval *string
+}
+
+func (p *GetBarbersNpsTrendParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.From != nil {
+		q.Set("from", fmt.Sprint(*p.From))
+	}
+	if p.To != nil {
+		q.Set("to", fmt.Sprint(*p.To))
+	}
+	if p.Interval != nil {
+		q.Set("interval", fmt.Sprint(*p.Interval))
+	}
+	return q
+}
+
+// GetBarbersNpsTrend - Get a barber's NPS trend
+//
+// GET /api/v1/barbers/{id}/nps
+// Requires authentication.
+func (c *Client) GetBarbersNpsTrend(ctx context.Context, id int, params *GetBarbersNpsTrendParams) (*NpsTrend, error) {
+	var out NpsTrend
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/nps", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListBarbersOpenSlots - List a barber's open slots
+//
+// GET /api/v1/barbers/{id}/open-slots
+// Requires authentication.
+func (c *Client) ListBarbersOpenSlots(ctx context.Context, id int) ([]OpenSlot, error) {
+	var out []OpenSlot
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/open-slots", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BroadcastLastMinuteOpenSlot - Broadcast a last-minute open slot
+//
+// POST /api/v1/barbers/{id}/open-slots
+// Requires authentication.
+func (c *Client) BroadcastLastMinuteOpenSlot(ctx context.Context, id int, body BroadcastOpenSlotRequest) (*OpenSlot, error) {
+	var out OpenSlot
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/open-slots", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// WithdrawOpenSlot - Withdraw an open slot
+//
+// DELETE /api/v1/barbers/{id}/open-slots/{slotId}
+// Requires authentication.
+func (c *Client) WithdrawOpenSlot(ctx context.Context, id int, slotID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barbers/{id}/open-slots/{slotId}", "id", id, "slotId", slotID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarbersReviewsParams holds the query parameters for GetBarbersReviews
+type GetBarbersReviewsParams struct {
+	// Filter by minimum rating
+	MinRating *int
+	// Filter by maximum rating
+	MaxRating *int
+	// Filter reviews with comments
+	HasComment *bool
+	// Filter reviews with images
+	HasImages *bool
+	// Sort by field (created_at, overall_rating, helpful_votes, report_count)
+	SortBy *string
+	// Sort order (ASC/DESC)
+	Order *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+	// Attach each customer's name and picture
+	IncludeCustomer *bool
+	// Attach each barber's shop summary
+	IncludeBarber *bool
+}
+
+func (p *GetBarbersReviewsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.MinRating != nil {
+		q.Set("min_rating", fmt.Sprint(*p.MinRating))
+	}
+	if p.MaxRating != nil {
+		q.Set("max_rating", fmt.Sprint(*p.MaxRating))
+	}
+	if p.HasComment != nil {
+		q.Set("has_comment", fmt.Sprint(*p.HasComment))
+	}
+	if p.HasImages != nil {
+		q.Set("has_images", fmt.Sprint(*p.HasImages))
+	}
+	if p.SortBy != nil {
+		q.Set("sort_by", fmt.Sprint(*p.SortBy))
+	}
+	if p.Order != nil {
+		q.Set("order", fmt.Sprint(*p.Order))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	if p.IncludeCustomer != nil {
+		q.Set("include_customer", fmt.Sprint(*p.IncludeCustomer))
+	}
+	if p.IncludeBarber != nil {
+		q.Set("include_barber", fmt.Sprint(*p.IncludeBarber))
+	}
+	return q
+}
+
+// GetBarbersReviews - Get barber's reviews
+//
+// GET /api/v1/barbers/{id}/reviews
+func (c *Client) GetBarbersReviews(ctx context.Context, id int, params *GetBarbersReviewsParams) ([]ReviewResponse, error) {
+	var out []ReviewResponse
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/reviews", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarbersReviewStatistics - Get barber's review statistics
+//
+// GET /api/v1/barbers/{id}/reviews/stats
+func (c *Client) GetBarbersReviewStatistics(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/reviews/stats", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarbersSchedule - Get a barber's schedule
+//
+// GET /api/v1/barbers/{id}/schedule
+func (c *Client) GetBarbersSchedule(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/schedule", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReplaceBarbersWeeklySchedule - Replace a barber's weekly schedule
+//
+// PUT /api/v1/barbers/{id}/schedule
+// Requires authentication.
+func (c *Client) ReplaceBarbersWeeklySchedule(ctx context.Context, id int, body UpdateScheduleRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/barbers/{id}/schedule", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddScheduleException - Add a schedule exception
+//
+// POST /api/v1/barbers/{id}/schedule/exceptions
+// Requires authentication.
+func (c *Client) AddScheduleException(ctx context.Context, id int, body CreateScheduleExceptionRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/schedule/exceptions", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoveScheduleException - Remove a schedule exception
+//
+// DELETE /api/v1/barbers/{id}/schedule/exceptions/{exceptionId}
+// Requires authentication.
+func (c *Client) RemoveScheduleException(ctx context.Context, id int, exceptionID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barbers/{id}/schedule/exceptions/{exceptionId}", "id", id, "exceptionId", exceptionID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListServicesAddOns - List a service's add-ons
+//
+// GET /api/v1/barbers/{id}/services/{serviceId}/add-ons
+func (c *Client) ListServicesAddOns(ctx context.Context, id int, serviceID int) ([]ServiceAddOn, error) {
+	var out []ServiceAddOn
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/services/{serviceId}/add-ons", "id", id, "serviceId", serviceID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddAddOnToService - Add an add-on to a service
+//
+// POST /api/v1/barbers/{id}/services/{serviceId}/add-ons
+// Requires authentication.
+func (c *Client) AddAddOnToService(ctx context.Context, id int, serviceID int, body AddOnRequest) (*ServiceAddOn, error) {
+	var out ServiceAddOn
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/services/{serviceId}/add-ons", "id", id, "serviceId", serviceID), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveServiceAddOn - Remove a service add-on
+//
+// DELETE /api/v1/barbers/{id}/services/{serviceId}/add-ons/{addOnId}
+// Requires authentication.
+func (c *Client) RemoveServiceAddOn(ctx context.Context, id int, serviceID int, addOnID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barbers/{id}/services/{serviceId}/add-ons/{addOnId}", "id", id, "serviceId", serviceID, "addOnId", addOnID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateServiceAddOn - Update a service add-on
+//
+// PUT /api/v1/barbers/{id}/services/{serviceId}/add-ons/{addOnId}
+// Requires authentication.
+func (c *Client) UpdateServiceAddOn(ctx context.Context, id int, serviceID int, addOnID int, body AddOnRequest) (*ServiceAddOn, error) {
+	var out ServiceAddOn
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/barbers/{id}/services/{serviceId}/add-ons/{addOnId}", "id", id, "serviceId", serviceID, "addOnId", addOnID), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListServicesPortfolioParams holds the query parameters for ListServicesPortfolio
+type ListServicesPortfolioParams struct {
+	// Gallery
+	Kind *string
+}
+
+func (p *ListServicesPortfolioParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Kind != nil {
+		q.Set("kind", fmt.Sprint(*p.Kind))
+	}
+	return q
+}
+
+// ListServicesPortfolio - List a service's portfolio
+//
+// GET /api/v1/barbers/{id}/services/{serviceId}/portfolio
+func (c *Client) ListServicesPortfolio(ctx context.Context, id int, serviceID int, params *ListServicesPortfolioParams) ([]PortfolioMedia, error) {
+	var out []PortfolioMedia
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/services/{serviceId}/portfolio", "id", id, "serviceId", serviceID), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddPhotoToServicesPortfolio - Add a photo to a service's portfolio
+//
+// POST /api/v1/barbers/{id}/services/{serviceId}/portfolio
+// Requires authentication.
+func (c *Client) AddPhotoToServicesPortfolio(ctx context.Context, id int, serviceID int) (*PortfolioMedia, error) {
+	var out PortfolioMedia
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/services/{serviceId}/portfolio", "id", id, "serviceId", serviceID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReorderServicesPortfolio - Reorder a service's portfolio
+//
+// PUT /api/v1/barbers/{id}/services/{serviceId}/portfolio/order
+// Requires authentication.
+func (c *Client) ReorderServicesPortfolio(ctx context.Context, id int, serviceID int, body PortfolioOrderRequest) ([]PortfolioMedia, error) {
+	var out []PortfolioMedia
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/barbers/{id}/services/{serviceId}/portfolio/order", "id", id, "serviceId", serviceID), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemovePortfolioPhoto - Remove a portfolio photo
+//
+// DELETE /api/v1/barbers/{id}/services/{serviceId}/portfolio/{mediaId}
+// Requires authentication.
+func (c *Client) RemovePortfolioPhoto(ctx context.Context, id int, serviceID int, mediaID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barbers/{id}/services/{serviceId}/portfolio/{mediaId}", "id", id, "serviceId", serviceID, "mediaId", mediaID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CaptionPortfolioPhoto - Caption a portfolio photo
+//
+// PATCH /api/v1/barbers/{id}/services/{serviceId}/portfolio/{mediaId}
+// Requires authentication.
+func (c *Client) CaptionPortfolioPhoto(ctx context.Context, id int, serviceID int, mediaID int, body PortfolioCaptionRequest) (*PortfolioMedia, error) {
+	var out PortfolioMedia
+	if err := c.do(ctx, "PATCH", expandPath("/api/v1/barbers/{id}/services/{serviceId}/portfolio/{mediaId}", "id", id, "serviceId", serviceID, "mediaId", mediaID), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetBarberStatistics - Get barber statistics
+//
+// GET /api/v1/barbers/{id}/statistics
+func (c *Client) GetBarberStatistics(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/statistics", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateBarberStatus - Update barber status
+//
+// PATCH /api/v1/barbers/{id}/status
+func (c *Client) UpdateBarberStatus(ctx context.Context, id int, body StatusUpdateRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PATCH", expandPath("/api/v1/barbers/{id}/status", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListBarbersTimeOff - List a barber's time off
+//
+// GET /api/v1/barbers/{id}/time-off
+// Requires authentication.
+func (c *Client) ListBarbersTimeOff(ctx context.Context, id int) ([]TimeOff, error) {
+	var out []TimeOff
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/time-off", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlockOutTimeOff - Block out time off
+//
+// POST /api/v1/barbers/{id}/time-off
+// Requires authentication.
+func (c *Client) BlockOutTimeOff(ctx context.Context, id int, body CreateTimeOffRequest) (*TimeOffResponse, error) {
+	var out TimeOffResponse
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/time-off", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveTimeOff - Remove time off
+//
+// DELETE /api/v1/barbers/{id}/time-off/{timeOffId}
+// Requires authentication.
+func (c *Client) RemoveTimeOff(ctx context.Context, id int, timeOffID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barbers/{id}/time-off/{timeOffId}", "id", id, "timeOffId", timeOffID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarbersTravelTimes - Get a barber's travel times
+//
+// GET /api/v1/barbers/{id}/travel-times
+// Requires authentication.
+func (c *Client) GetBarbersTravelTimes(ctx context.Context, id int) ([]TravelTime, error) {
+	var out []TravelTime
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/travel-times", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SetBarbersTravelTimes - Set a barber's travel times
+//
+// PUT /api/v1/barbers/{id}/travel-times
+// Requires authentication.
+func (c *Client) SetBarbersTravelTimes(ctx context.Context, id int, body TravelTimesRequest) ([]TravelTime, error) {
+	var out []TravelTime
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/barbers/{id}/travel-times", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListBarbersWebhooks - List a barber's webhooks
+//
+// GET /api/v1/barbers/{id}/webhooks
+// Requires authentication.
+func (c *Client) ListBarbersWebhooks(ctx context.Context, id int) ([]WebhookSubscription, error) {
+	var out []WebhookSubscription
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/webhooks", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegisterWebhook - Register a webhook
+//
+// POST /api/v1/barbers/{id}/webhooks
+// Requires authentication.
+func (c *Client) RegisterWebhook(ctx context.Context, id int, body CreateWebhookRequest) (*CreatedWebhookResponse, error) {
+	var out CreatedWebhookResponse
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/webhooks", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteWebhook - Delete a webhook
+//
+// DELETE /api/v1/barbers/{id}/webhooks/{webhookId}
+// Requires authentication.
+func (c *Client) DeleteWebhook(ctx context.Context, id int, webhookID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/barbers/{id}/webhooks/{webhookId}", "id", id, "webhookId", webhookID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateWebhook - Update a webhook
+//
+// PATCH /api/v1/barbers/{id}/webhooks/{webhookId}
+// Requires authentication.
+func (c *Client) UpdateWebhook(ctx context.Context, id int, webhookID int, body UpdateWebhookRequest) (*WebhookSubscription, error) {
+	var out WebhookSubscription
+	if err := c.do(ctx, "PATCH", expandPath("/api/v1/barbers/{id}/webhooks/{webhookId}", "id", id, "webhookId", webhookID), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListWebhooksDeliveriesParams holds the query parameters for ListWebhooksDeliveries
+type ListWebhooksDeliveriesParams struct {
+	// Only deliveries with this status
+	Status *string
+	// Page size
+	Limit *int
+	// Offset
+	Offset *int
+}
+
+func (p *ListWebhooksDeliveriesParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// ListWebhooksDeliveries - List a webhook's deliveries
+//
+// GET /api/v1/barbers/{id}/webhooks/{webhookId}/deliveries
+// Requires authentication.
+func (c *Client) ListWebhooksDeliveries(ctx context.Context, id int, webhookID int, params *ListWebhooksDeliveriesParams) ([]WebhookDelivery, error) {
+	var out []WebhookDelivery
+	if err := c.do(ctx, "GET", expandPath("/api/v1/barbers/{id}/webhooks/{webhookId}/deliveries", "id", id, "webhookId", webhookID), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RedeliverWebhookEvent - Redeliver a webhook event
+//
+// POST /api/v1/barbers/{id}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver
+// Requires authentication.
+func (c *Client) RedeliverWebhookEvent(ctx context.Context, id int, webhookID int, deliveryID int) (*WebhookDelivery, error) {
+	var out WebhookDelivery
+	if err := c.do(ctx, "POST", expandPath("/api/v1/barbers/{id}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver", "id", id, "webhookId", webhookID, "deliveryId", deliveryID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateNewBooking - Create a new booking
+//
+// POST /api/v1/bookings
+// Requires authentication.
+func (c *Client) CreateNewBooking(ctx context.Context, body CreateBookingRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", "/api/v1/bookings", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DescribeReminderLink - Describe a reminder link
+//
+// GET /api/v1/bookings/actions/{token}
+func (c *Client) DescribeReminderLink(ctx context.Context, token string) (*ActionLinkPreview, error) {
+	var out ActionLinkPreview
+	if err := c.do(ctx, "GET", expandPath("/api/v1/bookings/actions/{token}", "token", token), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ConfirmOrCancelBookingFromReminderLink - Confirm or cancel a booking from a reminder link
+//
+// POST /api/v1/bookings/actions/{token}
+func (c *Client) ConfirmOrCancelBookingFromReminderLink(ctx context.Context, token string) (*ActionLinkResult, error) {
+	var out ActionLinkResult
+	if err := c.do(ctx, "POST", expandPath("/api/v1/bookings/actions/{token}", "token", token), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CheckTimeSlotAvailabilityParams holds the query parameters for CheckTimeSlotAvailability
+type CheckTimeSlotAvailabilityParams struct {
+	// Barber ID
+	BarberID int
+	// Start time (RFC3339)
+	StartTime string
+	// Duration in minutes
+	Duration int
+}
+
+func (p *CheckTimeSlotAvailabilityParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	q.Set("barber_id", fmt.Sprint(p.BarberID))
+	q.Set("start_time", fmt.Sprint(p.StartTime))
+	q.Set("duration", fmt.Sprint(p.Duration))
+	return q
+}
+
+// CheckTimeSlotAvailability - Check time slot availability
+//
+// GET /api/v1/bookings/availability
+func (c *Client) CheckTimeSlotAvailability(ctx context.Context, params *CheckTimeSlotAvailabilityParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", "/api/v1/bookings/availability", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListCancellationReasonsParams holds the query parameters for ListCancellationReasons
+type ListCancellationReasonsParams struct {
+	// Language of the labels, e.g. es or pt-BR
+	Locale *string
+}
+
+func (p *ListCancellationReasonsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Locale != nil {
+		q.Set("locale", fmt.Sprint(*p.Locale))
+	}
+	return q
+}
+
+// ListCancellationReasons - List cancellation reasons
+//
+// GET /api/v1/bookings/cancellation-reasons
+func (c *Client) ListCancellationReasons(ctx context.Context, params *ListCancellationReasonsParams) ([]CancellationReason, error) {
+	var out []CancellationReason
+	if err := c.do(ctx, "GET", "/api/v1/bookings/cancellation-reasons", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BookAndPay - Book and pay
+//
+// POST /api/v1/bookings/checkout
+// Requires authentication.
+func (c *Client) BookAndPay(ctx context.Context, body CheckoutRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", "/api/v1/bookings/checkout", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CancelAppointmentFromConfirmationRequest - Cancel an appointment from a confirmation request
+//
+// POST /api/v1/bookings/confirmations/{token}/cancel
+func (c *Client) CancelAppointmentFromConfirmationRequest(ctx context.Context, token string) (*ConfirmationResponseResult, error) {
+	var out ConfirmationResponseResult
+	if err := c.do(ctx, "POST", expandPath("/api/v1/bookings/confirmations/{token}/cancel", "token", token), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ConfirmAppointmentFromConfirmationRequest - Confirm an appointment from a confirmation request
+//
+// POST /api/v1/bookings/confirmations/{token}/confirm
+func (c *Client) ConfirmAppointmentFromConfirmationRequest(ctx context.Context, token string) (*ConfirmationResponseResult, error) {
+	var out ConfirmationResponseResult
+	if err := c.do(ctx, "POST", expandPath("/api/v1/bookings/confirmations/{token}/confirm", "token", token), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyBookingsParams holds the query parameters for GetMyBookings
+type GetMyBookingsParams struct {
+	// Filter by status
+	Status *string
+	// Filter by payment status
+	PaymentStatus *string
+	// Filter by start date from (RFC3339)
+	StartDateFrom *string
+	// Filter by start date to (RFC3339)
+	StartDateTo *string
+	// Sort by field (scheduled_start_time, total_price, created_at, status, payment_status, customer_name, booking_number)
+	SortBy *string
+	// Sort order (ASC/DESC)
+	Order *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+	// Attach each customer's name and picture
+	IncludeCustomer *bool
+	// Attach each barber's shop summary
+	IncludeBarber *bool
+	// Attach each booked service's name, price and duration
+	IncludeService *bool
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *GetMyBookingsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.PaymentStatus != nil {
+		q.Set("payment_status", fmt.Sprint(*p.PaymentStatus))
+	}
+	if p.StartDateFrom != nil {
+		q.Set("start_date_from", fmt.Sprint(*p.StartDateFrom))
+	}
+	if p.StartDateTo != nil {
+		q.Set("start_date_to", fmt.Sprint(*p.StartDateTo))
+	}
+	if p.SortBy != nil {
+		q.Set("sort_by", fmt.Sprint(*p.SortBy))
+	}
+	if p.Order != nil {
+		q.Set("order", fmt.Sprint(*p.Order))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	if p.IncludeCustomer != nil {
+		q.Set("include_customer", fmt.Sprint(*p.IncludeCustomer))
+	}
+	if p.IncludeBarber != nil {
+		q.Set("include_barber", fmt.Sprint(*p.IncludeBarber))
+	}
+	if p.IncludeService != nil {
+		q.Set("include_service", fmt.Sprint(*p.IncludeService))
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// GetMyBookings - Get my bookings
+//
+// GET /api/v1/bookings/me
+// Requires authentication.
+func (c *Client) GetMyBookings(ctx context.Context, params *GetMyBookingsParams) ([]BookingResponse, error) {
+	var out []BookingResponse
+	if err := c.do(ctx, "GET", "/api/v1/bookings/me", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBookingByBookingNumberParams holds the query parameters for GetBookingByBookingNumber
+type GetBookingByBookingNumberParams struct {
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *GetBookingByBookingNumberParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// GetBookingByBookingNumber - Get booking by booking number
+//
+// GET /api/v1/bookings/number/{number}
+func (c *Client) GetBookingByBookingNumber(ctx context.Context, number string, params *GetBookingByBookingNumberParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/bookings/number/{number}", "number", number), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CancelBookingSeries - Cancel a booking series
+//
+// DELETE /api/v1/bookings/series/{id}
+// Requires authentication.
+func (c *Client) CancelBookingSeries(ctx context.Context, id int, body CancelBookingRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/bookings/series/{id}", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBookingSeries - Get a booking series
+//
+// GET /api/v1/bookings/series/{id}
+// Requires authentication.
+func (c *Client) GetBookingSeries(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/bookings/series/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RescheduleBookingSeries - Reschedule a booking series
+//
+// PUT /api/v1/bookings/series/{id}/reschedule
+// Requires authentication.
+func (c *Client) RescheduleBookingSeries(ctx context.Context, id int, body RescheduleSeriesRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/bookings/series/{id}/reschedule", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBookingByUUIDParams holds the query parameters for GetBookingByUUID
+type GetBookingByUUIDParams struct {
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *GetBookingByUUIDParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// GetBookingByUUID - Get booking by UUID
+//
+// GET /api/v1/bookings/uuid/{uuid}
+func (c *Client) GetBookingByUUID(ctx context.Context, uuid string, params *GetBookingByUUIDParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/bookings/uuid/{uuid}", "uuid", uuid), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CancelBooking - Cancel a booking
+//
+// DELETE /api/v1/bookings/{id}
+// Requires authentication.
+func (c *Client) CancelBooking(ctx context.Context, id int, body CancelBookingRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/bookings/{id}", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBookingByIDParams holds the query parameters for GetBookingByID
+type GetBookingByIDParams struct {
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *GetBookingByIDParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// GetBookingByID - Get booking by ID
+//
+// GET /api/v1/bookings/{id}
+// Requires authentication.
+func (c *Client) GetBookingByID(ctx context.Context, id int, params *GetBookingByIDParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/bookings/{id}", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateBookingDetails - Update booking details
+//
+// PUT /api/v1/bookings/{id}
+// Requires authentication.
+func (c *Client) UpdateBookingDetails(ctx context.Context, id int, body UpdateBookingRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/bookings/{id}", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CompleteBooking - Complete a booking
+//
+// POST /api/v1/bookings/{id}/complete
+// Requires authentication.
+func (c *Client) CompleteBooking(ctx context.Context, id int, body CompleteBookingRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", expandPath("/api/v1/bookings/{id}/complete", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBookingHistory - Get booking history
+//
+// GET /api/v1/bookings/{id}/history
+// Requires authentication.
+func (c *Client) GetBookingHistory(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/bookings/{id}/history", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DownloadBookingAsCalendarFile - Download a booking as a calendar file
+//
+// GET /api/v1/bookings/{id}/ics
+// Requires authentication.
+func (c *Client) DownloadBookingAsCalendarFile(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/bookings/{id}/ics", "id", id), nil, nil, false, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RescheduleBooking - Reschedule a booking
+//
+// PUT /api/v1/bookings/{id}/reschedule
+// Requires authentication.
+func (c *Client) RescheduleBooking(ctx context.Context, id int, body RescheduleBookingRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/bookings/{id}/reschedule", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListWhoWorkedOnBooking - List who worked on a booking
+//
+// GET /api/v1/bookings/{id}/staff
+// Requires authentication.
+func (c *Client) ListWhoWorkedOnBooking(ctx context.Context, id int) (*BookingStaffResponse, error) {
+	var out BookingStaffResponse
+	if err := c.do(ctx, "GET", expandPath("/api/v1/bookings/{id}/staff", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SplitBookingWithAssistants - Split a booking with assistants
+//
+// PUT /api/v1/bookings/{id}/staff
+// Requires authentication.
+func (c *Client) SplitBookingWithAssistants(ctx context.Context, id int, body BookingStaffRequest) (*BookingStaffResponse, error) {
+	var out BookingStaffResponse
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/bookings/{id}/staff", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateBookingStatus - Update booking status
+//
+// PATCH /api/v1/bookings/{id}/status
+// Requires authentication.
+func (c *Client) UpdateBookingStatus(ctx context.Context, id int, body UpdateStatusRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PATCH", expandPath("/api/v1/bookings/{id}/status", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetMyCalendarFeed - Get my calendar feed
+//
+// GET /api/v1/calendar/feed
+// Requires authentication.
+func (c *Client) GetMyCalendarFeed(ctx context.Context) (*CalendarFeedResponse, error) {
+	var out CalendarFeedResponse
+	if err := c.do(ctx, "GET", "/api/v1/calendar/feed", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ResetMyCalendarFeed - Reset my calendar feed
+//
+// POST /api/v1/calendar/feed/reset
+// Requires authentication.
+func (c *Client) ResetMyCalendarFeed(ctx context.Context) (*CalendarFeedResponse, error) {
+	var out CalendarFeedResponse
+	if err := c.do(ctx, "POST", "/api/v1/calendar/feed/reset", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetCalendarFeed - Get a calendar feed
+//
+// GET /api/v1/calendar/feeds/{token}
+func (c *Client) GetCalendarFeed(ctx context.Context, token string) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/calendar/feeds/{token}", "token", token), nil, nil, false, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListMyConsultationRequestsParams holds the query parameters for ListMyConsultationRequests
+type ListMyConsultationRequestsParams struct {
+	// Filter by status (open, quoted, booked, declined)
+	Status *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+}
+
+func (p *ListMyConsultationRequestsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// ListMyConsultationRequests - List my consultation requests
+//
+// GET /api/v1/consultation-requests
+// Requires authentication.
+func (c *Client) ListMyConsultationRequests(ctx context.Context, params *ListMyConsultationRequestsParams) ([]ConsultationRequest, error) {
+	var out []ConsultationRequest
+	if err := c.do(ctx, "GET", "/api/v1/consultation-requests", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AskForConsultation - Ask for a consultation
+//
+// POST /api/v1/consultation-requests
+// Requires authentication.
+func (c *Client) AskForConsultation(ctx context.Context, body SubmitConsultationRequest) (*ConsultationRequest, error) {
+	var out ConsultationRequest
+	if err := c.do(ctx, "POST", "/api/v1/consultation-requests", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyConsultationRequest - Get my consultation request
+//
+// GET /api/v1/consultation-requests/{id}
+// Requires authentication.
+func (c *Client) GetMyConsultationRequest(ctx context.Context, id int) (*ConsultationRequest, error) {
+	var out ConsultationRequest
+	if err := c.do(ctx, "GET", expandPath("/api/v1/consultation-requests/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AcceptConsultationQuote - Accept a consultation quote
+//
+// POST /api/v1/consultation-requests/{id}/accept
+// Requires authentication.
+func (c *Client) AcceptConsultationQuote(ctx context.Context, id int, body AcceptConsultationQuoteRequest) (*BookingResponse, error) {
+	var out BookingResponse
+	if err := c.do(ctx, "POST", expandPath("/api/v1/consultation-requests/{id}/accept", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AttachPhotoToMyConsultationRequest - Attach a photo to my consultation request
+//
+// POST /api/v1/consultation-requests/{id}/photos
+// Requires authentication.
+func (c *Client) AttachPhotoToMyConsultationRequest(ctx context.Context, id int) (*ConsultationPhoto, error) {
+	var out ConsultationPhoto
+	if err := c.do(ctx, "POST", expandPath("/api/v1/consultation-requests/{id}/photos", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RecordExperimentConversion - Record an experiment conversion
+//
+// POST /api/v1/experiments/{key}/conversions
+// Requires authentication.
+func (c *Client) RecordExperimentConversion(ctx context.Context, key string, body RecordConversionRequest) (*ExperimentConversion, error) {
+	var out ExperimentConversion
+	if err := c.do(ctx, "POST", expandPath("/api/v1/experiments/{key}/conversions", "key", key), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyExperimentVariant - Get my experiment variant
+//
+// GET /api/v1/experiments/{key}/variant
+// Requires authentication.
+func (c *Client) GetMyExperimentVariant(ctx context.Context, key string) (*VariantAssignment, error) {
+	var out VariantAssignment
+	if err := c.do(ctx, "GET", expandPath("/api/v1/experiments/{key}/variant", "key", key), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// FeaturedSlotAvailabilityParams holds the query parameters for FeaturedSlotAvailability
+type FeaturedSlotAvailabilityParams struct {
+	// City
+	City string
+	// Service category (omit for city-wide placement)
+	CategoryID *int
+	// First day (YYYY-MM-DD)
+	StartsOn string
+	// Last day, inclusive (YYYY-MM-DD)
+	EndsOn string
+}
+
+func (p *FeaturedSlotAvailabilityParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	q.Set("city", fmt.Sprint(p.City))
+	if p.CategoryID != nil {
+		q.Set("category_id", fmt.Sprint(*p.CategoryID))
+	}
+	q.Set("starts_on", fmt.Sprint(p.StartsOn))
+	q.Set("ends_on", fmt.Sprint(p.EndsOn))
+	return q
+}
+
+// FeaturedSlotAvailability - Featured slot availability
+//
+// GET /api/v1/featured/availability
+func (c *Client) FeaturedSlotAvailability(ctx context.Context, params *FeaturedSlotAvailabilityParams) (*FeaturedAvailability, error) {
+	var out FeaturedAvailability
+	if err := c.do(ctx, "GET", "/api/v1/featured/availability", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RecordClickOnFeaturedResult - Record a click on a featured result
+//
+// POST /api/v1/featured/{id}/click
+func (c *Client) RecordClickOnFeaturedResult(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", expandPath("/api/v1/featured/{id}/click", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetMyNotificationsParams holds the query parameters for GetMyNotifications
+type GetMyNotificationsParams struct {
+	// Filter by notification type
+	Type *string
+	// Filter by status
+	Status *string
+	// Filter by priority
+	Priority *string
+	// Filter by read status
+	IsRead *bool
+	// Filter by unread status
+	IsUnread *bool
+	// Sort by field (priority, scheduled_for, created_at)
+	SortBy *string
+	// Sort order (ASC/DESC)
+	Order *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+	// Attach the barber each booking notification is about
+	IncludeBarber *bool
+}
+
+func (p *GetMyNotificationsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Type != nil {
+		q.Set("type", fmt.Sprint(*p.Type))
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.Priority != nil {
+		q.Set("priority", fmt.Sprint(*p.Priority))
+	}
+	if p.IsRead != nil {
+		q.Set("is_read", fmt.Sprint(*p.IsRead))
+	}
+	if p.IsUnread != nil {
+		q.Set("is_unread", fmt.Sprint(*p.IsUnread))
+	}
+	if p.SortBy != nil {
+		q.Set("sort_by", fmt.Sprint(*p.SortBy))
+	}
+	if p.Order != nil {
+		q.Set("order", fmt.Sprint(*p.Order))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	if p.IncludeBarber != nil {
+		q.Set("include_barber", fmt.Sprint(*p.IncludeBarber))
+	}
+	return q
+}
+
+// GetMyNotifications - Get my notifications
+//
+// GET /api/v1/notifications
+// Requires authentication.
+func (c *Client) GetMyNotifications(ctx context.Context, params *GetMyNotificationsParams) ([]NotificationResponse, error) {
+	var out []NotificationResponse
+	if err := c.do(ctx, "GET", "/api/v1/notifications", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateNotification - Create a notification
+//
+// POST /api/v1/notifications
+// Requires authentication.
+func (c *Client) CreateNotification(ctx context.Context, body CreateNotificationRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", "/api/v1/notifications", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SendBookingNotification - Send booking notification
+//
+// POST /api/v1/notifications/booking
+// Requires authentication.
+func (c *Client) SendBookingNotification(ctx context.Context, body SendBookingNotificationRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", "/api/v1/notifications/booking", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RegisterPushDevice - Register a push device
+//
+// POST /api/v1/notifications/devices
+// Requires authentication.
+func (c *Client) RegisterPushDevice(ctx context.Context, body RegisterDeviceRequest) (*DeviceToken, error) {
+	var out DeviceToken
+	if err := c.do(ctx, "POST", "/api/v1/notifications/devices", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UnregisterPushDevice - Unregister a push device
+//
+// DELETE /api/v1/notifications/devices/{token}
+// Requires authentication.
+func (c *Client) UnregisterPushDevice(ctx context.Context, token string) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/notifications/devices/{token}", "token", token), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MarkAllNotificationsAsRead - Mark all notifications as read
+//
+// PATCH /api/v1/notifications/read-all
+// Requires authentication.
+func (c *Client) MarkAllNotificationsAsRead(ctx context.Context) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PATCH", "/api/v1/notifications/read-all", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InboundSMSWebhook - Inbound SMS webhook
+//
+// POST /api/v1/notifications/sms-inbound
+func (c *Client) InboundSMSWebhook(ctx context.Context) (*string, error) {
+	var out string
+	if err := c.do(ctx, "POST", "/api/v1/notifications/sms-inbound", nil, nil, false, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetNotificationStatistics - Get notification statistics
+//
+// GET /api/v1/notifications/stats
+// Requires authentication.
+func (c *Client) GetNotificationStatistics(ctx context.Context) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", "/api/v1/notifications/stats", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListNotificationTemplatesAndTheirVariables - List notification templates and their variables
+//
+// GET /api/v1/notifications/templates
+// Requires authentication.
+func (c *Client) ListNotificationTemplatesAndTheirVariables(ctx context.Context) ([]NotificationTemplateInfo, error) {
+	var out []NotificationTemplateInfo
+	if err := c.do(ctx, "GET", "/api/v1/notifications/templates", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CheckTemplateTextAgainstTemplatesVariables - Check template text against a template's variables
+//
+// POST /api/v1/notifications/templates/validate
+// Requires authentication.
+func (c *Client) CheckTemplateTextAgainstTemplatesVariables(ctx context.Context, body ValidateTemplateRequest) (*ValidateTemplateResult, error) {
+	var out ValidateTemplateResult
+	if err := c.do(ctx, "POST", "/api/v1/notifications/templates/validate", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUnreadNotificationsParams holds the query parameters for GetUnreadNotifications
+type GetUnreadNotificationsParams struct {
+	// Limit results
+	Limit *int
+}
+
+func (p *GetUnreadNotificationsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	return q
+}
+
+// GetUnreadNotifications - Get unread notifications
+//
+// GET /api/v1/notifications/unread
+// Requires authentication.
+func (c *Client) GetUnreadNotifications(ctx context.Context, params *GetUnreadNotificationsParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", "/api/v1/notifications/unread", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LongPollUnreadNotificationCountParams holds the query parameters for LongPollUnreadNotificationCount
+type LongPollUnreadNotificationCountParams struct {
+	// Last unread count seen by the client
+	Since *int
+	// Seconds to wait for a change (0-30)
+	Wait *int
+}
+
+func (p *LongPollUnreadNotificationCountParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Since != nil {
+		q.Set("since", fmt.Sprint(*p.Since))
+	}
+	if p.Wait != nil {
+		q.Set("wait", fmt.Sprint(*p.Wait))
+	}
+	return q
+}
+
+// LongPollUnreadNotificationCount - Long-poll unread notification count
+//
+// GET /api/v1/notifications/unread-count
+// Requires authentication.
+func (c *Client) LongPollUnreadNotificationCount(ctx context.Context, params *LongPollUnreadNotificationCountParams) (*UnreadCountResponse, error) {
+	var out UnreadCountResponse
+	if err := c.do(ctx, "GET", "/api/v1/notifications/unread-count", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetUnreadNotificationCount - Get unread notification count
+//
+// GET /api/v1/notifications/unread/count
+// Requires authentication.
+func (c *Client) GetUnreadNotificationCount(ctx context.Context) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", "/api/v1/notifications/unread/count", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteNotification - Delete a notification
+//
+// DELETE /api/v1/notifications/{id}
+// Requires authentication.
+func (c *Client) DeleteNotification(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/notifications/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetNotificationByID - Get notification by ID
+//
+// GET /api/v1/notifications/{id}
+// Requires authentication.
+func (c *Client) GetNotificationByID(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/notifications/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MarkNotificationAsRead - Mark notification as read
+//
+// PATCH /api/v1/notifications/{id}/read
+// Requires authentication.
+func (c *Client) MarkNotificationAsRead(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PATCH", expandPath("/api/v1/notifications/{id}/read", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SMSDeliveryStatusCallback - SMS delivery status callback
+//
+// POST /api/v1/notifications/{id}/sms-status
+func (c *Client) SMSDeliveryStatusCallback(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", expandPath("/api/v1/notifications/{id}/sms-status", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationDeliveryWebhookParams holds the query parameters for NotificationDeliveryWebhook
+type NotificationDeliveryWebhookParams struct {
+	// Delivery status (delivered/failed)
+	Status string
+}
+
+func (p *NotificationDeliveryWebhookParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	q.Set("status", fmt.Sprint(p.Status))
+	return q
+}
+
+// NotificationDeliveryWebhook - Notification delivery webhook
+//
+// POST /api/v1/notifications/{id}/webhook
+func (c *Client) NotificationDeliveryWebhook(ctx context.Context, id int, params *NotificationDeliveryWebhookParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", expandPath("/api/v1/notifications/{id}/webhook", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetOpenSlot - Get an open slot
+//
+// GET /api/v1/open-slots/{id}
+func (c *Client) GetOpenSlot(ctx context.Context, id int) (*OpenSlotResponse, error) {
+	var out OpenSlotResponse
+	if err := c.do(ctx, "GET", expandPath("/api/v1/open-slots/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ClaimOpenSlot - Claim an open slot
+//
+// POST /api/v1/open-slots/{id}/claim
+// Requires authentication.
+func (c *Client) ClaimOpenSlot(ctx context.Context, id int, body ClaimOpenSlotRequest) (*BookingResponse, error) {
+	var out BookingResponse
+	if err := c.do(ctx, "POST", expandPath("/api/v1/open-slots/{id}/claim", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateNewReview - Create a new review
+//
+// POST /api/v1/reviews
+// Requires authentication.
+func (c *Client) CreateNewReview(ctx context.Context, body CreateReviewRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", "/api/v1/reviews", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetReviewByBookingID - Get review by booking ID
+//
+// GET /api/v1/reviews/booking/{booking_id}
+func (c *Client) GetReviewByBookingID(ctx context.Context, bookingID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/reviews/booking/{booking_id}", "booking_id", bookingID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CheckIfBookingCanBeReviewed - Check if booking can be reviewed
+//
+// GET /api/v1/reviews/can-review/{booking_id}
+// Requires authentication.
+func (c *Client) CheckIfBookingCanBeReviewed(ctx context.Context, bookingID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/reviews/can-review/{booking_id}", "booking_id", bookingID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetMyReviewsParams holds the query parameters for GetMyReviews
+type GetMyReviewsParams struct {
+	// Filter by moderation status
+	ModerationStatus *string
+	// Sort by field (created_at, overall_rating, helpful_votes, report_count)
+	SortBy *string
+	// Sort order (ASC/DESC)
+	Order *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+	// Attach each customer's name and picture
+	IncludeCustomer *bool
+	// Attach each barber's shop summary
+	IncludeBarber *bool
+}
+
+func (p *GetMyReviewsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.ModerationStatus != nil {
+		q.Set("moderation_status", fmt.Sprint(*p.ModerationStatus))
+	}
+	if p.SortBy != nil {
+		q.Set("sort_by", fmt.Sprint(*p.SortBy))
+	}
+	if p.Order != nil {
+		q.Set("order", fmt.Sprint(*p.Order))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	if p.IncludeCustomer != nil {
+		q.Set("include_customer", fmt.Sprint(*p.IncludeCustomer))
+	}
+	if p.IncludeBarber != nil {
+		q.Set("include_barber", fmt.Sprint(*p.IncludeBarber))
+	}
+	return q
+}
+
+// GetMyReviews - Get my reviews
+//
+// GET /api/v1/reviews/me
+// Requires authentication.
+func (c *Client) GetMyReviews(ctx context.Context, params *GetMyReviewsParams) ([]ReviewResponse, error) {
+	var out []ReviewResponse
+	if err := c.do(ctx, "GET", "/api/v1/reviews/me", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetPendingReviewsParams holds the query parameters for GetPendingReviews
+type GetPendingReviewsParams struct {
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+	// Attach each customer's name and picture
+	IncludeCustomer *bool
+	// Attach each barber's shop summary
+	IncludeBarber *bool
+}
+
+func (p *GetPendingReviewsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	if p.IncludeCustomer != nil {
+		q.Set("include_customer", fmt.Sprint(*p.IncludeCustomer))
+	}
+	if p.IncludeBarber != nil {
+		q.Set("include_barber", fmt.Sprint(*p.IncludeBarber))
+	}
+	return q
+}
+
+// GetPendingReviews - Get pending reviews
+//
+// GET /api/v1/reviews/pending
+// Requires authentication.
+func (c *Client) GetPendingReviews(ctx context.Context, params *GetPendingReviewsParams) ([]ReviewResponse, error) {
+	var out []ReviewResponse
+	if err := c.do(ctx, "GET", "/api/v1/reviews/pending", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DescribeReviewLink - Describe a review link
+//
+// GET /api/v1/reviews/requests/{token}
+func (c *Client) DescribeReviewLink(ctx context.Context, token string) (*ReviewLinkInfo, error) {
+	var out ReviewLinkInfo
+	if err := c.do(ctx, "GET", expandPath("/api/v1/reviews/requests/{token}", "token", token), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReviewBookingFromReviewLink - Review a booking from a review link
+//
+// POST /api/v1/reviews/requests/{token}
+func (c *Client) ReviewBookingFromReviewLink(ctx context.Context, token string, body CreateReviewRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", expandPath("/api/v1/reviews/requests/{token}", "token", token), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteReview - Delete a review
+//
+// DELETE /api/v1/reviews/{id}
+// Requires authentication.
+func (c *Client) DeleteReview(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/reviews/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetReviewByID - Get review by ID
+//
+// GET /api/v1/reviews/{id}
+func (c *Client) GetReviewByID(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/reviews/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateReview - Update a review
+//
+// PUT /api/v1/reviews/{id}
+// Requires authentication.
+func (c *Client) UpdateReview(ctx context.Context, id int, body UpdateReviewRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/reviews/{id}", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListReviewsPhotos - List a review's photos
+//
+// GET /api/v1/reviews/{id}/images
+func (c *Client) ListReviewsPhotos(ctx context.Context, id int) ([]ReviewImage, error) {
+	var out []ReviewImage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/reviews/{id}/images", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttachPhotoToReview - Attach a photo to a review
+//
+// POST /api/v1/reviews/{id}/images
+// Requires authentication.
+func (c *Client) AttachPhotoToReview(ctx context.Context, id int) (*ReviewImage, error) {
+	var out ReviewImage
+	if err := c.do(ctx, "POST", expandPath("/api/v1/reviews/{id}/images", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemovePhotoFromReview - Remove a photo from a review
+//
+// DELETE /api/v1/reviews/{id}/images/{image_id}
+// Requires authentication.
+func (c *Client) RemovePhotoFromReview(ctx context.Context, id int, imageID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/reviews/{id}/images/{image_id}", "id", id, "image_id", imageID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ModerateReview - Moderate a review
+//
+// PATCH /api/v1/reviews/{id}/moderate
+// Requires authentication.
+func (c *Client) ModerateReview(ctx context.Context, id int, body ModerateReviewRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PATCH", expandPath("/api/v1/reviews/{id}/moderate", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReportReview - Report a review
+//
+// POST /api/v1/reviews/{id}/report
+// Requires authentication.
+func (c *Client) ReportReview(ctx context.Context, id int, body ReportReviewRequest) (*ReviewReport, error) {
+	var out ReviewReport
+	if err := c.do(ctx, "POST", expandPath("/api/v1/reviews/{id}/report", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AddBarberResponseToReview - Add barber response to a review
+//
+// POST /api/v1/reviews/{id}/response
+// Requires authentication.
+func (c *Client) AddBarberResponseToReview(ctx context.Context, id int, body BarberResponseRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", expandPath("/api/v1/reviews/{id}/response", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VoteOnReviewHelpfulness - Vote on review helpfulness
+//
+// POST /api/v1/reviews/{id}/vote
+func (c *Client) VoteOnReviewHelpfulness(ctx context.Context, id int, body VoteReviewRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", expandPath("/api/v1/reviews/{id}/vote", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetAllServicesParams holds the query parameters for GetAllServices
+type GetAllServicesParams struct {
+	// Filter by category ID
+	CategoryID *int
+	// Filter by service type (haircut, styling, treatment, grooming)
+	ServiceType *string
+	// Filter by active status
+	IsActive *bool
+	// Minimum rating
+	MinRating *float64
+	// Filter by complexity (1-5)
+	Complexity *int
+	// Filter by target gender (male, female, all)
+	TargetGender *string
+	// Search term
+	Search *string
+	// Sort by field (name, popularity, rating, duration, complexity)
+	SortBy *string
+	// Number of results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *GetAllServicesParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.CategoryID != nil {
+		q.Set("category_id", fmt.Sprint(*p.CategoryID))
+	}
+	if p.ServiceType != nil {
+		q.Set("service_type", fmt.Sprint(*p.ServiceType))
+	}
+	if p.IsActive != nil {
+		q.Set("is_active", fmt.Sprint(*p.IsActive))
+	}
+	if p.MinRating != nil {
+		q.Set("min_rating", fmt.Sprint(*p.MinRating))
+	}
+	if p.Complexity != nil {
+		q.Set("complexity", fmt.Sprint(*p.Complexity))
+	}
+	if p.TargetGender != nil {
+		q.Set("target_gender", fmt.Sprint(*p.TargetGender))
+	}
+	if p.Search != nil {
+		q.Set("search", fmt.Sprint(*p.Search))
+	}
+	if p.SortBy != nil {
+		q.Set("sort_by", fmt.Sprint(*p.SortBy))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// GetAllServices - Get all services
+//
+// GET /api/v1/services
+func (c *Client) GetAllServices(ctx context.Context, params *GetAllServicesParams) ([]Service, error) {
+	var out []Service
+	if err := c.do(ctx, "GET", "/api/v1/services", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateNewService - Create new service
+//
+// POST /api/v1/services
+func (c *Client) CreateNewService(ctx context.Context, body CreateServiceRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", "/api/v1/services", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetAllServiceCategoriesParams holds the query parameters for GetAllServiceCategories
+type GetAllServiceCategoriesParams struct {
+	// Only return active categories
+	ActiveOnly *bool
+}
+
+func (p *GetAllServiceCategoriesParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.ActiveOnly != nil {
+		q.Set("active_only", fmt.Sprint(*p.ActiveOnly))
+	}
+	return q
+}
+
+// GetAllServiceCategories - Get all service categories
+//
+// GET /api/v1/services/categories
+func (c *Client) GetAllServiceCategories(ctx context.Context, params *GetAllServiceCategoriesParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", "/api/v1/services/categories", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateNewCategory - Create new category
+//
+// POST /api/v1/services/categories
+func (c *Client) CreateNewCategory(ctx context.Context, body CreateCategoryRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "POST", "/api/v1/services/categories", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteCategory - Delete category
+//
+// DELETE /api/v1/services/categories/{id}
+func (c *Client) DeleteCategory(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/services/categories/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetCategoryByID - Get category by ID
+//
+// GET /api/v1/services/categories/{id}
+func (c *Client) GetCategoryByID(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/services/categories/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateCategory - Update category
+//
+// PUT /api/v1/services/categories/{id}
+func (c *Client) UpdateCategory(ctx context.Context, id int, body UpdateCategoryRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/services/categories/{id}", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchServicesParams holds the query parameters for SearchServices
+type SearchServicesParams struct {
+	// Search query
+	Q string
+	// Filter by category
+	CategoryID *int
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *SearchServicesParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	q.Set("q", fmt.Sprint(p.Q))
+	if p.CategoryID != nil {
+		q.Set("category_id", fmt.Sprint(*p.CategoryID))
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// SearchServices - Search services
+//
+// GET /api/v1/services/search
+func (c *Client) SearchServices(ctx context.Context, params *SearchServicesParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", "/api/v1/services/search", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetServiceBySlugParams holds the query parameters for GetServiceBySlug
+type GetServiceBySlugParams struct {
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *GetServiceBySlugParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// GetServiceBySlug - Get service by slug
+//
+// GET /api/v1/services/slug/{slug}
+func (c *Client) GetServiceBySlug(ctx context.Context, slug string, params *GetServiceBySlugParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/services/slug/{slug}", "slug", slug), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeleteService - Delete service
+//
+// DELETE /api/v1/services/{id}
+func (c *Client) DeleteService(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/services/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetServiceByIDParams holds the query parameters for GetServiceByID
+type GetServiceByIDParams struct {
+	// Comma-separated fields to return, e.g. id,name (id is always included)
+	Fields *string
+}
+
+func (p *GetServiceByIDParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Fields != nil {
+		q.Set("fields", fmt.Sprint(*p.Fields))
+	}
+	return q
+}
+
+// GetServiceByID - Get service by ID
+//
+// GET /api/v1/services/{id}
+func (c *Client) GetServiceByID(ctx context.Context, id int, params *GetServiceByIDParams) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/services/{id}", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateService - Update service
+//
+// PUT /api/v1/services/{id}
+func (c *Client) UpdateService(ctx context.Context, id int, body UpdateServiceRequest) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/services/{id}", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetBarbersOfferingService - Get barbers offering a service
+//
+// GET /api/v1/services/{service_id}/barbers
+func (c *Client) GetBarbersOfferingService(ctx context.Context, serviceID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/services/{service_id}/barbers", "service_id", serviceID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CreateShop - Create a shop
+//
+// POST /api/v1/shops
+// Requires authentication.
+func (c *Client) CreateShop(ctx context.Context, body ShopRequest) (*Shop, error) {
+	var out Shop
+	if err := c.do(ctx, "POST", "/api/v1/shops", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetShop - Get a shop
+//
+// GET /api/v1/shops/{id}
+func (c *Client) GetShop(ctx context.Context, id int) (*Shop, error) {
+	var out Shop
+	if err := c.do(ctx, "GET", expandPath("/api/v1/shops/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateShop - Update a shop
+//
+// PUT /api/v1/shops/{id}
+// Requires authentication.
+func (c *Client) UpdateShop(ctx context.Context, id int, body ShopRequest) (*Shop, error) {
+	var out Shop
+	if err := c.do(ctx, "PUT", expandPath("/api/v1/shops/{id}", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListShopsAdmins - List a shop's admins
+//
+// GET /api/v1/shops/{id}/admins
+// Requires authentication.
+func (c *Client) ListShopsAdmins(ctx context.Context, id int) ([]ShopAdmin, error) {
+	var out []ShopAdmin
+	if err := c.do(ctx, "GET", expandPath("/api/v1/shops/{id}/admins", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddShopAdmin - Add a shop admin
+//
+// POST /api/v1/shops/{id}/admins
+// Requires authentication.
+func (c *Client) AddShopAdmin(ctx context.Context, id int, body AddShopAdminRequest) (*ShopAdmin, error) {
+	var out ShopAdmin
+	if err := c.do(ctx, "POST", expandPath("/api/v1/shops/{id}/admins", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveShopAdmin - Remove a shop admin
+//
+// DELETE /api/v1/shops/{id}/admins/{userId}
+// Requires authentication.
+func (c *Client) RemoveShopAdmin(ctx context.Context, id int, userID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/shops/{id}/admins/{userId}", "id", id, "userId", userID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ListShopsBarbers - List a shop's barbers
+//
+// GET /api/v1/shops/{id}/barbers
+func (c *Client) ListShopsBarbers(ctx context.Context, id int) ([]Barber, error) {
+	var out []Barber
+	if err := c.do(ctx, "GET", expandPath("/api/v1/shops/{id}/barbers", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddBarberToShop - Add a barber to a shop
+//
+// POST /api/v1/shops/{id}/barbers
+// Requires authentication.
+func (c *Client) AddBarberToShop(ctx context.Context, id int, body AddShopBarberRequest) (*Barber, error) {
+	var out Barber
+	if err := c.do(ctx, "POST", expandPath("/api/v1/shops/{id}/barbers", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveBarberFromShop - Remove a barber from a shop
+//
+// DELETE /api/v1/shops/{id}/barbers/{barberId}
+// Requires authentication.
+func (c *Client) RemoveBarberFromShop(ctx context.Context, id int, barberID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/shops/{id}/barbers/{barberId}", "id", id, "barberId", barberID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetShopsBookingsParams holds the query parameters for GetShopsBookings
+type GetShopsBookingsParams struct {
+	// Filter by barber
+	BarberID *int
+	// Filter by status
+	Status *string
+	// Filter by payment status
+	PaymentStatus *string
+	// Filter by start date from (YYYY-MM-DD)
+	StartDateFrom *string
+	// Filter by start date to (YYYY-MM-DD)
+	StartDateTo *string
+	// Sort by field (scheduled_start_time, total_price, created_at, status, payment_status, customer_name, booking_number)
+	SortBy *string
+	// Sort order (ASC/DESC)
+	Order *string
+	// Limit results
+	Limit *int
+	// Offset for pagination
+	Offset *int
+}
+
+func (p *GetShopsBookingsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.BarberID != nil {
+		q.Set("barber_id", fmt.Sprint(*p.BarberID))
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.PaymentStatus != nil {
+		q.Set("payment_status", fmt.Sprint(*p.PaymentStatus))
+	}
+	if p.StartDateFrom != nil {
+		q.Set("start_date_from", fmt.Sprint(*p.StartDateFrom))
+	}
+	if p.StartDateTo != nil {
+		q.Set("start_date_to", fmt.Sprint(*p.StartDateTo))
+	}
+	if p.SortBy != nil {
+		q.Set("sort_by", fmt.Sprint(*p.SortBy))
+	}
+	if p.Order != nil {
+		q.Set("order", fmt.Sprint(*p.Order))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// GetShopsBookings - Get a shop's bookings
+//
+// GET /api/v1/shops/{id}/bookings
+// Requires authentication.
+func (c *Client) GetShopsBookings(ctx context.Context, id int, params *GetShopsBookingsParams) ([]BookingResponse, error) {
+	var out []BookingResponse
+	if err := c.do(ctx, "GET", expandPath("/api/v1/shops/{id}/bookings", "id", id), params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ExportShopsData - Export a shop's data
+//
+// GET /api/v1/shops/{id}/export
+// Requires authentication.
+func (c *Client) ExportShopsData(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "GET", expandPath("/api/v1/shops/{id}/export", "id", id), nil, nil, false, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CancelLeavingPlatform - Cancel leaving the platform
+//
+// DELETE /api/v1/shops/{id}/offboarding
+// Requires authentication.
+func (c *Client) CancelLeavingPlatform(ctx context.Context, id int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/shops/{id}/offboarding", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GetShopsOffboarding - Get a shop's offboarding
+//
+// GET /api/v1/shops/{id}/offboarding
+// Requires authentication.
+func (c *Client) GetShopsOffboarding(ctx context.Context, id int) (*ShopOffboarding, error) {
+	var out ShopOffboarding
+	if err := c.do(ctx, "GET", expandPath("/api/v1/shops/{id}/offboarding", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// LeavePlatform - Leave the platform
+//
+// POST /api/v1/shops/{id}/offboarding
+// Requires authentication.
+func (c *Client) LeavePlatform(ctx context.Context, id int, body ShopOffboardingRequest) (*ShopOffboarding, error) {
+	var out ShopOffboarding
+	if err := c.do(ctx, "POST", expandPath("/api/v1/shops/{id}/offboarding", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListShopsTaxRules - List a shop's tax rules
+//
+// GET /api/v1/shops/{id}/tax-rules
+// Requires authentication.
+func (c *Client) ListShopsTaxRules(ctx context.Context, id int) ([]TaxRule, error) {
+	var out []TaxRule
+	if err := c.do(ctx, "GET", expandPath("/api/v1/shops/{id}/tax-rules", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AddShopTaxRule - Add a shop tax rule
+//
+// POST /api/v1/shops/{id}/tax-rules
+// Requires authentication.
+func (c *Client) AddShopTaxRule(ctx context.Context, id int, body TaxRuleRequest) (*TaxRule, error) {
+	var out TaxRule
+	if err := c.do(ctx, "POST", expandPath("/api/v1/shops/{id}/tax-rules", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteShopTaxRule - Delete a shop tax rule
+//
+// DELETE /api/v1/shops/{id}/tax-rules/{ruleId}
+// Requires authentication.
+func (c *Client) DeleteShopTaxRule(ctx context.Context, id int, ruleID int) (json.RawMessage, error) {
+	var out json.RawMessage
+	if err := c.do(ctx, "DELETE", expandPath("/api/v1/shops/{id}/tax-rules/{ruleId}", "id", id, "ruleId", ruleID), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PlatformStatus - Platform status
+//
+// GET /api/v1/status
+func (c *Client) PlatformStatus(ctx context.Context) (*StatusPage, error) {
+	var out StatusPage
+	if err := c.do(ctx, "GET", "/api/v1/status", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListMySupportTicketsParams holds the query parameters for ListMySupportTickets
+type ListMySupportTicketsParams struct {
+	// open, in_progress, waiting_on_customer, resolved or closed
+	Status *string
+	// low, normal, high or urgent
+	Priority *string
+	// booking, payment, account or other
+	Category *string
+	// Page size
+	Limit *int
+	// Offset
+	Offset *int
+}
+
+func (p *ListMySupportTicketsParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Status != nil {
+		q.Set("status", fmt.Sprint(*p.Status))
+	}
+	if p.Priority != nil {
+		q.Set("priority", fmt.Sprint(*p.Priority))
+	}
+	if p.Category != nil {
+		q.Set("category", fmt.Sprint(*p.Category))
+	}
+	if p.Limit != nil {
+		q.Set("limit", fmt.Sprint(*p.Limit))
+	}
+	if p.Offset != nil {
+		q.Set("offset", fmt.Sprint(*p.Offset))
+	}
+	return q
+}
+
+// ListMySupportTickets - List my support tickets
+//
+// GET /api/v1/support/tickets
+// Requires authentication.
+func (c *Client) ListMySupportTickets(ctx context.Context, params *ListMySupportTicketsParams) ([]SupportTicket, error) {
+	var out []SupportTicket
+	if err := c.do(ctx, "GET", "/api/v1/support/tickets", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OpenSupportTicket - Open a support ticket
+//
+// POST /api/v1/support/tickets
+// Requires authentication.
+func (c *Client) OpenSupportTicket(ctx context.Context, body CreateSupportTicketRequest) (*SupportTicketResponse, error) {
+	var out SupportTicketResponse
+	if err := c.do(ctx, "POST", "/api/v1/support/tickets", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetOneOfMySupportTickets - Get one of my support tickets
+//
+// GET /api/v1/support/tickets/{id}
+// Requires authentication.
+func (c *Client) GetOneOfMySupportTickets(ctx context.Context, id int) (*SupportTicketResponse, error) {
+	var out SupportTicketResponse
+	if err := c.do(ctx, "GET", expandPath("/api/v1/support/tickets/{id}", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CloseOneOfMySupportTickets - Close one of my support tickets
+//
+// POST /api/v1/support/tickets/{id}/close
+// Requires authentication.
+func (c *Client) CloseOneOfMySupportTickets(ctx context.Context, id int) (*SupportTicketResponse, error) {
+	var out SupportTicketResponse
+	if err := c.do(ctx, "POST", expandPath("/api/v1/support/tickets/{id}/close", "id", id), nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ReplyToOneOfMySupportTickets - Reply to one of my support tickets
+//
+// POST /api/v1/support/tickets/{id}/messages
+// Requires authentication.
+func (c *Client) ReplyToOneOfMySupportTickets(ctx context.Context, id int, body SupportReplyRequest) (*SupportTicketMessage, error) {
+	var out SupportTicketMessage
+	if err := c.do(ctx, "POST", expandPath("/api/v1/support/tickets/{id}/messages", "id", id), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AnswerNpsSurvey - Answer an NPS survey
+//
+// POST /api/v1/surveys/nps/{token}
+func (c *Client) AnswerNpsSurvey(ctx context.Context, token string, body NpsResponseRequest) (*NpsResponseResult, error) {
+	var out NpsResponseResult
+	if err := c.do(ctx, "POST", expandPath("/api/v1/surveys/nps/{token}", "token", token), nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyAPIUsageParams holds the query parameters for GetMyAPIUsage
+type GetMyAPIUsageParams struct {
+	// First day (YYYY-MM-DD)
+	From *string
+	// Last day (YYYY-MM-DD)
+	To *string
+}
+
+func (p *GetMyAPIUsageParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.From != nil {
+		q.Set("from", fmt.Sprint(*p.From))
+	}
+	if p.To != nil {
+		q.Set("to", fmt.Sprint(*p.To))
+	}
+	return q
+}
+
+// GetMyAPIUsage - Get my API usage
+//
+// GET /api/v1/usage
+// Requires authentication.
+func (c *Client) GetMyAPIUsage(ctx context.Context, params *GetMyAPIUsageParams) (*APIUsageReport, error) {
+	var out APIUsageReport
+	if err := c.do(ctx, "GET", "/api/v1/usage", params.values(), nil, true, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetMyNotificationPreferences - Get my notification preferences
+//
+// GET /api/v1/users/me/notification-preferences
+// Requires authentication.
+func (c *Client) GetMyNotificationPreferences(ctx context.Context) ([]NotificationPreferenceResponse, error) {
+	var out []NotificationPreferenceResponse
+	if err := c.do(ctx, "GET", "/api/v1/users/me/notification-preferences", nil, nil, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UpdateMyNotificationPreferences - Update my notification preferences
+//
+// PUT /api/v1/users/me/notification-preferences
+// Requires authentication.
+func (c *Client) UpdateMyNotificationPreferences(ctx context.Context, body UpdateNotificationPreferencesRequest) ([]NotificationPreferenceResponse, error) {
+	var out []NotificationPreferenceResponse
+	if err := c.do(ctx, "PUT", "/api/v1/users/me/notification-preferences", nil, body, true, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LiveUpdatesWebSocketParams holds the query parameters for LiveUpdatesWebSocket
+type LiveUpdatesWebSocketParams struct {
+	// Access token, when the Authorization header cannot be set
+	Token *string
+}
+
+func (p *LiveUpdatesWebSocketParams) values() url.Values {
+	q := url.Values{}
+	if p == nil {
+		return q
+	}
+	if p.Token != nil {
+		q.Set("token", fmt.Sprint(*p.Token))
+	}
+	return q
+}
+
+// LiveUpdatesWebSocket - Live updates (WebSocket)
+//
+// GET /api/v1/ws
+// Requires authentication.
+func (c *Client) LiveUpdatesWebSocket(ctx context.Context, params *LiveUpdatesWebSocketParams) error {
+	return c.do(ctx, "GET", "/api/v1/ws", params.values(), nil, false, nil)
+}
@@ -0,0 +1,167 @@
+// sdk/go/barbershop/client.go
+
+// Package barbershop is a typed Go client for the Barbershop Booking API.
+// Models and operations live in api.gen.go, generated from docs/swagger.json;
+// this file holds the hand-written transport they share.
+package barbershop
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Client calls the Barbershop Booking API
+type Client struct {
+	baseURL    string
+	token      string
+	httpClient *http.Client
+	userAgent  string
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithToken sets the JWT sent as a Bearer token
+func WithToken(token string) Option {
+	return func(c *Client) { c.token = token }
+}
+
+// WithHTTPClient replaces the default HTTP client
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) { c.httpClient = httpClient }
+}
+
+// WithUserAgent sets the User-Agent header
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) { c.userAgent = userAgent }
+}
+
+// NewClient creates a client for the API at baseURL (e.g. http://localhost:8080)
+func NewClient(baseURL string, opts ...Option) *Client {
+	c := &Client{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		userAgent:  "barbershop-go-sdk",
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// SetToken updates the Bearer token, e.g. after login
+func (c *Client) SetToken(token string) {
+	c.token = token
+}
+
+// APIError is returned for non-2xx responses
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Body       []byte
+}
+
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("api error %d (%s): %s", e.StatusCode, e.Code, e.Message)
+	}
+	return fmt.Sprintf("api error %d: %s", e.StatusCode, e.Message)
+}
+
+// envelope is the standard success wrapper
+type envelope struct {
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// do performs a request; when enveloped, out receives the "data" field
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body interface{}, enveloped bool, out interface{}) error {
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		apiErr := &APIError{StatusCode: resp.StatusCode, Message: http.StatusText(resp.StatusCode), Body: data}
+		var errBody ErrorResponse
+		if json.Unmarshal(data, &errBody) == nil {
+			if errBody.Code != nil {
+				apiErr.Code = *errBody.Code
+			}
+			if errBody.Message != nil {
+				apiErr.Message = *errBody.Message
+			} else if errBody.Error != nil {
+				apiErr.Message = *errBody.Error
+			}
+		}
+		return apiErr
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if enveloped {
+		var env envelope
+		if err := json.Unmarshal(data, &env); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+		data = env.Data
+		if len(data) == 0 {
+			return nil
+		}
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// expandPath substitutes {name} placeholders with escaped values
+func expandPath(template string, kv ...interface{}) string {
+	for i := 0; i+1 < len(kv); i += 2 {
+		template = strings.Replace(template, "{"+fmt.Sprint(kv[i])+"}", url.PathEscape(fmt.Sprint(kv[i+1])), 1)
+	}
+	return template
+}
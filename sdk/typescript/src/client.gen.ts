@@ -0,0 +1,4830 @@
+// Code generated by cmd/sdkgen from docs/swagger.json. DO NOT EDIT.
+/* eslint-disable */
+
+export type QueryParams = Record<string, string | number | boolean | Array<string | number | boolean> | undefined>;
+
+export interface ClientOptions {
+  baseUrl: string;
+  token?: string;
+  fetch?: typeof fetch;
+}
+
+export class APIError extends Error {
+  constructor(
+    public readonly status: number,
+    public readonly code: string | undefined,
+    message: string,
+    public readonly body: unknown,
+  ) {
+    super(message);
+    this.name = "APIError";
+  }
+}
+
+export class BaseClient {
+  protected readonly baseUrl: string;
+  protected readonly fetchImpl: typeof fetch;
+  token?: string;
+
+  constructor(options: ClientOptions) {
+    this.baseUrl = options.baseUrl.replace(/\/+$/, "");
+    this.token = options.token;
+    this.fetchImpl = options.fetch ?? fetch;
+  }
+
+  protected async request<T>(
+    method: string,
+    path: string,
+    query: QueryParams | undefined,
+    body: unknown,
+    enveloped: boolean,
+    init?: RequestInit,
+  ): Promise<T> {
+    const url = new URL(this.baseUrl + path);
+    for (const [key, value] of Object.entries(query ?? {})) {
+      if (value === undefined) continue;
+      for (const v of Array.isArray(value) ? value : [value]) url.searchParams.append(key, String(v));
+    }
+
+    const headers = new Headers(init?.headers);
+    headers.set("Accept", "application/json");
+    if (body !== undefined) headers.set("Content-Type", "application/json");
+    if (this.token) headers.set("Authorization", "Bearer " + this.token);
+
+    const res = await this.fetchImpl(url.toString(), {
+      ...init,
+      method,
+      headers,
+      body: body === undefined ? undefined : JSON.stringify(body),
+    });
+
+    const text = await res.text();
+    const payload = text ? JSON.parse(text) : undefined;
+    if (!res.ok) {
+      throw new APIError(res.status, payload?.code, payload?.message ?? payload?.error ?? res.statusText, payload);
+    }
+    return (enveloped ? payload?.data : payload) as T;
+  }
+}
+
+// ============================================
+// Models
+// ============================================
+
+export interface Variant {
+  name: string;
+  weight: number;
+}
+
+/** Paginated response wrapper with metadata */
+export interface PaginatedResponse {
+  data?: unknown;
+  meta?: Pagination;
+  success?: boolean;
+}
+
+/** Pagination metadata for list responses */
+export interface Pagination {
+  count?: number;
+  has_more?: boolean;
+  limit?: number;
+  /** Largest limit the consumer may ask for; larger limits are capped */
+  max_limit?: number;
+  offset?: number;
+  total?: number;
+}
+
+export interface StatusUpdateRequest {
+  status: string;
+}
+
+/** Standard success response wrapper */
+export interface SuccessResponse {
+  data?: unknown;
+  message?: string;
+  meta?: unknown;
+  success?: boolean;
+}
+
+export interface ErrorResponse {
+  code?: string;
+  details?: Record<string, unknown>;
+  error?: string;
+  message?: string;
+  /** RequestID is the request's X-Request-ID, for quoting to support */
+  request_id?: string;
+}
+
+export interface APIQuota {
+  note?: string;
+  requests_per_minute?: number;
+  updated_at?: string;
+  user_email?: string;
+  user_id?: number;
+}
+
+export interface APIUsageReport {
+  /** Whether the limit is a per-consumer override */
+  custom_quota?: boolean;
+  daily?: APIUsageStats[];
+  endpoints?: APIUsageStats[];
+  from?: string;
+  /** Current rate limit */
+  requests_per_minute?: number;
+  to?: string;
+  totals?: APIUsageStats;
+  user_id?: number;
+}
+
+export interface APIUsageStats {
+  avg_latency_ms?: number;
+  client_error_count?: number;
+  day?: string;
+  /** Percent of requests answered 4xx or 5xx */
+  error_rate?: number;
+  max_latency_ms?: number;
+  method?: string;
+  request_count?: number;
+  route?: string;
+  server_error_count?: number;
+  user_email?: string;
+  user_id?: number;
+}
+
+export interface AuditLog {
+  /** e.g. booking.cancelled, review.moderated */
+  action?: string;
+  actor_id?: number;
+  /** customer, barber, admin, system */
+  actor_type?: string;
+  created_at?: string;
+  entity_id?: number;
+  /** booking, review, user, service, ... */
+  entity_type?: string;
+  id?: number;
+  /** ImpersonatorID is the real operator when an admin acted on ActorID's behalf */
+  impersonator_id?: number;
+  /** Request context */
+  ip_address?: string;
+  metadata?: JSONMap;
+  new_values?: JSONMap;
+  /** The changed fields before and after the action (nil when not recorded) */
+  old_values?: unknown;
+  request_id?: string;
+  user_agent?: string;
+}
+
+export interface Barber {
+  acceptance_rate?: number;
+  /** Location and contact */
+  address?: string;
+  address_line_2?: string;
+  /** Business settings */
+  advance_booking_days?: number;
+  auto_accept_bookings?: boolean;
+  business_email?: string;
+  business_name?: string;
+  business_registration_number?: string;
+  cancellation_rate?: number;
+  certifications?: string[];
+  city?: string;
+  /** Financial information */
+  commission_rate?: number;
+  country?: string;
+  cover_image_url?: string;
+  /** Audit fields */
+  created_at?: string;
+  deleted_at?: string;
+  /** Business details */
+  description?: string;
+  /** Ranking (search listings only). DistanceKm is set when the searcher sent a location; Ranking only when the listing asked for explain=true. */
+  distance_km?: number;
+  featured_placement_id?: number;
+  gallery_images?: string[];
+  id?: number;
+  instant_booking_enabled?: boolean;
+  /** Featured placement running today (search listings only). Clients report clicks on featured results against FeaturedPlacementID. */
+  is_featured?: boolean;
+  is_verified?: boolean;
+  languages_spoken?: string[];
+  last_active_at?: string;
+  latitude?: number;
+  longitude?: number;
+  min_booking_notice_hours?: number;
+  /** NoShowGraceMinutes is how long after the start time a confirmed booking nobody started is marked no-show (nil = platform default) */
+  no_show_grace_minutes?: number;
+  payout_details?: JSONMap;
+  payout_method?: string;
+  phone?: string;
+  postal_code?: string;
+  /** Media and branding */
+  profile_image_url?: string;
+  ranking?: Explanation;
+  /** Business metrics */
+  rating?: number;
+  /** Performance metrics */
+  response_time_minutes?: number;
+  services?: BarberService[];
+  /** Shop the barber works at (nil = standalone) */
+  shop_id?: number;
+  /** Business information */
+  shop_name?: string;
+  specialties?: string[];
+  state?: string;
+  /** Status and verification */
+  status?: string;
+  tax_id?: string;
+  /** A nearby search's pick of the barber's most booked services */
+  top_services?: BarberService[];
+  total_bookings?: number;
+  total_reviews?: number;
+  updated_at?: string;
+  /** Relations (populated when needed) */
+  user?: unknown;
+  user_email?: string;
+  /** Links to User table */
+  user_id?: number;
+  user_name?: string;
+  uuid?: string;
+  verification_date?: string;
+  verification_notes?: string;
+  website_url?: string;
+  /** Working hours (flexible JSON structure) */
+  working_hours?: unknown;
+  years_experience?: number;
+}
+
+export interface BarberClient {
+  barber_id?: number;
+  created_at?: string;
+  created_by?: number;
+  /** Lower-case */
+  email?: string;
+  email_consent?: boolean;
+  id?: number;
+  name?: string;
+  notes?: string;
+  /** Digits, with a leading + if given */
+  phone?: string;
+  sms_consent?: boolean;
+  /** import */
+  source?: string;
+  updated_at?: string;
+  /** The client's account; nil for clients without email */
+  user_id?: number;
+}
+
+export interface BarberDashboard {
+  barber_id?: number;
+  counts?: DashboardCounts;
+  current?: DashboardAppointment;
+  /** When the bookings were read; may trail GeneratedAt when cached */
+  data_as_of?: string;
+  date?: string;
+  earnings?: DashboardEarnings;
+  generated_at?: string;
+  late_by_minutes?: number;
+  next?: DashboardAppointment;
+  next_starts_in_seconds?: number;
+  pending_confirmations?: DashboardAppointment[];
+  queue?: DashboardAppointment[];
+  running_late?: boolean;
+  timezone?: string;
+}
+
+export interface BarberLocation {
+  address?: string;
+  barber_id?: number;
+  city?: string;
+  created_at?: string;
+  id?: number;
+  name?: string;
+  updated_at?: string;
+}
+
+export interface BarberService {
+  /** Barber's booking rules */
+  advance_notice_hours?: number;
+  /** From the service; add-ons can be booked with it */
+  allows_add_ons?: boolean;
+  /** ["monday", "tuesday"] */
+  available_days?: string[];
+  /** Custom time restrictions */
+  available_time_slots?: unknown;
+  /** 4.7 stars */
+  average_rating?: number;
+  /** The barber offering it */
+  barber?: unknown;
+  /** FK to barbers table */
+  barber_id?: number;
+  /** Before/after photos */
+  before_after_images?: string[];
+  /** Recent performance (for ML and trending) */
+  bookings_last_30_days?: number;
+  /** Break between services */
+  buffer_time_minutes?: number;
+  /** 5.2% */
+  cancellation_rate?: number;
+  category_id?: number;
+  /** 15 minutes */
+  consultation_duration?: number;
+  /** Audit fields */
+  created_at?: string;
+  /** USD, EUR, etc. */
+  currency?: string;
+  /** Barber's custom description */
+  custom_description?: string;
+  /** Barber's customization of the service */
+  custom_name?: string;
+  /** 94.5% */
+  customer_satisfaction?: number;
+  /** Current demand (0-1) */
+  demand_level?: number;
+  /** Deposit held when booking (nil = no deposit) and the part of it kept when the customer does not show up */
+  deposit_type?: string;
+  /** Percent of the price, or an amount */
+  deposit_value?: number;
+  /** Special offer price */
+  discount_price?: number;
+  discount_valid_until?: string;
+  /** Display and ordering */
+  display_order?: number;
+  /** Active/passive split of the duration (nil = fully active) */
+  duration_segments?: DurationSegment[];
+  /** 45 minutes */
+  estimated_duration_max?: number;
+  /** Barber's timing */
+  estimated_duration_min?: number;
+  id?: number;
+  /** Status */
+  is_active?: boolean;
+  /** Highlight this service */
+  is_featured?: boolean;
+  /** Marketing and promotions */
+  is_promotional?: boolean;
+  /** Seasonal availability */
+  is_seasonal?: boolean;
+  /** 30 days max */
+  max_advance_booking_days?: number;
+  /** No upper limit usually */
+  max_customer_age?: number;
+  /** For variable/surge pricing */
+  max_price?: number;
+  /** Age restrictions */
+  min_customer_age?: number;
+  /** nil = the whole deposit */
+  no_show_fee?: number;
+  /** Why service is paused */
+  paused_reason?: string;
+  /** Temporary pause */
+  paused_until?: string;
+  /** ML-calculated score */
+  popularity_score?: number;
+  /** Barber's portfolio for this service */
+  portfolio_images?: string[];
+  /** "Avoid washing for 24h" */
+  post_service_care?: string;
+  /** "Wash hair first" */
+  pre_service_instructions?: string;
+  /** Barber's pricing */
+  price?: number;
+  promotion_end_date?: string;
+  promotion_start_date?: string;
+  /** "20% off this week!" */
+  promotional_text?: string;
+  /** 67.3% */
+  repeat_customer_rate?: number;
+  /** Barber's service-specific requirements */
+  requires_consultation?: boolean;
+  /** $300.00 */
+  revenue_last_30_days?: number;
+  /** 10 (October) */
+  seasonal_end_month?: number;
+  /** 3 (March) */
+  seasonal_start_month?: number;
+  /** Relations (populated via joins when needed) */
+  service?: unknown;
+  /** FK to services table */
+  service_id?: number;
+  service_name?: string;
+  /** Special note for customers */
+  service_note?: string;
+  service_type?: string;
+  /** Performance metrics for this barber's service */
+  total_bookings?: number;
+  /** $3,900.00 */
+  total_revenue?: number;
+  /** 89 reviews */
+  total_reviews?: number;
+  updated_at?: string;
+}
+
+export interface BarberSummary {
+  city?: string;
+  id?: number;
+  profile_image_url?: string;
+  rating?: number;
+  shop_name?: string;
+  uuid?: string;
+}
+
+export interface Booking {
+  actual_end_time?: string;
+  actual_start_time?: string;
+  barber?: Barber;
+  barber_id?: number;
+  /** Service information */
+  barber_service_id?: number;
+  /** Human-readable reference */
+  booking_number?: string;
+  /** Source and attribution */
+  booking_source?: string;
+  cancellation_fee?: number;
+  /** Free text */
+  cancellation_reason?: string;
+  cancellation_reason_code?: string;
+  /** Cancellation information */
+  cancelled_at?: string;
+  cancelled_by?: number;
+  /** Communication tracking */
+  confirmation_method?: string;
+  confirmation_sent_at?: string;
+  /** Audit fields */
+  created_at?: string;
+  currency?: string;
+  /** Answers to the barber's custom booking-form fields */
+  custom_fields?: BookingFormAnswer[];
+  /** Relations (populated when needed) */
+  customer?: unknown;
+  customer_email?: string;
+  /** Relationships */
+  customer_id?: number;
+  /** Customer information (for guest bookings) */
+  customer_name?: string;
+  customer_phone?: string;
+  /** Deposit held when booking and the fee kept from it on a no-show */
+  deposit_amount?: number;
+  discount_amount?: number;
+  /** Active/passive split copied from the service (nil = fully active) */
+  duration_segments?: DurationSegment[];
+  estimated_duration_minutes?: number;
+  id?: number;
+  /** Row an imported booking came from */
+  import_ref?: string;
+  /** For barber use only */
+  internal_notes?: string;
+  /** Created in sandbox mode */
+  is_test?: boolean;
+  /** The services performed, in order (populated when needed) */
+  line_items?: BookingLineItem[];
+  /** One of the barber's locations (nil = the barber's address) */
+  location_id?: number;
+  no_show_fee?: number;
+  /** Booking details */
+  notes?: string;
+  paid_at?: string;
+  payment_method?: string;
+  payment_reference?: string;
+  /** Payment information */
+  payment_status?: string;
+  referral_source?: string;
+  reminder_sent_at?: string;
+  review?: Review;
+  scheduled_end_time?: string;
+  /** Timing */
+  scheduled_start_time?: string;
+  /** Set for occurrences of a recurring booking */
+  series_id?: number;
+  service_category?: string;
+  service_name?: string;
+  /** Pricing breakdown */
+  service_price?: number;
+  special_requests?: string;
+  /** Booking status */
+  status?: string;
+  tax_amount?: number;
+  /** Tax line items behind TaxAmount (populated when needed) */
+  tax_lines?: BookingTaxLine[];
+  time_slot?: TimeSlot;
+  time_slot_id?: number;
+  tip_amount?: number;
+  total_price?: number;
+  updated_at?: string;
+  utm_campaign?: string;
+  uuid?: string;
+}
+
+export interface BookingFormAnswer {
+  key?: string;
+  label?: string;
+  type?: string;
+  /** string, number or bool by type */
+  value?: unknown;
+}
+
+export interface BookingFormField {
+  barber_id?: number;
+  /** Questions on one service are only asked when it is booked (nil = asked for every booking); the answers that need a consultation first (select options, or "true"/"false" for checkboxes) */
+  barber_service_id?: number;
+  consultation_answers?: string[];
+  created_at?: string;
+  display_order?: number;
+  /** text, number, select, checkbox */
+  field_type?: string;
+  help_text?: string;
+  id?: number;
+  is_active?: boolean;
+  is_required?: boolean;
+  /** Key of the answer in custom_fields */
+  key?: string;
+  label?: string;
+  /** Text fields */
+  max_length?: number;
+  /** Number fields */
+  max_value?: number;
+  /** Number fields */
+  min_value?: number;
+  /** Choices of a select field */
+  options?: string[];
+  /** Text fields; the whole answer must match */
+  pattern?: string;
+  updated_at?: string;
+}
+
+export interface BookingLineItem {
+  /** Set for add-ons; nil once the add-on is deleted */
+  add_on_id?: number;
+  /** nil once the service is deleted */
+  barber_service_id?: number;
+  booking_id?: number;
+  created_at?: string;
+  duration_minutes?: number;
+  id?: number;
+  /** Order the services are performed in */
+  position?: number;
+  price?: number;
+  service_name?: string;
+}
+
+export interface BookingStaff {
+  barber_id?: number;
+  barber_name?: string;
+  booking_id?: number;
+  created_at?: string;
+  role?: string;
+  share_percent?: number;
+}
+
+export interface BookingTaxLine {
+  amount?: number;
+  booking_id?: number;
+  created_at?: string;
+  id?: number;
+  inclusive?: boolean;
+  name?: string;
+  rate_percent?: number;
+  tax_rule_id?: number;
+  /** Price before any tax */
+  taxable_amount?: number;
+}
+
+export interface CancellationReasonStat {
+  by_barber?: number;
+  by_customer?: number;
+  code?: string;
+  count?: number;
+  label?: string;
+  /** Share of all cancellations in the range */
+  percent?: number;
+}
+
+export interface CancellationReasonStats {
+  barber_id?: number;
+  from?: string;
+  reasons?: CancellationReasonStat[];
+  /** Exclusive */
+  to?: string;
+  total?: number;
+}
+
+export interface ConsultationPhoto {
+  consultation_request_id?: number;
+  content_type?: string;
+  created_at?: string;
+  height?: number;
+  id?: number;
+  size_bytes?: number;
+  thumbnail_url?: string;
+  uploaded_by?: number;
+  url?: string;
+  width?: number;
+}
+
+export interface ConsultationRequest {
+  answers?: BookingFormAnswer[];
+  barber_id?: number;
+  /** nil once the service is removed */
+  barber_service_id?: number;
+  /** The booking made from the accepted quote */
+  booking_id?: number;
+  created_at?: string;
+  customer_email?: string;
+  /** Who asked (customer for signed-in users, the contact details for guests) */
+  customer_id?: number;
+  customer_name?: string;
+  customer_phone?: string;
+  id?: number;
+  notes?: string;
+  photos?: ConsultationPhoto[];
+  /** From customers who ask for a consultation themselves */
+  preferred_times?: string[];
+  proposed_start_time?: string;
+  quoted_at?: string;
+  /** The barber's quote, which the customer may accept as a booking */
+  quoted_price?: number;
+  /** The booking that was asked for */
+  requested_start_time?: string;
+  responded_at?: string;
+  responded_by?: number;
+  response_notes?: string;
+  service_name?: string;
+  /** The barber's answer */
+  status?: string;
+  /** Keys of the questions that asked for the consultation */
+  triggered_by?: string[];
+  updated_at?: string;
+}
+
+export interface CustomerSummary {
+  id?: number;
+  name?: string;
+  profile_picture_url?: string;
+}
+
+export interface DashboardAppointment {
+  actual_start_time?: string;
+  booking_number?: string;
+  created_at?: string;
+  currency?: string;
+  customer_id?: number;
+  customer_name?: string;
+  customer_phone?: string;
+  id?: number;
+  late_by_minutes?: number;
+  scheduled_end_time?: string;
+  scheduled_start_time?: string;
+  service_name?: string;
+  status?: string;
+  tip_amount?: number;
+  total_price?: number;
+}
+
+export interface DashboardCounts {
+  cancelled?: number;
+  completed?: number;
+  no_show?: number;
+  remaining?: number;
+  total?: number;
+}
+
+export interface DashboardEarnings {
+  completed_count?: number;
+  currency?: string;
+  /** Total plus the price of today's remaining appointments */
+  projected?: number;
+  revenue?: number;
+  tips?: number;
+  total?: number;
+}
+
+export interface DeviceToken {
+  app_version?: string;
+  created_at?: string;
+  id?: number;
+  /** Last successful push */
+  last_used_at?: string;
+  /** ios, android or web */
+  platform?: string;
+  token?: string;
+  updated_at?: string;
+  user_id?: number;
+}
+
+export interface DurationSegment {
+  minutes?: number;
+  /** The barber is free during this segment */
+  passive?: boolean;
+}
+
+export interface EarningsLine {
+  /** This barber's part */
+  amount?: number;
+  /** The whole booking, before tax */
+  booking_amount?: number;
+  booking_id?: number;
+  booking_number?: string;
+  booking_tip?: number;
+  commission?: number;
+  currency?: string;
+  payout?: number;
+  role?: string;
+  service_name?: string;
+  share_percent?: number;
+  start_time?: string;
+  tip?: number;
+}
+
+export interface EarningsReport {
+  barber_id?: number;
+  bookings?: number;
+  /** Platform commission on earnings */
+  commission?: number;
+  /** Platform commission, percent */
+  commission_rate?: number;
+  currency?: string;
+  /** Share of booking totals, before tax */
+  earnings?: number;
+  from?: string;
+  lines?: EarningsLine[];
+  /** Earnings - commission + tips */
+  payout?: number;
+  /** Share of tips */
+  tips?: number;
+  to?: string;
+}
+
+export interface Experiment {
+  created_at?: string;
+  created_by?: number;
+  description?: string;
+  id?: number;
+  /** Referenced by the code under test */
+  key?: string;
+  name?: string;
+  started_at?: string;
+  status?: string;
+  stopped_at?: string;
+  updated_at?: string;
+  variants?: Variant[];
+  /** Served to everyone once stopped */
+  winner?: string;
+}
+
+export interface ExperimentConversion {
+  created_at?: string;
+  event?: string;
+  experiment_id?: number;
+  id?: number;
+  user_id?: number;
+  value?: number;
+  variant?: string;
+}
+
+export interface ExperimentReport {
+  experiment?: Experiment;
+  metric?: string;
+  variants?: ExperimentVariantResult[];
+  /** Conversions counted this long after exposure (0 = any time) */
+  window_days?: number;
+}
+
+export interface ExperimentVariantResult {
+  /** Percentage of exposures; nil without exposures */
+  conversion_rate?: number;
+  /** Exposed users who converted */
+  conversions?: number;
+  exposures?: number;
+  /** Relative change over the control's rate, in percent */
+  lift?: number;
+  /** Booking revenue or summed event values */
+  value?: number;
+  variant?: string;
+}
+
+export interface FavoriteBarber {
+  barber_id?: number;
+  city?: string;
+  created_at?: string;
+  shop_name?: string;
+  user_id?: number;
+}
+
+export interface FeaturedPlacement {
+  amount?: number;
+  barber_id?: number;
+  barber_shop_name?: string;
+  cancelled_at?: string;
+  /** nil = all categories */
+  category_id?: number;
+  city?: string;
+  /** Percentage of impressions; nil before any impression */
+  click_through_rate?: number;
+  clicks?: number;
+  created_at?: string;
+  currency?: string;
+  /** Inclusive */
+  ends_on?: string;
+  id?: number;
+  /** Performance over the whole placement */
+  impressions?: number;
+  payment_provider?: string;
+  payment_reference?: string;
+  refund_reference?: string;
+  starts_on?: string;
+  /** pending_payment, active, cancelled */
+  status?: string;
+  updated_at?: string;
+}
+
+export interface FeaturedSlotDay {
+  booked?: number;
+  day?: string;
+  limit?: number;
+  remaining?: number;
+}
+
+export interface FeaturedSlotLimit {
+  category_id?: number;
+  city?: string;
+  id?: number;
+  max_slots?: number;
+  updated_at?: string;
+}
+
+export interface InventoryItem {
+  barber_id?: number;
+  created_at?: string;
+  id?: number;
+  /** Alert at or below this quantity (0 = when out of stock) */
+  low_stock_threshold?: number;
+  /** Matched against services' required_products */
+  name?: string;
+  quantity?: number;
+  unit?: string;
+  updated_at?: string;
+  /** Taken out of stock per completed booking */
+  usage_per_service?: number;
+}
+
+export interface InventoryItemUsage {
+  bookings?: number;
+  in_stock?: number;
+  item_id?: number;
+  name?: string;
+  quantity?: number;
+  unit?: string;
+}
+
+export interface InventoryUsageReport {
+  barber_id?: number;
+  from?: string;
+  items?: InventoryItemUsage[];
+  to?: string;
+}
+
+export type JSONMap = Record<string, unknown>;
+
+export interface NpsSummary {
+  detractors?: number;
+  passives?: number;
+  promoters?: number;
+  responses?: number;
+  /** nil without responses */
+  score?: number;
+}
+
+export interface NpsTrend {
+  /** nil for platform-wide */
+  barber_id?: number;
+  from?: string;
+  interval?: string;
+  overall?: NpsSummary;
+  points?: NpsTrendPoint[];
+  to?: string;
+}
+
+export interface NpsTrendPoint {
+  detractors?: number;
+  passives?: number;
+  period_start?: string;
+  promoters?: number;
+  responses?: number;
+  /** nil without responses */
+  score?: number;
+}
+
+export interface OpenSlot {
+  /** favorites, nearby */
+  audiences?: string[];
+  barber_id?: number;
+  barber_service_id?: number;
+  booking_id?: number;
+  claimed_at?: string;
+  claimed_by?: number;
+  claims_close_at?: string;
+  created_at?: string;
+  created_by?: number;
+  /** Flash discount, 0 for none */
+  discount_percent?: number;
+  duration_minutes?: number;
+  id?: number;
+  message?: string;
+  /** Nearby audience only */
+  radius_km?: number;
+  /** Customers notified */
+  recipient_count?: number;
+  service_name?: string;
+  /** Before the flash discount */
+  service_price?: number;
+  start_time?: string;
+  /** open, claimed, cancelled */
+  status?: string;
+  updated_at?: string;
+}
+
+export interface PortfolioMedia {
+  barber_service_id?: number;
+  caption?: string;
+  content_type?: string;
+  created_at?: string;
+  height?: number;
+  id?: number;
+  /** portfolio, before_after */
+  kind?: string;
+  /** Order among the service's photos of this kind */
+  position?: number;
+  size_bytes?: number;
+  thumbnail_url?: string;
+  updated_at?: string;
+  uploaded_by?: number;
+  url?: string;
+  width?: number;
+}
+
+export interface Review {
+  barber?: Barber;
+  barber_id?: number;
+  /** Barber response. BarberResponse is the live response; a response awaiting moderation, rejected or redacted is PendingBarberResponse. */
+  barber_response?: string;
+  barber_response_at?: string;
+  /** pending, approved, rejected, flagged, redacted */
+  barber_response_status?: string;
+  booking?: Booking;
+  /** One review per booking */
+  booking_id?: number;
+  cleanliness_rating?: number;
+  comment?: string;
+  cons?: string;
+  created_at?: string;
+  /** Relations */
+  customer?: unknown;
+  customer_id?: number;
+  duration_accurate?: boolean;
+  /** Community interaction */
+  helpful_votes?: number;
+  id?: number;
+  /** Media attachments */
+  images?: string[];
+  is_published?: boolean;
+  /** Verification and moderation */
+  is_verified?: boolean;
+  moderated_at?: string;
+  moderated_by?: number;
+  moderation_notes?: string;
+  /** pending, approved, rejected, flagged */
+  moderation_status?: string;
+  /** Overall and detailed ratings (1-5 scale) */
+  overall_rating?: number;
+  professionalism_rating?: number;
+  pros?: string;
+  punctuality_rating?: number;
+  /** User reports since the review was last moderated */
+  report_count?: number;
+  service_as_expected?: boolean;
+  service_quality_rating?: number;
+  /** Review content */
+  title?: string;
+  total_votes?: number;
+  updated_at?: string;
+  value_for_money_rating?: number;
+  would_book_again?: boolean;
+  /** Additional feedback */
+  would_recommend?: boolean;
+}
+
+export interface ReviewImage {
+  content_type?: string;
+  created_at?: string;
+  height?: number;
+  id?: number;
+  review_id?: number;
+  size_bytes?: number;
+  thumbnail_url?: string;
+  uploaded_by?: number;
+  url?: string;
+  width?: number;
+}
+
+export interface ReviewReport {
+  created_at?: string;
+  details?: string;
+  id?: number;
+  /** spam, offensive, fake, personal_information, off_topic, other */
+  reason?: string;
+  /** Nil once the reporter's account is deleted */
+  reporter_id?: number;
+  reporter_name?: string;
+  review_id?: number;
+}
+
+export interface Role {
+  created_at?: string;
+  description?: string;
+  is_system?: boolean;
+  name?: string;
+  permissions?: string[];
+  updated_at?: string;
+}
+
+export interface Service {
+  /** Health and safety */
+  allergen_warnings?: string[];
+  /** Can add beard trim, etc. */
+  allows_add_ons?: boolean;
+  approval_notes?: string;
+  /** 0-5 */
+  average_global_rating?: number;
+  category?: ServiceCategory;
+  category_id?: number;
+  /** Relations (populated when needed via joins) */
+  category_name?: string;
+  change_log?: JSONMap;
+  /** 1-5 scale (1=easy, 5=expert) */
+  complexity?: number;
+  /** Audit fields */
+  created_at?: string;
+  /** Admin user ID */
+  created_by?: number;
+  /** USD, EUR, etc. */
+  currency?: string;
+  /** 45 minutes */
+  default_duration_max?: number;
+  /** Default duration (barbers can override) */
+  default_duration_min?: number;
+  detailed_description?: string;
+  gallery_images?: string[];
+  /** Global statistics (updated periodically) */
+  global_popularity_score?: number;
+  /** straight, wavy, curly, coily, all */
+  hair_types?: string[];
+  /** Service variations and add-ons */
+  has_variations?: boolean;
+  /** ["pregnancy", "skin_sensitivity"] */
+  health_precautions?: string[];
+  id?: number;
+  /** Media */
+  image_url?: string;
+  /** Status and approval */
+  is_active?: boolean;
+  /** Platform approval required */
+  is_approved?: boolean;
+  last_modified_by?: number;
+  meta_description?: string;
+  name?: string;
+  required_certifications?: string[];
+  /** ["shampoo", "conditioner"] */
+  required_products?: string[];
+  /** ["scissors", "comb", "clippers"] */
+  required_tools?: string[];
+  /** Service requirements */
+  requires_consultation?: boolean;
+  requires_health_check?: boolean;
+  /** ["fade", "buzz cut", "trim"] */
+  search_keywords?: string[];
+  /** Service characteristics */
+  service_type?: string;
+  /** Service details */
+  short_description?: string;
+  /** beginner, intermediate, advanced, expert */
+  skill_level_required?: string;
+  /** URL-friendly name */
+  slug?: string;
+  /** $40.00 */
+  suggested_price_max?: number;
+  /** Suggested pricing (barbers set their own prices) */
+  suggested_price_min?: number;
+  /** SEO and search */
+  tags?: string[];
+  target_age_max?: number;
+  target_age_min?: number;
+  /** Target demographics */
+  target_gender?: string;
+  total_global_bookings?: number;
+  total_global_reviews?: number;
+  updated_at?: string;
+  uuid?: string;
+  /** Version control */
+  version?: number;
+  video_url?: string;
+}
+
+export interface ServiceAddOn {
+  barber_service_id?: number;
+  created_at?: string;
+  description?: string;
+  display_order?: number;
+  /** 0 = no extra time */
+  duration_minutes?: number;
+  id?: number;
+  is_active?: boolean;
+  name?: string;
+  price?: number;
+  updated_at?: string;
+}
+
+export interface ServiceCategory {
+  /** Average price in category */
+  average_price?: number;
+  /** Barbers offering services */
+  barber_count?: number;
+  /** "haircuts/mens" */
+  category_path?: string;
+  /** #FF6B35 */
+  color_hex?: string;
+  /** Audit fields */
+  created_at?: string;
+  /** "Professional hair cutting services" */
+  description?: string;
+  /** Display attributes */
+  icon_url?: string;
+  id?: number;
+  /** Category banner */
+  image_url?: string;
+  /** Status */
+  is_active?: boolean;
+  /** Show prominently */
+  is_featured?: boolean;
+  keywords?: string[];
+  /** 1=main, 2=sub, etc. */
+  level?: number;
+  meta_description?: string;
+  /** SEO */
+  meta_title?: string;
+  /** "Haircuts" */
+  name?: string;
+  /** Relations */
+  parent_category?: unknown;
+  /** Hierarchy support */
+  parent_category_id?: number;
+  /** Category popularity */
+  popularity_score?: number;
+  /** Statistics (updated periodically) */
+  service_count?: number;
+  services?: Service[];
+  /** "haircuts" */
+  slug?: string;
+  /** Display order */
+  sort_order?: number;
+  sub_categories?: ServiceCategory[];
+  updated_at?: string;
+}
+
+export interface ServiceSummary {
+  currency?: string;
+  duration_minutes?: number;
+  id?: number;
+  name?: string;
+  price?: number;
+  service_id?: number;
+}
+
+export interface Shop {
+  /** Location and contact */
+  address?: string;
+  address_line_2?: string;
+  /** BarberCount is set by listings */
+  barber_count?: number;
+  city?: string;
+  country?: string;
+  created_at?: string;
+  created_by?: number;
+  description?: string;
+  email?: string;
+  id?: number;
+  latitude?: number;
+  longitude?: number;
+  name?: string;
+  opening_hours?: ShopHoursPeriod[];
+  phone?: string;
+  postal_code?: string;
+  state?: string;
+  tax_id?: string;
+  /** Settings */
+  timezone?: string;
+  updated_at?: string;
+  uuid?: string;
+}
+
+export interface ShopAdmin {
+  created_at?: string;
+  /** owner, admin */
+  role?: string;
+  shop_id?: number;
+  user_email?: string;
+  user_id?: number;
+  user_name?: string;
+}
+
+export interface ShopHoursPeriod {
+  /** 0=Sunday, 6=Saturday */
+  day_of_week?: number;
+  end_time?: string;
+  start_time?: string;
+}
+
+export interface ShopOffboarding {
+  completed_at?: string;
+  created_at?: string;
+  /** The data is deleted at the first run after this */
+  delete_after?: string;
+  id?: number;
+  /** Why the last deletion attempt was put off */
+  last_error?: string;
+  reason?: string;
+  requested_by?: number;
+  shop_id?: number;
+  shop_name?: string;
+  /** scheduled, cancelled, completed */
+  status?: string;
+  /** Rows deleted or anonymised, by table */
+  summary?: unknown;
+  updated_at?: string;
+}
+
+export interface StatusIncident {
+  component?: string;
+  created_at?: string;
+  id?: number;
+  /** degraded, major_outage */
+  impact?: string;
+  /** Opened by a health check rather than staff */
+  is_automatic?: boolean;
+  message?: string;
+  resolved_at?: string;
+  started_at?: string;
+  title?: string;
+  updated_at?: string;
+}
+
+export interface SupportTicket {
+  assigned_to?: number;
+  booking_id?: number;
+  /** booking, payment, account, other */
+  category?: string;
+  closed_at?: string;
+  created_at?: string;
+  first_responded_at?: string;
+  first_response_breached_at?: string;
+  first_response_due_at?: string;
+  id?: number;
+  /** Loaded with the ticket when viewing it */
+  messages?: SupportTicketMessage[];
+  payment_reference?: string;
+  /** low, normal, high, urgent */
+  priority?: string;
+  requester_id?: number;
+  resolution_breached_at?: string;
+  resolution_due_at?: string;
+  resolved_at?: string;
+  sla_paused_at?: string;
+  /** open, in_progress, waiting_on_customer, resolved, closed */
+  status?: string;
+  subject?: string;
+  updated_at?: string;
+}
+
+export interface SupportTicketMessage {
+  author_id?: number;
+  body?: string;
+  created_at?: string;
+  id?: number;
+  /** Staff-only note */
+  is_internal?: boolean;
+  is_staff?: boolean;
+  ticket_id?: number;
+}
+
+export interface Suppression {
+  /** Normalized, see NormalizeAddress */
+  address?: string;
+  /** email or sms */
+  channel?: string;
+  created_at?: string;
+  /** Admin who added it */
+  created_by?: number;
+  detail?: string;
+  id?: number;
+  /** hard_bounce, spam_complaint, opt_out or manual */
+  reason?: string;
+  /** Provider that reported it, or admin */
+  source?: string;
+  updated_at?: string;
+}
+
+export interface TaxReport {
+  barber_id?: number;
+  from?: string;
+  lines?: TaxReportLine[];
+  to?: string;
+  total?: number;
+}
+
+export interface TaxReportLine {
+  amount?: number;
+  bookings?: number;
+  inclusive?: boolean;
+  name?: string;
+  rate_percent?: number;
+  taxable_amount?: number;
+}
+
+export interface TaxRule {
+  barber_id?: number;
+  country?: string;
+  created_at?: string;
+  id?: number;
+  /** Prices already include this tax */
+  inclusive?: boolean;
+  is_active?: boolean;
+  name?: string;
+  rate_percent?: number;
+  shop_id?: number;
+  state?: string;
+  updated_at?: string;
+}
+
+export interface TimeOff {
+  /** Created from whole dates */
+  all_day?: boolean;
+  barber_id?: number;
+  created_at?: string;
+  created_by?: number;
+  /** Exclusive */
+  end_at?: string;
+  id?: number;
+  reason?: string;
+  start_at?: string;
+}
+
+export interface TimeRange {
+  end_time?: string;
+  start_time?: string;
+}
+
+export interface TimeSlot {
+  /** Relations */
+  barber?: unknown;
+  barber_id?: number;
+  /** Pricing */
+  base_price?: number;
+  /** Audit fields */
+  created_at?: string;
+  created_by?: number;
+  discount_percentage?: number;
+  duration_minutes?: number;
+  /** AI-optimized pricing */
+  dynamic_price?: number;
+  end_time?: string;
+  id?: number;
+  /** Availability and type */
+  is_available?: boolean;
+  max_customers?: number;
+  min_advance_notice_hours?: number;
+  /** Metadata */
+  notes?: string;
+  service?: BarberService;
+  /** Service constraints */
+  service_id?: number;
+  /** regular, premium, last_minute, group */
+  slot_type?: string;
+  special_requirements?: JSONMap;
+  start_time?: string;
+  updated_at?: string;
+}
+
+export interface TimelineEntry {
+  booking_id?: number;
+  customer_id?: number;
+  details?: JSONMap;
+  /** See config.TimelineEvent* */
+  event_type?: string;
+  id?: number;
+  occurred_at?: string;
+  /** booking_history, review, payment */
+  source?: string;
+  source_id?: number;
+  title?: string;
+}
+
+export interface TimelineState {
+  customer_id?: number;
+  event_count?: number;
+  rebuilt_at?: string;
+  /** Newest source event */
+  watermark?: string;
+}
+
+export interface TravelTime {
+  from_location_id?: number;
+  minutes?: number;
+  to_location_id?: number;
+}
+
+export interface User {
+  /** Location information */
+  address?: string;
+  city?: string;
+  country?: string;
+  /** Audit fields */
+  created_at?: string;
+  created_by?: number;
+  /** Personal information */
+  date_of_birth?: string;
+  deleted_at?: string;
+  email?: string;
+  /** Verification and security */
+  email_verified?: boolean;
+  failed_login_attempts?: number;
+  /** male, female, other, prefer_not_to_say */
+  gender?: string;
+  id?: number;
+  last_login_at?: string;
+  latitude?: number;
+  locked_until?: string;
+  longitude?: number;
+  name?: string;
+  notification_settings?: JSONMap;
+  phone?: string;
+  phone_verified?: boolean;
+  postal_code?: string;
+  /** User preferences and settings */
+  preferences?: unknown;
+  profile_picture_url?: string;
+  state?: string;
+  /** active, inactive, suspended, deleted */
+  status?: string;
+  two_factor_enabled?: boolean;
+  updated_at?: string;
+  /** customer, barber, admin */
+  user_type?: string;
+  uuid?: string;
+}
+
+export interface UserAccess {
+  permissions?: string[];
+  roles?: UserRole[];
+  user_id?: number;
+  user_type?: string;
+}
+
+export interface UserIdentity {
+  created_at?: string;
+  /** Email reported by the provider when linked */
+  email?: string;
+  id?: number;
+  last_login_at?: string;
+  /** google, apple */
+  provider?: string;
+  user_id?: number;
+}
+
+export interface UserRole {
+  granted_at?: string;
+  granted_by?: number;
+  role?: string;
+  user_id?: number;
+}
+
+export interface WebhookDelivery {
+  attempts?: number;
+  created_at?: string;
+  delivered_at?: string;
+  event_id?: string;
+  event_type?: string;
+  id?: number;
+  last_error?: string;
+  next_attempt_at?: string;
+  payload?: Record<string, unknown>;
+  /** HTTP status of the last attempt */
+  response_status?: number;
+  /** pending, delivered, failed */
+  status?: string;
+  subscription_id?: number;
+}
+
+export interface WebhookSubscription {
+  barber_id?: number;
+  created_at?: string;
+  created_by?: number;
+  description?: string;
+  events?: string[];
+  id?: number;
+  is_active?: boolean;
+  updated_at?: string;
+  url?: string;
+}
+
+export interface WinBackCampaign {
+  coupon_percent_off?: number;
+  coupon_valid_days?: number;
+  eligible_count?: number;
+  finished_at?: string;
+  id?: number;
+  inactive_weeks?: number;
+  sent_count?: number;
+  started_at?: string;
+  /** nil when run by the scheduler */
+  triggered_by?: number;
+}
+
+export interface WinBackCampaignStats {
+  conversion_rate?: number;
+  /** Customers who booked within the attribution window */
+  converted?: number;
+  coupon_percent_off?: number;
+  coupon_valid_days?: number;
+  coupons_redeemed?: number;
+  eligible_count?: number;
+  finished_at?: string;
+  id?: number;
+  inactive_weeks?: number;
+  /** Percentages of SentCount; nil before anything is sent */
+  open_rate?: number;
+  /** Notifications read */
+  opened?: number;
+  /** Total price of converting bookings */
+  revenue?: number;
+  sent_count?: number;
+  started_at?: string;
+  /** nil when run by the scheduler */
+  triggered_by?: number;
+}
+
+export interface WinBackCandidate {
+  completed_visits?: number;
+  customer_id?: number;
+  customer_name?: string;
+  first_visit_at?: string;
+  last_barber_name?: string;
+  last_service_name?: string;
+  last_visit_at?: string;
+}
+
+export interface Contribution {
+  contribution?: number;
+  factor?: string;
+  /** 0-1 */
+  value?: number;
+  /** Normalized */
+  weight?: number;
+}
+
+export interface Explanation {
+  factors?: Contribution[];
+  score?: number;
+}
+
+export interface AcceptConsultationQuoteRequest {
+  /** Provider token for the card holding the deposit, when the service requires one */
+  deposit_payment_method_id?: string;
+}
+
+export interface ActionLinkPreview {
+  /** confirm, cancel */
+  action?: string;
+  booking_id?: number;
+  booking_number?: string;
+  booking_status?: string;
+  expires_at?: string;
+  scheduled_start_time?: string;
+}
+
+export interface ActionLinkResult {
+  action?: string;
+  booking_id?: number;
+  booking_status?: string;
+  /** Set when cancelling a prepaid booking */
+  refund?: unknown;
+  used_at?: string;
+}
+
+export interface ActivityFeedResponse {
+  entries?: AuditLog[];
+  /** NextBeforeID is passed back as before_id to fetch the next (older) page; nil when exhausted */
+  next_before_id?: number;
+}
+
+export interface AddOnRequest {
+  description?: string;
+  display_order?: number;
+  duration_minutes?: number;
+  /** Default: true */
+  is_active?: boolean;
+  name: string;
+  price?: number;
+}
+
+export interface AddShopAdminRequest {
+  user_id: number;
+}
+
+export interface AddShopBarberRequest {
+  barber_id: number;
+}
+
+export interface AffectedBooking {
+  booking_id?: number;
+  booking_number?: string;
+  customer_id?: number;
+  reschedule_offered?: boolean;
+  scheduled_end_time?: string;
+  scheduled_start_time?: string;
+  suggested_slots?: TimeRange[];
+}
+
+export interface AssignRoleRequest {
+  role: string;
+}
+
+export interface AssistantShare {
+  barber_id: number;
+  share_percent: number;
+}
+
+export interface AuthResponse {
+  expires_at?: string;
+  refresh_expires_at?: string;
+  /** Exchange at POST /auth/refresh for a new token pair; single use */
+  refresh_token?: string;
+  token?: string;
+  user?: UserProfileResponse;
+}
+
+export interface AutoReplyResponse {
+  barber_id?: number;
+  created_at?: string;
+  enabled?: boolean;
+  /** May use {{placeholders}}, see config.AutoReplyVariables */
+  message?: string;
+  /** In the barber's timezone */
+  next_open_time?: string;
+  /** Whether the barber is working now (no reply is sent) */
+  open_now?: boolean;
+  /** Rendered for a sample booking */
+  preview?: string;
+  updated_at?: string;
+  /** Placeholders the message may use */
+  variables?: string[];
+}
+
+export interface BarberResponseRequest {
+  response: string;
+}
+
+export interface BookingFormFieldRequest {
+  /** Ask only when this service is booked; it must require a consultation */
+  barber_service_id?: number;
+  /** Answers that need a consultation first (select and checkbox questions on a service; checkboxes answer "true" or "false") */
+  consultation_answers?: string[];
+  display_order?: number;
+  /** text, number, select, checkbox */
+  field_type: string;
+  help_text?: string;
+  /** Default: true */
+  is_active?: boolean;
+  is_required?: boolean;
+  key: string;
+  label: string;
+  /** Text fields (default 500) */
+  max_length?: number;
+  /** Number fields */
+  max_value?: number;
+  /** Number fields */
+  min_value?: number;
+  /** Select fields: the choices */
+  options?: string[];
+  /** Text fields; the whole answer must match */
+  pattern?: string;
+}
+
+export interface BookingImportResult {
+  created?: number;
+  duplicates?: number;
+  failed?: number;
+  /** Created bookings linked to a customer account */
+  linked?: number;
+  rows?: BookingImportRowResult[];
+  total?: number;
+}
+
+export interface BookingImportRowResult {
+  booking_id?: number;
+  /** The account the booking was linked to */
+  customer_id?: number;
+  error?: string;
+  line?: number;
+  /** created, duplicate, failed */
+  status?: string;
+}
+
+export interface BookingResponse {
+  actual_end_time?: string;
+  actual_start_time?: string;
+  /** ArchivedAt is set for bookings read from the archive */
+  archived_at?: string;
+  barber?: BarberSummary;
+  barber_id?: number;
+  /** Service information */
+  barber_service_id?: number;
+  /** Human-readable reference */
+  booking_number?: string;
+  /** Source and attribution */
+  booking_source?: string;
+  can_cancel?: boolean;
+  can_reschedule?: boolean;
+  cancellation_fee?: number;
+  /** Free text */
+  cancellation_reason?: string;
+  cancellation_reason_code?: string;
+  /** Cancellation information */
+  cancelled_at?: string;
+  cancelled_by?: number;
+  /** Communication tracking */
+  confirmation_method?: string;
+  confirmation_sent_at?: string;
+  /** Audit fields */
+  created_at?: string;
+  currency?: string;
+  /** Answers to the barber's custom booking-form fields */
+  custom_fields?: BookingFormAnswer[];
+  /** Relations attached to lists on request (see relations.go) */
+  customer?: unknown;
+  customer_email?: string;
+  /** Relationships */
+  customer_id?: number;
+  /** Customer information (for guest bookings) */
+  customer_name?: string;
+  customer_phone?: string;
+  /** Deposit held when booking and the fee kept from it on a no-show */
+  deposit_amount?: number;
+  discount_amount?: number;
+  /** Active/passive split copied from the service (nil = fully active) */
+  duration_segments?: DurationSegment[];
+  estimated_duration_minutes?: number;
+  id?: number;
+  /** Row an imported booking came from */
+  import_ref?: string;
+  /** For barber use only */
+  internal_notes?: string;
+  /** Created in sandbox mode */
+  is_test?: boolean;
+  /** The services performed, in order (populated when needed) */
+  line_items?: BookingLineItem[];
+  /** One of the barber's locations (nil = the barber's address) */
+  location_id?: number;
+  no_show_fee?: number;
+  /** Booking details */
+  notes?: string;
+  paid_at?: string;
+  payment_method?: string;
+  payment_reference?: string;
+  /** Payment information */
+  payment_status?: string;
+  referral_source?: string;
+  /** Refund is set when cancelling a prepaid booking */
+  refund?: unknown;
+  reminder_sent_at?: string;
+  review?: Review;
+  scheduled_end_time?: string;
+  /** Timing */
+  scheduled_start_time?: string;
+  /** Set for occurrences of a recurring booking */
+  series_id?: number;
+  service?: ServiceSummary;
+  service_category?: string;
+  service_name?: string;
+  /** Pricing breakdown */
+  service_price?: number;
+  special_requests?: string;
+  /** Booking status */
+  status?: string;
+  tax_amount?: number;
+  /** Tax line items behind TaxAmount (populated when needed) */
+  tax_lines?: BookingTaxLine[];
+  time_slot?: TimeSlot;
+  time_slot_id?: number;
+  time_until?: string;
+  tip_amount?: number;
+  total_price?: number;
+  updated_at?: string;
+  utm_campaign?: string;
+  uuid?: string;
+}
+
+export interface BookingStaffRequest {
+  assistants?: AssistantShare[];
+}
+
+export interface BookingStaffResponse {
+  booking_id?: number;
+  staff?: BookingStaff[];
+}
+
+export interface BroadcastOpenSlotRequest {
+  /** favorites, nearby (default: both) */
+  audiences?: string[];
+  barber_service_id: number;
+  /** Flash discount, at most 50 */
+  discount_percent?: number;
+  /** Default: the service's duration */
+  duration_minutes?: number;
+  message?: string;
+  /** Nearby audience (default 5, at most 50) */
+  radius_km?: number;
+  start_time: string;
+}
+
+export interface BulkModerationItemResult {
+  error?: string;
+  review_id?: number;
+  /** moderated, failed */
+  status?: string;
+}
+
+export interface BulkModerationRequest {
+  action: string;
+  notes?: string;
+  reason?: string;
+  review_ids: number[];
+}
+
+export interface BulkModerationResult {
+  action?: string;
+  failed?: number;
+  moderated?: number;
+  reviews?: BulkModerationItemResult[];
+  total?: number;
+}
+
+export interface CalendarFeedResponse {
+  created_at?: string;
+  id?: number;
+  /** Last fetched by a calendar app */
+  last_accessed_at?: string;
+  updated_at?: string;
+  url?: string;
+  user_id?: number;
+  /** Opens the subscribe dialog of Apple Calendar */
+  webcal_url?: string;
+}
+
+export interface CancelBookingRequest {
+  is_by_customer?: boolean;
+  /** Free text (optional) */
+  reason?: string;
+  /** One of the cancellation reasons (optional) */
+  reason_code?: string;
+}
+
+export interface CancellationReason {
+  code?: string;
+  label?: string;
+}
+
+export interface ChangePasswordRequest {
+  confirm_password: string;
+  new_password: string;
+  old_password: string;
+}
+
+export interface CheckoutRequest {
+  /** Optional extras from the booked services' add-on catalogs */
+  add_on_ids?: number[];
+  /** Required fields */
+  barber_id?: number;
+  /** mobile_app, web_app, phone, walk_in */
+  booking_source?: string;
+  /** Replaces discount_amount; single bookings only */
+  coupon_code?: string;
+  /** Answers to the barber's custom booking-form fields (key -> value) */
+  custom_fields?: Record<string, unknown>;
+  customer_email?: string;
+  /** Customer info (either customer_id OR guest info) */
+  customer_id?: number;
+  customer_name?: string;
+  customer_phone?: string;
+  /** Provider token for the card holding the deposit, when the services require one (e.g. a Stripe pm_... id) */
+  deposit_payment_method_id?: string;
+  discount_amount?: number;
+  /** Default: the services' and add-ons' combined duration */
+  duration_minutes?: number;
+  /** One of the barber's locations (default: the barber's address) */
+  location_id?: number;
+  /** Optional fields */
+  notes?: string;
+  /** Provider token for the customer's card (e.g. a Stripe pm_... id) */
+  payment_method_id: string;
+  /** Recurrence (optional - creates a standing appointment series) */
+  recurrence?: unknown;
+  /** Services: service_id for one, or service_ids for several performed back to back, in order */
+  service_id?: number;
+  service_ids?: number[];
+  /** Pricing (optional - will be calculated if not provided) */
+  service_price?: number;
+  /** Instead of barber_id: book any available barber of the shop. The services are then catalog services (services.id), not a barber's. */
+  shop_id?: number;
+  special_requests?: string;
+  start_time: string;
+}
+
+export interface ClaimAccountRequest {
+  password: string;
+  token: string;
+}
+
+export interface ClaimOpenSlotRequest {
+  custom_fields?: Record<string, unknown>;
+  deposit_payment_method_id?: string;
+  notes?: string;
+}
+
+export interface ClientImportResult {
+  created?: number;
+  duplicates?: number;
+  failed?: number;
+  invited?: number;
+  linked?: number;
+  rows?: ClientImportRowResult[];
+  total?: number;
+}
+
+export interface ClientImportRowResult {
+  client_id?: number;
+  error?: string;
+  invited?: boolean;
+  line?: number;
+  name?: string;
+  /** created, linked, duplicate, failed */
+  status?: string;
+  user_id?: number;
+}
+
+export interface CompleteBookingRequest {
+  /** Required when service_price changes the price */
+  reason?: string;
+  /** Price of the work done, before discount and tax */
+  service_price?: number;
+}
+
+export interface ComponentDay {
+  /** YYYY-MM-DD */
+  date?: string;
+  /** The worst incident impact that day */
+  status?: string;
+  uptime_percent?: number;
+}
+
+export interface ComponentReport {
+  checked_at?: string;
+  component?: string;
+  /** Oldest first, ending today (UTC) */
+  history?: ComponentDay[];
+  name?: string;
+  status?: string;
+  /** Over the whole history */
+  uptime_percent?: number;
+}
+
+export interface ConfirmationResponseResult {
+  booking_id?: number;
+  booking_status?: string;
+  /** Set when cancelling a prepaid booking */
+  refund?: unknown;
+  responded_at?: string;
+  /** confirmed, cancelled */
+  response?: string;
+}
+
+export interface ConsultationQuoteRequest {
+  notes?: string;
+  price?: number;
+  start_time: string;
+}
+
+export interface ConsultationResponseRequest {
+  notes?: string;
+  status: string;
+}
+
+export interface CreateBarberRequest {
+  address: string;
+  address_line_2?: string;
+  business_email?: string;
+  business_name?: string;
+  business_registration_number?: string;
+  certifications?: string[];
+  city: string;
+  country: string;
+  description?: string;
+  languages_spoken?: string[];
+  latitude?: number;
+  longitude?: number;
+  phone?: string;
+  postal_code: string;
+  shop_name: string;
+  specialties?: string[];
+  state: string;
+  tax_id?: string;
+  user_id: number;
+  website_url?: string;
+  working_hours?: JSONMap;
+  years_experience?: number;
+}
+
+export interface CreateBarberServiceRequest {
+  advance_notice_hours?: number;
+  available_days?: string[];
+  available_time_slots?: JSONMap;
+  barber_id: number;
+  before_after_images?: string[];
+  buffer_time_minutes?: number;
+  consultation_duration?: number;
+  currency?: string;
+  custom_description?: string;
+  custom_name?: string;
+  /** percentage, fixed; nil = no deposit */
+  deposit_type?: string;
+  /** Percent of the price, or an amount */
+  deposit_value?: number;
+  discount_price?: number;
+  discount_valid_until?: string;
+  display_order?: number;
+  /** Active/passive split adding up to estimated_duration_min */
+  duration_segments?: DurationSegment[];
+  estimated_duration_max?: number;
+  estimated_duration_min: number;
+  is_featured?: boolean;
+  is_promotional?: boolean;
+  is_seasonal?: boolean;
+  max_advance_booking_days?: number;
+  max_customer_age?: number;
+  max_price?: number;
+  min_customer_age?: number;
+  /** Kept from the deposit on a no-show; nil = the whole deposit */
+  no_show_fee?: number;
+  portfolio_images?: string[];
+  post_service_care?: string;
+  pre_service_instructions?: string;
+  price: number;
+  promotion_end_date?: string;
+  promotion_start_date?: string;
+  promotional_text?: string;
+  requires_consultation?: boolean;
+  seasonal_end_month?: number;
+  seasonal_start_month?: number;
+  service_id: number;
+  service_note?: string;
+}
+
+export interface CreateBookingRequest {
+  /** Optional extras from the booked services' add-on catalogs */
+  add_on_ids?: number[];
+  /** Required fields */
+  barber_id?: number;
+  /** mobile_app, web_app, phone, walk_in */
+  booking_source?: string;
+  /** Replaces discount_amount; single bookings only */
+  coupon_code?: string;
+  /** Answers to the barber's custom booking-form fields (key -> value) */
+  custom_fields?: Record<string, unknown>;
+  customer_email?: string;
+  /** Customer info (either customer_id OR guest info) */
+  customer_id?: number;
+  customer_name?: string;
+  customer_phone?: string;
+  /** Provider token for the card holding the deposit, when the services require one (e.g. a Stripe pm_... id) */
+  deposit_payment_method_id?: string;
+  discount_amount?: number;
+  /** Default: the services' and add-ons' combined duration */
+  duration_minutes?: number;
+  /** One of the barber's locations (default: the barber's address) */
+  location_id?: number;
+  /** Optional fields */
+  notes?: string;
+  /** Recurrence (optional - creates a standing appointment series) */
+  recurrence?: unknown;
+  /** Services: service_id for one, or service_ids for several performed back to back, in order */
+  service_id?: number;
+  service_ids?: number[];
+  /** Pricing (optional - will be calculated if not provided) */
+  service_price?: number;
+  /** Instead of barber_id: book any available barber of the shop. The services are then catalog services (services.id), not a barber's. */
+  shop_id?: number;
+  special_requests?: string;
+  start_time: string;
+}
+
+export interface CreateCategoryRequest {
+  category_path?: string;
+  color_hex?: string;
+  description?: string;
+  icon_url?: string;
+  image_url?: string;
+  is_featured?: boolean;
+  keywords?: string[];
+  level?: number;
+  meta_description?: string;
+  meta_title?: string;
+  name: string;
+  parent_category_id?: number;
+  slug?: string;
+  sort_order?: number;
+}
+
+export interface CreateExperimentRequest {
+  description?: string;
+  key: string;
+  name: string;
+  /** The first is the control */
+  variants: Variant[];
+}
+
+export interface CreateIncidentRequest {
+  component: string;
+  impact: string;
+  message?: string;
+  /** Defaults to now */
+  started_at?: string;
+  title: string;
+}
+
+export interface CreateNotificationRequest {
+  /** Optional fields */
+  channels?: string[];
+  data?: Record<string, unknown>;
+  expires_at?: string;
+  message: string;
+  priority?: string;
+  related_entity_id?: number;
+  related_entity_type?: string;
+  scheduled_for?: string;
+  /** YYYY-MM-DDTHH:MM in the recipient's timezone, instead of scheduled_for */
+  scheduled_local?: string;
+  title: string;
+  type: string;
+  user_id: number;
+}
+
+export interface CreateReviewRequest {
+  booking_id: number;
+  cleanliness_rating?: number;
+  comment?: string;
+  cons?: string;
+  duration_accurate?: boolean;
+  /** Media */
+  images?: string[];
+  /** Ratings (required: overall, optional: detailed) */
+  overall_rating: number;
+  professionalism_rating?: number;
+  pros?: string;
+  punctuality_rating?: number;
+  service_as_expected?: boolean;
+  service_quality_rating?: number;
+  /** Content */
+  title?: string;
+  value_for_money_rating?: number;
+  would_book_again?: boolean;
+  /** Feedback */
+  would_recommend?: boolean;
+}
+
+export interface CreateRoleRequest {
+  description?: string;
+  name: string;
+  permissions: string[];
+}
+
+export interface CreateScheduleExceptionRequest {
+  /** Defaults to start_date */
+  end_date?: string;
+  /** custom_hours only */
+  end_time?: string;
+  exception_type: string;
+  reason?: string;
+  start_date: string;
+  /** custom_hours only */
+  start_time?: string;
+}
+
+export interface CreateServiceRequest {
+  allergen_warnings?: string[];
+  allows_add_ons?: boolean;
+  category_id: number;
+  complexity: number;
+  created_by?: number;
+  currency?: string;
+  default_duration_max?: number;
+  default_duration_min: number;
+  detailed_description?: string;
+  gallery_images?: string[];
+  hair_types?: string[];
+  has_variations?: boolean;
+  health_precautions?: string[];
+  image_url?: string;
+  meta_description?: string;
+  name: string;
+  required_certifications?: string[];
+  required_products?: string[];
+  required_tools?: string[];
+  requires_consultation?: boolean;
+  requires_health_check?: boolean;
+  search_keywords?: string[];
+  service_type: string;
+  short_description: string;
+  skill_level_required?: string;
+  slug?: string;
+  suggested_price_max?: number;
+  suggested_price_min?: number;
+  tags?: string[];
+  target_age_max?: number;
+  target_age_min?: number;
+  target_gender?: string;
+  video_url?: string;
+}
+
+export interface CreateSupportTicketRequest {
+  /** One of your bookings */
+  booking_id?: number;
+  category: string;
+  description: string;
+  /** Default: the booking's payment reference */
+  payment_reference?: string;
+  subject: string;
+}
+
+export interface CreateSuppressionRequest {
+  address: string;
+  channel: string;
+  detail?: string;
+  /** Default: manual */
+  reason?: string;
+}
+
+export interface CreateTimeOffRequest {
+  end_at?: string;
+  /** Inclusive; defaults to start_date */
+  end_date?: string;
+  /** Ask affected customers to move their bookings */
+  offer_reschedule?: boolean;
+  reason?: string;
+  /** Exact range instead of dates */
+  start_at?: string;
+  /** Whole days in the barber's timezone */
+  start_date?: string;
+}
+
+export interface CreateWebhookRequest {
+  description?: string;
+  events: string[];
+  url: string;
+}
+
+export interface CreatedWebhookResponse {
+  barber_id?: number;
+  created_at?: string;
+  created_by?: number;
+  description?: string;
+  events?: string[];
+  id?: number;
+  is_active?: boolean;
+  secret?: string;
+  updated_at?: string;
+  url?: string;
+}
+
+export interface FeaturedAvailability {
+  /** A slot is free on every day */
+  available?: boolean;
+  category_id?: number;
+  city?: string;
+  currency?: string;
+  daily_price?: number;
+  days?: FeaturedSlotDay[];
+  /** Concurrent placements allowed per day */
+  limit?: number;
+  total_price?: number;
+}
+
+export interface InventoryItemRequest {
+  low_stock_threshold?: number;
+  name: string;
+  quantity?: number;
+  unit?: string;
+  /** Default: 1 */
+  usage_per_service?: number;
+}
+
+export interface LinkIdentityRequest {
+  id_token: string;
+  nonce?: string;
+}
+
+export interface LocationRequest {
+  address?: string;
+  city?: string;
+  name: string;
+}
+
+export interface LoginRequest {
+  email: string;
+  password: string;
+}
+
+export interface LogoutRequest {
+  /** Ends every session of the user */
+  all_devices?: boolean;
+  /** Ends this session */
+  refresh_token?: string;
+}
+
+export interface ModerateReviewRequest {
+  notes?: string;
+  status: string;
+}
+
+export interface ModerationActionRequest {
+  notes?: string;
+  /** Reason template key; required to reject, flag or redact */
+  reason?: string;
+}
+
+export interface ModerationReason {
+  /** Actions the reason applies to */
+  actions?: string[];
+  key?: string;
+  label?: string;
+  message?: string;
+}
+
+export interface NpsResponseRequest {
+  comment?: string;
+  score: number;
+}
+
+export interface NpsResponseResult {
+  booking_id?: number;
+  /** promoter, passive, detractor */
+  category?: string;
+  /** The answer can be changed until then */
+  expires_at?: string;
+  responded_at?: string;
+  score?: number;
+}
+
+export interface NotificationPreferenceResponse {
+  /** The channels it goes out on; empty = off */
+  channels?: string[];
+  /** Channels chosen by the user */
+  customized?: boolean;
+  /** The channels without a preference */
+  default_channels?: string[];
+  type?: string;
+}
+
+export interface NotificationResponse {
+  /** Background delivery */
+  attempts?: number;
+  /** Barber attached to lists on request (see relations.go) */
+  barber?: unknown;
+  /** Delivery channels and status */
+  channels?: string[];
+  created_at?: string;
+  /** Notification data and settings */
+  data?: unknown;
+  delivered_at?: string;
+  expires_at?: string;
+  id?: number;
+  is_expired?: boolean;
+  is_read?: boolean;
+  last_error?: string;
+  message?: string;
+  next_attempt_at?: string;
+  /** low, normal, high, urgent */
+  priority?: string;
+  read_at?: string;
+  related_entity_id?: number;
+  /** Related entities */
+  related_entity_type?: string;
+  /** Scheduling */
+  scheduled_for?: string;
+  /** Delivery tracking */
+  sent_at?: string;
+  /** pending, sent, delivered, read, failed */
+  status?: string;
+  time_ago?: string;
+  title?: string;
+  /** booking_confirmation, booking_reminder, booking_cancelled, etc. */
+  type?: string;
+  /** Relations */
+  user?: unknown;
+  user_id?: number;
+}
+
+export interface NotificationTemplateInfo {
+  key?: string;
+  message?: string;
+  sms?: boolean;
+  title?: string;
+  type?: string;
+  variables?: TemplateVariable[];
+}
+
+export interface OpenSlotResponse {
+  /** favorites, nearby */
+  audiences?: string[];
+  barber_id?: number;
+  barber_service_id?: number;
+  booking_id?: number;
+  claimed_at?: string;
+  claimed_by?: number;
+  claims_close_at?: string;
+  created_at?: string;
+  created_by?: number;
+  /** Flash discount, 0 for none */
+  discount_percent?: number;
+  duration_minutes?: number;
+  id?: number;
+  is_claimable?: boolean;
+  message?: string;
+  /** After the flash discount, before tax */
+  price?: number;
+  /** Nearby audience only */
+  radius_km?: number;
+  /** Customers notified */
+  recipient_count?: number;
+  service_name?: string;
+  /** Before the flash discount */
+  service_price?: number;
+  start_time?: string;
+  /** open, claimed, cancelled */
+  status?: string;
+  updated_at?: string;
+}
+
+export interface PortfolioCaptionRequest {
+  /** Null or empty clears it */
+  caption?: string;
+}
+
+export interface PortfolioOrderRequest {
+  kind: string;
+  /** Every photo of the kind, in the new order */
+  media_ids: number[];
+}
+
+export interface PurchaseFeaturedRequest {
+  /** Omit for city-wide placement */
+  category_id?: number;
+  /** YYYY-MM-DD, inclusive */
+  ends_on: string;
+  payment_method_id: string;
+  /** YYYY-MM-DD */
+  starts_on: string;
+}
+
+export interface RecordConversionRequest {
+  event: string;
+  value?: number;
+}
+
+export interface RecurrenceRequest {
+  frequency: string;
+  occurrences: number;
+}
+
+export interface RefreshTokenRequest {
+  refresh_token: string;
+}
+
+export interface RefundResult {
+  /** Refunded to the customer */
+  amount?: number;
+  /** Kept by the shop */
+  cancellation_fee?: number;
+  error?: string;
+  /** 0-100 */
+  percent?: number;
+  reason?: string;
+  /** Provider refund reference, when one is created */
+  reference?: string;
+  status?: string;
+}
+
+export interface RegisterDeviceRequest {
+  app_version?: string;
+  platform: string;
+  token: string;
+}
+
+export interface RegisterRequest {
+  email: string;
+  name: string;
+  password: string;
+  phone?: string;
+  user_type?: string;
+}
+
+export interface ReportReviewRequest {
+  details?: string;
+  reason: string;
+}
+
+export interface RescheduleBookingRequest {
+  duration_minutes?: number;
+  new_start_time: string;
+  reason?: string;
+}
+
+export interface RescheduleSeriesRequest {
+  duration_minutes?: number;
+  new_start_time: string;
+  reason?: string;
+}
+
+export interface ReviewLinkInfo {
+  barber_id?: number;
+  booking_id?: number;
+  booking_number?: string;
+  expires_at?: string;
+  scheduled_start_time?: string;
+  service_name?: string;
+}
+
+export interface ReviewResponse {
+  average_rating?: number;
+  barber?: BarberSummary;
+  barber_id?: number;
+  barber_name?: string;
+  /** Barber response. BarberResponse is the live response; a response awaiting moderation, rejected or redacted is PendingBarberResponse. */
+  barber_response?: string;
+  barber_response_at?: string;
+  /** pending, approved, rejected, flagged, redacted */
+  barber_response_status?: string;
+  booking?: Booking;
+  /** One review per booking */
+  booking_id?: number;
+  can_edit?: boolean;
+  can_respond?: boolean;
+  cleanliness_rating?: number;
+  comment?: string;
+  cons?: string;
+  created_at?: string;
+  /** Relations attached to lists on request (see relations.go) */
+  customer?: unknown;
+  customer_id?: number;
+  customer_name?: string;
+  duration_accurate?: boolean;
+  /** Community interaction */
+  helpful_votes?: number;
+  helpfulness_ratio?: number;
+  id?: number;
+  /** Media attachments */
+  images?: string[];
+  is_positive?: boolean;
+  is_published?: boolean;
+  /** Verification and moderation */
+  is_verified?: boolean;
+  moderated_at?: string;
+  moderated_by?: number;
+  moderation_notes?: string;
+  /** pending, approved, rejected, flagged */
+  moderation_status?: string;
+  /** Overall and detailed ratings (1-5 scale) */
+  overall_rating?: number;
+  /** The barber's response as moderation sees it (the barber and moderators only; see review_response_moderation.go) */
+  pending_response?: string;
+  professionalism_rating?: number;
+  pros?: string;
+  punctuality_rating?: number;
+  /** User reports since the review was last moderated */
+  report_count?: number;
+  response_moderation_notes?: string;
+  service_as_expected?: boolean;
+  service_quality_rating?: number;
+  /** Review content */
+  title?: string;
+  total_votes?: number;
+  updated_at?: string;
+  value_for_money_rating?: number;
+  would_book_again?: boolean;
+  /** Additional feedback */
+  would_recommend?: boolean;
+}
+
+export interface RunWinBackRequest {
+  coupon_percent_off?: number;
+  /** List who would be messaged without sending */
+  dry_run?: boolean;
+  inactive_weeks?: number;
+}
+
+export interface SchedulePeriodInput {
+  day_of_week?: number;
+  end_time: string;
+  label?: string;
+  /** work (default) or break */
+  period_type?: string;
+  start_time: string;
+}
+
+export interface SendBookingNotificationRequest {
+  booking_id: number;
+  custom_message?: string;
+  notification_type: string;
+}
+
+export interface SetAPIQuotaRequest {
+  note?: string;
+  requests_per_minute: number;
+}
+
+export interface SetFeaturedSlotLimitRequest {
+  /** Omit for the city-wide market */
+  category_id?: number;
+  city: string;
+  max_slots: number;
+}
+
+export interface ShopOffboardingRequest {
+  reason?: string;
+}
+
+export interface ShopRequest {
+  address?: string;
+  address_line_2?: string;
+  city?: string;
+  country?: string;
+  description?: string;
+  email?: string;
+  latitude?: number;
+  longitude?: number;
+  name: string;
+  /** Hours of barbers without a schedule */
+  opening_hours?: ShopHoursPeriod[];
+  phone?: string;
+  postal_code?: string;
+  state?: string;
+  tax_id?: string;
+  /** Default: UTC */
+  timezone?: string;
+}
+
+export interface SocialLoginRequest {
+  id_token: string;
+  /** For new accounts (Apple only shares it with the client) */
+  name?: string;
+  /** Checked against the token's nonce claim when set */
+  nonce?: string;
+}
+
+export interface StatusPage {
+  components?: ComponentReport[];
+  /** Ongoing, or resolved within the history window; newest first */
+  incidents?: StatusIncident[];
+  /** The worst component status */
+  status?: string;
+  updated_at?: string;
+}
+
+export interface SubmitConsultationRequest {
+  barber_service_id: number;
+  notes?: string;
+  /** Up to 5, all in the future */
+  preferred_times: string[];
+}
+
+export interface SupportReplyRequest {
+  body: string;
+  /** Staff only: a note the requester does not see */
+  is_internal?: boolean;
+  /** Staff only: move the ticket with the reply */
+  status?: string;
+}
+
+export interface SupportTicketResponse {
+  assigned_to?: number;
+  booking_id?: number;
+  /** booking, payment, account, other */
+  category?: string;
+  closed_at?: string;
+  created_at?: string;
+  first_responded_at?: string;
+  first_response_breached_at?: string;
+  first_response_due_at?: string;
+  first_response_overdue?: boolean;
+  id?: number;
+  /** Loaded with the ticket when viewing it */
+  messages?: SupportTicketMessage[];
+  payment_reference?: string;
+  /** low, normal, high, urgent */
+  priority?: string;
+  requester_id?: number;
+  resolution_breached_at?: string;
+  resolution_due_at?: string;
+  resolution_overdue?: boolean;
+  resolved_at?: string;
+  sla_paused?: boolean;
+  sla_paused_at?: string;
+  /** open, in_progress, waiting_on_customer, resolved, closed */
+  status?: string;
+  subject?: string;
+  updated_at?: string;
+}
+
+export interface TaxRuleRequest {
+  barber_id?: number;
+  country?: string;
+  /** Prices already include the tax */
+  inclusive?: boolean;
+  /** Default: true */
+  is_active?: boolean;
+  name: string;
+  rate_percent?: number;
+  state?: string;
+}
+
+export interface TemplateVariable {
+  description?: string;
+  example?: string;
+  name?: string;
+  placeholder?: string;
+}
+
+export interface TimeOffResponse {
+  affected_bookings?: AffectedBooking[];
+  /** Created from whole dates */
+  all_day?: boolean;
+  barber_id?: number;
+  created_at?: string;
+  created_by?: number;
+  /** Exclusive */
+  end_at?: string;
+  id?: number;
+  reason?: string;
+  start_at?: string;
+}
+
+export interface TimelineResponse {
+  entries?: TimelineEntry[];
+  /** True when this request refreshed the projection */
+  rebuilt?: boolean;
+  rebuilt_at?: string;
+}
+
+export interface TravelTimesRequest {
+  travel_times?: TravelTime[];
+}
+
+export interface UnreadCountResponse {
+  changed?: boolean;
+  unread_count?: number;
+}
+
+export interface UpdateAutoReplyRequest {
+  enabled?: boolean;
+  /** Default: config.DefaultAutoReplyMessage */
+  message?: string;
+}
+
+export interface UpdateBarberRequest {
+  address?: string;
+  address_line_2?: string;
+  business_email?: string;
+  business_name?: string;
+  certifications?: string[];
+  city?: string;
+  country?: string;
+  cover_image_url?: string;
+  description?: string;
+  gallery_images?: string[];
+  languages_spoken?: string[];
+  /** Minutes after the start time a never-started booking becomes a no-show */
+  no_show_grace_minutes?: number;
+  phone?: string;
+  postal_code?: string;
+  profile_image_url?: string;
+  shop_name?: string;
+  specialties?: string[];
+  state?: string;
+  website_url?: string;
+  working_hours?: JSONMap;
+  years_experience?: number;
+}
+
+export interface UpdateBarberServiceRequest {
+  advance_notice_hours?: number;
+  available_days?: string[];
+  buffer_time_minutes?: number;
+  currency?: string;
+  custom_description?: string;
+  custom_name?: string;
+  /** Empty = no deposit (clears the value and no-show fee) */
+  deposit_type?: string;
+  deposit_value?: number;
+  discount_price?: number;
+  discount_valid_until?: string;
+  display_order?: number;
+  /** Empty list = fully active */
+  duration_segments?: DurationSegment[];
+  estimated_duration_max?: number;
+  estimated_duration_min?: number;
+  is_active?: boolean;
+  is_featured?: boolean;
+  is_promotional?: boolean;
+  max_advance_booking_days?: number;
+  max_price?: number;
+  no_show_fee?: number;
+  portfolio_images?: string[];
+  price?: number;
+  promotional_text?: string;
+  service_note?: string;
+}
+
+export interface UpdateBookingRequest {
+  /** Replaces the answers to the barber's custom booking-form fields */
+  custom_fields?: Record<string, unknown>;
+  customer_email?: string;
+  customer_name?: string;
+  customer_phone?: string;
+  internal_notes?: string;
+  notes?: string;
+  special_requests?: string;
+}
+
+export interface UpdateCategoryRequest {
+  color_hex?: string;
+  description?: string;
+  icon_url?: string;
+  image_url?: string;
+  is_active?: boolean;
+  is_featured?: boolean;
+  name?: string;
+  slug?: string;
+  sort_order?: number;
+}
+
+export interface UpdateExperimentRequest {
+  description?: string;
+  name?: string;
+  variants?: Variant[];
+}
+
+export interface UpdateExperimentStatusRequest {
+  status: string;
+  /** Variant served to everyone once stopped (default: the control) */
+  winner?: string;
+}
+
+export interface UpdateIncidentRequest {
+  impact?: string;
+  message?: string;
+  /** true resolves the incident now, false reopens it */
+  resolved?: boolean;
+  title?: string;
+}
+
+export interface UpdateNotificationPreferencesRequest {
+  preferences: Record<string, unknown>;
+}
+
+export interface UpdateProfileRequest {
+  address?: string;
+  city?: string;
+  country?: string;
+  date_of_birth?: string;
+  gender?: string;
+  name?: string;
+  /** NotificationSettings opts in or out of delivery channels (email, sms, push); omitted channels keep their current setting */
+  notification_settings?: Record<string, unknown>;
+  phone?: string;
+  postal_code?: string;
+  preferences?: Record<string, unknown>;
+  profile_picture_url?: string;
+  state?: string;
+}
+
+export interface UpdateReviewRequest {
+  cleanliness_rating?: number;
+  comment?: string;
+  cons?: string;
+  duration_accurate?: boolean;
+  images?: string[];
+  overall_rating?: number;
+  professionalism_rating?: number;
+  pros?: string;
+  punctuality_rating?: number;
+  service_as_expected?: boolean;
+  service_quality_rating?: number;
+  title?: string;
+  value_for_money_rating?: number;
+  would_book_again?: boolean;
+  would_recommend?: boolean;
+}
+
+export interface UpdateRoleRequest {
+  description?: string;
+  permissions: string[];
+}
+
+export interface UpdateScheduleRequest {
+  periods: SchedulePeriodInput[];
+  /** Defaults to UTC */
+  timezone?: string;
+}
+
+export interface UpdateServiceRequest {
+  allows_add_ons?: boolean;
+  category_id?: number;
+  complexity?: number;
+  currency?: string;
+  default_duration_max?: number;
+  default_duration_min?: number;
+  detailed_description?: string;
+  gallery_images?: string[];
+  hair_types?: string[];
+  has_variations?: boolean;
+  image_url?: string;
+  is_active?: boolean;
+  last_modified_by?: number;
+  name?: string;
+  required_products?: string[];
+  required_tools?: string[];
+  requires_consultation?: boolean;
+  search_keywords?: string[];
+  service_type?: string;
+  short_description?: string;
+  skill_level_required?: string;
+  slug?: string;
+  suggested_price_max?: number;
+  suggested_price_min?: number;
+  tags?: string[];
+  target_gender?: string;
+}
+
+export interface UpdateStatusRequest {
+  status: string;
+}
+
+export interface UpdateSupportTicketRequest {
+  /** A staff member holding support:manage; 0 unassigns */
+  assigned_to?: number;
+  priority?: string;
+  status?: string;
+}
+
+export interface UpdateWebhookRequest {
+  description?: string;
+  events?: string[];
+  is_active?: boolean;
+  url?: string;
+}
+
+export interface UserProfileResponse {
+  address?: string;
+  city?: string;
+  country?: string;
+  created_at?: string;
+  date_of_birth?: string;
+  email?: string;
+  email_verified?: boolean;
+  gender?: string;
+  id?: number;
+  last_login_at?: string;
+  name?: string;
+  notification_settings?: Record<string, unknown>;
+  phone?: string;
+  phone_verified?: boolean;
+  postal_code?: string;
+  preferences?: Record<string, unknown>;
+  profile_picture_url?: string;
+  state?: string;
+  status?: string;
+  user_type?: string;
+  uuid?: string;
+}
+
+export interface ValidateTemplateRequest {
+  key: string;
+  message: string;
+  title?: string;
+}
+
+export interface ValidateTemplateResult {
+  key?: string;
+  /** In order of first use */
+  variables?: string[];
+}
+
+export interface VariantAssignment {
+  experiment?: string;
+  /** false when the experiment is not running */
+  exposed?: boolean;
+  variant?: string;
+}
+
+export interface VoteReviewRequest {
+  is_helpful?: boolean;
+}
+
+export interface WinBackRunResult {
+  /** nil on dry runs */
+  campaign?: unknown;
+  /** Dry runs only */
+  candidates?: WinBackCandidate[];
+  dry_run?: boolean;
+  eligible?: number;
+  sent?: number;
+}
+
+// ============================================
+// Query Parameters
+// ============================================
+
+export interface GetMyActivityTimelineParams {
+  event_types?: string[];
+  limit?: number;
+  offset?: number;
+}
+
+export interface GetPlatformActivityFeedParams {
+  actor_id?: number;
+  actor_type?: string;
+  impersonator_id?: number;
+  impersonated?: boolean;
+  entity_type?: string;
+  entity_id?: number;
+  action?: string;
+  created_from?: string;
+  created_to?: string;
+  before_id?: number;
+  limit?: number;
+}
+
+export interface ExportAnonymizedBookingDatasetParams {
+  from?: string;
+  to?: string;
+  k?: number;
+  price_step?: number;
+  granularity?: string;
+}
+
+export interface GetCustomersActivityTimelineAdminParams {
+  event_types?: string[];
+  limit?: number;
+  offset?: number;
+}
+
+export interface ListExperimentsParams {
+  status?: string;
+  limit?: number;
+  offset?: number;
+}
+
+export interface ExperimentConversionReportParams {
+  metric?: string;
+  window_days?: number;
+}
+
+export interface ListFeaturedPlacementsParams {
+  city?: string;
+  status?: string;
+  limit?: number;
+  offset?: number;
+}
+
+export interface ExportFinancialLedgerParams {
+  from?: string;
+  to?: string;
+}
+
+export interface GetPlatformWideNpsTrendAdminParams {
+  from?: string;
+  to?: string;
+  interval?: string;
+}
+
+export interface ReviewModerationQueueParams {
+  moderation_status?: string;
+  barber_id?: number;
+  min_reports?: number;
+  sort_by?: string;
+  order?: string;
+  limit?: number;
+  offset?: number;
+  include_customer?: boolean;
+  include_barber?: boolean;
+}
+
+export interface ReviewResponseModerationQueueParams {
+  response_status?: string;
+  barber_id?: number;
+  sort_by?: string;
+  order?: string;
+  limit?: number;
+  offset?: number;
+  include_customer?: boolean;
+  include_barber?: boolean;
+}
+
+export interface ListSupportTicketsParams {
+  status?: string;
+  priority?: string;
+  category?: string;
+  assigned_to?: number;
+  breached?: boolean;
+  limit?: number;
+  offset?: number;
+}
+
+export interface ListSuppressedAddressesParams {
+  channel?: string;
+  reason?: string;
+  address?: string;
+  limit?: number;
+  offset?: number;
+}
+
+export interface GetTaxReportParams {
+  from?: string;
+  to?: string;
+  barber_id?: number;
+}
+
+export interface ListTopAPIConsumersParams {
+  from?: string;
+  to?: string;
+  limit?: number;
+}
+
+export interface GetConsumersAPIUsageParams {
+  from?: string;
+  to?: string;
+}
+
+export interface ListWinBackCampaignsParams {
+  limit?: number;
+  offset?: number;
+}
+
+export interface GetAllBarbersParams {
+  status?: string;
+  city?: string;
+  state?: string;
+  is_verified?: boolean;
+  min_rating?: number;
+  category_id?: number;
+  search?: string;
+  sort_by?: string;
+  lat?: number;
+  lng?: number;
+  explain?: boolean;
+  limit?: number;
+  offset?: number;
+  fields?: string;
+}
+
+export interface FindBarbersNearbyParams {
+  lat: number;
+  lng: number;
+  radius?: number;
+  limit?: number;
+  fields?: string;
+}
+
+export interface SearchBarbersParams {
+  q: string;
+  city?: string;
+  state?: string;
+  category_id?: number;
+  lat?: number;
+  lng?: number;
+  explain?: boolean;
+  fields?: string;
+}
+
+export interface GetBarberByUUIDParams {
+  fields?: string;
+}
+
+export interface GetBarberByIDParams {
+  fields?: string;
+}
+
+export interface ListOpenTimeSlotsForBarberParams {
+  date: string;
+  service_id?: number;
+  duration?: number;
+  interval?: number;
+  location_id?: number;
+}
+
+export interface GetBarbersBookingsParams {
+  status?: string;
+  payment_status?: string;
+  start_date_from?: string;
+  start_date_to?: string;
+  sort_by?: string;
+  order?: string;
+  limit?: number;
+  offset?: number;
+  include_customer?: boolean;
+  include_barber?: boolean;
+  include_service?: boolean;
+  fields?: string;
+}
+
+export interface GetBookingStatisticsForBarberParams {
+  from?: string;
+  to?: string;
+}
+
+export interface LiveBookingFeedServerSentEventsParams {
+  token?: string;
+}
+
+export interface GetBarbersCancellationsByReasonParams {
+  from?: string;
+  to?: string;
+  locale?: string;
+}
+
+export interface ListBarbersClientsParams {
+  limit?: number;
+  offset?: number;
+}
+
+export interface ListBarbersConsultationRequestsParams {
+  status?: string;
+  limit?: number;
+  offset?: number;
+}
+
+export interface GetBarbersEarningsParams {
+  from?: string;
+  to?: string;
+}
+
+export interface ListBarbersFeaturedPlacementsParams {
+  status?: string;
+  limit?: number;
+  offset?: number;
+}
+
+export interface ListBarbersInventoryParams {
+  low_stock?: boolean;
+}
+
+export interface GetBarbersProductUsageParams {
+  from?: string;
+  to?: string;
+}
+
+export interface GetBarbersNpsTrendParams {
+  from?: string;
+  to?: string;
+  interval?: string;
+}
+
+export interface GetBarbersReviewsParams {
+  min_rating?: number;
+  max_rating?: number;
+  has_comment?: boolean;
+  has_images?: boolean;
+  sort_by?: string;
+  order?: string;
+  limit?: number;
+  offset?: number;
+  include_customer?: boolean;
+  include_barber?: boolean;
+}
+
+export interface ListServicesPortfolioParams {
+  kind?: string;
+}
+
+export interface ListWebhooksDeliveriesParams {
+  status?: string;
+  limit?: number;
+  offset?: number;
+}
+
+export interface CheckTimeSlotAvailabilityParams {
+  barber_id: number;
+  start_time: string;
+  duration: number;
+}
+
+export interface ListCancellationReasonsParams {
+  locale?: string;
+}
+
+export interface GetMyBookingsParams {
+  status?: string;
+  payment_status?: string;
+  start_date_from?: string;
+  start_date_to?: string;
+  sort_by?: string;
+  order?: string;
+  limit?: number;
+  offset?: number;
+  include_customer?: boolean;
+  include_barber?: boolean;
+  include_service?: boolean;
+  fields?: string;
+}
+
+export interface GetBookingByBookingNumberParams {
+  fields?: string;
+}
+
+export interface GetBookingByUUIDParams {
+  fields?: string;
+}
+
+export interface GetBookingByIDParams {
+  fields?: string;
+}
+
+export interface ListMyConsultationRequestsParams {
+  status?: string;
+  limit?: number;
+  offset?: number;
+}
+
+export interface FeaturedSlotAvailabilityParams {
+  city: string;
+  category_id?: number;
+  starts_on: string;
+  ends_on: string;
+}
+
+export interface GetMyNotificationsParams {
+  type?: string;
+  status?: string;
+  priority?: string;
+  is_read?: boolean;
+  is_unread?: boolean;
+  sort_by?: string;
+  order?: string;
+  limit?: number;
+  offset?: number;
+  include_barber?: boolean;
+}
+
+export interface GetUnreadNotificationsParams {
+  limit?: number;
+}
+
+export interface LongPollUnreadNotificationCountParams {
+  since?: number;
+  wait?: number;
+}
+
+export interface NotificationDeliveryWebhookParams {
+  status: string;
+}
+
+export interface GetMyReviewsParams {
+  moderation_status?: string;
+  sort_by?: string;
+  order?: string;
+  limit?: number;
+  offset?: number;
+  include_customer?: boolean;
+  include_barber?: boolean;
+}
+
+export interface GetPendingReviewsParams {
+  limit?: number;
+  offset?: number;
+  include_customer?: boolean;
+  include_barber?: boolean;
+}
+
+export interface GetAllServicesParams {
+  category_id?: number;
+  service_type?: string;
+  is_active?: boolean;
+  min_rating?: number;
+  complexity?: number;
+  target_gender?: string;
+  search?: string;
+  sort_by?: string;
+  limit?: number;
+  offset?: number;
+  fields?: string;
+}
+
+export interface GetAllServiceCategoriesParams {
+  active_only?: boolean;
+}
+
+export interface SearchServicesParams {
+  q: string;
+  category_id?: number;
+  fields?: string;
+}
+
+export interface GetServiceBySlugParams {
+  fields?: string;
+}
+
+export interface GetServiceByIDParams {
+  fields?: string;
+}
+
+export interface GetShopsBookingsParams {
+  barber_id?: number;
+  status?: string;
+  payment_status?: string;
+  start_date_from?: string;
+  start_date_to?: string;
+  sort_by?: string;
+  order?: string;
+  limit?: number;
+  offset?: number;
+}
+
+export interface ListMySupportTicketsParams {
+  status?: string;
+  priority?: string;
+  category?: string;
+  limit?: number;
+  offset?: number;
+}
+
+export interface GetMyAPIUsageParams {
+  from?: string;
+  to?: string;
+}
+
+export interface LiveUpdatesWebSocketParams {
+  token?: string;
+}
+
+// ============================================
+// Client
+// ============================================
+
+export class BarbershopClient extends BaseClient {
+  /** Get my activity timeline (GET /api/v1/activity/me) */
+  getMyActivityTimeline(params?: GetMyActivityTimelineParams, init?: RequestInit): Promise<TimelineResponse> {
+    return this.request<TimelineResponse>("GET", `/api/v1/activity/me`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get platform activity feed (GET /api/v1/admin/activity) */
+  getPlatformActivityFeed(params?: GetPlatformActivityFeedParams, init?: RequestInit): Promise<ActivityFeedResponse> {
+    return this.request<ActivityFeedResponse>("GET", `/api/v1/admin/activity`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get an audit log entry (GET /api/v1/admin/activity/{id}) */
+  getAuditLogEntry(id: number, init?: RequestInit): Promise<AuditLog> {
+    return this.request<AuditLog>("GET", `/api/v1/admin/activity/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Export an anonymized booking dataset (GET /api/v1/admin/analytics/dataset) */
+  exportAnonymizedBookingDataset(params?: ExportAnonymizedBookingDatasetParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/admin/analytics/dataset`, params as QueryParams | undefined, undefined, false, init);
+  }
+
+  /** Get a customer's activity timeline (admin) (GET /api/v1/admin/customers/{id}/activity) */
+  getCustomersActivityTimelineAdmin(id: number, params?: GetCustomersActivityTimelineAdminParams, init?: RequestInit): Promise<TimelineResponse> {
+    return this.request<TimelineResponse>("GET", `/api/v1/admin/customers/${encodeURIComponent(String(id))}/activity`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Rebuild a customer's activity timeline (admin) (POST /api/v1/admin/customers/{id}/activity/rebuild) */
+  rebuildCustomersActivityTimelineAdmin(id: number, init?: RequestInit): Promise<TimelineState> {
+    return this.request<TimelineState>("POST", `/api/v1/admin/customers/${encodeURIComponent(String(id))}/activity/rebuild`, undefined, undefined, true, init);
+  }
+
+  /** List experiments (GET /api/v1/admin/experiments) */
+  listExperiments(params?: ListExperimentsParams, init?: RequestInit): Promise<Experiment[]> {
+    return this.request<Experiment[]>("GET", `/api/v1/admin/experiments`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Create an experiment (POST /api/v1/admin/experiments) */
+  createExperiment(body: CreateExperimentRequest, init?: RequestInit): Promise<Experiment> {
+    return this.request<Experiment>("POST", `/api/v1/admin/experiments`, undefined, body, true, init);
+  }
+
+  /** Get an experiment (GET /api/v1/admin/experiments/{key}) */
+  getExperiment(key: string, init?: RequestInit): Promise<Experiment> {
+    return this.request<Experiment>("GET", `/api/v1/admin/experiments/${encodeURIComponent(String(key))}`, undefined, undefined, true, init);
+  }
+
+  /** Update an experiment (PUT /api/v1/admin/experiments/{key}) */
+  updateExperiment(key: string, body: UpdateExperimentRequest, init?: RequestInit): Promise<Experiment> {
+    return this.request<Experiment>("PUT", `/api/v1/admin/experiments/${encodeURIComponent(String(key))}`, undefined, body, true, init);
+  }
+
+  /** Experiment conversion report (GET /api/v1/admin/experiments/{key}/report) */
+  experimentConversionReport(key: string, params?: ExperimentConversionReportParams, init?: RequestInit): Promise<ExperimentReport> {
+    return this.request<ExperimentReport>("GET", `/api/v1/admin/experiments/${encodeURIComponent(String(key))}/report`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Start or stop an experiment (PATCH /api/v1/admin/experiments/{key}/status) */
+  startOrStopExperiment(key: string, body: UpdateExperimentStatusRequest, init?: RequestInit): Promise<Experiment> {
+    return this.request<Experiment>("PATCH", `/api/v1/admin/experiments/${encodeURIComponent(String(key))}/status`, undefined, body, true, init);
+  }
+
+  /** List featured slot limits (GET /api/v1/admin/featured/limits) */
+  listFeaturedSlotLimits(init?: RequestInit): Promise<FeaturedSlotLimit[]> {
+    return this.request<FeaturedSlotLimit[]>("GET", `/api/v1/admin/featured/limits`, undefined, undefined, true, init);
+  }
+
+  /** Set a featured slot limit (PUT /api/v1/admin/featured/limits) */
+  setFeaturedSlotLimit(body: SetFeaturedSlotLimitRequest, init?: RequestInit): Promise<FeaturedSlotLimit> {
+    return this.request<FeaturedSlotLimit>("PUT", `/api/v1/admin/featured/limits`, undefined, body, true, init);
+  }
+
+  /** List featured placements (GET /api/v1/admin/featured/placements) */
+  listFeaturedPlacements(params?: ListFeaturedPlacementsParams, init?: RequestInit): Promise<FeaturedPlacement[]> {
+    return this.request<FeaturedPlacement[]>("GET", `/api/v1/admin/featured/placements`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Export the financial ledger (GET /api/v1/admin/finance/ledger/export) */
+  exportFinancialLedger(params?: ExportFinancialLedgerParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/admin/finance/ledger/export`, params as QueryParams | undefined, undefined, false, init);
+  }
+
+  /** Get the platform-wide NPS trend (admin) (GET /api/v1/admin/nps) */
+  getPlatformWideNpsTrendAdmin(params?: GetPlatformWideNpsTrendAdminParams, init?: RequestInit): Promise<NpsTrend> {
+    return this.request<NpsTrend>("GET", `/api/v1/admin/nps`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** List permissions (GET /api/v1/admin/permissions) */
+  listPermissions(init?: RequestInit): Promise<string[]> {
+    return this.request<string[]>("GET", `/api/v1/admin/permissions`, undefined, undefined, true, init);
+  }
+
+  /** List API quotas (GET /api/v1/admin/quotas) */
+  listApiQuotas(init?: RequestInit): Promise<APIQuota[]> {
+    return this.request<APIQuota[]>("GET", `/api/v1/admin/quotas`, undefined, undefined, true, init);
+  }
+
+  /** Remove an API quota (DELETE /api/v1/admin/quotas/{userId}) */
+  removeApiQuota(userId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/admin/quotas/${encodeURIComponent(String(userId))}`, undefined, undefined, true, init);
+  }
+
+  /** Set an API quota (PUT /api/v1/admin/quotas/{userId}) */
+  setApiQuota(userId: number, body: SetAPIQuotaRequest, init?: RequestInit): Promise<APIQuota> {
+    return this.request<APIQuota>("PUT", `/api/v1/admin/quotas/${encodeURIComponent(String(userId))}`, undefined, body, true, init);
+  }
+
+  /** Review moderation queue (GET /api/v1/admin/reviews) */
+  reviewModerationQueue(params?: ReviewModerationQueueParams, init?: RequestInit): Promise<ReviewResponse[]> {
+    return this.request<ReviewResponse[]>("GET", `/api/v1/admin/reviews`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Moderate reviews in bulk (POST /api/v1/admin/reviews/moderate) */
+  moderateReviewsInBulk(body: BulkModerationRequest, init?: RequestInit): Promise<BulkModerationResult> {
+    return this.request<BulkModerationResult>("POST", `/api/v1/admin/reviews/moderate`, undefined, body, true, init);
+  }
+
+  /** Moderation reason templates (GET /api/v1/admin/reviews/moderation-reasons) */
+  moderationReasonTemplates(init?: RequestInit): Promise<ModerationReason[]> {
+    return this.request<ModerationReason[]>("GET", `/api/v1/admin/reviews/moderation-reasons`, undefined, undefined, true, init);
+  }
+
+  /** Response moderation reason templates (GET /api/v1/admin/reviews/response-moderation-reasons) */
+  responseModerationReasonTemplates(init?: RequestInit): Promise<ModerationReason[]> {
+    return this.request<ModerationReason[]>("GET", `/api/v1/admin/reviews/response-moderation-reasons`, undefined, undefined, true, init);
+  }
+
+  /** Review response moderation queue (GET /api/v1/admin/reviews/responses) */
+  reviewResponseModerationQueue(params?: ReviewResponseModerationQueueParams, init?: RequestInit): Promise<ReviewResponse[]> {
+    return this.request<ReviewResponse[]>("GET", `/api/v1/admin/reviews/responses`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Approve a review (POST /api/v1/admin/reviews/{id}/approve) */
+  approveReview(id: number, body: ModerationActionRequest, init?: RequestInit): Promise<ReviewResponse> {
+    return this.request<ReviewResponse>("POST", `/api/v1/admin/reviews/${encodeURIComponent(String(id))}/approve`, undefined, body, true, init);
+  }
+
+  /** Flag a review (POST /api/v1/admin/reviews/{id}/flag) */
+  flagReview(id: number, body: ModerationActionRequest, init?: RequestInit): Promise<ReviewResponse> {
+    return this.request<ReviewResponse>("POST", `/api/v1/admin/reviews/${encodeURIComponent(String(id))}/flag`, undefined, body, true, init);
+  }
+
+  /** Reject a review (POST /api/v1/admin/reviews/{id}/reject) */
+  rejectReview(id: number, body: ModerationActionRequest, init?: RequestInit): Promise<ReviewResponse> {
+    return this.request<ReviewResponse>("POST", `/api/v1/admin/reviews/${encodeURIComponent(String(id))}/reject`, undefined, body, true, init);
+  }
+
+  /** List a review's reports (GET /api/v1/admin/reviews/{id}/reports) */
+  listReviewsReports(id: number, init?: RequestInit): Promise<ReviewReport[]> {
+    return this.request<ReviewReport[]>("GET", `/api/v1/admin/reviews/${encodeURIComponent(String(id))}/reports`, undefined, undefined, true, init);
+  }
+
+  /** Approve a barber response (POST /api/v1/admin/reviews/{id}/response/approve) */
+  approveBarberResponse(id: number, body: ModerationActionRequest, init?: RequestInit): Promise<ReviewResponse> {
+    return this.request<ReviewResponse>("POST", `/api/v1/admin/reviews/${encodeURIComponent(String(id))}/response/approve`, undefined, body, true, init);
+  }
+
+  /** Redact a barber response (POST /api/v1/admin/reviews/{id}/response/redact) */
+  redactBarberResponse(id: number, body: ModerationActionRequest, init?: RequestInit): Promise<ReviewResponse> {
+    return this.request<ReviewResponse>("POST", `/api/v1/admin/reviews/${encodeURIComponent(String(id))}/response/redact`, undefined, body, true, init);
+  }
+
+  /** Reject a barber response (POST /api/v1/admin/reviews/{id}/response/reject) */
+  rejectBarberResponse(id: number, body: ModerationActionRequest, init?: RequestInit): Promise<ReviewResponse> {
+    return this.request<ReviewResponse>("POST", `/api/v1/admin/reviews/${encodeURIComponent(String(id))}/response/reject`, undefined, body, true, init);
+  }
+
+  /** List roles (GET /api/v1/admin/roles) */
+  listRoles(init?: RequestInit): Promise<Role[]> {
+    return this.request<Role[]>("GET", `/api/v1/admin/roles`, undefined, undefined, true, init);
+  }
+
+  /** Create a role (POST /api/v1/admin/roles) */
+  createRole(body: CreateRoleRequest, init?: RequestInit): Promise<Role> {
+    return this.request<Role>("POST", `/api/v1/admin/roles`, undefined, body, true, init);
+  }
+
+  /** Delete a role (DELETE /api/v1/admin/roles/{name}) */
+  deleteRole(name: string, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/admin/roles/${encodeURIComponent(String(name))}`, undefined, undefined, true, init);
+  }
+
+  /** Update a role (PUT /api/v1/admin/roles/{name}) */
+  updateRole(name: string, body: UpdateRoleRequest, init?: RequestInit): Promise<Role> {
+    return this.request<Role>("PUT", `/api/v1/admin/roles/${encodeURIComponent(String(name))}`, undefined, body, true, init);
+  }
+
+  /** Post an incident (POST /api/v1/admin/status/incidents) */
+  postIncident(body: CreateIncidentRequest, init?: RequestInit): Promise<StatusIncident> {
+    return this.request<StatusIncident>("POST", `/api/v1/admin/status/incidents`, undefined, body, true, init);
+  }
+
+  /** Update an incident (PATCH /api/v1/admin/status/incidents/{id}) */
+  updateIncident(id: number, body: UpdateIncidentRequest, init?: RequestInit): Promise<StatusIncident> {
+    return this.request<StatusIncident>("PATCH", `/api/v1/admin/status/incidents/${encodeURIComponent(String(id))}`, undefined, body, true, init);
+  }
+
+  /** List support tickets (GET /api/v1/admin/support/tickets) */
+  listSupportTickets(params?: ListSupportTicketsParams, init?: RequestInit): Promise<SupportTicket[]> {
+    return this.request<SupportTicket[]>("GET", `/api/v1/admin/support/tickets`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get a support ticket (GET /api/v1/admin/support/tickets/{id}) */
+  getSupportTicket(id: number, init?: RequestInit): Promise<SupportTicketResponse> {
+    return this.request<SupportTicketResponse>("GET", `/api/v1/admin/support/tickets/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Update a support ticket (PATCH /api/v1/admin/support/tickets/{id}) */
+  updateSupportTicket(id: number, body: UpdateSupportTicketRequest, init?: RequestInit): Promise<SupportTicketResponse> {
+    return this.request<SupportTicketResponse>("PATCH", `/api/v1/admin/support/tickets/${encodeURIComponent(String(id))}`, undefined, body, true, init);
+  }
+
+  /** Reply to a support ticket (POST /api/v1/admin/support/tickets/{id}/messages) */
+  replyToSupportTicket(id: number, body: SupportReplyRequest, init?: RequestInit): Promise<SupportTicketMessage> {
+    return this.request<SupportTicketMessage>("POST", `/api/v1/admin/support/tickets/${encodeURIComponent(String(id))}/messages`, undefined, body, true, init);
+  }
+
+  /** List suppressed addresses (GET /api/v1/admin/suppressions) */
+  listSuppressedAddresses(params?: ListSuppressedAddressesParams, init?: RequestInit): Promise<Suppression[]> {
+    return this.request<Suppression[]>("GET", `/api/v1/admin/suppressions`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Suppress an address (POST /api/v1/admin/suppressions) */
+  suppressAddress(body: CreateSuppressionRequest, init?: RequestInit): Promise<Suppression> {
+    return this.request<Suppression>("POST", `/api/v1/admin/suppressions`, undefined, body, true, init);
+  }
+
+  /** Lift a suppression (DELETE /api/v1/admin/suppressions/{id}) */
+  liftSuppression(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/admin/suppressions/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Get a suppressed address (GET /api/v1/admin/suppressions/{id}) */
+  getSuppressedAddress(id: number, init?: RequestInit): Promise<Suppression> {
+    return this.request<Suppression>("GET", `/api/v1/admin/suppressions/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Get a tax report (GET /api/v1/admin/tax-report) */
+  getTaxReport(params?: GetTaxReportParams, init?: RequestInit): Promise<TaxReport> {
+    return this.request<TaxReport>("GET", `/api/v1/admin/tax-report`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** List tax rules (GET /api/v1/admin/tax-rules) */
+  listTaxRules(init?: RequestInit): Promise<TaxRule[]> {
+    return this.request<TaxRule[]>("GET", `/api/v1/admin/tax-rules`, undefined, undefined, true, init);
+  }
+
+  /** Create a tax rule (POST /api/v1/admin/tax-rules) */
+  createTaxRule(body: TaxRuleRequest, init?: RequestInit): Promise<TaxRule> {
+    return this.request<TaxRule>("POST", `/api/v1/admin/tax-rules`, undefined, body, true, init);
+  }
+
+  /** Delete a tax rule (DELETE /api/v1/admin/tax-rules/{id}) */
+  deleteTaxRule(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/admin/tax-rules/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Update a tax rule (PUT /api/v1/admin/tax-rules/{id}) */
+  updateTaxRule(id: number, body: TaxRuleRequest, init?: RequestInit): Promise<TaxRule> {
+    return this.request<TaxRule>("PUT", `/api/v1/admin/tax-rules/${encodeURIComponent(String(id))}`, undefined, body, true, init);
+  }
+
+  /** List top API consumers (GET /api/v1/admin/usage) */
+  listTopApiConsumers(params?: ListTopAPIConsumersParams, init?: RequestInit): Promise<APIUsageStats[]> {
+    return this.request<APIUsageStats[]>("GET", `/api/v1/admin/usage`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get a consumer's API usage (GET /api/v1/admin/usage/{userId}) */
+  getConsumersApiUsage(userId: number, params?: GetConsumersAPIUsageParams, init?: RequestInit): Promise<APIUsageReport> {
+    return this.request<APIUsageReport>("GET", `/api/v1/admin/usage/${encodeURIComponent(String(userId))}`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get a user's roles (GET /api/v1/admin/users/{id}/roles) */
+  getUsersRoles(id: number, init?: RequestInit): Promise<UserAccess> {
+    return this.request<UserAccess>("GET", `/api/v1/admin/users/${encodeURIComponent(String(id))}/roles`, undefined, undefined, true, init);
+  }
+
+  /** Assign a role (POST /api/v1/admin/users/{id}/roles) */
+  assignRole(id: number, body: AssignRoleRequest, init?: RequestInit): Promise<UserRole> {
+    return this.request<UserRole>("POST", `/api/v1/admin/users/${encodeURIComponent(String(id))}/roles`, undefined, body, true, init);
+  }
+
+  /** Revoke a role (DELETE /api/v1/admin/users/{id}/roles/{role}) */
+  revokeRole(id: number, role: string, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/admin/users/${encodeURIComponent(String(id))}/roles/${encodeURIComponent(String(role))}`, undefined, undefined, true, init);
+  }
+
+  /** List win-back campaigns (GET /api/v1/admin/win-back/campaigns) */
+  listWinBackCampaigns(params?: ListWinBackCampaignsParams, init?: RequestInit): Promise<WinBackCampaignStats[]> {
+    return this.request<WinBackCampaignStats[]>("GET", `/api/v1/admin/win-back/campaigns`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get a win-back campaign (GET /api/v1/admin/win-back/campaigns/{id}) */
+  getWinBackCampaign(id: number, init?: RequestInit): Promise<WinBackCampaignStats> {
+    return this.request<WinBackCampaignStats>("GET", `/api/v1/admin/win-back/campaigns/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Run a win-back campaign (POST /api/v1/admin/win-back/run) */
+  runWinBackCampaign(body: RunWinBackRequest, init?: RequestInit): Promise<WinBackRunResult> {
+    return this.request<WinBackRunResult>("POST", `/api/v1/admin/win-back/run`, undefined, body, true, init);
+  }
+
+  /** Change user password (POST /api/v1/auth/change-password) */
+  changeUserPassword(body: ChangePasswordRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/auth/change-password`, undefined, body, true, init);
+  }
+
+  /** Claim an imported account (POST /api/v1/auth/claim) */
+  claimImportedAccount(body: ClaimAccountRequest, init?: RequestInit): Promise<AuthResponse> {
+    return this.request<AuthResponse>("POST", `/api/v1/auth/claim`, undefined, body, true, init);
+  }
+
+  /** List linked sign-in accounts (GET /api/v1/auth/identities) */
+  listLinkedSignInAccounts(init?: RequestInit): Promise<UserIdentity[]> {
+    return this.request<UserIdentity[]>("GET", `/api/v1/auth/identities`, undefined, undefined, true, init);
+  }
+
+  /** Unlink a Google or Apple account (DELETE /api/v1/auth/identities/{provider}) */
+  unlinkGoogleOrAppleAccount(provider: string, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/auth/identities/${encodeURIComponent(String(provider))}`, undefined, undefined, true, init);
+  }
+
+  /** Link a Google or Apple account (POST /api/v1/auth/identities/{provider}) */
+  linkGoogleOrAppleAccount(provider: string, body: LinkIdentityRequest, init?: RequestInit): Promise<UserIdentity> {
+    return this.request<UserIdentity>("POST", `/api/v1/auth/identities/${encodeURIComponent(String(provider))}`, undefined, body, true, init);
+  }
+
+  /** User login (POST /api/v1/auth/login) */
+  userLogin(body: LoginRequest, init?: RequestInit): Promise<AuthResponse> {
+    return this.request<AuthResponse>("POST", `/api/v1/auth/login`, undefined, body, true, init);
+  }
+
+  /** User logout (POST /api/v1/auth/logout) */
+  userLogout(body: LogoutRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/auth/logout`, undefined, body, true, init);
+  }
+
+  /** Get current user profile (GET /api/v1/auth/me) */
+  getCurrentUserProfile(init?: RequestInit): Promise<UserProfileResponse> {
+    return this.request<UserProfileResponse>("GET", `/api/v1/auth/me`, undefined, undefined, true, init);
+  }
+
+  /** Sign in with Google or Apple (POST /api/v1/auth/oauth/{provider}) */
+  signInWithGoogleOrApple(provider: string, body: SocialLoginRequest, init?: RequestInit): Promise<AuthResponse> {
+    return this.request<AuthResponse>("POST", `/api/v1/auth/oauth/${encodeURIComponent(String(provider))}`, undefined, body, true, init);
+  }
+
+  /** Update user profile (PUT /api/v1/auth/profile) */
+  updateUserProfile(body: UpdateProfileRequest, init?: RequestInit): Promise<UserProfileResponse> {
+    return this.request<UserProfileResponse>("PUT", `/api/v1/auth/profile`, undefined, body, true, init);
+  }
+
+  /** Refresh tokens (POST /api/v1/auth/refresh) */
+  refreshTokens(body: RefreshTokenRequest, init?: RequestInit): Promise<AuthResponse> {
+    return this.request<AuthResponse>("POST", `/api/v1/auth/refresh`, undefined, body, true, init);
+  }
+
+  /** Register a new user (POST /api/v1/auth/register) */
+  registerNewUser(body: RegisterRequest, init?: RequestInit): Promise<AuthResponse> {
+    return this.request<AuthResponse>("POST", `/api/v1/auth/register`, undefined, body, true, init);
+  }
+
+  /** Add service to barber (POST /api/v1/barber-services) */
+  addServiceToBarber(body: CreateBarberServiceRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/barber-services`, undefined, body, true, init);
+  }
+
+  /** Remove service from barber (DELETE /api/v1/barber-services/{id}) */
+  removeServiceFromBarber(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/barber-services/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Get barber service by ID (GET /api/v1/barber-services/{id}) */
+  getBarberServiceById(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/barber-services/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Update barber service (PUT /api/v1/barber-services/{id}) */
+  updateBarberService(id: number, body: UpdateBarberServiceRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PUT", `/api/v1/barber-services/${encodeURIComponent(String(id))}`, undefined, body, true, init);
+  }
+
+  /** Get all barbers (GET /api/v1/barbers) */
+  getAllBarbers(params?: GetAllBarbersParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/barbers`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Create new barber (POST /api/v1/barbers) */
+  createNewBarber(body: CreateBarberRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/barbers`, undefined, body, true, init);
+  }
+
+  /** List favorite barbers (GET /api/v1/barbers/favorites) */
+  listFavoriteBarbers(init?: RequestInit): Promise<FavoriteBarber[]> {
+    return this.request<FavoriteBarber[]>("GET", `/api/v1/barbers/favorites`, undefined, undefined, true, init);
+  }
+
+  /** Find barbers nearby (GET /api/v1/barbers/nearby) */
+  findBarbersNearby(params?: FindBarbersNearbyParams, init?: RequestInit): Promise<Barber[]> {
+    return this.request<Barber[]>("GET", `/api/v1/barbers/nearby`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Search barbers (GET /api/v1/barbers/search) */
+  searchBarbers(params?: SearchBarbersParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/barbers/search`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get barber by UUID (GET /api/v1/barbers/uuid/{uuid}) */
+  getBarberByUuid(uuid: string, params?: GetBarberByUUIDParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/barbers/uuid/${encodeURIComponent(String(uuid))}`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get barber's services (GET /api/v1/barbers/{barber_id}/services) */
+  getBarbersServices(barberId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/barbers/${encodeURIComponent(String(barberId))}/services`, undefined, undefined, true, init);
+  }
+
+  /** Delete barber (DELETE /api/v1/barbers/{id}) */
+  deleteBarber(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/barbers/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Get barber by ID (GET /api/v1/barbers/{id}) */
+  getBarberById(id: number, params?: GetBarberByIDParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Update barber (PUT /api/v1/barbers/{id}) */
+  updateBarber(id: number, body: UpdateBarberRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PUT", `/api/v1/barbers/${encodeURIComponent(String(id))}`, undefined, body, true, init);
+  }
+
+  /** Get a barber's auto-reply (GET /api/v1/barbers/{id}/auto-reply) */
+  getBarbersAutoReply(id: number, init?: RequestInit): Promise<AutoReplyResponse> {
+    return this.request<AutoReplyResponse>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/auto-reply`, undefined, undefined, true, init);
+  }
+
+  /** Set a barber's auto-reply (PUT /api/v1/barbers/{id}/auto-reply) */
+  setBarbersAutoReply(id: number, body: UpdateAutoReplyRequest, init?: RequestInit): Promise<AutoReplyResponse> {
+    return this.request<AutoReplyResponse>("PUT", `/api/v1/barbers/${encodeURIComponent(String(id))}/auto-reply`, undefined, body, true, init);
+  }
+
+  /** List open time slots for a barber (GET /api/v1/barbers/{id}/availability) */
+  listOpenTimeSlotsForBarber(id: number, params?: ListOpenTimeSlotsForBarberParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/availability`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get a barber's booking form (GET /api/v1/barbers/{id}/booking-form) */
+  getBarbersBookingForm(id: number, init?: RequestInit): Promise<BookingFormField[]> {
+    return this.request<BookingFormField[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/booking-form`, undefined, undefined, true, init);
+  }
+
+  /** List booking form fields (GET /api/v1/barbers/{id}/booking-form/fields) */
+  listBookingFormFields(id: number, init?: RequestInit): Promise<BookingFormField[]> {
+    return this.request<BookingFormField[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/booking-form/fields`, undefined, undefined, true, init);
+  }
+
+  /** Add a booking form field (POST /api/v1/barbers/{id}/booking-form/fields) */
+  addBookingFormField(id: number, body: BookingFormFieldRequest, init?: RequestInit): Promise<BookingFormField> {
+    return this.request<BookingFormField>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/booking-form/fields`, undefined, body, true, init);
+  }
+
+  /** Remove a booking form field (DELETE /api/v1/barbers/{id}/booking-form/fields/{fieldId}) */
+  removeBookingFormField(id: number, fieldId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/barbers/${encodeURIComponent(String(id))}/booking-form/fields/${encodeURIComponent(String(fieldId))}`, undefined, undefined, true, init);
+  }
+
+  /** Update a booking form field (PUT /api/v1/barbers/{id}/booking-form/fields/{fieldId}) */
+  updateBookingFormField(id: number, fieldId: number, body: BookingFormFieldRequest, init?: RequestInit): Promise<BookingFormField> {
+    return this.request<BookingFormField>("PUT", `/api/v1/barbers/${encodeURIComponent(String(id))}/booking-form/fields/${encodeURIComponent(String(fieldId))}`, undefined, body, true, init);
+  }
+
+  /** Get barber's bookings (GET /api/v1/barbers/{id}/bookings) */
+  getBarbersBookings(id: number, params?: GetBarbersBookingsParams, init?: RequestInit): Promise<BookingResponse[]> {
+    return this.request<BookingResponse[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/bookings`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Import appointment history from CSV (POST /api/v1/barbers/{id}/bookings/import) */
+  importAppointmentHistoryFromCsv(id: number, init?: RequestInit): Promise<BookingImportResult> {
+    return this.request<BookingImportResult>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/bookings/import`, undefined, undefined, true, init);
+  }
+
+  /** Get booking statistics for a barber (GET /api/v1/barbers/{id}/bookings/stats) */
+  getBookingStatisticsForBarber(id: number, params?: GetBookingStatisticsForBarberParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/bookings/stats`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Live booking feed (Server-Sent Events) (GET /api/v1/barbers/{id}/bookings/stream) */
+  liveBookingFeedServerSentEvents(id: number, params?: LiveBookingFeedServerSentEventsParams, init?: RequestInit): Promise<string> {
+    return this.request<string>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/bookings/stream`, params as QueryParams | undefined, undefined, false, init);
+  }
+
+  /** Get today's bookings for a barber (GET /api/v1/barbers/{id}/bookings/today) */
+  getTodaysBookingsForBarber(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/bookings/today`, undefined, undefined, true, init);
+  }
+
+  /** Get a barber's cancellations by reason (GET /api/v1/barbers/{id}/cancellation-reasons) */
+  getBarbersCancellationsByReason(id: number, params?: GetBarbersCancellationsByReasonParams, init?: RequestInit): Promise<CancellationReasonStats> {
+    return this.request<CancellationReasonStats>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/cancellation-reasons`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** List a barber's clients (GET /api/v1/barbers/{id}/clients) */
+  listBarbersClients(id: number, params?: ListBarbersClientsParams, init?: RequestInit): Promise<BarberClient[]> {
+    return this.request<BarberClient[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/clients`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Import clients from CSV (POST /api/v1/barbers/{id}/clients/import) */
+  importClientsFromCsv(id: number, init?: RequestInit): Promise<ClientImportResult> {
+    return this.request<ClientImportResult>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/clients/import`, undefined, undefined, true, init);
+  }
+
+  /** List a barber's consultation requests (GET /api/v1/barbers/{id}/consultation-requests) */
+  listBarbersConsultationRequests(id: number, params?: ListBarbersConsultationRequestsParams, init?: RequestInit): Promise<ConsultationRequest[]> {
+    return this.request<ConsultationRequest[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/consultation-requests`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get a barber's consultation request (GET /api/v1/barbers/{id}/consultation-requests/{requestId}) */
+  getBarbersConsultationRequest(id: number, requestId: number, init?: RequestInit): Promise<ConsultationRequest> {
+    return this.request<ConsultationRequest>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/consultation-requests/${encodeURIComponent(String(requestId))}`, undefined, undefined, true, init);
+  }
+
+  /** Answer a consultation request (PUT /api/v1/barbers/{id}/consultation-requests/{requestId}) */
+  answerConsultationRequest(id: number, requestId: number, body: ConsultationResponseRequest, init?: RequestInit): Promise<ConsultationRequest> {
+    return this.request<ConsultationRequest>("PUT", `/api/v1/barbers/${encodeURIComponent(String(id))}/consultation-requests/${encodeURIComponent(String(requestId))}`, undefined, body, true, init);
+  }
+
+  /** Quote a consultation request (PUT /api/v1/barbers/{id}/consultation-requests/{requestId}/quote) */
+  quoteConsultationRequest(id: number, requestId: number, body: ConsultationQuoteRequest, init?: RequestInit): Promise<ConsultationRequest> {
+    return this.request<ConsultationRequest>("PUT", `/api/v1/barbers/${encodeURIComponent(String(id))}/consultation-requests/${encodeURIComponent(String(requestId))}/quote`, undefined, body, true, init);
+  }
+
+  /** Get the barber's live dashboard for today (GET /api/v1/barbers/{id}/dashboard/today) */
+  getBarbersLiveDashboardForToday(id: number, init?: RequestInit): Promise<BarberDashboard> {
+    return this.request<BarberDashboard>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/dashboard/today`, undefined, undefined, true, init);
+  }
+
+  /** Get a barber's earnings (GET /api/v1/barbers/{id}/earnings) */
+  getBarbersEarnings(id: number, params?: GetBarbersEarningsParams, init?: RequestInit): Promise<EarningsReport> {
+    return this.request<EarningsReport>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/earnings`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Unfavorite a barber (DELETE /api/v1/barbers/{id}/favorite) */
+  unfavoriteBarber(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/barbers/${encodeURIComponent(String(id))}/favorite`, undefined, undefined, true, init);
+  }
+
+  /** Favorite a barber (POST /api/v1/barbers/{id}/favorite) */
+  favoriteBarber(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/favorite`, undefined, undefined, true, init);
+  }
+
+  /** List a barber's featured placements (GET /api/v1/barbers/{id}/featured) */
+  listBarbersFeaturedPlacements(id: number, params?: ListBarbersFeaturedPlacementsParams, init?: RequestInit): Promise<FeaturedPlacement[]> {
+    return this.request<FeaturedPlacement[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/featured`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Buy featured placement (POST /api/v1/barbers/{id}/featured) */
+  buyFeaturedPlacement(id: number, body: PurchaseFeaturedRequest, init?: RequestInit): Promise<FeaturedPlacement> {
+    return this.request<FeaturedPlacement>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/featured`, undefined, body, true, init);
+  }
+
+  /** Cancel a featured placement (DELETE /api/v1/barbers/{id}/featured/{placementId}) */
+  cancelFeaturedPlacement(id: number, placementId: number, init?: RequestInit): Promise<FeaturedPlacement> {
+    return this.request<FeaturedPlacement>("DELETE", `/api/v1/barbers/${encodeURIComponent(String(id))}/featured/${encodeURIComponent(String(placementId))}`, undefined, undefined, true, init);
+  }
+
+  /** List a barber's inventory (GET /api/v1/barbers/{id}/inventory) */
+  listBarbersInventory(id: number, params?: ListBarbersInventoryParams, init?: RequestInit): Promise<InventoryItem[]> {
+    return this.request<InventoryItem[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/inventory`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Track a product (POST /api/v1/barbers/{id}/inventory) */
+  trackProduct(id: number, body: InventoryItemRequest, init?: RequestInit): Promise<InventoryItem> {
+    return this.request<InventoryItem>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/inventory`, undefined, body, true, init);
+  }
+
+  /** Get a barber's product usage (GET /api/v1/barbers/{id}/inventory/usage) */
+  getBarbersProductUsage(id: number, params?: GetBarbersProductUsageParams, init?: RequestInit): Promise<InventoryUsageReport> {
+    return this.request<InventoryUsageReport>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/inventory/usage`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Stop tracking a product (DELETE /api/v1/barbers/{id}/inventory/{itemId}) */
+  stopTrackingProduct(id: number, itemId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/barbers/${encodeURIComponent(String(id))}/inventory/${encodeURIComponent(String(itemId))}`, undefined, undefined, true, init);
+  }
+
+  /** Update a product (PUT /api/v1/barbers/{id}/inventory/{itemId}) */
+  updateProduct(id: number, itemId: number, body: InventoryItemRequest, init?: RequestInit): Promise<InventoryItem> {
+    return this.request<InventoryItem>("PUT", `/api/v1/barbers/${encodeURIComponent(String(id))}/inventory/${encodeURIComponent(String(itemId))}`, undefined, body, true, init);
+  }
+
+  /** List a barber's locations (GET /api/v1/barbers/{id}/locations) */
+  listBarbersLocations(id: number, init?: RequestInit): Promise<BarberLocation[]> {
+    return this.request<BarberLocation[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/locations`, undefined, undefined, true, init);
+  }
+
+  /** Add a location (POST /api/v1/barbers/{id}/locations) */
+  addLocation(id: number, body: LocationRequest, init?: RequestInit): Promise<BarberLocation> {
+    return this.request<BarberLocation>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/locations`, undefined, body, true, init);
+  }
+
+  /** Remove a location (DELETE /api/v1/barbers/{id}/locations/{locationId}) */
+  removeLocation(id: number, locationId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/barbers/${encodeURIComponent(String(id))}/locations/${encodeURIComponent(String(locationId))}`, undefined, undefined, true, init);
+  }
+
+  /** Update a location (PUT /api/v1/barbers/{id}/locations/{locationId}) */
+  updateLocation(id: number, locationId: number, body: LocationRequest, init?: RequestInit): Promise<BarberLocation> {
+    return this.request<BarberLocation>("PUT", `/api/v1/barbers/${encodeURIComponent(String(id))}/locations/${encodeURIComponent(String(locationId))}`, undefined, body, true, init);
+  }
+
+  /** Get a barber's NPS trend (GET /api/v1/barbers/{id}/nps) */
+  getBarbersNpsTrend(id: number, params?: GetBarbersNpsTrendParams, init?: RequestInit): Promise<NpsTrend> {
+    return this.request<NpsTrend>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/nps`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** List a barber's open slots (GET /api/v1/barbers/{id}/open-slots) */
+  listBarbersOpenSlots(id: number, init?: RequestInit): Promise<OpenSlot[]> {
+    return this.request<OpenSlot[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/open-slots`, undefined, undefined, true, init);
+  }
+
+  /** Broadcast a last-minute open slot (POST /api/v1/barbers/{id}/open-slots) */
+  broadcastLastMinuteOpenSlot(id: number, body: BroadcastOpenSlotRequest, init?: RequestInit): Promise<OpenSlot> {
+    return this.request<OpenSlot>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/open-slots`, undefined, body, true, init);
+  }
+
+  /** Withdraw an open slot (DELETE /api/v1/barbers/{id}/open-slots/{slotId}) */
+  withdrawOpenSlot(id: number, slotId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/barbers/${encodeURIComponent(String(id))}/open-slots/${encodeURIComponent(String(slotId))}`, undefined, undefined, true, init);
+  }
+
+  /** Get barber's reviews (GET /api/v1/barbers/{id}/reviews) */
+  getBarbersReviews(id: number, params?: GetBarbersReviewsParams, init?: RequestInit): Promise<ReviewResponse[]> {
+    return this.request<ReviewResponse[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/reviews`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get barber's review statistics (GET /api/v1/barbers/{id}/reviews/stats) */
+  getBarbersReviewStatistics(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/reviews/stats`, undefined, undefined, true, init);
+  }
+
+  /** Get a barber's schedule (GET /api/v1/barbers/{id}/schedule) */
+  getBarbersSchedule(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/schedule`, undefined, undefined, true, init);
+  }
+
+  /** Replace a barber's weekly schedule (PUT /api/v1/barbers/{id}/schedule) */
+  replaceBarbersWeeklySchedule(id: number, body: UpdateScheduleRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PUT", `/api/v1/barbers/${encodeURIComponent(String(id))}/schedule`, undefined, body, true, init);
+  }
+
+  /** Add a schedule exception (POST /api/v1/barbers/{id}/schedule/exceptions) */
+  addScheduleException(id: number, body: CreateScheduleExceptionRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/schedule/exceptions`, undefined, body, true, init);
+  }
+
+  /** Remove a schedule exception (DELETE /api/v1/barbers/{id}/schedule/exceptions/{exceptionId}) */
+  removeScheduleException(id: number, exceptionId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/barbers/${encodeURIComponent(String(id))}/schedule/exceptions/${encodeURIComponent(String(exceptionId))}`, undefined, undefined, true, init);
+  }
+
+  /** List a service's add-ons (GET /api/v1/barbers/{id}/services/{serviceId}/add-ons) */
+  listServicesAddOns(id: number, serviceId: number, init?: RequestInit): Promise<ServiceAddOn[]> {
+    return this.request<ServiceAddOn[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/services/${encodeURIComponent(String(serviceId))}/add-ons`, undefined, undefined, true, init);
+  }
+
+  /** Add an add-on to a service (POST /api/v1/barbers/{id}/services/{serviceId}/add-ons) */
+  addAddOnToService(id: number, serviceId: number, body: AddOnRequest, init?: RequestInit): Promise<ServiceAddOn> {
+    return this.request<ServiceAddOn>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/services/${encodeURIComponent(String(serviceId))}/add-ons`, undefined, body, true, init);
+  }
+
+  /** Remove a service add-on (DELETE /api/v1/barbers/{id}/services/{serviceId}/add-ons/{addOnId}) */
+  removeServiceAddOn(id: number, serviceId: number, addOnId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/barbers/${encodeURIComponent(String(id))}/services/${encodeURIComponent(String(serviceId))}/add-ons/${encodeURIComponent(String(addOnId))}`, undefined, undefined, true, init);
+  }
+
+  /** Update a service add-on (PUT /api/v1/barbers/{id}/services/{serviceId}/add-ons/{addOnId}) */
+  updateServiceAddOn(id: number, serviceId: number, addOnId: number, body: AddOnRequest, init?: RequestInit): Promise<ServiceAddOn> {
+    return this.request<ServiceAddOn>("PUT", `/api/v1/barbers/${encodeURIComponent(String(id))}/services/${encodeURIComponent(String(serviceId))}/add-ons/${encodeURIComponent(String(addOnId))}`, undefined, body, true, init);
+  }
+
+  /** List a service's portfolio (GET /api/v1/barbers/{id}/services/{serviceId}/portfolio) */
+  listServicesPortfolio(id: number, serviceId: number, params?: ListServicesPortfolioParams, init?: RequestInit): Promise<PortfolioMedia[]> {
+    return this.request<PortfolioMedia[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/services/${encodeURIComponent(String(serviceId))}/portfolio`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Add a photo to a service's portfolio (POST /api/v1/barbers/{id}/services/{serviceId}/portfolio) */
+  addPhotoToServicesPortfolio(id: number, serviceId: number, init?: RequestInit): Promise<PortfolioMedia> {
+    return this.request<PortfolioMedia>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/services/${encodeURIComponent(String(serviceId))}/portfolio`, undefined, undefined, true, init);
+  }
+
+  /** Reorder a service's portfolio (PUT /api/v1/barbers/{id}/services/{serviceId}/portfolio/order) */
+  reorderServicesPortfolio(id: number, serviceId: number, body: PortfolioOrderRequest, init?: RequestInit): Promise<PortfolioMedia[]> {
+    return this.request<PortfolioMedia[]>("PUT", `/api/v1/barbers/${encodeURIComponent(String(id))}/services/${encodeURIComponent(String(serviceId))}/portfolio/order`, undefined, body, true, init);
+  }
+
+  /** Remove a portfolio photo (DELETE /api/v1/barbers/{id}/services/{serviceId}/portfolio/{mediaId}) */
+  removePortfolioPhoto(id: number, serviceId: number, mediaId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/barbers/${encodeURIComponent(String(id))}/services/${encodeURIComponent(String(serviceId))}/portfolio/${encodeURIComponent(String(mediaId))}`, undefined, undefined, true, init);
+  }
+
+  /** Caption a portfolio photo (PATCH /api/v1/barbers/{id}/services/{serviceId}/portfolio/{mediaId}) */
+  captionPortfolioPhoto(id: number, serviceId: number, mediaId: number, body: PortfolioCaptionRequest, init?: RequestInit): Promise<PortfolioMedia> {
+    return this.request<PortfolioMedia>("PATCH", `/api/v1/barbers/${encodeURIComponent(String(id))}/services/${encodeURIComponent(String(serviceId))}/portfolio/${encodeURIComponent(String(mediaId))}`, undefined, body, true, init);
+  }
+
+  /** Get barber statistics (GET /api/v1/barbers/{id}/statistics) */
+  getBarberStatistics(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/statistics`, undefined, undefined, true, init);
+  }
+
+  /** Update barber status (PATCH /api/v1/barbers/{id}/status) */
+  updateBarberStatus(id: number, body: StatusUpdateRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PATCH", `/api/v1/barbers/${encodeURIComponent(String(id))}/status`, undefined, body, true, init);
+  }
+
+  /** List a barber's time off (GET /api/v1/barbers/{id}/time-off) */
+  listBarbersTimeOff(id: number, init?: RequestInit): Promise<TimeOff[]> {
+    return this.request<TimeOff[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/time-off`, undefined, undefined, true, init);
+  }
+
+  /** Block out time off (POST /api/v1/barbers/{id}/time-off) */
+  blockOutTimeOff(id: number, body: CreateTimeOffRequest, init?: RequestInit): Promise<TimeOffResponse> {
+    return this.request<TimeOffResponse>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/time-off`, undefined, body, true, init);
+  }
+
+  /** Remove time off (DELETE /api/v1/barbers/{id}/time-off/{timeOffId}) */
+  removeTimeOff(id: number, timeOffId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/barbers/${encodeURIComponent(String(id))}/time-off/${encodeURIComponent(String(timeOffId))}`, undefined, undefined, true, init);
+  }
+
+  /** Get a barber's travel times (GET /api/v1/barbers/{id}/travel-times) */
+  getBarbersTravelTimes(id: number, init?: RequestInit): Promise<TravelTime[]> {
+    return this.request<TravelTime[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/travel-times`, undefined, undefined, true, init);
+  }
+
+  /** Set a barber's travel times (PUT /api/v1/barbers/{id}/travel-times) */
+  setBarbersTravelTimes(id: number, body: TravelTimesRequest, init?: RequestInit): Promise<TravelTime[]> {
+    return this.request<TravelTime[]>("PUT", `/api/v1/barbers/${encodeURIComponent(String(id))}/travel-times`, undefined, body, true, init);
+  }
+
+  /** List a barber's webhooks (GET /api/v1/barbers/{id}/webhooks) */
+  listBarbersWebhooks(id: number, init?: RequestInit): Promise<WebhookSubscription[]> {
+    return this.request<WebhookSubscription[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/webhooks`, undefined, undefined, true, init);
+  }
+
+  /** Register a webhook (POST /api/v1/barbers/{id}/webhooks) */
+  registerWebhook(id: number, body: CreateWebhookRequest, init?: RequestInit): Promise<CreatedWebhookResponse> {
+    return this.request<CreatedWebhookResponse>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/webhooks`, undefined, body, true, init);
+  }
+
+  /** Delete a webhook (DELETE /api/v1/barbers/{id}/webhooks/{webhookId}) */
+  deleteWebhook(id: number, webhookId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/barbers/${encodeURIComponent(String(id))}/webhooks/${encodeURIComponent(String(webhookId))}`, undefined, undefined, true, init);
+  }
+
+  /** Update a webhook (PATCH /api/v1/barbers/{id}/webhooks/{webhookId}) */
+  updateWebhook(id: number, webhookId: number, body: UpdateWebhookRequest, init?: RequestInit): Promise<WebhookSubscription> {
+    return this.request<WebhookSubscription>("PATCH", `/api/v1/barbers/${encodeURIComponent(String(id))}/webhooks/${encodeURIComponent(String(webhookId))}`, undefined, body, true, init);
+  }
+
+  /** List a webhook's deliveries (GET /api/v1/barbers/{id}/webhooks/{webhookId}/deliveries) */
+  listWebhooksDeliveries(id: number, webhookId: number, params?: ListWebhooksDeliveriesParams, init?: RequestInit): Promise<WebhookDelivery[]> {
+    return this.request<WebhookDelivery[]>("GET", `/api/v1/barbers/${encodeURIComponent(String(id))}/webhooks/${encodeURIComponent(String(webhookId))}/deliveries`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Redeliver a webhook event (POST /api/v1/barbers/{id}/webhooks/{webhookId}/deliveries/{deliveryId}/redeliver) */
+  redeliverWebhookEvent(id: number, webhookId: number, deliveryId: number, init?: RequestInit): Promise<WebhookDelivery> {
+    return this.request<WebhookDelivery>("POST", `/api/v1/barbers/${encodeURIComponent(String(id))}/webhooks/${encodeURIComponent(String(webhookId))}/deliveries/${encodeURIComponent(String(deliveryId))}/redeliver`, undefined, undefined, true, init);
+  }
+
+  /** Create a new booking (POST /api/v1/bookings) */
+  createNewBooking(body: CreateBookingRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/bookings`, undefined, body, true, init);
+  }
+
+  /** Describe a reminder link (GET /api/v1/bookings/actions/{token}) */
+  describeReminderLink(token: string, init?: RequestInit): Promise<ActionLinkPreview> {
+    return this.request<ActionLinkPreview>("GET", `/api/v1/bookings/actions/${encodeURIComponent(String(token))}`, undefined, undefined, true, init);
+  }
+
+  /** Confirm or cancel a booking from a reminder link (POST /api/v1/bookings/actions/{token}) */
+  confirmOrCancelBookingFromReminderLink(token: string, init?: RequestInit): Promise<ActionLinkResult> {
+    return this.request<ActionLinkResult>("POST", `/api/v1/bookings/actions/${encodeURIComponent(String(token))}`, undefined, undefined, true, init);
+  }
+
+  /** Check time slot availability (GET /api/v1/bookings/availability) */
+  checkTimeSlotAvailability(params?: CheckTimeSlotAvailabilityParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/bookings/availability`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** List cancellation reasons (GET /api/v1/bookings/cancellation-reasons) */
+  listCancellationReasons(params?: ListCancellationReasonsParams, init?: RequestInit): Promise<CancellationReason[]> {
+    return this.request<CancellationReason[]>("GET", `/api/v1/bookings/cancellation-reasons`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Book and pay (POST /api/v1/bookings/checkout) */
+  bookAndPay(body: CheckoutRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/bookings/checkout`, undefined, body, true, init);
+  }
+
+  /** Cancel an appointment from a confirmation request (POST /api/v1/bookings/confirmations/{token}/cancel) */
+  cancelAppointmentFromConfirmationRequest(token: string, init?: RequestInit): Promise<ConfirmationResponseResult> {
+    return this.request<ConfirmationResponseResult>("POST", `/api/v1/bookings/confirmations/${encodeURIComponent(String(token))}/cancel`, undefined, undefined, true, init);
+  }
+
+  /** Confirm an appointment from a confirmation request (POST /api/v1/bookings/confirmations/{token}/confirm) */
+  confirmAppointmentFromConfirmationRequest(token: string, init?: RequestInit): Promise<ConfirmationResponseResult> {
+    return this.request<ConfirmationResponseResult>("POST", `/api/v1/bookings/confirmations/${encodeURIComponent(String(token))}/confirm`, undefined, undefined, true, init);
+  }
+
+  /** Get my bookings (GET /api/v1/bookings/me) */
+  getMyBookings(params?: GetMyBookingsParams, init?: RequestInit): Promise<BookingResponse[]> {
+    return this.request<BookingResponse[]>("GET", `/api/v1/bookings/me`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get booking by booking number (GET /api/v1/bookings/number/{number}) */
+  getBookingByBookingNumber(number: string, params?: GetBookingByBookingNumberParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/bookings/number/${encodeURIComponent(String(number))}`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Cancel a booking series (DELETE /api/v1/bookings/series/{id}) */
+  cancelBookingSeries(id: number, body: CancelBookingRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/bookings/series/${encodeURIComponent(String(id))}`, undefined, body, true, init);
+  }
+
+  /** Get a booking series (GET /api/v1/bookings/series/{id}) */
+  getBookingSeries(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/bookings/series/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Reschedule a booking series (PUT /api/v1/bookings/series/{id}/reschedule) */
+  rescheduleBookingSeries(id: number, body: RescheduleSeriesRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PUT", `/api/v1/bookings/series/${encodeURIComponent(String(id))}/reschedule`, undefined, body, true, init);
+  }
+
+  /** Get booking by UUID (GET /api/v1/bookings/uuid/{uuid}) */
+  getBookingByUuid(uuid: string, params?: GetBookingByUUIDParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/bookings/uuid/${encodeURIComponent(String(uuid))}`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Cancel a booking (DELETE /api/v1/bookings/{id}) */
+  cancelBooking(id: number, body: CancelBookingRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/bookings/${encodeURIComponent(String(id))}`, undefined, body, true, init);
+  }
+
+  /** Get booking by ID (GET /api/v1/bookings/{id}) */
+  getBookingById(id: number, params?: GetBookingByIDParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/bookings/${encodeURIComponent(String(id))}`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Update booking details (PUT /api/v1/bookings/{id}) */
+  updateBookingDetails(id: number, body: UpdateBookingRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PUT", `/api/v1/bookings/${encodeURIComponent(String(id))}`, undefined, body, true, init);
+  }
+
+  /** Complete a booking (POST /api/v1/bookings/{id}/complete) */
+  completeBooking(id: number, body: CompleteBookingRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/bookings/${encodeURIComponent(String(id))}/complete`, undefined, body, true, init);
+  }
+
+  /** Get booking history (GET /api/v1/bookings/{id}/history) */
+  getBookingHistory(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/bookings/${encodeURIComponent(String(id))}/history`, undefined, undefined, true, init);
+  }
+
+  /** Download a booking as a calendar file (GET /api/v1/bookings/{id}/ics) */
+  downloadBookingAsCalendarFile(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/bookings/${encodeURIComponent(String(id))}/ics`, undefined, undefined, false, init);
+  }
+
+  /** Reschedule a booking (PUT /api/v1/bookings/{id}/reschedule) */
+  rescheduleBooking(id: number, body: RescheduleBookingRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PUT", `/api/v1/bookings/${encodeURIComponent(String(id))}/reschedule`, undefined, body, true, init);
+  }
+
+  /** List who worked on a booking (GET /api/v1/bookings/{id}/staff) */
+  listWhoWorkedOnBooking(id: number, init?: RequestInit): Promise<BookingStaffResponse> {
+    return this.request<BookingStaffResponse>("GET", `/api/v1/bookings/${encodeURIComponent(String(id))}/staff`, undefined, undefined, true, init);
+  }
+
+  /** Split a booking with assistants (PUT /api/v1/bookings/{id}/staff) */
+  splitBookingWithAssistants(id: number, body: BookingStaffRequest, init?: RequestInit): Promise<BookingStaffResponse> {
+    return this.request<BookingStaffResponse>("PUT", `/api/v1/bookings/${encodeURIComponent(String(id))}/staff`, undefined, body, true, init);
+  }
+
+  /** Update booking status (PATCH /api/v1/bookings/{id}/status) */
+  updateBookingStatus(id: number, body: UpdateStatusRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PATCH", `/api/v1/bookings/${encodeURIComponent(String(id))}/status`, undefined, body, true, init);
+  }
+
+  /** Get my calendar feed (GET /api/v1/calendar/feed) */
+  getMyCalendarFeed(init?: RequestInit): Promise<CalendarFeedResponse> {
+    return this.request<CalendarFeedResponse>("GET", `/api/v1/calendar/feed`, undefined, undefined, true, init);
+  }
+
+  /** Reset my calendar feed (POST /api/v1/calendar/feed/reset) */
+  resetMyCalendarFeed(init?: RequestInit): Promise<CalendarFeedResponse> {
+    return this.request<CalendarFeedResponse>("POST", `/api/v1/calendar/feed/reset`, undefined, undefined, true, init);
+  }
+
+  /** Get a calendar feed (GET /api/v1/calendar/feeds/{token}) */
+  getCalendarFeed(token: string, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/calendar/feeds/${encodeURIComponent(String(token))}`, undefined, undefined, false, init);
+  }
+
+  /** List my consultation requests (GET /api/v1/consultation-requests) */
+  listMyConsultationRequests(params?: ListMyConsultationRequestsParams, init?: RequestInit): Promise<ConsultationRequest[]> {
+    return this.request<ConsultationRequest[]>("GET", `/api/v1/consultation-requests`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Ask for a consultation (POST /api/v1/consultation-requests) */
+  askForConsultation(body: SubmitConsultationRequest, init?: RequestInit): Promise<ConsultationRequest> {
+    return this.request<ConsultationRequest>("POST", `/api/v1/consultation-requests`, undefined, body, true, init);
+  }
+
+  /** Get my consultation request (GET /api/v1/consultation-requests/{id}) */
+  getMyConsultationRequest(id: number, init?: RequestInit): Promise<ConsultationRequest> {
+    return this.request<ConsultationRequest>("GET", `/api/v1/consultation-requests/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Accept a consultation quote (POST /api/v1/consultation-requests/{id}/accept) */
+  acceptConsultationQuote(id: number, body: AcceptConsultationQuoteRequest, init?: RequestInit): Promise<BookingResponse> {
+    return this.request<BookingResponse>("POST", `/api/v1/consultation-requests/${encodeURIComponent(String(id))}/accept`, undefined, body, true, init);
+  }
+
+  /** Attach a photo to my consultation request (POST /api/v1/consultation-requests/{id}/photos) */
+  attachPhotoToMyConsultationRequest(id: number, init?: RequestInit): Promise<ConsultationPhoto> {
+    return this.request<ConsultationPhoto>("POST", `/api/v1/consultation-requests/${encodeURIComponent(String(id))}/photos`, undefined, undefined, true, init);
+  }
+
+  /** Record an experiment conversion (POST /api/v1/experiments/{key}/conversions) */
+  recordExperimentConversion(key: string, body: RecordConversionRequest, init?: RequestInit): Promise<ExperimentConversion> {
+    return this.request<ExperimentConversion>("POST", `/api/v1/experiments/${encodeURIComponent(String(key))}/conversions`, undefined, body, true, init);
+  }
+
+  /** Get my experiment variant (GET /api/v1/experiments/{key}/variant) */
+  getMyExperimentVariant(key: string, init?: RequestInit): Promise<VariantAssignment> {
+    return this.request<VariantAssignment>("GET", `/api/v1/experiments/${encodeURIComponent(String(key))}/variant`, undefined, undefined, true, init);
+  }
+
+  /** Featured slot availability (GET /api/v1/featured/availability) */
+  featuredSlotAvailability(params?: FeaturedSlotAvailabilityParams, init?: RequestInit): Promise<FeaturedAvailability> {
+    return this.request<FeaturedAvailability>("GET", `/api/v1/featured/availability`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Record a click on a featured result (POST /api/v1/featured/{id}/click) */
+  recordClickOnFeaturedResult(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/featured/${encodeURIComponent(String(id))}/click`, undefined, undefined, true, init);
+  }
+
+  /** Get my notifications (GET /api/v1/notifications) */
+  getMyNotifications(params?: GetMyNotificationsParams, init?: RequestInit): Promise<NotificationResponse[]> {
+    return this.request<NotificationResponse[]>("GET", `/api/v1/notifications`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Create a notification (POST /api/v1/notifications) */
+  createNotification(body: CreateNotificationRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/notifications`, undefined, body, true, init);
+  }
+
+  /** Send booking notification (POST /api/v1/notifications/booking) */
+  sendBookingNotification(body: SendBookingNotificationRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/notifications/booking`, undefined, body, true, init);
+  }
+
+  /** Register a push device (POST /api/v1/notifications/devices) */
+  registerPushDevice(body: RegisterDeviceRequest, init?: RequestInit): Promise<DeviceToken> {
+    return this.request<DeviceToken>("POST", `/api/v1/notifications/devices`, undefined, body, true, init);
+  }
+
+  /** Unregister a push device (DELETE /api/v1/notifications/devices/{token}) */
+  unregisterPushDevice(token: string, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/notifications/devices/${encodeURIComponent(String(token))}`, undefined, undefined, true, init);
+  }
+
+  /** Mark all notifications as read (PATCH /api/v1/notifications/read-all) */
+  markAllNotificationsAsRead(init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PATCH", `/api/v1/notifications/read-all`, undefined, undefined, true, init);
+  }
+
+  /** Inbound SMS webhook (POST /api/v1/notifications/sms-inbound) */
+  inboundSmsWebhook(init?: RequestInit): Promise<string> {
+    return this.request<string>("POST", `/api/v1/notifications/sms-inbound`, undefined, undefined, false, init);
+  }
+
+  /** Get notification statistics (GET /api/v1/notifications/stats) */
+  getNotificationStatistics(init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/notifications/stats`, undefined, undefined, true, init);
+  }
+
+  /** List notification templates and their variables (GET /api/v1/notifications/templates) */
+  listNotificationTemplatesAndTheirVariables(init?: RequestInit): Promise<NotificationTemplateInfo[]> {
+    return this.request<NotificationTemplateInfo[]>("GET", `/api/v1/notifications/templates`, undefined, undefined, true, init);
+  }
+
+  /** Check template text against a template's variables (POST /api/v1/notifications/templates/validate) */
+  checkTemplateTextAgainstTemplatesVariables(body: ValidateTemplateRequest, init?: RequestInit): Promise<ValidateTemplateResult> {
+    return this.request<ValidateTemplateResult>("POST", `/api/v1/notifications/templates/validate`, undefined, body, true, init);
+  }
+
+  /** Get unread notifications (GET /api/v1/notifications/unread) */
+  getUnreadNotifications(params?: GetUnreadNotificationsParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/notifications/unread`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Long-poll unread notification count (GET /api/v1/notifications/unread-count) */
+  longPollUnreadNotificationCount(params?: LongPollUnreadNotificationCountParams, init?: RequestInit): Promise<UnreadCountResponse> {
+    return this.request<UnreadCountResponse>("GET", `/api/v1/notifications/unread-count`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get unread notification count (GET /api/v1/notifications/unread/count) */
+  getUnreadNotificationCount(init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/notifications/unread/count`, undefined, undefined, true, init);
+  }
+
+  /** Delete a notification (DELETE /api/v1/notifications/{id}) */
+  deleteNotification(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/notifications/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Get notification by ID (GET /api/v1/notifications/{id}) */
+  getNotificationById(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/notifications/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Mark notification as read (PATCH /api/v1/notifications/{id}/read) */
+  markNotificationAsRead(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PATCH", `/api/v1/notifications/${encodeURIComponent(String(id))}/read`, undefined, undefined, true, init);
+  }
+
+  /** SMS delivery status callback (POST /api/v1/notifications/{id}/sms-status) */
+  smsDeliveryStatusCallback(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/notifications/${encodeURIComponent(String(id))}/sms-status`, undefined, undefined, true, init);
+  }
+
+  /** Notification delivery webhook (POST /api/v1/notifications/{id}/webhook) */
+  notificationDeliveryWebhook(id: number, params?: NotificationDeliveryWebhookParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/notifications/${encodeURIComponent(String(id))}/webhook`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get an open slot (GET /api/v1/open-slots/{id}) */
+  getOpenSlot(id: number, init?: RequestInit): Promise<OpenSlotResponse> {
+    return this.request<OpenSlotResponse>("GET", `/api/v1/open-slots/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Claim an open slot (POST /api/v1/open-slots/{id}/claim) */
+  claimOpenSlot(id: number, body: ClaimOpenSlotRequest, init?: RequestInit): Promise<BookingResponse> {
+    return this.request<BookingResponse>("POST", `/api/v1/open-slots/${encodeURIComponent(String(id))}/claim`, undefined, body, true, init);
+  }
+
+  /** Create a new review (POST /api/v1/reviews) */
+  createNewReview(body: CreateReviewRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/reviews`, undefined, body, true, init);
+  }
+
+  /** Get review by booking ID (GET /api/v1/reviews/booking/{booking_id}) */
+  getReviewByBookingId(bookingId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/reviews/booking/${encodeURIComponent(String(bookingId))}`, undefined, undefined, true, init);
+  }
+
+  /** Check if booking can be reviewed (GET /api/v1/reviews/can-review/{booking_id}) */
+  checkIfBookingCanBeReviewed(bookingId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/reviews/can-review/${encodeURIComponent(String(bookingId))}`, undefined, undefined, true, init);
+  }
+
+  /** Get my reviews (GET /api/v1/reviews/me) */
+  getMyReviews(params?: GetMyReviewsParams, init?: RequestInit): Promise<ReviewResponse[]> {
+    return this.request<ReviewResponse[]>("GET", `/api/v1/reviews/me`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get pending reviews (GET /api/v1/reviews/pending) */
+  getPendingReviews(params?: GetPendingReviewsParams, init?: RequestInit): Promise<ReviewResponse[]> {
+    return this.request<ReviewResponse[]>("GET", `/api/v1/reviews/pending`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Describe a review link (GET /api/v1/reviews/requests/{token}) */
+  describeReviewLink(token: string, init?: RequestInit): Promise<ReviewLinkInfo> {
+    return this.request<ReviewLinkInfo>("GET", `/api/v1/reviews/requests/${encodeURIComponent(String(token))}`, undefined, undefined, true, init);
+  }
+
+  /** Review a booking from a review link (POST /api/v1/reviews/requests/{token}) */
+  reviewBookingFromReviewLink(token: string, body: CreateReviewRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/reviews/requests/${encodeURIComponent(String(token))}`, undefined, body, true, init);
+  }
+
+  /** Delete a review (DELETE /api/v1/reviews/{id}) */
+  deleteReview(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/reviews/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Get review by ID (GET /api/v1/reviews/{id}) */
+  getReviewById(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/reviews/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Update a review (PUT /api/v1/reviews/{id}) */
+  updateReview(id: number, body: UpdateReviewRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PUT", `/api/v1/reviews/${encodeURIComponent(String(id))}`, undefined, body, true, init);
+  }
+
+  /** List a review's photos (GET /api/v1/reviews/{id}/images) */
+  listReviewsPhotos(id: number, init?: RequestInit): Promise<ReviewImage[]> {
+    return this.request<ReviewImage[]>("GET", `/api/v1/reviews/${encodeURIComponent(String(id))}/images`, undefined, undefined, true, init);
+  }
+
+  /** Attach a photo to a review (POST /api/v1/reviews/{id}/images) */
+  attachPhotoToReview(id: number, init?: RequestInit): Promise<ReviewImage> {
+    return this.request<ReviewImage>("POST", `/api/v1/reviews/${encodeURIComponent(String(id))}/images`, undefined, undefined, true, init);
+  }
+
+  /** Remove a photo from a review (DELETE /api/v1/reviews/{id}/images/{image_id}) */
+  removePhotoFromReview(id: number, imageId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/reviews/${encodeURIComponent(String(id))}/images/${encodeURIComponent(String(imageId))}`, undefined, undefined, true, init);
+  }
+
+  /** Moderate a review (PATCH /api/v1/reviews/{id}/moderate) */
+  moderateReview(id: number, body: ModerateReviewRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PATCH", `/api/v1/reviews/${encodeURIComponent(String(id))}/moderate`, undefined, body, true, init);
+  }
+
+  /** Report a review (POST /api/v1/reviews/{id}/report) */
+  reportReview(id: number, body: ReportReviewRequest, init?: RequestInit): Promise<ReviewReport> {
+    return this.request<ReviewReport>("POST", `/api/v1/reviews/${encodeURIComponent(String(id))}/report`, undefined, body, true, init);
+  }
+
+  /** Add barber response to a review (POST /api/v1/reviews/{id}/response) */
+  addBarberResponseToReview(id: number, body: BarberResponseRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/reviews/${encodeURIComponent(String(id))}/response`, undefined, body, true, init);
+  }
+
+  /** Vote on review helpfulness (POST /api/v1/reviews/{id}/vote) */
+  voteOnReviewHelpfulness(id: number, body: VoteReviewRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/reviews/${encodeURIComponent(String(id))}/vote`, undefined, body, true, init);
+  }
+
+  /** Get all services (GET /api/v1/services) */
+  getAllServices(params?: GetAllServicesParams, init?: RequestInit): Promise<Service[]> {
+    return this.request<Service[]>("GET", `/api/v1/services`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Create new service (POST /api/v1/services) */
+  createNewService(body: CreateServiceRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/services`, undefined, body, true, init);
+  }
+
+  /** Get all service categories (GET /api/v1/services/categories) */
+  getAllServiceCategories(params?: GetAllServiceCategoriesParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/services/categories`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Create new category (POST /api/v1/services/categories) */
+  createNewCategory(body: CreateCategoryRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("POST", `/api/v1/services/categories`, undefined, body, true, init);
+  }
+
+  /** Delete category (DELETE /api/v1/services/categories/{id}) */
+  deleteCategory(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/services/categories/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Get category by ID (GET /api/v1/services/categories/{id}) */
+  getCategoryById(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/services/categories/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Update category (PUT /api/v1/services/categories/{id}) */
+  updateCategory(id: number, body: UpdateCategoryRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PUT", `/api/v1/services/categories/${encodeURIComponent(String(id))}`, undefined, body, true, init);
+  }
+
+  /** Search services (GET /api/v1/services/search) */
+  searchServices(params?: SearchServicesParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/services/search`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get service by slug (GET /api/v1/services/slug/{slug}) */
+  getServiceBySlug(slug: string, params?: GetServiceBySlugParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/services/slug/${encodeURIComponent(String(slug))}`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Delete service (DELETE /api/v1/services/{id}) */
+  deleteService(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/services/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Get service by ID (GET /api/v1/services/{id}) */
+  getServiceById(id: number, params?: GetServiceByIDParams, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/services/${encodeURIComponent(String(id))}`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Update service (PUT /api/v1/services/{id}) */
+  updateService(id: number, body: UpdateServiceRequest, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("PUT", `/api/v1/services/${encodeURIComponent(String(id))}`, undefined, body, true, init);
+  }
+
+  /** Get barbers offering a service (GET /api/v1/services/{service_id}/barbers) */
+  getBarbersOfferingService(serviceId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/services/${encodeURIComponent(String(serviceId))}/barbers`, undefined, undefined, true, init);
+  }
+
+  /** Create a shop (POST /api/v1/shops) */
+  createShop(body: ShopRequest, init?: RequestInit): Promise<Shop> {
+    return this.request<Shop>("POST", `/api/v1/shops`, undefined, body, true, init);
+  }
+
+  /** Get a shop (GET /api/v1/shops/{id}) */
+  getShop(id: number, init?: RequestInit): Promise<Shop> {
+    return this.request<Shop>("GET", `/api/v1/shops/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Update a shop (PUT /api/v1/shops/{id}) */
+  updateShop(id: number, body: ShopRequest, init?: RequestInit): Promise<Shop> {
+    return this.request<Shop>("PUT", `/api/v1/shops/${encodeURIComponent(String(id))}`, undefined, body, true, init);
+  }
+
+  /** List a shop's admins (GET /api/v1/shops/{id}/admins) */
+  listShopsAdmins(id: number, init?: RequestInit): Promise<ShopAdmin[]> {
+    return this.request<ShopAdmin[]>("GET", `/api/v1/shops/${encodeURIComponent(String(id))}/admins`, undefined, undefined, true, init);
+  }
+
+  /** Add a shop admin (POST /api/v1/shops/{id}/admins) */
+  addShopAdmin(id: number, body: AddShopAdminRequest, init?: RequestInit): Promise<ShopAdmin> {
+    return this.request<ShopAdmin>("POST", `/api/v1/shops/${encodeURIComponent(String(id))}/admins`, undefined, body, true, init);
+  }
+
+  /** Remove a shop admin (DELETE /api/v1/shops/{id}/admins/{userId}) */
+  removeShopAdmin(id: number, userId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/shops/${encodeURIComponent(String(id))}/admins/${encodeURIComponent(String(userId))}`, undefined, undefined, true, init);
+  }
+
+  /** List a shop's barbers (GET /api/v1/shops/{id}/barbers) */
+  listShopsBarbers(id: number, init?: RequestInit): Promise<Barber[]> {
+    return this.request<Barber[]>("GET", `/api/v1/shops/${encodeURIComponent(String(id))}/barbers`, undefined, undefined, true, init);
+  }
+
+  /** Add a barber to a shop (POST /api/v1/shops/{id}/barbers) */
+  addBarberToShop(id: number, body: AddShopBarberRequest, init?: RequestInit): Promise<Barber> {
+    return this.request<Barber>("POST", `/api/v1/shops/${encodeURIComponent(String(id))}/barbers`, undefined, body, true, init);
+  }
+
+  /** Remove a barber from a shop (DELETE /api/v1/shops/{id}/barbers/{barberId}) */
+  removeBarberFromShop(id: number, barberId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/shops/${encodeURIComponent(String(id))}/barbers/${encodeURIComponent(String(barberId))}`, undefined, undefined, true, init);
+  }
+
+  /** Get a shop's bookings (GET /api/v1/shops/{id}/bookings) */
+  getShopsBookings(id: number, params?: GetShopsBookingsParams, init?: RequestInit): Promise<BookingResponse[]> {
+    return this.request<BookingResponse[]>("GET", `/api/v1/shops/${encodeURIComponent(String(id))}/bookings`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Export a shop's data (GET /api/v1/shops/{id}/export) */
+  exportShopsData(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("GET", `/api/v1/shops/${encodeURIComponent(String(id))}/export`, undefined, undefined, false, init);
+  }
+
+  /** Cancel leaving the platform (DELETE /api/v1/shops/{id}/offboarding) */
+  cancelLeavingPlatform(id: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/shops/${encodeURIComponent(String(id))}/offboarding`, undefined, undefined, true, init);
+  }
+
+  /** Get a shop's offboarding (GET /api/v1/shops/{id}/offboarding) */
+  getShopsOffboarding(id: number, init?: RequestInit): Promise<ShopOffboarding> {
+    return this.request<ShopOffboarding>("GET", `/api/v1/shops/${encodeURIComponent(String(id))}/offboarding`, undefined, undefined, true, init);
+  }
+
+  /** Leave the platform (POST /api/v1/shops/{id}/offboarding) */
+  leavePlatform(id: number, body: ShopOffboardingRequest, init?: RequestInit): Promise<ShopOffboarding> {
+    return this.request<ShopOffboarding>("POST", `/api/v1/shops/${encodeURIComponent(String(id))}/offboarding`, undefined, body, true, init);
+  }
+
+  /** List a shop's tax rules (GET /api/v1/shops/{id}/tax-rules) */
+  listShopsTaxRules(id: number, init?: RequestInit): Promise<TaxRule[]> {
+    return this.request<TaxRule[]>("GET", `/api/v1/shops/${encodeURIComponent(String(id))}/tax-rules`, undefined, undefined, true, init);
+  }
+
+  /** Add a shop tax rule (POST /api/v1/shops/{id}/tax-rules) */
+  addShopTaxRule(id: number, body: TaxRuleRequest, init?: RequestInit): Promise<TaxRule> {
+    return this.request<TaxRule>("POST", `/api/v1/shops/${encodeURIComponent(String(id))}/tax-rules`, undefined, body, true, init);
+  }
+
+  /** Delete a shop tax rule (DELETE /api/v1/shops/{id}/tax-rules/{ruleId}) */
+  deleteShopTaxRule(id: number, ruleId: number, init?: RequestInit): Promise<unknown> {
+    return this.request<unknown>("DELETE", `/api/v1/shops/${encodeURIComponent(String(id))}/tax-rules/${encodeURIComponent(String(ruleId))}`, undefined, undefined, true, init);
+  }
+
+  /** Platform status (GET /api/v1/status) */
+  platformStatus(init?: RequestInit): Promise<StatusPage> {
+    return this.request<StatusPage>("GET", `/api/v1/status`, undefined, undefined, true, init);
+  }
+
+  /** List my support tickets (GET /api/v1/support/tickets) */
+  listMySupportTickets(params?: ListMySupportTicketsParams, init?: RequestInit): Promise<SupportTicket[]> {
+    return this.request<SupportTicket[]>("GET", `/api/v1/support/tickets`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Open a support ticket (POST /api/v1/support/tickets) */
+  openSupportTicket(body: CreateSupportTicketRequest, init?: RequestInit): Promise<SupportTicketResponse> {
+    return this.request<SupportTicketResponse>("POST", `/api/v1/support/tickets`, undefined, body, true, init);
+  }
+
+  /** Get one of my support tickets (GET /api/v1/support/tickets/{id}) */
+  getOneOfMySupportTickets(id: number, init?: RequestInit): Promise<SupportTicketResponse> {
+    return this.request<SupportTicketResponse>("GET", `/api/v1/support/tickets/${encodeURIComponent(String(id))}`, undefined, undefined, true, init);
+  }
+
+  /** Close one of my support tickets (POST /api/v1/support/tickets/{id}/close) */
+  closeOneOfMySupportTickets(id: number, init?: RequestInit): Promise<SupportTicketResponse> {
+    return this.request<SupportTicketResponse>("POST", `/api/v1/support/tickets/${encodeURIComponent(String(id))}/close`, undefined, undefined, true, init);
+  }
+
+  /** Reply to one of my support tickets (POST /api/v1/support/tickets/{id}/messages) */
+  replyToOneOfMySupportTickets(id: number, body: SupportReplyRequest, init?: RequestInit): Promise<SupportTicketMessage> {
+    return this.request<SupportTicketMessage>("POST", `/api/v1/support/tickets/${encodeURIComponent(String(id))}/messages`, undefined, body, true, init);
+  }
+
+  /** Answer an NPS survey (POST /api/v1/surveys/nps/{token}) */
+  answerNpsSurvey(token: string, body: NpsResponseRequest, init?: RequestInit): Promise<NpsResponseResult> {
+    return this.request<NpsResponseResult>("POST", `/api/v1/surveys/nps/${encodeURIComponent(String(token))}`, undefined, body, true, init);
+  }
+
+  /** Get my API usage (GET /api/v1/usage) */
+  getMyApiUsage(params?: GetMyAPIUsageParams, init?: RequestInit): Promise<APIUsageReport> {
+    return this.request<APIUsageReport>("GET", `/api/v1/usage`, params as QueryParams | undefined, undefined, true, init);
+  }
+
+  /** Get my notification preferences (GET /api/v1/users/me/notification-preferences) */
+  getMyNotificationPreferences(init?: RequestInit): Promise<NotificationPreferenceResponse[]> {
+    return this.request<NotificationPreferenceResponse[]>("GET", `/api/v1/users/me/notification-preferences`, undefined, undefined, true, init);
+  }
+
+  /** Update my notification preferences (PUT /api/v1/users/me/notification-preferences) */
+  updateMyNotificationPreferences(body: UpdateNotificationPreferencesRequest, init?: RequestInit): Promise<NotificationPreferenceResponse[]> {
+    return this.request<NotificationPreferenceResponse[]>("PUT", `/api/v1/users/me/notification-preferences`, undefined, body, true, init);
+  }
+
+  /** Live updates (WebSocket) (GET /api/v1/ws) */
+  liveUpdatesWebSocket(params?: LiveUpdatesWebSocketParams, init?: RequestInit): Promise<void> {
+    return this.request<void>("GET", `/api/v1/ws`, params as QueryParams | undefined, undefined, false, init);
+  }
+
+}
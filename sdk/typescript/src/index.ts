@@ -0,0 +1,2 @@
+// sdk/typescript/src/index.ts
+export * from "./client.gen";
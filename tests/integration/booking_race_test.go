@@ -0,0 +1,290 @@
+// tests/integration/booking_race_test.go
+package integration
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"barber-booking-system/config"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// =============================================================================
+// BOOKING RACE-CONDITION REGRESSION TESTS
+// =============================================================================
+// These tests hammer CreateBooking for the same slot from many goroutines and
+// several independent connection pools. Exactly one booking must win; every
+// other attempt must fail with the slot-unavailable error rather than a
+// double booking or a 500. Run with -race (see scripts/test_race.sh).
+//
+// Writes must be committed to be visible across connections, so these tests
+// use a shared (non-isolated) database and delete their bookings afterwards.
+// =============================================================================
+
+const raceAttempts = 20
+
+// slotUnavailableMessage is the error CreateBooking returns for conflicts
+const slotUnavailableMessage = "time slot is not available, please choose another time"
+
+// raceFixture is an active barber/service pair plus a slot nobody else uses
+type raceFixture struct {
+	barberID  int
+	serviceID int
+	start     time.Time
+}
+
+// setupSharedTestDatabase returns a connection pool whose writes are committed
+func setupSharedTestDatabase(t *testing.T) *sqlx.DB {
+	t.Helper()
+
+	dbManager, err := config.NewDatabaseManager(getTestConfig(t).Database)
+	if err != nil {
+		t.Fatalf("Failed to connect to test database: %v", err)
+	}
+	t.Cleanup(func() { dbManager.Close() })
+	return dbManager.DB
+}
+
+// newRaceFixture picks an active barber service and a random slot 10-25 days
+// out, and removes any bookings the test creates in that window
+func newRaceFixture(t *testing.T, db *sqlx.DB) raceFixture {
+	t.Helper()
+
+	var fx raceFixture
+	err := db.QueryRowx(`
+		SELECT bs.id, bs.barber_id
+		FROM barber_services bs
+		JOIN barbers b ON b.id = bs.barber_id
+		WHERE bs.is_active = true AND b.status = 'active'
+		ORDER BY bs.id
+		LIMIT 1
+	`).Scan(&fx.serviceID, &fx.barberID)
+	if err != nil {
+		t.Skipf("No active barber service available for race tests: %v", err)
+	}
+
+	// Minute granularity across a two-week window keeps parallel runs apart
+	offset := time.Duration(rand.Intn(14*24*60)) * time.Minute
+	fx.start = time.Now().Add(10 * 24 * time.Hour).Add(offset).Truncate(time.Minute)
+
+	t.Cleanup(func() {
+		_, _ = db.Exec(`
+			DELETE FROM bookings
+			WHERE barber_id = $1 AND customer_email LIKE 'race+%@test.com'
+			AND scheduled_start_time BETWEEN $2 AND $3
+		`, fx.barberID, fx.start.Add(-24*time.Hour), fx.start.Add(24*time.Hour))
+	})
+
+	return fx
+}
+
+// raceRequest builds a guest booking request for the fixture slot
+func raceRequest(fx raceFixture, attempt int, startOffset time.Duration) services.CreateBookingRequest {
+	name := fmt.Sprintf("Race Customer %d", attempt)
+	email := fmt.Sprintf("race+%d-%d@test.com", fx.start.Unix(), attempt)
+	return services.CreateBookingRequest{
+		BarberID:        fx.barberID,
+		ServiceID:       fx.serviceID,
+		StartTime:       fx.start.Add(startOffset),
+		DurationMinutes: 45,
+		CustomerName:    &name,
+		CustomerEmail:   &email,
+	}
+}
+
+// newBookingServiceFor builds a booking service on its own connection pool
+func newBookingServiceFor(db *sqlx.DB) *services.BookingService {
+	return services.NewBookingService(
+		repository.NewBookingRepository(db),
+		repository.NewBookingSeriesRepository(db),
+		repository.NewBarberScheduleRepository(db),
+		repository.NewBarberRepository(db),
+		repository.NewServiceRepository(db),
+		nil,
+	)
+}
+
+// raceResult tallies concurrent booking outcomes
+type raceResult struct {
+	mu        sync.Mutex
+	succeeded []int
+	conflicts int
+	other     []error
+}
+
+func (r *raceResult) record(resp *services.BookingResponse, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	switch {
+	case err == nil:
+		r.succeeded = append(r.succeeded, resp.ID)
+	case err.Error() == slotUnavailableMessage:
+		r.conflicts++
+	default:
+		r.other = append(r.other, err)
+	}
+}
+
+// assertSingleWinner checks exactly one booking exists and the rest conflicted
+func assertSingleWinner(t *testing.T, db *sqlx.DB, fx raceFixture, result *raceResult, attempts int) {
+	t.Helper()
+
+	assert.Empty(t, result.other, "unexpected errors: %v", result.other)
+	assert.Len(t, result.succeeded, 1, "exactly one concurrent booking must succeed")
+	assert.Equal(t, attempts-1, result.conflicts)
+
+	var active int
+	err := db.Get(&active, `
+		SELECT COUNT(*) FROM bookings
+		WHERE barber_id = $1
+		AND status IN ('pending', 'confirmed', 'in_progress')
+		AND scheduled_start_time < $3 AND scheduled_end_time > $2
+	`, fx.barberID, fx.start.Add(-time.Hour), fx.start.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 1, active, "database must hold a single active booking for the slot")
+}
+
+// runConcurrently starts all attempts at once and waits for them
+func runConcurrently(attempts int, fn func(i int)) {
+	var start, done sync.WaitGroup
+	start.Add(1)
+	done.Add(attempts)
+	for i := 0; i < attempts; i++ {
+		go func(i int) {
+			defer done.Done()
+			start.Wait()
+			fn(i)
+		}(i)
+	}
+	start.Done()
+	done.Wait()
+}
+
+func TestBookingRace_SameSlotSharedPool(t *testing.T) {
+	db := setupSharedTestDatabase(t)
+	fx := newRaceFixture(t, db)
+	service := newBookingServiceFor(db)
+
+	result := &raceResult{}
+	runConcurrently(raceAttempts, func(i int) {
+		result.record(service.CreateBooking(context.Background(), raceRequest(fx, i, 0), nil))
+	})
+
+	assertSingleWinner(t, db, fx, result, raceAttempts)
+}
+
+func TestBookingRace_SameSlotSeparatePools(t *testing.T) {
+	db := setupSharedTestDatabase(t)
+	fx := newRaceFixture(t, db)
+
+	// Each "server instance" gets its own pool, as with several API replicas
+	const instances = 4
+	servicesByInstance := make([]*services.BookingService, instances)
+	for i := range servicesByInstance {
+		servicesByInstance[i] = newBookingServiceFor(setupSharedTestDatabase(t))
+	}
+
+	result := &raceResult{}
+	runConcurrently(raceAttempts, func(i int) {
+		service := servicesByInstance[i%instances]
+		result.record(service.CreateBooking(context.Background(), raceRequest(fx, i, 0), nil))
+	})
+
+	assertSingleWinner(t, db, fx, result, raceAttempts)
+}
+
+func TestBookingRace_OverlappingSlots(t *testing.T) {
+	db := setupSharedTestDatabase(t)
+	fx := newRaceFixture(t, db)
+	service := newBookingServiceFor(db)
+
+	// 45-minute bookings starting 0-40 minutes apart all overlap one another
+	offsets := []time.Duration{0, 10 * time.Minute, 20 * time.Minute, 30 * time.Minute, 40 * time.Minute}
+
+	result := &raceResult{}
+	runConcurrently(raceAttempts, func(i int) {
+		req := raceRequest(fx, i, offsets[i%len(offsets)])
+		result.record(service.CreateBooking(context.Background(), req, nil))
+	})
+
+	assertSingleWinner(t, db, fx, result, raceAttempts)
+}
+
+func TestBookingRace_AdjacentSlotsBothSucceed(t *testing.T) {
+	db := setupSharedTestDatabase(t)
+	fx := newRaceFixture(t, db)
+	service := newBookingServiceFor(db)
+
+	// Back-to-back bookings touch but do not overlap, so neither may be rejected
+	result := &raceResult{}
+	runConcurrently(2, func(i int) {
+		req := raceRequest(fx, i, time.Duration(i)*45*time.Minute)
+		result.record(service.CreateBooking(context.Background(), req, nil))
+	})
+
+	assert.Empty(t, result.other)
+	assert.Len(t, result.succeeded, 2)
+	assert.Zero(t, result.conflicts)
+}
+
+func TestBookingRace_OverlappingAPassiveSegmentBooking(t *testing.T) {
+	db := setupSharedTestDatabase(t)
+	fx := newRaceFixture(t, db)
+	service := newBookingServiceFor(db)
+	ctx := context.Background()
+
+	// Give the service a passive middle segment for 60-minute bookings; the
+	// overlap constraint does not cover such bookings
+	var original []byte
+	require.NoError(t, db.Get(&original, `SELECT duration_segments FROM barber_services WHERE id = $1`, fx.serviceID))
+	_, err := db.Exec(`UPDATE barber_services SET duration_segments = $1 WHERE id = $2`,
+		`[{"minutes": 15, "passive": false}, {"minutes": 30, "passive": true}, {"minutes": 15, "passive": false}]`, fx.serviceID)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_, _ = db.Exec(`UPDATE barber_services SET duration_segments = $1 WHERE id = $2`, original, fx.serviceID)
+	})
+
+	segmented := raceRequest(fx, raceAttempts, 0)
+	segmented.DurationMinutes = 60
+	existing, err := service.CreateBooking(ctx, segmented, nil)
+	require.NoError(t, err)
+
+	// Another transaction holds the segmented booking's row while two fully
+	// active bookings over its first active segment race each other
+	holder, err := db.Beginx()
+	require.NoError(t, err)
+	_, err = holder.Exec(`SELECT id FROM bookings WHERE id = $1 FOR UPDATE`, existing.ID)
+	require.NoError(t, err)
+	go func() {
+		time.Sleep(300 * time.Millisecond)
+		holder.Rollback()
+	}()
+
+	result := &raceResult{}
+	runConcurrently(2, func(i int) {
+		result.record(service.CreateBooking(ctx, raceRequest(fx, i, 0), nil))
+	})
+
+	assert.Empty(t, result.other, "unexpected errors: %v", result.other)
+	assert.Empty(t, result.succeeded, "a locked booking must still block the slot")
+	assert.Equal(t, 2, result.conflicts)
+
+	var active int
+	require.NoError(t, db.Get(&active, `
+		SELECT COUNT(*) FROM bookings
+		WHERE barber_id = $1
+		AND status IN ('pending', 'confirmed', 'in_progress')
+		AND scheduled_start_time < $3 AND scheduled_end_time > $2
+	`, fx.barberID, fx.start, fx.start.Add(time.Hour)))
+	assert.Equal(t, 1, active, "only the segmented booking may hold the slot")
+}
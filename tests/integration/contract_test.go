@@ -0,0 +1,66 @@
+// tests/integration/contract_test.go
+package integration
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"barber-booking-system/internal/apispec"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHandlersMatchDocumentedSchemas replays representative requests against
+// the real router and fails if any response drifts from docs/swagger.json
+func TestHandlersMatchDocumentedSchemas(t *testing.T) {
+	router, dbManager, jwtSecret := setupTestRouter(t)
+	defer dbManager.Close()
+
+	spec, err := apispec.Load(filepath.Join("..", "..", "docs", "swagger.json"))
+	require.NoError(t, err)
+	validator := apispec.NewValidator(spec)
+
+	token, err := generateTestToken(1, "contract@example.com", "customer", jwtSecret)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		body   string
+		auth   bool
+	}{
+		{"list barbers", "GET", "/api/v1/barbers?limit=5", "", false},
+		{"search barbers", "GET", "/api/v1/barbers/search?q=fade", "", false},
+		{"barber not found", "GET", "/api/v1/barbers/999999", "", false},
+		{"list services", "GET", "/api/v1/services?limit=5", "", false},
+		{"service categories", "GET", "/api/v1/services/categories", "", false},
+		{"barber reviews", "GET", "/api/v1/barbers/1/reviews", "", false},
+		{"availability", "GET", "/api/v1/bookings/availability?barber_id=1&start_time=2030-01-01T10:00:00Z&duration=30", "", false},
+		{"login bad credentials", "POST", "/api/v1/auth/login", `{"email":"nobody@example.com","password":"wrong-password"}`, false},
+		{"login invalid body", "POST", "/api/v1/auth/login", `{"email":"not-an-email"}`, false},
+		{"my bookings", "GET", "/api/v1/bookings/me", "", true},
+		{"my notifications", "GET", "/api/v1/notifications", "", true},
+		{"unauthenticated", "GET", "/api/v1/bookings/me", "", false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req, _ := http.NewRequest(tc.method, tc.path, bytes.NewBufferString(tc.body))
+			if tc.body != "" {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			if tc.auth {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+			router.ServeHTTP(w, req)
+
+			assert.NoError(t, validator.ValidateResponse(tc.method, tc.path, w.Code, w.Body.Bytes()))
+		})
+	}
+}
@@ -0,0 +1,15 @@
+// tests/integration/main_test.go
+package integration
+
+import (
+	"os"
+	"testing"
+
+	"barber-booking-system/tests/testenv"
+)
+
+// TestMain starts Postgres and Redis containers unless DATABASE_URL points at
+// an existing database (set TESTCONTAINERS=1 to force containers)
+func TestMain(m *testing.M) {
+	os.Exit(testenv.RunMain(m))
+}
@@ -11,6 +11,7 @@ import (
 	"barber-booking-system/config"
 	appConfig "barber-booking-system/internal/config"
 	"barber-booking-system/internal/routes"
+	"barber-booking-system/tests/testenv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -44,6 +45,11 @@ func getTestConfig(t *testing.T) *appConfig.Config {
 }
 
 func setupTestDatabase(t *testing.T, cfg *appConfig.Config) *config.DatabaseManager {
+	// Under the container harness each test runs in its own rolled-back transaction
+	if env := testenv.Current(); env != nil {
+		return &config.DatabaseManager{DB: env.IsolatedDB(t), Config: cfg.Database}
+	}
+
 	dbManager, err := config.NewDatabaseManager(cfg.Database)
 	if err != nil {
 		t.Fatalf("Failed to connect to test database: %v", err)
@@ -0,0 +1,48 @@
+// tests/testenv/schema.go
+package testenv
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ApplySchema loads the baseline schema, every up migration in version order,
+// and optionally the seed scripts
+func ApplySchema(ctx context.Context, db *sqlx.DB, root string, seed bool) error {
+	files := []string{filepath.Join(root, "scripts", "schema", "baseline.sql")}
+
+	migrations, err := filepath.Glob(filepath.Join(root, "migrations", "*.up.sql"))
+	if err != nil {
+		return fmt.Errorf("failed to list migrations: %w", err)
+	}
+	// Zero-padded version prefixes sort lexically in version order
+	sort.Strings(migrations)
+	files = append(files, migrations...)
+
+	if seed {
+		seeds, err := filepath.Glob(filepath.Join(root, "scripts", "seeds", "*.sql"))
+		if err != nil {
+			return fmt.Errorf("failed to list seeds: %w", err)
+		}
+		sort.Strings(seeds)
+		files = append(files, seeds...)
+	}
+
+	for _, file := range files {
+		sql, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		// lib/pq runs multi-statement scripts when no arguments are passed
+		if _, err := db.ExecContext(ctx, string(sql)); err != nil {
+			return fmt.Errorf("failed to apply %s: %w", filepath.Base(file), err)
+		}
+	}
+
+	return nil
+}
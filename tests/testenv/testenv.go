@@ -0,0 +1,235 @@
+// tests/testenv/testenv.go
+
+// Package testenv provides a self-contained integration test environment:
+// Postgres and Redis run in throwaway containers (testcontainers), the schema,
+// migrations and seeds are applied once, and each test gets its own
+// transaction that is rolled back when the test ends.
+package testenv
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/seed"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/testcontainers/testcontainers-go"
+	tcpostgres "github.com/testcontainers/testcontainers-go/modules/postgres"
+	tcredis "github.com/testcontainers/testcontainers-go/modules/redis"
+)
+
+const (
+	// DefaultPostgresImage matches the minimum supported Postgres version
+	DefaultPostgresImage = "postgres:15-alpine"
+	// DefaultRedisImage matches the minimum supported Redis version
+	DefaultRedisImage = "redis:7-alpine"
+
+	// UseContainersEnv forces containers even when DATABASE_URL is set
+	UseContainersEnv = "TESTCONTAINERS"
+
+	testJWTSecret = "testenv-jwt-secret-with-at-least-32-characters"
+)
+
+// Options configures the environment
+type Options struct {
+	PostgresImage string
+	RedisImage    string
+	SkipRedis     bool
+	SkipSeeds     bool
+	// Generate, when set, also populates the database with generated data
+	// (see internal/seed); the plan is kept in Environment.Seeded
+	Generate *seed.Options
+	// ProjectRoot locates scripts/schema, migrations and scripts/seeds; defaults to the module root
+	ProjectRoot string
+}
+
+// Environment is a running set of test dependencies
+type Environment struct {
+	DatabaseURL string
+	RedisURL    string
+	Seeded      *seed.Plan // nil unless Options.Generate was set
+
+	db       *sqlx.DB
+	postgres *tcpostgres.PostgresContainer
+	redis    *tcredis.RedisContainer
+}
+
+var (
+	currentMu sync.RWMutex
+	current   *Environment
+)
+
+// Current returns the environment started by RunMain, or nil when tests run
+// against an externally provisioned database
+func Current() *Environment {
+	currentMu.RLock()
+	defer currentMu.RUnlock()
+	return current
+}
+
+// Start launches Postgres (and Redis unless skipped), then applies the
+// baseline schema, migrations and seeds
+func Start(ctx context.Context, opts Options) (*Environment, error) {
+	if opts.PostgresImage == "" {
+		opts.PostgresImage = DefaultPostgresImage
+	}
+	if opts.RedisImage == "" {
+		opts.RedisImage = DefaultRedisImage
+	}
+	if opts.ProjectRoot == "" {
+		opts.ProjectRoot = moduleRoot()
+	}
+
+	env := &Environment{}
+
+	pg, err := tcpostgres.Run(ctx, opts.PostgresImage,
+		tcpostgres.WithDatabase("barbershop_test"),
+		tcpostgres.WithUsername("barbershop"),
+		tcpostgres.WithPassword("barbershop"),
+		tcpostgres.BasicWaitStrategies(),
+	)
+	env.postgres = pg
+	if err != nil {
+		env.Terminate(ctx)
+		return nil, fmt.Errorf("failed to start postgres: %w", err)
+	}
+
+	env.DatabaseURL, err = pg.ConnectionString(ctx, "sslmode=disable")
+	if err != nil {
+		env.Terminate(ctx)
+		return nil, fmt.Errorf("failed to get postgres connection string: %w", err)
+	}
+
+	env.db, err = sqlx.Connect("postgres", env.DatabaseURL)
+	if err != nil {
+		env.Terminate(ctx)
+		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+
+	if err := ApplySchema(ctx, env.db, opts.ProjectRoot, !opts.SkipSeeds); err != nil {
+		env.Terminate(ctx)
+		return nil, err
+	}
+
+	if opts.Generate != nil {
+		now := time.Now()
+		plan := seed.NewPlan(*opts.Generate, now)
+		if _, err := seed.Run(ctx, env.db, plan, now); err != nil {
+			env.Terminate(ctx)
+			return nil, fmt.Errorf("failed to generate seed data: %w", err)
+		}
+		env.Seeded = plan
+	}
+
+	if !opts.SkipRedis {
+		rc, err := tcredis.Run(ctx, opts.RedisImage)
+		env.redis = rc
+		if err != nil {
+			env.Terminate(ctx)
+			return nil, fmt.Errorf("failed to start redis: %w", err)
+		}
+		env.RedisURL, err = rc.ConnectionString(ctx)
+		if err != nil {
+			env.Terminate(ctx)
+			return nil, fmt.Errorf("failed to get redis connection string: %w", err)
+		}
+	}
+
+	registerTxDriver(env.DatabaseURL)
+	return env, nil
+}
+
+// Terminate stops the containers; safe to call on a partially started environment
+func (e *Environment) Terminate(ctx context.Context) {
+	if e.db != nil {
+		e.db.Close()
+	}
+	if e.redis != nil {
+		if err := testcontainers.TerminateContainer(e.redis); err != nil {
+			log.Printf("testenv: failed to terminate redis: %v", err)
+		}
+	}
+	if e.postgres != nil {
+		if err := testcontainers.TerminateContainer(e.postgres); err != nil {
+			log.Printf("testenv: failed to terminate postgres: %v", err)
+		}
+	}
+}
+
+// Setenv exports connection settings so config.Load picks up the containers.
+// JWT_SECRET is only set when the caller has not provided one.
+func (e *Environment) Setenv() {
+	os.Setenv("DATABASE_URL", e.DatabaseURL)
+	os.Setenv("TEST_DATABASE_URL", e.DatabaseURL)
+	if e.RedisURL != "" {
+		os.Setenv("REDIS_URL", e.RedisURL)
+	}
+	if os.Getenv("JWT_SECRET") == "" {
+		os.Setenv("JWT_SECRET", testJWTSecret)
+	}
+}
+
+// DB returns a shared (non-isolated) connection for setup that must be visible to every test
+func (e *Environment) DB() *sqlx.DB {
+	return e.db
+}
+
+// IsolatedDB returns a database handle whose work runs inside a single
+// transaction that is rolled back when the test finishes. Nested transactions
+// opened by repositories become savepoints, so application code runs unchanged.
+func (e *Environment) IsolatedDB(tb testing.TB) *sqlx.DB {
+	tb.Helper()
+
+	db, err := sqlx.Open(txDriverName, fmt.Sprintf("%s-%d", tb.Name(), time.Now().UnixNano()))
+	if err != nil {
+		tb.Fatalf("testenv: failed to open isolated db: %v", err)
+	}
+	// One physical connection holds the transaction; database/sql must not open more
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+
+	tb.Cleanup(func() { db.Close() })
+	return db
+}
+
+// RunMain is a drop-in TestMain body. When DATABASE_URL (or TEST_DATABASE_URL)
+// is already set and TESTCONTAINERS is not "1", tests run against that
+// database as before; otherwise containers are started for the package.
+func RunMain(m *testing.M) int {
+	external := os.Getenv("DATABASE_URL") != "" || os.Getenv("TEST_DATABASE_URL") != ""
+	if external && os.Getenv(UseContainersEnv) != "1" {
+		return m.Run()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	env, err := Start(ctx, Options{})
+	cancel()
+	if err != nil {
+		log.Printf("testenv: %v (is Docker running?)", err)
+		return 1
+	}
+	defer env.Terminate(context.Background())
+
+	env.Setenv()
+	currentMu.Lock()
+	current = env
+	currentMu.Unlock()
+
+	return m.Run()
+}
+
+// moduleRoot finds the repository root relative to this source file
+func moduleRoot() string {
+	_, file, _, ok := runtime.Caller(0)
+	if !ok {
+		return "."
+	}
+	return filepath.Join(filepath.Dir(file), "..", "..")
+}
@@ -0,0 +1,146 @@
+// tests/testenv/txdriver.go
+package testenv
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"sync"
+
+	"github.com/lib/pq"
+)
+
+// txDriverName is the database/sql driver used by IsolatedDB
+const txDriverName = "testenv-txdb"
+
+var registerOnce sync.Once
+
+// registerTxDriver registers the transactional driver for dsn. The DSN is fixed
+// at registration; the name passed to sql.Open only labels the connection.
+func registerTxDriver(dsn string) {
+	registerOnce.Do(func() {
+		sql.Register(txDriverName, &txDriver{dsn: dsn})
+	})
+}
+
+// txDriver opens a real Postgres connection per sql.Open name, begins a
+// transaction on it, and rolls back when the connection is closed
+type txDriver struct {
+	dsn string
+}
+
+func (d *txDriver) Open(_ string) (driver.Conn, error) {
+	conn, err := pq.Open(d.dsn)
+	if err != nil {
+		return nil, err
+	}
+	c := &txConn{conn: conn}
+	if err := c.execRaw(context.Background(), "BEGIN"); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("testenv: failed to begin isolation transaction: %w", err)
+	}
+	return c, nil
+}
+
+// txConn wraps a pq connection that is permanently inside a transaction.
+// Application transactions map to savepoints, and every statement outside an
+// application transaction runs under its own savepoint so a failing query
+// (e.g. an expected unique violation) does not abort the whole test.
+type txConn struct {
+	conn      driver.Conn
+	savepoint int
+	inTx      bool
+}
+
+func (c *txConn) execRaw(ctx context.Context, query string) error {
+	_, err := c.conn.(driver.ExecerContext).ExecContext(ctx, query, nil)
+	return err
+}
+
+func (c *txConn) nextSavepoint() string {
+	c.savepoint++
+	return fmt.Sprintf("testenv_sp_%d", c.savepoint)
+}
+
+// guard runs fn under a savepoint unless an application transaction is open
+func (c *txConn) guard(ctx context.Context, release bool, fn func() error) error {
+	if c.inTx {
+		return fn()
+	}
+	sp := c.nextSavepoint()
+	if err := c.execRaw(ctx, "SAVEPOINT "+sp); err != nil {
+		return err
+	}
+	if err := fn(); err != nil {
+		_ = c.execRaw(ctx, "ROLLBACK TO SAVEPOINT "+sp)
+		return err
+	}
+	if release {
+		return c.execRaw(ctx, "RELEASE SAVEPOINT "+sp)
+	}
+	return nil
+}
+
+func (c *txConn) Prepare(query string) (driver.Stmt, error) {
+	return c.conn.Prepare(query)
+}
+
+func (c *txConn) Close() error {
+	_ = c.execRaw(context.Background(), "ROLLBACK")
+	return c.conn.Close()
+}
+
+func (c *txConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *txConn) BeginTx(ctx context.Context, _ driver.TxOptions) (driver.Tx, error) {
+	if c.inTx {
+		return nil, fmt.Errorf("testenv: nested application transactions are not supported")
+	}
+	sp := c.nextSavepoint()
+	if err := c.execRaw(ctx, "SAVEPOINT "+sp); err != nil {
+		return nil, err
+	}
+	c.inTx = true
+	return &txSavepoint{conn: c, name: sp}, nil
+}
+
+func (c *txConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	var result driver.Result
+	err := c.guard(ctx, true, func() error {
+		var err error
+		result, err = c.conn.(driver.ExecerContext).ExecContext(ctx, query, args)
+		return err
+	})
+	return result, err
+}
+
+func (c *txConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	var rows driver.Rows
+	// Rows stream on the connection, so the savepoint cannot be released yet;
+	// it is harmless to leave it open until the outer rollback
+	err := c.guard(ctx, false, func() error {
+		var err error
+		rows, err = c.conn.(driver.QueryerContext).QueryContext(ctx, query, args)
+		return err
+	})
+	return rows, err
+}
+
+// txSavepoint is an application transaction mapped onto a savepoint
+type txSavepoint struct {
+	conn *txConn
+	name string
+}
+
+func (t *txSavepoint) Commit() error {
+	t.conn.inTx = false
+	return t.conn.execRaw(context.Background(), "RELEASE SAVEPOINT "+t.name)
+}
+
+func (t *txSavepoint) Rollback() error {
+	t.conn.inTx = false
+	return t.conn.execRaw(context.Background(), "ROLLBACK TO SAVEPOINT "+t.name)
+}
@@ -0,0 +1,75 @@
+// tests/unit/actionlink/actionlink_test.go
+package actionlink
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/actionlink"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testClaims(now time.Time) actionlink.Claims {
+	return actionlink.Claims{
+		LinkID:    "a1b2c3",
+		BookingID: 42,
+		Action:    "confirm",
+		ExpiresAt: now.Add(time.Hour).Truncate(time.Second).UTC(),
+	}
+}
+
+func TestNewSigner_RequiresSecret(t *testing.T) {
+	_, err := actionlink.NewSigner("")
+	assert.Error(t, err)
+}
+
+func TestSigner_RoundTrip(t *testing.T) {
+	signer, err := actionlink.NewSigner("secret")
+	require.NoError(t, err)
+	now := time.Now()
+	claims := testClaims(now)
+
+	got, err := signer.Verify(signer.Sign(claims), now)
+	require.NoError(t, err)
+	assert.Equal(t, claims, got)
+}
+
+func TestSigner_Expired(t *testing.T) {
+	signer, _ := actionlink.NewSigner("secret")
+	now := time.Now()
+	claims := testClaims(now)
+	token := signer.Sign(claims)
+
+	_, err := signer.Verify(token, claims.ExpiresAt)
+	assert.ErrorIs(t, err, actionlink.ErrExpired)
+	_, err = signer.Verify(token, claims.ExpiresAt.Add(time.Minute))
+	assert.ErrorIs(t, err, actionlink.ErrExpired)
+}
+
+func TestSigner_RejectsTampering(t *testing.T) {
+	signer, _ := actionlink.NewSigner("secret")
+	other, _ := actionlink.NewSigner("other secret")
+	now := time.Now()
+	claims := testClaims(now)
+	token := signer.Sign(claims)
+
+	// Signed with another secret
+	_, err := signer.Verify(other.Sign(claims), now)
+	assert.ErrorIs(t, err, actionlink.ErrInvalidToken)
+
+	// Payload swapped for one naming another action
+	cancel := claims
+	cancel.Action = "cancel"
+	forgedPayload, _, _ := strings.Cut(other.Sign(cancel), ".")
+	_, signature, _ := strings.Cut(token, ".")
+	_, err = signer.Verify(forgedPayload+"."+signature, now)
+	assert.ErrorIs(t, err, actionlink.ErrInvalidToken)
+
+	for _, bad := range []string{"", "no-separator", token + "x", "." + token} {
+		_, err := signer.Verify(bad, now)
+		assert.ErrorIs(t, err, actionlink.ErrInvalidToken, bad)
+	}
+}
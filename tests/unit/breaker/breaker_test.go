@@ -0,0 +1,123 @@
+// tests/unit/breaker/breaker_test.go
+package breaker_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/breaker"
+	"barber-booking-system/internal/clock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errProvider = errors.New("provider unavailable")
+	errDeclined = errors.New("declined")
+)
+
+func newBreaker(t *testing.T) (*breaker.Breaker, *clock.Fake) {
+	t.Helper()
+	c := clock.NewFake(time.Date(2024, 5, 3, 12, 0, 0, 0, time.UTC))
+	b := breaker.New("stripe", breaker.Settings{
+		FailureThreshold: 3,
+		OpenTimeout:      time.Minute,
+		IsFailure:        func(err error) bool { return !errors.Is(err, errDeclined) },
+	})
+	b.SetClock(c)
+	return b, c
+}
+
+func fail() error { return errProvider }
+
+func succeed() error { return nil }
+
+func TestBreaker_OpensAfterConsecutiveFailures(t *testing.T) {
+	b, _ := newBreaker(t)
+
+	for i := 0; i < 2; i++ {
+		assert.ErrorIs(t, b.Do(fail), errProvider)
+	}
+	assert.Equal(t, breaker.StateClosed, b.State())
+
+	assert.ErrorIs(t, b.Do(fail), errProvider)
+	assert.Equal(t, breaker.StateOpen, b.State())
+
+	called := false
+	err := b.Do(func() error { called = true; return nil })
+	assert.ErrorIs(t, err, breaker.ErrOpen)
+	assert.False(t, called, "open breakers must not call the provider")
+
+	snap := b.Snapshot()
+	assert.Equal(t, 3, snap.Failures)
+	assert.Equal(t, errProvider.Error(), snap.LastError)
+	require.NotNil(t, snap.OpenedAt)
+}
+
+func TestBreaker_SuccessResetsFailures(t *testing.T) {
+	b, _ := newBreaker(t)
+
+	assert.Error(t, b.Do(fail))
+	assert.Error(t, b.Do(fail))
+	assert.NoError(t, b.Do(succeed))
+	assert.Error(t, b.Do(fail))
+	assert.Error(t, b.Do(fail))
+
+	assert.Equal(t, breaker.StateClosed, b.State())
+}
+
+func TestBreaker_IgnoresNonFailures(t *testing.T) {
+	b, _ := newBreaker(t)
+
+	for i := 0; i < 5; i++ {
+		assert.ErrorIs(t, b.Do(func() error { return errDeclined }), errDeclined)
+	}
+	assert.Equal(t, breaker.StateClosed, b.State())
+}
+
+func TestBreaker_HalfOpenTrial(t *testing.T) {
+	t.Run("success closes", func(t *testing.T) {
+		b, c := newBreaker(t)
+		for i := 0; i < 3; i++ {
+			_ = b.Do(fail)
+		}
+
+		c.Advance(time.Minute)
+		assert.Equal(t, breaker.StateHalfOpen, b.State())
+
+		assert.NoError(t, b.Do(succeed))
+		assert.Equal(t, breaker.StateClosed, b.State())
+	})
+
+	t.Run("failure reopens", func(t *testing.T) {
+		b, c := newBreaker(t)
+		for i := 0; i < 3; i++ {
+			_ = b.Do(fail)
+		}
+
+		c.Advance(time.Minute)
+		assert.ErrorIs(t, b.Do(fail), errProvider)
+		assert.Equal(t, breaker.StateOpen, b.State())
+
+		c.Advance(30 * time.Second)
+		assert.ErrorIs(t, b.Do(succeed), breaker.ErrOpen)
+	})
+
+	t.Run("one trial at a time", func(t *testing.T) {
+		b, c := newBreaker(t)
+		for i := 0; i < 3; i++ {
+			_ = b.Do(fail)
+		}
+		c.Advance(time.Minute)
+
+		err := b.Do(func() error {
+			// A second call while the trial is in flight is rejected
+			assert.ErrorIs(t, b.Do(succeed), breaker.ErrOpen)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, breaker.StateClosed, b.State())
+	})
+}
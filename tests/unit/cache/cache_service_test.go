@@ -4,6 +4,7 @@ package cache_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"barber-booking-system/internal/cache"
 	"barber-booking-system/internal/models"
@@ -211,3 +212,87 @@ func TestCacheService_TTL(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, barber.ShopName, cached.ShopName)
 }
+
+func TestCacheService_UnreadChangeSignal(t *testing.T) {
+	service := setupCacheService(t)
+	if service == nil {
+		t.Skip("Redis not available")
+		return
+	}
+
+	ctx := context.Background()
+	userID := 424242
+
+	waiter, err := service.SubscribeUnreadChanges(ctx, userID)
+	require.NoError(t, err)
+	defer waiter.Close()
+
+	// No signal yet - wait should time out
+	assert.False(t, waiter.Wait(ctx, 50*time.Millisecond))
+
+	// Publish a change - wait should return true
+	require.NoError(t, service.PublishUnreadChange(ctx, userID))
+	assert.True(t, waiter.Wait(ctx, 2*time.Second))
+}
+
+func TestCacheService_UserEventStream(t *testing.T) {
+	service := setupCacheService(t)
+	if service == nil {
+		t.Skip("Redis not available")
+		return
+	}
+
+	ctx := context.Background()
+	userID := 434343
+
+	stream, err := service.SubscribeUserEvents(ctx, userID)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	// Nothing published yet - Next should time out
+	msg, err := stream.Next(ctx, 50*time.Millisecond)
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+
+	// Events arrive as JSON
+	require.NoError(t, service.PublishUserEvent(ctx, userID, map[string]string{"type": "ping"}))
+	msg, err = stream.Next(ctx, 2*time.Second)
+	require.NoError(t, err)
+	require.NotNil(t, msg)
+	assert.False(t, msg.UnreadChanged)
+	assert.JSONEq(t, `{"type":"ping"}`, msg.Payload)
+
+	// Unread-count signals arrive on the same stream
+	require.NoError(t, service.PublishUnreadChange(ctx, userID))
+	msg, err = stream.Next(ctx, 2*time.Second)
+	require.NoError(t, err)
+	require.NotNil(t, msg)
+	assert.True(t, msg.UnreadChanged)
+}
+
+func TestCacheService_BarberEventStream(t *testing.T) {
+	service := setupCacheService(t)
+	if service == nil {
+		t.Skip("Redis not available")
+		return
+	}
+
+	ctx := context.Background()
+	barberID := 434344
+
+	stream, err := service.SubscribeBarberEvents(ctx, barberID)
+	require.NoError(t, err)
+	defer stream.Close()
+
+	// Other barbers' events are not received
+	require.NoError(t, service.PublishBarberEvent(ctx, barberID+1, map[string]string{"type": "booking.created"}))
+	msg, err := stream.Next(ctx, 50*time.Millisecond)
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+
+	require.NoError(t, service.PublishBarberEvent(ctx, barberID, map[string]string{"type": "booking.created"}))
+	msg, err = stream.Next(ctx, 2*time.Second)
+	require.NoError(t, err)
+	require.NotNil(t, msg)
+	assert.JSONEq(t, `{"type":"booking.created"}`, msg.Payload)
+}
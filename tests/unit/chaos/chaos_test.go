@@ -0,0 +1,68 @@
+// tests/unit/chaos/chaos_test.go
+package chaos_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/chaos"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	faults, err := chaos.Parse("latency=300ms, error=503, db=slow:2s, redis=down, rate=0.25")
+	require.NoError(t, err)
+
+	assert.Equal(t, 300*time.Millisecond, faults.Latency)
+	assert.Equal(t, 503, faults.Status)
+	assert.Equal(t, chaos.DependencyFault{Latency: 2 * time.Second}, faults.Dependencies[chaos.DependencyDB])
+	assert.Equal(t, chaos.DependencyFault{Down: true}, faults.Dependencies[chaos.DependencyRedis])
+	assert.Equal(t, 0.25, faults.Rate)
+	assert.Equal(t, "latency=300ms,error=503,db=slow:2s,redis=down,rate=0.25", faults.String())
+
+	assert.True(t, faults.Applies(0.1))
+	assert.False(t, faults.Applies(0.25))
+}
+
+func TestParseRejectsInvalidSpecs(t *testing.T) {
+	for _, spec := range []string{
+		"latency",
+		"latency=fast",
+		"latency=1h",
+		"error=200",
+		"rate=0",
+		"rate=1.5",
+		"redis=flaky",
+		"db=slow:-1s",
+		"kafka=down",
+	} {
+		_, err := chaos.Parse(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	assert.NoError(t, chaos.Check(context.Background(), chaos.DependencyRedis), "no faults injected")
+
+	faults, err := chaos.Parse("redis=down,db=slow:10ms")
+	require.NoError(t, err)
+	ctx := chaos.WithFaults(context.Background(), faults)
+
+	assert.ErrorIs(t, chaos.Check(ctx, chaos.DependencyRedis), chaos.ErrInjected)
+
+	start := time.Now()
+	assert.NoError(t, chaos.Check(ctx, chaos.DependencyDB))
+	assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+}
+
+func TestCheckStopsWaitingWhenContextEnds(t *testing.T) {
+	faults, err := chaos.Parse("db=slow:30s")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(chaos.WithFaults(context.Background(), faults))
+	cancel()
+
+	assert.ErrorIs(t, chaos.Check(ctx, chaos.DependencyDB), context.Canceled)
+}
@@ -0,0 +1,66 @@
+// tests/unit/clock/clock_test.go
+package clock_test
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFake_SetAndAdvance(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	fake := clock.NewFake(start)
+
+	assert.Equal(t, start, fake.Now())
+	assert.Equal(t, start, fake.Now(), "fake clock must not move on its own")
+
+	fake.Advance(90 * time.Minute)
+	assert.Equal(t, start.Add(90*time.Minute), fake.Now())
+
+	later := start.Add(48 * time.Hour)
+	fake.Set(later)
+	assert.Equal(t, later, fake.Now())
+}
+
+func TestOrSystem(t *testing.T) {
+	assert.Equal(t, clock.System, clock.OrSystem(nil))
+
+	fake := clock.NewFake(time.Now())
+	assert.Equal(t, fake, clock.OrSystem(fake))
+}
+
+func TestParseFrozen(t *testing.T) {
+	c, err := clock.ParseFrozen("")
+	require.NoError(t, err)
+	assert.Equal(t, clock.System, c)
+
+	c, err = clock.ParseFrozen("2025-03-10T09:00:00Z")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC), c.Now())
+
+	c, err = clock.ParseFrozen("2025-03-10")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC), c.Now())
+
+	_, err = clock.ParseFrozen("next tuesday")
+	assert.Error(t, err)
+}
+
+func TestBooking_IsUpcomingAt(t *testing.T) {
+	fake := clock.NewFake(time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC))
+	booking := &models.Booking{
+		Status:             config.BookingStatusConfirmed,
+		ScheduledStartTime: fake.Now().Add(2 * time.Hour),
+	}
+
+	assert.True(t, booking.IsUpcomingAt(fake.Now()))
+
+	fake.Advance(3 * time.Hour)
+	assert.False(t, booking.IsUpcomingAt(fake.Now()))
+}
@@ -0,0 +1,41 @@
+// tests/unit/config/status_hooks_test.go
+package config_test
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStatusHooks_Defaults(t *testing.T) {
+	hooks, err := config.ParseStatusHooks(config.DefaultBookingStatusHooks)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{config.StatusHookReviewRequest, config.StatusHookBarberStats, config.StatusHookNPSSurvey, config.StatusHookInventory}, hooks[config.BookingStatusCompleted])
+	assert.NotContains(t, hooks, config.BookingStatusConfirmed, "no calendar integration provides calendar_sync")
+	assert.Equal(t, []string{config.StatusHookNoShowFee, config.StatusHookBarberStats}, hooks[config.BookingStatusNoShow])
+}
+
+func TestParseStatusHooks_WhitespaceAndClearing(t *testing.T) {
+	hooks, err := config.ParseStatusHooks(" completed = review_request , ; confirmed= ;")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"review_request"}, hooks["completed"])
+	assert.Empty(t, hooks["confirmed"])
+	assert.Contains(t, hooks, "confirmed")
+
+	empty, err := config.ParseStatusHooks("")
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestParseStatusHooks_Invalid(t *testing.T) {
+	_, err := config.ParseStatusHooks("completed")
+	assert.Error(t, err)
+
+	_, err = config.ParseStatusHooks("=review_request")
+	assert.Error(t, err)
+}
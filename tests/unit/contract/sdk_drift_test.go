@@ -0,0 +1,58 @@
+// tests/unit/contract/sdk_drift_test.go
+package contract_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"barber-booking-system/internal/apispec"
+	"barber-booking-system/internal/apispec/docgen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var projectRoot = filepath.Join("..", "..", "..")
+
+func loadSpec(t *testing.T) *apispec.Spec {
+	spec, err := apispec.Load(filepath.Join(projectRoot, "docs", "swagger.json"))
+	require.NoError(t, err)
+	return spec
+}
+
+// TestSpecMatchesAnnotations fails when a handler's annotations changed
+// without regenerating docs/ (go run ./cmd/specgen)
+func TestSpecMatchesAnnotations(t *testing.T) {
+	stale, err := docgen.Stale(projectRoot)
+	require.NoError(t, err)
+	assert.Empty(t, stale, "spec is stale; run go run ./cmd/specgen && go generate ./sdk/...")
+}
+
+// TestGeneratedSDKsMatchSpec fails when docs/swagger.json changed without
+// regenerating the SDKs (go generate ./sdk/...)
+func TestGeneratedSDKsMatchSpec(t *testing.T) {
+	spec := loadSpec(t)
+
+	goSrc, err := spec.GenerateGo("barbershop")
+	require.NoError(t, err)
+
+	committedGo, err := os.ReadFile(filepath.Join(projectRoot, "sdk", "go", "barbershop", "api.gen.go"))
+	require.NoError(t, err)
+	assert.Equal(t, string(goSrc), string(committedGo), "Go SDK is stale; run go generate ./sdk/...")
+
+	committedTS, err := os.ReadFile(filepath.Join(projectRoot, "sdk", "typescript", "src", "client.gen.ts"))
+	require.NoError(t, err)
+	assert.Equal(t, string(spec.GenerateTypeScript()), string(committedTS), "TypeScript SDK is stale; run go generate ./sdk/...")
+}
+
+func TestGenerateGo_IsDeterministic(t *testing.T) {
+	spec := loadSpec(t)
+
+	first, err := spec.GenerateGo("barbershop")
+	require.NoError(t, err)
+	second, err := spec.GenerateGo("barbershop")
+	require.NoError(t, err)
+
+	assert.Equal(t, first, second)
+}
@@ -0,0 +1,120 @@
+// tests/unit/contract/validator_test.go
+package contract_test
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/apispec"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSpec = `{
+  "swagger": "2.0",
+  "paths": {
+    "/api/v1/items/{id}": {
+      "get": {
+        "responses": {
+          "200": {"schema": {"allOf": [
+            {"$ref": "#/definitions/Envelope"},
+            {"type": "object", "properties": {"data": {"$ref": "#/definitions/Item"}}}
+          ]}},
+          "404": {"schema": {"$ref": "#/definitions/Error"}}
+        }
+      }
+    },
+    "/api/v1/items/me": {
+      "get": {"responses": {"200": {"schema": {"$ref": "#/definitions/Envelope"}}}}
+    }
+  },
+  "definitions": {
+    "Envelope": {"type": "object", "properties": {"success": {"type": "boolean"}, "data": {}}},
+    "Item": {
+      "type": "object",
+      "required": ["id", "name"],
+      "properties": {
+        "id": {"type": "integer"},
+        "name": {"type": "string"},
+        "price": {"type": "number"},
+        "tags": {"type": "array", "items": {"type": "string"}}
+      }
+    },
+    "Error": {"type": "object", "properties": {"error": {"type": "string"}}}
+  }
+}`
+
+func newTestValidator(t *testing.T) *apispec.Validator {
+	spec, err := apispec.Parse([]byte(testSpec))
+	require.NoError(t, err)
+	return apispec.NewValidator(spec)
+}
+
+func TestValidateResponse_Matches(t *testing.T) {
+	v := newTestValidator(t)
+
+	body := `{"success": true, "data": {"id": 1, "name": "Fade", "price": 25.5, "tags": ["hair"]}}`
+	assert.NoError(t, v.ValidateResponse("GET", "/api/v1/items/1", 200, []byte(body)))
+}
+
+func TestValidateResponse_TypeDrift(t *testing.T) {
+	v := newTestValidator(t)
+
+	body := `{"success": true, "data": {"id": "1", "name": "Fade", "tags": [3]}}`
+	err := v.ValidateResponse("GET", "/api/v1/items/1", 200, []byte(body))
+	require.Error(t, err)
+
+	drift, ok := err.(*apispec.DriftError)
+	require.True(t, ok)
+	assert.Len(t, drift.Problems, 2)
+	assert.Contains(t, err.Error(), "$.data.id: expected integer")
+	assert.Contains(t, err.Error(), "$.data.tags[0]: expected string")
+}
+
+func TestValidateResponse_MissingRequiredAndNullable(t *testing.T) {
+	v := newTestValidator(t)
+
+	// Optional properties may be null (Go nil pointers); required ones must be present
+	body := `{"success": true, "data": {"id": 1, "price": null}}`
+	err := v.ValidateResponse("GET", "/api/v1/items/1", 200, []byte(body))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "$.data.name: required property missing")
+	assert.NotContains(t, err.Error(), "price")
+}
+
+func TestValidateResponse_UndocumentedStatusAndOperation(t *testing.T) {
+	v := newTestValidator(t)
+
+	err := v.ValidateResponse("GET", "/api/v1/items/1", 500, []byte(`{}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "status 500 is not documented")
+
+	err = v.ValidateResponse("DELETE", "/api/v1/items/1", 200, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "operation is not documented")
+}
+
+func TestValidateResponse_StrictReportsUndocumentedProperties(t *testing.T) {
+	v := newTestValidator(t)
+	body := []byte(`{"success": true, "data": {"id": 1, "name": "Fade", "colour": "red"}}`)
+
+	assert.NoError(t, v.ValidateResponse("GET", "/api/v1/items/1", 200, body))
+
+	v.Strict = true
+	err := v.ValidateResponse("GET", "/api/v1/items/1", 200, body)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "$.data.colour: undocumented property")
+}
+
+func TestFindOperation_PrefersLiteralSegments(t *testing.T) {
+	spec, err := apispec.Parse([]byte(testSpec))
+	require.NoError(t, err)
+
+	op, ok := spec.FindOperation("GET", "/api/v1/items/me?limit=5")
+	require.True(t, ok)
+	assert.Equal(t, "/api/v1/items/me", op.Path)
+
+	op, ok = spec.FindOperation("GET", "/api/v1/items/42")
+	require.True(t, ok)
+	assert.Equal(t, "/api/v1/items/{id}", op.Path)
+}
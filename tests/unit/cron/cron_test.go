@@ -0,0 +1,82 @@
+// tests/unit/cron/cron_test.go
+package cron
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/cron"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Monday 10 March 2025, 10:07 UTC
+var base = time.Date(2025, 3, 10, 10, 7, 30, 0, time.UTC)
+
+func next(t *testing.T, expr string, from time.Time) time.Time {
+	t.Helper()
+	s, err := cron.Parse(expr)
+	require.NoError(t, err, expr)
+	return s.Next(from)
+}
+
+func TestSchedule_Next(t *testing.T) {
+	tests := []struct {
+		expr string
+		want time.Time
+	}{
+		{"* * * * *", time.Date(2025, 3, 10, 10, 8, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2025, 3, 10, 10, 15, 0, 0, time.UTC)},
+		{"5/20 * * * *", time.Date(2025, 3, 10, 10, 25, 0, 0, time.UTC)},
+		{"30 3 * * *", time.Date(2025, 3, 11, 3, 30, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2025, 3, 10, 13, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 8 * * 6,7", time.Date(2025, 3, 15, 8, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2025, 3, 10, 11, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2025, 3, 16, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, next(t, tt.expr, base), tt.expr)
+	}
+}
+
+func TestSchedule_NextIsStrictlyAfter(t *testing.T) {
+	onTick := time.Date(2025, 3, 10, 10, 15, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 3, 10, 10, 30, 0, 0, time.UTC), next(t, "*/15 * * * *", onTick))
+}
+
+func TestSchedule_DayOfMonthOrDayOfWeek(t *testing.T) {
+	// Both restricted: the 20th or any Friday, whichever comes first
+	assert.Equal(t, time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC), next(t, "0 0 20 * 5", base))
+}
+
+func TestSchedule_InLocation(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	got := next(t, "0 9 * * *", base.In(loc))
+	assert.Equal(t, time.Date(2025, 3, 11, 9, 0, 0, 0, loc), got)
+}
+
+func TestSchedule_NeverMatches(t *testing.T) {
+	assert.True(t, next(t, "0 0 31 2 *", base).IsZero())
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "* * * * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *"} {
+		_, err := cron.Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestScheduler_Add(t *testing.T) {
+	s := cron.New(nil, nil)
+	run := func(context.Context) error { return nil }
+
+	require.NoError(t, s.Add(cron.Job{Name: "reminders", Schedule: "*/15 * * * *", Run: run}))
+	require.NoError(t, s.Add(cron.Job{Name: "disabled", Schedule: "", Run: run}))
+	assert.Error(t, s.Add(cron.Job{Name: "broken", Schedule: "every day", Run: run}))
+
+	assert.Equal(t, []string{"reminders (*/15 * * * *)"}, s.Jobs())
+}
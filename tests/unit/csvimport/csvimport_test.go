@@ -0,0 +1,157 @@
+// tests/unit/csvimport/csvimport_test.go
+package csvimport
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/csvimport"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readAll(t *testing.T, r *csvimport.Reader) []*csvimport.Row {
+	t.Helper()
+	var rows []*csvimport.Row
+	for {
+		row, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return rows
+		}
+		require.NoError(t, err)
+		rows = append(rows, row)
+	}
+}
+
+func TestReader_ReadsByColumnName(t *testing.T) {
+	input := "\ufeffEmail, Full-Name ,Phone\n" +
+		"ann@example.com,Ann Lee,555 0101\n" +
+		"\n" +
+		",,\n" +
+		"bob@example.com,Bob Stone\n"
+
+	r, err := csvimport.NewReader(strings.NewReader(input), "email", "full_name")
+	require.NoError(t, err)
+	assert.True(t, r.Has("phone"))
+	assert.False(t, r.Has("notes"))
+
+	rows := readAll(t, r)
+	require.Len(t, rows, 2)
+
+	assert.Equal(t, 2, rows[0].Line)
+	assert.Equal(t, "Ann Lee", rows[0].Get("full_name"))
+	assert.Equal(t, "555 0101", rows[0].Get("phone"))
+
+	// Short rows read missing trailing fields as empty
+	assert.Equal(t, 5, rows[1].Line)
+	assert.Equal(t, "bob@example.com", rows[1].Get("email"))
+	assert.Equal(t, "", rows[1].Get("phone"))
+	assert.Equal(t, "", rows[1].Get("notes"))
+}
+
+func TestReader_MissingColumns(t *testing.T) {
+	_, err := csvimport.NewReader(strings.NewReader("name,notes\n"), "name", "email", "phone")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "email, phone")
+
+	_, err = csvimport.NewReader(strings.NewReader(""), "name")
+	assert.ErrorIs(t, err, csvimport.ErrNoHeader)
+}
+
+func TestReader_MalformedCSV(t *testing.T) {
+	r, err := csvimport.NewReader(strings.NewReader("name\n\"unterminated\n"), "name")
+	require.NoError(t, err)
+
+	_, err = r.Next()
+	assert.Error(t, err)
+}
+
+func TestRow_Bool(t *testing.T) {
+	r, err := csvimport.NewReader(strings.NewReader("consent\nYes\nn\n\nx\nmaybe\n"), "consent")
+	require.NoError(t, err)
+	rows := readAll(t, r)
+	require.Len(t, rows, 4)
+
+	tests := []struct {
+		want    bool
+		wantErr bool
+	}{
+		{want: true},
+		{want: false},
+		{want: true},
+		{wantErr: true},
+	}
+	for i, tt := range tests {
+		got, err := rows[i].Bool("consent")
+		if tt.wantErr {
+			assert.Error(t, err)
+			continue
+		}
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, got)
+	}
+
+	// Absent columns read as no
+	got, err := rows[0].Bool("sms_consent")
+	require.NoError(t, err)
+	assert.False(t, got)
+}
+
+func TestRow_FloatAndInt(t *testing.T) {
+	r, err := csvimport.NewReader(strings.NewReader("price,duration\n\"$1,250.50\",45\n,30\n-5,abc\n"), "price", "duration")
+	require.NoError(t, err)
+	rows := readAll(t, r)
+	require.Len(t, rows, 3)
+
+	price, ok, err := rows[0].Float("price")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 1250.5, price)
+	minutes, ok, err := rows[0].Int("duration")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 45, minutes)
+
+	// Empty values and absent columns are not zero
+	_, ok, err = rows[1].Float("price")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	_, ok, err = rows[1].Int("tip")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = rows[2].Float("price")
+	assert.Error(t, err)
+	_, _, err = rows[2].Int("duration")
+	assert.Error(t, err)
+}
+
+func TestParseTime(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	want := time.Date(2024, 5, 3, 14, 30, 0, 0, loc)
+
+	for _, value := range []string{
+		"2024-05-03 14:30",
+		"2024-05-03T14:30",
+		"2024-05-03 14:30:00",
+		"2024-05-03  2:30 PM",
+		"2024-05-03 2:30pm",
+	} {
+		got, err := csvimport.ParseTime(value, loc)
+		require.NoError(t, err, value)
+		assert.True(t, want.Equal(got), value)
+	}
+
+	// An explicit offset wins over the location
+	got, err := csvimport.ParseTime("2024-05-03T14:30:00Z", loc)
+	require.NoError(t, err)
+	assert.True(t, time.Date(2024, 5, 3, 14, 30, 0, 0, time.UTC).Equal(got))
+
+	_, err = csvimport.ParseTime("03/05/2024", loc)
+	assert.Error(t, err)
+}
@@ -0,0 +1,67 @@
+// tests/unit/experiments/experiments_test.go
+package experiments_test
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/experiments"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssign_IsDeterministic(t *testing.T) {
+	variants := experiments.Variants{{Name: "control", Weight: 1}, {Name: "treatment", Weight: 1}}
+
+	for userID := 1; userID <= 100; userID++ {
+		first := experiments.Assign("slot_suggestions", userID, variants)
+		assert.Equal(t, first, experiments.Assign("slot_suggestions", userID, variants))
+		assert.True(t, variants.Has(first))
+	}
+}
+
+func TestAssign_FollowsWeights(t *testing.T) {
+	variants := experiments.Variants{{Name: "control", Weight: 9}, {Name: "treatment", Weight: 1}}
+
+	counts := map[string]int{}
+	for userID := 1; userID <= 10000; userID++ {
+		counts[experiments.Assign("reminder_timing", userID, variants)]++
+	}
+
+	// 10% ± 2 points go to the treatment
+	assert.InDelta(t, 1000, counts["treatment"], 200)
+	assert.Equal(t, 10000, counts["control"]+counts["treatment"])
+}
+
+func TestAssign_ExperimentsSplitIndependently(t *testing.T) {
+	variants := experiments.Variants{{Name: "a", Weight: 1}, {Name: "b", Weight: 1}}
+
+	same := 0
+	for userID := 1; userID <= 1000; userID++ {
+		if experiments.Assign("first", userID, variants) == experiments.Assign("second", userID, variants) {
+			same++
+		}
+	}
+	assert.InDelta(t, 500, same, 100)
+}
+
+func TestAssign_NoWeightFallsBackToControl(t *testing.T) {
+	assert.Equal(t, "control", experiments.Assign("x", 1, experiments.Variants{{Name: "control"}, {Name: "b"}}))
+	assert.Equal(t, "", experiments.Assign("x", 1, nil))
+}
+
+func TestVariants_Validate(t *testing.T) {
+	valid := experiments.Variants{{Name: "control", Weight: 50}, {Name: "new-algo", Weight: 50}}
+	assert.NoError(t, valid.Validate())
+
+	assert.ErrorIs(t, experiments.Variants{{Name: "control", Weight: 1}}.Validate(), experiments.ErrTooFewVariants)
+	assert.ErrorIs(t, experiments.Variants{{Name: "a", Weight: 1}, {Name: "a", Weight: 1}}.Validate(), experiments.ErrDuplicateName)
+	assert.ErrorIs(t, experiments.Variants{{Name: "a", Weight: 1}, {Name: "B", Weight: 1}}.Validate(), experiments.ErrInvalidVariant)
+	assert.ErrorIs(t, experiments.Variants{{Name: "a", Weight: 1}, {Name: "b", Weight: 0}}.Validate(), experiments.ErrInvalidWeight)
+}
+
+func TestValidateKey(t *testing.T) {
+	assert.NoError(t, experiments.ValidateKey("slot_suggestions"))
+	assert.NoError(t, experiments.ValidateKey("reminder-timing-v2"))
+	assert.ErrorIs(t, experiments.ValidateKey("x"), experiments.ErrInvalidKey)
+	assert.ErrorIs(t, experiments.ValidateKey("Has Spaces"), experiments.ErrInvalidKey)
+}
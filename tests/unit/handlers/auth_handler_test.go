@@ -0,0 +1,71 @@
+// tests/unit/handlers/auth_handler_test.go
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/handlers"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/oauth"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// acceptingVerifier accepts any ID token as identity
+type acceptingVerifier struct {
+	identity oauth.Identity
+}
+
+func (v acceptingVerifier) Name() string { return v.identity.Provider }
+
+func (v acceptingVerifier) Verify(_ context.Context, _, _ string) (*oauth.Identity, error) {
+	identity := v.identity
+	return &identity, nil
+}
+
+func TestSocialLogin_UnverifiedAccountIsAConflict(t *testing.T) {
+	users := &mocks.MockUserStore{}
+	identities := &mocks.MockUserIdentityStore{}
+	t.Cleanup(func() {
+		users.AssertExpectations(t)
+		identities.AssertExpectations(t)
+	})
+
+	userService := services.NewUserService(users, "test-secret", time.Hour)
+	userService.SetOAuthProviders(identities, acceptingVerifier{identity: oauth.Identity{
+		Provider:      "google",
+		Subject:       "g-123",
+		Email:         "jo@example.com",
+		EmailVerified: true,
+	}})
+	identities.On("FindByProviderSubject", mock.Anything, "google", "g-123").Return(nil, repository.ErrUserIdentityNotFound)
+	users.On("FindByEmail", mock.Anything, "jo@example.com").Return(&models.User{
+		ID:           4,
+		Email:        "jo@example.com",
+		PasswordHash: "hash",
+		UserType:     config.UserTypeCustomer,
+		Status:       config.UserStatusActive,
+	}, nil)
+
+	router := gin.New()
+	router.POST("/auth/oauth/:provider", handlers.NewAuthHandler(userService).SocialLogin)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/auth/oauth/google", strings.NewReader(`{"id_token":"token"}`))
+	req.Header.Set("Content-Type", "application/json")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Code)
+	assert.Contains(t, w.Body.String(), "Account not linked")
+}
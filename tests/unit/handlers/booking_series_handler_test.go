@@ -0,0 +1,155 @@
+// tests/unit/handlers/booking_series_handler_test.go
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/handlers"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Series 5 and booking 10 belong to customer 7 and barber 3 (user 30)
+const (
+	customerUserID = 7
+	barberUserID   = 30
+)
+
+type bookingHandlerFixture struct {
+	bookings *mocks.MockBookingStore
+	series   *mocks.MockBookingSeriesStore
+	barbers  *mocks.MockBarberStore
+	router   *gin.Engine
+}
+
+// newBookingHandlerFixture routes the booking handlers as userID of
+// userType, the way RequireAuth leaves the context
+func newBookingHandlerFixture(t *testing.T, userID int, userType string) *bookingHandlerFixture {
+	gin.SetMode(gin.TestMode)
+	f := &bookingHandlerFixture{
+		bookings: &mocks.MockBookingStore{},
+		series:   &mocks.MockBookingSeriesStore{},
+		barbers:  &mocks.MockBarberStore{},
+	}
+	service := services.NewBookingService(f.bookings, f.series, &mocks.MockScheduleStore{}, f.barbers, &mocks.MockServiceStore{}, nil)
+	handler := handlers.NewBookingHandler(service)
+
+	f.router = gin.New()
+	f.router.Use(func(c *gin.Context) {
+		c.Set("user_id", userID)
+		c.Set("user_type", userType)
+		c.Next()
+	})
+	f.router.GET("/bookings/series/:id", handler.GetBookingSeries)
+	f.router.PUT("/bookings/series/:id/reschedule", handler.RescheduleBookingSeries)
+	f.router.DELETE("/bookings/series/:id", handler.CancelBookingSeries)
+	f.router.GET("/bookings/:id", handler.GetBooking)
+	f.router.PATCH("/bookings/:id/status", handler.UpdateBookingStatus)
+
+	t.Cleanup(func() {
+		f.bookings.AssertExpectations(t)
+		f.series.AssertExpectations(t)
+		f.barbers.AssertExpectations(t)
+	})
+	return f
+}
+
+func (f *bookingHandlerFixture) do(method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	f.router.ServeHTTP(w, req)
+	return w
+}
+
+func (f *bookingHandlerFixture) expectSeries() {
+	customerID := customerUserID
+	f.series.On("FindByID", mock.Anything, 5).Return(&models.BookingSeries{
+		ID:         5,
+		CustomerID: &customerID,
+		BarberID:   3,
+		Status:     config.BookingSeriesStatusActive,
+	}, nil)
+}
+
+func (f *bookingHandlerFixture) expectBarber() {
+	f.barbers.On("FindByID", mock.Anything, 3).Return(&models.Barber{ID: 3, UserID: barberUserID}, nil)
+}
+
+func TestBookingSeriesHandlers_ForbidOtherCustomersAndBarbers(t *testing.T) {
+	requests := []struct {
+		name, method, path, body string
+	}{
+		{"get", http.MethodGet, "/bookings/series/5", ""},
+		{"reschedule", http.MethodPut, "/bookings/series/5/reschedule", `{"new_start_time":"2030-01-07T10:00:00Z"}`},
+		{"cancel", http.MethodDelete, "/bookings/series/5", ""},
+	}
+	strangers := []struct {
+		name     string
+		userID   int
+		userType string
+	}{
+		{"another customer", 8, "customer"},
+		{"another barber", 31, "barber"},
+	}
+
+	for _, req := range requests {
+		for _, stranger := range strangers {
+			t.Run(req.name+" by "+stranger.name, func(t *testing.T) {
+				f := newBookingHandlerFixture(t, stranger.userID, stranger.userType)
+				f.expectSeries()
+				f.expectBarber()
+
+				w := f.do(req.method, req.path, req.body)
+				assert.Equal(t, http.StatusForbidden, w.Code)
+			})
+		}
+	}
+}
+
+func TestGetBookingSeries_AllowsItsParties(t *testing.T) {
+	tests := []struct {
+		name     string
+		userID   int
+		userType string
+		barber   bool // Whether the barber lookup is needed
+	}{
+		{"its customer", customerUserID, "customer", false},
+		{"its barber", barberUserID, "barber", true},
+		{"an admin", 1, "admin", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newBookingHandlerFixture(t, tt.userID, tt.userType)
+			f.expectSeries()
+			if tt.barber {
+				f.expectBarber()
+			}
+			f.series.On("FindOccurrences", mock.Anything, 5).Return([]models.Booking{}, nil)
+
+			w := f.do(http.MethodGet, "/bookings/series/5", "")
+			assert.Equal(t, http.StatusOK, w.Code)
+		})
+	}
+}
+
+func TestGetBookingSeries_MissingSeries(t *testing.T) {
+	f := newBookingHandlerFixture(t, customerUserID, "customer")
+	f.series.On("FindByID", mock.Anything, 5).Return(nil, repository.ErrBookingSeriesNotFound)
+
+	w := f.do(http.MethodGet, "/bookings/series/5", "")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
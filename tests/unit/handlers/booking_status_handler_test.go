@@ -0,0 +1,88 @@
+// tests/unit/handlers/booking_status_handler_test.go
+package handlers_test
+
+import (
+	"net/http"
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// expectBooking finds booking 10 in status
+func (f *bookingHandlerFixture) expectBooking(status string) {
+	customerID := customerUserID
+	f.bookings.On("FindByID", mock.Anything, 10).Return(&models.Booking{
+		ID:         10,
+		CustomerID: &customerID,
+		BarberID:   3,
+		Status:     status,
+	}, nil)
+}
+
+func TestUpdateBookingStatus_CustomersCanOnlyCancel(t *testing.T) {
+	for _, status := range []string{
+		config.BookingStatusConfirmed,
+		config.BookingStatusInProgress,
+		config.BookingStatusCompleted,
+		config.BookingStatusNoShow,
+	} {
+		t.Run(status, func(t *testing.T) {
+			f := newBookingHandlerFixture(t, customerUserID, "customer")
+			f.expectBooking(config.BookingStatusPending)
+			f.expectBarber()
+
+			w := f.do(http.MethodPatch, "/bookings/10/status", `{"status":"`+status+`"}`)
+			assert.Equal(t, http.StatusForbidden, w.Code)
+		})
+	}
+}
+
+func TestUpdateBookingStatus_CustomerCancelPassesAuthorization(t *testing.T) {
+	f := newBookingHandlerFixture(t, customerUserID, "customer")
+	// A finished booking can't be cancelled: refused by the state machine,
+	// not by authorization
+	f.expectBooking(config.BookingStatusCompleted)
+
+	w := f.do(http.MethodPatch, "/bookings/10/status", `{"status":"cancelled"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestUpdateBookingStatus_OtherBarbersCannotConfirm(t *testing.T) {
+	f := newBookingHandlerFixture(t, 31, "barber")
+	f.expectBooking(config.BookingStatusPending)
+	f.expectBarber()
+
+	w := f.do(http.MethodPatch, "/bookings/10/status", `{"status":"confirmed"}`)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestUpdateBookingStatus_BarberSetsBarberStatuses(t *testing.T) {
+	f := newBookingHandlerFixture(t, barberUserID, "barber")
+	f.expectBooking(config.BookingStatusCancelled)
+	f.expectBarber()
+
+	w := f.do(http.MethodPatch, "/bookings/10/status", `{"status":"confirmed"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code, "authorized, then refused by the state machine")
+}
+
+func TestGetBooking_OtherUsersAreForbidden(t *testing.T) {
+	f := newBookingHandlerFixture(t, 8, "customer")
+	f.expectBooking(config.BookingStatusPending)
+	f.expectBarber()
+
+	w := f.do(http.MethodGet, "/bookings/10", "")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestGetBooking_AdminsSeeAnyBooking(t *testing.T) {
+	f := newBookingHandlerFixture(t, 1, "admin")
+	f.expectBooking(config.BookingStatusPending)
+	f.bookings.On("FindLineItems", mock.Anything, 10).Return([]models.BookingLineItem{}, nil)
+
+	w := f.do(http.MethodGet, "/bookings/10", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+}
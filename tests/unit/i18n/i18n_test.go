@@ -0,0 +1,79 @@
+// tests/unit/i18n/i18n_test.go
+package i18n_test
+
+import (
+	"context"
+	"testing"
+
+	"barber-booking-system/internal/i18n"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocales(t *testing.T) {
+	assert.Equal(t, []string{"de", "en", "es", "fr", "it", "pt"}, i18n.Locales())
+}
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale string
+		ok     bool
+	}{
+		{name: "es", locale: "es", ok: true},
+		{name: "pt-BR", locale: "pt", ok: true},
+		{name: "FR_ca", locale: "fr", ok: true},
+		{name: "en-US", locale: "en", ok: true},
+		{name: "ja"},
+		{name: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locale, ok := i18n.Match(tt.name)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.locale, locale)
+		})
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	assert.Equal(t, "Reserva confirmada", i18n.Translate("es", "Booking Confirmed"))
+	assert.Equal(t, "Booking Confirmed", i18n.Translate("en", "Booking Confirmed"))
+
+	// Untranslated text and unknown languages read in English
+	assert.Equal(t, "Not in any catalog", i18n.Translate("de", "Not in any catalog"))
+	assert.Equal(t, "Booking Confirmed", i18n.Translate("ja", "Booking Confirmed"))
+
+	assert.True(t, i18n.Has("en", "Not in any catalog"))
+	assert.False(t, i18n.Has("de", "Not in any catalog"))
+}
+
+func TestFromAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		locale string
+		ok     bool
+	}{
+		{header: "es-ES,es;q=0.9,en;q=0.8", locale: "es", ok: true},
+		{header: "ja, de;q=0.5, fr;q=0.7", locale: "fr", ok: true},
+		{header: "en;q=0.2, it", locale: "it", ok: true},
+		{header: "pt;q=0, de;q=0.1", locale: "de", ok: true},
+		{header: "ja, zh;q=0.8"},
+		{header: "*"},
+		{header: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			locale, ok := i18n.FromAcceptLanguage(tt.header)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.locale, locale)
+		})
+	}
+}
+
+func TestContext(t *testing.T) {
+	assert.Equal(t, i18n.DefaultLocale, i18n.FromContext(context.Background()))
+	assert.Equal(t, "pt", i18n.FromContext(i18n.WithLocale(context.Background(), "pt")))
+}
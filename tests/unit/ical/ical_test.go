@@ -0,0 +1,62 @@
+// tests/unit/ical/ical_test.go
+package ical_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/ical"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unfold joins folded content lines back together
+func unfold(data []byte) []string {
+	return strings.Split(strings.ReplaceAll(strings.TrimSuffix(string(data), "\r\n"), "\r\n ", ""), "\r\n")
+}
+
+func TestCalendar_Marshal(t *testing.T) {
+	start := time.Date(2025, 3, 10, 10, 0, 0, 0, time.FixedZone("EST", -5*3600))
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	data := ical.Calendar{
+		Name:            "Barber appointments",
+		RefreshInterval: 90 * time.Minute,
+		Events: []ical.Event{{
+			UID:         "booking-abc@example",
+			Start:       start,
+			End:         start.Add(45 * time.Minute),
+			Summary:     "Haircut at Joe's",
+			Description: "Booking BK1\nAwaiting confirmation",
+			Location:    "Joe's, 1 Main St, Springfield",
+			Status:      ical.StatusTentative,
+		}},
+	}.Marshal(now)
+
+	lines := unfold(data)
+	assert.Equal(t, "BEGIN:VCALENDAR", lines[0])
+	assert.Equal(t, "END:VCALENDAR", lines[len(lines)-1])
+	assert.Contains(t, lines, "X-WR-CALNAME:Barber appointments")
+	assert.Contains(t, lines, "REFRESH-INTERVAL;VALUE=DURATION:PT1H30M")
+	assert.Contains(t, lines, "UID:booking-abc@example")
+	assert.Contains(t, lines, "DTSTAMP:20250301T120000Z")
+	assert.Contains(t, lines, "DTSTART:20250310T150000Z") // Converted to UTC
+	assert.Contains(t, lines, "DTEND:20250310T154500Z")
+	assert.Contains(t, lines, `DESCRIPTION:Booking BK1\nAwaiting confirmation`)
+	assert.Contains(t, lines, `LOCATION:Joe's\, 1 Main St\, Springfield`)
+	assert.Contains(t, lines, "STATUS:TENTATIVE")
+	assert.NotContains(t, string(data), "CREATED:")
+}
+
+func TestCalendar_Marshal_FoldsLongLines(t *testing.T) {
+	summary := strings.Repeat("Fade ✂ ", 30)
+	data := ical.Calendar{Events: []ical.Event{{UID: "x", Summary: summary}}}.Marshal(time.Now())
+
+	for _, line := range strings.Split(string(data), "\r\n") {
+		assert.LessOrEqual(t, len(line), 75)
+		assert.True(t, strings.ToValidUTF8(line, "?") == line, "fold split a character: %q", line)
+	}
+	require.Contains(t, unfold(data), "SUMMARY:"+summary)
+}
@@ -0,0 +1,108 @@
+// tests/unit/imaging/imaging_test.go
+package imaging_test
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"barber-booking-system/internal/imaging"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// halves returns a w x h image, red on the left half and blue on the right
+func halves(w, h int) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if x < w/2 {
+				img.Set(x, y, color.RGBA{R: 255, A: 255})
+			} else {
+				img.Set(x, y, color.RGBA{B: 255, A: 255})
+			}
+		}
+	}
+	return img
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, img, &jpeg.Options{Quality: 100}))
+	return buf.Bytes()
+}
+
+// withOrientation inserts an EXIF segment carrying orientation after the
+// JPEG's start marker
+func withOrientation(data []byte, orientation byte) []byte {
+	exif := []byte("Exif\x00\x00")
+	exif = append(exif, 'M', 'M', 0, 42, 0, 0, 0, 8) // Big-endian TIFF, IFD at 8
+	exif = append(exif, 0, 1)                        // One entry
+	exif = append(exif, 0x01, 0x12, 0, 3, 0, 0, 0, 1, 0, orientation, 0, 0)
+	exif = append(exif, 0, 0, 0, 0) // No next IFD
+
+	segment := []byte{0xFF, 0xE1, byte((len(exif) + 2) >> 8), byte(len(exif) + 2)}
+	segment = append(segment, exif...)
+	return append(append(append([]byte{}, data[:2]...), segment...), data[2:]...)
+}
+
+func isRed(c color.Color) bool {
+	r, g, b, _ := c.RGBA()
+	return r > 0xC000 && g < 0x4000 && b < 0x4000
+}
+
+func TestDecodeChecksContent(t *testing.T) {
+	_, err := imaging.Decode([]byte("GIF89a not really"), 1000)
+	assert.ErrorIs(t, err, imaging.ErrUnsupportedFormat)
+
+	_, err = imaging.Decode(encodeJPEG(t, halves(40, 30)), 1000)
+	assert.ErrorIs(t, err, imaging.ErrTooManyPixels)
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, halves(4, 2)))
+	img, err := imaging.Decode(buf.Bytes(), 1000)
+	require.NoError(t, err)
+	assert.Equal(t, imaging.FormatPNG, img.Format)
+	assert.Equal(t, "image/png", img.ContentType())
+	assert.Equal(t, ".png", img.Extension())
+}
+
+func TestDecodeAppliesOrientation(t *testing.T) {
+	// Orientation 6: the camera was turned, the photo needs a 90° clockwise
+	// turn, which brings the red left half to the top
+	img, err := imaging.Decode(withOrientation(encodeJPEG(t, halves(16, 8)), 6), 1000)
+	require.NoError(t, err)
+
+	assert.Equal(t, 8, img.Bounds().Dx())
+	assert.Equal(t, 16, img.Bounds().Dy())
+	assert.True(t, isRed(img.At(4, 2)))
+	assert.False(t, isRed(img.At(4, 13)))
+
+	img, err = imaging.Decode(encodeJPEG(t, halves(16, 8)), 1000)
+	require.NoError(t, err)
+	assert.Equal(t, 16, img.Bounds().Dx(), "no EXIF: left as is")
+	assert.True(t, isRed(img.At(2, 4)))
+}
+
+func TestFitKeepsAspectRatio(t *testing.T) {
+	img, err := imaging.Decode(encodeJPEG(t, halves(400, 200)), 1_000_000)
+	require.NoError(t, err)
+
+	thumb := img.Fit(100, 100)
+	assert.Equal(t, 100, thumb.Bounds().Dx())
+	assert.Equal(t, 50, thumb.Bounds().Dy())
+	assert.True(t, isRed(thumb.At(10, 25)))
+	assert.False(t, isRed(thumb.At(90, 25)))
+
+	assert.Same(t, img, img.Fit(1000, 1000), "images that fit are not resized")
+
+	var buf bytes.Buffer
+	require.NoError(t, thumb.Encode(&buf))
+	_, format, err := image.DecodeConfig(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, "jpeg", format)
+}
@@ -0,0 +1,233 @@
+// tests/unit/loadgen/loadgen_test.go
+package loadgen_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/loadgen"
+	"barber-booking-system/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ========================================================================
+// GENERATOR
+// ========================================================================
+
+func TestGenerator_SlotsFallOnQuarterHoursInBusinessHours(t *testing.T) {
+	now := time.Date(2026, 3, 10, 22, 30, 0, 0, time.UTC)
+	gen := loadgen.NewGenerator(42, now, []int{1, 2, 3}, 4, 30, 7)
+
+	first := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	last := time.Date(2026, 3, 17, 17, 45, 0, 0, time.UTC)
+	for i := 0; i < 500; i++ {
+		slot := gen.Slot()
+		assert.Contains(t, []int{1, 2, 3}, slot.BarberID)
+		assert.Equal(t, 4, slot.ServiceID)
+		assert.Equal(t, 30, slot.DurationMinutes)
+		assert.False(t, slot.StartTime.Before(first), "starts tomorrow: %s", slot.StartTime)
+		assert.False(t, slot.StartTime.After(last), "within the days asked for: %s", slot.StartTime)
+		assert.GreaterOrEqual(t, slot.StartTime.Hour(), 9)
+		assert.Less(t, slot.StartTime.Hour(), 18)
+		assert.Zero(t, slot.StartTime.Minute()%15)
+	}
+}
+
+func TestGenerator_SameSeedSameSlots(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	a := loadgen.NewGenerator(7, now, []int{1, 2}, 4, 30, 14)
+	b := loadgen.NewGenerator(7, now, []int{1, 2}, 4, 30, 14)
+
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, a.Slot(), b.Slot())
+		assert.Equal(t, a.BarberID(), b.BarberID())
+	}
+}
+
+func TestGenerator_DaysStayInTheBookingWindow(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	wide := loadgen.NewGenerator(1, now, []int{1}, 4, 30, 365)
+	limit := time.Date(2026, 4, 8, 18, 0, 0, 0, time.UTC) // 29 days from tomorrow
+	for i := 0; i < 2000; i++ {
+		assert.True(t, wide.Slot().StartTime.Before(limit))
+	}
+
+	narrow := loadgen.NewGenerator(1, now, []int{1}, 4, 30, 0)
+	for i := 0; i < 100; i++ {
+		assert.Equal(t, 11, narrow.Slot().StartTime.Day(), "at least one day")
+	}
+}
+
+// ========================================================================
+// RUNNER
+// ========================================================================
+
+func TestRun_TalliesOutcomes(t *testing.T) {
+	outcomes := []loadgen.Outcome{loadgen.OutcomeOK, loadgen.OutcomeConflict, loadgen.OutcomeRejected, loadgen.OutcomeError}
+	var calls, inFlight, peak int32
+
+	report := loadgen.Run(context.Background(), loadgen.Scenario{
+		Name:  "mixed",
+		Count: 40,
+		Op: func(ctx context.Context) loadgen.Outcome {
+			n := atomic.AddInt32(&calls, 1)
+			current := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if current <= p || atomic.CompareAndSwapInt32(&peak, p, current) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			return outcomes[int(n)%len(outcomes)]
+		},
+	}, 4)
+
+	assert.Equal(t, "mixed", report.Name)
+	assert.Equal(t, 40, report.Total)
+	assert.Equal(t, 10, report.OK)
+	assert.Equal(t, 10, report.Conflicts)
+	assert.Equal(t, 10, report.Rejected)
+	assert.Equal(t, 10, report.Errors)
+	assert.Len(t, report.Latencies, 40)
+	assert.LessOrEqual(t, peak, int32(4), "no more than the workers asked for")
+}
+
+func TestRun_AtLeastOneWorker(t *testing.T) {
+	report := loadgen.Run(context.Background(), loadgen.Scenario{
+		Count: 3,
+		Op:    func(ctx context.Context) loadgen.Outcome { return loadgen.OutcomeOK },
+	}, 0)
+
+	assert.Equal(t, 3, report.OK)
+}
+
+func TestReport_Percentile(t *testing.T) {
+	report := &loadgen.Report{}
+	assert.Zero(t, report.Percentile(50), "no samples")
+
+	for i := 100; i >= 1; i-- {
+		report.Latencies = append(report.Latencies, time.Duration(i)*time.Millisecond)
+	}
+	assert.Equal(t, 1*time.Millisecond, report.Percentile(0.1))
+	assert.Equal(t, 50*time.Millisecond, report.Percentile(50))
+	assert.Equal(t, 95*time.Millisecond, report.Percentile(95))
+	assert.Equal(t, 100*time.Millisecond, report.Percentile(100))
+	assert.Equal(t, 100*time.Millisecond, report.Latencies[0], "samples are left in order")
+}
+
+// ========================================================================
+// TARGETS
+// ========================================================================
+
+func TestHTTPTarget_LogsInAndSendsTheToken(t *testing.T) {
+	var created map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/auth/login":
+			var creds map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&creds))
+			assert.Equal(t, map[string]string{"email": "load@example.com", "password": "pw"}, creds)
+			fmt.Fprint(w, `{"data":{"token":"jwt-1"}}`)
+		case "/api/v1/bookings":
+			assert.Equal(t, "Bearer jwt-1", r.Header.Get("Authorization"))
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+		default:
+			t.Errorf("unexpected request %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	target, err := loadgen.NewHTTPTarget(context.Background(), server.URL+"/", "", "load@example.com", "pw")
+	require.NoError(t, err)
+	defer target.Close()
+
+	start := time.Date(2026, 3, 11, 9, 15, 0, 0, time.UTC)
+	outcome := target.CreateBooking(context.Background(), loadgen.Slot{BarberID: 2, ServiceID: 4, StartTime: start, DurationMinutes: 30})
+	assert.Equal(t, loadgen.OutcomeOK, outcome)
+	assert.Equal(t, 2.0, created["barber_id"])
+	assert.Equal(t, 4.0, created["service_id"])
+	assert.Equal(t, "2026-03-11T09:15:00Z", created["start_time"])
+	assert.Equal(t, 30.0, created["duration_minutes"])
+}
+
+func TestHTTPTarget_LoginFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	_, err := loadgen.NewHTTPTarget(context.Background(), server.URL, "", "load@example.com", "wrong")
+	assert.EqualError(t, err, "login failed: 401 Unauthorized")
+}
+
+func TestHTTPTarget_ClassifiesResponses(t *testing.T) {
+	status := http.StatusOK
+	var lastURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lastURL = r.URL.String()
+		w.WriteHeader(status)
+	}))
+
+	target, err := loadgen.NewHTTPTarget(context.Background(), server.URL, "jwt-1", "", "")
+	require.NoError(t, err)
+	defer target.Close()
+
+	slot := loadgen.Slot{BarberID: 2, StartTime: time.Date(2026, 3, 11, 9, 15, 0, 0, time.UTC), DurationMinutes: 30}
+	tests := []struct {
+		status  int
+		outcome loadgen.Outcome
+	}{
+		{http.StatusOK, loadgen.OutcomeOK},
+		{http.StatusConflict, loadgen.OutcomeConflict},
+		{http.StatusUnprocessableEntity, loadgen.OutcomeRejected},
+		{http.StatusNotFound, loadgen.OutcomeRejected},
+		{http.StatusServiceUnavailable, loadgen.OutcomeError},
+	}
+	for _, tt := range tests {
+		status = tt.status
+		assert.Equal(t, tt.outcome, target.CheckAvailability(context.Background(), slot), "status %d", tt.status)
+	}
+	assert.Equal(t, "/api/v1/bookings/availability?barber_id=2&duration=30&start_time=2026-03-11T09%3A15%3A00Z", lastURL)
+
+	status = http.StatusOK
+	assert.Equal(t, loadgen.OutcomeOK, target.ListBookings(context.Background(), 3))
+	assert.Equal(t, "/api/v1/barbers/3/bookings?limit=50", lastURL)
+
+	// The instance went away
+	server.Close()
+	assert.Equal(t, loadgen.OutcomeError, target.ListBookings(context.Background(), 3))
+}
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		outcome loadgen.Outcome
+	}{
+		{"success", nil, loadgen.OutcomeOK},
+		{"slot taken", fmt.Errorf("failed to create booking: %w", repository.ErrBookingConflict), loadgen.OutcomeConflict},
+		{"slot unavailable", errors.New("time slot is not available"), loadgen.OutcomeConflict},
+		{"validation", errors.New("duration must be a multiple of 15 minutes"), loadgen.OutcomeRejected},
+		{"missing barber", errors.New("barber not found"), loadgen.OutcomeRejected},
+		{"database down", errors.New("connection reset by peer"), loadgen.OutcomeError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.outcome, loadgen.ClassifyError(tt.err))
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	"barber-booking-system/internal/audit"
 	"barber-booking-system/internal/middleware"
 
 	"github.com/gin-gonic/gin"
@@ -51,6 +52,35 @@ func TestAuthMiddleware_ValidToken(t *testing.T) {
 	assert.Equal(t, http.StatusOK, w.Code)
 }
 
+func TestRequireAuth_TagsAuditActor(t *testing.T) {
+	token, err := middleware.GenerateToken(7, "admin@example.com", "admin", testSecretKey, time.Hour)
+	require.NoError(t, err)
+
+	var actor audit.Actor
+	var tagged bool
+	router := gin.New()
+	router.Use(middleware.RequireAuth(testSecretKey))
+	router.POST("/test", func(c *gin.Context) {
+		actor, tagged = audit.ActorFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/test", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("User-Agent", "admin-console/1.0")
+	req.RemoteAddr = "203.0.113.9:5123"
+	router.ServeHTTP(w, req)
+
+	require.True(t, tagged)
+	assert.Equal(t, audit.Actor{
+		UserID:    7,
+		UserType:  "admin",
+		IPAddress: "203.0.113.9",
+		UserAgent: "admin-console/1.0",
+	}, actor)
+}
+
 func TestAuthMiddleware_MissingToken(t *testing.T) {
 	router := gin.New()
 	router.Use(middleware.ErrorHandler())
@@ -0,0 +1,78 @@
+// tests/unit/middleware/chaos_middleware_test.go
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"barber-booking-system/internal/chaos"
+	"barber-booking-system/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newChaosRouter(defaults *chaos.Faults) *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.Chaos(defaults))
+	router.GET("/cache", func(c *gin.Context) {
+		if err := chaos.Check(c.Request.Context(), chaos.DependencyRedis); err != nil {
+			c.String(http.StatusOK, "fallback")
+			return
+		}
+		c.String(http.StatusOK, "cached")
+	})
+	return router
+}
+
+func TestChaos_InjectsDependencyFaults(t *testing.T) {
+	router := newChaosRouter(nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/cache", nil)
+	req.Header.Set(chaos.Header, "redis=down")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, "fallback", w.Body.String())
+	assert.Equal(t, "redis=down", w.Header().Get(chaos.Header))
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/cache", nil))
+	assert.Equal(t, "cached", w.Body.String(), "no faults without the header")
+	assert.Empty(t, w.Header().Get(chaos.Header))
+}
+
+func TestChaos_InjectsErrors(t *testing.T) {
+	router := newChaosRouter(nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/cache", nil)
+	req.Header.Set(chaos.Header, "error=503")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, w.Body.String(), "CHAOS_INJECTED")
+}
+
+func TestChaos_AppliesDefaults(t *testing.T) {
+	defaults, err := chaos.Parse("redis=down")
+	require.NoError(t, err)
+	router := newChaosRouter(defaults)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/cache", nil))
+	assert.Equal(t, "fallback", w.Body.String())
+}
+
+func TestChaos_RejectsInvalidSpec(t *testing.T) {
+	router := newChaosRouter(nil)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/cache", nil)
+	req.Header.Set(chaos.Header, "kafka=down")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
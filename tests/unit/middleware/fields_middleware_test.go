@@ -0,0 +1,81 @@
+// tests/unit/middleware/fields_middleware_test.go
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"barber-booking-system/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fieldsBase struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type fieldsModel struct {
+	*fieldsBase
+	Price    float64 `json:"price"`
+	Notes    string  `json:"notes,omitempty"`
+	Internal string  `json:"-"`
+	hidden   string
+}
+
+// selectedFields runs a request through SparseFields and returns the
+// fields the handler saw
+func selectedFields(t *testing.T, query string) (int, []string) {
+	router := gin.New()
+	router.GET("/items", middleware.SparseFields(fieldsModel{}), func(c *gin.Context) {
+		c.JSON(http.StatusOK, middleware.GetFields(c))
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/items"+query, nil))
+
+	var fields []string
+	if w.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &fields))
+	}
+	return w.Code, fields
+}
+
+func TestJSONFieldNames(t *testing.T) {
+	assert.Equal(t, []string{"id", "name", "notes", "price"}, middleware.JSONFieldNames(&fieldsModel{}))
+}
+
+func TestSparseFields_ParsesSelection(t *testing.T) {
+	code, fields := selectedFields(t, "?fields=name,%20price,name")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, []string{"name", "price"}, fields)
+
+	code, fields = selectedFields(t, "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Nil(t, fields)
+}
+
+func TestSparseFields_RejectsUnknownOrEmpty(t *testing.T) {
+	code, _ := selectedFields(t, "?fields=name,password")
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = selectedFields(t, "?fields=")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestSelectFields(t *testing.T) {
+	item := fieldsModel{fieldsBase: &fieldsBase{ID: 7, Name: "Fade"}, Price: 25.5, Notes: "x"}
+
+	one, err := middleware.SelectFields(item, []string{"price"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": json.Number("7"), "price": json.Number("25.5")}, one)
+
+	list, err := middleware.SelectFields([]fieldsModel{item, item}, []string{"name"})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, map[string]interface{}{"id": json.Number("7"), "name": "Fade"}, list.([]interface{})[1])
+}
@@ -0,0 +1,133 @@
+// tests/unit/middleware/ip_filter_middleware_test.go
+package middleware_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"barber-booking-system/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupIPFilterRouter(t *testing.T, cfg middleware.IPFilterConfig) *gin.Engine {
+	filter, err := middleware.IPFilter(cfg)
+	require.NoError(t, err)
+
+	router := gin.New()
+	router.Use(filter)
+	router.GET("/admin", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"success": true})
+	})
+	return router
+}
+
+func doIPFilterRequest(router *gin.Engine, remoteAddr string, headers map[string]string) int {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/admin", nil)
+	req.RemoteAddr = remoteAddr
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	router.ServeHTTP(w, req)
+	return w.Code
+}
+
+func TestIPFilter_Allowlist(t *testing.T) {
+	router := setupIPFilterRouter(t, middleware.IPFilterConfig{
+		AllowCIDRs: []string{"10.0.0.0/8", "192.168.1.5"},
+	})
+
+	assert.Equal(t, http.StatusOK, doIPFilterRequest(router, "10.1.2.3:1234", nil))
+	assert.Equal(t, http.StatusOK, doIPFilterRequest(router, "192.168.1.5:1234", nil))
+	assert.Equal(t, http.StatusForbidden, doIPFilterRequest(router, "192.168.1.6:1234", nil))
+}
+
+func TestIPFilter_IgnoresSpoofedForwardedFor(t *testing.T) {
+	router := setupIPFilterRouter(t, middleware.IPFilterConfig{
+		AllowCIDRs: []string{"10.0.0.0/8"},
+	})
+
+	spoofed := map[string]string{"X-Forwarded-For": "10.1.2.3", "X-Real-IP": "10.1.2.3"}
+	assert.Equal(t, http.StatusForbidden, doIPFilterRequest(router, "203.0.113.5:1234", spoofed))
+}
+
+func TestIPFilter_TrustedProxyForwardsTheClient(t *testing.T) {
+	filter, err := middleware.IPFilter(middleware.IPFilterConfig{
+		AllowCIDRs:        []string{"10.0.0.0/8"},
+		TrustForwardedFor: true,
+	})
+	require.NoError(t, err)
+
+	router := gin.New()
+	require.NoError(t, router.SetTrustedProxies([]string{"192.0.2.1"}))
+	router.Use(filter)
+	router.GET("/admin", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"success": true})
+	})
+
+	forwarded := map[string]string{"X-Forwarded-For": "10.1.2.3"}
+	assert.Equal(t, http.StatusOK, doIPFilterRequest(router, "192.0.2.1:1234", forwarded))
+	// Only the configured proxy is believed
+	assert.Equal(t, http.StatusForbidden, doIPFilterRequest(router, "203.0.113.5:1234", forwarded))
+}
+
+func TestIPFilter_DenylistWinsOverAllowlist(t *testing.T) {
+	router := setupIPFilterRouter(t, middleware.IPFilterConfig{
+		AllowCIDRs: []string{"10.0.0.0/8"},
+		DenyCIDRs:  []string{"10.0.0.0/24"},
+	})
+
+	assert.Equal(t, http.StatusForbidden, doIPFilterRequest(router, "10.0.0.7:1234", nil))
+	assert.Equal(t, http.StatusOK, doIPFilterRequest(router, "10.0.1.7:1234", nil))
+}
+
+func TestIPFilter_CountryBlocking(t *testing.T) {
+	router := setupIPFilterRouter(t, middleware.IPFilterConfig{
+		BlockedCountries: []string{"kp"},
+		CountryResolver:  middleware.HeaderCountryResolver{Header: "CF-IPCountry"},
+	})
+
+	assert.Equal(t, http.StatusForbidden, doIPFilterRequest(router, "1.2.3.4:1234", map[string]string{"CF-IPCountry": "KP"}))
+	assert.Equal(t, http.StatusOK, doIPFilterRequest(router, "1.2.3.4:1234", map[string]string{"CF-IPCountry": "US"}))
+}
+
+func TestIPFilter_BypassToken(t *testing.T) {
+	sum := sha256.Sum256([]byte("break-glass"))
+	router := setupIPFilterRouter(t, middleware.IPFilterConfig{
+		AllowCIDRs:        []string{"10.0.0.0/8"},
+		BypassTokenHashes: []string{hex.EncodeToString(sum[:])},
+	})
+
+	headers := map[string]string{middleware.DefaultBypassTokenHeader: "break-glass"}
+	assert.Equal(t, http.StatusOK, doIPFilterRequest(router, "8.8.8.8:1234", headers))
+
+	headers[middleware.DefaultBypassTokenHeader] = "wrong"
+	assert.Equal(t, http.StatusForbidden, doIPFilterRequest(router, "8.8.8.8:1234", headers))
+}
+
+func TestIPFilter_InvalidConfig(t *testing.T) {
+	_, err := middleware.IPFilter(middleware.IPFilterConfig{AllowCIDRs: []string{"not-a-cidr"}})
+	assert.Error(t, err)
+
+	_, err = middleware.IPFilter(middleware.IPFilterConfig{BypassTokenHashes: []string{"plaintext-token"}})
+	assert.Error(t, err)
+}
+
+func TestGeoIPDatabase_MostSpecificMatch(t *testing.T) {
+	csv := "network,country_iso_code\n10.0.0.0/8,US\n10.1.0.0/16,CA\n"
+	db, err := middleware.ParseGeoIPDatabase(strings.NewReader(csv))
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, db.Len())
+	assert.Equal(t, "CA", db.Country(nil, net.ParseIP("10.1.2.3")))
+	assert.Equal(t, "US", db.Country(nil, net.ParseIP("10.2.2.3")))
+	assert.Equal(t, "", db.Country(nil, net.ParseIP("11.0.0.1")))
+}
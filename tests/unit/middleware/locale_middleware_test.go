@@ -0,0 +1,68 @@
+// tests/unit/middleware/locale_middleware_test.go
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"barber-booking-system/internal/i18n"
+	"barber-booking-system/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalization_TranslatesErrors(t *testing.T) {
+	router := gin.New()
+	router.Use(middleware.Localization("fr"))
+	var fromContext string
+	router.GET("/test", func(c *gin.Context) {
+		fromContext = i18n.FromContext(c.Request.Context())
+		middleware.WriteError(c, http.StatusUnauthorized, middleware.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "Booking 42 is not yours", // No translation
+		})
+	})
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		locale         string
+		title          string
+	}{
+		{name: "preferred language", acceptLanguage: "ja, es-MX;q=0.9", locale: "es", title: "No autorizado"},
+		{name: "default language", acceptLanguage: "ja", locale: "fr", title: "Non autorisé"},
+		{name: "english", acceptLanguage: "en-GB", locale: "en", title: "Unauthorized"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.Header.Set("Accept-Language", tt.acceptLanguage)
+			router.ServeHTTP(w, req)
+
+			var body middleware.ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			assert.Equal(t, tt.title, body.Error)
+			assert.Equal(t, "Booking 42 is not yours", body.Message)
+			assert.Equal(t, tt.locale, w.Header().Get("Content-Language"))
+			assert.Equal(t, tt.locale, fromContext)
+		})
+	}
+}
+
+func TestLocalization_UnknownDefaultIsEnglish(t *testing.T) {
+	router := gin.New()
+	router.Use(middleware.Localization("klingon"))
+	router.GET("/test", func(c *gin.Context) {
+		c.String(http.StatusOK, middleware.GetLocale(c))
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/test", nil))
+	assert.Equal(t, "en", w.Body.String())
+}
@@ -0,0 +1,70 @@
+// tests/unit/middleware/pagination_middleware_test.go
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pageLimitFor runs a request through PageLimits and returns the limit the
+// handler saw
+func pageLimitFor(t *testing.T, path string, userID int, userType string) middleware.PageLimit {
+	cfg := config.PaginationConfig{
+		DefaultLimit:     20,
+		MaxLimit:         100,
+		ElevatedMaxLimit: 500,
+		EndpointLimits:   map[string]int{"/barbers": 50},
+	}
+	router := gin.New()
+	router.Use(middleware.PageLimits(cfg, testSecretKey, fakeQuotas{9: 600}))
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, middleware.GetPageLimit(c))
+	}
+	router.GET("/barbers", handler)
+	router.GET("/reviews", handler)
+
+	req := httptest.NewRequest("GET", path, nil)
+	if userID != 0 {
+		token, err := middleware.GenerateToken(userID, "user@example.com", userType, testSecretKey, time.Hour)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var limit middleware.PageLimit
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &limit))
+	return limit
+}
+
+func TestPageLimits_PublicAndEndpointCaps(t *testing.T) {
+	assert.Equal(t, middleware.PageLimit{Default: 20, Max: 100}, pageLimitFor(t, "/reviews", 0, ""))
+	assert.Equal(t, middleware.PageLimit{Default: 20, Max: 50}, pageLimitFor(t, "/barbers", 0, ""))
+	assert.Equal(t, 100, pageLimitFor(t, "/reviews", 4, "customer").Max, "ordinary users get the public cap")
+}
+
+func TestPageLimits_ElevatedConsumers(t *testing.T) {
+	admin := pageLimitFor(t, "/barbers", 1, "admin")
+	assert.True(t, admin.Elevated)
+	assert.Equal(t, 500, admin.Max)
+
+	consumer := pageLimitFor(t, "/reviews", 9, "customer")
+	assert.True(t, consumer.Elevated, "consumers with an API quota get larger pages")
+	assert.Equal(t, 500, consumer.Max)
+}
+
+func TestGetPageLimit_DefaultsWithoutMiddleware(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	assert.Equal(t, config.MaxPageLimit, middleware.GetPageLimit(c).Max)
+}
@@ -0,0 +1,127 @@
+// tests/unit/middleware/permission_middleware_test.go
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakePermissions resolves permissions by user type, plus extras per user
+type fakePermissions struct {
+	byType map[string][]string
+	byUser map[int][]string
+	err    error
+	calls  int
+}
+
+func (f *fakePermissions) Permissions(_ context.Context, userID int, userType string) (map[string]bool, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	perms := map[string]bool{}
+	for _, p := range append(f.byType[userType], f.byUser[userID]...) {
+		perms[p] = true
+	}
+	return perms, nil
+}
+
+func newPermissionRouter(resolver middleware.PermissionResolver) *gin.Engine {
+	router := gin.New()
+	protected := router.Group("")
+	protected.Use(middleware.RequireAuth(testSecretKey))
+	protected.GET("/pending",
+		middleware.RequirePermission(resolver, config.PermissionBookingsRead),
+		middleware.RequirePermission(resolver, config.PermissionReviewsModerate),
+		func(c *gin.Context) { c.Status(http.StatusOK) })
+	protected.DELETE("/reviews/:id",
+		middleware.RequirePermission(resolver, config.PermissionReviewsWrite),
+		func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"moderator": middleware.HasPermission(c, config.PermissionReviewsModerate)})
+		})
+	return router
+}
+
+func servePermissionRequest(t *testing.T, router *gin.Engine, method, path string, userID int, userType string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, path, nil)
+	if userID > 0 {
+		token, err := middleware.GenerateToken(userID, "user@example.com", userType, testSecretKey, time.Hour)
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestRequirePermission(t *testing.T) {
+	resolver := &fakePermissions{
+		byType: map[string][]string{
+			"customer": {config.PermissionBookingsRead, config.PermissionReviewsWrite},
+			"admin":    {config.PermissionAll},
+		},
+		byUser: map[int][]string{
+			2: {config.PermissionReviewsModerate},
+		},
+	}
+	router := newPermissionRouter(resolver)
+
+	t.Run("missing permission is forbidden", func(t *testing.T) {
+		w := servePermissionRequest(t, router, "GET", "/pending", 1, "customer")
+		assert.Equal(t, http.StatusForbidden, w.Code)
+	})
+
+	t.Run("assigned role grants permission", func(t *testing.T) {
+		w := servePermissionRequest(t, router, "GET", "/pending", 2, "customer")
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("wildcard grants everything", func(t *testing.T) {
+		w := servePermissionRequest(t, router, "GET", "/pending", 3, "admin")
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("unauthenticated is rejected before permissions", func(t *testing.T) {
+		w := servePermissionRequest(t, router, "GET", "/pending", 0, "")
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("HasPermission reflects loaded permissions", func(t *testing.T) {
+		w := servePermissionRequest(t, router, "DELETE", "/reviews/1", 1, "customer")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"moderator": false}`, w.Body.String())
+
+		w = servePermissionRequest(t, router, "DELETE", "/reviews/1", 2, "customer")
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"moderator": true}`, w.Body.String())
+	})
+}
+
+func TestRequirePermission_ResolvesOncePerRequest(t *testing.T) {
+	resolver := &fakePermissions{byType: map[string][]string{"admin": {config.PermissionAll}}}
+	router := newPermissionRouter(resolver)
+
+	w := servePermissionRequest(t, router, "GET", "/pending", 1, "admin")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, 1, resolver.calls)
+}
+
+func TestRequirePermission_ResolverFailure(t *testing.T) {
+	resolver := &fakePermissions{err: errors.New("database unavailable")}
+	router := newPermissionRouter(resolver)
+
+	w := servePermissionRequest(t, router, "GET", "/pending", 1, "admin")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
@@ -0,0 +1,54 @@
+// tests/unit/middleware/query_budget_middleware_test.go
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/querybudget"
+	"barber-booking-system/internal/sqlhook"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// runQueries handles a request by running n queries through the budget hook
+func runQueries(n int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		for i := 0; i < n; i++ {
+			if err := querybudget.Hook(c.Request.Context(), sqlhook.OpQuery); err != nil {
+				c.String(http.StatusInternalServerError, err.Error())
+				return
+			}
+		}
+		c.String(http.StatusOK, "ok")
+	}
+}
+
+func TestQueryBudget_StrictFailsOverBudget(t *testing.T) {
+	router := gin.New()
+	router.Use(middleware.QueryBudget(3, true))
+	router.GET("/list", runQueries(4))
+	router.GET("/export", middleware.UnlimitedQueries(), runQueries(10))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/list", nil))
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "query budget exceeded")
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/export", nil))
+	assert.Equal(t, http.StatusOK, w.Code, "bulk routes opt out")
+}
+
+func TestQueryBudget_LenientOnlyReports(t *testing.T) {
+	router := gin.New()
+	router.Use(middleware.QueryBudget(3, false))
+	router.GET("/list", runQueries(10))
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/list", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -2,6 +2,7 @@
 package middleware_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -421,3 +422,52 @@ func TestRateLimitMiddleware_CustomKeyFunc(t *testing.T) {
 	router.ServeHTTP(w, req)
 	assert.Equal(t, http.StatusTooManyRequests, w.Code)
 }
+
+// fakeQuotas is a QuotaLookup backed by a map
+type fakeQuotas map[int]int
+
+func (q fakeQuotas) QuotaFor(_ context.Context, userID int) (int, bool) {
+	limit, ok := q[userID]
+	return limit, ok
+}
+
+// Test per-consumer quotas: each consumer gets their own bucket and quota
+func TestRateLimitMiddleware_ConsumerQuotas(t *testing.T) {
+	quotas := fakeQuotas{1: 3}
+	config := middleware.RateLimitConfig{
+		Limit:      1,
+		Window:     1 * time.Minute,
+		KeyFunc:    middleware.ConsumerKeyFunc(testSecretKey),
+		LimitFunc:  middleware.ConsumerLimitFunc(testSecretKey, quotas),
+		Message:    "Rate limit exceeded",
+		StatusCode: http.StatusTooManyRequests,
+	}
+
+	router := gin.New()
+	router.Use(middleware.RateLimitMiddleware(config))
+	router.GET("/test", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	send := func(userID int) *httptest.ResponseRecorder {
+		token, err := middleware.GenerateToken(userID, "partner@example.com", "customer", testSecretKey, time.Hour)
+		assert.NoError(t, err)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest("GET", "/test", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	// User 1 has a quota of 3
+	for i := 0; i < 3; i++ {
+		w := send(1)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "3", w.Header().Get("X-RateLimit-Limit"))
+	}
+	assert.Equal(t, http.StatusTooManyRequests, send(1).Code)
+
+	// User 2 gets the default limit in a separate bucket from the same IP
+	assert.Equal(t, http.StatusOK, send(2).Code)
+	assert.Equal(t, http.StatusTooManyRequests, send(2).Code)
+}
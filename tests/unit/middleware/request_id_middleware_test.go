@@ -0,0 +1,103 @@
+// tests/unit/middleware/request_id_middleware_test.go
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"barber-booking-system/internal/logger"
+	"barber-booking-system/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestIDMiddleware_ReplacesUnusableIDs(t *testing.T) {
+	router := gin.New()
+	router.Use(middleware.RequestIDMiddleware())
+	router.GET("/test", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	tests := []struct {
+		name     string
+		header   string
+		accepted bool
+	}{
+		{name: "uuid", header: "3f1c2a9e-8d4b-4f6a-9c1e-2b7d5a0e4f13", accepted: true},
+		{name: "trace-style", header: "req_01H.abc:42", accepted: true},
+		{name: "spaces", header: "not a valid id"},
+		{name: "newline injection", header: "abc\nlevel=error"},
+		{name: "too long", header: strings.Repeat("a", 129)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest("GET", "/test", nil)
+			req.Header[http.CanonicalHeaderKey("X-Request-ID")] = []string{tt.header}
+			router.ServeHTTP(w, req)
+
+			got := w.Header().Get("X-Request-ID")
+			require.NotEmpty(t, got)
+			if tt.accepted {
+				assert.Equal(t, tt.header, got)
+			} else {
+				assert.NotEqual(t, tt.header, got)
+			}
+		})
+	}
+}
+
+func TestRequestIDMiddleware_PropagatesToRequestContext(t *testing.T) {
+	router := gin.New()
+	router.Use(middleware.RequestIDMiddleware())
+
+	var fromContext string
+	router.GET("/test", func(c *gin.Context) {
+		fromContext = logger.RequestIDFromContext(c.Request.Context())
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("X-Request-ID", "support-ticket-123")
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, "support-ticket-123", fromContext)
+}
+
+func TestRequestIDMiddleware_TagsErrorResponses(t *testing.T) {
+	router := gin.New()
+	router.Use(middleware.RequestIDMiddleware())
+	router.GET("/test", func(c *gin.Context) {
+		middleware.RespondWithError(c, middleware.NewForbiddenError("Insufficient permissions"))
+	})
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/test", nil)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	var response middleware.ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.NotEmpty(t, response.RequestID)
+	assert.Equal(t, w.Header().Get("X-Request-ID"), response.RequestID)
+}
+
+func TestWriteError_WithoutRequestID(t *testing.T) {
+	router := gin.New()
+	router.GET("/test", func(c *gin.Context) {
+		middleware.WriteError(c, http.StatusBadRequest, middleware.ErrorResponse{Error: "Bad", Message: "bad"})
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("GET", "/test", nil))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.NotContains(t, w.Body.String(), "request_id")
+}
@@ -0,0 +1,86 @@
+// tests/unit/middleware/request_limit_middleware_test.go
+package middleware_test
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"barber-booking-system/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readBodyHandler reads the whole body so MaxBytesReader limits are enforced
+func readBodyHandler(c *gin.Context) {
+	if _, err := io.ReadAll(c.Request.Body); err != nil {
+		c.Status(http.StatusRequestEntityTooLarge)
+		return
+	}
+	c.Status(http.StatusOK)
+}
+
+func TestLimitRequestBody_PerRouteGroup(t *testing.T) {
+	router := gin.New()
+
+	// JSON and upload routes share a prefix but live in sibling groups
+	api := router.Group("/api/items")
+	api.Use(middleware.DefaultRequestBodyLimit(10))
+	api.POST("", readBodyHandler)
+
+	uploads := router.Group("/api/items")
+	uploads.Use(middleware.DefaultRequestBodyLimit(100))
+	uploads.POST("/photos", readBodyHandler)
+
+	body := strings.Repeat("x", 50)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/api/items", strings.NewReader(body)))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest("POST", "/api/items/photos", strings.NewReader(body)))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func buildMultipart(t *testing.T, fields int) (*bytes.Buffer, string) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+	for i := 0; i < fields; i++ {
+		require.NoError(t, writer.WriteField("field", "value"))
+	}
+	require.NoError(t, writer.Close())
+	return &buf, writer.FormDataContentType()
+}
+
+func TestLimitMultipartParts(t *testing.T) {
+	router := gin.New()
+	router.Use(middleware.LimitMultipartParts(3))
+	router.POST("/upload", func(c *gin.Context) {
+		form, err := c.MultipartForm()
+		require.NoError(t, err)
+		c.JSON(http.StatusOK, gin.H{"fields": len(form.Value["field"])})
+	})
+
+	body, contentType := buildMultipart(t, 3)
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest("POST", "/upload", body)
+	req.Header.Set("Content-Type", contentType)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"fields":3`)
+
+	body, contentType = buildMultipart(t, 4)
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest("POST", "/upload", body)
+	req.Header.Set("Content-Type", contentType)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Contains(t, w.Body.String(), "TOO_MANY_PARTS")
+}
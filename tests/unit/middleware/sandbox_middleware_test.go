@@ -0,0 +1,73 @@
+// tests/unit/middleware/sandbox_middleware_test.go
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"barber-booking-system/internal/middleware"
+	"barber-booking-system/internal/sandbox"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSandboxRouter(enabled bool) *gin.Engine {
+	router := gin.New()
+	router.Use(middleware.Sandbox(enabled))
+	router.GET("/mode", func(c *gin.Context) {
+		if sandbox.Enabled(c.Request.Context()) {
+			c.String(http.StatusOK, "sandbox")
+			return
+		}
+		c.String(http.StatusOK, "live")
+	})
+	return router
+}
+
+func TestSandbox_HeaderEnablesSandboxMode(t *testing.T) {
+	router := newSandboxRouter(true)
+
+	for _, value := range []string{"true", "1"} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/mode", nil)
+		req.Header.Set(sandbox.Header, value)
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "sandbox", w.Body.String())
+		assert.Equal(t, "true", w.Header().Get(sandbox.Header))
+	}
+}
+
+func TestSandbox_LiveWithoutHeader(t *testing.T) {
+	router := newSandboxRouter(true)
+
+	for _, value := range []string{"", "false"} {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/mode", nil)
+		if value != "" {
+			req.Header.Set(sandbox.Header, value)
+		}
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, "live", w.Body.String())
+		assert.Empty(t, w.Header().Get(sandbox.Header))
+	}
+}
+
+func TestSandbox_DisabledRefusesSandboxRequests(t *testing.T) {
+	router := newSandboxRouter(false)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/mode", nil)
+	req.Header.Set(sandbox.Header, "true")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Contains(t, w.Body.String(), "SANDBOX_DISABLED")
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/mode", nil))
+	assert.Equal(t, "live", w.Body.String())
+}
@@ -0,0 +1,59 @@
+// tests/unit/middleware/usage_middleware_test.go
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type usageRecord struct {
+	userID int
+	method string
+	route  string
+	status int
+}
+
+type fakeUsageRecorder struct {
+	records []usageRecord
+}
+
+func (r *fakeUsageRecorder) Record(userID int, method, route string, status int, _ time.Duration) {
+	r.records = append(r.records, usageRecord{userID, method, route, status})
+}
+
+func TestTrackUsage_RecordsAuthenticatedRequests(t *testing.T) {
+	recorder := &fakeUsageRecorder{}
+
+	router := gin.New()
+	api := router.Group("/api")
+	api.Use(middleware.TrackUsage(recorder))
+	api.GET("/public", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+	protected := api.Group("")
+	protected.Use(middleware.RequireAuth(testSecretKey))
+	protected.GET("/bookings/:id", func(c *gin.Context) {
+		c.Status(http.StatusNotFound)
+	})
+
+	token, err := middleware.GenerateToken(7, "partner@example.com", "customer", testSecretKey, time.Hour)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest("GET", "/api/bookings/42", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	// Anonymous requests are not recorded
+	router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/api/public", nil))
+
+	require.Len(t, recorder.records, 1)
+	assert.Equal(t, usageRecord{7, "GET", "/api/bookings/:id", http.StatusNotFound}, recorder.records[0])
+}
@@ -0,0 +1,145 @@
+// tests/unit/migrate/migrate_test.go
+package migrate_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"barber-booking-system/internal/migrate"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var migrationsDir = filepath.Join("..", "..", "..", "migrations")
+
+// TestMigrationsAreSafe fails when a new migration locks or breaks a live
+// database (go run ./cmd/migrate lint shows the details)
+func TestMigrationsAreSafe(t *testing.T) {
+	migrations, findings, err := migrate.Check(migrationsDir, migrate.DefaultLintOptions())
+	require.NoError(t, err)
+	assert.NotEmpty(t, migrations)
+	for _, f := range findings {
+		t.Error(f)
+	}
+}
+
+func TestSplitStatements(t *testing.T) {
+	sql := `-- a comment; not a statement
+CREATE TABLE t (note TEXT DEFAULT 'a;b');
+
+CREATE FUNCTION f() RETURNS trigger AS $$
+BEGIN
+    NEW.x := 1; RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+/* trailing; */`
+
+	statements := migrate.SplitStatements(sql)
+	require.Len(t, statements, 2)
+	assert.Equal(t, 2, statements[0].Line)
+	assert.Equal(t, "CREATE TABLE T (NOTE TEXT DEFAULT 'A;B')", statements[0].Normalized())
+	assert.Equal(t, 4, statements[1].Line)
+	assert.Contains(t, statements[1].SQL, "RETURN NEW;")
+}
+
+func lint(t *testing.T, sql string) []string {
+	t.Helper()
+	m, err := migrate.Parse(100, "test", sql)
+	require.NoError(t, err)
+
+	var rules []string
+	for _, f := range migrate.Lint(m, migrate.LintOptions{Since: 99, LargeTables: []string{"bookings"}}) {
+		rules = append(rules, f.Rule)
+	}
+	return rules
+}
+
+func TestLint(t *testing.T) {
+	t.Run("indexes must be built concurrently", func(t *testing.T) {
+		assert.Equal(t, []string{migrate.RuleIndexNotConcurrent}, lint(t, `CREATE INDEX idx ON bookings (status);`))
+		assert.Empty(t, lint(t, `CREATE INDEX CONCURRENTLY IF NOT EXISTS idx ON bookings (status);`))
+		assert.Equal(t, []string{migrate.RuleIndexNotConcurrent}, lint(t, `DROP INDEX IF EXISTS idx;`))
+	})
+
+	t.Run("tables created in the migration are exempt", func(t *testing.T) {
+		assert.Empty(t, lint(t, `
+			CREATE TABLE IF NOT EXISTS things (id SERIAL PRIMARY KEY, name TEXT);
+			CREATE INDEX idx_things ON things (name);
+			ALTER TABLE things ALTER COLUMN name TYPE VARCHAR(100);
+		`))
+	})
+
+	t.Run("large tables are not rewritten or scanned under lock", func(t *testing.T) {
+		assert.Equal(t, []string{migrate.RuleColumnTypeChange}, lint(t, `ALTER TABLE bookings ALTER COLUMN notes TYPE TEXT;`))
+		assert.Equal(t, []string{migrate.RuleSetNotNull}, lint(t, `ALTER TABLE bookings ALTER COLUMN notes SET NOT NULL;`))
+		assert.Equal(t, []string{migrate.RuleConstraintNotValid}, lint(t, `ALTER TABLE bookings ADD CONSTRAINT c CHECK (total_price >= 0);`))
+		assert.Empty(t, lint(t, `ALTER TABLE bookings ADD CONSTRAINT c CHECK (total_price >= 0) NOT VALID;`))
+		assert.Empty(t, lint(t, `ALTER TABLE services ALTER COLUMN name TYPE TEXT;`), "small tables may be rewritten")
+	})
+
+	t.Run("drops and renames wait for a contract migration", func(t *testing.T) {
+		assert.Equal(t, []string{migrate.RuleDestructive, migrate.RuleDestructive}, lint(t, `
+			ALTER TABLE services DROP COLUMN legacy, ADD COLUMN fresh TEXT;
+			ALTER TABLE services RENAME COLUMN a TO b;
+		`))
+		assert.Empty(t, lint(t, "-- migrate:phase contract\nALTER TABLE services DROP COLUMN legacy;"))
+		assert.Empty(t, lint(t, `ALTER TABLE services DROP CONSTRAINT services_check;`))
+	})
+
+	t.Run("allowed rules are skipped", func(t *testing.T) {
+		assert.Empty(t, lint(t, "-- migrate:allow index-not-concurrent table has a few rows\nCREATE INDEX idx ON bookings (status);"))
+	})
+
+	t.Run("migrations up to the baseline are skipped", func(t *testing.T) {
+		m, err := migrate.Parse(99, "old", `CREATE INDEX idx ON bookings (status);`)
+		require.NoError(t, err)
+		assert.Empty(t, migrate.Lint(m, migrate.LintOptions{Since: 99}))
+	})
+}
+
+func TestParseDirectives(t *testing.T) {
+	m, err := migrate.Parse(100, "expand", "-- migrate:phase expand\n-- migrate:backfill booking_cents\nALTER TABLE bookings ADD COLUMN total_cents BIGINT;")
+	require.NoError(t, err)
+	assert.Equal(t, migrate.PhaseExpand, m.Phase)
+	assert.Equal(t, []string{"booking_cents"}, m.Backfills)
+
+	_, err = migrate.Parse(100, "bad", "-- migrate:backfill booking_cents\nSELECT 1;")
+	assert.Error(t, err, "only expand migrations queue backfills")
+
+	_, err = migrate.Parse(100, "bad", "-- migrate:allow index-not-concurrent\nSELECT 1;")
+	assert.Error(t, err, "allow needs a reason")
+
+	_, err = migrate.Parse(100, "bad", "-- migrate:allow no-such-rule because\nSELECT 1;")
+	assert.Error(t, err)
+}
+
+func TestCheckBackfills(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	write("000001_expand.up.sql", "-- migrate:phase expand\n-- migrate:backfill booking_cents\nALTER TABLE bookings ADD COLUMN total_cents BIGINT;")
+	write("000002_contract.up.sql", "-- migrate:phase contract\n-- migrate:requires booking_cents\nALTER TABLE bookings DROP COLUMN total_price;")
+
+	_, _, err := migrate.Check(dir, migrate.LintOptions{})
+	assert.ErrorContains(t, err, "failed to read backfill booking_cents")
+
+	write("backfills/booking_cents.sql", "-- migrate:table bookings\nUPDATE bookings SET total_cents = ROUND(total_price * 100)\nWHERE id >= $1 AND id < $2 AND total_cents IS NULL;")
+	migrations, findings, err := migrate.Check(dir, migrate.LintOptions{})
+	require.NoError(t, err)
+	assert.Len(t, migrations, 2)
+	assert.Empty(t, findings)
+
+	backfill, err := migrate.LoadBackfill(dir, "booking_cents")
+	require.NoError(t, err)
+	assert.Equal(t, "bookings", backfill.Table)
+	assert.Equal(t, "id", backfill.KeyColumn)
+
+	write("000003_contract.up.sql", "-- migrate:phase contract\n-- migrate:requires unknown_backfill\nSELECT 1;")
+	_, _, err = migrate.Check(dir, migrate.LintOptions{})
+	assert.ErrorContains(t, err, "no earlier migration queues")
+}
@@ -0,0 +1,79 @@
+// tests/unit/mockgen/mockgen_test.go
+package mockgen_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"barber-booking-system/internal/mockgen"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var repositoryDir = filepath.Join("..", "..", "..", "internal", "repository")
+
+// TestRepositoryMocksMatchStores fails when repository/stores.go changed
+// without regenerating the mocks (go generate ./internal/repository/...)
+func TestRepositoryMocksMatchStores(t *testing.T) {
+	src, err := os.ReadFile(filepath.Join(repositoryDir, "stores.go"))
+	require.NoError(t, err)
+
+	generated, err := mockgen.Generate("stores.go", src, "barber-booking-system/internal/repository", "mocks")
+	require.NoError(t, err)
+
+	committed, err := os.ReadFile(filepath.Join(repositoryDir, "mocks", "stores.gen.go"))
+	require.NoError(t, err)
+	assert.Equal(t, string(generated), string(committed), "mocks are stale; run go generate ./internal/repository/...")
+}
+
+func TestGenerate(t *testing.T) {
+	src := []byte(`package store
+
+import (
+	"context"
+	"time"
+)
+
+type Thing struct{}
+
+type ThingStore interface {
+	Find(ctx context.Context, id int) (*Thing, error)
+	Touch(context.Context, time.Time)
+	Tags(names ...string) map[string]bool
+}
+
+type unexported interface {
+	Hidden() error
+}
+`)
+
+	out, err := mockgen.Generate("store.go", src, "example.com/app/store", "mocks")
+	require.NoError(t, err)
+
+	code := string(out)
+	assert.Contains(t, code, "package mocks")
+	assert.Contains(t, code, `"example.com/app/store"`)
+	assert.Contains(t, code, `"github.com/stretchr/testify/mock"`)
+	assert.Contains(t, code, "var _ store.ThingStore = (*MockThingStore)(nil)")
+	assert.Contains(t, code, "func (m *MockThingStore) Find(ctx context.Context, id int) (*store.Thing, error) {")
+	assert.Contains(t, code, "r0, _ := args.Get(0).(*store.Thing)")
+	assert.Contains(t, code, "func (m *MockThingStore) Touch(p0 context.Context, p1 time.Time) {")
+	assert.Contains(t, code, "func (m *MockThingStore) Tags(names ...string) map[string]bool {")
+	assert.NotContains(t, code, "Hidden")
+}
+
+func TestGenerate_RejectsEmbeddedInterfaces(t *testing.T) {
+	src := []byte(`package store
+
+import "io"
+
+type ThingStore interface {
+	io.Closer
+}
+`)
+
+	_, err := mockgen.Generate("store.go", src, "example.com/app/store", "mocks")
+	assert.Error(t, err)
+}
@@ -0,0 +1,91 @@
+// tests/unit/models/analytics_dataset_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsBookingBucket(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	category := "Haircut"
+	booking := models.AnalyticsBooking{
+		// Thursday 2026-03-12 09:30 in New York
+		ScheduledStartTime: time.Date(2026, 3, 12, 13, 30, 0, 0, time.UTC),
+		ScheduledEndTime:   time.Date(2026, 3, 12, 14, 15, 0, 0, time.UTC),
+		CreatedAt:          time.Date(2026, 3, 10, 8, 0, 0, 0, time.UTC),
+		TotalPrice:         34.5,
+		Currency:           "USD",
+		Status:             config.BookingStatusCompleted,
+		BookingSource:      "web_app",
+		ServiceCategory:    &category,
+		City:               " Brooklyn",
+	}
+
+	record := booking.Bucket(loc, config.AnalyticsGranularityWeek, 10)
+	assert.Equal(t, models.AnalyticsRecord{
+		Period:    "2026-03-09",
+		DayOfWeek: "thursday",
+		TimeBand:  "08:00-10:00",
+		LeadTime:  "1-3d",
+		Duration:  "30-60m",
+		PriceBand: "30-40",
+		Currency:  "USD",
+		Status:    config.BookingStatusCompleted,
+		Source:    "web_app",
+		Category:  "haircut",
+		City:      "brooklyn",
+	}, record)
+
+	booking.ServiceCategory, booking.City = nil, ""
+	record = booking.Bucket(loc, config.AnalyticsGranularityMonth, 25)
+	assert.Equal(t, "2026-03-01", record.Period)
+	assert.Equal(t, "25-50", record.PriceBand)
+	assert.Equal(t, "other", record.Category)
+	assert.Equal(t, "unknown", record.City)
+}
+
+func TestBuildAnalyticsDatasetSuppressesSmallRows(t *testing.T) {
+	common := models.AnalyticsRecord{Period: "2026-03-09", DayOfWeek: "monday", City: "austin"}
+	rare := common
+	rare.City = "marfa"
+	other := common
+	other.DayOfWeek = "friday"
+
+	records := []models.AnalyticsRecord{}
+	for i := 0; i < 6; i++ {
+		records = append(records, common)
+	}
+	for i := 0; i < 5; i++ {
+		records = append(records, other)
+	}
+	records = append(records, rare, rare)
+
+	dataset := models.BuildAnalyticsDataset(records, 5)
+	require.Len(t, dataset.Rows, 2)
+	assert.Equal(t, "friday", dataset.Rows[0].DayOfWeek, "rows are sorted by their attributes")
+	assert.Equal(t, 5, dataset.Rows[0].Bookings)
+	assert.Equal(t, 6, dataset.Rows[1].Bookings)
+	for _, row := range dataset.Rows {
+		assert.GreaterOrEqual(t, row.Bookings, dataset.K)
+	}
+
+	assert.Equal(t, 13, dataset.Bookings)
+	assert.Equal(t, 1, dataset.SuppressedRows)
+	assert.Equal(t, 2, dataset.SuppressedBookings)
+	assert.InDelta(t, 2.0/13, dataset.SuppressionRate(), 1e-9)
+}
+
+func TestAnalyticsEmptyDataset(t *testing.T) {
+	dataset := models.BuildAnalyticsDataset(nil, config.DefaultAnalyticsK)
+	assert.Empty(t, dataset.Rows)
+	assert.Zero(t, dataset.SuppressionRate())
+}
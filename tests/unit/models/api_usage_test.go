@@ -0,0 +1,44 @@
+// tests/unit/models/api_usage_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIUsage_AddAndMerge(t *testing.T) {
+	var usage models.APIUsage
+	usage.Add(200, 40*time.Millisecond)
+	usage.Add(404, 10*time.Millisecond)
+	usage.Add(503, 250*time.Millisecond)
+
+	assert.Equal(t, int64(3), usage.RequestCount)
+	assert.Equal(t, int64(1), usage.ClientErrorCount)
+	assert.Equal(t, int64(1), usage.ServerErrorCount)
+	assert.Equal(t, int64(300), usage.TotalLatencyMs)
+	assert.Equal(t, int64(250), usage.MaxLatencyMs)
+
+	other := models.APIUsage{RequestCount: 2, ServerErrorCount: 1, TotalLatencyMs: 900, MaxLatencyMs: 800}
+	usage.Merge(other)
+
+	assert.Equal(t, int64(5), usage.RequestCount)
+	assert.Equal(t, int64(2), usage.ServerErrorCount)
+	assert.Equal(t, int64(1200), usage.TotalLatencyMs)
+	assert.Equal(t, int64(800), usage.MaxLatencyMs)
+}
+
+func TestAPIUsageStats_Finalize(t *testing.T) {
+	stats := models.APIUsageStats{RequestCount: 8, ClientErrorCount: 2, ServerErrorCount: 1, AvgLatencyMs: 12.345}
+	stats.Finalize()
+
+	assert.Equal(t, 37.5, stats.ErrorRate)
+	assert.Equal(t, 12.3, stats.AvgLatencyMs)
+
+	empty := models.APIUsageStats{}
+	empty.Finalize()
+	assert.Zero(t, empty.ErrorRate)
+}
@@ -0,0 +1,37 @@
+// tests/unit/models/auto_reply_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBarberAutoReply_Validate(t *testing.T) {
+	reply := &models.BarberAutoReply{Message: config.DefaultAutoReplyMessage}
+	assert.NoError(t, reply.Validate())
+
+	reply.Message = "Back on {{ next_open_time }}, {{customer_name}}!"
+	assert.NoError(t, reply.Validate())
+
+	reply.Message = "   "
+	assert.ErrorContains(t, reply.Validate(), "must not be empty")
+
+	reply.Message = "See you {{tomorrow}}"
+	assert.ErrorContains(t, reply.Validate(), "got {{tomorrow}}")
+}
+
+func TestBarberAutoReply_Render(t *testing.T) {
+	reply := &models.BarberAutoReply{Message: "Hi {{customer_name}}, {{barber_name}} is back on {{ next_open_time }}. {{service_name}}"}
+
+	rendered := reply.Render(map[string]string{
+		"customer_name":  "Alex",
+		"barber_name":    "Sam",
+		"next_open_time": "Monday, March 10 at 9:00 AM UTC",
+	})
+
+	assert.Equal(t, "Hi Alex, Sam is back on Monday, March 10 at 9:00 AM UTC. ", rendered)
+}
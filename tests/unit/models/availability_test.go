@@ -0,0 +1,87 @@
+// tests/unit/models/availability_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func slotStarts(slots []models.TimeRange) []string {
+	starts := make([]string, len(slots))
+	for i, s := range slots {
+		starts[i] = s.Start.Format("15:04")
+	}
+	return starts
+}
+
+func TestAvailableSlots_SkipsBookingsWithBuffer(t *testing.T) {
+	day := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	windows := []models.TimeRange{{Start: day.Add(9 * time.Hour), End: day.Add(12 * time.Hour)}}
+	busy := []models.TimeRange{{Start: day.Add(10 * time.Hour), End: day.Add(10*time.Hour + 30*time.Minute)}}
+
+	slots := models.AvailableSlots(windows, busy, models.SlotOptions{
+		Duration: 30 * time.Minute,
+		Interval: 30 * time.Minute,
+	})
+	assert.Equal(t, []string{"09:00", "09:30", "10:30", "11:00", "11:30"}, slotStarts(slots))
+
+	// A 15-minute buffer keeps slots from touching the booking
+	slots = models.AvailableSlots(windows, busy, models.SlotOptions{
+		Duration: 30 * time.Minute,
+		Buffer:   15 * time.Minute,
+		Interval: 15 * time.Minute,
+	})
+	assert.Equal(t, []string{"09:00", "09:15", "10:45", "11:00", "11:15", "11:30"}, slotStarts(slots))
+}
+
+func TestAvailableSlots_DurationAndEarliest(t *testing.T) {
+	day := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	windows := []models.TimeRange{{Start: day.Add(9 * time.Hour), End: day.Add(11 * time.Hour)}}
+
+	// A 90-minute appointment only fits at the start of a 2-hour window
+	slots := models.AvailableSlots(windows, nil, models.SlotOptions{
+		Duration: 90 * time.Minute,
+		Interval: 30 * time.Minute,
+	})
+	assert.Equal(t, []string{"09:00", "09:30"}, slotStarts(slots))
+
+	slots = models.AvailableSlots(windows, nil, models.SlotOptions{
+		Duration: 30 * time.Minute,
+		Interval: 30 * time.Minute,
+		Earliest: day.Add(10 * time.Hour),
+	})
+	assert.Equal(t, []string{"10:00", "10:30"}, slotStarts(slots))
+}
+
+func TestBarberSchedule_WorkingWindows(t *testing.T) {
+	lunch := "Lunch"
+	schedule := &models.BarberSchedule{
+		Timezone: "America/New_York",
+		Periods: []models.SchedulePeriod{
+			{DayOfWeek: 1, StartTime: "09:00:00", EndTime: "17:00:00", PeriodType: config.SchedulePeriodWork},
+			{DayOfWeek: 1, StartTime: "12:00:00", EndTime: "13:00:00", PeriodType: config.SchedulePeriodBreak, Label: &lunch},
+		},
+	}
+
+	windows := schedule.WorkingWindows(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC))
+	require.Len(t, windows, 2)
+	assert.Equal(t, time.Date(2025, 6, 2, 13, 0, 0, 0, time.UTC), windows[0].Start.UTC()) // 09:00 EDT
+	assert.Equal(t, time.Date(2025, 6, 2, 16, 0, 0, 0, time.UTC), windows[0].End.UTC())
+	assert.Equal(t, time.Date(2025, 6, 2, 17, 0, 0, 0, time.UTC), windows[1].Start.UTC())
+	assert.Equal(t, time.Date(2025, 6, 2, 21, 0, 0, 0, time.UTC), windows[1].End.UTC())
+
+	// Tuesday has no periods; a holiday on Monday removes everything
+	assert.Empty(t, schedule.WorkingWindows(time.Date(2025, 6, 3, 0, 0, 0, 0, time.UTC)))
+	schedule.Exceptions = []models.ScheduleException{{
+		StartDate:     time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+		EndDate:       time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC),
+		ExceptionType: config.ScheduleExceptionHoliday,
+	}}
+	assert.Empty(t, schedule.WorkingWindows(time.Date(2025, 6, 2, 0, 0, 0, 0, time.UTC)))
+}
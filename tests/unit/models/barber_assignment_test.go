@@ -0,0 +1,46 @@
+// tests/unit/models/barber_assignment_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChooseBarber(t *testing.T) {
+	candidates := []models.BarberCandidate{
+		{BarberID: 1, Load: 4},
+		{BarberID: 2, Load: 1},
+		{BarberID: 3, Load: 1},
+	}
+	never := func(int) int { t.Fatal("random number drawn"); return 0 }
+
+	t.Run("least loaded takes the fewest bookings, earliest on a tie", func(t *testing.T) {
+		chosen, ok := models.ChooseBarber(config.AssignmentStrategyLeastLoaded, candidates, never)
+		assert.True(t, ok)
+		assert.Equal(t, 2, chosen.BarberID)
+	})
+
+	t.Run("first available takes the first candidate", func(t *testing.T) {
+		chosen, ok := models.ChooseBarber(config.AssignmentStrategyFirstAvailable, candidates, never)
+		assert.True(t, ok)
+		assert.Equal(t, 1, chosen.BarberID)
+	})
+
+	t.Run("random draws among the candidates", func(t *testing.T) {
+		chosen, ok := models.ChooseBarber(config.AssignmentStrategyRandom, candidates, func(n int) int {
+			assert.Equal(t, 3, n)
+			return 2
+		})
+		assert.True(t, ok)
+		assert.Equal(t, 3, chosen.BarberID)
+	})
+
+	t.Run("nobody free", func(t *testing.T) {
+		_, ok := models.ChooseBarber(config.AssignmentStrategyLeastLoaded, nil, never)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,20 @@
+// tests/unit/models/barber_client_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomerInvitation_IsOpen(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	claimed := now.Add(-time.Hour)
+
+	assert.True(t, (&models.CustomerInvitation{ExpiresAt: now.Add(time.Minute)}).IsOpen(now))
+	assert.False(t, (&models.CustomerInvitation{ExpiresAt: now}).IsOpen(now), "expires at its expiry time")
+	assert.False(t, (&models.CustomerInvitation{ExpiresAt: now.Add(time.Hour), ClaimedAt: &claimed}).IsOpen(now))
+}
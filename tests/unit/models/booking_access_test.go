@@ -0,0 +1,25 @@
+// tests/unit/models/booking_access_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBooking_IsAccessibleBy(t *testing.T) {
+	customerID := 10
+	booking := &models.Booking{CustomerID: &customerID, BarberID: 3}
+	const barberUserID = 20
+
+	assert.True(t, booking.IsAccessibleBy(10, barberUserID), "customer")
+	assert.True(t, booking.IsAccessibleBy(20, barberUserID), "assigned barber")
+	assert.False(t, booking.IsAccessibleBy(30, barberUserID), "someone else")
+
+	guest := &models.Booking{BarberID: 3}
+	assert.True(t, guest.IsAccessibleBy(20, barberUserID), "guest booking, assigned barber")
+	assert.False(t, guest.IsAccessibleBy(10, barberUserID), "guest booking, other user")
+	assert.False(t, guest.IsAccessibleBy(0, 0), "unknown barber")
+}
@@ -0,0 +1,49 @@
+// tests/unit/models/booking_archive_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchivedBookingRelated_Scan(t *testing.T) {
+	// As built by to_jsonb in the archival query
+	raw := []byte(`{
+		"history": [{"id": 7, "booking_id": 42, "change_type": "status_change"}],
+		"line_items": [{"id": 3, "booking_id": 42, "service_name": "Fade", "duration_minutes": 30, "price": 25.00, "position": 0, "created_at": "2023-03-14T09:00:00.123456+00:00"}],
+		"tax_lines": [{"id": 5, "booking_id": 42, "name": "VAT", "rate_percent": 20.000, "inclusive": true, "taxable_amount": 20.83, "amount": 4.17, "created_at": "2023-03-14T09:00:00+00:00"}],
+		"staff": []
+	}`)
+
+	var related models.ArchivedBookingRelated
+	require.NoError(t, related.Scan(raw))
+	require.Len(t, related.LineItems, 1)
+	assert.Equal(t, "Fade", related.LineItems[0].ServiceName)
+	require.Len(t, related.TaxLines, 1)
+	assert.Equal(t, "VAT", related.TaxLines[0].Name)
+	assert.InDelta(t, 4.17, related.TaxLines[0].Amount, 0.001)
+	assert.Len(t, related.History, 1)
+
+	require.NoError(t, related.Scan(nil))
+	assert.Empty(t, related.LineItems)
+}
+
+func TestArchivedBooking_Restore(t *testing.T) {
+	archived := &models.ArchivedBooking{
+		Booking: models.Booking{ID: 42, BookingNumber: "BK202303141234"},
+		Related: models.ArchivedBookingRelated{
+			LineItems: []models.BookingLineItem{{ID: 3, ServiceName: "Fade"}},
+			TaxLines:  []models.BookingTaxLine{{ID: 5}},
+		},
+	}
+
+	booking := archived.Restore()
+	assert.Equal(t, "BK202303141234", booking.BookingNumber)
+	assert.Len(t, booking.LineItems, 1)
+	assert.Len(t, booking.TaxLines, 1)
+	assert.Empty(t, archived.Booking.LineItems)
+}
@@ -0,0 +1,247 @@
+// tests/unit/models/booking_form_test.go
+package models
+
+import (
+	"encoding/json"
+	"testing"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookingFormField_Answer(t *testing.T) {
+	maxLength := 5
+	pattern := `[A-Z]?[0-9]+`
+	minValue, maxValue := 1.0, 10.0
+
+	tests := []struct {
+		name    string
+		field   models.BookingFormField
+		value   interface{}
+		want    interface{}
+		wantErr string
+	}{
+		{
+			name:  "text is trimmed",
+			field: models.BookingFormField{Key: "note", FieldType: "text"},
+			value: "  hello ",
+			want:  "hello",
+		},
+		{
+			name:  "blank optional answer is nil",
+			field: models.BookingFormField{Key: "note", FieldType: "text"},
+			value: "   ",
+			want:  nil,
+		},
+		{
+			name:    "blank required answer",
+			field:   models.BookingFormField{Key: "note", FieldType: "text", IsRequired: true},
+			value:   "",
+			wantErr: "is required",
+		},
+		{
+			name:    "text too long",
+			field:   models.BookingFormField{Key: "note", FieldType: "text", MaxLength: &maxLength},
+			value:   "abcdef",
+			wantErr: "at most 5 characters",
+		},
+		{
+			name:  "text matching pattern",
+			field: models.BookingFormField{Key: "spot", FieldType: "text", Pattern: &pattern},
+			value: "B12",
+			want:  "B12",
+		},
+		{
+			name:    "pattern must match the whole answer",
+			field:   models.BookingFormField{Key: "spot", FieldType: "text", Pattern: &pattern},
+			value:   "B12 near the door",
+			wantErr: "must match the format",
+		},
+		{
+			name:    "text answered with a number",
+			field:   models.BookingFormField{Key: "note", FieldType: "text"},
+			value:   3.0,
+			wantErr: "must be text",
+		},
+		{
+			name:  "number",
+			field: models.BookingFormField{Key: "guard", FieldType: "number", MinValue: &minValue, MaxValue: &maxValue},
+			value: 4.0,
+			want:  4.0,
+		},
+		{
+			name:  "number as string",
+			field: models.BookingFormField{Key: "guard", FieldType: "number"},
+			value: "2.5",
+			want:  2.5,
+		},
+		{
+			name:    "number below minimum",
+			field:   models.BookingFormField{Key: "guard", FieldType: "number", MinValue: &minValue},
+			value:   0.5,
+			wantErr: "at least 1",
+		},
+		{
+			name:    "number above maximum",
+			field:   models.BookingFormField{Key: "guard", FieldType: "number", MaxValue: &maxValue},
+			value:   11.0,
+			wantErr: "at most 10",
+		},
+		{
+			name:    "number not numeric",
+			field:   models.BookingFormField{Key: "guard", FieldType: "number"},
+			value:   "four",
+			wantErr: "must be a number",
+		},
+		{
+			name:  "select option",
+			field: models.BookingFormField{Key: "style", FieldType: "select", Options: models.StringArray{"fade", "taper"}},
+			value: "taper",
+			want:  "taper",
+		},
+		{
+			name:    "select unknown option",
+			field:   models.BookingFormField{Key: "style", FieldType: "select", Options: models.StringArray{"fade", "taper"}},
+			value:   "mohawk",
+			wantErr: "must be one of: fade, taper",
+		},
+		{
+			name:  "checkbox",
+			field: models.BookingFormField{Key: "consent", FieldType: "checkbox"},
+			value: false,
+			want:  false,
+		},
+		{
+			name:  "checkbox as string",
+			field: models.BookingFormField{Key: "consent", FieldType: "checkbox"},
+			value: "true",
+			want:  true,
+		},
+		{
+			name:    "required checkbox must be checked",
+			field:   models.BookingFormField{Key: "consent", FieldType: "checkbox", IsRequired: true},
+			value:   false,
+			wantErr: "is required",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.field.Answer(tt.value)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAnswerBookingForm(t *testing.T) {
+	fields := []models.BookingFormField{
+		{Key: "spot", Label: "Parking spot", FieldType: "text", IsActive: true},
+		{Key: "guard", Label: "Clipper guard", FieldType: "number", IsActive: true, IsRequired: true},
+		{Key: "retired", Label: "Old question", FieldType: "text", IsActive: false, IsRequired: true},
+	}
+
+	t.Run("answers in form order", func(t *testing.T) {
+		answers, err := models.AnswerBookingForm(fields, map[string]interface{}{"guard": 2.0, "spot": "B12"})
+		require.NoError(t, err)
+		assert.Equal(t, models.BookingFormAnswers{
+			{Key: "spot", Label: "Parking spot", Type: "text", Value: "B12"},
+			{Key: "guard", Label: "Clipper guard", Type: "number", Value: 2.0},
+		}, answers)
+	})
+
+	t.Run("unanswered optional fields are left out", func(t *testing.T) {
+		answers, err := models.AnswerBookingForm(fields, map[string]interface{}{"guard": 2.0})
+		require.NoError(t, err)
+		require.Len(t, answers, 1)
+		assert.Equal(t, "guard", answers[0].Key)
+	})
+
+	t.Run("required field missing", func(t *testing.T) {
+		_, err := models.AnswerBookingForm(fields, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"guard" is required`)
+	})
+
+	t.Run("unknown field", func(t *testing.T) {
+		_, err := models.AnswerBookingForm(fields, map[string]interface{}{"guard": 2.0, "shoe_size": 42.0})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"shoe_size" cannot be answered`)
+	})
+
+	t.Run("inactive field cannot be answered", func(t *testing.T) {
+		_, err := models.AnswerBookingForm(fields, map[string]interface{}{"guard": 2.0, "retired": "x"})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"retired" cannot be answered`)
+	})
+}
+
+func TestBookingFormAnswers_ValueScan(t *testing.T) {
+	value, err := models.BookingFormAnswers(nil).Value()
+	require.NoError(t, err)
+	assert.Nil(t, value)
+
+	in := models.BookingFormAnswers{{Key: "spot", Label: "Parking spot", Type: "text", Value: "B12"}}
+	value, err = in.Value()
+	require.NoError(t, err)
+
+	var out models.BookingFormAnswers
+	require.NoError(t, out.Scan(value))
+	assert.Equal(t, in, out)
+
+	var raw []map[string]interface{}
+	require.NoError(t, json.Unmarshal(value.([]byte), &raw))
+	assert.Equal(t, "spot", raw[0]["key"])
+}
+
+func TestBookingFormField_AppliesTo(t *testing.T) {
+	serviceID := 12
+	everyService := models.BookingFormField{Key: "spot"}
+	onService := models.BookingFormField{Key: "allergies", BarberServiceID: &serviceID}
+
+	assert.True(t, everyService.AppliesTo(nil))
+	assert.True(t, onService.AppliesTo([]int{3, 12}))
+	assert.False(t, onService.AppliesTo([]int{3}))
+}
+
+func TestConsultationTriggers(t *testing.T) {
+	serviceID := 12
+	fields := []models.BookingFormField{
+		{Key: "spot", FieldType: "text"},
+		{Key: "allergies", FieldType: "checkbox", BarberServiceID: &serviceID, ConsultationAnswers: models.StringArray{"true"}},
+		{Key: "colour", FieldType: "select", BarberServiceID: &serviceID, ConsultationAnswers: models.StringArray{"bleach", "other"}},
+	}
+
+	t.Run("answers that need a consultation, in form order", func(t *testing.T) {
+		answers := models.BookingFormAnswers{
+			{Key: "colour", Value: "bleach"},
+			{Key: "allergies", Value: true},
+			{Key: "spot", Value: "true"},
+		}
+		assert.Equal(t, []string{"allergies", "colour"}, models.ConsultationTriggers(fields, answers))
+	})
+
+	t.Run("other answers book as usual", func(t *testing.T) {
+		answers := models.BookingFormAnswers{
+			{Key: "colour", Value: "toner"},
+			{Key: "allergies", Value: false},
+		}
+		assert.Empty(t, models.ConsultationTriggers(fields, answers))
+	})
+}
+
+func TestBarberService_NeedsConsultation(t *testing.T) {
+	yes, no := true, false
+
+	assert.False(t, (&models.BarberService{}).NeedsConsultation())
+	assert.True(t, (&models.BarberService{ServiceRequiresConsultation: &yes}).NeedsConsultation())
+	assert.False(t, (&models.BarberService{RequiresConsultation: &no, ServiceRequiresConsultation: &yes}).NeedsConsultation())
+	assert.True(t, (&models.BarberService{RequiresConsultation: &yes, ServiceRequiresConsultation: &no}).NeedsConsultation())
+}
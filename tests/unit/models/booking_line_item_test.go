@@ -0,0 +1,68 @@
+// tests/unit/models/booking_line_item_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceSelection_Totals(t *testing.T) {
+	selection := models.SelectServices(
+		&models.BarberService{EstimatedDurationMin: 30, Price: 25.10, BufferTimeMinutes: 5},
+		&models.BarberService{EstimatedDurationMin: 15, Price: 12.20, BufferTimeMinutes: 10},
+	)
+
+	assert.Equal(t, 45, selection.TotalMinutes())
+	assert.Equal(t, 37.30, selection.TotalPrice())
+	// The break follows the last service
+	assert.Equal(t, 10, selection.BufferMinutes())
+
+	assert.Equal(t, 0, models.ServiceSelection(nil).BufferMinutes())
+}
+
+func TestServiceSelection_DurationSegments(t *testing.T) {
+	color := &models.BarberService{EstimatedDurationMin: 90, DurationSegments: colorSegments}
+	trim := &models.BarberService{EstimatedDurationMin: 15}
+
+	// One service keeps its own segments
+	assert.Equal(t, colorSegments, models.SelectServices(color).DurationSegments(90))
+
+	// Services are chained; one without segments is active throughout
+	combined := models.SelectServices(color, trim).DurationSegments(105)
+	assert.Equal(t, models.DurationSegments{
+		{Minutes: 30},
+		{Minutes: 45, Passive: true},
+		{Minutes: 15},
+		{Minutes: 15},
+	}, combined)
+
+	// A booking of a different length is treated as fully active
+	assert.Nil(t, models.SelectServices(color, trim).DurationSegments(120))
+	assert.Nil(t, models.SelectServices(trim, trim).DurationSegments(30))
+}
+
+func TestServiceSelection_AddOns(t *testing.T) {
+	color := &models.BarberService{EstimatedDurationMin: 90, Price: 80, DurationSegments: colorSegments, BufferTimeMinutes: 10}
+	hotTowel := &models.ServiceAddOn{DurationMinutes: 10, Price: 5.25}
+	gloss := &models.ServiceAddOn{Price: 12.50}
+
+	selection := models.ServiceSelection{{BarberService: color, AddOns: []*models.ServiceAddOn{hotTowel, gloss}}}
+	assert.True(t, selection.HasAddOns())
+	assert.False(t, models.SelectServices(color).HasAddOns())
+
+	assert.Equal(t, 100, selection.TotalMinutes())
+	assert.Equal(t, 97.75, selection.TotalPrice())
+	assert.Equal(t, 10, selection.BufferMinutes())
+
+	// Add-ons are active time right after their service; one without
+	// extra time adds no segment
+	assert.Equal(t, models.DurationSegments{
+		{Minutes: 30},
+		{Minutes: 45, Passive: true},
+		{Minutes: 15},
+		{Minutes: 10},
+	}, selection.DurationSegments(100))
+}
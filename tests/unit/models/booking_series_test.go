@@ -0,0 +1,63 @@
+// tests/unit/models/booking_series_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecurrenceTimes_WeeklyAndBiweekly(t *testing.T) {
+	start := time.Date(2025, 3, 4, 10, 30, 0, 0, time.UTC)
+
+	weekly, err := models.RecurrenceTimes(start, config.RecurrenceWeekly, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		start,
+		time.Date(2025, 3, 11, 10, 30, 0, 0, time.UTC),
+		time.Date(2025, 3, 18, 10, 30, 0, 0, time.UTC),
+	}, weekly)
+
+	biweekly, err := models.RecurrenceTimes(start, config.RecurrenceBiweekly, 2)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 3, 18, 10, 30, 0, 0, time.UTC), biweekly[1])
+}
+
+func TestRecurrenceTimes_MonthlyClampsToMonthEnd(t *testing.T) {
+	start := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	times, err := models.RecurrenceTimes(start, config.RecurrenceMonthly, 4)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{
+		start,
+		time.Date(2025, 2, 28, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 3, 31, 9, 0, 0, 0, time.UTC),
+		time.Date(2025, 4, 30, 9, 0, 0, 0, time.UTC),
+	}, times)
+}
+
+func TestRecurrenceTimes_KeepsWallClockAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("timezone data not available")
+	}
+	// DST starts on 2025-03-09 in New York
+	start := time.Date(2025, 3, 3, 10, 0, 0, 0, loc)
+
+	times, err := models.RecurrenceTimes(start, config.RecurrenceWeekly, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 10, times[1].Hour())
+}
+
+func TestRecurrenceTimes_InvalidInput(t *testing.T) {
+	_, err := models.RecurrenceTimes(time.Now(), "daily", 3)
+	assert.Error(t, err)
+
+	_, err = models.RecurrenceTimes(time.Now(), config.RecurrenceWeekly, 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,55 @@
+// tests/unit/models/cancellation_reason_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCancellationReasonStats(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 3, 0)
+
+	stats := models.BuildCancellationReasonStats(3, from, to, []models.CancellationReasonCount{
+		{Code: config.CancellationReasonSick, Status: config.BookingStatusCancelledByCustomer, Count: 4},
+		{Code: config.CancellationReasonSick, Status: config.BookingStatusCancelledByBarber, Count: 1},
+		{Code: "", Status: config.BookingStatusCancelledByCustomer, Count: 2},
+		{Code: "retired_code", Status: config.BookingStatusCancelledByBarber, Count: 1},
+	})
+
+	assert.Equal(t, 3, stats.BarberID)
+	assert.Equal(t, 8, stats.Total)
+
+	// Every reason is listed in order, unspecified last
+	require.Len(t, stats.Reasons, len(config.CancellationReasonCodes)+1)
+	for i, code := range config.CancellationReasonCodes {
+		assert.Equal(t, code, stats.Reasons[i].Code)
+	}
+
+	sick := stats.Reasons[0]
+	assert.Equal(t, 5, sick.Count)
+	assert.Equal(t, 4, sick.ByCustomer)
+	assert.Equal(t, 1, sick.ByBarber)
+	assert.Equal(t, 62.5, sick.Percent)
+
+	// Missing and unknown codes count as unspecified
+	unspecified := stats.Reasons[len(stats.Reasons)-1]
+	assert.Equal(t, models.CancellationReasonUnspecified, unspecified.Code)
+	assert.Equal(t, 3, unspecified.Count)
+	assert.Equal(t, 37.5, unspecified.Percent)
+
+	assert.Zero(t, stats.Reasons[1].Count)
+	assert.Zero(t, stats.Reasons[1].Percent)
+}
+
+func TestBuildCancellationReasonStats_Empty(t *testing.T) {
+	stats := models.BuildCancellationReasonStats(3, time.Time{}, time.Time{}, nil)
+	assert.Zero(t, stats.Total)
+	assert.Len(t, stats.Reasons, len(config.CancellationReasonCodes)+1)
+}
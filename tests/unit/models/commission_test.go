@@ -0,0 +1,54 @@
+// tests/unit/models/commission_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLeadSharePercent(t *testing.T) {
+	assert.Equal(t, 100.0, models.LeadSharePercent(nil))
+	assert.Equal(t, 45.5, models.LeadSharePercent([]models.BookingStaff{
+		{SharePercent: 30}, {SharePercent: 24.5},
+	}))
+}
+
+func TestNewEarningsReport(t *testing.T) {
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	lines := []models.EarningsLine{
+		{BookingID: 1, Role: config.StaffRoleLead, SharePercent: 100, BookingAmount: 40, BookingTip: 5, Currency: "EUR"},
+		{BookingID: 2, Role: config.StaffRoleLead, SharePercent: 70, BookingAmount: 100, BookingTip: 10, Currency: "EUR"},
+		{BookingID: 3, Role: config.StaffRoleAssistant, SharePercent: 33.33, BookingAmount: 60, Currency: "EUR"},
+	}
+
+	report := models.NewEarningsReport(7, from, to, 15, lines)
+
+	assert.Equal(t, 3, report.Bookings)
+	assert.Equal(t, "EUR", report.Currency)
+
+	assert.Equal(t, 70.0, report.Lines[1].Amount)
+	assert.Equal(t, 7.0, report.Lines[1].Tip)
+	assert.Equal(t, 10.5, report.Lines[1].Commission)
+	assert.Equal(t, 66.5, report.Lines[1].Payout)
+	assert.Equal(t, 20.0, report.Lines[2].Amount)
+
+	assert.Equal(t, 130.0, report.Earnings)
+	assert.Equal(t, 12.0, report.Tips)
+	assert.Equal(t, 19.5, report.Commission)
+	assert.Equal(t, 122.5, report.Payout)
+}
+
+func TestNewEarningsReport_Empty(t *testing.T) {
+	report := models.NewEarningsReport(7, time.Time{}, time.Time{}, 15, nil)
+
+	assert.Zero(t, report.Bookings)
+	assert.Zero(t, report.Payout)
+	assert.Equal(t, config.DefaultCurrency, report.Currency)
+	assert.NotNil(t, report.Lines)
+}
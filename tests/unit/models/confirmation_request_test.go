@@ -0,0 +1,40 @@
+// tests/unit/models/confirmation_request_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoShowHistory_Risk(t *testing.T) {
+	assert.Equal(t, 0.0, models.NoShowHistory{}.Risk())
+	assert.Equal(t, 0.25, models.NoShowHistory{Finished: 8, NoShows: 2}.Risk())
+}
+
+func TestNoShowHistory_IsHighRisk(t *testing.T) {
+	// At the threshold counts as high risk
+	assert.True(t, models.NoShowHistory{Finished: 5, NoShows: 1}.IsHighRisk(0.2, 2))
+	assert.False(t, models.NoShowHistory{Finished: 6, NoShows: 1}.IsHighRisk(0.2, 2))
+
+	// Too little history to judge
+	assert.False(t, models.NoShowHistory{Finished: 1, NoShows: 1}.IsHighRisk(0.2, 2))
+	assert.False(t, models.NoShowHistory{}.IsHighRisk(0, 0))
+}
+
+func TestConfirmationRequest_Status(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	request := &models.ConfirmationRequest{ExpiresAt: start}
+
+	assert.False(t, request.IsAnswered())
+	assert.False(t, request.IsExpired(start.Add(-time.Minute)))
+	// Closes when the appointment starts
+	assert.True(t, request.IsExpired(start))
+
+	now := start.Add(-time.Hour)
+	request.RespondedAt = &now
+	assert.True(t, request.IsAnswered())
+}
@@ -0,0 +1,86 @@
+// tests/unit/models/consultation_request_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsultationRequest_IsPending(t *testing.T) {
+	assert.True(t, (&models.ConsultationRequest{Status: config.ConsultationRequestOpen}).IsPending())
+	assert.True(t, (&models.ConsultationRequest{Status: config.ConsultationRequestQuoted}).IsPending())
+	assert.False(t, (&models.ConsultationRequest{Status: config.ConsultationRequestBooked}).IsPending())
+	assert.False(t, (&models.ConsultationRequest{Status: config.ConsultationRequestDeclined}).IsPending())
+}
+
+func TestConsultationRequest_CanAccept(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	serviceID := 12
+	price := 120.0
+	proposed := now.Add(48 * time.Hour)
+	quoted := func() *models.ConsultationRequest {
+		return &models.ConsultationRequest{
+			Status:            config.ConsultationRequestQuoted,
+			BarberServiceID:   &serviceID,
+			ServiceName:       "Colour correction",
+			QuotedPrice:       &price,
+			ProposedStartTime: &proposed,
+		}
+	}
+
+	t.Run("a live quote can be accepted", func(t *testing.T) {
+		assert.NoError(t, quoted().CanAccept(now))
+	})
+
+	t.Run("requests without a quote cannot", func(t *testing.T) {
+		request := quoted()
+		request.Status = config.ConsultationRequestOpen
+		assert.ErrorContains(t, request.CanAccept(now), "no quote")
+
+		request = quoted()
+		request.QuotedPrice = nil
+		assert.ErrorContains(t, request.CanAccept(now), "no quote")
+
+		request = quoted()
+		request.Status = config.ConsultationRequestBooked
+		assert.ErrorContains(t, request.CanAccept(now), "no quote")
+	})
+
+	t.Run("a quote whose time has passed cannot", func(t *testing.T) {
+		assert.ErrorContains(t, quoted().CanAccept(proposed), "has passed")
+	})
+
+	t.Run("a quote for a removed service cannot", func(t *testing.T) {
+		request := quoted()
+		request.BarberServiceID = nil
+		assert.ErrorContains(t, request.CanAccept(now), "no longer offers Colour correction")
+	})
+}
+
+func TestPreferredTimes_ValueScan(t *testing.T) {
+	value, err := models.PreferredTimes(nil).Value()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("[]"), value)
+
+	times := models.PreferredTimes{
+		time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 11, 14, 30, 0, 0, time.UTC),
+	}
+	value, err = times.Value()
+	require.NoError(t, err)
+
+	var scanned models.PreferredTimes
+	require.NoError(t, scanned.Scan(value))
+	require.Len(t, scanned, 2)
+	assert.True(t, scanned[0].Equal(times[0]))
+	assert.True(t, scanned[1].Equal(times[1]))
+
+	require.NoError(t, scanned.Scan(nil))
+	assert.Nil(t, scanned)
+}
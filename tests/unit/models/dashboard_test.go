@@ -0,0 +1,133 @@
+// tests/unit/models/dashboard_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func dashboardBooking(id int, status string, start time.Time, minutes int, price float64) models.DashboardBooking {
+	return models.DashboardBooking{
+		ID:                 id,
+		Status:             status,
+		ScheduledStartTime: start,
+		ScheduledEndTime:   start.Add(time.Duration(minutes) * time.Minute),
+		TotalPrice:         price,
+		Currency:           "USD",
+	}
+}
+
+func TestBuildBarberDashboard_QueueAndEarnings(t *testing.T) {
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	now := day.Add(11 * time.Hour)
+
+	completed := dashboardBooking(1, config.BookingStatusCompleted, day.Add(9*time.Hour), 30, 40)
+	completed.TipAmount = 8
+	snapshot := models.DashboardSnapshot{
+		BarberID: 7,
+		Date:     "2025-03-01",
+		Timezone: "UTC",
+		Bookings: []models.DashboardBooking{
+			dashboardBooking(4, config.BookingStatusConfirmed, day.Add(12*time.Hour), 30, 30),
+			completed,
+			dashboardBooking(2, config.BookingStatusCancelledByCustomer, day.Add(10*time.Hour), 30, 25),
+			dashboardBooking(3, config.BookingStatusInProgress, day.Add(10*time.Hour+45*time.Minute), 30, 35),
+			dashboardBooking(5, config.BookingStatusPending, day.Add(15*time.Hour), 60, 50),
+			dashboardBooking(6, config.BookingStatusPending, day.AddDate(0, 0, 2).Add(9*time.Hour), 30, 20),
+		},
+	}
+
+	d := models.BuildBarberDashboard(snapshot, now)
+
+	require.NotNil(t, d.Current)
+	assert.Equal(t, 3, d.Current.ID)
+	require.NotNil(t, d.Next)
+	assert.Equal(t, 4, d.Next.ID)
+	require.NotNil(t, d.NextStartsInSeconds)
+	assert.Equal(t, int64(3600), *d.NextStartsInSeconds)
+
+	var queueIDs []int
+	for _, a := range d.Queue {
+		queueIDs = append(queueIDs, a.ID)
+	}
+	assert.Equal(t, []int{3, 4, 5}, queueIDs)
+
+	var pendingIDs []int
+	for _, a := range d.PendingConfirmations {
+		pendingIDs = append(pendingIDs, a.ID)
+	}
+	assert.Equal(t, []int{5, 6}, pendingIDs, "pending bookings on later days still need confirming")
+
+	assert.Equal(t, models.DashboardCounts{Total: 5, Remaining: 3, Completed: 1, Cancelled: 1}, d.Counts)
+	assert.Equal(t, 1, d.Earnings.CompletedCount)
+	assert.Equal(t, 40.0, d.Earnings.Revenue)
+	assert.Equal(t, 8.0, d.Earnings.Tips)
+	assert.Equal(t, 48.0, d.Earnings.Total)
+	assert.Equal(t, 163.0, d.Earnings.Projected)
+	assert.False(t, d.RunningLate)
+}
+
+func TestBuildBarberDashboard_RunningLate(t *testing.T) {
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("appointment in the chair overruns its slot", func(t *testing.T) {
+		snapshot := models.DashboardSnapshot{Date: "2025-03-01", Bookings: []models.DashboardBooking{
+			dashboardBooking(1, config.BookingStatusInProgress, day.Add(10*time.Hour), 30, 30),
+		}}
+		d := models.BuildBarberDashboard(snapshot, day.Add(10*time.Hour+42*time.Minute))
+		assert.True(t, d.RunningLate)
+		assert.Equal(t, 12, d.LateByMinutes)
+		assert.Equal(t, 12, d.Current.LateByMinutes)
+		assert.Equal(t, 12, d.Queue[0].LateByMinutes)
+	})
+
+	t.Run("next appointment has not started on time", func(t *testing.T) {
+		snapshot := models.DashboardSnapshot{Date: "2025-03-01", Bookings: []models.DashboardBooking{
+			dashboardBooking(1, config.BookingStatusConfirmed, day.Add(10*time.Hour), 30, 30),
+		}}
+		d := models.BuildBarberDashboard(snapshot, day.Add(10*time.Hour+8*time.Minute))
+		assert.True(t, d.RunningLate)
+		assert.Equal(t, 8, d.LateByMinutes)
+		assert.Equal(t, int64(0), *d.NextStartsInSeconds)
+	})
+
+	t.Run("within the grace period", func(t *testing.T) {
+		snapshot := models.DashboardSnapshot{Date: "2025-03-01", Bookings: []models.DashboardBooking{
+			dashboardBooking(1, config.BookingStatusConfirmed, day.Add(10*time.Hour), 30, 30),
+		}}
+		d := models.BuildBarberDashboard(snapshot, day.Add(10*time.Hour+2*time.Minute))
+		assert.False(t, d.RunningLate)
+		assert.Equal(t, 2, d.LateByMinutes)
+	})
+}
+
+func TestBuildBarberDashboard_UsesBarberTimezone(t *testing.T) {
+	// 23:30 on Feb 28 in New York is already March 1 in UTC
+	start := time.Date(2025, 3, 1, 4, 30, 0, 0, time.UTC)
+	snapshot := models.DashboardSnapshot{
+		Date:     "2025-02-28",
+		Timezone: "America/New_York",
+		Bookings: []models.DashboardBooking{dashboardBooking(1, config.BookingStatusConfirmed, start, 30, 30)},
+	}
+
+	d := models.BuildBarberDashboard(snapshot, start.Add(-time.Hour))
+	assert.Equal(t, 1, d.Counts.Total)
+	require.NotNil(t, d.Next)
+	assert.Equal(t, 1, d.Next.ID)
+}
+
+func TestBuildBarberDashboard_EmptyDay(t *testing.T) {
+	d := models.BuildBarberDashboard(models.DashboardSnapshot{Date: "2025-03-01"}, time.Now())
+	assert.Nil(t, d.Current)
+	assert.Nil(t, d.Next)
+	assert.Nil(t, d.NextStartsInSeconds)
+	assert.NotNil(t, d.Queue)
+	assert.NotNil(t, d.PendingConfirmations)
+	assert.Equal(t, config.DefaultCurrency, d.Earnings.Currency)
+}
@@ -0,0 +1,68 @@
+// tests/unit/models/deposit_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func depositService(price float64, depositType string, value float64, noShowFee *float64) *models.BarberService {
+	return &models.BarberService{Price: price, DepositType: &depositType, DepositValue: &value, NoShowFee: noShowFee}
+}
+
+func TestValidateDeposit(t *testing.T) {
+	percentage, fixed, unknown := config.DepositTypePercentage, config.DepositTypeFixed, "monthly"
+	value, tooMuch, negative := 20.0, 120.0, -1.0
+
+	assert.NoError(t, models.ValidateDeposit(nil, nil, nil))
+	assert.NoError(t, models.ValidateDeposit(&percentage, &value, nil))
+	assert.NoError(t, models.ValidateDeposit(&fixed, &tooMuch, &value))
+
+	assert.Error(t, models.ValidateDeposit(nil, &value, nil))
+	assert.Error(t, models.ValidateDeposit(&unknown, &value, nil))
+	assert.Error(t, models.ValidateDeposit(&percentage, nil, nil))
+	assert.Error(t, models.ValidateDeposit(&percentage, &tooMuch, nil))
+	assert.Error(t, models.ValidateDeposit(&fixed, &value, &negative))
+}
+
+func TestBarberService_DepositFor(t *testing.T) {
+	assert.Equal(t, 0.0, (&models.BarberService{Price: 40}).DepositFor(40))
+	assert.Equal(t, 8.5, depositService(42.5, config.DepositTypePercentage, 20, nil).DepositFor(42.5))
+
+	// A fixed deposit never exceeds the price
+	fixed := depositService(15, config.DepositTypeFixed, 20, nil)
+	assert.Equal(t, 15.0, fixed.DepositFor(15))
+}
+
+func TestServiceSelection_DepositAndNoShowFee(t *testing.T) {
+	fee := 5.0
+	color := depositService(80, config.DepositTypePercentage, 25, &fee)
+	beard := depositService(20, config.DepositTypeFixed, 10, nil)
+	trim := &models.BarberService{Price: 15}
+
+	// Each service's deposit is on its own price, including add-ons
+	selection := models.ServiceSelection{
+		{BarberService: color, AddOns: []*models.ServiceAddOn{{Price: 20}}},
+		{BarberService: beard},
+		{BarberService: trim},
+	}
+	assert.Equal(t, 35.0, selection.Deposit())
+	// The color fee is capped by the service; the beard keeps its whole deposit
+	assert.Equal(t, 15.0, selection.NoShowFee())
+
+	assert.Equal(t, 0.0, models.SelectServices(trim).Deposit())
+}
+
+func TestQuoteRefund_AppliesToDeposit(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	booking := &models.Booking{TotalPrice: 100, DepositAmount: 30, ScheduledStartTime: now.Add(5 * time.Hour)}
+
+	quote := models.QuoteRefund(refundPolicy, booking, now, true)
+	assert.Equal(t, 15.0, quote.Amount)
+	assert.Equal(t, 15.0, quote.CancellationFee)
+}
@@ -0,0 +1,98 @@
+// tests/unit/models/duration_segments_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// colorSegments is a 90-minute color service: apply, process, rinse
+var colorSegments = models.DurationSegments{
+	{Minutes: 30},
+	{Minutes: 45, Passive: true},
+	{Minutes: 15},
+}
+
+func TestDurationSegments_Validate(t *testing.T) {
+	assert.NoError(t, models.DurationSegments(nil).Validate(30))
+	assert.NoError(t, colorSegments.Validate(90))
+
+	err := colorSegments.Validate(60)
+	assert.ErrorContains(t, err, "must add up to the duration")
+
+	err = models.DurationSegments{{Minutes: 30}, {Minutes: 0, Passive: true}}.Validate(30)
+	assert.ErrorContains(t, err, "must be positive")
+
+	err = models.DurationSegments{{Minutes: 30, Passive: true}}.Validate(30)
+	assert.ErrorContains(t, err, "at least one active segment")
+
+	tooMany := make(models.DurationSegments, 11)
+	for i := range tooMany {
+		tooMany[i] = models.DurationSegment{Minutes: 5}
+	}
+	assert.ErrorContains(t, tooMany.Validate(55), "cannot have more than")
+}
+
+func TestDurationSegments_ForDuration(t *testing.T) {
+	assert.Equal(t, colorSegments, colorSegments.ForDuration(90))
+
+	// A booking of a different length is treated as fully active
+	assert.Nil(t, colorSegments.ForDuration(60))
+	// Segments without passive time add nothing
+	assert.Nil(t, models.DurationSegments{{Minutes: 30}, {Minutes: 30}}.ForDuration(60))
+}
+
+func TestDurationSegments_ActiveRanges(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+
+	ranges := colorSegments.ActiveRanges(start, start.Add(90*time.Minute))
+	assert.Equal(t, []models.TimeRange{
+		{Start: start, End: start.Add(30 * time.Minute)},
+		{Start: start.Add(75 * time.Minute), End: start.Add(90 * time.Minute)},
+	}, ranges)
+
+	// Consecutive active segments are merged
+	segments := models.DurationSegments{{Minutes: 10}, {Minutes: 20}, {Minutes: 30, Passive: true}}
+	ranges = segments.ActiveRanges(start, start.Add(time.Hour))
+	assert.Equal(t, []models.TimeRange{{Start: start, End: start.Add(30 * time.Minute)}}, ranges)
+
+	// Segments that don't cover the range leave all of it active
+	ranges = colorSegments.ActiveRanges(start, start.Add(time.Hour))
+	assert.Equal(t, []models.TimeRange{{Start: start, End: start.Add(time.Hour)}}, ranges)
+}
+
+func TestRangesOverlap(t *testing.T) {
+	start := time.Date(2025, 3, 10, 9, 0, 0, 0, time.UTC)
+	active := colorSegments.ActiveRanges(start, start.Add(90*time.Minute))
+
+	// A haircut during the processing time fits
+	haircut := []models.TimeRange{{Start: start.Add(30 * time.Minute), End: start.Add(75 * time.Minute)}}
+	assert.False(t, models.RangesOverlap(active, haircut))
+
+	haircut[0].End = start.Add(80 * time.Minute)
+	assert.True(t, models.RangesOverlap(active, haircut))
+}
+
+func TestAvailableSlots_PassiveSegments(t *testing.T) {
+	day := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	windows := []models.TimeRange{{Start: day.Add(9 * time.Hour), End: day.Add(12 * time.Hour)}}
+	busy := []models.TimeRange{{Start: day.Add(9*time.Hour + 30*time.Minute), End: day.Add(10 * time.Hour)}}
+
+	slots := models.AvailableSlots(windows, busy, models.SlotOptions{
+		Duration: 90 * time.Minute,
+		Interval: 30 * time.Minute,
+	})
+	assert.Equal(t, []string{"10:00", "10:30"}, slotStarts(slots))
+
+	// The existing booking fits in the color's processing time
+	slots = models.AvailableSlots(windows, busy, models.SlotOptions{
+		Duration: 90 * time.Minute,
+		Interval: 30 * time.Minute,
+		Segments: colorSegments,
+	})
+	assert.Equal(t, []string{"09:00", "10:00", "10:30"}, slotStarts(slots))
+}
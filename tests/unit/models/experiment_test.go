@@ -0,0 +1,66 @@
+// tests/unit/models/experiment_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/experiments"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExperimentReport_Finalize(t *testing.T) {
+	report := models.ExperimentReport{
+		Experiment: &models.Experiment{
+			Variants: experiments.Variants{
+				{Name: "control", Weight: 1},
+				{Name: "treatment", Weight: 1},
+				{Name: "unseen", Weight: 1},
+			},
+		},
+		Variants: []models.ExperimentVariantResult{
+			{Variant: "treatment", Exposures: 200, Conversions: 30, Value: 900.004},
+			{Variant: "control", Exposures: 200, Conversions: 20, Value: 600},
+		},
+	}
+
+	report.Finalize()
+
+	require.Len(t, report.Variants, 3)
+	control, treatment, unseen := report.Variants[0], report.Variants[1], report.Variants[2]
+
+	assert.Equal(t, "control", control.Variant)
+	require.NotNil(t, control.ConversionRate)
+	assert.Equal(t, 10.0, *control.ConversionRate)
+	assert.Nil(t, control.Lift)
+
+	assert.Equal(t, "treatment", treatment.Variant)
+	assert.Equal(t, 15.0, *treatment.ConversionRate)
+	require.NotNil(t, treatment.Lift)
+	assert.Equal(t, 50.0, *treatment.Lift)
+	assert.Equal(t, 900.0, treatment.Value)
+
+	assert.Equal(t, "unseen", unseen.Variant)
+	assert.Zero(t, unseen.Exposures)
+	assert.Nil(t, unseen.ConversionRate)
+	assert.Nil(t, unseen.Lift)
+}
+
+func TestExperiment_DefaultVariant(t *testing.T) {
+	winner := "treatment"
+	experiment := models.Experiment{
+		Status:   config.ExperimentStatusDraft,
+		Variants: experiments.Variants{{Name: "control", Weight: 1}, {Name: "treatment", Weight: 1}},
+		Winner:   &winner,
+	}
+	assert.Equal(t, "control", experiment.DefaultVariant())
+
+	experiment.Status = config.ExperimentStatusStopped
+	assert.Equal(t, "treatment", experiment.DefaultVariant())
+
+	experiment.Winner = nil
+	assert.Equal(t, "control", experiment.DefaultVariant())
+}
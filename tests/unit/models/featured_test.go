@@ -0,0 +1,47 @@
+// tests/unit/models/featured_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeaturedDays(t *testing.T) {
+	start := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 1, models.FeaturedDays(start, start))
+	assert.Equal(t, 14, models.FeaturedDays(start, start.AddDate(0, 0, 13)))
+	assert.Equal(t, 0, models.FeaturedDays(start, start.AddDate(0, 0, -2)))
+
+	// Times of day are ignored
+	assert.Equal(t, 2, models.FeaturedDays(start.Add(23*time.Hour), start.AddDate(0, 0, 1).Add(time.Hour)))
+}
+
+func TestFeaturedPlacement_HasStarted(t *testing.T) {
+	p := models.FeaturedPlacement{
+		StartsOn: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC),
+		EndsOn:   time.Date(2026, 11, 7, 0, 0, 0, 0, time.UTC),
+	}
+	local := time.FixedZone("UTC-5", -5*3600)
+
+	assert.False(t, p.HasStarted(time.Date(2026, 10, 31, 23, 0, 0, 0, local)))
+	assert.True(t, p.HasStarted(time.Date(2026, 11, 1, 0, 30, 0, 0, local)))
+	assert.True(t, p.HasStarted(time.Date(2026, 11, 9, 12, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 7, p.Days())
+}
+
+func TestFeaturedPlacement_Finalize(t *testing.T) {
+	p := models.FeaturedPlacement{Impressions: 800, Clicks: 26}
+	p.Finalize()
+	require.NotNil(t, p.ClickRate)
+	assert.Equal(t, 3.3, *p.ClickRate)
+
+	empty := models.FeaturedPlacement{}
+	empty.Finalize()
+	assert.Nil(t, empty.ClickRate)
+}
@@ -0,0 +1,111 @@
+// tests/unit/models/financial_event_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFinancialChain links events the way the ledger appends them
+func newFinancialChain(amounts ...int64) []models.FinancialEvent {
+	entityType, reference := config.EntityTypeBooking, "pi_123"
+	occurred := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+	prevHash := config.FinancialChainGenesis
+
+	events := make([]models.FinancialEvent, len(amounts))
+	for i, amount := range amounts {
+		bookingID := 40 + i
+		events[i] = models.FinancialEvent{
+			ID:         int64(i + 1),
+			EventType:  config.FinancialEventPaymentCaptured,
+			EntityType: &entityType,
+			EntityID:   &bookingID,
+			Amount:     amount,
+			Currency:   "USD",
+			Reference:  &reference,
+			Details:    "{}",
+			OccurredAt: occurred.Add(time.Duration(i) * time.Minute),
+			PrevHash:   prevHash,
+		}
+		events[i].Hash = events[i].ComputeHash()
+		prevHash = events[i].Hash
+	}
+	return events
+}
+
+func TestFinancialEvent_ComputeHash(t *testing.T) {
+	event := newFinancialChain(2500)[0]
+	assert.Len(t, event.Hash, 64)
+
+	// Stable across time zones and sub-microsecond precision
+	event.OccurredAt = event.OccurredAt.In(time.FixedZone("EST", -5*60*60)).Add(300 * time.Nanosecond)
+	assert.Equal(t, event.Hash, event.ComputeHash())
+
+	// NULL and empty fields hash differently
+	empty := ""
+	event.Provider = &empty
+	assert.NotEqual(t, event.Hash, event.ComputeHash())
+}
+
+func TestVerifyFinancialChain_Valid(t *testing.T) {
+	events := newFinancialChain(2500, 1000, 300)
+
+	head, err := models.VerifyFinancialChain(config.FinancialChainGenesis, events)
+	require.NoError(t, err)
+	assert.Equal(t, events[2].Hash, head)
+
+	// Verifies in batches
+	head, err = models.VerifyFinancialChain(config.FinancialChainGenesis, events[:1])
+	require.NoError(t, err)
+	head, err = models.VerifyFinancialChain(head, events[1:])
+	require.NoError(t, err)
+	assert.Equal(t, events[2].Hash, head)
+}
+
+func TestVerifyFinancialChain_DetectsTampering(t *testing.T) {
+	tests := []struct {
+		name    string
+		tamper  func([]models.FinancialEvent) []models.FinancialEvent
+		eventID int64
+	}{
+		{"changed amount", func(e []models.FinancialEvent) []models.FinancialEvent {
+			e[1].Amount = 100
+			return e
+		}, 2},
+		{"changed amount with rehash", func(e []models.FinancialEvent) []models.FinancialEvent {
+			e[1].Amount = 100
+			e[1].Hash = e[1].ComputeHash()
+			return e
+		}, 3},
+		{"removed event", func(e []models.FinancialEvent) []models.FinancialEvent {
+			return append(e[:1], e[2:]...)
+		}, 3},
+		{"reordered events", func(e []models.FinancialEvent) []models.FinancialEvent {
+			e[1], e[2] = e[2], e[1]
+			return e
+		}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := tt.tamper(newFinancialChain(2500, 1000, 300))
+
+			_, err := models.VerifyFinancialChain(config.FinancialChainGenesis, events)
+			var chainErr *models.ChainError
+			require.ErrorAs(t, err, &chainErr)
+			assert.Equal(t, tt.eventID, chainErr.EventID)
+		})
+	}
+}
+
+func TestFinancialEvent_FormattedAmount(t *testing.T) {
+	assert.Equal(t, "12.50", (&models.FinancialEvent{Amount: 1250}).FormattedAmount())
+	assert.Equal(t, "0.05", (&models.FinancialEvent{Amount: 5}).FormattedAmount())
+	assert.Equal(t, "-3.00", (&models.FinancialEvent{Amount: -300}).FormattedAmount())
+}
@@ -0,0 +1,32 @@
+// tests/unit/models/geo_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoundingBox(t *testing.T) {
+	// 10 km is about 0.09 degrees of latitude, and more degrees of
+	// longitude away from the equator
+	box := models.BoundingBox(40.7, -74.0, 10)
+	assert.InDelta(t, 40.61, box.MinLatitude, 0.01)
+	assert.InDelta(t, 40.79, box.MaxLatitude, 0.01)
+	assert.InDelta(t, -74.12, box.MinLongitude, 0.01)
+	assert.InDelta(t, -73.88, box.MaxLongitude, 0.01)
+}
+
+func TestBoundingBoxSpansAllLongitudes(t *testing.T) {
+	nearPole := models.BoundingBox(89.95, 10, 20)
+	assert.Equal(t, 90.0, nearPole.MaxLatitude)
+	assert.Equal(t, -180.0, nearPole.MinLongitude)
+	assert.Equal(t, 180.0, nearPole.MaxLongitude)
+
+	antimeridian := models.BoundingBox(-17.7, 179.95, 50)
+	assert.Equal(t, -180.0, antimeridian.MinLongitude)
+	assert.Equal(t, 180.0, antimeridian.MaxLongitude)
+	assert.Less(t, antimeridian.MaxLatitude, -17.0, "latitudes are still bounded")
+}
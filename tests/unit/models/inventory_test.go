@@ -0,0 +1,47 @@
+// tests/unit/models/inventory_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInventoryItem_IsLowStock(t *testing.T) {
+	item := models.InventoryItem{Quantity: 6, LowStockThreshold: 5}
+	assert.False(t, item.IsLowStock())
+
+	item.Quantity = 5
+	assert.True(t, item.IsLowStock())
+
+	item = models.InventoryItem{Quantity: 0}
+	assert.True(t, item.IsLowStock(), "out of stock is low with no threshold")
+}
+
+func TestInventoryStockChange_BecameLowStock(t *testing.T) {
+	tests := []struct {
+		name      string
+		previous  int
+		quantity  int
+		threshold int
+		want      bool
+	}{
+		{"stays above threshold", 10, 8, 5, false},
+		{"drops to threshold", 6, 5, 5, true},
+		{"drops below threshold", 7, 3, 5, true},
+		{"already low", 5, 4, 5, false},
+		{"runs out without threshold", 1, 0, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change := models.InventoryStockChange{
+				InventoryItem:    models.InventoryItem{Quantity: tt.quantity, LowStockThreshold: tt.threshold},
+				PreviousQuantity: tt.previous,
+			}
+			assert.Equal(t, tt.want, change.BecameLowStock())
+		})
+	}
+}
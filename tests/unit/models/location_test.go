@@ -0,0 +1,62 @@
+// tests/unit/models/location_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestTravelTimes_Between(t *testing.T) {
+	times := models.NewTravelTimes([]models.TravelTime{
+		{FromLocationID: 1, ToLocationID: 2, Minutes: 20},
+		{FromLocationID: 2, ToLocationID: 3, Minutes: 30},
+		{FromLocationID: 3, ToLocationID: 2, Minutes: 45},
+	})
+
+	assert.Equal(t, 20*time.Minute, times.Between(intPtr(1), intPtr(2)))
+	assert.Equal(t, 20*time.Minute, times.Between(intPtr(2), intPtr(1)), "reverse direction falls back")
+	assert.Equal(t, 45*time.Minute, times.Between(intPtr(3), intPtr(2)), "both directions given")
+	assert.Zero(t, times.Between(intPtr(1), intPtr(1)))
+	assert.Zero(t, times.Between(nil, intPtr(2)))
+	assert.Zero(t, times.Between(intPtr(1), intPtr(3)), "no configured time")
+	assert.Equal(t, 45*time.Minute, times.Longest())
+	assert.Zero(t, models.TravelTimes(nil).Longest())
+}
+
+func TestTravel_Busy(t *testing.T) {
+	start := time.Date(2026, 3, 2, 10, 0, 0, 0, time.UTC)
+	other := &models.Booking{
+		LocationID:         intPtr(2),
+		ScheduledStartTime: start,
+		ScheduledEndTime:   start.Add(time.Hour),
+		DurationSegments:   models.DurationSegments{{Minutes: 20}, {Minutes: 30, Passive: true}, {Minutes: 10}},
+	}
+	times := models.NewTravelTimes([]models.TravelTime{
+		{FromLocationID: 1, ToLocationID: 2, Minutes: 15},
+		{FromLocationID: 2, ToLocationID: 1, Minutes: 25},
+	})
+
+	t.Run("same location keeps passive gaps", func(t *testing.T) {
+		busy := models.Travel{LocationID: intPtr(2), Times: times}.Busy(other)
+		assert.Len(t, busy, 2)
+	})
+
+	t.Run("other location is padded by travel each way", func(t *testing.T) {
+		busy := models.Travel{LocationID: intPtr(1), Times: times}.Busy(other)
+		assert.Equal(t, []models.TimeRange{{
+			Start: start.Add(-15 * time.Minute),
+			End:   start.Add(time.Hour + 25*time.Minute),
+		}}, busy)
+	})
+
+	t.Run("no location needs no travel", func(t *testing.T) {
+		busy := models.Travel{Times: times}.Busy(other)
+		assert.Len(t, busy, 2)
+	})
+}
@@ -0,0 +1,18 @@
+// tests/unit/models/no_show_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNoShowCandidate_DueAt(t *testing.T) {
+	start := time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC)
+	candidate := models.NoShowCandidate{ScheduledStartTime: start, GraceMinutes: 20}
+
+	assert.Equal(t, start.Add(20*time.Minute), candidate.DueAt())
+}
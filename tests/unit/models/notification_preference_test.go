@@ -0,0 +1,34 @@
+// tests/unit/models/notification_preference_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationPreference_ChannelsFor(t *testing.T) {
+	pref := models.NotificationPreference{
+		Channels: models.StringArray{config.NotificationChannelPush, config.NotificationChannelSMS},
+	}
+
+	// The preference replaces the picked channels
+	assert.Equal(t, []string{config.NotificationChannelPush},
+		pref.ChannelsFor([]string{config.NotificationChannelApp, config.NotificationChannelEmail}))
+
+	// SMS only when the sender picked it (the recipient is opted in)
+	assert.Equal(t, []string{config.NotificationChannelPush, config.NotificationChannelSMS},
+		pref.ChannelsFor([]string{config.NotificationChannelApp, config.NotificationChannelSMS}))
+}
+
+func TestNotificationPreference_IsOff(t *testing.T) {
+	pref := models.NotificationPreference{Channels: models.StringArray{}}
+	assert.True(t, pref.IsOff())
+	assert.Empty(t, pref.ChannelsFor([]string{config.NotificationChannelApp}))
+
+	pref.Channels = models.StringArray{config.NotificationChannelApp}
+	assert.False(t, pref.IsOff())
+}
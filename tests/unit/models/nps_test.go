@@ -0,0 +1,77 @@
+// tests/unit/models/nps_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNPSCategory(t *testing.T) {
+	cases := map[int]string{
+		0:  config.NPSCategoryDetractor,
+		6:  config.NPSCategoryDetractor,
+		7:  config.NPSCategoryPassive,
+		8:  config.NPSCategoryPassive,
+		9:  config.NPSCategoryPromoter,
+		10: config.NPSCategoryPromoter,
+	}
+	for score, want := range cases {
+		assert.Equal(t, want, models.NPSCategory(score), "score %d", score)
+	}
+}
+
+func TestNPSSummary_Finalize(t *testing.T) {
+	s := models.NPSSummary{Promoters: 5, Passives: 3, Detractors: 2}
+	s.Finalize()
+	assert.Equal(t, 10, s.Responses)
+	require.NotNil(t, s.Score)
+	assert.Equal(t, 30.0, *s.Score)
+
+	s = models.NPSSummary{Promoters: 1, Detractors: 2}
+	s.Finalize()
+	assert.Equal(t, -33.3, *s.Score)
+
+	empty := models.NPSSummary{}
+	empty.Finalize()
+	assert.Nil(t, empty.Score, "no responses means no score, not zero")
+}
+
+func TestBuildNPSTrend(t *testing.T) {
+	week1 := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+	points := []models.NPSTrendPoint{
+		{PeriodStart: week1, NPSSummary: models.NPSSummary{Promoters: 3, Passives: 1}},
+		{PeriodStart: week1.AddDate(0, 0, 7), NPSSummary: models.NPSSummary{Promoters: 1, Detractors: 3}},
+	}
+
+	overall, points := models.BuildNPSTrend(points)
+
+	assert.Equal(t, 75.0, *points[0].Score)
+	assert.Equal(t, 4, points[0].Responses)
+	assert.Equal(t, -50.0, *points[1].Score)
+
+	assert.Equal(t, 8, overall.Responses)
+	assert.Equal(t, 4, overall.Promoters)
+	assert.Equal(t, 12.5, *overall.Score)
+}
+
+func TestBuildNPSTrend_Empty(t *testing.T) {
+	overall, points := models.BuildNPSTrend(nil)
+	assert.NotNil(t, points)
+	assert.Empty(t, points)
+	assert.Nil(t, overall.Score)
+}
+
+func TestNPSSurvey_IsExpired(t *testing.T) {
+	expires := time.Date(2025, 3, 15, 12, 0, 0, 0, time.UTC)
+	survey := models.NPSSurvey{ExpiresAt: expires}
+
+	assert.False(t, survey.IsExpired(expires.Add(-time.Second)))
+	assert.True(t, survey.IsExpired(expires))
+	assert.False(t, survey.IsAnswered())
+}
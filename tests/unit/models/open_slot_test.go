@@ -0,0 +1,58 @@
+// tests/unit/models/open_slot_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOpenSlot_Pricing(t *testing.T) {
+	slot := &models.OpenSlot{ServicePrice: 45, DiscountPercent: 15}
+
+	assert.Equal(t, 6.75, slot.DiscountAmount())
+	assert.Equal(t, 38.25, slot.Price())
+}
+
+func TestOpenSlot_Pricing_NoDiscount(t *testing.T) {
+	slot := &models.OpenSlot{ServicePrice: 30}
+
+	assert.Zero(t, slot.DiscountAmount())
+	assert.Equal(t, 30.0, slot.Price())
+}
+
+func TestOpenSlot_EndTime(t *testing.T) {
+	start := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+	slot := &models.OpenSlot{StartTime: start, DurationMinutes: 45}
+
+	assert.Equal(t, start.Add(45*time.Minute), slot.EndTime())
+}
+
+func TestOpenSlot_IsClaimable(t *testing.T) {
+	start := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+	closesAt := models.ClaimsCloseFor(start)
+	assert.Equal(t, start.Add(-config.OpenSlotClaimNotice), closesAt)
+
+	tests := []struct {
+		name   string
+		status string
+		now    time.Time
+		want   bool
+	}{
+		{name: "open before claims close", status: config.OpenSlotStatusOpen, now: closesAt.Add(-time.Minute), want: true},
+		{name: "open when claims close", status: config.OpenSlotStatusOpen, now: closesAt},
+		{name: "claimed", status: config.OpenSlotStatusClaimed, now: closesAt.Add(-time.Hour)},
+		{name: "cancelled", status: config.OpenSlotStatusCancelled, now: closesAt.Add(-time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slot := &models.OpenSlot{Status: tt.status, StartTime: start, ClaimsCloseAt: closesAt}
+			assert.Equal(t, tt.want, slot.IsClaimable(tt.now))
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// tests/unit/models/outbox_test.go
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutboxEvent_HandledBy(t *testing.T) {
+	event := models.OutboxEvent{CompletedHandlers: models.StringArray{"webhooks"}}
+
+	assert.True(t, event.HandledBy("webhooks"))
+	assert.False(t, event.HandledBy("notifications"))
+	assert.False(t, (&models.OutboxEvent{}).HandledBy("webhooks"))
+}
+
+func TestBookingEvent_RoundTrip(t *testing.T) {
+	customerID, changedBy := 7, 3
+	start := time.Date(2024, 5, 3, 10, 0, 0, 0, time.UTC)
+	in := models.BookingEvent{
+		Booking: &models.Booking{
+			ID:                 42,
+			BookingNumber:      "BK-42",
+			BarberID:           5,
+			CustomerID:         &customerID,
+			Status:             "cancelled_by_barber",
+			ScheduledStartTime: start,
+			TotalPrice:         35,
+		},
+		PreviousStatus: "confirmed",
+		ChangedBy:      &changedBy,
+		Reason:         "Barber is ill",
+	}
+
+	payload, err := json.Marshal(in)
+	require.NoError(t, err)
+
+	var out models.BookingEvent
+	require.NoError(t, json.Unmarshal(payload, &out))
+	require.NotNil(t, out.Booking)
+	assert.Equal(t, 42, out.Booking.ID)
+	assert.Equal(t, 5, out.Booking.BarberID)
+	assert.Equal(t, customerID, *out.Booking.CustomerID)
+	assert.Equal(t, "cancelled_by_barber", out.Booking.Status)
+	assert.True(t, start.Equal(out.Booking.ScheduledStartTime))
+	assert.Equal(t, "confirmed", out.PreviousStatus)
+	assert.Equal(t, changedBy, *out.ChangedBy)
+	assert.Equal(t, "Barber is ill", out.Reason)
+}
@@ -0,0 +1,44 @@
+// tests/unit/models/partition_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMonthlyPartition(t *testing.T) {
+	// Late on Jan 31 in New York is already February in UTC
+	ny, _ := time.LoadLocation("America/New_York")
+	p := models.NewMonthlyPartition("notifications", time.Date(2026, 1, 31, 22, 0, 0, 0, ny))
+
+	assert.Equal(t, "notifications_y2026m02", p.Name)
+	assert.Equal(t, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), p.Month)
+	assert.Equal(t, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), p.End())
+}
+
+func TestMonthlyPartition_EndOfYear(t *testing.T) {
+	p := models.NewMonthlyPartition("notifications", time.Date(2026, 12, 15, 0, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, "notifications_y2026m12", p.Name)
+	assert.Equal(t, time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC), p.End())
+}
+
+func TestParseMonthlyPartition(t *testing.T) {
+	p, ok := models.ParseMonthlyPartition("notifications", "notifications_y2025m07")
+	assert.True(t, ok)
+	assert.Equal(t, models.NewMonthlyPartition("notifications", time.Date(2025, 7, 4, 0, 0, 0, 0, time.UTC)), p)
+
+	for _, name := range []string{
+		"notifications_default",
+		"notifications_y2025m13",
+		"bookings_y2025m07",
+		"notifications_y2025m07_old",
+	} {
+		_, ok := models.ParseMonthlyPartition("notifications", name)
+		assert.False(t, ok, name)
+	}
+}
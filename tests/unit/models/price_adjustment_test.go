@@ -0,0 +1,111 @@
+// tests/unit/models/price_adjustment_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckPriceAdjustment(t *testing.T) {
+	tests := []struct {
+		name     string
+		adjusted float64
+		wantErr  string
+	}{
+		{name: "raised within bounds", adjusted: 60},
+		{name: "raised to the limit", adjusted: 75},
+		{name: "raised too far", adjusted: 75.01, wantErr: "raised more than 50% (to at most 75.00)"},
+		{name: "lowered within bounds", adjusted: 40},
+		{name: "lowered to the limit", adjusted: 37.5},
+		{name: "lowered too far", adjusted: 37, wantErr: "lowered more than 25% (to at least 37.50)"},
+		{name: "negative", adjusted: -1, wantErr: "cannot be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := models.CheckPriceAdjustment(50, tt.adjusted, 50, 25)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestCheckPriceAdjustment_FreeAllowedWithFullDecrease(t *testing.T) {
+	assert.NoError(t, models.CheckPriceAdjustment(50, 0, 0, 100))
+	assert.Error(t, models.CheckPriceAdjustment(50, 50.01, 0, 100))
+}
+
+func TestBooking_Reprice(t *testing.T) {
+	ruleID := 3
+	booking := &models.Booking{
+		ID:             9,
+		ServicePrice:   50,
+		DiscountAmount: 10,
+		TaxAmount:      8,
+		TotalPrice:     48,
+		Currency:       "USD",
+		TaxLines: []models.BookingTaxLine{
+			{ID: 1, BookingID: 9, TaxLine: models.TaxLine{TaxRuleID: &ruleID, Name: "Sales tax", RatePercent: 20, TaxableAmount: 40, Amount: 8}},
+		},
+	}
+
+	require.NoError(t, booking.Reprice(70))
+
+	assert.Equal(t, 70.0, booking.ServicePrice)
+	assert.Equal(t, 10.0, booking.DiscountAmount)
+	assert.Equal(t, 12.0, booking.TaxAmount)
+	assert.Equal(t, 72.0, booking.TotalPrice)
+	require.Len(t, booking.TaxLines, 1)
+	line := booking.TaxLines[0]
+	assert.Equal(t, 9, line.BookingID)
+	assert.Equal(t, "Sales tax", line.Name)
+	assert.Equal(t, 60.0, line.TaxableAmount)
+	assert.Equal(t, 12.0, line.Amount)
+	require.NotNil(t, line.TaxRuleID)
+	assert.Equal(t, ruleID, *line.TaxRuleID)
+}
+
+func TestBooking_Reprice_InclusiveAndDeletedRule(t *testing.T) {
+	booking := &models.Booking{
+		ServicePrice: 60,
+		TaxAmount:    10,
+		TotalPrice:   60,
+		TaxLines: []models.BookingTaxLine{
+			{TaxLine: models.TaxLine{Name: "VAT", RatePercent: 20, Inclusive: true, TaxableAmount: 50, Amount: 10}},
+		},
+	}
+
+	require.NoError(t, booking.Reprice(72))
+
+	assert.Equal(t, 72.0, booking.TotalPrice) // Inclusive tax is already in the price
+	assert.Equal(t, 12.0, booking.TaxAmount)
+	require.Len(t, booking.TaxLines, 1)
+	assert.Nil(t, booking.TaxLines[0].TaxRuleID)
+}
+
+func TestBooking_Reprice_Untaxed(t *testing.T) {
+	booking := &models.Booking{ServicePrice: 30, TotalPrice: 30}
+
+	require.NoError(t, booking.Reprice(35))
+
+	assert.Equal(t, 35.0, booking.TotalPrice)
+	assert.Zero(t, booking.TaxAmount)
+	assert.Empty(t, booking.TaxLines)
+}
+
+func TestBooking_Reprice_BelowDiscount(t *testing.T) {
+	booking := &models.Booking{ServicePrice: 30, DiscountAmount: 20, TotalPrice: 10}
+
+	err := booking.Reprice(15)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot be less than the discount")
+	assert.Equal(t, 30.0, booking.ServicePrice)
+}
@@ -0,0 +1,71 @@
+// tests/unit/models/quiet_hours_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuietHours_DeferAcrossMidnight(t *testing.T) {
+	quiet, err := models.ParseQuietHours("22:00", "08:00")
+	require.NoError(t, err)
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+
+	// 20:30 UTC is 21:30 in Madrid: before the quiet hours
+	evening := time.Date(2025, 3, 10, 20, 30, 0, 0, time.UTC)
+	assert.Equal(t, evening, quiet.Defer(evening, madrid))
+
+	// 22:30 in Madrid waits for 08:00 the next morning there
+	late := time.Date(2025, 3, 10, 21, 30, 0, 0, time.UTC)
+	assert.True(t, time.Date(2025, 3, 11, 8, 0, 0, 0, madrid).Equal(quiet.Defer(late, madrid)))
+
+	// 06:00 in Madrid waits for 08:00 the same morning
+	early := time.Date(2025, 3, 11, 5, 0, 0, 0, time.UTC)
+	assert.True(t, time.Date(2025, 3, 11, 8, 0, 0, 0, madrid).Equal(quiet.Defer(early, madrid)))
+
+	// The same instant is daytime in Tokyo
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	assert.Equal(t, early, quiet.Defer(early, tokyo))
+}
+
+func TestQuietHours_DeferWithinDay(t *testing.T) {
+	quiet, err := models.ParseQuietHours("13:00", "15:30")
+	require.NoError(t, err)
+
+	lunch := time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 3, 10, 15, 30, 0, 0, time.UTC), quiet.Defer(lunch, time.UTC))
+
+	// The end itself is outside
+	end := time.Date(2025, 3, 10, 15, 30, 0, 0, time.UTC)
+	assert.Equal(t, end, quiet.Defer(end, time.UTC))
+}
+
+func TestQuietHours_DeferKeepsWallClockAcrossDST(t *testing.T) {
+	quiet, err := models.ParseQuietHours("22:00", "08:00")
+	require.NoError(t, err)
+	madrid, err := time.LoadLocation("Europe/Madrid")
+	require.NoError(t, err)
+
+	// Clocks go forward overnight on March 30, 2025
+	late := time.Date(2025, 3, 29, 23, 0, 0, 0, madrid)
+	deferred := quiet.Defer(late, madrid).In(madrid)
+	assert.Equal(t, 8, deferred.Hour())
+	assert.Equal(t, 30, deferred.Day())
+}
+
+func TestQuietHours_ZeroAndInvalid(t *testing.T) {
+	now := time.Date(2025, 3, 10, 23, 0, 0, 0, time.UTC)
+	assert.Equal(t, now, models.QuietHours{}.Defer(now, time.UTC))
+
+	_, err := models.ParseQuietHours("10pm", "08:00")
+	assert.Error(t, err)
+	_, err = models.ParseQuietHours("22:00", "8")
+	assert.Error(t, err)
+}
@@ -0,0 +1,20 @@
+// tests/unit/models/refresh_token_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRefreshToken_IsExpired(t *testing.T) {
+	expiresAt := time.Date(2026, 11, 1, 12, 0, 0, 0, time.UTC)
+	token := models.RefreshToken{ExpiresAt: expiresAt}
+
+	assert.False(t, token.IsExpired(expiresAt.Add(-time.Second)))
+	assert.True(t, token.IsExpired(expiresAt))
+	assert.True(t, token.IsExpired(expiresAt.Add(time.Hour)))
+}
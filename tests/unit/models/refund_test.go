@@ -0,0 +1,80 @@
+// tests/unit/models/refund_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var refundPolicy = config.CancellationPolicyConfig{
+	FullRefundHours:      24,
+	PartialRefundHours:   2,
+	PartialRefundPercent: 50,
+}
+
+func TestQuoteRefund_CustomerNoticeWindows(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	booking := &models.Booking{TotalPrice: 45}
+
+	cases := []struct {
+		name    string
+		notice  time.Duration
+		percent int
+		amount  float64
+		fee     float64
+	}{
+		{"well ahead", 48 * time.Hour, 100, 45, 0},
+		{"exactly at full window", 24 * time.Hour, 100, 45, 0},
+		{"partial window", 5 * time.Hour, 50, 22.5, 22.5},
+		{"exactly at partial window", 2 * time.Hour, 50, 22.5, 22.5},
+		{"too late", 30 * time.Minute, 0, 0, 45},
+		{"after start", -time.Hour, 0, 0, 45},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			booking.ScheduledStartTime = now.Add(tc.notice)
+			quote := models.QuoteRefund(refundPolicy, booking, now, true)
+			assert.Equal(t, tc.percent, quote.Percent)
+			assert.Equal(t, tc.amount, quote.Amount)
+			assert.Equal(t, tc.fee, quote.CancellationFee)
+		})
+	}
+}
+
+func TestQuoteRefund_BarberCancellationAlwaysFull(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	booking := &models.Booking{TotalPrice: 30, ScheduledStartTime: now.Add(10 * time.Minute)}
+
+	quote := models.QuoteRefund(refundPolicy, booking, now, false)
+	assert.True(t, quote.IsFull())
+	assert.Equal(t, 30.0, quote.Amount)
+	assert.Zero(t, quote.CancellationFee)
+}
+
+func TestQuoteRefund_RoundsToCents(t *testing.T) {
+	now := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	policy := refundPolicy
+	policy.PartialRefundPercent = 33
+	booking := &models.Booking{TotalPrice: 19.99, ScheduledStartTime: now.Add(3 * time.Hour)}
+
+	quote := models.QuoteRefund(policy, booking, now, true)
+	assert.Equal(t, 6.6, quote.Amount)
+	assert.Equal(t, 13.39, quote.CancellationFee)
+}
+
+func TestBooking_IsPrepaid(t *testing.T) {
+	ref := "pi_123"
+	empty := ""
+
+	assert.True(t, (&models.Booking{PaymentStatus: config.PaymentStatusAuthorized, PaymentReference: &ref}).IsPrepaid())
+	assert.True(t, (&models.Booking{PaymentStatus: config.PaymentStatusPaid, PaymentReference: &ref}).IsPrepaid())
+	assert.False(t, (&models.Booking{PaymentStatus: config.PaymentStatusPending, PaymentReference: &ref}).IsPrepaid())
+	assert.False(t, (&models.Booking{PaymentStatus: config.PaymentStatusRefunded, PaymentReference: &ref}).IsPrepaid())
+	assert.False(t, (&models.Booking{PaymentStatus: config.PaymentStatusPaid, PaymentReference: &empty}).IsPrepaid())
+	assert.False(t, (&models.Booking{PaymentStatus: config.PaymentStatusPaid}).IsPrepaid())
+}
@@ -0,0 +1,37 @@
+// tests/unit/models/review_request_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewRequest_IsOpen(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	sent := now.Add(-time.Hour)
+	request := models.ReviewRequest{
+		SendAt:    sent,
+		ExpiresAt: now.Add(24 * time.Hour),
+	}
+
+	// Not sent yet: nobody has the link
+	assert.False(t, request.IsOpen(now))
+
+	request.SentAt = &sent
+	assert.True(t, request.IsOpen(now))
+	assert.False(t, request.IsExpired(now))
+
+	// Expires at ExpiresAt exactly
+	assert.True(t, request.IsExpired(request.ExpiresAt))
+	assert.False(t, request.IsOpen(request.ExpiresAt))
+
+	// Used once, closed for good
+	used := now
+	request.UsedAt = &used
+	assert.True(t, request.IsUsed())
+	assert.False(t, request.IsOpen(now))
+}
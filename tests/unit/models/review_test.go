@@ -199,3 +199,32 @@ func TestReview_Validate_ValidRatings(t *testing.T) {
 		}
 	}
 }
+
+func TestReview_CanAddResponse(t *testing.T) {
+	text := "Thanks for coming in!"
+	tests := []struct {
+		name     string
+		response *string
+		status   string
+		want     bool
+	}{
+		{"no response", nil, "", true},
+		{"live before moderation existed", &text, "", false},
+		{"pending", nil, "pending", false},
+		{"flagged", nil, "flagged", false},
+		{"approved", &text, "approved", false},
+		{"rejected", nil, "rejected", true},
+		{"redacted", nil, "redacted", true},
+	}
+
+	for _, tt := range tests {
+		review := &models.Review{BarberResponse: tt.response}
+		if tt.status != "" {
+			status := tt.status
+			review.BarberResponseStatus = &status
+		}
+		if got := review.CanAddResponse(); got != tt.want {
+			t.Errorf("%s: CanAddResponse() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// tests/unit/models/role_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRole_Grants(t *testing.T) {
+	moderator := &models.Role{
+		Name:        "moderator",
+		Permissions: models.StringArray{config.PermissionReviewsModerate},
+	}
+	assert.True(t, moderator.Grants(config.PermissionReviewsModerate))
+	assert.False(t, moderator.Grants(config.PermissionBookingsWrite))
+
+	admin := &models.Role{Name: "admin", Permissions: models.StringArray{config.PermissionAll}}
+	assert.True(t, admin.Grants(config.PermissionRolesManage))
+
+	empty := &models.Role{Name: "empty"}
+	assert.False(t, empty.Grants(config.PermissionBookingsRead))
+}
@@ -0,0 +1,157 @@
+// tests/unit/models/schedule_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// weekdaySchedule works Mon-Fri 09:00-17:00 with a 12:00-13:00 lunch break
+func weekdaySchedule(timezone string) *models.BarberSchedule {
+	lunch := "Lunch"
+	schedule := &models.BarberSchedule{BarberID: 1, Timezone: timezone}
+	for day := 1; day <= 5; day++ {
+		schedule.Periods = append(schedule.Periods,
+			models.SchedulePeriod{DayOfWeek: day, StartTime: "09:00:00", EndTime: "17:00:00", PeriodType: config.SchedulePeriodWork},
+			models.SchedulePeriod{DayOfWeek: day, StartTime: "12:00:00", EndTime: "13:00:00", PeriodType: config.SchedulePeriodBreak, Label: &lunch},
+		)
+	}
+	return schedule
+}
+
+func TestBarberSchedule_CheckAvailability_WorkingHours(t *testing.T) {
+	schedule := weekdaySchedule("UTC")
+	monday := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, schedule.CheckAvailability(monday.Add(9*time.Hour), monday.Add(10*time.Hour)))
+	assert.NoError(t, schedule.CheckAvailability(monday.Add(16*time.Hour), monday.Add(17*time.Hour)))
+
+	err := schedule.CheckAvailability(monday.Add(8*time.Hour+30*time.Minute), monday.Add(9*time.Hour+30*time.Minute))
+	assert.ErrorContains(t, err, "must be within the barber's working hours")
+
+	err = schedule.CheckAvailability(monday.Add(16*time.Hour+30*time.Minute), monday.Add(17*time.Hour+30*time.Minute))
+	assert.ErrorContains(t, err, "must be within the barber's working hours")
+
+	sunday := monday.AddDate(0, 0, -1)
+	err = schedule.CheckAvailability(sunday.Add(10*time.Hour), sunday.Add(11*time.Hour))
+	assert.ErrorContains(t, err, "not working that day")
+}
+
+func TestBarberSchedule_CheckAvailability_Breaks(t *testing.T) {
+	schedule := weekdaySchedule("UTC")
+	monday := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	err := schedule.CheckAvailability(monday.Add(11*time.Hour+30*time.Minute), monday.Add(12*time.Hour+15*time.Minute))
+	assert.ErrorContains(t, err, "cannot overlap the barber's Lunch")
+
+	// Touching the break on either side is fine
+	assert.NoError(t, schedule.CheckAvailability(monday.Add(11*time.Hour), monday.Add(12*time.Hour)))
+	assert.NoError(t, schedule.CheckAvailability(monday.Add(13*time.Hour), monday.Add(14*time.Hour)))
+}
+
+func TestBarberSchedule_CheckAvailability_Timezone(t *testing.T) {
+	schedule := weekdaySchedule("America/New_York")
+
+	// 14:00 UTC is 10:00 in New York (EDT) on 2025-06-02, a Monday
+	start := time.Date(2025, 6, 2, 14, 0, 0, 0, time.UTC)
+	assert.NoError(t, schedule.CheckAvailability(start, start.Add(time.Hour)))
+
+	// 09:00 UTC is 05:00 in New York
+	early := time.Date(2025, 6, 2, 9, 0, 0, 0, time.UTC)
+	assert.Error(t, schedule.CheckAvailability(early, early.Add(time.Hour)))
+}
+
+func TestBarberSchedule_CheckAvailability_Exceptions(t *testing.T) {
+	schedule := weekdaySchedule("UTC")
+	customStart, customEnd := "10:00", "14:00"
+	schedule.Exceptions = []models.ScheduleException{
+		{
+			StartDate:     time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC),
+			EndDate:       time.Date(2025, 3, 12, 0, 0, 0, 0, time.UTC),
+			ExceptionType: config.ScheduleExceptionVacation,
+		},
+		{
+			StartDate:     time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+			EndDate:       time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+			ExceptionType: config.ScheduleExceptionCustomHours,
+			StartTime:     &customStart,
+			EndTime:       &customEnd,
+		},
+	}
+
+	wednesday := time.Date(2025, 3, 12, 10, 0, 0, 0, time.UTC)
+	err := schedule.CheckAvailability(wednesday, wednesday.Add(time.Hour))
+	assert.ErrorContains(t, err, "barber is unavailable (vacation)")
+
+	thursday := time.Date(2025, 3, 13, 10, 0, 0, 0, time.UTC)
+	assert.NoError(t, schedule.CheckAvailability(thursday, thursday.Add(time.Hour)))
+
+	// Custom hours replace the weekly window, but the weekly break still applies
+	friday := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	assert.NoError(t, schedule.CheckAvailability(friday.Add(10*time.Hour), friday.Add(11*time.Hour)))
+	assert.Error(t, schedule.CheckAvailability(friday.Add(9*time.Hour), friday.Add(10*time.Hour)))
+	assert.Error(t, schedule.CheckAvailability(friday.Add(15*time.Hour), friday.Add(16*time.Hour)))
+	assert.Error(t, schedule.CheckAvailability(friday.Add(12*time.Hour), friday.Add(13*time.Hour)))
+}
+
+func TestSchedulePeriodAndException_Validate(t *testing.T) {
+	valid := models.SchedulePeriod{DayOfWeek: 1, StartTime: "09:00", EndTime: "17:00", PeriodType: config.SchedulePeriodWork}
+	require.NoError(t, valid.Validate())
+
+	badDay := valid
+	badDay.DayOfWeek = 7
+	assert.Error(t, badDay.Validate())
+
+	reversed := valid
+	reversed.StartTime, reversed.EndTime = "17:00", "09:00"
+	assert.Error(t, reversed.Validate())
+
+	badTime := valid
+	badTime.StartTime = "9am"
+	assert.Error(t, badTime.Validate())
+
+	day := time.Date(2025, 12, 25, 0, 0, 0, 0, time.UTC)
+	holiday := models.ScheduleException{StartDate: day, EndDate: day, ExceptionType: config.ScheduleExceptionHoliday}
+	assert.NoError(t, holiday.Validate())
+
+	custom := models.ScheduleException{StartDate: day, EndDate: day, ExceptionType: config.ScheduleExceptionCustomHours}
+	assert.ErrorContains(t, custom.Validate(), "required for custom_hours")
+
+	backwards := models.ScheduleException{StartDate: day, EndDate: day.AddDate(0, 0, -1), ExceptionType: config.ScheduleExceptionClosed}
+	assert.Error(t, backwards.Validate())
+}
+
+func TestBarberSchedule_NextOpening(t *testing.T) {
+	schedule := weekdaySchedule("UTC")
+	monday := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	// During working hours the barber is open now
+	now := monday.Add(10 * time.Hour)
+	next, ok := schedule.NextOpening(now, 7)
+	require.True(t, ok)
+	assert.Equal(t, now, next)
+
+	// Over lunch, after hours and over the weekend: the next window's start
+	next, ok = schedule.NextOpening(monday.Add(12*time.Hour+15*time.Minute), 7)
+	require.True(t, ok)
+	assert.Equal(t, monday.Add(13*time.Hour), next)
+
+	next, ok = schedule.NextOpening(monday.Add(20*time.Hour), 7)
+	require.True(t, ok)
+	assert.Equal(t, monday.AddDate(0, 0, 1).Add(9*time.Hour), next)
+
+	saturday := monday.AddDate(0, 0, 5)
+	next, ok = schedule.NextOpening(saturday.Add(10*time.Hour), 7)
+	require.True(t, ok)
+	assert.Equal(t, monday.AddDate(0, 0, 7).Add(9*time.Hour), next)
+
+	// Nothing within the lookahead
+	_, ok = schedule.NextOpening(saturday.Add(10*time.Hour), 1)
+	assert.False(t, ok)
+}
@@ -0,0 +1,37 @@
+// tests/unit/models/schema_backfill_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaBackfillNextBatch(t *testing.T) {
+	maxKey := int64(2500)
+	backfill := models.SchemaBackfill{NextKey: 0, MaxKey: &maxKey}
+
+	from, to, done := backfill.NextBatch(1000)
+	assert.False(t, done)
+	assert.Equal(t, int64(0), from)
+	assert.Equal(t, int64(1000), to)
+
+	// The last batch stops just past the max key
+	backfill.NextKey = 2000
+	from, to, done = backfill.NextBatch(1000)
+	assert.False(t, done)
+	assert.Equal(t, int64(2000), from)
+	assert.Equal(t, int64(2501), to)
+
+	backfill.NextKey = 2501
+	_, _, done = backfill.NextBatch(1000)
+	assert.True(t, done)
+	assert.Equal(t, 1.0, backfill.Progress())
+
+	// Empty tables are done at once
+	empty := int64(-1)
+	_, _, done = (&models.SchemaBackfill{MaxKey: &empty}).NextBatch(1000)
+	assert.True(t, done)
+}
@@ -0,0 +1,37 @@
+// tests/unit/models/shop_offboarding_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShopOffboardingCanCancel(t *testing.T) {
+	deleteAfter := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	o := models.ShopOffboarding{Status: config.ShopOffboardingStatusScheduled, DeleteAfter: deleteAfter}
+
+	assert.True(t, o.CanCancel(deleteAfter.Add(-time.Hour)))
+	assert.False(t, o.CanCancel(deleteAfter), "not once the deletion date has come")
+
+	o.Status = config.ShopOffboardingStatusCompleted
+	assert.False(t, o.CanCancel(deleteAfter.Add(-time.Hour)))
+}
+
+func TestBarberClientConsentedContact(t *testing.T) {
+	email, phone := "ann@example.com", "+15550100"
+	client := models.BarberClient{Email: &email, Phone: &phone, EmailConsent: true}
+
+	gotEmail, gotPhone := client.ConsentedContact()
+	assert.Equal(t, &email, gotEmail)
+	assert.Nil(t, gotPhone, "no SMS consent, no phone")
+
+	client.EmailConsent, client.SMSConsent = false, true
+	gotEmail, gotPhone = client.ConsentedContact()
+	assert.Nil(t, gotEmail)
+	assert.Equal(t, &phone, gotPhone)
+}
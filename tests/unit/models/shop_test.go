@@ -0,0 +1,44 @@
+// tests/unit/models/shop_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShopHoursValidate(t *testing.T) {
+	valid := models.ShopHours{
+		{DayOfWeek: 1, StartTime: "09:00", EndTime: "18:00"},
+		{DayOfWeek: 6, StartTime: "10:00", EndTime: "14:00"},
+	}
+	assert.NoError(t, valid.Validate())
+	assert.NoError(t, models.ShopHours(nil).Validate())
+
+	assert.Error(t, models.ShopHours{{DayOfWeek: 7, StartTime: "09:00", EndTime: "18:00"}}.Validate())
+	assert.Error(t, models.ShopHours{{DayOfWeek: 1, StartTime: "18:00", EndTime: "09:00"}}.Validate())
+	assert.Error(t, models.ShopHours{{DayOfWeek: 1, StartTime: "9am", EndTime: "18:00"}}.Validate())
+}
+
+func TestShopSchedule(t *testing.T) {
+	shop := models.Shop{Timezone: "America/New_York"}
+	assert.Nil(t, shop.Schedule(3), "no opening hours, no schedule")
+
+	shop.OpeningHours = models.ShopHours{{DayOfWeek: 1, StartTime: "09:00", EndTime: "17:00"}}
+	schedule := shop.Schedule(3)
+	require.NotNil(t, schedule)
+	assert.Equal(t, 3, schedule.BarberID)
+
+	// Monday opening hours in the shop's timezone
+	ny, _ := time.LoadLocation("America/New_York")
+	windows := schedule.WorkingWindows(time.Date(2026, 8, 3, 0, 0, 0, 0, time.UTC))
+	require.Len(t, windows, 1)
+	assert.Equal(t, time.Date(2026, 8, 3, 9, 0, 0, 0, ny), windows[0].Start.In(ny))
+	assert.Equal(t, time.Date(2026, 8, 3, 17, 0, 0, 0, ny), windows[0].End.In(ny))
+
+	assert.Empty(t, schedule.WorkingWindows(time.Date(2026, 8, 4, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,38 @@
+// tests/unit/models/status_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusIncident_Overlap(t *testing.T) {
+	day := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+	end := day.Add(24 * time.Hour)
+	at := func(hour int) *time.Time {
+		t := day.Add(time.Duration(hour) * time.Hour)
+		return &t
+	}
+
+	tests := []struct {
+		name     string
+		incident models.StatusIncident
+		want     time.Duration
+	}{
+		{"within the day", models.StatusIncident{StartedAt: *at(2), ResolvedAt: at(5)}, 3 * time.Hour},
+		{"started the day before", models.StatusIncident{StartedAt: *at(-3), ResolvedAt: at(1)}, time.Hour},
+		{"still ongoing", models.StatusIncident{StartedAt: *at(20)}, 4 * time.Hour},
+		{"resolved the day before", models.StatusIncident{StartedAt: *at(-5), ResolvedAt: at(-1)}, 0},
+		{"starts the day after", models.StatusIncident{StartedAt: *at(25)}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.incident.Overlap(day, end))
+		})
+	}
+}
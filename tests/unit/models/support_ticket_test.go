@@ -0,0 +1,151 @@
+// tests/unit/models/support_ticket_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTicket(priority string, openedAt time.Time) *models.SupportTicket {
+	ticket := &models.SupportTicket{
+		Priority:  priority,
+		Status:    config.SupportStatusOpen,
+		CreatedAt: openedAt,
+	}
+	ticket.ApplySLA()
+	return ticket
+}
+
+func TestSupportTicket_ApplySLA(t *testing.T) {
+	opened := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+	ticket := newTicket(config.SupportPriorityUrgent, opened)
+
+	assert.Equal(t, opened.Add(time.Hour), ticket.FirstResponseDueAt)
+	assert.Equal(t, opened.Add(8*time.Hour), ticket.ResolutionDueAt)
+}
+
+func TestSupportTicket_SetPriority(t *testing.T) {
+	opened := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+	ticket := newTicket(config.SupportPriorityNormal, opened)
+
+	require.NoError(t, ticket.SetPriority(config.SupportPriorityHigh))
+	assert.Equal(t, opened.Add(4*time.Hour), ticket.FirstResponseDueAt)
+	assert.Equal(t, opened.Add(24*time.Hour), ticket.ResolutionDueAt)
+
+	assert.Error(t, ticket.SetPriority("critical"))
+	assert.Equal(t, config.SupportPriorityHigh, ticket.Priority)
+}
+
+func TestSupportTicket_SetStatus_Transitions(t *testing.T) {
+	tests := []struct {
+		from    string
+		to      string
+		allowed bool
+	}{
+		{config.SupportStatusOpen, config.SupportStatusInProgress, true},
+		{config.SupportStatusInProgress, config.SupportStatusWaitingOnCustomer, true},
+		{config.SupportStatusWaitingOnCustomer, config.SupportStatusInProgress, true},
+		{config.SupportStatusInProgress, config.SupportStatusResolved, true},
+		{config.SupportStatusResolved, config.SupportStatusOpen, true},
+		{config.SupportStatusResolved, config.SupportStatusClosed, true},
+		{config.SupportStatusResolved, config.SupportStatusInProgress, false},
+		{config.SupportStatusClosed, config.SupportStatusOpen, false},
+		{config.SupportStatusInProgress, config.SupportStatusOpen, false},
+		{config.SupportStatusOpen, "escalated", false},
+	}
+
+	now := time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+	for _, tt := range tests {
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			ticket := &models.SupportTicket{Status: tt.from}
+			err := ticket.SetStatus(tt.to, now)
+			if tt.allowed {
+				require.NoError(t, err)
+				assert.Equal(t, tt.to, ticket.Status)
+			} else {
+				assert.Error(t, err)
+				assert.Equal(t, tt.from, ticket.Status)
+			}
+		})
+	}
+}
+
+func TestSupportTicket_WaitingOnCustomerPausesResolution(t *testing.T) {
+	opened := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+	ticket := newTicket(config.SupportPriorityHigh, opened)
+	firstResponseDue := ticket.FirstResponseDueAt
+	resolutionDue := ticket.ResolutionDueAt
+
+	pausedAt := opened.Add(2 * time.Hour)
+	require.NoError(t, ticket.SetStatus(config.SupportStatusWaitingOnCustomer, pausedAt))
+	assert.NotNil(t, ticket.SLAPausedAt)
+
+	// Paused past the original due date: not overdue while waiting
+	assert.False(t, ticket.ResolutionOverdue(resolutionDue.Add(time.Hour)))
+
+	resumedAt := pausedAt.Add(30 * time.Hour)
+	require.NoError(t, ticket.SetStatus(config.SupportStatusInProgress, resumedAt))
+	assert.Nil(t, ticket.SLAPausedAt)
+	assert.Equal(t, 30*60*60, ticket.SLAPausedSeconds)
+	assert.Equal(t, resolutionDue.Add(30*time.Hour), ticket.ResolutionDueAt)
+	assert.Equal(t, firstResponseDue, ticket.FirstResponseDueAt)
+
+	// The paused time survives a priority change
+	require.NoError(t, ticket.SetPriority(config.SupportPriorityUrgent))
+	assert.Equal(t, opened.Add(8*time.Hour+30*time.Hour), ticket.ResolutionDueAt)
+}
+
+func TestSupportTicket_ResolveAndReopen(t *testing.T) {
+	opened := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+	ticket := newTicket(config.SupportPriorityNormal, opened)
+
+	resolvedAt := opened.Add(time.Hour)
+	require.NoError(t, ticket.SetStatus(config.SupportStatusResolved, resolvedAt))
+	require.NotNil(t, ticket.ResolvedAt)
+	assert.Equal(t, resolvedAt, *ticket.ResolvedAt)
+	assert.False(t, ticket.IsActive())
+
+	require.NoError(t, ticket.SetStatus(config.SupportStatusOpen, opened.Add(2*time.Hour)))
+	assert.Nil(t, ticket.ResolvedAt)
+	assert.True(t, ticket.IsActive())
+
+	closedAt := opened.Add(3 * time.Hour)
+	require.NoError(t, ticket.SetStatus(config.SupportStatusClosed, closedAt))
+	assert.Equal(t, closedAt, *ticket.ClosedAt)
+	assert.Equal(t, closedAt, *ticket.ResolvedAt)
+}
+
+func TestSupportTicket_FirstResponse(t *testing.T) {
+	opened := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+	ticket := newTicket(config.SupportPriorityHigh, opened)
+	due := ticket.FirstResponseDueAt
+
+	assert.False(t, ticket.FirstResponseOverdue(due))
+	assert.True(t, ticket.FirstResponseOverdue(due.Add(time.Minute)))
+
+	repliedAt := opened.Add(time.Hour)
+	ticket.RecordFirstResponse(repliedAt)
+	ticket.RecordFirstResponse(repliedAt.Add(time.Hour))
+	assert.Equal(t, repliedAt, *ticket.FirstRespondedAt)
+	assert.False(t, ticket.FirstResponseOverdue(due.Add(time.Hour)))
+}
+
+func TestSupportTicket_LateFirstResponseStaysOverdue(t *testing.T) {
+	opened := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+	ticket := newTicket(config.SupportPriorityUrgent, opened)
+
+	ticket.RecordFirstResponse(opened.Add(2 * time.Hour))
+	assert.True(t, ticket.FirstResponseOverdue(opened.Add(3*time.Hour)))
+}
+
+func TestDefaultSupportPriority(t *testing.T) {
+	assert.Equal(t, config.SupportPriorityHigh, models.DefaultSupportPriority(config.SupportCategoryPayment))
+	assert.Equal(t, config.SupportPriorityNormal, models.DefaultSupportPriority(config.SupportCategoryBooking))
+	assert.Equal(t, config.SupportPriorityNormal, models.DefaultSupportPriority(config.SupportCategoryOther))
+}
@@ -0,0 +1,27 @@
+// tests/unit/models/suppression_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeAddress_Email(t *testing.T) {
+	assert.Equal(t, "jane.doe@example.com", models.NormalizeAddress(config.NotificationChannelEmail, "  Jane.Doe@Example.COM "))
+}
+
+func TestNormalizeAddress_Phone(t *testing.T) {
+	tests := map[string]string{
+		"+1 (555) 010-9999": "+15550109999",
+		"+15550109999":      "+15550109999",
+		"555.010.9999":      "5550109999",
+		"1+555":             "1555",
+	}
+	for input, want := range tests {
+		assert.Equal(t, want, models.NormalizeAddress(config.NotificationChannelSMS, input), input)
+	}
+}
@@ -0,0 +1,132 @@
+// tests/unit/models/tax_test.go
+package models
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func strPtr(v string) *string { return &v }
+
+func TestApplicableTaxRules(t *testing.T) {
+	barber := &models.Barber{ID: 3, Country: "USA", State: "NY"}
+	global := models.TaxRule{ID: 1, Name: "Sales tax", RatePercent: 8, IsActive: true}
+	country := models.TaxRule{ID: 2, Name: "US tax", RatePercent: 5, Country: strPtr("usa"), IsActive: true}
+	state := models.TaxRule{ID: 3, Name: "NY state", RatePercent: 4, Country: strPtr("USA"), State: strPtr("NY"), IsActive: true}
+	city := models.TaxRule{ID: 4, Name: "NYC", RatePercent: 4.5, Country: strPtr("USA"), State: strPtr("ny"), IsActive: true}
+	otherState := models.TaxRule{ID: 5, Name: "NJ state", RatePercent: 6.625, Country: strPtr("USA"), State: strPtr("NJ"), IsActive: true}
+	own := models.TaxRule{ID: 6, Name: "Exempt", RatePercent: 0, BarberID: intPtr(3), IsActive: true}
+	otherBarber := models.TaxRule{ID: 7, Name: "Other", RatePercent: 10, BarberID: intPtr(4), IsActive: true}
+
+	t.Run("barber's own rules win", func(t *testing.T) {
+		rules := models.ApplicableTaxRules([]models.TaxRule{global, country, state, own}, barber)
+		require.Len(t, rules, 1)
+		assert.Equal(t, 6, rules[0].ID)
+	})
+
+	t.Run("shop rules come before regional ones", func(t *testing.T) {
+		shopBarber := *barber
+		shopBarber.ShopID = intPtr(9)
+		shop := models.TaxRule{ID: 8, Name: "Shop VAT", RatePercent: 7, ShopID: intPtr(9), IsActive: true}
+		otherShop := models.TaxRule{ID: 9, Name: "Other shop", RatePercent: 12, ShopID: intPtr(10), IsActive: true}
+
+		rules := models.ApplicableTaxRules([]models.TaxRule{global, state, shop, otherShop}, &shopBarber)
+		require.Len(t, rules, 1)
+		assert.Equal(t, 8, rules[0].ID)
+
+		rules = models.ApplicableTaxRules([]models.TaxRule{global, state, shop, own}, &shopBarber)
+		require.Len(t, rules, 1)
+		assert.Equal(t, 6, rules[0].ID)
+
+		// Standalone barbers never get shop rules
+		rules = models.ApplicableTaxRules([]models.TaxRule{global, shop}, barber)
+		require.Len(t, rules, 1)
+		assert.Equal(t, 1, rules[0].ID)
+	})
+
+	t.Run("state rules stack", func(t *testing.T) {
+		rules := models.ApplicableTaxRules([]models.TaxRule{global, country, state, city, otherState, otherBarber}, barber)
+		require.Len(t, rules, 2)
+		assert.Equal(t, 3, rules[0].ID)
+		assert.Equal(t, 4, rules[1].ID)
+	})
+
+	t.Run("falls back to country then global", func(t *testing.T) {
+		rules := models.ApplicableTaxRules([]models.TaxRule{global, country, otherState}, barber)
+		require.Len(t, rules, 1)
+		assert.Equal(t, 2, rules[0].ID)
+
+		rules = models.ApplicableTaxRules([]models.TaxRule{global, otherState}, barber)
+		require.Len(t, rules, 1)
+		assert.Equal(t, 1, rules[0].ID)
+	})
+
+	t.Run("inactive rules are skipped", func(t *testing.T) {
+		inactive := state
+		inactive.IsActive = false
+		rules := models.ApplicableTaxRules([]models.TaxRule{global, inactive}, barber)
+		require.Len(t, rules, 1)
+		assert.Equal(t, 1, rules[0].ID)
+	})
+
+	t.Run("no rules", func(t *testing.T) {
+		assert.Empty(t, models.ApplicableTaxRules(nil, barber))
+	})
+}
+
+func TestApplyTaxes(t *testing.T) {
+	t.Run("exclusive", func(t *testing.T) {
+		lines, added := models.ApplyTaxes(90, []models.TaxRule{{ID: 1, Name: "Sales tax", RatePercent: 8}})
+		require.Len(t, lines, 1)
+		assert.Equal(t, 90.0, lines[0].TaxableAmount)
+		assert.Equal(t, 7.2, lines[0].Amount)
+		assert.Equal(t, 7.2, added)
+	})
+
+	t.Run("inclusive is taken out of the price", func(t *testing.T) {
+		lines, added := models.ApplyTaxes(120, []models.TaxRule{{ID: 1, Name: "VAT", RatePercent: 20, Inclusive: true}})
+		require.Len(t, lines, 1)
+		assert.Equal(t, 100.0, lines[0].TaxableAmount)
+		assert.Equal(t, 20.0, lines[0].Amount)
+		assert.Equal(t, 0.0, added)
+	})
+
+	t.Run("mixed taxes share the pre-tax amount", func(t *testing.T) {
+		lines, added := models.ApplyTaxes(110, []models.TaxRule{
+			{ID: 1, Name: "VAT", RatePercent: 10, Inclusive: true},
+			{ID: 2, Name: "City", RatePercent: 5},
+		})
+		require.Len(t, lines, 2)
+		assert.Equal(t, 10.0, lines[0].Amount)
+		assert.Equal(t, 100.0, lines[1].TaxableAmount)
+		assert.Equal(t, 5.0, lines[1].Amount)
+		assert.Equal(t, 5.0, added)
+		assert.Equal(t, 15.0, models.TotalTax(lines))
+	})
+
+	t.Run("no rules", func(t *testing.T) {
+		lines, added := models.ApplyTaxes(50, nil)
+		assert.Empty(t, lines)
+		assert.Equal(t, 0.0, added)
+	})
+}
+
+func TestNewTaxedPricing(t *testing.T) {
+	pricing := models.NewTaxedPricing(100, 10, []models.TaxRule{
+		{ID: 1, Name: "VAT", RatePercent: 20, Inclusive: true},
+		{ID: 2, Name: "City", RatePercent: 5},
+	}, "EUR")
+
+	assert.Equal(t, 90.0, pricing.SubTotal)
+	assert.Equal(t, 18.75, pricing.TaxAmount) // 15 VAT + 3.75 city on 75
+	assert.Equal(t, 93.75, pricing.TotalPrice)
+	assert.Len(t, pricing.TaxLines, 2)
+
+	untaxed := models.NewTaxedPricing(40, 0, nil, "EUR")
+	assert.Equal(t, 0.0, untaxed.TaxAmount)
+	assert.Equal(t, 40.0, untaxed.TotalPrice)
+}
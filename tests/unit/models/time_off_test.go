@@ -0,0 +1,52 @@
+// tests/unit/models/time_off_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeOffDays(t *testing.T) {
+	ny, _ := time.LoadLocation("America/New_York")
+	start := time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+
+	// The range ends at midnight after the last day, across the DST change
+	r := models.TimeOffDays(start, end, ny)
+	assert.Equal(t, time.Date(2026, 3, 7, 0, 0, 0, 0, ny), r.Start)
+	assert.Equal(t, time.Date(2026, 3, 9, 0, 0, 0, 0, ny), r.End)
+	assert.Equal(t, 47*time.Hour, r.End.Sub(r.Start))
+}
+
+func TestTimeOffValidate(t *testing.T) {
+	start := time.Date(2026, 8, 1, 9, 0, 0, 0, time.UTC)
+
+	valid := models.TimeOff{StartAt: start, EndAt: start.Add(2 * time.Hour)}
+	assert.NoError(t, valid.Validate())
+
+	empty := models.TimeOff{StartAt: start, EndAt: start}
+	assert.Error(t, empty.Validate())
+
+	tooLong := models.TimeOff{StartAt: start, EndAt: start.AddDate(0, 0, 91)}
+	assert.Error(t, tooLong.Validate())
+}
+
+func TestOverlappingTimeOff(t *testing.T) {
+	start := time.Date(2026, 8, 1, 12, 0, 0, 0, time.UTC)
+	timeOff := []models.TimeOff{
+		{ID: 1, StartAt: start, EndAt: start.Add(time.Hour)},
+		{ID: 2, StartAt: start.Add(3 * time.Hour), EndAt: start.Add(5 * time.Hour)},
+	}
+
+	// Touching the end of a block is not overlapping it
+	assert.Nil(t, models.OverlappingTimeOff(timeOff, models.TimeRange{Start: start.Add(time.Hour), End: start.Add(2 * time.Hour)}))
+
+	blocked := models.OverlappingTimeOff(timeOff, models.TimeRange{Start: start.Add(2 * time.Hour), End: start.Add(4 * time.Hour)})
+	if assert.NotNil(t, blocked) {
+		assert.Equal(t, 2, blocked.ID)
+	}
+}
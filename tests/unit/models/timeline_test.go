@@ -0,0 +1,143 @@
+// tests/unit/models/timeline_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var timelineBase = time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+
+// historyEvent builds a booking_history source event for booking 7
+func historyEvent(id int, kind string, minutes int, newValues models.JSONMap) models.TimelineEvent {
+	return models.TimelineEvent{
+		Source:        config.TimelineSourceBookingHistory,
+		SourceID:      id,
+		BookingID:     7,
+		Kind:          kind,
+		NewValues:     newValues,
+		OccurredAt:    timelineBase.Add(time.Duration(minutes) * time.Minute),
+		BookingNumber: "BK7",
+		ServiceName:   "Skin Fade",
+		TotalPrice:    35,
+		Currency:      "USD",
+	}
+}
+
+func eventTypes(entries []models.TimelineEntry) []string {
+	types := make([]string, len(entries))
+	for i, e := range entries {
+		types[i] = e.EventType
+	}
+	return types
+}
+
+func TestProjectTimeline_MapsBookingLifecycle(t *testing.T) {
+	events := []models.TimelineEvent{
+		historyEvent(1, "created", 0, models.JSONMap{"status": "pending"}),
+		historyEvent(2, "status_changed", 5, models.JSONMap{"status": config.BookingStatusConfirmed}),
+		historyEvent(3, "updated", 6, models.JSONMap{"notes": "internal"}),
+		historyEvent(4, "status_changed", 60, models.JSONMap{"status": config.BookingStatusCompleted}),
+	}
+
+	entries := models.ProjectTimeline(42, events)
+
+	assert.Equal(t, []string{
+		config.TimelineEventBookingCreated,
+		config.TimelineEventBookingConfirmed,
+		config.TimelineEventBookingCompleted,
+	}, eventTypes(entries), "detail edits are not customer-facing activity")
+
+	require.NotEmpty(t, entries)
+	assert.Equal(t, 42, entries[0].CustomerID)
+	assert.Equal(t, "Booked Skin Fade", entries[0].Title)
+	assert.Equal(t, "BK7", entries[0].Details["booking_number"])
+	require.NotNil(t, entries[0].BookingID)
+	assert.Equal(t, 7, *entries[0].BookingID)
+}
+
+func TestProjectTimeline_CancellationVariantsShareType(t *testing.T) {
+	for _, status := range []string{
+		config.BookingStatusCancelled,
+		config.BookingStatusCancelledByCustomer,
+		config.BookingStatusCancelledByBarber,
+	} {
+		entries := models.ProjectTimeline(1, []models.TimelineEvent{
+			historyEvent(1, "status_changed", 0, models.JSONMap{"status": status}),
+		})
+		require.Len(t, entries, 1, status)
+		assert.Equal(t, config.TimelineEventBookingCancelled, entries[0].EventType)
+	}
+}
+
+func TestProjectTimeline_Reschedule(t *testing.T) {
+	ev := historyEvent(1, "rescheduled", 0, models.JSONMap{"scheduled_start_time": "2025-03-08T10:00:00Z"})
+	ev.OldValues = models.JSONMap{"scheduled_start_time": "2025-03-07T10:00:00Z"}
+
+	entries := models.ProjectTimeline(1, []models.TimelineEvent{ev})
+	require.Len(t, entries, 1)
+	assert.Equal(t, config.TimelineEventBookingRescheduled, entries[0].EventType)
+	assert.Equal(t, "2025-03-07T10:00:00Z", entries[0].Details["old_start_time"])
+	assert.Equal(t, "2025-03-08T10:00:00Z", entries[0].Details["new_start_time"])
+}
+
+func TestProjectTimeline_ReviewsAndPayments(t *testing.T) {
+	rating := 5
+	events := []models.TimelineEvent{
+		historyEvent(1, "payment_status_changed", 0, models.JSONMap{"payment_status": config.PaymentStatusAuthorized}),
+		{Source: config.TimelineSourceReview, SourceID: 9, BookingID: 7, Kind: "review", Rating: &rating,
+			OccurredAt: timelineBase.Add(2 * time.Hour), ServiceName: "Skin Fade"},
+		historyEvent(2, "payment_status_changed", 90, models.JSONMap{
+			"payment_status": config.PaymentStatusRefunded, "refund_amount": 17.5,
+		}),
+	}
+
+	entries := models.ProjectTimeline(1, events)
+	assert.Equal(t, []string{
+		config.TimelineEventPaymentAuthorized,
+		config.TimelineEventPaymentRefunded,
+		config.TimelineEventReviewPosted,
+	}, eventTypes(entries), "entries are ordered by when they happened")
+
+	assert.Equal(t, 17.5, entries[1].Details["amount"])
+	assert.Equal(t, 5, entries[2].Details["rating"])
+}
+
+func TestProjectTimeline_PaidAtNotDuplicatedByHistory(t *testing.T) {
+	paidAt := models.TimelineEvent{
+		Source: config.TimelineSourcePayment, SourceID: 7, BookingID: 7, Kind: "paid",
+		OccurredAt: timelineBase.Add(time.Hour),
+	}
+
+	// Legacy bookings only have paid_at
+	entries := models.ProjectTimeline(1, []models.TimelineEvent{paidAt})
+	assert.Equal(t, []string{config.TimelineEventPaymentReceived}, eventTypes(entries))
+
+	// When the payment was also recorded in history, it appears once
+	entries = models.ProjectTimeline(1, []models.TimelineEvent{
+		historyEvent(1, "payment_status_changed", 60, models.JSONMap{"payment_status": config.PaymentStatusPaid}),
+		paidAt,
+	})
+	assert.Equal(t, []string{config.TimelineEventPaymentReceived}, eventTypes(entries))
+	assert.Equal(t, config.TimelineSourceBookingHistory, entries[0].Source)
+}
+
+func TestTimelineState_IsCurrent(t *testing.T) {
+	at := timelineBase
+	later := timelineBase.Add(time.Second)
+	state := &models.TimelineState{Watermark: &at, EventCount: 3}
+
+	assert.True(t, state.IsCurrent(models.TimelineVersion{Watermark: &at, EventCount: 3}))
+	assert.False(t, state.IsCurrent(models.TimelineVersion{Watermark: &later, EventCount: 3}))
+	assert.False(t, state.IsCurrent(models.TimelineVersion{Watermark: &at, EventCount: 2}), "deleted events change the count")
+
+	empty := &models.TimelineState{}
+	assert.True(t, empty.IsCurrent(models.TimelineVersion{}))
+	assert.False(t, empty.IsCurrent(models.TimelineVersion{Watermark: &at, EventCount: 1}))
+}
@@ -0,0 +1,59 @@
+// tests/unit/models/win_back_test.go
+package models
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWinBackCandidate_Cadence(t *testing.T) {
+	first := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("average gap between visits", func(t *testing.T) {
+		c := models.WinBackCandidate{CompletedVisits: 4, FirstVisitAt: first, LastVisitAt: first.AddDate(0, 0, 63)}
+		assert.Equal(t, 21*24*time.Hour, c.Cadence())
+	})
+
+	t.Run("single visit has no cadence", func(t *testing.T) {
+		c := models.WinBackCandidate{CompletedVisits: 1, FirstVisitAt: first, LastVisitAt: first}
+		assert.Zero(t, c.Cadence())
+	})
+}
+
+func TestWinBackCandidate_FirstName(t *testing.T) {
+	assert.Equal(t, "Alex", (&models.WinBackCandidate{CustomerName: "  Alex Morgan "}).FirstName())
+	assert.Equal(t, "", (&models.WinBackCandidate{}).FirstName())
+}
+
+func TestWinBackMessage_CouponDiscount(t *testing.T) {
+	m := models.WinBackMessage{CouponPercentOff: 15}
+	assert.Equal(t, 4.5, m.CouponDiscount(30))
+	assert.Equal(t, 5.25, m.CouponDiscount(34.99))
+	assert.Equal(t, 0.0, (&models.WinBackMessage{}).CouponDiscount(30))
+}
+
+func TestWinBackCampaignStats_Finalize(t *testing.T) {
+	stats := models.WinBackCampaignStats{
+		WinBackCampaign: models.WinBackCampaign{SentCount: 8},
+		Opened:          5,
+		Converted:       2,
+		Revenue:         64.999,
+	}
+	stats.Finalize()
+
+	require.NotNil(t, stats.OpenRate)
+	require.NotNil(t, stats.ConversionRate)
+	assert.Equal(t, 62.5, *stats.OpenRate)
+	assert.Equal(t, 25.0, *stats.ConversionRate)
+	assert.Equal(t, 65.0, stats.Revenue)
+
+	empty := models.WinBackCampaignStats{}
+	empty.Finalize()
+	assert.Nil(t, empty.OpenRate)
+	assert.Nil(t, empty.ConversionRate)
+}
@@ -0,0 +1,175 @@
+// tests/unit/oauth/oauth_test.go
+package oauth_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/oauth"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testClientID = "client-123.apps.googleusercontent.com"
+
+// jwksServer publishes key under kid and counts fetches
+func jwksServer(t *testing.T, key *rsa.PrivateKey, kid string) (*httptest.Server, *int32) {
+	t.Helper()
+	var fetches int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		w.Header().Set("Cache-Control", "public, max-age=3600")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kid": kid,
+				"kty": "RSA",
+				"alg": "RS256",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server, &fetches
+}
+
+func signToken(t *testing.T, key *rsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func googleClaims() jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"iss":            "https://accounts.google.com",
+		"aud":            testClientID,
+		"sub":            "1098765",
+		"email":          "Jane@Example.com",
+		"email_verified": true,
+		"name":           "Jane Doe",
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+	}
+}
+
+func newGoogleVerifier(t *testing.T) (*oauth.IDTokenVerifier, *rsa.PrivateKey, *int32) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server, fetches := jwksServer(t, key, "key-1")
+
+	verifier, err := oauth.NewGoogleVerifier(config.OAuthProviderConfig{
+		ClientIDs: []string{testClientID},
+		JWKSURL:   server.URL,
+	})
+	require.NoError(t, err)
+	return verifier, key, fetches
+}
+
+func TestNewGoogleVerifier_RequiresClientIDs(t *testing.T) {
+	_, err := oauth.NewGoogleVerifier(config.OAuthProviderConfig{})
+	assert.ErrorIs(t, err, oauth.ErrNotConfigured)
+}
+
+func TestVerify_ValidToken(t *testing.T) {
+	verifier, key, fetches := newGoogleVerifier(t)
+
+	identity, err := verifier.Verify(context.Background(), signToken(t, key, "key-1", googleClaims()), "")
+	require.NoError(t, err)
+	assert.Equal(t, config.OAuthProviderGoogle, identity.Provider)
+	assert.Equal(t, "1098765", identity.Subject)
+	assert.Equal(t, "jane@example.com", identity.Email)
+	assert.True(t, identity.EmailVerified)
+	assert.Equal(t, "Jane Doe", identity.Name)
+
+	// Keys are cached
+	_, err = verifier.Verify(context.Background(), signToken(t, key, "key-1", googleClaims()), "")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(fetches))
+}
+
+func TestVerify_RejectsInvalidTokens(t *testing.T) {
+	verifier, key, _ := newGoogleVerifier(t)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		modify func(jwt.MapClaims)
+		key    *rsa.PrivateKey
+	}{
+		{name: "other audience", modify: func(c jwt.MapClaims) { c["aud"] = "someone-else" }},
+		{name: "other issuer", modify: func(c jwt.MapClaims) { c["iss"] = "https://evil.example.com" }},
+		{name: "expired", modify: func(c jwt.MapClaims) { c["exp"] = time.Now().Add(-time.Hour).Unix() }},
+		{name: "no subject", modify: func(c jwt.MapClaims) { delete(c, "sub") }},
+		{name: "wrong signature", modify: func(c jwt.MapClaims) {}, key: otherKey},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := googleClaims()
+			tt.modify(claims)
+			signer := key
+			if tt.key != nil {
+				signer = tt.key
+			}
+
+			_, err := verifier.Verify(context.Background(), signToken(t, signer, "key-1", claims), "")
+			assert.ErrorIs(t, err, oauth.ErrInvalidToken)
+		})
+	}
+}
+
+func TestVerify_Nonce(t *testing.T) {
+	verifier, key, _ := newGoogleVerifier(t)
+	claims := googleClaims()
+	claims["nonce"] = "abc"
+	token := signToken(t, key, "key-1", claims)
+
+	_, err := verifier.Verify(context.Background(), token, "abc")
+	assert.NoError(t, err)
+
+	_, err = verifier.Verify(context.Background(), token, "xyz")
+	assert.ErrorIs(t, err, oauth.ErrInvalidToken)
+}
+
+func TestVerify_AppleStringBooleans(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	server, _ := jwksServer(t, key, "apple-1")
+	verifier, err := oauth.NewAppleVerifier(config.OAuthProviderConfig{
+		ClientIDs: []string{"com.example.barbershop"},
+		JWKSURL:   server.URL,
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	identity, err := verifier.Verify(context.Background(), signToken(t, key, "apple-1", jwt.MapClaims{
+		"iss":            "https://appleid.apple.com",
+		"aud":            "com.example.barbershop",
+		"sub":            "001234.abcd",
+		"email":          "relay@privaterelay.appleid.com",
+		"email_verified": "true",
+		"iat":            now.Unix(),
+		"exp":            now.Add(time.Hour).Unix(),
+	}), "")
+	require.NoError(t, err)
+	assert.Equal(t, config.OAuthProviderApple, identity.Provider)
+	assert.True(t, identity.EmailVerified)
+}
@@ -0,0 +1,66 @@
+// tests/unit/payments/recorder_test.go
+package payments_test
+
+import (
+	"context"
+	"testing"
+
+	"barber-booking-system/internal/payments"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type operationLog struct {
+	ops []payments.Operation
+}
+
+func (l *operationLog) RecordPayment(_ context.Context, op payments.Operation) {
+	l.ops = append(l.ops, op)
+}
+
+func TestWithRecorder_RecordsSuccessfulOperations(t *testing.T) {
+	log := &operationLog{}
+	gateway := payments.WithRecorder(payments.NewSandboxGateway(), log)
+	ctx := context.Background()
+
+	auth, err := gateway.Authorize(ctx, payments.AuthorizeRequest{
+		Amount:   2500,
+		Currency: "usd",
+		Metadata: map[string]string{"booking_id": "42"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, gateway.Capture(ctx, auth.ID, 2000))
+	refundID, err := gateway.Refund(ctx, auth.ID, 500)
+	require.NoError(t, err)
+
+	require.Len(t, log.ops, 3)
+	assert.Equal(t, payments.Operation{
+		Kind:            payments.OperationAuthorize,
+		Provider:        "sandbox",
+		AuthorizationID: auth.ID,
+		Amount:          2500,
+		Currency:        "usd",
+		Metadata:        map[string]string{"booking_id": "42"},
+	}, log.ops[0])
+	assert.Equal(t, payments.OperationCapture, log.ops[1].Kind)
+	assert.Equal(t, int64(2000), log.ops[1].Amount)
+	assert.Equal(t, payments.OperationRefund, log.ops[2].Kind)
+	assert.Equal(t, refundID, log.ops[2].RefundID)
+}
+
+func TestWithRecorder_SkipsFailedOperations(t *testing.T) {
+	log := &operationLog{}
+	gateway := payments.WithRecorder(payments.NewSandboxGateway(), log)
+
+	_, err := gateway.Authorize(context.Background(), payments.AuthorizeRequest{
+		Amount:          2500,
+		PaymentMethodID: payments.SandboxPaymentMethodDeclined,
+	})
+	assert.ErrorIs(t, err, payments.ErrPaymentDeclined)
+	assert.Empty(t, log.ops)
+}
+
+func TestWithRecorder_NilGateway(t *testing.T) {
+	assert.Nil(t, payments.WithRecorder(nil, &operationLog{}))
+}
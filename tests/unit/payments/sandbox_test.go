@@ -0,0 +1,50 @@
+// tests/unit/payments/sandbox_test.go
+package payments_test
+
+import (
+	"context"
+	"testing"
+
+	"barber-booking-system/internal/payments"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSandboxGateway_AuthorizesAnyPaymentMethod(t *testing.T) {
+	gateway := payments.NewSandboxGateway()
+
+	auth, err := gateway.Authorize(context.Background(), payments.AuthorizeRequest{
+		Amount:          2500,
+		Currency:        "usd",
+		PaymentMethodID: "pm_card_visa",
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, auth.ID, "sandbox_auth_")
+	assert.Equal(t, int64(2500), auth.Amount)
+	assert.Equal(t, "requires_capture", auth.Status)
+	assert.NoError(t, gateway.Capture(context.Background(), auth.ID, 2500))
+	assert.NoError(t, gateway.Void(context.Background(), auth.ID))
+
+	refundID, err := gateway.Refund(context.Background(), auth.ID, 1000)
+	require.NoError(t, err)
+	assert.Contains(t, refundID, "sandbox_refund_")
+}
+
+func TestSandboxGateway_TestPaymentMethodsFail(t *testing.T) {
+	gateway := payments.NewSandboxGateway()
+
+	_, err := gateway.Authorize(context.Background(), payments.AuthorizeRequest{
+		Amount:          2500,
+		PaymentMethodID: payments.SandboxPaymentMethodDeclined,
+	})
+	assert.ErrorIs(t, err, payments.ErrPaymentDeclined)
+
+	_, err = gateway.Authorize(context.Background(), payments.AuthorizeRequest{
+		Amount:          2500,
+		PaymentMethodID: payments.SandboxPaymentMethodError,
+	})
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, payments.ErrPaymentDeclined)
+}
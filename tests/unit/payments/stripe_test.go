@@ -0,0 +1,125 @@
+// tests/unit/payments/stripe_test.go
+package payments_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/payments"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGateway(t *testing.T, handler http.HandlerFunc) *payments.StripeGateway {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	gateway, err := payments.NewStripeGateway(config.StripeConfig{SecretKey: "sk_test_123", APIBaseURL: server.URL})
+	require.NoError(t, err)
+	return gateway
+}
+
+func TestNewStripeGateway_RequiresSecretKey(t *testing.T) {
+	_, err := payments.NewStripeGateway(config.StripeConfig{})
+	assert.ErrorIs(t, err, payments.ErrNotConfigured)
+}
+
+func TestStripeGateway_AuthorizeUsesManualCapture(t *testing.T) {
+	gateway := newTestGateway(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "/v1/payment_intents", r.URL.Path)
+		assert.Equal(t, "booking-abc", r.Header.Get("Idempotency-Key"))
+		user, _, _ := r.BasicAuth()
+		assert.Equal(t, "sk_test_123", user)
+
+		assert.Equal(t, "2550", r.PostForm.Get("amount"))
+		assert.Equal(t, "usd", r.PostForm.Get("currency"))
+		assert.Equal(t, "manual", r.PostForm.Get("capture_method"))
+		assert.Equal(t, "true", r.PostForm.Get("confirm"))
+		assert.Equal(t, "BK1", r.PostForm.Get("metadata[booking_number]"))
+
+		_, _ = w.Write([]byte(`{"id":"pi_1","amount":2550,"currency":"usd","status":"requires_capture"}`))
+	})
+
+	auth, err := gateway.Authorize(context.Background(), payments.AuthorizeRequest{
+		Amount:          payments.ToMinorUnits(25.50),
+		Currency:        "USD",
+		PaymentMethodID: "pm_card_visa",
+		IdempotencyKey:  "booking-abc",
+		Metadata:        map[string]string{"booking_number": "BK1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "pi_1", auth.ID)
+	assert.Equal(t, int64(2550), auth.Amount)
+}
+
+func TestStripeGateway_CardErrorIsDeclined(t *testing.T) {
+	gateway := newTestGateway(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusPaymentRequired)
+		_, _ = w.Write([]byte(`{"error":{"type":"card_error","code":"card_declined","message":"Your card was declined."}}`))
+	})
+
+	_, err := gateway.Authorize(context.Background(), payments.AuthorizeRequest{
+		Amount: 1000, Currency: "usd", PaymentMethodID: "pm_card_chargeDeclined",
+	})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, payments.ErrPaymentDeclined))
+	assert.Contains(t, err.Error(), "Your card was declined.")
+}
+
+func TestStripeGateway_AuthorizeRequiringActionIsVoided(t *testing.T) {
+	var paths []string
+	gateway := newTestGateway(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		_, _ = w.Write([]byte(`{"id":"pi_3ds","amount":1000,"currency":"usd","status":"requires_action"}`))
+	})
+
+	_, err := gateway.Authorize(context.Background(), payments.AuthorizeRequest{
+		Amount: 1000, Currency: "usd", PaymentMethodID: "pm_card_threeDSecure2Required",
+	})
+	assert.ErrorIs(t, err, payments.ErrPaymentDeclined)
+	assert.Equal(t, []string{"/v1/payment_intents", "/v1/payment_intents/pi_3ds/cancel"}, paths)
+}
+
+func TestStripeGateway_CaptureAndVoid(t *testing.T) {
+	var paths []string
+	gateway := newTestGateway(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		paths = append(paths, r.URL.Path+"?"+r.PostForm.Encode())
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	require.NoError(t, gateway.Capture(context.Background(), "pi_1", 1500))
+	require.NoError(t, gateway.Void(context.Background(), "pi_2"))
+	assert.Equal(t, []string{
+		"/v1/payment_intents/pi_1/capture?amount_to_capture=1500",
+		"/v1/payment_intents/pi_2/cancel?",
+	}, paths)
+}
+
+func TestStripeGateway_Refund(t *testing.T) {
+	gateway := newTestGateway(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "/v1/refunds", r.URL.Path)
+		assert.Equal(t, "pi_1", r.PostForm.Get("payment_intent"))
+		assert.Equal(t, "1250", r.PostForm.Get("amount"))
+		assert.Equal(t, "refund-pi_1-1250", r.Header.Get("Idempotency-Key"))
+		_, _ = w.Write([]byte(`{"id":"re_1","status":"succeeded"}`))
+	})
+
+	refundID, err := gateway.Refund(context.Background(), "pi_1", 1250)
+	require.NoError(t, err)
+	assert.Equal(t, "re_1", refundID)
+}
+
+func TestToMinorUnits(t *testing.T) {
+	assert.Equal(t, int64(2550), payments.ToMinorUnits(25.50))
+	assert.Equal(t, int64(1999), payments.ToMinorUnits(19.99))
+	assert.Equal(t, int64(0), payments.ToMinorUnits(0))
+}
@@ -0,0 +1,313 @@
+// tests/unit/push/push_test.go
+package push_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/push"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rsaKeyPEM(t *testing.T) (string, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), key
+}
+
+func ecKeyPEM(t *testing.T) (string, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})), key
+}
+
+func intPtr(i int) *int { return &i }
+
+// ========================================================================
+// FCM
+// ========================================================================
+
+func newTestFCM(t *testing.T, send http.HandlerFunc) (*push.FCMSender, *int) {
+	t.Helper()
+	keyPEM, key := rsaKeyPEM(t)
+	tokenRequests := 0
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.PostForm.Get("grant_type"))
+
+		claims := jwt.MapClaims{}
+		_, err := jwt.ParseWithClaims(r.PostForm.Get("assertion"), claims, func(*jwt.Token) (any, error) {
+			return &key.PublicKey, nil
+		}, jwt.WithValidMethods([]string{"RS256"}))
+		require.NoError(t, err)
+		assert.Equal(t, "push@example.iam.gserviceaccount.com", claims["iss"])
+		assert.Equal(t, server.URL+"/token", claims["aud"])
+
+		_, _ = w.Write([]byte(`{"access_token":"ya29.test","expires_in":3600}`))
+	})
+	mux.HandleFunc("/v1/projects/demo/messages:send", send)
+
+	sender, err := push.NewFCMSender(config.FCMConfig{
+		ProjectID:   "demo",
+		ClientEmail: "push@example.iam.gserviceaccount.com",
+		PrivateKey:  keyPEM,
+		TokenURL:    server.URL + "/token",
+		APIBaseURL:  server.URL,
+	})
+	require.NoError(t, err)
+	return sender, &tokenRequests
+}
+
+func TestNewFCMSender_RequiresServiceAccount(t *testing.T) {
+	_, err := push.NewFCMSender(config.FCMConfig{ProjectID: "demo"})
+	assert.ErrorIs(t, err, push.ErrNotConfigured)
+}
+
+func TestFCMSender_SendsWithCachedAccessToken(t *testing.T) {
+	sender, tokenRequests := newTestFCM(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer ya29.test", r.Header.Get("Authorization"))
+
+		var body struct {
+			Message struct {
+				Token        string            `json:"token"`
+				Notification map[string]string `json:"notification"`
+				Data         map[string]string `json:"data"`
+				Android      struct {
+					Notification struct {
+						Count int `json:"notification_count"`
+					} `json:"notification"`
+				} `json:"android"`
+			} `json:"message"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "device-1", body.Message.Token)
+		assert.Equal(t, "Booking Confirmed", body.Message.Notification["title"])
+		assert.Equal(t, "42", body.Message.Data["notification_id"])
+		assert.Equal(t, 3, body.Message.Android.Notification.Count)
+
+		_, _ = w.Write([]byte(`{"name":"projects/demo/messages/1"}`))
+	})
+
+	msg := push.Message{
+		Token: "device-1",
+		Title: "Booking Confirmed",
+		Body:  "See you soon",
+		Badge: intPtr(3),
+		Data:  map[string]string{"notification_id": "42"},
+	}
+	require.NoError(t, sender.Send(context.Background(), msg))
+	require.NoError(t, sender.Send(context.Background(), msg))
+	assert.Equal(t, 1, *tokenRequests, "access token should be reused until it nears expiry")
+}
+
+func TestFCMSender_ErrorMapping(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   error
+	}{
+		{"unregistered token", http.StatusNotFound,
+			`{"error":{"code":404,"message":"Requested entity was not found.","status":"NOT_FOUND","details":[{"errorCode":"UNREGISTERED"}]}}`,
+			push.ErrInvalidToken},
+		{"malformed token", http.StatusBadRequest,
+			`{"error":{"code":400,"message":"The registration token is not a valid FCM registration token","status":"INVALID_ARGUMENT","details":[{"errorCode":"INVALID_ARGUMENT"}]}}`,
+			push.ErrInvalidToken},
+		{"quota exceeded", http.StatusTooManyRequests,
+			`{"error":{"code":429,"message":"Quota exceeded","details":[{"errorCode":"QUOTA_EXCEEDED"}]}}`,
+			push.ErrTransient},
+		{"unavailable", http.StatusServiceUnavailable, `{}`, push.ErrTransient},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender, _ := newTestFCM(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			})
+			err := sender.Send(context.Background(), push.Message{Token: "device-1", Title: "t", Body: "b"})
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestFCMSender_OtherClientErrorsAreFinal(t *testing.T) {
+	sender, _ := newTestFCM(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":{"code":403,"message":"SenderId mismatch","details":[{"errorCode":"SENDER_ID_MISMATCH"}]}}`))
+	})
+	err := sender.Send(context.Background(), push.Message{Token: "device-1", Title: "t", Body: "b"})
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, push.ErrTransient))
+	assert.False(t, errors.Is(err, push.ErrInvalidToken))
+}
+
+// ========================================================================
+// APNS
+// ========================================================================
+
+func newTestAPNs(t *testing.T, handler func(w http.ResponseWriter, r *http.Request, key *ecdsa.PrivateKey)) *push.APNsSender {
+	t.Helper()
+	keyPEM, key := ecKeyPEM(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler(w, r, key)
+	}))
+	t.Cleanup(server.Close)
+
+	sender, err := push.NewAPNsSender(config.APNsConfig{
+		KeyID:      "ABC123DEFG",
+		TeamID:     "TEAM123456",
+		PrivateKey: keyPEM,
+		Topic:      "com.example.barber",
+		APIBaseURL: server.URL,
+	})
+	require.NoError(t, err)
+	return sender
+}
+
+func TestAPNsSender_Send(t *testing.T) {
+	sender := newTestAPNs(t, func(w http.ResponseWriter, r *http.Request, key *ecdsa.PrivateKey) {
+		assert.Equal(t, "/3/device/abcdef", r.URL.Path)
+		assert.Equal(t, "com.example.barber", r.Header.Get("apns-topic"))
+		assert.Equal(t, "alert", r.Header.Get("apns-push-type"))
+
+		raw := strings.TrimPrefix(r.Header.Get("Authorization"), "bearer ")
+		claims := jwt.MapClaims{}
+		token, err := jwt.ParseWithClaims(raw, claims, func(*jwt.Token) (any, error) {
+			return &key.PublicKey, nil
+		}, jwt.WithValidMethods([]string{"ES256"}))
+		require.NoError(t, err)
+		assert.Equal(t, "ABC123DEFG", token.Header["kid"])
+		assert.Equal(t, "TEAM123456", claims["iss"])
+
+		var payload struct {
+			APS struct {
+				Alert map[string]string `json:"alert"`
+				Badge int               `json:"badge"`
+			} `json:"aps"`
+			NotificationID string `json:"notification_id"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+		assert.Equal(t, "Reminder", payload.APS.Alert["title"])
+		assert.Equal(t, 5, payload.APS.Badge)
+		assert.Equal(t, "7", payload.NotificationID)
+	})
+
+	err := sender.Send(context.Background(), push.Message{
+		Token: "abcdef",
+		Title: "Reminder",
+		Body:  "Your appointment is tomorrow",
+		Badge: intPtr(5),
+		Data:  map[string]string{"notification_id": "7"},
+	})
+	require.NoError(t, err)
+}
+
+func TestAPNsSender_ErrorMapping(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		reason string
+		want   error
+	}{
+		{"unregistered", http.StatusGone, "Unregistered", push.ErrInvalidToken},
+		{"bad device token", http.StatusBadRequest, "BadDeviceToken", push.ErrInvalidToken},
+		{"too many requests", http.StatusTooManyRequests, "TooManyRequests", push.ErrTransient},
+		{"service unavailable", http.StatusServiceUnavailable, "ServiceUnavailable", push.ErrTransient},
+		{"expired provider token", http.StatusForbidden, "ExpiredProviderToken", push.ErrTransient},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sender := newTestAPNs(t, func(w http.ResponseWriter, r *http.Request, _ *ecdsa.PrivateKey) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"reason":"` + tt.reason + `"}`))
+			})
+			err := sender.Send(context.Background(), push.Message{Token: "abcdef", Title: "t", Body: "b"})
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+// ========================================================================
+// RETRY AND DISPATCH
+// ========================================================================
+
+type fakeSender struct {
+	errs  []error
+	calls int
+}
+
+func (f *fakeSender) Name() string { return "fake" }
+
+func (f *fakeSender) Send(context.Context, push.Message) error {
+	f.calls++
+	if f.calls <= len(f.errs) {
+		return f.errs[f.calls-1]
+	}
+	return nil
+}
+
+func TestSendWithRetry_RetriesTransientFailures(t *testing.T) {
+	sender := &fakeSender{errs: []error{push.ErrTransient, push.ErrTransient}}
+	err := push.SendWithRetry(context.Background(), sender, push.Message{}, 3, time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, 3, sender.calls)
+}
+
+func TestSendWithRetry_GivesUpAfterMaxAttempts(t *testing.T) {
+	sender := &fakeSender{errs: []error{push.ErrTransient, push.ErrTransient, push.ErrTransient}}
+	err := push.SendWithRetry(context.Background(), sender, push.Message{}, 2, time.Millisecond)
+	assert.ErrorIs(t, err, push.ErrTransient)
+	assert.Equal(t, 2, sender.calls)
+}
+
+func TestSendWithRetry_DoesNotRetryInvalidToken(t *testing.T) {
+	sender := &fakeSender{errs: []error{push.ErrInvalidToken}}
+	err := push.SendWithRetry(context.Background(), sender, push.Message{}, 3, time.Millisecond)
+	assert.ErrorIs(t, err, push.ErrInvalidToken)
+	assert.Equal(t, 1, sender.calls)
+}
+
+func TestDispatcher_RoutesByPlatform(t *testing.T) {
+	ios := &fakeSender{}
+	dispatcher := push.NewDispatcher(config.DefaultPushMaxAttempts)
+	dispatcher.Register(config.DevicePlatformIOS, ios)
+	dispatcher.Register(config.DevicePlatformAndroid, nil)
+
+	assert.True(t, dispatcher.Enabled())
+	require.NoError(t, dispatcher.Send(context.Background(), config.DevicePlatformIOS, push.Message{}))
+	assert.Equal(t, 1, ios.calls)
+	assert.ErrorIs(t, dispatcher.Send(context.Background(), config.DevicePlatformAndroid, push.Message{}), push.ErrNotConfigured)
+
+	var disabled *push.Dispatcher
+	assert.False(t, disabled.Enabled())
+}
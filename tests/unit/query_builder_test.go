@@ -225,6 +225,24 @@ func TestQueryBuilder_ComplexQuery(t *testing.T) {
 	}
 }
 
+func TestQueryBuilder_BuildCount(t *testing.T) {
+	qb := repository.NewQueryBuilder("SELECT * FROM users").
+		Where("status = ?", "active").
+		OrderBy("created_at", "DESC").
+		Paginate(20, 40)
+	query, args := qb.BuildCount()
+
+	expectedQuery := "SELECT COUNT(*) FROM (SELECT * FROM users WHERE status = $1) AS counted"
+	if query != expectedQuery {
+		t.Errorf("Expected query %q, got %q", expectedQuery, query)
+	}
+
+	// Pagination args are not included
+	if len(args) != 1 || args[0] != "active" {
+		t.Errorf("Expected args [active], got %v", args)
+	}
+}
+
 func TestQueryBuilder_Join(t *testing.T) {
 	qb := repository.NewQueryBuilder("SELECT * FROM users u")
 	query, _ := qb.
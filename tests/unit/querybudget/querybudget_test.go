@@ -0,0 +1,47 @@
+// tests/unit/querybudget/querybudget_test.go
+package querybudget_test
+
+import (
+	"context"
+	"testing"
+
+	"barber-booking-system/internal/querybudget"
+	"barber-booking-system/internal/sqlhook"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHookCountsQueries(t *testing.T) {
+	ctx, counter := querybudget.WithCounter(context.Background(), 2, false)
+
+	for _, op := range []string{sqlhook.OpBegin, sqlhook.OpQuery, sqlhook.OpExec, sqlhook.OpPrepare, sqlhook.OpPing} {
+		assert.NoError(t, querybudget.Hook(ctx, op), "only strict mode fails queries")
+	}
+
+	assert.Equal(t, 3, counter.Queries(), "transactions and pings are not queries")
+	assert.True(t, counter.Exceeded())
+}
+
+func TestHookFailsQueriesOverBudgetWhenStrict(t *testing.T) {
+	ctx, counter := querybudget.WithCounter(context.Background(), 1, true)
+
+	assert.NoError(t, querybudget.Hook(ctx, sqlhook.OpQuery))
+	assert.ErrorIs(t, querybudget.Hook(ctx, sqlhook.OpQuery), querybudget.ErrBudgetExceeded)
+	assert.True(t, counter.Exceeded())
+}
+
+func TestUnlimitedLiftsTheBudget(t *testing.T) {
+	ctx, counter := querybudget.WithCounter(context.Background(), 1, true)
+	querybudget.Unlimited(ctx)
+
+	for i := 0; i < 5; i++ {
+		assert.NoError(t, querybudget.Hook(ctx, sqlhook.OpQuery))
+	}
+	assert.Equal(t, 5, counter.Queries())
+	assert.False(t, counter.Exceeded())
+}
+
+func TestHookIgnoresQueriesOutsideRequests(t *testing.T) {
+	assert.NoError(t, querybudget.Hook(context.Background(), sqlhook.OpQuery))
+	querybudget.Unlimited(context.Background())
+}
@@ -0,0 +1,84 @@
+// tests/unit/ranking/ranking_test.go
+package ranking_test
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/ranking"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew_NormalizesWeights(t *testing.T) {
+	scorer := ranking.New(config.RankingConfig{WeightRating: 3, WeightFeatured: 1, WeightPrice: -2})
+
+	assert.InDelta(t, 0.75, scorer.Weight(ranking.FactorRating), 1e-9)
+	assert.InDelta(t, 0.25, scorer.Weight(ranking.FactorFeatured), 1e-9)
+	assert.Zero(t, scorer.Weight(ranking.FactorPrice))
+	assert.Zero(t, scorer.Weight(ranking.FactorDistance))
+}
+
+func TestNew_ZeroWeightsUseDefaults(t *testing.T) {
+	scorer := ranking.New(config.RankingConfig{})
+
+	assert.InDelta(t, config.DefaultRankingWeightRating, scorer.Weight(ranking.FactorRating), 1e-9)
+	assert.InDelta(t, config.DefaultRankingWeightFeatured, scorer.Weight(ranking.FactorFeatured), 1e-9)
+}
+
+func TestExplain_ContributionsSumToScore(t *testing.T) {
+	scorer := ranking.Default()
+	values := ranking.Values{
+		ranking.FactorRating:       0.9,
+		ranking.FactorDistance:     0.5,
+		ranking.FactorResponseTime: 0.5,
+		ranking.FactorPrice:        0.4,
+		ranking.FactorFeatured:     1,
+	}
+
+	explanation := scorer.Explain(values)
+	require.Len(t, explanation.Factors, 5)
+
+	var sum float64
+	for _, f := range explanation.Factors {
+		assert.InDelta(t, f.Value*f.Weight, f.Contribution, 1e-9)
+		sum += f.Contribution
+	}
+	assert.InDelta(t, sum, explanation.Score, 1e-9)
+	assert.InDelta(t, 0.35*0.9+0.25*0.5+0.1*0.5+0.1*0.4+0.2, scorer.Score(values), 1e-9)
+}
+
+func TestExplain_MissingFactorsCountAsZero(t *testing.T) {
+	scorer := ranking.New(config.RankingConfig{WeightRating: 1, WeightDistance: 1})
+
+	assert.InDelta(t, 0.5, scorer.Score(ranking.Values{ranking.FactorRating: 1}), 1e-9)
+	assert.Zero(t, scorer.Score(nil))
+}
+
+func TestScoreSQL_SkipsZeroWeights(t *testing.T) {
+	cols := ranking.Columns{
+		Rating:          "b.rating",
+		DistanceKm:      "ri.distance_km",
+		ResponseMinutes: "b.response_time_minutes",
+		AveragePrice:    "ri.avg_price",
+		Featured:        "fp.id IS NOT NULL",
+	}
+	scorer := ranking.New(config.RankingConfig{WeightRating: 1})
+
+	sql := scorer.ScoreSQL(cols)
+	assert.Contains(t, sql, "b.rating")
+	assert.NotContains(t, sql, "ri.distance_km")
+
+	// Factor values are always selected, whatever their weight
+	assert.Contains(t, scorer.FactorsSQL(cols), "'distance', COALESCE(1.0 / (1.0 + ri.distance_km / 5), 0)")
+}
+
+func TestValues_Scan(t *testing.T) {
+	var values ranking.Values
+	require.NoError(t, values.Scan([]byte(`{"rating": 0.8, "featured": 1}`)))
+	assert.Equal(t, ranking.Values{"rating": 0.8, "featured": 1}, values)
+
+	require.NoError(t, values.Scan(nil))
+	assert.Nil(t, values)
+}
@@ -0,0 +1,60 @@
+// tests/unit/repository/list_filters_test.go
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateListFilters_NormalizesOrderAndCapsLimit(t *testing.T) {
+	filters := repository.BookingFilters{SortBy: "total_price", Order: "desc", Limit: 500}
+
+	require.NoError(t, repository.ValidateListFilters(&filters, 0))
+	assert.Equal(t, "DESC", filters.Order)
+	assert.Equal(t, config.MaxPageLimit, filters.Limit)
+}
+
+func TestValidateListFilters_RejectsUnknownSort(t *testing.T) {
+	err := repository.ValidateListFilters(&repository.ReviewFilters{SortBy: "password"}, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sort_by must be one of")
+
+	err = repository.ValidateListFilters(&repository.BarberFilters{SortBy: "rating; DROP TABLE barbers"}, 0)
+	assert.Error(t, err)
+
+	assert.NoError(t, repository.ValidateListFilters(&repository.ServiceFilters{SortBy: "popularity"}, 0))
+}
+
+func TestValidateListFilters_RejectsBadOrderAndPaging(t *testing.T) {
+	assert.Error(t, repository.ValidateListFilters(&repository.NotificationFilters{Order: "sideways"}, 0))
+	assert.Error(t, repository.ValidateListFilters(&repository.BookingFilters{Limit: -1}, 0))
+	assert.Error(t, repository.ValidateListFilters(&repository.TimelineFilters{Offset: -5}, 0))
+}
+
+func TestValidateListFilters_DateRanges(t *testing.T) {
+	now := time.Now()
+
+	err := repository.ValidateListFilters(&repository.BookingFilters{StartDateFrom: now, StartDateTo: now.Add(-time.Hour)}, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "start_date")
+
+	// Open-ended ranges are fine
+	assert.NoError(t, repository.ValidateListFilters(&repository.AuditLogFilters{CreatedFrom: now}, 0))
+}
+
+func TestValidateListFilters_AppliesConsumerLimit(t *testing.T) {
+	filters := repository.ReviewFilters{Limit: 5000}
+	require.NoError(t, repository.ValidateListFilters(&filters, 500))
+	assert.Equal(t, 500, filters.Limit, "elevated consumers get larger pages")
+
+	// An explicit zero would otherwise mean no limit at all
+	filters = repository.ReviewFilters{Limit: 0}
+	require.NoError(t, repository.ValidateListFilters(&filters, 0))
+	assert.Equal(t, config.DefaultPageLimit, filters.Limit)
+}
@@ -0,0 +1,39 @@
+// tests/unit/repository/sort_columns_test.go
+package repository
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortColumns_OrderBy(t *testing.T) {
+	bookings := repository.BookingSortColumns
+	fallback := bookings["created_at"]
+
+	assert.Equal(t, "created_at DESC", bookings.OrderBy("", "", fallback))
+	assert.Equal(t, "total_price ASC", bookings.OrderBy("total_price", "asc", fallback))
+	assert.Equal(t, "LOWER(customer_name) ASC NULLS LAST, created_at DESC",
+		bookings.OrderBy("customer_name", "ASC", fallback))
+
+	// Unknown keys never reach the SQL
+	assert.Equal(t, "created_at DESC", bookings.OrderBy("id; DROP TABLE bookings", "ASC; --", fallback))
+}
+
+func TestSortColumns_DefaultOrderAndBefore(t *testing.T) {
+	barbers := repository.BarberSortColumns
+
+	assert.Equal(t, "is_featured DESC, b.shop_name ASC", barbers.OrderBy("shop_name", "", barbers["rank"]))
+	assert.Equal(t, "rank_score DESC, b.id ASC", barbers.OrderBy("", "", barbers["rank"]))
+}
+
+func TestValidateListFilters_BookingSortKeys(t *testing.T) {
+	for _, key := range []string{"payment_status", "customer_name", "booking_number"} {
+		assert.NoError(t, repository.ValidateListFilters(&repository.BookingFilters{SortBy: key}, 0), key)
+	}
+
+	err := repository.ValidateListFilters(&repository.BookingFilters{SortBy: "customer_email"}, 0)
+	assert.ErrorContains(t, err, "booking_number, created_at, customer_name, payment_status")
+}
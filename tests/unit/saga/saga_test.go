@@ -0,0 +1,124 @@
+// tests/unit/saga/saga_test.go
+package saga_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"barber-booking-system/internal/saga"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recorder tracks the order in which actions and compensations run
+type recorder struct {
+	calls []string
+}
+
+func (r *recorder) action(name string, err error) saga.Action {
+	return func(context.Context) error {
+		r.calls = append(r.calls, name)
+		return err
+	}
+}
+
+func TestSaga_RunsAllStepsInOrder(t *testing.T) {
+	rec := &recorder{}
+	s := saga.New("test").
+		Step("a", rec.action("a", nil), rec.action("undo a", nil)).
+		Step("b", rec.action("b", nil), rec.action("undo b", nil)).
+		Step("c", rec.action("c", nil), nil)
+
+	require.NoError(t, s.Run(context.Background()))
+	assert.Equal(t, []string{"a", "b", "c"}, rec.calls)
+	assert.Equal(t, []string{"a", "b", "c"}, s.Steps())
+}
+
+func TestSaga_CompensatesCompletedStepsInReverse(t *testing.T) {
+	rec := &recorder{}
+	declined := errors.New("card declined")
+	s := saga.New("checkout").
+		Step("reserve", rec.action("reserve", nil), rec.action("release", nil)).
+		Step("authorize", rec.action("authorize", nil), rec.action("void", nil)).
+		Step("confirm", rec.action("confirm", declined), rec.action("unconfirm", nil))
+
+	err := s.Run(context.Background())
+	require.Error(t, err)
+
+	// The failed step is not compensated; earlier steps are, newest first
+	assert.Equal(t, []string{"reserve", "authorize", "confirm", "void", "release"}, rec.calls)
+
+	var sagaErr *saga.Error
+	require.True(t, errors.As(err, &sagaErr))
+	assert.Equal(t, "confirm", sagaErr.Step)
+	assert.Equal(t, []string{"authorize", "reserve"}, sagaErr.Compensated)
+	assert.Empty(t, sagaErr.CompensationErrors)
+	assert.ErrorIs(t, err, declined)
+}
+
+func TestSaga_FirstStepFailureHasNothingToUndo(t *testing.T) {
+	rec := &recorder{}
+	s := saga.New("checkout").
+		Step("reserve", rec.action("reserve", errors.New("slot taken")), rec.action("release", nil))
+
+	err := s.Run(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, []string{"reserve"}, rec.calls)
+	assert.Contains(t, err.Error(), `step "reserve" failed: slot taken`)
+}
+
+func TestSaga_ContinuesRollbackWhenCompensationFails(t *testing.T) {
+	rec := &recorder{}
+	s := saga.New("checkout").
+		Step("reserve", rec.action("reserve", nil), rec.action("release", nil)).
+		Step("authorize", rec.action("authorize", nil), rec.action("void", errors.New("provider down"))).
+		Step("confirm", rec.action("confirm", errors.New("db error")), nil)
+
+	err := s.Run(context.Background())
+
+	var sagaErr *saga.Error
+	require.True(t, errors.As(err, &sagaErr))
+	assert.Equal(t, []string{"reserve", "authorize", "confirm", "void", "release"}, rec.calls)
+	assert.Equal(t, []string{"reserve"}, sagaErr.Compensated)
+	require.Len(t, sagaErr.CompensationErrors, 1)
+	assert.Contains(t, err.Error(), "compensate authorize: provider down")
+}
+
+func TestSaga_CompensationsIgnoreCallerCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var compensateErr error
+
+	s := saga.New("checkout").
+		Step("reserve", func(context.Context) error { return nil },
+			func(ctx context.Context) error {
+				compensateErr = ctx.Err()
+				return nil
+			}).
+		Step("authorize", func(context.Context) error {
+			cancel() // client disconnected mid-flow
+			return context.Canceled
+		}, nil)
+
+	err := s.Run(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.NoError(t, compensateErr, "compensation must not see the cancelled context")
+}
+
+func TestSaga_StopsBeforeNextStepWhenContextCancelled(t *testing.T) {
+	rec := &recorder{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	s := saga.New("checkout").
+		Step("reserve", func(context.Context) error {
+			rec.calls = append(rec.calls, "reserve")
+			cancel()
+			return nil
+		}, rec.action("release", nil)).
+		Step("authorize", rec.action("authorize", nil), nil)
+
+	err := s.Run(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"reserve", "release"}, rec.calls)
+}
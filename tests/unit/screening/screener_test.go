@@ -0,0 +1,64 @@
+// tests/unit/screening/screener_test.go
+package screening_test
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/screening"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newScreener() *screening.Screener {
+	return screening.New(config.ReviewScreeningConfig{
+		Enabled:        true,
+		BlockedWords:   []string{"shit", "Bastard "},
+		MaxLinks:       1,
+		MaxLinkDensity: 0.2,
+	})
+}
+
+func TestBlockedWords_MatchesDisguisedAndInflectedWords(t *testing.T) {
+	s := newScreener()
+
+	assert.Equal(t, []string{"shit", "bastard"}, s.BlockedWords("What a $H1T cut, the bastards. Sh1t!"))
+	assert.Empty(t, s.BlockedWords("Shitake risotto next door, a bast ardent fan"), "only whole words match")
+}
+
+func TestCheckText_CleanReviewHasNoFindings(t *testing.T) {
+	assert.Empty(t, newScreener().CheckText("Great fade, friendly barber, booked again for next month."))
+}
+
+func TestCheckText_FlagsTooManyLinks(t *testing.T) {
+	findings := newScreener().CheckText("Cheap cuts at https://spam.example and www.other.example instead, trust me")
+	require.Len(t, findings, 1)
+	assert.Equal(t, screening.CheckLinks, findings[0].Check)
+}
+
+func TestCheckText_FlagsLinkHeavyText(t *testing.T) {
+	findings := newScreener().CheckText("Visit cheapcuts.com now")
+	require.Len(t, findings, 1)
+	assert.Equal(t, screening.CheckLinks, findings[0].Check)
+
+	assert.Empty(t, newScreener().CheckText("Found him on barberbook.com, really happy with the beard trim and the hot towel shave"))
+}
+
+func TestNormalizeText(t *testing.T) {
+	assert.Equal(t, "best barber in town", screening.NormalizeText("  Best\tbarber\n in   TOWN "))
+}
+
+func TestCheckText_FlagsPersonalInformation(t *testing.T) {
+	findings := newScreener().CheckText("Sorry about that, text me on (555) 123-4567 and we'll sort it out")
+	require.Len(t, findings, 1)
+	assert.Equal(t, screening.CheckPersonalInfo, findings[0].Check)
+	assert.Contains(t, findings[0].Detail, "phone number")
+
+	findings = newScreener().CheckText("Please write to john.smith@mailbox.example so we can make it right, thanks again")
+	require.NotEmpty(t, findings)
+	assert.Equal(t, screening.CheckPersonalInfo, findings[0].Check)
+	assert.Contains(t, findings[0].Detail, "email address")
+
+	assert.Empty(t, newScreener().CheckText("Sorry about booking BK-20250310-0042, the 3 of us will make it right next time"))
+}
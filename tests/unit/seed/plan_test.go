@@ -0,0 +1,126 @@
+// tests/unit/seed/plan_test.go
+package seed_test
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/seed"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var now = time.Date(2026, 3, 11, 15, 30, 0, 0, time.UTC) // A Wednesday
+
+func TestNewPlan_IsDeterministic(t *testing.T) {
+	opts := seed.DefaultOptions()
+
+	a := seed.NewPlan(opts, now)
+	b := seed.NewPlan(opts, now)
+	assert.Equal(t, a, b)
+
+	opts.Seed++
+	c := seed.NewPlan(opts, now)
+	assert.NotEqual(t, a.Bookings, c.Bookings)
+}
+
+func TestNewPlan_Counts(t *testing.T) {
+	opts := seed.DefaultOptions()
+	plan := seed.NewPlan(opts, now)
+
+	assert.Len(t, plan.Barbers, opts.Barbers)
+	assert.Len(t, plan.Customers, opts.Customers)
+	assert.Len(t, plan.Bookings, opts.Barbers*opts.BookingsPerBarber)
+	for _, barber := range plan.Barbers {
+		assert.GreaterOrEqual(t, len(barber.Services), 4)
+		for _, s := range barber.Services {
+			assert.GreaterOrEqual(t, s.Price, s.PriceMin)
+			assert.LessOrEqual(t, s.Price, s.PriceMax)
+		}
+	}
+}
+
+func TestNewPlan_UniqueEmails(t *testing.T) {
+	plan := seed.NewPlan(seed.DefaultOptions(), now)
+
+	seen := map[string]bool{plan.Admin.Email: true}
+	people := append([]seed.Person{}, plan.Customers...)
+	for _, b := range plan.Barbers {
+		people = append(people, b.Person)
+	}
+	for _, p := range people {
+		assert.False(t, seen[p.Email], "duplicate email %s", p.Email)
+		seen[p.Email] = true
+		assert.Contains(t, p.Email, "@"+seed.EmailDomain)
+	}
+}
+
+func TestNewPlan_Bookings(t *testing.T) {
+	opts := seed.DefaultOptions()
+	plan := seed.NewPlan(opts, now)
+	today := now.Truncate(24 * time.Hour)
+
+	statuses := map[string]int{}
+	last := make(map[int]time.Time)
+	for _, b := range plan.Bookings {
+		statuses[b.Status]++
+
+		require.True(t, b.End.After(b.Start))
+		assert.False(t, b.CreatedAt.After(now))
+		assert.False(t, b.Start.Truncate(24*time.Hour).Equal(today), "booked today")
+		assert.False(t, b.Start.Before(today.AddDate(0, 0, -opts.PastDays)))
+		assert.False(t, b.Start.After(today.AddDate(0, 0, opts.FutureDays+1)))
+
+		// One barber's bookings are in order and never overlap
+		if prev, ok := last[b.Barber]; ok {
+			assert.False(t, b.Start.Before(prev), "overlapping bookings for barber %d", b.Barber)
+		}
+		last[b.Barber] = b.End
+
+		if b.Start.Before(now) {
+			assert.Contains(t, []string{
+				config.BookingStatusCompleted,
+				config.BookingStatusCancelledByCustomer,
+				config.BookingStatusCancelledByBarber,
+				config.BookingStatusNoShow,
+			}, b.Status)
+		} else {
+			assert.Contains(t, []string{
+				config.BookingStatusConfirmed,
+				config.BookingStatusPending,
+				config.BookingStatusCancelledByCustomer,
+			}, b.Status)
+		}
+
+		if b.Review != nil {
+			assert.Equal(t, config.BookingStatusCompleted, b.Status)
+			assert.True(t, b.Review.Rating >= 1 && b.Review.Rating <= 5)
+		}
+		if b.CancellationReason != "" {
+			assert.Contains(t, []string{
+				config.BookingStatusCancelledByCustomer,
+				config.BookingStatusCancelledByBarber,
+			}, b.Status)
+		}
+	}
+
+	for _, status := range []string{
+		config.BookingStatusCompleted,
+		config.BookingStatusConfirmed,
+		config.BookingStatusPending,
+		config.BookingStatusCancelledByCustomer,
+	} {
+		assert.Positive(t, statuses[status], "no %s bookings", status)
+	}
+}
+
+func TestNewPlan_NoCustomers(t *testing.T) {
+	opts := seed.DefaultOptions()
+	opts.Customers = 0
+
+	plan := seed.NewPlan(opts, now)
+	assert.Len(t, plan.Barbers, opts.Barbers)
+	assert.Empty(t, plan.Bookings)
+}
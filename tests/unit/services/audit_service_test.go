@@ -0,0 +1,200 @@
+// tests/unit/services/audit_service_test.go
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"barber-booking-system/internal/audit"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryAuditLog keeps audit entries in memory and serves them newest
+// first, filtered by action and entity the way the repository does
+type memoryAuditLog struct {
+	entries []models.AuditLog
+	err     error // Returned by Create when set
+}
+
+func (l *memoryAuditLog) Create(_ context.Context, entry *models.AuditLog) error {
+	if l.err != nil {
+		return l.err
+	}
+	entry.ID = len(l.entries) + 1
+	l.entries = append(l.entries, *entry)
+	return nil
+}
+
+func (l *memoryAuditLog) FindByID(_ context.Context, id int) (*models.AuditLog, error) {
+	for _, entry := range l.entries {
+		if entry.ID == id {
+			return &entry, nil
+		}
+	}
+	return nil, repository.ErrAuditLogNotFound
+}
+
+func (l *memoryAuditLog) FindAll(_ context.Context, filters repository.AuditLogFilters) ([]models.AuditLog, error) {
+	var found []models.AuditLog
+	for i := len(l.entries) - 1; i >= 0; i-- {
+		entry := l.entries[i]
+		if filters.Action != "" && entry.Action != filters.Action {
+			continue
+		}
+		if filters.EntityType != "" && entry.EntityType != filters.EntityType {
+			continue
+		}
+		found = append(found, entry)
+	}
+	return found, nil
+}
+
+type auditFeedFixture struct {
+	log      *memoryAuditLog
+	audit    *services.AuditService
+	services *mocks.MockServiceStore
+	catalog  *services.ServiceService
+}
+
+func newAuditFeedFixture(t *testing.T) *auditFeedFixture {
+	f := &auditFeedFixture{
+		log:      &memoryAuditLog{},
+		services: &mocks.MockServiceStore{},
+	}
+	f.audit = services.NewAuditService(f.log)
+	f.catalog = services.NewServiceService(f.services, nil)
+	f.catalog.SetAuditor(f.audit)
+	t.Cleanup(func() { f.services.AssertExpectations(t) })
+	return f
+}
+
+// adminContext is a request by admin 1 from the admin console
+func adminContext() context.Context {
+	return audit.WithActor(context.Background(), audit.Actor{
+		UserID:    1,
+		UserType:  config.UserTypeAdmin,
+		IPAddress: "203.0.113.9",
+		UserAgent: "admin-console",
+	})
+}
+
+func TestActivityFeed_ShowsServiceApproval(t *testing.T) {
+	f := newAuditFeedFixture(t)
+	ctx := adminContext()
+
+	f.services.On("FindByID", ctx, 12).Return(&models.Service{ID: 12, Name: "Beard trim"}, nil)
+	f.services.On("Update", ctx, mock.Anything).Return(nil)
+	adminID := 1
+	require.NoError(t, f.catalog.ApproveService(ctx, 12, &adminID, nil))
+
+	feed, err := f.audit.GetActivityFeed(ctx, repository.AuditLogFilters{Action: config.AuditActionServiceApproved})
+	require.NoError(t, err)
+	require.Len(t, feed.Entries, 1)
+
+	entry := feed.Entries[0]
+	assert.Equal(t, config.EntityTypeService, entry.EntityType)
+	require.NotNil(t, entry.EntityID)
+	assert.Equal(t, 12, *entry.EntityID)
+	require.NotNil(t, entry.ActorID)
+	assert.Equal(t, 1, *entry.ActorID)
+	assert.Equal(t, config.UserTypeAdmin, entry.ActorType)
+	require.NotNil(t, entry.IPAddress)
+	assert.Equal(t, "203.0.113.9", *entry.IPAddress)
+	assert.Equal(t, false, entry.OldValues["is_approved"])
+	assert.Equal(t, true, entry.NewValues["is_approved"])
+	assert.NotContains(t, entry.NewValues, "name", "unchanged fields are left out")
+}
+
+func TestActivityFeed_ShowsCategoryLifecycle(t *testing.T) {
+	f := newAuditFeedFixture(t)
+	ctx := adminContext()
+
+	name := "Colour & tint"
+	f.services.On("FindCategoryByID", ctx, 4).Return(&models.ServiceCategory{ID: 4, Name: "Colour", IsActive: true}, nil).Once()
+	f.services.On("UpdateCategory", ctx, mock.Anything).Return(nil)
+	f.services.On("FindCategoryByID", ctx, 4).Return(&models.ServiceCategory{ID: 4, Name: name, IsActive: true}, nil).Once()
+	f.services.On("DeleteCategory", ctx, 4).Return(nil)
+
+	_, err := f.catalog.UpdateCategory(ctx, 4, services.UpdateCategoryRequest{Name: &name})
+	require.NoError(t, err)
+	require.NoError(t, f.catalog.DeleteCategory(ctx, 4))
+
+	feed, err := f.audit.GetActivityFeed(ctx, repository.AuditLogFilters{EntityType: config.EntityTypeCategory})
+	require.NoError(t, err)
+	require.Len(t, feed.Entries, 2)
+
+	// Newest first
+	assert.Equal(t, config.AuditActionCategoryDeleted, feed.Entries[0].Action)
+	assert.Equal(t, name, feed.Entries[0].OldValues["name"])
+	assert.Nil(t, feed.Entries[0].NewValues)
+	assert.Equal(t, config.AuditActionCategoryUpdated, feed.Entries[1].Action)
+	assert.Equal(t, "Colour", feed.Entries[1].OldValues["name"])
+	assert.Equal(t, name, feed.Entries[1].NewValues["name"])
+}
+
+func TestActivityFeed_FailedActionsAreNotRecorded(t *testing.T) {
+	f := newAuditFeedFixture(t)
+	ctx := adminContext()
+
+	f.services.On("FindByID", ctx, 12).Return(&models.Service{ID: 12}, nil)
+	f.services.On("Update", ctx, mock.Anything).Return(errors.New("connection reset"))
+	f.services.On("FindCategoryByID", ctx, 5).Return(nil, repository.ErrCategoryNotFound)
+
+	assert.Error(t, f.catalog.ApproveService(ctx, 12, nil, nil))
+	assert.ErrorIs(t, f.catalog.DeleteCategory(ctx, 5), repository.ErrCategoryNotFound)
+
+	feed, err := f.audit.GetActivityFeed(ctx, repository.AuditLogFilters{})
+	require.NoError(t, err)
+	assert.Empty(t, feed.Entries)
+}
+
+func TestActivityFeed_AuditFailureDoesNotFailTheAction(t *testing.T) {
+	f := newAuditFeedFixture(t)
+	ctx := adminContext()
+	f.log.err = errors.New("audit table locked")
+
+	f.services.On("FindCategoryByID", ctx, 4).Return(&models.ServiceCategory{ID: 4}, nil)
+	f.services.On("DeleteCategory", ctx, 4).Return(nil)
+
+	assert.NoError(t, f.catalog.DeleteCategory(ctx, 4))
+}
+
+func TestActivityFeed_ActionsOutsideARequestAreTheSystems(t *testing.T) {
+	f := newAuditFeedFixture(t)
+	ctx := context.Background()
+
+	f.services.On("FindCategoryByID", ctx, 4).Return(&models.ServiceCategory{ID: 4}, nil)
+	f.services.On("DeleteCategory", ctx, 4).Return(nil)
+	require.NoError(t, f.catalog.DeleteCategory(ctx, 4))
+
+	feed, err := f.audit.GetActivityFeed(ctx, repository.AuditLogFilters{})
+	require.NoError(t, err)
+	require.Len(t, feed.Entries, 1)
+	assert.Equal(t, config.AuditActorSystem, feed.Entries[0].ActorType)
+	assert.Nil(t, feed.Entries[0].ActorID)
+}
+
+func TestActivityFeed_RedactsSecrets(t *testing.T) {
+	f := newAuditFeedFixture(t)
+	ctx := adminContext()
+
+	f.audit.RecordChange(ctx, config.AuditActionRoleUpdated, config.EntityTypeRole, nil, nil, nil, models.JSONMap{
+		"role":    "support",
+		"api_key": map[string]interface{}{"secret": "s3cr3t", "label": "ci"},
+	})
+
+	feed, err := f.audit.GetActivityFeed(ctx, repository.AuditLogFilters{})
+	require.NoError(t, err)
+	require.Len(t, feed.Entries, 1)
+	assert.Equal(t, "support", feed.Entries[0].Metadata["role"])
+	assert.Equal(t, map[string]interface{}{"secret": "[REDACTED]", "label": "ci"}, feed.Entries[0].Metadata["api_key"])
+}
@@ -0,0 +1,45 @@
+// tests/unit/services/barber_service_test.go
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindNearby_AttachesTopServices(t *testing.T) {
+	barbers := &mocks.MockBarberStore{}
+	t.Cleanup(func() { barbers.AssertExpectations(t) })
+	service := services.NewBarberService(barbers, nil)
+	ctx := context.Background()
+
+	lat, lng := 40.7, -74.0
+	near, far := 1.2, 6.5
+	barbers.On("FindNearby", ctx, lat, lng, config.DefaultNearbyRadiusKm, config.DefaultNearbyLimit).
+		Return([]models.Barber{{ID: 4, DistanceKm: &near}, {ID: 9, DistanceKm: &far}}, nil)
+	barbers.On("FindTopServices", ctx, []int{4, 9}, config.NearbyTopServices).
+		Return(map[int][]models.BarberService{4: {{ID: 40, BarberID: 4}, {ID: 41, BarberID: 4}}}, nil)
+
+	result, err := service.FindNearby(ctx, services.NearbyBarbersQuery{Latitude: &lat, Longitude: &lng})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Len(t, result[0].TopServices, 2)
+	assert.Empty(t, result[1].TopServices)
+}
+
+func TestFindNearby_RejectsLargeRadius(t *testing.T) {
+	service := services.NewBarberService(&mocks.MockBarberStore{}, nil)
+	lat, lng := 40.7, -74.0
+
+	_, err := service.FindNearby(context.Background(), services.NearbyBarbersQuery{
+		Latitude: &lat, Longitude: &lng, RadiusKm: config.MaxNearbyRadiusKm + 1,
+	})
+	assert.ErrorContains(t, err, "cannot exceed")
+}
@@ -0,0 +1,101 @@
+// tests/unit/services/booking_access_test.go
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// Booking 10 belongs to customer 7 and barber 3 (user 30)
+const (
+	accessBookingID      = 10
+	accessCustomerUserID = 7
+	accessBarberID       = 3
+	accessBarberUserID   = 30
+)
+
+type bookingAccessFixture struct {
+	bookings *mocks.MockBookingStore
+	barbers  *mocks.MockBarberStore
+	service  *services.BookingService
+}
+
+func newBookingAccessFixture(t *testing.T) *bookingAccessFixture {
+	f := &bookingAccessFixture{
+		bookings: &mocks.MockBookingStore{},
+		barbers:  &mocks.MockBarberStore{},
+	}
+	f.service = services.NewBookingService(f.bookings, &mocks.MockBookingSeriesStore{}, &mocks.MockScheduleStore{}, f.barbers, &mocks.MockServiceStore{}, nil)
+
+	customerID := accessCustomerUserID
+	f.bookings.On("FindByID", mock.Anything, accessBookingID).Return(&models.Booking{
+		ID:         accessBookingID,
+		CustomerID: &customerID,
+		BarberID:   accessBarberID,
+	}, nil)
+
+	t.Cleanup(func() {
+		f.bookings.AssertExpectations(t)
+		f.barbers.AssertExpectations(t)
+	})
+	return f
+}
+
+func (f *bookingAccessFixture) expectBarber() {
+	f.barbers.On("FindByID", mock.Anything, accessBarberID).
+		Return(&models.Barber{ID: accessBarberID, UserID: accessBarberUserID}, nil)
+}
+
+func TestAuthorizeBooking_OwningCustomer(t *testing.T) {
+	f := newBookingAccessFixture(t)
+
+	booking, err := f.service.AuthorizeBooking(context.Background(), accessBookingID, services.BookingActor{UserID: accessCustomerUserID})
+	require.NoError(t, err)
+	assert.Equal(t, accessBookingID, booking.ID)
+	f.barbers.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
+
+func TestAuthorizeBooking_AssignedBarber(t *testing.T) {
+	f := newBookingAccessFixture(t)
+	f.expectBarber()
+
+	booking, err := f.service.AuthorizeBooking(context.Background(), accessBookingID, services.BookingActor{UserID: accessBarberUserID})
+	require.NoError(t, err)
+	assert.Equal(t, accessBookingID, booking.ID)
+}
+
+func TestAuthorizeBooking_UnrelatedUserIsRefused(t *testing.T) {
+	f := newBookingAccessFixture(t)
+	f.expectBarber()
+
+	booking, err := f.service.AuthorizeBooking(context.Background(), accessBookingID, services.BookingActor{UserID: 8})
+	assert.ErrorIs(t, err, repository.ErrNotOwner)
+	assert.Nil(t, booking)
+}
+
+func TestAuthorizeBooking_OverrideSkipsTheOwnershipCheck(t *testing.T) {
+	f := newBookingAccessFixture(t)
+
+	booking, err := f.service.AuthorizeBooking(context.Background(), accessBookingID, services.BookingActor{UserID: 1, Override: true})
+	require.NoError(t, err)
+	assert.Equal(t, accessBookingID, booking.ID)
+	f.barbers.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+}
+
+func TestAuthorizeBooking_MissingBooking(t *testing.T) {
+	bookings := &mocks.MockBookingStore{}
+	service := services.NewBookingService(bookings, &mocks.MockBookingSeriesStore{}, &mocks.MockScheduleStore{}, &mocks.MockBarberStore{}, &mocks.MockServiceStore{}, nil)
+	bookings.On("FindByID", mock.Anything, 11).Return(nil, repository.ErrBookingNotFound)
+
+	_, err := service.AuthorizeBooking(context.Background(), 11, services.BookingActor{UserID: accessCustomerUserID})
+	assert.ErrorIs(t, err, repository.ErrBookingNotFound)
+}
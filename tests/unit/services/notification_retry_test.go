@@ -0,0 +1,68 @@
+// tests/unit/services/notification_retry_test.go
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newRetryFixture(t *testing.T) (*mocks.MockNotificationStore, *services.NotificationService) {
+	notifications := &mocks.MockNotificationStore{}
+	t.Cleanup(func() { notifications.AssertExpectations(t) })
+	return notifications, services.NewNotificationService(notifications, nil, nil, nil)
+}
+
+func TestRetryNotification_RequeuesAFailedNotification(t *testing.T) {
+	notifications, service := newRetryFixture(t)
+	ctx := context.Background()
+
+	notifications.On("FindByID", ctx, 12).Return(&models.Notification{ID: 12, UserID: 7, Status: config.NotificationStatusFailed}, nil)
+	notifications.On("Requeue", ctx, 12).Return(nil)
+
+	require.NoError(t, service.RetryNotification(ctx, 12))
+}
+
+func TestRetryNotification_OnlyFailedNotifications(t *testing.T) {
+	for _, status := range []string{config.NotificationStatusPending, config.NotificationStatusSent} {
+		t.Run(status, func(t *testing.T) {
+			notifications, service := newRetryFixture(t)
+			ctx := context.Background()
+
+			notifications.On("FindByID", ctx, 12).Return(&models.Notification{ID: 12, Status: status}, nil)
+
+			err := service.RetryNotification(ctx, 12)
+			assert.EqualError(t, err, "notification 12 is "+status+", only failed notifications can be retried")
+			notifications.AssertNotCalled(t, "Requeue", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestRetryNotification_Errors(t *testing.T) {
+	t.Run("missing notification", func(t *testing.T) {
+		notifications, service := newRetryFixture(t)
+		ctx := context.Background()
+		notifications.On("FindByID", ctx, 12).Return(nil, repository.ErrNotificationNotFound)
+
+		assert.ErrorIs(t, service.RetryNotification(ctx, 12), repository.ErrNotificationNotFound)
+	})
+
+	t.Run("requeue fails", func(t *testing.T) {
+		notifications, service := newRetryFixture(t)
+		ctx := context.Background()
+		notifications.On("FindByID", ctx, 12).Return(&models.Notification{ID: 12, Status: config.NotificationStatusFailed}, nil)
+		notifications.On("Requeue", ctx, 12).Return(errors.New("connection reset"))
+
+		assert.EqualError(t, service.RetryNotification(ctx, 12), "failed to requeue notification: connection reset")
+	})
+}
@@ -0,0 +1,89 @@
+// tests/unit/services/notification_templates_test.go
+package services_test
+
+import (
+	"testing"
+
+	"barber-booking-system/internal/i18n"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderTemplate(t *testing.T) {
+	accepted := []string{"booking_number", "start_time"}
+
+	text, err := services.RenderTemplate("Booking {booking_number} at {start_time}, ref {booking_number}", accepted,
+		map[string]string{"booking_number": "BK-1", "start_time": "Monday"})
+	require.NoError(t, err)
+	assert.Equal(t, "Booking BK-1 at Monday, ref BK-1", text)
+
+	// Accepted but not provided renders empty; braces without a name stay
+	text, err = services.RenderTemplate("At {start_time} {}", accepted, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "At  {}", text)
+
+	_, err = services.RenderTemplate("Hi {customer_name} and {barber}", accepted, nil)
+	assert.ErrorIs(t, err, services.ErrUnknownTemplateVariable)
+	assert.Contains(t, err.Error(), "{customer_name}, {barber}")
+}
+
+func TestTemplatePlaceholders(t *testing.T) {
+	assert.Equal(t, []string{"b", "a"}, services.TemplatePlaceholders("{b} {a} {b}"))
+	assert.Nil(t, services.TemplatePlaceholders("no placeholders"))
+}
+
+// Every built-in template only uses the variables it declares
+func TestTemplateCatalog_TemplatesUseTheirVariables(t *testing.T) {
+	s := services.NewNotificationService(nil, nil, nil, nil)
+
+	catalog := s.TemplateCatalog()
+	require.NotEmpty(t, catalog)
+	for _, info := range catalog {
+		_, err := s.ValidateTemplate(services.ValidateTemplateRequest{Key: info.Key, Title: info.Title, Message: info.Message})
+		assert.NoError(t, err, info.Key)
+		for _, variable := range info.Variables {
+			assert.NotEmpty(t, variable.Description, "%s: %s", info.Key, variable.Name)
+			assert.Equal(t, "{"+variable.Name+"}", variable.Placeholder)
+		}
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	s := services.NewNotificationService(nil, nil, nil, nil)
+
+	result, err := s.ValidateTemplate(services.ValidateTemplateRequest{
+		Key:     "rescheduled",
+		Message: "Moved from {old_start_time} to {new_start_time} ({booking_number})",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"old_start_time", "new_start_time", "booking_number"}, result.Variables)
+
+	// Only the reschedule template has the old and new times
+	_, err = s.ValidateTemplate(services.ValidateTemplateRequest{Key: "reminder", Message: "Was {old_start_time}"})
+	assert.ErrorIs(t, err, services.ErrUnknownTemplateVariable)
+
+	_, err = s.ValidateTemplate(services.ValidateTemplateRequest{Key: "nope", Message: "x"})
+	assert.ErrorIs(t, err, services.ErrUnknownTemplate)
+}
+
+// Every built-in template is translated into every language, with the
+// variables it declares
+func TestTemplateCatalog_Translations(t *testing.T) {
+	s := services.NewNotificationService(nil, nil, nil, nil)
+
+	for _, locale := range i18n.Locales() {
+		for _, info := range s.TemplateCatalog() {
+			assert.True(t, i18n.Has(locale, info.Title), "%s: %s title", locale, info.Key)
+			assert.True(t, i18n.Has(locale, info.Message), "%s: %s message", locale, info.Key)
+
+			_, err := s.ValidateTemplate(services.ValidateTemplateRequest{
+				Key:     info.Key,
+				Title:   i18n.Translate(locale, info.Title),
+				Message: i18n.Translate(locale, info.Message),
+			})
+			assert.NoError(t, err, "%s: %s", locale, info.Key)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// tests/unit/services/notification_undelivered_test.go
+package services_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFailUndeliveredNotifications_FailsThoseOutsideTheDeliveryWindow(t *testing.T) {
+	notifications, service := newRetryFixture(t)
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	service.SetClock(clock.NewFake(now))
+	ctx := context.Background()
+
+	notifications.On("FailUndelivered", ctx, now.Add(-config.NotificationDeliveryWindow), mock.MatchedBy(func(reason string) bool {
+		return reason != ""
+	})).Return(3, nil)
+
+	failed, err := service.FailUndeliveredNotifications(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, failed)
+}
+
+func TestFailUndeliveredNotifications_StoreError(t *testing.T) {
+	notifications, service := newRetryFixture(t)
+	ctx := context.Background()
+	notifications.On("FailUndelivered", ctx, mock.Anything, mock.Anything).Return(0, errors.New("connection reset"))
+
+	_, err := service.FailUndeliveredNotifications(ctx)
+	assert.EqualError(t, err, "connection reset")
+}
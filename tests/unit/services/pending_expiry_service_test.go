@@ -0,0 +1,210 @@
+// tests/unit/services/pending_expiry_service_test.go
+package services_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// txOnlyConnector opens connections that can begin, commit and roll back
+// transactions and nothing else, so a mocked BeginTx can hand the service
+// a real *sqlx.Tx
+type txOnlyConnector struct{}
+
+func (txOnlyConnector) Connect(context.Context) (driver.Conn, error) { return txOnlyConn{}, nil }
+func (txOnlyConnector) Driver() driver.Driver                        { return txOnlyDriver{} }
+
+type txOnlyDriver struct{}
+
+func (txOnlyDriver) Open(string) (driver.Conn, error) { return txOnlyConn{}, nil }
+
+type txOnlyConn struct{}
+
+func (txOnlyConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("no statements") }
+func (txOnlyConn) Close() error                        { return nil }
+func (txOnlyConn) Begin() (driver.Tx, error)           { return txOnlyConn{}, nil }
+func (txOnlyConn) Commit() error                       { return nil }
+func (txOnlyConn) Rollback() error                     { return nil }
+
+func newTx(t *testing.T) *sqlx.Tx {
+	db := sqlx.NewDb(sql.OpenDB(txOnlyConnector{}), "postgres")
+	t.Cleanup(func() { db.Close() })
+	tx, err := db.Beginx()
+	require.NoError(t, err)
+	return tx
+}
+
+type pendingExpiryFixture struct {
+	bookings      *mocks.MockBookingStore
+	notifications *mocks.MockNotificationStore
+	clock         *clock.Fake
+	service       *services.PendingExpiryService
+}
+
+func newPendingExpiryFixture(t *testing.T) *pendingExpiryFixture {
+	f := &pendingExpiryFixture{
+		bookings:      &mocks.MockBookingStore{},
+		notifications: &mocks.MockNotificationStore{},
+		clock:         clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)),
+	}
+	bookingService := services.NewBookingService(f.bookings, &mocks.MockBookingSeriesStore{}, &mocks.MockScheduleStore{}, &mocks.MockBarberStore{}, &mocks.MockServiceStore{}, nil)
+	notificationService := services.NewNotificationService(f.notifications, nil, f.bookings, nil)
+	f.service = services.NewPendingExpiryService(f.bookings, bookingService, notificationService, config.PendingExpiryConfig{TTL: time.Hour})
+	f.service.SetClock(f.clock)
+	t.Cleanup(func() {
+		f.bookings.AssertExpectations(t)
+		f.notifications.AssertExpectations(t)
+	})
+	return f
+}
+
+// pending is a booking made two hours before the fixture's clock
+func (f *pendingExpiryFixture) pending(id int) models.Booking {
+	customerID := 7
+	return models.Booking{
+		ID:                 id,
+		BookingNumber:      "BK-EXP",
+		CustomerID:         &customerID,
+		BarberID:           3,
+		ServiceName:        "Haircut",
+		Status:             config.BookingStatusPending,
+		ScheduledStartTime: f.clock.Now().Add(24 * time.Hour),
+		ScheduledEndTime:   f.clock.Now().Add(25 * time.Hour),
+		CreatedAt:          f.clock.Now().Add(-2 * time.Hour),
+	}
+}
+
+// expectScan returns bookings from the stale pending scan, which must ask
+// for bookings made before the TTL
+func (f *pendingExpiryFixture) expectScan(bookings ...models.Booking) {
+	f.bookings.On("FindStalePending", mock.Anything, f.clock.Now().Add(-time.Hour)).Return(bookings, nil)
+}
+
+// expectRead serves the booking as CancelBooking reads it
+func (f *pendingExpiryFixture) expectRead(booking models.Booking) {
+	f.bookings.On("FindByID", mock.Anything, booking.ID).Return(&booking, nil).Once()
+}
+
+// expectCancel expects booking, still pending under the lock, to be
+// cancelled by the barber, its expiry recorded and its customer told
+func (f *pendingExpiryFixture) expectCancel(t *testing.T, booking models.Booking) {
+	f.expectRead(booking)
+	f.bookings.On("BeginTx", mock.Anything).Return(newTx(t), nil).Once()
+	f.bookings.On("LockStatusTx", mock.Anything, mock.Anything, booking.ID, config.BookingStatusPending).Return(nil).Once()
+	f.bookings.On("SetCancellationTx", mock.Anything, mock.Anything, booking.ID, (*int)(nil), mock.Anything, "Not confirmed by the barber in time").Return(nil).Once()
+	f.bookings.On("UpdateStatusTx", mock.Anything, mock.Anything, booking.ID, config.BookingStatusCancelledByBarber).Return(nil).Once()
+	f.bookings.On("CreateHistory", mock.Anything, mock.MatchedBy(func(h *models.BookingHistory) bool {
+		return h.BookingID == booking.ID && h.ChangeType == "status_changed"
+	})).Return(nil).Once()
+	cancelled := booking
+	cancelled.Status = config.BookingStatusCancelledByBarber
+	f.expectRead(cancelled)
+	f.bookings.On("FindLineItems", mock.Anything, booking.ID).Return([]models.BookingLineItem{}, nil).Once()
+	f.bookings.On("CreateHistory", mock.Anything, mock.MatchedBy(func(h *models.BookingHistory) bool {
+		return h.BookingID == booking.ID && h.ChangeType == "pending_expired"
+	})).Return(nil).Once()
+	f.notifications.On("Create", mock.Anything, mock.MatchedBy(func(n *models.Notification) bool {
+		return n.UserID == *booking.CustomerID &&
+			n.RelatedEntityID != nil && *n.RelatedEntityID == booking.ID &&
+			n.Data["reason"] == "not_confirmed"
+	})).Return(nil).Once()
+}
+
+func TestPendingExpiry_CancelsStaleBookingsAndTellsTheCustomer(t *testing.T) {
+	f := newPendingExpiryFixture(t)
+	ctx := context.Background()
+
+	booking := f.pending(10)
+	f.expectScan(booking)
+	f.expectCancel(t, booking)
+
+	require.NoError(t, f.service.RunScheduled(ctx))
+}
+
+func TestPendingExpiry_LeavesFreshBookingsAlone(t *testing.T) {
+	f := newPendingExpiryFixture(t)
+	ctx := context.Background()
+
+	// Nothing was made before the TTL, so nothing is read or cancelled
+	f.expectScan()
+
+	require.NoError(t, f.service.RunScheduled(ctx))
+	f.bookings.AssertNotCalled(t, "FindByID", mock.Anything, mock.Anything)
+	f.notifications.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPendingExpiry_SkipsBookingConfirmedBeforeTheCancelReadsIt(t *testing.T) {
+	f := newPendingExpiryFixture(t)
+	ctx := context.Background()
+
+	booking := f.pending(10)
+	f.expectScan(booking)
+	confirmed := booking
+	confirmed.Status = config.BookingStatusConfirmed
+	f.expectRead(confirmed)
+
+	require.NoError(t, f.service.RunScheduled(ctx))
+	f.bookings.AssertNotCalled(t, "BeginTx", mock.Anything)
+	f.bookings.AssertNotCalled(t, "CreateHistory", mock.Anything, mock.Anything)
+	f.notifications.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestPendingExpiry_SkipsBookingConfirmedBeforeItsRowIsLocked(t *testing.T) {
+	f := newPendingExpiryFixture(t)
+	ctx := context.Background()
+
+	// The barber confirms 10 after CancelBooking read it as pending; 11
+	// still expires
+	race := f.pending(10)
+	stale := f.pending(11)
+	f.expectScan(race, stale)
+	f.expectRead(race)
+	f.bookings.On("BeginTx", mock.Anything).Return(newTx(t), nil).Once()
+	f.bookings.On("LockStatusTx", mock.Anything, mock.Anything, 10, config.BookingStatusPending).
+		Return(repository.ErrBookingStatusChanged).Once()
+	f.expectCancel(t, stale)
+
+	require.NoError(t, f.service.RunScheduled(ctx))
+	f.bookings.AssertNotCalled(t, "SetCancellationTx", mock.Anything, mock.Anything, 10, mock.Anything, mock.Anything, mock.Anything)
+	f.bookings.AssertNotCalled(t, "UpdateStatusTx", mock.Anything, mock.Anything, 10, mock.Anything)
+	f.notifications.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestPendingExpiry_KeepsGoingWhenOneBookingFails(t *testing.T) {
+	f := newPendingExpiryFixture(t)
+	ctx := context.Background()
+
+	failing := f.pending(10)
+	stale := f.pending(11)
+	f.expectScan(failing, stale)
+	f.bookings.On("FindByID", mock.Anything, 10).Return(nil, errors.New("connection reset")).Once()
+	f.expectCancel(t, stale)
+
+	require.NoError(t, f.service.RunScheduled(ctx))
+	f.notifications.AssertNumberOfCalls(t, "Create", 1)
+}
+
+func TestPendingExpiry_ScanFailure(t *testing.T) {
+	f := newPendingExpiryFixture(t)
+	ctx := context.Background()
+
+	f.bookings.On("FindStalePending", mock.Anything, mock.Anything).Return(nil, errors.New("connection reset"))
+
+	assert.EqualError(t, f.service.RunScheduled(ctx), "connection reset")
+}
@@ -0,0 +1,423 @@
+// tests/unit/services/portfolio_service_test.go
+package services_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/imaging"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStorage keeps uploaded files in memory
+type memoryStorage struct {
+	files     map[string][]byte
+	putErr    error // Returned by Put when set
+	deleteErr error // Returned by Delete when set
+}
+
+func newMemoryStorage() *memoryStorage {
+	return &memoryStorage{files: map[string][]byte{}}
+}
+
+func (s *memoryStorage) Put(_ context.Context, key string, data []byte, _ string) error {
+	if s.putErr != nil {
+		return s.putErr
+	}
+	s.files[key] = data
+	return nil
+}
+
+func (s *memoryStorage) Delete(_ context.Context, key string) error {
+	if s.deleteErr != nil {
+		return s.deleteErr
+	}
+	delete(s.files, key)
+	return nil
+}
+
+func (s *memoryStorage) URL(key string) string {
+	return "https://cdn.example.com/" + key
+}
+
+// Barber 3 (user 30) offers barber service 5
+const (
+	portfolioBarberID       = 3
+	portfolioBarberUserID   = 30
+	portfolioBarberService  = 5
+	portfolioOtherUserID    = 31
+	portfolioAdminUserID    = 1
+	portfolioMediaID        = 40
+	portfolioOtherServiceID = 6
+)
+
+type portfolioFixture struct {
+	media   *mocks.MockPortfolioStore
+	catalog *mocks.MockServiceStore
+	barbers *mocks.MockBarberStore
+	storage *memoryStorage
+	service *services.PortfolioService
+}
+
+func newPortfolioFixture(t *testing.T) *portfolioFixture {
+	f := &portfolioFixture{
+		media:   &mocks.MockPortfolioStore{},
+		catalog: &mocks.MockServiceStore{},
+		barbers: &mocks.MockBarberStore{},
+		storage: newMemoryStorage(),
+	}
+	f.service = services.NewPortfolioService(f.media, f.catalog, f.barbers, f.storage)
+	t.Cleanup(func() {
+		f.media.AssertExpectations(t)
+		f.catalog.AssertExpectations(t)
+		f.barbers.AssertExpectations(t)
+	})
+	return f
+}
+
+func (f *portfolioFixture) expectBarber() {
+	f.barbers.On("FindByID", mock.Anything, portfolioBarberID).
+		Return(&models.Barber{ID: portfolioBarberID, UserID: portfolioBarberUserID}, nil)
+}
+
+func (f *portfolioFixture) expectBarberService() {
+	f.catalog.On("FindBarberServiceByID", mock.Anything, portfolioBarberService).
+		Return(&models.BarberService{ID: portfolioBarberService, BarberID: portfolioBarberID}, nil)
+}
+
+// expectGallery serves count existing photos of kind
+func (f *portfolioFixture) expectGallery(kind string, count int) {
+	gallery := make([]models.PortfolioMedia, count)
+	for i := range gallery {
+		gallery[i] = models.PortfolioMedia{ID: i + 1, BarberServiceID: portfolioBarberService, Kind: kind, Position: i}
+	}
+	f.media.On("FindByBarberServiceID", mock.Anything, portfolioBarberService, kind).Return(gallery, nil).Once()
+}
+
+// pngPhoto is a width x height PNG
+func pngPhoto(t *testing.T, width, height int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func stringPtr(s string) *string { return &s }
+
+// ========================================================================
+// GALLERIES
+// ========================================================================
+
+func TestPortfolioListMedia(t *testing.T) {
+	t.Run("lists the service's photos", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarberService()
+		f.expectGallery(config.PortfolioKindBeforeAfter, 2)
+
+		media, err := f.service.ListMedia(context.Background(), portfolioBarberID, portfolioBarberService, config.PortfolioKindBeforeAfter)
+		require.NoError(t, err)
+		assert.Len(t, media, 2)
+	})
+
+	t.Run("another barber's service is not found", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarberService()
+
+		_, err := f.service.ListMedia(context.Background(), 4, portfolioBarberService, "")
+		assert.ErrorIs(t, err, repository.ErrBarberServiceNotFound)
+	})
+}
+
+func TestPortfolioUploadMedia_StoresPhotoAndThumbnail(t *testing.T) {
+	f := newPortfolioFixture(t)
+	ctx := context.Background()
+
+	f.expectBarber()
+	f.expectBarberService()
+	f.expectGallery(config.PortfolioKindWork, 3)
+	f.media.On("Create", ctx, mock.Anything, config.MaxGalleryImages).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.PortfolioMedia).ID = portfolioMediaID
+	}).Return(nil)
+
+	media, err := f.service.UploadMedia(ctx, portfolioBarberID, portfolioBarberService, config.PortfolioKindWork,
+		stringPtr("  Skin fade  "), pngPhoto(t, 2400, 1200), portfolioBarberUserID, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, portfolioMediaID, media.ID)
+	assert.Equal(t, portfolioBarberService, media.BarberServiceID)
+	assert.Equal(t, config.PortfolioKindWork, media.Kind)
+	require.NotNil(t, media.Caption)
+	assert.Equal(t, "Skin fade", *media.Caption)
+	require.NotNil(t, media.UploadedBy)
+	assert.Equal(t, portfolioBarberUserID, *media.UploadedBy)
+
+	// Scaled down and stored next to its thumbnail under the service
+	assert.Equal(t, config.UploadImageMaxDimension, media.Width)
+	assert.Equal(t, config.UploadImageMaxDimension/2, media.Height)
+	assert.Equal(t, "image/png", media.ContentType)
+	assert.True(t, strings.HasPrefix(media.ImageKey, "barber-services/5/"))
+	assert.True(t, strings.HasSuffix(media.ThumbnailKey, "_thumb.png"))
+	assert.Equal(t, "https://cdn.example.com/"+media.ImageKey, media.URL)
+	assert.Equal(t, "https://cdn.example.com/"+media.ThumbnailKey, media.ThumbnailURL)
+	assert.Len(t, f.storage.files, 2)
+	assert.Equal(t, media.SizeBytes, len(f.storage.files[media.ImageKey]))
+}
+
+func TestPortfolioUploadMedia_AdminMayUploadForAnyBarber(t *testing.T) {
+	f := newPortfolioFixture(t)
+	ctx := context.Background()
+
+	f.expectBarber()
+	f.expectBarberService()
+	f.expectGallery(config.PortfolioKindWork, 0)
+	f.media.On("Create", ctx, mock.Anything, config.MaxGalleryImages).Return(nil)
+
+	media, err := f.service.UploadMedia(ctx, portfolioBarberID, portfolioBarberService, config.PortfolioKindWork,
+		stringPtr("   "), pngPhoto(t, 40, 30), portfolioAdminUserID, true)
+	require.NoError(t, err)
+	assert.Nil(t, media.Caption, "blank captions are cleared")
+	assert.Equal(t, portfolioAdminUserID, *media.UploadedBy)
+}
+
+func TestPortfolioUploadMedia_Rejected(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("unknown kind", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		_, err := f.service.UploadMedia(ctx, portfolioBarberID, portfolioBarberService, "selfie", nil, pngPhoto(t, 40, 30), portfolioBarberUserID, false)
+		assert.EqualError(t, err, "kind must be portfolio or before_after")
+	})
+
+	t.Run("caption too long", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		caption := strings.Repeat("é", config.MaxPortfolioCaptionLength+1)
+		_, err := f.service.UploadMedia(ctx, portfolioBarberID, portfolioBarberService, config.PortfolioKindWork, &caption, pngPhoto(t, 40, 30), portfolioBarberUserID, false)
+		assert.EqualError(t, err, "caption cannot exceed 300 characters")
+	})
+
+	t.Run("another barber", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		_, err := f.service.UploadMedia(ctx, portfolioBarberID, portfolioBarberService, config.PortfolioKindWork, nil, pngPhoto(t, 40, 30), portfolioOtherUserID, false)
+		assert.ErrorIs(t, err, repository.ErrNotOwner)
+	})
+
+	t.Run("another barber's service", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		f.catalog.On("FindBarberServiceByID", mock.Anything, portfolioOtherServiceID).
+			Return(&models.BarberService{ID: portfolioOtherServiceID, BarberID: 4}, nil)
+		_, err := f.service.UploadMedia(ctx, portfolioBarberID, portfolioOtherServiceID, config.PortfolioKindWork, nil, pngPhoto(t, 40, 30), portfolioBarberUserID, false)
+		assert.ErrorIs(t, err, repository.ErrBarberServiceNotFound)
+	})
+
+	t.Run("gallery full", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		f.expectBarberService()
+		f.expectGallery(config.PortfolioKindWork, config.MaxGalleryImages)
+		_, err := f.service.UploadMedia(ctx, portfolioBarberID, portfolioBarberService, config.PortfolioKindWork, nil, pngPhoto(t, 40, 30), portfolioBarberUserID, false)
+		assert.ErrorIs(t, err, repository.ErrPortfolioLimit)
+		assert.Empty(t, f.storage.files)
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		f.expectBarberService()
+		f.expectGallery(config.PortfolioKindWork, 0)
+		_, err := f.service.UploadMedia(ctx, portfolioBarberID, portfolioBarberService, config.PortfolioKindWork, nil, []byte("%PDF-1.7"), portfolioBarberUserID, false)
+		assert.ErrorIs(t, err, imaging.ErrUnsupportedFormat)
+		assert.Empty(t, f.storage.files)
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		f.expectBarberService()
+		f.expectGallery(config.PortfolioKindWork, 0)
+		_, err := f.service.UploadMedia(ctx, portfolioBarberID, portfolioBarberService, config.PortfolioKindWork, nil, make([]byte, config.MaxImageSizeBytes+1), portfolioBarberUserID, false)
+		assert.ErrorIs(t, err, services.ErrImageTooLarge)
+	})
+}
+
+func TestPortfolioUploadMedia_Failures(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("storage fails", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		f.expectBarberService()
+		f.expectGallery(config.PortfolioKindWork, 0)
+		f.storage.putErr = errors.New("bucket unavailable")
+
+		_, err := f.service.UploadMedia(ctx, portfolioBarberID, portfolioBarberService, config.PortfolioKindWork, nil, pngPhoto(t, 40, 30), portfolioBarberUserID, false)
+		assert.EqualError(t, err, "bucket unavailable")
+		f.media.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("saving fails and the files are removed", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		f.expectBarberService()
+		f.expectGallery(config.PortfolioKindWork, config.MaxGalleryImages-1)
+		// Another upload took the last place since the gallery was counted
+		f.media.On("Create", ctx, mock.Anything, config.MaxGalleryImages).Return(repository.ErrPortfolioLimit)
+
+		_, err := f.service.UploadMedia(ctx, portfolioBarberID, portfolioBarberService, config.PortfolioKindWork, nil, pngPhoto(t, 40, 30), portfolioBarberUserID, false)
+		assert.ErrorIs(t, err, repository.ErrPortfolioLimit)
+		assert.Empty(t, f.storage.files)
+	})
+}
+
+func TestPortfolioUpdateCaption(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("sets the caption", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		f.expectBarberService()
+		f.media.On("FindByID", ctx, portfolioMediaID).Return(&models.PortfolioMedia{ID: portfolioMediaID, BarberServiceID: portfolioBarberService}, nil)
+		f.media.On("UpdateCaption", ctx, portfolioMediaID, stringPtr("Taper")).Return(nil)
+
+		media, err := f.service.UpdateCaption(ctx, portfolioBarberID, portfolioBarberService, portfolioMediaID,
+			services.PortfolioCaptionRequest{Caption: stringPtr(" Taper ")}, portfolioBarberUserID, false)
+		require.NoError(t, err)
+		assert.Equal(t, "Taper", *media.Caption)
+	})
+
+	t.Run("clears an empty caption", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		f.expectBarberService()
+		f.media.On("FindByID", ctx, portfolioMediaID).
+			Return(&models.PortfolioMedia{ID: portfolioMediaID, BarberServiceID: portfolioBarberService, Caption: stringPtr("Taper")}, nil)
+		f.media.On("UpdateCaption", ctx, portfolioMediaID, (*string)(nil)).Return(nil)
+
+		media, err := f.service.UpdateCaption(ctx, portfolioBarberID, portfolioBarberService, portfolioMediaID,
+			services.PortfolioCaptionRequest{Caption: stringPtr("")}, portfolioBarberUserID, false)
+		require.NoError(t, err)
+		assert.Nil(t, media.Caption)
+	})
+
+	t.Run("another service's photo is not found", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		f.expectBarberService()
+		f.media.On("FindByID", ctx, portfolioMediaID).Return(&models.PortfolioMedia{ID: portfolioMediaID, BarberServiceID: portfolioOtherServiceID}, nil)
+
+		_, err := f.service.UpdateCaption(ctx, portfolioBarberID, portfolioBarberService, portfolioMediaID,
+			services.PortfolioCaptionRequest{Caption: stringPtr("Taper")}, portfolioBarberUserID, false)
+		assert.ErrorIs(t, err, repository.ErrPortfolioMediaNotFound)
+		f.media.AssertNotCalled(t, "UpdateCaption", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("another barber", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+
+		_, err := f.service.UpdateCaption(ctx, portfolioBarberID, portfolioBarberService, portfolioMediaID,
+			services.PortfolioCaptionRequest{Caption: stringPtr("Taper")}, portfolioOtherUserID, false)
+		assert.ErrorIs(t, err, repository.ErrNotOwner)
+	})
+}
+
+func TestPortfolioReorderMedia(t *testing.T) {
+	ctx := context.Background()
+	req := services.PortfolioOrderRequest{Kind: config.PortfolioKindWork, MediaIDs: []int{3, 1, 2}}
+
+	t.Run("returns the gallery in its new order", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		f.expectBarberService()
+		f.media.On("Reorder", ctx, portfolioBarberService, config.PortfolioKindWork, []int{3, 1, 2}).Return(nil)
+		f.expectGallery(config.PortfolioKindWork, 3)
+
+		media, err := f.service.ReorderMedia(ctx, portfolioBarberID, portfolioBarberService, req, portfolioBarberUserID, false)
+		require.NoError(t, err)
+		assert.Len(t, media, 3)
+	})
+
+	t.Run("an incomplete order is rejected", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		f.expectBarberService()
+		f.media.On("Reorder", ctx, portfolioBarberService, config.PortfolioKindWork, []int{3, 1, 2}).Return(repository.ErrPortfolioOrderInvalid)
+
+		_, err := f.service.ReorderMedia(ctx, portfolioBarberID, portfolioBarberService, req, portfolioBarberUserID, false)
+		assert.ErrorIs(t, err, repository.ErrPortfolioOrderInvalid)
+	})
+
+	t.Run("another barber", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+
+		_, err := f.service.ReorderMedia(ctx, portfolioBarberID, portfolioBarberService, req, portfolioOtherUserID, false)
+		assert.ErrorIs(t, err, repository.ErrNotOwner)
+		f.media.AssertNotCalled(t, "Reorder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestPortfolioDeleteMedia(t *testing.T) {
+	ctx := context.Background()
+	deleted := &models.PortfolioMedia{
+		ID:              portfolioMediaID,
+		BarberServiceID: portfolioBarberService,
+		ImageKey:        "barber-services/5/a.png",
+		ThumbnailKey:    "barber-services/5/a_thumb.png",
+	}
+
+	t.Run("removes the photo and its files", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.storage.files[deleted.ImageKey] = []byte("photo")
+		f.storage.files[deleted.ThumbnailKey] = []byte("thumb")
+		f.storage.files["barber-services/5/b.png"] = []byte("other")
+		f.expectBarber()
+		f.expectBarberService()
+		f.media.On("Delete", ctx, portfolioBarberService, portfolioMediaID).Return(deleted, nil)
+
+		require.NoError(t, f.service.DeleteMedia(ctx, portfolioBarberID, portfolioBarberService, portfolioMediaID, portfolioBarberUserID, false))
+		assert.Equal(t, map[string][]byte{"barber-services/5/b.png": []byte("other")}, f.storage.files)
+	})
+
+	t.Run("a file that cannot be removed does not fail the delete", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.storage.deleteErr = errors.New("bucket unavailable")
+		f.expectBarber()
+		f.expectBarberService()
+		f.media.On("Delete", ctx, portfolioBarberService, portfolioMediaID).Return(deleted, nil)
+
+		assert.NoError(t, f.service.DeleteMedia(ctx, portfolioBarberID, portfolioBarberService, portfolioMediaID, portfolioBarberUserID, false))
+	})
+
+	t.Run("missing photo", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+		f.expectBarberService()
+		f.media.On("Delete", ctx, portfolioBarberService, portfolioMediaID).Return(nil, repository.ErrPortfolioMediaNotFound)
+
+		err := f.service.DeleteMedia(ctx, portfolioBarberID, portfolioBarberService, portfolioMediaID, portfolioBarberUserID, false)
+		assert.ErrorIs(t, err, repository.ErrPortfolioMediaNotFound)
+	})
+
+	t.Run("another barber", func(t *testing.T) {
+		f := newPortfolioFixture(t)
+		f.expectBarber()
+
+		err := f.service.DeleteMedia(ctx, portfolioBarberID, portfolioBarberService, portfolioMediaID, portfolioOtherUserID, false)
+		assert.ErrorIs(t, err, repository.ErrNotOwner)
+		f.media.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
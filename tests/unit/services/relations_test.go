@@ -0,0 +1,81 @@
+// tests/unit/services/relations_test.go
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func intPtr(v int) *int { return &v }
+
+func TestBookingResponse_RelationIDs(t *testing.T) {
+	response := services.BookingResponse{Booking: &models.Booking{
+		CustomerID:      intPtr(7),
+		BarberID:        3,
+		BarberServiceID: intPtr(12),
+	}}
+	assert.Equal(t, repository.RelationIDs{CustomerID: 7, BarberID: 3, BarberServiceID: 12}, response.RelationIDs())
+
+	guest := services.BookingResponse{Booking: &models.Booking{BarberID: 3}}
+	assert.Equal(t, repository.RelationIDs{BarberID: 3}, guest.RelationIDs())
+}
+
+func TestNotificationResponse_RelationIDs(t *testing.T) {
+	cases := map[string]models.JSONMap{
+		"created":   {"barber_id": 4},
+		"read back": {"barber_id": float64(4)},
+		"number":    {"barber_id": json.Number("4")},
+	}
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			response := services.NotificationResponse{Notification: &models.Notification{Data: data}}
+			assert.Equal(t, 4, response.RelationIDs().BarberID)
+		})
+	}
+
+	response := services.NotificationResponse{Notification: &models.Notification{Data: models.JSONMap{}}}
+	assert.Zero(t, response.RelationIDs().BarberID)
+}
+
+func TestRelationSet_For(t *testing.T) {
+	set := &repository.RelationSet{
+		Customers: map[int]*models.CustomerSummary{7: {ID: 7, Name: "Sam"}},
+		Barbers:   map[int]*models.BarberSummary{3: {ID: 3, ShopName: "Fade Room"}},
+		Services:  map[int]*models.ServiceSummary{},
+	}
+
+	relations := set.For(repository.RelationIDs{CustomerID: 7, BarberID: 3, BarberServiceID: 12})
+	require.NotNil(t, relations.Customer)
+	assert.Equal(t, "Sam", relations.Customer.Name)
+	require.NotNil(t, relations.Barber)
+	assert.Equal(t, "Fade Room", relations.Barber.ShopName)
+	assert.Nil(t, relations.Service, "missing services are left empty")
+}
+
+func TestHydrate_WithoutLoaderOrInclude(t *testing.T) {
+	responses := []services.BookingResponse{{Booking: &models.Booking{BarberID: 3}}}
+
+	// No loader, and a loader with nothing included, leave the list alone
+	// without touching the database
+	require.NoError(t, repository.Hydrate(context.Background(), nil, repository.Include{Barber: true}, responses))
+	require.NoError(t, repository.Hydrate(context.Background(), repository.NewRelationLoader(nil), repository.Include{}, responses))
+	assert.Nil(t, responses[0].Barber)
+}
+
+func TestFilters_Include(t *testing.T) {
+	bookings := repository.BookingFilters{IncludeCustomer: true, IncludeService: true}
+	assert.Equal(t, repository.Include{Customer: true, Service: true}, bookings.Include())
+
+	reviews := repository.ReviewFilters{IncludeBarber: true}
+	assert.Equal(t, repository.Include{Barber: true}, reviews.Include())
+
+	assert.False(t, repository.NotificationFilters{}.Include().Any())
+}
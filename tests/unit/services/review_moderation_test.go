@@ -0,0 +1,142 @@
+// tests/unit/services/review_moderation_test.go
+package services_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func pendingReview(id int) *models.Review {
+	return &models.Review{ID: id, BarberID: 3, ModerationStatus: config.ReviewModerationPending}
+}
+
+func TestGetModerationQueue_DefaultsToPendingOldestFirst(t *testing.T) {
+	f := newReviewFixture(t)
+	ctx := context.Background()
+
+	queued := mock.MatchedBy(func(filters repository.ReviewFilters) bool {
+		return filters.ModerationStatus == config.ReviewModerationPending &&
+			filters.SortBy == "created_at" && filters.Order == "ASC"
+	})
+	f.reviews.On("FindAll", ctx, queued).Return([]models.Review{*pendingReview(1)}, nil)
+	f.reviews.On("Count", ctx, queued).Return(1, nil)
+
+	reviews, total, err := f.service.GetModerationQueue(ctx, repository.ReviewFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Len(t, reviews, 1)
+}
+
+func TestGetModerationQueue_RejectsUnknownStatus(t *testing.T) {
+	f := newReviewFixture(t)
+
+	_, _, err := f.service.GetModerationQueue(context.Background(), repository.ReviewFilters{ModerationStatus: "hidden"})
+	assert.ErrorIs(t, err, repository.ErrInvalidModeration)
+}
+
+func TestApplyModeration_RejectUsesReasonTemplate(t *testing.T) {
+	f := newReviewFixture(t)
+	ctx := context.Background()
+
+	notes := "Links to a rival shop"
+	f.reviews.On("FindByID", ctx, 5).Return(pendingReview(5), nil)
+	f.reviews.On("UpdateModerationStatus", ctx, 5, config.ReviewModerationRejected, 1,
+		mock.MatchedBy(func(n *string) bool {
+			return n != nil && strings.HasPrefix(*n, "Spam or advertising: ") && strings.HasSuffix(*n, "\n\n"+notes)
+		})).Return(nil)
+
+	_, err := f.service.ApplyModeration(ctx, 5, config.ModerationActionReject,
+		services.ModerationActionRequest{Reason: "spam", Notes: &notes}, 1)
+	require.NoError(t, err)
+}
+
+func TestApplyModeration_ValidatesReason(t *testing.T) {
+	f := newReviewFixture(t)
+	ctx := context.Background()
+
+	_, err := f.service.ApplyModeration(ctx, 5, config.ModerationActionFlag, services.ModerationActionRequest{}, 1)
+	assert.ErrorIs(t, err, services.ErrModerationReasonRequired)
+
+	_, err = f.service.ApplyModeration(ctx, 5, config.ModerationActionReject, services.ModerationActionRequest{Reason: "rude"}, 1)
+	assert.ErrorIs(t, err, services.ErrUnknownModerationReason)
+
+	// Flag-only reasons cannot reject
+	_, err = f.service.ApplyModeration(ctx, 5, config.ModerationActionReject, services.ModerationActionRequest{Reason: "disputed"}, 1)
+	assert.ErrorIs(t, err, services.ErrUnknownModerationReason)
+}
+
+func TestApplyModeration_ApproveNeedsNoReason(t *testing.T) {
+	f := newReviewFixture(t)
+	ctx := context.Background()
+
+	f.reviews.On("FindByID", ctx, 5).Return(pendingReview(5), nil)
+	f.reviews.On("UpdateModerationStatus", ctx, 5, config.ReviewModerationApproved, 1, (*string)(nil)).Return(nil)
+	f.barbers.On("UpdateRatingStats", ctx, 3).Return(nil)
+
+	_, err := f.service.ApplyModeration(ctx, 5, config.ModerationActionApprove, services.ModerationActionRequest{}, 1)
+	require.NoError(t, err)
+}
+
+func TestApplyModeration_RefreshesRatingsWhenPublishingChanges(t *testing.T) {
+	f := newReviewFixture(t)
+	ctx := context.Background()
+
+	// Rejecting a pending review leaves ratings alone
+	f.reviews.On("FindByID", ctx, 5).Return(pendingReview(5), nil)
+	f.reviews.On("UpdateModerationStatus", ctx, 5, config.ReviewModerationRejected, 1, mock.Anything).Return(nil)
+	_, err := f.service.ApplyModeration(ctx, 5, config.ModerationActionReject, services.ModerationActionRequest{Reason: "spam"}, 1)
+	require.NoError(t, err)
+	f.barbers.AssertNotCalled(t, "UpdateRatingStats", mock.Anything, mock.Anything)
+
+	// Flagging a published one takes it out of the ratings
+	published := &models.Review{ID: 6, BarberID: 3, ModerationStatus: config.ReviewModerationApproved, IsPublished: true}
+	f.reviews.On("FindByID", ctx, 6).Return(published, nil)
+	f.reviews.On("UpdateModerationStatus", ctx, 6, config.ReviewModerationFlagged, 1, mock.Anything).Return(nil)
+	f.barbers.On("UpdateRatingStats", ctx, 3).Return(nil).Once()
+	_, err = f.service.ApplyModeration(ctx, 6, config.ModerationActionFlag, services.ModerationActionRequest{Reason: "needs_second_review"}, 1)
+	require.NoError(t, err)
+	f.barbers.AssertExpectations(t)
+}
+
+func TestBulkModerate_ReportsEachReview(t *testing.T) {
+	f := newReviewFixture(t)
+	ctx := context.Background()
+
+	f.reviews.On("FindByID", ctx, 5).Return(pendingReview(5), nil)
+	f.reviews.On("FindByID", ctx, 6).Return(nil, repository.ErrReviewNotFound)
+	f.reviews.On("UpdateModerationStatus", ctx, 5, config.ReviewModerationFlagged, 1, mock.Anything).Return(nil)
+
+	result, err := f.service.BulkModerate(ctx, services.BulkModerationRequest{
+		ReviewIDs: []int{5, 6, 5},
+		Action:    config.ModerationActionFlag,
+		Reason:    "needs_second_review",
+	}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Total, "duplicate IDs are moderated once")
+	assert.Equal(t, 1, result.Moderated)
+	assert.Equal(t, 1, result.Failed)
+	assert.Equal(t, "failed", result.Reviews[1].Status)
+	assert.NotEmpty(t, result.Reviews[1].Error)
+}
+
+func TestModerationReasons_ApplyToRejectOrFlag(t *testing.T) {
+	f := newReviewFixture(t)
+
+	for _, reason := range f.service.ModerationReasons() {
+		assert.NotEmpty(t, reason.Key)
+		assert.NotEmpty(t, reason.Message)
+		for _, action := range reason.Actions {
+			assert.Contains(t, []string{config.ModerationActionReject, config.ModerationActionFlag}, action, reason.Key)
+		}
+	}
+}
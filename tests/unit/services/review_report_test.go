@@ -0,0 +1,50 @@
+// tests/unit/services/review_report_test.go
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newReviewReportService(reviews *mocks.MockReviewStore) *services.ReviewReportService {
+	return services.NewReviewReportService(reviews, nil, nil, 0)
+}
+
+func TestReportReview_OtherNeedsDetails(t *testing.T) {
+	reviews := &mocks.MockReviewStore{}
+	blank := "   "
+
+	_, err := newReviewReportService(reviews).ReportReview(context.Background(), 5,
+		services.ReportReviewRequest{Reason: config.ReviewReportOther, Details: &blank}, 8)
+	assert.ErrorIs(t, err, services.ErrReportDetailsRequired)
+	reviews.AssertNotCalled(t, "FindByID")
+}
+
+func TestReportReview_OnlyPublishedReviews(t *testing.T) {
+	reviews := &mocks.MockReviewStore{}
+	ctx := context.Background()
+	reviews.On("FindByID", ctx, 5).Return(&models.Review{ID: 5, BarberID: 3, IsPublished: false}, nil)
+
+	_, err := newReviewReportService(reviews).ReportReview(ctx, 5,
+		services.ReportReviewRequest{Reason: config.ReviewReportSpam}, 8)
+	assert.ErrorIs(t, err, repository.ErrReviewNotFound)
+}
+
+func TestReportReview_NotOwnReview(t *testing.T) {
+	reviews := &mocks.MockReviewStore{}
+	ctx := context.Background()
+	author := 8
+	reviews.On("FindByID", ctx, 5).Return(&models.Review{ID: 5, BarberID: 3, CustomerID: &author, IsPublished: true}, nil)
+
+	_, err := newReviewReportService(reviews).ReportReview(ctx, 5,
+		services.ReportReviewRequest{Reason: config.ReviewReportSpam}, author)
+	assert.ErrorContains(t, err, "your own review")
+}
@@ -0,0 +1,100 @@
+// tests/unit/services/review_screening_test.go
+package services_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/screening"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func screeningConfig() config.ReviewScreeningConfig {
+	return config.ReviewScreeningConfig{
+		Enabled:            true,
+		AutoPublish:        true,
+		BlockedWords:       []string{"shit"},
+		MaxLinks:           1,
+		MaxLinkDensity:     0.2,
+		DuplicateWindow:    24 * time.Hour,
+		DuplicateMinLength: 20,
+		RatingOnlyLimit:    3,
+		RatingOnlyWindow:   time.Hour,
+	}
+}
+
+// createScreenedReview creates a review for booking 10 and expects it saved
+// with the given moderation status
+func createScreenedReview(t *testing.T, f *reviewFixture, req services.CreateReviewRequest, status string) {
+	ctx := context.Background()
+	f.bookings.On("FindByID", ctx, 10).Return(completedBooking(7), nil)
+	f.reviews.On("ExistsByBookingID", ctx, 10).Return(false, nil)
+	f.reviews.On("Create", ctx, mock.MatchedBy(func(r *models.Review) bool {
+		if r.ModerationStatus != status || r.IsPublished != (status == config.ReviewModerationApproved) {
+			return false
+		}
+		if status == config.ReviewModerationFlagged {
+			return r.ModerationNotes != nil && strings.HasPrefix(*r.ModerationNotes, "Flagged by automatic screening")
+		}
+		return true
+	})).Return(nil)
+
+	req.BookingID = 10
+	_, err := f.service.CreateReview(ctx, req, 7)
+	require.NoError(t, err)
+}
+
+func TestCreateReview_PublishesCleanScreenedReview(t *testing.T) {
+	f := newReviewFixture(t)
+	f.service.SetScreening(screening.New(screeningConfig()))
+
+	comment := "Sharp skin fade and a great chat, will be back."
+	f.reviews.On("CountMatchingComments", mock.Anything, screening.NormalizeText(comment), mock.Anything).Return(0, nil)
+
+	createScreenedReview(t, f, services.CreateReviewRequest{OverallRating: 5, Comment: &comment}, config.ReviewModerationApproved)
+}
+
+func TestCreateReview_FlagsProfanity(t *testing.T) {
+	f := newReviewFixture(t)
+	f.service.SetScreening(screening.New(screeningConfig()))
+
+	comment := "Waited an hour for a sh1t haircut, never again."
+	f.reviews.On("CountMatchingComments", mock.Anything, mock.Anything, mock.Anything).Return(0, nil)
+
+	createScreenedReview(t, f, services.CreateReviewRequest{OverallRating: 1, Comment: &comment}, config.ReviewModerationFlagged)
+}
+
+func TestCreateReview_FlagsDuplicateComment(t *testing.T) {
+	f := newReviewFixture(t)
+	f.service.SetScreening(screening.New(screeningConfig()))
+
+	comment := "Best barber in town, highly recommended!"
+	f.reviews.On("CountMatchingComments", mock.Anything, screening.NormalizeText(comment), mock.Anything).Return(2, nil)
+
+	createScreenedReview(t, f, services.CreateReviewRequest{OverallRating: 5, Comment: &comment}, config.ReviewModerationFlagged)
+}
+
+func TestCreateReview_FlagsRatingOnlyFlood(t *testing.T) {
+	f := newReviewFixture(t)
+	f.service.SetScreening(screening.New(screeningConfig()))
+
+	f.reviews.On("CountRatingOnly", mock.Anything, 3, mock.Anything).Return(3, nil)
+
+	createScreenedReview(t, f, services.CreateReviewRequest{OverallRating: 5}, config.ReviewModerationFlagged)
+}
+
+func TestCreateReview_LeavesPendingWhenScreeningFails(t *testing.T) {
+	f := newReviewFixture(t)
+	f.service.SetScreening(screening.New(screeningConfig()))
+
+	f.reviews.On("CountRatingOnly", mock.Anything, 3, mock.Anything).Return(0, context.DeadlineExceeded)
+
+	createScreenedReview(t, f, services.CreateReviewRequest{OverallRating: 4}, config.ReviewModerationPending)
+}
@@ -0,0 +1,126 @@
+// tests/unit/services/review_service_test.go
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type reviewFixture struct {
+	reviews  *mocks.MockReviewStore
+	bookings *mocks.MockBookingStore
+	barbers  *mocks.MockBarberStore
+	service  *services.ReviewService
+}
+
+func newReviewFixture(t *testing.T) *reviewFixture {
+	f := &reviewFixture{
+		reviews:  &mocks.MockReviewStore{},
+		bookings: &mocks.MockBookingStore{},
+		barbers:  &mocks.MockBarberStore{},
+	}
+	f.service = services.NewReviewService(f.reviews, f.bookings, f.barbers, nil)
+	t.Cleanup(func() {
+		f.reviews.AssertExpectations(t)
+		f.bookings.AssertExpectations(t)
+		f.barbers.AssertExpectations(t)
+	})
+	return f
+}
+
+func completedBooking(customerID int) *models.Booking {
+	return &models.Booking{ID: 10, BarberID: 3, CustomerID: &customerID, Status: config.BookingStatusCompleted}
+}
+
+func TestCreateReview_SavesPendingReview(t *testing.T) {
+	f := newReviewFixture(t)
+	ctx := context.Background()
+
+	f.bookings.On("FindByID", ctx, 10).Return(completedBooking(7), nil)
+	f.reviews.On("ExistsByBookingID", ctx, 10).Return(false, nil)
+	f.reviews.On("Create", ctx, mock.MatchedBy(func(r *models.Review) bool {
+		return r.BookingID == 10 && r.BarberID == 3 && *r.CustomerID == 7 &&
+			r.OverallRating == 5 && !r.IsPublished &&
+			r.ModerationStatus == config.ReviewModerationPending
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.Review).ID = 99
+	}).Return(nil)
+
+	resp, err := f.service.CreateReview(ctx, services.CreateReviewRequest{BookingID: 10, OverallRating: 5}, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 99, resp.ID)
+}
+
+func TestCreateReview_RejectsInvalidRatingWithoutLookups(t *testing.T) {
+	f := newReviewFixture(t)
+
+	_, err := f.service.CreateReview(context.Background(), services.CreateReviewRequest{BookingID: 10, OverallRating: 6}, 7)
+	assert.Error(t, err)
+}
+
+func TestCreateReview_RejectsUnfinishedBooking(t *testing.T) {
+	f := newReviewFixture(t)
+	ctx := context.Background()
+
+	booking := completedBooking(7)
+	booking.Status = config.BookingStatusConfirmed
+	f.bookings.On("FindByID", ctx, 10).Return(booking, nil)
+
+	_, err := f.service.CreateReview(ctx, services.CreateReviewRequest{BookingID: 10, OverallRating: 5}, 7)
+	assert.ErrorIs(t, err, repository.ErrBookingNotCompleted)
+}
+
+func TestCreateReview_RejectsDuplicate(t *testing.T) {
+	f := newReviewFixture(t)
+	ctx := context.Background()
+
+	f.bookings.On("FindByID", ctx, 10).Return(completedBooking(7), nil)
+	f.reviews.On("ExistsByBookingID", ctx, 10).Return(true, nil)
+
+	_, err := f.service.CreateReview(ctx, services.CreateReviewRequest{BookingID: 10, OverallRating: 5}, 7)
+	assert.ErrorIs(t, err, repository.ErrDuplicateReview)
+}
+
+func TestCanReviewBooking(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("missing booking", func(t *testing.T) {
+		f := newReviewFixture(t)
+		f.bookings.On("FindByID", ctx, 10).Return(nil, repository.ErrBookingNotFound)
+
+		ok, _, err := f.service.CanReviewBooking(ctx, 10, 7)
+		assert.False(t, ok)
+		assert.ErrorIs(t, err, repository.ErrBookingNotFound)
+	})
+
+	t.Run("someone else's booking", func(t *testing.T) {
+		f := newReviewFixture(t)
+		f.bookings.On("FindByID", ctx, 10).Return(completedBooking(8), nil)
+
+		ok, reason, err := f.service.CanReviewBooking(ctx, 10, 7)
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.NotEmpty(t, reason)
+	})
+
+	t.Run("reviewable", func(t *testing.T) {
+		f := newReviewFixture(t)
+		f.bookings.On("FindByID", ctx, 10).Return(completedBooking(7), nil)
+		f.reviews.On("ExistsByBookingID", ctx, 10).Return(false, nil)
+
+		ok, reason, err := f.service.CanReviewBooking(ctx, 10, 7)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Empty(t, reason)
+	})
+}
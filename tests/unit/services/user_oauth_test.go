@@ -0,0 +1,117 @@
+// tests/unit/services/user_oauth_test.go
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/oauth"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVerifier accepts any token as identity
+type fakeVerifier struct {
+	identity oauth.Identity
+}
+
+func (v *fakeVerifier) Name() string { return v.identity.Provider }
+
+func (v *fakeVerifier) Verify(_ context.Context, _, _ string) (*oauth.Identity, error) {
+	identity := v.identity
+	return &identity, nil
+}
+
+type socialLoginFixture struct {
+	users      *mocks.MockUserStore
+	identities *mocks.MockUserIdentityStore
+	service    *services.UserService
+}
+
+func newSocialLoginFixture(t *testing.T, identity oauth.Identity) *socialLoginFixture {
+	f := &socialLoginFixture{
+		users:      &mocks.MockUserStore{},
+		identities: &mocks.MockUserIdentityStore{},
+	}
+	f.service = services.NewUserService(f.users, "test-secret", time.Hour)
+	f.service.SetOAuthProviders(f.identities, &fakeVerifier{identity: identity})
+	t.Cleanup(func() {
+		f.users.AssertExpectations(t)
+		f.identities.AssertExpectations(t)
+	})
+	return f
+}
+
+var googleIdentity = oauth.Identity{
+	Provider:      "google",
+	Subject:       "g-123",
+	Email:         "jo@example.com",
+	EmailVerified: true,
+	Name:          "Jo",
+}
+
+func TestSocialLogin_DoesNotLinkAccountWithUnverifiedEmail(t *testing.T) {
+	f := newSocialLoginFixture(t, googleIdentity)
+	ctx := context.Background()
+
+	// Someone registered the address with a password and never verified it
+	f.identities.On("FindByProviderSubject", ctx, "google", "g-123").Return(nil, repository.ErrUserIdentityNotFound)
+	f.users.On("FindByEmail", ctx, "jo@example.com").Return(&models.User{
+		ID:            4,
+		Email:         "jo@example.com",
+		PasswordHash:  "hash",
+		UserType:      config.UserTypeCustomer,
+		Status:        config.UserStatusActive,
+		EmailVerified: false,
+	}, nil)
+
+	_, err := f.service.SocialLogin(ctx, "google", services.SocialLoginRequest{IDToken: "token"})
+	assert.ErrorIs(t, err, repository.ErrAccountEmailUnverified)
+	f.users.AssertNotCalled(t, "MarkEmailVerified", mock.Anything, mock.Anything)
+	f.identities.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestSocialLogin_LinksAccountWithVerifiedEmail(t *testing.T) {
+	f := newSocialLoginFixture(t, googleIdentity)
+	ctx := context.Background()
+
+	f.identities.On("FindByProviderSubject", ctx, "google", "g-123").Return(nil, repository.ErrUserIdentityNotFound)
+	f.users.On("FindByEmail", ctx, "jo@example.com").Return(&models.User{
+		ID:            4,
+		Email:         "jo@example.com",
+		UserType:      config.UserTypeCustomer,
+		Status:        config.UserStatusActive,
+		EmailVerified: true,
+	}, nil)
+	f.identities.On("Create", ctx, mock.MatchedBy(func(link *models.UserIdentity) bool {
+		return link.UserID == 4 && link.Provider == "google" && link.Subject == "g-123"
+	})).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.UserIdentity).ID = 9
+	}).Return(nil)
+	f.users.On("UpdateLastLogin", ctx, 4).Return(nil)
+	f.identities.On("UpdateLastLogin", ctx, 9).Return(nil)
+
+	resp, err := f.service.SocialLogin(ctx, "google", services.SocialLoginRequest{IDToken: "token"})
+	require.NoError(t, err)
+	assert.NotEmpty(t, resp.Token)
+}
+
+func TestSocialLogin_RejectsUnverifiedProviderEmail(t *testing.T) {
+	identity := googleIdentity
+	identity.EmailVerified = false
+	f := newSocialLoginFixture(t, identity)
+	ctx := context.Background()
+
+	f.identities.On("FindByProviderSubject", ctx, "google", "g-123").Return(nil, repository.ErrUserIdentityNotFound)
+
+	_, err := f.service.SocialLogin(ctx, "google", services.SocialLoginRequest{IDToken: "token"})
+	assert.ErrorIs(t, err, repository.ErrIdentityEmailUnverified)
+}
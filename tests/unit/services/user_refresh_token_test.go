@@ -0,0 +1,109 @@
+// tests/unit/services/user_refresh_token_test.go
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type refreshTokenFixture struct {
+	users   *mocks.MockUserStore
+	tokens  *mocks.MockRefreshTokenStore
+	clock   *clock.Fake
+	service *services.UserService
+}
+
+func newRefreshTokenFixture(t *testing.T) *refreshTokenFixture {
+	f := &refreshTokenFixture{
+		users:  &mocks.MockUserStore{},
+		tokens: &mocks.MockRefreshTokenStore{},
+		clock:  clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)),
+	}
+	f.service = services.NewUserService(f.users, "test-secret", time.Hour)
+	f.service.SetRefreshTokens(f.tokens, 24*time.Hour)
+	f.service.SetClock(f.clock)
+	t.Cleanup(func() {
+		f.users.AssertExpectations(t)
+		f.tokens.AssertExpectations(t)
+	})
+	return f
+}
+
+// liveToken is a token issued at the fixture's start, valid for a day
+func (f *refreshTokenFixture) liveToken() *models.RefreshToken {
+	issued := f.clock.Now()
+	return &models.RefreshToken{
+		ID:        5,
+		UserID:    4,
+		FamilyID:  "family",
+		ExpiresAt: issued.Add(24 * time.Hour),
+		CreatedAt: issued,
+	}
+}
+
+func TestRefreshToken_ExpiresByTheServiceClock(t *testing.T) {
+	f := newRefreshTokenFixture(t)
+	ctx := context.Background()
+
+	f.tokens.On("FindByHash", ctx, mock.Anything).Return(f.liveToken(), nil)
+	f.clock.Advance(24 * time.Hour)
+
+	_, err := f.service.RefreshToken(ctx, "raw")
+	assert.ErrorIs(t, err, repository.ErrRefreshTokenExpired)
+}
+
+func TestRefreshToken_RotatesAtTheServiceClock(t *testing.T) {
+	f := newRefreshTokenFixture(t)
+	ctx := context.Background()
+
+	f.tokens.On("FindByHash", ctx, mock.Anything).Return(f.liveToken(), nil)
+	f.users.On("FindByID", ctx, 4).Return(&models.User{ID: 4, Status: config.UserStatusActive}, nil)
+	f.clock.Advance(23 * time.Hour)
+	now := f.clock.Now()
+	f.tokens.On("Rotate", ctx, 5, mock.MatchedBy(func(successor *models.RefreshToken) bool {
+		return successor.FamilyID == "family" &&
+			successor.CreatedAt.Equal(now) &&
+			successor.ExpiresAt.Equal(now.Add(24*time.Hour))
+	})).Return(nil)
+
+	resp, err := f.service.RefreshToken(ctx, "raw")
+	require.NoError(t, err)
+	require.NotNil(t, resp.RefreshExpiresAt)
+	assert.True(t, resp.RefreshExpiresAt.Equal(now.Add(24*time.Hour)))
+}
+
+func TestRefreshToken_ReuseRevokesFamily(t *testing.T) {
+	f := newRefreshTokenFixture(t)
+	ctx := context.Background()
+
+	used := f.liveToken()
+	usedAt := f.clock.Now()
+	used.UsedAt = &usedAt
+	f.tokens.On("FindByHash", ctx, mock.Anything).Return(used, nil)
+	f.clock.Advance(time.Minute)
+	f.tokens.On("RevokeFamily", ctx, "family", f.clock.Now()).Return(int64(2), nil)
+
+	_, err := f.service.RefreshToken(ctx, "raw")
+	assert.ErrorIs(t, err, repository.ErrRefreshTokenReused)
+}
+
+func TestLogout_AllDevicesRevokesAtTheServiceClock(t *testing.T) {
+	f := newRefreshTokenFixture(t)
+	ctx := context.Background()
+
+	f.tokens.On("RevokeAllForUser", ctx, 4, f.clock.Now()).Return(int64(3), nil)
+
+	require.NoError(t, f.service.Logout(ctx, 4, services.LogoutRequest{AllDevices: true}))
+}
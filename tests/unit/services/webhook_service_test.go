@@ -0,0 +1,333 @@
+// tests/unit/services/webhook_service_test.go
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/clock"
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/repository/mocks"
+	"barber-booking-system/internal/services"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// Barber 3 (user 30) owns subscription 8
+const (
+	webhookBarberID       = 3
+	webhookBarberUserID   = 30
+	webhookSubscriptionID = 8
+)
+
+type webhookFixture struct {
+	webhooks *mocks.MockWebhookStore
+	barbers  *mocks.MockBarberStore
+	clock    *clock.Fake
+	service  *services.WebhookService
+}
+
+func newWebhookFixture(t *testing.T) *webhookFixture {
+	f := &webhookFixture{
+		webhooks: &mocks.MockWebhookStore{},
+		barbers:  &mocks.MockBarberStore{},
+		clock:    clock.NewFake(time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)),
+	}
+	f.service = services.NewWebhookService(f.webhooks, f.barbers, config.WebhookConfig{})
+	f.service.SetClock(f.clock)
+	t.Cleanup(func() {
+		f.webhooks.AssertExpectations(t)
+		f.barbers.AssertExpectations(t)
+	})
+	return f
+}
+
+func (f *webhookFixture) expectBarber() {
+	f.barbers.On("FindByID", mock.Anything, webhookBarberID).Return(&models.Barber{ID: webhookBarberID, UserID: webhookBarberUserID}, nil)
+}
+
+func (f *webhookFixture) expectSubscription(barberID int) {
+	f.webhooks.On("FindSubscriptionByID", mock.Anything, webhookSubscriptionID).Return(&models.WebhookSubscription{
+		ID:       webhookSubscriptionID,
+		BarberID: barberID,
+		IsActive: true,
+	}, nil)
+}
+
+func webhookBooking(status string) *models.Booking {
+	name := "Jo"
+	return &models.Booking{
+		ID:            10,
+		BookingNumber: "BK-100",
+		BarberID:      webhookBarberID,
+		Status:        status,
+		ServiceName:   "Haircut",
+		CustomerName:  &name,
+		TotalPrice:    35,
+		Currency:      "USD",
+	}
+}
+
+// bookingOutboxEvent is the outbox event the booking service records for
+// eventType
+func bookingOutboxEvent(t *testing.T, eventType string, booking *models.Booking) *models.OutboxEvent {
+	payload, err := json.Marshal(models.BookingEvent{Booking: booking, PreviousStatus: config.BookingStatusConfirmed})
+	require.NoError(t, err)
+	return &models.OutboxEvent{
+		EventID:       "evt-1",
+		EventType:     eventType,
+		AggregateType: config.OutboxAggregateBooking,
+		AggregateID:   booking.ID,
+		Payload:       payload,
+		OccurredAt:    time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+// ========================================================================
+// BOOKING EVENTS
+// ========================================================================
+
+func TestBookingEventHandler_QueuesOneDeliveryPerSubscriber(t *testing.T) {
+	f := newWebhookFixture(t)
+	ctx := context.Background()
+
+	f.webhooks.On("FindSubscribers", ctx, webhookBarberID, config.WebhookEventBookingCreated).
+		Return([]models.WebhookSubscription{{ID: 8}, {ID: 9}}, nil)
+	var queued []models.WebhookDelivery
+	f.webhooks.On("CreateDeliveries", ctx, mock.Anything).Run(func(args mock.Arguments) {
+		queued = args.Get(1).([]models.WebhookDelivery)
+	}).Return(nil)
+
+	event := bookingOutboxEvent(t, config.DomainEventBookingCreated, webhookBooking(config.BookingStatusPending))
+	require.NoError(t, f.service.BookingEventHandler(ctx, event))
+
+	require.Len(t, queued, 2)
+	assert.Equal(t, 8, queued[0].SubscriptionID)
+	assert.Equal(t, 9, queued[1].SubscriptionID)
+	for _, delivery := range queued {
+		assert.Equal(t, "evt-1", delivery.EventID, "deliveries share the domain event's ID")
+		assert.Equal(t, config.WebhookEventBookingCreated, delivery.EventType)
+	}
+
+	var sent map[string]interface{}
+	require.NoError(t, json.Unmarshal(queued[0].Payload, &sent))
+	assert.Equal(t, config.WebhookEventBookingCreated, sent["type"])
+	assert.Equal(t, "2026-03-10T12:00:00Z", sent["created_at"])
+	data := sent["data"].(map[string]interface{})
+	assert.Equal(t, "BK-100", data["booking_number"])
+	assert.Equal(t, "Jo", data["customer_name"])
+	assert.Equal(t, config.BookingStatusPending, data["status"])
+	assert.Equal(t, 35.0, data["total_price"])
+}
+
+func TestBookingEventHandler_MapsDomainEvents(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		status    string
+		webhook   string
+	}{
+		{"cancelled", config.DomainEventBookingCancelled, config.BookingStatusCancelledByCustomer, config.WebhookEventBookingCancelled},
+		{"completed", config.DomainEventBookingStatusChanged, config.BookingStatusCompleted, config.WebhookEventBookingCompleted},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newWebhookFixture(t)
+			ctx := context.Background()
+
+			f.webhooks.On("FindSubscribers", ctx, webhookBarberID, tt.webhook).Return([]models.WebhookSubscription{{ID: 8}}, nil)
+			f.webhooks.On("CreateDeliveries", ctx, mock.MatchedBy(func(deliveries []models.WebhookDelivery) bool {
+				return len(deliveries) == 1 && deliveries[0].EventType == tt.webhook
+			})).Return(nil)
+
+			require.NoError(t, f.service.BookingEventHandler(ctx, bookingOutboxEvent(t, tt.eventType, webhookBooking(tt.status))))
+		})
+	}
+}
+
+func TestBookingEventHandler_IgnoresOtherEvents(t *testing.T) {
+	f := newWebhookFixture(t)
+	ctx := context.Background()
+
+	for _, status := range []string{config.BookingStatusConfirmed, config.BookingStatusInProgress, config.BookingStatusNoShow} {
+		event := bookingOutboxEvent(t, config.DomainEventBookingStatusChanged, webhookBooking(status))
+		require.NoError(t, f.service.BookingEventHandler(ctx, event))
+	}
+
+	other := bookingOutboxEvent(t, config.DomainEventBookingCreated, webhookBooking(config.BookingStatusPending))
+	other.AggregateType = "review"
+	require.NoError(t, f.service.BookingEventHandler(ctx, other))
+
+	f.webhooks.AssertNotCalled(t, "FindSubscribers", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBookingEventHandler_NoSubscribers(t *testing.T) {
+	f := newWebhookFixture(t)
+	ctx := context.Background()
+
+	f.webhooks.On("FindSubscribers", ctx, webhookBarberID, config.WebhookEventBookingCreated).
+		Return([]models.WebhookSubscription{}, nil)
+
+	event := bookingOutboxEvent(t, config.DomainEventBookingCreated, webhookBooking(config.BookingStatusPending))
+	require.NoError(t, f.service.BookingEventHandler(ctx, event))
+	f.webhooks.AssertNotCalled(t, "CreateDeliveries", mock.Anything, mock.Anything)
+}
+
+func TestBookingEventHandler_Errors(t *testing.T) {
+	t.Run("subscriber lookup fails", func(t *testing.T) {
+		f := newWebhookFixture(t)
+		ctx := context.Background()
+		f.webhooks.On("FindSubscribers", ctx, webhookBarberID, config.WebhookEventBookingCreated).
+			Return(nil, errors.New("connection reset"))
+
+		event := bookingOutboxEvent(t, config.DomainEventBookingCreated, webhookBooking(config.BookingStatusPending))
+		assert.EqualError(t, f.service.BookingEventHandler(ctx, event), "connection reset")
+		f.webhooks.AssertNotCalled(t, "CreateDeliveries", mock.Anything, mock.Anything)
+	})
+
+	t.Run("queueing fails", func(t *testing.T) {
+		f := newWebhookFixture(t)
+		ctx := context.Background()
+		f.webhooks.On("FindSubscribers", ctx, webhookBarberID, config.WebhookEventBookingCreated).
+			Return([]models.WebhookSubscription{{ID: 8}}, nil)
+		f.webhooks.On("CreateDeliveries", ctx, mock.Anything).Return(errors.New("connection reset"))
+
+		event := bookingOutboxEvent(t, config.DomainEventBookingCreated, webhookBooking(config.BookingStatusPending))
+		assert.EqualError(t, f.service.BookingEventHandler(ctx, event), "connection reset")
+	})
+
+	t.Run("payload without a booking", func(t *testing.T) {
+		f := newWebhookFixture(t)
+
+		event := bookingOutboxEvent(t, config.DomainEventBookingCreated, webhookBooking(config.BookingStatusPending))
+		event.Payload = json.RawMessage(`{"previous_status":"pending"}`)
+		assert.ErrorContains(t, f.service.BookingEventHandler(context.Background(), event), "has no booking")
+	})
+}
+
+// ========================================================================
+// DELIVERY LOG
+// ========================================================================
+
+func TestListDeliveries(t *testing.T) {
+	t.Run("filters by status for the owner", func(t *testing.T) {
+		f := newWebhookFixture(t)
+		ctx := context.Background()
+		f.expectBarber()
+		f.expectSubscription(webhookBarberID)
+		f.webhooks.On("FindDeliveriesBySubscription", ctx, webhookSubscriptionID, config.WebhookDeliveryFailed, 20, 40).
+			Return([]models.WebhookDelivery{{ID: 1, Status: config.WebhookDeliveryFailed}}, 41, nil)
+
+		deliveries, total, err := f.service.ListDeliveries(ctx, webhookBarberID, webhookSubscriptionID, config.WebhookDeliveryFailed, 20, 40, webhookBarberUserID, false)
+		require.NoError(t, err)
+		assert.Len(t, deliveries, 1)
+		assert.Equal(t, 41, total)
+	})
+
+	t.Run("rejects unknown statuses", func(t *testing.T) {
+		f := newWebhookFixture(t)
+
+		_, _, err := f.service.ListDeliveries(context.Background(), webhookBarberID, webhookSubscriptionID, "bounced", 20, 0, webhookBarberUserID, false)
+		assert.ErrorContains(t, err, "status must be pending, delivered or failed")
+	})
+
+	t.Run("forbids other barbers", func(t *testing.T) {
+		f := newWebhookFixture(t)
+		f.expectBarber()
+
+		_, _, err := f.service.ListDeliveries(context.Background(), webhookBarberID, webhookSubscriptionID, "", 20, 0, 31, false)
+		assert.ErrorIs(t, err, repository.ErrNotOwner)
+	})
+
+	t.Run("admins may read any barber's log", func(t *testing.T) {
+		f := newWebhookFixture(t)
+		ctx := context.Background()
+		f.expectBarber()
+		f.expectSubscription(webhookBarberID)
+		f.webhooks.On("FindDeliveriesBySubscription", ctx, webhookSubscriptionID, "", 20, 0).Return([]models.WebhookDelivery{}, 0, nil)
+
+		_, _, err := f.service.ListDeliveries(ctx, webhookBarberID, webhookSubscriptionID, "", 20, 0, 1, true)
+		assert.NoError(t, err)
+	})
+
+	t.Run("another barber's subscription is not found", func(t *testing.T) {
+		f := newWebhookFixture(t)
+		f.expectBarber()
+		f.expectSubscription(4)
+
+		_, _, err := f.service.ListDeliveries(context.Background(), webhookBarberID, webhookSubscriptionID, "", 20, 0, webhookBarberUserID, false)
+		assert.ErrorIs(t, err, repository.ErrWebhookNotFound)
+	})
+}
+
+func TestRedeliver(t *testing.T) {
+	delivery := func(subscriptionID int, status string) *models.WebhookDelivery {
+		return &models.WebhookDelivery{ID: 50, SubscriptionID: subscriptionID, EventID: "evt", Status: status}
+	}
+
+	t.Run("requeues a failed delivery", func(t *testing.T) {
+		f := newWebhookFixture(t)
+		ctx := context.Background()
+		f.expectBarber()
+		f.expectSubscription(webhookBarberID)
+		f.webhooks.On("FindDeliveryByID", ctx, 50).Return(delivery(webhookSubscriptionID, config.WebhookDeliveryFailed), nil)
+		f.webhooks.On("Requeue", ctx, 50).Return(delivery(webhookSubscriptionID, config.WebhookDeliveryPending), nil)
+
+		requeued, err := f.service.Redeliver(ctx, webhookBarberID, webhookSubscriptionID, 50, webhookBarberUserID, false)
+		require.NoError(t, err)
+		assert.Equal(t, config.WebhookDeliveryPending, requeued.Status)
+		assert.Equal(t, "evt", requeued.EventID)
+	})
+
+	t.Run("refuses a delivery still pending", func(t *testing.T) {
+		f := newWebhookFixture(t)
+		ctx := context.Background()
+		f.expectBarber()
+		f.expectSubscription(webhookBarberID)
+		f.webhooks.On("FindDeliveryByID", ctx, 50).Return(delivery(webhookSubscriptionID, config.WebhookDeliveryPending), nil)
+
+		_, err := f.service.Redeliver(ctx, webhookBarberID, webhookSubscriptionID, 50, webhookBarberUserID, false)
+		assert.ErrorContains(t, err, "still pending")
+		f.webhooks.AssertNotCalled(t, "Requeue", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a delivery of another subscription is not found", func(t *testing.T) {
+		f := newWebhookFixture(t)
+		ctx := context.Background()
+		f.expectBarber()
+		f.expectSubscription(webhookBarberID)
+		f.webhooks.On("FindDeliveryByID", ctx, 50).Return(delivery(9, config.WebhookDeliveryFailed), nil)
+
+		_, err := f.service.Redeliver(ctx, webhookBarberID, webhookSubscriptionID, 50, webhookBarberUserID, false)
+		assert.ErrorIs(t, err, repository.ErrWebhookDeliveryNotFound)
+		f.webhooks.AssertNotCalled(t, "Requeue", mock.Anything, mock.Anything)
+	})
+
+	t.Run("a missing delivery is not found", func(t *testing.T) {
+		f := newWebhookFixture(t)
+		ctx := context.Background()
+		f.expectBarber()
+		f.expectSubscription(webhookBarberID)
+		f.webhooks.On("FindDeliveryByID", ctx, 50).Return(nil, repository.ErrWebhookDeliveryNotFound)
+
+		_, err := f.service.Redeliver(ctx, webhookBarberID, webhookSubscriptionID, 50, webhookBarberUserID, false)
+		assert.ErrorIs(t, err, repository.ErrWebhookDeliveryNotFound)
+	})
+
+	t.Run("forbids other barbers", func(t *testing.T) {
+		f := newWebhookFixture(t)
+		f.expectBarber()
+
+		_, err := f.service.Redeliver(context.Background(), webhookBarberID, webhookSubscriptionID, 50, 31, false)
+		assert.ErrorIs(t, err, repository.ErrNotOwner)
+		f.webhooks.AssertNotCalled(t, "FindDeliveryByID", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,149 @@
+// tests/unit/sms/twilio_test.go
+package sms_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/sms"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSender(t *testing.T, cfg config.TwilioConfig, handler http.HandlerFunc) *sms.TwilioSender {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	cfg.APIBaseURL = server.URL
+	sender, err := sms.NewTwilioSender(cfg)
+	require.NoError(t, err)
+	return sender
+}
+
+func TestNewTwilioSender_RequiresCredentialsAndSender(t *testing.T) {
+	_, err := sms.NewTwilioSender(config.TwilioConfig{AccountSID: "AC1", AuthToken: "secret"})
+	assert.ErrorIs(t, err, sms.ErrNotConfigured)
+
+	_, err = sms.NewTwilioSender(config.TwilioConfig{AccountSID: "AC1", MessagingServiceSID: "MG1"})
+	assert.ErrorIs(t, err, sms.ErrNotConfigured)
+
+	_, err = sms.NewTwilioSender(config.TwilioConfig{AccountSID: "AC1", AuthToken: "secret", MessagingServiceSID: "MG1"})
+	assert.NoError(t, err)
+}
+
+func TestTwilioSender_Send(t *testing.T) {
+	cfg := config.TwilioConfig{AccountSID: "AC123", AuthToken: "secret", FromNumber: "+15550001111"}
+	sender := newTestSender(t, cfg, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", r.URL.Path)
+		user, pass, _ := r.BasicAuth()
+		assert.Equal(t, "AC123", user)
+		assert.Equal(t, "secret", pass)
+
+		assert.Equal(t, "+15552223333", r.PostForm.Get("To"))
+		assert.Equal(t, "+15550001111", r.PostForm.Get("From"))
+		assert.Equal(t, "See you at 3 PM", r.PostForm.Get("Body"))
+		assert.Equal(t, "https://api.example.com/api/v1/notifications/9/sms-status", r.PostForm.Get("StatusCallback"))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"sid":"SM42","status":"queued"}`))
+	})
+
+	sid, err := sender.Send(context.Background(), sms.Message{
+		To:             "+15552223333",
+		Body:           "See you at 3 PM",
+		StatusCallback: "https://api.example.com/api/v1/notifications/9/sms-status",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "SM42", sid)
+}
+
+func TestTwilioSender_SendPrefersMessagingService(t *testing.T) {
+	cfg := config.TwilioConfig{AccountSID: "AC123", AuthToken: "secret", FromNumber: "+15550001111", MessagingServiceSID: "MG9"}
+	sender := newTestSender(t, cfg, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "MG9", r.PostForm.Get("MessagingServiceSid"))
+		assert.Empty(t, r.PostForm.Get("From"))
+		assert.Empty(t, r.PostForm.Get("StatusCallback"))
+		_, _ = w.Write([]byte(`{"sid":"SM1"}`))
+	})
+
+	_, err := sender.Send(context.Background(), sms.Message{To: "+15552223333", Body: "hi"})
+	require.NoError(t, err)
+}
+
+func TestTwilioSender_SendError(t *testing.T) {
+	cfg := config.TwilioConfig{AccountSID: "AC123", AuthToken: "secret", FromNumber: "+15550001111"}
+	sender := newTestSender(t, cfg, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"code":21211,"message":"The 'To' number is not a valid phone number."}`))
+	})
+
+	_, err := sender.Send(context.Background(), sms.Message{To: "+1", Body: "hi"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a valid phone number")
+	assert.Contains(t, err.Error(), "21211")
+
+	var providerErr *sms.ProviderError
+	require.ErrorAs(t, err, &providerErr)
+	assert.Equal(t, "twilio", providerErr.Provider)
+	assert.Equal(t, "21211", providerErr.Code)
+}
+
+func TestSuppressionReason(t *testing.T) {
+	assert.Equal(t, config.SuppressionReasonOptOut, sms.SuppressionReason("21610"))
+	assert.Equal(t, config.SuppressionReasonHardBounce, sms.SuppressionReason("30006"))
+	assert.Empty(t, sms.SuppressionReason("30003"), "unreachable handsets may come back")
+	assert.Empty(t, sms.SuppressionReason(""))
+}
+
+func TestOptOutKeywords(t *testing.T) {
+	assert.True(t, sms.IsOptOut("STOP"))
+	assert.True(t, sms.IsOptOut(" unsubscribe\n"))
+	assert.False(t, sms.IsOptOut("please stop by at 3"))
+	assert.False(t, sms.IsOptOut("START"))
+
+	assert.True(t, sms.IsOptIn("start"))
+	assert.True(t, sms.IsOptIn("Unstop"))
+	assert.False(t, sms.IsOptIn("STOP"))
+}
+
+func TestTwilioSender_VerifyCallback(t *testing.T) {
+	sender, err := sms.NewTwilioSender(config.TwilioConfig{AccountSID: "AC1", AuthToken: "12345", FromNumber: "+15550001111"})
+	require.NoError(t, err)
+
+	callbackURL := "https://api.example.com/api/v1/notifications/9/sms-status"
+	params := url.Values{
+		"MessageStatus": {"delivered"},
+		"MessageSid":    {"SM42"},
+		"AccountSid":    {"AC1"},
+	}
+
+	// Signed payload: URL + params sorted by name, each as name+value
+	mac := hmac.New(sha1.New, []byte("12345"))
+	mac.Write([]byte(callbackURL + "AccountSidAC1MessageSidSM42MessageStatusdelivered"))
+	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	assert.True(t, sender.VerifyCallback(callbackURL, params, signature))
+	assert.False(t, sender.VerifyCallback(callbackURL+"?x=1", params, signature), "different URL")
+	assert.False(t, sender.VerifyCallback(callbackURL, url.Values{"MessageStatus": {"failed"}}, signature), "tampered params")
+	assert.False(t, sender.VerifyCallback(callbackURL, params, ""), "missing signature")
+	assert.False(t, sender.VerifyCallback(callbackURL, params, "not base64!"), "malformed signature")
+}
+
+func TestIsFinal(t *testing.T) {
+	assert.True(t, sms.IsFinal(sms.StatusDelivered))
+	assert.True(t, sms.IsFinal(sms.StatusUndelivered))
+	assert.True(t, sms.IsFinal(sms.StatusFailed))
+	assert.False(t, sms.IsFinal(sms.StatusQueued))
+	assert.False(t, sms.IsFinal(sms.StatusSent))
+}
@@ -0,0 +1,80 @@
+// tests/unit/sqlhook/driver_test.go
+package sqlhook_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"barber-booking-system/internal/sqlhook"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDriver answers every query with no rows and counts the calls that
+// reach it
+type fakeDriver struct{ calls int }
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{driver: d}, nil }
+
+type fakeConn struct{ driver *fakeDriver }
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c *fakeConn) Close() error                        { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+
+func (c *fakeConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	c.driver.calls++
+	return emptyRows{}, nil
+}
+
+func (c *fakeConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	c.driver.calls++
+	return driver.RowsAffected(1), nil
+}
+
+type emptyRows struct{}
+
+func (emptyRows) Columns() []string         { return []string{"id"} }
+func (emptyRows) Close() error              { return nil }
+func (emptyRows) Next([]driver.Value) error { return io.EOF }
+
+func TestHooksRunBeforeEachCall(t *testing.T) {
+	base := &fakeDriver{}
+	var ops []string
+	sql.Register("sqlhook-test-run", sqlhook.WrapDriver(base, func(ctx context.Context, op string) error {
+		ops = append(ops, op)
+		return nil
+	}))
+	db, err := sql.Open("sqlhook-test-run", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	rows, err := db.QueryContext(context.Background(), "SELECT 1")
+	require.NoError(t, err)
+	rows.Close()
+	_, err = db.ExecContext(context.Background(), "UPDATE t SET x = 1")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{sqlhook.OpQuery, sqlhook.OpExec}, ops)
+	assert.Equal(t, 2, base.calls)
+}
+
+func TestHookErrorStopsTheCall(t *testing.T) {
+	base := &fakeDriver{}
+	refused := errors.New("refused")
+	sql.Register("sqlhook-test-refuse", sqlhook.WrapDriver(base, func(ctx context.Context, op string) error {
+		return refused
+	}))
+	db, err := sql.Open("sqlhook-test-refuse", "")
+	require.NoError(t, err)
+	defer db.Close()
+
+	_, err = db.ExecContext(context.Background(), "UPDATE t SET x = 1")
+	assert.ErrorIs(t, err, refused)
+	assert.Zero(t, base.calls)
+}
@@ -0,0 +1,99 @@
+// tests/unit/statemachine/statemachine_test.go
+package statemachine_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/models"
+	"barber-booking-system/internal/repository"
+	"barber-booking-system/internal/statemachine"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookingMachine_AliasesFollowCanonicalRules(t *testing.T) {
+	m := statemachine.NewBooking[*models.Booking]()
+
+	assert.True(t, m.Can(config.BookingStatusPending, config.BookingStatusCancelledByCustomer))
+	assert.True(t, m.Can(config.BookingStatusInProgress, config.BookingStatusCancelledByBarber))
+	assert.True(t, m.IsTerminal(config.BookingStatusCancelledByCustomer))
+
+	next, err := m.Allowed(config.BookingStatusCancelledByBarber)
+	require.NoError(t, err)
+	assert.Empty(t, next)
+}
+
+func TestBookingMachine_RepositoryAndModelAgree(t *testing.T) {
+	m := statemachine.NewBooking[*models.Booking]()
+	statuses := append(m.States(), config.BookingStatusCancelledByCustomer, config.BookingStatusCancelledByBarber)
+
+	for _, from := range statuses {
+		booking := &models.Booking{Status: from}
+		for _, to := range statuses {
+			assert.Equal(t, booking.CanTransitionTo(to), repository.IsValidStatusTransition(from, to),
+				"%s -> %s", from, to)
+		}
+	}
+}
+
+func TestMachine_GuardsBlockTransitions(t *testing.T) {
+	blocked := errors.New("too early")
+	m := statemachine.NewBooking[*models.Booking]().
+		Guard(config.BookingStatusConfirmed, config.BookingStatusNoShow, func(_ context.Context, b *models.Booking, _, _ string) error {
+			if b.ID == 1 {
+				return blocked
+			}
+			return nil
+		})
+
+	ctx := context.Background()
+	err := m.Check(ctx, &models.Booking{ID: 1}, config.BookingStatusConfirmed, config.BookingStatusNoShow)
+	assert.ErrorIs(t, err, statemachine.ErrTransitionNotAllowed)
+	assert.ErrorIs(t, err, blocked)
+
+	assert.NoError(t, m.Check(ctx, &models.Booking{ID: 2}, config.BookingStatusConfirmed, config.BookingStatusNoShow))
+
+	// Declared rules are checked before guards
+	err = m.Check(ctx, &models.Booking{ID: 2}, config.BookingStatusCompleted, config.BookingStatusNoShow)
+	assert.ErrorIs(t, err, statemachine.ErrTransitionNotAllowed)
+}
+
+func TestMachine_OnEnterHooks(t *testing.T) {
+	var entered []string
+	failing := errors.New("smtp down")
+	m := statemachine.NewBooking[*models.Booking]().
+		OnEnter(config.BookingStatusCancelled, func(_ context.Context, _ *models.Booking, _, to string) error {
+			entered = append(entered, "cancelled:"+to)
+			return nil
+		}).
+		OnEnter(config.BookingStatusCancelledByCustomer, func(_ context.Context, _ *models.Booking, _, _ string) error {
+			entered = append(entered, "by_customer")
+			return failing
+		})
+
+	err := m.Enter(context.Background(), &models.Booking{}, config.BookingStatusPending, config.BookingStatusCancelledByCustomer)
+	assert.ErrorIs(t, err, failing)
+	assert.Equal(t, []string{"cancelled:" + config.BookingStatusCancelledByCustomer, "by_customer"}, entered)
+
+	entered = nil
+	require.NoError(t, m.Enter(context.Background(), &models.Booking{}, config.BookingStatusPending, config.BookingStatusCancelledByBarber))
+	assert.Equal(t, []string{"cancelled:" + config.BookingStatusCancelledByBarber}, entered)
+}
+
+func TestMachine_Diagrams(t *testing.T) {
+	m := statemachine.NewBooking[any]()
+
+	mermaid := m.Mermaid()
+	assert.Contains(t, mermaid, "stateDiagram-v2")
+	assert.Contains(t, mermaid, "[*] --> pending")
+	assert.Contains(t, mermaid, "in_progress --> completed")
+	assert.Contains(t, mermaid, "completed --> [*]")
+
+	dot := m.DOT()
+	assert.Contains(t, dot, `"confirmed" -> "in_progress";`)
+	assert.Contains(t, dot, `"no_show" [peripheries=2];`)
+}
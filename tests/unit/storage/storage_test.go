@@ -0,0 +1,116 @@
+// tests/unit/storage/storage_test.go
+package storage_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"barber-booking-system/internal/config"
+	"barber-booking-system/internal/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	store := storage.NewLocalStorage(dir, "/uploads/")
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "reviews/1/a.jpg", []byte("photo"), "image/jpeg"))
+	data, err := os.ReadFile(filepath.Join(dir, "reviews", "1", "a.jpg"))
+	require.NoError(t, err)
+	assert.Equal(t, "photo", string(data))
+	assert.Equal(t, "/uploads/reviews/1/a.jpg", store.URL("reviews/1/a.jpg"))
+
+	require.NoError(t, store.Delete(ctx, "reviews/1/a.jpg"))
+	_, err = os.Stat(filepath.Join(dir, "reviews", "1", "a.jpg"))
+	assert.True(t, os.IsNotExist(err))
+	assert.NoError(t, store.Delete(ctx, "reviews/1/a.jpg"), "deleting a missing file is not an error")
+
+	for _, key := range []string{"", "/etc/passwd", "../outside", "reviews/../../outside", "reviews//a.jpg"} {
+		assert.ErrorIs(t, store.Put(ctx, key, nil, "image/jpeg"), storage.ErrInvalidKey, key)
+	}
+}
+
+func TestNewS3StorageRequiresCredentials(t *testing.T) {
+	_, err := storage.NewS3Storage(config.S3Config{Bucket: "photos"}, "")
+	assert.ErrorIs(t, err, storage.ErrNotConfigured)
+
+	_, err = storage.New(config.UploadConfig{Driver: "ftp"})
+	assert.Error(t, err)
+}
+
+func TestS3StorageSignsRequests(t *testing.T) {
+	type request struct {
+		method, path, auth, contentHash, contentType, body string
+	}
+	var got []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got = append(got, request{
+			method:      r.Method,
+			path:        r.URL.EscapedPath(),
+			auth:        r.Header.Get("Authorization"),
+			contentHash: r.Header.Get("X-Amz-Content-Sha256"),
+			contentType: r.Header.Get("Content-Type"),
+			body:        string(body),
+		})
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	store, err := storage.NewS3Storage(config.S3Config{
+		Bucket:          "photos",
+		Region:          "eu-west-1",
+		Endpoint:        server.URL,
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+		PathStyle:       true,
+	}, "")
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	require.NoError(t, store.Put(ctx, "reviews/1/a b.jpg", []byte("photo"), "image/jpeg"))
+	require.NoError(t, store.Delete(ctx, "reviews/1/a b.jpg"))
+	require.Len(t, got, 2)
+
+	sum := sha256.Sum256([]byte("photo"))
+	assert.Equal(t, http.MethodPut, got[0].method)
+	assert.Equal(t, "/photos/reviews/1/a%20b.jpg", got[0].path)
+	assert.Equal(t, "photo", got[0].body)
+	assert.Equal(t, "image/jpeg", got[0].contentType)
+	assert.Equal(t, hex.EncodeToString(sum[:]), got[0].contentHash)
+	assert.True(t, strings.HasPrefix(got[0].auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/"), got[0].auth)
+	assert.Contains(t, got[0].auth, "/eu-west-1/s3/aws4_request, SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=")
+	assert.Equal(t, http.MethodDelete, got[1].method)
+
+	assert.Equal(t, server.URL+"/photos/reviews/1/a%20b.jpg", store.URL("reviews/1/a b.jpg"))
+}
+
+func TestS3StorageReportsErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("<Error><Code>AccessDenied</Code></Error>"))
+	}))
+	defer server.Close()
+
+	store, err := storage.NewS3Storage(config.S3Config{
+		Bucket: "photos", Region: "us-east-1", Endpoint: server.URL,
+		AccessKeyID: "AKID", SecretAccessKey: "secret", PathStyle: true,
+	}, "https://cdn.example.com")
+	require.NoError(t, err)
+
+	err = store.Put(context.Background(), "reviews/1/a.jpg", []byte("photo"), "image/jpeg")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AccessDenied")
+	assert.Equal(t, "https://cdn.example.com/reviews/1/a.jpg", store.URL("reviews/1/a.jpg"))
+}
@@ -0,0 +1,72 @@
+// tests/unit/timefmt/timefmt_test.go
+package timefmt_test
+
+import (
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/timefmt"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// appointment is Monday, March 3, 2025 at 15:30 UTC
+var appointment = time.Date(2025, time.March, 3, 15, 30, 0, 0, time.UTC)
+
+func TestDefault_EnglishUTC(t *testing.T) {
+	style := timefmt.Default("", "")
+
+	assert.Equal(t, "Monday, March 3 at 3:30 PM", style.DateTime(appointment))
+	assert.Equal(t, "March 3", style.Date(appointment))
+}
+
+func TestWithPreferences_TimezoneLocaleAndClock(t *testing.T) {
+	base := timefmt.Default("UTC", "en")
+
+	madrid := base.WithPreferences(map[string]interface{}{
+		timefmt.PreferenceTimezone: "Europe/Madrid",
+		timefmt.PreferenceLocale:   "es-ES",
+	})
+	assert.Equal(t, "lunes, 3 de marzo a las 16:30", madrid.DateTime(appointment))
+
+	// The clock preference overrides the language's own
+	newYork := base.WithPreferences(map[string]interface{}{
+		timefmt.PreferenceTimezone:   "America/New_York",
+		timefmt.PreferenceTimeFormat: timefmt.Clock24,
+	})
+	assert.Equal(t, "Monday, March 3 at 10:30", newYork.DateTime(appointment))
+
+	german := base.WithPreferences(map[string]interface{}{timefmt.PreferenceLocale: "de"})
+	assert.Equal(t, "3. März", german.Date(appointment))
+}
+
+func TestWithPreferences_IgnoresInvalidValues(t *testing.T) {
+	base := timefmt.Default("Europe/Paris", "fr")
+
+	style := base.WithPreferences(map[string]interface{}{
+		timefmt.PreferenceTimezone:   "Mars/Olympus",
+		timefmt.PreferenceLocale:     "tlh",
+		timefmt.PreferenceTimeFormat: 12,
+	})
+	assert.Equal(t, "lundi 3 mars à 16:30", style.DateTime(appointment))
+	assert.Equal(t, base, timefmt.Default("Europe/Paris", "fr").WithPreferences(nil))
+}
+
+func TestValidatePreferences(t *testing.T) {
+	require.NoError(t, timefmt.ValidatePreferences(map[string]interface{}{
+		timefmt.PreferenceTimezone:   "Asia/Tokyo",
+		timefmt.PreferenceLocale:     "pt-BR",
+		timefmt.PreferenceTimeFormat: "12h",
+		"theme":                      "dark",
+	}))
+
+	for _, prefs := range []map[string]interface{}{
+		{timefmt.PreferenceTimezone: "Nowhere/Town"},
+		{timefmt.PreferenceTimezone: 3},
+		{timefmt.PreferenceLocale: "xx"},
+		{timefmt.PreferenceTimeFormat: "military"},
+	} {
+		assert.ErrorIs(t, timefmt.ValidatePreferences(prefs), timefmt.ErrInvalidPreference, prefs)
+	}
+}
@@ -0,0 +1,168 @@
+// tests/unit/worker/worker_test.go
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"barber-booking-system/internal/worker"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	base := 30 * time.Second
+	max := 10 * time.Minute
+
+	assert.Equal(t, 30*time.Second, worker.Backoff(1, base, max))
+	assert.Equal(t, 60*time.Second, worker.Backoff(2, base, max))
+	assert.Equal(t, 120*time.Second, worker.Backoff(3, base, max))
+	assert.Equal(t, max, worker.Backoff(10, base, max))
+	assert.Equal(t, max, worker.Backoff(1000, base, max), "large attempts must not overflow")
+	assert.Equal(t, base, worker.Backoff(0, base, max))
+}
+
+func TestAdd_IgnoresDisabledJobs(t *testing.T) {
+	w := worker.New()
+	noop := func(context.Context) error { return nil }
+
+	w.Add(worker.Job{Name: "disabled", Interval: 0, Run: noop})
+	w.Add(worker.Job{Name: "no_run", Interval: time.Second})
+	w.Add(worker.Job{Name: "enabled", Interval: time.Second, Run: noop})
+
+	assert.Equal(t, []string{"enabled"}, w.Jobs())
+}
+
+func TestRunOnce_RecoversFromPanicsAndErrors(t *testing.T) {
+	assert.NotPanics(t, func() {
+		worker.RunOnce(context.Background(), worker.Job{
+			Name: "panics",
+			Run:  func(context.Context) error { panic("boom") },
+		})
+	})
+	assert.NotPanics(t, func() {
+		worker.RunOnce(context.Background(), worker.Job{
+			Name: "fails",
+			Run:  func(context.Context) error { return errors.New("failed") },
+		})
+	})
+}
+
+func TestRun_RunsJobsUntilCancelled(t *testing.T) {
+	var runs atomic.Int32
+	w := worker.New()
+	w.Add(worker.Job{
+		Name:     "tick",
+		Interval: 5 * time.Millisecond,
+		Run: func(context.Context) error {
+			runs.Add(1)
+			return nil
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		w.Run(ctx)
+	}()
+
+	assert.Eventually(t, func() bool { return runs.Load() >= 2 }, time.Second, time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after cancellation")
+	}
+}
+
+func TestShutdown_LetsInFlightRunsFinishThenFlushes(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var runErr atomic.Value
+	var flushed atomic.Int32
+
+	w := worker.New()
+	w.Add(worker.Job{
+		Name:     "slow",
+		Interval: 5 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			select {
+			case started <- struct{}{}:
+			default:
+				return nil
+			}
+			<-release
+			runErr.Store(fmt.Sprint(ctx.Err()))
+			return nil
+		},
+	})
+	w.AddFlush(worker.Job{Name: "flush", Run: func(context.Context) error {
+		flushed.Add(1)
+		return nil
+	}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go w.Run(ctx)
+	<-started
+	assert.Equal(t, []string{"slow"}, w.Running())
+
+	// Cancelling Run's context only stops new runs
+	cancel()
+	time.AfterFunc(20*time.Millisecond, func() { close(release) })
+	status := w.Shutdown(context.Background())
+
+	assert.True(t, status.Drained)
+	assert.Empty(t, status.Interrupted)
+	assert.Equal(t, "<nil>", runErr.Load(), "in-flight run must keep a live context")
+	assert.Equal(t, []string{"flush"}, status.Flushed)
+	assert.Equal(t, int32(1), flushed.Load())
+	assert.Empty(t, w.Running())
+}
+
+func TestShutdown_AbortsRunsAtDeadline(t *testing.T) {
+	started := make(chan struct{}, 1)
+	w := worker.New()
+	w.Add(worker.Job{
+		Name:     "stuck",
+		Interval: 5 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			started <- struct{}{}
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	})
+	w.AddFlush(worker.Job{Name: "flush", Run: func(context.Context) error { return nil }})
+
+	go w.Run(context.Background())
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	status := w.Shutdown(ctx)
+
+	assert.False(t, status.Drained)
+	assert.Equal(t, []string{"stuck"}, status.Interrupted)
+	assert.Empty(t, status.Flushed)
+	assert.Equal(t, []string{"flush"}, status.Unflushed)
+	assert.Less(t, status.Duration, worker.AbortGrace, "aborted runs that return promptly must not wait out the grace period")
+}
+
+func TestShutdown_WithoutRunSkipsFlush(t *testing.T) {
+	var flushed atomic.Int32
+	w := worker.New()
+	w.AddFlush(worker.Job{Name: "flush", Run: func(context.Context) error {
+		flushed.Add(1)
+		return nil
+	}})
+
+	status := w.Shutdown(context.Background())
+	assert.True(t, status.Drained)
+	assert.Empty(t, status.Flushed)
+	assert.Zero(t, flushed.Load())
+}